@@ -13,6 +13,7 @@ import (
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	_ "github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github/chapool/go-wallet/internal/models"
@@ -20,8 +21,9 @@ import (
 
 func main() {
 	var (
-		txHash  = flag.String("tx", "", "Transaction hash")
-		chainID = flag.Int("chain", 97, "Chain ID")
+		txHash     = flag.String("tx", "", "Transaction hash")
+		chainID    = flag.Int("chain", 97, "Chain ID")
+		eventIndex = flag.Int("event", -1, "Event (log) index of the ERC20 transfer, required if the transaction contains several deposits")
 	)
 	flag.Parse()
 
@@ -44,25 +46,37 @@ func main() {
 
 	// Get transaction
 	txHashLower := strings.ToLower(*txHash)
-	transaction, err := models.Transactions(
+	mods := []qm.QueryMod{
 		models.TransactionWhere.ChainID.EQ(*chainID),
 		models.TransactionWhere.TXHash.EQ(txHashLower),
-	).One(ctx, db)
-
+	}
+	if *eventIndex >= 0 {
+		mods = append(mods, models.TransactionWhere.EventIndex.EQ(null.IntFrom(*eventIndex)))
+	}
+	transactions, err := models.Transactions(mods...).All(ctx, db)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			fmt.Fprintf(os.Stderr, "Transaction not found: %s\n", *txHash)
-			os.Exit(1)
-		}
 		fmt.Fprintf(os.Stderr, "Error getting transaction: %v\n", err)
 		os.Exit(1)
 	}
 
+	if len(transactions) == 0 {
+		fmt.Fprintf(os.Stderr, "Transaction not found: %s\n", *txHash)
+		os.Exit(1)
+	}
+	if len(transactions) > 1 {
+		fmt.Fprintf(os.Stderr, "Transaction %s contains %d deposits, select one with -event\n", *txHash, len(transactions))
+		os.Exit(1)
+	}
+	transaction := transactions[0]
+
 	fmt.Printf("Found transaction: %s\n", transaction.TXHash)
 	fmt.Printf("  Chain ID: %d\n", transaction.ChainID)
 	fmt.Printf("  To Address: %s\n", transaction.ToAddr)
 	fmt.Printf("  Amount: %s\n", transaction.Amount)
 	fmt.Printf("  Token Address: %s\n", transaction.TokenAddr.String)
+	if transaction.EventIndex.Valid {
+		fmt.Printf("  Event Index: %d\n", transaction.EventIndex.Int)
+	}
 	fmt.Println()
 
 	// Check if credit already exists
@@ -70,8 +84,8 @@ func main() {
 	err = db.QueryRowContext(ctx, `
 		SELECT COUNT(*) 
 		FROM credits 
-		WHERE chain_id = $1 AND tx_hash = $2
-	`, *chainID, txHashLower).Scan(&creditCount)
+		WHERE reference_type = $1 AND reference_id = $2
+	`, models.ReferenceTypeBlockchainTX, transaction.ID).Scan(&creditCount)
 
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error checking credit existence: %v\n", err)
@@ -142,22 +156,21 @@ func main() {
 		TokenID:       tokenID,
 		TokenSymbol:   tokenSymbol,
 		Amount:        transaction.Amount,
-		CreditType:    "deposit",
-		BusinessType:  "blockchain",
+		CreditType:    models.CreditTypeDeposit,
+		BusinessType:  models.BusinessTypeBlockchain,
 		ReferenceID:   transaction.ID,
-		ReferenceType: "blockchain_tx",
+		ReferenceType: models.ReferenceTypeBlockchainTX,
 		ChainID:       null.IntFrom(*chainID),
 		ChainType:     null.StringFrom("evm"),
-		Status:        "confirmed",
+		Status:        models.CreditStatusConfirmed,
 		BlockNumber:   null.Int64From(transaction.BlockNo),
 		TXHash:        null.StringFrom(transaction.TXHash),
-		EventIndex:    null.Int{}, // 暂时设为空
+		EventIndex:    transaction.EventIndex,
 	}
 
-	// For ERC20 transfers, try to get event index from transaction
-	// (This is a simplified version, in production you might want to query the receipt)
-	if tokenAddr != "" {
-		credit.EventIndex = null.IntFrom(0) // Default to 0 for ERC20
+	// ERC20 transactions scanned before event indexes were recorded default to 0
+	if tokenAddr != "" && !transaction.EventIndex.Valid {
+		credit.EventIndex = null.IntFrom(0)
 	}
 
 	if err := credit.Insert(ctx, db, boil.Infer()); err != nil {
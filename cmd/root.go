@@ -8,8 +8,13 @@ import (
 	"github.com/spf13/cobra"
 	"github/chapool/go-wallet/cmd/db"
 	"github/chapool/go-wallet/cmd/env"
+	"github/chapool/go-wallet/cmd/events"
 	"github/chapool/go-wallet/cmd/probe"
+	"github/chapool/go-wallet/cmd/scan"
 	"github/chapool/go-wallet/cmd/server"
+	"github/chapool/go-wallet/cmd/signer"
+	"github/chapool/go-wallet/cmd/snapshot"
+	"github/chapool/go-wallet/cmd/wallet"
 	"github/chapool/go-wallet/internal/config"
 )
 
@@ -33,8 +38,13 @@ func Execute() {
 	rootCmd.AddCommand(
 		db.New(),
 		env.New(),
+		events.New(),
 		probe.New(),
+		scan.New(),
 		server.New(),
+		signer.New(),
+		snapshot.New(),
+		wallet.New(),
 	)
 
 	if err := rootCmd.Execute(); err != nil {
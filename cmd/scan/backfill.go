@@ -0,0 +1,103 @@
+package scan
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/config"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/util/command"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/deposit"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/stats"
+)
+
+type backfillFlags struct {
+	ChainID   int
+	FromBlock int64
+	ToBlock   int64
+}
+
+func newBackfill() *cobra.Command {
+	var flags backfillFlags
+
+	cmd := &cobra.Command{
+		Use:   "backfill",
+		Short: "Scans a range of historical blocks for deposits.",
+		Long: `Scans the blocks from --from to --to (inclusive) of a chain and records deposits to user wallets.
+
+Progress is checkpointed in the database: if the command is interrupted or fails,
+running it again with the same range resumes from the last checkpoint.
+The scan rate is limited by WALLET_BACKFILL_BLOCKS_PER_SECOND.`,
+		Run: func(_ *cobra.Command, _ []string) {
+			backfillCmdFunc(flags)
+		},
+	}
+
+	cmd.Flags().IntVar(&flags.ChainID, "chain", 0, "Chain ID to backfill.")
+	cmd.Flags().Int64Var(&flags.FromBlock, "from", 0, "First block to scan.")
+	cmd.Flags().Int64Var(&flags.ToBlock, "to", 0, "Last block to scan (inclusive).")
+	_ = cmd.MarkFlagRequired("chain")
+	_ = cmd.MarkFlagRequired("from")
+	_ = cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
+func backfillCmdFunc(flags backfillFlags) {
+	err := command.WithServer(context.Background(), config.DefaultServiceConfigFromEnv(), func(ctx context.Context, s *api.Server) error {
+		log := util.LogFromContext(ctx)
+
+		// Stop at the next block on SIGINT/SIGTERM, the checkpoint is kept so the backfill can be resumed
+		ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		walletConfig := s.Config.Wallet
+		chainService := chain.NewService(s.DB, chain.BlockOverrides{
+			ConfirmationBlocks: walletConfig.ConfirmationBlocksOverrides,
+			FinalizedBlocks:    walletConfig.FinalizedBlocksOverrides,
+		})
+		depositService := deposit.NewService(s.DB, chainService, stats.NewService(s.DB))
+
+		// Withdraw status updates are left to the server
+		scanService := scan.NewService(
+			s.DB,
+			chainService,
+			depositService,
+			nil,
+			walletConfig.ScanInterval,
+			walletConfig.BlockBatchSize,
+			walletConfig.RPCBatchSize,
+			walletConfig.Backfill.BlocksPerSecond,
+			0, // backfill does not watch the chain head
+			nil,
+			false,
+		)
+		depositService.SetContractChecker(scanService)
+
+		job, err := scanService.BackfillRange(ctx, flags.ChainID, flags.FromBlock, flags.ToBlock)
+		if job != nil {
+			log.Info().
+				Str("job_id", job.ID).
+				Str("status", job.Status).
+				Int64("scanned_blocks", job.ScannedBlocks()).
+				Int64("total_blocks", job.TotalBlocks()).
+				Msg("Backfill finished")
+		}
+		if err != nil {
+			log.Err(err).Msg("Error while backfilling blocks")
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to backfill blocks")
+	}
+}
@@ -0,0 +1,12 @@
+package scan
+
+import (
+	"github.com/spf13/cobra"
+	"github/chapool/go-wallet/internal/util/command"
+)
+
+func New() *cobra.Command {
+	return command.NewSubcommandGroup("scan",
+		newBackfill(),
+	)
+}
@@ -78,16 +78,21 @@ func runServer(flags Flags) {
 			}
 		}
 
-		// Initialize wallet keystore and seed manager
+		// Validate the wallet config and log the effective values before any wallet service starts
+		if err := validateWalletConfig(s.Config.Wallet); err != nil {
+			log.Fatal().Err(err).Msg("Invalid wallet configuration")
+		}
+
+		// Initialize wallet keystore and signer (seed manager only in local signer mode)
 		// This must be done before router initialization
-		seedManager, err := initializeWallet(ctx, s)
+		deriver, err := initializeWallet(ctx, s)
 		if err != nil {
 			log.Fatal().Err(err).Msg("Failed to initialize wallet")
 		}
 
 		// Initialize and start blockchain scan service
-		// This starts scanning all active chains in the background
-		err = initializeScanService(ctx, s, seedManager)
+		// This starts scanning all active chains in the background, the workers are drained on shutdown
+		err = initializeScanService(s.Lifecycle.Attach(ctx), s, deriver)
 		if err != nil {
 			log.Fatal().Err(err).Msg("Failed to initialize scan service")
 		}
@@ -107,10 +112,19 @@ func runServer(flags Flags) {
 			}
 		}()
 
+		grpcServer, err := startGRPCServer(s)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to start gRPC API")
+		}
+
 		quit := make(chan os.Signal, 1)
 		signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 		<-quit
 
+		if grpcServer != nil {
+			grpcServer.Stop()
+		}
+
 		return nil
 	})
 	if err != nil {
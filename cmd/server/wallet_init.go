@@ -2,99 +2,206 @@ package server
 
 import (
 	"context"
+	"net"
+	"strings"
 	"time"
 
 	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/grpcapi"
+	"github/chapool/go-wallet/internal/config"
+	"github/chapool/go-wallet/internal/util/lifecycle"
 	"github/chapool/go-wallet/internal/wallet"
 	"github/chapool/go-wallet/internal/wallet/address"
+	"github/chapool/go-wallet/internal/wallet/addressbook"
+	"github/chapool/go-wallet/internal/wallet/addressindex"
+	"github/chapool/go-wallet/internal/wallet/alert"
+	"github/chapool/go-wallet/internal/wallet/allowance"
+	"github/chapool/go-wallet/internal/wallet/apitoken"
+	"github/chapool/go-wallet/internal/wallet/archive"
+	"github/chapool/go-wallet/internal/wallet/audit"
 	"github/chapool/go-wallet/internal/wallet/balance"
 	"github/chapool/go-wallet/internal/wallet/chain"
 	"github/chapool/go-wallet/internal/wallet/collect"
 	"github/chapool/go-wallet/internal/wallet/deposit"
+	"github/chapool/go-wallet/internal/wallet/diagnostics"
+	"github/chapool/go-wallet/internal/wallet/dust"
+	"github/chapool/go-wallet/internal/wallet/events"
+	"github/chapool/go-wallet/internal/wallet/export"
+	"github/chapool/go-wallet/internal/wallet/gasguard"
 	"github/chapool/go-wallet/internal/wallet/hotwallet"
 	"github/chapool/go-wallet/internal/wallet/keystore"
+	"github/chapool/go-wallet/internal/wallet/ledger"
+	"github/chapool/go-wallet/internal/wallet/maintenance"
+	"github/chapool/go-wallet/internal/wallet/nft"
+	"github/chapool/go-wallet/internal/wallet/notification"
+	"github/chapool/go-wallet/internal/wallet/organization"
+	"github/chapool/go-wallet/internal/wallet/price"
+	"github/chapool/go-wallet/internal/wallet/quarantine"
 	"github/chapool/go-wallet/internal/wallet/rebalance"
+	"github/chapool/go-wallet/internal/wallet/risk"
 	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/scanhealth"
 	"github/chapool/go-wallet/internal/wallet/seed"
+	"github/chapool/go-wallet/internal/wallet/settings"
 	"github/chapool/go-wallet/internal/wallet/signer"
+	"github/chapool/go-wallet/internal/wallet/signer/remote"
+	"github/chapool/go-wallet/internal/wallet/smartaccount"
+	"github/chapool/go-wallet/internal/wallet/statement"
+	"github/chapool/go-wallet/internal/wallet/stats"
+	"github/chapool/go-wallet/internal/wallet/token"
+	"github/chapool/go-wallet/internal/wallet/trace"
+	"github/chapool/go-wallet/internal/wallet/transaction"
+	"github/chapool/go-wallet/internal/wallet/transfer"
 	"github/chapool/go-wallet/internal/wallet/withdraw"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/rs/zerolog/log"
 
 	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
 )
 
-// initializeWallet initializes wallet keystore and seed manager at startup
+// initializeWallet initializes the keystore, seed manager and signer at startup.
+// In remote signer mode the keystore is not decrypted, addresses are derived and EVM transactions signed by the signer process.
 //
 //nolint:ireturn // Returning interface is intentional
-func initializeWallet(ctx context.Context, s *api.Server) (seed.Manager, error) {
+func initializeWallet(ctx context.Context, s *api.Server) (address.Deriver, error) {
+	// Initialize address service
+	addressService, err := address.NewService(s.DB, address.Config{
+		CoinTypes: s.Config.Wallet.Derivation.CoinTypes,
+		Accounts:  s.Config.Wallet.Derivation.Accounts,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create address service")
+	}
+
+	// Refuse to start if the configured derivation scheme would derive new wallets on a different path than existing ones
+	if err := addressService.CheckDerivationPaths(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to check derivation paths")
+	}
+
+	var (
+		deriver       address.Deriver
+		signerService signer.Service
+	)
+	if s.Config.Wallet.Signer.Mode == config.WalletSignerModeRemote {
+		client, err := newRemoteSigner(s.Config.Wallet.Signer)
+		if err != nil {
+			return nil, err
+		}
+		deriver = client
+		signerService = client
+	} else {
+		deriver, signerService, err = initializeLocalSigner(ctx, s, addressService)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Create wallet service
+	walletService, err := wallet.NewService(s.DB, deriver, addressService)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create wallet service")
+	}
+
+	// Store services in Server struct
+	s.Wallet = walletService
+	s.Signer = &signerServiceAdapter{signer: signerService}
+
+	return deriver, nil
+}
+
+// initializeLocalSigner decrypts the keystore and creates the signer signing with the seed in memory
+//
+//nolint:ireturn // Returning interface is intentional
+func initializeLocalSigner(ctx context.Context, s *api.Server, addressService address.Service) (address.Deriver, signer.Service, error) {
 	// Initialize keystore service
 	keystoreService, err := keystore.NewService(s.DB)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create keystore service")
+		return nil, nil, errors.Wrap(err, "failed to create keystore service")
 	}
 
 	// Initialize seed manager
 	seedManager := seed.NewManager()
 
-	// Initialize address service
-	addressService, err := address.NewService(s.DB)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to create address service")
-	}
-
 	// Initialize keystore (create or decrypt)
 	if err := wallet.InitializeKeystore(ctx, s.DB, seedManager, keystoreService, addressService); err != nil {
-		return nil, errors.Wrap(err, "failed to initialize keystore")
+		return nil, nil, errors.Wrap(err, "failed to initialize keystore")
 	}
 
-	// Create wallet service
-	walletService, err := wallet.NewService(s.DB, seedManager, addressService)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to create wallet service")
-	}
+	// Auto-lock applies from now on, the seed was unlocked with the password entered at startup
+	seedManager.SetAutoLock(s.Config.Wallet.SeedAutoLockAfter)
 
 	// Create signer service with signing configuration
 	signerService, err := signer.NewService(seedManager, addressService, s.Config.Wallet.EnableSigning)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create signer service")
+		return nil, nil, errors.Wrap(err, "failed to create signer service")
 	}
 
-	// Store services in Server struct
-	s.Wallet = walletService
-	s.Signer = &signerServiceAdapter{signer: signerService}
+	// The keystore can only be locked and unlocked at runtime if the seed is loaded by this process
+	s.KeystoreLock = wallet.NewKeystoreLock(s.DB, seedManager, keystoreService, addressService)
 
-	return seedManager, nil
+	return address.NewSeedDeriver(seedManager, addressService), signerService, nil
 }
 
-const (
-	// Default scan interval: 2 seconds (optimized for fast chains with ~0.5s block time)
-	// For chains with 0.5s block time, 2s interval means checking every ~4 blocks
-	defaultScanInterval = 2 * time.Second
-	// Default block batch size: 1000 blocks per scan (optimized for fast chains)
-	// Larger batch size for faster historical catch-up, but smaller batches per iteration
-	defaultBlockBatchSize = 1000
-	// Default deposit backfill interval: 30 seconds (reduced for faster processing)
-	defaultDepositBackfillInterval = 30 * time.Second
-	// Default collect interval for sweeping user addresses
-	defaultCollectInterval = 5 * time.Minute
-	// Default rebalance interval for hot wallets
-	defaultRebalanceInterval = 10 * time.Minute
-)
+// validateWalletConfig validates the wallet config and dumps the effective values to the log
+func validateWalletConfig(cfg config.Wallet) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	// Sensitive fields are tagged with `json:"-"` and therefore never part of the dump
+	effective, err := cfg.EffectiveConfigJSON()
+	if err != nil {
+		return err
+	}
+
+	log.Info().RawJSON("wallet_config", []byte(effective)).Msg("Effective wallet configuration")
+
+	return nil
+}
 
 // initializeScanService initializes and starts the blockchain scan service
 //
 //nolint:unparam // Error return is kept for future error handling (e.g., validation checks)
-func initializeScanService(ctx context.Context, s *api.Server, seedManager seed.Manager) error {
+func initializeScanService(ctx context.Context, s *api.Server, deriver address.Deriver) error {
 	log.Info().Msg("Initializing blockchain scan service")
 
 	// Initialize chain configuration service
-	chainService := chain.NewService(s.DB)
+	walletConfig := s.Config.Wallet
+	chainService := chain.NewService(s.DB, chain.BlockOverrides{
+		ConfirmationBlocks: walletConfig.ConfirmationBlocksOverrides,
+		FinalizedBlocks:    walletConfig.FinalizedBlocksOverrides,
+	})
+
+	// Initialize stats service (rollups updated by deposit and withdraw services)
+	statsService := stats.NewService(s.DB)
+	s.Stats = statsService
 
 	// Initialize deposit service
-	depositService := deposit.NewService(s.DB)
+	depositService := deposit.NewService(s.DB, chainService, statsService)
 	s.Deposit = depositService
 
+	// Deposits from source addresses or of tokens on the screening list are quarantined instead of credited,
+	// withdraws to such addresses or of such tokens are blocked; compliance is notified through its own
+	// webhook and email recipients. Addresses not on the list are optionally checked with a sanctions API.
+	complianceNotifier := alert.NewNotifier(walletConfig.Compliance.WebhookURL, s.Mailer, walletConfig.Compliance.EmailRecipients)
+	var screeningProvider quarantine.Provider
+	if walletConfig.Compliance.ProviderURL != "" {
+		var err error
+		screeningProvider, err = quarantine.NewSanctionsAPIProvider(
+			walletConfig.Compliance.ProviderURL,
+			walletConfig.Compliance.ProviderAPIKey,
+			walletConfig.Compliance.ProviderTimeout,
+		)
+		if err != nil {
+			return errors.Wrap(err, "failed to create sanctions screening provider")
+		}
+	}
+	quarantineService := quarantine.NewService(s.DB, statsService, complianceNotifier, screeningProvider)
+	depositService.SetScreeningHook(quarantineService)
+	s.Quarantine = quarantineService
+
 	// Initialize balance service
 	balanceService := balance.NewService(s.DB)
 	s.Balance = balanceService
@@ -102,13 +209,16 @@ func initializeScanService(ctx context.Context, s *api.Server, seedManager seed.
 	// --- Initialize Withdraw Related Services (needed for scan service) ---
 
 	// Initialize address service (stateless, can be re-created)
-	addressService, err := address.NewService(s.DB)
+	addressService, err := address.NewService(s.DB, address.Config{
+		CoinTypes: s.Config.Wallet.Derivation.CoinTypes,
+		Accounts:  s.Config.Wallet.Derivation.Accounts,
+	})
 	if err != nil {
 		return errors.Wrap(err, "failed to create address service for withdraw")
 	}
 
-	// Initialize hot wallet service
-	hotWalletService := hotwallet.NewService(s.DB, addressService, seedManager)
+	// Initialize hot wallet service, withdraws are spread over the hot wallets of a chain by the configured strategy
+	hotWalletService := hotwallet.NewService(s.DB, addressService, deriver, walletConfig.HotWalletStrategies)
 	s.HotWallet = hotWalletService
 
 	// Get signer service from adapter
@@ -118,6 +228,9 @@ func initializeScanService(ctx context.Context, s *api.Server, seedManager seed.
 	}
 	signerService := signerAdapter.signer
 
+	// Alerts (e.g. chain halts) are always logged and additionally sent to the webhook and email recipients if configured
+	alertNotifier := alert.NewNotifier(walletConfig.Alerts.WebhookURL, s.Mailer, walletConfig.Alerts.EmailRecipients)
+
 	// Create a temporary scan service (without withdrawStatusUpdater) for withdraw service initialization
 	// This is needed because withdrawService needs scanService, but scanService needs withdrawService
 	tempScanService := scan.NewService(
@@ -125,20 +238,174 @@ func initializeScanService(ctx context.Context, s *api.Server, seedManager seed.
 		chainService,
 		depositService,
 		nil, // withdrawStatusUpdater will be set later
-		defaultScanInterval,
-		defaultBlockBatchSize,
+		walletConfig.ScanInterval,
+		walletConfig.BlockBatchSize,
+		walletConfig.RPCBatchSize,
+		walletConfig.Backfill.BlocksPerSecond,
+		walletConfig.ChainHaltThreshold,
+		alertNotifier,
+		walletConfig.TokenDiscovery,
 	)
 
+	// Security notifications are pushed and mailed to users, unless disabled by the user
+	notificationService := notification.NewService(s.DB, s.Mailer, s.Push)
+	s.Notification = notificationService
+
+	// Push deposit and withdraw status changes queued by database triggers to the owning user's devices
+	notificationService.StartStatusPushWorker(ctx, walletConfig.StatusPushInterval)
+
+	// User API tokens authenticate programmatic access to wallet endpoints
+	s.APIToken = apitoken.NewService(s.DB)
+
+	// Organizations scope wallets, credits, default withdraw limits and hot wallets of their members
+	s.Organization = organization.NewService(s.DB)
+
+	// Per-user withdraw limits are managed by admins and checked on every withdraw request
+	riskService := risk.NewService(s.DB)
+	s.Risk = riskService
+
+	// Automatic operations of chains are paused while their base fee exceeds the configured ceiling
+	gasCeilings := make([]gasguard.Ceiling, 0, len(walletConfig.GasSpike.Ceilings))
+	for _, ceiling := range walletConfig.GasSpike.Ceilings {
+		gasCeilings = append(gasCeilings, gasguard.Ceiling{
+			ChainID:    ceiling.ChainID,
+			MaxBaseFee: ceiling.MaxBaseFeeWei(),
+		})
+	}
+	gasGuard := gasguard.NewGuard(
+		gasguard.Config{
+			Ceilings:      gasCeilings,
+			ResumePercent: walletConfig.GasSpike.ResumePercent,
+		},
+		tempScanService,
+		alertNotifier,
+	)
+	s.GasGuard = gasGuard
+	gasGuard.StartMonitor(ctx, walletConfig.GasSpike.CheckInterval)
+
+	// Maintenance mode is toggled by admins at runtime, approved withdraws are queued during the maintenance
+	settingsService := settings.NewService(s.DB)
+	s.Settings = settingsService
+
+	// Users may restrict withdraws to confirmed address book entries that passed the cooling period,
+	// EVM entries may additionally prove ownership with a signature of the address (EOA or EIP-1271 contract wallet)
+	addressBookService := addressbook.NewService(
+		s.DB,
+		addressbook.Config{
+			CoolingPeriod:        walletConfig.WithdrawAddress.WhitelistCoolingPeriod,
+			ConfirmationValidity: walletConfig.WithdrawAddress.WhitelistConfirmationValidity,
+		},
+		notificationService,
+		tempScanService,
+	)
+	s.AddressBook = addressBookService
+
+	// Outgoing transactions are deferred while their gas price exceeds the cap of the chain, admins may override the cap
+	gasPriceCaps := make([]gasguard.Cap, 0, len(walletConfig.GasPriceCap.Caps))
+	for _, c := range walletConfig.GasPriceCap.Caps {
+		gasPriceCaps = append(gasPriceCaps, gasguard.Cap{
+			ChainID:     c.ChainID,
+			MaxGasPrice: c.MaxGasPriceWei(),
+		})
+	}
+	gasPriceCap := gasguard.NewPriceCap(
+		gasguard.CapConfig{
+			Caps:        gasPriceCaps,
+			RetryDelays: walletConfig.GasPriceCap.RetryDelays,
+		},
+		settingsService,
+	)
+	s.GasPriceCap = gasPriceCap
+
 	// Initialize withdraw service
+	approvalThresholds := make([]withdraw.ApprovalThreshold, 0, len(walletConfig.WithdrawApprovalThresholds))
+	for _, threshold := range walletConfig.WithdrawApprovalThresholds {
+		approvalThresholds = append(approvalThresholds, withdraw.ApprovalThreshold{
+			TokenID:           threshold.TokenID,
+			MinAmount:         threshold.MinAmountFloat(),
+			RequiredApprovals: threshold.RequiredApprovals,
+		})
+	}
+	processingWindows := make([]withdraw.ProcessingWindow, 0, len(walletConfig.WithdrawWindows))
+	for _, window := range walletConfig.WithdrawWindows {
+		processingWindows = append(processingWindows, withdraw.ProcessingWindow{
+			ChainID: window.ChainID,
+			TokenID: window.TokenID,
+			Times:   window.TimesOfDay(),
+		})
+	}
+	withdrawBatches := make([]withdraw.BatchConfig, 0, len(walletConfig.WithdrawBatches))
+	for _, batch := range walletConfig.WithdrawBatches {
+		withdrawBatches = append(withdrawBatches, withdraw.BatchConfig{
+			ChainID:         batch.ChainID,
+			ContractAddress: batch.ContractAddress,
+			MaxSize:         batch.MaxSize,
+		})
+	}
+	contractAllowlist := make(withdraw.ContractAllowlist)
+	for _, contract := range walletConfig.WithdrawAddress.ContractAllowlist {
+		if contractAllowlist[contract.ChainID] == nil {
+			contractAllowlist[contract.ChainID] = make(map[string]bool)
+		}
+		contractAllowlist[contract.ChainID][strings.ToLower(contract.Address)] = true
+	}
 	withdrawService := withdraw.NewService(
 		s.DB,
+		withdraw.Config{
+			BaseFeeMultiplier:   walletConfig.Fees.BaseFeeMultiplier,
+			WorkerConcurrency:   walletConfig.WorkerConcurrency,
+			ApprovalThresholds:  approvalThresholds,
+			ProcessingWindows:   processingWindows,
+			LegacyTxChainIDs:    walletConfig.Fees.LegacyTxChainIDs,
+			Batches:             withdrawBatches,
+			QueueOnGasSpike:     walletConfig.GasSpike.WithdrawMode == config.WalletGasSpikeWithdrawModeQueue,
+			ContractAllowlist:   contractAllowlist,
+			InternalAddressMode: walletConfig.WithdrawAddress.InternalMode,
+			FrozenCredits: withdraw.FrozenCreditsConfig{
+				ReleaseAfter: walletConfig.FrozenCredits.ReleaseAfter,
+				StaleAfter:   walletConfig.FrozenCredits.StaleAfter,
+			},
+			RequestExpiry:    walletConfig.WithdrawExpiry.RequestExpiry,
+			FeeAccountUserID: walletConfig.Fees.WithdrawFeeAccountUserID,
+			RateLimit: withdraw.RateLimit{
+				MaxRequests: walletConfig.WithdrawRateLimit.MaxRequests,
+				Window:      walletConfig.WithdrawRateLimit.Window,
+			},
+		},
+		chainService,
 		balanceService,
 		hotWalletService,
 		tempScanService,
 		signerService,
+		statsService,
+		notificationService,
+		riskService,
+		gasGuard,
+		settingsService,
+		addressBookService,
+		gasPriceCap,
+		alertNotifier,
+		quarantineService,
 	)
 	s.Withdraw = withdrawService
 
+	// Poll withdraw confirmations directly via RPC so status progression
+	// does not depend on the block scanner being healthy for the chain
+	withdrawService.StartConfirmationPoller(ctx, walletConfig.WithdrawConfirmationInterval)
+
+	// Approved withdraws of chains/tokens with processing windows are batched at the configured times
+	withdrawService.StartWindowProcessor(ctx, walletConfig.WithdrawWindowInterval)
+
+	// Approved withdraws are written to an outbox in the approval transaction; the dispatcher
+	// signs and broadcasts those whose processing did not complete (e.g. after a crash)
+	withdrawService.StartOutboxDispatcher(ctx, walletConfig.WithdrawOutboxInterval)
+
+	// Frozen credits of withdraws that failed before signing are released, other stuck withdraws are escalated
+	withdrawService.StartFrozenCreditReconciler(ctx, walletConfig.FrozenCredits.Interval)
+
+	// Withdraw requests awaiting approval past their expiry are rejected and their credits unfrozen
+	withdrawService.StartRequestExpiryWorker(ctx, walletConfig.WithdrawExpiry.Interval)
+
 	// Create scan service with withdrawService as WithdrawStatusUpdater
 	// These can be made configurable via environment variables in the future
 	scanService := scan.NewService(
@@ -146,66 +413,351 @@ func initializeScanService(ctx context.Context, s *api.Server, seedManager seed.
 		chainService,
 		depositService,
 		withdrawService, // withdrawService implements WithdrawStatusUpdater interface
-		defaultScanInterval,
-		defaultBlockBatchSize,
+		walletConfig.ScanInterval,
+		walletConfig.BlockBatchSize,
+		walletConfig.RPCBatchSize,
+		walletConfig.Backfill.BlocksPerSecond,
+		walletConfig.ChainHaltThreshold,
+		alertNotifier,
+		walletConfig.TokenDiscovery,
 	)
 
 	// Store scan service in Server struct (optional, for API access)
 	s.Scan = scanService
 
+	// After restoring the wallets table from an older backup, address_indexes may be behind addresses already handed out
+	if walletConfig.AddressIndexRecovery.OnStartup {
+		recoverAddressIndexes(ctx, s, addressService, deriver, chainService, scanService)
+	}
+
+	// On chains with smart accounts new user wallets are counterfactual ERC-4337 accounts computed by the factory contract
+	smartAccountConfigs := make([]smartaccount.Config, 0, len(walletConfig.SmartAccounts))
+	for _, account := range walletConfig.SmartAccounts {
+		smartAccountConfigs = append(smartAccountConfigs, smartaccount.Config{
+			ChainID:    account.ChainID,
+			Factory:    common.HexToAddress(account.FactoryAddress),
+			EntryPoint: common.HexToAddress(account.EntryPointAddress),
+			Paymaster:  common.HexToAddress(account.PaymasterAddress),
+		})
+	}
+	smartAccountService := smartaccount.NewService(smartAccountConfigs, scanService)
+	s.Wallet.SetSmartAccounts(smartAccountService)
+
+	// Deposit rules with a sender_is_contract condition query contract code through the scan service
+	depositService.SetContractChecker(scanService)
+
+	// Tokens registered by admins are validated on-chain through the scan service
+	s.Token = token.NewService(s.DB, chainService, scanService)
+
+	// Admins query scanned transactions instead of the database and cross-check them on chain
+	s.Transaction = transaction.NewService(s.DB, chainService, scanService)
+
+	// Users trace deposits that have not been credited before opening a support ticket
+	s.DepositTrace = trace.NewService(
+		s.DB,
+		trace.Config{
+			RateLimit: trace.RateLimit{
+				MaxRequests: walletConfig.DepositTraceRateLimit.MaxRequests,
+				Window:      walletConfig.DepositTraceRateLimit.Window,
+			},
+		},
+		chainService,
+		scanService,
+	)
+
+	// Operations teams reconcile deposits, withdraws and collects from exported files
+	s.Export = export.NewService(s.DB)
+
+	// USD token prices enrich balance responses, tokens unsupported by the provider are priced by admins
+	priceProvider, err := price.NewProvider(
+		price.Config{
+			Provider:         walletConfig.Prices.Provider,
+			CoinGeckoURL:     walletConfig.Prices.CoinGeckoURL,
+			CoinGeckoAPIKey:  walletConfig.Prices.CoinGeckoAPIKey,
+			CoinGeckoIDs:     walletConfig.Prices.CoinGeckoIDs,
+			ChainlinkChainID: walletConfig.Prices.ChainlinkChainID,
+			ChainlinkFeeds:   walletConfig.Prices.ChainlinkFeeds,
+		},
+		scanService,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create token price provider")
+	}
+	priceService := price.NewService(s.DB, priceProvider)
+	s.Price = priceService
+	priceService.StartUpdater(ctx, walletConfig.Prices.UpdateInterval)
+
 	// Update withdrawService to use the final scanService
 	// Note: This assumes withdrawService stores scanService as a field that can be updated
 	// If not, we may need to recreate withdrawService with the final scanService
 	// For now, we'll use the final scanService for both
 
 	// Start multi-chain scanning in background
-	go func() {
+	lifecycle.Go(ctx, "multi-chain scan start", func() {
 		if err := scanService.StartMultiChainScan(ctx); err != nil {
 			log.Error().
 				Err(err).
 				Msg("Failed to start multi-chain scan service")
 		}
-	}()
+	})
+
+	startDepositBackfillWorker(ctx, chainService, depositService, walletConfig)
+
+	// Run historical backfill jobs created via API and resume jobs interrupted by a crash
+	scanService.StartBackfillWorker(ctx, walletConfig.BackfillInterval)
+
+	// Retry transactions whose analysis failed during scanning, so their deposits are not silently lost
+	scanService.StartScanFailureWorker(ctx, walletConfig.ScanFailures.RetryInterval, walletConfig.ScanFailures.MaxAttempts)
 
-	startDepositBackfillWorker(ctx, chainService, depositService)
+	// Rebuild cached RPC clients when a chain's RPC URLs change, without a restart
+	scanService.StartChainConfigWatcher(ctx, s.Config.Database.ConnectionString(), walletConfig.ChainConfigReloadInterval)
 
 	collectService := collect.NewService(
 		s.DB,
+		collect.Config{
+			MinNativeCollectAmountWei: walletConfig.MinNativeCollectAmountWei(),
+			MinERC20CollectAmount:     walletConfig.Collect.MinERC20Amount,
+			BaseFeeMultiplier:         walletConfig.Fees.BaseFeeMultiplier,
+			WorkerConcurrency:         walletConfig.WorkerConcurrency,
+			LegacyTxChainIDs:          walletConfig.Fees.LegacyTxChainIDs,
+			ReceiptPollInterval:       walletConfig.Collect.ReceiptPollInterval,
+			ReceiptTimeout:            walletConfig.Collect.ReceiptTimeout,
+		},
 		chainService,
 		scanService,
 		hotWalletService,
 		signerService,
+		gasGuard,
+		gasPriceCap,
+		smartAccountService,
 	)
 	s.Collect = collectService
 
 	// 根据配置决定是否启动自动归集
 	if s.Config.Wallet.EnableAutoCollect {
 		log.Info().Msg("Auto collect is enabled, starting auto collect service")
-		collectService.StartAutoCollect(ctx, defaultCollectInterval)
+		collectService.StartAutoCollect(ctx, walletConfig.CollectInterval)
 	} else {
 		log.Info().Msg("Auto collect is disabled, skipping auto collect service startup")
 	}
 
 	rebalanceService := rebalance.NewService(
 		s.DB,
+		rebalance.Config{
+			MinBalanceWei:       walletConfig.RebalanceMinBalanceWei(),
+			MaxBalanceWei:       walletConfig.RebalanceMaxBalanceWei(),
+			BaseFeeMultiplier:   walletConfig.Fees.BaseFeeMultiplier,
+			WorkerConcurrency:   walletConfig.WorkerConcurrency,
+			LegacyTxChainIDs:    walletConfig.Fees.LegacyTxChainIDs,
+			ReceiptPollInterval: walletConfig.Rebalance.ReceiptPollInterval,
+			ReceiptTimeout:      walletConfig.Rebalance.ReceiptTimeout,
+		},
 		chainService,
 		scanService,
 		hotWalletService,
 		signerService,
+		gasGuard,
+		gasPriceCap,
 	)
 	s.Rebalance = rebalanceService
 	if s.Config.Wallet.EnableAutoRebalance {
 		log.Info().Msg("Auto rebalance is enabled, starting auto rebalance service")
-		rebalanceService.StartAutoRebalance(ctx, defaultRebalanceInterval)
+		rebalanceService.StartAutoRebalance(ctx, walletConfig.RebalanceInterval)
 	} else {
 		log.Info().Msg("Auto rebalance is disabled, skipping auto rebalance service startup")
 	}
 
+	// Hot wallet balances are checked against the configured minimums and the withdraws waiting to be sent
+	hotWalletMinBalances := make([]hotwallet.MinBalance, 0, len(walletConfig.HotWalletMinBalances))
+	for _, minBalance := range walletConfig.HotWalletMinBalances {
+		hotWalletMinBalances = append(hotWalletMinBalances, hotwallet.MinBalance{
+			ChainID:     minBalance.ChainID,
+			TokenSymbol: minBalance.TokenSymbol,
+			MinAmount:   minBalance.MinAmountFloat(),
+		})
+	}
+	hotWalletMonitor := hotwallet.NewMonitor(
+		s.DB,
+		hotwallet.MonitorConfig{
+			MinBalances:       hotWalletMinBalances,
+			WorkerConcurrency: walletConfig.WorkerConcurrency,
+		},
+		chainService,
+		scanService,
+		hotWalletService,
+		alertNotifier,
+	)
+	s.HotWalletMonitor = hotWalletMonitor
+	hotWalletMonitor.StartMonitor(ctx, walletConfig.HotWalletMonitorInterval)
+
+	// Scanner lag is sampled per chain, a lag sustained over the window raises an alert
+	scanHealthService := scanhealth.NewService(
+		s.DB,
+		scanhealth.Config{
+			Window:                  walletConfig.ScanLag.Window,
+			WarningBlocks:           walletConfig.ScanLag.WarningBlocks,
+			CriticalBlocks:          walletConfig.ScanLag.CriticalBlocks,
+			WarningBlocksOverrides:  walletConfig.ScanLag.WarningBlocksOverrides,
+			CriticalBlocksOverrides: walletConfig.ScanLag.CriticalBlocksOverrides,
+			Retention:               walletConfig.ScanLag.Retention,
+		},
+		chainService,
+		scanService,
+		alertNotifier,
+	)
+	s.ScanHealth = scanHealthService
+	scanHealthService.StartMonitor(ctx, walletConfig.ScanLag.SampleInterval)
+
+	// Diagnostics bundle for incident tickets
+	s.Diagnostics = diagnostics.NewService(s.DB, scanService, hotWalletMonitor)
+
+	// Hot wallets and the verification address are re-derived from the seed at startup and periodically
+	systemWalletVerifier := hotwallet.NewVerifier(s.DB, addressService, deriver, alertNotifier)
+	s.SystemWalletVerifier = systemWalletVerifier
+	systemWalletVerifier.StartVerifier(ctx, walletConfig.SystemWalletVerifyInterval)
+
+	ledgerService := ledger.NewService(
+		s.DB,
+		ledger.Config{
+			WorkerConcurrency: walletConfig.WorkerConcurrency,
+		},
+		scanService,
+	)
+	s.Ledger = ledgerService
+	ledgerService.StartInvariantsChecker(ctx, walletConfig.LedgerInvariantsInterval)
+
+	maintenanceService := maintenance.NewService(s.DB)
+	s.Maintenance = maintenanceService
+	maintenanceService.StartReporter(ctx, walletConfig.DatabaseMaintenanceInterval)
+
+	// Balances of each user and token are snapshotted once a day has ended (UTC), missing days are caught up
+	statementService := statement.NewService(s.DB)
+	s.Statement = statementService
+	statementService.StartSnapshotGenerator(ctx, walletConfig.BalanceSnapshotInterval)
+
+	// Old finalized blocks and settled transactions are moved out of the live tables, admins can also run it on demand
+	archiveService := archive.NewService(
+		s.DB,
+		archive.Config{
+			Mode:      walletConfig.Archive.Mode,
+			MinAge:    walletConfig.Archive.MinAge,
+			BatchSize: walletConfig.Archive.BatchSize,
+		},
+	)
+	s.Archive = archiveService
+	if walletConfig.Archive.Enabled {
+		archiveService.StartArchiver(ctx, walletConfig.Archive.Interval)
+	} else {
+		log.Info().Msg("Block and transaction archival is disabled, skipping archiver startup")
+	}
+
+	// NFTs deposited to user addresses stay there until an admin withdraws them, gas is topped up from the hot wallet
+	nftService := nft.NewService(
+		s.DB,
+		nft.Config{
+			BaseFeeMultiplier:   walletConfig.Fees.BaseFeeMultiplier,
+			LegacyTxChainIDs:    walletConfig.Fees.LegacyTxChainIDs,
+			ReceiptPollInterval: walletConfig.Collect.ReceiptPollInterval,
+			ReceiptTimeout:      walletConfig.Collect.ReceiptTimeout,
+		},
+		scanService,
+		hotWalletService,
+		signerService,
+		gasPriceCap,
+	)
+	s.NFT = nftService
+	nftService.StartWithdrawer(ctx, walletConfig.NFTWithdrawInterval)
+
+	// Allowances granted by hot wallets are recorded by the scanner, admins revoke them with approve(spender, 0)
+	s.Allowance = allowance.NewService(
+		s.DB,
+		allowance.Config{
+			BaseFeeMultiplier: walletConfig.Fees.BaseFeeMultiplier,
+			LegacyTxChainIDs:  walletConfig.Fees.LegacyTxChainIDs,
+		},
+		scanService,
+		hotWalletService,
+		signerService,
+		gasPriceCap,
+	)
+
+	// Admin mutations are recorded by the admin audit middleware attached in router.Init
+	s.AdminAudit = audit.NewService(s.DB)
+
+	s.Transfer = transfer.NewService(s.DB)
+
+	dustService := dust.NewService(
+		s.DB,
+		dust.Config{
+			AccountUserID: walletConfig.DustConsolidation.AccountUserID,
+			MinIdle:       walletConfig.DustConsolidation.MinIdle,
+		},
+	)
+	s.Dust = dustService
+	if s.Config.Wallet.EnableDustConsolidation {
+		log.Info().Msg("Dust consolidation is enabled, starting dust consolidation service")
+		dustService.StartDustConsolidation(ctx, walletConfig.DustConsolidationInterval)
+	} else {
+		log.Info().Msg("Dust consolidation is disabled, skipping dust consolidation service startup")
+	}
+
+	// Wallet domain events are recorded in the outbox by database triggers and published to the analytics pipeline
+	eventPublisher, err := events.NewPublisher(walletConfig.Events.Publisher, walletConfig.Events.URL, walletConfig.Events.Topic)
+	if err != nil {
+		return errors.Wrap(err, "failed to create wallet event publisher")
+	}
+	eventsService := events.NewService(
+		s.DB,
+		events.Config{
+			BatchSize: walletConfig.Events.BatchSize,
+			Retention: walletConfig.Events.Retention,
+		},
+		eventPublisher,
+	)
+	eventsService.StartPublisher(ctx, walletConfig.Events.PublishInterval)
+
 	log.Info().Msg("Blockchain scan and withdraw services started successfully")
 	return nil
 }
 
-func startDepositBackfillWorker(ctx context.Context, chainService chain.Service, depositService deposit.Service) {
+// recoverAddressIndexes raises address_indexes to the highest derived address used on-chain,
+// failures are logged and do not prevent the startup
+func recoverAddressIndexes(
+	ctx context.Context,
+	s *api.Server,
+	addressService address.Service,
+	deriver address.Deriver,
+	chainService chain.Service,
+	scanService scan.Service,
+) {
+	recoveryService := addressindex.NewService(
+		s.DB,
+		addressindex.Config{
+			GapLimit: s.Config.Wallet.AddressIndexRecovery.GapLimit,
+		},
+		addressService,
+		deriver,
+		chainService,
+		scanService,
+	)
+
+	report, err := recoveryService.Recover(ctx, false)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to recover address indexes")
+		return
+	}
+
+	for _, result := range report.ChainTypes {
+		log.Info().
+			Str("chain_type", result.ChainType).
+			Bool("on_chain_checked", result.OnChainChecked).
+			Interface("used_index", result.UsedIndex).
+			Interface("target_index", result.TargetIndex).
+			Msg("Address index recovery finished")
+	}
+}
+
+func startDepositBackfillWorker(ctx context.Context, chainService chain.Service, depositService deposit.Service, cfg config.Wallet) {
 	if depositService == nil {
 		return
 	}
@@ -217,21 +769,29 @@ func startDepositBackfillWorker(ctx context.Context, chainService chain.Service,
 			return
 		}
 
+		var g errgroup.Group
+		g.SetLimit(cfg.WorkerConcurrency)
+
 		for _, ch := range chains {
-			if err := depositService.ProcessFinalizedDeposits(ctx, ch.ChainID); err != nil {
-				log.Error().
-					Int("chain_id", ch.ChainID).
-					Err(err).
-					Msg("Backfill worker failed to process finalized deposits")
-			}
+			g.Go(func() error {
+				if err := depositService.ProcessFinalizedDeposits(ctx, ch.ChainID); err != nil {
+					log.Error().
+						Int("chain_id", ch.ChainID).
+						Err(err).
+						Msg("Backfill worker failed to process finalized deposits")
+				}
+				return nil
+			})
 		}
+
+		_ = g.Wait()
 	}
 
-	go func() {
+	lifecycle.Go(ctx, "deposit backfill worker", func() {
 		log.Info().Msg("Starting deposit backfill worker")
 		runOnce()
 
-		ticker := time.NewTicker(defaultDepositBackfillInterval)
+		ticker := time.NewTicker(cfg.DepositBackfillInterval)
 		defer ticker.Stop()
 
 		for {
@@ -243,7 +803,24 @@ func startDepositBackfillWorker(ctx context.Context, chainService chain.Service,
 				runOnce()
 			}
 		}
-	}()
+	})
+}
+
+// newRemoteSigner connects to the signer process with mutual TLS, Solana and Bitcoin transactions are not supported in remote mode
+func newRemoteSigner(cfg config.WalletSigner) (*remote.Client, error) {
+	tlsConfig, err := remote.ClientTLSConfig(cfg.TLSCertFile, cfg.TLSKeyFile, cfg.TLSCAFile, cfg.TLSServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := remote.NewClient(cfg.Addr, tlsConfig, cfg.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().Str("signer_addr", cfg.Addr).Msg("Addresses are derived and EVM transactions signed by the remote signer")
+
+	return client, nil
 }
 
 // signerServiceAdapter adapts signer.Service to api.SignerService
@@ -278,3 +855,40 @@ func (a *signerServiceAdapter) SignEVMTransaction(ctx context.Context, req *api.
 		TxHash:         signerResp.TxHash,
 	}, nil
 }
+
+// startGRPCServer starts the gRPC API for other backend services if WALLET_GRPC_LISTEN_ADDR is set,
+// returns nil if it is disabled.
+func startGRPCServer(s *api.Server) (*grpcapi.Server, error) {
+	grpcConfig := s.Config.Wallet.GRPC
+	if grpcConfig.ListenAddr == "" {
+		return nil, nil //nolint:nilnil // the gRPC API is optional
+	}
+
+	tlsConfig, err := grpcapi.TLSConfig(grpcConfig.TLSCertFile, grpcConfig.TLSKeyFile, grpcConfig.TLSCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	server, err := grpcapi.NewServer(s, tlsConfig, grpcConfig.Tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	lis, err := net.Listen("tcp", grpcConfig.ListenAddr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to listen on %s", grpcConfig.ListenAddr)
+	}
+
+	go func() {
+		if err := server.Serve(lis); err != nil {
+			log.Fatal().Err(err).Msg("Failed to serve gRPC API")
+		}
+	}()
+	log.Info().
+		Str("listen_addr", grpcConfig.ListenAddr).
+		Bool("mutual_tls", grpcConfig.TLSCAFile != "").
+		Int("tokens", len(grpcConfig.Tokens)).
+		Msg("gRPC API started")
+
+	return server, nil
+}
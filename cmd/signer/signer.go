@@ -0,0 +1,114 @@
+package signer
+
+import (
+	"context"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/config"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/util/command"
+	"github/chapool/go-wallet/internal/wallet"
+	"github/chapool/go-wallet/internal/wallet/address"
+	"github/chapool/go-wallet/internal/wallet/keystore"
+	"github/chapool/go-wallet/internal/wallet/seed"
+	"github/chapool/go-wallet/internal/wallet/signer"
+	"github/chapool/go-wallet/internal/wallet/signer/remote"
+)
+
+func New() *cobra.Command {
+	return &cobra.Command{
+		Use:   "signer",
+		Short: "Starts the standalone signer",
+		Long: `Starts the signer as a standalone gRPC service.
+
+Unlocks the keystore, derives wallet addresses and signs EVM transactions for
+API servers running with WALLET_SIGNER_MODE=remote, so they never load the seed.
+Solana and Bitcoin transactions are not supported in remote mode.
+Clients must present a certificate issued by WALLET_SIGNER_TLS_CA_FILE (mutual TLS),
+every signing request is written to the audit log.`,
+		Run: func(_ *cobra.Command, _ []string) {
+			runSigner()
+		},
+	}
+}
+
+func runSigner() {
+	err := command.WithServer(context.Background(), config.DefaultServiceConfigFromEnv(), func(ctx context.Context, s *api.Server) error {
+		log := util.LogFromContext(ctx)
+		signerConfig := s.Config.Wallet.Signer
+
+		tlsConfig, err := remote.ServerTLSConfig(signerConfig.TLSCertFile, signerConfig.TLSKeyFile, signerConfig.TLSCAFile)
+		if err != nil {
+			return errors.Wrap(err, "mutual TLS is required for the signer")
+		}
+
+		seedManager := seed.NewManager()
+		defer seedManager.Clear()
+
+		signerService, deriver, err := newSignerService(ctx, s, seedManager)
+		if err != nil {
+			return err
+		}
+
+		lis, err := net.Listen("tcp", signerConfig.ListenAddr)
+		if err != nil {
+			return errors.Wrapf(err, "failed to listen on %s", signerConfig.ListenAddr)
+		}
+
+		server := remote.NewServer(signerService, deriver, tlsConfig)
+		go func() {
+			if err := server.Serve(lis); err != nil {
+				log.Fatal().Err(err).Msg("Failed to serve signer")
+			}
+		}()
+		log.Info().Str("listen_addr", signerConfig.ListenAddr).Msg("Signer started")
+
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+		<-quit
+
+		// In-flight signing requests are finished before the seed is cleared
+		server.Stop()
+
+		return nil
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to run signer")
+	}
+}
+
+// newSignerService unlocks the keystore and creates the signer service and address deriver using its seed
+//
+//nolint:ireturn // Returning interface is intentional
+func newSignerService(ctx context.Context, s *api.Server, seedManager seed.Manager) (signer.Service, address.Deriver, error) {
+	keystoreService, err := keystore.NewService(s.DB)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create keystore service")
+	}
+
+	addressService, err := address.NewService(s.DB, address.Config{
+		CoinTypes: s.Config.Wallet.Derivation.CoinTypes,
+		Accounts:  s.Config.Wallet.Derivation.Accounts,
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create address service")
+	}
+
+	if err := wallet.InitializeKeystore(ctx, s.DB, seedManager, keystoreService, addressService); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to initialize keystore")
+	}
+
+	signerService, err := signer.NewService(seedManager, addressService, s.Config.Wallet.EnableSigning)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return signerService, address.NewSeedDeriver(seedManager, addressService), nil
+}
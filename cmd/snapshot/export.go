@@ -0,0 +1,91 @@
+package snapshot
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/config"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/util/command"
+	"github/chapool/go-wallet/internal/wallet/address"
+	"github/chapool/go-wallet/internal/wallet/snapshot"
+)
+
+type exportFlags struct {
+	Out             string
+	PreserveAmounts bool
+}
+
+func newExport() *cobra.Command {
+	var flags exportFlags
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports an anonymized snapshot of the wallet tables.",
+		Long: `Writes chains, tokens, users, wallets and credits as JSON Lines to --out (stdout by default),
+to be loaded into a staging database with "snapshot import".
+
+The snapshot is anonymized:
+  - wallet addresses are re-derived at their derivation paths from a random seed that is discarded after the export
+  - usernames, passwords, device names, chain RPC URLs and credit metadata are removed
+  - transaction hashes are replaced by salted hashes, the salt is discarded after the export
+  - credit amounts are replaced by 0 unless --preserve-amounts is set
+
+Chains and tokens are kept otherwise unchanged.`,
+		Run: func(_ *cobra.Command, _ []string) {
+			exportCmdFunc(flags)
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.Out, "out", "", "File to write the snapshot to, defaults to stdout.")
+	cmd.Flags().BoolVar(&flags.PreserveAmounts, "preserve-amounts", false, "Keep the real credit amounts.")
+
+	return cmd
+}
+
+func exportCmdFunc(flags exportFlags) {
+	err := command.WithServer(context.Background(), config.DefaultServiceConfigFromEnv(), func(ctx context.Context, s *api.Server) error {
+		log := util.LogFromContext(ctx)
+
+		var out io.Writer = os.Stdout
+		if flags.Out != "" {
+			file, err := os.Create(flags.Out)
+			if err != nil {
+				log.Err(err).Str("out", flags.Out).Msg("Failed to create snapshot file")
+				return err
+			}
+			defer file.Close()
+			out = file
+		}
+
+		addressService, err := address.NewService(s.DB, address.Config{
+			CoinTypes: s.Config.Wallet.Derivation.CoinTypes,
+			Accounts:  s.Config.Wallet.Derivation.Accounts,
+		})
+		if err != nil {
+			return err
+		}
+
+		result, err := snapshot.NewService(s.DB, addressService).Export(ctx, out, &snapshot.ExportOptions{
+			PreserveAmounts: flags.PreserveAmounts,
+		})
+		if err != nil {
+			log.Err(err).Msg("Error while exporting wallet snapshot")
+			return err
+		}
+
+		log.Info().
+			Interface("rows", result.Rows).
+			Bool("preserve_amounts", flags.PreserveAmounts).
+			Msg("Successfully exported wallet snapshot")
+
+		return nil
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to export wallet snapshot")
+	}
+}
@@ -0,0 +1,78 @@
+package snapshot
+
+import (
+	"context"
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/config"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/util/command"
+	"github/chapool/go-wallet/internal/wallet/address"
+	"github/chapool/go-wallet/internal/wallet/snapshot"
+)
+
+type importFlags struct {
+	In string
+}
+
+func newImport() *cobra.Command {
+	var flags importFlags
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Imports a snapshot created by \"snapshot export\".",
+		Long: `Loads the snapshot from --in into the configured database in a single database transaction.
+
+Rows that already exist (any unique constraint conflict) are left unchanged.
+Chains are matched by chain ID and tokens by chain and contract address, credits are linked to the matched tokens.
+Chains missing in the database are created inactive without an RPC URL.
+
+Imported users have no password and cannot sign in.
+Only run this against staging or development databases.`,
+		Run: func(_ *cobra.Command, _ []string) {
+			importCmdFunc(flags)
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.In, "in", "", "Snapshot file to import.")
+	_ = cmd.MarkFlagRequired("in")
+
+	return cmd
+}
+
+func importCmdFunc(flags importFlags) {
+	err := command.WithServer(context.Background(), config.DefaultServiceConfigFromEnv(), func(ctx context.Context, s *api.Server) error {
+		log := util.LogFromContext(ctx)
+
+		file, err := os.Open(flags.In)
+		if err != nil {
+			log.Err(err).Str("in", flags.In).Msg("Failed to open snapshot file")
+			return err
+		}
+		defer file.Close()
+
+		addressService, err := address.NewService(s.DB, address.Config{
+			CoinTypes: s.Config.Wallet.Derivation.CoinTypes,
+			Accounts:  s.Config.Wallet.Derivation.Accounts,
+		})
+		if err != nil {
+			return err
+		}
+
+		result, err := snapshot.NewService(s.DB, addressService).Import(ctx, file)
+		if err != nil {
+			log.Err(err).Msg("Error while importing wallet snapshot")
+			return err
+		}
+
+		log.Info().Interface("rows", result.Rows).Msg("Successfully imported wallet snapshot")
+
+		return nil
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to import wallet snapshot")
+	}
+}
@@ -0,0 +1,13 @@
+package snapshot
+
+import (
+	"github.com/spf13/cobra"
+	"github/chapool/go-wallet/internal/util/command"
+)
+
+func New() *cobra.Command {
+	return command.NewSubcommandGroup("snapshot",
+		newExport(),
+		newImport(),
+	)
+}
@@ -0,0 +1,154 @@
+package wallet
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/config"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/util/command"
+	walletpkg "github/chapool/go-wallet/internal/wallet"
+	"github/chapool/go-wallet/internal/wallet/address"
+	"github/chapool/go-wallet/internal/wallet/addressindex"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/keystore"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/seed"
+)
+
+type recoverAddressIndexFlags struct {
+	GapLimit int
+	DryRun   bool
+}
+
+func newRecoverAddressIndex() *cobra.Command {
+	var flags recoverAddressIndexFlags
+
+	cmd := &cobra.Command{
+		Use:   "recover-address-index",
+		Short: "Re-synchronizes address indexes with the derived addresses used on-chain.",
+		Long: `Unlocks the keystore and derives the addresses following the highest known address index
+of every chain type of the active chains, checking each address on every active chain of that type
+(EVM: nonce, native and token balances; Solana: transaction history).
+The scan stops after --gap-limit consecutive unused addresses.
+
+address_indexes of all devices of the chain type are then raised to the highest used address index
+or the highest address index in the wallets table, whichever is larger. Indexes are never lowered.
+Bitcoin nodes cannot be queried by address, Bitcoin indexes are only synchronized with the wallets table.
+
+Run this after restoring the wallets table from an older backup, before the API creates new wallets,
+otherwise addresses already handed out to users may be created again for other users.`,
+		Run: func(_ *cobra.Command, _ []string) {
+			recoverAddressIndexCmdFunc(flags)
+		},
+	}
+
+	cmd.Flags().IntVar(&flags.GapLimit, "gap-limit", 0, "Consecutive unused addresses after which the scan stops (defaults to WALLET_ADDRESS_INDEX_GAP_LIMIT).")
+	cmd.Flags().BoolVar(&flags.DryRun, "dry-run", false, "Only report the indexes which would be raised.")
+
+	return cmd
+}
+
+func recoverAddressIndexCmdFunc(flags recoverAddressIndexFlags) {
+	err := command.WithServer(context.Background(), config.DefaultServiceConfigFromEnv(), func(ctx context.Context, s *api.Server) error {
+		log := util.LogFromContext(ctx)
+
+		ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		keystoreService, err := keystore.NewService(s.DB)
+		if err != nil {
+			return errors.Wrap(err, "failed to create keystore service")
+		}
+		exists, err := keystoreService.Exists(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to check keystore existence")
+		}
+		if !exists {
+			return errors.New("keystore not found, nothing to recover")
+		}
+
+		addressService, err := address.NewService(s.DB, address.Config{
+			CoinTypes: s.Config.Wallet.Derivation.CoinTypes,
+			Accounts:  s.Config.Wallet.Derivation.Accounts,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create address service")
+		}
+
+		seedManager := seed.NewManager()
+		defer seedManager.Clear()
+		if err := walletpkg.InitializeKeystore(ctx, s.DB, seedManager, keystoreService, addressService); err != nil {
+			return errors.Wrap(err, "failed to initialize keystore")
+		}
+
+		walletConfig := s.Config.Wallet
+		chainService := chain.NewService(s.DB, chain.BlockOverrides{
+			ConfirmationBlocks: walletConfig.ConfirmationBlocksOverrides,
+			FinalizedBlocks:    walletConfig.FinalizedBlocksOverrides,
+		})
+
+		// Only the RPC clients of the scan service are used, nothing is scanned
+		scanService := scan.NewService(
+			s.DB,
+			chainService,
+			nil,
+			nil,
+			walletConfig.ScanInterval,
+			walletConfig.BlockBatchSize,
+			walletConfig.RPCBatchSize,
+			walletConfig.Backfill.BlocksPerSecond,
+			0,
+			nil,
+			false,
+		)
+
+		gapLimit := flags.GapLimit
+		if gapLimit <= 0 {
+			gapLimit = walletConfig.AddressIndexRecovery.GapLimit
+		}
+
+		recoveryService := addressindex.NewService(
+			s.DB,
+			addressindex.Config{GapLimit: gapLimit},
+			addressService,
+			address.NewSeedDeriver(seedManager, addressService),
+			chainService,
+			scanService,
+		)
+
+		report, err := recoveryService.Recover(ctx, flags.DryRun)
+		if err != nil {
+			log.Err(err).Msg("Error while recovering address indexes")
+			return err
+		}
+
+		for _, result := range report.ChainTypes {
+			for _, index := range result.Indexes {
+				log.Info().
+					Bool("dry_run", report.DryRun).
+					Str("chain_type", result.ChainType).
+					Interface("device_name", index.DeviceName).
+					Bool("on_chain_checked", result.OnChainChecked).
+					Interface("wallet_index", result.WalletIndex).
+					Interface("used_index", result.UsedIndex).
+					Interface("scan_to", result.ScanTo).
+					Interface("current_index", index.CurrentIndex).
+					Int("index", index.Index).
+					Bool("updated", index.Updated).
+					Msg("Address index")
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to recover address indexes")
+	}
+}
@@ -0,0 +1,12 @@
+package wallet
+
+import (
+	"github.com/spf13/cobra"
+	"github/chapool/go-wallet/internal/util/command"
+)
+
+func New() *cobra.Command {
+	return command.NewSubcommandGroup("wallet",
+		newRecoverAddressIndex(),
+	)
+}
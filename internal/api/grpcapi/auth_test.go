@@ -0,0 +1,105 @@
+package grpcapi
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+
+	"github/chapool/go-wallet/internal/api/grpcapi/walletv1"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthInterceptor(t *testing.T) {
+	tokens := map[string]string{"billing": "secret-billing", "payments": "secret-payments"}
+	info := &grpc.UnaryServerInfo{FullMethod: walletv1.Wallet_GetBalance_FullMethodName}
+	handler := func(_ context.Context, _ any) (any, error) {
+		return &walletv1.GetBalanceResponse{}, nil
+	}
+
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want codes.Code
+	}{
+		{"ValidToken", metadata.Pairs(authorizationHeader, "Bearer secret-payments"), codes.OK},
+		{"InvalidToken", metadata.Pairs(authorizationHeader, "Bearer secret"), codes.Unauthenticated},
+		{"MissingBearerPrefix", metadata.Pairs(authorizationHeader, "secret-billing"), codes.Unauthenticated},
+		{"MissingToken", metadata.MD{}, codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+
+			resp, err := AuthInterceptor(tokens)(ctx, &walletv1.GetBalanceRequest{}, info, handler)
+			assert.Equal(t, tt.want, status.Code(err))
+			if tt.want == codes.OK {
+				assert.NotNil(t, resp)
+			}
+		})
+	}
+
+	t.Run("NoTokensConfigured", func(t *testing.T) {
+		resp, err := AuthInterceptor(nil)(context.Background(), &walletv1.GetBalanceRequest{}, info, handler)
+		require.NoError(t, err)
+		assert.NotNil(t, resp)
+	})
+}
+
+func TestAuthenticateTokenReturnsClientName(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, "Bearer secret-billing"))
+
+	name, ok := authenticateToken(ctx, map[string]string{"billing": "secret-billing"})
+	require.True(t, ok)
+	assert.Equal(t, "billing", name)
+}
+
+func TestNewServerRequiresAuthentication(t *testing.T) {
+	// Neither client certificates nor service tokens
+	_, err := NewServer(nil, &tls.Config{MinVersion: tls.VersionTLS13}, nil)
+	require.ErrorIs(t, err, ErrUnauthenticated)
+
+	server, err := NewServer(nil, &tls.Config{MinVersion: tls.VersionTLS13}, map[string]string{"billing": "secret-billing"})
+	require.NoError(t, err)
+	server.Stop()
+
+	server, err = NewServer(nil, &tls.Config{
+		MinVersion: tls.VersionTLS13,
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  x509.NewCertPool(),
+	}, nil)
+	require.NoError(t, err)
+	server.Stop()
+}
+
+func TestStatusFromError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"InsufficientBalance", errors.Wrap(walleterrors.ErrInsufficientBalance, "balance 1 < 2"), codes.FailedPrecondition},
+		{"ChainNotFound", walleterrors.ErrChainNotFound, codes.NotFound},
+		{"IdempotencyKeyConflict", walleterrors.ErrIdempotencyKeyConflict, codes.AlreadyExists},
+		{"RateLimited", walleterrors.ErrRateLimited, codes.ResourceExhausted},
+		{"Other", errors.New("connection refused"), codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, status.Code(statusFromError(tt.err, "failed")))
+		})
+	}
+
+	// Details of unexpected errors are not returned to the client
+	assert.Equal(t, "failed to get balance", status.Convert(statusFromError(errors.New("pq: password authentication failed"), "failed to get balance")).Message())
+}
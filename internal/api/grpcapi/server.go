@@ -0,0 +1,290 @@
+// Package grpcapi exposes wallet operations to other backend services over gRPC, next to the REST API.
+// Clients act on behalf of a user given by user_id and authenticate with a client certificate (mutual TLS),
+// a service token or both, see AuthInterceptor.
+//
+// The service and its messages are generated from api/proto/wallet/v1/wallet.proto into internal/api/grpcapi/walletv1.
+package grpcapi
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/grpcapi/walletv1"
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+	"github/chapool/go-wallet/internal/wallet/withdraw"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultDepositLimit = 50
+	maxDepositLimit     = 500
+)
+
+// ErrUnauthenticated is returned by NewServer if neither client certificates nor service tokens are required
+var ErrUnauthenticated = errors.New("gRPC API requires client certificates or service tokens")
+
+// Server serves the wallet gRPC API, requests are handled by the same services as the REST API.
+type Server struct {
+	walletv1.UnimplementedWalletServer
+
+	s          *api.Server
+	grpcServer *grpc.Server
+}
+
+// NewServer creates the gRPC API server. tokens map client names to service tokens, if empty clients are
+// authenticated by their certificate only and tlsConfig must require client certificates (see TLSConfig).
+func NewServer(s *api.Server, tlsConfig *tls.Config, tokens map[string]string) (*Server, error) {
+	if len(tokens) == 0 && (tlsConfig == nil || tlsConfig.ClientAuth != tls.RequireAndVerifyClientCert || tlsConfig.ClientCAs == nil) {
+		return nil, ErrUnauthenticated
+	}
+
+	server := &Server{s: s}
+	server.grpcServer = grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.UnaryInterceptor(AuthInterceptor(tokens)),
+	)
+	walletv1.RegisterWalletServer(server.grpcServer, server)
+
+	return server, nil
+}
+
+// Serve accepts connections on lis until Stop is called
+func (s *Server) Serve(lis net.Listener) error {
+	return s.grpcServer.Serve(lis)
+}
+
+// Stop stops accepting connections and waits for running requests to finish
+func (s *Server) Stop() {
+	s.grpcServer.GracefulStop()
+}
+
+// CreateWallet creates the wallet of the user on the chain, an existing wallet is returned as is
+func (s *Server) CreateWallet(ctx context.Context, req *walletv1.CreateWalletRequest) (*walletv1.WalletInfo, error) {
+	if err := s.checkUser(ctx, req.GetUserId()); err != nil {
+		return nil, err
+	}
+	if req.GetChainId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "chain_id is required")
+	}
+
+	wallet, err := s.s.Wallet.CreateWallet(ctx, req.GetUserId(), int(req.GetChainId()))
+	if err != nil {
+		return nil, statusFromError(err, "failed to create wallet")
+	}
+
+	return &walletv1.WalletInfo{
+		Id:             wallet.ID,
+		UserId:         wallet.UserID,
+		Address:        wallet.Address,
+		ChainType:      wallet.ChainType,
+		ChainId:        int64(wallet.ChainID),
+		ChainName:      wallet.ChainName,
+		DerivationPath: wallet.DerivationPath,
+		AddressIndex:   int64(wallet.AddressIndex),
+		CreatedAt:      wallet.CreatedAt.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// GetBalance returns the balances of the user per token
+func (s *Server) GetBalance(ctx context.Context, req *walletv1.GetBalanceRequest) (*walletv1.GetBalanceResponse, error) {
+	if err := s.checkUser(ctx, req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	var chainID *int
+	if req.ChainId != nil {
+		id := int(req.GetChainId())
+		chainID = &id
+	}
+
+	balances, err := s.s.Balance.GetBalanceByToken(ctx, req.GetUserId(), chainID)
+	if err != nil {
+		return nil, statusFromError(err, "failed to get balance")
+	}
+
+	resp := &walletv1.GetBalanceResponse{Balances: make([]*walletv1.TokenBalance, 0, len(balances))}
+	for _, balance := range balances {
+		resp.Balances = append(resp.Balances, &walletv1.TokenBalance{
+			TokenId:     int64(balance.TokenID),
+			TokenSymbol: balance.TokenSymbol,
+			ChainId:     int64(balance.ChainID),
+			Amount:      balance.Amount.Text('f', -1),
+			Status:      balance.Status.String(),
+		})
+	}
+
+	return resp, nil
+}
+
+// RequestWithdraw creates a withdraw of the user, processed after the same checks and reviews as REST withdraws
+func (s *Server) RequestWithdraw(ctx context.Context, req *walletv1.RequestWithdrawRequest) (*walletv1.WithdrawInfo, error) {
+	if err := s.checkUser(ctx, req.GetUserId()); err != nil {
+		return nil, err
+	}
+	if req.GetToAddress() == "" || req.GetTokenId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "to_address and token_id are required")
+	}
+
+	amount, err := money.ParseFloat(req.GetAmount())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "amount must be a valid number")
+	}
+
+	record, err := s.s.Withdraw.RequestWithdraw(ctx, req.GetUserId(), &withdraw.Request{
+		ToAddress:      req.GetToAddress(),
+		TokenID:        int(req.GetTokenId()),
+		Amount:         amount,
+		IdempotencyKey: req.GetIdempotencyKey(),
+	})
+	if err != nil {
+		// Withdraws to addresses of platform users are not converted to internal transfers as in the REST API
+		var addrErr *withdraw.AddressError
+		if errors.As(err, &addrErr) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid to_address (%s): %s", addrErr.Reason, addrErr.Message)
+		}
+		return nil, statusFromError(err, "failed to request withdraw")
+	}
+
+	resp := &walletv1.WithdrawInfo{
+		Id:        record.ID,
+		UserId:    record.UserID,
+		ToAddress: record.ToAddress,
+		TokenId:   int64(record.TokenID),
+		ChainId:   int64(record.ChainID),
+		Amount:    record.Amount,
+		Fee:       record.Fee,
+		Status:    record.Status.String(),
+		CreatedAt: record.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if record.TXHash.Valid {
+		resp.TxHash = record.TXHash.String
+	}
+
+	return resp, nil
+}
+
+// GetDeposits lists the deposits to wallet addresses of the user, newest first
+func (s *Server) GetDeposits(ctx context.Context, req *walletv1.GetDepositsRequest) (*walletv1.GetDepositsResponse, error) {
+	if err := s.checkUser(ctx, req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	limit := req.GetLimit()
+	switch {
+	case limit <= 0:
+		limit = defaultDepositLimit
+	case limit > maxDepositLimit:
+		limit = maxDepositLimit
+	}
+
+	// Deposits are the deposit transactions to any wallet address of the user, including watch addresses
+	mods := []qm.QueryMod{
+		models.TransactionWhere.Type.EQ(models.TransactionTypeDeposit),
+		qm.Where(`EXISTS (
+			SELECT 1 FROM wallets
+			WHERE wallets.user_id = ? AND wallets.chain_id = transactions.chain_id AND LOWER(wallets.address) = LOWER(transactions.to_addr)
+		)`, req.GetUserId()),
+	}
+	if req.ChainId != nil {
+		mods = append(mods, models.TransactionWhere.ChainID.EQ(int(req.GetChainId())))
+	}
+	if req.GetStatus() != "" {
+		if models.TransactionStatus(req.GetStatus()).IsValid() != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid status")
+		}
+		mods = append(mods, models.TransactionWhere.Status.EQ(models.TransactionStatus(req.GetStatus())))
+	}
+	mods = append(mods,
+		qm.OrderBy(models.TransactionColumns.CreatedAt+" DESC"),
+		qm.Offset(int(max(req.GetOffset(), 0))),
+		qm.Limit(int(limit)),
+	)
+
+	transactions, err := models.Transactions(mods...).All(ctx, s.s.DB)
+	if err != nil {
+		return nil, statusFromError(err, "failed to get deposits")
+	}
+
+	resp := &walletv1.GetDepositsResponse{Deposits: make([]*walletv1.Deposit, 0, len(transactions))}
+	for _, tx := range transactions {
+		resp.Deposits = append(resp.Deposits, &walletv1.Deposit{
+			Id:                tx.ID,
+			ChainId:           int64(tx.ChainID),
+			TxHash:            tx.TXHash,
+			BlockNumber:       tx.BlockNo,
+			FromAddress:       tx.FromAddr,
+			ToAddress:         tx.ToAddr,
+			TokenAddress:      tx.TokenAddr.String,
+			Amount:            tx.Amount,
+			Status:            tx.Status.String(),
+			ConfirmationCount: int64(tx.ConfirmationCount.Int),
+			CreatedAt:         tx.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return resp, nil
+}
+
+// checkUser validates the user_id of a request and checks the user exists
+func (s *Server) checkUser(ctx context.Context, userID string) error {
+	if _, err := uuid.Parse(userID); err != nil {
+		return status.Error(codes.InvalidArgument, "user_id must be a UUID")
+	}
+
+	exists, err := models.UserExists(ctx, s.s.DB, userID)
+	if err != nil {
+		return statusFromError(err, "failed to load user")
+	}
+	if !exists {
+		return status.Error(codes.NotFound, "user not found")
+	}
+
+	return nil
+}
+
+// statusFromError maps the wallet error taxonomy (see package walleterrors) to gRPC status codes,
+// other errors are logged and returned as internal errors without details.
+func statusFromError(err error, msg string) error {
+	for _, e := range walletErrorCodes {
+		if errors.Is(err, e.err) {
+			return status.Error(e.code, err.Error())
+		}
+	}
+
+	log.Error().Err(err).Str("component", "grpc_api").Msg(msg)
+
+	return status.Error(codes.Internal, msg)
+}
+
+// walletErrorCodes maps wallet errors to gRPC status codes, the first entry the error matches wins
+//
+//nolint:gochecknoglobals // Lookup table, same as the HTTP error mapping
+var walletErrorCodes = []struct {
+	err  error
+	code codes.Code
+}{
+	{walleterrors.ErrInvalidAmount, codes.InvalidArgument},
+	{walleterrors.ErrBelowMinimum, codes.FailedPrecondition},
+	{walleterrors.ErrInsufficientBalance, codes.FailedPrecondition},
+	{walleterrors.ErrChainNotFound, codes.NotFound},
+	{walleterrors.ErrTokenNotFound, codes.NotFound},
+	{walleterrors.ErrWalletNotFound, codes.NotFound},
+	{walleterrors.ErrWithdrawNotFound, codes.NotFound},
+	{walleterrors.ErrWithdrawStateConflict, codes.FailedPrecondition},
+	{walleterrors.ErrIdempotencyKeyConflict, codes.AlreadyExists},
+	{walleterrors.ErrRateLimited, codes.ResourceExhausted},
+	{walleterrors.ErrWithdrawLimitExceeded, codes.FailedPrecondition},
+}
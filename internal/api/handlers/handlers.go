@@ -30,24 +30,131 @@ func AttachAllRoutes(s *api.Server) {
 		common.GetSwaggerRoute(s),
 		common.GetVersionRoute(s),
 		push.PutUpdatePushTokenRoute(s),
+		wallet.DeleteAPITokenRoute(s),
+		wallet.DeleteDepositRuleRoute(s),
+		wallet.DeleteGasPriceCapOverrideRoute(s),
+		wallet.DeleteOrganizationMemberRoute(s),
+		wallet.DeleteOrganizationNotificationTemplateRoute(s),
+		wallet.DeleteScreeningAddressRoute(s),
+		wallet.DeleteScreeningTokenRoute(s),
+		wallet.DeleteTokenRoute(s),
+		wallet.DeleteTokenPriceRoute(s),
+		wallet.DeleteWatchAddressRoute(s),
+		wallet.DeleteWithdrawAddressRoute(s),
+		wallet.DeleteWithdrawLimitRoute(s),
+		wallet.GetAdminAuditsRoute(s),
+		wallet.GetAllowancesRoute(s),
+		wallet.GetAPITokensRoute(s),
+		wallet.GetArchiveRoute(s),
+		wallet.GetBackfillJobRoute(s),
+		wallet.GetBackfillJobsRoute(s),
 		wallet.GetBalanceByTokenRoute(s),
+		wallet.GetChainScanHealthRoute(s),
+		wallet.GetChainScanSettingsRoute(s),
 		wallet.GetChainsRoute(s),
 		wallet.GetCollectsRoute(s),
+		wallet.GetCollectsExportRoute(s),
+		wallet.GetCreditsByReferenceRoute(s),
+		wallet.GetDatabaseDiagnosticsRoute(s),
+		wallet.GetDepositRuleRoute(s),
+		wallet.GetDepositRulesRoute(s),
+		wallet.GetDepositURIRoute(s),
 		wallet.GetDepositsRoute(s),
+		wallet.GetDepositsExportRoute(s),
+		wallet.GetDiagnosticsRoute(s),
+		wallet.GetDustDepositsRoute(s),
+		wallet.GetDustConsolidationConsentRoute(s),
+		wallet.GetDustConsolidationsRoute(s),
+		wallet.GetFrozenWithdrawCreditsRoute(s),
+		wallet.GetGasPriceCapsRoute(s),
+		wallet.GetHotWalletHealthRoute(s),
+		wallet.GetLedgerInvariantsRoute(s),
+		wallet.GetLedgerReconciliationRoute(s),
+		wallet.GetLedgerRoute(s),
+		wallet.GetMaintenanceRoute(s),
+		wallet.GetNFTsRoute(s),
+		wallet.GetNotificationSettingsRoute(s),
+		wallet.GetOrganizationBalancesRoute(s),
+		wallet.GetOrganizationMembersRoute(s),
+		wallet.GetOrganizationNotificationTemplatesRoute(s),
+		wallet.GetOrganizationsRoute(s),
+		wallet.GetPendingApprovalWithdrawsRoute(s),
 		wallet.GetPendingDepositBalanceRoute(s),
 		wallet.GetPendingDepositsRoute(s),
+		wallet.GetPushPreferencesRoute(s),
+		wallet.GetQuarantineRoute(s),
+		wallet.GetQuarantinesRoute(s),
+		wallet.GetQuarantinesExportRoute(s),
+		wallet.GetRPCClientDiagnosticsRoute(s),
+		wallet.GetScanFailuresRoute(s),
+		wallet.GetScanStatusRoute(s),
+		wallet.GetStatementsRoute(s),
+		wallet.GetSystemWalletVerificationRoute(s),
+		wallet.GetTokenPricesRoute(s),
+		wallet.GetTokensRoute(s),
 		wallet.GetTotalBalanceRoute(s),
+		wallet.GetTransactionRoute(s),
+		wallet.GetTransactionsRoute(s),
+		wallet.GetUserTransactionsRoute(s),
 		wallet.GetWalletAddressRoute(s),
 		wallet.GetWalletListRoute(s),
+		wallet.GetWalletStatsRoute(s),
+		wallet.GetWatchAddressesRoute(s),
+		wallet.GetWithdrawAddressesRoute(s),
+		wallet.GetWithdrawAddressSettingsRoute(s),
+		wallet.GetWithdrawApprovalsRoute(s),
+		wallet.GetWithdrawLimitsRoute(s),
 		wallet.GetWithdrawsRoute(s),
+		wallet.GetWithdrawsExportRoute(s),
+		wallet.PostAPITokenRoute(s),
 		wallet.PostApproveWithdrawRoute(s),
+		wallet.PostArchiveRunRoute(s),
+		wallet.PostBackfillRoute(s),
+		wallet.PostBulkBalancesRoute(s),
+		wallet.PostCancelBackfillRoute(s),
 		wallet.PostCollectRoute(s),
+		wallet.PostConfirmWithdrawAddressRoute(s),
+		wallet.PostDepositRuleRoute(s),
+		wallet.PostDepositRulesDryRunRoute(s),
+		wallet.PostDepositTraceRoute(s),
 		wallet.PostCreateHotWalletRoute(s),
 		wallet.PostCreateWalletRoute(s),
+		wallet.PostFlushWithdrawsRoute(s),
+		wallet.PostKeystoreLockRoute(s),
+		wallet.PostKeystoreUnlockRoute(s),
+		wallet.PostNFTWithdrawRoute(s),
+		wallet.PostOrganizationNotificationTemplatePreviewRoute(s),
+		wallet.PostOrganizationRoute(s),
 		wallet.PostRebalanceRoute(s),
 		wallet.PostRejectWithdrawRoute(s),
+		wallet.PostRequeueScanFailureRoute(s),
+		wallet.PostResolveQuarantineRoute(s),
+		wallet.PostRetryWithdrawRoute(s),
+		wallet.PostRevokeAllowanceRoute(s),
+		wallet.PostScreeningAddressRoute(s),
+		wallet.PostScreeningTokenRoute(s),
 		wallet.PostSignTransactionRoute(s),
+		wallet.PostTokenRoute(s),
+		wallet.PostTransferRoute(s),
+		wallet.PostVerifyWithdrawAddressOwnershipRoute(s),
+		wallet.PostWatchAddressRoute(s),
+		wallet.PostWithdrawAddressRoute(s),
 		wallet.PostWithdrawRoute(s),
+		wallet.PutChainScanSettingsRoute(s),
+		wallet.PutDepositRuleRoute(s),
+		wallet.PutDustConsolidationConsentRoute(s),
+		wallet.PutGasPriceCapOverrideRoute(s),
+		wallet.PutMaintenanceRoute(s),
+		wallet.PutNotificationSettingsRoute(s),
+		wallet.PutOrganizationMemberRoute(s),
+		wallet.PutOrganizationNotificationBrandingRoute(s),
+		wallet.PutOrganizationNotificationTemplateRoute(s),
+		wallet.PutPushPreferencesRoute(s),
+		wallet.PutTokenRoute(s),
+		wallet.PutTokenPriceRoute(s),
+		wallet.PutWithdrawAddressSettingsRoute(s),
+		wallet.PutWithdrawExpiryRoute(s),
+		wallet.PutWithdrawLimitRoute(s),
 		wellknown.GetAndroidDigitalAssetLinksRoute(s),
 		wellknown.GetAppleAppSiteAssociationRoute(s),
 	}
@@ -9,6 +9,7 @@ import (
 	"github/chapool/go-wallet/internal/api"
 	"github/chapool/go-wallet/internal/auth"
 	"github/chapool/go-wallet/internal/data/dto"
+	"github/chapool/go-wallet/internal/models"
 	"github/chapool/go-wallet/internal/types"
 	"github/chapool/go-wallet/internal/util"
 )
@@ -31,7 +32,7 @@ func putUpdatePushTokenHandler(s *api.Server) echo.HandlerFunc {
 		err := s.Local.UpdatePushToken(ctx, dto.UpdatePushTokenRequest{
 			User:          *user,
 			Token:         swag.StringValue(body.NewToken),
-			Provider:      swag.StringValue(body.Provider),
+			Provider:      models.ProviderType(swag.StringValue(body.Provider)),
 			ExistingToken: null.StringFromPtr(body.OldToken),
 		})
 		if err != nil {
@@ -1,11 +1,16 @@
 package wallet
 
 import (
+	"context"
+	"math/big"
 	"net/http"
 	"strconv"
 
+	"github/chapool/go-wallet/internal/api"
 	"github/chapool/go-wallet/internal/api/httperrors"
 	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/price"
 
 	"github.com/go-openapi/swag"
 	"github.com/labstack/echo/v4"
@@ -38,3 +43,49 @@ func parseChainIDParam(c echo.Context) (*int, error) {
 
 	return &chainIDInt, nil
 }
+
+// getTokenPrices 获取代币的美元价格，用于余额响应中的美元价值
+// 价格只是附加信息，查询失败时记录日志并返回空结果，不影响余额查询
+func getTokenPrices(ctx context.Context, s *api.Server, tokenIDs []int) map[int]*price.Price {
+	if s.Price == nil || len(tokenIDs) == 0 {
+		return nil
+	}
+
+	prices, err := s.Price.GetPrices(ctx, tokenIDs)
+	if err != nil {
+		util.LogFromContext(ctx).Warn().Err(err).Msg("Failed to get token prices, omitting USD values")
+		return nil
+	}
+
+	return prices
+}
+
+// getTotalUSDValue 计算用户已确认余额的美元总价值，没有价格的代币不计入；没有任何价格时返回空
+// 使用换算为代币单位的余额（GetBalanceBreakdown），充值 credits 为代币最小单位，不能直接乘以价格
+func getTotalUSDValue(ctx context.Context, s *api.Server, userID string, chainID *int) string {
+	tokenBalances, err := s.Balance.GetBalanceBreakdown(ctx, userID, chainID)
+	if err != nil {
+		util.LogFromContext(ctx).Warn().Err(err).Msg("Failed to get balance by token, omitting total USD value")
+		return ""
+	}
+
+	tokenIDs := make([]int, 0, len(tokenBalances))
+	for _, tokenBalance := range tokenBalances {
+		tokenIDs = append(tokenIDs, tokenBalance.TokenID)
+	}
+	prices := getTokenPrices(ctx, s, tokenIDs)
+	if len(prices) == 0 {
+		return ""
+	}
+
+	total := new(big.Float)
+	for _, tokenBalance := range tokenBalances {
+		if tokenPrice, ok := prices[tokenBalance.TokenID]; ok {
+			if value := tokenPrice.Value(tokenBalance.Finalized); value != nil {
+				total.Add(total, value)
+			}
+		}
+	}
+
+	return price.FormatUSD(total)
+}
@@ -0,0 +1,49 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/apitoken"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func DeleteAPITokenRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.DELETE("/api-token/:tokenId", deleteAPITokenHandler(s))
+}
+
+func deleteAPITokenHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		params := walletTypes.NewDeleteAPITokenRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		tokenID := params.TokenID.String()
+		if err := s.APIToken.RevokeToken(ctx, user.ID, tokenID); err != nil {
+			if errors.Is(err, apitoken.ErrTokenNotFound) {
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "API token not found")
+			}
+			log.Error().Err(err).Str("api_token_id", tokenID).Msg("Failed to revoke API token")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to revoke API token")
+		}
+
+		log.Info().Str("api_token_id", tokenID).Msg("API token revoked")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,63 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/labstack/echo/v4"
+)
+
+func DeleteDepositRuleRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.DELETE("/deposit-rule/:ruleId", deleteDepositRuleHandler(s)))
+}
+
+func deleteDepositRuleHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to delete deposit rule")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage deposit rules",
+			)
+		}
+
+		params := walletTypes.NewDeleteDepositRuleRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		ruleID := params.RuleID.String()
+		if err := s.Deposit.DeleteRule(ctx, ruleID); err != nil {
+			if err.Error() == "deposit rule not found" {
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Deposit rule not found")
+			}
+			log.Error().Err(err).Str("rule_id", ruleID).Msg("Failed to delete deposit rule")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to delete deposit rule")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Str("rule_id", ruleID).
+			Msg("Deposit rule deleted")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,62 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/settings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func DeleteGasPriceCapOverrideRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.DELETE("/chains/:chainId/gas-price-cap-override", deleteGasPriceCapOverrideHandler(s)))
+}
+
+// deleteGasPriceCapOverrideHandler 删除链的 gas 价格上限覆盖，立即恢复配置的上限
+func deleteGasPriceCapOverrideHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to remove gas price cap override")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage gas price caps",
+			)
+		}
+
+		params := walletTypes.NewDeleteGasPriceCapOverrideRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		if err := s.Settings.ClearGasPriceCapOverride(ctx, int(params.ChainID)); err != nil {
+			if errors.Is(err, settings.ErrGasPriceCapOverrideNotFound) {
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Gas price cap override not found")
+			}
+			log.Error().Err(err).Int64("chain_id", params.ChainID).Msg("Failed to remove gas price cap override")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to remove gas price cap override")
+		}
+
+		log.Info().Str("admin_user_id", user.ID).Int64("chain_id", params.ChainID).Msg("Gas price cap override removed")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,57 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/organization"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func DeleteOrganizationMemberRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.DELETE("/organizations/:orgId/members/:userId", deleteOrganizationMemberHandler(s)))
+}
+
+func deleteOrganizationMemberHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		params := walletTypes.NewDeleteOrganizationMemberRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if err := authorizeOrganizationAdmin(ctx, s, user, orgID); err != nil {
+			return err
+		}
+
+		memberUserID := params.UserID.String()
+		if err := s.Organization.RemoveMember(ctx, orgID, memberUserID); err != nil {
+			switch {
+			case errors.Is(err, organization.ErrMemberNotFound):
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Organization member not found")
+			case errors.Is(err, organization.ErrMemberHasWallets):
+				return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Organization member has wallets and cannot be removed")
+			}
+			log.Error().Err(err).Str("org_id", orgID).Str("member_user_id", memberUserID).Msg("Failed to remove organization member")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to remove organization member")
+		}
+
+		log.Info().Str("org_id", orgID).Str("member_user_id", memberUserID).Msg("Organization member removed")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
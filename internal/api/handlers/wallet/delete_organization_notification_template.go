@@ -0,0 +1,54 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/notification"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func DeleteOrganizationNotificationTemplateRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.DELETE("/organizations/:orgId/notification-templates/:templateId", deleteOrganizationNotificationTemplateHandler(s)))
+}
+
+func deleteOrganizationNotificationTemplateHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		params := walletTypes.NewDeleteOrganizationNotificationTemplateRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if err := authorizeOrganizationAdmin(ctx, s, user, orgID); err != nil {
+			return err
+		}
+
+		templateID := params.TemplateID.String()
+		if err := s.Notification.DeleteTemplate(ctx, orgID, templateID); err != nil {
+			if errors.Is(err, notification.ErrTemplateNotFound) {
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Notification template not found")
+			}
+			log.Error().Err(err).Str("org_id", orgID).Str("template_id", templateID).Msg("Failed to delete notification template")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to delete notification template")
+		}
+
+		log.Info().Str("org_id", orgID).Str("template_id", templateID).Msg("Notification template deleted")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,65 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/quarantine"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func DeleteScreeningTokenRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.DELETE("/screening-token/:screeningTokenId", deleteScreeningTokenHandler(s)))
+}
+
+func deleteScreeningTokenHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to remove screening token")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage the screening list",
+			)
+		}
+
+		params := walletTypes.NewDeleteScreeningTokenRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		id := params.ScreeningTokenID.String()
+		if err := s.Quarantine.DeleteScreeningToken(ctx, id); err != nil {
+			if errors.Is(err, quarantine.ErrScreeningTokenNotFound) {
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Screening token not found")
+			}
+			log.Error().Err(err).Str("screening_token_id", id).Msg("Failed to remove screening token")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to remove screening token")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Str("screening_token_id", id).
+			Msg("Screening token removed")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,71 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/token"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func DeleteTokenRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.DELETE("/token/:tokenId", deleteTokenHandler(s)))
+}
+
+func deleteTokenHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to delete token")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage tokens",
+			)
+		}
+
+		params := walletTypes.NewDeleteTokenRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		tokenID := int(params.TokenID)
+		if err := s.Token.DeleteToken(ctx, tokenID); err != nil {
+			switch {
+			case errors.Is(err, token.ErrInvalidToken):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, tokenErrorMessage(err))
+			case errors.Is(err, token.ErrTokenInUse):
+				return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Token is in use and can only be deactivated")
+			}
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Int("token_id", tokenID).Msg("Failed to delete token")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to delete token")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Int("token_id", tokenID).
+			Msg("Token deleted")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,65 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/price"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func DeleteTokenPriceRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.DELETE("/token-price/:tokenId", deleteTokenPriceHandler(s)))
+}
+
+// deleteTokenPriceHandler 删除代币的手动价格，之后由价格源更新
+func deleteTokenPriceHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to delete token price")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage token prices",
+			)
+		}
+
+		params := walletTypes.NewDeleteTokenPriceRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		if err := s.Price.DeleteManualPrice(ctx, int(params.TokenID)); err != nil {
+			if errors.Is(err, price.ErrPriceNotFound) {
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Manual token price not found")
+			}
+			log.Error().Err(err).Int64("token_id", params.TokenID).Msg("Failed to delete token price")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to delete token price")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Int64("token_id", params.TokenID).
+			Msg("Manual token price deleted")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,63 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/labstack/echo/v4"
+)
+
+func DeleteWatchAddressRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.DELETE("/watch-address/:walletId", deleteWatchAddressHandler(s)))
+}
+
+func deleteWatchAddressHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to remove watch address")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage watch addresses",
+			)
+		}
+
+		params := walletTypes.NewDeleteWatchAddressRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		walletID := params.WalletID.String()
+		if err := s.Wallet.RemoveWatchAddress(ctx, walletID); err != nil {
+			if err.Error() == "watch address not found" {
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Watch address not found")
+			}
+			log.Error().Err(err).Str("wallet_id", walletID).Msg("Failed to remove watch address")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to remove watch address")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Str("wallet_id", walletID).
+			Msg("Watch address removed")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,49 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/addressbook"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func DeleteWithdrawAddressRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.DELETE("/withdraw-address/:addressId", deleteWithdrawAddressHandler(s))
+}
+
+func deleteWithdrawAddressHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		params := walletTypes.NewDeleteWithdrawAddressRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		addressID := params.AddressID.String()
+		if err := s.AddressBook.DeleteAddress(ctx, user.ID, addressID); err != nil {
+			if errors.Is(err, addressbook.ErrAddressNotFound) {
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Withdraw address not found")
+			}
+			log.Error().Err(err).Str("withdraw_address_id", addressID).Msg("Failed to delete withdraw address")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to delete withdraw address")
+		}
+
+		log.Info().Str("withdraw_address_id", addressID).Msg("Withdraw address deleted")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,65 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/risk"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func DeleteWithdrawLimitRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.DELETE("/withdraw-limit/:limitId", deleteWithdrawLimitHandler(s)))
+}
+
+func deleteWithdrawLimitHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to delete withdraw limit")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage withdraw limits",
+			)
+		}
+
+		params := walletTypes.NewDeleteWithdrawLimitRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		limitID := params.LimitID.String()
+		if err := s.Risk.DeleteLimit(ctx, limitID); err != nil {
+			if errors.Is(err, risk.ErrLimitNotFound) {
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Withdraw limit not found")
+			}
+			log.Error().Err(err).Str("limit_id", limitID).Msg("Failed to delete withdraw limit")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to delete withdraw limit")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Str("limit_id", limitID).
+			Msg("Withdraw limit deleted")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
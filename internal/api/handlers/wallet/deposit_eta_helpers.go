@@ -0,0 +1,61 @@
+package wallet
+
+import (
+	"context"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/deposit"
+	"github/chapool/go-wallet/internal/wallet/scan"
+)
+
+// estimateDepositFinality 获取未终结充值所在链的扫描状况并估算终结时间，
+// 单条链 RPC 不可用时该链按降级模式估算；估算失败只记录日志，不影响查询结果
+func estimateDepositFinality(ctx context.Context, s *api.Server, transactions []*models.Transaction) map[string]*deposit.FinalityETA {
+	log := util.LogFromContext(ctx)
+
+	conditions := make(map[int]*deposit.ChainConditions)
+	for _, tx := range transactions {
+		if tx.Status != models.TransactionStatusConfirmed && tx.Status != models.TransactionStatusSafe {
+			continue
+		}
+		if _, ok := conditions[tx.ChainID]; ok {
+			continue
+		}
+
+		progress, err := s.Scan.GetScanProgress(ctx, tx.ChainID)
+		if err != nil {
+			log.Warn().Err(err).Int("chain_id", tx.ChainID).Msg("Failed to get scan progress for deposit ETA, using degraded estimate")
+			conditions[tx.ChainID] = &deposit.ChainConditions{Unavailable: true}
+			continue
+		}
+		conditions[tx.ChainID] = chainConditionsFromProgress(progress)
+	}
+
+	if len(conditions) == 0 {
+		return nil
+	}
+
+	etas, err := s.Deposit.EstimateFinality(ctx, transactions, conditions)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to estimate deposit finality")
+		return nil
+	}
+	return etas
+}
+
+// chainConditionsFromProgress 转换扫描进度为充值终结估算使用的链状况
+func chainConditionsFromProgress(progress *scan.ScanProgress) *deposit.ChainConditions {
+	conditions := &deposit.ChainConditions{
+		Halted:      progress.Halted,
+		Unavailable: progress.LatestBlock == nil,
+	}
+	if progress.LatestBlock != nil {
+		conditions.LatestBlock = progress.LatestBlock.Int64()
+	}
+	if progress.ScannedTo != nil {
+		conditions.ScannedTo = progress.ScannedTo.Int64()
+	}
+	return conditions
+}
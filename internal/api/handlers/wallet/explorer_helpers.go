@@ -0,0 +1,41 @@
+package wallet
+
+import (
+	"context"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/chain"
+)
+
+// chainExplorers 获取各链的区块浏览器 URL 模板，用于在响应中附带浏览器链接；获取失败只记录日志，响应中不返回链接
+func chainExplorers(ctx context.Context, s *api.Server) chain.Explorers {
+	chains, err := models.Chains(
+		models.ChainWhere.ExplorerURLTemplate.IsNotNull(),
+	).All(ctx, s.DB)
+	if err != nil {
+		util.LogFromContext(ctx).Warn().Err(err).Msg("Failed to get chain explorers, omitting explorer links")
+		return nil
+	}
+	return chain.NewExplorers(chains)
+}
+
+// explorerLinks 生成交易和收发地址的浏览器链接，链未配置浏览器模板时返回 nil
+func explorerLinks(explorers chain.Explorers, chainID int, txHash string, fromAddress string, toAddress string) *types.ExplorerLinks {
+	if _, ok := explorers[chainID]; !ok {
+		return nil
+	}
+
+	return &types.ExplorerLinks{
+		TxURL:          explorers.TxURL(chainID, txHash),
+		FromAddressURL: explorers.AddressURL(chainID, fromAddress),
+		ToAddressURL:   explorers.AddressURL(chainID, toAddress),
+	}
+}
+
+// withdrawExplorerLinks 生成提现交易和收发地址的浏览器链接，未广播的提现只有目标地址链接
+func withdrawExplorerLinks(explorers chain.Explorers, withdrawRecord *models.Withdraw) *types.ExplorerLinks {
+	return explorerLinks(explorers, withdrawRecord.ChainID, withdrawRecord.TXHash.String, withdrawRecord.FromAddress.String, withdrawRecord.ToAddress)
+}
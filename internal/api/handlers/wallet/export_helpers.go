@@ -0,0 +1,98 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/export"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/labstack/echo/v4"
+)
+
+// exportFunc 导出服务方法
+type exportFunc func(ctx context.Context, filter *export.Filter, w export.Writer) error
+
+// newExportFilter 将查询参数转换为导出条件
+func newExportFilter(chainID *int64, tokenID *int64, status *string, createdAfter *strfmt.DateTime, createdBefore *strfmt.DateTime) *export.Filter {
+	filter := &export.Filter{
+		ChainID: util.Int64PtrToIntPtr(chainID),
+		TokenID: util.Int64PtrToIntPtr(tokenID),
+		Status:  status,
+	}
+	if createdAfter != nil {
+		after := time.Time(*createdAfter)
+		filter.CreatedAfter = &after
+	}
+	if createdBefore != nil {
+		before := time.Time(*createdBefore)
+		filter.CreatedBefore = &before
+	}
+
+	return filter
+}
+
+// streamExport 以附件形式流式返回导出文件
+// 响应头在写出第一行时才发送，写出之前的错误（如代币不存在）仍可返回错误响应
+func streamExport(c echo.Context, name string, format string, filter *export.Filter, fn exportFunc) error {
+	ctx := c.Request().Context()
+	log := util.LogFromContext(ctx)
+
+	res := c.Response()
+	filename := fmt.Sprintf("%s-%s.%s", name, time.Now().UTC().Format("20060102T150405Z"), format)
+	lazy := &lazyExportResponse{c: c, contentType: export.ContentType(format), filename: filename}
+
+	w, err := export.NewWriter(format, lazy)
+	if err != nil {
+		return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Unsupported export format")
+	}
+
+	err = fn(ctx, filter, w)
+	if err == nil {
+		err = w.Close()
+	}
+	if err != nil {
+		if !res.Committed {
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Str("export", name).Msg("Failed to export records")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to export records")
+		}
+
+		// 已经开始发送文件，只能中断响应，客户端收到的文件不完整
+		log.Error().Err(err).Str("export", name).Msg("Export interrupted while streaming")
+		return nil
+	}
+
+	return nil
+}
+
+// lazyExportResponse 第一次写入时才发送响应头
+type lazyExportResponse struct {
+	c           echo.Context
+	contentType string
+	filename    string
+}
+
+func (l *lazyExportResponse) Write(p []byte) (int, error) {
+	res := l.c.Response()
+	if !res.Committed {
+		res.Header().Set(echo.HeaderContentType, l.contentType)
+		res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", l.filename))
+		res.WriteHeader(http.StatusOK)
+	}
+
+	return res.Write(p)
+}
+
+func (l *lazyExportResponse) Flush() {
+	if l.c.Response().Committed {
+		l.c.Response().Flush()
+	}
+}
@@ -0,0 +1,116 @@
+package wallet
+
+import (
+	"net/http"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/audit"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetAdminAuditsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/admin-audits", getAdminAuditsHandler(s))
+}
+
+func getAdminAuditsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to list admin audit entries")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view the admin audit trail",
+			)
+		}
+
+		params := walletTypes.NewGetAdminAuditsRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		filter := &audit.Filter{
+			Method:     params.Method,
+			Route:      params.Route,
+			TargetType: params.TargetType,
+			TargetID:   params.TargetID,
+			Result:     params.Result,
+		}
+		if params.ActorUserID != nil {
+			filter.ActorUserID = swag.String(params.ActorUserID.String())
+		}
+		if params.CreatedAfter != nil {
+			after := time.Time(*params.CreatedAfter)
+			filter.CreatedAfter = &after
+		}
+		if params.CreatedBefore != nil {
+			before := time.Time(*params.CreatedBefore)
+			filter.CreatedBefore = &before
+		}
+
+		entries, err := s.AdminAudit.List(ctx, filter, int(swag.Int64Value(params.Limit)), int(swag.Int64Value(params.Offset)))
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list admin audit entries")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to list admin audit entries")
+		}
+
+		response := &types.GetAdminAuditsResponse{
+			Entries: make([]*types.AdminAuditEntry, 0, len(entries)),
+		}
+		for _, entry := range entries {
+			response.Entries = append(response.Entries, toAdminAuditEntry(entry))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+// toAdminAuditEntry 转换审计记录为 API 响应格式
+func toAdminAuditEntry(entry *audit.Entry) *types.AdminAuditEntry {
+	createdAt := strfmt.DateTime(entry.CreatedAt)
+	response := &types.AdminAuditEntry{
+		ID:          swag.Int64(entry.ID),
+		ActorRole:   entry.ActorRole,
+		Method:      swag.String(entry.Method),
+		Route:       swag.String(entry.Route),
+		Path:        swag.String(entry.Path),
+		TargetType:  entry.TargetType,
+		TargetID:    entry.TargetID,
+		RequestID:   entry.RequestID,
+		PayloadHash: entry.PayloadHash,
+		Result:      swag.String(entry.Result),
+		StatusCode:  swag.Int64(int64(entry.StatusCode)),
+		Error:       entry.Error,
+		LatencyMs:   swag.Int64(entry.Latency.Milliseconds()),
+		ClientIP:    entry.ClientIP,
+		CreatedAt:   &createdAt,
+	}
+	if entry.Params != nil {
+		response.Params = entry.Params
+	}
+	if entry.ActorUserID != nil {
+		actorUserID := strfmt.UUID(*entry.ActorUserID)
+		response.ActorUserID = &actorUserID
+	}
+
+	return response
+}
@@ -0,0 +1,97 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/allowance"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetAllowancesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/allowances", getAllowancesHandler(s))
+}
+
+func getAllowancesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to list allowances")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can list allowances",
+			)
+		}
+
+		params := walletTypes.NewGetAllowancesRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		allowances, err := s.Allowance.ListAllowances(ctx, &allowance.Filter{
+			ChainID: util.Int64PtrToIntPtr(params.ChainID),
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list allowances")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to list allowances")
+		}
+
+		items := make([]*types.Allowance, 0, len(allowances))
+		for _, item := range allowances {
+			items = append(items, convertAllowance(item))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetAllowancesResponse{
+			Allowances: items,
+		})
+	}
+}
+
+// convertAllowance 转换为 API 响应的授权
+func convertAllowance(item *allowance.Allowance) *types.Allowance {
+	id := strfmt.UUID(item.ID)
+	walletID := strfmt.UUID(item.WalletID)
+	approvedAt := strfmt.DateTime(item.ApprovedAt)
+	result := &types.Allowance{
+		ID:             &id,
+		WalletID:       &walletID,
+		ChainID:        swag.Int64(int64(item.ChainID)),
+		OwnerAddress:   swag.String(item.OwnerAddress),
+		TokenAddress:   swag.String(item.TokenAddress),
+		TokenSymbol:    item.TokenSymbol,
+		SpenderAddress: swag.String(item.SpenderAddress),
+		Amount:         swag.String(item.Amount),
+		TxHash:         swag.String(item.TxHash),
+		BlockNo:        swag.Int64(item.BlockNo),
+		ApprovedAt:     &approvedAt,
+		RevokeTxHash:   item.RevokeTxHash,
+	}
+	if item.CurrentAllowance != nil {
+		result.CurrentAllowance = swag.String(item.CurrentAllowance.String())
+	}
+	if item.RevokeRequestedAt != nil {
+		requestedAt := strfmt.DateTime(*item.RevokeRequestedAt)
+		result.RevokeRequestedAt = &requestedAt
+	}
+
+	return result
+}
@@ -0,0 +1,69 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/apitoken"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetAPITokensRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/api-tokens", getAPITokensHandler(s))
+}
+
+func getAPITokensHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		tokens, err := s.APIToken.ListTokens(ctx, user.ID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get API tokens")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get API tokens")
+		}
+
+		items := make([]*types.UserAPIToken, 0, len(tokens))
+		for _, token := range tokens {
+			items = append(items, toUserAPIToken(token))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetUserAPITokensResponse{APITokens: items})
+	}
+}
+
+func toUserAPIToken(token *apitoken.Token) *types.UserAPIToken {
+	id := strfmt.UUID(token.ID)
+	createdAt := strfmt.DateTime(token.CreatedAt)
+
+	item := &types.UserAPIToken{
+		ID:                &id,
+		Name:              swag.String(token.Name),
+		Prefix:            swag.String(token.Prefix),
+		Scopes:            token.Scopes,
+		MaxWithdrawAmount: token.MaxWithdrawAmount,
+		ExpiresAt:         toOptionalDateTime(token.ExpiresAt),
+		LastUsedAt:        toOptionalDateTime(token.LastUsedAt),
+		LastUsedIP:        token.LastUsedIP,
+		CreatedAt:         &createdAt,
+	}
+	if token.WithdrawTokenID != nil {
+		item.WithdrawTokenID = swag.Int64(int64(*token.WithdrawTokenID))
+	}
+	if token.RateLimitPerMinute != nil {
+		item.RateLimitPerMinute = swag.Int64(int64(*token.RateLimitPerMinute))
+	}
+
+	return item
+}
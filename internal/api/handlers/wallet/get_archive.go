@@ -0,0 +1,95 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/archive"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// archiveRunsLimit 返回的最近归档执行记录数
+const archiveRunsLimit = 20
+
+func GetArchiveRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/archive", getArchiveHandler(s))
+}
+
+func getArchiveHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get archive runs")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can get archive runs",
+			)
+		}
+
+		runs, err := s.Archive.ListRuns(ctx, archiveRunsLimit)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list archive runs")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to list archive runs")
+		}
+
+		items := make([]*types.ArchiveRun, 0, len(runs))
+		for _, run := range runs {
+			items = append(items, convertArchiveRun(run))
+		}
+
+		config := s.Config.Wallet.Archive
+		response := &types.GetArchiveResponse{
+			Enabled:    swag.Bool(config.Enabled),
+			Mode:       swag.String(config.Mode),
+			MinAgeDays: swag.Int64(int64(config.MinAge.Hours() / 24)),
+			BatchSize:  swag.Int64(int64(config.BatchSize)),
+			Runs:       items,
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+// convertArchiveRun 将归档执行记录转换为 API 响应类型
+func convertArchiveRun(run *archive.Run) *types.ArchiveRun {
+	cutoff := strfmt.DateTime(run.Cutoff)
+	startedAt := strfmt.DateTime(run.StartedAt)
+	item := &types.ArchiveRun{
+		ID:                swag.Int64(int64(run.ID)),
+		Mode:              swag.String(run.Mode),
+		Cutoff:            &cutoff,
+		BlocksCount:       swag.Int64(run.Blocks),
+		TransactionsCount: swag.Int64(run.Transactions),
+		StartedAt:         &startedAt,
+	}
+	if run.TriggeredBy != nil {
+		triggeredBy := strfmt.UUID(*run.TriggeredBy)
+		item.TriggeredBy = &triggeredBy
+	}
+	if run.Error != nil {
+		item.Error = *run.Error
+	}
+	if run.FinishedAt != nil {
+		finishedAt := strfmt.DateTime(*run.FinishedAt)
+		item.FinishedAt = &finishedAt
+	}
+	return item
+}
@@ -0,0 +1,90 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetBackfillJobRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/backfill/:backfillId", getBackfillJobHandler(s))
+}
+
+func getBackfillJobHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get backfill job")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view backfill jobs",
+			)
+		}
+
+		params := walletTypes.NewGetBackfillJobRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		jobID := params.BackfillID.String()
+		job, err := s.Scan.GetBackfillJob(ctx, jobID)
+		if err != nil {
+			if err.Error() == "backfill job not found" {
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Backfill job not found")
+			}
+			log.Error().Err(err).Str("job_id", jobID).Msg("Failed to get backfill job")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get backfill job")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, toBackfillJob(job))
+	}
+}
+
+// toBackfillJob 将补扫任务转换为 API 响应类型
+func toBackfillJob(job *scan.BackfillJob) *types.BackfillJob {
+	id := strfmt.UUID(job.ID)
+	createdAt := strfmt.DateTime(job.CreatedAt)
+	updatedAt := strfmt.DateTime(job.UpdatedAt)
+
+	item := &types.BackfillJob{
+		ID:            &id,
+		ChainID:       swag.Int64(int64(job.ChainID)),
+		FromBlock:     swag.Int64(job.FromBlock),
+		ToBlock:       swag.Int64(job.ToBlock),
+		NextBlock:     swag.Int64(job.NextBlock),
+		ScannedBlocks: swag.Int64(job.ScannedBlocks()),
+		TotalBlocks:   swag.Int64(job.TotalBlocks()),
+		Status:        swag.String(job.Status),
+		Error:         job.Error,
+		CreatedAt:     &createdAt,
+		UpdatedAt:     &updatedAt,
+		CompletedAt:   toOptionalDateTime(job.CompletedAt),
+	}
+	if job.CreatedBy != nil {
+		createdBy := strfmt.UUID(*job.CreatedBy)
+		item.CreatedBy = &createdBy
+	}
+
+	return item
+}
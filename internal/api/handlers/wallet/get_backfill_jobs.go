@@ -0,0 +1,71 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetBackfillJobsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/backfills", getBackfillJobsHandler(s))
+}
+
+func getBackfillJobsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get backfill jobs")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view backfill jobs",
+			)
+		}
+
+		params := walletTypes.NewGetBackfillJobsRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		filter := &scan.BackfillFilter{
+			ChainID: util.Int64PtrToIntPtr(params.ChainID),
+			Status:  params.Status,
+			Limit:   int(swag.Int64Value(params.Limit)),
+			Offset:  int(swag.Int64Value(params.Offset)),
+		}
+
+		jobs, err := s.Scan.ListBackfillJobs(ctx, filter)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get backfill jobs")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get backfill jobs")
+		}
+
+		response := &types.GetBackfillJobsResponse{
+			Jobs: make([]*types.BackfillJob, 0, len(jobs)),
+		}
+		for _, job := range jobs {
+			response.Jobs = append(response.Jobs, toBackfillJob(job))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
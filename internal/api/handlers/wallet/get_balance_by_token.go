@@ -6,6 +6,7 @@ import (
 	"github/chapool/go-wallet/internal/api"
 	"github/chapool/go-wallet/internal/api/httperrors"
 	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/models"
 	"github/chapool/go-wallet/internal/types"
 	"github/chapool/go-wallet/internal/util"
 
@@ -33,22 +34,37 @@ func getBalanceByTokenHandler(s *api.Server) echo.HandlerFunc {
 		}
 
 		// 调用余额服务
-		tokenBalances, err := s.Balance.GetBalanceByToken(ctx, user.ID, chainID)
+		tokenBalances, err := s.Balance.GetBalanceBreakdown(ctx, user.ID, chainID)
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to get balance by token")
 			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get balance by token")
 		}
 
-		// 转换为 API 响应类型
+		tokenIDs := make([]int, 0, len(tokenBalances))
+		for _, tokenBalance := range tokenBalances {
+			tokenIDs = append(tokenIDs, tokenBalance.TokenID)
+		}
+		prices := getTokenPrices(ctx, s, tokenIDs)
+
+		// 转换为 API 响应类型，amount 保持为 finalized 余额
 		balanceItems := make([]*types.TokenBalanceItem, 0, len(tokenBalances))
 		for _, tokenBalance := range tokenBalances {
-			balanceItems = append(balanceItems, &types.TokenBalanceItem{
-				TokenID:     swag.Int64(int64(tokenBalance.TokenID)),
-				TokenSymbol: swag.String(tokenBalance.TokenSymbol),
-				ChainID:     swag.Int64(int64(tokenBalance.ChainID)),
-				Amount:      swag.String(tokenBalance.Amount.Text('f', -1)),
-				Status:      swag.String(tokenBalance.Status),
-			})
+			item := &types.TokenBalanceItem{
+				TokenID:        swag.Int64(int64(tokenBalance.TokenID)),
+				TokenSymbol:    swag.String(tokenBalance.TokenSymbol),
+				ChainID:        swag.Int64(int64(tokenBalance.ChainID)),
+				Amount:         swag.String(tokenBalance.Finalized.Text('f', -1)),
+				Status:         swag.String(models.CreditStatusFinalized.String()),
+				Available:      swag.String(tokenBalance.Available.Text('f', -1)),
+				PendingDeposit: swag.String(tokenBalance.PendingDeposit.Text('f', -1)),
+				FrozenWithdraw: swag.String(tokenBalance.FrozenWithdraw.Text('f', -1)),
+				Total:          swag.String(tokenBalance.Total.Text('f', -1)),
+			}
+			if tokenPrice, ok := prices[tokenBalance.TokenID]; ok {
+				item.UsdPrice = tokenPrice.PriceUSD
+				item.UsdValue = tokenPrice.ValueUSD(tokenBalance.Finalized)
+			}
+			balanceItems = append(balanceItems, item)
 		}
 
 		response := &types.GetBalanceByTokenResponse{
@@ -0,0 +1,102 @@
+package wallet
+
+import (
+	"net/http"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/scanhealth"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// defaultScanHealthRange 未指定 from 时返回的采样时间范围
+const defaultScanHealthRange = 24 * time.Hour
+
+func GetChainScanHealthRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/admin/chains/:chainId/scan-health", getChainScanHealthHandler(s))
+}
+
+func getChainScanHealthHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get chain scan health")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view chain scan health",
+			)
+		}
+
+		params := walletTypes.NewGetChainScanHealthRouteParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		to := time.Now()
+		if params.To != nil {
+			to = time.Time(*params.To)
+		}
+		from := to.Add(-defaultScanHealthRange)
+		if params.From != nil {
+			from = time.Time(*params.From)
+		}
+		if !from.Before(to) {
+			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "from must be before to")
+		}
+
+		health, err := s.ScanHealth.GetHealth(ctx, int(params.ChainID), from, to)
+		if err != nil {
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Int64("chain_id", params.ChainID).Msg("Failed to get chain scan health")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get chain scan health")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, toChainScanHealth(health))
+	}
+}
+
+// toChainScanHealth 转换链扫描延迟状态为 API 响应
+func toChainScanHealth(health *scanhealth.Health) *types.ChainScanHealth {
+	samples := make([]*types.ScanLagSample, 0, len(health.Samples))
+	for _, sample := range health.Samples {
+		sampledAt := strfmt.DateTime(sample.SampledAt)
+		samples = append(samples, &types.ScanLagSample{
+			SampledAt:    &sampledAt,
+			LatestBlock:  swag.Int64(sample.LatestBlock),
+			ScannedBlock: swag.Int64(sample.ScannedBlock),
+			Lag:          swag.Int64(sample.Lag),
+		})
+	}
+
+	return &types.ChainScanHealth{
+		ChainID:        swag.Int64(int64(health.ChainID)),
+		Level:          swag.String(health.Level),
+		AverageLag:     health.AverageLag,
+		CurrentLag:     health.CurrentLag,
+		WarningBlocks:  swag.Int64(int64(health.Thresholds.WarningBlocks)),
+		CriticalBlocks: swag.Int64(int64(health.Thresholds.CriticalBlocks)),
+		WindowSec:      swag.Int64(int64(health.Window.Seconds())),
+		Samples:        samples,
+	}
+}
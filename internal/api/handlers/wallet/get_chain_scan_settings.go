@@ -0,0 +1,81 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/aarondl/null/v8"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetChainScanSettingsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/chains/:chainId/scan-settings", getChainScanSettingsHandler(s))
+}
+
+func getChainScanSettingsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get chain scan settings")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view chain scan settings",
+			)
+		}
+
+		params := walletTypes.NewGetChainScanSettingsRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		settings, err := s.Scan.GetChainScanSettings(ctx, int(params.ChainID))
+		if err != nil {
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Int64("chain_id", params.ChainID).Msg("Failed to get chain scan settings")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get chain scan settings")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, toChainScanSettings(settings))
+	}
+}
+
+// toChainScanSettings 转换链扫描参数为 API 响应
+func toChainScanSettings(settings *scan.ChainScanSettings) *types.ChainScanSettings {
+	return &types.ChainScanSettings{
+		ChainID:                         swag.Int64(int64(settings.ChainID)),
+		ScanIntervalMs:                  swag.Int64(settings.Effective.ScanInterval.Milliseconds()),
+		BlockBatchSize:                  swag.Int64(int64(settings.Effective.BlockBatchSize)),
+		MaxReceiptConcurrency:           swag.Int64(int64(settings.Effective.MaxReceiptConcurrency)),
+		ConfiguredScanIntervalMs:        nullIntToInt64Ptr(settings.Configured.ScanIntervalMs),
+		ConfiguredBlockBatchSize:        nullIntToInt64Ptr(settings.Configured.BlockBatchSize),
+		ConfiguredMaxReceiptConcurrency: nullIntToInt64Ptr(settings.Configured.MaxReceiptConcurrency),
+	}
+}
+
+func nullIntToInt64Ptr(value null.Int) *int64 {
+	if !value.Valid {
+		return nil
+	}
+	return swag.Int64(int64(value.Int))
+}
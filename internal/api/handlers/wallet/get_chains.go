@@ -39,10 +39,33 @@ func getChainsHandler(s *api.Server) echo.HandlerFunc {
 			return err
 		}
 
+		// Load active wrapped native tokens (e.g. WBNB, WETH) of the chains
+		chainIDs := make([]int, 0, len(chains))
+		for _, chain := range chains {
+			chainIDs = append(chainIDs, chain.ChainID)
+		}
+		wrappedTokens, err := models.Tokens(
+			models.TokenWhere.ChainID.IN(chainIDs),
+			models.TokenWhere.IsWrappedNative.EQ(true),
+			models.TokenWhere.IsActive.EQ(true),
+		).All(ctx, s.DB)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to get wrapped native tokens")
+			return err
+		}
+		wrappedByChain := make(map[int]*models.Token, len(wrappedTokens))
+		for _, token := range wrappedTokens {
+			wrappedByChain[token.ChainID] = token
+		}
+
 		// Convert to ChainItem slice
 		chainItems := make([]*types.ChainItem, 0, len(chains))
 		for _, chain := range chains {
-			chainItems = append(chainItems, wallet.ChainToChainItem(chain))
+			item := wallet.ChainToChainItem(chain)
+			if token, ok := wrappedByChain[chain.ChainID]; ok {
+				item.WrappedNativeToken = wallet.TokenToWrappedNativeToken(token)
+			}
+			chainItems = append(chainItems, item)
 		}
 
 		response := &types.GetChainsResponse{
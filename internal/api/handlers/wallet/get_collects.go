@@ -13,6 +13,7 @@ import (
 	"github/chapool/go-wallet/internal/models"
 	"github/chapool/go-wallet/internal/types"
 	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/chain"
 
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/go-openapi/strfmt"
@@ -35,7 +36,7 @@ func GetCollectsRoute(s *api.Server) *echo.Route {
 func getUserWalletsForCollect(ctx context.Context, db *sql.DB, userID string) ([]string, error) {
 	userWallets, err := models.Wallets(
 		models.WalletWhere.UserID.EQ(userID),
-		models.WalletWhere.WalletType.EQ(string(auth.RoleUser)),
+		models.WalletWhere.WalletType.EQ(models.WalletTypeUser),
 	).All(ctx, db)
 
 	if err != nil {
@@ -64,7 +65,7 @@ func getUserWalletsForCollect(ctx context.Context, db *sql.DB, userID string) ([
 func getUserWalletsForChain(ctx context.Context, db *sql.DB, userID string, chainID int) ([]string, error) {
 	userWallets, err := models.Wallets(
 		models.WalletWhere.UserID.EQ(userID),
-		models.WalletWhere.WalletType.EQ(string(auth.RoleUser)),
+		models.WalletWhere.WalletType.EQ(models.WalletTypeUser),
 		models.WalletWhere.ChainID.EQ(chainID),
 	).All(ctx, db)
 
@@ -167,22 +168,23 @@ func parsePaginationParams(offsetStr, limitStr string) (int, int) {
 }
 
 // convertTransactionsToCollectItems 将交易转换为响应格式
-func convertTransactionsToCollectItems(transactions []*models.Transaction) []*types.CollectItem {
+func convertTransactionsToCollectItems(transactions []*models.Transaction, explorers chain.Explorers) []*types.CollectItem {
 	collectItems := make([]*types.CollectItem, 0, len(transactions))
 	for _, tx := range transactions {
 		id := strfmt.UUID(tx.ID)
 		createdAt := strfmt.DateTime(tx.CreatedAt)
 
 		item := &types.CollectItem{
-			ID:        &id,
-			ChainID:   swag.Int64(int64(tx.ChainID)),
-			TxHash:    swag.String(tx.TXHash),
-			FromAddr:  swag.String(tx.FromAddr),
-			ToAddr:    swag.String(tx.ToAddr),
-			Amount:    swag.String(tx.Amount),
-			Status:    swag.String(tx.Status),
-			BlockNo:   swag.Int64(tx.BlockNo),
-			CreatedAt: &createdAt,
+			ID:            &id,
+			ChainID:       swag.Int64(int64(tx.ChainID)),
+			TxHash:        swag.String(tx.TXHash),
+			FromAddr:      swag.String(tx.FromAddr),
+			ToAddr:        swag.String(tx.ToAddr),
+			Amount:        swag.String(tx.Amount),
+			Status:        swag.String(tx.Status.String()),
+			BlockNo:       swag.Int64(tx.BlockNo),
+			CreatedAt:     &createdAt,
+			ExplorerLinks: explorerLinks(explorers, tx.ChainID, tx.TXHash, tx.FromAddr, tx.ToAddr),
 		}
 
 		if tx.ConfirmationCount.Valid {
@@ -286,7 +288,10 @@ func getCollectsHandler(s *api.Server) echo.HandlerFunc {
 
 		// 按状态过滤
 		if status != "" {
-			mods = append(mods, models.TransactionWhere.Status.EQ(status))
+			if models.TransactionStatus(status).IsValid() != nil {
+				return invalidStatusParamError()
+			}
+			mods = append(mods, models.TransactionWhere.Status.EQ(models.TransactionStatus(status)))
 		}
 
 		// 地址过滤（只查询从用户地址发起的归集交易）
@@ -338,7 +343,7 @@ func getCollectsHandler(s *api.Server) echo.HandlerFunc {
 					Str("from_addr", tx.FromAddr).
 					Str("to_addr", tx.ToAddr).
 					Int("chain_id", tx.ChainID).
-					Str("status", tx.Status).
+					Str("status", tx.Status.String()).
 					Msg("Sample collect transaction from database")
 			}
 		}
@@ -400,7 +405,7 @@ func getCollectsHandler(s *api.Server) echo.HandlerFunc {
 			Msg("Found collect transactions")
 
 		// 转换为响应格式
-		collectItems := convertTransactionsToCollectItems(transactions)
+		collectItems := convertTransactionsToCollectItems(transactions, chainExplorers(ctx, s))
 
 		response := &types.GetCollectsResponse{
 			Collects: collectItems,
@@ -0,0 +1,38 @@
+package wallet
+
+import (
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/auth"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetCollectsExportRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/collects/export", getCollectsExportHandler(s))
+}
+
+// getCollectsExportHandler 导出归集交易，与归集列表接口的范围一致：管理员导出全部，普通用户只导出自己钱包的归集
+func getCollectsExportHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+
+		params := walletTypes.NewGetCollectsExportRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		filter := newExportFilter(params.ChainID, params.TokenID, params.Status, params.CreatedAfter, params.CreatedBefore)
+		if user.Role != string(auth.RoleAdmin) {
+			filter.UserID = &user.ID
+		}
+
+		return streamExport(c, "collects", swag.StringValue(params.Format), filter, s.Export.ExportCollects)
+	}
+}
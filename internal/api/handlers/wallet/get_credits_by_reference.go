@@ -0,0 +1,98 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/ledger"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetCreditsByReferenceRoute(s *api.Server) *echo.Route {
+	return s.Router.InternalV1.GET("/credits/by-reference", getCreditsByReferenceHandler(s))
+}
+
+func getCreditsByReferenceHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := walletTypes.NewGetCreditsByReferenceRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		var eventIndex *int
+		if params.EventIndex != nil {
+			index := int(*params.EventIndex)
+			eventIndex = &index
+		}
+
+		credits, err := s.Ledger.GetCreditsByReference(ctx, int(params.ChainID), params.TxHash, eventIndex)
+		if err != nil {
+			log.Error().Err(err).Int64("chain_id", params.ChainID).Str("tx_hash", params.TxHash).Msg("Failed to get credits by reference")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get credits")
+		}
+
+		items := make([]*types.CreditDetailItem, 0, len(credits))
+		for _, credit := range credits {
+			items = append(items, toCreditDetailItem(credit))
+		}
+
+		response := &types.GetCreditsByReferenceResponse{
+			Credits: items,
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+// toCreditDetailItem 转换为 API 响应类型
+func toCreditDetailItem(credit *ledger.CreditDetail) *types.CreditDetailItem {
+	id := strfmt.UUID(credit.ID)
+	userID := strfmt.UUID(credit.UserID)
+	createdAt := strfmt.DateTime(credit.CreatedAt)
+	updatedAt := strfmt.DateTime(credit.UpdatedAt)
+
+	history := make([]*types.CreditStatusChange, 0, len(credit.StatusHistory))
+	for _, change := range credit.StatusHistory {
+		changedAt := strfmt.DateTime(change.ChangedAt)
+		item := &types.CreditStatusChange{
+			ToStatus:  swag.String(change.ToStatus),
+			ChangedAt: &changedAt,
+		}
+		if change.FromStatus != "" {
+			item.FromStatus = swag.String(change.FromStatus)
+		}
+		history = append(history, item)
+	}
+
+	return &types.CreditDetailItem{
+		ID:            &id,
+		UserID:        &userID,
+		Address:       swag.String(credit.Address),
+		TokenID:       swag.Int64(int64(credit.TokenID)),
+		TokenSymbol:   swag.String(credit.TokenSymbol),
+		ChainID:       swag.Int64(int64(credit.ChainID)),
+		Amount:        swag.String(credit.Amount.Text('f', -1)),
+		CreditType:    swag.String(credit.CreditType),
+		BusinessType:  swag.String(credit.BusinessType),
+		ReferenceID:   swag.String(credit.ReferenceID),
+		ReferenceType: swag.String(credit.ReferenceType),
+		Status:        swag.String(credit.Status),
+		TxHash:        swag.String(credit.TxHash),
+		EventIndex:    swag.Int64(int64(credit.EventIndex)),
+		BlockNumber:   credit.BlockNumber,
+		Effective:     swag.Bool(credit.Effective),
+		StatusHistory: history,
+		CreatedAt:     &createdAt,
+		UpdatedAt:     &updatedAt,
+	}
+}
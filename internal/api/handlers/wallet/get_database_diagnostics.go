@@ -0,0 +1,128 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetDatabaseDiagnosticsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/diagnostics/database", getDatabaseDiagnosticsHandler(s))
+}
+
+func getDatabaseDiagnosticsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get database diagnostics")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can get database diagnostics",
+			)
+		}
+
+		report, err := s.Maintenance.Diagnose(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get database diagnostics")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get database diagnostics")
+		}
+
+		// 转换为 API 响应类型
+		tables := make([]*types.DatabaseTableStats, 0, len(report.Tables))
+		for _, t := range report.Tables {
+			table := &types.DatabaseTableStats{
+				Table:        swag.String(t.Table),
+				LiveRows:     swag.Int64(t.LiveRows),
+				DeadRows:     swag.Int64(t.DeadRows),
+				DeadRowRatio: swag.Float64(t.DeadRowRatio),
+				TotalBytes:   swag.Int64(t.TotalBytes),
+				TableBytes:   swag.Int64(t.TableBytes),
+				IndexBytes:   swag.Int64(t.IndexBytes),
+				SeqScans:     swag.Int64(t.SeqScans),
+				IndexScans:   swag.Int64(t.IndexScans),
+				Bloated:      swag.Bool(t.Bloated),
+			}
+			if t.LastVacuumAt != nil {
+				lastVacuumAt := strfmt.DateTime(*t.LastVacuumAt)
+				table.LastVacuumAt = &lastVacuumAt
+			}
+			if t.LastAnalyzeAt != nil {
+				lastAnalyzeAt := strfmt.DateTime(*t.LastAnalyzeAt)
+				table.LastAnalyzeAt = &lastAnalyzeAt
+			}
+			tables = append(tables, table)
+		}
+
+		indexes := make([]*types.DatabaseIndexStats, 0, len(report.Indexes))
+		for _, idx := range report.Indexes {
+			indexes = append(indexes, &types.DatabaseIndexStats{
+				Index:     swag.String(idx.Index),
+				Table:     swag.String(idx.Table),
+				Columns:   idx.Columns,
+				SizeBytes: swag.Int64(idx.SizeBytes),
+				Scans:     swag.Int64(idx.Scans),
+				Unique:    swag.Bool(idx.Unique),
+				Valid:     swag.Bool(idx.Valid),
+				Issues:    idx.Issues,
+			})
+		}
+
+		slowQueries := make([]*types.DatabaseSlowQuery, 0, len(report.SlowQueries))
+		for _, q := range report.SlowQueries {
+			slowQueries = append(slowQueries, &types.DatabaseSlowQuery{
+				Query:   swag.String(q.Query),
+				Calls:   swag.Int64(q.Calls),
+				TotalMs: swag.Float64(q.TotalMs),
+				MeanMs:  swag.Float64(q.MeanMs),
+				Rows:    swag.Int64(q.Rows),
+			})
+		}
+
+		suggestions := make([]*types.DatabaseIndexSuggestion, 0, len(report.Suggestions))
+		for _, suggestion := range report.Suggestions {
+			item := &types.DatabaseIndexSuggestion{
+				Table:   swag.String(suggestion.Table),
+				Columns: suggestion.Columns,
+				Reason:  swag.String(suggestion.Reason),
+			}
+			if suggestion.Statement != "" {
+				item.Statement = swag.String(suggestion.Statement)
+			}
+			suggestions = append(suggestions, item)
+		}
+
+		generatedAt := strfmt.DateTime(report.GeneratedAt)
+		response := &types.GetDatabaseDiagnosticsResponse{
+			GeneratedAt:          &generatedAt,
+			Tables:               tables,
+			Indexes:              indexes,
+			SlowQueriesAvailable: swag.Bool(report.SlowQueriesAvailable),
+			SlowQueries:          slowQueries,
+			Suggestions:          suggestions,
+		}
+		if report.SlowQueriesError != "" {
+			response.SlowQueriesError = swag.String(report.SlowQueriesError)
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
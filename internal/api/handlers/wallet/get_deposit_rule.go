@@ -0,0 +1,113 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/deposit"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetDepositRuleRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/deposit-rule/:ruleId", getDepositRuleHandler(s))
+}
+
+func getDepositRuleHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get deposit rule")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view deposit rules",
+			)
+		}
+
+		params := walletTypes.NewGetDepositRuleRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		ruleID := params.RuleID.String()
+		rule, err := s.Deposit.GetRule(ctx, ruleID)
+		if err != nil {
+			if err.Error() == "deposit rule not found" {
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Deposit rule not found")
+			}
+			log.Error().Err(err).Str("rule_id", ruleID).Msg("Failed to get deposit rule")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get deposit rule")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, toDepositRule(rule))
+	}
+}
+
+// toDepositRule 将充值规则转换为 API 响应类型
+func toDepositRule(rule *deposit.Rule) *types.DepositRule {
+	id := strfmt.UUID(rule.ID)
+	createdAt := strfmt.DateTime(rule.CreatedAt)
+	updatedAt := strfmt.DateTime(rule.UpdatedAt)
+
+	fromAddresses := rule.FromAddresses
+	if fromAddresses == nil {
+		fromAddresses = []string{}
+	}
+
+	item := &types.DepositRule{
+		ID:               &id,
+		Name:             swag.String(rule.Name),
+		Description:      rule.Description,
+		Priority:         swag.Int64(int64(rule.Priority)),
+		IsActive:         swag.Bool(rule.IsActive),
+		StopProcessing:   swag.Bool(rule.StopProcessing),
+		FromAddresses:    fromAddresses,
+		MinAmount:        rule.MinAmount,
+		MaxAmount:        rule.MaxAmount,
+		SenderIsContract: rule.SenderIsContract,
+		Action:           swag.String(rule.Action),
+		Tag:              rule.Tag,
+		FeePercent:       rule.FeePercent,
+		CreatedAt:        &createdAt,
+		UpdatedAt:        &updatedAt,
+	}
+	if rule.ChainID != nil {
+		item.ChainID = swag.Int64(int64(*rule.ChainID))
+	}
+	if rule.TokenID != nil {
+		item.TokenID = swag.Int64(int64(*rule.TokenID))
+	}
+	item.UserID = toOptionalUUID(rule.UserID)
+	item.FeeAccountUserID = toOptionalUUID(rule.FeeAccountUserID)
+	item.CreatedBy = toOptionalUUID(rule.CreatedBy)
+
+	return item
+}
+
+// toOptionalUUID 将可选 ID 转换为 API UUID 类型
+func toOptionalUUID(id *string) *strfmt.UUID {
+	if id == nil {
+		return nil
+	}
+
+	v := strfmt.UUID(*id)
+	return &v
+}
@@ -0,0 +1,54 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/labstack/echo/v4"
+)
+
+func GetDepositRulesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/deposit-rules", getDepositRulesHandler(s))
+}
+
+func getDepositRulesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get deposit rules")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view deposit rules",
+			)
+		}
+
+		rules, err := s.Deposit.ListRules(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get deposit rules")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get deposit rules")
+		}
+
+		items := make([]*types.DepositRule, 0, len(rules))
+		for _, rule := range rules {
+			items = append(items, toDepositRule(rule))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetDepositRulesResponse{Rules: items})
+	}
+}
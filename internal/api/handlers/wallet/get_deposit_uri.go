@@ -0,0 +1,96 @@
+package wallet
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/util/qrcode"
+	"github/chapool/go-wallet/internal/wallet/deposit"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// depositQRCodeScale 二维码每个模块的像素大小
+const depositQRCodeScale = 8
+
+func GetDepositURIRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/:chainId/deposit-uri", getDepositURIHandler(s))
+}
+
+func getDepositURIHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		params := walletTypes.NewGetDepositURIRouteParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		req := &deposit.URIRequest{
+			UserID:  user.ID,
+			ChainID: int(params.ChainID),
+			TokenID: util.Int64PtrToIntPtr(params.TokenID),
+			Amount:  swag.StringValue(params.Amount),
+		}
+
+		uri, err := s.Deposit.GetDepositURI(ctx, req)
+		if err != nil {
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Int64("chain_id", params.ChainID).Msg("Failed to get deposit URI")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get deposit URI")
+		}
+
+		chainID := int64(uri.ChainID)
+		response := &types.DepositURIResponse{
+			URI:     swag.String(uri.URI),
+			Address: swag.String(uri.Address),
+			ChainID: &chainID,
+			TokenID: params.TokenID,
+			Amount:  params.Amount,
+		}
+
+		if swag.BoolValue(params.IncludeQr) {
+			qrCode, err := encodeQRCodePNG(uri.URI)
+			if err != nil {
+				if errors.Is(err, qrcode.ErrContentTooLong) {
+					return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Deposit URI too long for QR code")
+				}
+				log.Error().Err(err).Str("uri", uri.URI).Msg("Failed to encode deposit QR code")
+				return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to encode QR code")
+			}
+			response.QrCodePng = swag.String(qrCode)
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+// encodeQRCodePNG 将内容编码为 base64 格式的 PNG 二维码
+func encodeQRCodePNG(content string) (string, error) {
+	code, err := qrcode.Encode(content)
+	if err != nil {
+		return "", err
+	}
+
+	png, err := code.PNG(depositQRCodeScale)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(png), nil
+}
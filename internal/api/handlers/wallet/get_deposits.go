@@ -14,6 +14,8 @@ import (
 	"github/chapool/go-wallet/internal/models"
 	"github/chapool/go-wallet/internal/types"
 	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/deposit"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -48,7 +50,7 @@ func getDepositsHandler(s *api.Server) echo.HandlerFunc {
 
 		// 构建查询条件
 		mods := []qm.QueryMod{
-			models.TransactionWhere.Type.EQ("deposit"),
+			models.TransactionWhere.Type.EQ(models.TransactionTypeDeposit),
 		}
 
 		// 只查询当前用户的充值交易（通过钱包地址）
@@ -108,7 +110,10 @@ func getDepositsHandler(s *api.Server) echo.HandlerFunc {
 
 		// 按状态过滤
 		if status != "" {
-			mods = append(mods, models.TransactionWhere.Status.EQ(status))
+			if models.TransactionStatus(status).IsValid() != nil {
+				return invalidStatusParamError()
+			}
+			mods = append(mods, models.TransactionWhere.Status.EQ(models.TransactionStatus(status)))
 		}
 
 		// 地址过滤（只查询充值到用户地址的交易）
@@ -155,7 +160,8 @@ func getDepositsHandler(s *api.Server) echo.HandlerFunc {
 			return err
 		}
 
-		depositItems, err := buildDepositResponse(ctx, s.DB, user.ID, transactions)
+		etas := estimateDepositFinality(ctx, s, transactions)
+		depositItems, err := buildDepositResponse(ctx, s.DB, user.ID, transactions, etas, chainExplorers(ctx, s))
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to build deposit response")
 			return err
@@ -172,7 +178,7 @@ func getDepositsHandler(s *api.Server) echo.HandlerFunc {
 // transactionToDepositItem 转换 Transaction 为 DepositItem
 //
 //nolint:varnamelen // tx is a common abbreviation for transaction
-func buildDepositResponse(ctx context.Context, db *sql.DB, userID string, transactions []*models.Transaction) ([]*types.DepositItem, error) {
+func buildDepositResponse(ctx context.Context, db *sql.DB, userID string, transactions []*models.Transaction, etas map[string]*deposit.FinalityETA, explorers chain.Explorers) ([]*types.DepositItem, error) {
 	if len(transactions) == 0 {
 		return []*types.DepositItem{}, nil
 	}
@@ -191,7 +197,7 @@ func buildDepositResponse(ctx context.Context, db *sql.DB, userID string, transa
 	for _, tx := range transactions {
 		credit := creditsByTx[tx.ID]
 		tokenSymbol := resolveTokenSymbol(tx, credit, tokenMap)
-		item := transactionToDepositItem(tx, credit, tokenSymbol)
+		item := transactionToDepositItem(tx, credit, tokenSymbol, etas[tx.ID], explorers)
 		depositItems = append(depositItems, item)
 	}
 	return depositItems, nil
@@ -270,20 +276,21 @@ func normalizeTokenAddress(addr null.String) string {
 }
 
 //nolint:varnamelen // tx is a common abbreviation for transaction
-func transactionToDepositItem(tx *models.Transaction, credit *models.Credit, tokenSymbol string) *types.DepositItem {
+func transactionToDepositItem(tx *models.Transaction, credit *models.Credit, tokenSymbol string, eta *deposit.FinalityETA, explorers chain.Explorers) *types.DepositItem {
 	id := strfmt.UUID(tx.ID)
 	createdAt := strfmt.DateTime(tx.CreatedAt)
 
 	item := &types.DepositItem{
-		ID:        &id,
-		ChainID:   swag.Int64(int64(tx.ChainID)),
-		TxHash:    swag.String(tx.TXHash),
-		FromAddr:  swag.String(tx.FromAddr),
-		ToAddr:    swag.String(tx.ToAddr),
-		Amount:    swag.String(tx.Amount),
-		Status:    swag.String(tx.Status),
-		BlockNo:   swag.Int64(tx.BlockNo),
-		CreatedAt: &createdAt,
+		ID:            &id,
+		ChainID:       swag.Int64(int64(tx.ChainID)),
+		TxHash:        swag.String(tx.TXHash),
+		FromAddr:      swag.String(tx.FromAddr),
+		ToAddr:        swag.String(tx.ToAddr),
+		Amount:        swag.String(tx.Amount),
+		Status:        swag.String(tx.Status.String()),
+		BlockNo:       swag.Int64(tx.BlockNo),
+		CreatedAt:     &createdAt,
+		ExplorerLinks: explorerLinks(explorers, tx.ChainID, tx.TXHash, tx.FromAddr, tx.ToAddr),
 	}
 
 	if tx.TokenAddr.Valid {
@@ -300,6 +307,12 @@ func transactionToDepositItem(tx *models.Transaction, credit *models.Credit, tok
 		item.TokenSymbol = swag.String(tokenSymbol)
 	}
 
+	// 未终结的充值返回预计终结时间
+	if eta != nil {
+		item.EstimatedFinalizedAt = toOptionalDateTime(&eta.EstimatedAt)
+		item.EtaDegraded = eta.Degraded
+	}
+
 	return item
 }
 
@@ -0,0 +1,176 @@
+package wallet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/diagnostics"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func GetDiagnosticsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/admin/diagnostics", getDiagnosticsHandler(s))
+}
+
+// getDiagnosticsHandler 收集运维诊断包（扫描状态、RPC 节点健康、队列深度、热钱包余额、卡住的提现、最近的重组和错误）
+// format=zip 时以附件形式返回压缩的诊断包，便于附加到事故工单
+func getDiagnosticsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get diagnostics bundle")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can get the diagnostics bundle",
+			)
+		}
+
+		params := walletTypes.NewGetDiagnosticsRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		bundle := s.Diagnostics.Collect(ctx)
+		response := toDiagnosticsResponse(s, bundle)
+
+		if swag.StringValue(params.Format) != "zip" {
+			return util.ValidateAndReturn(c, http.StatusOK, response)
+		}
+
+		if err := response.Validate(strfmt.Default); err != nil {
+			log.Error().Err(err).Msg("Diagnostics bundle failed validation")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to create diagnostics bundle")
+		}
+
+		name := "diagnostics-" + bundle.GeneratedAt.UTC().Format("20060102T150405Z")
+		archive, err := zipDiagnostics(name+".json", response)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to zip diagnostics bundle")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to create diagnostics bundle")
+		}
+
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", name+".zip"))
+		return c.Blob(http.StatusOK, "application/zip", archive)
+	}
+}
+
+// zipDiagnostics 把诊断包写入只包含一个 JSON 文件的 zip
+func zipDiagnostics(filename string, response *types.GetDiagnosticsResponse) ([]byte, error) {
+	data, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal diagnostics bundle")
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create(filename)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create zip entry")
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, errors.Wrap(err, "failed to write zip entry")
+	}
+	if err := zw.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close zip")
+	}
+
+	return buf.Bytes(), nil
+}
+
+func toDiagnosticsResponse(s *api.Server, bundle *diagnostics.Bundle) *types.GetDiagnosticsResponse {
+	generatedAt := strfmt.DateTime(bundle.GeneratedAt)
+	response := &types.GetDiagnosticsResponse{
+		GeneratedAt:    &generatedAt,
+		Scanners:       make([]*types.ChainScanStatus, 0, len(bundle.Scanners)),
+		RPCClients:     make([]*types.RPCClientState, 0, len(bundle.RPCClients)),
+		QueueDepths:    make([]*types.DiagnosticsQueueDepth, 0, len(bundle.QueueDepths)),
+		HotWallets:     make([]*types.HotWalletHealthItem, 0, len(bundle.HotWallets)),
+		StuckWithdraws: make([]*types.DiagnosticsStuckWithdraw, 0, len(bundle.StuckWithdraws)),
+		RecentReorgs:   make([]*types.DiagnosticsReorg, 0, len(bundle.RecentReorgs)),
+		RecentErrors:   make([]*types.DiagnosticsError, 0, len(bundle.RecentErrors)),
+	}
+	if len(bundle.CollectionErrors) > 0 {
+		response.CollectionErrors = bundle.CollectionErrors
+	}
+
+	for _, progress := range bundle.Scanners {
+		response.Scanners = append(response.Scanners, toChainScanStatus(s, progress))
+	}
+	for _, state := range bundle.RPCClients {
+		response.RPCClients = append(response.RPCClients, toRPCClientState(state))
+	}
+	for _, depth := range bundle.QueueDepths {
+		response.QueueDepths = append(response.QueueDepths, &types.DiagnosticsQueueDepth{
+			Queue:    swag.String(depth.Queue),
+			ChainID:  util.IntPtrToInt64Ptr(depth.ChainID),
+			Status:   depth.Status,
+			Depth:    swag.Int64(depth.Depth),
+			OldestAt: toOptionalDateTime(depth.OldestAt),
+		})
+	}
+	for _, health := range bundle.HotWallets {
+		response.HotWallets = append(response.HotWallets, toHotWalletHealthItem(health))
+	}
+	for _, withdraw := range bundle.StuckWithdraws {
+		createdAt := strfmt.DateTime(withdraw.CreatedAt)
+		updatedAt := strfmt.DateTime(withdraw.UpdatedAt)
+		item := &types.DiagnosticsStuckWithdraw{
+			ID:          (*strfmt.UUID)(swag.String(withdraw.ID)),
+			ChainID:     swag.Int64(int64(withdraw.ChainID)),
+			TokenID:     swag.Int64(int64(withdraw.TokenID)),
+			Status:      swag.String(withdraw.Status),
+			FromAddress: withdraw.FromAddress,
+			TxHash:      withdraw.TxHash,
+			Nonce:       util.IntPtrToInt64Ptr(withdraw.Nonce),
+			CreatedAt:   &createdAt,
+			UpdatedAt:   &updatedAt,
+		}
+		response.StuckWithdraws = append(response.StuckWithdraws, item)
+	}
+	for _, reorg := range bundle.RecentReorgs {
+		lastOrphanedAt := strfmt.DateTime(reorg.LastOrphanedAt)
+		response.RecentReorgs = append(response.RecentReorgs, &types.DiagnosticsReorg{
+			ChainID:        swag.Int64(int64(reorg.ChainID)),
+			OrphanedBlocks: swag.Int64(reorg.OrphanedBlocks),
+			FromBlock:      swag.Int64(reorg.FromBlock),
+			ToBlock:        swag.Int64(reorg.ToBlock),
+			LastOrphanedAt: &lastOrphanedAt,
+		})
+	}
+	for _, recentError := range bundle.RecentErrors {
+		occurredAt := strfmt.DateTime(recentError.OccurredAt)
+		response.RecentErrors = append(response.RecentErrors, &types.DiagnosticsError{
+			Source:      swag.String(recentError.Source),
+			ChainID:     util.IntPtrToInt64Ptr(recentError.ChainID),
+			ReferenceID: swag.String(recentError.ReferenceID),
+			Message:     swag.String(recentError.Message),
+			OccurredAt:  &occurredAt,
+		})
+	}
+
+	return response
+}
@@ -0,0 +1,47 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/dust"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetDustConsolidationConsentRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/dust-consolidation/consent", getDustConsolidationConsentHandler(s))
+}
+
+func getDustConsolidationConsentHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		consent, err := s.Dust.GetConsent(ctx, user.ID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get dust consolidation consent")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get dust consolidation consent")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, toDustConsolidationConsentResponse(consent))
+	}
+}
+
+// toDustConsolidationConsentResponse 将授权状态转换为 API 响应类型
+func toDustConsolidationConsentResponse(consent *dust.Consent) *types.DustConsolidationConsentResponse {
+	return &types.DustConsolidationConsentResponse{
+		Enabled:     swag.Bool(consent.Enabled),
+		ConsentedAt: toOptionalDateTime(consent.ConsentedAt),
+		RevokedAt:   toOptionalDateTime(consent.RevokedAt),
+	}
+}
@@ -0,0 +1,108 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/dust"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetDustConsolidationsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/dust-consolidations", getDustConsolidationsHandler(s))
+}
+
+func getDustConsolidationsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get dust consolidation report")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view the dust consolidation report",
+			)
+		}
+
+		params := walletTypes.NewGetDustConsolidationsRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		filter := &dust.ReportFilter{
+			Limit:  int(swag.Int64Value(params.Limit)),
+			Offset: int(swag.Int64Value(params.Offset)),
+		}
+		if params.UserID != nil {
+			userID := params.UserID.String()
+			filter.UserID = &userID
+		}
+		if params.TokenID != nil {
+			tokenID := int(*params.TokenID)
+			filter.TokenID = &tokenID
+		}
+
+		report, err := s.Dust.GetReport(ctx, filter)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get dust consolidation report")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get dust consolidation report")
+		}
+
+		// 转换为 API 响应类型
+		items := make([]*types.DustConsolidationItem, 0, len(report.Items))
+		for _, item := range report.Items {
+			id := strfmt.UUID(item.ID)
+			userID := strfmt.UUID(item.UserID)
+			accountUserID := strfmt.UUID(item.AccountUserID)
+			createdAt := strfmt.DateTime(item.CreatedAt)
+			items = append(items, &types.DustConsolidationItem{
+				ID:            &id,
+				UserID:        &userID,
+				AccountUserID: &accountUserID,
+				TokenID:       swag.Int64(int64(item.TokenID)),
+				TokenSymbol:   swag.String(item.TokenSymbol),
+				ChainID:       swag.Int64(int64(item.ChainID)),
+				Amount:        swag.String(item.Amount.Text('f', -1)),
+				Threshold:     swag.String(item.Threshold.Text('f', -1)),
+				CreatedAt:     &createdAt,
+			})
+		}
+
+		totals := make([]*types.DustConsolidationTokenTotal, 0, len(report.Totals))
+		for _, total := range report.Totals {
+			totals = append(totals, &types.DustConsolidationTokenTotal{
+				TokenID:     swag.Int64(int64(total.TokenID)),
+				TokenSymbol: swag.String(total.TokenSymbol),
+				ChainID:     swag.Int64(int64(total.ChainID)),
+				TotalAmount: swag.String(total.TotalAmount.Text('f', -1)),
+				Count:       swag.Int64(total.Count),
+				UserCount:   swag.Int64(total.UserCount),
+			})
+		}
+
+		response := &types.GetDustConsolidationsResponse{
+			Items:  items,
+			Totals: totals,
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
@@ -0,0 +1,76 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetDustDepositsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/deposits/dust", getDustDepositsHandler(s))
+}
+
+func getDustDepositsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get dust deposits")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view dust deposits",
+			)
+		}
+
+		params := walletTypes.NewGetDustDepositsRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		summaries, err := s.Deposit.GetDustReport(ctx, util.Int64PtrToIntPtr(params.ChainID))
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get dust deposits")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get dust deposits")
+		}
+
+		items := make([]*types.DustDepositSummary, 0, len(summaries))
+		for _, summary := range summaries {
+			firstDepositAt := strfmt.DateTime(summary.FirstDepositAt)
+			lastDepositAt := strfmt.DateTime(summary.LastDepositAt)
+			items = append(items, &types.DustDepositSummary{
+				ChainID:          swag.Int64(int64(summary.ChainID)),
+				TokenID:          swag.Int64(int64(summary.TokenID)),
+				TokenSymbol:      swag.String(summary.TokenSymbol),
+				MinDepositAmount: swag.String(summary.MinDepositAmount),
+				DepositCount:     swag.Int64(int64(summary.DepositCount)),
+				AddressCount:     swag.Int64(int64(summary.AddressCount)),
+				TotalAmount:      swag.String(summary.TotalAmount),
+				FirstDepositAt:   &firstDepositAt,
+				LastDepositAt:    &lastDepositAt,
+			})
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetDustDepositsResponse{
+			Items: items,
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/withdraw"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetFrozenWithdrawCreditsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/withdraws/frozen-credits", getFrozenWithdrawCreditsHandler(s))
+}
+
+func getFrozenWithdrawCreditsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get frozen withdraw credits")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view frozen withdraw credits",
+			)
+		}
+
+		// 报告只读：不释放冻结资金也不告警，释放由定时对账执行
+		report, err := s.Withdraw.ReconcileFrozenCredits(ctx, true)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to reconcile frozen withdraw credits")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get frozen withdraw credits")
+		}
+
+		items := make([]*types.FrozenWithdrawCredit, 0, len(report.Items))
+		for _, item := range report.Items {
+			items = append(items, toFrozenWithdrawCredit(item))
+		}
+		checkedAt := strfmt.DateTime(report.CheckedAt)
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetFrozenWithdrawCreditsResponse{
+			CheckedAt: &checkedAt,
+			Items:     items,
+		})
+	}
+}
+
+func toFrozenWithdrawCredit(item *withdraw.FrozenWithdraw) *types.FrozenWithdrawCredit {
+	userID := strfmt.UUID(item.UserID)
+	since := strfmt.DateTime(item.Since)
+
+	return &types.FrozenWithdrawCredit{
+		Action:         swag.String(item.Action),
+		ChainID:        util.IntPtrToInt64Ptr(item.ChainID),
+		ChainType:      item.ChainType,
+		CreditIds:      item.CreditIDs,
+		FrozenAmount:   swag.String(item.FrozenAmount),
+		Reason:         swag.String(item.Reason),
+		Since:          &since,
+		TokenID:        swag.Int64(int64(item.TokenID)),
+		TokenSymbol:    swag.String(item.TokenSymbol),
+		TxHash:         item.TxHash,
+		UserID:         &userID,
+		WithdrawID:     swag.String(item.WithdrawID),
+		WithdrawStatus: item.WithdrawStatus,
+	}
+}
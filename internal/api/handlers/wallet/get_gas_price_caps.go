@@ -0,0 +1,84 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/gasguard"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetGasPriceCapsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/gas-price-caps", getGasPriceCapsHandler(s))
+}
+
+// getGasPriceCapsHandler 查询各链的 gas 价格上限和当前生效的管理员覆盖
+func getGasPriceCapsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get gas price caps")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage gas price caps",
+			)
+		}
+
+		caps, err := s.GasPriceCap.GetCaps(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get gas price caps")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get gas price caps")
+		}
+
+		items := make([]*types.GasPriceCap, 0, len(caps))
+		for _, capStatus := range caps {
+			items = append(items, toGasPriceCap(capStatus))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetGasPriceCapsResponse{Items: items})
+	}
+}
+
+func toGasPriceCap(capStatus *gasguard.CapStatus) *types.GasPriceCap {
+	item := &types.GasPriceCap{
+		ChainID:         swag.Int64(int64(capStatus.ChainID)),
+		MaxGasPriceGwei: swag.String(gasguard.FormatGwei(capStatus.MaxGasPrice)),
+	}
+
+	if override := capStatus.Override; override != nil {
+		endsAt := strfmt.DateTime(override.EndsAt)
+		updatedAt := strfmt.DateTime(override.UpdatedAt)
+		item.Override = &types.GasPriceCapOverride{
+			Reason:    swag.String(override.Reason),
+			EndsAt:    &endsAt,
+			UpdatedAt: &updatedAt,
+		}
+		if override.MaxGasPrice != nil {
+			item.Override.MaxGasPriceGwei = swag.String(gasguard.FormatGwei(override.MaxGasPrice))
+		}
+		if override.UpdatedBy != nil {
+			updatedBy := strfmt.UUID(*override.UpdatedBy)
+			item.Override.UpdatedBy = &updatedBy
+		}
+	}
+
+	return item
+}
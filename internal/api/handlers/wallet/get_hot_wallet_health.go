@@ -0,0 +1,74 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/hotwallet"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetHotWalletHealthRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/hot-wallets/health", getHotWalletHealthHandler(s))
+}
+
+func getHotWalletHealthHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get hot wallet health")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view hot wallet health",
+			)
+		}
+
+		health, err := s.HotWalletMonitor.GetHealth(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get hot wallet health")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get hot wallet health")
+		}
+
+		items := make([]*types.HotWalletHealthItem, 0, len(health))
+		for _, h := range health {
+			items = append(items, toHotWalletHealthItem(h))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetHotWalletHealthResponse{Items: items})
+	}
+}
+
+func toHotWalletHealthItem(h *hotwallet.Health) *types.HotWalletHealthItem {
+	checkedAt := strfmt.DateTime(h.CheckedAt)
+
+	return &types.HotWalletHealthItem{
+		ChainID:               swag.Int64(int64(h.ChainID)),
+		TokenID:               swag.Int64(int64(h.TokenID)),
+		TokenSymbol:           swag.String(h.TokenSymbol),
+		Address:               swag.String(h.Address),
+		Balance:               h.Balance,
+		MinBalance:            h.MinBalance,
+		PendingWithdrawAmount: swag.String(h.PendingWithdrawAmount),
+		PendingWithdrawCount:  swag.Int64(int64(h.PendingWithdrawCount)),
+		Status:                swag.String(h.Status),
+		CheckedAt:             &checkedAt,
+	}
+}
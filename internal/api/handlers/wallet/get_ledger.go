@@ -0,0 +1,85 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/ledger"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetLedgerRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/ledger", getLedgerHandler(s))
+}
+
+func getLedgerHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		params := walletTypes.NewGetLedgerRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		filter := &ledger.Filter{
+			Limit:  int(swag.Int64Value(params.Limit)),
+			Offset: int(swag.Int64Value(params.Offset)),
+		}
+		if params.ChainID != nil {
+			chainID := int(*params.ChainID)
+			filter.ChainID = &chainID
+		}
+		if params.TokenID != nil {
+			tokenID := int(*params.TokenID)
+			filter.TokenID = &tokenID
+		}
+
+		entries, err := s.Ledger.GetLedger(ctx, user.ID, filter)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get ledger entries")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get ledger entries")
+		}
+
+		// 转换为 API 响应类型
+		entryItems := make([]*types.LedgerEntryItem, 0, len(entries))
+		for _, entry := range entries {
+			id := strfmt.UUID(entry.ID)
+			createdAt := strfmt.DateTime(entry.CreatedAt)
+			entryItems = append(entryItems, &types.LedgerEntryItem{
+				ID:             &id,
+				TokenID:        swag.Int64(int64(entry.TokenID)),
+				TokenSymbol:    swag.String(entry.TokenSymbol),
+				ChainID:        swag.Int64(int64(entry.ChainID)),
+				Amount:         swag.String(entry.Amount.Text('f', -1)),
+				CreditType:     swag.String(entry.CreditType),
+				BusinessType:   swag.String(entry.BusinessType),
+				ReferenceID:    swag.String(entry.ReferenceID),
+				ReferenceType:  swag.String(entry.ReferenceType),
+				Status:         swag.String(entry.Status),
+				TxHash:         entry.TxHash,
+				Effective:      swag.Bool(entry.Effective),
+				RunningBalance: swag.String(entry.RunningBalance.Text('f', -1)),
+				CreatedAt:      &createdAt,
+			})
+		}
+
+		response := &types.GetLedgerResponse{
+			Entries: entryItems,
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
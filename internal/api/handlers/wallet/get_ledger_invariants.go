@@ -0,0 +1,71 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetLedgerInvariantsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/ledger/invariants", getLedgerInvariantsHandler(s))
+}
+
+func getLedgerInvariantsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to check ledger invariants")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can check ledger invariants",
+			)
+		}
+
+		report, err := s.Ledger.CheckInvariants(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to check ledger invariants")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to check ledger invariants")
+		}
+
+		// 转换为 API 响应类型
+		violations := make([]*types.LedgerInvariantViolation, 0, len(report.Violations))
+		for _, v := range report.Violations {
+			userID := strfmt.UUID(v.UserID)
+			violations = append(violations, &types.LedgerInvariantViolation{
+				Check:       swag.String(v.Check),
+				UserID:      &userID,
+				TokenID:     swag.Int64(int64(v.TokenID)),
+				ReferenceID: v.ReferenceID,
+				Expected:    swag.String(v.Expected),
+				Actual:      swag.String(v.Actual),
+			})
+		}
+
+		checkedAt := strfmt.DateTime(report.CheckedAt)
+		response := &types.GetLedgerInvariantsResponse{
+			CheckedAt:  &checkedAt,
+			Violations: violations,
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
@@ -0,0 +1,84 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetLedgerReconciliationRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/ledger/reconciliation", getLedgerReconciliationHandler(s))
+}
+
+func getLedgerReconciliationHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get ledger reconciliation report")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can get the ledger reconciliation report",
+			)
+		}
+
+		params := walletTypes.NewGetLedgerReconciliationRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		var chainID *int
+		if params.ChainID != nil {
+			v := int(*params.ChainID)
+			chainID = &v
+		}
+
+		report, err := s.Ledger.GetReconciliationReport(ctx, chainID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get ledger reconciliation report")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get ledger reconciliation report")
+		}
+
+		// 转换为 API 响应类型
+		items := make([]*types.LedgerReconciliationItem, 0, len(report.Items))
+		for _, item := range report.Items {
+			items = append(items, &types.LedgerReconciliationItem{
+				ChainID:          swag.Int64(int64(item.ChainID)),
+				TokenID:          swag.Int64(int64(item.TokenID)),
+				TokenSymbol:      swag.String(item.TokenSymbol),
+				LedgerTotal:      swag.String(item.LedgerTotal.Text('f', -1)),
+				OnchainUserTotal: swag.String(item.OnchainUserTotal.Text('f', -1)),
+				OnchainHotTotal:  swag.String(item.OnchainHotTotal.Text('f', -1)),
+				Difference:       swag.String(item.Difference.Text('f', -1)),
+				FailedWallets:    swag.Int64(int64(item.FailedWallets)),
+			})
+		}
+
+		generatedAt := strfmt.DateTime(report.GeneratedAt)
+		response := &types.GetLedgerReconciliationResponse{
+			GeneratedAt: &generatedAt,
+			Items:       items,
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
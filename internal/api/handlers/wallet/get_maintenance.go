@@ -0,0 +1,67 @@
+package wallet
+
+import (
+	"net/http"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/labstack/echo/v4"
+)
+
+func GetMaintenanceRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/maintenance", getMaintenanceHandler(s))
+}
+
+// getMaintenanceHandler 查询全局和各链的维护模式设置，包括尚未开始和已结束的
+func getMaintenanceHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get maintenance settings")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage maintenance mode",
+			)
+		}
+
+		response, err := maintenanceResponse(c, s)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get maintenance settings")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get maintenance settings")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+// maintenanceResponse 查询所有维护模式设置并标记当前是否生效
+func maintenanceResponse(c echo.Context, s *api.Server) (*types.GetMaintenanceResponse, error) {
+	maintenances, err := s.Settings.ListMaintenances(c.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	items := make([]*types.MaintenanceSetting, 0, len(maintenances))
+	for _, maintenance := range maintenances {
+		items = append(items, toMaintenanceSetting(maintenance, maintenance.ActiveAt(now)))
+	}
+
+	return &types.GetMaintenanceResponse{Items: items}, nil
+}
@@ -0,0 +1,91 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/nft"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetNFTsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/nfts", getNFTsHandler(s))
+}
+
+func getNFTsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		params := walletTypes.NewGetNFTsRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		holdings, err := s.NFT.ListHoldings(ctx, &nft.Filter{
+			UserID:  user.ID,
+			ChainID: util.Int64PtrToIntPtr(params.ChainID),
+			Status:  params.Status,
+		}, int(swag.Int64Value(params.Limit)), int(swag.Int64Value(params.Offset)))
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get nfts")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get NFTs")
+		}
+
+		items := make([]*types.NFTHolding, 0, len(holdings))
+		for _, holding := range holdings {
+			items = append(items, convertNFTHolding(holding))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetNFTsResponse{
+			Nfts: items,
+		})
+	}
+}
+
+// convertNFTHolding 转换为 API 响应的 NFT 托管记录
+func convertNFTHolding(holding *nft.Holding) *types.NFTHolding {
+	id := strfmt.UUID(holding.ID)
+	userID := strfmt.UUID(holding.UserID)
+	createdAt := strfmt.DateTime(holding.CreatedAt)
+	item := &types.NFTHolding{
+		ID:                &id,
+		UserID:            &userID,
+		WalletAddress:     swag.String(holding.WalletAddress),
+		ChainID:           swag.Int64(int64(holding.ChainID)),
+		ContractAddress:   swag.String(holding.ContractAddress),
+		TokenID:           swag.String(holding.TokenID),
+		Standard:          swag.String(holding.Standard),
+		Amount:            swag.String(holding.Amount),
+		FromAddress:       swag.String(holding.FromAddress),
+		TxHash:            swag.String(holding.TxHash),
+		BlockNo:           swag.Int64(holding.BlockNo),
+		Status:            swag.String(holding.Status),
+		WithdrawToAddress: swag.StringValue(holding.WithdrawToAddress),
+		WithdrawTxHash:    swag.StringValue(holding.WithdrawTxHash),
+		WithdrawError:     swag.StringValue(holding.WithdrawError),
+		CreatedAt:         &createdAt,
+	}
+	if holding.WithdrawRequestedAt != nil {
+		requestedAt := strfmt.DateTime(*holding.WithdrawRequestedAt)
+		item.WithdrawRequestedAt = &requestedAt
+	}
+	if holding.WithdrawnAt != nil {
+		withdrawnAt := strfmt.DateTime(*holding.WithdrawnAt)
+		item.WithdrawnAt = &withdrawnAt
+	}
+
+	return item
+}
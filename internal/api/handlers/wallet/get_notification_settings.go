@@ -0,0 +1,55 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/notification"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetNotificationSettingsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/notification-settings", getNotificationSettingsHandler(s))
+}
+
+func getNotificationSettingsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		settings, err := s.Notification.GetSettings(ctx, user.ID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get notification settings")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get notification settings")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, toNotificationSettingsResponse(settings))
+	}
+}
+
+// toNotificationSettingsResponse 将通知设置转换为 API 响应类型
+func toNotificationSettingsResponse(settings *notification.Settings) *types.NotificationSettings {
+	thresholds := make([]*types.WithdrawNotificationThreshold, 0, len(settings.WithdrawThresholds))
+	for _, threshold := range settings.WithdrawThresholds {
+		thresholds = append(thresholds, &types.WithdrawNotificationThreshold{
+			TokenID:   swag.Int64(int64(threshold.TokenID)),
+			MinAmount: swag.String(threshold.MinAmount),
+		})
+	}
+
+	return &types.NotificationSettings{
+		DisabledEvents:     settings.DisabledEvents,
+		WithdrawThresholds: thresholds,
+		Locale:             settings.Locale,
+	}
+}
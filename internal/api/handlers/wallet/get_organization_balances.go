@@ -0,0 +1,73 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetOrganizationBalancesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/organizations/:orgId/balances", getOrganizationBalancesHandler(s))
+}
+
+func getOrganizationBalancesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		params := walletTypes.NewGetOrganizationBalancesRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if err := authorizeOrganizationAdmin(ctx, s, user, orgID); err != nil {
+			return err
+		}
+
+		balances, err := s.Balance.GetOrgBalances(ctx, orgID)
+		if err != nil {
+			log.Error().Err(err).Str("org_id", orgID).Msg("Failed to get organization balances")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get organization balances")
+		}
+
+		tokenIDs := make([]int, 0, len(balances))
+		for _, tokenBalance := range balances {
+			tokenIDs = append(tokenIDs, tokenBalance.TokenID)
+		}
+		prices := getTokenPrices(ctx, s, tokenIDs)
+
+		response := &types.GetOrganizationBalancesResponse{
+			Balances: make([]*types.BulkTokenBalance, 0, len(balances)),
+		}
+		for _, tokenBalance := range balances {
+			balanceItem := &types.BulkTokenBalance{
+				ChainID:     swag.Int64(int64(tokenBalance.ChainID)),
+				TokenID:     swag.Int64(int64(tokenBalance.TokenID)),
+				TokenSymbol: swag.String(tokenBalance.TokenSymbol),
+				Available:   swag.String(tokenBalance.Available.Text('f', -1)),
+				Frozen:      swag.String(tokenBalance.Frozen.Text('f', -1)),
+			}
+			if tokenPrice, ok := prices[tokenBalance.TokenID]; ok {
+				balanceItem.UsdPrice = tokenPrice.PriceUSD
+				balanceItem.AvailableUsdValue = tokenPrice.ValueUSD(tokenBalance.Available)
+				balanceItem.FrozenUsdValue = tokenPrice.ValueUSD(tokenBalance.Frozen)
+			}
+			response.Balances = append(response.Balances, balanceItem)
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
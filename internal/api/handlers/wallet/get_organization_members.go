@@ -0,0 +1,54 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/labstack/echo/v4"
+)
+
+func GetOrganizationMembersRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/organizations/:orgId/members", getOrganizationMembersHandler(s))
+}
+
+func getOrganizationMembersHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		params := walletTypes.NewGetOrganizationMembersRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if err := authorizeOrganizationAdmin(ctx, s, user, orgID); err != nil {
+			return err
+		}
+
+		members, err := s.Organization.ListMembers(ctx, orgID)
+		if err != nil {
+			log.Error().Err(err).Str("org_id", orgID).Msg("Failed to list organization members")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get organization members")
+		}
+
+		response := &types.GetOrganizationMembersResponse{
+			Members: make([]*types.OrganizationMember, 0, len(members)),
+		}
+		for _, member := range members {
+			response.Members = append(response.Members, toOrganizationMember(member))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
@@ -0,0 +1,105 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/notification"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetOrganizationNotificationTemplatesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/organizations/:orgId/notification-templates", getOrganizationNotificationTemplatesHandler(s))
+}
+
+func getOrganizationNotificationTemplatesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		params := walletTypes.NewGetOrganizationNotificationTemplatesRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if err := authorizeOrganizationAdmin(ctx, s, user, orgID); err != nil {
+			return err
+		}
+
+		branding, err := s.Notification.GetBranding(ctx, orgID)
+		if err != nil {
+			log.Error().Err(err).Str("org_id", orgID).Msg("Failed to get notification branding")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get notification templates")
+		}
+
+		templates, err := s.Notification.ListTemplates(ctx, orgID)
+		if err != nil {
+			log.Error().Err(err).Str("org_id", orgID).Msg("Failed to list notification templates")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get notification templates")
+		}
+
+		response := &types.GetOrganizationNotificationTemplatesResponse{
+			Branding:  toNotificationBranding(branding),
+			Templates: make([]*types.NotificationTemplate, 0, len(templates)),
+		}
+		for _, tmpl := range templates {
+			response.Templates = append(response.Templates, toNotificationTemplate(tmpl))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+// toNotificationBranding 将组织通知品牌转换为响应类型
+func toNotificationBranding(branding *notification.Branding) *types.NotificationBranding {
+	orgID := strfmt.UUID(branding.OrgID)
+
+	response := &types.NotificationBranding{
+		OrgID:      &orgID,
+		SenderName: branding.SenderName,
+		LogoURL:    branding.LogoURL,
+	}
+	if branding.UpdatedAt != nil {
+		updatedAt := strfmt.DateTime(*branding.UpdatedAt)
+		response.UpdatedAt = &updatedAt
+	}
+
+	return response
+}
+
+// toNotificationTemplate 将组织通知模板转换为响应类型
+func toNotificationTemplate(tmpl *notification.Template) *types.NotificationTemplate {
+	id := strfmt.UUID(tmpl.ID)
+	createdAt := strfmt.DateTime(tmpl.CreatedAt)
+	updatedAt := strfmt.DateTime(tmpl.UpdatedAt)
+
+	response := &types.NotificationTemplate{
+		ID:        &id,
+		Event:     swag.String(tmpl.Event),
+		Locale:    swag.String(tmpl.Locale),
+		Title:     swag.String(tmpl.Title),
+		Body:      swag.String(tmpl.Body),
+		EmailHTML: tmpl.EmailHTML,
+		CreatedAt: &createdAt,
+		UpdatedAt: &updatedAt,
+	}
+	if tmpl.CreatedBy != nil {
+		createdBy := strfmt.UUID(*tmpl.CreatedBy)
+		response.CreatedBy = &createdBy
+	}
+
+	return response
+}
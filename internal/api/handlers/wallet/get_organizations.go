@@ -0,0 +1,64 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetOrganizationsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/organizations", getOrganizationsHandler(s))
+}
+
+func getOrganizationsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		response := &types.GetOrganizationsResponse{
+			Organizations: make([]*types.Organization, 0),
+		}
+
+		// 管理员查看所有组织，其他用户只能看到自己所属的组织
+		if user.Role == string(auth.RoleAdmin) {
+			organizations, err := s.Organization.ListOrganizations(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to list organizations")
+				return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get organizations")
+			}
+			for _, org := range organizations {
+				response.Organizations = append(response.Organizations, toOrganization(org, nil))
+			}
+			return util.ValidateAndReturn(c, http.StatusOK, response)
+		}
+
+		member, err := s.Organization.GetMembership(ctx, user.ID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get organization membership")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get organizations")
+		}
+		if member == nil {
+			return util.ValidateAndReturn(c, http.StatusOK, response)
+		}
+
+		org, err := s.Organization.GetOrganization(ctx, member.OrgID)
+		if err != nil {
+			log.Error().Err(err).Str("org_id", member.OrgID).Msg("Failed to get organization")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get organizations")
+		}
+		response.Organizations = append(response.Organizations, toOrganization(org, swag.String(member.Role)))
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
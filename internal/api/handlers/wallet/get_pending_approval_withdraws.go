@@ -0,0 +1,129 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/risk"
+	"github/chapool/go-wallet/internal/wallet/withdraw"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetPendingApprovalWithdrawsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/withdraws/pending-approval", getPendingApprovalWithdrawsHandler(s))
+}
+
+func getPendingApprovalWithdrawsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get withdraws pending approval")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view withdraws pending approval",
+			)
+		}
+
+		params := walletTypes.NewGetPendingApprovalWithdrawsRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		pending, err := s.Withdraw.ListPendingApprovals(ctx, int(swag.Int64Value(params.Limit)), int(swag.Int64Value(params.Offset)))
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get withdraws pending approval")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get withdraws pending approval")
+		}
+
+		maintenances := activeMaintenances(ctx, s)
+		explorers := chainExplorers(ctx, s)
+		items := make([]*types.PendingApprovalWithdrawItem, 0, len(pending))
+		for _, p := range pending {
+			withdrawRecord := p.Withdraw
+			id := strfmt.UUID(withdrawRecord.ID)
+			userID := strfmt.UUID(withdrawRecord.UserID)
+			createdAt := strfmt.DateTime(withdrawRecord.CreatedAt)
+			withdrawItem := &types.WithdrawItem{
+				ID:            &id,
+				UserID:        &userID,
+				ToAddress:     swag.String(withdrawRecord.ToAddress),
+				TokenID:       swag.Int64(int64(withdrawRecord.TokenID)),
+				Amount:        swag.String(withdrawRecord.Amount),
+				Fee:           withdrawRecord.Fee,
+				Status:        swag.String(withdrawRecord.Status.String()),
+				CreatedAt:     &createdAt,
+				ProcessingEta: toOptionalDateTime(s.Withdraw.GetProcessingETA(withdrawRecord)),
+				ExpiresAt:     toOptionalDateTime(s.Withdraw.GetRequestExpiry(withdrawRecord)),
+				ExplorerLinks: withdrawExplorerLinks(explorers, withdrawRecord),
+				Maintenance:   withdrawMaintenanceNotice(maintenances, withdrawRecord),
+			}
+			if withdrawRecord.TXHash.Valid {
+				withdrawItem.TxHash = withdrawRecord.TXHash.String
+			}
+
+			items = append(items, &types.PendingApprovalWithdrawItem{
+				Withdraw:          withdrawItem,
+				RequiredApprovals: swag.Int64(int64(p.RequiredApprovals)),
+				ApprovalCount:     swag.Int64(int64(countApproved(p.Approvals))),
+				Approvals:         toWithdrawApprovalItems(p.Approvals),
+				RiskFlags:         toWithdrawRiskFlags(p.RiskFlags),
+			})
+		}
+
+		response := &types.GetPendingApprovalWithdrawsResponse{
+			Withdraws: items,
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+// countApproved 统计审批记录中的批准数
+func countApproved(approvals []*withdraw.Approval) int {
+	count := 0
+	for _, approval := range approvals {
+		if approval.Decision == withdraw.ApprovalDecisionApproved {
+			count++
+		}
+	}
+
+	return count
+}
+
+// toWithdrawRiskFlags 转换提现的复核标记
+func toWithdrawRiskFlags(flags []*risk.Flag) []*types.WithdrawRiskFlag {
+	items := make([]*types.WithdrawRiskFlag, 0, len(flags))
+	for _, flag := range flags {
+		createdAt := strfmt.DateTime(flag.CreatedAt)
+		item := &types.WithdrawRiskFlag{
+			Reason:    swag.String(flag.Reason),
+			CreatedAt: &createdAt,
+		}
+		if flag.LimitID != nil {
+			limitID := strfmt.UUID(*flag.LimitID)
+			item.LimitID = &limitID
+		}
+		items = append(items, item)
+	}
+
+	return items
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/go-openapi/swag"
@@ -18,6 +19,7 @@ import (
 	"github/chapool/go-wallet/internal/models"
 	"github/chapool/go-wallet/internal/types"
 	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/deposit"
 )
 
 const (
@@ -44,7 +46,7 @@ func getPendingDepositsHandler(s *api.Server) echo.HandlerFunc {
 		// 构建查询条件：只查询 confirmed 或 safe 状态的充值交易（未 finalized）
 		mods := []qm.QueryMod{
 			models.TransactionWhere.Type.EQ(models.TransactionTypeDeposit),
-			models.TransactionWhere.Status.IN([]string{
+			models.TransactionWhere.Status.IN([]models.TransactionStatus{
 				models.TransactionStatusConfirmed,
 				models.TransactionStatusSafe,
 			}),
@@ -124,7 +126,8 @@ func getPendingDepositsHandler(s *api.Server) echo.HandlerFunc {
 		}
 
 		// 按 token_symbol 分组并聚合
-		pendingDeposits, err := aggregatePendingDeposits(ctx, s.DB, transactions)
+		etas := estimateDepositFinality(ctx, s, transactions)
+		pendingDeposits, err := aggregatePendingDeposits(ctx, s.DB, transactions, etas)
 		if err != nil {
 			log.Error().Err(err).Msg("Failed to aggregate pending deposits")
 			return err
@@ -138,8 +141,8 @@ func getPendingDepositsHandler(s *api.Server) echo.HandlerFunc {
 	}
 }
 
-// aggregatePendingDeposits 按 token_symbol 分组并聚合待确认充值
-func aggregatePendingDeposits(ctx context.Context, db *sql.DB, transactions []*models.Transaction) ([]*types.PendingDepositItem, error) {
+// aggregatePendingDeposits 按 token_symbol 分组并聚合待确认充值，预计终结时间取组内最晚的一笔，任一笔为降级估算时整组标记为降级
+func aggregatePendingDeposits(ctx context.Context, db *sql.DB, transactions []*models.Transaction, etas map[string]*deposit.FinalityETA) ([]*types.PendingDepositItem, error) {
 	if len(transactions) == 0 {
 		return []*types.PendingDepositItem{}, nil
 	}
@@ -155,6 +158,8 @@ func aggregatePendingDeposits(ctx context.Context, db *sql.DB, transactions []*m
 		tokenSymbol string
 		totalAmount *big.Int
 		count       int64
+		eta         *time.Time
+		degraded    bool
 	}
 
 	groups := make(map[string]*tokenGroup)
@@ -171,15 +176,24 @@ func aggregatePendingDeposits(ctx context.Context, db *sql.DB, transactions []*m
 		}
 
 		// 分组聚合
-		if group, exists := groups[tokenSymbol]; exists {
+		group, exists := groups[tokenSymbol]
+		if exists {
 			group.totalAmount = new(big.Int).Add(group.totalAmount, amount)
 			group.count++
 		} else {
-			groups[tokenSymbol] = &tokenGroup{
+			group = &tokenGroup{
 				tokenSymbol: tokenSymbol,
 				totalAmount: new(big.Int).Set(amount),
 				count:       1,
 			}
+			groups[tokenSymbol] = group
+		}
+
+		if eta, ok := etas[tx.ID]; ok {
+			if group.eta == nil || eta.EstimatedAt.After(*group.eta) {
+				group.eta = &eta.EstimatedAt
+			}
+			group.degraded = group.degraded || eta.Degraded
 		}
 	}
 
@@ -191,6 +205,10 @@ func aggregatePendingDeposits(ctx context.Context, db *sql.DB, transactions []*m
 			PendingAmount:    swag.String(group.totalAmount.String()),
 			TransactionCount: swag.Int64(group.count),
 		}
+		if group.eta != nil {
+			item.EstimatedFinalizedAt = toOptionalDateTime(group.eta)
+			item.EtaDegraded = group.degraded
+		}
 		result = append(result, item)
 	}
 
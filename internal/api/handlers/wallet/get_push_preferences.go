@@ -0,0 +1,45 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/notification"
+
+	"github.com/labstack/echo/v4"
+)
+
+func GetPushPreferencesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/push-preferences", getPushPreferencesHandler(s))
+}
+
+func getPushPreferencesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		preferences, err := s.Notification.GetPushPreferences(ctx, user.ID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get push preferences")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get push preferences")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, toPushPreferencesResponse(preferences))
+	}
+}
+
+// toPushPreferencesResponse 将状态推送偏好转换为 API 响应类型
+func toPushPreferencesResponse(preferences *notification.PushPreferences) *types.PushPreferences {
+	return &types.PushPreferences{
+		DisabledEvents:  preferences.DisabledEvents,
+		AvailableEvents: notification.AllStatusEvents(),
+	}
+}
@@ -0,0 +1,94 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/quarantine"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func GetQuarantineRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/quarantine/:caseId", getQuarantineHandler(s))
+}
+
+func getQuarantineHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get quarantine case")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage quarantined deposits",
+			)
+		}
+
+		params := walletTypes.NewGetQuarantineRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		quarantineCase, err := s.Quarantine.GetCase(ctx, params.CaseID.String())
+		if err != nil {
+			if errors.Is(err, quarantine.ErrCaseNotFound) {
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Quarantine case not found")
+			}
+			log.Error().Err(err).Str("case_id", params.CaseID.String()).Msg("Failed to get quarantine case")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get quarantine case")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, toQuarantineCase(quarantineCase))
+	}
+}
+
+// toQuarantineCase 将隔离案件转换为 API 类型
+func toQuarantineCase(quarantineCase *quarantine.Case) *types.QuarantineCase {
+	id := strfmt.UUID(quarantineCase.ID)
+	transactionID := strfmt.UUID(quarantineCase.TransactionID)
+	userID := strfmt.UUID(quarantineCase.UserID)
+	createdAt := strfmt.DateTime(quarantineCase.CreatedAt)
+	updatedAt := strfmt.DateTime(quarantineCase.UpdatedAt)
+
+	return &types.QuarantineCase{
+		ID:              &id,
+		TransactionID:   &transactionID,
+		UserID:          &userID,
+		ChainID:         swag.Int64(int64(quarantineCase.ChainID)),
+		TokenID:         swag.Int64(int64(quarantineCase.TokenID)),
+		TokenSymbol:     swag.String(quarantineCase.TokenSymbol),
+		FromAddress:     swag.String(quarantineCase.FromAddress),
+		ToAddress:       swag.String(quarantineCase.ToAddress),
+		Amount:          swag.String(quarantineCase.Amount),
+		TxHash:          swag.String(quarantineCase.TxHash),
+		ScreeningSource: swag.String(quarantineCase.ScreeningSource),
+		ScreeningReason: quarantineCase.ScreeningReason,
+		Status:          swag.String(quarantineCase.Status),
+		ResolutionNote:  quarantineCase.ResolutionNote,
+		ResolvedBy:      toOptionalUUID(quarantineCase.ResolvedBy),
+		ReturnTxHash:    quarantineCase.ReturnTxHash,
+		EscalatedAt:     toOptionalDateTime(quarantineCase.EscalatedAt),
+		ResolvedAt:      toOptionalDateTime(quarantineCase.ResolvedAt),
+		CreatedAt:       &createdAt,
+		UpdatedAt:       &updatedAt,
+	}
+}
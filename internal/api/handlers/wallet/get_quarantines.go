@@ -0,0 +1,88 @@
+package wallet
+
+import (
+	"net/http"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/quarantine"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetQuarantinesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/quarantines", getQuarantinesHandler(s))
+}
+
+func getQuarantinesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to list quarantine cases")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage quarantined deposits",
+			)
+		}
+
+		params := walletTypes.NewGetQuarantinesRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		filter := newQuarantineFilter(params.Status, params.UserID, params.ChainID, params.CreatedAfter, params.CreatedBefore)
+		cases, err := s.Quarantine.ListCases(ctx, filter, int(swag.Int64Value(params.Limit)), int(swag.Int64Value(params.Offset)))
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list quarantine cases")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to list quarantine cases")
+		}
+
+		response := &types.GetQuarantinesResponse{
+			Cases: make([]*types.QuarantineCase, 0, len(cases)),
+		}
+		for _, quarantineCase := range cases {
+			response.Cases = append(response.Cases, toQuarantineCase(quarantineCase))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+// newQuarantineFilter 将查询参数转换为隔离案件查询条件
+func newQuarantineFilter(status *string, userID *strfmt.UUID, chainID *int64, createdAfter *strfmt.DateTime, createdBefore *strfmt.DateTime) *quarantine.Filter {
+	filter := &quarantine.Filter{
+		Status:  status,
+		ChainID: util.Int64PtrToIntPtr(chainID),
+	}
+	if userID != nil {
+		filter.UserID = swag.String(userID.String())
+	}
+	if createdAfter != nil {
+		after := time.Time(*createdAfter)
+		filter.CreatedAfter = &after
+	}
+	if createdBefore != nil {
+		before := time.Time(*createdBefore)
+		filter.CreatedBefore = &before
+	}
+
+	return filter
+}
@@ -0,0 +1,58 @@
+package wallet
+
+import (
+	"context"
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/export"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetQuarantinesExportRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/quarantines/export", getQuarantinesExportHandler(s))
+}
+
+func getQuarantinesExportHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to export quarantine cases")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage quarantined deposits",
+			)
+		}
+
+		params := walletTypes.NewGetQuarantinesExportRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		// 隔离案件有自己的筛选条件，导出条件中的字段不使用
+		filter := newQuarantineFilter(params.Status, params.UserID, params.ChainID, params.CreatedAfter, params.CreatedBefore)
+		exportCases := func(ctx context.Context, _ *export.Filter, w export.Writer) error {
+			return s.Quarantine.ExportCases(ctx, filter, w)
+		}
+
+		return streamExport(c, "quarantines", swag.StringValue(params.Format), &export.Filter{}, exportCases)
+	}
+}
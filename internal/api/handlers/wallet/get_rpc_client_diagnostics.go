@@ -0,0 +1,111 @@
+package wallet
+
+import (
+	"net/http"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetRPCClientDiagnosticsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/diagnostics/rpc-clients", getRPCClientDiagnosticsHandler(s))
+}
+
+func getRPCClientDiagnosticsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get RPC client diagnostics")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can get RPC client diagnostics",
+			)
+		}
+
+		states := s.Scan.GetRPCClientStates()
+
+		clients := make([]*types.RPCClientState, 0, len(states))
+		for _, state := range states {
+			clients = append(clients, toRPCClientState(state))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetRPCClientDiagnosticsResponse{
+			Clients: clients,
+		})
+	}
+}
+
+func toRPCClientState(state *scan.RPCClientState) *types.RPCClientState {
+	item := &types.RPCClientState{
+		ChainID:          swag.Int64(int64(state.ChainID)),
+		ChainType:        swag.String(state.ChainType),
+		RPCEndpoint:      swag.Int64(int64(state.RPCEndpoint)),
+		RPCEndpointCount: swag.Int64(int64(state.RPCEndpointCount)),
+		ScannerRunning:   swag.Bool(state.ScannerRunning),
+	}
+	if state.Health != nil {
+		lastUsedAt := strfmt.DateTime(state.Health.LastUsedAt)
+		lastSuccessAt := strfmt.DateTime(state.Health.LastSuccessAt)
+		item.LastUsedAt = &lastUsedAt
+		item.LastSuccessAt = &lastSuccessAt
+		if state.Health.FailingSince != nil {
+			failingSince := strfmt.DateTime(*state.Health.FailingSince)
+			item.FailingSince = &failingSince
+		}
+		item.Endpoints = make([]*types.RPCEndpointHealth, 0, len(state.Health.Endpoints))
+		for _, endpoint := range state.Health.Endpoints {
+			item.Endpoints = append(item.Endpoints, toRPCEndpointHealth(endpoint))
+		}
+	}
+
+	return item
+}
+
+func toRPCEndpointHealth(health *scan.RPCEndpointHealth) *types.RPCEndpointHealth {
+	item := &types.RPCEndpointHealth{
+		Endpoint:            swag.Int64(int64(health.Endpoint)),
+		Connected:           swag.Bool(health.Connected),
+		Primary:             swag.Bool(health.Primary),
+		CircuitState:        swag.String(health.CircuitState),
+		ErrorRate:           swag.Float64(health.ErrorRate),
+		ConsecutiveFailures: swag.Int64(int64(health.ConsecutiveFailures)),
+		Requests:            swag.Int64(int64(health.Requests)),
+		Failures:            swag.Int64(int64(health.Failures)),
+	}
+	if health.CircuitOpenUntil != nil {
+		circuitOpenUntil := strfmt.DateTime(*health.CircuitOpenUntil)
+		item.CircuitOpenUntil = &circuitOpenUntil
+	}
+	if health.Latency != nil {
+		item.LatencyMs = swag.Float64(float64(*health.Latency) / float64(time.Millisecond))
+	}
+	if health.LastError != "" {
+		item.LastError = swag.String(health.LastError)
+	}
+	if health.LastErrorAt != nil {
+		lastErrorAt := strfmt.DateTime(*health.LastErrorAt)
+		item.LastErrorAt = &lastErrorAt
+	}
+
+	return item
+}
@@ -0,0 +1,94 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetScanFailuresRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/scan-failures", getScanFailuresHandler(s))
+}
+
+func getScanFailuresHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get scan failures")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view scan failures",
+			)
+		}
+
+		params := walletTypes.NewGetScanFailuresRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		filter := &scan.ScanFailureFilter{
+			ChainID: util.Int64PtrToIntPtr(params.ChainID),
+			Status:  params.Status,
+			Limit:   int(swag.Int64Value(params.Limit)),
+			Offset:  int(swag.Int64Value(params.Offset)),
+		}
+
+		failures, err := s.Scan.ListScanFailures(ctx, filter)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get scan failures")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get scan failures")
+		}
+
+		response := &types.GetScanFailuresResponse{
+			Failures: make([]*types.ScanFailure, 0, len(failures)),
+		}
+		for _, failure := range failures {
+			response.Failures = append(response.Failures, toScanFailure(failure))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+func toScanFailure(failure *scan.ScanFailure) *types.ScanFailure {
+	id := strfmt.UUID(failure.ID)
+	nextRetryAt := strfmt.DateTime(failure.NextRetryAt)
+	createdAt := strfmt.DateTime(failure.CreatedAt)
+	updatedAt := strfmt.DateTime(failure.UpdatedAt)
+
+	return &types.ScanFailure{
+		ID:          &id,
+		ChainID:     swag.Int64(int64(failure.ChainID)),
+		BlockNumber: swag.Int64(failure.BlockNumber),
+		BlockHash:   swag.String(failure.BlockHash),
+		TxHash:      swag.String(failure.TxHash),
+		Error:       swag.String(failure.Error),
+		Attempts:    swag.Int64(int64(failure.Attempts)),
+		Status:      swag.String(failure.Status),
+		NextRetryAt: &nextRetryAt,
+		ResolvedAt:  toOptionalDateTime(failure.ResolvedAt),
+		CreatedAt:   &createdAt,
+		UpdatedAt:   &updatedAt,
+	}
+}
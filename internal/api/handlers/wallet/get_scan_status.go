@@ -0,0 +1,98 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/gasguard"
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetScanStatusRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/scan-status", getScanStatusHandler(s))
+}
+
+func getScanStatusHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get scan status")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view scan status",
+			)
+		}
+
+		statuses, err := s.Scan.GetScanStatus(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get scan status")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get scan status")
+		}
+
+		chains := make([]*types.ChainScanStatus, 0, len(statuses))
+		for _, progress := range statuses {
+			chains = append(chains, toChainScanStatus(s, progress))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetScanStatusResponse{Chains: chains})
+	}
+}
+
+func toChainScanStatus(s *api.Server, progress *scan.ScanProgress) *types.ChainScanStatus {
+	item := &types.ChainScanStatus{
+		ChainID:          swag.Int64(int64(progress.ChainID)),
+		Status:           swag.String(progress.Status),
+		Halted:           swag.Bool(progress.Halted),
+		RPCEndpoint:      swag.Int64(int64(progress.RPCEndpoint)),
+		RPCEndpointCount: swag.Int64(int64(progress.RPCEndpointCount)),
+	}
+	if progress.Error != "" {
+		item.Error = swag.String(progress.Error)
+	}
+	if progress.LatestBlock != nil && progress.ScannedTo != nil {
+		latestBlock := progress.LatestBlock.Int64()
+		scannedTo := progress.ScannedTo.Int64()
+		item.LatestBlock = swag.Int64(latestBlock)
+		item.ScannedTo = swag.Int64(scannedTo)
+		item.Lag = swag.Int64(max(latestBlock-scannedTo, 0))
+	}
+	if progress.LastHeadChangeAt != nil {
+		lastHeadChangeAt := strfmt.DateTime(*progress.LastHeadChangeAt)
+		item.LastHeadChangeAt = &lastHeadChangeAt
+	}
+	// 配置了 baseFee 上限的链返回 gas 熔断状态
+	if s.GasGuard != nil {
+		if gasStatus := s.GasGuard.GetStatus(progress.ChainID); gasStatus != nil {
+			item.GasPaused = swag.Bool(gasStatus.Paused)
+			item.MaxBaseFeeGwei = swag.String(gasguard.FormatGwei(gasStatus.MaxBaseFee))
+			if gasStatus.BaseFee != nil {
+				item.BaseFeeGwei = swag.String(gasguard.FormatGwei(gasStatus.BaseFee))
+			}
+			if gasStatus.PausedSince != nil {
+				pausedSince := strfmt.DateTime(*gasStatus.PausedSince)
+				item.GasPausedSince = &pausedSince
+			}
+		}
+	}
+
+	return item
+}
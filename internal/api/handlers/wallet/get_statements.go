@@ -0,0 +1,108 @@
+package wallet
+
+import (
+	"net/http"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/statement"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+// statementMonthLayout 对账单月份参数格式
+const statementMonthLayout = "2006-01"
+
+func GetStatementsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/statements", getStatementsHandler(s))
+}
+
+func getStatementsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		params := walletTypes.NewGetStatementsRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		month, err := time.Parse(statementMonthLayout, params.Month)
+		if err != nil {
+			return httperrors.NewHTTPValidationError(
+				http.StatusBadRequest,
+				types.PublicHTTPErrorTypeGeneric,
+				"Invalid month parameter",
+				[]*types.HTTPValidationErrorDetail{
+					{
+						Key:   swag.String("month"),
+						In:    swag.String("query"),
+						Error: swag.String("must be a month in the format YYYY-MM"),
+					},
+				},
+			)
+		}
+
+		filter := &statement.Filter{}
+		if params.ChainID != nil {
+			chainID := int(*params.ChainID)
+			filter.ChainID = &chainID
+		}
+		if params.TokenID != nil {
+			tokenID := int(*params.TokenID)
+			filter.TokenID = &tokenID
+		}
+
+		result, err := s.Statement.GetStatement(ctx, user.ID, month, filter)
+		if err != nil {
+			log.Error().Err(err).Str("month", params.Month).Msg("Failed to get balance statement")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get balance statement")
+		}
+
+		// 转换为 API 响应类型
+		tokens := make([]*types.BalanceStatementToken, 0, len(result.Tokens))
+		for _, token := range result.Tokens {
+			days := make([]*types.BalanceStatementDay, 0, len(token.Days))
+			for _, day := range token.Days {
+				date := strfmt.Date(day.Date)
+				days = append(days, &types.BalanceStatementDay{
+					Date:    &date,
+					Balance: swag.String(day.Balance.Text('f', -1)),
+					Change:  swag.String(day.Change.Text('f', -1)),
+				})
+			}
+
+			tokens = append(tokens, &types.BalanceStatementToken{
+				TokenID:        swag.Int64(int64(token.TokenID)),
+				TokenSymbol:    swag.String(token.TokenSymbol),
+				ChainID:        swag.Int64(int64(token.ChainID)),
+				OpeningBalance: swag.String(token.OpeningBalance.Text('f', -1)),
+				ClosingBalance: swag.String(token.ClosingBalance.Text('f', -1)),
+				NetChange:      swag.String(token.NetChange.Text('f', -1)),
+				Days:           days,
+			})
+		}
+
+		response := &types.GetStatementsResponse{
+			Month:  swag.String(result.Month.Format(statementMonthLayout)),
+			Tokens: tokens,
+		}
+		if result.GeneratedThrough != nil {
+			generatedThrough := strfmt.Date(*result.GeneratedThrough)
+			response.GeneratedThrough = &generatedThrough
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
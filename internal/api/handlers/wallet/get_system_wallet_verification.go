@@ -0,0 +1,101 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/hotwallet"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetSystemWalletVerificationRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/system-wallets/verification", getSystemWalletVerificationHandler(s))
+}
+
+func getSystemWalletVerificationHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get system wallet verification")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view system wallet verification",
+			)
+		}
+
+		params := walletTypes.NewGetSystemWalletVerificationRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		var (
+			report *hotwallet.VerificationReport
+			err    error
+		)
+		if swag.BoolValue(params.Refresh) {
+			report, err = s.SystemWalletVerifier.Verify(ctx)
+		} else {
+			report, err = s.SystemWalletVerifier.GetReport(ctx)
+		}
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to verify system wallets")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to verify system wallets")
+		}
+
+		items := make([]*types.SystemWalletVerificationItem, 0, len(report.Items))
+		for _, item := range report.Items {
+			items = append(items, toSystemWalletVerificationItem(item))
+		}
+		checkedAt := strfmt.DateTime(report.CheckedAt)
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetSystemWalletVerificationResponse{
+			Healthy:   swag.Bool(report.Healthy),
+			CheckedAt: &checkedAt,
+			Items:     items,
+		})
+	}
+}
+
+func toSystemWalletVerificationItem(v *hotwallet.Verification) *types.SystemWalletVerificationItem {
+	item := &types.SystemWalletVerificationItem{
+		Kind:           swag.String(v.Kind),
+		ChainID:        util.IntPtrToInt64Ptr(v.ChainID),
+		ChainType:      swag.String(v.ChainType),
+		DeviceName:     v.DeviceName,
+		Address:        swag.String(v.Address),
+		DerivationPath: swag.String(v.DerivationPath),
+		AddressIndex:   swag.Int64(int64(v.AddressIndex)),
+		DerivedAddress: v.DerivedAddress,
+		CurrentIndex:   util.IntPtrToInt64Ptr(v.CurrentIndex),
+		Status:         swag.String(v.Status),
+		Error:          v.Error,
+		SuggestedIndex: util.IntPtrToInt64Ptr(v.SuggestedIndex),
+		SuggestedPath:  v.SuggestedPath,
+		Repair:         v.Repair,
+	}
+	if v.WalletID != nil {
+		walletID := strfmt.UUID(*v.WalletID)
+		item.WalletID = &walletID
+	}
+
+	return item
+}
@@ -0,0 +1,76 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/price"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetTokenPricesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/token-prices", getTokenPricesHandler(s))
+}
+
+func getTokenPricesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get token prices")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage token prices",
+			)
+		}
+
+		prices, err := s.Price.ListPrices(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get token prices")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get token prices")
+		}
+
+		items := make([]*types.TokenPrice, 0, len(prices))
+		for _, tokenPrice := range prices {
+			items = append(items, toTokenPrice(tokenPrice))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetTokenPricesResponse{Prices: items})
+	}
+}
+
+func toTokenPrice(tokenPrice *price.Price) *types.TokenPrice {
+	updatedAt := strfmt.DateTime(tokenPrice.UpdatedAt)
+
+	item := &types.TokenPrice{
+		TokenID:     swag.Int64(int64(tokenPrice.TokenID)),
+		TokenSymbol: swag.String(tokenPrice.TokenSymbol),
+		ChainID:     swag.Int64(int64(tokenPrice.ChainID)),
+		PriceUsd:    swag.String(tokenPrice.PriceUSD),
+		Source:      swag.String(tokenPrice.Source),
+		UpdatedAt:   &updatedAt,
+	}
+	if tokenPrice.UpdatedBy != nil {
+		updatedBy := strfmt.UUID(*tokenPrice.UpdatedBy)
+		item.UpdatedBy = &updatedBy
+	}
+
+	return item
+}
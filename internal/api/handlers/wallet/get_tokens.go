@@ -0,0 +1,96 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/token"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetTokensRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/tokens", getTokensHandler(s))
+}
+
+func getTokensHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get tokens")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view tokens",
+			)
+		}
+
+		params := walletTypes.NewGetTokensRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		tokens, err := s.Token.ListTokens(ctx, &token.ListFilter{
+			ChainID:  util.Int64PtrToIntPtr(params.ChainID),
+			IsActive: params.IsActive,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get tokens")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get tokens")
+		}
+
+		items := make([]*types.AdminToken, 0, len(tokens))
+		for _, t := range tokens {
+			items = append(items, toAdminToken(t))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetTokensResponse{Tokens: items})
+	}
+}
+
+// toAdminToken 将代币记录转换为管理接口响应
+func toAdminToken(t *models.Token) *types.AdminToken {
+	createdAt := strfmt.DateTime(t.CreatedAt)
+	updatedAt := strfmt.DateTime(t.UpdatedAt)
+
+	return &types.AdminToken{
+		ID:                swag.Int64(int64(t.ID)),
+		ChainType:         swag.String(t.ChainType),
+		ChainID:           swag.Int64(int64(t.ChainID)),
+		TokenAddress:      t.TokenAddress.Ptr(),
+		TokenSymbol:       swag.String(t.TokenSymbol),
+		TokenName:         t.TokenName.Ptr(),
+		Decimals:          swag.Int64(int64(t.Decimals)),
+		IsNative:          swag.Bool(t.IsNative),
+		TokenType:         t.TokenType.Ptr(),
+		WithdrawFee:       t.WithdrawFee.Ptr(),
+		MinWithdrawAmount: t.MinWithdrawAmount.Ptr(),
+		MinTransferAmount: t.MinTransferAmount.Ptr(),
+		MinDepositAmount:  t.MinDepositAmount.Ptr(),
+		IsActive:          swag.Bool(t.IsActive),
+		IsWrappedNative:   swag.Bool(t.IsWrappedNative),
+		CreditAsNative:    swag.Bool(t.CreditAsNative),
+		FeeOnTransfer:     swag.Bool(t.FeeOnTransfer),
+		SupportsPermit:    swag.Bool(t.SupportsPermit),
+		CreatedAt:         &createdAt,
+		UpdatedAt:         &updatedAt,
+	}
+}
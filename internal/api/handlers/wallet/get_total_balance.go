@@ -48,6 +48,7 @@ func getTotalBalanceHandler(s *api.Server) echo.HandlerFunc {
 			TotalAmount: swag.String(balance.TotalAmount.Text('f', -1)),
 			TokenCount:  swag.Int64(int64(balance.TokenCount)),
 		}
+		response.TotalUsdValue = getTotalUSDValue(ctx, s, user.ID, chainID)
 
 		return util.ValidateAndReturn(c, http.StatusOK, response)
 	}
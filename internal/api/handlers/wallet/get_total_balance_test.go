@@ -0,0 +1,83 @@
+package wallet_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/test"
+	"github/chapool/go-wallet/internal/test/fixtures"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/wallet/balance"
+	"github/chapool/go-wallet/internal/wallet/price"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceUSDValueOfDepositCredits(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		s.Balance = balance.NewService(s.DB)
+		s.Price = price.NewService(s.DB, nil)
+
+		token, err := models.Tokens(qm.OrderBy(models.TokenColumns.ID)).One(ctx, s.DB)
+		require.NoError(t, err)
+		_, err = s.Price.SetManualPrice(ctx, token.ID, "2", fix.User1.ID)
+		require.NoError(t, err)
+
+		// 充值 credits 为代币最小单位（3 个代币），内部转账 credits 为代币单位（1.5 个代币）
+		depositAmount, err := money.ToSmallestUnit("3", token.Decimals)
+		require.NoError(t, err)
+		credits := []*models.Credit{
+			{
+				Amount:        depositAmount.String(),
+				CreditType:    models.CreditTypeDeposit,
+				BusinessType:  models.BusinessTypeBlockchain,
+				ReferenceID:   "0xdeposit_0",
+				ReferenceType: models.ReferenceTypeBlockchainTX,
+			},
+			{
+				Amount:        "1.5",
+				CreditType:    models.CreditTypeInternal,
+				BusinessType:  models.BusinessTypeInternalTransfer,
+				ReferenceID:   "transfer_0",
+				ReferenceType: models.ReferenceTypeInternalTransfer,
+			},
+		}
+		for _, credit := range credits {
+			credit.UserID = fix.User1.ID
+			credit.Address = "0x8589427373d6d84e98730d7795d8f6f8731fda16"
+			credit.TokenID = token.ID
+			credit.TokenSymbol = token.TokenSymbol
+			credit.ChainID = null.IntFrom(token.ChainID)
+			credit.ChainType = null.StringFrom(token.ChainType)
+			credit.Status = models.CreditStatusFinalized
+			credit.EventIndex = null.IntFrom(0)
+			require.NoError(t, credit.Insert(ctx, s.DB, boil.Infer()))
+		}
+
+		headers := test.HeadersWithAuth(t, fix.User1AccessToken1.Token)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/wallet/balance/total", nil, headers)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		var total types.TotalBalanceResponse
+		test.ParseResponseAndValidate(t, res, &total)
+		assert.Equal(t, "9.00", total.TotalUsdValue)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/wallet/balance/tokens", nil, headers)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		var tokens types.GetBalanceByTokenResponse
+		test.ParseResponseAndValidate(t, res, &tokens)
+		require.Len(t, tokens.Balances, 1)
+		assert.Equal(t, "4.5", *tokens.Balances[0].Amount)
+		assert.Equal(t, "9.00", tokens.Balances[0].UsdValue)
+	})
+}
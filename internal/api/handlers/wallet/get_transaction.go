@@ -0,0 +1,115 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/transaction"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func GetTransactionRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/transactions/:txHash", getTransactionHandler(s))
+}
+
+func getTransactionHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get transaction detail")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view transactions",
+			)
+		}
+
+		params := walletTypes.NewGetTransactionRouteParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		detail, err := s.Transaction.GetTransactionDetail(ctx, params.TxHash, util.Int64PtrToIntPtr(params.ChainID))
+		if err != nil {
+			switch {
+			case errors.Is(err, transaction.ErrInvalidTxHash):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Invalid transaction hash")
+			case errors.Is(err, transaction.ErrTransactionNotFound):
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Transaction not found, pass chain_id to check it on chain")
+			}
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Str("tx_hash", params.TxHash).Msg("Failed to get transaction detail")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get transaction detail")
+		}
+
+		explorers := chainExplorers(ctx, s)
+		items := make([]*types.AdminTransaction, 0, len(detail.Transactions))
+		for _, tx := range detail.Transactions {
+			items = append(items, toAdminTransaction(tx, explorers))
+		}
+
+		checks := make([]*types.TransactionChainCheck, 0, len(detail.Checks))
+		for _, check := range detail.Checks {
+			checks = append(checks, toTransactionChainCheck(check))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetTransactionResponse{
+			TxHash:       swag.String(detail.TxHash),
+			Transactions: items,
+			Checks:       checks,
+		})
+	}
+}
+
+// toTransactionChainCheck 将链上核对结果转换为管理接口响应
+func toTransactionChainCheck(check *transaction.ChainCheck) *types.TransactionChainCheck {
+	transfers := make([]*types.TransactionTokenTransfer, 0, len(check.TokenTransfers))
+	for _, transfer := range check.TokenTransfers {
+		transfers = append(transfers, &types.TransactionTokenTransfer{
+			TokenAddress: swag.String(transfer.TokenAddress),
+			TokenID:      util.IntPtrToInt64Ptr(transfer.TokenID),
+			TokenSymbol:  transfer.TokenSymbol,
+			FromAddress:  swag.String(transfer.FromAddress),
+			ToAddress:    swag.String(transfer.ToAddress),
+			Amount:       swag.String(transfer.Amount),
+		})
+	}
+
+	return &types.TransactionChainCheck{
+		ChainID:        swag.Int64(int64(check.ChainID)),
+		ChainType:      check.ChainType,
+		Supported:      swag.Bool(check.Supported),
+		Error:          check.Error,
+		Status:         check.Status,
+		BlockNumber:    check.BlockNumber,
+		BlockHash:      check.BlockHash,
+		LatestBlock:    check.LatestBlock,
+		Confirmations:  check.Confirmations,
+		BlockScanned:   check.BlockScanned,
+		RecordsMatch:   check.RecordsMatch,
+		FromAddress:    check.FromAddress,
+		ToAddress:      check.ToAddress,
+		Value:          check.Value,
+		TokenTransfers: transfers,
+	}
+}
@@ -0,0 +1,124 @@
+package wallet
+
+import (
+	"net/http"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/transaction"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func GetTransactionsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/admin/transactions", getTransactionsHandler(s))
+}
+
+func getTransactionsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get transactions")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view transactions",
+			)
+		}
+
+		params := walletTypes.NewGetTransactionsRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		filter := &transaction.Filter{
+			Type:      params.Type,
+			Status:    params.Status,
+			ChainID:   util.Int64PtrToIntPtr(params.ChainID),
+			TokenID:   util.Int64PtrToIntPtr(params.TokenID),
+			Address:   params.Address,
+			FromBlock: params.FromBlock,
+			ToBlock:   params.ToBlock,
+			Cursor:    params.Cursor,
+			Limit:     int(swag.Int64Value(params.Limit)),
+		}
+		if params.CreatedAfter != nil {
+			createdAfter := time.Time(*params.CreatedAfter)
+			filter.CreatedAfter = &createdAfter
+		}
+		if params.CreatedBefore != nil {
+			createdBefore := time.Time(*params.CreatedBefore)
+			filter.CreatedBefore = &createdBefore
+		}
+
+		page, err := s.Transaction.ListTransactions(ctx, filter)
+		if err != nil {
+			switch {
+			case errors.Is(err, transaction.ErrInvalidCursor):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Invalid cursor")
+			}
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Msg("Failed to get transactions")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get transactions")
+		}
+
+		explorers := chainExplorers(ctx, s)
+		items := make([]*types.AdminTransaction, 0, len(page.Transactions))
+		for _, tx := range page.Transactions {
+			items = append(items, toAdminTransaction(tx, explorers))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetTransactionsResponse{
+			Transactions:  items,
+			NextCursor:    page.NextCursor,
+			TotalEstimate: swag.Int64(page.TotalEstimate),
+		})
+	}
+}
+
+// toAdminTransaction 将交易记录转换为管理接口响应
+func toAdminTransaction(tx *models.Transaction, explorers chain.Explorers) *types.AdminTransaction {
+	id := strfmt.UUID(tx.ID)
+	createdAt := strfmt.DateTime(tx.CreatedAt)
+	updatedAt := strfmt.DateTime(tx.UpdatedAt)
+
+	return &types.AdminTransaction{
+		ID:                &id,
+		ChainID:           swag.Int64(int64(tx.ChainID)),
+		BlockHash:         swag.String(tx.BlockHash),
+		BlockNo:           swag.Int64(tx.BlockNo),
+		TxHash:            swag.String(tx.TXHash),
+		FromAddr:          swag.String(tx.FromAddr),
+		ToAddr:            swag.String(tx.ToAddr),
+		TokenAddr:         tx.TokenAddr.Ptr(),
+		Amount:            swag.String(tx.Amount),
+		Type:              swag.String(tx.Type.String()),
+		Status:            swag.String(tx.Status.String()),
+		ConfirmationCount: util.IntPtrToInt64Ptr(tx.ConfirmationCount.Ptr()),
+		CreatedAt:         &createdAt,
+		UpdatedAt:         &updatedAt,
+		ExplorerLinks:     explorerLinks(explorers, tx.ChainID, tx.TXHash, tx.FromAddr, tx.ToAddr),
+	}
+}
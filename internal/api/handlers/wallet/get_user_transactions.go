@@ -0,0 +1,85 @@
+package wallet
+
+import (
+	"net/http"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/transaction"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func GetUserTransactionsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/transactions", getUserTransactionsHandler(s))
+}
+
+func getUserTransactionsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		params := walletTypes.NewGetUserTransactionsRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		// 只查询当前用户钱包地址的交易
+		filter := &transaction.Filter{
+			UserID:    &user.ID,
+			Type:      params.Type,
+			Status:    params.Status,
+			ChainID:   util.Int64PtrToIntPtr(params.ChainID),
+			TokenID:   util.Int64PtrToIntPtr(params.TokenID),
+			Address:   params.Address,
+			FromBlock: params.FromBlock,
+			ToBlock:   params.ToBlock,
+			Cursor:    params.Cursor,
+			Limit:     int(swag.Int64Value(params.Limit)),
+		}
+		if params.CreatedAfter != nil {
+			createdAfter := time.Time(*params.CreatedAfter)
+			filter.CreatedAfter = &createdAfter
+		}
+		if params.CreatedBefore != nil {
+			createdBefore := time.Time(*params.CreatedBefore)
+			filter.CreatedBefore = &createdBefore
+		}
+
+		page, err := s.Transaction.ListTransactions(ctx, filter)
+		if err != nil {
+			switch {
+			case errors.Is(err, transaction.ErrInvalidCursor):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Invalid cursor")
+			}
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Msg("Failed to get user transactions")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get transactions")
+		}
+
+		explorers := chainExplorers(ctx, s)
+		items := make([]*types.AdminTransaction, 0, len(page.Transactions))
+		for _, tx := range page.Transactions {
+			items = append(items, toAdminTransaction(tx, explorers))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetTransactionsResponse{
+			Transactions:  items,
+			NextCursor:    page.NextCursor,
+			TotalEstimate: swag.Int64(page.TotalEstimate),
+		})
+	}
+}
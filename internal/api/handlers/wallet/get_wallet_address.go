@@ -7,7 +7,6 @@ import (
 	"github/chapool/go-wallet/internal/api"
 	"github/chapool/go-wallet/internal/api/httperrors"
 	"github/chapool/go-wallet/internal/auth"
-	"github/chapool/go-wallet/internal/types"
 	walletTypes "github/chapool/go-wallet/internal/types/wallet"
 	"github/chapool/go-wallet/internal/util"
 )
@@ -33,8 +32,8 @@ func getWalletAddressHandler(s *api.Server) echo.HandlerFunc {
 		walletResult, err := s.Wallet.GetWallet(ctx, user.ID, int(params.ChainID))
 		if err != nil {
 			log.Debug().Err(err).Msg("Failed to get wallet")
-			if err.Error() == "wallet not found" {
-				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Wallet not found")
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
 			}
 			return err
 		}
@@ -0,0 +1,74 @@
+package wallet
+
+import (
+	"net/http"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetWalletStatsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/stats", getWalletStatsHandler(s))
+}
+
+func getWalletStatsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 调用统计服务（读取增量维护的汇总表）
+		userStats, err := s.Stats.GetUserStats(ctx, user.ID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get wallet stats")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get wallet stats")
+		}
+
+		// 转换为 API 响应类型
+		tokenItems := make([]*types.TokenStatsItem, 0, len(userStats.Tokens))
+		for _, tokenStats := range userStats.Tokens {
+			tokenItems = append(tokenItems, &types.TokenStatsItem{
+				TokenID:         swag.Int64(int64(tokenStats.TokenID)),
+				TokenSymbol:     swag.String(tokenStats.TokenSymbol),
+				ChainID:         swag.Int64(int64(tokenStats.ChainID)),
+				TotalDeposited:  swag.String(tokenStats.TotalDeposited.Text('f', -1)),
+				DepositCount:    swag.Int64(tokenStats.DepositCount),
+				TotalWithdrawn:  swag.String(tokenStats.TotalWithdrawn.Text('f', -1)),
+				WithdrawCount:   swag.Int64(tokenStats.WithdrawCount),
+				FirstActivityAt: toOptionalDateTime(tokenStats.FirstActivityAt),
+				LastActivityAt:  toOptionalDateTime(tokenStats.LastActivityAt),
+			})
+		}
+
+		response := &types.WalletStatsResponse{
+			DepositCount:    swag.Int64(userStats.DepositCount),
+			WithdrawCount:   swag.Int64(userStats.WithdrawCount),
+			FirstActivityAt: toOptionalDateTime(userStats.FirstActivityAt),
+			LastActivityAt:  toOptionalDateTime(userStats.LastActivityAt),
+			Tokens:          tokenItems,
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+// toOptionalDateTime 转换可选时间，nil 时返回 nil（序列化时省略）
+func toOptionalDateTime(t *time.Time) *strfmt.DateTime {
+	if t == nil {
+		return nil
+	}
+
+	dt := strfmt.DateTime(*t)
+	return &dt
+}
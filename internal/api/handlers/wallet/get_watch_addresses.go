@@ -0,0 +1,66 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/labstack/echo/v4"
+)
+
+func GetWatchAddressesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/watch-addresses", getWatchAddressesHandler(s))
+}
+
+func getWatchAddressesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get watch addresses")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view watch addresses",
+			)
+		}
+
+		params := walletTypes.NewGetWatchAddressesRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		var userID *string
+		if params.UserID != nil {
+			id := params.UserID.String()
+			userID = &id
+		}
+
+		wallets, err := s.Wallet.ListWatchAddresses(ctx, userID, util.Int64PtrToIntPtr(params.ChainID))
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get watch addresses")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get watch addresses")
+		}
+
+		items := make([]*types.WatchAddressItem, 0, len(wallets))
+		for _, w := range wallets {
+			items = append(items, w.ToWatchAddressItem())
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetWatchAddressesResponse{Addresses: items})
+	}
+}
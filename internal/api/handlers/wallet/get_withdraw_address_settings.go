@@ -0,0 +1,51 @@
+package wallet
+
+import (
+	"net/http"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/addressbook"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetWithdrawAddressSettingsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/withdraw-addresses/settings", getWithdrawAddressSettingsHandler(s))
+}
+
+func getWithdrawAddressSettingsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		settings, err := s.AddressBook.GetSettings(ctx, user.ID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get withdraw address settings")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get withdraw address settings")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, toWithdrawAddressSettings(settings, time.Now()))
+	}
+}
+
+func toWithdrawAddressSettings(settings *addressbook.Settings, now time.Time) *types.WithdrawAddressSettings {
+	item := &types.WithdrawAddressSettings{
+		WhitelistOnly: swag.Bool(settings.WhitelistOnly),
+		Enforced:      swag.Bool(settings.Enforced(now)),
+	}
+	if !settings.WhitelistOnly {
+		item.EnforcedUntil = toOptionalDateTime(settings.EnforcedUntil)
+	}
+
+	return item
+}
@@ -0,0 +1,64 @@
+package wallet
+
+import (
+	"net/http"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/addressbook"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetWithdrawAddressesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/withdraw-addresses", getWithdrawAddressesHandler(s))
+}
+
+func getWithdrawAddressesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		addresses, err := s.AddressBook.ListAddresses(ctx, user.ID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get withdraw addresses")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get withdraw addresses")
+		}
+
+		now := time.Now()
+		items := make([]*types.WithdrawAddress, 0, len(addresses))
+		for _, address := range addresses {
+			items = append(items, toWithdrawAddress(address, now))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetWithdrawAddressesResponse{WithdrawAddresses: items})
+	}
+}
+
+func toWithdrawAddress(address *addressbook.Address, now time.Time) *types.WithdrawAddress {
+	id := strfmt.UUID(address.ID)
+	createdAt := strfmt.DateTime(address.CreatedAt)
+
+	return &types.WithdrawAddress{
+		ID:                  &id,
+		ChainID:             swag.Int64(int64(address.ChainID)),
+		Address:             swag.String(address.Address),
+		Label:               address.Label,
+		Status:              swag.String(address.Status(now)),
+		ConfirmedAt:         toOptionalDateTime(address.ConfirmedAt),
+		AvailableAt:         toOptionalDateTime(address.AvailableAt),
+		OwnershipMessage:    addressbook.OwnershipMessage(address),
+		OwnershipVerifiedAt: toOptionalDateTime(address.OwnershipVerifiedAt),
+		CreatedAt:           &createdAt,
+	}
+}
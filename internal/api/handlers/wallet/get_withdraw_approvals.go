@@ -0,0 +1,87 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/withdraw"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetWithdrawApprovalsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/withdraw/:withdrawId/approvals", getWithdrawApprovalsHandler(s))
+}
+
+func getWithdrawApprovalsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get withdraw approvals")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can view withdraw approvals",
+			)
+		}
+
+		params := walletTypes.NewGetWithdrawApprovalsRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		withdrawID := params.WithdrawID.String()
+		approvals, err := s.Withdraw.GetWithdrawApprovals(ctx, withdrawID)
+		if err != nil {
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Str("withdraw_id", withdrawID).Msg("Failed to get withdraw approvals")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get withdraw approvals")
+		}
+
+		response := &types.GetWithdrawApprovalsResponse{
+			Approvals: toWithdrawApprovalItems(approvals),
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+// toWithdrawApprovalItems 将审批记录转换为 API 响应类型
+func toWithdrawApprovalItems(approvals []*withdraw.Approval) []*types.WithdrawApprovalItem {
+	items := make([]*types.WithdrawApprovalItem, 0, len(approvals))
+	for _, approval := range approvals {
+		id := strfmt.UUID(approval.ID)
+		withdrawID := strfmt.UUID(approval.WithdrawID)
+		adminUserID := strfmt.UUID(approval.AdminUserID)
+		createdAt := strfmt.DateTime(approval.CreatedAt)
+		items = append(items, &types.WithdrawApprovalItem{
+			ID:          &id,
+			WithdrawID:  &withdrawID,
+			AdminUserID: &adminUserID,
+			Decision:    swag.String(approval.Decision),
+			Reason:      approval.Reason,
+			CreatedAt:   &createdAt,
+		})
+	}
+
+	return items
+}
@@ -0,0 +1,106 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/risk"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetWithdrawLimitsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/withdraw-limits", getWithdrawLimitsHandler(s))
+}
+
+func getWithdrawLimitsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to get withdraw limits")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage withdraw limits",
+			)
+		}
+
+		params := walletTypes.NewGetWithdrawLimitsRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		filter := &risk.LimitFilter{
+			TokenID: util.Int64PtrToIntPtr(params.TokenID),
+		}
+		if params.UserID != nil {
+			filter.UserID = swag.String(params.UserID.String())
+		}
+		if params.OrgID != nil {
+			filter.OrgID = swag.String(params.OrgID.String())
+		}
+
+		limits, err := s.Risk.ListLimits(ctx, filter)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get withdraw limits")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get withdraw limits")
+		}
+
+		items := make([]*types.WithdrawLimit, 0, len(limits))
+		for _, limit := range limits {
+			items = append(items, toWithdrawLimit(limit))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.GetWithdrawLimitsResponse{Limits: items})
+	}
+}
+
+func toWithdrawLimit(limit *risk.Limit) *types.WithdrawLimit {
+	id := strfmt.UUID(limit.ID)
+	createdAt := strfmt.DateTime(limit.CreatedAt)
+	updatedAt := strfmt.DateTime(limit.UpdatedAt)
+
+	item := &types.WithdrawLimit{
+		ID:        &id,
+		TokenID:   swag.Int64(int64(limit.TokenID)),
+		Period:    swag.String(limit.Period),
+		MaxAmount: limit.MaxAmount,
+		Action:    swag.String(limit.Action),
+		CreatedAt: &createdAt,
+		UpdatedAt: &updatedAt,
+	}
+	if limit.UserID != nil {
+		userID := strfmt.UUID(*limit.UserID)
+		item.UserID = &userID
+	}
+	if limit.OrgID != nil {
+		orgID := strfmt.UUID(*limit.OrgID)
+		item.OrgID = &orgID
+	}
+	if limit.MaxCount != nil {
+		item.MaxCount = swag.Int64(int64(*limit.MaxCount))
+	}
+	if limit.CreatedBy != nil {
+		createdBy := strfmt.UUID(*limit.CreatedBy)
+		item.CreatedBy = &createdBy
+	}
+
+	return item
+}
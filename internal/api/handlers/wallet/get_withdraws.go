@@ -56,7 +56,10 @@ func getWithdrawsHandler(s *api.Server) echo.HandlerFunc {
 		}
 
 		if status != "" {
-			mods = append(mods, models.WithdrawWhere.Status.EQ(status))
+			if models.WithdrawStatus(status).IsValid() != nil {
+				return invalidStatusParamError()
+			}
+			mods = append(mods, models.WithdrawWhere.Status.EQ(models.WithdrawStatus(status)))
 		}
 
 		// 分页
@@ -82,20 +85,26 @@ func getWithdrawsHandler(s *api.Server) echo.HandlerFunc {
 			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get withdraws")
 		}
 
+		maintenances := activeMaintenances(ctx, s)
+		explorers := chainExplorers(ctx, s)
 		items := make([]*types.WithdrawItem, 0, len(withdraws))
 		for _, withdrawRecord := range withdraws {
 			id := strfmt.UUID(withdrawRecord.ID)
 			userID := strfmt.UUID(withdrawRecord.UserID)
 			createdAt := strfmt.DateTime(withdrawRecord.CreatedAt)
 			item := &types.WithdrawItem{
-				ID:        &id,
-				UserID:    &userID,
-				ToAddress: swag.String(withdrawRecord.ToAddress),
-				TokenID:   swag.Int64(int64(withdrawRecord.TokenID)),
-				Amount:    swag.String(withdrawRecord.Amount),
-				Fee:       withdrawRecord.Fee,
-				Status:    swag.String(withdrawRecord.Status),
-				CreatedAt: &createdAt,
+				ID:            &id,
+				UserID:        &userID,
+				ToAddress:     swag.String(withdrawRecord.ToAddress),
+				TokenID:       swag.Int64(int64(withdrawRecord.TokenID)),
+				Amount:        swag.String(withdrawRecord.Amount),
+				Fee:           withdrawRecord.Fee,
+				Status:        swag.String(withdrawRecord.Status.String()),
+				CreatedAt:     &createdAt,
+				ProcessingEta: toOptionalDateTime(s.Withdraw.GetProcessingETA(withdrawRecord)),
+				ExpiresAt:     toOptionalDateTime(s.Withdraw.GetRequestExpiry(withdrawRecord)),
+				ExplorerLinks: withdrawExplorerLinks(explorers, withdrawRecord),
+				Maintenance:   withdrawMaintenanceNotice(maintenances, withdrawRecord),
 			}
 			if withdrawRecord.TXHash.Valid {
 				item.TxHash = withdrawRecord.TXHash.String
@@ -0,0 +1,36 @@
+package wallet
+
+import (
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/auth"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetWithdrawsExportRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.GET("/withdraws/export", getWithdrawsExportHandler(s))
+}
+
+// getWithdrawsExportHandler 导出当前用户的提现，与提现列表接口的范围一致
+func getWithdrawsExportHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+
+		params := walletTypes.NewGetWithdrawsExportRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		filter := newExportFilter(params.ChainID, params.TokenID, params.Status, params.CreatedAfter, params.CreatedBefore)
+		filter.UserID = &user.ID
+
+		return streamExport(c, "withdraws", swag.StringValue(params.Format), filter, s.Export.ExportWithdraws)
+	}
+}
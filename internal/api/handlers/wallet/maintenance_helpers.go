@@ -0,0 +1,70 @@
+package wallet
+
+import (
+	"context"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/settings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// activeMaintenances 获取当前生效的维护模式，用于在等待处理的提现上附带维护通知；获取失败只记录日志，不影响响应
+func activeMaintenances(ctx context.Context, s *api.Server) *settings.ActiveMaintenances {
+	maintenances, err := s.Settings.GetActiveMaintenances(ctx)
+	if err != nil {
+		util.LogFromContext(ctx).Warn().Err(err).Msg("Failed to get maintenance settings, omitting maintenance notices")
+		return nil
+	}
+	return maintenances
+}
+
+// withdrawMaintenanceNotice 等待处理的提现所在链处于维护时返回维护通知，否则返回 nil
+func withdrawMaintenanceNotice(maintenances *settings.ActiveMaintenances, withdrawRecord *models.Withdraw) *types.MaintenanceNotice {
+	if withdrawRecord.Status != models.WithdrawStatusUserWithdrawRequest {
+		return nil
+	}
+
+	maintenance := maintenances.For(withdrawRecord.ChainID)
+	if maintenance == nil {
+		return nil
+	}
+
+	startsAt := strfmt.DateTime(maintenance.StartsAt)
+	notice := &types.MaintenanceNotice{
+		Message:  swag.String(maintenance.Message),
+		StartsAt: &startsAt,
+		EndsAt:   toOptionalDateTime(maintenance.EndsAt),
+	}
+	if maintenance.ChainID != nil {
+		notice.ChainID = swag.Int64(int64(*maintenance.ChainID))
+	}
+
+	return notice
+}
+
+func toMaintenanceSetting(maintenance *settings.Maintenance, active bool) *types.MaintenanceSetting {
+	startsAt := strfmt.DateTime(maintenance.StartsAt)
+	updatedAt := strfmt.DateTime(maintenance.UpdatedAt)
+
+	item := &types.MaintenanceSetting{
+		Active:    swag.Bool(active),
+		Message:   swag.String(maintenance.Message),
+		StartsAt:  &startsAt,
+		EndsAt:    toOptionalDateTime(maintenance.EndsAt),
+		UpdatedAt: &updatedAt,
+	}
+	if maintenance.ChainID != nil {
+		item.ChainID = swag.Int64(int64(*maintenance.ChainID))
+	}
+	if maintenance.UpdatedBy != nil {
+		updatedBy := strfmt.UUID(*maintenance.UpdatedBy)
+		item.UpdatedBy = &updatedBy
+	}
+
+	return item
+}
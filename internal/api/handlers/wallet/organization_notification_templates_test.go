@@ -0,0 +1,96 @@
+package wallet_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/test"
+	"github/chapool/go-wallet/internal/test/fixtures"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/wallet/notification"
+	"github/chapool/go-wallet/internal/wallet/organization"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationNotificationTemplates(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		s.Organization = organization.NewService(s.DB)
+		s.Notification = notification.NewService(s.DB, nil, nil)
+
+		org, err := s.Organization.CreateOrganization(ctx, "Acme")
+		require.NoError(t, err)
+		_, err = s.Organization.AddMember(ctx, org.ID, fix.User1.ID, organization.RoleAdmin)
+		require.NoError(t, err)
+
+		basePath := "/api/v1/wallet/organizations/" + org.ID
+		headers := test.HeadersWithAuth(t, fix.User1AccessToken1.Token)
+
+		// 不属于组织的普通用户不能管理组织模板
+		res := test.PerformRequest(t, s, "GET", basePath+"/notification-templates", nil, test.HeadersWithAuth(t, fix.User2AccessToken1.Token))
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "PUT", basePath+"/notification-branding", test.GenericPayload{
+			"sender_name": "Acme Pay",
+		}, headers)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "PUT", basePath+"/notification-templates", test.GenericPayload{
+			"event":  "large_withdraw",
+			"locale": "de",
+			"title":  "Große Auszahlung",
+			"body":   "{{ .senderName }}: {{ .amount }} {{ .tokenSymbol }}",
+		}, headers)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		var saved types.NotificationTemplate
+		test.ParseResponseAndValidate(t, res, &saved)
+		assert.Equal(t, "de", *saved.Locale)
+
+		// 无法解析的模板不保存
+		res = test.PerformRequest(t, s, "PUT", basePath+"/notification-templates", test.GenericPayload{
+			"event": "large_withdraw",
+			"title": "Large withdrawal",
+			"body":  "{{ .amount ",
+		}, headers)
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "POST", basePath+"/notification-templates/preview", test.GenericPayload{
+			"event":  "large_withdraw",
+			"locale": "de-AT",
+		}, headers)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		var preview types.NotificationTemplatePreview
+		test.ParseResponseAndValidate(t, res, &preview)
+		assert.Equal(t, notification.TemplateSourceOrganization, *preview.Source)
+		assert.Equal(t, "Große Auszahlung", *preview.Title)
+		assert.Equal(t, "Acme Pay: 1.5 USDT", *preview.Body)
+
+		res = test.PerformRequest(t, s, "GET", basePath+"/notification-templates", nil, headers)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		var list types.GetOrganizationNotificationTemplatesResponse
+		test.ParseResponseAndValidate(t, res, &list)
+		require.NotNil(t, list.Branding.SenderName)
+		assert.Equal(t, "Acme Pay", *list.Branding.SenderName)
+		require.Len(t, list.Templates, 1)
+		assert.Equal(t, saved.ID.String(), list.Templates[0].ID.String())
+
+		res = test.PerformRequest(t, s, "DELETE", basePath+"/notification-templates/"+saved.ID.String(), nil, headers)
+		assert.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+		res = test.PerformRequest(t, s, "DELETE", basePath+"/notification-templates/"+saved.ID.String(), nil, headers)
+		assert.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+
+		// 删除后预览系统模板
+		res = test.PerformRequest(t, s, "POST", basePath+"/notification-templates/preview", test.GenericPayload{
+			"event":  "large_withdraw",
+			"locale": "de-AT",
+		}, headers)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		test.ParseResponseAndValidate(t, res, &preview)
+		assert.Equal(t, notification.TemplateSourceSystem, *preview.Source)
+	})
+}
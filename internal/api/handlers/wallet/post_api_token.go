@@ -0,0 +1,75 @@
+package wallet
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/apitoken"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PostAPITokenRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.POST("/api-tokens", postAPITokenHandler(s))
+}
+
+func postAPITokenHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		var body types.PostUserAPITokenPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		req := &apitoken.CreateRequest{
+			UserID:            user.ID,
+			Name:              swag.StringValue(body.Name),
+			Scopes:            body.Scopes,
+			MaxWithdrawAmount: body.MaxWithdrawAmount,
+		}
+		if body.WithdrawTokenID != nil {
+			req.WithdrawTokenID = swag.Int(int(*body.WithdrawTokenID))
+		}
+		if body.RateLimitPerMinute != nil {
+			req.RateLimitPerMinute = swag.Int(int(*body.RateLimitPerMinute))
+		}
+		if body.ExpiresAt != nil {
+			expiresAt := time.Time(*body.ExpiresAt)
+			req.ExpiresAt = &expiresAt
+		}
+
+		token, plaintext, err := s.APIToken.CreateToken(ctx, req)
+		if err != nil {
+			if errors.Is(err, apitoken.ErrInvalidToken) {
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric,
+					strings.TrimSuffix(err.Error(), ": "+apitoken.ErrInvalidToken.Error()))
+			}
+			log.Error().Err(err).Msg("Failed to create API token")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to create API token")
+		}
+
+		log.Info().
+			Str("api_token_id", token.ID).
+			Strs("scopes", token.Scopes).
+			Msg("API token created")
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.PostUserAPITokenResponse{
+			APIToken: toUserAPIToken(token),
+			Token:    swag.String(plaintext),
+		})
+	}
+}
@@ -15,7 +15,7 @@ import (
 )
 
 func PostApproveWithdrawRoute(s *api.Server) *echo.Route {
-	return s.Router.APIV1Wallet.POST("/withdraw/:withdrawId/approve", postApproveWithdrawHandler(s))
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/withdraw/:withdrawId/approve", postApproveWithdrawHandler(s)))
 }
 
 func postApproveWithdrawHandler(s *api.Server) echo.HandlerFunc {
@@ -56,8 +56,11 @@ func postApproveWithdrawHandler(s *api.Server) echo.HandlerFunc {
 			)
 		}
 
-		withdrawRecord, err := s.Withdraw.ApproveWithdraw(ctx, withdrawID)
+		withdrawRecord, err := s.Withdraw.ApproveWithdraw(ctx, withdrawID, user.ID)
 		if err != nil {
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
 			log.Error().Err(err).Str("withdraw_id", withdrawID).Msg("Failed to approve withdraw")
 			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to approve withdraw request")
 		}
@@ -68,14 +71,18 @@ func postApproveWithdrawHandler(s *api.Server) echo.HandlerFunc {
 		createdAt := strfmt.DateTime(withdrawRecord.CreatedAt)
 		response := &types.WithdrawResponse{
 			Withdraw: &types.WithdrawItem{
-				ID:        &id,
-				UserID:    &userID,
-				ToAddress: swag.String(withdrawRecord.ToAddress),
-				TokenID:   swag.Int64(int64(withdrawRecord.TokenID)),
-				Amount:    swag.String(withdrawRecord.Amount),
-				Fee:       withdrawRecord.Fee,
-				Status:    swag.String(withdrawRecord.Status),
-				CreatedAt: &createdAt,
+				ID:            &id,
+				UserID:        &userID,
+				ToAddress:     swag.String(withdrawRecord.ToAddress),
+				TokenID:       swag.Int64(int64(withdrawRecord.TokenID)),
+				Amount:        swag.String(withdrawRecord.Amount),
+				Fee:           withdrawRecord.Fee,
+				Status:        swag.String(withdrawRecord.Status.String()),
+				CreatedAt:     &createdAt,
+				ProcessingEta: toOptionalDateTime(s.Withdraw.GetProcessingETA(withdrawRecord)),
+				ExpiresAt:     toOptionalDateTime(s.Withdraw.GetRequestExpiry(withdrawRecord)),
+				ExplorerLinks: withdrawExplorerLinks(chainExplorers(ctx, s), withdrawRecord),
+				Maintenance:   withdrawMaintenanceNotice(activeMaintenances(ctx, s), withdrawRecord),
 			},
 		}
 
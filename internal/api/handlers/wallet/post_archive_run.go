@@ -0,0 +1,93 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PostArchiveRunRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/archive/run", postArchiveRunHandler(s)))
+}
+
+func postArchiveRunHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to run archive")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can run archive",
+			)
+		}
+
+		var body types.PostArchiveRunPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		// 预览：只统计满足归档条件的记录数
+		if body.DryRun {
+			preview, err := s.Archive.Preview(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to preview archive")
+				return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to preview archive")
+			}
+
+			cutoff := strfmt.DateTime(preview.Cutoff)
+			response := &types.PostArchiveRunResponse{
+				DryRun:            swag.Bool(true),
+				Mode:              swag.String(preview.Mode),
+				Cutoff:            &cutoff,
+				BlocksCount:       swag.Int64(preview.Blocks),
+				TransactionsCount: swag.Int64(preview.Transactions),
+			}
+
+			return util.ValidateAndReturn(c, http.StatusOK, response)
+		}
+
+		run, err := s.Archive.Run(ctx, &user.ID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to run archive")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to run archive")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Int("run_id", run.ID).
+			Int64("blocks", run.Blocks).
+			Int64("transactions", run.Transactions).
+			Msg("Archive run by admin")
+
+		cutoff := strfmt.DateTime(run.Cutoff)
+		response := &types.PostArchiveRunResponse{
+			DryRun:            swag.Bool(false),
+			Mode:              swag.String(run.Mode),
+			Cutoff:            &cutoff,
+			BlocksCount:       swag.Int64(run.Blocks),
+			TransactionsCount: swag.Int64(run.Transactions),
+			Run:               convertArchiveRun(run),
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
@@ -0,0 +1,73 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PostBackfillRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/backfill", postBackfillHandler(s)))
+}
+
+func postBackfillHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to create backfill job")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can create backfill jobs",
+			)
+		}
+
+		var body types.PostBackfillPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		chainID := int(swag.Int64Value(body.ChainID))
+		job, err := s.Scan.CreateBackfillJob(ctx, chainID, swag.Int64Value(body.FromBlock), swag.Int64Value(body.ToBlock), &user.ID)
+		if err != nil {
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			switch err.Error() {
+			case "chain is not active":
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Chain is not active")
+			case "invalid block range":
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Invalid block range")
+			}
+			log.Error().Err(err).Int("chain_id", chainID).Msg("Failed to create backfill job")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to create backfill job")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Str("job_id", job.ID).
+			Int("chain_id", job.ChainID).
+			Int64("from_block", job.FromBlock).
+			Int64("to_block", job.ToBlock).
+			Msg("Backfill job requested")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toBackfillJob(job))
+	}
+}
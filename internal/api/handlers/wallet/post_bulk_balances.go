@@ -0,0 +1,86 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/balance"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PostBulkBalancesRoute(s *api.Server) *echo.Route {
+	return s.Router.InternalV1.POST("/balances/bulk", postBulkBalancesHandler(s))
+}
+
+func postBulkBalancesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostBulkBalancesPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		userIDs := make([]string, 0, len(body.UserIds))
+		for _, userID := range body.UserIds {
+			userIDs = append(userIDs, userID.String())
+		}
+
+		filter := &balance.BulkBalanceFilter{
+			ChainID: util.Int64PtrToIntPtr(body.ChainID),
+		}
+		for _, tokenID := range body.TokenIds {
+			filter.TokenIDs = append(filter.TokenIDs, int(tokenID))
+		}
+
+		users, err := s.Balance.GetBulkBalances(ctx, userIDs, filter)
+		if err != nil {
+			log.Error().Err(err).Int("user_count", len(userIDs)).Msg("Failed to get bulk balances")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get balances")
+		}
+
+		var tokenIDs []int
+		for _, user := range users {
+			for _, tokenBalance := range user.Balances {
+				tokenIDs = append(tokenIDs, tokenBalance.TokenID)
+			}
+		}
+		prices := getTokenPrices(ctx, s, tokenIDs)
+
+		response := &types.BulkBalancesResponse{
+			Users: make([]*types.BulkUserBalances, 0, len(users)),
+		}
+		for _, user := range users {
+			userID := strfmt.UUID(user.UserID)
+			item := &types.BulkUserBalances{
+				UserID:   &userID,
+				Balances: make([]*types.BulkTokenBalance, 0, len(user.Balances)),
+			}
+			for _, tokenBalance := range user.Balances {
+				balanceItem := &types.BulkTokenBalance{
+					ChainID:     swag.Int64(int64(tokenBalance.ChainID)),
+					TokenID:     swag.Int64(int64(tokenBalance.TokenID)),
+					TokenSymbol: swag.String(tokenBalance.TokenSymbol),
+					Available:   swag.String(tokenBalance.Available.Text('f', -1)),
+					Frozen:      swag.String(tokenBalance.Frozen.Text('f', -1)),
+				}
+				if tokenPrice, ok := prices[tokenBalance.TokenID]; ok {
+					balanceItem.UsdPrice = tokenPrice.PriceUSD
+					balanceItem.AvailableUsdValue = tokenPrice.ValueUSD(tokenBalance.Available)
+					balanceItem.FrozenUsdValue = tokenPrice.ValueUSD(tokenBalance.Frozen)
+				}
+				item.Balances = append(item.Balances, balanceItem)
+			}
+			response.Users = append(response.Users, item)
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
@@ -0,0 +1,67 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/labstack/echo/v4"
+)
+
+func PostCancelBackfillRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/backfill/:backfillId/cancel", postCancelBackfillHandler(s)))
+}
+
+func postCancelBackfillHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to cancel backfill job")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can cancel backfill jobs",
+			)
+		}
+
+		params := walletTypes.NewPostCancelBackfillRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		jobID := params.BackfillID.String()
+		job, err := s.Scan.CancelBackfillJob(ctx, jobID)
+		if err != nil {
+			switch err.Error() {
+			case "backfill job not found":
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Backfill job not found")
+			case "backfill job is already finished":
+				return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Backfill job is already finished")
+			}
+			log.Error().Err(err).Str("job_id", jobID).Msg("Failed to cancel backfill job")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to cancel backfill job")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Str("job_id", jobID).
+			Msg("Backfill job cancelled by admin")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toBackfillJob(job))
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github/chapool/go-wallet/internal/models"
 	"github/chapool/go-wallet/internal/types"
 	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/collect"
 
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/go-openapi/strfmt"
@@ -19,7 +20,7 @@ import (
 )
 
 func PostCollectRoute(s *api.Server) *echo.Route {
-	return s.Router.APIV1Wallet.POST("/collect", postCollectHandler(s))
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/collect", postCollectHandler(s)))
 }
 
 func postCollectHandler(s *api.Server) echo.HandlerFunc {
@@ -88,7 +89,7 @@ func postCollectHandler(s *api.Server) echo.HandlerFunc {
 			chainID := int(body.ChainID)
 			queryMods := []qm.QueryMod{
 				models.WalletWhere.ChainID.EQ(chainID),
-				models.WalletWhere.WalletType.EQ(string(auth.RoleUser)),
+				models.WalletWhere.WalletType.EQ(models.WalletTypeUser),
 			}
 			if user.Role != string(auth.RoleAdmin) {
 				queryMods = append(queryMods, models.WalletWhere.UserID.EQ(user.ID))
@@ -140,12 +141,20 @@ func postCollectHandler(s *api.Server) echo.HandlerFunc {
 		}
 
 		// 验证钱包类型（只允许用户钱包）
-		if wallet.WalletType != string(auth.RoleUser) {
+		if wallet.WalletType != models.WalletTypeUser {
 			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Only user wallets can be collected")
 		}
 
+		// 指定了代币、金额或目标热钱包时只归集该资产，同步返回交易哈希
+		if body.TokenID != nil || body.Amount != "" || !swag.IsZero(body.HotWalletID) {
+			return collectRequested(c, s, wallet, &body)
+		}
+
 		// 触发归集
 		if err := s.Collect.CollectWallet(ctx, wallet.ID); err != nil {
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
 			log.Error().Err(err).Str("wallet_id", wallet.ID).Msg("Failed to collect wallet")
 			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to collect funds")
 		}
@@ -159,3 +168,44 @@ func postCollectHandler(s *api.Server) echo.HandlerFunc {
 		return util.ValidateAndReturn(c, http.StatusOK, response)
 	}
 }
+
+// collectRequested 按指定的代币、金额和目标热钱包归集
+func collectRequested(c echo.Context, s *api.Server, wallet *models.Wallet, body *types.PostCollectPayload) error {
+	ctx := c.Request().Context()
+	log := util.LogFromContext(ctx)
+
+	result, err := s.Collect.Collect(ctx, &collect.Request{
+		WalletID:    wallet.ID,
+		TokenID:     util.Int64PtrToIntPtr(body.TokenID),
+		Amount:      body.Amount,
+		HotWalletID: body.HotWalletID.String(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, collect.ErrHotWalletNotFound):
+			return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Hot wallet not found on the chain of the wallet")
+		case errors.Is(err, collect.ErrUnsupportedChain):
+			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Collection is not supported on this chain")
+		case errors.Is(err, collect.ErrCollectInProgress):
+			return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Wallet is already being collected")
+		}
+		if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+			return httpErr
+		}
+		log.Error().Err(err).Str("wallet_id", wallet.ID).Msg("Failed to collect requested funds")
+		return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to collect funds")
+	}
+
+	message := "Collection completed successfully"
+	if result.Status != models.TransactionStatusConfirmed {
+		message = "Collection transaction failed on chain"
+	}
+
+	walletIDResponse := strfmt.UUID(wallet.ID)
+	return util.ValidateAndReturn(c, http.StatusOK, &types.CollectResponse{
+		Message:       swag.String(message),
+		WalletID:      &walletIDResponse,
+		TxHash:        result.TxHash,
+		ExplorerLinks: explorerLinks(chainExplorers(ctx, s), wallet.ChainID, result.TxHash, wallet.Address, ""),
+	})
+}
@@ -0,0 +1,53 @@
+package wallet
+
+import (
+	"net/http"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/addressbook"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PostConfirmWithdrawAddressRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.POST("/withdraw-addresses/confirm", postConfirmWithdrawAddressHandler(s))
+}
+
+func postConfirmWithdrawAddressHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		var body types.PostConfirmWithdrawAddressPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		address, err := s.AddressBook.ConfirmAddress(ctx, user.ID, swag.StringValue(body.Token))
+		if err != nil {
+			if errors.Is(err, addressbook.ErrInvalidConfirmationToken) {
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Invalid or expired confirmation token")
+			}
+			log.Error().Err(err).Msg("Failed to confirm withdraw address")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to confirm withdraw address")
+		}
+
+		log.Info().
+			Str("withdraw_address_id", address.ID).
+			Time("available_at", *address.AvailableAt).
+			Msg("Withdraw address confirmed")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toWithdrawAddress(address, time.Now()))
+	}
+}
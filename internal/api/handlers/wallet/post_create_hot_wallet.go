@@ -6,12 +6,14 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 	"github/chapool/go-wallet/internal/api"
 	"github/chapool/go-wallet/internal/api/httperrors"
 	"github/chapool/go-wallet/internal/auth"
 	"github/chapool/go-wallet/internal/models"
 	"github/chapool/go-wallet/internal/types"
 	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/organization"
 )
 
 const (
@@ -20,7 +22,7 @@ const (
 )
 
 func PostCreateHotWalletRoute(s *api.Server) *echo.Route {
-	return s.Router.APIV1Wallet.POST("/hot-wallet", postCreateHotWalletHandler(s))
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/hot-wallet", postCreateHotWalletHandler(s)))
 }
 
 func postCreateHotWalletHandler(s *api.Server) echo.HandlerFunc {
@@ -80,14 +82,31 @@ func postCreateHotWalletHandler(s *api.Server) echo.HandlerFunc {
 			)
 		}
 
+		// Hot wallets of an organization only send withdraws of and collect deposits from its members
+		var orgID *string
+		if body.OrgID != nil {
+			if _, err := s.Organization.GetOrganization(ctx, body.OrgID.String()); err != nil {
+				if errors.Is(err, organization.ErrOrganizationNotFound) {
+					return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Organization not found")
+				}
+				log.Error().Err(err).Msg("Failed to get organization")
+				return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to create hot wallet")
+			}
+			orgID = swag.String(body.OrgID.String())
+		}
+
 		// Create hot wallet
 		hotWallet, err := s.HotWallet.CreateHotWallet(
 			ctx,
 			user.ID,
 			int(swag.Int64Value(body.ChainID)),
 			swag.StringValue(body.DeviceName),
+			orgID,
 		)
 		if err != nil {
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
 			log.Error().Err(err).Msg("Failed to create hot wallet")
 			return httperrors.NewHTTPError(
 				http.StatusInternalServerError,
@@ -120,13 +139,17 @@ func postCreateHotWalletHandler(s *api.Server) echo.HandlerFunc {
 			ChainName:      swag.String(chainName),
 			DerivationPath: swag.String(hotWallet.DerivationPath),
 			AddressIndex:   swag.Int64(int64(hotWallet.AddressIndex)),
-			WalletType:     swag.String(hotWallet.WalletType),
+			WalletType:     swag.String(hotWallet.WalletType.String()),
 			CreatedAt:      &createdAt,
 		}
 
 		if hotWallet.DeviceName.Valid {
 			response.DeviceName = hotWallet.DeviceName.String
 		}
+		if hotWallet.OrgID.Valid {
+			responseOrgID := strfmt.UUID(hotWallet.OrgID.String)
+			response.OrgID = &responseOrgID
+		}
 
 		return util.ValidateAndReturn(c, http.StatusOK, response)
 	}
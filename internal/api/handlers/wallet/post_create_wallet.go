@@ -5,11 +5,13 @@ import (
 
 	"github.com/go-openapi/swag"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 	"github/chapool/go-wallet/internal/api"
 	"github/chapool/go-wallet/internal/api/httperrors"
 	"github/chapool/go-wallet/internal/auth"
 	"github/chapool/go-wallet/internal/types"
 	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
 )
 
 func PostCreateWalletRoute(s *api.Server) *echo.Route {
@@ -48,10 +50,10 @@ func postCreateWalletHandler(s *api.Server) echo.HandlerFunc {
 		wallet, err := s.Wallet.CreateWallet(ctx, user.ID, int(swag.Int64Value(body.ChainID)))
 		if err != nil {
 			log.Debug().Err(err).Msg("Failed to create wallet")
-			if err.Error() == "chain not found or inactive" {
+			if errors.Is(err, walleterrors.ErrChainNotFound) {
 				return httperrors.NewHTTPValidationError(
 					http.StatusBadRequest,
-					types.PublicHTTPErrorTypeGeneric,
+					types.PublicHTTPErrorTypeCHAINNOTFOUND,
 					"Chain not found or inactive",
 					[]*types.HTTPValidationErrorDetail{
 						{
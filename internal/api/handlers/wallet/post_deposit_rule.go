@@ -0,0 +1,104 @@
+package wallet
+
+import (
+	"net/http"
+	"strings"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/deposit"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PostDepositRuleRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/deposit-rule", postDepositRuleHandler(s)))
+}
+
+func postDepositRuleHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to create deposit rule")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage deposit rules",
+			)
+		}
+
+		var body types.DepositRulePayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		rule := fromDepositRulePayload(&body)
+		rule.CreatedBy = &user.ID
+
+		created, err := s.Deposit.CreateRule(ctx, rule)
+		if err != nil {
+			if errors.Is(err, deposit.ErrInvalidRule) {
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, depositRuleErrorMessage(err))
+			}
+			log.Error().Err(err).Msg("Failed to create deposit rule")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to create deposit rule")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Str("rule_id", created.ID).
+			Str("action", created.Action).
+			Int("priority", created.Priority).
+			Msg("Deposit rule created")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toDepositRule(created))
+	}
+}
+
+// fromDepositRulePayload 将请求体转换为充值规则
+func fromDepositRulePayload(body *types.DepositRulePayload) *deposit.Rule {
+	rule := &deposit.Rule{
+		Name:             swag.StringValue(body.Name),
+		Description:      body.Description,
+		Priority:         int(swag.Int64Value(body.Priority)),
+		IsActive:         swag.BoolValue(body.IsActive),
+		StopProcessing:   swag.BoolValue(body.StopProcessing),
+		ChainID:          util.Int64PtrToIntPtr(body.ChainID),
+		TokenID:          util.Int64PtrToIntPtr(body.TokenID),
+		FromAddresses:    body.FromAddresses,
+		MinAmount:        body.MinAmount,
+		MaxAmount:        body.MaxAmount,
+		SenderIsContract: body.SenderIsContract,
+		Action:           swag.StringValue(body.Action),
+		Tag:              body.Tag,
+		FeePercent:       body.FeePercent,
+	}
+	if body.UserID != nil {
+		rule.UserID = swag.String(body.UserID.String())
+	}
+	if body.FeeAccountUserID != nil {
+		rule.FeeAccountUserID = swag.String(body.FeeAccountUserID.String())
+	}
+
+	return rule
+}
+
+// depositRuleErrorMessage 返回规则校验错误的具体原因（去掉 ErrInvalidRule 后缀）
+func depositRuleErrorMessage(err error) string {
+	return strings.TrimSuffix(err.Error(), ": "+deposit.ErrInvalidRule.Error())
+}
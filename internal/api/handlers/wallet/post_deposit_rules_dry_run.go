@@ -0,0 +1,96 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/deposit"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PostDepositRulesDryRunRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.POST("/deposit-rules/dry-run", postDepositRulesDryRunHandler(s))
+}
+
+func postDepositRulesDryRunHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to dry-run deposit rules")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage deposit rules",
+			)
+		}
+
+		var body types.DepositRuleDryRunPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		input := &deposit.RuleInput{
+			ChainID:          int(swag.Int64Value(body.ChainID)),
+			TokenID:          int(swag.Int64Value(body.TokenID)),
+			FromAddress:      swag.StringValue(body.FromAddress),
+			Amount:           swag.StringValue(body.Amount),
+			SenderIsContract: body.SenderIsContract,
+		}
+		if body.UserID != nil {
+			input.UserID = body.UserID.String()
+		}
+
+		outcome, err := s.Deposit.EvaluateRules(ctx, input)
+		if err != nil {
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Int("chain_id", input.ChainID).Int("token_id", input.TokenID).Msg("Failed to dry-run deposit rules")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to evaluate deposit rules")
+		}
+
+		resp := &types.DepositRuleDryRunResponse{
+			Rejected:     swag.Bool(outcome.Rejected),
+			Tags:         make([]string, 0, len(outcome.Tags)),
+			MatchedRules: make([]*types.DepositRuleMatch, 0, len(outcome.Matches)),
+			FeeRoutes:    make([]*types.DepositRuleFeeRoute, 0, len(outcome.FeeRoutes)),
+		}
+		resp.Tags = append(resp.Tags, outcome.Tags...)
+		for _, match := range outcome.Matches {
+			ruleID := strfmt.UUID(match.RuleID)
+			resp.MatchedRules = append(resp.MatchedRules, &types.DepositRuleMatch{
+				RuleID: &ruleID,
+				Name:   swag.String(match.Name),
+				Action: swag.String(match.Action),
+			})
+		}
+		for _, route := range outcome.FeeRoutes {
+			ruleID := strfmt.UUID(route.RuleID)
+			accountUserID := strfmt.UUID(route.AccountUserID)
+			resp.FeeRoutes = append(resp.FeeRoutes, &types.DepositRuleFeeRoute{
+				RuleID:           &ruleID,
+				FeeAccountUserID: &accountUserID,
+				Amount:           swag.String(route.Amount),
+			})
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, resp)
+	}
+}
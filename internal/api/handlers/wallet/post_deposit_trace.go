@@ -0,0 +1,93 @@
+package wallet
+
+import (
+	"errors"
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/trace"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PostDepositTraceRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.POST("/deposits/trace", postDepositTraceHandler(s))
+}
+
+// postDepositTraceHandler 用户排查自己的一笔充值交易为什么没有到账
+func postDepositTraceHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		var body types.PostDepositTracePayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		result, err := s.DepositTrace.TraceDeposit(ctx, &trace.Request{
+			UserID:  user.ID,
+			ChainID: int(*body.ChainID),
+			TxHash:  *body.TxHash,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, trace.ErrInvalidTxHash):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Invalid transaction hash")
+			case errors.Is(err, trace.ErrUnsupportedChain):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Deposit trace is not supported on this chain")
+			case errors.Is(err, trace.ErrRateLimited):
+				log.Warn().Msg("Deposit trace request rate limited")
+				return httperrors.NewHTTPError(http.StatusTooManyRequests, types.PublicHTTPErrorTypeGeneric, "Too many deposit trace requests, please try again later")
+			}
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Int64("chain_id", *body.ChainID).Str("tx_hash", *body.TxHash).Msg("Failed to trace deposit")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to trace deposit")
+		}
+
+		chainID := int64(result.ChainID)
+		response := &types.DepositTraceResponse{
+			ChainID:               &chainID,
+			TxHash:                swag.String(result.TxHash),
+			Diagnosis:             swag.String(result.Diagnosis),
+			Found:                 swag.Bool(result.Found),
+			Pending:               swag.Bool(result.Pending),
+			Succeeded:             swag.Bool(result.Succeeded),
+			BlockNumber:           result.BlockNumber,
+			BlockHash:             result.BlockHash,
+			Confirmations:         swag.Int64(result.Confirmations),
+			RequiredConfirmations: swag.Int64(result.RequiredConfirmations),
+			FromAddress:           result.FromAddress,
+			ToAddress:             result.ToAddress,
+			Transfers:             make([]*types.DepositTraceTransfer, 0, len(result.Transfers)),
+			BlockScanned:          swag.Bool(result.BlockScanned),
+			ScannedTo:             result.ScannedTo,
+			Recorded:              swag.Bool(result.Recorded),
+			TransactionStatus:     result.TransactionStatus,
+			CreditStatus:          result.CreditStatus,
+		}
+		for _, transfer := range result.Transfers {
+			response.Transfers = append(response.Transfers, &types.DepositTraceTransfer{
+				ToAddress:    swag.String(transfer.ToAddress),
+				TokenAddress: transfer.TokenAddress,
+				TokenID:      util.IntPtrToInt64Ptr(transfer.TokenID),
+				TokenSymbol:  transfer.TokenSymbol,
+				Amount:       swag.String(transfer.Amount),
+				Supported:    swag.Bool(transfer.Supported),
+			})
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
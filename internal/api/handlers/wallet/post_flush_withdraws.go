@@ -0,0 +1,96 @@
+package wallet
+
+import (
+	"net/http"
+	"sort"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/withdraw"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PostFlushWithdrawsRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/withdraws/flush", postFlushWithdrawsHandler(s)))
+}
+
+func postFlushWithdrawsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to flush queued withdraws")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can flush queued withdraws",
+			)
+		}
+
+		var body types.PostFlushWithdrawsPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		filter := &withdraw.FlushFilter{}
+		if body.ChainID != nil {
+			chainID := int(*body.ChainID)
+			filter.ChainID = &chainID
+		}
+		if body.TokenID != nil {
+			tokenID := int(*body.TokenID)
+			filter.TokenID = &tokenID
+		}
+
+		result, err := s.Withdraw.FlushWithdraws(ctx, filter)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to flush queued withdraws")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to flush queued withdraws")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Int("processed", len(result.Processed)).
+			Int("failed", len(result.Failed)).
+			Int("deferred", len(result.Deferred)).
+			Msg("Queued withdraws flushed by admin")
+
+		failedIDs := make([]string, 0, len(result.Failed))
+		for withdrawID := range result.Failed {
+			failedIDs = append(failedIDs, withdrawID)
+		}
+		sort.Strings(failedIDs)
+
+		failed := make([]*types.FlushWithdrawFailure, 0, len(failedIDs))
+		for _, withdrawID := range failedIDs {
+			id := strfmt.UUID(withdrawID)
+			failed = append(failed, &types.FlushWithdrawFailure{
+				WithdrawID: &id,
+				Error:      swag.String(result.Failed[withdrawID]),
+			})
+		}
+
+		response := &types.PostFlushWithdrawsResponse{
+			Processed: result.Processed,
+			Failed:    failed,
+			Deferred:  result.Deferred,
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
@@ -0,0 +1,63 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/seed"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PostKeystoreLockRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/admin/keystore/lock", postKeystoreLockHandler(s)))
+}
+
+// postKeystoreLockHandler 锁定种子：从内存中清除种子，签名和地址派生暂停，直到管理员输入密码解锁
+func postKeystoreLockHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to lock keystore")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can lock the keystore",
+			)
+		}
+
+		// 远程签名模式下种子只在签名进程中，API 服务没有可锁定的种子
+		if s.KeystoreLock == nil {
+			return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "The keystore is managed by the remote signer")
+		}
+
+		s.KeystoreLock.Lock(ctx)
+		log.Warn().Str("admin_user_id", user.ID).Msg("Keystore locked by admin")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toKeystoreStatus(s.KeystoreLock.Status()))
+	}
+}
+
+func toKeystoreStatus(status seed.Status) *types.KeystoreStatus {
+	return &types.KeystoreStatus{
+		Locked:     swag.Bool(status.Locked),
+		UnlockedAt: toOptionalDateTime(status.UnlockedAt),
+		LockedAt:   toOptionalDateTime(status.LockedAt),
+		AutoLockAt: toOptionalDateTime(status.AutoLockAt),
+	}
+}
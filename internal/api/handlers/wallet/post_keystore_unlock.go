@@ -0,0 +1,68 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PostKeystoreUnlockRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.POST("/admin/keystore/unlock", postKeystoreUnlockHandler(s))
+}
+
+// postKeystoreUnlockHandler 使用 keystore 密码解锁种子，密码通过校验地址验证后才替换内存中的种子
+func postKeystoreUnlockHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to unlock keystore")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can unlock the keystore",
+			)
+		}
+
+		// 远程签名模式下种子只在签名进程中，API 服务没有可解锁的种子
+		if s.KeystoreLock == nil {
+			return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "The keystore is managed by the remote signer")
+		}
+
+		var body types.PostKeystoreUnlockPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		if err := s.KeystoreLock.Unlock(ctx, swag.StringValue(body.Password)); err != nil {
+			if errors.Is(err, wallet.ErrInvalidKeystorePassword) {
+				log.Warn().Str("admin_user_id", user.ID).Msg("Failed to unlock keystore: invalid password")
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Invalid keystore password")
+			}
+			log.Error().Err(err).Msg("Failed to unlock keystore")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to unlock keystore")
+		}
+
+		log.Info().Str("admin_user_id", user.ID).Msg("Keystore unlocked by admin")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toKeystoreStatus(s.KeystoreLock.Status()))
+	}
+}
@@ -0,0 +1,71 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/notification"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PostOrganizationNotificationTemplatePreviewRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.POST("/organizations/:orgId/notification-templates/preview", postOrganizationNotificationTemplatePreviewHandler(s))
+}
+
+func postOrganizationNotificationTemplatePreviewHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		params := walletTypes.NewPostOrganizationNotificationTemplatePreviewRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PostOrganizationNotificationTemplatePreviewPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if err := authorizeOrganizationAdmin(ctx, s, user, orgID); err != nil {
+			return err
+		}
+
+		preview, err := s.Notification.PreviewTemplate(ctx, orgID, &notification.Template{
+			OrgID:     orgID,
+			Event:     swag.StringValue(body.Event),
+			Locale:    body.Locale,
+			Title:     body.Title,
+			Body:      body.Body,
+			EmailHTML: body.EmailHTML,
+		})
+		if err != nil {
+			if errors.Is(err, notification.ErrInvalidTemplate) {
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, err.Error())
+			}
+			log.Error().Err(err).Str("org_id", orgID).Msg("Failed to preview notification template")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to preview notification template")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.NotificationTemplatePreview{
+			Source:    swag.String(preview.Source),
+			Locale:    preview.Locale,
+			Title:     swag.String(preview.Title),
+			Body:      swag.String(preview.Body),
+			EmailHTML: preview.EmailHTML,
+		})
+	}
+}
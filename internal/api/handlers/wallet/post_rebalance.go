@@ -8,6 +8,7 @@ import (
 	"github/chapool/go-wallet/internal/api"
 	"github/chapool/go-wallet/internal/api/httperrors"
 	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/money"
 	"github/chapool/go-wallet/internal/types"
 	"github/chapool/go-wallet/internal/util"
 	"github/chapool/go-wallet/internal/wallet/rebalance"
@@ -17,7 +18,7 @@ import (
 )
 
 func PostRebalanceRoute(s *api.Server) *echo.Route {
-	return s.Router.APIV1Wallet.POST("/rebalance", postRebalanceHandler(s))
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/rebalance", postRebalanceHandler(s)))
 }
 
 func postRebalanceHandler(s *api.Server) echo.HandlerFunc {
@@ -35,12 +36,11 @@ func postRebalanceHandler(s *api.Server) echo.HandlerFunc {
 		}
 
 		// 解析金额（wei）
-		//nolint:mnd // 10 and 256 are standard constants for big.ParseFloat
-		amountFloat, _, err := big.ParseFloat(*body.Amount, 10, 256, big.ToNearestEven)
+		amountFloat, err := money.ParseFloat(*body.Amount)
 		if err != nil {
 			return httperrors.NewHTTPValidationError(
 				http.StatusBadRequest,
-				types.PublicHTTPErrorTypeGeneric,
+				types.PublicHTTPErrorTypeINVALIDAMOUNT,
 				"Invalid amount format",
 				[]*types.HTTPValidationErrorDetail{
 					{
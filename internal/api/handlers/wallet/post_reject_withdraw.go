@@ -15,7 +15,7 @@ import (
 )
 
 func PostRejectWithdrawRoute(s *api.Server) *echo.Route {
-	return s.Router.APIV1Wallet.POST("/withdraw/:withdrawId/reject", postRejectWithdrawHandler(s))
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/withdraw/:withdrawId/reject", postRejectWithdrawHandler(s)))
 }
 
 func postRejectWithdrawHandler(s *api.Server) echo.HandlerFunc {
@@ -68,8 +68,11 @@ func postRejectWithdrawHandler(s *api.Server) echo.HandlerFunc {
 			}
 		}
 
-		withdrawRecord, err := s.Withdraw.RejectWithdraw(ctx, withdrawID, reason)
+		withdrawRecord, err := s.Withdraw.RejectWithdraw(ctx, withdrawID, user.ID, reason)
 		if err != nil {
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
 			log.Error().Err(err).Str("withdraw_id", withdrawID).Msg("Failed to reject withdraw")
 			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to reject withdraw request")
 		}
@@ -80,14 +83,17 @@ func postRejectWithdrawHandler(s *api.Server) echo.HandlerFunc {
 		createdAt := strfmt.DateTime(withdrawRecord.CreatedAt)
 		response := &types.WithdrawResponse{
 			Withdraw: &types.WithdrawItem{
-				ID:        &id,
-				UserID:    &userID,
-				ToAddress: swag.String(withdrawRecord.ToAddress),
-				TokenID:   swag.Int64(int64(withdrawRecord.TokenID)),
-				Amount:    swag.String(withdrawRecord.Amount),
-				Fee:       withdrawRecord.Fee,
-				Status:    swag.String(withdrawRecord.Status),
-				CreatedAt: &createdAt,
+				ID:            &id,
+				UserID:        &userID,
+				ToAddress:     swag.String(withdrawRecord.ToAddress),
+				TokenID:       swag.Int64(int64(withdrawRecord.TokenID)),
+				Amount:        swag.String(withdrawRecord.Amount),
+				Fee:           withdrawRecord.Fee,
+				Status:        swag.String(withdrawRecord.Status.String()),
+				CreatedAt:     &createdAt,
+				ProcessingEta: toOptionalDateTime(s.Withdraw.GetProcessingETA(withdrawRecord)),
+				ExpiresAt:     toOptionalDateTime(s.Withdraw.GetRequestExpiry(withdrawRecord)),
+				ExplorerLinks: withdrawExplorerLinks(chainExplorers(ctx, s), withdrawRecord),
 			},
 		}
 
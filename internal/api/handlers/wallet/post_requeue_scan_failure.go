@@ -0,0 +1,67 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/labstack/echo/v4"
+)
+
+func PostRequeueScanFailureRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/scan-failure/:failureId/requeue", postRequeueScanFailureHandler(s)))
+}
+
+func postRequeueScanFailureHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to requeue scan failure")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can requeue scan failures",
+			)
+		}
+
+		params := walletTypes.NewPostRequeueScanFailureRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		failureID := params.FailureID.String()
+		failure, err := s.Scan.RequeueScanFailure(ctx, failureID)
+		if err != nil {
+			switch err.Error() {
+			case "scan failure not found":
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Scan failure not found")
+			case "scan failure is already resolved":
+				return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Scan failure is already resolved")
+			}
+			log.Error().Err(err).Str("failure_id", failureID).Msg("Failed to requeue scan failure")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to requeue scan failure")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Str("failure_id", failureID).
+			Msg("Scan failure requeued by admin")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toScanFailure(failure))
+	}
+}
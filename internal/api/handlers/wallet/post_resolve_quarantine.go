@@ -0,0 +1,86 @@
+package wallet
+
+import (
+	"net/http"
+	"strings"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/quarantine"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PostResolveQuarantineRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/quarantine/:caseId/resolve", postResolveQuarantineHandler(s)))
+}
+
+func postResolveQuarantineHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to resolve quarantine case")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage quarantined deposits",
+			)
+		}
+
+		params := walletTypes.NewPostResolveQuarantineRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.ResolveQuarantinePayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		caseID := params.CaseID.String()
+		resolved, err := s.Quarantine.Resolve(ctx, &quarantine.ResolveRequest{
+			CaseID:       caseID,
+			AdminUserID:  user.ID,
+			Action:       swag.StringValue(body.Action),
+			Note:         body.Note,
+			ReturnTxHash: body.ReturnTxHash,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, quarantine.ErrCaseNotFound):
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Quarantine case not found")
+			case errors.Is(err, quarantine.ErrInvalidTransition):
+				return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, strings.TrimSuffix(err.Error(), ": "+quarantine.ErrInvalidTransition.Error()))
+			case errors.Is(err, quarantine.ErrInvalidRequest):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, strings.TrimSuffix(err.Error(), ": "+quarantine.ErrInvalidRequest.Error()))
+			}
+			log.Error().Err(err).Str("case_id", caseID).Msg("Failed to resolve quarantine case")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to resolve quarantine case")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Str("case_id", caseID).
+			Str("action", swag.StringValue(body.Action)).
+			Str("status", resolved.Status).
+			Msg("Quarantine case resolved")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toQuarantineCase(resolved))
+	}
+}
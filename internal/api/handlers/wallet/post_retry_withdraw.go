@@ -0,0 +1,86 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PostRetryWithdrawRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/withdraw/:withdrawId/retry", postRetryWithdrawHandler(s)))
+}
+
+func postRetryWithdrawHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to retry withdraw")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can retry withdraws",
+			)
+		}
+
+		params := walletTypes.NewPostRetryWithdrawRouteParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+		withdrawID := params.WithdrawID.String()
+
+		withdrawRecord, err := s.Withdraw.RetryWithdraw(ctx, withdrawID, user.ID, swag.BoolValue(params.ConfirmNotSigned))
+		if err != nil {
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Str("withdraw_id", withdrawID).Msg("Failed to retry withdraw")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to retry withdraw")
+		}
+
+		// 构建响应
+		id := strfmt.UUID(withdrawRecord.ID)
+		userID := strfmt.UUID(withdrawRecord.UserID)
+		createdAt := strfmt.DateTime(withdrawRecord.CreatedAt)
+		response := &types.WithdrawResponse{
+			Withdraw: &types.WithdrawItem{
+				ID:            &id,
+				UserID:        &userID,
+				ToAddress:     swag.String(withdrawRecord.ToAddress),
+				TokenID:       swag.Int64(int64(withdrawRecord.TokenID)),
+				Amount:        swag.String(withdrawRecord.Amount),
+				Fee:           withdrawRecord.Fee,
+				Status:        swag.String(withdrawRecord.Status.String()),
+				CreatedAt:     &createdAt,
+				ProcessingEta: toOptionalDateTime(s.Withdraw.GetProcessingETA(withdrawRecord)),
+				ExpiresAt:     toOptionalDateTime(s.Withdraw.GetRequestExpiry(withdrawRecord)),
+				ExplorerLinks: withdrawExplorerLinks(chainExplorers(ctx, s), withdrawRecord),
+				Maintenance:   withdrawMaintenanceNotice(activeMaintenances(ctx, s), withdrawRecord),
+			},
+		}
+
+		if withdrawRecord.TXHash.Valid {
+			response.Withdraw.TxHash = withdrawRecord.TXHash.String
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
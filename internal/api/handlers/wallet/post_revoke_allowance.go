@@ -0,0 +1,69 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/allowance"
+	"github/chapool/go-wallet/internal/wallet/gasguard"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PostRevokeAllowanceRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/allowance/:allowanceId/revoke", postRevokeAllowanceHandler(s)))
+}
+
+func postRevokeAllowanceHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to revoke allowance")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can revoke allowances",
+			)
+		}
+
+		params := walletTypes.NewPostRevokeAllowanceRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		allowanceID := params.AllowanceID.String()
+		revoked, err := s.Allowance.RevokeAllowance(ctx, allowanceID, user.ID)
+		if err != nil {
+			switch {
+			case errors.Is(err, allowance.ErrAllowanceNotFound):
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Allowance not found")
+			case errors.Is(err, allowance.ErrAllowanceRevoked):
+				return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Allowance is already revoked")
+			case errors.Is(err, allowance.ErrRevokePending):
+				return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Revoke transaction of the allowance is still pending")
+			case gasguard.IsCapExceeded(err):
+				return httperrors.NewHTTPError(http.StatusServiceUnavailable, types.PublicHTTPErrorTypeGeneric, "Gas price exceeds the cap of the chain, please try again later")
+			}
+			log.Error().Err(err).Str("allowance_id", allowanceID).Msg("Failed to revoke allowance")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to revoke allowance")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, convertAllowance(revoked))
+	}
+}
@@ -0,0 +1,90 @@
+package wallet
+
+import (
+	"net/http"
+	"strings"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/quarantine"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PostScreeningAddressRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/screening-address", postScreeningAddressHandler(s)))
+}
+
+func postScreeningAddressHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to add screening address")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage the screening list",
+			)
+		}
+
+		var body types.ScreeningAddressPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		created, err := s.Quarantine.AddScreeningAddress(ctx, &quarantine.ScreeningAddress{
+			ChainType: swag.StringValue(body.ChainType),
+			Address:   swag.StringValue(body.Address),
+			Source:    swag.StringValue(body.Source),
+			Reason:    body.Reason,
+			CreatedBy: &user.ID,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, quarantine.ErrScreeningAddressExists):
+				return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Address is already on the screening list")
+			case errors.Is(err, quarantine.ErrInvalidRequest):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, strings.TrimSuffix(err.Error(), ": "+quarantine.ErrInvalidRequest.Error()))
+			}
+			log.Error().Err(err).Msg("Failed to add screening address")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to add screening address")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Str("screening_address_id", created.ID).
+			Str("chain_type", created.ChainType).
+			Str("address", created.Address).
+			Str("source", created.Source).
+			Msg("Screening address added")
+
+		id := strfmt.UUID(created.ID)
+		createdAt := strfmt.DateTime(created.CreatedAt)
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.ScreeningAddress{
+			ID:        &id,
+			ChainType: swag.String(created.ChainType),
+			Address:   swag.String(created.Address),
+			Source:    swag.String(created.Source),
+			Reason:    created.Reason,
+			CreatedBy: toOptionalUUID(created.CreatedBy),
+			CreatedAt: &createdAt,
+		})
+	}
+}
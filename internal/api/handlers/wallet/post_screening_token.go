@@ -0,0 +1,94 @@
+package wallet
+
+import (
+	"net/http"
+	"strings"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/quarantine"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PostScreeningTokenRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/screening-token", postScreeningTokenHandler(s)))
+}
+
+func postScreeningTokenHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to add screening token")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage the screening list",
+			)
+		}
+
+		var body types.ScreeningTokenPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		created, err := s.Quarantine.AddScreeningToken(ctx, &quarantine.ScreeningToken{
+			TokenID:   int(swag.Int64Value(body.TokenID)),
+			Source:    swag.StringValue(body.Source),
+			Reason:    body.Reason,
+			CreatedBy: &user.ID,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, quarantine.ErrScreeningTokenExists):
+				return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Token is already on the screening list")
+			case errors.Is(err, quarantine.ErrInvalidRequest):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, strings.TrimSuffix(err.Error(), ": "+quarantine.ErrInvalidRequest.Error()))
+			}
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Msg("Failed to add screening token")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to add screening token")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Str("screening_token_id", created.ID).
+			Int("token_id", created.TokenID).
+			Int("chain_id", created.ChainID).
+			Str("token_symbol", created.TokenSymbol).
+			Str("source", created.Source).
+			Msg("Screening token added")
+
+		id := strfmt.UUID(created.ID)
+		createdAt := strfmt.DateTime(created.CreatedAt)
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.ScreeningToken{
+			ID:          &id,
+			TokenID:     swag.Int64(int64(created.TokenID)),
+			ChainID:     swag.Int64(int64(created.ChainID)),
+			TokenSymbol: swag.String(created.TokenSymbol),
+			Source:      swag.String(created.Source),
+			Reason:      created.Reason,
+			CreatedBy:   toOptionalUUID(created.CreatedBy),
+			CreatedAt:   &createdAt,
+		})
+	}
+}
@@ -36,8 +36,8 @@ func postSignTransactionHandler(s *api.Server) echo.HandlerFunc {
 		walletResult, err := s.Wallet.GetWalletByAddress(ctx, swag.StringValue(body.FromAddress), int(swag.Int64Value(body.ChainID)))
 		if err != nil {
 			log.Debug().Err(err).Msg("Failed to get wallet by address")
-			if err.Error() == "wallet not found" {
-				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Wallet not found")
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
 			}
 			return err
 		}
@@ -0,0 +1,96 @@
+package wallet
+
+import (
+	"net/http"
+	"strings"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/token"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PostTokenRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/token", postTokenHandler(s)))
+}
+
+func postTokenHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to register token")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage tokens",
+			)
+		}
+
+		var body types.PostTokenPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		// 未指定时默认启用
+		isActive := true
+		if body.IsActive != nil {
+			isActive = *body.IsActive
+		}
+
+		created, err := s.Token.CreateToken(ctx, &token.CreateRequest{
+			ChainID:           int(swag.Int64Value(body.ChainID)),
+			TokenAddress:      swag.StringValue(body.TokenAddress),
+			IsActive:          isActive,
+			WithdrawFee:       body.WithdrawFee,
+			MinWithdrawAmount: body.MinWithdrawAmount,
+			MinTransferAmount: body.MinTransferAmount,
+			MinDepositAmount:  body.MinDepositAmount,
+			IsWrappedNative:   swag.BoolValue(body.IsWrappedNative),
+			CreditAsNative:    swag.BoolValue(body.CreditAsNative),
+			FeeOnTransfer:     swag.BoolValue(body.FeeOnTransfer),
+			SupportsPermit:    swag.BoolValue(body.SupportsPermit),
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, token.ErrInvalidToken):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, tokenErrorMessage(err))
+			case errors.Is(err, token.ErrTokenExists):
+				return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Token is already registered on this chain")
+			}
+			log.Error().Err(err).Msg("Failed to register token")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to register token")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Int("token_id", created.ID).
+			Int("chain_id", created.ChainID).
+			Str("token_addr", created.TokenAddress.String).
+			Str("token_symbol", created.TokenSymbol).
+			Int("decimals", created.Decimals).
+			Msg("Token registered")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toAdminToken(created))
+	}
+}
+
+// tokenErrorMessage 返回代币校验错误的具体原因（去掉 ErrInvalidToken 后缀）
+func tokenErrorMessage(err error) string {
+	return strings.TrimSuffix(err.Error(), ": "+token.ErrInvalidToken.Error())
+}
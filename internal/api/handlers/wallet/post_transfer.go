@@ -0,0 +1,113 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/api/middleware"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/transfer"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PostTransferRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.POST("/transfer", postTransferHandler(s), middleware.Idempotency())
+}
+
+func postTransferHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		idempotencyKey := util.IdempotencyKeyFromContext(ctx)
+		if idempotencyKey == "" {
+			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Idempotency-Key header is required")
+		}
+
+		var body types.PostTransferPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		amount, err := money.ParseFloat(*body.Amount)
+		if err != nil || amount.Sign() <= 0 {
+			return httperrors.NewHTTPValidationError(
+				http.StatusBadRequest,
+				types.PublicHTTPErrorTypeINVALIDAMOUNT,
+				"Invalid amount format",
+				[]*types.HTTPValidationErrorDetail{
+					{
+						Key:   swag.String("amount"),
+						In:    swag.String("body"),
+						Error: swag.String("must be a positive number"),
+					},
+				},
+			)
+		}
+
+		result, err := s.Transfer.Transfer(ctx, user.ID, &transfer.Request{
+			ToUserID:       body.ToUserID.String(),
+			TokenID:        int(*body.TokenID),
+			Amount:         amount,
+			Memo:           body.Memo,
+			IdempotencyKey: idempotencyKey,
+		})
+		if err != nil {
+			if httpErr := transferHTTPError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Msg("Failed to transfer")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to process transfer")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, toInternalTransfer(result))
+	}
+}
+
+// transferHTTPError 将内部转账的业务错误转换为 HTTP 错误，其他错误返回 nil
+func transferHTTPError(err error) error {
+	switch {
+	case errors.Is(err, transfer.ErrSameUser):
+		return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Cannot transfer to yourself")
+	case errors.Is(err, transfer.ErrRecipientNotFound):
+		return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Recipient not found")
+	}
+
+	// 金额、余额、代币等错误按钱包错误分类返回
+	if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+		return httpErr
+	}
+
+	return nil
+}
+
+func toInternalTransfer(result *transfer.Transfer) *types.InternalTransfer {
+	id := strfmt.UUID(result.ID)
+	fromUserID := strfmt.UUID(result.FromUserID)
+	toUserID := strfmt.UUID(result.ToUserID)
+	createdAt := strfmt.DateTime(result.CreatedAt)
+
+	return &types.InternalTransfer{
+		ID:          &id,
+		FromUserID:  &fromUserID,
+		ToUserID:    &toUserID,
+		TokenID:     swag.Int64(int64(result.TokenID)),
+		TokenSymbol: swag.String(result.TokenSymbol),
+		ChainID:     swag.Int64(int64(result.ChainID)),
+		Amount:      swag.String(result.Amount),
+		Memo:        result.Memo,
+		CreatedAt:   &createdAt,
+	}
+}
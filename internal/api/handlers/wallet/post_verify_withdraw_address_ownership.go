@@ -0,0 +1,79 @@
+package wallet
+
+import (
+	"net/http"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/addressbook"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PostVerifyWithdrawAddressOwnershipRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.POST("/withdraw-address/:addressId/ownership", postVerifyWithdrawAddressOwnershipHandler(s))
+}
+
+func postVerifyWithdrawAddressOwnershipHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		params := walletTypes.NewPostVerifyWithdrawAddressOwnershipRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PostVerifyWithdrawAddressOwnershipPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		signature, err := hexutil.Decode(swag.StringValue(body.Signature))
+		if err != nil {
+			return httperrors.NewHTTPValidationError(
+				http.StatusBadRequest,
+				types.PublicHTTPErrorTypeGeneric,
+				"Invalid signature",
+				[]*types.HTTPValidationErrorDetail{
+					{
+						Key:   swag.String("signature"),
+						In:    swag.String("body"),
+						Error: swag.String("must be a 0x prefixed hex string"),
+					},
+				},
+			)
+		}
+
+		addressID := params.AddressID.String()
+		address, err := s.AddressBook.VerifyOwnership(ctx, user.ID, addressID, signature)
+		if err != nil {
+			switch {
+			case errors.Is(err, addressbook.ErrAddressNotFound):
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Withdraw address not found")
+			case errors.Is(err, addressbook.ErrInvalidOwnershipSignature):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Signature does not prove ownership of the withdraw address")
+			case errors.Is(err, addressbook.ErrInvalidAddress):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Ownership verification is only supported for EVM addresses")
+			}
+			log.Error().Err(err).Str("withdraw_address_id", addressID).Msg("Failed to verify withdraw address ownership")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to verify withdraw address ownership")
+		}
+
+		log.Info().Str("withdraw_address_id", addressID).Msg("Withdraw address ownership verified")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toWithdrawAddress(address, time.Now()))
+	}
+}
@@ -0,0 +1,75 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PostWatchAddressRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.POST("/watch-address", postWatchAddressHandler(s)))
+}
+
+func postWatchAddressHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to register watch address")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage watch addresses",
+			)
+		}
+
+		var body types.PostWatchAddressPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		watchWallet, err := s.Wallet.RegisterWatchAddress(ctx, body.UserID.String(), int(swag.Int64Value(body.ChainID)), swag.StringValue(body.Address))
+		if err != nil {
+			switch {
+			case errors.Is(err, wallet.ErrInvalidWatchAddress):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Invalid address")
+			case errors.Is(err, wallet.ErrWatchAddressUserNotFound):
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "User not found")
+			case errors.Is(err, wallet.ErrWatchAddressChainInvalid):
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Chain not found or inactive")
+			case errors.Is(err, wallet.ErrWatchAddressExists):
+				return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Address is already registered on this chain")
+			}
+			log.Error().Err(err).Msg("Failed to register watch address")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to register watch address")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Str("wallet_id", watchWallet.ID).
+			Str("user_id", watchWallet.UserID).
+			Int("chain_id", watchWallet.ChainID).
+			Str("address", watchWallet.Address).
+			Msg("Watch address registered")
+
+		return util.ValidateAndReturn(c, http.StatusOK, watchWallet.ToWatchAddressItem())
+	}
+}
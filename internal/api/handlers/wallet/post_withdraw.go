@@ -6,18 +6,24 @@ import (
 
 	"github/chapool/go-wallet/internal/api"
 	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/api/middleware"
 	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/money"
 	"github/chapool/go-wallet/internal/types"
 	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/risk"
+	"github/chapool/go-wallet/internal/wallet/transfer"
 	"github/chapool/go-wallet/internal/wallet/withdraw"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 )
 
 func PostWithdrawRoute(s *api.Server) *echo.Route {
-	return s.Router.APIV1Wallet.POST("/withdraw", postWithdrawHandler(s))
+	return s.Router.APIV1Wallet.POST("/withdraw", postWithdrawHandler(s), middleware.Idempotency())
 }
 
 func postWithdrawHandler(s *api.Server) echo.HandlerFunc {
@@ -34,12 +40,11 @@ func postWithdrawHandler(s *api.Server) echo.HandlerFunc {
 			return err
 		}
 
-		//nolint:mnd // 10 and 256 are standard constants for big.ParseFloat
-		amount, _, err := big.ParseFloat(*body.Amount, 10, 256, big.ToNearestEven)
+		amount, err := money.ParseFloat(*body.Amount)
 		if err != nil {
 			return httperrors.NewHTTPValidationError(
 				http.StatusBadRequest,
-				types.PublicHTTPErrorTypeGeneric,
+				types.PublicHTTPErrorTypeINVALIDAMOUNT,
 				"Invalid amount format",
 				[]*types.HTTPValidationErrorDetail{
 					{
@@ -51,14 +56,41 @@ func postWithdrawHandler(s *api.Server) echo.HandlerFunc {
 			)
 		}
 
+		// API Token 可以限制提现代币和单笔提现金额
+		if apiToken := auth.APITokenFromContext(ctx); apiToken != nil {
+			if err := checkAPITokenWithdraw(apiToken, int(*body.TokenID), amount); err != nil {
+				log.Warn().Str("api_token_id", apiToken.ID).Err(err).Msg("Withdraw request exceeds API token permissions")
+				return err
+			}
+		}
+
 		req := &withdraw.Request{
-			ToAddress: *body.ToAddress,
-			TokenID:   int(*body.TokenID),
-			Amount:    amount,
+			ToAddress:      *body.ToAddress,
+			TokenID:        int(*body.TokenID),
+			Amount:         amount,
+			IdempotencyKey: util.IdempotencyKeyFromContext(ctx),
 		}
 
 		withdrawRecord, err := s.Withdraw.RequestWithdraw(ctx, user.ID, req)
 		if err != nil {
+			if errors.Is(err, withdraw.ErrRateLimited) {
+				log.Warn().Msg("Withdraw request rate limited")
+			}
+			var addrErr *withdraw.AddressError
+			if errors.As(err, &addrErr) {
+				if addrErr.ConvertToTransfer {
+					return convertWithdrawToTransfer(c, s, user.ID, req, addrErr.RecipientUserID)
+				}
+				log.Warn().Str("reason", addrErr.Reason).Str("to_address", req.ToAddress).Msg("Withdraw request to invalid address")
+				return httperrors.NewInvalidWithdrawAddressError(addrErr.Reason, addrErr.Message)
+			}
+			var limitErr *risk.LimitExceededError
+			if errors.As(err, &limitErr) {
+				log.Warn().Str("limit_id", limitErr.Violation.Limit.ID).Msg("Withdraw request exceeds withdraw limit")
+			}
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
 			log.Error().Err(err).Msg("Failed to request withdraw")
 			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to process withdraw request")
 		}
@@ -72,14 +104,18 @@ func postWithdrawHandler(s *api.Server) echo.HandlerFunc {
 		createdAt := strfmt.DateTime(withdrawRecord.CreatedAt)
 		response := &types.WithdrawResponse{
 			Withdraw: &types.WithdrawItem{
-				ID:        &id,
-				UserID:    &userID,
-				ToAddress: swag.String(withdrawRecord.ToAddress),
-				TokenID:   swag.Int64(int64(withdrawRecord.TokenID)),
-				Amount:    swag.String(withdrawRecord.Amount),
-				Fee:       withdrawRecord.Fee,
-				Status:    swag.String(withdrawRecord.Status),
-				CreatedAt: &createdAt,
+				ID:            &id,
+				UserID:        &userID,
+				ToAddress:     swag.String(withdrawRecord.ToAddress),
+				TokenID:       swag.Int64(int64(withdrawRecord.TokenID)),
+				Amount:        swag.String(withdrawRecord.Amount),
+				Fee:           withdrawRecord.Fee,
+				Status:        swag.String(withdrawRecord.Status.String()),
+				CreatedAt:     &createdAt,
+				ProcessingEta: toOptionalDateTime(s.Withdraw.GetProcessingETA(withdrawRecord)),
+				ExpiresAt:     toOptionalDateTime(s.Withdraw.GetRequestExpiry(withdrawRecord)),
+				ExplorerLinks: withdrawExplorerLinks(chainExplorers(ctx, s), withdrawRecord),
+				Maintenance:   withdrawMaintenanceNotice(activeMaintenances(ctx, s), withdrawRecord),
 			},
 		}
 
@@ -90,3 +126,58 @@ func postWithdrawHandler(s *api.Server) echo.HandlerFunc {
 		return util.ValidateAndReturn(c, http.StatusOK, response)
 	}
 }
+
+// convertWithdrawToTransfer 提现地址属于平台其他用户时改为内部转账，不产生链上交易
+// 未提供幂等键时生成一个，客户端重试不会去重（与未提供幂等键的提现一致）
+func convertWithdrawToTransfer(c echo.Context, s *api.Server, userID string, req *withdraw.Request, recipientUserID string) error {
+	ctx := c.Request().Context()
+	log := util.LogFromContext(ctx)
+
+	idempotencyKey := req.IdempotencyKey
+	if idempotencyKey == "" {
+		idempotencyKey = uuid.NewString()
+	}
+
+	result, err := s.Transfer.Transfer(ctx, userID, &transfer.Request{
+		ToUserID:       recipientUserID,
+		TokenID:        req.TokenID,
+		Amount:         req.Amount,
+		IdempotencyKey: idempotencyKey,
+	})
+	if err != nil {
+		if httpErr := transferHTTPError(err); httpErr != nil {
+			return httpErr
+		}
+		log.Error().Err(err).Msg("Failed to convert withdraw to internal transfer")
+		return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to process withdraw request")
+	}
+
+	log.Info().
+		Str("transfer_id", result.ID).
+		Str("to_user_id", recipientUserID).
+		Str("to_address", req.ToAddress).
+		Msg("Withdraw to internal address converted to internal transfer")
+
+	return util.ValidateAndReturn(c, http.StatusOK, &types.WithdrawResponse{
+		InternalTransfer: toInternalTransfer(result),
+	})
+}
+
+// checkAPITokenWithdraw 检查提现是否在 API Token 允许的代币和单笔金额范围内
+func checkAPITokenWithdraw(apiToken *auth.APIToken, tokenID int, amount *big.Float) error {
+	if apiToken.WithdrawTokenID != nil && *apiToken.WithdrawTokenID != tokenID {
+		return httperrors.NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeGeneric, "API token is not permitted to withdraw this token")
+	}
+
+	if apiToken.MaxWithdrawAmount != nil {
+		maxAmount, err := money.ParseFloat(*apiToken.MaxWithdrawAmount)
+		if err != nil {
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Invalid API token withdraw limit")
+		}
+		if amount.Cmp(maxAmount) > 0 {
+			return httperrors.NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeGeneric, "Withdraw amount exceeds the API token limit of "+*apiToken.MaxWithdrawAmount)
+		}
+	}
+
+	return nil
+}
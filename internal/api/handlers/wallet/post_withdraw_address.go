@@ -0,0 +1,88 @@
+package wallet
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/data/dto"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/util/url"
+	"github/chapool/go-wallet/internal/wallet/addressbook"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PostWithdrawAddressRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.POST("/withdraw-addresses", postWithdrawAddressHandler(s))
+}
+
+// postWithdrawAddressHandler 登记提现地址并发送确认邮件，地址已登记但未确认时重新发送确认邮件
+func postWithdrawAddressHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		var body types.PostWithdrawAddressPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		if !user.Username.Valid {
+			return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric,
+				"An email address is required to confirm withdraw addresses")
+		}
+
+		address, token, err := s.AddressBook.AddAddress(ctx, &addressbook.AddRequest{
+			UserID:  user.ID,
+			ChainID: int(swag.Int64Value(body.ChainID)),
+			Address: strings.TrimSpace(swag.StringValue(body.Address)),
+			Label:   body.Label,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, addressbook.ErrInvalidAddress):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric,
+					strings.TrimSuffix(err.Error(), ": "+addressbook.ErrInvalidAddress.Error()))
+			case errors.Is(err, addressbook.ErrAddressExists):
+				return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "Withdraw address already exists")
+			}
+			log.Error().Err(err).Msg("Failed to add withdraw address")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to add withdraw address")
+		}
+
+		confirmationLink, err := url.WithdrawAddressConfirmationDeeplinkURL(s.Config, token)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to generate withdraw address confirmation link")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to send confirmation email")
+		}
+
+		if err := s.Mailer.SendWithdrawAddressConfirmation(ctx, user.Username.String, dto.WithdrawAddressConfirmationPayload{
+			ConfirmationLink: confirmationLink.String(),
+			Address:          address.Address,
+			ChainID:          address.ChainID,
+			ExpiresIn:        s.Config.Wallet.WithdrawAddress.WhitelistConfirmationValidity.String(),
+		}); err != nil {
+			log.Error().Err(err).Str("withdraw_address_id", address.ID).Msg("Failed to send withdraw address confirmation email")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to send confirmation email")
+		}
+
+		log.Info().
+			Str("withdraw_address_id", address.ID).
+			Int("chain_id", address.ChainID).
+			Str("address", address.Address).
+			Msg("Withdraw address added, confirmation email sent")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toWithdrawAddress(address, time.Now()))
+	}
+}
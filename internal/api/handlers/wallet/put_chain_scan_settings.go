@@ -0,0 +1,85 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/chain"
+
+	"github.com/aarondl/null/v8"
+	"github.com/labstack/echo/v4"
+)
+
+func PutChainScanSettingsRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.PUT("/chains/:chainId/scan-settings", putChainScanSettingsHandler(s)))
+}
+
+func putChainScanSettingsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to update chain scan settings")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage chain scan settings",
+			)
+		}
+
+		params := walletTypes.NewPutChainScanSettingsRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PutChainScanSettingsPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		// 未提供的参数清除链上的配置，恢复使用全局配置
+		settings, err := s.Scan.UpdateChainScanSettings(ctx, int(params.ChainID), chain.ScanSettings{
+			ScanIntervalMs:        int64PtrToNullInt(body.ScanIntervalMs),
+			BlockBatchSize:        int64PtrToNullInt(body.BlockBatchSize),
+			MaxReceiptConcurrency: int64PtrToNullInt(body.MaxReceiptConcurrency),
+		})
+		if err != nil {
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Int64("chain_id", params.ChainID).Msg("Failed to update chain scan settings")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to update chain scan settings")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Int("chain_id", settings.ChainID).
+			Dur("scan_interval", settings.Effective.ScanInterval).
+			Int("block_batch_size", settings.Effective.BlockBatchSize).
+			Int("max_receipt_concurrency", settings.Effective.MaxReceiptConcurrency).
+			Msg("Chain scan settings updated")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toChainScanSettings(settings))
+	}
+}
+
+func int64PtrToNullInt(value *int64) null.Int {
+	if value == nil {
+		return null.Int{}
+	}
+	return null.IntFrom(int(*value))
+}
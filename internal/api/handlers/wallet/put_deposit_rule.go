@@ -0,0 +1,78 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/deposit"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PutDepositRuleRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.PUT("/deposit-rule/:ruleId", putDepositRuleHandler(s)))
+}
+
+func putDepositRuleHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to update deposit rule")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage deposit rules",
+			)
+		}
+
+		params := walletTypes.NewPutDepositRuleRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.DepositRulePayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		rule := fromDepositRulePayload(&body)
+		rule.ID = params.RuleID.String()
+
+		updated, err := s.Deposit.UpdateRule(ctx, rule)
+		if err != nil {
+			if errors.Is(err, deposit.ErrInvalidRule) {
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, depositRuleErrorMessage(err))
+			}
+			if err.Error() == "deposit rule not found" {
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Deposit rule not found")
+			}
+			log.Error().Err(err).Str("rule_id", rule.ID).Msg("Failed to update deposit rule")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to update deposit rule")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Str("rule_id", updated.ID).
+			Str("action", updated.Action).
+			Bool("is_active", updated.IsActive).
+			Msg("Deposit rule updated")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toDepositRule(updated))
+	}
+}
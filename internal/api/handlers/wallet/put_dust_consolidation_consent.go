@@ -0,0 +1,42 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PutDustConsolidationConsentRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.PUT("/dust-consolidation/consent", putDustConsolidationConsentHandler(s))
+}
+
+func putDustConsolidationConsentHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		var body types.PutDustConsolidationConsentPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		consent, err := s.Dust.SetConsent(ctx, user.ID, swag.BoolValue(body.Enabled))
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to update dust consolidation consent")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to update dust consolidation consent")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, toDustConsolidationConsentResponse(consent))
+	}
+}
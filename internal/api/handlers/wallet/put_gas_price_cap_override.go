@@ -0,0 +1,104 @@
+package wallet
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/gasguard"
+	"github/chapool/go-wallet/internal/wallet/settings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PutGasPriceCapOverrideRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.PUT("/chains/:chainId/gas-price-cap-override", putGasPriceCapOverrideHandler(s)))
+}
+
+// putGasPriceCapOverrideHandler 临时提高或取消链的 gas 价格上限，用于紧急出款
+func putGasPriceCapOverrideHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to override gas price cap")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage gas price caps",
+			)
+		}
+
+		params := walletTypes.NewPutGasPriceCapOverrideRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PutGasPriceCapOverridePayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		// 只有配置了上限的链才能覆盖
+		chainID := int(params.ChainID)
+		if !s.GasPriceCap.Caps(chainID) {
+			return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "No gas price cap configured for chain")
+		}
+
+		req := &settings.GasPriceCapOverrideRequest{
+			ChainID: chainID,
+			Reason:  body.Reason,
+			EndsAt:  time.Time(*body.EndsAt),
+		}
+		if body.MaxGasPriceGwei != "" {
+			maxGasPrice, ok := gasguard.ParseGwei(body.MaxGasPriceGwei)
+			if !ok {
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Invalid max gas price")
+			}
+			req.MaxGasPrice = maxGasPrice
+		}
+
+		if _, err := s.Settings.SetGasPriceCapOverride(ctx, req, user.ID); err != nil {
+			switch {
+			case errors.Is(err, settings.ErrInvalidGasPriceCapOverride):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric,
+					strings.TrimSuffix(err.Error(), ": "+settings.ErrInvalidGasPriceCapOverride.Error()))
+			}
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Int("chain_id", chainID).Msg("Failed to override gas price cap")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to override gas price cap")
+		}
+
+		caps, err := s.GasPriceCap.GetCaps(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get gas price caps")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get gas price caps")
+		}
+
+		for _, capStatus := range caps {
+			if capStatus.ChainID == chainID {
+				return util.ValidateAndReturn(c, http.StatusOK, toGasPriceCap(capStatus))
+			}
+		}
+
+		return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "No gas price cap configured for chain")
+	}
+}
@@ -0,0 +1,97 @@
+package wallet
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/settings"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PutMaintenanceRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.PUT("/maintenance", putMaintenanceHandler(s)))
+}
+
+// putMaintenanceHandler 开启（可预约开始和结束时间）或关闭全局或链的维护模式
+// 维护期间照常受理提现请求，获得批准后排队，维护结束后由提现调度器处理
+func putMaintenanceHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to change maintenance mode")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage maintenance mode",
+			)
+		}
+
+		var body types.PutMaintenancePayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		chainID := util.Int64PtrToIntPtr(body.ChainID)
+
+		if swag.BoolValue(body.Enabled) {
+			req := &settings.MaintenanceRequest{
+				ChainID: chainID,
+				Message: body.Message,
+			}
+			if body.StartsAt != nil {
+				startsAt := time.Time(*body.StartsAt)
+				req.StartsAt = &startsAt
+			}
+			if body.EndsAt != nil {
+				endsAt := time.Time(*body.EndsAt)
+				req.EndsAt = &endsAt
+			}
+
+			if _, err := s.Settings.SetMaintenance(ctx, req, user.ID); err != nil {
+				switch {
+				case errors.Is(err, settings.ErrInvalidMaintenance):
+					return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric,
+						strings.TrimSuffix(err.Error(), ": "+settings.ErrInvalidMaintenance.Error()))
+				}
+				if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+					return httpErr
+				}
+				log.Error().Err(err).Msg("Failed to set maintenance mode")
+				return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to set maintenance mode")
+			}
+		} else if err := s.Settings.ClearMaintenance(ctx, chainID); err != nil {
+			if errors.Is(err, settings.ErrMaintenanceNotFound) {
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Maintenance mode is not enabled")
+			}
+			log.Error().Err(err).Msg("Failed to clear maintenance mode")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to clear maintenance mode")
+		}
+
+		response, err := maintenanceResponse(c, s)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get maintenance settings")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to get maintenance settings")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
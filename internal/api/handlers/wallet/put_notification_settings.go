@@ -0,0 +1,59 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/notification"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PutNotificationSettingsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.PUT("/notification-settings", putNotificationSettingsHandler(s))
+}
+
+func putNotificationSettingsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		var body types.PutNotificationSettingsPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		settings := &notification.Settings{
+			DisabledEvents:     body.DisabledEvents,
+			WithdrawThresholds: make([]notification.WithdrawThreshold, 0, len(body.WithdrawThresholds)),
+			Locale:             body.Locale,
+		}
+		for _, threshold := range body.WithdrawThresholds {
+			settings.WithdrawThresholds = append(settings.WithdrawThresholds, notification.WithdrawThreshold{
+				TokenID:   int(swag.Int64Value(threshold.TokenID)),
+				MinAmount: swag.StringValue(threshold.MinAmount),
+			})
+		}
+
+		updated, err := s.Notification.UpdateSettings(ctx, user.ID, settings)
+		if err != nil {
+			if errors.Is(err, notification.ErrInvalidSettings) {
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, err.Error())
+			}
+			log.Error().Err(err).Msg("Failed to update notification settings")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to update notification settings")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, toNotificationSettingsResponse(updated))
+	}
+}
@@ -0,0 +1,72 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/organization"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PutOrganizationMemberRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.PUT("/organizations/:orgId/members", putOrganizationMemberHandler(s)))
+}
+
+func putOrganizationMemberHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		params := walletTypes.NewPutOrganizationMemberRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PutOrganizationMemberPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if err := authorizeOrganizationAdmin(ctx, s, user, orgID); err != nil {
+			return err
+		}
+
+		memberUserID := body.UserID.String()
+		member, err := s.Organization.AddMember(ctx, orgID, memberUserID, swag.StringValue(body.Role))
+		if err != nil {
+			switch {
+			case errors.Is(err, organization.ErrInvalidOrganization):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, err.Error())
+			case errors.Is(err, organization.ErrOrganizationNotFound):
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "Organization not found")
+			case errors.Is(err, organization.ErrUserNotFound):
+				return httperrors.NewHTTPError(http.StatusNotFound, types.PublicHTTPErrorTypeGeneric, "User not found")
+			case errors.Is(err, organization.ErrAlreadyMember):
+				return httperrors.NewHTTPError(http.StatusConflict, types.PublicHTTPErrorTypeGeneric, "User already belongs to another organization")
+			}
+			log.Error().Err(err).Str("org_id", orgID).Str("member_user_id", memberUserID).Msg("Failed to add organization member")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to add organization member")
+		}
+
+		log.Info().
+			Str("org_id", orgID).
+			Str("member_user_id", memberUserID).
+			Str("role", member.Role).
+			Msg("Organization member added")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toOrganizationMember(member))
+	}
+}
@@ -0,0 +1,63 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/notification"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PutOrganizationNotificationBrandingRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.PUT("/organizations/:orgId/notification-branding", putOrganizationNotificationBrandingHandler(s)))
+}
+
+func putOrganizationNotificationBrandingHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		params := walletTypes.NewPutOrganizationNotificationBrandingRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PutOrganizationNotificationBrandingPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if err := authorizeOrganizationAdmin(ctx, s, user, orgID); err != nil {
+			return err
+		}
+
+		branding, err := s.Notification.UpdateBranding(ctx, &notification.Branding{
+			OrgID:      orgID,
+			SenderName: body.SenderName,
+			LogoURL:    body.LogoURL,
+		})
+		if err != nil {
+			if errors.Is(err, notification.ErrInvalidBranding) {
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, err.Error())
+			}
+			log.Error().Err(err).Str("org_id", orgID).Msg("Failed to update notification branding")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to update notification branding")
+		}
+
+		log.Info().Str("org_id", orgID).Msg("Notification branding updated")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toNotificationBranding(branding))
+	}
+}
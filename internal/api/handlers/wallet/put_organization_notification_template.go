@@ -0,0 +1,73 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/notification"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PutOrganizationNotificationTemplateRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.PUT("/organizations/:orgId/notification-templates", putOrganizationNotificationTemplateHandler(s)))
+}
+
+func putOrganizationNotificationTemplateHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		params := walletTypes.NewPutOrganizationNotificationTemplateRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PutOrganizationNotificationTemplatePayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		orgID := params.OrgID.String()
+		if err := authorizeOrganizationAdmin(ctx, s, user, orgID); err != nil {
+			return err
+		}
+
+		tmpl, err := s.Notification.SaveTemplate(ctx, &notification.Template{
+			OrgID:     orgID,
+			Event:     swag.StringValue(body.Event),
+			Locale:    body.Locale,
+			Title:     swag.StringValue(body.Title),
+			Body:      swag.StringValue(body.Body),
+			EmailHTML: body.EmailHTML,
+			CreatedBy: &user.ID,
+		})
+		if err != nil {
+			if errors.Is(err, notification.ErrInvalidTemplate) {
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, err.Error())
+			}
+			log.Error().Err(err).Str("org_id", orgID).Msg("Failed to save notification template")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to save notification template")
+		}
+
+		log.Info().
+			Str("org_id", orgID).
+			Str("template_id", tmpl.ID).
+			Str("event", tmpl.Event).
+			Str("locale", tmpl.Locale).
+			Msg("Notification template saved")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toNotificationTemplate(tmpl))
+	}
+}
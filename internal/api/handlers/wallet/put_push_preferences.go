@@ -0,0 +1,48 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/notification"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PutPushPreferencesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.PUT("/push-preferences", putPushPreferencesHandler(s))
+}
+
+func putPushPreferencesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		var body types.PutPushPreferencesPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		updated, err := s.Notification.UpdatePushPreferences(ctx, user.ID, &notification.PushPreferences{
+			DisabledEvents: body.DisabledEvents,
+		})
+		if err != nil {
+			if errors.Is(err, notification.ErrInvalidSettings) {
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, err.Error())
+			}
+			log.Error().Err(err).Msg("Failed to update push preferences")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to update push preferences")
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, toPushPreferencesResponse(updated))
+	}
+}
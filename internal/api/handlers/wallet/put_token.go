@@ -0,0 +1,90 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/token"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PutTokenRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.PUT("/token/:tokenId", putTokenHandler(s)))
+}
+
+func putTokenHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to update token")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage tokens",
+			)
+		}
+
+		params := walletTypes.NewPutTokenRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PutTokenPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		updated, err := s.Token.UpdateToken(ctx, &token.UpdateRequest{
+			TokenID:           int(params.TokenID),
+			IsActive:          body.IsActive,
+			WithdrawFee:       body.WithdrawFee,
+			MinWithdrawAmount: body.MinWithdrawAmount,
+			MinTransferAmount: body.MinTransferAmount,
+			MinDepositAmount:  body.MinDepositAmount,
+			IsWrappedNative:   body.IsWrappedNative,
+			CreditAsNative:    body.CreditAsNative,
+			FeeOnTransfer:     body.FeeOnTransfer,
+			SupportsPermit:    body.SupportsPermit,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, token.ErrInvalidToken):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, tokenErrorMessage(err))
+			}
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Int64("token_id", params.TokenID).Msg("Failed to update token")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to update token")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Int("token_id", updated.ID).
+			Bool("is_active", updated.IsActive).
+			Bool("is_wrapped_native", updated.IsWrappedNative).
+			Bool("credit_as_native", updated.CreditAsNative).
+			Bool("fee_on_transfer", updated.FeeOnTransfer).
+			Bool("supports_permit", updated.SupportsPermit).
+			Msg("Token updated")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toAdminToken(updated))
+	}
+}
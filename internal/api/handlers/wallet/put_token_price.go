@@ -0,0 +1,79 @@
+package wallet
+
+import (
+	"net/http"
+	"strings"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/price"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PutTokenPriceRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.PUT("/token-price/:tokenId", putTokenPriceHandler(s)))
+}
+
+// putTokenPriceHandler 设置代币的手动价格（价格源不支持的代币），手动价格不会被价格源覆盖
+func putTokenPriceHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to set token price")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage token prices",
+			)
+		}
+
+		params := walletTypes.NewPutTokenPriceRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PutTokenPricePayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		saved, err := s.Price.SetManualPrice(ctx, int(params.TokenID), swag.StringValue(body.PriceUsd), user.ID)
+		if err != nil {
+			switch {
+			case errors.Is(err, price.ErrInvalidPrice):
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric,
+					strings.TrimSuffix(err.Error(), ": "+price.ErrInvalidPrice.Error()))
+			}
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Int64("token_id", params.TokenID).Msg("Failed to set token price")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to set token price")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Int("token_id", saved.TokenID).
+			Str("price_usd", saved.PriceUSD).
+			Msg("Manual token price set")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toTokenPrice(saved))
+	}
+}
@@ -0,0 +1,46 @@
+package wallet
+
+import (
+	"net/http"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PutWithdrawAddressSettingsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Wallet.PUT("/withdraw-addresses/settings", putWithdrawAddressSettingsHandler(s))
+}
+
+// putWithdrawAddressSettingsHandler 开启仅限白名单提现立即生效，关闭在冷静期后生效
+func putWithdrawAddressSettingsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		var body types.PutWithdrawAddressSettingsPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		settings, err := s.AddressBook.UpdateSettings(ctx, user.ID, swag.BoolValue(body.WhitelistOnly))
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to update withdraw address settings")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to update withdraw address settings")
+		}
+
+		log.Info().Bool("whitelist_only", settings.WhitelistOnly).Msg("Withdraw address settings updated")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toWithdrawAddressSettings(settings, time.Now()))
+	}
+}
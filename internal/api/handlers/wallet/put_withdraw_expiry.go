@@ -0,0 +1,112 @@
+package wallet
+
+import (
+	"net/http"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	walletTypes "github/chapool/go-wallet/internal/types/wallet"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func PutWithdrawExpiryRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.PUT("/withdraw/:withdrawId/expiry", putWithdrawExpiryHandler(s)))
+}
+
+// putWithdrawExpiryHandler 设置等待审核的提现请求的过期时间，未提供过期时间时恢复为配置的默认过期时间
+func putWithdrawExpiryHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to set withdraw expiry")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can set withdraw expiry",
+			)
+		}
+
+		params := walletTypes.NewPutWithdrawExpiryRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PutWithdrawExpiryPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		var expiresAt *time.Time
+		if !swag.IsZero(body.ExpiresAt) {
+			t := time.Time(body.ExpiresAt)
+			if !t.After(time.Now()) {
+				return httperrors.NewHTTPValidationError(
+					http.StatusBadRequest,
+					types.PublicHTTPErrorTypeGeneric,
+					"expires_at has to be in the future",
+					[]*types.HTTPValidationErrorDetail{
+						{
+							Key:   swag.String("expires_at"),
+							In:    swag.String("body"),
+							Error: swag.String("must be in the future"),
+						},
+					},
+				)
+			}
+			expiresAt = &t
+		}
+
+		withdrawID := params.WithdrawID.String()
+		withdrawRecord, err := s.Withdraw.SetRequestExpiry(ctx, withdrawID, user.ID, expiresAt)
+		if err != nil {
+			if httpErr := httperrors.NewWalletError(err); httpErr != nil {
+				return httpErr
+			}
+			log.Error().Err(err).Str("withdraw_id", withdrawID).Msg("Failed to set withdraw expiry")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to set withdraw expiry")
+		}
+
+		// 构建响应
+		id := strfmt.UUID(withdrawRecord.ID)
+		userID := strfmt.UUID(withdrawRecord.UserID)
+		createdAt := strfmt.DateTime(withdrawRecord.CreatedAt)
+		response := &types.WithdrawResponse{
+			Withdraw: &types.WithdrawItem{
+				ID:            &id,
+				UserID:        &userID,
+				ToAddress:     swag.String(withdrawRecord.ToAddress),
+				TokenID:       swag.Int64(int64(withdrawRecord.TokenID)),
+				Amount:        swag.String(withdrawRecord.Amount),
+				Fee:           withdrawRecord.Fee,
+				Status:        swag.String(withdrawRecord.Status.String()),
+				CreatedAt:     &createdAt,
+				ProcessingEta: toOptionalDateTime(s.Withdraw.GetProcessingETA(withdrawRecord)),
+				ExpiresAt:     toOptionalDateTime(s.Withdraw.GetRequestExpiry(withdrawRecord)),
+				ExplorerLinks: withdrawExplorerLinks(chainExplorers(ctx, s), withdrawRecord),
+			},
+		}
+
+		if withdrawRecord.TXHash.Valid {
+			response.Withdraw.TxHash = withdrawRecord.TXHash.String
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
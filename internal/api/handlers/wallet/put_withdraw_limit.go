@@ -0,0 +1,87 @@
+package wallet
+
+import (
+	"net/http"
+	"strings"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/risk"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+func PutWithdrawLimitRoute(s *api.Server) *echo.Route {
+	return s.Router.AdminMutation(s.Router.APIV1Wallet.PUT("/withdraw-limits", putWithdrawLimitHandler(s)))
+}
+
+func putWithdrawLimitHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return echo.ErrUnauthorized
+		}
+		log := util.LogFromContext(ctx)
+
+		// 检查用户是否为管理员
+		if user.Role != string(auth.RoleAdmin) {
+			log.Warn().
+				Str("user_id", user.ID).
+				Str("user_role", user.Role).
+				Msg("Non-admin user attempted to set withdraw limit")
+			return httperrors.NewHTTPError(
+				http.StatusForbidden,
+				types.PublicHTTPErrorTypeGeneric,
+				"Only admin users can manage withdraw limits",
+			)
+		}
+
+		var body types.WithdrawLimitPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		limit := &risk.Limit{
+			TokenID:   int(swag.Int64Value(body.TokenID)),
+			Period:    swag.StringValue(body.Period),
+			MaxAmount: body.MaxAmount,
+			Action:    swag.StringValue(body.Action),
+			CreatedBy: swag.String(user.ID),
+		}
+		if body.UserID != nil {
+			limit.UserID = swag.String(body.UserID.String())
+		}
+		if body.OrgID != nil {
+			limit.OrgID = swag.String(body.OrgID.String())
+		}
+		if body.MaxCount != nil {
+			limit.MaxCount = swag.Int(int(*body.MaxCount))
+		}
+
+		saved, err := s.Risk.SetLimit(ctx, limit)
+		if err != nil {
+			if errors.Is(err, risk.ErrInvalidLimit) {
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric,
+					strings.TrimSuffix(err.Error(), ": "+risk.ErrInvalidLimit.Error()))
+			}
+			log.Error().Err(err).Msg("Failed to set withdraw limit")
+			return httperrors.NewHTTPError(http.StatusInternalServerError, types.PublicHTTPErrorTypeGeneric, "Failed to set withdraw limit")
+		}
+
+		log.Info().
+			Str("admin_user_id", user.ID).
+			Str("limit_id", saved.ID).
+			Int("token_id", saved.TokenID).
+			Str("period", saved.Period).
+			Str("action", saved.Action).
+			Msg("Withdraw limit set")
+
+		return util.ValidateAndReturn(c, http.StatusOK, toWithdrawLimit(saved))
+	}
+}
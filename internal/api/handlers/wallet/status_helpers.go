@@ -0,0 +1,26 @@
+package wallet
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/types"
+
+	"github.com/go-openapi/swag"
+)
+
+// invalidStatusParamError 查询参数 status 不是合法的状态枚举值
+func invalidStatusParamError() *httperrors.HTTPValidationError {
+	return httperrors.NewHTTPValidationError(
+		http.StatusBadRequest,
+		types.PublicHTTPErrorTypeGeneric,
+		"Invalid status parameter",
+		[]*types.HTTPValidationErrorDetail{
+			{
+				Key:   swag.String("status"),
+				In:    swag.String("query"),
+				Error: swag.String("must be a valid status"),
+			},
+		},
+	)
+}
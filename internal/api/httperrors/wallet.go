@@ -0,0 +1,66 @@
+package httperrors
+
+import (
+	"net/http"
+
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/go-openapi/swag"
+	"github.com/pkg/errors"
+)
+
+// walletErrors maps the wallet error taxonomy to HTTP status codes and public error types,
+// the first entry the error matches wins.
+var walletErrors = []struct {
+	err       error
+	code      int
+	errorType types.PublicHTTPErrorType
+	title     string
+}{
+	{walleterrors.ErrInvalidAmount, http.StatusBadRequest, types.PublicHTTPErrorTypeINVALIDAMOUNT, "Invalid amount"},
+	{walleterrors.ErrBelowMinimum, http.StatusUnprocessableEntity, types.PublicHTTPErrorTypeAMOUNTBELOWMINIMUM, "Amount is below the minimum amount of the token"},
+	{walleterrors.ErrInsufficientBalance, http.StatusUnprocessableEntity, types.PublicHTTPErrorTypeINSUFFICIENTBALANCE, "Insufficient balance"},
+	{walleterrors.ErrChainNotFound, http.StatusNotFound, types.PublicHTTPErrorTypeCHAINNOTFOUND, "Chain not found"},
+	{walleterrors.ErrTokenNotFound, http.StatusNotFound, types.PublicHTTPErrorTypeWALLETTOKENNOTFOUND, "Token not found"},
+	{walleterrors.ErrWalletNotFound, http.StatusNotFound, types.PublicHTTPErrorTypeWALLETNOTFOUND, "Wallet not found"},
+	{walleterrors.ErrWithdrawNotFound, http.StatusNotFound, types.PublicHTTPErrorTypeWITHDRAWNOTFOUND, "Withdraw not found"},
+	{walleterrors.ErrWithdrawStateConflict, http.StatusConflict, types.PublicHTTPErrorTypeWITHDRAWSTATECONFLICT, "Withdraw state does not allow this operation"},
+	{walleterrors.ErrSelfApproval, http.StatusForbidden, types.PublicHTTPErrorTypeSELFAPPROVALNOTALLOWED, "Admins cannot review their own withdraws"},
+	{walleterrors.ErrIdempotencyKeyConflict, http.StatusConflict, types.PublicHTTPErrorTypeIDEMPOTENCYKEYCONFLICT, "Idempotency key was already used with a different request"},
+	{walleterrors.ErrRateLimited, http.StatusTooManyRequests, types.PublicHTTPErrorTypeRATELIMITED, "Too many requests, please try again later"},
+	{walleterrors.ErrWithdrawLimitExceeded, http.StatusUnprocessableEntity, types.PublicHTTPErrorTypeWITHDRAWLIMITEXCEEDED, "Withdraw limit exceeded"},
+	{walleterrors.ErrNFTNotFound, http.StatusNotFound, types.PublicHTTPErrorTypeNFTNOTFOUND, "NFT not found"},
+	{walleterrors.ErrNFTStateConflict, http.StatusConflict, types.PublicHTTPErrorTypeNFTSTATECONFLICT, "NFT state does not allow this operation"},
+	{walleterrors.ErrWithdrawBlocked, http.StatusForbidden, types.PublicHTTPErrorTypeWITHDRAWBLOCKED, "Withdraw is blocked by compliance screening"},
+}
+
+// NewInvalidWithdrawAddressError returns a validation error for the to_address of a withdraw,
+// reason is the machine readable validation failure (e.g. invalid_checksum), detail the human readable explanation.
+func NewInvalidWithdrawAddressError(reason string, detail string) *HTTPValidationError {
+	return NewHTTPValidationErrorWithDetail(
+		http.StatusBadRequest,
+		types.PublicHTTPErrorTypeINVALIDWITHDRAWADDRESS,
+		"Invalid withdraw address",
+		[]*types.HTTPValidationErrorDetail{
+			{
+				Key:   swag.String("to_address"),
+				In:    swag.String("body"),
+				Error: swag.String(reason),
+			},
+		},
+		detail,
+	)
+}
+
+// NewWalletError returns the HTTP error for errors of the wallet error taxonomy (see package walleterrors),
+// the error message is returned as detail. Returns nil for other errors, handlers fall back to their own handling.
+func NewWalletError(err error) *HTTPError {
+	for _, e := range walletErrors {
+		if errors.Is(err, e.err) {
+			return NewHTTPErrorWithDetail(e.code, e.errorType, e.title, err.Error())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package httperrors_test
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+)
+
+func TestNewWalletError(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		code      int64
+		errorType types.PublicHTTPErrorType
+		detail    string
+	}{
+		{"insufficient balance", walleterrors.ErrInsufficientBalance, http.StatusUnprocessableEntity, types.PublicHTTPErrorTypeINSUFFICIENTBALANCE, "insufficient balance"},
+		{"token not found", walleterrors.ErrTokenNotFound, http.StatusNotFound, types.PublicHTTPErrorTypeWALLETTOKENNOTFOUND, "token not found"},
+		{
+			"wrapped state conflict",
+			errors.Wrap(walleterrors.ErrWithdrawStateConflict, "withdraw status is processing, expected user_withdraw_request"),
+			http.StatusConflict,
+			types.PublicHTTPErrorTypeWITHDRAWSTATECONFLICT,
+			"withdraw status is processing, expected user_withdraw_request: withdraw state conflict",
+		},
+		{"rate limited", walleterrors.ErrRateLimited, http.StatusTooManyRequests, types.PublicHTTPErrorTypeRATELIMITED, "too many requests"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpErr := httperrors.NewWalletError(tt.err)
+			require.NotNil(t, httpErr)
+			assert.Equal(t, tt.code, *httpErr.Code)
+			assert.Equal(t, tt.errorType, *httpErr.Type)
+			assert.Equal(t, tt.detail, httpErr.Detail)
+		})
+	}
+}
+
+func TestNewWalletErrorUnknown(t *testing.T) {
+	assert.Nil(t, httperrors.NewWalletError(sql.ErrConnDone))
+	assert.Nil(t, httperrors.NewWalletError(errors.New("withdraw not found")))
+}
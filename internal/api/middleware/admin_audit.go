@@ -0,0 +1,217 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/audit"
+)
+
+// maxAuditedBodySize is the largest request body stored in the audit parameters, larger bodies are recorded by hash only
+const maxAuditedBodySize = 16 << 10
+
+// redactedValue replaces the values of sensitive request body fields in the audit parameters
+const redactedValue = "[REDACTED]"
+
+// sensitiveFieldParts are case-insensitive substrings of request body field names whose values are never stored
+var sensitiveFieldParts = []string{"password", "passphrase", "secret", "mnemonic", "private_key", "privatekey", "seed"}
+
+type AdminAuditConfig struct {
+	S       *api.Server        // API server used for recording audit entries via S.AdminAudit
+	Skipper middleware.Skipper // Controls which routes are audited (default: all routes)
+}
+
+// AdminAuditWithConfig records an admin_audit entry for each request not skipped, capturing the acting user, route,
+// target entity (taken from the route path parameter), request parameters with sensitive fields redacted,
+// SHA-256 of the request payload, result and latency. Audit failures are logged and never change the response,
+// the request has already been handled at that point.
+func AdminAuditWithConfig(config AdminAuditConfig) echo.MiddlewareFunc {
+	if config.S == nil {
+		panic("admin audit middleware: server is required")
+	}
+
+	if config.Skipper == nil {
+		config.Skipper = middleware.DefaultSkipper
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if config.Skipper(c) || config.S.AdminAudit == nil {
+				return next(c)
+			}
+
+			req := c.Request()
+
+			var (
+				payloadHash *string
+				body        []byte
+			)
+			if req.Body != nil {
+				var err error
+				body, err = io.ReadAll(req.Body)
+				if err != nil {
+					return fmt.Errorf("failed to read body while auditing request: %w", err)
+				}
+				req.Body = io.NopCloser(bytes.NewBuffer(body))
+
+				if len(body) > 0 {
+					sum := sha256.Sum256(body)
+					hash := hex.EncodeToString(sum[:])
+					payloadHash = &hash
+				}
+			}
+
+			start := time.Now()
+			err := next(c)
+			if err != nil {
+				c.Error(err)
+			}
+			latency := time.Since(start)
+
+			// Retrieve request context again since the handler chain enhanced it with the authenticated user
+			ctx := c.Request().Context()
+
+			entry := &audit.Entry{
+				Method:      req.Method,
+				Route:       c.Path(),
+				Path:        req.URL.Path,
+				Params:      auditParams(c, body),
+				PayloadHash: payloadHash,
+				Result:      audit.ResultFor(c.Response().Status),
+				StatusCode:  c.Response().Status,
+				Latency:     latency,
+			}
+			if names := c.ParamNames(); len(names) > 0 {
+				targetType := auditTargetType(names[0])
+				targetID := c.Param(names[0])
+				entry.TargetType = &targetType
+				entry.TargetID = &targetID
+			}
+			if user := auth.UserFromContext(ctx); user != nil {
+				entry.ActorUserID = &user.ID
+				entry.ActorRole = &user.Role
+			}
+			if requestID, idErr := util.RequestIDFromContext(ctx); idErr == nil && len(requestID) > 0 {
+				entry.RequestID = &requestID
+			}
+			if clientIP := c.RealIP(); len(clientIP) > 0 {
+				entry.ClientIP = &clientIP
+			}
+			if err != nil {
+				message := err.Error()
+				entry.Error = &message
+			}
+
+			if recordErr := config.S.AdminAudit.Record(util.DetachContext(ctx), entry); recordErr != nil {
+				util.LogFromContext(ctx).Error().
+					Err(recordErr).
+					Str("method", entry.Method).
+					Str("route", entry.Route).
+					Int("status_code", entry.StatusCode).
+					Msg("Failed to record admin audit entry")
+			}
+
+			return nil
+		}
+	}
+}
+
+// auditTargetType derives the target entity type from a route path parameter name, e.g. withdrawId is withdraw
+// and screeningAddressId is screening_address.
+func auditTargetType(paramName string) string {
+	name := strings.TrimSuffix(paramName, "Id")
+
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// auditParams returns the path and query parameters and the JSON request body of the request as a JSON object,
+// with the values of sensitive body fields redacted. Bodies which are not JSON or too large are left out.
+func auditParams(c echo.Context, body []byte) json.RawMessage {
+	params := struct {
+		Path  map[string]string `json:"path,omitempty"`
+		Query url.Values        `json:"query,omitempty"`
+		Body  any               `json:"body,omitempty"`
+	}{
+		Query: c.QueryParams(),
+	}
+
+	if names := c.ParamNames(); len(names) > 0 {
+		params.Path = make(map[string]string, len(names))
+		for _, name := range names {
+			params.Path[name] = c.Param(name)
+		}
+	}
+
+	if len(body) > 0 && len(body) <= maxAuditedBodySize {
+		var decoded any
+		if err := json.Unmarshal(body, &decoded); err == nil {
+			params.Body = redactSensitiveFields(decoded)
+		}
+	}
+
+	if params.Path == nil && len(params.Query) == 0 && params.Body == nil {
+		return nil
+	}
+
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		return nil
+	}
+
+	return encoded
+}
+
+// redactSensitiveFields replaces the values of sensitive fields in a decoded JSON value, including nested objects.
+func redactSensitiveFields(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if isSensitiveField(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactSensitiveFields(field)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactSensitiveFields(item)
+		}
+	}
+
+	return value
+}
+
+func isSensitiveField(key string) bool {
+	key = strings.ToLower(key)
+	for _, part := range sensitiveFieldParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,146 @@
+package middleware_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/middleware"
+	"github/chapool/go-wallet/internal/wallet/audit"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingAuditService struct {
+	entries []*audit.Entry
+}
+
+func (r *recordingAuditService) Record(_ context.Context, entry *audit.Entry) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *recordingAuditService) List(_ context.Context, _ *audit.Filter, _ int, _ int) ([]*audit.Entry, error) {
+	return r.entries, nil
+}
+
+func TestAdminAuditRecordsMutation(t *testing.T) {
+	recorder := &recordingAuditService{}
+	e := echo.New()
+	e.Use(middleware.AdminAuditWithConfig(middleware.AdminAuditConfig{
+		S: &api.Server{AdminAudit: recorder},
+		Skipper: func(c echo.Context) bool {
+			return c.Request().Method == http.MethodGet
+		},
+	}))
+
+	payload := `{"reason":"manual review"}`
+	e.POST("/withdraw/:withdrawId/approve", func(c echo.Context) error {
+		// the handler must still be able to read the payload hashed by the middleware
+		body, err := io.ReadAll(c.Request().Body)
+		require.NoError(t, err)
+		assert.Equal(t, payload, string(body))
+
+		return c.NoContent(http.StatusNoContent)
+	})
+	e.GET("/withdraws", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/withdraw/123/approve", strings.NewReader(payload))
+	res := httptest.NewRecorder()
+	e.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/withdraws", nil)
+	e.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Len(t, recorder.entries, 1)
+	entry := recorder.entries[0]
+	sum := sha256.Sum256([]byte(payload))
+	assert.Equal(t, http.MethodPost, entry.Method)
+	assert.Equal(t, "/withdraw/:withdrawId/approve", entry.Route)
+	assert.Equal(t, "/withdraw/123/approve", entry.Path)
+	require.NotNil(t, entry.PayloadHash)
+	assert.Equal(t, hex.EncodeToString(sum[:]), *entry.PayloadHash)
+	assert.Equal(t, audit.ResultSuccess, entry.Result)
+	assert.Equal(t, http.StatusNoContent, entry.StatusCode)
+	assert.Nil(t, entry.Error)
+	assert.Nil(t, entry.ActorUserID)
+	require.NotNil(t, entry.TargetType)
+	assert.Equal(t, "withdraw", *entry.TargetType)
+	require.NotNil(t, entry.TargetID)
+	assert.Equal(t, "123", *entry.TargetID)
+	assert.JSONEq(t, `{"path":{"withdrawId":"123"},"body":{"reason":"manual review"}}`, string(entry.Params))
+}
+
+func TestAdminAuditRecordsFailure(t *testing.T) {
+	recorder := &recordingAuditService{}
+	e := echo.New()
+	e.Use(middleware.AdminAuditWithConfig(middleware.AdminAuditConfig{
+		S: &api.Server{AdminAudit: recorder},
+	}))
+
+	e.DELETE("/token/:tokenId", func(_ echo.Context) error {
+		return echo.NewHTTPError(http.StatusForbidden, "Only admin users can delete tokens")
+	})
+
+	req := httptest.NewRequest(http.MethodDelete, "/token/1", nil)
+	res := httptest.NewRecorder()
+	e.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+
+	require.Len(t, recorder.entries, 1)
+	entry := recorder.entries[0]
+	assert.Equal(t, audit.ResultFailure, entry.Result)
+	assert.Equal(t, http.StatusForbidden, entry.StatusCode)
+	assert.Nil(t, entry.PayloadHash)
+	require.NotNil(t, entry.Error)
+	assert.Contains(t, *entry.Error, "Only admin users can delete tokens")
+}
+
+func TestAdminAuditRedactsSensitiveParams(t *testing.T) {
+	recorder := &recordingAuditService{}
+	e := echo.New()
+	e.Use(middleware.AdminAuditWithConfig(middleware.AdminAuditConfig{
+		S: &api.Server{AdminAudit: recorder},
+	}))
+
+	e.PUT("/screening-address/:screeningAddressId", func(c echo.Context) error {
+		return c.NoContent(http.StatusNoContent)
+	})
+	e.POST("/hot-wallet", func(c echo.Context) error {
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	payload := `{"chain_id":56,"keystore":{"password":"hunter2","Mnemonic":"abandon abandon"},"signers":[{"api_secret":"s3cret"}]}`
+	req := httptest.NewRequest(http.MethodPut, "/screening-address/42?dry_run=true", strings.NewReader(payload))
+	e.ServeHTTP(httptest.NewRecorder(), req)
+
+	req = httptest.NewRequest(http.MethodPost, "/hot-wallet", strings.NewReader("not json"))
+	e.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Len(t, recorder.entries, 2)
+	entry := recorder.entries[0]
+	require.NotNil(t, entry.TargetType)
+	assert.Equal(t, "screening_address", *entry.TargetType)
+	assert.JSONEq(t, `{
+		"path": {"screeningAddressId": "42"},
+		"query": {"dry_run": ["true"]},
+		"body": {"chain_id": 56, "keystore": {"password": "[REDACTED]", "Mnemonic": "[REDACTED]"}, "signers": [{"api_secret": "[REDACTED]"}]}
+	}`, string(entry.Params))
+
+	// bodies which are not JSON are recorded by hash only
+	entry = recorder.entries[1]
+	assert.Nil(t, entry.TargetType)
+	assert.Nil(t, entry.Params)
+	assert.NotNil(t, entry.PayloadHash)
+}
@@ -0,0 +1,147 @@
+package middleware
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/data/mapper"
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/apitoken"
+)
+
+var (
+	ErrForbiddenAPITokenScope = httperrors.NewHTTPError(http.StatusForbidden, types.PublicHTTPErrorTypeMISSINGSCOPES, "API token is not permitted to access this endpoint")
+	ErrAPITokenRateLimited    = httperrors.NewHTTPError(http.StatusTooManyRequests, types.PublicHTTPErrorTypeRATELIMITED, "API token rate limit exceeded, please try again later")
+)
+
+// APITokenFormatValidator accepts access tokens as well as user API tokens.
+func APITokenFormatValidator(token string) bool {
+	return DefaultAuthTokenFormatValidator(token) || apitoken.IsToken(token)
+}
+
+// APITokenValidator validates user API tokens and records their last usage, access tokens are passed on to DefaultAuthTokenValidator.
+func APITokenValidator(c echo.Context, config AuthConfig, token string) (auth.Result, error) {
+	if !apitoken.IsToken(token) {
+		return DefaultAuthTokenValidator(c, config, token)
+	}
+
+	ctx := c.Request().Context()
+
+	apiToken, err := config.S.APIToken.Authenticate(ctx, token, c.RealIP())
+	if err != nil {
+		if errors.Is(err, apitoken.ErrTokenNotFound) {
+			log.Trace().Msg("API token not found in database")
+			return auth.Result{}, ErrAuthTokenValidationFailed
+		}
+
+		log.Error().Err(err).Msg("Failed to validate API token, aborting request")
+		return auth.Result{}, echo.ErrInternalServerError
+	}
+
+	user, err := models.Users(
+		models.UserWhere.ID.EQ(apiToken.UserID),
+		qm.Load(models.UserRels.AppUserProfile),
+	).One(ctx, config.S.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return auth.Result{}, ErrAuthTokenValidationFailed
+		}
+
+		log.Error().Err(err).Msg("Failed to query for API token user in database, aborting request")
+		return auth.Result{}, echo.ErrInternalServerError
+	}
+
+	var validUntil time.Time
+	if apiToken.ExpiresAt != nil {
+		validUntil = *apiToken.ExpiresAt
+	}
+
+	rateLimit := config.S.Config.Wallet.APITokenRateLimitPerMinute
+	if apiToken.RateLimitPerMinute != nil {
+		rateLimit = *apiToken.RateLimitPerMinute
+	}
+
+	return auth.Result{
+		Token:      token,
+		User:       mapper.LocalUserToDTO(user).Ptr(),
+		ValidUntil: validUntil,
+		APIToken: &auth.APIToken{
+			ID:                 apiToken.ID,
+			Scopes:             apiToken.Scopes,
+			WithdrawTokenID:    apiToken.WithdrawTokenID,
+			MaxWithdrawAmount:  apiToken.MaxWithdrawAmount,
+			RateLimitPerMinute: rateLimit,
+		},
+	}, nil
+}
+
+// AuthWithAPITokens authenticates requests by access token or user API token, see APITokenScopes for restricting
+// the routes available to API tokens.
+func AuthWithAPITokens(s *api.Server) echo.MiddlewareFunc {
+	c := DefaultAuthConfig
+	c.S = s
+	c.FormatValidator = APITokenFormatValidator
+	c.TokenValidator = APITokenValidator
+	return AuthWithConfig(c)
+}
+
+// APITokenScopes rejects requests authenticated by a user API token unless the token was granted the scope required
+// by the route. requiredScope returns an empty string for routes not available to API tokens at all.
+// Requests authenticated by an access token are not restricted.
+func APITokenScopes(requiredScope func(c echo.Context) string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			apiToken := auth.APITokenFromContext(c.Request().Context())
+			if apiToken == nil {
+				return next(c)
+			}
+
+			scope := requiredScope(c)
+			if len(scope) == 0 || !apiToken.HasScope(scope) {
+				util.LogFromEchoContext(c).Debug().
+					Str("middleware", "api_token_scopes").
+					Str("api_token_id", apiToken.ID).
+					Str("required_scope", scope).
+					Strs("api_token_scopes", apiToken.Scopes).
+					Msg("API token is missing required scope, rejecting request")
+				return ErrForbiddenAPITokenScope
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// APITokenRateLimit rejects requests authenticated by a user API token once the token exceeded its requests per minute.
+// Requests authenticated by an access token are not limited.
+func APITokenRateLimit(s *api.Server) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			apiToken := auth.APITokenFromContext(c.Request().Context())
+			if apiToken == nil {
+				return next(c)
+			}
+
+			if !s.APIToken.Allow(apiToken.ID, apiToken.RateLimitPerMinute) {
+				util.LogFromEchoContext(c).Debug().
+					Str("middleware", "api_token_rate_limit").
+					Str("api_token_id", apiToken.ID).
+					Int("rate_limit_per_minute", apiToken.RateLimitPerMinute).
+					Msg("API token exceeded its rate limit, rejecting request")
+				return ErrAPITokenRateLimited
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github/chapool/go-wallet/internal/api"
+	"github/chapool/go-wallet/internal/test"
+	"github/chapool/go-wallet/internal/test/fixtures"
+	"github/chapool/go-wallet/internal/wallet/apitoken"
+	"github/chapool/go-wallet/internal/wallet/balance"
+	"github/chapool/go-wallet/internal/wallet/price"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withAPITokenServer runs closure with a test server providing the services used by the API token tests.
+func withAPITokenServer(t *testing.T, closure func(s *api.Server)) {
+	t.Helper()
+
+	test.WithTestServer(t, func(s *api.Server) {
+		s.APIToken = apitoken.NewService(s.DB)
+		s.Balance = balance.NewService(s.DB)
+		s.Price = price.NewService(s.DB, nil)
+
+		closure(s)
+	})
+}
+
+func createAPIToken(t *testing.T, s *api.Server, req *apitoken.CreateRequest) (*apitoken.Token, string) {
+	t.Helper()
+
+	req.UserID = fixtures.Fixtures().User1.ID
+	req.Name = "test"
+	token, plaintext, err := s.APIToken.CreateToken(t.Context(), req)
+	require.NoError(t, err)
+
+	return token, plaintext
+}
+
+func TestAPITokenScopes(t *testing.T) {
+	withAPITokenServer(t, func(s *api.Server) {
+		_, plaintext := createAPIToken(t, s, &apitoken.CreateRequest{Scopes: []string{apitoken.ScopeWalletRead}})
+		headers := test.HeadersWithAuth(t, plaintext)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/wallet/balance/total", nil, headers)
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		// the token was not granted wallet:withdraw
+		res = test.PerformRequest(t, s, "POST", "/api/v1/wallet/withdraw", nil, headers)
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		// API token management is not available to API tokens at all
+		res = test.PerformRequest(t, s, "GET", "/api/v1/wallet/api-tokens", nil, headers)
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		// access tokens are not restricted by scopes
+		res = test.PerformRequest(t, s, "GET", "/api/v1/wallet/api-tokens", nil, test.HeadersWithAuth(t, fixtures.Fixtures().User1AccessToken1.Token))
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
+
+func TestAPITokenRevoked(t *testing.T) {
+	withAPITokenServer(t, func(s *api.Server) {
+		token, plaintext := createAPIToken(t, s, &apitoken.CreateRequest{Scopes: []string{apitoken.ScopeWalletRead}})
+		headers := test.HeadersWithAuth(t, plaintext)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/wallet/balance/total", nil, headers)
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		require.NoError(t, s.APIToken.RevokeToken(t.Context(), token.UserID, token.ID))
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/wallet/balance/total", nil, headers)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
+
+func TestAPITokenExpired(t *testing.T) {
+	withAPITokenServer(t, func(s *api.Server) {
+		expiresAt := time.Now().Add(time.Hour)
+		token, plaintext := createAPIToken(t, s, &apitoken.CreateRequest{
+			Scopes:    []string{apitoken.ScopeWalletRead},
+			ExpiresAt: &expiresAt,
+		})
+		headers := test.HeadersWithAuth(t, plaintext)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/wallet/balance/total", nil, headers)
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		_, err := s.DB.ExecContext(t.Context(), "UPDATE api_tokens SET expires_at = NOW() - INTERVAL '1 minute' WHERE id = $1", token.ID)
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/wallet/balance/total", nil, headers)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
+
+func TestAPITokenRateLimit(t *testing.T) {
+	withAPITokenServer(t, func(s *api.Server) {
+		rateLimit := 2
+		_, plaintext := createAPIToken(t, s, &apitoken.CreateRequest{
+			Scopes:             []string{apitoken.ScopeWalletRead},
+			RateLimitPerMinute: &rateLimit,
+		})
+		headers := test.HeadersWithAuth(t, plaintext)
+
+		for i := 0; i < rateLimit; i++ {
+			res := test.PerformRequest(t, s, "GET", "/api/v1/wallet/balance/total", nil, headers)
+			assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+		}
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/wallet/balance/total", nil, headers)
+		assert.Equal(t, http.StatusTooManyRequests, res.Result().StatusCode)
+
+		// the limit applies per token, other tokens and access tokens are not affected
+		_, other := createAPIToken(t, s, &apitoken.CreateRequest{
+			Scopes:             []string{apitoken.ScopeWalletRead},
+			RateLimitPerMinute: &rateLimit,
+		})
+		res = test.PerformRequest(t, s, "GET", "/api/v1/wallet/balance/total", nil, test.HeadersWithAuth(t, other))
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/wallet/balance/total", nil, test.HeadersWithAuth(t, fixtures.Fixtures().User1AccessToken1.Token))
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github/chapool/go-wallet/internal/api/httperrors"
+	"github/chapool/go-wallet/internal/types"
+	"github/chapool/go-wallet/internal/util"
+)
+
+const maxIdempotencyKeyLength = 255
+
+var (
+	DefaultIdempotencyConfig = IdempotencyConfig{
+		Skipper: middleware.DefaultSkipper,
+	}
+)
+
+type IdempotencyConfig struct {
+	Skipper middleware.Skipper
+}
+
+// Idempotency stores the Idempotency-Key request header in the request context, see util.IdempotencyKeyFromContext.
+// Handlers are responsible for deduplicating requests with the same key, the header is optional.
+func Idempotency() echo.MiddlewareFunc {
+	return IdempotencyWithConfig(DefaultIdempotencyConfig)
+}
+
+func IdempotencyWithConfig(config IdempotencyConfig) echo.MiddlewareFunc {
+	if config.Skipper == nil {
+		config.Skipper = DefaultIdempotencyConfig.Skipper
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if config.Skipper(c) {
+				return next(c)
+			}
+
+			key := c.Request().Header.Get(util.HTTPHeaderIdempotencyKey)
+			if len(key) == 0 {
+				return next(c)
+			}
+
+			if len(key) > maxIdempotencyKeyLength || !isPrintableASCII(key) {
+				return httperrors.NewHTTPError(http.StatusBadRequest, types.PublicHTTPErrorTypeGeneric, "Invalid Idempotency-Key header")
+			}
+
+			ctx := c.Request().Context()
+
+			l := util.LogFromContext(ctx).With().Str("idempotencyKey", key).Logger()
+			ctx = l.WithContext(ctx)
+
+			ctx = context.WithValue(ctx, util.CTXKeyIdempotency, key)
+
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
+
+func isPrintableASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < ' ' || s[i] > '~' {
+			return false
+		}
+	}
+
+	return true
+}
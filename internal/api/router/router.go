@@ -2,6 +2,7 @@ package router
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"html/template"
 	"net/http"
@@ -19,6 +20,8 @@ import (
 	"github/chapool/go-wallet/internal/api/handlers/constants"
 	"github/chapool/go-wallet/internal/api/middleware"
 	"github/chapool/go-wallet/internal/api/router/templates"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/apitoken"
 
 	// #nosec G108 - pprof handlers (conditionally made available via http.DefaultServeMux)
 	"net/http/pprof"
@@ -236,8 +239,25 @@ func Init(s *api.Server) error {
 		WellKnown: s.Echo.Group("/.well-known"),
 
 		// Your other endpoints, typically secured by bearer auth, available at /api/v1/**
-		APIV1Push:   s.Echo.Group("/api/v1/push", middleware.Auth(s)),
-		APIV1Wallet: s.Echo.Group("/api/v1/wallet", middleware.Auth(s)),
+		// Wallet endpoints additionally accept user API tokens, limited to the endpoints their scopes permit and to
+		// their requests per minute, admin mutations are recorded in the admin audit trail
+		APIV1Push: s.Echo.Group("/api/v1/push", middleware.Auth(s)),
+		APIV1Wallet: s.Echo.Group("/api/v1/wallet", middleware.AuthWithAPITokens(s), middleware.APITokenScopes(walletAPITokenScope), middleware.APITokenRateLimit(s), middleware.AdminAuditWithConfig(middleware.AdminAuditConfig{
+			S: s,
+			Skipper: func(c echo.Context) bool {
+				return !isWalletAdminMutation(s, c)
+			},
+		})),
+
+		// Endpoints for other backend services, uncacheable, secured by key auth (header), available at /internal/v1/**
+		// An empty internal API secret rejects all requests.
+		InternalV1: s.Echo.Group("/internal/v1", echoMiddleware.KeyAuthWithConfig(echoMiddleware.KeyAuthConfig{
+			KeyLookup: "header:" + util.HTTPHeaderInternalAPIKey,
+			Validator: func(key string, _ echo.Context) (bool, error) {
+				secret := s.Config.Internal.Secret
+				return secret != "" && subtle.ConstantTimeCompare([]byte(key), []byte(secret)) == 1, nil
+			},
+		}), middleware.NoCache()),
 	}
 
 	// ---
@@ -251,3 +271,35 @@ func Init(s *api.Server) error {
 
 	return nil
 }
+
+// walletAPITokenScope returns the scope a user API token requires for a wallet endpoint.
+// All other endpoints, e.g. admin endpoints and API token management, are not available to API tokens.
+func walletAPITokenScope(c echo.Context) string {
+	switch c.Request().Method + " " + c.Path() {
+	case "GET /api/v1/wallet/address",
+		"GET /api/v1/wallet/list",
+		"GET /api/v1/wallet/chains",
+		"GET /api/v1/wallet/balance/total",
+		"GET /api/v1/wallet/balance/tokens",
+		"GET /api/v1/wallet/balance/pending",
+		"GET /api/v1/wallet/deposits",
+		"GET /api/v1/wallet/deposits/pending",
+		"GET /api/v1/wallet/transactions",
+		"GET /api/v1/wallet/:chainId/deposit-uri",
+		"GET /api/v1/wallet/withdraws",
+		"GET /api/v1/wallet/stats":
+		return apitoken.ScopeWalletRead
+	case "POST /api/v1/wallet/withdraw":
+		return apitoken.ScopeWalletWithdraw
+	}
+	return ""
+}
+
+// isWalletAdminMutation reports whether a wallet endpoint is an admin mutation recorded in the admin audit trail.
+// Handlers mark admin mutations when attaching their routes (api.Router.AdminMutation).
+// Requests by non-admin users to these endpoints are recorded as well, they are rejected by the handlers.
+// POST /api/v1/wallet/admin/keystore/unlock is not marked: the audit trail stores a hash of the request body,
+// which would allow brute forcing the keystore password offline. The handler logs unlock attempts instead.
+func isWalletAdminMutation(s *api.Server, c echo.Context) bool {
+	return s.Router.IsAdminMutation(c.Request().Method, c.Path())
+}
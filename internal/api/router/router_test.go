@@ -1,10 +1,16 @@
 package router_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/go-openapi/swag"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -126,3 +132,56 @@ func TestNotFound(t *testing.T) {
 		})
 	})
 }
+
+func TestWalletAdminMutationsAreAudited(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		data, err := os.ReadFile(filepath.Join(s.Config.Paths.APIBaseDirAbs, "swagger.yml"))
+		require.NoError(t, err)
+		doc, err := swag.BytesToYAMLDoc(data)
+		require.NoError(t, err)
+		raw, err := swag.YAMLToJSON(doc)
+		require.NoError(t, err)
+
+		var spec struct {
+			Paths map[string]map[string]json.RawMessage `json:"paths"`
+		}
+		require.NoError(t, json.Unmarshal(raw, &spec))
+
+		// not recorded: the unlock request body contains the keystore password, dry runs do not change anything
+		skipped := map[string]bool{
+			"POST /api/v1/wallet/admin/keystore/unlock": true,
+			"POST /api/v1/wallet/deposit-rules/dry-run": true,
+		}
+
+		pathParam := regexp.MustCompile(`\{(\w+)\}`)
+		checked := 0
+		for path, operations := range spec.Paths {
+			if !strings.HasPrefix(path, "/api/v1/wallet/") {
+				continue
+			}
+			echoPath := pathParam.ReplaceAllString(path, ":$1")
+			for method, rawOperation := range operations {
+				method = strings.ToUpper(method)
+				if method != http.MethodPost && method != http.MethodPut && method != http.MethodDelete {
+					continue
+				}
+				var operation struct {
+					Summary string `json:"summary"`
+				}
+				require.NoError(t, json.Unmarshal(rawOperation, &operation))
+
+				key := method + " " + echoPath
+				if !strings.Contains(operation.Summary, "(Admin only)") || skipped[key] {
+					continue
+				}
+				assert.True(t, s.Router.IsAdminMutation(method, echoPath), "admin mutation %s is not recorded in the admin audit trail", key)
+				checked++
+			}
+		}
+		assert.Positive(t, checked)
+
+		assert.True(t, s.Router.IsAdminMutation(http.MethodPut, "/api/v1/wallet/withdraw/:withdrawId/expiry"))
+		assert.False(t, s.Router.IsAdminMutation(http.MethodPost, "/api/v1/wallet/admin/keystore/unlock"))
+		assert.False(t, s.Router.IsAdminMutation(http.MethodPost, "/api/v1/wallet/withdraw"))
+	})
+}
@@ -7,6 +7,11 @@ import (
 	"fmt"
 	"net/http"
 
+	"github/chapool/go-wallet/internal/wallet/archive"
+	"github/chapool/go-wallet/internal/wallet/organization"
+	"github/chapool/go-wallet/internal/wallet/smartaccount"
+	"github/chapool/go-wallet/internal/wallet/statement"
+
 	"github.com/dropbox/godropbox/time2"
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog/log"
@@ -18,13 +23,36 @@ import (
 	"github/chapool/go-wallet/internal/metrics"
 	"github/chapool/go-wallet/internal/push"
 	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/util/lifecycle"
 	"github/chapool/go-wallet/internal/wallet"
+	"github/chapool/go-wallet/internal/wallet/addressbook"
+	"github/chapool/go-wallet/internal/wallet/allowance"
+	"github/chapool/go-wallet/internal/wallet/apitoken"
+	"github/chapool/go-wallet/internal/wallet/audit"
 	"github/chapool/go-wallet/internal/wallet/balance"
 	"github/chapool/go-wallet/internal/wallet/collect"
 	"github/chapool/go-wallet/internal/wallet/deposit"
+	"github/chapool/go-wallet/internal/wallet/diagnostics"
+	"github/chapool/go-wallet/internal/wallet/dust"
+	"github/chapool/go-wallet/internal/wallet/export"
+	"github/chapool/go-wallet/internal/wallet/gasguard"
 	"github/chapool/go-wallet/internal/wallet/hotwallet"
+	"github/chapool/go-wallet/internal/wallet/ledger"
+	"github/chapool/go-wallet/internal/wallet/maintenance"
+	"github/chapool/go-wallet/internal/wallet/nft"
+	"github/chapool/go-wallet/internal/wallet/notification"
+	"github/chapool/go-wallet/internal/wallet/price"
+	"github/chapool/go-wallet/internal/wallet/quarantine"
 	"github/chapool/go-wallet/internal/wallet/rebalance"
+	"github/chapool/go-wallet/internal/wallet/risk"
 	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/scanhealth"
+	"github/chapool/go-wallet/internal/wallet/settings"
+	"github/chapool/go-wallet/internal/wallet/stats"
+	"github/chapool/go-wallet/internal/wallet/token"
+	"github/chapool/go-wallet/internal/wallet/trace"
+	"github/chapool/go-wallet/internal/wallet/transaction"
+	"github/chapool/go-wallet/internal/wallet/transfer"
 	"github/chapool/go-wallet/internal/wallet/withdraw"
 
 	// Import postgres driver for database/sql package
@@ -37,6 +65,10 @@ type WalletService interface {
 	GetWallet(ctx context.Context, userID string, chainID int) (*wallet.Wallet, error)
 	ListWallets(ctx context.Context, userID string) ([]*wallet.Wallet, error)
 	GetWalletByAddress(ctx context.Context, address string, chainID int) (*wallet.Wallet, error)
+	RegisterWatchAddress(ctx context.Context, userID string, chainID int, address string) (*wallet.Wallet, error)
+	ListWatchAddresses(ctx context.Context, userID *string, chainID *int) ([]*wallet.Wallet, error)
+	RemoveWatchAddress(ctx context.Context, walletID string) error
+	SetSmartAccounts(smartAccounts smartaccount.Service)
 }
 
 // SignerService interface for transaction signing operations
@@ -69,6 +101,93 @@ type RebalanceService = rebalance.Service
 // HotWalletService interface for managing hot wallets
 type HotWalletService = hotwallet.Service
 
+// HotWalletMonitorService interface for hot wallet balance monitoring
+type HotWalletMonitorService = hotwallet.Monitor
+
+// ScanHealthService interface for scanner lag sampling, lag alerts and lag history
+type ScanHealthService = scanhealth.Service
+
+// SystemWalletVerifierService interface for verifying that system wallet derivation paths derive their stored addresses
+type SystemWalletVerifierService = hotwallet.Verifier
+
+// GasGuardService interface for the gas spike circuit breaker
+type GasGuardService = gasguard.Guard
+
+// GasPriceCapService interface for the per chain gas price caps of outgoing transactions
+type GasPriceCapService = gasguard.PriceCap
+
+// StatsService interface for user wallet statistics
+type StatsService = stats.Service
+
+// LedgerService interface for ledger, invariants and reconciliation
+type LedgerService = ledger.Service
+
+// DustService interface for consolidation of dust balances
+type DustService = dust.Service
+
+// RiskService interface for withdraw limits and velocity controls
+type RiskService = risk.Service
+
+// APITokenService interface for user API tokens
+type APITokenService = apitoken.Service
+
+// AddressBookService interface for user withdraw address books
+type AddressBookService = addressbook.Service
+
+// TokenService interface for managing registered tokens
+type TokenService = token.Service
+
+// TransactionService interface for querying scanned on-chain transactions
+type TransactionService = transaction.Service
+
+// ExportService interface for exporting deposits, withdraws and collects
+type ExportService = export.Service
+
+// QuarantineService interface for deposits quarantined by the source address screening
+type QuarantineService = quarantine.Service
+
+// MaintenanceService interface for database bloat, index health and slow query diagnostics
+type MaintenanceService = maintenance.Service
+
+// NotificationService interface for user security notifications
+type NotificationService = notification.Service
+
+// AdminAuditService interface for the audit trail of admin mutations
+type AdminAuditService = audit.Service
+
+// TransferService interface for off-chain transfers between users
+type TransferService = transfer.Service
+
+// DepositTraceService interface for self-service troubleshooting of missing deposits
+type DepositTraceService = trace.Service
+
+// PriceService interface for USD token prices
+type PriceService = price.Service
+
+// SettingsService interface for system settings changed by admins at runtime (maintenance mode)
+type SettingsService = settings.Service
+
+// KeystoreLockService interface for locking and unlocking the seed at runtime
+type KeystoreLockService = wallet.KeystoreLock
+
+// DiagnosticsService interface for the diagnostics bundle attached to incident tickets
+type DiagnosticsService = diagnostics.Service
+
+// StatementService interface for daily balance snapshots and monthly balance statements
+type StatementService = statement.Service
+
+// ArchiveService interface for archiving old scanned blocks and transactions
+type ArchiveService = archive.Service
+
+// NFTService interface for NFT deposits held in custody on deposit addresses and their withdraws
+type NFTService = nft.Service
+
+// OrganizationService interface for tenants isolating wallets, credits, withdraw limits and hot wallets
+type OrganizationService = organization.Service
+
+// AllowanceService interface for tracking and revoking ERC20 allowances granted by hot wallets
+type AllowanceService = allowance.Service
+
 // SignEVMRequest represents a request to sign an EVM transaction
 type SignEVMRequest struct {
 	ChainID              int64
@@ -96,7 +215,28 @@ type Router struct {
 	APIV1Auth   *echo.Group
 	APIV1Push   *echo.Group
 	APIV1Wallet *echo.Group
+	InternalV1  *echo.Group
 	WellKnown   *echo.Group
+
+	// adminMutations holds the routes ("METHOD path") marked with AdminMutation
+	adminMutations map[string]struct{}
+}
+
+// AdminMutation marks route as an admin mutation recorded in the admin audit trail and returns it.
+// Routes are marked while the handlers are attached, before the server serves requests.
+func (r *Router) AdminMutation(route *echo.Route) *echo.Route {
+	if r.adminMutations == nil {
+		r.adminMutations = make(map[string]struct{})
+	}
+	r.adminMutations[route.Method+" "+route.Path] = struct{}{}
+
+	return route
+}
+
+// IsAdminMutation reports whether the route registered for method and path is marked as an admin mutation.
+func (r *Router) IsAdminMutation(method string, path string) bool {
+	_, ok := r.adminMutations[method+" "+path]
+	return ok
 }
 
 // Server is a central struct keeping all the dependencies.
@@ -132,6 +272,62 @@ type Server struct {
 	HotWallet HotWalletService
 	Collect   CollectService
 	Rebalance RebalanceService
+	Stats     StatsService
+	Ledger    LedgerService
+	Dust      DustService
+	Risk      RiskService
+	// Security notifications to users (large withdraws, new withdraw addresses, whitelist changes)
+	Notification NotificationService
+	// Hot wallet balances compared with minimum balances and pending withdraws
+	HotWalletMonitor HotWalletMonitorService
+	// Scanner lag per chain sampled over time, alerting on a sustained time-weighted average lag
+	ScanHealth ScanHealthService
+	// Hot wallet and verification address derivation paths re-derived from the loaded seed
+	SystemWalletVerifier SystemWalletVerifierService
+	// User API tokens for programmatic access to wallet endpoints
+	APIToken APITokenService
+	// Gas spike circuit breaker pausing automatic operations while the base fee exceeds the ceiling
+	GasGuard GasGuardService
+	// Admin management of registered tokens, validated on-chain
+	Token TokenService
+	// Admin queries over scanned on-chain transactions with keyset pagination
+	Transaction TransactionService
+	// Background workers (scanners, schedulers) drained on shutdown
+	Lifecycle *lifecycle.Manager
+	// CSV/XLSX exports of deposits, withdraws and collects
+	Export ExportService
+	// Deposits from flagged source addresses held for compliance review
+	Quarantine QuarantineService
+	// Database diagnostics reported periodically and via the admin endpoint
+	Maintenance MaintenanceService
+	// Audit trail of admin mutations, recorded by the admin audit middleware
+	AdminAudit AdminAuditService
+	// Off-chain transfers between users, recorded as a pair of credits
+	Transfer TransferService
+	// Self-service troubleshooting of deposits that have not been credited
+	DepositTrace DepositTraceService
+	// USD token prices from the configured price provider or set by admins
+	Price PriceService
+	// System settings changed by admins at runtime, such as the maintenance mode pausing withdraw processing
+	Settings SettingsService
+	// Locks the seed (manually or after the auto-lock timeout) and unlocks it with the keystore password
+	KeystoreLock KeystoreLockService
+	// Scanner status, RPC health, queue depths, hot wallet balances, stuck withdraws, reorgs and errors in a single bundle
+	Diagnostics DiagnosticsService
+	// Withdraw address book of users, optionally restricting withdraws to confirmed addresses after a cooling period
+	AddressBook AddressBookService
+	// Per chain gas price caps deferring outgoing transactions, overridable by admins for urgent payouts
+	GasPriceCap GasPriceCapService
+	// Daily per user per token balance snapshots backing the monthly balance statements
+	Statement StatementService
+	// Archival of old finalized blocks and settled transactions out of the live tables
+	Archive ArchiveService
+	// ERC-721 / ERC-1155 deposits held in custody on user deposit addresses, withdrawn by admins
+	NFT NFTService
+	// Tenants sharing the deployment and seed, with wallets, credits, default withdraw limits and hot wallets scoped per organization
+	Organization OrganizationService
+	// ERC20 allowances granted by hot wallets, recorded from scanned Approval events and revoked by admins
+	Allowance AllowanceService
 }
 
 // newServerWithComponents is used by wire to initialize the server components.
@@ -149,15 +345,16 @@ func newServerWithComponents(
 	metrics *metrics.Service,
 ) *Server {
 	return &Server{
-		Config:  cfg,
-		DB:      db,
-		Mailer:  mail,
-		Push:    pusher,
-		I18n:    i18n,
-		Clock:   clock,
-		Auth:    auth,
-		Local:   local,
-		Metrics: metrics,
+		Config:    cfg,
+		DB:        db,
+		Mailer:    mail,
+		Push:      pusher,
+		I18n:      i18n,
+		Clock:     clock,
+		Auth:      auth,
+		Local:     local,
+		Metrics:   metrics,
+		Lifecycle: lifecycle.New(),
 	}
 }
 
@@ -176,7 +373,8 @@ type AuthService interface {
 
 func NewServer(config config.Server) *Server {
 	s := &Server{
-		Config: config,
+		Config:    config,
+		Lifecycle: lifecycle.New(),
 	}
 
 	return s
@@ -208,20 +406,30 @@ func (s *Server) Shutdown(ctx context.Context) []error {
 
 	var errs []error
 
-	if s.DB != nil {
-		log.Debug().Msg("Closing database connection")
+	// Stop accepting requests first, in-flight requests still use the background services and the database
+	if s.Echo != nil {
+		log.Debug().Msg("Shutting down echo server")
 
-		if err := s.DB.Close(); err != nil && !errors.Is(err, sql.ErrConnDone) {
-			log.Error().Err(err).Msg("Failed to close database connection")
+		if err := s.Echo.Shutdown(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error().Err(err).Msg("Failed to shutdown echo server")
 			errs = append(errs, err)
 		}
 	}
 
-	if s.Echo != nil {
-		log.Debug().Msg("Shutting down echo server")
+	// Wait for background workers to finish in-flight signing and broadcasting before closing the database
+	if s.Lifecycle != nil {
+		log.Debug().Strs("workers", s.Lifecycle.Running()).Msg("Draining background workers")
 
-		if err := s.Echo.Shutdown(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			log.Error().Err(err).Msg("Failed to shutdown echo server")
+		if err := s.Lifecycle.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if s.DB != nil {
+		log.Debug().Msg("Closing database connection")
+
+		if err := s.DB.Close(); err != nil && !errors.Is(err, sql.ErrConnDone) {
+			log.Error().Err(err).Msg("Failed to close database connection")
 			errs = append(errs, err)
 		}
 	}
@@ -20,6 +20,10 @@ func EnrichContextWithCredentials(ctx context.Context, result Result) context.Co
 	ctx = context.WithValue(ctx, util.CTXKeyUser, result.User)
 	// Store access token used for authentication in context
 	ctx = context.WithValue(ctx, util.CTXKeyAccessToken, result.Token)
+	// Store permissions of the user API token used for authentication, if any
+	if result.APIToken != nil {
+		ctx = context.WithValue(ctx, util.CTXKeyAPIToken, result.APIToken)
+	}
 
 	return ctx
 }
@@ -30,6 +34,8 @@ func EnrichEchoContextWithCredentials(c echo.Context, result Result) echo.Contex
 	// Get current context and enrich it with credentials
 	req := c.Request()
 	ctx := EnrichContextWithCredentials(req.Context(), result)
+	// Store the device the authenticated request originates from, e.g. for security notifications
+	ctx = context.WithValue(ctx, util.CTXKeyDevice, &Device{IP: c.RealIP(), UserAgent: req.UserAgent()})
 
 	// Set updated request with enriched context in echo context
 	c.SetRequest(req.WithContext(ctx))
@@ -80,3 +86,35 @@ func AccessTokenFromContext(ctx context.Context) *string {
 func AccessTokenFromEchoContext(c echo.Context) *string {
 	return AccessTokenFromContext(c.Request().Context())
 }
+
+// DeviceFromContext returns the device the authenticated request originates from. If the current context was not enriched
+// by EnrichEchoContextWithCredentials, nil will be returned instead.
+func DeviceFromContext(ctx context.Context) *Device {
+	d := ctx.Value(util.CTXKeyDevice)
+	if d == nil {
+		return nil
+	}
+
+	device, ok := d.(*Device)
+	if !ok {
+		return nil
+	}
+
+	return device
+}
+
+// APITokenFromContext returns the user API token used for authentication from a context. If the request was authenticated
+// by an access token or the current context does not carry any authentication, nil will be returned instead.
+func APITokenFromContext(ctx context.Context) *APIToken {
+	t := ctx.Value(util.CTXKeyAPIToken)
+	if t == nil {
+		return nil
+	}
+
+	token, ok := t.(*APIToken)
+	if !ok {
+		return nil
+	}
+
+	return token
+}
@@ -11,4 +11,33 @@ type Result struct {
 	User       *dto.User
 	ValidUntil time.Time
 	Scopes     []string
+	// APIToken is set if the request was authenticated by a user API token instead of an access token.
+	APIToken *APIToken
+}
+
+// APIToken describes the permissions of the user API token a request was authenticated with.
+type APIToken struct {
+	ID                string
+	Scopes            []string
+	WithdrawTokenID   *int
+	MaxWithdrawAmount *string
+	// RateLimitPerMinute is the number of requests the token may make per minute (0 = unlimited).
+	RateLimitPerMinute int
+}
+
+// HasScope checks if the API token was granted the given scope.
+func (t *APIToken) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Device describes the client an authenticated request originates from.
+type Device struct {
+	IP        string
+	UserAgent string
 }
@@ -80,9 +80,16 @@ type ManagementServer struct {
 	EnableMetrics           bool
 }
 
+// InternalAPIServer configures the /internal/v1 API used by other backend services.
+// An empty secret disables the internal API (all requests are rejected).
+type InternalAPIServer struct {
+	Secret string `json:"-"` // sensitive
+}
+
 type FrontendServer struct {
-	BaseURL               string
-	PasswordResetEndpoint string
+	BaseURL                        string
+	PasswordResetEndpoint          string
+	WithdrawAddressConfirmEndpoint string
 }
 
 type LoggerServer struct {
@@ -102,12 +109,6 @@ type I18n struct {
 	BundleDirAbs    string
 }
 
-type Wallet struct {
-	EnableAutoCollect   bool
-	EnableAutoRebalance bool
-	EnableSigning       bool
-}
-
 type Server struct {
 	Database   Database
 	Echo       EchoServer
@@ -115,6 +116,7 @@ type Server struct {
 	Paths      PathsServer
 	Auth       AuthServer
 	Management ManagementServer
+	Internal   InternalAPIServer
 	Mailer     Mailer
 	SMTP       transport.SMTPMailTransportConfig
 	Frontend   FrontendServer
@@ -219,6 +221,9 @@ func DefaultServiceConfigFromEnv() Server {
 			ProbeWriteableTouchfile: util.GetEnv("SERVER_MANAGEMENT_PROBE_WRITEABLE_TOUCHFILE", ".healthy"),
 			EnableMetrics:           util.GetEnvAsBool("SERVER_MANAGEMENT_ENABLE_METRICS", false),
 		},
+		Internal: InternalAPIServer{
+			Secret: util.GetEnv("SERVER_INTERNAL_API_SECRET", ""),
+		},
 		Mailer: Mailer{
 			DefaultSender:               util.GetEnv("SERVER_MAILER_DEFAULT_SENDER", "go-starter@example.com"),
 			Send:                        util.GetEnvAsBool("SERVER_MAILER_SEND", true),
@@ -235,8 +240,9 @@ func DefaultServiceConfigFromEnv() Server {
 			TLSConfig:  nil,
 		},
 		Frontend: FrontendServer{
-			BaseURL:               util.GetEnv("SERVER_FRONTEND_BASE_URL", "http://localhost:3000"),
-			PasswordResetEndpoint: util.GetEnv("SERVER_FRONTEND_PASSWORD_RESET_ENDPOINT", "/set-new-password"),
+			BaseURL:                        util.GetEnv("SERVER_FRONTEND_BASE_URL", "http://localhost:3000"),
+			PasswordResetEndpoint:          util.GetEnv("SERVER_FRONTEND_PASSWORD_RESET_ENDPOINT", "/set-new-password"),
+			WithdrawAddressConfirmEndpoint: util.GetEnv("SERVER_FRONTEND_WITHDRAW_ADDRESS_CONFIRM_ENDPOINT", "/confirm-withdraw-address"),
 		},
 		Logger: LoggerServer{
 			Level:              util.LogLevelFromString(util.GetEnv("SERVER_LOGGER_LEVEL", zerolog.DebugLevel.String())),
@@ -263,9 +269,173 @@ func DefaultServiceConfigFromEnv() Server {
 			BundleDirAbs:    util.GetEnv("SERVER_I18N_BUNDLE_DIR_ABS", filepath.Join(util.GetProjectRootDir(), "/web/i18n")), // /app/web/i18n
 		},
 		Wallet: Wallet{
-			EnableAutoCollect:   util.GetEnvAsBool("WALLET_ENABLE_AUTO_COLLECT", false),
-			EnableAutoRebalance: util.GetEnvAsBool("WALLET_ENABLE_AUTO_REBALANCE", false),
-			EnableSigning:       util.GetEnvAsBool("WALLET_ENABLE_SIGNING", true),
+			EnableAutoCollect:       util.GetEnvAsBool("WALLET_ENABLE_AUTO_COLLECT", false),
+			EnableAutoRebalance:     util.GetEnvAsBool("WALLET_ENABLE_AUTO_REBALANCE", false),
+			EnableSigning:           util.GetEnvAsBool("WALLET_ENABLE_SIGNING", true),
+			EnableDustConsolidation: util.GetEnvAsBool("WALLET_ENABLE_DUST_CONSOLIDATION", false),
+
+			ScanInterval:                 time.Millisecond * time.Duration(util.GetEnvAsInt("WALLET_SCAN_INTERVAL_MS", 2000)),
+			BlockBatchSize:               util.GetEnvAsInt("WALLET_BLOCK_BATCH_SIZE", 1000),
+			RPCBatchSize:                 util.GetEnvAsInt("WALLET_RPC_BATCH_SIZE", 100),
+			DepositBackfillInterval:      time.Second * time.Duration(util.GetEnvAsInt("WALLET_DEPOSIT_BACKFILL_INTERVAL_SEC", 30)),
+			WithdrawConfirmationInterval: time.Second * time.Duration(util.GetEnvAsInt("WALLET_WITHDRAW_CONFIRMATION_INTERVAL_SEC", 15)),
+			CollectInterval:              time.Second * time.Duration(util.GetEnvAsInt("WALLET_COLLECT_INTERVAL_SEC", 300)),
+			RebalanceInterval:            time.Second * time.Duration(util.GetEnvAsInt("WALLET_REBALANCE_INTERVAL_SEC", 600)),
+			LedgerInvariantsInterval:     time.Second * time.Duration(util.GetEnvAsInt("WALLET_LEDGER_INVARIANTS_INTERVAL_SEC", 3600)),
+			DustConsolidationInterval:    time.Second * time.Duration(util.GetEnvAsInt("WALLET_DUST_CONSOLIDATION_INTERVAL_SEC", 86400)),
+			BackfillInterval:             time.Second * time.Duration(util.GetEnvAsInt("WALLET_BACKFILL_INTERVAL_SEC", 30)),
+			WithdrawWindowInterval:       time.Second * time.Duration(util.GetEnvAsInt("WALLET_WITHDRAW_WINDOW_INTERVAL_SEC", 60)),
+			WithdrawOutboxInterval:       time.Second * time.Duration(util.GetEnvAsInt("WALLET_WITHDRAW_OUTBOX_INTERVAL_SEC", 10)),
+			ChainHaltThreshold:           time.Second * time.Duration(util.GetEnvAsInt("WALLET_CHAIN_HALT_THRESHOLD_SEC", 300)),
+			HotWalletMonitorInterval:     time.Second * time.Duration(util.GetEnvAsInt("WALLET_HOT_WALLET_MONITOR_INTERVAL_SEC", 300)),
+			SystemWalletVerifyInterval:   time.Second * time.Duration(util.GetEnvAsInt("WALLET_SYSTEM_WALLET_VERIFY_INTERVAL_SEC", 3600)),
+			ChainConfigReloadInterval:    time.Second * time.Duration(util.GetEnvAsInt("WALLET_CHAIN_CONFIG_RELOAD_INTERVAL_SEC", 300)),
+			DatabaseMaintenanceInterval:  time.Second * time.Duration(util.GetEnvAsInt("WALLET_DATABASE_MAINTENANCE_INTERVAL_SEC", 86400)),
+			StatusPushInterval:           time.Second * time.Duration(util.GetEnvAsInt("WALLET_STATUS_PUSH_INTERVAL_SEC", 5)),
+			BalanceSnapshotInterval:      time.Second * time.Duration(util.GetEnvAsInt("WALLET_BALANCE_SNAPSHOT_INTERVAL_SEC", 3600)),
+			NFTWithdrawInterval:          time.Second * time.Duration(util.GetEnvAsInt("WALLET_NFT_WITHDRAW_INTERVAL_SEC", 30)),
+			SeedAutoLockAfter:            time.Second * time.Duration(util.GetEnvAsInt("WALLET_SEED_AUTO_LOCK_SEC", 0)),
+			WorkerConcurrency:            util.GetEnvAsInt("WALLET_WORKER_CONCURRENCY", 1),
+			Collect: WalletCollect{
+				MinNativeAmountWei:  util.GetEnv("WALLET_COLLECT_MIN_NATIVE_AMOUNT_WEI", "5000000000000000"), // 0.005 native token
+				MinERC20Amount:      util.GetEnv("WALLET_COLLECT_MIN_ERC20_AMOUNT", "1"),
+				ReceiptPollInterval: time.Second * time.Duration(util.GetEnvAsInt("WALLET_COLLECT_RECEIPT_POLL_INTERVAL_SEC", 3)),
+				ReceiptTimeout:      time.Second * time.Duration(util.GetEnvAsInt("WALLET_COLLECT_RECEIPT_TIMEOUT_SEC", 120)),
+			},
+			Rebalance: WalletRebalance{
+				MinBalanceWei:       util.GetEnv("WALLET_REBALANCE_MIN_BALANCE_WEI", "3000000000000000000"), // 3 native token
+				MaxBalanceWei:       util.GetEnv("WALLET_REBALANCE_MAX_BALANCE_WEI", "8000000000000000000"), // 8 native token
+				ReceiptPollInterval: time.Second * time.Duration(util.GetEnvAsInt("WALLET_REBALANCE_RECEIPT_POLL_INTERVAL_SEC", 3)),
+				ReceiptTimeout:      time.Second * time.Duration(util.GetEnvAsInt("WALLET_REBALANCE_RECEIPT_TIMEOUT_SEC", 120)),
+			},
+			Fees: WalletFees{
+				BaseFeeMultiplier:        int64(util.GetEnvAsInt("WALLET_FEES_BASE_FEE_MULTIPLIER", 2)),
+				LegacyTxChainIDs:         parseChainIDs("WALLET_FEES_LEGACY_TX_CHAINS", util.GetEnvAsStringArr("WALLET_FEES_LEGACY_TX_CHAINS", []string{})),
+				WithdrawFeeAccountUserID: util.GetEnv("WALLET_FEES_WITHDRAW_FEE_ACCOUNT_USER_ID", ""),
+			},
+			Backfill: WalletBackfill{
+				BlocksPerSecond: util.GetEnvAsInt("WALLET_BACKFILL_BLOCKS_PER_SECOND", 20),
+			},
+			Alerts: WalletAlerts{
+				WebhookURL:      util.GetEnv("WALLET_ALERT_WEBHOOK_URL", ""),
+				EmailRecipients: util.GetEnvAsStringArrTrimmed("WALLET_ALERT_EMAIL_RECIPIENTS", []string{}),
+			},
+			Compliance: WalletCompliance{
+				WebhookURL:      util.GetEnv("WALLET_COMPLIANCE_WEBHOOK_URL", ""),
+				EmailRecipients: util.GetEnvAsStringArrTrimmed("WALLET_COMPLIANCE_EMAIL_RECIPIENTS", []string{}),
+				ProviderURL:     util.GetEnv("WALLET_COMPLIANCE_PROVIDER_URL", ""),
+				ProviderAPIKey:  util.GetEnv("WALLET_COMPLIANCE_PROVIDER_API_KEY", ""),
+				ProviderTimeout: time.Second * time.Duration(util.GetEnvAsInt("WALLET_COMPLIANCE_PROVIDER_TIMEOUT_SEC", 10)),
+			},
+			GasSpike: WalletGasSpike{
+				Ceilings:      parseGasCeilings("WALLET_GAS_SPIKE_CEILINGS", util.GetEnvAsStringArr("WALLET_GAS_SPIKE_CEILINGS", []string{})),
+				ResumePercent: util.GetEnvAsInt("WALLET_GAS_SPIKE_RESUME_PERCENT", 80),
+				WithdrawMode:  util.GetEnv("WALLET_GAS_SPIKE_WITHDRAW_MODE", WalletGasSpikeWithdrawModeQueue),
+				CheckInterval: time.Second * time.Duration(util.GetEnvAsInt("WALLET_GAS_SPIKE_CHECK_INTERVAL_SEC", 30)),
+			},
+			GasPriceCap: WalletGasPriceCap{
+				Caps:        parseMaxGasPrices("WALLET_GAS_PRICE_CAPS", util.GetEnvAsStringArr("WALLET_GAS_PRICE_CAPS", []string{})),
+				RetryDelays: parseSeconds("WALLET_GAS_PRICE_CAP_RETRY_DELAYS_SEC", util.GetEnvAsStringArr("WALLET_GAS_PRICE_CAP_RETRY_DELAYS_SEC", []string{"60", "300", "900", "1800", "3600"})),
+			},
+			WithdrawRateLimit: WalletWithdrawRateLimit{
+				MaxRequests: util.GetEnvAsInt("WALLET_WITHDRAW_RATE_LIMIT_MAX_REQUESTS", 10),
+				Window:      time.Second * time.Duration(util.GetEnvAsInt("WALLET_WITHDRAW_RATE_LIMIT_WINDOW_SEC", 3600)),
+			},
+			APITokenRateLimitPerMinute: util.GetEnvAsInt("WALLET_API_TOKEN_RATE_LIMIT_PER_MINUTE", 600),
+			WithdrawAddress: WalletWithdrawAddress{
+				ContractAllowlist:             parseWithdrawContracts("WALLET_WITHDRAW_CONTRACT_ALLOWLIST", util.GetEnvAsStringArr("WALLET_WITHDRAW_CONTRACT_ALLOWLIST", []string{})),
+				InternalMode:                  util.GetEnv("WALLET_WITHDRAW_INTERNAL_ADDRESS_MODE", WalletWithdrawInternalModeReject),
+				WhitelistCoolingPeriod:        time.Second * time.Duration(util.GetEnvAsInt("WALLET_WITHDRAW_WHITELIST_COOLING_PERIOD_SEC", 86400)),
+				WhitelistConfirmationValidity: time.Second * time.Duration(util.GetEnvAsInt("WALLET_WITHDRAW_WHITELIST_CONFIRMATION_VALIDITY_SEC", 3600)),
+			},
+			DepositTraceRateLimit: WalletDepositTraceRateLimit{
+				MaxRequests: util.GetEnvAsInt("WALLET_DEPOSIT_TRACE_RATE_LIMIT_MAX_REQUESTS", 10),
+				Window:      time.Second * time.Duration(util.GetEnvAsInt("WALLET_DEPOSIT_TRACE_RATE_LIMIT_WINDOW_SEC", 600)),
+			},
+			DustConsolidation: WalletDustConsolidation{
+				AccountUserID: util.GetEnv("WALLET_DUST_CONSOLIDATION_ACCOUNT_USER_ID", ""),
+				MinIdle:       24 * time.Hour * time.Duration(util.GetEnvAsInt("WALLET_DUST_CONSOLIDATION_MIN_IDLE_DAYS", 90)),
+			},
+			Events: WalletEvents{
+				Publisher:       util.GetEnv("WALLET_EVENTS_PUBLISHER", ""),
+				URL:             util.GetEnv("WALLET_EVENTS_URL", ""),
+				Topic:           util.GetEnv("WALLET_EVENTS_TOPIC", "wallet.events"),
+				BatchSize:       util.GetEnvAsInt("WALLET_EVENTS_BATCH_SIZE", 500),
+				PublishInterval: time.Millisecond * time.Duration(util.GetEnvAsInt("WALLET_EVENTS_PUBLISH_INTERVAL_MS", 1000)),
+				Retention:       24 * time.Hour * time.Duration(util.GetEnvAsInt("WALLET_EVENTS_RETENTION_DAYS", 30)),
+			},
+			Archive: WalletArchive{
+				Enabled:   util.GetEnvAsBool("WALLET_ARCHIVE_ENABLED", false),
+				Mode:      util.GetEnv("WALLET_ARCHIVE_MODE", WalletArchiveModeArchive),
+				MinAge:    24 * time.Hour * time.Duration(util.GetEnvAsInt("WALLET_ARCHIVE_MIN_AGE_DAYS", 90)),
+				BatchSize: util.GetEnvAsInt("WALLET_ARCHIVE_BATCH_SIZE", 5000),
+				Interval:  time.Second * time.Duration(util.GetEnvAsInt("WALLET_ARCHIVE_INTERVAL_SEC", 3600)),
+			},
+			AddressIndexRecovery: WalletAddressIndexRecovery{
+				OnStartup: util.GetEnvAsBool("WALLET_ADDRESS_INDEX_RECOVERY_ON_STARTUP", false),
+				GapLimit:  util.GetEnvAsInt("WALLET_ADDRESS_INDEX_GAP_LIMIT", 20),
+			},
+			FrozenCredits: WalletFrozenCredits{
+				Interval:     time.Second * time.Duration(util.GetEnvAsInt("WALLET_FROZEN_CREDITS_INTERVAL_SEC", 3600)),
+				ReleaseAfter: time.Hour * time.Duration(util.GetEnvAsInt("WALLET_FROZEN_CREDITS_RELEASE_AFTER_HOURS", 168)),
+				StaleAfter:   time.Hour * time.Duration(util.GetEnvAsInt("WALLET_FROZEN_CREDITS_STALE_AFTER_HOURS", 24)),
+			},
+			WithdrawExpiry: WalletWithdrawExpiry{
+				Interval:      time.Second * time.Duration(util.GetEnvAsInt("WALLET_WITHDRAW_EXPIRY_INTERVAL_SEC", 300)),
+				RequestExpiry: time.Hour * time.Duration(util.GetEnvAsInt("WALLET_WITHDRAW_EXPIRY_HOURS", 72)),
+			},
+			ScanFailures: WalletScanFailures{
+				RetryInterval: time.Second * time.Duration(util.GetEnvAsInt("WALLET_SCAN_FAILURE_RETRY_INTERVAL_SEC", 60)),
+				MaxAttempts:   util.GetEnvAsInt("WALLET_SCAN_FAILURE_MAX_ATTEMPTS", 10),
+			},
+			ScanLag: WalletScanLag{
+				SampleInterval:          time.Second * time.Duration(util.GetEnvAsInt("WALLET_SCAN_LAG_SAMPLE_INTERVAL_SEC", 60)),
+				Window:                  time.Second * time.Duration(util.GetEnvAsInt("WALLET_SCAN_LAG_WINDOW_SEC", 600)),
+				WarningBlocks:           util.GetEnvAsInt("WALLET_SCAN_LAG_WARNING_BLOCKS", 100),
+				CriticalBlocks:          util.GetEnvAsInt("WALLET_SCAN_LAG_CRITICAL_BLOCKS", 500),
+				WarningBlocksOverrides:  parseChainBlockOverrides("WALLET_SCAN_LAG_WARNING_BLOCKS_OVERRIDES", util.GetEnvAsStringArr("WALLET_SCAN_LAG_WARNING_BLOCKS_OVERRIDES", []string{})),
+				CriticalBlocksOverrides: parseChainBlockOverrides("WALLET_SCAN_LAG_CRITICAL_BLOCKS_OVERRIDES", util.GetEnvAsStringArr("WALLET_SCAN_LAG_CRITICAL_BLOCKS_OVERRIDES", []string{})),
+				Retention:               24 * time.Hour * time.Duration(util.GetEnvAsInt("WALLET_SCAN_LAG_RETENTION_DAYS", 7)),
+			},
+			Derivation: WalletDerivation{
+				CoinTypes: parseChainTypeIndexes("WALLET_DERIVATION_COIN_TYPES", util.GetEnvAsStringArr("WALLET_DERIVATION_COIN_TYPES", []string{"evm:60", "solana:501", "bitcoin:0"})),
+				Accounts:  parseChainTypeIndexes("WALLET_DERIVATION_ACCOUNTS", util.GetEnvAsStringArr("WALLET_DERIVATION_ACCOUNTS", []string{})),
+			},
+			Prices: WalletPrices{
+				Provider:         util.GetEnv("WALLET_PRICES_PROVIDER", ""),
+				UpdateInterval:   time.Second * time.Duration(util.GetEnvAsInt("WALLET_PRICES_UPDATE_INTERVAL_SEC", 300)),
+				CoinGeckoURL:     util.GetEnv("WALLET_PRICES_COINGECKO_URL", "https://api.coingecko.com/api/v3"),
+				CoinGeckoAPIKey:  util.GetEnv("WALLET_PRICES_COINGECKO_API_KEY", ""),
+				CoinGeckoIDs:     parseSymbolMap("WALLET_PRICES_COINGECKO_IDS", util.GetEnvAsStringArr("WALLET_PRICES_COINGECKO_IDS", []string{"ETH:ethereum", "BNB:binancecoin", "BTC:bitcoin", "SOL:solana", "USDT:tether", "USDC:usd-coin"})),
+				ChainlinkChainID: util.GetEnvAsInt("WALLET_PRICES_CHAINLINK_CHAIN_ID", 1),
+				ChainlinkFeeds:   parseSymbolMap("WALLET_PRICES_CHAINLINK_FEEDS", util.GetEnvAsStringArr("WALLET_PRICES_CHAINLINK_FEEDS", []string{})),
+			},
+			Signer: WalletSigner{
+				Mode:          util.GetEnv("WALLET_SIGNER_MODE", WalletSignerModeLocal),
+				Addr:          util.GetEnv("WALLET_SIGNER_ADDR", ""),
+				ListenAddr:    util.GetEnv("WALLET_SIGNER_LISTEN_ADDR", ":9090"),
+				TLSCertFile:   util.GetEnv("WALLET_SIGNER_TLS_CERT_FILE", ""),
+				TLSKeyFile:    util.GetEnv("WALLET_SIGNER_TLS_KEY_FILE", ""),
+				TLSCAFile:     util.GetEnv("WALLET_SIGNER_TLS_CA_FILE", ""),
+				TLSServerName: util.GetEnv("WALLET_SIGNER_TLS_SERVER_NAME", ""),
+				Timeout:       time.Second * time.Duration(util.GetEnvAsInt("WALLET_SIGNER_TIMEOUT_SEC", 10)),
+			},
+			GRPC: WalletGRPC{
+				ListenAddr:  util.GetEnv("WALLET_GRPC_LISTEN_ADDR", ""),
+				TLSCertFile: util.GetEnv("WALLET_GRPC_TLS_CERT_FILE", ""),
+				TLSKeyFile:  util.GetEnv("WALLET_GRPC_TLS_KEY_FILE", ""),
+				TLSCAFile:   util.GetEnv("WALLET_GRPC_TLS_CA_FILE", ""),
+				Tokens:      parseGRPCTokens("WALLET_GRPC_TOKENS", util.GetEnvAsStringArr("WALLET_GRPC_TOKENS", []string{})),
+			},
+			ConfirmationBlocksOverrides: parseChainBlockOverrides("WALLET_CONFIRMATION_BLOCKS_OVERRIDES", util.GetEnvAsStringArr("WALLET_CONFIRMATION_BLOCKS_OVERRIDES", []string{})),
+			FinalizedBlocksOverrides:    parseChainBlockOverrides("WALLET_FINALIZED_BLOCKS_OVERRIDES", util.GetEnvAsStringArr("WALLET_FINALIZED_BLOCKS_OVERRIDES", []string{})),
+			WithdrawApprovalThresholds:  parseWithdrawApprovalThresholds("WALLET_WITHDRAW_APPROVAL_THRESHOLDS", util.GetEnvAsStringArr("WALLET_WITHDRAW_APPROVAL_THRESHOLDS", []string{})),
+			WithdrawWindows:             parseWithdrawWindows("WALLET_WITHDRAW_WINDOWS", util.GetEnvAsStringArr("WALLET_WITHDRAW_WINDOWS", []string{})),
+			WithdrawBatches:             parseWithdrawBatches("WALLET_WITHDRAW_BATCHES", util.GetEnvAsStringArr("WALLET_WITHDRAW_BATCHES", []string{})),
+			SmartAccounts:               parseSmartAccounts("WALLET_SMART_ACCOUNTS", util.GetEnvAsStringArr("WALLET_SMART_ACCOUNTS", []string{})),
+			HotWalletStrategies:         parseHotWalletStrategies("WALLET_HOT_WALLET_STRATEGIES", util.GetEnvAsStringArr("WALLET_HOT_WALLET_STRATEGIES", []string{})),
+			HotWalletMinBalances:        parseHotWalletMinBalances("WALLET_HOT_WALLET_MIN_BALANCES", util.GetEnvAsStringArr("WALLET_HOT_WALLET_MIN_BALANCES", []string{})),
+			TokenDiscovery:              util.GetEnvAsBool("WALLET_TOKEN_DISCOVERY", false),
 		},
 	}
 }
@@ -0,0 +1,1722 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github/chapool/go-wallet/internal/money"
+)
+
+type Wallet struct {
+	EnableAutoCollect   bool
+	EnableAutoRebalance bool
+	EnableSigning       bool
+	// EnableDustConsolidation sweeps dust balances of consenting users into DustConsolidation.AccountUserID.
+	EnableDustConsolidation bool
+
+	ScanInterval   time.Duration
+	BlockBatchSize int
+	// RPCBatchSize is the maximum number of requests sent in one JSON-RPC batch
+	// (transaction receipts, balance polling), 0 sends every request on its own.
+	RPCBatchSize                 int
+	DepositBackfillInterval      time.Duration
+	WithdrawConfirmationInterval time.Duration
+	CollectInterval              time.Duration
+	RebalanceInterval            time.Duration
+	// LedgerInvariantsInterval is how often the ledger invariants (balances, credits vs. deposits/withdraws) are checked.
+	LedgerInvariantsInterval  time.Duration
+	DustConsolidationInterval time.Duration
+	// BackfillInterval is how often pending or interrupted backfill jobs are picked up.
+	BackfillInterval time.Duration
+	// WithdrawWindowInterval is how often approved withdraws waiting for a processing window or a batch are checked.
+	WithdrawWindowInterval time.Duration
+	// WithdrawOutboxInterval is how often the withdraw outbox is checked for approved withdraws whose
+	// processing did not complete right after approval (e.g. the server crashed in between).
+	WithdrawOutboxInterval time.Duration
+	// ChainHaltThreshold is how long the head block of a chain may stay unchanged before the scanner
+	// checks the other RPC endpoints and raises a chain halt alert (0 = disabled).
+	ChainHaltThreshold time.Duration
+	// HotWalletMonitorInterval is how often hot wallet balances are checked against HotWalletMinBalances
+	// and the volume of withdraws waiting to be sent.
+	HotWalletMonitorInterval time.Duration
+	// SystemWalletVerifyInterval is how often hot wallets and the keystore verification address are re-derived
+	// from the loaded seed and compared against their stored addresses and address indexes.
+	SystemWalletVerifyInterval time.Duration
+	// ChainConfigReloadInterval is how often cached RPC clients are compared against the chain configuration,
+	// as a fallback for chain config change notifications missed while the listener was reconnecting.
+	ChainConfigReloadInterval time.Duration
+	// DatabaseMaintenanceInterval is how often table bloat, index health, slow queries and missing indexes are reported.
+	DatabaseMaintenanceInterval time.Duration
+	// StatusPushInterval is how often queued deposit and withdraw status push notifications are sent.
+	StatusPushInterval time.Duration
+	// BalanceSnapshotInterval is how often daily balance snapshots are written for the days (UTC) that have ended,
+	// missing days are caught up on each run.
+	BalanceSnapshotInterval time.Duration
+	// NFTWithdrawInterval is how often NFT withdraws requested by admins are sent and broadcast ones are confirmed.
+	NFTWithdrawInterval time.Duration
+	// SeedAutoLockAfter locks the seed (signing and address derivation) after it has been unlocked for this long,
+	// an admin unlocks it again with the keystore password (0 = disabled).
+	SeedAutoLockAfter time.Duration
+
+	// WorkerConcurrency is the maximum number of chains processed in parallel by background workers
+	// (auto collect, auto rebalance, deposit backfill, withdraw confirmation polling).
+	WorkerConcurrency int
+
+	Collect   WalletCollect
+	Rebalance WalletRebalance
+	Fees      WalletFees
+	Backfill  WalletBackfill
+	Alerts    WalletAlerts
+
+	// Compliance receives notifications about deposits quarantined by the source address and token screening,
+	// their resolution and blocked withdraws, in addition to the log. Optionally screens addresses not on
+	// the local screening list with an external sanctions screening API.
+	Compliance WalletCompliance
+
+	// GasSpike pauses automatic operations (auto collect, auto rebalance, gas top-ups) of a chain
+	// while its base fee exceeds the configured ceiling.
+	GasSpike WalletGasSpike
+
+	// GasPriceCap defers outgoing transactions (withdraws, collects, rebalances) of a chain while their gas price
+	// exceeds the configured cap, admins can temporarily override the cap for urgent payouts.
+	GasPriceCap WalletGasPriceCap
+
+	WithdrawRateLimit WalletWithdrawRateLimit
+
+	// APITokenRateLimitPerMinute is the number of requests a user API token may make per minute unless the token
+	// was created with its own limit (0 = unlimited). Requests are counted per process.
+	APITokenRateLimitPerMinute int
+
+	// FrozenCredits releases or escalates frozen withdraw credits whose withdraw failed or got stuck,
+	// e.g. after a crash between freezing the credits and broadcasting the transaction.
+	FrozenCredits WalletFrozenCredits
+
+	// WithdrawExpiry automatically rejects withdraw requests still awaiting admin approval after the expiry
+	// and unfreezes their credits, admins can override the expiry per withdraw.
+	WithdrawExpiry WalletWithdrawExpiry
+
+	// ScanFailures retries EVM transactions whose analysis failed during scanning (dead-letter queue),
+	// the block is marked scanned regardless so the failed transactions are retried individually.
+	ScanFailures WalletScanFailures
+
+	// ScanLag samples how far the scanner of each chain lags behind the chain head, alerts when the time-weighted
+	// average lag over a window exceeds a threshold and keeps the lag history for graphing.
+	ScanLag WalletScanLag
+
+	// WithdrawAddress controls how withdraw destination addresses are validated
+	// (contract addresses, addresses of wallets managed by this platform).
+	WithdrawAddress WalletWithdrawAddress
+
+	// DepositTraceRateLimit limits how often a single user may trace a deposit transaction (self-service troubleshooting).
+	DepositTraceRateLimit WalletDepositTraceRateLimit
+
+	DustConsolidation WalletDustConsolidation
+
+	// Events publishes wallet domain events recorded in the outbox (wallet_events) for the analytics pipeline.
+	Events WalletEvents
+
+	// Archive moves old scanned blocks and transactions out of the live tables to keep scanner queries fast.
+	Archive WalletArchive
+
+	// AddressIndexRecovery re-synchronizes address_indexes with the highest derived address used on-chain,
+	// e.g. after the wallets table was restored from an older backup.
+	AddressIndexRecovery WalletAddressIndexRecovery
+
+	// Derivation selects the SLIP-44 coin type and BIP44 account of the derivation path of each chain type.
+	// Changing them for a chain type that already has wallets is refused at startup.
+	Derivation WalletDerivation
+
+	// Prices are the USD token prices used for the fiat values in balance responses.
+	Prices WalletPrices
+
+	// Signer selects where EVM transactions are signed: in this process or by the standalone signer process over gRPC.
+	Signer WalletSigner
+
+	// GRPC serves CreateWallet, GetBalance, RequestWithdraw and GetDeposits to other backend services over gRPC.
+	GRPC WalletGRPC
+
+	// ConfirmationBlocksOverrides and FinalizedBlocksOverrides override the per chain values
+	// stored in the chains table (chain_id -> number of blocks).
+	ConfirmationBlocksOverrides map[int]int
+	FinalizedBlocksOverrides    map[int]int
+
+	// WithdrawApprovalThresholds require multiple admin approvals for large withdraws.
+	// Withdraws not matching any threshold need a single approval.
+	WithdrawApprovalThresholds []WalletWithdrawApprovalThreshold
+
+	// WithdrawWindows restrict processing of approved withdraws to fixed daily times (UTC) per chain or token.
+	// Withdraws without a matching window are processed right after approval.
+	WithdrawWindows []WalletWithdrawWindow
+
+	// WithdrawBatches aggregate approved withdraws of the same token into a single transaction
+	// through a disperse contract per chain. Approved withdraws on these chains are sent every WithdrawWindowInterval.
+	WithdrawBatches []WalletWithdrawBatch
+
+	// SmartAccounts use counterfactual ERC-4337 smart accounts as deposit addresses of new user wallets on these chains.
+	// Collection is submitted by the hot wallet through the EntryPoint with gas sponsored by a paymaster,
+	// so user addresses never need native gas.
+	SmartAccounts []WalletSmartAccount
+
+	// HotWalletStrategies select the hot wallet sending a withdraw on chains with several hot wallets
+	// (chain_id -> strategy). Chains without a strategy use the first hot wallet.
+	HotWalletStrategies map[int]string
+
+	// HotWalletMinBalances are the per chain minimum hot wallet balances of native and ERC20 tokens.
+	// Falling below a minimum raises a low balance alert.
+	HotWalletMinBalances []WalletHotWalletMinBalance
+
+	// TokenDiscovery registers unknown ERC20 tokens received by user addresses as inactive tokens,
+	// deposits of these tokens are credited once an admin activates the token.
+	TokenDiscovery bool
+}
+
+type WalletCollect struct {
+	// MinNativeAmountWei is the minimum native balance (in wei) of a user address worth sweeping.
+	MinNativeAmountWei string
+	// MinERC20Amount is the default minimum ERC20 amount (in whole tokens) worth sweeping.
+	MinERC20Amount string
+	// ReceiptPollInterval is how often the receipt of a gas top-up or sweep transaction is polled.
+	ReceiptPollInterval time.Duration
+	// ReceiptTimeout is how long a collect waits for the receipt of a gas top-up or sweep transaction.
+	ReceiptTimeout time.Duration
+}
+
+type WalletRebalance struct {
+	// MinBalanceWei is the native balance (in wei) below which a hot wallet receives funds.
+	MinBalanceWei string
+	// MaxBalanceWei is the native balance (in wei) above which a hot wallet donates funds.
+	MaxBalanceWei string
+	// ReceiptPollInterval is how often the receipt of a rebalance transaction is polled.
+	ReceiptPollInterval time.Duration
+	// ReceiptTimeout is how long a rebalance waits for the receipt of its transaction.
+	ReceiptTimeout time.Duration
+}
+
+type WalletFees struct {
+	// BaseFeeMultiplier is applied to the latest base fee when computing maxFeePerGas (EIP-1559).
+	BaseFeeMultiplier int64
+	// LegacyTxChainIDs always use legacy (gasPrice) transactions, even if the latest block has a base fee.
+	// Chains whose blocks have no base fee are detected automatically.
+	LegacyTxChainIDs []int
+	// WithdrawFeeAccountUserID is the platform fee account credited with the withdraw fees charged by the
+	// withdraw_fees policies, withdraws of tokens with a fee policy are rejected while it is not configured.
+	WithdrawFeeAccountUserID string
+}
+
+type WalletGasSpike struct {
+	// Ceilings are the per chain base fee ceilings, chains without a ceiling are never paused.
+	Ceilings []WalletGasCeiling
+	// ResumePercent is the percentage of the ceiling the base fee has to drop to before a paused chain resumes,
+	// avoiding flapping around the ceiling.
+	ResumePercent int
+	// WithdrawMode is "queue" (approved withdraws of a paused chain wait until fees normalize)
+	// or "allow" (withdraws are sent regardless, users pay the withdraw fee configured for the token).
+	WithdrawMode  string
+	CheckInterval time.Duration
+}
+
+type WalletGasCeiling struct {
+	ChainID int
+	// MaxBaseFeeGwei is the base fee (gas price on chains without EIP-1559) above which the chain is paused.
+	MaxBaseFeeGwei string
+}
+
+// MaxBaseFeeWei returns MaxBaseFeeGwei converted to wei, rounded down. Call Validate first.
+func (c WalletGasCeiling) MaxBaseFeeWei() *big.Int {
+	v, _ := parsePositiveGwei(c.MaxBaseFeeGwei)
+	return v
+}
+
+type WalletGasPriceCap struct {
+	// Caps are the per chain maximum gas prices of outgoing transactions, chains without a cap are never deferred.
+	Caps []WalletMaxGasPrice
+	// RetryDelays is the retry schedule of deferred transactions: the n-th consecutive deferral waits RetryDelays[n-1],
+	// the last delay is repeated once the schedule is exhausted.
+	RetryDelays []time.Duration
+}
+
+type WalletMaxGasPrice struct {
+	ChainID int
+	// MaxGasPriceGwei is the maxFeePerGas (gas price on chains without EIP-1559) above which transactions are deferred.
+	MaxGasPriceGwei string
+}
+
+// MaxGasPriceWei returns MaxGasPriceGwei converted to wei, rounded down. Call Validate first.
+func (c WalletMaxGasPrice) MaxGasPriceWei() *big.Int {
+	v, _ := parsePositiveGwei(c.MaxGasPriceGwei)
+	return v
+}
+
+// Hot wallet selection strategies.
+const (
+	WalletHotWalletStrategyFirst             = "first"
+	WalletHotWalletStrategyRoundRobin        = "round_robin"
+	WalletHotWalletStrategyHighestBalance    = "highest_balance"
+	WalletHotWalletStrategyLeastPendingNonce = "least_pending_nonce"
+)
+
+// Withdraw internal address modes.
+const (
+	WalletWithdrawInternalModeReject   = "reject"
+	WalletWithdrawInternalModeTransfer = "transfer"
+)
+
+// Gas spike withdraw modes.
+const (
+	WalletGasSpikeWithdrawModeQueue = "queue"
+	WalletGasSpikeWithdrawModeAllow = "allow"
+)
+
+type WalletBackfill struct {
+	// BlocksPerSecond limits the number of historical blocks scanned per second by a backfill job (0 = unlimited).
+	BlocksPerSecond int
+}
+
+type WalletAlerts struct {
+	// WebhookURL receives alerts as JSON POST requests in addition to the log (empty = log only).
+	// May contain credentials, thus never logged.
+	WebhookURL string `json:"-"`
+	// EmailRecipients receive alerts by email through the mailer in addition to the log.
+	EmailRecipients []string
+}
+
+type WalletCompliance struct {
+	// WebhookURL receives quarantine notifications as JSON POST requests (empty = log only).
+	// May contain credentials, thus never logged.
+	WebhookURL string `json:"-"`
+	// EmailRecipients receive quarantine notifications by email through the mailer.
+	EmailRecipients []string
+	// ProviderURL is the base URL of a Chainalysis-style sanctions screening API (GET {ProviderURL}/address/{address}),
+	// queried for deposit source and withdraw destination addresses not on the local screening list (empty = disabled).
+	// Deposits are not credited and withdraws are rejected while the API is unavailable.
+	ProviderURL string
+	// ProviderAPIKey is sent as X-API-Key to the screening API. Never logged.
+	ProviderAPIKey string `json:"-"`
+	// ProviderTimeout is the timeout of a single screening API request.
+	ProviderTimeout time.Duration
+}
+
+type WalletWithdrawRateLimit struct {
+	// MaxRequests is the number of withdraws a single user may request within Window (0 = unlimited).
+	// Replayed requests with an already used idempotency key are not counted.
+	MaxRequests int
+	Window      time.Duration
+}
+
+type WalletWithdrawAddress struct {
+	// ContractAllowlist are the contract addresses EVM withdraws may be sent to.
+	// Withdraws to any other address with contract code are rejected.
+	ContractAllowlist []WalletWithdrawContract
+	// InternalMode is "reject" (withdraws to a user address of this platform are refused, pointing to internal transfers)
+	// or "transfer" (they are converted to internal transfers without an on-chain transaction).
+	InternalMode string
+	// WhitelistCoolingPeriod is how long a confirmed address book entry waits before withdraws may use it.
+	// Turning off the whitelist-only setting takes effect after the same period.
+	WhitelistCoolingPeriod time.Duration
+	// WhitelistConfirmationValidity is how long the email confirmation link of a new address book entry is valid.
+	WhitelistConfirmationValidity time.Duration
+}
+
+type WalletWithdrawContract struct {
+	ChainID int
+	Address string
+}
+
+type WalletDepositTraceRateLimit struct {
+	// MaxRequests is the number of deposit traces a single user may request within Window (0 = unlimited).
+	// The limit is kept in memory and applies per instance.
+	MaxRequests int
+	Window      time.Duration
+}
+
+type WalletWithdrawApprovalThreshold struct {
+	TokenID int
+	// MinAmount is the withdraw amount (in whole tokens) from which this threshold applies.
+	MinAmount string
+	// RequiredApprovals is the number of distinct admins that have to approve the withdraw.
+	RequiredApprovals int
+}
+
+// MinAmountFloat returns MinAmount parsed as big.Float. Call Validate first.
+func (t WalletWithdrawApprovalThreshold) MinAmountFloat() *big.Float {
+	v, _ := parseNonNegativeFloat(t.MinAmount)
+	return v
+}
+
+type WalletWithdrawWindow struct {
+	// Exactly one of ChainID or TokenID is set, token windows take precedence over chain windows.
+	ChainID int
+	TokenID int
+	// Times are the daily processing times in UTC, formatted as "HH:MM".
+	Times []string
+}
+
+// TimesOfDay returns Times as offsets from midnight UTC. Call Validate first.
+func (w WalletWithdrawWindow) TimesOfDay() []time.Duration {
+	res := make([]time.Duration, 0, len(w.Times))
+	for _, t := range w.Times {
+		v, _ := parseTimeOfDay(t)
+		res = append(res, v)
+	}
+
+	return res
+}
+
+type WalletWithdrawBatch struct {
+	ChainID int
+	// ContractAddress is a Disperse compatible contract (disperseEther / disperseToken).
+	// ERC20 batches are transferred by the contract, thus the hot wallet approves it on first use.
+	ContractAddress string
+	// MaxSize is the maximum number of withdraws per transaction.
+	MaxSize int
+}
+
+type WalletSmartAccount struct {
+	ChainID int
+	// FactoryAddress is a SimpleAccountFactory compatible factory (createAccount / getAddress), deploying
+	// accounts with CREATE2 on their first collection.
+	FactoryAddress string
+	// EntryPointAddress is the ERC-4337 EntryPoint (v0.6) the accounts of the factory trust.
+	EntryPointAddress string
+	// PaymasterAddress sponsors the gas of collect user operations, it has to accept them without paymaster data
+	// (e.g. a paymaster allowing the accounts of the factory) and hold a deposit at the EntryPoint.
+	PaymasterAddress string
+}
+
+type WalletHotWalletMinBalance struct {
+	ChainID int
+	// TokenSymbol identifies the native or ERC20 token on the chain, matched case-insensitively.
+	TokenSymbol string
+	// MinAmount is the minimum balance in whole tokens.
+	MinAmount string
+}
+
+// MinAmountFloat returns MinAmount parsed as big.Float. Call Validate first.
+func (b WalletHotWalletMinBalance) MinAmountFloat() *big.Float {
+	v, _ := parseNonNegativeFloat(b.MinAmount)
+	return v
+}
+
+type WalletFrozenCredits struct {
+	// Interval is how often frozen withdraw credits are reconciled.
+	Interval time.Duration
+	// ReleaseAfter releases the frozen credits of EVM withdraws that failed before signing (no transaction signed by their latest dispatch)
+	// and were neither retried nor rejected for this long (0 = never released automatically, only escalated).
+	ReleaseAfter time.Duration
+	// StaleAfter escalates withdraws with frozen credits that stay failed, approved but unsent, signing
+	// or unconfirmed for this long.
+	StaleAfter time.Duration
+}
+
+type WalletWithdrawExpiry struct {
+	// Interval is how often expired withdraw requests are rejected.
+	Interval time.Duration
+	// RequestExpiry is how long a withdraw request may await approval by default
+	// (0 = never expires, expiries set by admins still apply).
+	RequestExpiry time.Duration
+}
+
+type WalletScanFailures struct {
+	// RetryInterval is how often failed transactions are retried, the delay doubles after each failed retry (up to 6 hours).
+	RetryInterval time.Duration
+	// MaxAttempts is the number of retries after which a failed transaction is marked dead and an alert is raised,
+	// admins requeue dead transactions via the API.
+	MaxAttempts int
+}
+
+type WalletScanLag struct {
+	// SampleInterval is how often the lag of every active chain is sampled.
+	SampleInterval time.Duration
+	// Window is the period the lag is averaged over (weighted by how long each sample lasted),
+	// so a short spike does not alert but a lag sustained for about this long does.
+	Window time.Duration
+	// WarningBlocks and CriticalBlocks are the average lag (blocks behind the chain head) above which a warning
+	// or critical alert is raised (0 = no alert of that severity).
+	WarningBlocks  int
+	CriticalBlocks int
+	// WarningBlocksOverrides and CriticalBlocksOverrides override the thresholds per chain (chain ID -> blocks),
+	// e.g. for chains with short block times.
+	WarningBlocksOverrides  map[int]int
+	CriticalBlocksOverrides map[int]int
+	// Retention is how long lag samples are kept.
+	Retention time.Duration
+}
+
+type WalletDustConsolidation struct {
+	// AccountUserID is the user (fee/benefit account) receiving consolidated dust. Required if enabled.
+	AccountUserID string
+	// MinIdle is how long a balance has to be left untouched before it is considered abandoned dust.
+	MinIdle time.Duration
+}
+
+type WalletEvents struct {
+	// Publisher is the transport for wallet events: "kafka" (through a Kafka REST Proxy), "nats" or empty (disabled).
+	// Events are recorded in the outbox regardless, so a publisher enabled later can backfill them.
+	Publisher string
+	// URL of the Kafka REST Proxy (http(s)://) or the NATS server (nats:// or tls://).
+	// May contain credentials, thus never logged.
+	URL string `json:"-"`
+	// Topic is the Kafka topic, or the NATS subject prefix (the event type is appended, e.g. "wallet.events.deposit.created").
+	Topic string
+	// BatchSize is the maximum number of events sent per publish request.
+	BatchSize       int
+	PublishInterval time.Duration
+	// Retention is how long events are kept in the outbox. Unpublished events are only removed if no publisher is configured.
+	Retention time.Duration
+}
+
+// Wallet event publishers.
+const (
+	WalletEventsPublisherKafka = "kafka"
+	WalletEventsPublisherNATS  = "nats"
+)
+
+type WalletArchive struct {
+	// Enabled runs the archival every Interval, admins can run it on demand either way.
+	Enabled bool
+	// Mode is "archive" (rows are moved to blocks_archive and transactions_archive) or "delete".
+	Mode string
+	// MinAge is how long ago (created_at) finalized blocks and settled transactions must have been recorded.
+	// Transactions referenced by credits, withdraws, quarantines or custody moves and the blocks containing them are kept,
+	// as is the latest scanned block of every chain.
+	MinAge time.Duration
+	// BatchSize is the maximum number of rows moved per statement.
+	BatchSize int
+	Interval  time.Duration
+}
+
+type WalletAddressIndexRecovery struct {
+	// OnStartup runs the recovery at startup, before the API serves requests. It can be run with "app wallet recover-address-index" either way.
+	OnStartup bool
+	// GapLimit is the number of consecutive unused derived addresses after which the on-chain scan stops.
+	GapLimit int
+}
+
+type WalletDerivation struct {
+	// CoinTypes map chain types to SLIP-44 coin types (chain type -> coin type), e.g. "evm" -> 60, "tron" -> 195.
+	// Chain types without a coin type use 60 (EVM compatible derivation).
+	CoinTypes map[string]uint32
+	// Accounts map chain types to the hardened BIP44 account level (chain type -> account), missing chain types use account 0.
+	// Solana derives the address index at the account level, so its account cannot be configured.
+	Accounts map[string]uint32
+}
+
+// Wallet archive modes.
+const (
+	WalletArchiveModeArchive = "archive"
+	WalletArchiveModeDelete  = "delete"
+)
+
+type WalletPrices struct {
+	// Provider fetches token prices periodically: "coingecko", "chainlink" or empty (manual prices only).
+	// Tokens are matched by symbol, prices set by an admin are never overwritten.
+	Provider       string
+	UpdateInterval time.Duration
+	CoinGeckoURL   string
+	// CoinGeckoAPIKey is sent as the demo API key, or as the pro API key for pro-api.coingecko.com. Never logged.
+	CoinGeckoAPIKey string `json:"-"`
+	// CoinGeckoIDs map token symbols to CoinGecko coin IDs (symbol -> id), e.g. "ETH" -> "ethereum".
+	CoinGeckoIDs map[string]string
+	// ChainlinkChainID is the chain whose RPC endpoints are used to read the Chainlink feeds.
+	ChainlinkChainID int
+	// ChainlinkFeeds map token symbols to USD price feed addresses on ChainlinkChainID (symbol -> address).
+	ChainlinkFeeds map[string]string
+}
+
+type WalletSigner struct {
+	// Mode is "local" (transactions are signed in this process) or "remote" (the keystore is not decrypted,
+	// addresses are derived and EVM transactions signed by the signer process at Addr, Solana and Bitcoin
+	// transactions are not supported).
+	Mode string
+	// Addr is the address of the signer process used by the API server in remote mode (host:port).
+	Addr string
+	// ListenAddr is the address the signer process accepts connections on.
+	ListenAddr string
+	// TLSCertFile and TLSKeyFile are the certificate of this process (client certificate of the API server,
+	// server certificate of the signer process). Both sides verify the certificate of the other against TLSCAFile.
+	TLSCertFile string
+	TLSKeyFile  string
+	TLSCAFile   string
+	// TLSServerName is the name the signer certificate must be valid for (empty = host of Addr).
+	TLSServerName string
+	// Timeout limits a single signing or derivation request to the signer process.
+	Timeout time.Duration
+}
+
+type WalletGRPC struct {
+	// ListenAddr is the address the gRPC API accepts connections on (empty = gRPC API disabled).
+	ListenAddr string
+	// TLSCertFile and TLSKeyFile are the server certificate of the gRPC API.
+	TLSCertFile string
+	TLSKeyFile  string
+	// TLSCAFile requires clients to present a certificate issued by this CA (mutual TLS, empty = not required).
+	TLSCAFile string
+	// Tokens map client names to service tokens sent as "authorization: Bearer <token>" (empty = not required).
+	// At least one of TLSCAFile and Tokens must be set.
+	Tokens map[string]string `json:"-"`
+}
+
+// Signer modes.
+const (
+	WalletSignerModeLocal  = "local"
+	WalletSignerModeRemote = "remote"
+)
+
+// Token price providers.
+const (
+	WalletPricesProviderCoinGecko = "coingecko"
+	WalletPricesProviderChainlink = "chainlink"
+)
+
+// Validate checks the wallet configuration for invalid or inconsistent values.
+func (w Wallet) Validate() error {
+	var errs []string
+
+	intervals := []struct {
+		name  string
+		value time.Duration
+	}{
+		{"ScanInterval", w.ScanInterval},
+		{"DepositBackfillInterval", w.DepositBackfillInterval},
+		{"WithdrawConfirmationInterval", w.WithdrawConfirmationInterval},
+		{"CollectInterval", w.CollectInterval},
+		{"RebalanceInterval", w.RebalanceInterval},
+		{"LedgerInvariantsInterval", w.LedgerInvariantsInterval},
+		{"DustConsolidationInterval", w.DustConsolidationInterval},
+		{"BackfillInterval", w.BackfillInterval},
+		{"WithdrawWindowInterval", w.WithdrawWindowInterval},
+		{"WithdrawOutboxInterval", w.WithdrawOutboxInterval},
+		{"HotWalletMonitorInterval", w.HotWalletMonitorInterval},
+		{"SystemWalletVerifyInterval", w.SystemWalletVerifyInterval},
+		{"ChainConfigReloadInterval", w.ChainConfigReloadInterval},
+		{"DatabaseMaintenanceInterval", w.DatabaseMaintenanceInterval},
+		{"StatusPushInterval", w.StatusPushInterval},
+		{"BalanceSnapshotInterval", w.BalanceSnapshotInterval},
+		{"NFTWithdrawInterval", w.NFTWithdrawInterval},
+		{"Collect.ReceiptPollInterval", w.Collect.ReceiptPollInterval},
+		{"Collect.ReceiptTimeout", w.Collect.ReceiptTimeout},
+		{"Rebalance.ReceiptPollInterval", w.Rebalance.ReceiptPollInterval},
+		{"Rebalance.ReceiptTimeout", w.Rebalance.ReceiptTimeout},
+		{"GasSpike.CheckInterval", w.GasSpike.CheckInterval},
+		{"FrozenCredits.Interval", w.FrozenCredits.Interval},
+		{"FrozenCredits.StaleAfter", w.FrozenCredits.StaleAfter},
+		{"WithdrawExpiry.Interval", w.WithdrawExpiry.Interval},
+		{"ScanFailures.RetryInterval", w.ScanFailures.RetryInterval},
+		{"ScanLag.SampleInterval", w.ScanLag.SampleInterval},
+		{"ScanLag.Window", w.ScanLag.Window},
+		{"Prices.UpdateInterval", w.Prices.UpdateInterval},
+	}
+	for _, interval := range intervals {
+		if interval.value <= 0 {
+			errs = append(errs, fmt.Sprintf("%s must be positive, got %s", interval.name, interval.value))
+		}
+	}
+
+	if w.BlockBatchSize <= 0 {
+		errs = append(errs, fmt.Sprintf("BlockBatchSize must be positive, got %d", w.BlockBatchSize))
+	}
+
+	if w.RPCBatchSize < 0 {
+		errs = append(errs, fmt.Sprintf("RPCBatchSize must not be negative, got %d", w.RPCBatchSize))
+	}
+
+	if w.WorkerConcurrency <= 0 {
+		errs = append(errs, fmt.Sprintf("WorkerConcurrency must be positive, got %d", w.WorkerConcurrency))
+	}
+
+	if w.Fees.BaseFeeMultiplier < 1 {
+		errs = append(errs, fmt.Sprintf("Fees.BaseFeeMultiplier must be at least 1, got %d", w.Fees.BaseFeeMultiplier))
+	}
+
+	for _, chainID := range w.Fees.LegacyTxChainIDs {
+		if chainID <= 0 {
+			errs = append(errs, fmt.Sprintf("Fees.LegacyTxChainIDs must only contain positive chain IDs, got %d", chainID))
+		}
+	}
+
+	if w.ChainHaltThreshold < 0 {
+		errs = append(errs, fmt.Sprintf("ChainHaltThreshold must not be negative, got %s", w.ChainHaltThreshold))
+	}
+	if w.SeedAutoLockAfter < 0 {
+		errs = append(errs, fmt.Sprintf("SeedAutoLockAfter must not be negative, got %s", w.SeedAutoLockAfter))
+	}
+
+	if w.Alerts.WebhookURL != "" {
+		if u, err := url.Parse(w.Alerts.WebhookURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, "Alerts.WebhookURL must be a valid http(s) URL")
+		}
+	}
+	for i, recipient := range w.Alerts.EmailRecipients {
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			errs = append(errs, fmt.Sprintf("Alerts.EmailRecipients[%d] must be a valid email address, got %q", i, recipient))
+		}
+	}
+
+	if w.Compliance.WebhookURL != "" {
+		if u, err := url.Parse(w.Compliance.WebhookURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, "Compliance.WebhookURL must be a valid http(s) URL")
+		}
+	}
+	for i, recipient := range w.Compliance.EmailRecipients {
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			errs = append(errs, fmt.Sprintf("Compliance.EmailRecipients[%d] must be a valid email address, got %q", i, recipient))
+		}
+	}
+	if w.Compliance.ProviderURL != "" {
+		if u, err := url.Parse(w.Compliance.ProviderURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, "Compliance.ProviderURL must be a valid http(s) URL")
+		}
+		if w.Compliance.ProviderTimeout <= 0 {
+			errs = append(errs, "Compliance.ProviderTimeout must be positive when Compliance.ProviderURL is set")
+		}
+	}
+
+	if w.Backfill.BlocksPerSecond < 0 {
+		errs = append(errs, fmt.Sprintf("Backfill.BlocksPerSecond must not be negative, got %d", w.Backfill.BlocksPerSecond))
+	}
+
+	if w.APITokenRateLimitPerMinute < 0 {
+		errs = append(errs, fmt.Sprintf("APITokenRateLimitPerMinute must not be negative, got %d", w.APITokenRateLimitPerMinute))
+	}
+
+	if w.WithdrawRateLimit.MaxRequests < 0 {
+		errs = append(errs, fmt.Sprintf("WithdrawRateLimit.MaxRequests must not be negative, got %d", w.WithdrawRateLimit.MaxRequests))
+	}
+	if w.WithdrawRateLimit.MaxRequests > 0 && w.WithdrawRateLimit.Window <= 0 {
+		errs = append(errs, fmt.Sprintf("WithdrawRateLimit.Window must be positive, got %s", w.WithdrawRateLimit.Window))
+	}
+	if w.DepositTraceRateLimit.MaxRequests < 0 {
+		errs = append(errs, fmt.Sprintf("DepositTraceRateLimit.MaxRequests must not be negative, got %d", w.DepositTraceRateLimit.MaxRequests))
+	}
+	if w.DepositTraceRateLimit.MaxRequests > 0 && w.DepositTraceRateLimit.Window <= 0 {
+		errs = append(errs, fmt.Sprintf("DepositTraceRateLimit.Window must be positive, got %s", w.DepositTraceRateLimit.Window))
+	}
+
+	if _, ok := parseNonNegativeInt(w.Collect.MinNativeAmountWei); !ok {
+		errs = append(errs, fmt.Sprintf("Collect.MinNativeAmountWei must be a non-negative integer, got %q", w.Collect.MinNativeAmountWei))
+	}
+
+	if _, ok := parseNonNegativeFloat(w.Collect.MinERC20Amount); !ok {
+		errs = append(errs, fmt.Sprintf("Collect.MinERC20Amount must be a non-negative number, got %q", w.Collect.MinERC20Amount))
+	}
+
+	minBalance, minOK := parseNonNegativeInt(w.Rebalance.MinBalanceWei)
+	if !minOK {
+		errs = append(errs, fmt.Sprintf("Rebalance.MinBalanceWei must be a non-negative integer, got %q", w.Rebalance.MinBalanceWei))
+	}
+	maxBalance, maxOK := parseNonNegativeInt(w.Rebalance.MaxBalanceWei)
+	if !maxOK {
+		errs = append(errs, fmt.Sprintf("Rebalance.MaxBalanceWei must be a non-negative integer, got %q", w.Rebalance.MaxBalanceWei))
+	}
+	if minOK && maxOK && minBalance.Cmp(maxBalance) >= 0 {
+		errs = append(errs, "Rebalance.MinBalanceWei must be lower than Rebalance.MaxBalanceWei")
+	}
+
+	for chainID, blocks := range w.ConfirmationBlocksOverrides {
+		if blocks < 0 {
+			errs = append(errs, fmt.Sprintf("ConfirmationBlocksOverrides[%d] must not be negative, got %d", chainID, blocks))
+		}
+		if finalized, ok := w.FinalizedBlocksOverrides[chainID]; ok && finalized < blocks {
+			errs = append(errs, fmt.Sprintf("FinalizedBlocksOverrides[%d] must not be lower than ConfirmationBlocksOverrides[%d]", chainID, chainID))
+		}
+	}
+	for chainID, blocks := range w.FinalizedBlocksOverrides {
+		if blocks < 0 {
+			errs = append(errs, fmt.Sprintf("FinalizedBlocksOverrides[%d] must not be negative, got %d", chainID, blocks))
+		}
+	}
+
+	if w.FrozenCredits.ReleaseAfter < 0 {
+		errs = append(errs, fmt.Sprintf("FrozenCredits.ReleaseAfter must not be negative, got %s", w.FrozenCredits.ReleaseAfter))
+	}
+
+	if w.Fees.WithdrawFeeAccountUserID != "" {
+		if _, err := uuid.Parse(w.Fees.WithdrawFeeAccountUserID); err != nil {
+			errs = append(errs, fmt.Sprintf("Fees.WithdrawFeeAccountUserID must be a valid UUID, got %q", w.Fees.WithdrawFeeAccountUserID))
+		}
+	}
+
+	if w.WithdrawExpiry.RequestExpiry < 0 {
+		errs = append(errs, fmt.Sprintf("WithdrawExpiry.RequestExpiry must not be negative, got %s", w.WithdrawExpiry.RequestExpiry))
+	}
+
+	if w.ScanFailures.MaxAttempts <= 0 {
+		errs = append(errs, fmt.Sprintf("ScanFailures.MaxAttempts must be positive, got %d", w.ScanFailures.MaxAttempts))
+	}
+
+	if w.DustConsolidation.MinIdle < 0 {
+		errs = append(errs, fmt.Sprintf("DustConsolidation.MinIdle must not be negative, got %s", w.DustConsolidation.MinIdle))
+	}
+	if w.EnableDustConsolidation {
+		if _, err := uuid.Parse(w.DustConsolidation.AccountUserID); err != nil {
+			errs = append(errs, fmt.Sprintf("DustConsolidation.AccountUserID must be a valid UUID when dust consolidation is enabled, got %q", w.DustConsolidation.AccountUserID))
+		}
+	}
+
+	for i, threshold := range w.WithdrawApprovalThresholds {
+		if _, ok := parseNonNegativeFloat(threshold.MinAmount); !ok {
+			errs = append(errs, fmt.Sprintf("WithdrawApprovalThresholds[%d].MinAmount must be a non-negative number, got %q", i, threshold.MinAmount))
+		}
+		if threshold.RequiredApprovals < 1 {
+			errs = append(errs, fmt.Sprintf("WithdrawApprovalThresholds[%d].RequiredApprovals must be at least 1, got %d", i, threshold.RequiredApprovals))
+		}
+	}
+
+	errs = append(errs, validateEvents(w.Events)...)
+	errs = append(errs, validateArchive(w.Archive)...)
+	errs = append(errs, validateScanLag(w.ScanLag)...)
+
+	if w.AddressIndexRecovery.GapLimit <= 0 {
+		errs = append(errs, fmt.Sprintf("AddressIndexRecovery.GapLimit must be positive, got %d", w.AddressIndexRecovery.GapLimit))
+	}
+	errs = append(errs, validateDerivation(w.Derivation)...)
+	errs = append(errs, validatePrices(w.Prices)...)
+	errs = append(errs, validateSigner(w.Signer)...)
+	errs = append(errs, validateGRPC(w.GRPC)...)
+	errs = append(errs, validateWithdrawWindows(w.WithdrawWindows)...)
+	errs = append(errs, validateWithdrawBatches(w.WithdrawBatches)...)
+	errs = append(errs, validateSmartAccounts(w.SmartAccounts)...)
+	errs = append(errs, validateWithdrawAddress(w.WithdrawAddress)...)
+	errs = append(errs, validateHotWalletMinBalances(w.HotWalletMinBalances)...)
+	errs = append(errs, validateGasSpike(w.GasSpike)...)
+	errs = append(errs, validateGasPriceCap(w.GasPriceCap)...)
+
+	for chainID, strategy := range w.HotWalletStrategies {
+		switch strategy {
+		case WalletHotWalletStrategyFirst, WalletHotWalletStrategyRoundRobin,
+			WalletHotWalletStrategyHighestBalance, WalletHotWalletStrategyLeastPendingNonce:
+		default:
+			errs = append(errs, fmt.Sprintf("HotWalletStrategies[%d] must be one of %s, %s, %s or %s, got %q", chainID,
+				WalletHotWalletStrategyFirst, WalletHotWalletStrategyRoundRobin,
+				WalletHotWalletStrategyHighestBalance, WalletHotWalletStrategyLeastPendingNonce, strategy))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Errorf("invalid wallet config: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// EffectiveConfigJSON returns the wallet config as JSON for logging at startup.
+// Sensitive fields must be tagged with `json:"-"` so they never end up in the output.
+func (w Wallet) EffectiveConfigJSON() (string, error) {
+	b, err := json.Marshal(w)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal wallet config")
+	}
+
+	return string(b), nil
+}
+
+// MinNativeCollectAmountWei returns Collect.MinNativeAmountWei parsed as big.Int. Call Validate first.
+func (w Wallet) MinNativeCollectAmountWei() *big.Int {
+	v, _ := parseNonNegativeInt(w.Collect.MinNativeAmountWei)
+	return v
+}
+
+// RebalanceMinBalanceWei returns Rebalance.MinBalanceWei parsed as big.Int. Call Validate first.
+func (w Wallet) RebalanceMinBalanceWei() *big.Int {
+	v, _ := parseNonNegativeInt(w.Rebalance.MinBalanceWei)
+	return v
+}
+
+// RebalanceMaxBalanceWei returns Rebalance.MaxBalanceWei parsed as big.Int. Call Validate first.
+func (w Wallet) RebalanceMaxBalanceWei() *big.Int {
+	v, _ := parseNonNegativeInt(w.Rebalance.MaxBalanceWei)
+	return v
+}
+
+// parseChainBlockOverrides parses overrides in the form "chainID:blocks", e.g. []string{"56:15", "97:3"}.
+// Malformed entries cause a panic, same as other unparsable ENV values at startup.
+func parseChainBlockOverrides(key string, entries []string) map[int]int {
+	res := make(map[int]int, len(entries))
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		chainIDStr, blocksStr, found := strings.Cut(entry, ":")
+		if !found {
+			log.Panic().Str("key", key).Str("entry", entry).Msg("Failed to parse env variable, expected chainID:blocks")
+		}
+
+		chainID, err := strconv.Atoi(strings.TrimSpace(chainIDStr))
+		if err != nil {
+			log.Panic().Str("key", key).Str("entry", entry).Err(err).Msg("Failed to parse chain ID in env variable")
+		}
+
+		blocks, err := strconv.Atoi(strings.TrimSpace(blocksStr))
+		if err != nil {
+			log.Panic().Str("key", key).Str("entry", entry).Err(err).Msg("Failed to parse block count in env variable")
+		}
+
+		res[chainID] = blocks
+	}
+
+	return res
+}
+
+// parseHotWalletStrategies parses strategies in the form "chainID:strategy", e.g. []string{"56:round_robin", "1:highest_balance"}.
+// Malformed entries cause a panic, same as parseChainBlockOverrides.
+func parseHotWalletStrategies(key string, entries []string) map[int]string {
+	res := make(map[int]string, len(entries))
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		chainIDStr, strategy, found := strings.Cut(entry, ":")
+		if !found {
+			log.Panic().Str("key", key).Str("entry", entry).Msg("Failed to parse env variable, expected chainID:strategy")
+		}
+
+		chainID, err := strconv.Atoi(strings.TrimSpace(chainIDStr))
+		if err != nil {
+			log.Panic().Str("key", key).Str("entry", entry).Err(err).Msg("Failed to parse chain ID in env variable")
+		}
+
+		res[chainID] = strings.TrimSpace(strategy)
+	}
+
+	return res
+}
+
+// parseSymbolMap parses symbol mappings in the form "symbol:value", e.g. []string{"ETH:ethereum", "USDT:tether"}.
+// Symbols are upper-cased. Malformed entries cause a panic, same as parseChainBlockOverrides.
+func parseSymbolMap(key string, entries []string) map[string]string {
+	res := make(map[string]string, len(entries))
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		symbol, value, found := strings.Cut(entry, ":")
+		symbol, value = strings.TrimSpace(symbol), strings.TrimSpace(value)
+		if !found || symbol == "" || value == "" {
+			log.Panic().Str("key", key).Str("entry", entry).Msg("Failed to parse env variable, expected symbol:value")
+		}
+
+		res[strings.ToUpper(symbol)] = value
+	}
+
+	return res
+}
+
+// parseGRPCTokens parses service tokens of gRPC API clients, e.g. []string{"billing:secret"}.
+// Malformed entries cause a panic, same as parseChainBlockOverrides.
+func parseGRPCTokens(key string, entries []string) map[string]string {
+	res := make(map[string]string, len(entries))
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, token, found := strings.Cut(entry, ":")
+		name, token = strings.TrimSpace(name), strings.TrimSpace(token)
+		if !found || name == "" || token == "" {
+			// The entry contains the token, only the key is logged
+			log.Panic().Str("key", key).Msg("Failed to parse env variable, expected client:token")
+		}
+
+		res[name] = token
+	}
+
+	return res
+}
+
+// parseChainIDs parses a list of chain IDs, e.g. []string{"56", "97"}.
+// Malformed entries cause a panic, same as parseChainBlockOverrides.
+func parseChainIDs(key string, entries []string) []int {
+	res := make([]int, 0, len(entries))
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		chainID, err := strconv.Atoi(entry)
+		if err != nil {
+			log.Panic().Str("key", key).Str("entry", entry).Err(err).Msg("Failed to parse chain ID in env variable")
+		}
+
+		res = append(res, chainID)
+	}
+
+	return res
+}
+
+// parseChainTypeIndexes parses hardened derivation indexes per chain type in the form "chainType:index",
+// e.g. []string{"evm:60", "tron:195"}. Chain types are lower-cased. Malformed entries cause a panic, same as parseChainBlockOverrides.
+func parseChainTypeIndexes(key string, entries []string) map[string]uint32 {
+	res := make(map[string]uint32, len(entries))
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		chainType, indexStr, found := strings.Cut(entry, ":")
+		chainType = strings.TrimSpace(chainType)
+		if !found || chainType == "" {
+			log.Panic().Str("key", key).Str("entry", entry).Msg("Failed to parse env variable, expected chainType:index")
+		}
+
+		index, err := strconv.ParseUint(strings.TrimSpace(indexStr), 10, 32)
+		if err != nil {
+			log.Panic().Str("key", key).Str("entry", entry).Err(err).Msg("Failed to parse derivation index in env variable")
+		}
+
+		res[strings.ToLower(chainType)] = uint32(index)
+	}
+
+	return res
+}
+
+// parseWithdrawApprovalThresholds parses thresholds in the form "tokenID:minAmount:requiredApprovals",
+// e.g. []string{"1:10:2", "1:100:3"}. Malformed entries cause a panic, same as parseChainBlockOverrides.
+func parseWithdrawApprovalThresholds(key string, entries []string) []WalletWithdrawApprovalThreshold {
+	res := make([]WalletWithdrawApprovalThreshold, 0, len(entries))
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 {
+			log.Panic().Str("key", key).Str("entry", entry).Msg("Failed to parse env variable, expected tokenID:minAmount:requiredApprovals")
+		}
+
+		tokenID, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+		if err != nil {
+			log.Panic().Str("key", key).Str("entry", entry).Err(err).Msg("Failed to parse token ID in env variable")
+		}
+
+		requiredApprovals, err := strconv.Atoi(strings.TrimSpace(parts[2]))
+		if err != nil {
+			log.Panic().Str("key", key).Str("entry", entry).Err(err).Msg("Failed to parse required approvals in env variable")
+		}
+
+		res = append(res, WalletWithdrawApprovalThreshold{
+			TokenID:           tokenID,
+			MinAmount:         strings.TrimSpace(parts[1]),
+			RequiredApprovals: requiredApprovals,
+		})
+	}
+
+	return res
+}
+
+// validateEvents checks the publisher URL matches the transport and the batch settings are usable.
+func validateSigner(signer WalletSigner) []string {
+	switch signer.Mode {
+	case WalletSignerModeLocal:
+		return nil
+	case WalletSignerModeRemote:
+	default:
+		return []string{fmt.Sprintf("Signer.Mode must be %q or %q, got %q", WalletSignerModeLocal, WalletSignerModeRemote, signer.Mode)}
+	}
+
+	var errs []string
+	if signer.Addr == "" {
+		errs = append(errs, "Signer.Addr must not be empty in remote mode")
+	}
+	if signer.TLSCertFile == "" || signer.TLSKeyFile == "" || signer.TLSCAFile == "" {
+		errs = append(errs, "Signer.TLSCertFile, Signer.TLSKeyFile and Signer.TLSCAFile must be set in remote mode")
+	}
+	if signer.Timeout <= 0 {
+		errs = append(errs, fmt.Sprintf("Signer.Timeout must be positive, got %s", signer.Timeout))
+	}
+
+	return errs
+}
+
+// validateGRPC checks an enabled gRPC API has a server certificate and authenticates its clients.
+func validateGRPC(grpc WalletGRPC) []string {
+	if grpc.ListenAddr == "" {
+		return nil
+	}
+
+	var errs []string
+	if grpc.TLSCertFile == "" || grpc.TLSKeyFile == "" {
+		errs = append(errs, "GRPC.TLSCertFile and GRPC.TLSKeyFile must be set when the gRPC API is enabled")
+	}
+	if grpc.TLSCAFile == "" && len(grpc.Tokens) == 0 {
+		errs = append(errs, "GRPC.TLSCAFile or GRPC.Tokens must be set when the gRPC API is enabled")
+	}
+
+	return errs
+}
+
+func validateDerivation(derivation WalletDerivation) []string {
+	// Hardened derivation adds 2^31 to the index, larger values overflow the 32 bit child index
+	const maxHardenedIndex = 1<<31 - 1
+
+	var errs []string
+
+	for chainType, coinType := range derivation.CoinTypes {
+		if coinType > maxHardenedIndex {
+			errs = append(errs, fmt.Sprintf("Derivation.CoinTypes[%s] must not exceed %d, got %d", chainType, maxHardenedIndex, coinType))
+		}
+	}
+	for chainType, account := range derivation.Accounts {
+		if account > maxHardenedIndex {
+			errs = append(errs, fmt.Sprintf("Derivation.Accounts[%s] must not exceed %d, got %d", chainType, maxHardenedIndex, account))
+		}
+		if chainType == "solana" && account != 0 {
+			errs = append(errs, fmt.Sprintf("Derivation.Accounts[solana] is not supported (the address index is the Solana account), got %d", account))
+		}
+	}
+
+	return errs
+}
+
+func validateEvents(events WalletEvents) []string {
+	var errs []string
+
+	if events.BatchSize <= 0 {
+		errs = append(errs, fmt.Sprintf("Events.BatchSize must be positive, got %d", events.BatchSize))
+	}
+	if events.PublishInterval <= 0 {
+		errs = append(errs, fmt.Sprintf("Events.PublishInterval must be positive, got %s", events.PublishInterval))
+	}
+	if events.Retention <= 0 {
+		errs = append(errs, fmt.Sprintf("Events.Retention must be positive, got %s", events.Retention))
+	}
+
+	var schemes []string
+	switch events.Publisher {
+	case "":
+		return errs
+	case WalletEventsPublisherKafka:
+		schemes = []string{"http", "https"}
+	case WalletEventsPublisherNATS:
+		schemes = []string{"nats", "tls"}
+	default:
+		return append(errs, fmt.Sprintf("Events.Publisher must be %q, %q or empty, got %q", WalletEventsPublisherKafka, WalletEventsPublisherNATS, events.Publisher))
+	}
+
+	if u, err := url.Parse(events.URL); err != nil || !slices.Contains(schemes, u.Scheme) || u.Host == "" {
+		errs = append(errs, fmt.Sprintf("Events.URL must be a valid %s URL when the %s publisher is enabled", strings.Join(schemes, "/"), events.Publisher))
+	}
+	if events.Topic == "" {
+		errs = append(errs, "Events.Topic must not be empty when a publisher is enabled")
+	}
+
+	return errs
+}
+
+// validateArchive checks the archive mode and that the archival job settings are usable.
+func validateArchive(archive WalletArchive) []string {
+	var errs []string
+
+	if archive.Mode != WalletArchiveModeArchive && archive.Mode != WalletArchiveModeDelete {
+		errs = append(errs, fmt.Sprintf("Archive.Mode must be %q or %q, got %q", WalletArchiveModeArchive, WalletArchiveModeDelete, archive.Mode))
+	}
+	if archive.MinAge < 24*time.Hour {
+		errs = append(errs, fmt.Sprintf("Archive.MinAge must be at least 24h, got %s", archive.MinAge))
+	}
+	if archive.BatchSize <= 0 {
+		errs = append(errs, fmt.Sprintf("Archive.BatchSize must be positive, got %d", archive.BatchSize))
+	}
+	if archive.Interval <= 0 {
+		errs = append(errs, fmt.Sprintf("Archive.Interval must be positive, got %s", archive.Interval))
+	}
+
+	return errs
+}
+
+// validateScanLag checks that the scan lag thresholds are consistent and samples outlive the averaging window.
+func validateScanLag(scanLag WalletScanLag) []string {
+	var errs []string
+
+	if scanLag.WarningBlocks < 0 {
+		errs = append(errs, fmt.Sprintf("ScanLag.WarningBlocks must not be negative, got %d", scanLag.WarningBlocks))
+	}
+	if scanLag.CriticalBlocks < 0 {
+		errs = append(errs, fmt.Sprintf("ScanLag.CriticalBlocks must not be negative, got %d", scanLag.CriticalBlocks))
+	}
+	if scanLag.WarningBlocks > 0 && scanLag.CriticalBlocks > 0 && scanLag.CriticalBlocks < scanLag.WarningBlocks {
+		errs = append(errs, "ScanLag.CriticalBlocks must not be lower than ScanLag.WarningBlocks")
+	}
+	for chainID, blocks := range scanLag.WarningBlocksOverrides {
+		if blocks < 0 {
+			errs = append(errs, fmt.Sprintf("ScanLag.WarningBlocksOverrides[%d] must not be negative, got %d", chainID, blocks))
+		}
+	}
+	for chainID, blocks := range scanLag.CriticalBlocksOverrides {
+		if blocks < 0 {
+			errs = append(errs, fmt.Sprintf("ScanLag.CriticalBlocksOverrides[%d] must not be negative, got %d", chainID, blocks))
+		}
+	}
+	if scanLag.Retention < scanLag.Window {
+		errs = append(errs, fmt.Sprintf("ScanLag.Retention must not be shorter than ScanLag.Window, got %s", scanLag.Retention))
+	}
+
+	return errs
+}
+
+// validatePrices checks the settings of the configured price provider.
+func validatePrices(prices WalletPrices) []string {
+	var errs []string
+
+	switch prices.Provider {
+	case "":
+	case WalletPricesProviderCoinGecko:
+		if u, err := url.Parse(prices.CoinGeckoURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Sprintf("Prices.CoinGeckoURL must be a valid http/https URL, got %q", prices.CoinGeckoURL))
+		}
+		if len(prices.CoinGeckoIDs) == 0 {
+			errs = append(errs, "Prices.CoinGeckoIDs must not be empty when the coingecko provider is enabled")
+		}
+	case WalletPricesProviderChainlink:
+		if prices.ChainlinkChainID <= 0 {
+			errs = append(errs, fmt.Sprintf("Prices.ChainlinkChainID must be positive, got %d", prices.ChainlinkChainID))
+		}
+		if len(prices.ChainlinkFeeds) == 0 {
+			errs = append(errs, "Prices.ChainlinkFeeds must not be empty when the chainlink provider is enabled")
+		}
+		for symbol, feed := range prices.ChainlinkFeeds {
+			if !common.IsHexAddress(feed) {
+				errs = append(errs, fmt.Sprintf("Prices.ChainlinkFeeds[%s] must be a hex address, got %q", symbol, feed))
+			}
+		}
+	default:
+		errs = append(errs, fmt.Sprintf("Prices.Provider must be %q, %q or empty, got %q", WalletPricesProviderCoinGecko, WalletPricesProviderChainlink, prices.Provider))
+	}
+
+	return errs
+}
+
+// validateWithdrawWindows checks that every window has a single scope with valid times
+// and that no chain or token is configured twice.
+func validateWithdrawWindows(windows []WalletWithdrawWindow) []string {
+	var errs []string
+
+	chains := make(map[int]bool, len(windows))
+	tokens := make(map[int]bool, len(windows))
+	for i, window := range windows {
+		switch {
+		case (window.ChainID > 0) == (window.TokenID > 0):
+			errs = append(errs, fmt.Sprintf("WithdrawWindows[%d] must set exactly one of ChainID or TokenID", i))
+		case window.ChainID > 0 && chains[window.ChainID]:
+			errs = append(errs, fmt.Sprintf("WithdrawWindows[%d] duplicates the window of chain %d", i, window.ChainID))
+		case window.TokenID > 0 && tokens[window.TokenID]:
+			errs = append(errs, fmt.Sprintf("WithdrawWindows[%d] duplicates the window of token %d", i, window.TokenID))
+		}
+		chains[window.ChainID] = true
+		tokens[window.TokenID] = true
+
+		if len(window.Times) == 0 {
+			errs = append(errs, fmt.Sprintf("WithdrawWindows[%d] must have at least one time", i))
+		}
+		for _, t := range window.Times {
+			if _, ok := parseTimeOfDay(t); !ok {
+				errs = append(errs, fmt.Sprintf("WithdrawWindows[%d] time must be formatted as HH:MM, got %q", i, t))
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateWithdrawBatches checks contract addresses and batch sizes and that no chain is configured twice.
+func validateWithdrawBatches(batches []WalletWithdrawBatch) []string {
+	var errs []string
+
+	chains := make(map[int]bool, len(batches))
+	for i, batch := range batches {
+		if batch.ChainID <= 0 {
+			errs = append(errs, fmt.Sprintf("WithdrawBatches[%d].ChainID must be positive, got %d", i, batch.ChainID))
+		} else if chains[batch.ChainID] {
+			errs = append(errs, fmt.Sprintf("WithdrawBatches[%d] duplicates the batch of chain %d", i, batch.ChainID))
+		}
+		chains[batch.ChainID] = true
+
+		if !common.IsHexAddress(batch.ContractAddress) {
+			errs = append(errs, fmt.Sprintf("WithdrawBatches[%d].ContractAddress must be a hex address, got %q", i, batch.ContractAddress))
+		}
+		if batch.MaxSize < 2 {
+			errs = append(errs, fmt.Sprintf("WithdrawBatches[%d].MaxSize must be at least 2, got %d", i, batch.MaxSize))
+		}
+	}
+
+	return errs
+}
+
+// validateSmartAccounts checks the contract addresses and that no chain is configured twice.
+func validateSmartAccounts(accounts []WalletSmartAccount) []string {
+	var errs []string
+
+	chains := make(map[int]bool, len(accounts))
+	for i, account := range accounts {
+		if account.ChainID <= 0 {
+			errs = append(errs, fmt.Sprintf("SmartAccounts[%d].ChainID must be positive, got %d", i, account.ChainID))
+		} else if chains[account.ChainID] {
+			errs = append(errs, fmt.Sprintf("SmartAccounts[%d] duplicates the smart account config of chain %d", i, account.ChainID))
+		}
+		chains[account.ChainID] = true
+
+		if !common.IsHexAddress(account.FactoryAddress) {
+			errs = append(errs, fmt.Sprintf("SmartAccounts[%d].FactoryAddress must be a hex address, got %q", i, account.FactoryAddress))
+		}
+		if !common.IsHexAddress(account.EntryPointAddress) {
+			errs = append(errs, fmt.Sprintf("SmartAccounts[%d].EntryPointAddress must be a hex address, got %q", i, account.EntryPointAddress))
+		}
+		if !common.IsHexAddress(account.PaymasterAddress) {
+			errs = append(errs, fmt.Sprintf("SmartAccounts[%d].PaymasterAddress must be a hex address, got %q", i, account.PaymasterAddress))
+		}
+	}
+
+	return errs
+}
+
+// validateWithdrawAddress checks the allowlisted contract addresses, the internal address mode and the address book periods.
+func validateWithdrawAddress(withdrawAddress WalletWithdrawAddress) []string {
+	var errs []string
+
+	for i, contract := range withdrawAddress.ContractAllowlist {
+		if contract.ChainID <= 0 {
+			errs = append(errs, fmt.Sprintf("WithdrawAddress.ContractAllowlist[%d].ChainID must be positive, got %d", i, contract.ChainID))
+		}
+		if !common.IsHexAddress(contract.Address) {
+			errs = append(errs, fmt.Sprintf("WithdrawAddress.ContractAllowlist[%d].Address must be a hex address, got %q", i, contract.Address))
+		}
+	}
+
+	switch withdrawAddress.InternalMode {
+	case WalletWithdrawInternalModeReject, WalletWithdrawInternalModeTransfer:
+	default:
+		errs = append(errs, fmt.Sprintf("WithdrawAddress.InternalMode must be %q or %q, got %q",
+			WalletWithdrawInternalModeReject, WalletWithdrawInternalModeTransfer, withdrawAddress.InternalMode))
+	}
+
+	if withdrawAddress.WhitelistCoolingPeriod < 0 {
+		errs = append(errs, fmt.Sprintf("WithdrawAddress.WhitelistCoolingPeriod must not be negative, got %s", withdrawAddress.WhitelistCoolingPeriod))
+	}
+	if withdrawAddress.WhitelistConfirmationValidity <= 0 {
+		errs = append(errs, fmt.Sprintf("WithdrawAddress.WhitelistConfirmationValidity must be positive, got %s", withdrawAddress.WhitelistConfirmationValidity))
+	}
+
+	return errs
+}
+
+// parseWithdrawContracts parses contracts in the form "chainID:contractAddress",
+// e.g. []string{"56:0xD152f549545093347A162Dce210e7293f1452150"}. Malformed entries cause a panic, same as parseChainBlockOverrides.
+func parseWithdrawContracts(key string, entries []string) []WalletWithdrawContract {
+	res := make([]WalletWithdrawContract, 0, len(entries))
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ":")
+		if len(parts) != 2 {
+			log.Panic().Str("key", key).Str("entry", entry).Msg("Failed to parse env variable, expected chainID:contractAddress")
+		}
+
+		chainID, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+		if err != nil {
+			log.Panic().Str("key", key).Str("entry", entry).Err(err).Msg("Failed to parse chain ID in env variable")
+		}
+
+		res = append(res, WalletWithdrawContract{
+			ChainID: chainID,
+			Address: strings.TrimSpace(parts[1]),
+		})
+	}
+
+	return res
+}
+
+// parseWithdrawBatches parses batches in the form "chainID:contractAddress:maxSize",
+// e.g. []string{"56:0xD152f549545093347A162Dce210e7293f1452150:50"}. Malformed entries cause a panic, same as parseChainBlockOverrides.
+func parseWithdrawBatches(key string, entries []string) []WalletWithdrawBatch {
+	res := make([]WalletWithdrawBatch, 0, len(entries))
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 {
+			log.Panic().Str("key", key).Str("entry", entry).Msg("Failed to parse env variable, expected chainID:contractAddress:maxSize")
+		}
+
+		chainID, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+		if err != nil {
+			log.Panic().Str("key", key).Str("entry", entry).Err(err).Msg("Failed to parse chain ID in env variable")
+		}
+
+		maxSize, err := strconv.Atoi(strings.TrimSpace(parts[2]))
+		if err != nil {
+			log.Panic().Str("key", key).Str("entry", entry).Err(err).Msg("Failed to parse max batch size in env variable")
+		}
+
+		res = append(res, WalletWithdrawBatch{
+			ChainID:         chainID,
+			ContractAddress: strings.TrimSpace(parts[1]),
+			MaxSize:         maxSize,
+		})
+	}
+
+	return res
+}
+
+// parseSmartAccounts parses smart account configs in the form "chainID:factoryAddress:entryPointAddress:paymasterAddress",
+// e.g. []string{"56:0x9406Cc6185a346906296840746125a0E44976454:0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789:0x..."}.
+// Malformed entries cause a panic, same as parseChainBlockOverrides.
+func parseSmartAccounts(key string, entries []string) []WalletSmartAccount {
+	res := make([]WalletSmartAccount, 0, len(entries))
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ":")
+		if len(parts) != 4 {
+			log.Panic().Str("key", key).Str("entry", entry).Msg("Failed to parse env variable, expected chainID:factoryAddress:entryPointAddress:paymasterAddress")
+		}
+
+		chainID, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+		if err != nil {
+			log.Panic().Str("key", key).Str("entry", entry).Err(err).Msg("Failed to parse chain ID in env variable")
+		}
+
+		res = append(res, WalletSmartAccount{
+			ChainID:           chainID,
+			FactoryAddress:    strings.TrimSpace(parts[1]),
+			EntryPointAddress: strings.TrimSpace(parts[2]),
+			PaymasterAddress:  strings.TrimSpace(parts[3]),
+		})
+	}
+
+	return res
+}
+
+// parseWithdrawWindows parses windows in the form "chain:chainID@HH:MM|HH:MM" or "token:tokenID@HH:MM|HH:MM",
+// e.g. []string{"chain:56@10:00|18:00", "token:3@12:00"}. Malformed entries cause a panic, same as parseChainBlockOverrides.
+func parseWithdrawWindows(key string, entries []string) []WalletWithdrawWindow {
+	res := make([]WalletWithdrawWindow, 0, len(entries))
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		scope, times, found := strings.Cut(entry, "@")
+		if !found {
+			log.Panic().Str("key", key).Str("entry", entry).Msg("Failed to parse env variable, expected chain:chainID@HH:MM|HH:MM or token:tokenID@HH:MM|HH:MM")
+		}
+
+		kind, idStr, found := strings.Cut(strings.TrimSpace(scope), ":")
+		if !found {
+			log.Panic().Str("key", key).Str("entry", entry).Msg("Failed to parse env variable, expected chain:chainID or token:tokenID before @")
+		}
+
+		id, err := strconv.Atoi(strings.TrimSpace(idStr))
+		if err != nil {
+			log.Panic().Str("key", key).Str("entry", entry).Err(err).Msg("Failed to parse ID in env variable")
+		}
+
+		var window WalletWithdrawWindow
+		switch strings.TrimSpace(kind) {
+		case "chain":
+			window.ChainID = id
+		case "token":
+			window.TokenID = id
+		default:
+			log.Panic().Str("key", key).Str("entry", entry).Msg("Failed to parse env variable, scope must be chain or token")
+		}
+
+		for _, t := range strings.Split(times, "|") {
+			if t = strings.TrimSpace(t); t != "" {
+				window.Times = append(window.Times, t)
+			}
+		}
+
+		res = append(res, window)
+	}
+
+	return res
+}
+
+// parseTimeOfDay parses "HH:MM" into the offset from midnight.
+func parseTimeOfDay(s string) (time.Duration, bool) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, false
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, true
+}
+
+func parseNonNegativeFloat(s string) (*big.Float, bool) {
+	v, err := money.ParseFloat(s)
+	if err != nil || v.Sign() < 0 {
+		return nil, false
+	}
+
+	return v, true
+}
+
+func parseNonNegativeInt(s string) (*big.Int, bool) {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok || v.Sign() < 0 {
+		return nil, false
+	}
+
+	return v, true
+}
+
+// validateHotWalletMinBalances checks chain IDs and amounts and that no token of a chain is configured twice.
+func validateHotWalletMinBalances(balances []WalletHotWalletMinBalance) []string {
+	var errs []string
+
+	seen := make(map[string]bool, len(balances))
+	for i, balance := range balances {
+		if balance.ChainID <= 0 {
+			errs = append(errs, fmt.Sprintf("HotWalletMinBalances[%d].ChainID must be positive, got %d", i, balance.ChainID))
+		}
+		if balance.TokenSymbol == "" {
+			errs = append(errs, fmt.Sprintf("HotWalletMinBalances[%d].TokenSymbol must not be empty", i))
+		}
+
+		key := fmt.Sprintf("%d:%s", balance.ChainID, strings.ToUpper(balance.TokenSymbol))
+		if seen[key] {
+			errs = append(errs, fmt.Sprintf("HotWalletMinBalances[%d] duplicates the minimum of %s on chain %d", i, balance.TokenSymbol, balance.ChainID))
+		}
+		seen[key] = true
+
+		if _, ok := parseNonNegativeFloat(balance.MinAmount); !ok {
+			errs = append(errs, fmt.Sprintf("HotWalletMinBalances[%d].MinAmount must be a non-negative number, got %q", i, balance.MinAmount))
+		}
+	}
+
+	return errs
+}
+
+// parseHotWalletMinBalances parses minimums in the form "chainID:tokenSymbol:minAmount", e.g. []string{"56:BNB:1", "56:USDT:5000"}.
+// Malformed entries cause a panic, same as parseChainBlockOverrides.
+func parseHotWalletMinBalances(key string, entries []string) []WalletHotWalletMinBalance {
+	res := make([]WalletHotWalletMinBalance, 0, len(entries))
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 {
+			log.Panic().Str("key", key).Str("entry", entry).Msg("Failed to parse env variable, expected chainID:tokenSymbol:minAmount")
+		}
+
+		chainID, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+		if err != nil {
+			log.Panic().Str("key", key).Str("entry", entry).Err(err).Msg("Failed to parse chain ID in env variable")
+		}
+
+		res = append(res, WalletHotWalletMinBalance{
+			ChainID:     chainID,
+			TokenSymbol: strings.TrimSpace(parts[1]),
+			MinAmount:   strings.TrimSpace(parts[2]),
+		})
+	}
+
+	return res
+}
+
+// validateGasSpike checks the ceilings, that no chain is configured twice, the resume percentage and the withdraw mode.
+func validateGasSpike(gasSpike WalletGasSpike) []string {
+	var errs []string
+
+	seen := make(map[int]bool, len(gasSpike.Ceilings))
+	for i, ceiling := range gasSpike.Ceilings {
+		if ceiling.ChainID <= 0 {
+			errs = append(errs, fmt.Sprintf("GasSpike.Ceilings[%d].ChainID must be positive, got %d", i, ceiling.ChainID))
+		}
+		if seen[ceiling.ChainID] {
+			errs = append(errs, fmt.Sprintf("GasSpike.Ceilings[%d] duplicates the ceiling of chain %d", i, ceiling.ChainID))
+		}
+		seen[ceiling.ChainID] = true
+
+		if _, ok := parsePositiveGwei(ceiling.MaxBaseFeeGwei); !ok {
+			errs = append(errs, fmt.Sprintf("GasSpike.Ceilings[%d].MaxBaseFeeGwei must be a positive number of at least 1 wei, got %q", i, ceiling.MaxBaseFeeGwei))
+		}
+	}
+
+	if gasSpike.ResumePercent < 1 || gasSpike.ResumePercent > 100 {
+		errs = append(errs, fmt.Sprintf("GasSpike.ResumePercent must be between 1 and 100, got %d", gasSpike.ResumePercent))
+	}
+
+	switch gasSpike.WithdrawMode {
+	case WalletGasSpikeWithdrawModeQueue, WalletGasSpikeWithdrawModeAllow:
+	default:
+		errs = append(errs, fmt.Sprintf("GasSpike.WithdrawMode must be %q or %q, got %q", WalletGasSpikeWithdrawModeQueue, WalletGasSpikeWithdrawModeAllow, gasSpike.WithdrawMode))
+	}
+
+	return errs
+}
+
+// validateGasPriceCap checks the caps, that no chain is configured twice and the retry schedule.
+func validateGasPriceCap(gasPriceCap WalletGasPriceCap) []string {
+	var errs []string
+
+	seen := make(map[int]bool, len(gasPriceCap.Caps))
+	for i, c := range gasPriceCap.Caps {
+		if c.ChainID <= 0 {
+			errs = append(errs, fmt.Sprintf("GasPriceCap.Caps[%d].ChainID must be positive, got %d", i, c.ChainID))
+		}
+		if seen[c.ChainID] {
+			errs = append(errs, fmt.Sprintf("GasPriceCap.Caps[%d] duplicates the cap of chain %d", i, c.ChainID))
+		}
+		seen[c.ChainID] = true
+
+		if _, ok := parsePositiveGwei(c.MaxGasPriceGwei); !ok {
+			errs = append(errs, fmt.Sprintf("GasPriceCap.Caps[%d].MaxGasPriceGwei must be a positive number of at least 1 wei, got %q", i, c.MaxGasPriceGwei))
+		}
+	}
+
+	if len(gasPriceCap.Caps) > 0 && len(gasPriceCap.RetryDelays) == 0 {
+		errs = append(errs, "GasPriceCap.RetryDelays must not be empty when gas price caps are configured")
+	}
+	for i, delay := range gasPriceCap.RetryDelays {
+		if delay <= 0 {
+			errs = append(errs, fmt.Sprintf("GasPriceCap.RetryDelays[%d] must be positive, got %s", i, delay))
+		}
+	}
+
+	return errs
+}
+
+// parsePositiveGwei parses a decimal gwei amount and returns it in wei (rounded down), which has to be at least 1 wei.
+func parsePositiveGwei(s string) (*big.Int, bool) {
+	const weiPerGwei = 1_000_000_000
+
+	v, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, false
+	}
+
+	wei := new(big.Int).Quo(new(big.Int).Mul(v.Num(), big.NewInt(weiPerGwei)), v.Denom())
+	if wei.Sign() <= 0 {
+		return nil, false
+	}
+
+	return wei, true
+}
+
+// parseGasCeilings parses ceilings in the form "chainID:maxBaseFeeGwei", e.g. []string{"1:100", "56:5"}.
+// Malformed entries cause a panic, same as parseChainBlockOverrides.
+func parseGasCeilings(key string, entries []string) []WalletGasCeiling {
+	res := make([]WalletGasCeiling, 0, len(entries))
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ":")
+		if len(parts) != 2 {
+			log.Panic().Str("key", key).Str("entry", entry).Msg("Failed to parse env variable, expected chainID:maxBaseFeeGwei")
+		}
+
+		chainID, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+		if err != nil {
+			log.Panic().Str("key", key).Str("entry", entry).Err(err).Msg("Failed to parse chain ID in env variable")
+		}
+
+		res = append(res, WalletGasCeiling{
+			ChainID:        chainID,
+			MaxBaseFeeGwei: strings.TrimSpace(parts[1]),
+		})
+	}
+
+	return res
+}
+
+// parseMaxGasPrices parses caps in the form "chainID:maxGasPriceGwei", e.g. []string{"1:80", "56:5"}.
+// Malformed entries cause a panic, same as parseChainBlockOverrides.
+func parseMaxGasPrices(key string, entries []string) []WalletMaxGasPrice {
+	res := make([]WalletMaxGasPrice, 0, len(entries))
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ":")
+		if len(parts) != 2 {
+			log.Panic().Str("key", key).Str("entry", entry).Msg("Failed to parse env variable, expected chainID:maxGasPriceGwei")
+		}
+
+		chainID, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+		if err != nil {
+			log.Panic().Str("key", key).Str("entry", entry).Err(err).Msg("Failed to parse chain ID in env variable")
+		}
+
+		res = append(res, WalletMaxGasPrice{
+			ChainID:         chainID,
+			MaxGasPriceGwei: strings.TrimSpace(parts[1]),
+		})
+	}
+
+	return res
+}
+
+// parseSeconds parses a list of durations in seconds, e.g. []string{"60", "300"}.
+// Malformed entries cause a panic, same as parseChainIDs.
+func parseSeconds(key string, entries []string) []time.Duration {
+	res := make([]time.Duration, 0, len(entries))
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		seconds, err := strconv.Atoi(entry)
+		if err != nil {
+			log.Panic().Str("key", key).Str("entry", entry).Err(err).Msg("Failed to parse seconds in env variable")
+		}
+
+		res = append(res, time.Duration(seconds)*time.Second)
+	}
+
+	return res
+}
@@ -1,10 +1,13 @@
 package dto
 
-import "github.com/aarondl/null/v8"
+import (
+	"github.com/aarondl/null/v8"
+	"github/chapool/go-wallet/internal/models"
+)
 
 type UpdatePushTokenRequest struct {
 	User          User
 	Token         string
-	Provider      string
+	Provider      models.ProviderType
 	ExistingToken null.String
 }
@@ -157,3 +157,27 @@ type DeleteUserAccountRequest struct {
 type ConfirmatioNotificationPayload struct {
 	ConfirmationLink string
 }
+
+type WithdrawAddressConfirmationPayload struct {
+	ConfirmationLink string
+	Address          string
+	ChainID          int
+	ExpiresIn        string
+}
+
+type SecurityNotificationPayload struct {
+	Event      string
+	Subject    string
+	Data       map[string]string
+	Template   string // organization email template (html/template), overrides the security_<event> template when set
+	SenderName string // organization sender display name, overrides the display name of the default sender when set
+}
+
+type AlertNotificationPayload struct {
+	Subject  string
+	Type     string
+	Severity string
+	Message  string
+	Time     string
+	Fields   map[string]string
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"html/template"
+	netmail "net/mail"
 	"os"
 	"path/filepath"
 
@@ -19,8 +20,11 @@ import (
 
 var (
 	ErrEmailTemplateNotFound         = errors.New("email template not found")
-	emailTemplatePasswordReset       = "password_reset"       // /app/templates/email/password_reset/**.
-	emailTemplateAccountConfirmation = "account_confirmation" // /app/templates/email/account_confirmation/**
+	emailTemplatePasswordReset       = "password_reset"                // /app/templates/email/password_reset/**.
+	emailTemplateAccountConfirmation = "account_confirmation"          // /app/templates/email/account_confirmation/**
+	emailTemplateWithdrawAddress     = "withdraw_address_confirmation" // /app/templates/email/withdraw_address_confirmation/**
+	emailTemplateSecurityPrefix      = "security_"                     // /app/templates/email/security_<event>/**
+	emailTemplateAlert               = "alert"                         // /app/templates/email/alert/**
 )
 
 type Mailer struct {
@@ -160,3 +164,144 @@ func (m *Mailer) SendAccountConfirmation(ctx context.Context, to string, payload
 
 	return nil
 }
+
+func (m *Mailer) SendWithdrawAddressConfirmation(ctx context.Context, to string, payload dto.WithdrawAddressConfirmationPayload) error {
+	log := util.LogFromContext(ctx).With().Str("component", "mailer").Str("email_template", emailTemplateWithdrawAddress).Logger()
+
+	tmpl, ok := m.Templates[emailTemplateWithdrawAddress]
+	if !ok {
+		log.Error().Msg("Withdraw address confirmation email template not found")
+		return ErrEmailTemplateNotFound
+	}
+
+	data := map[string]interface{}{
+		"confirmationLink": payload.ConfirmationLink,
+		"address":          payload.Address,
+		"chainId":          payload.ChainID,
+		"expiresIn":        payload.ExpiresIn,
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		log.Error().Err(err).Msg("Failed to execute withdraw address confirmation email template")
+		return fmt.Errorf("failed to execute withdraw address confirmation email template: %w", err)
+	}
+
+	mail := email.NewEmail()
+
+	mail.From = m.Config.DefaultSender
+	mail.To = []string{to}
+	mail.Subject = "Confirm your new withdrawal address"
+	mail.HTML = buf.Bytes()
+
+	if !m.Config.Send {
+		log.Warn().Str("to", to).Msg("Sending has been disabled in mailer config, skipping withdraw address confirmation email")
+		return nil
+	}
+
+	if err := m.Transport.Send(mail); err != nil {
+		log.Debug().Err(err).Msg("Failed to send withdraw address confirmation email")
+		return fmt.Errorf("failed to send withdraw address confirmation email: %w", err)
+	}
+
+	log.Debug().Msg("Successfully sent withdraw address confirmation email")
+
+	return nil
+}
+
+func (m *Mailer) SendSecurityNotification(ctx context.Context, to string, payload dto.SecurityNotificationPayload) error {
+	templateName := emailTemplateSecurityPrefix + payload.Event
+	log := util.LogFromContext(ctx).With().Str("component", "mailer").Str("email_template", templateName).Logger()
+
+	tmpl, ok := m.Templates[templateName]
+	if payload.Template != "" {
+		custom, err := template.New(templateName).Parse(payload.Template)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to parse custom security notification email template")
+			return fmt.Errorf("failed to parse custom security notification email template: %w", err)
+		}
+		tmpl, ok = custom, true
+	}
+	if !ok {
+		log.Error().Msg("Security notification email template not found")
+		return ErrEmailTemplateNotFound
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, payload.Data); err != nil {
+		log.Error().Err(err).Msg("Failed to execute security notification email template")
+		return fmt.Errorf("failed to execute security notification email template: %w", err)
+	}
+
+	mail := email.NewEmail()
+
+	mail.From = m.senderWithName(payload.SenderName)
+	mail.To = []string{to}
+	mail.Subject = payload.Subject
+	mail.HTML = buf.Bytes()
+
+	if !m.Config.Send {
+		log.Warn().Str("to", to).Msg("Sending has been disabled in mailer config, skipping security notification email")
+		return nil
+	}
+
+	if err := m.Transport.Send(mail); err != nil {
+		log.Debug().Err(err).Msg("Failed to send security notification email")
+		return fmt.Errorf("failed to send security notification email: %w", err)
+	}
+
+	log.Debug().Msg("Successfully sent security notification email")
+
+	return nil
+}
+
+// senderWithName returns the default sender address displayed as name, or the default sender if name is empty.
+func (m *Mailer) senderWithName(name string) string {
+	if name == "" {
+		return m.Config.DefaultSender
+	}
+
+	sender, err := netmail.ParseAddress(m.Config.DefaultSender)
+	if err != nil {
+		return m.Config.DefaultSender
+	}
+
+	return (&netmail.Address{Name: name, Address: sender.Address}).String()
+}
+
+func (m *Mailer) SendAlert(ctx context.Context, to []string, payload dto.AlertNotificationPayload) error {
+	log := util.LogFromContext(ctx).With().Str("component", "mailer").Str("email_template", emailTemplateAlert).Logger()
+
+	tmpl, ok := m.Templates[emailTemplateAlert]
+	if !ok {
+		log.Error().Msg("Alert email template not found")
+		return ErrEmailTemplateNotFound
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, payload); err != nil {
+		log.Error().Err(err).Msg("Failed to execute alert email template")
+		return fmt.Errorf("failed to execute alert email template: %w", err)
+	}
+
+	mail := email.NewEmail()
+
+	mail.From = m.Config.DefaultSender
+	mail.To = to
+	mail.Subject = payload.Subject
+	mail.HTML = buf.Bytes()
+
+	if !m.Config.Send {
+		log.Warn().Strs("to", to).Str("alertType", payload.Type).Msg("Sending has been disabled in mailer config, skipping alert email")
+		return nil
+	}
+
+	if err := m.Transport.Send(mail); err != nil {
+		log.Debug().Err(err).Msg("Failed to send alert email")
+		return fmt.Errorf("failed to send alert email: %w", err)
+	}
+
+	log.Debug().Msg("Successfully sent alert email")
+
+	return nil
+}
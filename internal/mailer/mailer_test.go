@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github/chapool/go-wallet/internal/data/dto"
 	"github/chapool/go-wallet/internal/test"
 	"github/chapool/go-wallet/internal/test/fixtures"
 )
@@ -36,3 +37,30 @@ func TestMailerSendPasswordReset(t *testing.T) {
 	assert.Equal(t, "Password reset", mail.Subject)
 	assert.Contains(t, string(mail.HTML), passwordResetLink)
 }
+
+func TestMailerSendSecurityNotificationWithOrganizationTemplate(t *testing.T) {
+	ctx := t.Context()
+	fix := fixtures.Fixtures()
+
+	mailer := test.NewTestMailer(t)
+	mailTransport := test.GetTestMailerMockTransport(t, mailer)
+	mailTransport.Expect(1)
+
+	err := mailer.SendSecurityNotification(ctx, fix.User1.Username.String, dto.SecurityNotificationPayload{
+		Event:      "large_withdraw",
+		Subject:    "Large withdrawal requested",
+		Data:       map[string]string{"amount": "1.5", "tokenSymbol": "<b>USDT</b>"},
+		Template:   `<p>{{ .amount }} {{ .tokenSymbol }}</p>`,
+		SenderName: "Acme Pay",
+	})
+	require.NoError(t, err)
+
+	mailTransport.WaitWithTimeout(time.Second)
+
+	mail := mailTransport.GetLastSentMail()
+	require.NotNil(t, mail)
+	assert.Equal(t, `"Acme Pay" <`+test.TestMailerDefaultSender+`>`, mail.From)
+	assert.Equal(t, "Large withdrawal requested", mail.Subject)
+	// 组织模板的变量同样按 HTML 转义
+	assert.Equal(t, "<p>1.5 &lt;b&gt;USDT&lt;/b&gt;</p>", string(mail.HTML))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github/chapool/go-wallet/internal/config"
 	"github/chapool/go-wallet/internal/metrics/users"
+	walletMetrics "github/chapool/go-wallet/internal/metrics/wallet"
 	"github/chapool/go-wallet/internal/util"
 )
 
@@ -31,6 +32,7 @@ func (s *Service) RegisterMetrics(ctx context.Context) error {
 
 	// custom metrics
 	metrics = append(metrics, users.Metrics(ctx, users.NewDatabaseMetricsCollector(s.db))...)
+	metrics = append(metrics, walletMetrics.Metrics(ctx, walletMetrics.NewDatabaseMetricsCollector(s.db))...)
 
 	// sqlstats metrics, see https://github.com/dlmiddlecote/sqlstats?tab=readme-ov-file#exposed-metrics for the exposed metrics
 	metrics = append(metrics, sqlstats.NewStatsCollector(s.config.Database.Database, s.db))
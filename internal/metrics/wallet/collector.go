@@ -0,0 +1,52 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/util"
+)
+
+type DatabaseMetricsCollector struct {
+	db *sql.DB
+}
+
+func NewDatabaseMetricsCollector(db *sql.DB) *DatabaseMetricsCollector {
+	return &DatabaseMetricsCollector{db: db}
+}
+
+func (c DatabaseMetricsCollector) GetWithdrawCountsByStatus(ctx context.Context) map[string]float64 {
+	log := util.LogFromContext(ctx)
+
+	// report every status, including the ones without withdraws
+	counts := make(map[string]float64, len(models.AllWithdrawStatus()))
+	for _, status := range models.AllWithdrawStatus() {
+		counts[status.String()] = 0
+	}
+
+	rows, err := c.db.QueryContext(ctx, `SELECT status, COUNT(*) FROM withdraws GROUP BY status`)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get withdraw counts by status")
+		return counts
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			status string
+			count  int64
+		)
+		if err := rows.Scan(&status, &count); err != nil {
+			log.Error().Err(err).Msg("Failed to scan withdraw count")
+			return counts
+		}
+		counts[status] = float64(count)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error().Err(err).Msg("Failed to iterate withdraw counts")
+	}
+
+	return counts
+}
@@ -0,0 +1,240 @@
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github/chapool/go-wallet/internal/money"
+)
+
+type MetricsCollector interface {
+	GetWithdrawCountsByStatus(ctx context.Context) map[string]float64
+}
+
+const (
+	namespace = "wallet"
+
+	MetricNameWithdraws = "wallet_withdraws"
+)
+
+// Instrumented directly by the wallet subsystems. Updating them is a no-op for the /metrics output
+// unless they are registered via Metrics (i.e. management metrics are enabled).
+var (
+	BlocksScanned = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "scan",
+		Name:      "blocks_scanned_total",
+		Help:      "Blocks indexed by the scanner (live and backfill)",
+	}, []string{"chain_id"})
+
+	ScanLatestBlock = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "scan",
+		Name:      "latest_block",
+		Help:      "Latest block reported by the RPC node",
+	}, []string{"chain_id"})
+
+	ScanScannedBlock = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "scan",
+		Name:      "scanned_block",
+		Help:      "Highest block scanned",
+	}, []string{"chain_id"})
+
+	ScanLagBlocks = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "scan",
+		Name:      "lag_blocks",
+		Help:      "Number of blocks the scanner is behind the latest block",
+	}, []string{"chain_id"})
+
+	ChainHalted = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "scan",
+		Name:      "chain_halted",
+		Help:      "1 if the head block has not advanced on any RPC endpoint for longer than the halt threshold",
+	}, []string{"chain_id"})
+
+	ChainBaseFeeGwei = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "gas",
+		Name:      "base_fee_gwei",
+		Help:      "Last observed base fee (gas price on chains without EIP-1559) of chains with a gas ceiling",
+	}, []string{"chain_id"})
+
+	GasSpikePaused = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "gas",
+		Name:      "spike_paused",
+		Help:      "1 if automatic operations of the chain are paused because the base fee exceeds the configured ceiling",
+	}, []string{"chain_id"})
+
+	DepositsDetected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "deposit",
+		Name:      "detected_total",
+		Help:      "Deposit transactions detected by the scanner",
+	}, []string{"chain_id", "asset"})
+
+	RPCErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "errors_total",
+		Help:      "Failed RPC calls",
+	}, []string{"chain_id", "method"})
+
+	RPCFailovers = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "failovers_total",
+		Help:      "Switches to another RPC endpoint",
+	}, []string{"chain_id"})
+
+	RPCEndpointRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "endpoint_requests_total",
+		Help:      "RPC calls per endpoint by result, failures count towards the endpoint circuit breaker",
+	}, []string{"chain_id", "endpoint", "result"})
+
+	RPCEndpointLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "endpoint_latency_seconds",
+		Help:      "Latency of successful RPC calls per endpoint",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"chain_id", "endpoint"})
+
+	RPCEndpointCircuitOpen = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "endpoint_circuit_open",
+		Help:      "1 while the circuit breaker of an RPC endpoint is open and the endpoint is skipped",
+	}, []string{"chain_id", "endpoint"})
+
+	ChainIDMismatch = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "chain_id_mismatch",
+		Help:      "1 if an RPC endpoint reports a chain ID different from the configured one and the chain is not served",
+	}, []string{"chain_id"})
+
+	CollectTransactions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "collect",
+		Name:      "transactions_total",
+		Help:      "Collect transactions sent to hot wallets by receipt status",
+	}, []string{"chain_id", "asset", "status"})
+
+	HotWalletBalance = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "hot_wallet",
+		Name:      "balance",
+		Help:      "Last observed hot wallet balance in whole tokens",
+	}, []string{"chain_id", "address", "token"})
+
+	EventsPublished = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "published_total",
+		Help:      "Wallet domain events delivered to the analytics publisher",
+	}, []string{"event_type"})
+
+	EventPublishErrors = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "publish_errors_total",
+		Help:      "Failed attempts to publish a batch of wallet domain events",
+	})
+
+	EventsPending = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "pending",
+		Help:      "Wallet domain events in the outbox waiting to be published",
+	})
+
+	EventsLagSeconds = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "lag_seconds",
+		Help:      "Age of the oldest unpublished wallet domain event",
+	})
+)
+
+// Asset label values.
+const (
+	AssetNative = "native"
+	AssetERC20  = "erc20"
+	AssetSPL    = "spl"
+)
+
+func Metrics(ctx context.Context, collector MetricsCollector) []prometheus.Collector {
+	return []prometheus.Collector{
+		BlocksScanned,
+		ScanLatestBlock,
+		ScanScannedBlock,
+		ScanLagBlocks,
+		ChainHalted,
+		ChainBaseFeeGwei,
+		GasSpikePaused,
+		DepositsDetected,
+		RPCErrors,
+		RPCFailovers,
+		RPCEndpointRequests,
+		RPCEndpointLatency,
+		RPCEndpointCircuitOpen,
+		ChainIDMismatch,
+		CollectTransactions,
+		HotWalletBalance,
+		EventsPublished,
+		EventPublishErrors,
+		EventsPending,
+		EventsLagSeconds,
+		newWithdrawStatusCollector(ctx, collector),
+	}
+}
+
+// ChainLabel formats a chain ID as label value.
+func ChainLabel(chainID int) string {
+	return strconv.Itoa(chainID)
+}
+
+// SetHotWalletBalance records a hot wallet balance given in the smallest token unit.
+func SetHotWalletBalance(chainID int, address string, token string, balance *big.Int, decimals int) {
+	value := new(big.Float).SetInt(balance)
+	if decimals > 0 {
+		value.Quo(value, new(big.Float).SetInt(money.Scale(decimals)))
+	}
+
+	f, _ := value.Float64()
+	HotWalletBalance.WithLabelValues(ChainLabel(chainID), address, token).Set(f)
+}
+
+// withdrawStatusCollector queries the number of withdraws per status on every scrape.
+type withdrawStatusCollector struct {
+	ctx       context.Context //nolint:containedctx // scrapes have no request context, same as the users metrics
+	collector MetricsCollector
+	desc      *prometheus.Desc
+}
+
+func newWithdrawStatusCollector(ctx context.Context, collector MetricsCollector) *withdrawStatusCollector {
+	return &withdrawStatusCollector{
+		ctx:       ctx,
+		collector: collector,
+		desc:      prometheus.NewDesc(MetricNameWithdraws, "Withdraws by status", []string{"status"}, nil),
+	}
+}
+
+func (c *withdrawStatusCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *withdrawStatusCollector) Collect(ch chan<- prometheus.Metric) {
+	for status, count := range c.collector.GetWithdrawCountsByStatus(c.ctx) {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, count, status)
+	}
+}
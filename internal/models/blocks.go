@@ -23,14 +23,14 @@ import (
 
 // Block is an object representing the database table.
 type Block struct {
-	Hash       string    `boil:"hash" json:"hash" toml:"hash" yaml:"hash"`
-	ChainID    int       `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
-	ParentHash string    `boil:"parent_hash" json:"parent_hash" toml:"parent_hash" yaml:"parent_hash"`
-	Number     int64     `boil:"number" json:"number" toml:"number" yaml:"number"`
-	Timestamp  int64     `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
-	Status     string    `boil:"status" json:"status" toml:"status" yaml:"status"`
-	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt  time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Hash       string      `boil:"hash" json:"hash" toml:"hash" yaml:"hash"`
+	ChainID    int         `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
+	ParentHash string      `boil:"parent_hash" json:"parent_hash" toml:"parent_hash" yaml:"parent_hash"`
+	Number     int64       `boil:"number" json:"number" toml:"number" yaml:"number"`
+	Timestamp  int64       `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+	Status     BlockStatus `boil:"status" json:"status" toml:"status" yaml:"status"`
+	CreatedAt  time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 
 	R *blockR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L blockL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -101,13 +101,48 @@ func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
+type whereHelperBlockStatus struct{ field string }
+
+func (w whereHelperBlockStatus) EQ(x BlockStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperBlockStatus) NEQ(x BlockStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperBlockStatus) LT(x BlockStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperBlockStatus) LTE(x BlockStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperBlockStatus) GT(x BlockStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperBlockStatus) GTE(x BlockStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperBlockStatus) IN(slice []BlockStatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperBlockStatus) NIN(slice []BlockStatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var BlockWhere = struct {
 	Hash       whereHelperstring
 	ChainID    whereHelperint
 	ParentHash whereHelperstring
 	Number     whereHelperint64
 	Timestamp  whereHelperint64
-	Status     whereHelperstring
+	Status     whereHelperBlockStatus
 	CreatedAt  whereHelpertime_Time
 	UpdatedAt  whereHelpertime_Time
 }{
@@ -116,7 +151,7 @@ var BlockWhere = struct {
 	ParentHash: whereHelperstring{field: "\"blocks\".\"parent_hash\""},
 	Number:     whereHelperint64{field: "\"blocks\".\"number\""},
 	Timestamp:  whereHelperint64{field: "\"blocks\".\"timestamp\""},
-	Status:     whereHelperstring{field: "\"blocks\".\"status\""},
+	Status:     whereHelperBlockStatus{field: "\"blocks\".\"status\""},
 	CreatedAt:  whereHelpertime_Time{field: "\"blocks\".\"created_at\""},
 	UpdatedAt:  whereHelpertime_Time{field: "\"blocks\".\"updated_at\""},
 }
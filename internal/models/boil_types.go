@@ -51,16 +51,18 @@ func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
 	return str
 }
 
+type BlockStatus string
+
 // Enum values for BlockStatus
 const (
-	BlockStatusConfirmed string = "confirmed"
-	BlockStatusSafe      string = "safe"
-	BlockStatusFinalized string = "finalized"
-	BlockStatusOrphaned  string = "orphaned"
+	BlockStatusConfirmed BlockStatus = "confirmed"
+	BlockStatusSafe      BlockStatus = "safe"
+	BlockStatusFinalized BlockStatus = "finalized"
+	BlockStatusOrphaned  BlockStatus = "orphaned"
 )
 
-func AllBlockStatus() []string {
-	return []string{
+func AllBlockStatus() []BlockStatus {
+	return []BlockStatus{
 		BlockStatusConfirmed,
 		BlockStatusSafe,
 		BlockStatusFinalized,
@@ -68,70 +70,228 @@ func AllBlockStatus() []string {
 	}
 }
 
+func (e BlockStatus) IsValid() error {
+	switch e {
+	case BlockStatusConfirmed, BlockStatusSafe, BlockStatusFinalized, BlockStatusOrphaned:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e BlockStatus) String() string {
+	return string(e)
+}
+
+func (e BlockStatus) Ordinal() int {
+	switch e {
+	case BlockStatusConfirmed:
+		return 0
+	case BlockStatusSafe:
+		return 1
+	case BlockStatusFinalized:
+		return 2
+	case BlockStatusOrphaned:
+		return 3
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type CreditType string
+
 // Enum values for CreditType
 const (
-	CreditTypeDeposit   string = "deposit"
-	CreditTypeWithdraw  string = "withdraw"
-	CreditTypeCollect   string = "collect"
-	CreditTypeRebalance string = "rebalance"
-	CreditTypeFreeze    string = "freeze"
-	CreditTypeUnfreeze  string = "unfreeze"
+	CreditTypeDeposit           CreditType = "deposit"
+	CreditTypeWithdraw          CreditType = "withdraw"
+	CreditTypeCollect           CreditType = "collect"
+	CreditTypeRebalance         CreditType = "rebalance"
+	CreditTypeFreeze            CreditType = "freeze"
+	CreditTypeUnfreeze          CreditType = "unfreeze"
+	CreditTypeDustConsolidation CreditType = "dust_consolidation"
+	CreditTypeDepositFee        CreditType = "deposit_fee"
+	CreditTypeWithdrawFee       CreditType = "withdraw_fee"
+	CreditTypeWithdrawReversal  CreditType = "withdraw_reversal"
+	CreditTypeInternal          CreditType = "internal"
 )
 
-func AllCreditType() []string {
-	return []string{
+func AllCreditType() []CreditType {
+	return []CreditType{
 		CreditTypeDeposit,
 		CreditTypeWithdraw,
 		CreditTypeCollect,
 		CreditTypeRebalance,
 		CreditTypeFreeze,
 		CreditTypeUnfreeze,
+		CreditTypeDustConsolidation,
+		CreditTypeDepositFee,
+		CreditTypeWithdrawFee,
+		CreditTypeWithdrawReversal,
+		CreditTypeInternal,
+	}
+}
+
+func (e CreditType) IsValid() error {
+	switch e {
+	case CreditTypeDeposit, CreditTypeWithdraw, CreditTypeCollect, CreditTypeRebalance, CreditTypeFreeze, CreditTypeUnfreeze, CreditTypeDustConsolidation, CreditTypeDepositFee, CreditTypeWithdrawFee, CreditTypeWithdrawReversal, CreditTypeInternal:
+		return nil
+	default:
+		return errors.New("enum is not valid")
 	}
 }
 
+func (e CreditType) String() string {
+	return string(e)
+}
+
+func (e CreditType) Ordinal() int {
+	switch e {
+	case CreditTypeDeposit:
+		return 0
+	case CreditTypeWithdraw:
+		return 1
+	case CreditTypeCollect:
+		return 2
+	case CreditTypeRebalance:
+		return 3
+	case CreditTypeFreeze:
+		return 4
+	case CreditTypeUnfreeze:
+		return 5
+	case CreditTypeDustConsolidation:
+		return 6
+	case CreditTypeDepositFee:
+		return 7
+	case CreditTypeWithdrawFee:
+		return 8
+	case CreditTypeWithdrawReversal:
+		return 9
+	case CreditTypeInternal:
+		return 10
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type BusinessType string
+
 // Enum values for BusinessType
 const (
-	BusinessTypeBlockchain       string = "blockchain"
-	BusinessTypeInternalTransfer string = "internal_transfer"
-	BusinessTypeAdminAdjust      string = "admin_adjust"
+	BusinessTypeBlockchain       BusinessType = "blockchain"
+	BusinessTypeInternalTransfer BusinessType = "internal_transfer"
+	BusinessTypeAdminAdjust      BusinessType = "admin_adjust"
 )
 
-func AllBusinessType() []string {
-	return []string{
+func AllBusinessType() []BusinessType {
+	return []BusinessType{
 		BusinessTypeBlockchain,
 		BusinessTypeInternalTransfer,
 		BusinessTypeAdminAdjust,
 	}
 }
 
+func (e BusinessType) IsValid() error {
+	switch e {
+	case BusinessTypeBlockchain, BusinessTypeInternalTransfer, BusinessTypeAdminAdjust:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e BusinessType) String() string {
+	return string(e)
+}
+
+func (e BusinessType) Ordinal() int {
+	switch e {
+	case BusinessTypeBlockchain:
+		return 0
+	case BusinessTypeInternalTransfer:
+		return 1
+	case BusinessTypeAdminAdjust:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type ReferenceType string
+
 // Enum values for ReferenceType
 const (
-	ReferenceTypeBlockchainTX string = "blockchain_tx"
-	ReferenceTypeWithdraw     string = "withdraw"
-	ReferenceTypeCollect      string = "collect"
-	ReferenceTypeRebalance    string = "rebalance"
+	ReferenceTypeBlockchainTX      ReferenceType = "blockchain_tx"
+	ReferenceTypeWithdraw          ReferenceType = "withdraw"
+	ReferenceTypeCollect           ReferenceType = "collect"
+	ReferenceTypeRebalance         ReferenceType = "rebalance"
+	ReferenceTypeDustConsolidation ReferenceType = "dust_consolidation"
+	ReferenceTypeDepositFee        ReferenceType = "deposit_fee"
+	ReferenceTypeInternalTransfer  ReferenceType = "internal_transfer"
 )
 
-func AllReferenceType() []string {
-	return []string{
+func AllReferenceType() []ReferenceType {
+	return []ReferenceType{
 		ReferenceTypeBlockchainTX,
 		ReferenceTypeWithdraw,
 		ReferenceTypeCollect,
 		ReferenceTypeRebalance,
+		ReferenceTypeDustConsolidation,
+		ReferenceTypeDepositFee,
+		ReferenceTypeInternalTransfer,
+	}
+}
+
+func (e ReferenceType) IsValid() error {
+	switch e {
+	case ReferenceTypeBlockchainTX, ReferenceTypeWithdraw, ReferenceTypeCollect, ReferenceTypeRebalance, ReferenceTypeDustConsolidation, ReferenceTypeDepositFee, ReferenceTypeInternalTransfer:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ReferenceType) String() string {
+	return string(e)
+}
+
+func (e ReferenceType) Ordinal() int {
+	switch e {
+	case ReferenceTypeBlockchainTX:
+		return 0
+	case ReferenceTypeWithdraw:
+		return 1
+	case ReferenceTypeCollect:
+		return 2
+	case ReferenceTypeRebalance:
+		return 3
+	case ReferenceTypeDustConsolidation:
+		return 4
+	case ReferenceTypeDepositFee:
+		return 5
+	case ReferenceTypeInternalTransfer:
+		return 6
+
+	default:
+		panic(errors.New("enum is not valid"))
 	}
 }
 
+type CreditStatus string
+
 // Enum values for CreditStatus
 const (
-	CreditStatusPending   string = "pending"
-	CreditStatusConfirmed string = "confirmed"
-	CreditStatusFinalized string = "finalized"
-	CreditStatusFailed    string = "failed"
-	CreditStatusFrozen    string = "frozen"
+	CreditStatusPending   CreditStatus = "pending"
+	CreditStatusConfirmed CreditStatus = "confirmed"
+	CreditStatusFinalized CreditStatus = "finalized"
+	CreditStatusFailed    CreditStatus = "failed"
+	CreditStatusFrozen    CreditStatus = "frozen"
 )
 
-func AllCreditStatus() []string {
-	return []string{
+func AllCreditStatus() []CreditStatus {
+	return []CreditStatus{
 		CreditStatusPending,
 		CreditStatusConfirmed,
 		CreditStatusFinalized,
@@ -140,29 +300,89 @@ func AllCreditStatus() []string {
 	}
 }
 
+func (e CreditStatus) IsValid() error {
+	switch e {
+	case CreditStatusPending, CreditStatusConfirmed, CreditStatusFinalized, CreditStatusFailed, CreditStatusFrozen:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e CreditStatus) String() string {
+	return string(e)
+}
+
+func (e CreditStatus) Ordinal() int {
+	switch e {
+	case CreditStatusPending:
+		return 0
+	case CreditStatusConfirmed:
+		return 1
+	case CreditStatusFinalized:
+		return 2
+	case CreditStatusFailed:
+		return 3
+	case CreditStatusFrozen:
+		return 4
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type ProviderType string
+
 // Enum values for ProviderType
 const (
-	ProviderTypeFCM string = "fcm"
-	ProviderTypeApn string = "apn"
+	ProviderTypeFCM ProviderType = "fcm"
+	ProviderTypeApn ProviderType = "apn"
 )
 
-func AllProviderType() []string {
-	return []string{
+func AllProviderType() []ProviderType {
+	return []ProviderType{
 		ProviderTypeFCM,
 		ProviderTypeApn,
 	}
 }
 
+func (e ProviderType) IsValid() error {
+	switch e {
+	case ProviderTypeFCM, ProviderTypeApn:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ProviderType) String() string {
+	return string(e)
+}
+
+func (e ProviderType) Ordinal() int {
+	switch e {
+	case ProviderTypeFCM:
+		return 0
+	case ProviderTypeApn:
+		return 1
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type TransactionType string
+
 // Enum values for TransactionType
 const (
-	TransactionTypeDeposit   string = "deposit"
-	TransactionTypeWithdraw  string = "withdraw"
-	TransactionTypeCollect   string = "collect"
-	TransactionTypeRebalance string = "rebalance"
+	TransactionTypeDeposit   TransactionType = "deposit"
+	TransactionTypeWithdraw  TransactionType = "withdraw"
+	TransactionTypeCollect   TransactionType = "collect"
+	TransactionTypeRebalance TransactionType = "rebalance"
 )
 
-func AllTransactionType() []string {
-	return []string{
+func AllTransactionType() []TransactionType {
+	return []TransactionType{
 		TransactionTypeDeposit,
 		TransactionTypeWithdraw,
 		TransactionTypeCollect,
@@ -170,35 +390,149 @@ func AllTransactionType() []string {
 	}
 }
 
+func (e TransactionType) IsValid() error {
+	switch e {
+	case TransactionTypeDeposit, TransactionTypeWithdraw, TransactionTypeCollect, TransactionTypeRebalance:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e TransactionType) String() string {
+	return string(e)
+}
+
+func (e TransactionType) Ordinal() int {
+	switch e {
+	case TransactionTypeDeposit:
+		return 0
+	case TransactionTypeWithdraw:
+		return 1
+	case TransactionTypeCollect:
+		return 2
+	case TransactionTypeRebalance:
+		return 3
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type TransactionStatus string
+
 // Enum values for TransactionStatus
 const (
-	TransactionStatusConfirmed string = "confirmed"
-	TransactionStatusSafe      string = "safe"
-	TransactionStatusFinalized string = "finalized"
-	TransactionStatusFailed    string = "failed"
+	TransactionStatusConfirmed TransactionStatus = "confirmed"
+	TransactionStatusSafe      TransactionStatus = "safe"
+	TransactionStatusFinalized TransactionStatus = "finalized"
+	TransactionStatusFailed    TransactionStatus = "failed"
+	TransactionStatusDust      TransactionStatus = "dust"
 )
 
-func AllTransactionStatus() []string {
-	return []string{
+func AllTransactionStatus() []TransactionStatus {
+	return []TransactionStatus{
 		TransactionStatusConfirmed,
 		TransactionStatusSafe,
 		TransactionStatusFinalized,
 		TransactionStatusFailed,
+		TransactionStatusDust,
+	}
+}
+
+func (e TransactionStatus) IsValid() error {
+	switch e {
+	case TransactionStatusConfirmed, TransactionStatusSafe, TransactionStatusFinalized, TransactionStatusFailed, TransactionStatusDust:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e TransactionStatus) String() string {
+	return string(e)
+}
+
+func (e TransactionStatus) Ordinal() int {
+	switch e {
+	case TransactionStatusConfirmed:
+		return 0
+	case TransactionStatusSafe:
+		return 1
+	case TransactionStatusFinalized:
+		return 2
+	case TransactionStatusFailed:
+		return 3
+	case TransactionStatusDust:
+		return 4
+
+	default:
+		panic(errors.New("enum is not valid"))
 	}
 }
 
+type WalletType string
+
+// Enum values for WalletType
+const (
+	WalletTypeUser  WalletType = "user"
+	WalletTypeHot   WalletType = "hot"
+	WalletTypeCold  WalletType = "cold"
+	WalletTypeWatch WalletType = "watch"
+)
+
+func AllWalletType() []WalletType {
+	return []WalletType{
+		WalletTypeUser,
+		WalletTypeHot,
+		WalletTypeCold,
+		WalletTypeWatch,
+	}
+}
+
+func (e WalletType) IsValid() error {
+	switch e {
+	case WalletTypeUser, WalletTypeHot, WalletTypeCold, WalletTypeWatch:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e WalletType) String() string {
+	return string(e)
+}
+
+func (e WalletType) Ordinal() int {
+	switch e {
+	case WalletTypeUser:
+		return 0
+	case WalletTypeHot:
+		return 1
+	case WalletTypeCold:
+		return 2
+	case WalletTypeWatch:
+		return 3
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type WithdrawStatus string
+
 // Enum values for WithdrawStatus
 const (
-	WithdrawStatusUserWithdrawRequest string = "user_withdraw_request"
-	WithdrawStatusSigning             string = "signing"
-	WithdrawStatusPending             string = "pending"
-	WithdrawStatusProcessing          string = "processing"
-	WithdrawStatusConfirmed           string = "confirmed"
-	WithdrawStatusFailed              string = "failed"
+	WithdrawStatusUserWithdrawRequest WithdrawStatus = "user_withdraw_request"
+	WithdrawStatusSigning             WithdrawStatus = "signing"
+	WithdrawStatusPending             WithdrawStatus = "pending"
+	WithdrawStatusProcessing          WithdrawStatus = "processing"
+	WithdrawStatusConfirmed           WithdrawStatus = "confirmed"
+	WithdrawStatusFailed              WithdrawStatus = "failed"
 )
 
-func AllWithdrawStatus() []string {
-	return []string{
+func AllWithdrawStatus() []WithdrawStatus {
+	return []WithdrawStatus{
 		WithdrawStatusUserWithdrawRequest,
 		WithdrawStatusSigning,
 		WithdrawStatusPending,
@@ -207,3 +541,36 @@ func AllWithdrawStatus() []string {
 		WithdrawStatusFailed,
 	}
 }
+
+func (e WithdrawStatus) IsValid() error {
+	switch e {
+	case WithdrawStatusUserWithdrawRequest, WithdrawStatusSigning, WithdrawStatusPending, WithdrawStatusProcessing, WithdrawStatusConfirmed, WithdrawStatusFailed:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e WithdrawStatus) String() string {
+	return string(e)
+}
+
+func (e WithdrawStatus) Ordinal() int {
+	switch e {
+	case WithdrawStatusUserWithdrawRequest:
+		return 0
+	case WithdrawStatusSigning:
+		return 1
+	case WithdrawStatusPending:
+		return 2
+	case WithdrawStatusProcessing:
+		return 3
+	case WithdrawStatusConfirmed:
+		return 4
+	case WithdrawStatusFailed:
+		return 5
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
@@ -24,82 +24,107 @@ import (
 
 // Chain is an object representing the database table.
 type Chain struct {
-	ID                 int         `boil:"id" json:"id" toml:"id" yaml:"id"`
-	ChainID            int         `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
-	ChainName          string      `boil:"chain_name" json:"chain_name" toml:"chain_name" yaml:"chain_name"`
-	ChainType          string      `boil:"chain_type" json:"chain_type" toml:"chain_type" yaml:"chain_type"`
-	RPCURL             string      `boil:"rpc_url" json:"rpc_url" toml:"rpc_url" yaml:"rpc_url"`
-	ExplorerURL        null.String `boil:"explorer_url" json:"explorer_url,omitempty" toml:"explorer_url" yaml:"explorer_url,omitempty"`
-	NativeTokenSymbol  string      `boil:"native_token_symbol" json:"native_token_symbol" toml:"native_token_symbol" yaml:"native_token_symbol"`
-	BlockTimeSeconds   null.Int    `boil:"block_time_seconds" json:"block_time_seconds,omitempty" toml:"block_time_seconds" yaml:"block_time_seconds,omitempty"`
-	ConfirmationBlocks null.Int    `boil:"confirmation_blocks" json:"confirmation_blocks,omitempty" toml:"confirmation_blocks" yaml:"confirmation_blocks,omitempty"`
-	FinalizedBlocks    null.Int    `boil:"finalized_blocks" json:"finalized_blocks,omitempty" toml:"finalized_blocks" yaml:"finalized_blocks,omitempty"`
-	IsActive           bool        `boil:"is_active" json:"is_active" toml:"is_active" yaml:"is_active"`
-	CreatedAt          time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt          time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID                    int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ChainID               int         `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
+	ChainName             string      `boil:"chain_name" json:"chain_name" toml:"chain_name" yaml:"chain_name"`
+	ChainType             string      `boil:"chain_type" json:"chain_type" toml:"chain_type" yaml:"chain_type"`
+	RPCURL                string      `boil:"rpc_url" json:"rpc_url" toml:"rpc_url" yaml:"rpc_url"`
+	ExplorerURL           null.String `boil:"explorer_url" json:"explorer_url,omitempty" toml:"explorer_url" yaml:"explorer_url,omitempty"`
+	NativeTokenSymbol     string      `boil:"native_token_symbol" json:"native_token_symbol" toml:"native_token_symbol" yaml:"native_token_symbol"`
+	BlockTimeSeconds      null.Int    `boil:"block_time_seconds" json:"block_time_seconds,omitempty" toml:"block_time_seconds" yaml:"block_time_seconds,omitempty"`
+	ConfirmationBlocks    null.Int    `boil:"confirmation_blocks" json:"confirmation_blocks,omitempty" toml:"confirmation_blocks" yaml:"confirmation_blocks,omitempty"`
+	FinalizedBlocks       null.Int    `boil:"finalized_blocks" json:"finalized_blocks,omitempty" toml:"finalized_blocks" yaml:"finalized_blocks,omitempty"`
+	IsActive              bool        `boil:"is_active" json:"is_active" toml:"is_active" yaml:"is_active"`
+	CreatedAt             time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt             time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ScanIntervalMs        null.Int    `boil:"scan_interval_ms" json:"scan_interval_ms,omitempty" toml:"scan_interval_ms" yaml:"scan_interval_ms,omitempty"`
+	BlockBatchSize        null.Int    `boil:"block_batch_size" json:"block_batch_size,omitempty" toml:"block_batch_size" yaml:"block_batch_size,omitempty"`
+	MaxReceiptConcurrency null.Int    `boil:"max_receipt_concurrency" json:"max_receipt_concurrency,omitempty" toml:"max_receipt_concurrency" yaml:"max_receipt_concurrency,omitempty"`
+	NativeTokenDecimals   int         `boil:"native_token_decimals" json:"native_token_decimals" toml:"native_token_decimals" yaml:"native_token_decimals"`
+	ExplorerURLTemplate   null.String `boil:"explorer_url_template" json:"explorer_url_template,omitempty" toml:"explorer_url_template" yaml:"explorer_url_template,omitempty"`
 
 	R *chainR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L chainL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ChainColumns = struct {
-	ID                 string
-	ChainID            string
-	ChainName          string
-	ChainType          string
-	RPCURL             string
-	ExplorerURL        string
-	NativeTokenSymbol  string
-	BlockTimeSeconds   string
-	ConfirmationBlocks string
-	FinalizedBlocks    string
-	IsActive           string
-	CreatedAt          string
-	UpdatedAt          string
+	ID                    string
+	ChainID               string
+	ChainName             string
+	ChainType             string
+	RPCURL                string
+	ExplorerURL           string
+	NativeTokenSymbol     string
+	BlockTimeSeconds      string
+	ConfirmationBlocks    string
+	FinalizedBlocks       string
+	IsActive              string
+	CreatedAt             string
+	UpdatedAt             string
+	ScanIntervalMs        string
+	BlockBatchSize        string
+	MaxReceiptConcurrency string
+	NativeTokenDecimals   string
+	ExplorerURLTemplate   string
 }{
-	ID:                 "id",
-	ChainID:            "chain_id",
-	ChainName:          "chain_name",
-	ChainType:          "chain_type",
-	RPCURL:             "rpc_url",
-	ExplorerURL:        "explorer_url",
-	NativeTokenSymbol:  "native_token_symbol",
-	BlockTimeSeconds:   "block_time_seconds",
-	ConfirmationBlocks: "confirmation_blocks",
-	FinalizedBlocks:    "finalized_blocks",
-	IsActive:           "is_active",
-	CreatedAt:          "created_at",
-	UpdatedAt:          "updated_at",
+	ID:                    "id",
+	ChainID:               "chain_id",
+	ChainName:             "chain_name",
+	ChainType:             "chain_type",
+	RPCURL:                "rpc_url",
+	ExplorerURL:           "explorer_url",
+	NativeTokenSymbol:     "native_token_symbol",
+	BlockTimeSeconds:      "block_time_seconds",
+	ConfirmationBlocks:    "confirmation_blocks",
+	FinalizedBlocks:       "finalized_blocks",
+	IsActive:              "is_active",
+	CreatedAt:             "created_at",
+	UpdatedAt:             "updated_at",
+	ScanIntervalMs:        "scan_interval_ms",
+	BlockBatchSize:        "block_batch_size",
+	MaxReceiptConcurrency: "max_receipt_concurrency",
+	NativeTokenDecimals:   "native_token_decimals",
+	ExplorerURLTemplate:   "explorer_url_template",
 }
 
 var ChainTableColumns = struct {
-	ID                 string
-	ChainID            string
-	ChainName          string
-	ChainType          string
-	RPCURL             string
-	ExplorerURL        string
-	NativeTokenSymbol  string
-	BlockTimeSeconds   string
-	ConfirmationBlocks string
-	FinalizedBlocks    string
-	IsActive           string
-	CreatedAt          string
-	UpdatedAt          string
+	ID                    string
+	ChainID               string
+	ChainName             string
+	ChainType             string
+	RPCURL                string
+	ExplorerURL           string
+	NativeTokenSymbol     string
+	BlockTimeSeconds      string
+	ConfirmationBlocks    string
+	FinalizedBlocks       string
+	IsActive              string
+	CreatedAt             string
+	UpdatedAt             string
+	ScanIntervalMs        string
+	BlockBatchSize        string
+	MaxReceiptConcurrency string
+	NativeTokenDecimals   string
+	ExplorerURLTemplate   string
 }{
-	ID:                 "chains.id",
-	ChainID:            "chains.chain_id",
-	ChainName:          "chains.chain_name",
-	ChainType:          "chains.chain_type",
-	RPCURL:             "chains.rpc_url",
-	ExplorerURL:        "chains.explorer_url",
-	NativeTokenSymbol:  "chains.native_token_symbol",
-	BlockTimeSeconds:   "chains.block_time_seconds",
-	ConfirmationBlocks: "chains.confirmation_blocks",
-	FinalizedBlocks:    "chains.finalized_blocks",
-	IsActive:           "chains.is_active",
-	CreatedAt:          "chains.created_at",
-	UpdatedAt:          "chains.updated_at",
+	ID:                    "chains.id",
+	ChainID:               "chains.chain_id",
+	ChainName:             "chains.chain_name",
+	ChainType:             "chains.chain_type",
+	RPCURL:                "chains.rpc_url",
+	ExplorerURL:           "chains.explorer_url",
+	NativeTokenSymbol:     "chains.native_token_symbol",
+	BlockTimeSeconds:      "chains.block_time_seconds",
+	ConfirmationBlocks:    "chains.confirmation_blocks",
+	FinalizedBlocks:       "chains.finalized_blocks",
+	IsActive:              "chains.is_active",
+	CreatedAt:             "chains.created_at",
+	UpdatedAt:             "chains.updated_at",
+	ScanIntervalMs:        "chains.scan_interval_ms",
+	BlockBatchSize:        "chains.block_batch_size",
+	MaxReceiptConcurrency: "chains.max_receipt_concurrency",
+	NativeTokenDecimals:   "chains.native_token_decimals",
+	ExplorerURLTemplate:   "chains.explorer_url_template",
 }
 
 // Generated where
@@ -152,33 +177,43 @@ func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field
 func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
 var ChainWhere = struct {
-	ID                 whereHelperint
-	ChainID            whereHelperint
-	ChainName          whereHelperstring
-	ChainType          whereHelperstring
-	RPCURL             whereHelperstring
-	ExplorerURL        whereHelpernull_String
-	NativeTokenSymbol  whereHelperstring
-	BlockTimeSeconds   whereHelpernull_Int
-	ConfirmationBlocks whereHelpernull_Int
-	FinalizedBlocks    whereHelpernull_Int
-	IsActive           whereHelperbool
-	CreatedAt          whereHelpertime_Time
-	UpdatedAt          whereHelpertime_Time
+	ID                    whereHelperint
+	ChainID               whereHelperint
+	ChainName             whereHelperstring
+	ChainType             whereHelperstring
+	RPCURL                whereHelperstring
+	ExplorerURL           whereHelpernull_String
+	NativeTokenSymbol     whereHelperstring
+	BlockTimeSeconds      whereHelpernull_Int
+	ConfirmationBlocks    whereHelpernull_Int
+	FinalizedBlocks       whereHelpernull_Int
+	IsActive              whereHelperbool
+	CreatedAt             whereHelpertime_Time
+	UpdatedAt             whereHelpertime_Time
+	ScanIntervalMs        whereHelpernull_Int
+	BlockBatchSize        whereHelpernull_Int
+	MaxReceiptConcurrency whereHelpernull_Int
+	NativeTokenDecimals   whereHelperint
+	ExplorerURLTemplate   whereHelpernull_String
 }{
-	ID:                 whereHelperint{field: "\"chains\".\"id\""},
-	ChainID:            whereHelperint{field: "\"chains\".\"chain_id\""},
-	ChainName:          whereHelperstring{field: "\"chains\".\"chain_name\""},
-	ChainType:          whereHelperstring{field: "\"chains\".\"chain_type\""},
-	RPCURL:             whereHelperstring{field: "\"chains\".\"rpc_url\""},
-	ExplorerURL:        whereHelpernull_String{field: "\"chains\".\"explorer_url\""},
-	NativeTokenSymbol:  whereHelperstring{field: "\"chains\".\"native_token_symbol\""},
-	BlockTimeSeconds:   whereHelpernull_Int{field: "\"chains\".\"block_time_seconds\""},
-	ConfirmationBlocks: whereHelpernull_Int{field: "\"chains\".\"confirmation_blocks\""},
-	FinalizedBlocks:    whereHelpernull_Int{field: "\"chains\".\"finalized_blocks\""},
-	IsActive:           whereHelperbool{field: "\"chains\".\"is_active\""},
-	CreatedAt:          whereHelpertime_Time{field: "\"chains\".\"created_at\""},
-	UpdatedAt:          whereHelpertime_Time{field: "\"chains\".\"updated_at\""},
+	ID:                    whereHelperint{field: "\"chains\".\"id\""},
+	ChainID:               whereHelperint{field: "\"chains\".\"chain_id\""},
+	ChainName:             whereHelperstring{field: "\"chains\".\"chain_name\""},
+	ChainType:             whereHelperstring{field: "\"chains\".\"chain_type\""},
+	RPCURL:                whereHelperstring{field: "\"chains\".\"rpc_url\""},
+	ExplorerURL:           whereHelpernull_String{field: "\"chains\".\"explorer_url\""},
+	NativeTokenSymbol:     whereHelperstring{field: "\"chains\".\"native_token_symbol\""},
+	BlockTimeSeconds:      whereHelpernull_Int{field: "\"chains\".\"block_time_seconds\""},
+	ConfirmationBlocks:    whereHelpernull_Int{field: "\"chains\".\"confirmation_blocks\""},
+	FinalizedBlocks:       whereHelpernull_Int{field: "\"chains\".\"finalized_blocks\""},
+	IsActive:              whereHelperbool{field: "\"chains\".\"is_active\""},
+	CreatedAt:             whereHelpertime_Time{field: "\"chains\".\"created_at\""},
+	UpdatedAt:             whereHelpertime_Time{field: "\"chains\".\"updated_at\""},
+	ScanIntervalMs:        whereHelpernull_Int{field: "\"chains\".\"scan_interval_ms\""},
+	BlockBatchSize:        whereHelpernull_Int{field: "\"chains\".\"block_batch_size\""},
+	MaxReceiptConcurrency: whereHelpernull_Int{field: "\"chains\".\"max_receipt_concurrency\""},
+	NativeTokenDecimals:   whereHelperint{field: "\"chains\".\"native_token_decimals\""},
+	ExplorerURLTemplate:   whereHelpernull_String{field: "\"chains\".\"explorer_url_template\""},
 }
 
 // ChainRels is where relationship names are stored.
@@ -198,9 +233,9 @@ func (*chainR) NewStruct() *chainR {
 type chainL struct{}
 
 var (
-	chainAllColumns            = []string{"id", "chain_id", "chain_name", "chain_type", "rpc_url", "explorer_url", "native_token_symbol", "block_time_seconds", "confirmation_blocks", "finalized_blocks", "is_active", "created_at", "updated_at"}
+	chainAllColumns            = []string{"id", "chain_id", "chain_name", "chain_type", "rpc_url", "explorer_url", "native_token_symbol", "block_time_seconds", "confirmation_blocks", "finalized_blocks", "is_active", "created_at", "updated_at", "scan_interval_ms", "block_batch_size", "max_receipt_concurrency", "native_token_decimals", "explorer_url_template"}
 	chainColumnsWithoutDefault = []string{"chain_id", "chain_name", "chain_type", "rpc_url", "native_token_symbol", "is_active"}
-	chainColumnsWithDefault    = []string{"id", "explorer_url", "block_time_seconds", "confirmation_blocks", "finalized_blocks", "created_at", "updated_at"}
+	chainColumnsWithDefault    = []string{"id", "explorer_url", "block_time_seconds", "confirmation_blocks", "finalized_blocks", "created_at", "updated_at", "scan_interval_ms", "block_batch_size", "max_receipt_concurrency", "native_token_decimals", "explorer_url_template"}
 	chainPrimaryKeyColumns     = []string{"id"}
 	chainGeneratedColumns      = []string{}
 )
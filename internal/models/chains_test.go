@@ -427,7 +427,7 @@ func testChainsSelect(t *testing.T) {
 }
 
 var (
-	chainDBTypes = map[string]string{`ID`: `integer`, `ChainID`: `integer`, `ChainName`: `character varying`, `ChainType`: `character varying`, `RPCURL`: `text`, `ExplorerURL`: `text`, `NativeTokenSymbol`: `character varying`, `BlockTimeSeconds`: `integer`, `ConfirmationBlocks`: `integer`, `FinalizedBlocks`: `integer`, `IsActive`: `boolean`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	chainDBTypes = map[string]string{`ID`: `integer`, `ChainID`: `integer`, `ChainName`: `character varying`, `ChainType`: `character varying`, `RPCURL`: `text`, `ExplorerURL`: `text`, `NativeTokenSymbol`: `character varying`, `BlockTimeSeconds`: `integer`, `ConfirmationBlocks`: `integer`, `FinalizedBlocks`: `integer`, `IsActive`: `boolean`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `ScanIntervalMs`: `integer`, `BlockBatchSize`: `integer`, `MaxReceiptConcurrency`: `integer`}
 	_            = bytes.MinRead
 )
 
@@ -24,25 +24,25 @@ import (
 
 // Credit is an object representing the database table.
 type Credit struct {
-	ID            string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserID        string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	Address       string      `boil:"address" json:"address" toml:"address" yaml:"address"`
-	TokenID       int         `boil:"token_id" json:"token_id" toml:"token_id" yaml:"token_id"`
-	TokenSymbol   string      `boil:"token_symbol" json:"token_symbol" toml:"token_symbol" yaml:"token_symbol"`
-	Amount        string      `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
-	CreditType    string      `boil:"credit_type" json:"credit_type" toml:"credit_type" yaml:"credit_type"`
-	BusinessType  string      `boil:"business_type" json:"business_type" toml:"business_type" yaml:"business_type"`
-	ReferenceID   string      `boil:"reference_id" json:"reference_id" toml:"reference_id" yaml:"reference_id"`
-	ReferenceType string      `boil:"reference_type" json:"reference_type" toml:"reference_type" yaml:"reference_type"`
-	ChainID       null.Int    `boil:"chain_id" json:"chain_id,omitempty" toml:"chain_id" yaml:"chain_id,omitempty"`
-	ChainType     null.String `boil:"chain_type" json:"chain_type,omitempty" toml:"chain_type" yaml:"chain_type,omitempty"`
-	Status        string      `boil:"status" json:"status" toml:"status" yaml:"status"`
-	BlockNumber   null.Int64  `boil:"block_number" json:"block_number,omitempty" toml:"block_number" yaml:"block_number,omitempty"`
-	TXHash        null.String `boil:"tx_hash" json:"tx_hash,omitempty" toml:"tx_hash" yaml:"tx_hash,omitempty"`
-	EventIndex    null.Int    `boil:"event_index" json:"event_index,omitempty" toml:"event_index" yaml:"event_index,omitempty"`
-	Metadata      null.JSON   `boil:"metadata" json:"metadata,omitempty" toml:"metadata" yaml:"metadata,omitempty"`
-	CreatedAt     time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt     time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID            string        `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID        string        `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Address       string        `boil:"address" json:"address" toml:"address" yaml:"address"`
+	TokenID       int           `boil:"token_id" json:"token_id" toml:"token_id" yaml:"token_id"`
+	TokenSymbol   string        `boil:"token_symbol" json:"token_symbol" toml:"token_symbol" yaml:"token_symbol"`
+	Amount        string        `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	CreditType    CreditType    `boil:"credit_type" json:"credit_type" toml:"credit_type" yaml:"credit_type"`
+	BusinessType  BusinessType  `boil:"business_type" json:"business_type" toml:"business_type" yaml:"business_type"`
+	ReferenceID   string        `boil:"reference_id" json:"reference_id" toml:"reference_id" yaml:"reference_id"`
+	ReferenceType ReferenceType `boil:"reference_type" json:"reference_type" toml:"reference_type" yaml:"reference_type"`
+	ChainID       null.Int      `boil:"chain_id" json:"chain_id,omitempty" toml:"chain_id" yaml:"chain_id,omitempty"`
+	ChainType     null.String   `boil:"chain_type" json:"chain_type,omitempty" toml:"chain_type" yaml:"chain_type,omitempty"`
+	Status        CreditStatus  `boil:"status" json:"status" toml:"status" yaml:"status"`
+	BlockNumber   null.Int64    `boil:"block_number" json:"block_number,omitempty" toml:"block_number" yaml:"block_number,omitempty"`
+	TXHash        null.String   `boil:"tx_hash" json:"tx_hash,omitempty" toml:"tx_hash" yaml:"tx_hash,omitempty"`
+	EventIndex    null.Int      `boil:"event_index" json:"event_index,omitempty" toml:"event_index" yaml:"event_index,omitempty"`
+	Metadata      null.JSON     `boil:"metadata" json:"metadata,omitempty" toml:"metadata" yaml:"metadata,omitempty"`
+	CreatedAt     time.Time     `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time     `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 
 	R *creditR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L creditL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -134,6 +134,146 @@ var CreditTableColumns = struct {
 
 // Generated where
 
+type whereHelperCreditType struct{ field string }
+
+func (w whereHelperCreditType) EQ(x CreditType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperCreditType) NEQ(x CreditType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperCreditType) LT(x CreditType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperCreditType) LTE(x CreditType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperCreditType) GT(x CreditType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperCreditType) GTE(x CreditType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperCreditType) IN(slice []CreditType) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperCreditType) NIN(slice []CreditType) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperBusinessType struct{ field string }
+
+func (w whereHelperBusinessType) EQ(x BusinessType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperBusinessType) NEQ(x BusinessType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperBusinessType) LT(x BusinessType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperBusinessType) LTE(x BusinessType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperBusinessType) GT(x BusinessType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperBusinessType) GTE(x BusinessType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperBusinessType) IN(slice []BusinessType) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperBusinessType) NIN(slice []BusinessType) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperReferenceType struct{ field string }
+
+func (w whereHelperReferenceType) EQ(x ReferenceType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperReferenceType) NEQ(x ReferenceType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperReferenceType) LT(x ReferenceType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperReferenceType) LTE(x ReferenceType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperReferenceType) GT(x ReferenceType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperReferenceType) GTE(x ReferenceType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperReferenceType) IN(slice []ReferenceType) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperReferenceType) NIN(slice []ReferenceType) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperCreditStatus struct{ field string }
+
+func (w whereHelperCreditStatus) EQ(x CreditStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperCreditStatus) NEQ(x CreditStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperCreditStatus) LT(x CreditStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperCreditStatus) LTE(x CreditStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperCreditStatus) GT(x CreditStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperCreditStatus) GTE(x CreditStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperCreditStatus) IN(slice []CreditStatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperCreditStatus) NIN(slice []CreditStatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 type whereHelpernull_Int64 struct{ field string }
 
 func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
@@ -203,13 +343,13 @@ var CreditWhere = struct {
 	TokenID       whereHelperint
 	TokenSymbol   whereHelperstring
 	Amount        whereHelperstring
-	CreditType    whereHelperstring
-	BusinessType  whereHelperstring
+	CreditType    whereHelperCreditType
+	BusinessType  whereHelperBusinessType
 	ReferenceID   whereHelperstring
-	ReferenceType whereHelperstring
+	ReferenceType whereHelperReferenceType
 	ChainID       whereHelpernull_Int
 	ChainType     whereHelpernull_String
-	Status        whereHelperstring
+	Status        whereHelperCreditStatus
 	BlockNumber   whereHelpernull_Int64
 	TXHash        whereHelpernull_String
 	EventIndex    whereHelpernull_Int
@@ -223,13 +363,13 @@ var CreditWhere = struct {
 	TokenID:       whereHelperint{field: "\"credits\".\"token_id\""},
 	TokenSymbol:   whereHelperstring{field: "\"credits\".\"token_symbol\""},
 	Amount:        whereHelperstring{field: "\"credits\".\"amount\""},
-	CreditType:    whereHelperstring{field: "\"credits\".\"credit_type\""},
-	BusinessType:  whereHelperstring{field: "\"credits\".\"business_type\""},
+	CreditType:    whereHelperCreditType{field: "\"credits\".\"credit_type\""},
+	BusinessType:  whereHelperBusinessType{field: "\"credits\".\"business_type\""},
 	ReferenceID:   whereHelperstring{field: "\"credits\".\"reference_id\""},
-	ReferenceType: whereHelperstring{field: "\"credits\".\"reference_type\""},
+	ReferenceType: whereHelperReferenceType{field: "\"credits\".\"reference_type\""},
 	ChainID:       whereHelpernull_Int{field: "\"credits\".\"chain_id\""},
 	ChainType:     whereHelpernull_String{field: "\"credits\".\"chain_type\""},
-	Status:        whereHelperstring{field: "\"credits\".\"status\""},
+	Status:        whereHelperCreditStatus{field: "\"credits\".\"status\""},
 	BlockNumber:   whereHelpernull_Int64{field: "\"credits\".\"block_number\""},
 	TXHash:        whereHelpernull_String{field: "\"credits\".\"tx_hash\""},
 	EventIndex:    whereHelpernull_Int{field: "\"credits\".\"event_index\""},
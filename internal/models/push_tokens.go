@@ -23,12 +23,12 @@ import (
 
 // PushToken is an object representing the database table.
 type PushToken struct {
-	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Token     string    `boil:"token" json:"token" toml:"token" yaml:"token"`
-	Provider  string    `boil:"provider" json:"provider" toml:"provider" yaml:"provider"`
-	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID        string       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Token     string       `boil:"token" json:"token" toml:"token" yaml:"token"`
+	Provider  ProviderType `boil:"provider" json:"provider" toml:"provider" yaml:"provider"`
+	UserID    string       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	CreatedAt time.Time    `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time    `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 
 	R *pushTokenR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L pushTokenL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -68,17 +68,52 @@ var PushTokenTableColumns = struct {
 
 // Generated where
 
+type whereHelperProviderType struct{ field string }
+
+func (w whereHelperProviderType) EQ(x ProviderType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperProviderType) NEQ(x ProviderType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperProviderType) LT(x ProviderType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperProviderType) LTE(x ProviderType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperProviderType) GT(x ProviderType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperProviderType) GTE(x ProviderType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperProviderType) IN(slice []ProviderType) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperProviderType) NIN(slice []ProviderType) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var PushTokenWhere = struct {
 	ID        whereHelperstring
 	Token     whereHelperstring
-	Provider  whereHelperstring
+	Provider  whereHelperProviderType
 	UserID    whereHelperstring
 	CreatedAt whereHelpertime_Time
 	UpdatedAt whereHelpertime_Time
 }{
 	ID:        whereHelperstring{field: "\"push_tokens\".\"id\""},
 	Token:     whereHelperstring{field: "\"push_tokens\".\"token\""},
-	Provider:  whereHelperstring{field: "\"push_tokens\".\"provider\""},
+	Provider:  whereHelperProviderType{field: "\"push_tokens\".\"provider\""},
 	UserID:    whereHelperstring{field: "\"push_tokens\".\"user_id\""},
 	CreatedAt: whereHelpertime_Time{field: "\"push_tokens\".\"created_at\""},
 	UpdatedAt: whereHelpertime_Time{field: "\"push_tokens\".\"updated_at\""},
@@ -38,6 +38,12 @@ type Token struct {
 	IsActive          bool        `boil:"is_active" json:"is_active" toml:"is_active" yaml:"is_active"`
 	CreatedAt         time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt         time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	MinTransferAmount null.String `boil:"min_transfer_amount" json:"min_transfer_amount,omitempty" toml:"min_transfer_amount" yaml:"min_transfer_amount,omitempty"`
+	IsWrappedNative   bool        `boil:"is_wrapped_native" json:"is_wrapped_native" toml:"is_wrapped_native" yaml:"is_wrapped_native"`
+	CreditAsNative    bool        `boil:"credit_as_native" json:"credit_as_native" toml:"credit_as_native" yaml:"credit_as_native"`
+	MinDepositAmount  null.String `boil:"min_deposit_amount" json:"min_deposit_amount,omitempty" toml:"min_deposit_amount" yaml:"min_deposit_amount,omitempty"`
+	FeeOnTransfer     bool        `boil:"fee_on_transfer" json:"fee_on_transfer" toml:"fee_on_transfer" yaml:"fee_on_transfer"`
+	SupportsPermit    bool        `boil:"supports_permit" json:"supports_permit" toml:"supports_permit" yaml:"supports_permit"`
 
 	R *tokenR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L tokenL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -58,6 +64,12 @@ var TokenColumns = struct {
 	IsActive          string
 	CreatedAt         string
 	UpdatedAt         string
+	MinTransferAmount string
+	IsWrappedNative   string
+	CreditAsNative    string
+	MinDepositAmount  string
+	FeeOnTransfer     string
+	SupportsPermit    string
 }{
 	ID:                "id",
 	ChainType:         "chain_type",
@@ -73,6 +85,12 @@ var TokenColumns = struct {
 	IsActive:          "is_active",
 	CreatedAt:         "created_at",
 	UpdatedAt:         "updated_at",
+	MinTransferAmount: "min_transfer_amount",
+	IsWrappedNative:   "is_wrapped_native",
+	CreditAsNative:    "credit_as_native",
+	MinDepositAmount:  "min_deposit_amount",
+	FeeOnTransfer:     "fee_on_transfer",
+	SupportsPermit:    "supports_permit",
 }
 
 var TokenTableColumns = struct {
@@ -90,6 +108,12 @@ var TokenTableColumns = struct {
 	IsActive          string
 	CreatedAt         string
 	UpdatedAt         string
+	MinTransferAmount string
+	IsWrappedNative   string
+	CreditAsNative    string
+	MinDepositAmount  string
+	FeeOnTransfer     string
+	SupportsPermit    string
 }{
 	ID:                "tokens.id",
 	ChainType:         "tokens.chain_type",
@@ -105,6 +129,12 @@ var TokenTableColumns = struct {
 	IsActive:          "tokens.is_active",
 	CreatedAt:         "tokens.created_at",
 	UpdatedAt:         "tokens.updated_at",
+	MinTransferAmount: "tokens.min_transfer_amount",
+	IsWrappedNative:   "tokens.is_wrapped_native",
+	CreditAsNative:    "tokens.credit_as_native",
+	MinDepositAmount:  "tokens.min_deposit_amount",
+	FeeOnTransfer:     "tokens.fee_on_transfer",
+	SupportsPermit:    "tokens.supports_permit",
 }
 
 // Generated where
@@ -124,6 +154,12 @@ var TokenWhere = struct {
 	IsActive          whereHelperbool
 	CreatedAt         whereHelpertime_Time
 	UpdatedAt         whereHelpertime_Time
+	MinTransferAmount whereHelpernull_String
+	IsWrappedNative   whereHelperbool
+	CreditAsNative    whereHelperbool
+	MinDepositAmount  whereHelpernull_String
+	FeeOnTransfer     whereHelperbool
+	SupportsPermit    whereHelperbool
 }{
 	ID:                whereHelperint{field: "\"tokens\".\"id\""},
 	ChainType:         whereHelperstring{field: "\"tokens\".\"chain_type\""},
@@ -139,6 +175,12 @@ var TokenWhere = struct {
 	IsActive:          whereHelperbool{field: "\"tokens\".\"is_active\""},
 	CreatedAt:         whereHelpertime_Time{field: "\"tokens\".\"created_at\""},
 	UpdatedAt:         whereHelpertime_Time{field: "\"tokens\".\"updated_at\""},
+	MinTransferAmount: whereHelpernull_String{field: "\"tokens\".\"min_transfer_amount\""},
+	IsWrappedNative:   whereHelperbool{field: "\"tokens\".\"is_wrapped_native\""},
+	CreditAsNative:    whereHelperbool{field: "\"tokens\".\"credit_as_native\""},
+	MinDepositAmount:  whereHelpernull_String{field: "\"tokens\".\"min_deposit_amount\""},
+	FeeOnTransfer:     whereHelperbool{field: "\"tokens\".\"fee_on_transfer\""},
+	SupportsPermit:    whereHelperbool{field: "\"tokens\".\"supports_permit\""},
 }
 
 // TokenRels is where relationship names are stored.
@@ -197,9 +239,9 @@ func (r *tokenR) GetWithdraws() WithdrawSlice {
 type tokenL struct{}
 
 var (
-	tokenAllColumns            = []string{"id", "chain_type", "chain_id", "token_address", "token_symbol", "token_name", "decimals", "is_native", "token_type", "withdraw_fee", "min_withdraw_amount", "is_active", "created_at", "updated_at"}
+	tokenAllColumns            = []string{"id", "chain_type", "chain_id", "token_address", "token_symbol", "token_name", "decimals", "is_native", "token_type", "withdraw_fee", "min_withdraw_amount", "is_active", "created_at", "updated_at", "min_transfer_amount", "is_wrapped_native", "credit_as_native", "min_deposit_amount", "fee_on_transfer", "supports_permit"}
 	tokenColumnsWithoutDefault = []string{"chain_type", "chain_id", "token_symbol", "decimals", "is_native", "is_active"}
-	tokenColumnsWithDefault    = []string{"id", "token_address", "token_name", "token_type", "withdraw_fee", "min_withdraw_amount", "created_at", "updated_at"}
+	tokenColumnsWithDefault    = []string{"id", "token_address", "token_name", "token_type", "withdraw_fee", "min_withdraw_amount", "created_at", "updated_at", "min_transfer_amount", "is_wrapped_native", "credit_as_native", "min_deposit_amount", "fee_on_transfer", "supports_permit"}
 	tokenPrimaryKeyColumns     = []string{"id"}
 	tokenGeneratedColumns      = []string{}
 )
@@ -24,20 +24,21 @@ import (
 
 // Transaction is an object representing the database table.
 type Transaction struct {
-	ID                string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	ChainID           int         `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
-	BlockHash         string      `boil:"block_hash" json:"block_hash" toml:"block_hash" yaml:"block_hash"`
-	BlockNo           int64       `boil:"block_no" json:"block_no" toml:"block_no" yaml:"block_no"`
-	TXHash            string      `boil:"tx_hash" json:"tx_hash" toml:"tx_hash" yaml:"tx_hash"`
-	FromAddr          string      `boil:"from_addr" json:"from_addr" toml:"from_addr" yaml:"from_addr"`
-	ToAddr            string      `boil:"to_addr" json:"to_addr" toml:"to_addr" yaml:"to_addr"`
-	TokenAddr         null.String `boil:"token_addr" json:"token_addr,omitempty" toml:"token_addr" yaml:"token_addr,omitempty"`
-	Amount            string      `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
-	Type              string      `boil:"type" json:"type" toml:"type" yaml:"type"`
-	Status            string      `boil:"status" json:"status" toml:"status" yaml:"status"`
-	ConfirmationCount null.Int    `boil:"confirmation_count" json:"confirmation_count,omitempty" toml:"confirmation_count" yaml:"confirmation_count,omitempty"`
-	CreatedAt         time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt         time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID                string            `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ChainID           int               `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
+	BlockHash         string            `boil:"block_hash" json:"block_hash" toml:"block_hash" yaml:"block_hash"`
+	BlockNo           int64             `boil:"block_no" json:"block_no" toml:"block_no" yaml:"block_no"`
+	TXHash            string            `boil:"tx_hash" json:"tx_hash" toml:"tx_hash" yaml:"tx_hash"`
+	FromAddr          string            `boil:"from_addr" json:"from_addr" toml:"from_addr" yaml:"from_addr"`
+	ToAddr            string            `boil:"to_addr" json:"to_addr" toml:"to_addr" yaml:"to_addr"`
+	TokenAddr         null.String       `boil:"token_addr" json:"token_addr,omitempty" toml:"token_addr" yaml:"token_addr,omitempty"`
+	Amount            string            `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Type              TransactionType   `boil:"type" json:"type" toml:"type" yaml:"type"`
+	Status            TransactionStatus `boil:"status" json:"status" toml:"status" yaml:"status"`
+	ConfirmationCount null.Int          `boil:"confirmation_count" json:"confirmation_count,omitempty" toml:"confirmation_count" yaml:"confirmation_count,omitempty"`
+	CreatedAt         time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt         time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	EventIndex        null.Int          `boil:"event_index" json:"event_index,omitempty" toml:"event_index" yaml:"event_index,omitempty"`
 
 	R *transactionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L transactionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -58,6 +59,7 @@ var TransactionColumns = struct {
 	ConfirmationCount string
 	CreatedAt         string
 	UpdatedAt         string
+	EventIndex        string
 }{
 	ID:                "id",
 	ChainID:           "chain_id",
@@ -73,6 +75,7 @@ var TransactionColumns = struct {
 	ConfirmationCount: "confirmation_count",
 	CreatedAt:         "created_at",
 	UpdatedAt:         "updated_at",
+	EventIndex:        "event_index",
 }
 
 var TransactionTableColumns = struct {
@@ -90,6 +93,7 @@ var TransactionTableColumns = struct {
 	ConfirmationCount string
 	CreatedAt         string
 	UpdatedAt         string
+	EventIndex        string
 }{
 	ID:                "transactions.id",
 	ChainID:           "transactions.chain_id",
@@ -105,10 +109,81 @@ var TransactionTableColumns = struct {
 	ConfirmationCount: "transactions.confirmation_count",
 	CreatedAt:         "transactions.created_at",
 	UpdatedAt:         "transactions.updated_at",
+	EventIndex:        "transactions.event_index",
 }
 
 // Generated where
 
+type whereHelperTransactionType struct{ field string }
+
+func (w whereHelperTransactionType) EQ(x TransactionType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperTransactionType) NEQ(x TransactionType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperTransactionType) LT(x TransactionType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperTransactionType) LTE(x TransactionType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperTransactionType) GT(x TransactionType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperTransactionType) GTE(x TransactionType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperTransactionType) IN(slice []TransactionType) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperTransactionType) NIN(slice []TransactionType) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperTransactionStatus struct{ field string }
+
+func (w whereHelperTransactionStatus) EQ(x TransactionStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperTransactionStatus) NEQ(x TransactionStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperTransactionStatus) LT(x TransactionStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperTransactionStatus) LTE(x TransactionStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperTransactionStatus) GT(x TransactionStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperTransactionStatus) GTE(x TransactionStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperTransactionStatus) IN(slice []TransactionStatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperTransactionStatus) NIN(slice []TransactionStatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var TransactionWhere = struct {
 	ID                whereHelperstring
 	ChainID           whereHelperint
@@ -119,11 +194,12 @@ var TransactionWhere = struct {
 	ToAddr            whereHelperstring
 	TokenAddr         whereHelpernull_String
 	Amount            whereHelperstring
-	Type              whereHelperstring
-	Status            whereHelperstring
+	Type              whereHelperTransactionType
+	Status            whereHelperTransactionStatus
 	ConfirmationCount whereHelpernull_Int
 	CreatedAt         whereHelpertime_Time
 	UpdatedAt         whereHelpertime_Time
+	EventIndex        whereHelpernull_Int
 }{
 	ID:                whereHelperstring{field: "\"transactions\".\"id\""},
 	ChainID:           whereHelperint{field: "\"transactions\".\"chain_id\""},
@@ -134,11 +210,12 @@ var TransactionWhere = struct {
 	ToAddr:            whereHelperstring{field: "\"transactions\".\"to_addr\""},
 	TokenAddr:         whereHelpernull_String{field: "\"transactions\".\"token_addr\""},
 	Amount:            whereHelperstring{field: "\"transactions\".\"amount\""},
-	Type:              whereHelperstring{field: "\"transactions\".\"type\""},
-	Status:            whereHelperstring{field: "\"transactions\".\"status\""},
+	Type:              whereHelperTransactionType{field: "\"transactions\".\"type\""},
+	Status:            whereHelperTransactionStatus{field: "\"transactions\".\"status\""},
 	ConfirmationCount: whereHelpernull_Int{field: "\"transactions\".\"confirmation_count\""},
 	CreatedAt:         whereHelpertime_Time{field: "\"transactions\".\"created_at\""},
 	UpdatedAt:         whereHelpertime_Time{field: "\"transactions\".\"updated_at\""},
+	EventIndex:        whereHelpernull_Int{field: "\"transactions\".\"event_index\""},
 }
 
 // TransactionRels is where relationship names are stored.
@@ -158,9 +235,9 @@ func (*transactionR) NewStruct() *transactionR {
 type transactionL struct{}
 
 var (
-	transactionAllColumns            = []string{"id", "chain_id", "block_hash", "block_no", "tx_hash", "from_addr", "to_addr", "token_addr", "amount", "type", "status", "confirmation_count", "created_at", "updated_at"}
+	transactionAllColumns            = []string{"id", "chain_id", "block_hash", "block_no", "tx_hash", "from_addr", "to_addr", "token_addr", "amount", "type", "status", "confirmation_count", "created_at", "updated_at", "event_index"}
 	transactionColumnsWithoutDefault = []string{"chain_id", "block_hash", "block_no", "tx_hash", "from_addr", "to_addr", "amount", "type", "status"}
-	transactionColumnsWithDefault    = []string{"id", "token_addr", "confirmation_count", "created_at", "updated_at"}
+	transactionColumnsWithDefault    = []string{"id", "token_addr", "confirmation_count", "created_at", "updated_at", "event_index"}
 	transactionPrimaryKeyColumns     = []string{"id"}
 	transactionGeneratedColumns      = []string{}
 )
@@ -24,100 +24,156 @@ import (
 
 // Wallet is an object representing the database table.
 type Wallet struct {
-	ID             string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserID         string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	Address        string      `boil:"address" json:"address" toml:"address" yaml:"address"`
-	ChainType      string      `boil:"chain_type" json:"chain_type" toml:"chain_type" yaml:"chain_type"`
-	ChainID        int         `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
-	DerivationPath string      `boil:"derivation_path" json:"derivation_path" toml:"derivation_path" yaml:"derivation_path"`
-	AddressIndex   int         `boil:"address_index" json:"address_index" toml:"address_index" yaml:"address_index"`
-	WalletType     string      `boil:"wallet_type" json:"wallet_type" toml:"wallet_type" yaml:"wallet_type"`
-	DeviceName     null.String `boil:"device_name" json:"device_name,omitempty" toml:"device_name" yaml:"device_name,omitempty"`
-	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt      time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID                  string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID              string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Address             string      `boil:"address" json:"address" toml:"address" yaml:"address"`
+	ChainType           string      `boil:"chain_type" json:"chain_type" toml:"chain_type" yaml:"chain_type"`
+	ChainID             int         `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
+	DerivationPath      string      `boil:"derivation_path" json:"derivation_path" toml:"derivation_path" yaml:"derivation_path"`
+	AddressIndex        int         `boil:"address_index" json:"address_index" toml:"address_index" yaml:"address_index"`
+	WalletType          WalletType  `boil:"wallet_type" json:"wallet_type" toml:"wallet_type" yaml:"wallet_type"`
+	DeviceName          null.String `boil:"device_name" json:"device_name,omitempty" toml:"device_name" yaml:"device_name,omitempty"`
+	CreatedAt           time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt           time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	SmartAccountOwner   null.String `boil:"smart_account_owner" json:"smart_account_owner,omitempty" toml:"smart_account_owner" yaml:"smart_account_owner,omitempty"`
+	SmartAccountFactory null.String `boil:"smart_account_factory" json:"smart_account_factory,omitempty" toml:"smart_account_factory" yaml:"smart_account_factory,omitempty"`
+	OrgID               null.String `boil:"org_id" json:"org_id,omitempty" toml:"org_id" yaml:"org_id,omitempty"`
 
 	R *walletR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L walletL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var WalletColumns = struct {
-	ID             string
-	UserID         string
-	Address        string
-	ChainType      string
-	ChainID        string
-	DerivationPath string
-	AddressIndex   string
-	WalletType     string
-	DeviceName     string
-	CreatedAt      string
-	UpdatedAt      string
+	ID                  string
+	UserID              string
+	Address             string
+	ChainType           string
+	ChainID             string
+	DerivationPath      string
+	AddressIndex        string
+	WalletType          string
+	DeviceName          string
+	CreatedAt           string
+	UpdatedAt           string
+	SmartAccountOwner   string
+	SmartAccountFactory string
+	OrgID               string
 }{
-	ID:             "id",
-	UserID:         "user_id",
-	Address:        "address",
-	ChainType:      "chain_type",
-	ChainID:        "chain_id",
-	DerivationPath: "derivation_path",
-	AddressIndex:   "address_index",
-	WalletType:     "wallet_type",
-	DeviceName:     "device_name",
-	CreatedAt:      "created_at",
-	UpdatedAt:      "updated_at",
+	ID:                  "id",
+	UserID:              "user_id",
+	Address:             "address",
+	ChainType:           "chain_type",
+	ChainID:             "chain_id",
+	DerivationPath:      "derivation_path",
+	AddressIndex:        "address_index",
+	WalletType:          "wallet_type",
+	DeviceName:          "device_name",
+	CreatedAt:           "created_at",
+	UpdatedAt:           "updated_at",
+	SmartAccountOwner:   "smart_account_owner",
+	SmartAccountFactory: "smart_account_factory",
+	OrgID:               "org_id",
 }
 
 var WalletTableColumns = struct {
-	ID             string
-	UserID         string
-	Address        string
-	ChainType      string
-	ChainID        string
-	DerivationPath string
-	AddressIndex   string
-	WalletType     string
-	DeviceName     string
-	CreatedAt      string
-	UpdatedAt      string
+	ID                  string
+	UserID              string
+	Address             string
+	ChainType           string
+	ChainID             string
+	DerivationPath      string
+	AddressIndex        string
+	WalletType          string
+	DeviceName          string
+	CreatedAt           string
+	UpdatedAt           string
+	SmartAccountOwner   string
+	SmartAccountFactory string
+	OrgID               string
 }{
-	ID:             "wallets.id",
-	UserID:         "wallets.user_id",
-	Address:        "wallets.address",
-	ChainType:      "wallets.chain_type",
-	ChainID:        "wallets.chain_id",
-	DerivationPath: "wallets.derivation_path",
-	AddressIndex:   "wallets.address_index",
-	WalletType:     "wallets.wallet_type",
-	DeviceName:     "wallets.device_name",
-	CreatedAt:      "wallets.created_at",
-	UpdatedAt:      "wallets.updated_at",
+	ID:                  "wallets.id",
+	UserID:              "wallets.user_id",
+	Address:             "wallets.address",
+	ChainType:           "wallets.chain_type",
+	ChainID:             "wallets.chain_id",
+	DerivationPath:      "wallets.derivation_path",
+	AddressIndex:        "wallets.address_index",
+	WalletType:          "wallets.wallet_type",
+	DeviceName:          "wallets.device_name",
+	CreatedAt:           "wallets.created_at",
+	UpdatedAt:           "wallets.updated_at",
+	SmartAccountOwner:   "wallets.smart_account_owner",
+	SmartAccountFactory: "wallets.smart_account_factory",
+	OrgID:               "wallets.org_id",
 }
 
 // Generated where
 
+type whereHelperWalletType struct{ field string }
+
+func (w whereHelperWalletType) EQ(x WalletType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperWalletType) NEQ(x WalletType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperWalletType) LT(x WalletType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperWalletType) LTE(x WalletType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperWalletType) GT(x WalletType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperWalletType) GTE(x WalletType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperWalletType) IN(slice []WalletType) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperWalletType) NIN(slice []WalletType) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var WalletWhere = struct {
-	ID             whereHelperstring
-	UserID         whereHelperstring
-	Address        whereHelperstring
-	ChainType      whereHelperstring
-	ChainID        whereHelperint
-	DerivationPath whereHelperstring
-	AddressIndex   whereHelperint
-	WalletType     whereHelperstring
-	DeviceName     whereHelpernull_String
-	CreatedAt      whereHelpertime_Time
-	UpdatedAt      whereHelpertime_Time
+	ID                  whereHelperstring
+	UserID              whereHelperstring
+	Address             whereHelperstring
+	ChainType           whereHelperstring
+	ChainID             whereHelperint
+	DerivationPath      whereHelperstring
+	AddressIndex        whereHelperint
+	WalletType          whereHelperWalletType
+	DeviceName          whereHelpernull_String
+	CreatedAt           whereHelpertime_Time
+	UpdatedAt           whereHelpertime_Time
+	SmartAccountOwner   whereHelpernull_String
+	SmartAccountFactory whereHelpernull_String
+	OrgID               whereHelpernull_String
 }{
-	ID:             whereHelperstring{field: "\"wallets\".\"id\""},
-	UserID:         whereHelperstring{field: "\"wallets\".\"user_id\""},
-	Address:        whereHelperstring{field: "\"wallets\".\"address\""},
-	ChainType:      whereHelperstring{field: "\"wallets\".\"chain_type\""},
-	ChainID:        whereHelperint{field: "\"wallets\".\"chain_id\""},
-	DerivationPath: whereHelperstring{field: "\"wallets\".\"derivation_path\""},
-	AddressIndex:   whereHelperint{field: "\"wallets\".\"address_index\""},
-	WalletType:     whereHelperstring{field: "\"wallets\".\"wallet_type\""},
-	DeviceName:     whereHelpernull_String{field: "\"wallets\".\"device_name\""},
-	CreatedAt:      whereHelpertime_Time{field: "\"wallets\".\"created_at\""},
-	UpdatedAt:      whereHelpertime_Time{field: "\"wallets\".\"updated_at\""},
+	ID:                  whereHelperstring{field: "\"wallets\".\"id\""},
+	UserID:              whereHelperstring{field: "\"wallets\".\"user_id\""},
+	Address:             whereHelperstring{field: "\"wallets\".\"address\""},
+	ChainType:           whereHelperstring{field: "\"wallets\".\"chain_type\""},
+	ChainID:             whereHelperint{field: "\"wallets\".\"chain_id\""},
+	DerivationPath:      whereHelperstring{field: "\"wallets\".\"derivation_path\""},
+	AddressIndex:        whereHelperint{field: "\"wallets\".\"address_index\""},
+	WalletType:          whereHelperWalletType{field: "\"wallets\".\"wallet_type\""},
+	DeviceName:          whereHelpernull_String{field: "\"wallets\".\"device_name\""},
+	CreatedAt:           whereHelpertime_Time{field: "\"wallets\".\"created_at\""},
+	UpdatedAt:           whereHelpertime_Time{field: "\"wallets\".\"updated_at\""},
+	SmartAccountOwner:   whereHelpernull_String{field: "\"wallets\".\"smart_account_owner\""},
+	SmartAccountFactory: whereHelpernull_String{field: "\"wallets\".\"smart_account_factory\""},
+	OrgID:               whereHelpernull_String{field: "\"wallets\".\"org_id\""},
 }
 
 // WalletRels is where relationship names are stored.
@@ -157,9 +213,9 @@ func (r *walletR) GetUser() *User {
 type walletL struct{}
 
 var (
-	walletAllColumns            = []string{"id", "user_id", "address", "chain_type", "chain_id", "derivation_path", "address_index", "wallet_type", "device_name", "created_at", "updated_at"}
+	walletAllColumns            = []string{"id", "user_id", "address", "chain_type", "chain_id", "derivation_path", "address_index", "wallet_type", "device_name", "created_at", "updated_at", "smart_account_owner", "smart_account_factory", "org_id"}
 	walletColumnsWithoutDefault = []string{"user_id", "address", "chain_type", "chain_id", "derivation_path", "address_index", "wallet_type"}
-	walletColumnsWithDefault    = []string{"id", "device_name", "created_at", "updated_at"}
+	walletColumnsWithDefault    = []string{"id", "device_name", "created_at", "updated_at", "smart_account_owner", "smart_account_factory", "org_id"}
 	walletPrimaryKeyColumns     = []string{"id"}
 	walletGeneratedColumns      = []string{}
 )
@@ -537,7 +537,7 @@ func testWalletsSelect(t *testing.T) {
 }
 
 var (
-	walletDBTypes = map[string]string{`ID`: `uuid`, `UserID`: `uuid`, `Address`: `character varying`, `ChainType`: `character varying`, `ChainID`: `integer`, `DerivationPath`: `character varying`, `AddressIndex`: `integer`, `WalletType`: `character varying`, `DeviceName`: `character varying`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	walletDBTypes = map[string]string{`ID`: `uuid`, `UserID`: `uuid`, `Address`: `character varying`, `ChainType`: `character varying`, `ChainID`: `integer`, `DerivationPath`: `character varying`, `AddressIndex`: `integer`, `WalletType`: `enum.wallet_type('user','hot','cold','watch')`, `DeviceName`: `character varying`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
 	_             = bytes.MinRead
 )
 
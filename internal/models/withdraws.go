@@ -24,26 +24,29 @@ import (
 
 // Withdraw is an object representing the database table.
 type Withdraw struct {
-	ID                   string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserID               string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	ToAddress            string      `boil:"to_address" json:"to_address" toml:"to_address" yaml:"to_address"`
-	FromAddress          null.String `boil:"from_address" json:"from_address,omitempty" toml:"from_address" yaml:"from_address,omitempty"`
-	TokenID              int         `boil:"token_id" json:"token_id" toml:"token_id" yaml:"token_id"`
-	Amount               string      `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
-	Fee                  string      `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
-	ChainID              int         `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
-	ChainType            string      `boil:"chain_type" json:"chain_type" toml:"chain_type" yaml:"chain_type"`
-	TXHash               null.String `boil:"tx_hash" json:"tx_hash,omitempty" toml:"tx_hash" yaml:"tx_hash,omitempty"`
-	GasPrice             null.String `boil:"gas_price" json:"gas_price,omitempty" toml:"gas_price" yaml:"gas_price,omitempty"`
-	MaxFeePerGas         null.String `boil:"max_fee_per_gas" json:"max_fee_per_gas,omitempty" toml:"max_fee_per_gas" yaml:"max_fee_per_gas,omitempty"`
-	MaxPriorityFeePerGas null.String `boil:"max_priority_fee_per_gas" json:"max_priority_fee_per_gas,omitempty" toml:"max_priority_fee_per_gas" yaml:"max_priority_fee_per_gas,omitempty"`
-	GasUsed              null.String `boil:"gas_used" json:"gas_used,omitempty" toml:"gas_used" yaml:"gas_used,omitempty"`
-	Nonce                null.Int    `boil:"nonce" json:"nonce,omitempty" toml:"nonce" yaml:"nonce,omitempty"`
-	Status               string      `boil:"status" json:"status" toml:"status" yaml:"status"`
-	ErrorMessage         null.String `boil:"error_message" json:"error_message,omitempty" toml:"error_message" yaml:"error_message,omitempty"`
-	OperationID          null.String `boil:"operation_id" json:"operation_id,omitempty" toml:"operation_id" yaml:"operation_id,omitempty"`
-	CreatedAt            time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt            time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID                   string         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID               string         `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	ToAddress            string         `boil:"to_address" json:"to_address" toml:"to_address" yaml:"to_address"`
+	FromAddress          null.String    `boil:"from_address" json:"from_address,omitempty" toml:"from_address" yaml:"from_address,omitempty"`
+	TokenID              int            `boil:"token_id" json:"token_id" toml:"token_id" yaml:"token_id"`
+	Amount               string         `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Fee                  string         `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	ChainID              int            `boil:"chain_id" json:"chain_id" toml:"chain_id" yaml:"chain_id"`
+	ChainType            string         `boil:"chain_type" json:"chain_type" toml:"chain_type" yaml:"chain_type"`
+	TXHash               null.String    `boil:"tx_hash" json:"tx_hash,omitempty" toml:"tx_hash" yaml:"tx_hash,omitempty"`
+	GasPrice             null.String    `boil:"gas_price" json:"gas_price,omitempty" toml:"gas_price" yaml:"gas_price,omitempty"`
+	MaxFeePerGas         null.String    `boil:"max_fee_per_gas" json:"max_fee_per_gas,omitempty" toml:"max_fee_per_gas" yaml:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas null.String    `boil:"max_priority_fee_per_gas" json:"max_priority_fee_per_gas,omitempty" toml:"max_priority_fee_per_gas" yaml:"max_priority_fee_per_gas,omitempty"`
+	GasUsed              null.String    `boil:"gas_used" json:"gas_used,omitempty" toml:"gas_used" yaml:"gas_used,omitempty"`
+	Nonce                null.Int       `boil:"nonce" json:"nonce,omitempty" toml:"nonce" yaml:"nonce,omitempty"`
+	Status               WithdrawStatus `boil:"status" json:"status" toml:"status" yaml:"status"`
+	ErrorMessage         null.String    `boil:"error_message" json:"error_message,omitempty" toml:"error_message" yaml:"error_message,omitempty"`
+	OperationID          null.String    `boil:"operation_id" json:"operation_id,omitempty" toml:"operation_id" yaml:"operation_id,omitempty"`
+	GasDeferredUntil     null.Time      `boil:"gas_deferred_until" json:"gas_deferred_until,omitempty" toml:"gas_deferred_until" yaml:"gas_deferred_until,omitempty"`
+	GasDeferCount        int            `boil:"gas_defer_count" json:"gas_defer_count" toml:"gas_defer_count" yaml:"gas_defer_count"`
+	ExpiresAt            null.Time      `boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
+	CreatedAt            time.Time      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt            time.Time      `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 
 	R *withdrawR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L withdrawL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -68,6 +71,9 @@ var WithdrawColumns = struct {
 	Status               string
 	ErrorMessage         string
 	OperationID          string
+	GasDeferredUntil     string
+	GasDeferCount        string
+	ExpiresAt            string
 	CreatedAt            string
 	UpdatedAt            string
 }{
@@ -89,6 +95,9 @@ var WithdrawColumns = struct {
 	Status:               "status",
 	ErrorMessage:         "error_message",
 	OperationID:          "operation_id",
+	GasDeferredUntil:     "gas_deferred_until",
+	GasDeferCount:        "gas_defer_count",
+	ExpiresAt:            "expires_at",
 	CreatedAt:            "created_at",
 	UpdatedAt:            "updated_at",
 }
@@ -112,6 +121,9 @@ var WithdrawTableColumns = struct {
 	Status               string
 	ErrorMessage         string
 	OperationID          string
+	GasDeferredUntil     string
+	GasDeferCount        string
+	ExpiresAt            string
 	CreatedAt            string
 	UpdatedAt            string
 }{
@@ -133,12 +145,50 @@ var WithdrawTableColumns = struct {
 	Status:               "withdraws.status",
 	ErrorMessage:         "withdraws.error_message",
 	OperationID:          "withdraws.operation_id",
+	GasDeferredUntil:     "withdraws.gas_deferred_until",
+	GasDeferCount:        "withdraws.gas_defer_count",
+	ExpiresAt:            "withdraws.expires_at",
 	CreatedAt:            "withdraws.created_at",
 	UpdatedAt:            "withdraws.updated_at",
 }
 
 // Generated where
 
+type whereHelperWithdrawStatus struct{ field string }
+
+func (w whereHelperWithdrawStatus) EQ(x WithdrawStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperWithdrawStatus) NEQ(x WithdrawStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperWithdrawStatus) LT(x WithdrawStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperWithdrawStatus) LTE(x WithdrawStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperWithdrawStatus) GT(x WithdrawStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperWithdrawStatus) GTE(x WithdrawStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperWithdrawStatus) IN(slice []WithdrawStatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperWithdrawStatus) NIN(slice []WithdrawStatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var WithdrawWhere = struct {
 	ID                   whereHelperstring
 	UserID               whereHelperstring
@@ -155,9 +205,12 @@ var WithdrawWhere = struct {
 	MaxPriorityFeePerGas whereHelpernull_String
 	GasUsed              whereHelpernull_String
 	Nonce                whereHelpernull_Int
-	Status               whereHelperstring
+	Status               whereHelperWithdrawStatus
 	ErrorMessage         whereHelpernull_String
 	OperationID          whereHelpernull_String
+	GasDeferredUntil     whereHelpernull_Time
+	GasDeferCount        whereHelperint
+	ExpiresAt            whereHelpernull_Time
 	CreatedAt            whereHelpertime_Time
 	UpdatedAt            whereHelpertime_Time
 }{
@@ -176,9 +229,12 @@ var WithdrawWhere = struct {
 	MaxPriorityFeePerGas: whereHelpernull_String{field: "\"withdraws\".\"max_priority_fee_per_gas\""},
 	GasUsed:              whereHelpernull_String{field: "\"withdraws\".\"gas_used\""},
 	Nonce:                whereHelpernull_Int{field: "\"withdraws\".\"nonce\""},
-	Status:               whereHelperstring{field: "\"withdraws\".\"status\""},
+	Status:               whereHelperWithdrawStatus{field: "\"withdraws\".\"status\""},
 	ErrorMessage:         whereHelpernull_String{field: "\"withdraws\".\"error_message\""},
 	OperationID:          whereHelpernull_String{field: "\"withdraws\".\"operation_id\""},
+	GasDeferredUntil:     whereHelpernull_Time{field: "\"withdraws\".\"gas_deferred_until\""},
+	GasDeferCount:        whereHelperint{field: "\"withdraws\".\"gas_defer_count\""},
+	ExpiresAt:            whereHelpernull_Time{field: "\"withdraws\".\"expires_at\""},
 	CreatedAt:            whereHelpertime_Time{field: "\"withdraws\".\"created_at\""},
 	UpdatedAt:            whereHelpertime_Time{field: "\"withdraws\".\"updated_at\""},
 }
@@ -239,9 +295,9 @@ func (r *withdrawR) GetUser() *User {
 type withdrawL struct{}
 
 var (
-	withdrawAllColumns            = []string{"id", "user_id", "to_address", "from_address", "token_id", "amount", "fee", "chain_id", "chain_type", "tx_hash", "gas_price", "max_fee_per_gas", "max_priority_fee_per_gas", "gas_used", "nonce", "status", "error_message", "operation_id", "created_at", "updated_at"}
+	withdrawAllColumns            = []string{"id", "user_id", "to_address", "from_address", "token_id", "amount", "fee", "chain_id", "chain_type", "tx_hash", "gas_price", "max_fee_per_gas", "max_priority_fee_per_gas", "gas_used", "nonce", "status", "error_message", "operation_id", "gas_deferred_until", "gas_defer_count", "expires_at", "created_at", "updated_at"}
 	withdrawColumnsWithoutDefault = []string{"user_id", "to_address", "token_id", "amount", "fee", "chain_id", "chain_type", "status"}
-	withdrawColumnsWithDefault    = []string{"id", "from_address", "tx_hash", "gas_price", "max_fee_per_gas", "max_priority_fee_per_gas", "gas_used", "nonce", "error_message", "operation_id", "created_at", "updated_at"}
+	withdrawColumnsWithDefault    = []string{"id", "from_address", "tx_hash", "gas_price", "max_fee_per_gas", "max_priority_fee_per_gas", "gas_used", "nonce", "error_message", "operation_id", "gas_deferred_until", "gas_defer_count", "expires_at", "created_at", "updated_at"}
 	withdrawPrimaryKeyColumns     = []string{"id"}
 	withdrawGeneratedColumns      = []string{}
 )
@@ -0,0 +1,115 @@
+// Package money 提供代币金额的解析、单位换算和格式化
+// 人类可读金额和最小单位（wei、lamports、satoshi 等）之间的换算使用 big.Rat 精确计算，
+// 避免 big.Float 乘以 10^decimals 时的二进制舍入误差（如 0.1 换算后少 1 wei）
+package money
+
+import (
+	"math/big"
+	"strings"
+
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/pkg/errors"
+)
+
+// Precision big.Float 金额的精度位数（约 77 位十进制有效数字）
+const Precision = 256
+
+// decimalBase 十进制基数
+const decimalBase = 10
+
+// ParseFloat 解析十进制金额字符串（人类可读单位），用于余额等只做比较和加减的金额
+// 不接受 Inf 等非有限值
+func ParseFloat(amount string) (*big.Float, error) {
+	value, _, err := big.ParseFloat(amount, decimalBase, Precision, big.ToNearestEven)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse amount %q", amount)
+	}
+	if value.IsInf() {
+		return nil, errors.Errorf("failed to parse amount %q: not a finite number", amount)
+	}
+
+	return value, nil
+}
+
+// ParseDecimal 精确解析十进制金额字符串，不接受分数（如 1/3）和空字符串
+func ParseDecimal(amount string) (*big.Rat, error) {
+	amount = strings.TrimSpace(amount)
+	if amount == "" || strings.Contains(amount, "/") {
+		return nil, errors.Wrapf(walleterrors.ErrInvalidAmount, "failed to parse amount %q", amount)
+	}
+
+	value, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return nil, errors.Wrapf(walleterrors.ErrInvalidAmount, "failed to parse amount %q", amount)
+	}
+
+	return value, nil
+}
+
+// Scale 返回 10^decimals
+func Scale(decimals int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(decimalBase), big.NewInt(int64(decimals)), nil)
+}
+
+// ToSmallestUnit 将人类可读金额精确转换为最小单位
+// 金额无法解析、不是正数或小数位超过代币精度时返回 walleterrors.ErrInvalidAmount，不会截断
+func ToSmallestUnit(amount string, decimals int) (*big.Int, error) {
+	value, err := ParseDecimal(amount)
+	if err != nil {
+		return nil, err
+	}
+	if value.Sign() <= 0 {
+		return nil, errors.Wrapf(walleterrors.ErrInvalidAmount, "amount %q must be positive", amount)
+	}
+
+	value.Mul(value, new(big.Rat).SetInt(Scale(decimals)))
+	if !value.IsInt() {
+		return nil, errors.Wrapf(walleterrors.ErrInvalidAmount, "amount %q has more than %d decimals", amount, decimals)
+	}
+
+	return new(big.Int).Set(value.Num()), nil
+}
+
+// CeilSmallestUnit 将人类可读金额转换为最小单位，不足 1 个最小单位的部分向上取整（如手续费、最小金额）
+func CeilSmallestUnit(amount *big.Rat, decimals int) *big.Int {
+	scaled := new(big.Rat).Mul(amount, new(big.Rat).SetInt(Scale(decimals)))
+
+	units, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	if rem.Sign() > 0 {
+		units.Add(units, big.NewInt(1))
+	}
+
+	return units
+}
+
+// FromSmallestUnit 将最小单位金额精确转换为人类可读金额
+func FromSmallestUnit(units *big.Int, decimals int) *big.Rat {
+	return new(big.Rat).SetFrac(units, Scale(decimals))
+}
+
+// FloatFromSmallestUnit 将最小单位金额转换为 big.Float 人类可读金额
+func FloatFromSmallestUnit(units *big.Int, decimals int) *big.Float {
+	value := new(big.Float).SetPrec(Precision).SetInt(units)
+	return value.Quo(value, new(big.Float).SetPrec(Precision).SetInt(Scale(decimals)))
+}
+
+// Format 将人类可读金额格式化为十进制字符串，保留 decimals 位小数（四舍五入）并去掉末尾多余的 0
+func Format(amount *big.Rat, decimals int) string {
+	s := amount.FloatString(decimals)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+
+	return s
+}
+
+// FormatSmallestUnit 将最小单位金额字符串格式化为人类可读单位，金额无法解析时原样返回
+func FormatSmallestUnit(units string, decimals int) string {
+	value, ok := new(big.Int).SetString(units, decimalBase)
+	if !ok {
+		return units
+	}
+
+	return Format(FromSmallestUnit(value, decimals), decimals)
+}
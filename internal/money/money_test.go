@@ -0,0 +1,85 @@
+package money_test
+
+import (
+	"testing"
+
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToSmallestUnit(t *testing.T) {
+	amount, err := money.ToSmallestUnit("100.5", 6)
+	require.NoError(t, err)
+	assert.Equal(t, "100500000", amount.String())
+
+	amount, err = money.ToSmallestUnit("0.000000000000000001", 18)
+	require.NoError(t, err)
+	assert.Equal(t, "1", amount.String())
+
+	// 0.1 不能用二进制浮点数精确表示，换算后不能少 1 wei
+	amount, err = money.ToSmallestUnit("0.1", 18)
+	require.NoError(t, err)
+	assert.Equal(t, "100000000000000000", amount.String())
+
+	amount, err = money.ToSmallestUnit("123456789012345678901234567890.123456789012345678", 18)
+	require.NoError(t, err)
+	assert.Equal(t, "123456789012345678901234567890123456789012345678", amount.String())
+
+	for _, invalid := range []string{"", "abc", "0", "-1", "0.0000001", "1/3"} {
+		_, err := money.ToSmallestUnit(invalid, 6)
+		assert.ErrorIs(t, err, walleterrors.ErrInvalidAmount, invalid)
+	}
+}
+
+func TestCeilSmallestUnit(t *testing.T) {
+	tests := []struct {
+		amount   string
+		decimals int
+		want     string
+	}{
+		{"1", 6, "1000000"},
+		{"0.5", 18, "500000000000000000"},
+		{"0.0000015", 6, "2"},
+		{"1.5", 0, "2"},
+	}
+
+	for _, tt := range tests {
+		amount, err := money.ParseDecimal(tt.amount)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, money.CeilSmallestUnit(amount, tt.decimals).String(), tt.amount)
+	}
+}
+
+func TestFormatSmallestUnit(t *testing.T) {
+	tests := []struct {
+		amount   string
+		decimals int
+		want     string
+	}{
+		{"1500000000000000000", 18, "1.5"},
+		{"1000000", 6, "1"},
+		{"1", 6, "0.000001"},
+		{"0", 18, "0"},
+		{"123", 0, "123"},
+		{"not-a-number", 18, "not-a-number"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, money.FormatSmallestUnit(tt.amount, tt.decimals), tt.amount)
+	}
+}
+
+func TestParseFloat(t *testing.T) {
+	amount, err := money.ParseFloat("1.25")
+	require.NoError(t, err)
+	assert.Equal(t, "1.25", amount.Text('f', -1))
+	assert.Equal(t, uint(money.Precision), amount.Prec())
+
+	for _, invalid := range []string{"", "abc", "Inf", "-Inf"} {
+		_, err := money.ParseFloat(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
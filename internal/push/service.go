@@ -69,7 +69,7 @@ func (s *Service) SendToUser(ctx context.Context, user *dto.User, title string,
 	for providerType, provider := range s.provider {
 		// get all registered tokens for provider
 		pushTokens, err := models.PushTokens(
-			models.PushTokenWhere.Provider.EQ(string(providerType)),
+			models.PushTokenWhere.Provider.EQ(models.ProviderType(providerType)),
 			models.PushTokenWhere.UserID.EQ(user.ID),
 		).All(ctx, s.DB)
 		if err != nil {
@@ -42,6 +42,10 @@ type ChainItem struct {
 	// Example: 12
 	ConfirmationBlocks int64 `json:"confirmation_blocks,omitempty"`
 
+	// Block explorer URL template, {type} is replaced by tx or address and {value} by the transaction hash or address
+	// Example: https://etherscan.io/{type}/{value}
+	ExplorerURLTemplate string `json:"explorer_url_template,omitempty"`
+
 	// finalized blocks
 	// Example: 32
 	FinalizedBlocks int64 `json:"finalized_blocks,omitempty"`
@@ -56,10 +60,18 @@ type ChainItem struct {
 	// Required: true
 	IsActive *bool `json:"is_active"`
 
+	// Decimals of the native token
+	// Example: 18
+	// Required: true
+	NativeTokenDecimals *int64 `json:"native_token_decimals"`
+
 	// native token symbol
 	// Example: ETH
 	// Required: true
 	NativeTokenSymbol *string `json:"native_token_symbol"`
+
+	// Wrapped native token of the chain (e.g. WBNB, WETH), omitted when not configured
+	WrappedNativeToken *WrappedNativeToken `json:"wrapped_native_token,omitempty"`
 }
 
 // Validate validates this chain item
@@ -86,10 +98,18 @@ func (m *ChainItem) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateNativeTokenDecimals(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateNativeTokenSymbol(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateWrappedNativeToken(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -141,6 +161,15 @@ func (m *ChainItem) validateIsActive(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ChainItem) validateNativeTokenDecimals(formats strfmt.Registry) error {
+
+	if err := validate.Required("native_token_decimals", "body", m.NativeTokenDecimals); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ChainItem) validateNativeTokenSymbol(formats strfmt.Registry) error {
 
 	if err := validate.Required("native_token_symbol", "body", m.NativeTokenSymbol); err != nil {
@@ -150,8 +179,57 @@ func (m *ChainItem) validateNativeTokenSymbol(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this chain item based on context it is used
+func (m *ChainItem) validateWrappedNativeToken(formats strfmt.Registry) error {
+	if swag.IsZero(m.WrappedNativeToken) { // not required
+		return nil
+	}
+
+	if m.WrappedNativeToken != nil {
+		if err := m.WrappedNativeToken.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("wrapped_native_token")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("wrapped_native_token")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this chain item based on the context it is used
 func (m *ChainItem) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateWrappedNativeToken(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ChainItem) contextValidateWrappedNativeToken(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.WrappedNativeToken != nil {
+
+		if swag.IsZero(m.WrappedNativeToken) { // not required
+			return nil
+		}
+
+		if err := m.WrappedNativeToken.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("wrapped_native_token")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("wrapped_native_token")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
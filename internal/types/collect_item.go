@@ -44,6 +44,9 @@ type CollectItem struct {
 	// Format: date-time
 	CreatedAt *strfmt.DateTime `json:"created_at"`
 
+	// Block explorer links, omitted when no explorer is configured for the chain
+	ExplorerLinks *ExplorerLinks `json:"explorer_links,omitempty"`
+
 	// from addr
 	// Example: 0x742d35Cc6634C0532925a3b8D4C9db96C4b4d8b6
 	// Required: true
@@ -91,6 +94,10 @@ func (m *CollectItem) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateExplorerLinks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateFromAddr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -157,6 +164,25 @@ func (m *CollectItem) validateCreatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *CollectItem) validateExplorerLinks(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExplorerLinks) { // not required
+		return nil
+	}
+
+	if m.ExplorerLinks != nil {
+		if err := m.ExplorerLinks.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("explorer_links")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("explorer_links")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *CollectItem) validateFromAddr(formats strfmt.Registry) error {
 
 	if err := validate.Required("from_addr", "body", m.FromAddr); err != nil {
@@ -248,6 +274,31 @@ func (m *CollectItem) validateTxHash(formats strfmt.Registry) error {
 
 // ContextValidate validates this collect item based on context it is used
 func (m *CollectItem) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateExplorerLinks(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CollectItem) contextValidateExplorerLinks(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ExplorerLinks != nil {
+		if err := m.ExplorerLinks.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("explorer_links")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("explorer_links")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -19,6 +19,9 @@ import (
 // swagger:model collectResponse
 type CollectResponse struct {
 
+	// Block explorer links, omitted when no explorer is configured for the chain
+	ExplorerLinks *ExplorerLinks `json:"explorer_links,omitempty"`
+
 	// message
 	// Example: Collection initiated successfully
 	// Required: true
@@ -39,6 +42,10 @@ type CollectResponse struct {
 func (m *CollectResponse) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateExplorerLinks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMessage(formats); err != nil {
 		res = append(res, err)
 	}
@@ -53,6 +60,25 @@ func (m *CollectResponse) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *CollectResponse) validateExplorerLinks(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExplorerLinks) { // not required
+		return nil
+	}
+
+	if m.ExplorerLinks != nil {
+		if err := m.ExplorerLinks.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("explorer_links")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("explorer_links")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *CollectResponse) validateMessage(formats strfmt.Registry) error {
 
 	if err := validate.Required("message", "body", m.Message); err != nil {
@@ -77,6 +103,31 @@ func (m *CollectResponse) validateWalletID(formats strfmt.Registry) error {
 
 // ContextValidate validates this collect response based on context it is used
 func (m *CollectResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateExplorerLinks(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CollectResponse) contextValidateExplorerLinks(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ExplorerLinks != nil {
+		if err := m.ExplorerLinks.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("explorer_links")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("explorer_links")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
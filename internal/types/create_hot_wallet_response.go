@@ -65,6 +65,10 @@ type CreateHotWalletResponse struct {
 	// Format: uuid
 	ID *strfmt.UUID `json:"id"`
 
+	// Organization the hot wallet belongs to, null if it is shared by all organizations
+	// Format: uuid
+	OrgID *strfmt.UUID `json:"org_id,omitempty"`
+
 	// wallet type
 	// Example: hot
 	// Required: true
@@ -107,6 +111,10 @@ func (m *CreateHotWalletResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateOrgID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateWalletType(formats); err != nil {
 		res = append(res, err)
 	}
@@ -197,6 +205,19 @@ func (m *CreateHotWalletResponse) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *CreateHotWalletResponse) validateOrgID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.OrgID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("org_id", "body", "uuid", m.OrgID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *CreateHotWalletResponse) validateWalletType(formats strfmt.Registry) error {
 
 	if err := validate.Required("wallet_type", "body", m.WalletType); err != nil {
@@ -45,6 +45,17 @@ type DepositItem struct {
 	// Format: date-time
 	CreatedAt *strfmt.DateTime `json:"created_at"`
 
+	// Estimated time the deposit becomes finalized and credited, only for deposits not yet finalized
+	// Format: date-time
+	EstimatedFinalizedAt *strfmt.DateTime `json:"estimated_finalized_at,omitempty"`
+
+	// Estimate is degraded because the chain is halted, the scanner lags behind the chain head or the RPC is unavailable
+	// Example: false
+	EtaDegraded bool `json:"eta_degraded,omitempty"`
+
+	// Block explorer links, omitted when no explorer is configured for the chain
+	ExplorerLinks *ExplorerLinks `json:"explorer_links,omitempty"`
+
 	// from addr
 	// Example: 0x742d35Cc6634C0532925a3b8D4C9db96C4b4d8b6
 	// Required: true
@@ -58,7 +69,7 @@ type DepositItem struct {
 	// status
 	// Example: finalized
 	// Required: true
-	// Enum: [confirmed safe finalized failed]
+	// Enum: [confirmed safe finalized failed dust]
 	Status *string `json:"status"`
 
 	// to addr
@@ -101,6 +112,14 @@ func (m *DepositItem) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateEstimatedFinalizedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExplorerLinks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateFromAddr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -171,6 +190,37 @@ func (m *DepositItem) validateCreatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *DepositItem) validateEstimatedFinalizedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.EstimatedFinalizedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("estimated_finalized_at", "body", "date-time", m.EstimatedFinalizedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DepositItem) validateExplorerLinks(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExplorerLinks) { // not required
+		return nil
+	}
+
+	if m.ExplorerLinks != nil {
+		if err := m.ExplorerLinks.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("explorer_links")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("explorer_links")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *DepositItem) validateFromAddr(formats strfmt.Registry) error {
 
 	if err := validate.Required("from_addr", "body", m.FromAddr); err != nil {
@@ -197,7 +247,7 @@ var depositItemTypeStatusPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["confirmed","safe","finalized","failed"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["confirmed","safe","finalized","failed","dust"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -218,6 +268,9 @@ const (
 
 	// DepositItemStatusFailed captures enum value "failed"
 	DepositItemStatusFailed string = "failed"
+
+	// DepositItemStatusDust captures enum value "dust"
+	DepositItemStatusDust string = "dust"
 )
 
 // prop value enum
@@ -271,6 +324,31 @@ func (m *DepositItem) validateTxHash(formats strfmt.Registry) error {
 
 // ContextValidate validates this deposit item based on context it is used
 func (m *DepositItem) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateExplorerLinks(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DepositItem) contextValidateExplorerLinks(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ExplorerLinks != nil {
+		if err := m.ExplorerLinks.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("explorer_links")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("explorer_links")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -19,6 +19,14 @@ import (
 // swagger:model pendingDepositItem
 type PendingDepositItem struct {
 
+	// Estimated time the last deposit of the group becomes finalized and credited
+	// Format: date-time
+	EstimatedFinalizedAt *strfmt.DateTime `json:"estimated_finalized_at,omitempty"`
+
+	// Estimate is degraded for at least one deposit of the group because the chain is halted, the scanner lags behind the chain head or the RPC is unavailable
+	// Example: false
+	EtaDegraded bool `json:"eta_degraded,omitempty"`
+
 	// Total pending deposit amount (as string to avoid precision loss)
 	// Example: 0.500000
 	// Required: true
@@ -39,6 +47,10 @@ type PendingDepositItem struct {
 func (m *PendingDepositItem) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateEstimatedFinalizedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePendingAmount(formats); err != nil {
 		res = append(res, err)
 	}
@@ -57,6 +69,18 @@ func (m *PendingDepositItem) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PendingDepositItem) validateEstimatedFinalizedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.EstimatedFinalizedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("estimated_finalized_at", "body", "date-time", m.EstimatedFinalizedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *PendingDepositItem) validatePendingAmount(formats strfmt.Registry) error {
 
 	if err := validate.Required("pending_amount", "body", m.PendingAmount); err != nil {
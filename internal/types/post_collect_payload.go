@@ -19,10 +19,23 @@ import (
 // swagger:model postCollectPayload
 type PostCollectPayload struct {
 
+	// Amount to collect (human readable), omit to sweep the full balance (native: balance minus gas fee)
+	// Example: 100.5
+	Amount string `json:"amount,omitempty"`
+
 	// Chain ID (required if wallet_id is not provided, will use wallet's chain_id if wallet_id is provided)
 	// Example: 1
 	ChainID int64 `json:"chain_id,omitempty"`
 
+	// Destination hot wallet on the chain of the wallet, omit to use the hot wallet selected for the chain
+	// Example: 6f1c2a4e-8d3b-4c7a-9e21-0b5d4f3a2c18
+	// Format: uuid
+	HotWalletID strfmt.UUID `json:"hot_wallet_id,omitempty"`
+
+	// Token to collect, omit for the native token. When token_id, amount or hot_wallet_id is given only this asset is collected and the transaction hash is returned
+	// Example: 3
+	TokenID *int64 `json:"token_id,omitempty"`
+
 	// Wallet ID to collect from (optional, if not provided, chain_id must be provided)
 	// Example: 550e8400-e29b-41d4-a716-446655440000
 	// Format: uuid
@@ -33,6 +46,10 @@ type PostCollectPayload struct {
 func (m *PostCollectPayload) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateHotWalletID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateWalletID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -43,6 +60,18 @@ func (m *PostCollectPayload) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PostCollectPayload) validateHotWalletID(formats strfmt.Registry) error {
+	if swag.IsZero(m.HotWalletID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("hot_wallet_id", "body", "uuid", m.HotWalletID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *PostCollectPayload) validateWalletID(formats strfmt.Registry) error {
 	if swag.IsZero(m.WalletID) { // not required
 		return nil
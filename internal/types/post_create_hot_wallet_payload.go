@@ -28,6 +28,10 @@ type PostCreateHotWalletPayload struct {
 	// Example: hot-wallet-1
 	// Required: true
 	DeviceName *string `json:"device_name"`
+
+	// Organization the hot wallet belongs to, null creates a hot wallet shared by all organizations
+	// Format: uuid
+	OrgID *strfmt.UUID `json:"org_id,omitempty"`
 }
 
 // Validate validates this post create hot wallet payload
@@ -42,6 +46,10 @@ func (m *PostCreateHotWalletPayload) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateOrgID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -66,6 +74,19 @@ func (m *PostCreateHotWalletPayload) validateDeviceName(formats strfmt.Registry)
 	return nil
 }
 
+func (m *PostCreateHotWalletPayload) validateOrgID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.OrgID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("org_id", "body", "uuid", m.OrgID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validates this post create hot wallet payload based on context it is used
 func (m *PostCreateHotWalletPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
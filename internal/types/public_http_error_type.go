@@ -68,6 +68,54 @@ const (
 
 	// PublicHTTPErrorTypeMISSINGSCOPES captures enum value "MISSING_SCOPES"
 	PublicHTTPErrorTypeMISSINGSCOPES PublicHTTPErrorType = "MISSING_SCOPES"
+
+	// PublicHTTPErrorTypeINVALIDWITHDRAWADDRESS captures enum value "INVALID_WITHDRAW_ADDRESS"
+	PublicHTTPErrorTypeINVALIDWITHDRAWADDRESS PublicHTTPErrorType = "INVALID_WITHDRAW_ADDRESS"
+
+	// PublicHTTPErrorTypeINVALIDAMOUNT captures enum value "INVALID_AMOUNT"
+	PublicHTTPErrorTypeINVALIDAMOUNT PublicHTTPErrorType = "INVALID_AMOUNT"
+
+	// PublicHTTPErrorTypeAMOUNTBELOWMINIMUM captures enum value "AMOUNT_BELOW_MINIMUM"
+	PublicHTTPErrorTypeAMOUNTBELOWMINIMUM PublicHTTPErrorType = "AMOUNT_BELOW_MINIMUM"
+
+	// PublicHTTPErrorTypeINSUFFICIENTBALANCE captures enum value "INSUFFICIENT_BALANCE"
+	PublicHTTPErrorTypeINSUFFICIENTBALANCE PublicHTTPErrorType = "INSUFFICIENT_BALANCE"
+
+	// PublicHTTPErrorTypeCHAINNOTFOUND captures enum value "CHAIN_NOT_FOUND"
+	PublicHTTPErrorTypeCHAINNOTFOUND PublicHTTPErrorType = "CHAIN_NOT_FOUND"
+
+	// PublicHTTPErrorTypeWALLETTOKENNOTFOUND captures enum value "WALLET_TOKEN_NOT_FOUND"
+	PublicHTTPErrorTypeWALLETTOKENNOTFOUND PublicHTTPErrorType = "WALLET_TOKEN_NOT_FOUND"
+
+	// PublicHTTPErrorTypeWALLETNOTFOUND captures enum value "WALLET_NOT_FOUND"
+	PublicHTTPErrorTypeWALLETNOTFOUND PublicHTTPErrorType = "WALLET_NOT_FOUND"
+
+	// PublicHTTPErrorTypeWITHDRAWNOTFOUND captures enum value "WITHDRAW_NOT_FOUND"
+	PublicHTTPErrorTypeWITHDRAWNOTFOUND PublicHTTPErrorType = "WITHDRAW_NOT_FOUND"
+
+	// PublicHTTPErrorTypeWITHDRAWSTATECONFLICT captures enum value "WITHDRAW_STATE_CONFLICT"
+	PublicHTTPErrorTypeWITHDRAWSTATECONFLICT PublicHTTPErrorType = "WITHDRAW_STATE_CONFLICT"
+
+	// PublicHTTPErrorTypeSELFAPPROVALNOTALLOWED captures enum value "SELF_APPROVAL_NOT_ALLOWED"
+	PublicHTTPErrorTypeSELFAPPROVALNOTALLOWED PublicHTTPErrorType = "SELF_APPROVAL_NOT_ALLOWED"
+
+	// PublicHTTPErrorTypeIDEMPOTENCYKEYCONFLICT captures enum value "IDEMPOTENCY_KEY_CONFLICT"
+	PublicHTTPErrorTypeIDEMPOTENCYKEYCONFLICT PublicHTTPErrorType = "IDEMPOTENCY_KEY_CONFLICT"
+
+	// PublicHTTPErrorTypeRATELIMITED captures enum value "RATE_LIMITED"
+	PublicHTTPErrorTypeRATELIMITED PublicHTTPErrorType = "RATE_LIMITED"
+
+	// PublicHTTPErrorTypeWITHDRAWLIMITEXCEEDED captures enum value "WITHDRAW_LIMIT_EXCEEDED"
+	PublicHTTPErrorTypeWITHDRAWLIMITEXCEEDED PublicHTTPErrorType = "WITHDRAW_LIMIT_EXCEEDED"
+
+	// PublicHTTPErrorTypeNFTNOTFOUND captures enum value "NFT_NOT_FOUND"
+	PublicHTTPErrorTypeNFTNOTFOUND PublicHTTPErrorType = "NFT_NOT_FOUND"
+
+	// PublicHTTPErrorTypeNFTSTATECONFLICT captures enum value "NFT_STATE_CONFLICT"
+	PublicHTTPErrorTypeNFTSTATECONFLICT PublicHTTPErrorType = "NFT_STATE_CONFLICT"
+
+	// PublicHTTPErrorTypeWITHDRAWBLOCKED captures enum value "WITHDRAW_BLOCKED"
+	PublicHTTPErrorTypeWITHDRAWBLOCKED PublicHTTPErrorType = "WITHDRAW_BLOCKED"
 )
 
 // for schema
@@ -75,7 +123,7 @@ var publicHttpErrorTypeEnum []interface{}
 
 func init() {
 	var res []PublicHTTPErrorType
-	if err := json.Unmarshal([]byte(`["generic","PUSH_TOKEN_ALREADY_EXISTS","OLD_PUSH_TOKEN_NOT_FOUND","ZERO_FILE_SIZE","USER_DEACTIVATED","INVALID_PASSWORD","NOT_LOCAL_USER","TOKEN_NOT_FOUND","TOKEN_EXPIRED","USER_ALREADY_EXISTS","MALFORMED_TOKEN","LAST_AUTHENTICATED_AT_EXCEEDED","MISSING_SCOPES"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["generic","PUSH_TOKEN_ALREADY_EXISTS","OLD_PUSH_TOKEN_NOT_FOUND","ZERO_FILE_SIZE","USER_DEACTIVATED","INVALID_PASSWORD","NOT_LOCAL_USER","TOKEN_NOT_FOUND","TOKEN_EXPIRED","USER_ALREADY_EXISTS","MALFORMED_TOKEN","LAST_AUTHENTICATED_AT_EXCEEDED","MISSING_SCOPES","INVALID_WITHDRAW_ADDRESS","INVALID_AMOUNT","AMOUNT_BELOW_MINIMUM","INSUFFICIENT_BALANCE","CHAIN_NOT_FOUND","WALLET_TOKEN_NOT_FOUND","WALLET_NOT_FOUND","WITHDRAW_NOT_FOUND","WITHDRAW_STATE_CONFLICT","SELF_APPROVAL_NOT_ALLOWED","IDEMPOTENCY_KEY_CONFLICT","RATE_LIMITED","WITHDRAW_LIMIT_EXCEEDED","NFT_NOT_FOUND","NFT_STATE_CONFLICT","WITHDRAW_BLOCKED"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -25,11 +25,26 @@ type TokenBalanceItem struct {
 	// Required: true
 	Amount *string `json:"amount"`
 
+	// Withdrawable balance: finalized credits minus the amounts of withdraws in progress or completed
+	// Example: 90.5
+	// Required: true
+	Available *string `json:"available"`
+
 	// chain id
 	// Example: 1
 	// Required: true
 	ChainID *int64 `json:"chain_id"`
 
+	// Amount held by withdraws in progress (requested, approved, signing or broadcast), including withdraw fees
+	// Example: 10
+	// Required: true
+	FrozenWithdraw *string `json:"frozen_withdraw"`
+
+	// Deposits that are not finalized yet (pending or confirmed)
+	// Example: 5
+	// Required: true
+	PendingDeposit *string `json:"pending_deposit"`
+
 	// status
 	// Example: finalized
 	// Required: true
@@ -45,6 +60,19 @@ type TokenBalanceItem struct {
 	// Example: ETH
 	// Required: true
 	TokenSymbol *string `json:"token_symbol"`
+
+	// Sum of available, pending_deposit and frozen_withdraw
+	// Example: 105.5
+	// Required: true
+	Total *string `json:"total"`
+
+	// USD price of one token, omitted if the token has no price
+	// Example: 3120.15
+	UsdPrice string `json:"usd_price,omitempty"`
+
+	// USD value of the amount rounded to cents, omitted if the token has no price
+	// Example: 313575.08
+	UsdValue string `json:"usd_value,omitempty"`
 }
 
 // Validate validates this token balance item
@@ -55,10 +83,22 @@ func (m *TokenBalanceItem) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateAvailable(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateChainID(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateFrozenWithdraw(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePendingDeposit(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStatus(formats); err != nil {
 		res = append(res, err)
 	}
@@ -71,6 +111,10 @@ func (m *TokenBalanceItem) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTotal(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -86,6 +130,15 @@ func (m *TokenBalanceItem) validateAmount(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TokenBalanceItem) validateAvailable(formats strfmt.Registry) error {
+
+	if err := validate.Required("available", "body", m.Available); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *TokenBalanceItem) validateChainID(formats strfmt.Registry) error {
 
 	if err := validate.Required("chain_id", "body", m.ChainID); err != nil {
@@ -95,6 +148,24 @@ func (m *TokenBalanceItem) validateChainID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TokenBalanceItem) validateFrozenWithdraw(formats strfmt.Registry) error {
+
+	if err := validate.Required("frozen_withdraw", "body", m.FrozenWithdraw); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TokenBalanceItem) validatePendingDeposit(formats strfmt.Registry) error {
+
+	if err := validate.Required("pending_deposit", "body", m.PendingDeposit); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 var tokenBalanceItemTypeStatusPropEnum []interface{}
 
 func init() {
@@ -159,6 +230,15 @@ func (m *TokenBalanceItem) validateTokenSymbol(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TokenBalanceItem) validateTotal(formats strfmt.Registry) error {
+
+	if err := validate.Required("total", "body", m.Total); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validates this token balance item based on context it is used
 func (m *TokenBalanceItem) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
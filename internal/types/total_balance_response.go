@@ -28,6 +28,10 @@ type TotalBalanceResponse struct {
 	// Example: 100.500000
 	// Required: true
 	TotalAmount *string `json:"total_amount"`
+
+	// Total USD value of the finalized balances rounded to cents, tokens without a price are not included
+	// Example: 313575.08
+	TotalUsdValue string `json:"total_usd_value,omitempty"`
 }
 
 // Validate validates this total balance response
@@ -280,7 +280,7 @@ func (o *GetDepositsRouteParams) validateStatus(formats strfmt.Registry) error {
 		return nil
 	}
 
-	if err := validate.EnumCase("status", "query", *o.Status, []interface{}{"confirmed", "safe", "finalized", "failed"}, true); err != nil {
+	if err := validate.EnumCase("status", "query", *o.Status, []interface{}{"confirmed", "safe", "finalized", "failed", "dust"}, true); err != nil {
 		return err
 	}
 
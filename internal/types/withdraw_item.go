@@ -30,7 +30,14 @@ type WithdrawItem struct {
 	// Format: date-time
 	CreatedAt *strfmt.DateTime `json:"created_at"`
 
-	// fee
+	// Expiry of a withdraw request awaiting approval, the request is rejected automatically if it has not received the required approvals by then
+	// Format: date-time
+	ExpiresAt *strfmt.DateTime `json:"expires_at,omitempty"`
+
+	// Block explorer links, omitted when no explorer is configured for the chain
+	ExplorerLinks *ExplorerLinks `json:"explorer_links,omitempty"`
+
+	// Withdraw fee charged in addition to the amount (human-readable units)
 	// Example: 0.001
 	Fee string `json:"fee,omitempty"`
 
@@ -39,6 +46,13 @@ type WithdrawItem struct {
 	// Format: uuid
 	ID *strfmt.UUID `json:"id"`
 
+	// Maintenance notice, set for withdraws waiting for processing while their chain is under maintenance
+	Maintenance *MaintenanceNotice `json:"maintenance,omitempty"`
+
+	// Next scheduled processing window for withdraws waiting for a processing window
+	// Format: date-time
+	ProcessingEta *strfmt.DateTime `json:"processing_eta,omitempty"`
+
 	// status
 	// Example: user_withdraw_request
 	// Required: true
@@ -77,10 +91,26 @@ func (m *WithdrawItem) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExplorerLinks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateID(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateMaintenance(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProcessingEta(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStatus(formats); err != nil {
 		res = append(res, err)
 	}
@@ -125,6 +155,38 @@ func (m *WithdrawItem) validateCreatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *WithdrawItem) validateExpiresAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ExpiresAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("expires_at", "body", "date-time", m.ExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *WithdrawItem) validateExplorerLinks(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExplorerLinks) { // not required
+		return nil
+	}
+
+	if m.ExplorerLinks != nil {
+		if err := m.ExplorerLinks.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("explorer_links")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("explorer_links")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *WithdrawItem) validateID(formats strfmt.Registry) error {
 
 	if err := validate.Required("id", "body", m.ID); err != nil {
@@ -138,6 +200,38 @@ func (m *WithdrawItem) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *WithdrawItem) validateMaintenance(formats strfmt.Registry) error {
+	if swag.IsZero(m.Maintenance) { // not required
+		return nil
+	}
+
+	if m.Maintenance != nil {
+		if err := m.Maintenance.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maintenance")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maintenance")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *WithdrawItem) validateProcessingEta(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ProcessingEta) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("processing_eta", "body", "date-time", m.ProcessingEta.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 var withdrawItemTypeStatusPropEnum []interface{}
 
 func init() {
@@ -224,8 +318,53 @@ func (m *WithdrawItem) validateUserID(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this withdraw item based on context it is used
+// ContextValidate validate this withdraw item based on the context it is used
 func (m *WithdrawItem) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateExplorerLinks(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMaintenance(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *WithdrawItem) contextValidateExplorerLinks(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ExplorerLinks != nil {
+		if err := m.ExplorerLinks.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("explorer_links")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("explorer_links")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *WithdrawItem) contextValidateMaintenance(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Maintenance != nil {
+		if err := m.Maintenance.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maintenance")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maintenance")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -11,7 +11,6 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
-	"github.com/go-openapi/validate"
 )
 
 // WithdrawResponse withdraw response
@@ -19,15 +18,21 @@ import (
 // swagger:model withdrawResponse
 type WithdrawResponse struct {
 
+	// Internal transfer the withdraw was converted to, set instead of withdraw if the address belongs to another user of this platform
+	InternalTransfer *InternalTransfer `json:"internal_transfer,omitempty"`
+
 	// withdraw
-	// Required: true
-	Withdraw *WithdrawItem `json:"withdraw"`
+	Withdraw *WithdrawItem `json:"withdraw,omitempty"`
 }
 
 // Validate validates this withdraw response
 func (m *WithdrawResponse) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateInternalTransfer(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateWithdraw(formats); err != nil {
 		res = append(res, err)
 	}
@@ -38,10 +43,28 @@ func (m *WithdrawResponse) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *WithdrawResponse) validateWithdraw(formats strfmt.Registry) error {
+func (m *WithdrawResponse) validateInternalTransfer(formats strfmt.Registry) error {
+	if swag.IsZero(m.InternalTransfer) { // not required
+		return nil
+	}
 
-	if err := validate.Required("withdraw", "body", m.Withdraw); err != nil {
-		return err
+	if m.InternalTransfer != nil {
+		if err := m.InternalTransfer.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("internal_transfer")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("internal_transfer")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *WithdrawResponse) validateWithdraw(formats strfmt.Registry) error {
+	if swag.IsZero(m.Withdraw) { // not required
+		return nil
 	}
 
 	if m.Withdraw != nil {
@@ -62,6 +85,10 @@ func (m *WithdrawResponse) validateWithdraw(formats strfmt.Registry) error {
 func (m *WithdrawResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateInternalTransfer(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateWithdraw(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -72,6 +99,22 @@ func (m *WithdrawResponse) ContextValidate(ctx context.Context, formats strfmt.R
 	return nil
 }
 
+func (m *WithdrawResponse) contextValidateInternalTransfer(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.InternalTransfer != nil {
+		if err := m.InternalTransfer.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("internal_transfer")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("internal_transfer")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *WithdrawResponse) contextValidateWithdraw(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Withdraw != nil {
@@ -12,7 +12,10 @@ type contextKey string
 const (
 	CTXKeyUser          contextKey = "user"
 	CTXKeyAccessToken   contextKey = "access_token"
+	CTXKeyAPIToken      contextKey = "api_token"
+	CTXKeyDevice        contextKey = "device"
 	CTXKeyCacheControl  contextKey = "cache_control"
+	CTXKeyIdempotency   contextKey = "idempotency_key"
 	CTXKeyRequestID     contextKey = "request_id"
 	CTXKeyDisableLogger contextKey = "disable_logger"
 )
@@ -49,6 +52,16 @@ func RequestIDFromContext(ctx context.Context) (string, error) {
 	return id, nil
 }
 
+// IdempotencyKeyFromContext returns the idempotency key of the (HTTP) request, or an empty string if none was provided.
+func IdempotencyKeyFromContext(ctx context.Context) string {
+	key, ok := ctx.Value(CTXKeyIdempotency).(string)
+	if !ok {
+		return ""
+	}
+
+	return key
+}
+
 // ShouldDisableLogger checks whether the logger instance should be disabled for the provided context.
 // `util.LogFromContext` will use this function to check whether it should return a default logger if
 // none has been set by our logging middleware before, or fall back to the disabled logger, suppressing
@@ -23,7 +23,9 @@ import (
 )
 
 const (
-	HTTPHeaderCacheControl = "Cache-Control"
+	HTTPHeaderCacheControl   = "Cache-Control"
+	HTTPHeaderIdempotencyKey = "Idempotency-Key"
+	HTTPHeaderInternalAPIKey = "X-Internal-Api-Key"
 )
 
 // BindAndValidateBody binds the request, parsing **only** its body (depending on the `Content-Type` request header) and performs validation
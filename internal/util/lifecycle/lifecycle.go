@@ -0,0 +1,159 @@
+// Package lifecycle coordinates the shutdown of long-running background workers.
+//
+// Workers are started with Go on a context obtained from Manager.Attach. When the server
+// shuts down, these contexts are cancelled so workers stop picking up new work, and
+// Manager.Shutdown waits for them to return. Work that must not be interrupted halfway
+// (signing and broadcasting a transaction, then persisting its hash) runs on a context
+// obtained from InFlight, which survives the shutdown signal and is only cancelled once
+// the drain deadline has passed.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+type contextKey struct{}
+
+// ErrDrainTimeout is returned by Shutdown if workers are still running when the drain deadline passes
+var ErrDrainTimeout = errors.New("background workers did not stop before the shutdown deadline")
+
+// Manager tracks background workers and drains them on shutdown
+type Manager struct {
+	// stopCtx is cancelled when shutdown begins, workers stop picking up new work
+	stopCtx    context.Context //nolint:containedctx
+	stopCancel context.CancelFunc
+	// hardCtx is cancelled when the drain deadline passes, in-flight work is aborted
+	hardCtx    context.Context //nolint:containedctx
+	hardCancel context.CancelFunc
+
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	running map[string]int
+}
+
+// New creates a new Manager
+func New() *Manager {
+	stopCtx, stopCancel := context.WithCancel(context.Background())
+	hardCtx, hardCancel := context.WithCancel(context.Background())
+
+	return &Manager{
+		stopCtx:    stopCtx,
+		stopCancel: stopCancel,
+		hardCtx:    hardCtx,
+		hardCancel: hardCancel,
+		running:    make(map[string]int),
+	}
+}
+
+// Attach returns a context derived from parent which carries the manager and is cancelled when shutdown begins.
+// Background workers should be started with this context.
+func (m *Manager) Attach(parent context.Context) context.Context {
+	ctx, cancel := context.WithCancel(context.WithValue(parent, contextKey{}, m))
+	context.AfterFunc(m.stopCtx, cancel)
+
+	return ctx
+}
+
+// Shutdown cancels all attached contexts and waits for the workers to return.
+// If ctx expires first, in-flight work is aborted and ErrDrainTimeout is returned listing the workers still running.
+func (m *Manager) Shutdown(ctx context.Context) error {
+	m.stopCancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		m.wg.Wait()
+	}()
+
+	select {
+	case <-done:
+		m.hardCancel()
+		log.Debug().Msg("All background workers stopped")
+		return nil
+	case <-ctx.Done():
+		m.hardCancel()
+		running := m.Running()
+		log.Error().Strs("workers", running).Msg("Background workers did not stop before the shutdown deadline, aborting in-flight work")
+		return fmt.Errorf("%w: %s", ErrDrainTimeout, strings.Join(running, ", "))
+	}
+}
+
+// Running returns the names of the workers currently running, sorted
+func (m *Manager) Running() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	names := make([]string, 0, len(m.running))
+	for name := range m.running {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (m *Manager) add(name string) {
+	m.wg.Add(1)
+
+	m.mu.Lock()
+	m.running[name]++
+	m.mu.Unlock()
+}
+
+func (m *Manager) done(name string) {
+	m.mu.Lock()
+	m.running[name]--
+	if m.running[name] <= 0 {
+		delete(m.running, name)
+	}
+	m.mu.Unlock()
+
+	m.wg.Done()
+}
+
+// FromContext returns the manager carried by ctx, or nil if ctx was not obtained from Manager.Attach
+func FromContext(ctx context.Context) *Manager {
+	m, _ := ctx.Value(contextKey{}).(*Manager)
+	return m
+}
+
+// Go runs fn in a new goroutine. If ctx carries a manager, Shutdown waits for fn to return.
+// fn must return once ctx is cancelled.
+func Go(ctx context.Context, name string, fn func()) {
+	m := FromContext(ctx)
+	if m == nil {
+		go fn()
+		return
+	}
+
+	m.add(name)
+	go func() {
+		defer m.done(name)
+		fn()
+	}()
+}
+
+// InFlight returns a context for work which must not be interrupted by the shutdown signal, such as
+// signing, broadcasting and persisting a transaction. It keeps the values of ctx and is only cancelled
+// when the drain deadline passes or cancel is called. If ctx carries no manager, it is cancelled with ctx.
+func InFlight(ctx context.Context) (context.Context, context.CancelFunc) {
+	m := FromContext(ctx)
+	if m == nil {
+		return context.WithCancel(ctx)
+	}
+
+	inFlightCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(m.hardCtx, cancel)
+
+	return inFlightCtx, func() {
+		stop()
+		cancel()
+	}
+}
@@ -0,0 +1,85 @@
+package lifecycle_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github/chapool/go-wallet/internal/util/lifecycle"
+)
+
+func TestShutdownWaitsForInFlightWork(t *testing.T) {
+	m := lifecycle.New()
+	ctx := m.Attach(context.Background())
+
+	started := make(chan struct{})
+	var finished atomic.Bool
+	var inFlightErr atomic.Value
+
+	lifecycle.Go(ctx, "worker", func() {
+		<-ctx.Done()
+
+		// work that started before shutdown still completes on an in-flight context
+		inFlightCtx, cancel := lifecycle.InFlight(ctx)
+		defer cancel()
+		close(started)
+
+		time.Sleep(50 * time.Millisecond)
+		if err := inFlightCtx.Err(); err != nil {
+			inFlightErr.Store(err)
+		}
+		finished.Store(true)
+	})
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, m.Shutdown(shutdownCtx))
+
+	<-started
+	assert.True(t, finished.Load())
+	assert.Nil(t, inFlightErr.Load())
+	assert.Empty(t, m.Running())
+}
+
+func TestShutdownDrainTimeout(t *testing.T) {
+	m := lifecycle.New()
+	ctx := m.Attach(context.Background())
+
+	aborted := make(chan struct{})
+	lifecycle.Go(ctx, "stuck", func() {
+		inFlightCtx, cancel := lifecycle.InFlight(ctx)
+		defer cancel()
+
+		<-inFlightCtx.Done()
+		close(aborted)
+	})
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := m.Shutdown(shutdownCtx)
+	require.ErrorIs(t, err, lifecycle.ErrDrainTimeout)
+	assert.Contains(t, err.Error(), "stuck")
+
+	select {
+	case <-aborted:
+	case <-time.After(time.Second):
+		t.Fatal("in-flight context was not cancelled after the drain deadline")
+	}
+}
+
+func TestGoWithoutManager(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	assert.Nil(t, lifecycle.FromContext(ctx))
+
+	done := make(chan struct{})
+	lifecycle.Go(ctx, "untracked", func() { close(done) })
+	<-done
+
+	inFlightCtx, inFlightCancel := lifecycle.InFlight(ctx)
+	defer inFlightCancel()
+	cancel()
+	assert.Error(t, inFlightCtx.Err())
+}
@@ -0,0 +1,523 @@
+package qrcode
+
+import (
+	"errors"
+)
+
+// Minimal QR code encoder (ISO/IEC 18004) supporting byte mode with error correction level M
+// and versions 1-10, which is plenty for payment URIs (up to 213 bytes).
+// Inspired by: https://www.nayuki.io/page/qr-code-generator-library
+
+const (
+	maxVersion = 10
+
+	modeByte          = 0x4
+	formatBitsLevelM  = 0x0
+	formatXORMask     = 0x5412
+	formatGenerator   = 0x537
+	versionGenerator  = 0x1F25
+	gfPrimitive       = 0x11D
+	padCodewordFirst  = 0xEC
+	padCodewordSecond = 0x11
+
+	penaltyN1 = 3
+	penaltyN2 = 3
+	penaltyN3 = 40
+	penaltyN4 = 10
+)
+
+// ErrContentTooLong indicates the content does not fit into the largest supported QR code version.
+var ErrContentTooLong = errors.New("content too long for QR code")
+
+// ecBlocks describes the error correction block structure of a version at level M.
+type ecBlocks struct {
+	ecCodewords int // error correction codewords per block
+	group1      int // number of blocks in group 1
+	group1Data  int // data codewords per block in group 1
+	group2      int // number of blocks in group 2
+	group2Data  int // data codewords per block in group 2
+}
+
+func (b ecBlocks) dataCodewords() int {
+	return b.group1*b.group1Data + b.group2*b.group2Data
+}
+
+// versionsLevelM is indexed by version - 1.
+var versionsLevelM = [maxVersion]ecBlocks{
+	{10, 1, 16, 0, 0},
+	{16, 1, 28, 0, 0},
+	{26, 1, 44, 0, 0},
+	{18, 2, 32, 0, 0},
+	{24, 2, 43, 0, 0},
+	{16, 4, 27, 0, 0},
+	{18, 4, 31, 0, 0},
+	{22, 2, 38, 2, 39},
+	{22, 3, 36, 2, 37},
+	{26, 4, 43, 1, 44},
+}
+
+// alignmentPositions is indexed by version - 1.
+var alignmentPositions = [maxVersion][]int{
+	{},
+	{6, 18},
+	{6, 22},
+	{6, 26},
+	{6, 30},
+	{6, 34},
+	{6, 22, 38},
+	{6, 24, 42},
+	{6, 26, 46},
+	{6, 28, 50},
+}
+
+// Code is an encoded QR code. Modules are addressed as [y][x], true means dark.
+type Code struct {
+	Version int
+	Size    int
+	Modules [][]bool
+
+	isFunction [][]bool
+}
+
+// Encode encodes content in byte mode with error correction level M, using the smallest version that fits.
+func Encode(content string) (*Code, error) {
+	data := []byte(content)
+
+	version := 0
+	for v := 1; v <= maxVersion; v++ {
+		capacityBits := versionsLevelM[v-1].dataCodewords() * 8
+		if 4+charCountBits(v)+len(data)*8 <= capacityBits {
+			version = v
+			break
+		}
+	}
+	if version == 0 {
+		return nil, ErrContentTooLong
+	}
+
+	size := version*4 + 17
+	c := &Code{
+		Version:    version,
+		Size:       size,
+		Modules:    newGrid(size),
+		isFunction: newGrid(size),
+	}
+
+	c.drawFunctionPatterns()
+	c.drawCodewords(addErrorCorrection(encodeData(data, version), versionsLevelM[version-1]))
+
+	// choose the mask with the lowest penalty score
+	bestMask := 0
+	minPenalty := -1
+	for mask := 0; mask < 8; mask++ {
+		c.applyMask(mask)
+		c.drawFormatBits(mask)
+		penalty := c.penaltyScore()
+		if minPenalty < 0 || penalty < minPenalty {
+			bestMask = mask
+			minPenalty = penalty
+		}
+		c.applyMask(mask) // XOR again to undo
+	}
+	c.applyMask(bestMask)
+	c.drawFormatBits(bestMask)
+
+	return c, nil
+}
+
+func newGrid(size int) [][]bool {
+	grid := make([][]bool, size)
+	for i := range grid {
+		grid[i] = make([]bool, size)
+	}
+
+	return grid
+}
+
+func charCountBits(version int) int {
+	if version < 10 {
+		return 8
+	}
+
+	return 16
+}
+
+// encodeData builds the data codewords: mode indicator, character count, data, terminator and padding.
+func encodeData(data []byte, version int) []byte {
+	capacity := versionsLevelM[version-1].dataCodewords()
+
+	var bb bitBuffer
+	bb.append(modeByte, 4)
+	bb.append(len(data), charCountBits(version))
+	for _, b := range data {
+		bb.append(int(b), 8)
+	}
+
+	// terminator of up to 4 zero bits, then pad to a byte boundary
+	bb.append(0, min(4, capacity*8-len(bb)))
+	bb.append(0, (8-len(bb)%8)%8)
+
+	codewords := bb.bytes()
+	for pad := padCodewordFirst; len(codewords) < capacity; pad ^= padCodewordFirst ^ padCodewordSecond {
+		codewords = append(codewords, byte(pad))
+	}
+
+	return codewords
+}
+
+// addErrorCorrection splits the data into blocks, appends Reed-Solomon codewords and interleaves the result.
+func addErrorCorrection(data []byte, blocks ecBlocks) []byte {
+	divisor := reedSolomonDivisor(blocks.ecCodewords)
+
+	var dataBlocks, ecBlocks [][]byte
+	offset := 0
+	for i := 0; i < blocks.group1+blocks.group2; i++ {
+		length := blocks.group1Data
+		if i >= blocks.group1 {
+			length = blocks.group2Data
+		}
+
+		block := data[offset : offset+length]
+		offset += length
+
+		dataBlocks = append(dataBlocks, block)
+		ecBlocks = append(ecBlocks, reedSolomonRemainder(block, divisor))
+	}
+
+	result := make([]byte, 0, len(data)+len(ecBlocks)*blocks.ecCodewords)
+	for i := 0; i < max(blocks.group1Data, blocks.group2Data); i++ {
+		for _, block := range dataBlocks {
+			if i < len(block) {
+				result = append(result, block[i])
+			}
+		}
+	}
+	for i := 0; i < blocks.ecCodewords; i++ {
+		for _, block := range ecBlocks {
+			result = append(result, block[i])
+		}
+	}
+
+	return result
+}
+
+// reedSolomonDivisor returns the generator polynomial of the given degree (highest coefficient omitted).
+func reedSolomonDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+
+	return result
+}
+
+// reedSolomonRemainder returns the error correction codewords of data.
+func reedSolomonRemainder(data []byte, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i := range result {
+			result[i] ^= gfMultiply(divisor[i], factor)
+		}
+	}
+
+	return result
+}
+
+// gfMultiply multiplies two elements of GF(2^8) modulo the QR code primitive polynomial.
+func gfMultiply(x byte, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * gfPrimitive)
+		z ^= int((y>>i)&1) * int(x)
+	}
+
+	return byte(z)
+}
+
+func (c *Code) setFunctionModule(x int, y int, dark bool) {
+	c.Modules[y][x] = dark
+	c.isFunction[y][x] = true
+}
+
+func (c *Code) drawFunctionPatterns() {
+	// timing patterns
+	for i := 0; i < c.Size; i++ {
+		c.setFunctionModule(6, i, i%2 == 0)
+		c.setFunctionModule(i, 6, i%2 == 0)
+	}
+
+	// finder patterns (including separators)
+	c.drawFinderPattern(3, 3)
+	c.drawFinderPattern(c.Size-4, 3)
+	c.drawFinderPattern(3, c.Size-4)
+
+	// alignment patterns, except the ones overlapping the finder patterns
+	positions := alignmentPositions[c.Version-1]
+	last := len(positions) - 1
+	for i, x := range positions {
+		for j, y := range positions {
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			c.drawAlignmentPattern(x, y)
+		}
+	}
+
+	// reserve the format areas, the actual bits are drawn after masking
+	c.drawFormatBits(0)
+	c.drawVersion()
+}
+
+func (c *Code) drawFinderPattern(x int, y int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			dist := max(abs(dx), abs(dy))
+			xx, yy := x+dx, y+dy
+			if xx >= 0 && xx < c.Size && yy >= 0 && yy < c.Size {
+				c.setFunctionModule(xx, yy, dist != 2 && dist != 4)
+			}
+		}
+	}
+}
+
+func (c *Code) drawAlignmentPattern(x int, y int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			c.setFunctionModule(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+// formatBits returns the 15 bit format information for level M and the given mask.
+func formatBits(mask int) int {
+	data := formatBitsLevelM<<3 | mask
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * formatGenerator)
+	}
+
+	return (data<<10 | rem) ^ formatXORMask
+}
+
+// versionBits returns the 18 bit version information (only used for versions 7 and up).
+func versionBits(version int) int {
+	rem := version
+	for i := 0; i < 12; i++ {
+		rem = (rem << 1) ^ ((rem >> 11) * versionGenerator)
+	}
+
+	return version<<12 | rem
+}
+
+func (c *Code) drawFormatBits(mask int) {
+	bits := formatBits(mask)
+
+	// first copy, around the top left finder pattern
+	for i := 0; i <= 5; i++ {
+		c.setFunctionModule(8, i, bit(bits, i))
+	}
+	c.setFunctionModule(8, 7, bit(bits, 6))
+	c.setFunctionModule(8, 8, bit(bits, 7))
+	c.setFunctionModule(7, 8, bit(bits, 8))
+	for i := 9; i < 15; i++ {
+		c.setFunctionModule(14-i, 8, bit(bits, i))
+	}
+
+	// second copy, split between the top right and bottom left finder patterns
+	for i := 0; i < 8; i++ {
+		c.setFunctionModule(c.Size-1-i, 8, bit(bits, i))
+	}
+	for i := 8; i < 15; i++ {
+		c.setFunctionModule(8, c.Size-15+i, bit(bits, i))
+	}
+	c.setFunctionModule(8, c.Size-8, true) // always dark
+}
+
+func (c *Code) drawVersion() {
+	if c.Version < 7 {
+		return
+	}
+
+	bits := versionBits(c.Version)
+	for i := 0; i < 18; i++ {
+		a := c.Size - 11 + i%3
+		b := i / 3
+		c.setFunctionModule(a, b, bit(bits, i))
+		c.setFunctionModule(b, a, bit(bits, i))
+	}
+}
+
+// drawCodewords places the codewords in the zigzag pattern, skipping function modules.
+func (c *Code) drawCodewords(codewords []byte) {
+	i := 0
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5 // skip the vertical timing pattern
+		}
+		for vert := 0; vert < c.Size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				upward := (right+1)&2 == 0
+				y := vert
+				if upward {
+					y = c.Size - 1 - vert
+				}
+
+				if !c.isFunction[y][x] && i < len(codewords)*8 {
+					c.Modules[y][x] = bit(int(codewords[i>>3]), 7-(i&7))
+					i++
+				}
+				// remainder bits stay light
+			}
+		}
+	}
+}
+
+// applyMask XORs all non-function modules with the mask pattern, applying it twice undoes it.
+func (c *Code) applyMask(mask int) {
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if c.isFunction[y][x] {
+				continue
+			}
+
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+
+			if invert {
+				c.Modules[y][x] = !c.Modules[y][x]
+			}
+		}
+	}
+}
+
+// penaltyScore computes the mask penalty score as defined by the specification.
+func (c *Code) penaltyScore() int {
+	penalty := 0
+	dark := 0
+
+	for i := 0; i < c.Size; i++ {
+		penalty += linePenalty(func(j int) bool { return c.Modules[i][j] }, c.Size)
+		penalty += linePenalty(func(j int) bool { return c.Modules[j][i] }, c.Size)
+	}
+
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if c.Modules[y][x] {
+				dark++
+			}
+			if x < c.Size-1 && y < c.Size-1 {
+				color := c.Modules[y][x]
+				if color == c.Modules[y][x+1] && color == c.Modules[y+1][x] && color == c.Modules[y+1][x+1] {
+					penalty += penaltyN2
+				}
+			}
+		}
+	}
+
+	total := c.Size * c.Size
+	deviation := abs(dark*20-total*10) / total // deviation from 50% in steps of 5%
+	penalty += deviation * penaltyN4
+
+	return penalty
+}
+
+// finderLikePatterns are the 1:1:3:1:1 patterns with 4 light modules on either side.
+var finderLikePatterns = [2][11]bool{
+	{true, false, true, true, true, false, true, false, false, false, false},
+	{false, false, false, false, true, false, true, true, true, false, true},
+}
+
+// linePenalty computes the run length and finder-like pattern penalties of a single row or column.
+func linePenalty(module func(int) bool, size int) int {
+	penalty := 0
+
+	run := 1
+	for j := 1; j <= size; j++ {
+		if j < size && module(j) == module(j-1) {
+			run++
+			continue
+		}
+		if run >= 5 {
+			penalty += penaltyN1 + run - 5
+		}
+		run = 1
+	}
+
+	for j := 0; j+11 <= size; j++ {
+		for _, pattern := range finderLikePatterns {
+			matches := true
+			for k, dark := range pattern {
+				if module(j+k) != dark {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				penalty += penaltyN3
+			}
+		}
+	}
+
+	return penalty
+}
+
+func bit(x int, i int) bool {
+	return (x>>i)&1 != 0
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
+
+type bitBuffer []bool
+
+func (bb *bitBuffer) append(value int, length int) {
+	for i := length - 1; i >= 0; i-- {
+		*bb = append(*bb, bit(value, i))
+	}
+}
+
+func (bb bitBuffer) bytes() []byte {
+	result := make([]byte, len(bb)/8)
+	for i, b := range bb {
+		if b {
+			result[i>>3] |= 1 << (7 - (i & 7))
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,64 @@
+package qrcode_test
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github/chapool/go-wallet/internal/util/qrcode"
+)
+
+func TestEncodeVersion(t *testing.T) {
+	tests := []struct {
+		content string
+		version int
+	}{
+		{"hello", 1},
+		{strings.Repeat("a", 14), 1},
+		{strings.Repeat("a", 15), 2},
+		{"ethereum:0xfb6916095ca1df60bB79Ce92cE3Ea74c37c5d359@56/transfer?address=0x8e23ee67d1332ad560396262c48ffbb01f93d052&uint256=1000000000000000000", 8},
+		{strings.Repeat("a", 213), 10},
+	}
+
+	for _, tt := range tests {
+		code, err := qrcode.Encode(tt.content)
+		require.NoError(t, err)
+		assert.Equal(t, tt.version, code.Version)
+		assert.Equal(t, tt.version*4+17, code.Size)
+		require.Len(t, code.Modules, code.Size)
+
+		// centres of the three finder patterns are dark
+		assert.True(t, code.Modules[3][3])
+		assert.True(t, code.Modules[3][code.Size-4])
+		assert.True(t, code.Modules[code.Size-4][3])
+	}
+}
+
+func TestEncodeTooLong(t *testing.T) {
+	_, err := qrcode.Encode(strings.Repeat("a", 214))
+	assert.ErrorIs(t, err, qrcode.ErrContentTooLong)
+}
+
+func TestPNG(t *testing.T) {
+	code, err := qrcode.Encode("ethereum:0xfb6916095ca1df60bB79Ce92cE3Ea74c37c5d359@1")
+	require.NoError(t, err)
+
+	data, err := code.PNG(4)
+	require.NoError(t, err)
+
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	// 4-module quiet zone on every side
+	size := (code.Size + 8) * 4
+	assert.Equal(t, size, img.Bounds().Dx())
+	assert.Equal(t, size, img.Bounds().Dy())
+
+	r, _, _, _ := img.At(0, 0).RGBA()
+	assert.Equal(t, uint32(0xffff), r)
+	r, _, _, _ = img.At(4*4, 4*4).RGBA()
+	assert.Equal(t, uint32(0), r)
+}
@@ -28,6 +28,21 @@ func PasswordResetDeeplinkURL(config config.Server, token string) (*url.URL, err
 	return u, nil
 }
 
+func WithdrawAddressConfirmationDeeplinkURL(config config.Server, token string) (*url.URL, error) {
+	u, err := url.Parse(config.Frontend.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the base URL: %w", err)
+	}
+
+	u.Path = path.Join(u.Path, config.Frontend.WithdrawAddressConfirmEndpoint)
+
+	q := u.Query()
+	q.Set(queryParamToken, token)
+	u.RawQuery = q.Encode()
+
+	return u, nil
+}
+
 func ConfirmationDeeplinkURL(config config.Server, token string) (*url.URL, error) {
 	u, err := url.Parse(config.Echo.BaseURL)
 	if err != nil {
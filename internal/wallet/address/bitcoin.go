@@ -0,0 +1,45 @@
+package address
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github/chapool/go-wallet/internal/wallet/bitcoin"
+)
+
+// bitcoinDerivationPath gets the BIP84 (native segwit) derivation path on the receive chain
+// Format: m/84'/{coinType}'/{account}'/0/{index}, coin type 0 (mainnet) and account 0 by default
+func bitcoinDerivationPath(coinType uint32, account uint32, addressIndex int) string {
+	return fmt.Sprintf("m/84'/%d'/%d'/0/%d", coinType, account, addressIndex)
+}
+
+// deriveBitcoinAddress derives a P2WPKH (bech32) address from seed and BIP84 path
+func deriveBitcoinAddress(seed []byte, path string) (string, error) {
+	privateKey, err := deriveBitcoinPrivateKey(seed, path)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to derive private key")
+	}
+
+	// Clear private key after use
+	defer func() {
+		for i := range privateKey {
+			privateKey[i] = 0
+		}
+	}()
+
+	ecdsaPrivateKey, err := crypto.ToECDSA(privateKey)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to convert to ECDSA private key")
+	}
+	defer ecdsaPrivateKey.D.SetInt64(0)
+
+	return bitcoin.AddressFromPublicKey(crypto.CompressPubkey(&ecdsaPrivateKey.PublicKey))
+}
+
+// deriveBitcoinPrivateKey derives a secp256k1 private key from seed and BIP84 path
+// BIP84 uses the same BIP32 derivation as EVM chains, only the path differs
+// WARNING: Caller must clear the private key after use
+func deriveBitcoinPrivateKey(seed []byte, path string) ([]byte, error) {
+	return deriveEVMPrivateKey(seed, path)
+}
@@ -0,0 +1,81 @@
+package address_test
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github/chapool/go-wallet/internal/wallet/address"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/testvectors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveAddressGolden(t *testing.T) {
+	t.Parallel()
+
+	service, err := address.NewService(nil, address.Config{})
+	require.NoError(t, err)
+
+	vectors := append(append([]testvectors.DerivationVector{}, testvectors.EVMDerivationVectors...), testvectors.SolanaDerivationVectors...)
+	vectors = append(vectors, testvectors.BitcoinDerivationVectors...)
+	for _, vector := range vectors {
+		t.Run(vector.ChainType+"/"+vector.Name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, vector.Path, service.GetDerivationPath(vector.ChainType, vector.Index))
+
+			seed := testvectors.Seed(vector.Mnemonic, vector.Passphrase)
+			derived, err := service.DeriveAddress(context.Background(), seed, vector.Path, vector.ChainType)
+			require.NoError(t, err)
+			assert.Equal(t, vector.Address, derived)
+
+			if vector.PrivateKey == "" {
+				return
+			}
+			privateKey, err := service.DerivePrivateKey(context.Background(), seed, vector.Path, vector.ChainType)
+			require.NoError(t, err)
+			assert.Equal(t, vector.PrivateKey, hex.EncodeToString(privateKey))
+		})
+	}
+}
+
+func TestDerivePassphraseChangesAddress(t *testing.T) {
+	t.Parallel()
+
+	service, err := address.NewService(nil, address.Config{})
+	require.NoError(t, err)
+
+	vector := testvectors.EVMDerivationVectors[0]
+	derived, err := service.DeriveAddress(context.Background(), testvectors.Seed(vector.Mnemonic, "TREZOR"), vector.Path, chain.TypeEVM)
+	require.NoError(t, err)
+	assert.NotEqual(t, vector.Address, derived)
+}
+
+func TestDeriveAddressUnsupportedChainType(t *testing.T) {
+	t.Parallel()
+
+	service, err := address.NewService(nil, address.Config{})
+	require.NoError(t, err)
+
+	_, err = service.DeriveAddress(context.Background(), testvectors.Seed(testvectors.AbandonMnemonic, ""), "m/44'/195'/0'/0/0", "tron")
+	require.Error(t, err)
+}
+
+func TestDerivationPathConfig(t *testing.T) {
+	t.Parallel()
+
+	service, err := address.NewService(nil, address.Config{
+		CoinTypes: map[string]uint32{"tron": 195, chain.TypeBitcoin: 1},
+		Accounts:  map[string]uint32{chain.TypeEVM: 2, chain.TypeBitcoin: 3},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "m/44'/60'/2'/0/5", service.GetDerivationPath(chain.TypeEVM, 5))
+	assert.Equal(t, "m/44'/195'/0'/0/5", service.GetDerivationPath("tron", 5))
+	assert.Equal(t, "m/84'/1'/3'/0/5", service.GetDerivationPath(chain.TypeBitcoin, 5))
+	assert.Equal(t, "m/44'/501'/5'/0'", service.GetDerivationPath(chain.TypeSolana, 5))
+	assert.Equal(t, "m/44'/60'/0'/0/0", service.GetBIP44Path(0))
+}
@@ -0,0 +1,44 @@
+package address
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github/chapool/go-wallet/internal/wallet/seed"
+)
+
+// ErrSeedLocked is returned when deriving an address while the seed is locked or not initialized
+var ErrSeedLocked = errors.New("seed is locked or not initialized")
+
+// Deriver derives addresses without handing the seed to the caller
+// In local signer mode addresses are derived from the seed in memory, in remote mode by the signer process
+type Deriver interface {
+	// DeriveAddress derives the address at path using the derivation scheme of the chain type
+	DeriveAddress(ctx context.Context, path string, chainType string) (string, error)
+}
+
+type seedDeriver struct {
+	seedManager    seed.Manager
+	addressService Service
+}
+
+// NewSeedDeriver creates a Deriver deriving addresses from the seed held by seedManager
+//
+//nolint:ireturn // Returning interface is intentional for dependency injection
+func NewSeedDeriver(seedManager seed.Manager, addressService Service) Deriver {
+	return &seedDeriver{
+		seedManager:    seedManager,
+		addressService: addressService,
+	}
+}
+
+// DeriveAddress derives the address at path from the seed in memory
+func (d *seedDeriver) DeriveAddress(ctx context.Context, path string, chainType string) (string, error) {
+	seed := d.seedManager.GetSeed()
+	if seed == nil {
+		return "", ErrSeedLocked
+	}
+	defer clear(seed)
+
+	return d.addressService.DeriveAddress(ctx, seed, path, chainType)
+}
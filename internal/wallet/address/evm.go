@@ -1,7 +1,6 @@
 package address
 
 import (
-	"context"
 	"crypto/ecdsa"
 	"fmt"
 
@@ -10,14 +9,10 @@ import (
 	"github.com/tyler-smith/go-bip32"
 )
 
-// DeriveAddress derives an EVM address from seed and BIP44 path
-func (s *service) DeriveAddress(ctx context.Context, seed []byte, path string, chainType string) (string, error) {
-	if chainType != "evm" {
-		return "", fmt.Errorf("unsupported chain type: %s", chainType)
-	}
-
+// deriveEVMAddress derives an EVM address from seed and BIP44 path
+func deriveEVMAddress(seed []byte, path string) (string, error) {
 	// Derive private key from seed and path
-	privateKey, err := s.DerivePrivateKey(ctx, seed, path, chainType)
+	privateKey, err := deriveEVMPrivateKey(seed, path)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to derive private key")
 	}
@@ -48,13 +43,9 @@ func (s *service) DeriveAddress(ctx context.Context, seed []byte, path string, c
 	return address.Hex(), nil
 }
 
-// DerivePrivateKey derives a private key from seed and BIP44 path
+// deriveEVMPrivateKey derives a secp256k1 private key from seed and BIP44 path
 // WARNING: Caller must clear the private key after use
-func (s *service) DerivePrivateKey(_ context.Context, seed []byte, path string, chainType string) ([]byte, error) {
-	if chainType != "evm" {
-		return nil, fmt.Errorf("unsupported chain type: %s", chainType)
-	}
-
+func deriveEVMPrivateKey(seed []byte, path string) ([]byte, error) {
 	// Create master key from seed
 	masterKey, err := bip32.NewMasterKey(seed)
 	if err != nil {
@@ -0,0 +1,99 @@
+package address
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github/chapool/go-wallet/internal/wallet/chain"
+)
+
+// DefaultCoinTypes are the SLIP-44 coin types used for chain types without a configured coin type.
+// Wallets created before coin types were configurable were derived with these coin types and account 0.
+var DefaultCoinTypes = map[string]uint32{
+	chain.TypeEVM:     60,
+	chain.TypeSolana:  501,
+	chain.TypeBitcoin: 0,
+}
+
+// fallbackCoinType is used for chain types neither configured nor in DefaultCoinTypes (EVM compatible derivation).
+const fallbackCoinType = 60
+
+// Config selects the coin type and account of the derivation path of each chain type.
+type Config struct {
+	CoinTypes map[string]uint32 // chain type -> SLIP-44 coin type, missing chain types use DefaultCoinTypes
+	Accounts  map[string]uint32 // chain type -> BIP44 account, missing chain types use account 0
+}
+
+// coinType returns the configured coin type of the chain type.
+func (c Config) coinType(chainType string) uint32 {
+	if coinType, ok := c.CoinTypes[chainType]; ok {
+		return coinType
+	}
+	if coinType, ok := DefaultCoinTypes[chainType]; ok {
+		return coinType
+	}
+
+	return fallbackCoinType
+}
+
+// derivationPath builds the derivation path of an address index for the chain type:
+//   - Solana: m/44'/{coin}'/{index}'/0' (SLIP-0010 ed25519 only supports hardened levels, the index takes the account level)
+//   - Bitcoin: m/84'/{coin}'/{account}'/0/{index} (BIP84 native segwit)
+//   - EVM and other secp256k1 chain types: m/44'/{coin}'/{account}'/0/{index}
+func (c Config) derivationPath(chainType string, addressIndex int) string {
+	coinType := c.coinType(chainType)
+	account := c.Accounts[chainType]
+
+	switch chainType {
+	case chain.TypeSolana:
+		return solanaDerivationPath(coinType, addressIndex)
+	case chain.TypeBitcoin:
+		return bitcoinDerivationPath(coinType, account, addressIndex)
+	default:
+		return fmt.Sprintf("m/44'/%d'/%d'/0/%d", coinType, account, addressIndex)
+	}
+}
+
+// CheckDerivationPaths compares the most recently created derived wallet of each chain type with the configured scheme.
+// Existing wallets keep the derivation path stored with them, but new wallets continue the shared address index,
+// so changing the coin type or account of a chain type that already has wallets is refused instead of silently
+// mixing two schemes.
+func (s *service) CheckDerivationPaths(ctx context.Context) error {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT DISTINCT ON (chain_type) chain_type, derivation_path, address_index
+		FROM wallets
+		WHERE derivation_path <> ''
+		ORDER BY chain_type, created_at DESC, id DESC
+	`)
+	if err != nil {
+		return errors.Wrap(err, "failed to query wallet derivation paths")
+	}
+	defer rows.Close()
+
+	var mismatches []string
+	for rows.Next() {
+		var (
+			chainType    string
+			storedPath   string
+			addressIndex int
+		)
+		if err := rows.Scan(&chainType, &storedPath, &addressIndex); err != nil {
+			return errors.Wrap(err, "failed to scan wallet derivation path")
+		}
+
+		if expected := s.GetDerivationPath(chainType, addressIndex); expected != storedPath {
+			mismatches = append(mismatches, fmt.Sprintf("%s: wallets use %s, configuration derives %s", chainType, storedPath, expected))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(err, "failed to iterate wallet derivation paths")
+	}
+
+	if len(mismatches) > 0 {
+		return errors.Errorf("derivation scheme does not match existing wallets (%s)", strings.Join(mismatches, "; "))
+	}
+
+	return nil
+}
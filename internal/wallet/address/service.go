@@ -11,18 +11,21 @@ import (
 	"github/chapool/go-wallet/internal/models"
 	"github/chapool/go-wallet/internal/util"
 	"github/chapool/go-wallet/internal/util/db"
+	"github/chapool/go-wallet/internal/wallet/chain"
 )
 
 type service struct {
-	db *sql.DB
+	db     *sql.DB
+	config Config
 }
 
 // NewService creates a new AddressService
 //
 //nolint:ireturn // Returning interface is intentional for dependency injection
-func NewService(db *sql.DB) (Service, error) {
+func NewService(db *sql.DB, config Config) (Service, error) {
 	return &service{
-		db: db,
+		db:     db,
+		config: config,
 	}, nil
 }
 
@@ -93,8 +96,45 @@ func (s *service) GetNextAddressIndex(ctx context.Context, chainType string, dev
 	return nextIndex, nil
 }
 
+// DeriveAddress derives an address from seed using the derivation scheme of the chain type
+func (s *service) DeriveAddress(_ context.Context, seed []byte, path string, chainType string) (string, error) {
+	switch chainType {
+	case chain.TypeEVM:
+		return deriveEVMAddress(seed, path)
+	case chain.TypeSolana:
+		return deriveSolanaAddress(seed, path)
+	case chain.TypeBitcoin:
+		return deriveBitcoinAddress(seed, path)
+	default:
+		return "", fmt.Errorf("unsupported chain type: %s", chainType)
+	}
+}
+
+// DerivePrivateKey derives a private key from seed using the derivation scheme of the chain type
+// WARNING: Caller must clear the private key after use
+func (s *service) DerivePrivateKey(_ context.Context, seed []byte, path string, chainType string) ([]byte, error) {
+	switch chainType {
+	case chain.TypeEVM:
+		return deriveEVMPrivateKey(seed, path)
+	case chain.TypeSolana:
+		return deriveSolanaPrivateKey(seed, path)
+	case chain.TypeBitcoin:
+		return deriveBitcoinPrivateKey(seed, path)
+	default:
+		return nil, fmt.Errorf("unsupported chain type: %s", chainType)
+	}
+}
+
+// GetDerivationPath gets the derivation path of an address index for the chain type,
+// using the configured coin type and account of the chain type
+func (s *service) GetDerivationPath(chainType string, addressIndex int) string {
+	return s.config.derivationPath(chainType, addressIndex)
+}
+
 // GetBIP44Path gets BIP44 path (fixed format for EVM chains)
 // Format: m/44'/60'/0'/0/{index}
+// The keystore verification address is always derived on this path, independent of the configured EVM coin type,
+// so the password check keeps working after the derivation configuration changes
 func (s *service) GetBIP44Path(addressIndex int) string {
 	return fmt.Sprintf("m/44'/60'/0'/0/%d", addressIndex)
 }
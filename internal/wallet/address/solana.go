@@ -0,0 +1,50 @@
+package address
+
+import (
+	"crypto/ed25519"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github/chapool/go-wallet/internal/wallet/solana"
+)
+
+// solanaDerivationPath gets the Solana derivation path (all levels hardened, as required by SLIP-0010 ed25519)
+// Format: m/44'/{coinType}'/{index}'/0', coin type 501 by default
+func solanaDerivationPath(coinType uint32, addressIndex int) string {
+	return fmt.Sprintf("m/44'/%d'/%d'/0'", coinType, addressIndex)
+}
+
+// deriveSolanaAddress derives a base58 encoded Solana address from seed and derivation path
+func deriveSolanaAddress(seed []byte, path string) (string, error) {
+	privateKey, err := deriveSolanaPrivateKey(seed, path)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to derive private key")
+	}
+
+	// Clear private key after use
+	defer func() {
+		for i := range privateKey {
+			privateKey[i] = 0
+		}
+	}()
+
+	keyPair := ed25519.NewKeyFromSeed(privateKey)
+	defer func() {
+		for i := range keyPair {
+			keyPair[i] = 0
+		}
+	}()
+
+	return solana.PublicKeyFromPrivateKey(keyPair).String(), nil
+}
+
+// deriveSolanaPrivateKey derives an ed25519 private key seed (32 bytes) from seed and derivation path
+// WARNING: Caller must clear the private key after use
+func deriveSolanaPrivateKey(seed []byte, path string) ([]byte, error) {
+	indices, err := parseBIP44Path(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse derivation path")
+	}
+
+	return solana.DeriveKey(seed, indices)
+}
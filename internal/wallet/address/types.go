@@ -8,12 +8,20 @@ type Service interface {
 	GetNextAddressIndex(ctx context.Context, chainType string, deviceName string) (int, error)
 
 	// DeriveAddress derives an address from seed (all EVM chains use same path, same address)
+	// Solana addresses are derived with SLIP-0010 ed25519 from the same seed, Bitcoin addresses are BIP84 P2WPKH
 	DeriveAddress(ctx context.Context, seed []byte, path string, chainType string) (string, error)
 
 	// DerivePrivateKey derives a private key from seed (all EVM chains use same path, same private key)
+	// For Solana the 32 byte ed25519 seed is returned
 	// WARNING: Private key should be cleared after use
 	DerivePrivateKey(ctx context.Context, seed []byte, path string, chainType string) ([]byte, error)
 
+	// GetDerivationPath gets the derivation path of an address index for the chain type
+	GetDerivationPath(chainType string, addressIndex int) string
+
 	// GetBIP44Path gets BIP44 path (fixed format for EVM chains)
 	GetBIP44Path(addressIndex int) string
+
+	// CheckDerivationPaths returns an error if existing wallets were derived with a different coin type or account than configured
+	CheckDerivationPaths(ctx context.Context) error
 }
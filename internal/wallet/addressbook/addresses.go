@@ -0,0 +1,421 @@
+package addressbook
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/bitcoin"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/notification"
+	"github/chapool/go-wallet/internal/wallet/signer"
+	"github/chapool/go-wallet/internal/wallet/solana"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+const (
+	// confirmationTokenBytes 确认 Token 的随机字节数
+	confirmationTokenBytes = 32
+	// maxLabelLength 地址备注最大长度
+	maxLabelLength = 100
+)
+
+// 白名单变更通知中的变更描述
+const (
+	changeAdded                = "added"
+	changeRemoved              = "removed"
+	changeWhitelistOnlyEnabled = "whitelist-only withdrawals enabled"
+	changeWhitelistOnlyDisable = "whitelist-only withdrawals disabled, effective at"
+)
+
+// withdrawAddressColumns withdraw_addresses 查询列，与 scanAddress 的顺序一致
+const withdrawAddressColumns = `id, user_id, chain_id, address, label, confirmed_at, available_at, ownership_verified_at, created_at`
+
+// ownershipMessageFormat 持有证明消息，绑定地址簿记录、链和地址，签名不能用于其他记录
+const ownershipMessageFormat = "I confirm that I own this address and register it as a withdraw address.\n\nAddress: %s\nChain ID: %d\nAddress book entry: %s"
+
+// rowScanner 兼容 *sql.Row 和 *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// AddAddress 登记提现地址，地址已登记但未确认时重新生成确认 Token（重新发送确认邮件）
+func (s *service) AddAddress(ctx context.Context, req *AddRequest) (*Address, string, error) {
+	if req.Label != nil && len(strings.TrimSpace(*req.Label)) > maxLabelLength {
+		return nil, "", errors.Wrapf(ErrInvalidAddress, "label must be at most %d characters", maxLabelLength)
+	}
+
+	chainType, err := s.chainType(ctx, req.ChainID)
+	if err != nil {
+		return nil, "", err
+	}
+	if !isValidAddress(chainType, req.Address) {
+		return nil, "", errors.Wrapf(ErrInvalidAddress, "invalid %s address %s", chainType, req.Address)
+	}
+
+	plaintext, err := util.GenerateRandomHexString(confirmationTokenBytes)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to generate confirmation token")
+	}
+
+	var label *string
+	if req.Label != nil {
+		trimmed := strings.TrimSpace(*req.Label)
+		label = &trimmed
+	}
+
+	address, err := scanAddress(s.db.QueryRowContext(ctx, `
+		INSERT INTO withdraw_addresses (user_id, chain_id, address, address_key, label, confirmation_token_hash, confirmation_expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id, chain_id, address_key) DO UPDATE SET
+			address = EXCLUDED.address,
+			label = EXCLUDED.label,
+			confirmation_token_hash = EXCLUDED.confirmation_token_hash,
+			confirmation_expires_at = EXCLUDED.confirmation_expires_at,
+			updated_at = NOW()
+		WHERE withdraw_addresses.confirmed_at IS NULL
+		RETURNING `+withdrawAddressColumns,
+		req.UserID, req.ChainID, req.Address, chain.NormalizeAddress(chainType, req.Address), label,
+		hashToken(plaintext), time.Now().Add(s.config.ConfirmationValidity),
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, "", ErrAddressExists
+		}
+		return nil, "", errors.Wrap(err, "failed to insert withdraw address")
+	}
+
+	return address, plaintext, nil
+}
+
+// ConfirmAddress 确认地址并开始冷静期，确认 Token 只能使用一次
+func (s *service) ConfirmAddress(ctx context.Context, userID string, token string) (*Address, error) {
+	now := time.Now()
+
+	address, err := scanAddress(s.db.QueryRowContext(ctx, `
+		UPDATE withdraw_addresses SET
+			confirmed_at = $3,
+			available_at = $4,
+			confirmation_token_hash = NULL,
+			confirmation_expires_at = NULL,
+			updated_at = NOW()
+		WHERE user_id = $1
+			AND confirmation_token_hash = $2
+			AND confirmation_expires_at > $3
+		RETURNING `+withdrawAddressColumns,
+		userID, hashToken(token), now, now.Add(s.config.CoolingPeriod),
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidConfirmationToken
+		}
+		return nil, errors.Wrap(err, "failed to confirm withdraw address")
+	}
+
+	s.notifyChanged(ctx, userID, changeAdded, address.Address)
+
+	return address, nil
+}
+
+// ListAddresses 查询用户的地址簿（按创建时间倒序）
+func (s *service) ListAddresses(ctx context.Context, userID string) ([]*Address, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+withdrawAddressColumns+`
+		FROM withdraw_addresses
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+	`, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query withdraw addresses")
+	}
+	defer rows.Close()
+
+	addresses := make([]*Address, 0)
+	for rows.Next() {
+		address, err := scanAddress(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan withdraw address")
+		}
+		addresses = append(addresses, address)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate withdraw addresses")
+	}
+
+	return addresses, nil
+}
+
+// DeleteAddress 删除地址，已确认的地址删除时发送白名单变更通知
+func (s *service) DeleteAddress(ctx context.Context, userID string, addressID string) error {
+	address, err := scanAddress(s.db.QueryRowContext(ctx, `
+		DELETE FROM withdraw_addresses
+		WHERE id = $1 AND user_id = $2
+		RETURNING `+withdrawAddressColumns,
+		addressID, userID,
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrAddressNotFound
+		}
+		return errors.Wrap(err, "failed to delete withdraw address")
+	}
+
+	if address.ConfirmedAt != nil {
+		s.notifyChanged(ctx, userID, changeRemoved, address.Address)
+	}
+
+	return nil
+}
+
+// GetSettings 获取用户的地址簿设置，未设置时返回关闭
+func (s *service) GetSettings(ctx context.Context, userID string) (*Settings, error) {
+	var (
+		settings      Settings
+		enforcedUntil sql.NullTime
+	)
+	err := s.db.QueryRowContext(ctx, `
+		SELECT whitelist_only, enforced_until
+		FROM withdraw_address_settings
+		WHERE user_id = $1
+	`, userID).Scan(&settings.WhitelistOnly, &enforcedUntil)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &Settings{}, nil
+		}
+		return nil, errors.Wrap(err, "failed to query withdraw address settings")
+	}
+
+	if enforcedUntil.Valid {
+		settings.EnforcedUntil = &enforcedUntil.Time
+	}
+
+	return &settings, nil
+}
+
+// UpdateSettings 开启立即生效；关闭时记录冷静期结束时间，冷静期内仍只允许提现到白名单地址
+func (s *service) UpdateSettings(ctx context.Context, userID string, whitelistOnly bool) (*Settings, error) {
+	current, err := s.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if current.WhitelistOnly == whitelistOnly {
+		return current, nil
+	}
+
+	settings := &Settings{WhitelistOnly: whitelistOnly}
+	if !whitelistOnly {
+		enforcedUntil := time.Now().Add(s.config.CoolingPeriod)
+		settings.EnforcedUntil = &enforcedUntil
+	}
+
+	if _, err := s.db.ExecContext(ctx, `
+		INSERT INTO withdraw_address_settings (user_id, whitelist_only, enforced_until)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET
+			whitelist_only = EXCLUDED.whitelist_only,
+			enforced_until = EXCLUDED.enforced_until,
+			updated_at = NOW()
+	`, userID, settings.WhitelistOnly, settings.EnforcedUntil); err != nil {
+		return nil, errors.Wrap(err, "failed to update withdraw address settings")
+	}
+
+	if whitelistOnly {
+		s.notifyChanged(ctx, userID, changeWhitelistOnlyEnabled, "")
+	} else {
+		s.notifyChanged(ctx, userID, changeWhitelistOnlyDisable, settings.EnforcedUntil.UTC().Format(time.RFC3339))
+	}
+
+	return settings, nil
+}
+
+// CheckWithdrawAddress 校验提现目标地址是否在用户地址簿中且已过冷静期（未开启仅限白名单提现时不校验）
+func (s *service) CheckWithdrawAddress(ctx context.Context, userID string, chainID int, chainType string, address string) error {
+	settings, err := s.GetSettings(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !settings.Enforced(time.Now()) {
+		return nil
+	}
+
+	var exists bool
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1
+			FROM withdraw_addresses
+			WHERE user_id = $1
+				AND chain_id = $2
+				AND address_key = $3
+				AND confirmed_at IS NOT NULL
+				AND available_at <= NOW()
+		)
+	`, userID, chainID, chain.NormalizeAddress(chainType, address)).Scan(&exists); err != nil {
+		return errors.Wrap(err, "failed to check withdraw address whitelist")
+	}
+	if !exists {
+		return ErrNotWhitelisted
+	}
+
+	return nil
+}
+
+// VerifyOwnership 校验地址对持有证明消息的签名，校验通过后记录持有证明时间
+func (s *service) VerifyOwnership(ctx context.Context, userID string, addressID string, signature []byte) (*Address, error) {
+	address, err := scanAddress(s.db.QueryRowContext(ctx, `
+		SELECT `+withdrawAddressColumns+`
+		FROM withdraw_addresses
+		WHERE id = $1 AND user_id = $2
+	`, addressID, userID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAddressNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get withdraw address")
+	}
+
+	chainType, err := s.chainType(ctx, address.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	if chainType != chain.TypeEVM {
+		return nil, errors.Wrapf(ErrInvalidAddress, "ownership verification is not supported for %s addresses", chainType)
+	}
+
+	// 地址有合约代码时按 EIP-1271 由合约校验签名，需要链的 RPC 客户端
+	var caller signer.ContractCaller
+	if s.clients != nil {
+		client, err := s.clients.GetClient(ctx, address.ChainID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get client for chain %d", address.ChainID)
+		}
+		caller = client
+	}
+
+	valid, err := signer.VerifyMessageSignature(ctx, caller, address.Address, []byte(OwnershipMessage(address)), signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to verify ownership signature")
+	}
+	if !valid {
+		return nil, ErrInvalidOwnershipSignature
+	}
+
+	address, err = scanAddress(s.db.QueryRowContext(ctx, `
+		UPDATE withdraw_addresses SET
+			ownership_verified_at = NOW(),
+			updated_at = NOW()
+		WHERE id = $1 AND user_id = $2
+		RETURNING `+withdrawAddressColumns,
+		addressID, userID,
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAddressNotFound
+		}
+		return nil, errors.Wrap(err, "failed to record withdraw address ownership")
+	}
+
+	return address, nil
+}
+
+// OwnershipMessage 地址持有证明需要签名的消息（personal_sign）
+func OwnershipMessage(address *Address) string {
+	return fmt.Sprintf(ownershipMessageFormat, address.Address, address.ChainID, address.ID)
+}
+
+// chainType 查询链类型，链不存在时返回 ErrInvalidAddress
+func (s *service) chainType(ctx context.Context, chainID int) (string, error) {
+	c, err := models.Chains(models.ChainWhere.ChainID.EQ(chainID)).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", errors.Wrapf(ErrInvalidAddress, "unknown chain %d", chainID)
+		}
+		return "", errors.Wrap(err, "failed to get chain")
+	}
+
+	return c.ChainType, nil
+}
+
+// notifyChanged 发送白名单变更安全通知
+func (s *service) notifyChanged(ctx context.Context, userID string, change string, toAddress string) {
+	if s.notificationService == nil {
+		return
+	}
+
+	s.notificationService.Notify(ctx, &notification.Event{
+		Type:   notification.EventWhitelistChanged,
+		UserID: userID,
+		Data: map[string]string{
+			"change":    change,
+			"toAddress": toAddress,
+		},
+	})
+}
+
+// isValidAddress 校验地址格式：EVM 为 0x 开头的 20 字节十六进制地址，Solana 为 base58 公钥，比特币为主网地址
+func isValidAddress(chainType string, address string) bool {
+	switch chainType {
+	case chain.TypeEVM:
+		return strings.HasPrefix(address, "0x") && common.IsHexAddress(address)
+	case chain.TypeSolana:
+		return solana.IsValidAddress(address)
+	case chain.TypeBitcoin:
+		return bitcoin.IsValidAddress(address)
+	default:
+		return false
+	}
+}
+
+// hashToken 计算确认 Token 明文的 SHA-256
+func hashToken(plaintext string) string {
+	sum := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(sum[:])
+}
+
+// scanAddress 扫描一行提现地址
+func scanAddress(row rowScanner) (*Address, error) {
+	var (
+		address             Address
+		label               sql.NullString
+		confirmedAt         sql.NullTime
+		availableAt         sql.NullTime
+		ownershipVerifiedAt sql.NullTime
+	)
+
+	if err := row.Scan(
+		&address.ID,
+		&address.UserID,
+		&address.ChainID,
+		&address.Address,
+		&label,
+		&confirmedAt,
+		&availableAt,
+		&ownershipVerifiedAt,
+		&address.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if label.Valid {
+		address.Label = &label.String
+	}
+	if confirmedAt.Valid {
+		address.ConfirmedAt = &confirmedAt.Time
+	}
+	if availableAt.Valid {
+		address.AvailableAt = &availableAt.Time
+	}
+	if ownershipVerifiedAt.Valid {
+		address.OwnershipVerifiedAt = &ownershipVerifiedAt.Time
+	}
+
+	return &address, nil
+}
@@ -0,0 +1,144 @@
+//nolint:ireturn // 返回接口类型是预期的设计
+package addressbook
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github/chapool/go-wallet/internal/wallet/notification"
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/pkg/errors"
+)
+
+// 地址状态
+const (
+	StatusPendingConfirmation = "pending_confirmation" // 等待邮件确认
+	StatusCooling             = "cooling"              // 已确认，冷静期内不能用于提现
+	StatusActive              = "active"               // 可用于提现
+)
+
+var (
+	// ErrInvalidAddress 地址参数不合法
+	ErrInvalidAddress = errors.New("invalid withdraw address")
+	// ErrAddressExists 地址已在地址簿中
+	ErrAddressExists = errors.New("withdraw address already exists")
+	// ErrAddressNotFound 地址不存在
+	ErrAddressNotFound = errors.New("withdraw address not found")
+	// ErrInvalidConfirmationToken 确认 Token 不存在、已使用或已过期
+	ErrInvalidConfirmationToken = errors.New("invalid or expired confirmation token")
+	// ErrNotWhitelisted 用户开启了仅限白名单提现，目标地址不在地址簿中或未生效
+	ErrNotWhitelisted = errors.New("withdraw address is not whitelisted")
+	// ErrInvalidOwnershipSignature 持有证明签名不是该地址对持有证明消息的有效签名
+	ErrInvalidOwnershipSignature = errors.New("invalid withdraw address ownership signature")
+)
+
+// Config 地址簿配置
+type Config struct {
+	// CoolingPeriod 地址确认后到可用于提现的冷静期，关闭仅限白名单提现也在冷静期后生效
+	CoolingPeriod time.Duration
+	// ConfirmationValidity 邮件确认链接的有效期
+	ConfirmationValidity time.Duration
+}
+
+// Service 用户提现地址簿服务接口
+// 用户登记提现地址并通过邮件确认，开启仅限白名单提现后只能提现到地址簿中已生效的地址
+type Service interface {
+	// AddAddress 登记提现地址，返回确认 Token 明文（只在创建时返回一次，用于发送确认邮件）
+	AddAddress(ctx context.Context, req *AddRequest) (*Address, string, error)
+
+	// ConfirmAddress 通过确认 Token 确认用户的地址，确认后开始冷静期
+	ConfirmAddress(ctx context.Context, userID string, token string) (*Address, error)
+
+	// ListAddresses 查询用户的地址簿
+	ListAddresses(ctx context.Context, userID string) ([]*Address, error)
+
+	// DeleteAddress 删除用户地址簿中的地址，立即生效
+	DeleteAddress(ctx context.Context, userID string, addressID string) error
+
+	// GetSettings 获取用户的地址簿设置
+	GetSettings(ctx context.Context, userID string) (*Settings, error)
+
+	// UpdateSettings 开启或关闭仅限白名单提现：开启立即生效，关闭在冷静期后生效
+	UpdateSettings(ctx context.Context, userID string, whitelistOnly bool) (*Settings, error)
+
+	// CheckWithdrawAddress 用户开启了仅限白名单提现时，校验目标地址在地址簿中且已过冷静期，否则返回 ErrNotWhitelisted
+	CheckWithdrawAddress(ctx context.Context, userID string, chainID int, chainType string, address string) error
+
+	// VerifyOwnership 校验地址对 OwnershipMessage 的 personal_sign 签名并记录持有证明，仅支持 EVM 地址
+	// EOA 通过 ECDSA 恢复签名者校验，合约钱包通过 EIP-1271 isValidSignature 校验
+	VerifyOwnership(ctx context.Context, userID string, addressID string, signature []byte) (*Address, error)
+}
+
+// ClientProvider 提供链的 RPC 客户端，由扫描服务实现，用于校验合约钱包的签名
+type ClientProvider interface {
+	GetClient(ctx context.Context, chainID int) (*scan.RPCClient, error)
+}
+
+// AddRequest 登记提现地址请求
+type AddRequest struct {
+	UserID  string
+	ChainID int
+	Address string
+	Label   *string
+}
+
+// Address 地址簿中的提现地址
+type Address struct {
+	ID          string
+	UserID      string
+	ChainID     int
+	Address     string
+	Label       *string
+	ConfirmedAt *time.Time
+	AvailableAt *time.Time
+	// OwnershipVerifiedAt 地址持有证明签名校验通过的时间，未证明时为 nil
+	OwnershipVerifiedAt *time.Time
+	CreatedAt           time.Time
+}
+
+// Status 地址在 now 时的状态
+func (a *Address) Status(now time.Time) string {
+	switch {
+	case a.ConfirmedAt == nil:
+		return StatusPendingConfirmation
+	case a.AvailableAt != nil && now.Before(*a.AvailableAt):
+		return StatusCooling
+	default:
+		return StatusActive
+	}
+}
+
+// Settings 用户地址簿设置
+type Settings struct {
+	// WhitelistOnly 用户选择的设置
+	WhitelistOnly bool
+	// EnforcedUntil 关闭仅限白名单提现后，冷静期结束前仍按开启处理
+	EnforcedUntil *time.Time
+}
+
+// Enforced 在 now 时是否只允许提现到白名单地址
+func (s *Settings) Enforced(now time.Time) bool {
+	return s.WhitelistOnly || (s.EnforcedUntil != nil && now.Before(*s.EnforcedUntil))
+}
+
+type service struct {
+	db                  *sql.DB
+	config              Config
+	notificationService notification.Service
+	clients             ClientProvider
+}
+
+// NewService 创建提现地址簿服务，notificationService 为空时不发送白名单变更通知
+// clients 为空时持有证明只支持 EOA 签名
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(db *sql.DB, config Config, notificationService notification.Service, clients ClientProvider) Service {
+	return &service{
+		db:                  db,
+		config:              config,
+		notificationService: notificationService,
+		clients:             clients,
+	}
+}
@@ -0,0 +1,50 @@
+package addressbook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddressStatus(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	assert.Equal(t, StatusPendingConfirmation, (&Address{}).Status(now))
+	assert.Equal(t, StatusCooling, (&Address{ConfirmedAt: &past, AvailableAt: &future}).Status(now))
+	assert.Equal(t, StatusActive, (&Address{ConfirmedAt: &past, AvailableAt: &past}).Status(now))
+}
+
+func TestSettingsEnforced(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	assert.False(t, (&Settings{}).Enforced(now))
+	assert.True(t, (&Settings{WhitelistOnly: true}).Enforced(now))
+	assert.True(t, (&Settings{EnforcedUntil: &future}).Enforced(now), "disabling takes effect after the cooling period")
+	assert.False(t, (&Settings{EnforcedUntil: &past}).Enforced(now))
+}
+
+func TestIsValidAddress(t *testing.T) {
+	assert.True(t, isValidAddress("evm", "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb0"))
+	assert.False(t, isValidAddress("evm", "742d35Cc6634C0532925a3b844Bc9e7595f0bEb0"))
+	assert.False(t, isValidAddress("evm", "0x123"))
+	assert.False(t, isValidAddress("unknown", "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb0"))
+}
+
+func TestOwnershipMessage(t *testing.T) {
+	address := &Address{ID: "3ea3ea2a-26be-4aaa-9e65-4a1f2f8fd2c1", ChainID: 56, Address: "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb0"}
+	message := OwnershipMessage(address)
+
+	assert.Contains(t, message, "Address: 0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb0")
+	assert.Contains(t, message, "Chain ID: 56")
+	assert.Contains(t, message, "Address book entry: 3ea3ea2a-26be-4aaa-9e65-4a1f2f8fd2c1")
+
+	// 消息绑定地址簿记录，同一地址的其他记录需要重新签名
+	other := *address
+	other.ID = "9d7b4f5c-8f3e-4f0e-a9c2-1b6f0e2d7a11"
+	assert.NotEqual(t, message, OwnershipMessage(&other))
+}
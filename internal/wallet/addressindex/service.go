@@ -0,0 +1,298 @@
+//nolint:ireturn // 返回接口类型是预期的设计
+package addressindex
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/address"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultGapLimit 默认连续未使用地址数，达到后停止扫描
+const DefaultGapLimit = 20
+
+// Config 地址索引恢复配置
+type Config struct {
+	GapLimit int // 连续未使用的派生地址达到该数量后停止扫描
+}
+
+// Service 地址索引恢复服务接口
+// 从旧备份恢复 wallets 表后，address_indexes 的当前索引可能落后于已分配出去的地址，新建钱包会复用已被其他用户使用的地址。
+// 恢复时从已知的最大索引之后逐个派生地址并在链上检查是否使用过（gap limit），
+// 把 address_indexes 的当前索引提升到最大已使用的索引，只提升不降低
+type Service interface {
+	// Recover 按链类型扫描并同步所有设备的地址索引，dryRun 时只返回结果不修改
+	Recover(ctx context.Context, dryRun bool) (*Report, error)
+}
+
+// Report 地址索引恢复结果
+type Report struct {
+	ChainTypes []*ChainTypeResult
+	DryRun     bool
+}
+
+// ChainTypeResult 一个链类型的恢复结果，同一链类型的所有链和设备共用派生路径
+type ChainTypeResult struct {
+	ChainType string
+	// WalletIndex wallets 表中该链类型最大的地址索引，没有钱包时为空
+	WalletIndex *int
+	// ScanFrom / ScanTo 链上检查的索引范围（包含），未检查链上记录时为空
+	ScanFrom *int
+	ScanTo   *int
+	// OnChainChecked 是否检查了链上记录（Bitcoin 节点不支持按地址查询，只同步到 wallets 表的最大索引）
+	OnChainChecked bool
+	// UsedIndex 链上检查到已使用的最大索引，没有时为空
+	UsedIndex *int
+	// TargetIndex 恢复后的最小当前索引（WalletIndex 和 UsedIndex 的较大值），都为空时为空
+	TargetIndex *int
+	Indexes     []*IndexResult
+}
+
+// IndexResult 一个设备的地址索引恢复结果
+type IndexResult struct {
+	DeviceName   *string
+	CurrentIndex *int // 恢复前的当前索引，没有记录时为空
+	Index        int  // 恢复后的当前索引
+	Updated      bool
+}
+
+// usedChecker 检查派生索引的地址在链上是否使用过
+type usedChecker func(ctx context.Context, index int) (bool, error)
+
+type service struct {
+	db             *sql.DB
+	config         Config
+	addressService address.Service
+	deriver        address.Deriver
+	chainService   chain.Service
+	scanService    scan.Service
+}
+
+// NewService 创建地址索引恢复服务
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(
+	db *sql.DB,
+	config Config,
+	addressService address.Service,
+	deriver address.Deriver,
+	chainService chain.Service,
+	scanService scan.Service,
+) Service {
+	if config.GapLimit <= 0 {
+		config.GapLimit = DefaultGapLimit
+	}
+
+	return &service{
+		db:             db,
+		config:         config,
+		addressService: addressService,
+		deriver:        deriver,
+		chainService:   chainService,
+		scanService:    scanService,
+	}
+}
+
+// Recover 按链类型扫描并同步所有设备的地址索引
+func (s *service) Recover(ctx context.Context, dryRun bool) (*Report, error) {
+	chains, err := s.chainService.GetActiveChains(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get active chains")
+	}
+
+	// 同一链类型的所有链共用派生路径，按链类型扫描
+	chainsByType := make(map[string][]*models.Chain)
+	chainTypes := make([]string, 0)
+	for _, c := range chains {
+		if _, ok := chainsByType[c.ChainType]; !ok {
+			chainTypes = append(chainTypes, c.ChainType)
+		}
+		chainsByType[c.ChainType] = append(chainsByType[c.ChainType], c)
+	}
+	slices.Sort(chainTypes)
+
+	report := &Report{
+		ChainTypes: make([]*ChainTypeResult, 0, len(chainTypes)),
+		DryRun:     dryRun,
+	}
+	for _, chainType := range chainTypes {
+		result, err := s.recoverChainType(ctx, chainType, chainsByType[chainType], dryRun)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to recover address index of chain type %s", chainType)
+		}
+		report.ChainTypes = append(report.ChainTypes, result)
+	}
+
+	return report, nil
+}
+
+// recoverChainType 扫描一个链类型已使用的最大索引，并提升该链类型所有设备的当前索引
+func (s *service) recoverChainType(ctx context.Context, chainType string, chains []*models.Chain, dryRun bool) (*ChainTypeResult, error) {
+	result := &ChainTypeResult{ChainType: chainType}
+
+	var walletIndex sql.NullInt64
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT MAX(address_index) FROM wallets WHERE chain_type = $1
+	`, chainType).Scan(&walletIndex); err != nil {
+		return nil, errors.Wrap(err, "failed to get highest wallet address index")
+	}
+	if walletIndex.Valid {
+		result.WalletIndex = ptr(int(walletIndex.Int64))
+	}
+
+	indexes, err := models.AddressIndexes(
+		models.AddressIndexWhere.ChainType.EQ(chainType),
+		qm.OrderBy(models.AddressIndexColumns.DeviceName+" ASC NULLS FIRST"),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get address indexes")
+	}
+
+	// 从已知的最大索引之后开始检查，之前的索引已在 wallets 表或 address_indexes 中
+	known := -1
+	if result.WalletIndex != nil {
+		known = *result.WalletIndex
+	}
+	for _, index := range indexes {
+		known = max(known, index.CurrentIndex)
+	}
+
+	isUsed := s.usedChecker(ctx, chainType, chains)
+	if isUsed != nil {
+		result.OnChainChecked = true
+		usedIndex, scanTo, err := highestUsedIndex(ctx, known+1, s.config.GapLimit, isUsed)
+		if err != nil {
+			return nil, err
+		}
+		result.ScanFrom = ptr(known + 1)
+		result.ScanTo = &scanTo
+		result.UsedIndex = usedIndex
+	}
+
+	result.TargetIndex = result.WalletIndex
+	if result.UsedIndex != nil && (result.TargetIndex == nil || *result.UsedIndex > *result.TargetIndex) {
+		result.TargetIndex = result.UsedIndex
+	}
+
+	for _, index := range indexes {
+		item := &IndexResult{
+			DeviceName:   index.DeviceName.Ptr(),
+			CurrentIndex: ptr(index.CurrentIndex),
+			Index:        index.CurrentIndex,
+		}
+		if result.TargetIndex != nil && index.CurrentIndex < *result.TargetIndex {
+			item.Index = *result.TargetIndex
+			item.Updated = true
+		}
+		result.Indexes = append(result.Indexes, item)
+	}
+
+	// 用户钱包使用没有设备名的索引，没有记录但已有地址被使用时补建
+	hasDefault := slices.ContainsFunc(indexes, func(index *models.AddressIndex) bool { return !index.DeviceName.Valid })
+	if !hasDefault && result.TargetIndex != nil {
+		result.Indexes = append([]*IndexResult{{Index: *result.TargetIndex, Updated: true}}, result.Indexes...)
+	}
+
+	if dryRun || result.TargetIndex == nil {
+		return result, nil
+	}
+
+	for _, item := range result.Indexes {
+		if !item.Updated {
+			continue
+		}
+		if err := s.raiseIndex(ctx, chainType, item, *result.TargetIndex); err != nil {
+			return nil, err
+		}
+
+		log.Warn().
+			Str("chain_type", chainType).
+			Str("device_name", deref(item.DeviceName)).
+			Int("previous_index", derefInt(item.CurrentIndex, -1)).
+			Int("index", item.Index).
+			Msg("Address index raised to the highest used address index")
+	}
+
+	return result, nil
+}
+
+// raiseIndex 提升当前索引，只提升不降低，恢复期间新分配的索引不受影响
+func (s *service) raiseIndex(ctx context.Context, chainType string, item *IndexResult, target int) error {
+	if item.CurrentIndex == nil {
+		if _, err := s.db.ExecContext(ctx, `
+			INSERT INTO address_indexes (chain_type, device_name, current_index)
+			SELECT $1, NULL, $2
+			WHERE NOT EXISTS (SELECT 1 FROM address_indexes WHERE chain_type = $1 AND device_name IS NULL)
+		`, chainType, target); err != nil {
+			return errors.Wrap(err, "failed to create address index")
+		}
+		return nil
+	}
+
+	if _, err := s.db.ExecContext(ctx, `
+		UPDATE address_indexes
+		SET current_index = GREATEST(current_index, $3), updated_at = NOW()
+		WHERE chain_type = $1 AND device_name IS NOT DISTINCT FROM $2
+	`, chainType, item.DeviceName, target); err != nil {
+		return errors.Wrap(err, "failed to raise address index")
+	}
+
+	return nil
+}
+
+// highestUsedIndex 从 from 开始逐个检查索引，连续 gapLimit 个未使用后停止
+// 返回已使用的最大索引（没有时为空）和检查到的最后一个索引
+func highestUsedIndex(ctx context.Context, from int, gapLimit int, isUsed usedChecker) (*int, int, error) {
+	var (
+		used *int
+		gap  int
+	)
+
+	index := from
+	for ; gap < gapLimit; index++ {
+		if err := ctx.Err(); err != nil {
+			return nil, 0, errors.Wrap(err, "address index scan canceled")
+		}
+
+		ok, err := isUsed(ctx, index)
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "failed to check address index %d", index)
+		}
+		if ok {
+			used = ptr(index)
+			gap = 0
+			continue
+		}
+		gap++
+	}
+
+	return used, index - 1, nil
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+func deref(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+func derefInt(value *int, fallback int) int {
+	if value == nil {
+		return fallback
+	}
+
+	return *value
+}
@@ -0,0 +1,57 @@
+package addressindex
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHighestUsedIndex(t *testing.T) {
+	usedIndexes := func(indexes ...int) usedChecker {
+		used := make(map[int]bool, len(indexes))
+		for _, index := range indexes {
+			used[index] = true
+		}
+		return func(_ context.Context, index int) (bool, error) {
+			return used[index], nil
+		}
+	}
+
+	tests := []struct {
+		name     string
+		from     int
+		gapLimit int
+		used     []int
+		wantUsed *int
+		wantTo   int
+	}{
+		{name: "nothing used", from: 10, gapLimit: 5, wantTo: 14},
+		{name: "used within the gap limit", from: 10, gapLimit: 5, used: []int{12, 16}, wantUsed: ptr(16), wantTo: 21},
+		{name: "used after the gap limit is not found", from: 10, gapLimit: 5, used: []int{11, 17}, wantUsed: ptr(11), wantTo: 16},
+		{name: "from the first index", from: 0, gapLimit: 3, used: []int{0, 1, 2}, wantUsed: ptr(2), wantTo: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			used, scanTo, err := highestUsedIndex(context.Background(), tt.from, tt.gapLimit, usedIndexes(tt.used...))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantUsed, used)
+			assert.Equal(t, tt.wantTo, scanTo)
+		})
+	}
+}
+
+func TestHighestUsedIndexError(t *testing.T) {
+	rpcErr := errors.New("rpc unavailable")
+	_, _, err := highestUsedIndex(context.Background(), 0, 20, func(_ context.Context, index int) (bool, error) {
+		if index == 3 {
+			return false, rpcErr
+		}
+		return false, nil
+	})
+	require.ErrorIs(t, err, rpcErr)
+	assert.Contains(t, err.Error(), "address index 3")
+}
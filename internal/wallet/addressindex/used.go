@@ -0,0 +1,127 @@
+package addressindex
+
+import (
+	"context"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/solana"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// usedChecker 返回链类型的链上使用检查，不支持按地址查询的链类型（Bitcoin）返回 nil
+func (s *service) usedChecker(ctx context.Context, chainType string, chains []*models.Chain) usedChecker {
+	derive := func(ctx context.Context, index int) (string, error) {
+		return s.deriver.DeriveAddress(ctx, s.addressService.GetDerivationPath(chainType, index), chainType)
+	}
+
+	switch chainType {
+	case chain.TypeEVM:
+		return func(ctx context.Context, index int) (bool, error) {
+			addr, err := derive(ctx, index)
+			if err != nil {
+				return false, err
+			}
+			for _, c := range chains {
+				used, err := s.evmAddressUsed(ctx, c.ChainID, common.HexToAddress(addr))
+				if err != nil {
+					return false, errors.Wrapf(err, "failed to check address on chain %d", c.ChainID)
+				}
+				if used {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+	case chain.TypeSolana:
+		return func(ctx context.Context, index int) (bool, error) {
+			addr, err := derive(ctx, index)
+			if err != nil {
+				return false, err
+			}
+			publicKey, err := solana.ParsePublicKey(addr)
+			if err != nil {
+				return false, errors.Wrap(err, "failed to parse derived address")
+			}
+			for _, c := range chains {
+				client, err := s.scanService.GetSolanaClient(ctx, c.ChainID)
+				if err != nil {
+					return false, errors.Wrapf(err, "failed to get RPC client of chain %d", c.ChainID)
+				}
+				used, err := client.HasTransactions(ctx, publicKey)
+				if err != nil {
+					return false, errors.Wrapf(err, "failed to check address on chain %d", c.ChainID)
+				}
+				if used {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+	default:
+		return nil
+	}
+}
+
+// evmAddressUsed 地址发送过交易（nonce > 0），或持有原生代币或任一启用的 ERC20 代币时视为已使用
+// 只收到过 ERC20 且已被归集的地址 nonce 一定大于 0（归集由该地址发送）
+func (s *service) evmAddressUsed(ctx context.Context, chainID int, addr common.Address) (bool, error) {
+	client, err := s.scanService.GetClient(ctx, chainID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get RPC client")
+	}
+
+	nonce, err := client.NonceAt(ctx, addr)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get nonce")
+	}
+	if nonce > 0 {
+		return true, nil
+	}
+
+	balance, err := client.BalanceAt(ctx, addr)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get balance")
+	}
+	if balance.Sign() > 0 {
+		return true, nil
+	}
+
+	tokens, err := models.Tokens(
+		models.TokenWhere.ChainID.EQ(chainID),
+		models.TokenWhere.IsActive.EQ(true),
+		models.TokenWhere.IsNative.EQ(false),
+	).All(ctx, s.db)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get active tokens")
+	}
+
+	queries := make([]scan.TokenBalanceQuery, 0, len(tokens))
+	for _, token := range tokens {
+		if !token.TokenAddress.Valid || !common.IsHexAddress(token.TokenAddress.String) {
+			continue
+		}
+		queries = append(queries, scan.TokenBalanceQuery{
+			Token:   common.HexToAddress(token.TokenAddress.String),
+			Account: addr,
+		})
+	}
+
+	balances, errs, err := client.BatchTokenBalances(ctx, queries)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get token balances")
+	}
+	for i, tokenBalance := range balances {
+		if errs[i] != nil {
+			return false, errors.Wrap(errs[i], "failed to get token balance")
+		}
+		if tokenBalance != nil && tokenBalance.Sign() > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,207 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github/chapool/go-wallet/internal/data/dto"
+	"github/chapool/go-wallet/internal/mailer"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// 告警级别
+const (
+	SeverityInfo     = "info"
+	SeverityWarning  = "warning"
+	SeverityCritical = "critical"
+)
+
+// 告警类型
+const (
+	TypeChainHalted     = "chain_halted"      // 所有 RPC 节点返回的最新区块长时间不变
+	TypeChainRecovered  = "chain_recovered"   // 停滞后重新出块
+	TypeRPCNodeStuck    = "rpc_node_stuck"    // 单个 RPC 节点卡住，已切换到其他节点
+	TypeChainIDMismatch = "chain_id_mismatch" // RPC 节点返回的链 ID 与配置不一致，该链停止服务
+
+	TypeHotWalletLowBalance   = "hot_wallet_low_balance"  // 热钱包余额低于配置的最低余额
+	TypeHotWalletInsufficient = "hot_wallet_insufficient" // 热钱包余额不足以支付待发送的提现
+	TypeHotWalletRecovered    = "hot_wallet_recovered"    // 热钱包余额恢复正常
+
+	TypeGasSpike      = "gas_spike"      // baseFee 超过上限，暂停该链的自动操作
+	TypeGasNormalized = "gas_normalized" // baseFee 回落，恢复该链的自动操作
+
+	TypeDepositQuarantined  = "deposit_quarantined"  // 充值来源地址命中合规筛查名单，资金已隔离
+	TypeQuarantineEscalated = "quarantine_escalated" // 隔离案件升级处理
+	TypeQuarantineResolved  = "quarantine_resolved"  // 隔离案件已释放入账或退回
+	TypeWithdrawBlocked     = "withdraw_blocked"     // 提现目标地址或代币命中合规筛查名单，提现请求已拦截
+
+	TypeSystemWalletMismatch          = "system_wallet_mismatch"           // 系统钱包的派生路径派生不出存储的地址，签名会失败
+	TypeSystemWalletIndexInconsistent = "system_wallet_index_inconsistent" // 系统钱包的地址索引与派生路径或 address_indexes 不一致
+	TypeSystemWalletRecovered         = "system_wallet_recovered"          // 系统钱包派生校验恢复正常
+
+	TypeFrozenCreditsEscalated = "frozen_credits_escalated" // 提现失败或停滞，冻结资金需要管理员处理
+
+	TypeScanFailureDead = "scan_failure_dead" // 交易分析重试次数用尽，交易中的充值可能未入账
+
+	TypeScanLagWarning   = "scan_lag_warning"   // 扫描器的时间加权平均延迟在统计窗口内超过告警阈值
+	TypeScanLagCritical  = "scan_lag_critical"  // 扫描器的时间加权平均延迟在统计窗口内超过严重阈值
+	TypeScanLagRecovered = "scan_lag_recovered" // 扫描延迟回落到阈值以下
+)
+
+// webhookTimeout Webhook 请求超时时间
+const webhookTimeout = 10 * time.Second
+
+// Alert 告警
+type Alert struct {
+	Type     string         `json:"type"`
+	Severity string         `json:"severity"`
+	ChainID  int            `json:"chain_id,omitempty"`
+	Message  string         `json:"message"`
+	Fields   map[string]any `json:"fields,omitempty"`
+	Time     time.Time      `json:"time"`
+}
+
+// Notifier 告警通知
+type Notifier interface {
+	Notify(ctx context.Context, alert *Alert) error
+}
+
+// NewNotifier 创建告警通知：始终写日志，配置了 webhookURL 时同时以 JSON POST 到 Webhook，
+// 配置了 mailer 和 emailRecipients 时同时发送邮件
+//
+//nolint:ireturn
+func NewNotifier(webhookURL string, mailer *mailer.Mailer, emailRecipients []string) Notifier {
+	notifiers := multiNotifier{logNotifier{}}
+	if webhookURL != "" {
+		notifiers = append(notifiers, &webhookNotifier{
+			url:    webhookURL,
+			client: &http.Client{Timeout: webhookTimeout},
+		})
+	}
+	if mailer != nil && len(emailRecipients) > 0 {
+		notifiers = append(notifiers, &emailNotifier{
+			mailer:     mailer,
+			recipients: emailRecipients,
+		})
+	}
+
+	return notifiers
+}
+
+// multiNotifier 依次发送到所有通知渠道，单个渠道失败不影响其他渠道
+type multiNotifier []Notifier
+
+func (m multiNotifier) Notify(ctx context.Context, alert *Alert) error {
+	if alert.Time.IsZero() {
+		alert.Time = time.Now()
+	}
+
+	var firstErr error
+	for _, n := range m {
+		if err := n.Notify(ctx, alert); err != nil {
+			log.Error().Err(err).Str("alert_type", alert.Type).Int("chain_id", alert.ChainID).Msg("Failed to send alert")
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	return firstErr
+}
+
+// logNotifier 将告警写入日志（带 alert 字段便于日志系统过滤）
+type logNotifier struct{}
+
+func (logNotifier) Notify(_ context.Context, alert *Alert) error {
+	var event *zerolog.Event
+	switch alert.Severity {
+	case SeverityCritical:
+		event = log.Error()
+	case SeverityWarning:
+		event = log.Warn()
+	default:
+		event = log.Info()
+	}
+
+	event.
+		Bool("alert", true).
+		Str("alert_type", alert.Type).
+		Str("severity", alert.Severity).
+		Int("chain_id", alert.ChainID).
+		Fields(alert.Fields).
+		Msg(alert.Message)
+
+	return nil
+}
+
+// webhookNotifier 将告警以 JSON POST 到 Webhook
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func (w *webhookNotifier) Notify(ctx context.Context, alert *Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal alert")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to create alert webhook request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send alert webhook")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("alert webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// emailNotifier 将告警通过邮件发送给运维人员
+type emailNotifier struct {
+	mailer     *mailer.Mailer
+	recipients []string
+}
+
+func (e *emailNotifier) Notify(ctx context.Context, alert *Alert) error {
+	fields := make(map[string]string, len(alert.Fields)+1)
+	if alert.ChainID != 0 {
+		fields["chain_id"] = fmt.Sprint(alert.ChainID)
+	}
+	for key, value := range alert.Fields {
+		fields[key] = fmt.Sprint(value)
+	}
+
+	subject := fmt.Sprintf("[%s] %s", alert.Severity, alert.Type)
+	if alert.ChainID != 0 {
+		subject = fmt.Sprintf("%s (chain %d)", subject, alert.ChainID)
+	}
+
+	if err := e.mailer.SendAlert(ctx, e.recipients, dto.AlertNotificationPayload{
+		Subject:  subject,
+		Type:     alert.Type,
+		Severity: alert.Severity,
+		Message:  alert.Message,
+		Time:     alert.Time.UTC().Format(time.RFC3339),
+		Fields:   fields,
+	}); err != nil {
+		return errors.Wrap(err, "failed to send alert email")
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package allowance
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/signer"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// gasLimitMarginPercent 预估 gas 的余量（百分比）
+	gasLimitMarginPercent = 20
+	// abiWordSize ABI 编码中每个字的字节数
+	abiWordSize = 32
+)
+
+// erc20ApproveMethodID approve(address,uint256) 方法选择器
+var erc20ApproveMethodID = common.FromHex("095ea7b3")
+
+// RevokeAllowance 由热钱包发送 approve(spender, 0) 撤销授权
+// 交易广播后记录到 token_approval_revokes，上链后扫描器记录额度为 0 的 Approval 事件，授权不再出现在列表中
+// 上一次撤销交易已上链或仍在节点交易池中时返回 ErrRevokePending，交易丢失时可以重新撤销
+func (s *service) RevokeAllowance(ctx context.Context, allowanceID string, adminUserID string) (*Allowance, error) {
+	allowance, err := s.getAllowance(ctx, allowanceID)
+	if err != nil {
+		return nil, err
+	}
+	if allowance.Amount == "0" {
+		return nil, ErrAllowanceRevoked
+	}
+
+	client, err := s.scanService.GetClient(ctx, allowance.ChainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get RPC client")
+	}
+
+	if allowance.RevokeTxHash != nil {
+		pending, err := revokePending(ctx, client, common.HexToHash(*allowance.RevokeTxHash))
+		if err != nil {
+			return nil, err
+		}
+		if pending {
+			return nil, ErrRevokePending
+		}
+	}
+
+	hotWallet, err := models.FindWallet(ctx, s.db, allowance.WalletID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get hot wallet")
+	}
+
+	txHash, err := s.sendRevoke(ctx, client, allowance, hotWallet)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestedAt time.Time
+	if err := s.db.QueryRowContext(ctx, `
+		INSERT INTO token_approval_revokes (approval_id, tx_hash, requested_by)
+		VALUES ($1, $2, $3)
+		RETURNING created_at
+	`, allowance.ID, txHash, adminUserID).Scan(&requestedAt); err != nil {
+		// 交易已广播，记录失败不影响撤销，上链后授权同样会从列表中消失
+		log.Error().
+			Err(err).
+			Str("allowance_id", allowance.ID).
+			Str("tx_hash", txHash).
+			Msg("Failed to record allowance revoke transaction")
+		requestedAt = time.Now()
+	}
+
+	allowance.RevokeTxHash = &txHash
+	allowance.RevokeRequestedAt = &requestedAt
+
+	log.Info().
+		Int("chain_id", allowance.ChainID).
+		Str("allowance_id", allowance.ID).
+		Str("owner_addr", allowance.OwnerAddress).
+		Str("token_addr", allowance.TokenAddress).
+		Str("spender_addr", allowance.SpenderAddress).
+		Str("tx_hash", txHash).
+		Str("admin_user_id", adminUserID).
+		Msg("Allowance revoke transaction broadcast")
+
+	return allowance, nil
+}
+
+// revokePending 检查上一次撤销交易：已成功上链（等待扫描）或仍在交易池中时返回 true，回滚或丢失时返回 false
+func revokePending(ctx context.Context, client *scan.RPCClient, txHash common.Hash) (bool, error) {
+	receipt, err := client.GetTransactionReceipt(ctx, txHash)
+	if err == nil {
+		return receipt.Status == types.ReceiptStatusSuccessful, nil
+	}
+	if !errors.Is(err, ethereum.NotFound) {
+		return false, errors.Wrap(err, "failed to get revoke transaction receipt")
+	}
+
+	_, pending, err := client.GetTransactionByHash(ctx, txHash)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "failed to get revoke transaction")
+	}
+
+	return pending, nil
+}
+
+// sendRevoke 模拟、签名并广播 approve(spender, 0)，返回交易哈希
+func (s *service) sendRevoke(ctx context.Context, client *scan.RPCClient, allowance *Allowance, hotWallet *models.Wallet) (string, error) {
+	from := common.HexToAddress(hotWallet.Address)
+	token := common.HexToAddress(allowance.TokenAddress)
+	data := approveData(common.HexToAddress(allowance.SpenderAddress))
+
+	// 模拟调用：代币合约拒绝撤销时交易会回滚，不占用 nonce
+	gasLimit, err := client.EstimateGas(ctx, ethereum.CallMsg{From: from, To: &token, Data: data})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to estimate gas for revoke")
+	}
+	gasLimit += gasLimit * gasLimitMarginPercent / 100
+
+	fees, err := client.SuggestGasFees(ctx, s.config.BaseFeeMultiplier, slices.Contains(s.config.LegacyTxChainIDs, allowance.ChainID))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to suggest gas fees")
+	}
+
+	// gas 价格超过链的上限时不发送
+	if err := s.gasPriceCap.Check(ctx, allowance.ChainID, fees); err != nil {
+		return "", errors.Wrap(err, "gas price cap")
+	}
+
+	// 与提现共用热钱包的 nonce 分配
+	nonce, err := s.hotWalletService.GetNextNonce(ctx, strings.ToLower(hotWallet.Address), hotWallet.ChainID)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to reserve nonce")
+	}
+
+	maxFeePerGas, maxPriorityFeePerGas, gasPrice := fees.SignFields()
+	signResp, err := s.signerService.SignEVMTransaction(ctx, &signer.SignEVMRequest{
+		ChainID:              int64(allowance.ChainID),
+		To:                   token.Hex(),
+		Value:                "0",
+		GasLimit:             gasLimit,
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+		GasPrice:             gasPrice,
+		//nolint:gosec // Nonce is guaranteed to be positive and fit in uint64
+		Nonce:          uint64(nonce),
+		Data:           data,
+		FromAddress:    from.Hex(),
+		DerivationPath: hotWallet.DerivationPath,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign revoke transaction")
+	}
+
+	txObj := new(types.Transaction)
+	if err := txObj.UnmarshalBinary(signResp.RawTransaction); err != nil {
+		return "", errors.Wrap(err, "failed to decode signed revoke transaction")
+	}
+
+	if err := client.BroadcastTransaction(ctx, txObj); err != nil {
+		return "", errors.Wrap(err, "failed to broadcast revoke transaction")
+	}
+
+	return strings.ToLower(txObj.Hash().Hex()), nil
+}
+
+// approveData 编码 approve(spender, 0) 调用数据
+func approveData(spender common.Address) []byte {
+	data := make([]byte, 0, len(erc20ApproveMethodID)+abiWordSize*2)
+	data = append(data, erc20ApproveMethodID...)
+	data = append(data, common.LeftPadBytes(spender.Bytes(), abiWordSize)...)
+	data = append(data, make([]byte, abiWordSize)...)
+	return data
+}
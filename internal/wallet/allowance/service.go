@@ -0,0 +1,254 @@
+// Package allowance 提供热钱包 ERC20 授权的跟踪和撤销
+// 扫描器记录 owner 为热钱包的 Approval 事件（token_approvals），管理员查看未撤销的授权并一键发送 approve(spender, 0) 撤销
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+package allowance
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/wallet/gasguard"
+	"github/chapool/go-wallet/internal/wallet/hotwallet"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/signer"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	// ErrAllowanceNotFound 授权不存在或不是该授权组合最新的有效授权事件
+	ErrAllowanceNotFound = errors.New("allowance not found")
+	// ErrAllowanceRevoked 授权额度已为 0
+	ErrAllowanceRevoked = errors.New("allowance already revoked")
+	// ErrRevokePending 已发送的撤销交易仍在等待上链或等待扫描
+	ErrRevokePending = errors.New("allowance revoke pending")
+)
+
+// allowanceColumns 授权查询列，与 scanAllowance 的顺序一致
+// 同一 (chain_id, owner_addr, token_addr, spender_addr) 只取最新的有效授权事件，附带代币符号和最近一次撤销交易
+const allowanceColumns = `a.id, a.wallet_id, a.chain_id, a.owner_addr, a.token_addr, t.token_symbol, a.spender_addr, a.amount,
+	a.tx_hash, a.block_no, a.created_at, r.tx_hash, r.created_at`
+
+// allowanceFrom 授权查询的 FROM 子句（最新的有效授权事件）
+const allowanceFrom = `FROM (
+		SELECT DISTINCT ON (chain_id, owner_addr, token_addr, spender_addr) *
+		FROM token_approvals
+		WHERE status = 'active'
+		ORDER BY chain_id, owner_addr, token_addr, spender_addr, block_no DESC, event_index DESC
+	) a
+	LEFT JOIN tokens t ON t.chain_id = a.chain_id AND LOWER(t.token_address) = a.token_addr
+	LEFT JOIN LATERAL (
+		SELECT tx_hash, created_at
+		FROM token_approval_revokes
+		WHERE approval_id = a.id
+		ORDER BY created_at DESC
+		LIMIT 1
+	) r ON TRUE`
+
+// Config 撤销授权交易配置
+type Config struct {
+	BaseFeeMultiplier int64 // maxFeePerGas = baseFee * BaseFeeMultiplier + tipCap
+	LegacyTxChainIDs  []int // 强制使用 legacy gasPrice 交易的链
+}
+
+// Allowance 热钱包的一项授权（最新的有效授权事件）
+type Allowance struct {
+	ID                string // 最新授权事件的 ID，撤销时使用
+	WalletID          string
+	ChainID           int
+	OwnerAddress      string
+	TokenAddress      string
+	TokenSymbol       *string // 未配置的代币为空
+	SpenderAddress    string
+	Amount            string   // 最新 Approval 事件中的额度
+	CurrentAllowance  *big.Int // 链上当前额度（spender 使用后会减少），查询失败时为空
+	TxHash            string
+	BlockNo           int64
+	ApprovedAt        time.Time
+	RevokeTxHash      *string // 最近一次撤销交易，未撤销过时为空
+	RevokeRequestedAt *time.Time
+}
+
+// Filter 授权查询条件
+type Filter struct {
+	ChainID *int
+}
+
+// Service 热钱包授权服务接口
+type Service interface {
+	// ListAllowances 查询热钱包未撤销的授权（最新额度不为 0），并查询链上当前额度
+	ListAllowances(ctx context.Context, filter *Filter) ([]*Allowance, error)
+
+	// RevokeAllowance 由热钱包发送 approve(spender, 0) 撤销授权，返回带撤销交易哈希的授权
+	RevokeAllowance(ctx context.Context, allowanceID string, adminUserID string) (*Allowance, error)
+}
+
+// service 实现 Service 接口
+type service struct {
+	db               *sql.DB
+	config           Config
+	scanService      scan.Service
+	hotWalletService hotwallet.Service
+	signerService    signer.Service
+	gasPriceCap      gasguard.PriceCap
+}
+
+// NewService 创建热钱包授权服务
+func NewService(
+	db *sql.DB,
+	config Config,
+	scanService scan.Service,
+	hotWalletService hotwallet.Service,
+	signerService signer.Service,
+	gasPriceCap gasguard.PriceCap,
+) Service {
+	return &service{
+		db:               db,
+		config:           config,
+		scanService:      scanService,
+		hotWalletService: hotWalletService,
+		signerService:    signerService,
+		gasPriceCap:      gasPriceCap,
+	}
+}
+
+// rowScanner 兼容 *sql.Row 和 *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// ListAllowances 查询热钱包未撤销的授权，按链、热钱包、代币和被授权地址排序
+// 链上当前额度按链查询，RPC 不可用时只记录日志，CurrentAllowance 为空
+func (s *service) ListAllowances(ctx context.Context, filter *Filter) ([]*Allowance, error) {
+	conditions := []string{"a.amount <> '0'"}
+	args := []any{}
+	if filter != nil && filter.ChainID != nil {
+		args = append(args, *filter.ChainID)
+		conditions = append(conditions, fmt.Sprintf("a.chain_id = $%d", len(args)))
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+allowanceColumns+`
+		`+allowanceFrom+`
+		WHERE `+strings.Join(conditions, " AND ")+`
+		ORDER BY a.chain_id, a.owner_addr, a.token_addr, a.spender_addr
+	`, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query allowances")
+	}
+	defer rows.Close()
+
+	allowances := make([]*Allowance, 0)
+	for rows.Next() {
+		allowance, err := scanAllowance(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan allowance")
+		}
+		allowances = append(allowances, allowance)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate allowances")
+	}
+
+	s.loadCurrentAllowances(ctx, allowances)
+
+	return allowances, nil
+}
+
+// loadCurrentAllowances 查询链上当前额度，查询失败的授权 CurrentAllowance 为空
+func (s *service) loadCurrentAllowances(ctx context.Context, allowances []*Allowance) {
+	clients := make(map[int]*scan.RPCClient)
+	for _, allowance := range allowances {
+		client, ok := clients[allowance.ChainID]
+		if !ok {
+			var err error
+			client, err = s.scanService.GetClient(ctx, allowance.ChainID)
+			if err != nil {
+				log.Warn().Err(err).Int("chain_id", allowance.ChainID).Msg("Failed to get RPC client, omitting current allowances")
+			}
+			clients[allowance.ChainID] = client
+		}
+		if client == nil {
+			continue
+		}
+
+		current, err := client.TokenAllowance(ctx,
+			common.HexToAddress(allowance.TokenAddress),
+			common.HexToAddress(allowance.OwnerAddress),
+			common.HexToAddress(allowance.SpenderAddress),
+		)
+		if err != nil {
+			log.Warn().
+				Err(err).
+				Int("chain_id", allowance.ChainID).
+				Str("allowance_id", allowance.ID).
+				Msg("Failed to get current allowance")
+			continue
+		}
+		allowance.CurrentAllowance = current
+	}
+}
+
+// getAllowance 获取授权，不是该授权组合最新的有效授权事件时返回 ErrAllowanceNotFound
+func (s *service) getAllowance(ctx context.Context, allowanceID string) (*Allowance, error) {
+	allowance, err := scanAllowance(s.db.QueryRowContext(ctx, `
+		SELECT `+allowanceColumns+`
+		`+allowanceFrom+`
+		WHERE a.id = $1
+	`, allowanceID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAllowanceNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// scanAllowance 扫描一行授权
+func scanAllowance(row rowScanner) (*Allowance, error) {
+	var (
+		allowance         Allowance
+		tokenSymbol       sql.NullString
+		revokeTxHash      sql.NullString
+		revokeRequestedAt sql.NullTime
+	)
+	if err := row.Scan(
+		&allowance.ID,
+		&allowance.WalletID,
+		&allowance.ChainID,
+		&allowance.OwnerAddress,
+		&allowance.TokenAddress,
+		&tokenSymbol,
+		&allowance.SpenderAddress,
+		&allowance.Amount,
+		&allowance.TxHash,
+		&allowance.BlockNo,
+		&allowance.ApprovedAt,
+		&revokeTxHash,
+		&revokeRequestedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if tokenSymbol.Valid {
+		allowance.TokenSymbol = &tokenSymbol.String
+	}
+	if revokeTxHash.Valid {
+		allowance.RevokeTxHash = &revokeTxHash.String
+	}
+	if revokeRequestedAt.Valid {
+		allowance.RevokeRequestedAt = &revokeRequestedAt.Time
+	}
+
+	return &allowance, nil
+}
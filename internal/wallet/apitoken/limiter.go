@@ -0,0 +1,54 @@
+package apitoken
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimitWindow API Token 限流窗口
+const rateLimitWindow = time.Minute
+
+// rateLimiter 按 Token 的固定窗口计数限流，只在本进程内生效（多实例部署时总频率为实例数倍）
+type rateLimiter struct {
+	mu      sync.Mutex
+	windows map[string]*rateWindow
+}
+
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		windows: make(map[string]*rateWindow),
+	}
+}
+
+// allow 记录 Token 的一次请求，超过窗口内的次数限制时返回 false，limit 为 0 表示不限制
+func (l *rateLimiter) allow(tokenID string, limit int, now time.Time) bool {
+	if limit <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// 清理已过期的窗口，避免 map 随 Token 数无限增长
+	for key, window := range l.windows {
+		if now.Sub(window.start) >= rateLimitWindow {
+			delete(l.windows, key)
+		}
+	}
+
+	window, ok := l.windows[tokenID]
+	if !ok {
+		l.windows[tokenID] = &rateWindow{start: now, count: 1}
+		return true
+	}
+	if window.count >= limit {
+		return false
+	}
+	window.count++
+	return true
+}
@@ -0,0 +1,37 @@
+package apitoken
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiterAllow(t *testing.T) {
+	limiter := newRateLimiter()
+	now := time.Date(2025, 12, 3, 11, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 3; i++ {
+		if !limiter.allow("a", 3, now.Add(time.Duration(i)*time.Second)) {
+			t.Fatalf("request %d should be allowed", i+1)
+		}
+	}
+	if limiter.allow("a", 3, now.Add(10*time.Second)) {
+		t.Fatal("request over the limit should be rejected")
+	}
+	if !limiter.allow("b", 3, now.Add(10*time.Second)) {
+		t.Fatal("other tokens should have their own window")
+	}
+	if !limiter.allow("a", 3, now.Add(time.Minute)) {
+		t.Fatal("request in a new window should be allowed")
+	}
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	limiter := newRateLimiter()
+	now := time.Now()
+
+	for i := 0; i < 100; i++ {
+		if !limiter.allow("a", 0, now) {
+			t.Fatal("limit 0 should not restrict requests")
+		}
+	}
+}
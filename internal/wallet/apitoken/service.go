@@ -0,0 +1,87 @@
+//nolint:ireturn // 返回接口类型是预期的设计
+package apitoken
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Token 权限范围
+const (
+	ScopeWalletRead     = "wallet:read"     // 查询余额、充值和提现
+	ScopeWalletWithdraw = "wallet:withdraw" // 发起提现
+)
+
+// TokenPrefix API Token 前缀，用于和登录 access token 区分
+const TokenPrefix = "gwt_"
+
+var (
+	// ErrInvalidToken Token 参数不合法
+	ErrInvalidToken = errors.New("invalid API token")
+	// ErrTokenNotFound Token 不存在或已吊销
+	ErrTokenNotFound = errors.New("API token not found")
+)
+
+// Service 用户 API Token 服务接口
+// 用户为程序化访问创建 API Token，Token 只能访问其 scope 允许的钱包接口
+type Service interface {
+	// CreateToken 创建 API Token，返回 Token 明文（只在创建时返回一次）
+	CreateToken(ctx context.Context, req *CreateRequest) (*Token, string, error)
+
+	// ListTokens 查询用户未吊销的 API Token
+	ListTokens(ctx context.Context, userID string) ([]*Token, error)
+
+	// RevokeToken 吊销用户的 API Token
+	RevokeToken(ctx context.Context, userID string, tokenID string) error
+
+	// Authenticate 校验 Token 明文并记录最近使用时间，Token 不存在、已吊销或已过期时返回 ErrTokenNotFound
+	Authenticate(ctx context.Context, plaintext string, ip string) (*Token, error)
+
+	// Allow 记录 Token 的一次请求，超过每分钟 limitPerMinute 次时返回 false（limitPerMinute 为 0 表示不限制）
+	Allow(tokenID string, limitPerMinute int) bool
+}
+
+// CreateRequest 创建 API Token 请求
+type CreateRequest struct {
+	UserID             string
+	Name               string
+	Scopes             []string
+	WithdrawTokenID    *int    // 只允许提现该代币
+	MaxWithdrawAmount  *string // 单笔提现金额上限（人类可读单位），需要指定 WithdrawTokenID
+	ExpiresAt          *time.Time
+	RateLimitPerMinute *int // 每分钟最多请求数，为空时使用全局默认值
+}
+
+// Token 用户 API Token（不含明文）
+type Token struct {
+	ID                 string
+	UserID             string
+	Name               string
+	Prefix             string
+	Scopes             []string
+	WithdrawTokenID    *int
+	MaxWithdrawAmount  *string
+	ExpiresAt          *time.Time
+	RateLimitPerMinute *int // 每分钟最多请求数，为空时使用全局默认值
+	LastUsedAt         *time.Time
+	LastUsedIP         *string
+	CreatedAt          time.Time
+}
+
+type service struct {
+	db      *sql.DB
+	limiter *rateLimiter
+}
+
+// NewService 创建 API Token 服务
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(db *sql.DB) Service {
+	return &service{
+		db:      db,
+		limiter: newRateLimiter(),
+	}
+}
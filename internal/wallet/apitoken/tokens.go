@@ -0,0 +1,250 @@
+package apitoken
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const (
+	// tokenRandomBytes Token 随机部分的字节数
+	tokenRandomBytes = 32
+	// displayPrefixLength 保存用于展示的 Token 前缀长度（含 TokenPrefix）
+	displayPrefixLength = 12
+	// maxNameLength Token 名称最大长度
+	maxNameLength = 100
+	// maxRateLimitPerMinute Token 每分钟请求数限制的上限
+	maxRateLimitPerMinute = 100000
+	// lastUsedInterval 最近使用时间的更新间隔，避免每个请求都写数据库
+	lastUsedInterval = time.Minute
+)
+
+// apiTokenColumns api_tokens 查询列，与 scanToken 的顺序一致
+const apiTokenColumns = `id, user_id, name, token_prefix, scopes, withdraw_token_id, max_withdraw_amount, expires_at, rate_limit_per_minute, last_used_at, last_used_ip, created_at`
+
+// rowScanner 兼容 *sql.Row 和 *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// IsToken 是否为 API Token 格式（用于和登录 access token 区分）
+func IsToken(plaintext string) bool {
+	return strings.HasPrefix(plaintext, TokenPrefix) && len(plaintext) == len(TokenPrefix)+2*tokenRandomBytes
+}
+
+// CreateToken 创建 API Token
+func (s *service) CreateToken(ctx context.Context, req *CreateRequest) (*Token, string, error) {
+	if err := validateCreateRequest(req); err != nil {
+		return nil, "", err
+	}
+
+	random, err := util.GenerateRandomHexString(tokenRandomBytes)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to generate API token")
+	}
+	plaintext := TokenPrefix + random
+
+	token, err := scanToken(s.db.QueryRowContext(ctx, `
+		INSERT INTO api_tokens (user_id, name, token_hash, token_prefix, scopes, withdraw_token_id, max_withdraw_amount, expires_at, rate_limit_per_minute)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING `+apiTokenColumns,
+		req.UserID, strings.TrimSpace(req.Name), hashToken(plaintext), plaintext[:displayPrefixLength],
+		pq.Array(req.Scopes), req.WithdrawTokenID, req.MaxWithdrawAmount, req.ExpiresAt, req.RateLimitPerMinute,
+	))
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to insert API token")
+	}
+
+	return token, plaintext, nil
+}
+
+// ListTokens 查询用户未吊销的 API Token（按创建时间倒序）
+func (s *service) ListTokens(ctx context.Context, userID string) ([]*Token, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+apiTokenColumns+`
+		FROM api_tokens
+		WHERE user_id = $1 AND revoked_at IS NULL
+		ORDER BY created_at DESC
+	`, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query API tokens")
+	}
+	defer rows.Close()
+
+	tokens := make([]*Token, 0)
+	for rows.Next() {
+		token, err := scanToken(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan API token")
+		}
+		tokens = append(tokens, token)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate API tokens")
+	}
+
+	return tokens, nil
+}
+
+// RevokeToken 吊销用户的 API Token，吊销后立即失效
+func (s *service) RevokeToken(ctx context.Context, userID string, tokenID string) error {
+	res, err := s.db.ExecContext(ctx, `
+		UPDATE api_tokens SET revoked_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+	`, tokenID, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to revoke API token")
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get affected rows")
+	}
+	if affected == 0 {
+		return ErrTokenNotFound
+	}
+
+	return nil
+}
+
+// Authenticate 校验 Token 明文，最近使用时间最多每分钟更新一次
+func (s *service) Authenticate(ctx context.Context, plaintext string, ip string) (*Token, error) {
+	if !IsToken(plaintext) {
+		return nil, ErrTokenNotFound
+	}
+
+	token, err := scanToken(s.db.QueryRowContext(ctx, `
+		SELECT `+apiTokenColumns+`
+		FROM api_tokens
+		WHERE token_hash = $1 AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > NOW())
+	`, hashToken(plaintext)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTokenNotFound
+		}
+		return nil, errors.Wrap(err, "failed to query API token")
+	}
+
+	if token.LastUsedAt == nil || time.Since(*token.LastUsedAt) >= lastUsedInterval {
+		if _, err := s.db.ExecContext(ctx, `
+			UPDATE api_tokens SET last_used_at = NOW(), last_used_ip = $2 WHERE id = $1
+		`, token.ID, ip); err != nil {
+			return nil, errors.Wrap(err, "failed to update API token last used time")
+		}
+	}
+
+	return token, nil
+}
+
+// Allow 记录 Token 的一次请求，按每分钟固定窗口计数，只在本进程内生效
+func (s *service) Allow(tokenID string, limitPerMinute int) bool {
+	return s.limiter.allow(tokenID, limitPerMinute, time.Now())
+}
+
+// validateCreateRequest 校验创建参数
+func validateCreateRequest(req *CreateRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(name) > maxNameLength {
+		return errors.Wrapf(ErrInvalidToken, "name must be between 1 and %d characters", maxNameLength)
+	}
+
+	if len(req.Scopes) == 0 {
+		return errors.Wrap(ErrInvalidToken, "at least one scope is required")
+	}
+	for _, scope := range req.Scopes {
+		switch scope {
+		case ScopeWalletRead, ScopeWalletWithdraw:
+		default:
+			return errors.Wrapf(ErrInvalidToken, "unknown scope %q", scope)
+		}
+	}
+
+	if req.MaxWithdrawAmount != nil {
+		if req.WithdrawTokenID == nil {
+			return errors.Wrap(ErrInvalidToken, "max_withdraw_amount requires withdraw_token_id")
+		}
+		amount, ok := new(big.Rat).SetString(*req.MaxWithdrawAmount)
+		if !ok || amount.Sign() <= 0 {
+			return errors.Wrap(ErrInvalidToken, "max_withdraw_amount must be a positive number")
+		}
+	}
+
+	if req.RateLimitPerMinute != nil && (*req.RateLimitPerMinute <= 0 || *req.RateLimitPerMinute > maxRateLimitPerMinute) {
+		return errors.Wrapf(ErrInvalidToken, "rate_limit_per_minute must be between 1 and %d", maxRateLimitPerMinute)
+	}
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return errors.Wrap(ErrInvalidToken, "expires_at must be in the future")
+	}
+
+	return nil
+}
+
+// hashToken 计算 Token 明文的 SHA-256
+func hashToken(plaintext string) string {
+	sum := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(sum[:])
+}
+
+// scanToken 扫描一行 API Token
+func scanToken(row rowScanner) (*Token, error) {
+	var (
+		token             Token
+		withdrawTokenID   sql.NullInt64
+		maxWithdrawAmount sql.NullString
+		expiresAt         sql.NullTime
+		rateLimit         sql.NullInt64
+		lastUsedAt        sql.NullTime
+		lastUsedIP        sql.NullString
+	)
+
+	if err := row.Scan(
+		&token.ID,
+		&token.UserID,
+		&token.Name,
+		&token.Prefix,
+		pq.Array(&token.Scopes),
+		&withdrawTokenID,
+		&maxWithdrawAmount,
+		&expiresAt,
+		&rateLimit,
+		&lastUsedAt,
+		&lastUsedIP,
+		&token.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if withdrawTokenID.Valid {
+		v := int(withdrawTokenID.Int64)
+		token.WithdrawTokenID = &v
+	}
+	if maxWithdrawAmount.Valid {
+		token.MaxWithdrawAmount = &maxWithdrawAmount.String
+	}
+	if expiresAt.Valid {
+		token.ExpiresAt = &expiresAt.Time
+	}
+	if rateLimit.Valid {
+		v := int(rateLimit.Int64)
+		token.RateLimitPerMinute = &v
+	}
+	if lastUsedAt.Valid {
+		token.LastUsedAt = &lastUsedAt.Time
+	}
+	if lastUsedIP.Valid {
+		token.LastUsedIP = &lastUsedIP.String
+	}
+
+	return &token, nil
+}
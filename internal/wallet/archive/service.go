@@ -0,0 +1,362 @@
+//nolint:ireturn // 返回接口类型是预期的设计
+package archive
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github/chapool/go-wallet/internal/util/lifecycle"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// 归档方式
+const (
+	ModeArchive = "archive" // 移到 blocks_archive / transactions_archive
+	ModeDelete  = "delete"  // 直接删除
+)
+
+// maxErrorLength archive_runs.error 最大保存长度
+const maxErrorLength = 1000
+
+const (
+	// eligibleTransactionsSQL 可归档的交易（别名 t，$1 为截止时间）：已终结或失败，且没有被入账、提现、隔离记录或托管流转引用
+	// 提现交易哈希由签名器生成（小写），直接比较以使用 withdraws.tx_hash 索引
+	eligibleTransactionsSQL = `
+		t.created_at < $1
+		AND t.status IN ('finalized', 'failed')
+		AND NOT EXISTS (SELECT 1 FROM credits c WHERE c.chain_id = t.chain_id AND lower(c.tx_hash) = lower(t.tx_hash))
+		AND NOT EXISTS (SELECT 1 FROM withdraws w WHERE w.chain_id = t.chain_id AND w.tx_hash = t.tx_hash)
+		AND NOT EXISTS (SELECT 1 FROM deposit_quarantines q WHERE q.transaction_id = t.id)
+		AND NOT EXISTS (SELECT 1 FROM custody_moves m WHERE m.transaction_id = t.id)
+	`
+
+	// eligibleBlocksSQL 可归档的区块（别名 b，$1 为截止时间）：已终结或已孤立，不包含剩余的交易和 UTXO，
+	// 且不是链最新的已扫描区块（扫描器从已扫描的最大区块号继续扫描）
+	eligibleBlocksSQL = `
+		b.created_at < $1
+		AND b.status IN ('finalized', 'orphaned')
+		AND b.number < (SELECT MAX(l.number) FROM blocks l WHERE l.chain_id = b.chain_id AND l.status <> 'orphaned')
+		AND NOT EXISTS (SELECT 1 FROM transactions t WHERE t.chain_id = b.chain_id AND t.block_no = b.number AND t.block_hash = b.hash)
+		AND NOT EXISTS (SELECT 1 FROM utxos u WHERE u.chain_id = b.chain_id AND u.block_hash = b.hash)
+	`
+
+	transactionColumns = `id, chain_id, block_hash, block_no, tx_hash, from_addr, to_addr, token_addr, amount, type, status,
+		confirmation_count, event_index, created_at, updated_at`
+
+	blockColumns = `hash, chain_id, parent_hash, number, timestamp, status, created_at, updated_at`
+)
+
+// Config 归档配置
+type Config struct {
+	// Mode 归档方式：archive 移到归档表，delete 直接删除
+	Mode string
+	// MinAge 只归档 created_at 早于该时长之前的记录
+	MinAge time.Duration
+	// BatchSize 每条语句最多移动的记录数
+	BatchSize int
+}
+
+// Run 一次归档执行记录
+type Run struct {
+	ID           int
+	Mode         string
+	Cutoff       time.Time
+	TriggeredBy  *string // 手动执行的管理员，定时任务为 nil
+	Blocks       int64
+	Transactions int64
+	Error        *string
+	StartedAt    time.Time
+	FinishedAt   *time.Time
+}
+
+// Preview 当前满足归档条件的记录数
+type Preview struct {
+	Mode         string
+	Cutoff       time.Time
+	Blocks       int64
+	Transactions int64
+}
+
+// Service 区块和交易归档服务接口
+// 超过保留时间的已终结区块和已结算交易从 blocks/transactions 移到归档表或直接删除，避免扫描器查询随历史数据增长而变慢；
+// 仍被入账、提现等记录引用的交易及其所在区块保留在原表
+type Service interface {
+	// Run 执行一次归档，先归档交易再归档区块，adminUserID 为 nil 表示定时任务
+	Run(ctx context.Context, adminUserID *string) (*Run, error)
+
+	// Preview 统计当前满足归档条件的区块和交易数，不修改数据
+	Preview(ctx context.Context) (*Preview, error)
+
+	// ListRuns 获取最近的归档执行记录，按开始时间倒序
+	ListRuns(ctx context.Context, limit int) ([]*Run, error)
+
+	// StartArchiver 启动定时归档
+	StartArchiver(ctx context.Context, interval time.Duration)
+}
+
+// service 实现 Service 接口
+type service struct {
+	db     *sql.DB
+	config Config
+}
+
+// NewService 创建归档服务
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(db *sql.DB, config Config) Service {
+	return &service{
+		db:     db,
+		config: config,
+	}
+}
+
+// StartArchiver 启动定时归档
+func (s *service) StartArchiver(ctx context.Context, interval time.Duration) {
+	log.Info().
+		Str("mode", s.config.Mode).
+		Dur("min_age", s.config.MinAge).
+		Dur("interval", interval).
+		Msg("Starting block and transaction archiver")
+
+	lifecycle.Go(ctx, "block and transaction archiver", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Block and transaction archiver stopped")
+				return
+			case <-ticker.C:
+				if _, err := s.Run(ctx, nil); err != nil {
+					log.Error().Err(err).Msg("Block and transaction archival failed")
+				}
+			}
+		}
+	})
+}
+
+// Run 执行一次归档
+// 每批在一条语句中删除并写入归档表（SKIP LOCKED，多实例同时执行时互不重复），停机或请求取消时在批次之间停止
+func (s *service) Run(ctx context.Context, adminUserID *string) (*Run, error) {
+	run := &Run{
+		Mode:        s.config.Mode,
+		Cutoff:      time.Now().Add(-s.config.MinAge),
+		TriggeredBy: adminUserID,
+	}
+
+	if err := s.db.QueryRowContext(ctx, `
+		INSERT INTO archive_runs (mode, cutoff, triggered_by)
+		VALUES ($1, $2, $3)
+		RETURNING id, started_at
+	`, run.Mode, run.Cutoff, adminUserID).Scan(&run.ID, &run.StartedAt); err != nil {
+		return nil, errors.Wrap(err, "failed to insert archive run")
+	}
+
+	runErr := s.archive(ctx, run)
+
+	finishedAt := time.Now()
+	run.FinishedAt = &finishedAt
+	if runErr != nil {
+		message := runErr.Error()
+		if len(message) > maxErrorLength {
+			message = message[:maxErrorLength]
+		}
+		run.Error = &message
+	}
+
+	// 请求取消或停机时仍记录已归档的数量
+	if _, err := s.db.ExecContext(context.WithoutCancel(ctx), `
+		UPDATE archive_runs
+		SET blocks_count = $2, transactions_count = $3, error = $4, finished_at = $5
+		WHERE id = $1
+	`, run.ID, run.Blocks, run.Transactions, run.Error, finishedAt); err != nil {
+		return nil, errors.Wrap(err, "failed to update archive run")
+	}
+
+	logEvent := log.Info()
+	if runErr != nil {
+		logEvent = log.Error().Err(runErr)
+	}
+	logEvent.
+		Int("run_id", run.ID).
+		Str("mode", run.Mode).
+		Time("cutoff", run.Cutoff).
+		Int64("blocks", run.Blocks).
+		Int64("transactions", run.Transactions).
+		Dur("duration", finishedAt.Sub(run.StartedAt)).
+		Msg("Block and transaction archival finished")
+
+	return run, runErr
+}
+
+// archive 分批归档交易和区块，已归档的数量累加到 run
+// 先归档交易，不再被交易引用的区块在同一次执行中即可归档
+func (s *service) archive(ctx context.Context, run *Run) error {
+	for {
+		if ctx.Err() != nil {
+			return errors.Wrap(ctx.Err(), "archival interrupted")
+		}
+
+		moved, err := s.archiveTransactions(ctx, run.Cutoff)
+		if err != nil {
+			return err
+		}
+		run.Transactions += moved
+		if moved < int64(s.config.BatchSize) {
+			break
+		}
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return errors.Wrap(ctx.Err(), "archival interrupted")
+		}
+
+		moved, err := s.archiveBlocks(ctx, run.Cutoff)
+		if err != nil {
+			return err
+		}
+		run.Blocks += moved
+		if moved < int64(s.config.BatchSize) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// archiveTransactions 归档一批交易，返回移动（或删除）的记录数
+func (s *service) archiveTransactions(ctx context.Context, cutoff time.Time) (int64, error) {
+	query := `
+		DELETE FROM transactions
+		WHERE id IN (
+			SELECT t.id FROM transactions t
+			WHERE ` + eligibleTransactionsSQL + `
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+	`
+	if s.config.Mode == ModeArchive {
+		query = `
+			WITH moved AS (` + query + ` RETURNING ` + transactionColumns + `)
+			INSERT INTO transactions_archive (` + transactionColumns + `)
+			SELECT ` + transactionColumns + ` FROM moved
+		`
+	}
+
+	result, err := s.db.ExecContext(ctx, query, cutoff, s.config.BatchSize)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to archive transactions")
+	}
+
+	moved, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get affected rows")
+	}
+
+	return moved, nil
+}
+
+// archiveBlocks 归档一批区块，返回移动（或删除）的记录数
+func (s *service) archiveBlocks(ctx context.Context, cutoff time.Time) (int64, error) {
+	query := `
+		DELETE FROM blocks
+		WHERE (hash, chain_id) IN (
+			SELECT b.hash, b.chain_id FROM blocks b
+			WHERE ` + eligibleBlocksSQL + `
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+	`
+	if s.config.Mode == ModeArchive {
+		query = `
+			WITH moved AS (` + query + ` RETURNING ` + blockColumns + `)
+			INSERT INTO blocks_archive (` + blockColumns + `)
+			SELECT ` + blockColumns + ` FROM moved
+		`
+	}
+
+	result, err := s.db.ExecContext(ctx, query, cutoff, s.config.BatchSize)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to archive blocks")
+	}
+
+	moved, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get affected rows")
+	}
+
+	return moved, nil
+}
+
+// Preview 统计当前满足归档条件的区块和交易数
+// 区块按当前的交易计算，交易归档后才满足条件的区块不计入
+func (s *service) Preview(ctx context.Context) (*Preview, error) {
+	preview := &Preview{
+		Mode:   s.config.Mode,
+		Cutoff: time.Now().Add(-s.config.MinAge),
+	}
+
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM transactions t WHERE `+eligibleTransactionsSQL,
+		preview.Cutoff,
+	).Scan(&preview.Transactions); err != nil {
+		return nil, errors.Wrap(err, "failed to count archivable transactions")
+	}
+
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM blocks b WHERE `+eligibleBlocksSQL,
+		preview.Cutoff,
+	).Scan(&preview.Blocks); err != nil {
+		return nil, errors.Wrap(err, "failed to count archivable blocks")
+	}
+
+	return preview, nil
+}
+
+// ListRuns 获取最近的归档执行记录
+func (s *service) ListRuns(ctx context.Context, limit int) ([]*Run, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, mode, cutoff, triggered_by, blocks_count, transactions_count, error, started_at, finished_at
+		FROM archive_runs
+		ORDER BY started_at DESC, id DESC
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query archive runs")
+	}
+	defer rows.Close()
+
+	runs := make([]*Run, 0)
+	for rows.Next() {
+		var (
+			run         Run
+			triggeredBy sql.NullString
+			runError    sql.NullString
+			finishedAt  sql.NullTime
+		)
+		if err := rows.Scan(&run.ID, &run.Mode, &run.Cutoff, &triggeredBy, &run.Blocks, &run.Transactions, &runError, &run.StartedAt, &finishedAt); err != nil {
+			return nil, errors.Wrap(err, "failed to scan archive run")
+		}
+		if triggeredBy.Valid {
+			run.TriggeredBy = &triggeredBy.String
+		}
+		if runError.Valid {
+			run.Error = &runError.String
+		}
+		if finishedAt.Valid {
+			run.FinishedAt = &finishedAt.Time
+		}
+		runs = append(runs, &run)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate archive runs")
+	}
+
+	return runs, nil
+}
@@ -0,0 +1,275 @@
+//nolint:ireturn // 返回接口类型是预期的设计
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// 审计结果
+const (
+	ResultSuccess = "success" // 响应状态码 < 400
+	ResultFailure = "failure"
+)
+
+// maxErrorLength 记录的错误信息最大长度
+const maxErrorLength = 1000
+
+// entryColumns 审计记录查询列，与 scanEntry 的顺序一致
+const entryColumns = `id, actor_user_id, actor_role, method, route, path, target_type, target_id, params,
+	request_id, payload_hash, result, status_code, error, latency_ms, client_ip, created_at`
+
+// Service 管理员操作审计服务接口
+// 钱包管理接口的变更请求由中间件记录，记录只追加不修改
+type Service interface {
+	// Record 记录一次管理员操作
+	Record(ctx context.Context, entry *Entry) error
+
+	// List 查询审计记录（按时间倒序）
+	List(ctx context.Context, filter *Filter, limit int, offset int) ([]*Entry, error)
+}
+
+// Entry 管理员操作审计记录
+type Entry struct {
+	ID          int64
+	ActorUserID *string // 未认证的请求为空
+	ActorRole   *string
+	Method      string
+	Route       string          // 路由模板，如 /api/v1/wallet/withdraw/:withdrawId/approve
+	Path        string          // 实际请求路径
+	TargetType  *string         // 操作对象类型，取自路由的路径参数，如 :withdrawId 为 withdraw；没有路径参数时为空
+	TargetID    *string         // 操作对象 ID，即路径参数的值
+	Params      json.RawMessage // 请求参数（JSON 对象：path、query、body），敏感字段已脱敏
+	RequestID   *string
+	PayloadHash *string // 请求体 SHA-256（十六进制），无请求体时为空
+	Result      string
+	StatusCode  int
+	Error       *string
+	Latency     time.Duration
+	ClientIP    *string
+	CreatedAt   time.Time
+}
+
+// Filter 审计记录查询条件，字段为空表示不限
+type Filter struct {
+	ActorUserID   *string
+	Method        *string
+	Route         *string
+	TargetType    *string
+	TargetID      *string
+	Result        *string
+	CreatedAfter  *time.Time // 包含
+	CreatedBefore *time.Time // 不包含
+}
+
+type service struct {
+	db *sql.DB
+}
+
+// NewService 创建管理员操作审计服务
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(db *sql.DB) Service {
+	return &service{
+		db: db,
+	}
+}
+
+// ResultFor 根据响应状态码返回审计结果
+func ResultFor(statusCode int) string {
+	if statusCode >= 400 {
+		return ResultFailure
+	}
+
+	return ResultSuccess
+}
+
+// Record 记录一次管理员操作，Result 为空时根据状态码确定
+func (s *service) Record(ctx context.Context, entry *Entry) error {
+	if entry.Result == "" {
+		entry.Result = ResultFor(entry.StatusCode)
+	}
+	if entry.Error != nil && len(*entry.Error) > maxErrorLength {
+		truncated := (*entry.Error)[:maxErrorLength]
+		entry.Error = &truncated
+	}
+
+	if err := s.db.QueryRowContext(ctx, `
+		INSERT INTO admin_audit (
+			actor_user_id, actor_role, method, route, path, target_type, target_id, params,
+			request_id, payload_hash, result, status_code, error, latency_ms, client_ip
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		RETURNING id, created_at
+	`,
+		entry.ActorUserID,
+		entry.ActorRole,
+		entry.Method,
+		entry.Route,
+		entry.Path,
+		entry.TargetType,
+		entry.TargetID,
+		nullJSON(entry.Params),
+		entry.RequestID,
+		entry.PayloadHash,
+		entry.Result,
+		entry.StatusCode,
+		entry.Error,
+		entry.Latency.Milliseconds(),
+		entry.ClientIP,
+	).Scan(&entry.ID, &entry.CreatedAt); err != nil {
+		return errors.Wrap(err, "failed to insert admin audit entry")
+	}
+
+	return nil
+}
+
+// List 查询审计记录
+func (s *service) List(ctx context.Context, filter *Filter, limit int, offset int) ([]*Entry, error) {
+	where, args := filterClause(filter)
+	args = append(args, limit, offset)
+
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT %s FROM admin_audit %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d OFFSET $%d
+	`, entryColumns, where, len(args)-1, len(args)), args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query admin audit entries")
+	}
+	defer rows.Close()
+
+	entries := make([]*Entry, 0)
+	for rows.Next() {
+		entry, err := scanEntry(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan admin audit entry")
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate admin audit entries")
+	}
+
+	return entries, nil
+}
+
+// filterClause 将查询条件转换为 WHERE 子句和参数
+func filterClause(filter *Filter) (string, []any) {
+	var (
+		where string
+		args  []any
+	)
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		condition = fmt.Sprintf(condition, len(args))
+		if where == "" {
+			where = "WHERE " + condition
+		} else {
+			where += " AND " + condition
+		}
+	}
+
+	if filter.ActorUserID != nil {
+		add("actor_user_id = $%d", *filter.ActorUserID)
+	}
+	if filter.Method != nil {
+		add("method = $%d", *filter.Method)
+	}
+	if filter.Route != nil {
+		add("route = $%d", *filter.Route)
+	}
+	if filter.TargetType != nil {
+		add("target_type = $%d", *filter.TargetType)
+	}
+	if filter.TargetID != nil {
+		add("target_id = $%d", *filter.TargetID)
+	}
+	if filter.Result != nil {
+		add("result = $%d", *filter.Result)
+	}
+	if filter.CreatedAfter != nil {
+		add("created_at >= $%d", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		add("created_at < $%d", *filter.CreatedBefore)
+	}
+
+	return where, args
+}
+
+// scanEntry 扫描一行审计记录
+func scanEntry(rows *sql.Rows) (*Entry, error) {
+	var (
+		entry       Entry
+		actorUserID sql.NullString
+		actorRole   sql.NullString
+		targetType  sql.NullString
+		targetID    sql.NullString
+		params      []byte
+		requestID   sql.NullString
+		payloadHash sql.NullString
+		errMessage  sql.NullString
+		clientIP    sql.NullString
+		latencyMs   int64
+	)
+
+	if err := rows.Scan(
+		&entry.ID,
+		&actorUserID,
+		&actorRole,
+		&entry.Method,
+		&entry.Route,
+		&entry.Path,
+		&targetType,
+		&targetID,
+		&params,
+		&requestID,
+		&payloadHash,
+		&entry.Result,
+		&entry.StatusCode,
+		&errMessage,
+		&latencyMs,
+		&clientIP,
+		&entry.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	entry.ActorUserID = nullStringPtr(actorUserID)
+	entry.ActorRole = nullStringPtr(actorRole)
+	entry.TargetType = nullStringPtr(targetType)
+	entry.TargetID = nullStringPtr(targetID)
+	if len(params) > 0 {
+		entry.Params = params
+	}
+	entry.RequestID = nullStringPtr(requestID)
+	entry.PayloadHash = nullStringPtr(payloadHash)
+	entry.Error = nullStringPtr(errMessage)
+	entry.ClientIP = nullStringPtr(clientIP)
+	entry.Latency = time.Duration(latencyMs) * time.Millisecond
+
+	return &entry, nil
+}
+
+func nullStringPtr(value sql.NullString) *string {
+	if !value.Valid {
+		return nil
+	}
+
+	return &value.String
+}
+
+// nullJSON 空的 JSON 参数写入 NULL
+func nullJSON(value json.RawMessage) any {
+	if len(value) == 0 {
+		return nil
+	}
+
+	return []byte(value)
+}
@@ -0,0 +1,100 @@
+package balance
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+
+	"github/chapool/go-wallet/internal/money"
+)
+
+// TokenBalanceBreakdown 单个代币按状态拆分的余额，金额为代币单位（充值 credits 按代币精度换算）
+// Available = Finalized - 进行中和已完成的提现扣减，Total = Available + PendingDeposit + FrozenWithdraw
+type TokenBalanceBreakdown struct {
+	ChainID        int
+	TokenID        int
+	TokenSymbol    string
+	Finalized      *big.Float // finalized 的 credits（与 GetBalanceByToken 口径一致）
+	Available      *big.Float // 可提现余额（与 GetAvailableBalance 一致）
+	PendingDeposit *big.Float // 未最终确认的充值（与 GetPendingDepositBalance 一致）
+	FrozenWithdraw *big.Float // 进行中的提现冻结的金额（含手续费），正数
+	Total          *big.Float
+}
+
+// GetBalanceBreakdown 一次聚合查询用户各代币的可用、充值中、提现冻结和合计余额
+// 只返回任一状态余额不为 0 的代币，按链和代币排序
+func (s *service) GetBalanceBreakdown(ctx context.Context, userID string, chainID *int) ([]*TokenBalanceBreakdown, error) {
+	query := `
+		SELECT chain_id, token_id, token_symbol, finalized::text, available::text, pending_deposit::text, frozen_withdraw::text,
+			(available + pending_deposit + frozen_withdraw)::text
+		FROM (
+			SELECT
+				credits.chain_id, credits.token_id,
+				MAX(credits.token_symbol) AS token_symbol,
+				COALESCE(SUM(` + TokenAmountSQL + `) FILTER (WHERE status = 'finalized'), 0) AS finalized,
+				` + availableAmountSQL + ` AS available,
+				COALESCE(SUM(` + TokenAmountSQL + `) FILTER (WHERE credit_type = 'deposit' AND status IN ('pending', 'confirmed')), 0) AS pending_deposit,
+				` + frozenWithdrawAmountSQL + ` AS frozen_withdraw
+			FROM credits
+			JOIN tokens t ON t.id = credits.token_id
+			WHERE credits.user_id = $1
+				AND status IN ('finalized', 'pending', 'confirmed', 'frozen')
+	`
+	args := []interface{}{userID}
+
+	if chainID != nil {
+		query += ` AND credits.chain_id = $2`
+		args = append(args, *chainID)
+	}
+
+	query += `
+			GROUP BY credits.chain_id, credits.token_id
+		) balances
+		WHERE finalized <> 0 OR pending_deposit <> 0 OR frozen_withdraw <> 0
+		ORDER BY chain_id, token_id
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query balance breakdown")
+	}
+	defer rows.Close()
+
+	balances := make([]*TokenBalanceBreakdown, 0)
+	for rows.Next() {
+		var (
+			balance TokenBalanceBreakdown
+			amounts [5]string
+		)
+		if err := rows.Scan(
+			&balance.ChainID,
+			&balance.TokenID,
+			&balance.TokenSymbol,
+			&amounts[0],
+			&amounts[1],
+			&amounts[2],
+			&amounts[3],
+			&amounts[4],
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan balance breakdown")
+		}
+
+		targets := []**big.Float{&balance.Finalized, &balance.Available, &balance.PendingDeposit, &balance.FrozenWithdraw, &balance.Total}
+		for i, amountStr := range amounts {
+			amount, err := money.ParseFloat(amountStr)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse amount: %s", amountStr)
+			}
+			*targets[i] = amount
+		}
+
+		balances = append(balances, &balance)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate balance breakdown")
+	}
+
+	return balances, nil
+}
@@ -0,0 +1,110 @@
+package balance_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/test"
+	"github/chapool/go-wallet/internal/test/fixtures"
+	"github/chapool/go-wallet/internal/wallet/balance"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBalanceBreakdownReleasesConfirmedWithdraw(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		token, err := models.Tokens(qm.OrderBy(models.TokenColumns.ID)).One(ctx, db)
+		require.NoError(t, err)
+
+		insertCredit := func(amount string, creditType models.CreditType, referenceID string, referenceType models.ReferenceType, eventIndex int) {
+			t.Helper()
+			credit := &models.Credit{
+				UserID:        fix.User1.ID,
+				Address:       "0x8589427373d6d84e98730d7795d8f6f8731fda16",
+				TokenID:       token.ID,
+				TokenSymbol:   token.TokenSymbol,
+				Amount:        amount,
+				CreditType:    creditType,
+				BusinessType:  models.BusinessTypeBlockchain,
+				ReferenceID:   referenceID,
+				ReferenceType: referenceType,
+				ChainID:       null.IntFrom(token.ChainID),
+				ChainType:     null.StringFrom(token.ChainType),
+				Status:        models.CreditStatusFinalized,
+				EventIndex:    null.IntFrom(eventIndex),
+			}
+			if referenceType == models.ReferenceTypeWithdraw {
+				credit.Status = models.CreditStatusFrozen
+			}
+			require.NoError(t, credit.Insert(ctx, db, boil.Infer()))
+		}
+
+		withdraw := &models.Withdraw{
+			UserID:    fix.User1.ID,
+			ToAddress: "0x0000000000000000000000000000000000000001",
+			TokenID:   token.ID,
+			Amount:    "10",
+			Fee:       "1",
+			ChainID:   token.ChainID,
+			ChainType: token.ChainType,
+			Status:    models.WithdrawStatusPending,
+		}
+		require.NoError(t, withdraw.Insert(ctx, db, boil.Infer()))
+
+		// 充值 credits 为代币最小单位，提现 credits 为代币单位
+		depositAmount, err := money.ToSmallestUnit("100", token.Decimals)
+		require.NoError(t, err)
+		insertCredit(depositAmount.String(), models.CreditTypeDeposit, "0xdeposit_0", models.ReferenceTypeBlockchainTX, 0)
+		pendingAmount, err := money.ToSmallestUnit("5", token.Decimals)
+		require.NoError(t, err)
+		pendingDeposit := &models.Credit{
+			UserID:        fix.User1.ID,
+			Address:       "0x8589427373d6d84e98730d7795d8f6f8731fda16",
+			TokenID:       token.ID,
+			TokenSymbol:   token.TokenSymbol,
+			Amount:        pendingAmount.String(),
+			CreditType:    models.CreditTypeDeposit,
+			BusinessType:  models.BusinessTypeBlockchain,
+			ReferenceID:   "0xdeposit_1",
+			ReferenceType: models.ReferenceTypeBlockchainTX,
+			ChainID:       null.IntFrom(token.ChainID),
+			ChainType:     null.StringFrom(token.ChainType),
+			Status:        models.CreditStatusPending,
+			EventIndex:    null.IntFrom(0),
+		}
+		require.NoError(t, pendingDeposit.Insert(ctx, db, boil.Infer()))
+		insertCredit("-10", models.CreditTypeWithdraw, withdraw.ID, models.ReferenceTypeWithdraw, 0)
+		insertCredit("-1", models.CreditTypeWithdrawFee, withdraw.ID, models.ReferenceTypeWithdraw, 1)
+
+		service := balance.NewService(db)
+
+		breakdown, err := service.GetBalanceBreakdown(ctx, fix.User1.ID, &token.ChainID)
+		require.NoError(t, err)
+		require.Len(t, breakdown, 1)
+		assert.Equal(t, "100", breakdown[0].Finalized.Text('f', -1))
+		assert.Equal(t, "89", breakdown[0].Available.Text('f', -1))
+		assert.Equal(t, "5", breakdown[0].PendingDeposit.Text('f', -1))
+		assert.Equal(t, "11", breakdown[0].FrozenWithdraw.Text('f', -1))
+		assert.Equal(t, "105", breakdown[0].Total.Text('f', -1))
+
+		withdraw.Status = models.WithdrawStatusConfirmed
+		_, err = withdraw.Update(ctx, db, boil.Whitelist(models.WithdrawColumns.Status))
+		require.NoError(t, err)
+
+		breakdown, err = service.GetBalanceBreakdown(ctx, fix.User1.ID, &token.ChainID)
+		require.NoError(t, err)
+		require.Len(t, breakdown, 1)
+		assert.Equal(t, "89", breakdown[0].Available.Text('f', -1))
+		assert.Equal(t, "0", breakdown[0].FrozenWithdraw.Text('f', -1))
+		assert.Equal(t, "94", breakdown[0].Total.Text('f', -1))
+	})
+}
@@ -0,0 +1,223 @@
+package balance
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github/chapool/go-wallet/internal/money"
+)
+
+// MaxBulkUsers 批量余额查询单次最多的用户数
+const MaxBulkUsers = 500
+
+// withdrawHoldSQL 提现冻结的 credits：'pending'/'frozen' 的提现扣减（含手续费及拒绝提现时的冲正），与 GetAvailableBalance 一致
+const withdrawHoldSQL = `credit_type IN ('withdraw', 'withdraw_fee', 'withdraw_reversal') AND status IN ('pending', 'frozen')`
+
+// withdrawInProgressSQL 进行中的提现冻结的 credits：withdrawHoldSQL 中父提现尚未确认、也没有被冲正（拒绝或释放）的部分
+// 提现 credits 确认后仍保持 'frozen'（作为已完成的扣减计入可用余额），只按状态统计会累计所有历史提现
+const withdrawInProgressSQL = withdrawHoldSQL + ` AND reference_type = 'withdraw' AND reference_id IN (
+	SELECT w.id::text FROM withdraws w
+	WHERE w.status <> 'confirmed'
+		AND NOT EXISTS (
+			SELECT 1 FROM credits r
+			WHERE r.reference_type = 'withdraw' AND r.reference_id = w.id::text AND r.credit_type = 'withdraw_reversal'
+		)
+)`
+
+// frozenWithdrawAmountSQL 进行中的提现冻结的金额（含手续费），正数
+const frozenWithdrawAmountSQL = `COALESCE(-SUM(` + TokenAmountSQL + `) FILTER (WHERE ` + withdrawInProgressSQL + `), 0)`
+
+// availableAmountSQL 可用余额：finalized 的 credits + 提现冻结的扣减，与 GetAvailableBalance 一致
+const availableAmountSQL = `COALESCE(SUM(` + TokenAmountSQL + `) FILTER (WHERE ` + EffectiveCreditSQL + `), 0)`
+
+// frozenAmountSQL 冻结余额：进行中的提现冻结的扣减取反 + 其他 frozen 状态的 credits
+const frozenAmountSQL = frozenWithdrawAmountSQL + `
+	+ COALESCE(SUM(` + TokenAmountSQL + `) FILTER (WHERE status = 'frozen' AND NOT (` + withdrawHoldSQL + `)), 0)`
+
+// BulkBalanceFilter 批量余额查询的代币过滤条件，字段为空表示不限
+type BulkBalanceFilter struct {
+	ChainID  *int
+	TokenIDs []int
+}
+
+// UserBalances 用户在各代币上的余额，没有余额的用户 Balances 为空
+type UserBalances struct {
+	UserID   string
+	Balances []*UserTokenBalance
+}
+
+// UserTokenBalance 用户单个代币的可用和冻结余额
+// 金额为代币单位：汇总时充值 credits（代币最小单位）按代币精度换算（TokenAmountSQL）
+type UserTokenBalance struct {
+	ChainID     int
+	TokenID     int
+	TokenSymbol string
+	Available   *big.Float // 与 GetAvailableBalance 相同的可提现余额
+	Frozen      *big.Float // 进行中的提现冻结的金额 + 被冻结未入账的充值（如隔离中的充值）
+}
+
+// GetBulkBalances 一次聚合查询多个用户的可用和冻结余额，按传入的用户顺序返回（用户 ID 转为小写，重复的只返回一次）
+func (s *service) GetBulkBalances(ctx context.Context, userIDs []string, filter *BulkBalanceFilter) ([]*UserBalances, error) {
+	userIDs = uniqueLowerStrings(userIDs)
+	if len(userIDs) > MaxBulkUsers {
+		return nil, errors.Errorf("at most %d users can be queried at once, got %d", MaxBulkUsers, len(userIDs))
+	}
+
+	result := make([]*UserBalances, 0, len(userIDs))
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	args := []any{pq.Array(userIDs)}
+	conditions := []string{"credits.user_id = ANY($1::uuid[])"}
+	if filter != nil && filter.ChainID != nil {
+		args = append(args, *filter.ChainID)
+		conditions = append(conditions, fmt.Sprintf("credits.chain_id = $%d", len(args)))
+	}
+	if filter != nil && len(filter.TokenIDs) > 0 {
+		tokenIDs := make([]int64, 0, len(filter.TokenIDs))
+		for _, tokenID := range filter.TokenIDs {
+			tokenIDs = append(tokenIDs, int64(tokenID))
+		}
+		args = append(args, pq.Array(tokenIDs))
+		conditions = append(conditions, fmt.Sprintf("credits.token_id = ANY($%d::integer[])", len(args)))
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT user_id, chain_id, token_id, token_symbol, available::text, frozen::text
+		FROM (
+			SELECT
+				credits.user_id, credits.chain_id, credits.token_id,
+				MAX(credits.token_symbol) AS token_symbol,
+				`+availableAmountSQL+` AS available,
+				`+frozenAmountSQL+` AS frozen
+			FROM credits
+			JOIN tokens t ON t.id = credits.token_id
+			WHERE `+strings.Join(conditions, " AND ")+`
+				AND status IN ('finalized', 'pending', 'frozen')
+			GROUP BY credits.user_id, credits.chain_id, credits.token_id
+		) balances
+		WHERE available <> 0 OR frozen <> 0
+		ORDER BY user_id, chain_id, token_id
+	`, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query bulk balances")
+	}
+	defer rows.Close()
+
+	byUser := make(map[string][]*UserTokenBalance, len(userIDs))
+	for rows.Next() {
+		var (
+			userID                  string
+			balance                 UserTokenBalance
+			availableStr, frozenStr string
+		)
+		if err := rows.Scan(&userID, &balance.ChainID, &balance.TokenID, &balance.TokenSymbol, &availableStr, &frozenStr); err != nil {
+			return nil, errors.Wrap(err, "failed to scan bulk balance")
+		}
+		if err := balance.parseAmounts(availableStr, frozenStr); err != nil {
+			return nil, err
+		}
+
+		byUser[userID] = append(byUser[userID], &balance)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate bulk balances")
+	}
+
+	for _, userID := range userIDs {
+		balances := byUser[userID]
+		if balances == nil {
+			balances = make([]*UserTokenBalance, 0)
+		}
+		result = append(result, &UserBalances{
+			UserID:   userID,
+			Balances: balances,
+		})
+	}
+
+	return result, nil
+}
+
+// GetOrgBalances 按 credits.org_id 聚合组织的可用和冻结余额，口径与 GetBulkBalances 一致
+func (s *service) GetOrgBalances(ctx context.Context, orgID string) ([]*UserTokenBalance, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT chain_id, token_id, token_symbol, available::text, frozen::text
+		FROM (
+			SELECT
+				credits.chain_id, credits.token_id,
+				MAX(credits.token_symbol) AS token_symbol,
+				`+availableAmountSQL+` AS available,
+				`+frozenAmountSQL+` AS frozen
+			FROM credits
+			JOIN tokens t ON t.id = credits.token_id
+			WHERE credits.org_id = $1
+				AND status IN ('finalized', 'pending', 'frozen')
+			GROUP BY credits.chain_id, credits.token_id
+		) balances
+		WHERE available <> 0 OR frozen <> 0
+		ORDER BY chain_id, token_id
+	`, orgID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query organization balances")
+	}
+	defer rows.Close()
+
+	balances := make([]*UserTokenBalance, 0)
+	for rows.Next() {
+		var (
+			balance                 UserTokenBalance
+			availableStr, frozenStr string
+		)
+		if err := rows.Scan(&balance.ChainID, &balance.TokenID, &balance.TokenSymbol, &availableStr, &frozenStr); err != nil {
+			return nil, errors.Wrap(err, "failed to scan organization balance")
+		}
+		if err := balance.parseAmounts(availableStr, frozenStr); err != nil {
+			return nil, err
+		}
+
+		balances = append(balances, &balance)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate organization balances")
+	}
+
+	return balances, nil
+}
+
+// uniqueLowerStrings 转为小写并去除重复的值，保持原有顺序
+func uniqueLowerStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	unique := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.ToLower(value)
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		unique = append(unique, value)
+	}
+
+	return unique
+}
+
+// parseAmounts 解析查询返回的可用和冻结余额
+func (b *UserTokenBalance) parseAmounts(availableStr, frozenStr string) error {
+	var err error
+	b.Available, err = money.ParseFloat(availableStr)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse available amount: %s", availableStr)
+	}
+	b.Frozen, err = money.ParseFloat(frozenStr)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse frozen amount: %s", frozenStr)
+	}
+	return nil
+}
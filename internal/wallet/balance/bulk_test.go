@@ -0,0 +1,85 @@
+package balance_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/test"
+	"github/chapool/go-wallet/internal/test/fixtures"
+	"github/chapool/go-wallet/internal/wallet/balance"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBulkBalancesInTokenUnits(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		token, err := models.Tokens(qm.OrderBy(models.TokenColumns.ID)).One(ctx, db)
+		require.NoError(t, err)
+
+		withdraw := &models.Withdraw{
+			UserID:    fix.User1.ID,
+			ToAddress: "0x0000000000000000000000000000000000000001",
+			TokenID:   token.ID,
+			Amount:    "2.5",
+			Fee:       "0",
+			ChainID:   token.ChainID,
+			ChainType: token.ChainType,
+			Status:    models.WithdrawStatusPending,
+		}
+		require.NoError(t, withdraw.Insert(ctx, db, boil.Infer()))
+
+		// 充值 credits 为代币最小单位，提现 credits 为代币单位
+		depositAmount, err := money.ToSmallestUnit("10", token.Decimals)
+		require.NoError(t, err)
+		credits := []*models.Credit{
+			{
+				Amount:        depositAmount.String(),
+				CreditType:    models.CreditTypeDeposit,
+				ReferenceID:   "0xdeposit_0",
+				ReferenceType: models.ReferenceTypeBlockchainTX,
+				Status:        models.CreditStatusFinalized,
+			},
+			{
+				Amount:        "-2.5",
+				CreditType:    models.CreditTypeWithdraw,
+				ReferenceID:   withdraw.ID,
+				ReferenceType: models.ReferenceTypeWithdraw,
+				Status:        models.CreditStatusFrozen,
+			},
+		}
+		for _, credit := range credits {
+			credit.UserID = fix.User1.ID
+			credit.Address = "0x8589427373d6d84e98730d7795d8f6f8731fda16"
+			credit.TokenID = token.ID
+			credit.TokenSymbol = token.TokenSymbol
+			credit.BusinessType = models.BusinessTypeBlockchain
+			credit.ChainID = null.IntFrom(token.ChainID)
+			credit.ChainType = null.StringFrom(token.ChainType)
+			credit.EventIndex = null.IntFrom(0)
+			require.NoError(t, credit.Insert(ctx, db, boil.Infer()))
+		}
+
+		service := balance.NewService(db)
+
+		// 提现检查使用的可用余额与批量余额一致
+		available, err := service.GetAvailableBalance(ctx, db, fix.User1.ID, token.ChainID, token.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "7.5", available.Text('f', -1))
+
+		balances, err := service.GetBulkBalances(ctx, []string{fix.User1.ID}, nil)
+		require.NoError(t, err)
+		require.Len(t, balances, 1)
+		require.Len(t, balances[0].Balances, 1)
+		assert.Equal(t, "7.5", balances[0].Balances[0].Available.Text('f', -1))
+		assert.Equal(t, "2.5", balances[0].Balances[0].Frozen.Text('f', -1))
+	})
+}
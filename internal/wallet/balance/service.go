@@ -6,16 +6,23 @@ import (
 	"database/sql"
 	"math/big"
 
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/pkg/errors"
 )
 
 const (
-	// bigFloatBase 用于 big.ParseFloat 的基数（十进制）
-	bigFloatBase = 10
-	// bigFloatPrecision 用于 big.ParseFloat 的精度位数
-	bigFloatPrecision = 256
 	// chainIDFilterSQL SQL 查询中的 chain_id 过滤条件
-	chainIDFilterSQL = ` AND chain_id = $2`
+	chainIDFilterSQL = ` AND credits.chain_id = $2`
+
+	// EffectiveCreditSQL 计入可用余额的 credits 条件（与 GetAvailableBalance 一致），供其他按余额口径统计的服务复用
+	EffectiveCreditSQL = `(status = 'finalized' OR (` + withdrawHoldSQL + `))`
+
+	// TokenAmountSQL credits 金额换算为代币单位：充值及充值手续费 credits 为代币最小单位，按代币精度换算，其他 credits 已是代币单位
+	// 使用的查询需要以别名 t 关联 tokens 表（JOIN tokens t ON t.id = credits.token_id）
+	TokenAmountSQL = `(CASE WHEN credit_type IN ('deposit', 'deposit_fee') THEN amount::numeric * POWER(10::numeric, -t.decimals) ELSE amount::numeric END)`
 )
 
 // Service 余额服务接口
@@ -32,8 +39,17 @@ type Service interface {
 	// GetBalanceByToken 按代币分组获取余额列表
 	GetBalanceByToken(ctx context.Context, userID string, chainID *int) ([]*TokenBalance, error)
 
-	// GetAvailableBalance 获取可用余额（用于提现检查）
-	GetAvailableBalance(ctx context.Context, userID string, chainID int, tokenID int) (*big.Float, error)
+	// GetBalanceBreakdown 按代币获取可用、充值中、提现冻结和合计余额（单次聚合查询）
+	GetBalanceBreakdown(ctx context.Context, userID string, chainID *int) ([]*TokenBalanceBreakdown, error)
+
+	// GetAvailableBalance 获取可用余额（用于提现检查，传入事务时在锁定用户后读取）
+	GetAvailableBalance(ctx context.Context, exec boil.ContextExecutor, userID string, chainID int, tokenID int) (*big.Float, error)
+
+	// GetBulkBalances 批量获取多个用户的可用和冻结余额（单次聚合查询，最多 MaxBulkUsers 个用户）
+	GetBulkBalances(ctx context.Context, userIDs []string, filter *BulkBalanceFilter) ([]*UserBalances, error)
+
+	// GetOrgBalances 获取组织所有成员在各代币上的可用和冻结余额合计（组织的隔离账务）
+	GetOrgBalances(ctx context.Context, orgID string) ([]*UserTokenBalance, error)
 }
 
 // service 实现 Service 接口
@@ -52,7 +68,7 @@ func NewService(db *sql.DB) Service {
 
 // Balance 余额信息
 type Balance struct {
-	TotalAmount *big.Float // 总余额（字符串转 big.Float）
+	TotalAmount *big.Float // 总余额（代币单位，字符串转 big.Float）
 	TokenCount  int        // 代币种类数
 }
 
@@ -62,7 +78,7 @@ type TokenBalance struct {
 	TokenSymbol string
 	ChainID     int
 	Amount      *big.Float
-	Status      string // 余额状态：pending, confirmed, finalized
+	Status      models.CreditStatus // 余额状态：pending, confirmed, finalized
 }
 
 // GetPendingDepositBalance 获取充值中余额（状态为 pending/confirmed 的充值）
@@ -73,10 +89,11 @@ func (s *service) GetPendingDepositBalance(ctx context.Context, userID string, c
 
 	query := `
 		SELECT 
-			COALESCE(SUM(amount::numeric), 0)::text as total_amount,
-			COUNT(DISTINCT token_id) as token_count
+			COALESCE(SUM(` + TokenAmountSQL + `), 0)::text as total_amount,
+			COUNT(DISTINCT credits.token_id) as token_count
 		FROM credits
-		WHERE user_id = $1 
+		JOIN tokens t ON t.id = credits.token_id
+		WHERE credits.user_id = $1
 			AND credit_type = 'deposit'
 			AND status IN ('pending', 'confirmed')
 	`
@@ -92,7 +109,7 @@ func (s *service) GetPendingDepositBalance(ctx context.Context, userID string, c
 		return nil, errors.Wrap(err, "failed to query pending deposit balance")
 	}
 
-	totalAmount, _, err := big.ParseFloat(totalAmountStr, bigFloatBase, bigFloatPrecision, big.ToNearestEven)
+	totalAmount, err := money.ParseFloat(totalAmountStr)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to parse total amount: %s", totalAmountStr)
 	}
@@ -111,10 +128,11 @@ func (s *service) GetTotalBalance(ctx context.Context, userID string, chainID *i
 
 	query := `
 		SELECT 
-			COALESCE(SUM(amount::numeric), 0)::text as total_amount,
-			COUNT(DISTINCT token_id) as token_count
+			COALESCE(SUM(` + TokenAmountSQL + `), 0)::text as total_amount,
+			COUNT(DISTINCT credits.token_id) as token_count
 		FROM credits
-		WHERE user_id = $1 
+		JOIN tokens t ON t.id = credits.token_id
+		WHERE credits.user_id = $1
 			AND status = 'finalized'
 	`
 	args := []interface{}{userID}
@@ -130,7 +148,7 @@ func (s *service) GetTotalBalance(ctx context.Context, userID string, chainID *i
 		return nil, errors.Wrap(err, "failed to query total balance")
 	}
 
-	totalAmount, _, err := big.ParseFloat(totalAmountStr, bigFloatBase, bigFloatPrecision, big.ToNearestEven)
+	totalAmount, err := money.ParseFloat(totalAmountStr)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to parse total amount: %s", totalAmountStr)
 	}
@@ -148,14 +166,15 @@ func (s *service) GetTokenBalance(ctx context.Context, userID string, chainID in
 
 	query := `
 		SELECT 
-			COALESCE(SUM(amount::numeric), 0)::text as total_amount,
-			MAX(token_symbol) as token_symbol
+			COALESCE(SUM(` + TokenAmountSQL + `), 0)::text as total_amount,
+			MAX(credits.token_symbol) as token_symbol
 		FROM credits
-		WHERE user_id = $1 
-			AND chain_id = $2
-			AND token_id = $3
+		JOIN tokens t ON t.id = credits.token_id
+		WHERE credits.user_id = $1
+			AND credits.chain_id = $2
+			AND credits.token_id = $3
 			AND status = 'finalized'
-		GROUP BY token_id
+		GROUP BY credits.token_id
 	`
 
 	err := s.db.QueryRowContext(ctx, query, userID, chainID, tokenID).Scan(&totalAmountStr, &tokenSymbol)
@@ -168,13 +187,13 @@ func (s *service) GetTokenBalance(ctx context.Context, userID string, chainID in
 				TokenSymbol: "",
 				ChainID:     chainID,
 				Amount:      big.NewFloat(0),
-				Status:      "finalized",
+				Status:      models.CreditStatusFinalized,
 			}, nil
 		}
 		return nil, errors.Wrap(err, "failed to query token balance")
 	}
 
-	totalAmount, _, err := big.ParseFloat(totalAmountStr, bigFloatBase, bigFloatPrecision, big.ToNearestEven)
+	totalAmount, err := money.ParseFloat(totalAmountStr)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to parse amount: %s", totalAmountStr)
 	}
@@ -184,7 +203,7 @@ func (s *service) GetTokenBalance(ctx context.Context, userID string, chainID in
 		TokenSymbol: tokenSymbol,
 		ChainID:     chainID,
 		Amount:      totalAmount,
-		Status:      "finalized",
+		Status:      models.CreditStatusFinalized,
 	}, nil
 }
 
@@ -192,13 +211,14 @@ func (s *service) GetTokenBalance(ctx context.Context, userID string, chainID in
 func (s *service) GetBalanceByToken(ctx context.Context, userID string, chainID *int) ([]*TokenBalance, error) {
 	query := `
 		SELECT 
-			token_id,
-			token_symbol,
-			chain_id,
-			COALESCE(SUM(amount::numeric), 0)::text as total_amount,
+			credits.token_id,
+			credits.token_symbol,
+			credits.chain_id,
+			COALESCE(SUM(` + TokenAmountSQL + `), 0)::text as total_amount,
 			'finalized' as status
 		FROM credits
-		WHERE user_id = $1 
+		JOIN tokens t ON t.id = credits.token_id
+		WHERE credits.user_id = $1
 			AND status = 'finalized'
 	`
 	args := []interface{}{userID}
@@ -209,9 +229,9 @@ func (s *service) GetBalanceByToken(ctx context.Context, userID string, chainID
 	}
 
 	query += `
-		GROUP BY token_id, token_symbol, chain_id
-		HAVING SUM(amount::numeric) > 0
-		ORDER BY chain_id, token_id
+		GROUP BY credits.token_id, credits.token_symbol, credits.chain_id
+		HAVING SUM(` + TokenAmountSQL + `) > 0
+		ORDER BY credits.chain_id, credits.token_id
 	`
 
 	rows, err := s.db.QueryContext(ctx, query, args...)
@@ -223,13 +243,16 @@ func (s *service) GetBalanceByToken(ctx context.Context, userID string, chainID
 	var balances []*TokenBalance
 	for rows.Next() {
 		var tokenID, chainIDVal int
-		var tokenSymbol, totalAmountStr, status string
+		var (
+			tokenSymbol, totalAmountStr string
+			status                      models.CreditStatus
+		)
 
 		if err := rows.Scan(&tokenID, &tokenSymbol, &chainIDVal, &totalAmountStr, &status); err != nil {
 			return nil, errors.Wrap(err, "failed to scan token balance")
 		}
 
-		totalAmount, _, err := big.ParseFloat(totalAmountStr, bigFloatBase, bigFloatPrecision, big.ToNearestEven)
+		totalAmount, err := money.ParseFloat(totalAmountStr)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to parse amount: %s", totalAmountStr)
 		}
@@ -251,30 +274,30 @@ func (s *service) GetBalanceByToken(ctx context.Context, userID string, chainID
 }
 
 // GetAvailableBalance 获取可用余额
-// 计算逻辑：SUM(finalized credits) + SUM(pending/frozen withdraw credits)
+// 计算逻辑：SUM(finalized credits) + SUM(pending/frozen withdraw / withdraw_fee / withdraw_reversal credits)
+// 金额为代币单位：充值 credits（代币最小单位）按代币精度换算（TokenAmountSQL）
+// 拒绝提现时写入状态相同、金额相反的冲正记录，冻结金额随之抵消
 // 前提：提现时必须创建负数金额的 credits 记录
 // 注意：'processing' 和 'signing' 是 withdraw_status 枚举值，不是 credit_status 枚举值
-func (s *service) GetAvailableBalance(ctx context.Context, userID string, chainID int, tokenID int) (*big.Float, error) {
+func (s *service) GetAvailableBalance(ctx context.Context, exec boil.ContextExecutor, userID string, chainID int, tokenID int) (*big.Float, error) {
 	var totalAmountStr string
 
 	query := `
-		SELECT COALESCE(SUM(amount::numeric), 0)::text
+		SELECT COALESCE(SUM(` + TokenAmountSQL + `), 0)::text
 		FROM credits
-		WHERE user_id = $1 
-			AND chain_id = $2
-			AND token_id = $3
-			AND (
-				status = 'finalized' 
-				OR (credit_type = 'withdraw' AND status IN ('pending', 'frozen'))
-			)
+		JOIN tokens t ON t.id = credits.token_id
+		WHERE credits.user_id = $1
+			AND credits.chain_id = $2
+			AND credits.token_id = $3
+			AND ` + EffectiveCreditSQL + `
 	`
 
-	err := s.db.QueryRowContext(ctx, query, userID, chainID, tokenID).Scan(&totalAmountStr)
+	err := exec.QueryRowContext(ctx, query, userID, chainID, tokenID).Scan(&totalAmountStr)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to query available balance")
 	}
 
-	amount, _, err := big.ParseFloat(totalAmountStr, bigFloatBase, bigFloatPrecision, big.ToNearestEven)
+	amount, err := money.ParseFloat(totalAmountStr)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to parse amount: %s", totalAmountStr)
 	}
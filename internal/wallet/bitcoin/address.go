@@ -0,0 +1,139 @@
+package bitcoin
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ripemd160" //nolint:staticcheck // HASH160 按比特币协议使用 RIPEMD-160
+)
+
+// 比特币主网地址参数（chain_type = bitcoin 固定为主网）
+const (
+	// MainNetHRP 主网隔离见证地址前缀
+	MainNetHRP = "bc"
+	// PubKeyHashSize 公钥哈希（HASH160）长度
+	PubKeyHashSize = 20
+
+	p2pkhVersion = 0x00
+	p2shVersion  = 0x05
+)
+
+// 脚本操作码
+const (
+	opDup         = 0x76
+	opHash160     = 0xa9
+	opEqual       = 0x87
+	opEqualVerify = 0x88
+	opCheckSig    = 0xac
+	op1           = 0x51
+)
+
+// base58Alphabet 比特币 base58 字母表
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// Hash160 RIPEMD160(SHA256(data))
+func Hash160(data []byte) []byte {
+	sha := sha256.Sum256(data)
+	hasher := ripemd160.New()
+	hasher.Write(sha[:])
+	return hasher.Sum(nil)
+}
+
+// doubleSHA256 SHA256(SHA256(data))
+func doubleSHA256(data []byte) []byte {
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	return second[:]
+}
+
+// AddressFromPublicKey 由压缩公钥生成 P2WPKH 地址（BIP-84）
+func AddressFromPublicKey(compressedPublicKey []byte) (string, error) {
+	if len(compressedPublicKey) != 33 {
+		return "", errors.Errorf("expected 33 byte compressed public key, got %d bytes", len(compressedPublicKey))
+	}
+	return encodeSegwitAddress(MainNetHRP, 0, Hash160(compressedPublicKey))
+}
+
+// P2WPKHScript 公钥哈希对应的 P2WPKH 锁定脚本
+func P2WPKHScript(pubKeyHash []byte) []byte {
+	return append([]byte{0x00, byte(len(pubKeyHash))}, pubKeyHash...)
+}
+
+// AddressScript 解析主网地址并返回锁定脚本，支持隔离见证地址（bech32/bech32m）和 P2PKH、P2SH 地址（base58check）
+func AddressScript(address string) ([]byte, error) {
+	if strings.HasPrefix(strings.ToLower(address), MainNetHRP+"1") {
+		version, program, err := decodeSegwitAddress(MainNetHRP, address)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid bitcoin address %s", address)
+		}
+
+		opcode := byte(0x00)
+		if version > 0 {
+			opcode = op1 + version - 1
+		}
+		return append([]byte{opcode, byte(len(program))}, program...), nil
+	}
+
+	payload, err := decodeBase58Check(address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid bitcoin address %s", address)
+	}
+	if len(payload) != 21 {
+		return nil, errors.Errorf("invalid bitcoin address %s: unexpected payload length %d", address, len(payload))
+	}
+
+	hash := payload[1:]
+	switch payload[0] {
+	case p2pkhVersion:
+		script := append([]byte{opDup, opHash160, 0x14}, hash...)
+		return append(script, opEqualVerify, opCheckSig), nil
+	case p2shVersion:
+		script := append([]byte{opHash160, 0x14}, hash...)
+		return append(script, opEqual), nil
+	default:
+		return nil, errors.Errorf("invalid bitcoin address %s: not a mainnet address", address)
+	}
+}
+
+// IsValidAddress 判断是否为合法的比特币主网地址
+func IsValidAddress(address string) bool {
+	_, err := AddressScript(address)
+	return err == nil
+}
+
+// decodeBase58Check 解码 base58check 字符串并校验 4 字节校验和
+func decodeBase58Check(s string) ([]byte, error) {
+	if s == "" {
+		return nil, errors.New("empty base58 string")
+	}
+
+	n := new(big.Int)
+	radix := big.NewInt(58)
+	for i := 0; i < len(s); i++ {
+		idx := strings.IndexByte(base58Alphabet, s[i])
+		if idx < 0 {
+			return nil, errors.Errorf("invalid base58 character %q", s[i])
+		}
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(idx)))
+	}
+
+	zeros := 0
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+	decoded := append(make([]byte, zeros), n.Bytes()...)
+	if len(decoded) < 5 {
+		return nil, errors.New("base58check string too short")
+	}
+
+	payload, checksum := decoded[:len(decoded)-4], decoded[len(decoded)-4:]
+	if !bytes.Equal(doubleSHA256(payload)[:4], checksum) {
+		return nil, errors.New("invalid base58check checksum")
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,185 @@
+package bitcoin
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// bech32Charset BIP-173 字符集
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// bech32 校验和常量：witness v0 使用 bech32（BIP-173），v1 及以上使用 bech32m（BIP-350）
+const (
+	bech32Const  = 1
+	bech32mConst = 0x2bc830a3
+)
+
+// bech32MaxLength bech32 字符串最大长度
+const bech32MaxLength = 90
+
+// bech32Polymod 计算 bech32 校验和多项式
+func bech32Polymod(values []byte) uint32 {
+	generators := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= generators[i]
+			}
+		}
+	}
+	return chk
+}
+
+// bech32HRPExpand 展开 HRP 用于计算校验和
+func bech32HRPExpand(hrp string) []byte {
+	expanded := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]>>5)
+	}
+	expanded = append(expanded, 0)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]&31)
+	}
+	return expanded
+}
+
+// bech32Encode 编码 5 位分组数据，checksumConst 区分 bech32 和 bech32m
+func bech32Encode(hrp string, data []byte, checksumConst uint32) string {
+	values := append(bech32HRPExpand(hrp), data...)
+	values = append(values, 0, 0, 0, 0, 0, 0)
+	polymod := bech32Polymod(values) ^ checksumConst
+
+	var sb strings.Builder
+	sb.Grow(len(hrp) + 1 + len(data) + 6)
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, v := range data {
+		sb.WriteByte(bech32Charset[v])
+	}
+	for i := 0; i < 6; i++ {
+		sb.WriteByte(bech32Charset[(polymod>>(5*(5-i)))&31])
+	}
+	return sb.String()
+}
+
+// bech32Decode 解码 bech32/bech32m 字符串，返回 HRP、5 位分组数据（不含校验和）和校验和常量
+func bech32Decode(s string) (string, []byte, uint32, error) {
+	if len(s) > bech32MaxLength {
+		return "", nil, 0, errors.New("bech32 string too long")
+	}
+	if strings.ToLower(s) != s && strings.ToUpper(s) != s {
+		return "", nil, 0, errors.New("bech32 string has mixed case")
+	}
+	s = strings.ToLower(s)
+
+	sep := strings.LastIndexByte(s, '1')
+	if sep < 1 || sep+7 > len(s) {
+		return "", nil, 0, errors.New("invalid bech32 separator position")
+	}
+
+	hrp := s[:sep]
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return "", nil, 0, errors.New("invalid bech32 human readable part")
+		}
+	}
+
+	data := make([]byte, 0, len(s)-sep-1)
+	for i := sep + 1; i < len(s); i++ {
+		idx := strings.IndexByte(bech32Charset, s[i])
+		if idx < 0 {
+			return "", nil, 0, errors.Errorf("invalid bech32 character %q", s[i])
+		}
+		data = append(data, byte(idx))
+	}
+
+	checksumConst := bech32Polymod(append(bech32HRPExpand(hrp), data...))
+	if checksumConst != bech32Const && checksumConst != bech32mConst {
+		return "", nil, 0, errors.New("invalid bech32 checksum")
+	}
+
+	return hrp, data[:len(data)-6], checksumConst, nil
+}
+
+// convertBits 在不同位宽的分组之间转换（8 位字节与 5 位分组）
+func convertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	acc := uint32(0)
+	bits := uint(0)
+	maxv := uint32(1)<<toBits - 1
+	converted := make([]byte, 0, len(data)*int(fromBits)/int(toBits)+1)
+	for _, v := range data {
+		if uint32(v)>>fromBits != 0 {
+			return nil, errors.New("invalid data range")
+		}
+		acc = acc<<fromBits | uint32(v)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			converted = append(converted, byte(acc>>bits&maxv))
+		}
+	}
+
+	if pad {
+		if bits > 0 {
+			converted = append(converted, byte(acc<<(toBits-bits)&maxv))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxv != 0 {
+		return nil, errors.New("invalid padding")
+	}
+
+	return converted, nil
+}
+
+// encodeSegwitAddress 编码隔离见证地址
+func encodeSegwitAddress(hrp string, version byte, program []byte) (string, error) {
+	data, err := convertBits(program, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	checksumConst := uint32(bech32Const)
+	if version > 0 {
+		checksumConst = bech32mConst
+	}
+
+	return bech32Encode(hrp, append([]byte{version}, data...), checksumConst), nil
+}
+
+// decodeSegwitAddress 解码隔离见证地址，返回见证版本和见证程序
+func decodeSegwitAddress(hrp string, address string) (byte, []byte, error) {
+	decodedHRP, data, checksumConst, err := bech32Decode(address)
+	if err != nil {
+		return 0, nil, err
+	}
+	if decodedHRP != hrp {
+		return 0, nil, errors.Errorf("unexpected address prefix %s", decodedHRP)
+	}
+	if len(data) == 0 {
+		return 0, nil, errors.New("empty witness data")
+	}
+
+	version := data[0]
+	if version > 16 {
+		return 0, nil, errors.Errorf("invalid witness version %d", version)
+	}
+	if (version == 0 && checksumConst != bech32Const) || (version > 0 && checksumConst != bech32mConst) {
+		return 0, nil, errors.New("checksum variant does not match witness version")
+	}
+
+	program, err := convertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(program) < 2 || len(program) > 40 {
+		return 0, nil, errors.Errorf("invalid witness program length %d", len(program))
+	}
+	if version == 0 && len(program) != 20 && len(program) != 32 {
+		return 0, nil, errors.Errorf("invalid witness v0 program length %d", len(program))
+	}
+
+	return version, program, nil
+}
@@ -0,0 +1,333 @@
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+const (
+	// txVersion 交易版本
+	txVersion = 2
+	// sequenceRBF 不启用相对时间锁，允许手续费替换（BIP-125）
+	sequenceRBF = 0xfffffffd
+	// sigHashAll SIGHASH_ALL
+	sigHashAll = 0x01
+	// witnessScaleFactor 隔离见证权重系数
+	witnessScaleFactor = 4
+)
+
+// p2wpkh 输入的大小（字节）：outpoint 36 + 空 scriptSig 1 + sequence 4；
+// 见证：项数 1 + 签名长度 1 + DER 签名最大 72 + sighash 1 + 公钥长度 1 + 压缩公钥 33
+const (
+	p2wpkhInputBaseSize    = 41
+	p2wpkhInputWitnessSize = 109
+)
+
+// TxIn 交易输入，花费 P2WPKH 输出
+type TxIn struct {
+	TxID     string // 被花费交易的 txid（十六进制，区块浏览器显示的字节序）
+	Vout     uint32
+	Sequence uint32
+	Amount   int64  // 被花费输出的金额（聪），BIP-143 签名需要
+	PkScript []byte // 被花费输出的锁定脚本
+	Witness  [][]byte
+}
+
+// TxOut 交易输出
+type TxOut struct {
+	Value    int64 // 金额（聪）
+	PkScript []byte
+}
+
+// Transaction 隔离见证交易
+type Transaction struct {
+	Version  int32
+	Inputs   []*TxIn
+	Outputs  []*TxOut
+	LockTime uint32
+}
+
+// NewTransaction 创建空交易
+func NewTransaction() *Transaction {
+	return &Transaction{Version: txVersion}
+}
+
+// AddInput 添加花费 P2WPKH 输出的输入
+func (t *Transaction) AddInput(txID string, vout uint32, amount int64, pkScript []byte) error {
+	if _, err := txIDBytes(txID); err != nil {
+		return err
+	}
+	if !isP2WPKHScript(pkScript) {
+		return errors.Errorf("input %s:%d is not a P2WPKH output", txID, vout)
+	}
+
+	t.Inputs = append(t.Inputs, &TxIn{
+		TxID:     txID,
+		Vout:     vout,
+		Sequence: sequenceRBF,
+		Amount:   amount,
+		PkScript: pkScript,
+	})
+	return nil
+}
+
+// AddOutput 添加输出
+func (t *Transaction) AddOutput(value int64, pkScript []byte) {
+	t.Outputs = append(t.Outputs, &TxOut{Value: value, PkScript: pkScript})
+}
+
+// Serialize 序列化交易，已签名时包含见证数据
+func (t *Transaction) Serialize() []byte {
+	return t.serialize(t.hasWitness())
+}
+
+// TxID 交易 ID（不含见证数据的双 SHA256，按显示字节序）
+func (t *Transaction) TxID() string {
+	hash := doubleSHA256(t.serialize(false))
+	return hex.EncodeToString(reverseBytes(hash))
+}
+
+// VirtualSize 交易的虚拟大小（vbytes）
+func (t *Transaction) VirtualSize() int64 {
+	baseSize := int64(len(t.serialize(false)))
+	totalSize := int64(len(t.serialize(true)))
+	weight := baseSize*(witnessScaleFactor-1) + totalSize
+	return (weight + witnessScaleFactor - 1) / witnessScaleFactor
+}
+
+// SignatureHash 计算输入的 BIP-143 签名哈希（SIGHASH_ALL）
+func (t *Transaction) SignatureHash(index int) ([]byte, error) {
+	if index < 0 || index >= len(t.Inputs) {
+		return nil, errors.Errorf("input index %d out of range", index)
+	}
+
+	var prevouts, sequences, outputs bytes.Buffer
+	for _, input := range t.Inputs {
+		if err := writeOutPoint(&prevouts, input); err != nil {
+			return nil, err
+		}
+		_ = binary.Write(&sequences, binary.LittleEndian, input.Sequence)
+	}
+	for _, output := range t.Outputs {
+		writeTxOut(&outputs, output)
+	}
+
+	input := t.Inputs[index]
+	// P2WPKH 的 scriptCode 为对应的 P2PKH 脚本
+	scriptCode := append([]byte{opDup, opHash160, 0x14}, input.PkScript[2:]...)
+	scriptCode = append(scriptCode, opEqualVerify, opCheckSig)
+
+	var preimage bytes.Buffer
+	_ = binary.Write(&preimage, binary.LittleEndian, t.Version)
+	preimage.Write(doubleSHA256(prevouts.Bytes()))
+	preimage.Write(doubleSHA256(sequences.Bytes()))
+	if err := writeOutPoint(&preimage, input); err != nil {
+		return nil, err
+	}
+	writeVarBytes(&preimage, scriptCode)
+	_ = binary.Write(&preimage, binary.LittleEndian, input.Amount)
+	_ = binary.Write(&preimage, binary.LittleEndian, input.Sequence)
+	preimage.Write(doubleSHA256(outputs.Bytes()))
+	_ = binary.Write(&preimage, binary.LittleEndian, t.LockTime)
+	_ = binary.Write(&preimage, binary.LittleEndian, uint32(sigHashAll))
+
+	return doubleSHA256(preimage.Bytes()), nil
+}
+
+// SignInput 使用 secp256k1 私钥签名输入，私钥必须对应输入花费的 P2WPKH 输出
+func (t *Transaction) SignInput(index int, privateKey []byte) error {
+	hash, err := t.SignatureHash(index)
+	if err != nil {
+		return err
+	}
+
+	key, err := crypto.ToECDSA(privateKey)
+	if err != nil {
+		return errors.Wrap(err, "invalid private key")
+	}
+	defer key.D.SetInt64(0)
+
+	publicKey := crypto.CompressPubkey(&key.PublicKey)
+	if !bytes.Equal(Hash160(publicKey), t.Inputs[index].PkScript[2:]) {
+		return errors.Errorf("private key does not match input %d", index)
+	}
+
+	signature, err := crypto.Sign(hash, key)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign input")
+	}
+
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:64])
+	// BIP-62 要求 low-S 签名
+	curveOrder := crypto.S256().Params().N
+	if s.Cmp(new(big.Int).Rsh(curveOrder, 1)) > 0 {
+		s.Sub(curveOrder, s)
+	}
+
+	t.Inputs[index].Witness = [][]byte{
+		append(encodeDERSignature(r, s), sigHashAll),
+		publicKey,
+	}
+	return nil
+}
+
+// EstimateVirtualSize 估算花费 inputCount 个 P2WPKH 输入、产生 outputScripts 输出的已签名交易虚拟大小
+func EstimateVirtualSize(inputCount int, outputScripts [][]byte) int64 {
+	// 版本 4 + 输入数量 + 输出数量 + locktime 4
+	baseSize := int64(4 + varIntSize(uint64(inputCount)) + varIntSize(uint64(len(outputScripts))) + 4)
+	baseSize += int64(inputCount) * p2wpkhInputBaseSize
+	for _, script := range outputScripts {
+		baseSize += int64(8 + varIntSize(uint64(len(script))) + len(script))
+	}
+
+	// 见证标记和标志 2 字节
+	witnessSize := int64(2 + inputCount*p2wpkhInputWitnessSize)
+
+	weight := baseSize*witnessScaleFactor + witnessSize
+	return (weight + witnessScaleFactor - 1) / witnessScaleFactor
+}
+
+// serialize 序列化交易，withWitness 为 true 时使用 BIP-144 格式
+func (t *Transaction) serialize(withWitness bool) []byte {
+	var buf bytes.Buffer
+	_ = binary.Write(&buf, binary.LittleEndian, t.Version)
+	if withWitness {
+		buf.Write([]byte{0x00, 0x01})
+	}
+
+	writeVarInt(&buf, uint64(len(t.Inputs)))
+	for _, input := range t.Inputs {
+		// 输入在 AddInput 时已校验 txid
+		_ = writeOutPoint(&buf, input)
+		writeVarInt(&buf, 0) // 隔离见证输入的 scriptSig 为空
+		_ = binary.Write(&buf, binary.LittleEndian, input.Sequence)
+	}
+
+	writeVarInt(&buf, uint64(len(t.Outputs)))
+	for _, output := range t.Outputs {
+		writeTxOut(&buf, output)
+	}
+
+	if withWitness {
+		for _, input := range t.Inputs {
+			writeVarInt(&buf, uint64(len(input.Witness)))
+			for _, item := range input.Witness {
+				writeVarBytes(&buf, item)
+			}
+		}
+	}
+
+	_ = binary.Write(&buf, binary.LittleEndian, t.LockTime)
+	return buf.Bytes()
+}
+
+// hasWitness 是否有输入已签名
+func (t *Transaction) hasWitness() bool {
+	for _, input := range t.Inputs {
+		if len(input.Witness) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// isP2WPKHScript 是否为 P2WPKH 锁定脚本
+func isP2WPKHScript(script []byte) bool {
+	return len(script) == 22 && script[0] == 0x00 && script[1] == 0x14
+}
+
+// txIDBytes 将显示字节序的 txid 转换为序列化使用的字节序
+func txIDBytes(txID string) ([]byte, error) {
+	decoded, err := hex.DecodeString(txID)
+	if err != nil || len(decoded) != 32 {
+		return nil, errors.Errorf("invalid txid %s", txID)
+	}
+	return reverseBytes(decoded), nil
+}
+
+// writeOutPoint 写入输入引用的 outpoint
+func writeOutPoint(buf *bytes.Buffer, input *TxIn) error {
+	txID, err := txIDBytes(input.TxID)
+	if err != nil {
+		return err
+	}
+	buf.Write(txID)
+	_ = binary.Write(buf, binary.LittleEndian, input.Vout)
+	return nil
+}
+
+// writeTxOut 写入输出
+func writeTxOut(buf *bytes.Buffer, output *TxOut) {
+	_ = binary.Write(buf, binary.LittleEndian, output.Value)
+	writeVarBytes(buf, output.PkScript)
+}
+
+// writeVarBytes 写入带长度前缀的字节串
+func writeVarBytes(buf *bytes.Buffer, data []byte) {
+	writeVarInt(buf, uint64(len(data)))
+	buf.Write(data)
+}
+
+// writeVarInt 写入 CompactSize 编码的整数
+func writeVarInt(buf *bytes.Buffer, n uint64) {
+	switch {
+	case n < 0xfd:
+		buf.WriteByte(byte(n))
+	case n <= 0xffff:
+		buf.WriteByte(0xfd)
+		_ = binary.Write(buf, binary.LittleEndian, uint16(n))
+	case n <= 0xffffffff:
+		buf.WriteByte(0xfe)
+		_ = binary.Write(buf, binary.LittleEndian, uint32(n))
+	default:
+		buf.WriteByte(0xff)
+		_ = binary.Write(buf, binary.LittleEndian, n)
+	}
+}
+
+// varIntSize CompactSize 编码的字节数
+func varIntSize(n uint64) int {
+	switch {
+	case n < 0xfd:
+		return 1
+	case n <= 0xffff:
+		return 3
+	case n <= 0xffffffff:
+		return 5
+	default:
+		return 9
+	}
+}
+
+// encodeDERSignature DER 编码 ECDSA 签名
+func encodeDERSignature(r, s *big.Int) []byte {
+	encodeInt := func(n *big.Int) []byte {
+		b := n.Bytes()
+		if len(b) == 0 {
+			b = []byte{0x00}
+		}
+		// 最高位为 1 时补零，避免被解析为负数
+		if b[0]&0x80 != 0 {
+			b = append([]byte{0x00}, b...)
+		}
+		return append([]byte{0x02, byte(len(b))}, b...)
+	}
+
+	body := append(encodeInt(r), encodeInt(s)...)
+	return append([]byte{0x30, byte(len(body))}, body...)
+}
+
+// reverseBytes 返回字节序反转后的副本
+func reverseBytes(data []byte) []byte {
+	reversed := make([]byte, len(data))
+	for i, b := range data {
+		reversed[len(data)-1-i] = b
+	}
+	return reversed
+}
@@ -0,0 +1,164 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+// bip143Transaction 构建 BIP-143 "Native P2WPKH" 示例中的未签名交易
+func bip143Transaction(t *testing.T) *Transaction {
+	t.Helper()
+
+	txID := func(serialized string) string {
+		return hex.EncodeToString(reverseBytes(mustHex(t, serialized)))
+	}
+
+	return &Transaction{
+		Version: 1,
+		Inputs: []*TxIn{
+			{
+				TxID:     txID("fff7f7881a8099afa6940d42d1e7f6362bec38171ea3edf433541db4e4ad969f"),
+				Vout:     0,
+				Sequence: 0xffffffee,
+				Amount:   625000000,
+				PkScript: mustHex(t, "2103c9f4836b9a4f77fc0d81f7bcb01b7f1b35916864b9476c241ce9fc198bd25432ac"),
+			},
+			{
+				TxID:     txID("ef51e1b804cc89d182d279655c3aa89e815b1b309fe287d9b2b55d57b90ec68a"),
+				Vout:     1,
+				Sequence: 0xffffffff,
+				Amount:   600000000,
+				PkScript: mustHex(t, "00141d0f172a0ecb48aee1be1f2687d2963ae33f71a1"),
+			},
+		},
+		Outputs: []*TxOut{
+			{Value: 112340000, PkScript: mustHex(t, "76a9148280b37df378db99f66f85c95a783a76ac7a6d5988ac")},
+			{Value: 223450000, PkScript: mustHex(t, "76a9143bde42dbee7e4dbe6a21b2d50ce2f0167faa815988ac")},
+		},
+		LockTime: 17,
+	}
+}
+
+func TestBIP143SignatureHash(t *testing.T) {
+	t.Parallel()
+
+	tx := bip143Transaction(t)
+	assert.Equal(t,
+		"0100000002fff7f7881a8099afa6940d42d1e7f6362bec38171ea3edf433541db4e4ad969f0000000000eeffffffef51e1b804cc89d182d279655c3aa89e815b1b309fe287d9b2b55d57b90ec68a0100000000ffffffff02202cb206000000001976a9148280b37df378db99f66f85c95a783a76ac7a6d5988ac9093510d000000001976a9143bde42dbee7e4dbe6a21b2d50ce2f0167faa815988ac11000000",
+		hex.EncodeToString(tx.Serialize()))
+
+	hash, err := tx.SignatureHash(1)
+	require.NoError(t, err)
+	assert.Equal(t, "c37af31116d1b27caf68aae9e3ac82f1477929014d5b917657d0eb49478cb670", hex.EncodeToString(hash))
+
+	// ECDSA 签名按 RFC 6979 确定性生成，与 BIP-143 示例一致
+	require.NoError(t, tx.SignInput(1, mustHex(t, "619c335025c7f4012e556c2a58b2506e30b8511b53ade95ea316fd8c3286feb9")))
+	assert.Equal(t, []string{
+		"304402203609e17b84f6a7d30c80bfa610b5b4542f32a8a0d5447a12fb1366d7f01cc44a0220573a954c4518331561406f90300e8f3358f51928d43c212a8caed02de67eebee01",
+		"025476c2e83188368da1ff3e292e7acafcdb3566bb0ad253f62fc70f07aeee6357",
+	}, []string{hex.EncodeToString(tx.Inputs[1].Witness[0]), hex.EncodeToString(tx.Inputs[1].Witness[1])})
+
+	// 私钥与输入不匹配时拒绝签名
+	require.Error(t, tx.SignInput(1, mustHex(t, "4604b4b710fe91f584fff084e1a9159fe4f8408fff380596a604948474ce4fa3")))
+}
+
+func TestAddressScript(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		address string
+		script  string
+	}{
+		{"bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", "0014c0cebcd6c3d3ca8c75dc5ec62ebe55330ef910e2"},
+		{"BC1QCR8TE4KR609GCAWUTMRZA0J4XV80JY8Z306FYU", "0014c0cebcd6c3d3ca8c75dc5ec62ebe55330ef910e2"},
+		{"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", "512079be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"},
+		{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "76a91477bff20c60e522dfaa3350c39b030a5d004e839a88ac"},
+		{"3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", "a914b472a266d0bd89c13706a4132ccfb16f7c3b9fcb87"},
+	}
+	for _, tt := range tests {
+		script, err := AddressScript(tt.address)
+		require.NoError(t, err, tt.address)
+		assert.Equal(t, tt.script, hex.EncodeToString(script), tt.address)
+	}
+
+	for _, invalid := range []string{
+		"",
+		"bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyv", // 校验和错误
+		"tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", // 测试网
+		"bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306FYU", // 大小写混合
+		"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3",         // base58 校验和错误
+		"0x9858EfFD232B4033E47d90003D41EC34EcaEda94", // EVM 地址
+	} {
+		assert.False(t, IsValidAddress(invalid), invalid)
+	}
+}
+
+func TestSelectUTXOs(t *testing.T) {
+	t.Parallel()
+
+	destination := mustHex(t, "0014c0cebcd6c3d3ca8c75dc5ec62ebe55330ef910e2")
+	change := mustHex(t, "00149c90f934ea51fa0f6504177043e0908da6929983")
+	utxos := []*UTXO{
+		{TxID: "01", Amount: 20_000},
+		{TxID: "02", Amount: 150_000},
+		{TxID: "03", Amount: 60_000},
+	}
+
+	// 最大的 UTXO 足够支付时只使用一个输入，余额找零
+	selection, err := SelectUTXOs(utxos, 100_000, 10, destination, change)
+	require.NoError(t, err)
+	require.Len(t, selection.Inputs, 1)
+	assert.Equal(t, "02", selection.Inputs[0].TxID)
+	assert.Equal(t, EstimateVirtualSize(1, [][]byte{destination, change})*10, selection.Fee)
+	assert.Equal(t, int64(150_000-100_000)-selection.Fee, selection.Change)
+
+	// 按金额从大到小累加输入
+	selection, err = SelectUTXOs(utxos, 200_000, 10, destination, change)
+	require.NoError(t, err)
+	require.Len(t, selection.Inputs, 2)
+	assert.Equal(t, "03", selection.Inputs[1].TxID)
+	assert.Equal(t, int64(210_000), 200_000+selection.Fee+selection.Change)
+
+	// 找零低于粉尘阈值时并入手续费
+	selection, err = SelectUTXOs(utxos, 150_000-EstimateVirtualSize(1, [][]byte{destination})*10-100, 10, destination, change)
+	require.NoError(t, err)
+	require.Len(t, selection.Inputs, 1)
+	assert.Zero(t, selection.Change)
+	assert.Equal(t, EstimateVirtualSize(1, [][]byte{destination})*10+100, selection.Fee)
+
+	_, err = SelectUTXOs(utxos, 230_000, 10, destination, change)
+	assert.True(t, errors.Is(err, ErrInsufficientFunds))
+}
+
+func TestEstimateVirtualSize(t *testing.T) {
+	t.Parallel()
+
+	destination := mustHex(t, "0014c0cebcd6c3d3ca8c75dc5ec62ebe55330ef910e2")
+	txID := "9f96ade4b41d5433f4eda31e1738ec2b36f6e7d1420d94a6af99801a88f7f7ff"
+	privateKey := mustHex(t, "4604b4b710fe91f584fff084e1a9159fe4f8408fff380596a604948474ce4fa3")
+
+	tx := NewTransaction()
+	require.NoError(t, tx.AddInput(txID, 0, 100_000, destination))
+	require.NoError(t, tx.AddInput(txID, 1, 100_000, destination))
+	tx.AddOutput(150_000, destination)
+	tx.AddOutput(40_000, destination)
+	require.NoError(t, tx.SignInput(0, privateKey))
+	require.NoError(t, tx.SignInput(1, privateKey))
+
+	// 估算按最长的 DER 签名计算，不低于实际大小且误差在每个输入 1 vbyte 内
+	estimated := EstimateVirtualSize(2, [][]byte{destination, destination})
+	assert.GreaterOrEqual(t, estimated, tx.VirtualSize())
+	assert.LessOrEqual(t, estimated-tx.VirtualSize(), int64(2))
+	assert.Len(t, tx.TxID(), 64)
+}
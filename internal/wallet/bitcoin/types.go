@@ -0,0 +1,75 @@
+package bitcoin
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// SatoshisPerBitcoin 1 BTC 对应的聪数
+const SatoshisPerBitcoin = 100_000_000
+
+// Amount 节点返回的 BTC 金额，解析为聪；直接解析 JSON 数字文本，避免浮点误差
+type Amount int64
+
+// UnmarshalJSON 解析以 BTC 为单位的 JSON 数字
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	value, ok := new(big.Rat).SetString(string(data))
+	if !ok {
+		return errors.Errorf("invalid bitcoin amount %s", data)
+	}
+
+	value.Mul(value, new(big.Rat).SetInt64(SatoshisPerBitcoin))
+	if !value.IsInt() || !value.Num().IsInt64() {
+		return errors.Errorf("invalid bitcoin amount %s", data)
+	}
+
+	*a = Amount(value.Num().Int64())
+	return nil
+}
+
+// Block getblock（verbosity 3）返回的区块，输入包含被花费输出的信息
+type Block struct {
+	Hash              string              `json:"hash"`
+	PreviousBlockHash string              `json:"previousblockhash"`
+	Height            int64               `json:"height"`
+	Time              int64               `json:"time"`
+	Transactions      []*BlockTransaction `json:"tx"`
+}
+
+// BlockTransaction 区块中的交易
+type BlockTransaction struct {
+	TxID    string      `json:"txid"`
+	Inputs  []*TxInput  `json:"vin"`
+	Outputs []*TxOutput `json:"vout"`
+}
+
+// TxInput 交易输入，coinbase 输入没有 txid
+type TxInput struct {
+	TxID     string    `json:"txid"`
+	Vout     uint32    `json:"vout"`
+	Coinbase string    `json:"coinbase"`
+	Prevout  *TxOutput `json:"prevout"`
+}
+
+// TxOutput 交易输出
+type TxOutput struct {
+	Value        Amount       `json:"value"`
+	N            uint32       `json:"n"`
+	ScriptPubKey ScriptPubKey `json:"scriptPubKey"`
+}
+
+// ScriptPubKey 输出的锁定脚本，非标准脚本没有地址
+type ScriptPubKey struct {
+	Hex     string `json:"hex"`
+	Address string `json:"address"`
+	Type    string `json:"type"`
+}
+
+// FromAddress 交易第一个输入花费的输出地址，coinbase 交易或节点未返回 prevout 时为空
+func (t *BlockTransaction) FromAddress() string {
+	if len(t.Inputs) == 0 || t.Inputs[0].Prevout == nil {
+		return ""
+	}
+	return t.Inputs[0].Prevout.ScriptPubKey.Address
+}
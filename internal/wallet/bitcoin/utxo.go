@@ -0,0 +1,64 @@
+package bitcoin
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// DustLimit P2WPKH 找零输出的粉尘阈值（聪），低于该金额的找零并入手续费
+const DustLimit = 294
+
+// ErrInsufficientFunds 可花费的 UTXO 不足以支付转账金额和手续费
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// UTXO 可花费的未花费输出
+type UTXO struct {
+	TxID           string
+	Vout           uint32
+	Amount         int64 // 金额（聪）
+	PkScript       []byte
+	DerivationPath string // 输出所属地址的派生路径，签名时派生私钥
+}
+
+// Selection UTXO 选择结果
+type Selection struct {
+	Inputs []*UTXO
+	Fee    int64 // 手续费（聪）
+	Change int64 // 找零金额（聪），0 表示没有找零输出
+}
+
+// SelectUTXOs 按金额从大到小选择 UTXO，直到覆盖转账金额和按 feeRate（聪/vbyte）估算的手续费
+// 找零低于粉尘阈值时不产生找零输出，差额并入手续费
+func SelectUTXOs(utxos []*UTXO, amount int64, feeRate int64, destinationScript []byte, changeScript []byte) (*Selection, error) {
+	if amount <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+	if feeRate <= 0 {
+		return nil, errors.New("fee rate must be positive")
+	}
+
+	candidates := make([]*UTXO, len(utxos))
+	copy(candidates, utxos)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Amount > candidates[j].Amount
+	})
+
+	var total int64
+	for i, utxo := range candidates {
+		total += utxo.Amount
+		inputCount := i + 1
+
+		feeWithChange := EstimateVirtualSize(inputCount, [][]byte{destinationScript, changeScript}) * feeRate
+		if change := total - amount - feeWithChange; change >= DustLimit {
+			return &Selection{Inputs: candidates[:inputCount], Fee: feeWithChange, Change: change}, nil
+		}
+
+		feeWithoutChange := EstimateVirtualSize(inputCount, [][]byte{destinationScript}) * feeRate
+		if total-amount >= feeWithoutChange {
+			return &Selection{Inputs: candidates[:inputCount], Fee: total - amount}, nil
+		}
+	}
+
+	return nil, errors.Wrapf(ErrInsufficientFunds, "have %d, need %d plus fee", total, amount)
+}
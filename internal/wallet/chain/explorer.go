@@ -0,0 +1,52 @@
+package chain
+
+import (
+	"net/url"
+	"strings"
+
+	"github/chapool/go-wallet/internal/models"
+)
+
+// 区块浏览器链接类型，替换 explorer_url_template 中的 {type}
+const (
+	ExplorerTypeTx      = "tx"
+	ExplorerTypeAddress = "address"
+)
+
+// ExplorerURL 根据链的区块浏览器 URL 模板生成浏览器链接：{type} 替换为 explorerType，{value} 替换为交易哈希或地址
+// 模板或值为空时返回空字符串
+func ExplorerURL(template string, explorerType string, value string) string {
+	if template == "" || value == "" {
+		return ""
+	}
+
+	return strings.NewReplacer(
+		"{type}", explorerType,
+		"{value}", url.PathEscape(value),
+	).Replace(template)
+}
+
+// Explorers 各链的区块浏览器 URL 模板（chain_id → 模板），未配置模板的链不生成链接
+type Explorers map[int]string
+
+// NewExplorers 从链配置中收集区块浏览器 URL 模板
+func NewExplorers(chains []*models.Chain) Explorers {
+	explorers := make(Explorers, len(chains))
+	for _, chain := range chains {
+		if chain.ExplorerURLTemplate.Valid && chain.ExplorerURLTemplate.String != "" {
+			explorers[chain.ChainID] = chain.ExplorerURLTemplate.String
+		}
+	}
+
+	return explorers
+}
+
+// TxURL 交易的浏览器链接，链未配置模板时返回空字符串
+func (e Explorers) TxURL(chainID int, txHash string) string {
+	return ExplorerURL(e[chainID], ExplorerTypeTx, txHash)
+}
+
+// AddressURL 地址的浏览器链接，链未配置模板时返回空字符串
+func (e Explorers) AddressURL(chainID int, address string) string {
+	return ExplorerURL(e[chainID], ExplorerTypeAddress, address)
+}
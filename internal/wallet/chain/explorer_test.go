@@ -0,0 +1,46 @@
+package chain
+
+import (
+	"testing"
+
+	"github/chapool/go-wallet/internal/models"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplorerURL(t *testing.T) {
+	tests := []struct {
+		name         string
+		template     string
+		explorerType string
+		value        string
+		want         string
+	}{
+		{"Tx", "https://bscscan.com/{type}/{value}", ExplorerTypeTx, "0xabc", "https://bscscan.com/tx/0xabc"},
+		{"Address", "https://bscscan.com/{type}/{value}", ExplorerTypeAddress, "0xDef", "https://bscscan.com/address/0xDef"},
+		{"FixedPath", "https://solscan.io/{type}/{value}?cluster=devnet", ExplorerTypeTx, "5abc", "https://solscan.io/tx/5abc?cluster=devnet"},
+		{"EscapedValue", "https://example.com/{type}/{value}", ExplorerTypeTx, "a/b c", "https://example.com/tx/a%2Fb%20c"},
+		{"NoTemplate", "", ExplorerTypeTx, "0xabc", ""},
+		{"NoValue", "https://bscscan.com/{type}/{value}", ExplorerTypeTx, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ExplorerURL(tt.template, tt.explorerType, tt.value))
+		})
+	}
+}
+
+func TestExplorers(t *testing.T) {
+	explorers := NewExplorers([]*models.Chain{
+		{ChainID: 56, ExplorerURLTemplate: null.StringFrom("https://bscscan.com/{type}/{value}")},
+		{ChainID: 1, ExplorerURLTemplate: null.StringFrom("")},
+		{ChainID: 137},
+	})
+
+	assert.Equal(t, "https://bscscan.com/tx/0xabc", explorers.TxURL(56, "0xabc"))
+	assert.Equal(t, "https://bscscan.com/address/0xdef", explorers.AddressURL(56, "0xdef"))
+	assert.Empty(t, explorers.TxURL(1, "0xabc"))
+	assert.Empty(t, explorers.AddressURL(137, "0xdef"))
+}
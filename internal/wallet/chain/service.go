@@ -5,21 +5,26 @@ import (
 	"database/sql"
 	"strings"
 
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/pkg/errors"
 	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
 )
 
 // service 实现 Service 接口
 type service struct {
-	db *sql.DB
+	db        *sql.DB
+	overrides BlockOverrides
 }
 
 // NewService 创建链配置服务
+// overrides 中配置的确认/终结区块数优先于数据库中的值
 //
 //nolint:ireturn
-func NewService(db *sql.DB) Service {
-	return &service{db: db}
+func NewService(db *sql.DB, overrides BlockOverrides) Service {
+	return &service{db: db, overrides: overrides}
 }
 
 // GetChain 根据 chain_id 查询链配置
@@ -30,11 +35,13 @@ func (s *service) GetChain(ctx context.Context, chainID int) (*models.Chain, err
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.New("chain not found")
+			return nil, walleterrors.ErrChainNotFound
 		}
 		return nil, errors.Wrap(err, "failed to get chain")
 	}
 
+	s.applyOverrides(chain)
+
 	return chain, nil
 }
 
@@ -45,6 +52,10 @@ func (s *service) ListChains(ctx context.Context) ([]*models.Chain, error) {
 		return nil, errors.Wrap(err, "failed to list chains")
 	}
 
+	for _, chain := range chains {
+		s.applyOverrides(chain)
+	}
+
 	return chains, nil
 }
 
@@ -59,6 +70,10 @@ func (s *service) GetActiveChains(ctx context.Context) ([]*models.Chain, error)
 		return nil, errors.Wrap(err, "failed to get active chains")
 	}
 
+	for _, chain := range chains {
+		s.applyOverrides(chain)
+	}
+
 	return chains, nil
 }
 
@@ -80,3 +95,38 @@ func (s *service) ParseRPCURLs(rpcURL string) []string {
 
 	return result
 }
+
+// UpdateScanSettings 更新链的扫描参数，chains 表触发器会通知各服务实例重新加载
+func (s *service) UpdateScanSettings(ctx context.Context, chainID int, settings ScanSettings) (*models.Chain, error) {
+	chain, err := s.GetChain(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	chain.ScanIntervalMs = settings.ScanIntervalMs
+	chain.BlockBatchSize = settings.BlockBatchSize
+	chain.MaxReceiptConcurrency = settings.MaxReceiptConcurrency
+
+	// 只更新扫描参数列，避免把确认/终结区块数的配置覆盖值写回数据库
+	if _, err := chain.Update(ctx, s.db, boil.Whitelist(
+		models.ChainColumns.ScanIntervalMs,
+		models.ChainColumns.BlockBatchSize,
+		models.ChainColumns.MaxReceiptConcurrency,
+		models.ChainColumns.UpdatedAt,
+	)); err != nil {
+		return nil, errors.Wrap(err, "failed to update chain scan settings")
+	}
+
+	return chain, nil
+}
+
+// applyOverrides 将配置中的确认/终结区块数覆盖到链配置上
+func (s *service) applyOverrides(chain *models.Chain) {
+	if blocks, ok := s.overrides.ConfirmationBlocks[chain.ChainID]; ok {
+		chain.ConfirmationBlocks = null.IntFrom(blocks)
+	}
+
+	if blocks, ok := s.overrides.FinalizedBlocks[chain.ChainID]; ok {
+		chain.FinalizedBlocks = null.IntFrom(blocks)
+	}
+}
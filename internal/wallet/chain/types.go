@@ -2,10 +2,40 @@ package chain
 
 import (
 	"context"
+	"strings"
 
 	"github/chapool/go-wallet/internal/models"
+
+	"github.com/aarondl/null/v8"
+)
+
+// 链类型（chains.chain_type），决定地址派生、签名和扫描使用的实现
+const (
+	TypeEVM     = "evm"
+	TypeSolana  = "solana"
+	TypeBitcoin = "bitcoin"
 )
 
+// NormalizeAddress 规范化地址用于存储和比较：EVM 地址统一小写，Solana（base58）地址区分大小写保持原样；
+// 比特币 bech32 地址统一小写，base58 地址区分大小写保持原样
+func NormalizeAddress(chainType string, address string) string {
+	switch chainType {
+	case TypeSolana:
+		return address
+	case TypeBitcoin:
+		if lower := strings.ToLower(address); strings.HasPrefix(lower, "bc1") {
+			return lower
+		}
+		return address
+	default:
+		return strings.ToLower(address)
+	}
+}
+
+// WalletAddressKeySQL wallets 表的地址规范化表达式（数据库函数 wallet_address_key，与 NormalizeAddress 一致），
+// (chain_id, WalletAddressKeySQL) 上有索引，按地址查找钱包归属时与 NormalizeAddress 的结果比较
+const WalletAddressKeySQL = "wallet_address_key(chain_type, address)"
+
 // Service 定义链配置服务接口
 type Service interface {
 	// GetChain 根据 chain_id 查询链配置
@@ -19,4 +49,20 @@ type Service interface {
 
 	// ParseRPCURLs 解析 RPC URL（支持多个，逗号分隔）
 	ParseRPCURLs(rpcURL string) []string
+
+	// UpdateScanSettings 更新链的扫描参数，Null 的项清除配置恢复使用全局配置
+	UpdateScanSettings(ctx context.Context, chainID int, settings ScanSettings) (*models.Chain, error)
+}
+
+// ScanSettings chains 表中的扫描参数，Null 表示使用全局配置
+type ScanSettings struct {
+	ScanIntervalMs        null.Int
+	BlockBatchSize        null.Int
+	MaxReceiptConcurrency null.Int
+}
+
+// BlockOverrides 按 chain_id 覆盖数据库中配置的确认区块数和终结区块数
+type BlockOverrides struct {
+	ConfirmationBlocks map[int]int
+	FinalizedBlocks    map[int]int
 }
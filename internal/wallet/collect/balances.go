@@ -0,0 +1,97 @@
+package collect
+
+import (
+	"context"
+	"strings"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+// balancePollWallets is the number of wallets whose balances are polled together.
+const balancePollWallets = 500
+
+// walletsToCollect polls the native and ERC20 balances of the wallets with batched JSON-RPC requests
+// and returns the wallets holding at least one balance above its collect threshold, so empty wallets
+// cost no per-wallet requests. Wallets whose balances could not be polled are kept, the collection
+// itself queries fresh balances before sending anything.
+func (s *service) walletsToCollect(ctx context.Context, chainID int, wallets []*models.Wallet, tokens []*models.Token) []*models.Wallet {
+	client, err := s.scanService.GetClient(ctx, chainID)
+	if err != nil || client.BatchSize() == 0 {
+		return wallets
+	}
+
+	var erc20Tokens []*models.Token
+	for _, token := range tokens {
+		if token == nil || token.IsNative || !token.TokenAddress.Valid || token.TokenAddress.String == "" {
+			continue
+		}
+		erc20Tokens = append(erc20Tokens, token)
+	}
+
+	candidates := make([]*models.Wallet, 0, len(wallets))
+	for start := 0; start < len(wallets); start += balancePollWallets {
+		chunk := wallets[start:min(start+balancePollWallets, len(wallets))]
+
+		addresses := make([]common.Address, len(chunk))
+		for i, wallet := range chunk {
+			addresses[i] = common.HexToAddress(strings.ToLower(wallet.Address))
+		}
+
+		keep := make([]bool, len(chunk))
+
+		balances, errs, err := client.BatchBalances(ctx, addresses)
+		if err != nil {
+			log.Warn().Err(err).Int("chain_id", chainID).Msg("CollectService: failed to poll native balances")
+			return append(candidates, wallets[start:]...)
+		}
+		for i := range chunk {
+			keep[i] = errs[i] != nil || balances[i].Cmp(s.config.MinNativeCollectAmountWei) >= 0
+		}
+
+		if len(erc20Tokens) > 0 {
+			queries := make([]scan.TokenBalanceQuery, 0, len(chunk)*len(erc20Tokens))
+			queryWallets := make([]int, 0, cap(queries)) // index of the queried wallet in chunk
+			queryTokens := make([]*models.Token, 0, cap(queries))
+			for i, address := range addresses {
+				for _, token := range erc20Tokens {
+					queries = append(queries, scan.TokenBalanceQuery{
+						Token:   common.HexToAddress(strings.ToLower(token.TokenAddress.String)),
+						Account: address,
+					})
+					queryWallets = append(queryWallets, i)
+					queryTokens = append(queryTokens, token)
+				}
+			}
+
+			tokenBalances, tokenErrs, err := client.BatchTokenBalances(ctx, queries)
+			if err != nil {
+				log.Warn().Err(err).Int("chain_id", chainID).Msg("CollectService: failed to poll ERC20 balances")
+				return append(candidates, wallets[start:]...)
+			}
+			for i := range queries {
+				if tokenErrs[i] != nil ||
+					(tokenBalances[i].Sign() > 0 && tokenBalances[i].Cmp(s.getTokenMinCollectAmount(queryTokens[i])) >= 0) {
+					keep[queryWallets[i]] = true
+				}
+			}
+		}
+
+		for i, wallet := range chunk {
+			if keep[i] {
+				candidates = append(candidates, wallet)
+			}
+		}
+	}
+
+	log.Debug().
+		Int("chain_id", chainID).
+		Int("wallets", len(wallets)).
+		Int("wallets_to_collect", len(candidates)).
+		Msg("CollectService: polled wallet balances")
+
+	return candidates
+}
@@ -0,0 +1,170 @@
+package collect
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/signer"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	permitGasLimit     uint64 = 100000
+	permitValidMinutes        = 60
+)
+
+var (
+	permitValidity               = permitValidMinutes * time.Minute
+	erc20TransferFromMethodID    = common.FromHex("23b872dd")
+	erc20PermitMethodID          = common.FromHex("d505accf")
+	erc20DomainSeparatorMethodID = common.FromHex("3644e515")
+	erc20NoncesMethodID          = common.FromHex("7ecebe00")
+)
+
+// sendERC20PermitCollect collects an EIP-2612 token without native gas on the user address:
+// the user address signs a permit for the hot wallet offline, the hot wallet submits permit (skipped while
+// the allowance already covers the amount) and then transferFrom. Returns the unconfirmed transferFrom transaction.
+func (s *service) sendERC20PermitCollect(
+	ctx context.Context,
+	wallet *models.Wallet,
+	hotWallet *models.Wallet,
+	client *scan.RPCClient,
+	fees *scan.GasFees,
+	tokenAddr common.Address,
+	amount *big.Int,
+) (*types.Transaction, error) {
+	owner := common.HexToAddress(wallet.Address)
+	spender := common.HexToAddress(hotWallet.Address)
+
+	allowance, err := client.TokenAllowance(ctx, tokenAddr, owner, spender)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query allowance")
+	}
+
+	if allowance.Cmp(amount) < 0 {
+		if err := s.submitPermit(ctx, wallet, hotWallet, client, fees, tokenAddr, amount); err != nil {
+			return nil, err
+		}
+	}
+
+	data := make([]byte, 0, len(erc20TransferFromMethodID)+abiPaddedAddressLength*3)
+	data = append(data, erc20TransferFromMethodID...)
+	data = append(data, common.LeftPadBytes(owner.Bytes(), abiPaddedAddressLength)...)
+	data = append(data, common.LeftPadBytes(spender.Bytes(), abiPaddedAddressLength)...)
+	data = append(data, common.LeftPadBytes(amount.Bytes(), abiPaddedAddressLength)...)
+
+	txObj, err := s.signAndBroadcast(ctx, client, hotWalletSignRequest(wallet.ChainID, hotWallet, fees, tokenAddr, defaultERC20CollectGasLimit, data))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send transferFrom transaction")
+	}
+
+	return txObj, nil
+}
+
+// submitPermit signs a permit of amount with the user address key and waits for the hot wallet's permit transaction
+func (s *service) submitPermit(
+	ctx context.Context,
+	wallet *models.Wallet,
+	hotWallet *models.Wallet,
+	client *scan.RPCClient,
+	fees *scan.GasFees,
+	tokenAddr common.Address,
+	amount *big.Int,
+) error {
+	owner := common.HexToAddress(wallet.Address)
+	spender := common.HexToAddress(hotWallet.Address)
+
+	domainSeparator, err := client.CallContract(ctx, ethereum.CallMsg{To: &tokenAddr, Data: erc20DomainSeparatorMethodID}, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to read DOMAIN_SEPARATOR")
+	}
+	if len(domainSeparator) != abiPaddedAddressLength {
+		return errors.New("token does not implement DOMAIN_SEPARATOR")
+	}
+
+	nonceData := make([]byte, 0, len(erc20NoncesMethodID)+abiPaddedAddressLength)
+	nonceData = append(nonceData, erc20NoncesMethodID...)
+	nonceData = append(nonceData, common.LeftPadBytes(owner.Bytes(), abiPaddedAddressLength)...)
+	nonceResp, err := client.CallContract(ctx, ethereum.CallMsg{To: &tokenAddr, Data: nonceData}, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to read permit nonce")
+	}
+	if len(nonceResp) != abiPaddedAddressLength {
+		return errors.New("token does not implement nonces")
+	}
+
+	deadline := time.Now().Add(permitValidity).Unix()
+	permit, err := s.signerService.SignEVMPermit(ctx, &signer.SignEVMPermitRequest{
+		ChainID:         int64(wallet.ChainID),
+		Token:           tokenAddr.Hex(),
+		DomainSeparator: domainSeparator,
+		Owner:           owner.Hex(),
+		Spender:         spender.Hex(),
+		Value:           amount.String(),
+		Nonce:           new(big.Int).SetBytes(nonceResp).String(),
+		Deadline:        deadline,
+		DerivationPath:  wallet.DerivationPath,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to sign permit")
+	}
+
+	// permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s)
+	data := make([]byte, 0, len(erc20PermitMethodID)+abiPaddedAddressLength*7)
+	data = append(data, erc20PermitMethodID...)
+	data = append(data, common.LeftPadBytes(owner.Bytes(), abiPaddedAddressLength)...)
+	data = append(data, common.LeftPadBytes(spender.Bytes(), abiPaddedAddressLength)...)
+	data = append(data, common.LeftPadBytes(amount.Bytes(), abiPaddedAddressLength)...)
+	data = append(data, common.LeftPadBytes(big.NewInt(deadline).Bytes(), abiPaddedAddressLength)...)
+	data = append(data, common.LeftPadBytes([]byte{permit.V}, abiPaddedAddressLength)...)
+	data = append(data, permit.R[:]...)
+	data = append(data, permit.S[:]...)
+
+	txObj, err := s.signAndBroadcast(ctx, client, hotWalletSignRequest(wallet.ChainID, hotWallet, fees, tokenAddr, permitGasLimit, data))
+	if err != nil {
+		return errors.Wrap(err, "failed to send permit transaction")
+	}
+
+	receipt, err := s.waitForReceipt(ctx, client, txObj.Hash())
+	if err != nil {
+		return errors.Wrap(err, "failed while waiting for permit receipt")
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return errors.New("permit transaction failed")
+	}
+
+	log.Info().
+		Str("wallet_id", wallet.ID).
+		Str("hot_wallet_id", hotWallet.ID).
+		Str("token_address", tokenAddr.Hex()).
+		Str("tx_hash", txObj.Hash().Hex()).
+		Str("amount", amount.String()).
+		Msg("CollectService: submitted permit for ERC20 collect")
+
+	return nil
+}
+
+// hotWalletSignRequest builds a contract call of the hot wallet to the token
+func hotWalletSignRequest(chainID int, hotWallet *models.Wallet, fees *scan.GasFees, tokenAddr common.Address, gasLimit uint64, data []byte) *signer.SignEVMRequest {
+	maxFeePerGas, maxPriorityFeePerGas, gasPrice := fees.SignFields()
+	return &signer.SignEVMRequest{
+		ChainID:              int64(chainID),
+		To:                   tokenAddr.Hex(),
+		Value:                "0",
+		GasLimit:             gasLimit,
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+		GasPrice:             gasPrice,
+		Data:                 data,
+		FromAddress:          common.HexToAddress(hotWallet.Address).Hex(),
+		DerivationPath:       hotWallet.DerivationPath,
+	}
+}
@@ -0,0 +1,295 @@
+package collect
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"strings"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/aarondl/null/v8"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// nativeTokenDecimals is used for native amounts on chains without a registered native token.
+const nativeTokenDecimals = 18
+
+var (
+	// ErrHotWalletNotFound is returned when the destination hot wallet does not exist on the chain of the wallet.
+	ErrHotWalletNotFound = errors.New("hot wallet not found")
+	// ErrUnsupportedChain is returned for wallets on chains collection does not support (non-EVM).
+	ErrUnsupportedChain = errors.New("collection is not supported on this chain")
+	// ErrCollectInProgress is returned when the wallet is already being collected.
+	ErrCollectInProgress = errors.New("wallet is already being collected")
+)
+
+// Collect sweeps a single asset of a user wallet as requested by an admin.
+// Unlike the automatic collection the configured minimum amounts do not apply, the requested amount
+// (or the full balance) is sent as long as the wallet holds it.
+func (s *service) Collect(ctx context.Context, req *Request) (*Result, error) {
+	wallet, err := models.FindWallet(ctx, s.db, req.WalletID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, walleterrors.ErrWalletNotFound
+		}
+		return nil, errors.Wrap(err, "failed to load wallet")
+	}
+	if wallet.WalletType != models.WalletTypeUser {
+		return nil, errors.New("only user wallets support collection")
+	}
+
+	chainConfig, err := s.chainService.GetChain(ctx, wallet.ChainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load chain")
+	}
+	if chainConfig.ChainType != chain.TypeEVM {
+		return nil, ErrUnsupportedChain
+	}
+
+	token, err := s.requestToken(ctx, wallet.ChainID, req.TokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	hotWallet, err := s.requestHotWallet(ctx, wallet, req.HotWalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	var amount *big.Int
+	if req.Amount != "" {
+		decimals := nativeTokenDecimals
+		if token != nil {
+			decimals = token.Decimals
+		} else if native, err := s.nativeToken(ctx, wallet.ChainID); err != nil {
+			return nil, err
+		} else if native != nil {
+			decimals = native.Decimals
+		}
+		if amount, err = money.ToSmallestUnit(req.Amount, decimals); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, loaded := s.collecting.LoadOrStore(wallet.ID, struct{}{}); loaded {
+		return nil, ErrCollectInProgress
+	}
+	defer s.collecting.Delete(wallet.ID)
+
+	client, err := s.scanService.GetClient(ctx, wallet.ChainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get RPC client")
+	}
+
+	fees, err := s.suggestGasFees(ctx, client, wallet.ChainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to suggest gas fees")
+	}
+
+	result := &Result{
+		WalletID:    wallet.ID,
+		HotWalletID: hotWallet.ID,
+	}
+
+	if token != nil {
+		result.TokenAddress = strings.ToLower(token.TokenAddress.String)
+	}
+
+	var (
+		txObj      *types.Transaction
+		userOpHash *common.Hash
+	)
+	switch {
+	case isSmartAccount(wallet):
+		txObj, result.Amount, userOpHash, err = s.sendRequestedSmartAccount(ctx, wallet, hotWallet, client, fees, token, amount)
+	case token == nil:
+		txObj, result.Amount, err = s.sendRequestedNative(ctx, wallet, hotWallet, client, fees, amount)
+	default:
+		txObj, result.Amount, err = s.sendRequestedERC20(ctx, wallet, hotWallet, client, fees, token, amount)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.TxHash = strings.ToLower(txObj.Hash().Hex())
+
+	receipt, err := s.waitForReceipt(ctx, client, txObj.Hash())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed while waiting for collect receipt")
+	}
+
+	result.Status = s.receiptStatus(wallet, receipt, userOpHash)
+
+	tokenAddress := null.String{}
+	if result.TokenAddress != "" {
+		tokenAddress = null.StringFrom(result.TokenAddress)
+	}
+	if err := s.insertCollectTransaction(ctx, wallet, hotWallet, result.Amount, txObj, receipt, result.Status, tokenAddress); err != nil {
+		return nil, errors.Wrap(err, "failed to insert collect transaction record")
+	}
+
+	log.Info().
+		Str("wallet_id", wallet.ID).
+		Str("hot_wallet_id", hotWallet.ID).
+		Str("token_address", result.TokenAddress).
+		Str("amount", result.Amount.String()).
+		Bool("partial", amount != nil).
+		Str("tx_hash", result.TxHash).
+		Str("status", result.Status.String()).
+		Msg("CollectService: collected requested funds to hot wallet")
+
+	return result, nil
+}
+
+// sendRequestedNative sends amount (or the balance left after the gas fee when amount is nil) of the native token.
+func (s *service) sendRequestedNative(
+	ctx context.Context,
+	wallet *models.Wallet,
+	hotWallet *models.Wallet,
+	client *scan.RPCClient,
+	fees *scan.GasFees,
+	amount *big.Int,
+) (*types.Transaction, *big.Int, error) {
+	balance, err := client.BalanceAt(ctx, common.HexToAddress(strings.ToLower(wallet.Address)))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to query wallet balance")
+	}
+
+	gasFee := fees.Cost(collectGasLimitNative)
+	if amount == nil {
+		amount = new(big.Int).Sub(balance, gasFee)
+		if amount.Sign() <= 0 {
+			return nil, nil, errors.Wrapf(walleterrors.ErrInsufficientBalance, "balance %s does not cover the gas fee %s", balance, gasFee)
+		}
+	} else if required := new(big.Int).Add(amount, gasFee); balance.Cmp(required) < 0 {
+		return nil, nil, errors.Wrapf(walleterrors.ErrInsufficientBalance, "balance %s does not cover amount and gas fee %s", balance, required)
+	}
+
+	txObj, err := s.sendNativeTransfer(ctx, wallet, hotWallet, client, fees, amount)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to send collect transaction")
+	}
+
+	return txObj, amount, nil
+}
+
+// sendRequestedERC20 sends amount (or the full balance when amount is nil) of the token,
+// with permit + transferFrom for tokens supporting EIP-2612.
+func (s *service) sendRequestedERC20(
+	ctx context.Context,
+	wallet *models.Wallet,
+	hotWallet *models.Wallet,
+	client *scan.RPCClient,
+	fees *scan.GasFees,
+	token *models.Token,
+	amount *big.Int,
+) (*types.Transaction, *big.Int, error) {
+	fromAddr := common.HexToAddress(strings.ToLower(wallet.Address))
+	tokenAddr := common.HexToAddress(strings.ToLower(token.TokenAddress.String))
+
+	tokenBalance, err := client.TokenBalance(ctx, tokenAddr, fromAddr)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to query ERC20 balance")
+	}
+	if amount == nil {
+		amount = tokenBalance
+	}
+	if amount.Sign() <= 0 || tokenBalance.Cmp(amount) < 0 {
+		return nil, nil, errors.Wrapf(walleterrors.ErrInsufficientBalance, "token balance %s does not cover amount %s", tokenBalance, amount)
+	}
+
+	var txObj *types.Transaction
+	if token.SupportsPermit {
+		txObj, err = s.sendERC20PermitCollect(ctx, wallet, hotWallet, client, fees, tokenAddr, amount)
+	} else {
+		nativeBalance, balanceErr := client.BalanceAt(ctx, fromAddr)
+		if balanceErr != nil {
+			return nil, nil, errors.Wrap(balanceErr, "failed to fetch native balance for ERC20 collect")
+		}
+		txObj, err = s.sendERC20Transfer(ctx, wallet, hotWallet, client, fees, tokenAddr, amount, nativeBalance)
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to send ERC20 collect transaction")
+	}
+
+	return txObj, amount, nil
+}
+
+// requestToken loads the requested active token of the chain, nil for the native token.
+func (s *service) requestToken(ctx context.Context, chainID int, tokenID *int) (*models.Token, error) {
+	if tokenID == nil {
+		return nil, nil //nolint:nilnil // nil token collects the native token
+	}
+
+	token, err := models.Tokens(
+		models.TokenWhere.ID.EQ(*tokenID),
+		models.TokenWhere.ChainID.EQ(chainID),
+		models.TokenWhere.IsActive.EQ(true),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, walleterrors.ErrTokenNotFound
+		}
+		return nil, errors.Wrap(err, "failed to load token")
+	}
+	if token.IsNative {
+		return nil, nil //nolint:nilnil // nil token collects the native token
+	}
+	if !token.TokenAddress.Valid || !common.IsHexAddress(token.TokenAddress.String) {
+		return nil, errors.Wrapf(walleterrors.ErrTokenNotFound, "token %d has no contract address", token.ID)
+	}
+
+	return token, nil
+}
+
+// requestHotWallet loads the requested hot wallet of the wallet's chain, or the hot wallet of the wallet's organization.
+// Hot wallets of other organizations are not valid collection targets.
+func (s *service) requestHotWallet(ctx context.Context, wallet *models.Wallet, hotWalletID string) (*models.Wallet, error) {
+	if hotWalletID == "" {
+		hotWallet, err := s.hotWalletService.GetOrgHotWallet(ctx, wallet.ChainID, wallet.OrgID.Ptr())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load target hot wallet")
+		}
+		return hotWallet, nil
+	}
+
+	hotWallet, err := models.Wallets(
+		models.WalletWhere.ID.EQ(hotWalletID),
+		models.WalletWhere.ChainID.EQ(wallet.ChainID),
+		models.WalletWhere.WalletType.EQ(models.WalletTypeHot),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrHotWalletNotFound
+		}
+		return nil, errors.Wrap(err, "failed to load hot wallet")
+	}
+	if hotWallet.OrgID.Valid && hotWallet.OrgID != wallet.OrgID {
+		return nil, errors.Wrap(ErrHotWalletNotFound, "hot wallet belongs to another organization")
+	}
+
+	return hotWallet, nil
+}
+
+// nativeToken loads the registered native token of the chain, nil if none is registered.
+func (s *service) nativeToken(ctx context.Context, chainID int) (*models.Token, error) {
+	token, err := models.Tokens(
+		models.TokenWhere.ChainID.EQ(chainID),
+		models.TokenWhere.IsNative.EQ(true),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil // chains may not register their native token
+		}
+		return nil, errors.Wrap(err, "failed to load native token")
+	}
+
+	return token, nil
+}
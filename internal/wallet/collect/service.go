@@ -5,15 +5,21 @@ import (
 	"context"
 	"database/sql"
 	"math/big"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
+	walletMetrics "github/chapool/go-wallet/internal/metrics/wallet"
 	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/util/lifecycle"
 	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/gasguard"
 	"github/chapool/go-wallet/internal/wallet/hotwallet"
 	"github/chapool/go-wallet/internal/wallet/scan"
 	"github/chapool/go-wallet/internal/wallet/signer"
+	"github/chapool/go-wallet/internal/wallet/smartaccount"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
@@ -22,25 +28,18 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
-	collectGasLimitNative              uint64 = 21000
-	defaultEIP1559Multiplier           int64  = 2
-	defaultERC20CollectGasLimit        uint64 = 120000
-	minCollectAmountWeiValue                  = 5_000_000_000_000_000 // 0.005 native token
-	minBalanceWithGasBuffValue                = 100_000_000_000_000   // 0.0001 native token
-	receiptPollIntervalSeconds                = 3
-	receiptWaitTimeoutMinutes                 = 2
-	defaultMinERC20CollectAmountString        = "1"                // 默认收集 1 个 token（可按 token 配置覆盖）
-	nativeTopUpBufferWeiValue                 = 50_000_000_000_000 // 0.00005 native token
-	abiPaddedAddressLength                    = 32
+	collectGasLimitNative       uint64 = 21000
+	defaultERC20CollectGasLimit uint64 = 120000
+	minBalanceWithGasBuffValue         = 100_000_000_000_000 // 0.0001 native token
+	nativeTopUpBufferWeiValue          = 50_000_000_000_000  // 0.00005 native token
+	abiPaddedAddressLength             = 32
 )
 
 var (
-	minCollectAmountWei   = big.NewInt(minCollectAmountWeiValue)
-	receiptPollInterval   = receiptPollIntervalSeconds * time.Second
-	receiptWaitTimeout    = receiptWaitTimeoutMinutes * time.Minute
 	minBalanceWithGasBuff = big.NewInt(minBalanceWithGasBuffValue)
 	nativeTopUpBufferWei  = big.NewInt(nativeTopUpBufferWeiValue)
 	erc20TransferMethodID = common.FromHex("a9059cbb")
@@ -54,14 +53,20 @@ type Service interface {
 	CollectForChain(ctx context.Context, chainID int) error
 	// CollectWallet triggers collection for a specific wallet by ID.
 	CollectWallet(ctx context.Context, walletID string) error
+	// Collect sweeps a single asset of a user wallet, optionally a partial amount to a specific hot wallet.
+	Collect(ctx context.Context, req *Request) (*Result, error)
 }
 
 type service struct {
 	db               *sql.DB
+	config           Config
 	chainService     chain.Service
 	scanService      scan.Service
 	hotWalletService hotwallet.Service
 	signerService    signer.Service
+	gasGuard         gasguard.Guard
+	gasPriceCap      gasguard.PriceCap
+	smartAccounts    smartaccount.Service
 	collecting       sync.Map
 }
 
@@ -70,17 +75,25 @@ type service struct {
 //nolint:ireturn // Returning interface is intentional for DI
 func NewService(
 	db *sql.DB,
+	config Config,
 	chainService chain.Service,
 	scanService scan.Service,
 	hotWalletService hotwallet.Service,
 	signerService signer.Service,
+	gasGuard gasguard.Guard,
+	gasPriceCap gasguard.PriceCap,
+	smartAccounts smartaccount.Service,
 ) Service {
 	return &service{
 		db:               db,
+		config:           config,
 		chainService:     chainService,
 		scanService:      scanService,
 		hotWalletService: hotWalletService,
 		signerService:    signerService,
+		gasGuard:         gasGuard,
+		gasPriceCap:      gasPriceCap,
+		smartAccounts:    smartAccounts,
 	}
 }
 
@@ -90,7 +103,7 @@ func (s *service) StartAutoCollect(ctx context.Context, interval time.Duration)
 		Dur("interval", interval).
 		Msg("Starting auto collect scheduler")
 
-	go func() {
+	lifecycle.Go(ctx, "auto collect", func() {
 		ticker := time.NewTicker(interval)
 		defer ticker.Stop()
 
@@ -105,14 +118,14 @@ func (s *service) StartAutoCollect(ctx context.Context, interval time.Duration)
 				s.runAutoCollect(ctx)
 			}
 		}
-	}()
+	})
 }
 
 // CollectForChain runs a collection cycle for all user wallets on a given chain.
 func (s *service) CollectForChain(ctx context.Context, chainID int) error {
 	wallets, err := models.Wallets(
 		models.WalletWhere.ChainID.EQ(chainID),
-		models.WalletWhere.WalletType.EQ("user"),
+		models.WalletWhere.WalletType.EQ(models.WalletTypeUser),
 	).All(ctx, s.db)
 	if err != nil {
 		return errors.Wrap(err, "failed to load user wallets for chain")
@@ -127,11 +140,22 @@ func (s *service) CollectForChain(ctx context.Context, chainID int) error {
 		return errors.Wrap(err, "failed to load active tokens for chain")
 	}
 
-	hotWallet, err := s.hotWalletService.GetHotWallet(ctx, chainID)
-	if err != nil {
-		return errors.Wrap(err, "failed to load target hot wallet")
+	// Wallets of an organization are collected into the organization's hot wallet
+	hotWallets := make(map[string]*models.Wallet) // org_id -> target hot wallet
+	for _, wallet := range wallets {
+		if _, ok := hotWallets[wallet.OrgID.String]; ok {
+			continue
+		}
+		hotWallet, err := s.hotWalletService.GetOrgHotWallet(ctx, chainID, wallet.OrgID.Ptr())
+		if err != nil {
+			return errors.Wrap(err, "failed to load target hot wallet")
+		}
+		hotWallets[wallet.OrgID.String] = hotWallet
 	}
 
+	// Skip wallets without collectable balances before querying them one by one
+	wallets = s.walletsToCollect(ctx, chainID, wallets, tokens)
+
 	for _, wallet := range wallets {
 		select {
 		case <-ctx.Done():
@@ -139,7 +163,35 @@ func (s *service) CollectForChain(ctx context.Context, chainID int) error {
 		default:
 		}
 
-		if err := s.collectWalletERC20(ctx, wallet, hotWallet, tokens); err != nil {
+		hotWallet := hotWallets[wallet.OrgID.String]
+
+		// Signing, broadcasting and recording a wallet's collection is not interrupted by shutdown
+		walletCtx, cancel := lifecycle.InFlight(ctx)
+
+		// Smart accounts are collected with user operations sponsored by the paymaster instead of gas top-ups
+		if isSmartAccount(wallet) {
+			err := s.collectSmartAccount(walletCtx, wallet, hotWallet, tokens)
+			cancel()
+			if gasguard.IsCapExceeded(err) {
+				return err
+			}
+			if err != nil {
+				log.Error().
+					Err(err).
+					Str("wallet_id", wallet.ID).
+					Str("address", wallet.Address).
+					Int("chain_id", chainID).
+					Msg("CollectService: smart account collection failed")
+			}
+			continue
+		}
+
+		if err := s.collectWalletERC20(walletCtx, wallet, hotWallet, tokens); err != nil {
+			// The gas price applies to the whole chain, remaining wallets are collected on the next attempt
+			if gasguard.IsCapExceeded(err) {
+				cancel()
+				return err
+			}
 			log.Error().
 				Err(err).
 				Str("wallet_id", wallet.ID).
@@ -148,7 +200,11 @@ func (s *service) CollectForChain(ctx context.Context, chainID int) error {
 				Msg("CollectService: wallet ERC20 collection failed")
 		}
 
-		if err := s.collectWalletNative(ctx, wallet, hotWallet); err != nil {
+		if err := s.collectWalletNative(walletCtx, wallet, hotWallet); err != nil {
+			if gasguard.IsCapExceeded(err) {
+				cancel()
+				return err
+			}
 			log.Error().
 				Err(err).
 				Str("wallet_id", wallet.ID).
@@ -156,6 +212,8 @@ func (s *service) CollectForChain(ctx context.Context, chainID int) error {
 				Int("chain_id", chainID).
 				Msg("CollectService: wallet collection failed")
 		}
+
+		cancel()
 	}
 
 	return nil
@@ -168,15 +226,19 @@ func (s *service) CollectWallet(ctx context.Context, walletID string) error {
 		return errors.Wrap(err, "failed to load wallet")
 	}
 
-	if wallet.WalletType != "user" {
+	if wallet.WalletType != models.WalletTypeUser {
 		return errors.New("only user wallets support collection")
 	}
 
-	hotWallet, err := s.hotWalletService.GetHotWallet(ctx, wallet.ChainID)
+	hotWallet, err := s.hotWalletService.GetOrgHotWallet(ctx, wallet.ChainID, wallet.OrgID.Ptr())
 	if err != nil {
 		return errors.Wrap(err, "failed to load target hot wallet")
 	}
 
+	if isSmartAccount(wallet) {
+		return s.collectSmartAccount(ctx, wallet, hotWallet, nil)
+	}
+
 	return s.collectWalletNative(ctx, wallet, hotWallet)
 }
 
@@ -187,14 +249,53 @@ func (s *service) runAutoCollect(ctx context.Context) {
 		return
 	}
 
+	var g errgroup.Group
+	g.SetLimit(max(s.config.WorkerConcurrency, 1))
+
 	for _, ch := range chains {
-		if err := s.CollectForChain(ctx, ch.ChainID); err != nil {
-			log.Error().
+		// Collection only supports EVM chains
+		if ch.ChainType != chain.TypeEVM {
+			continue
+		}
+
+		// Automatic operations are paused while the base fee exceeds the configured ceiling
+		if s.gasGuard.IsPaused(ch.ChainID) {
+			log.Info().
 				Int("chain_id", ch.ChainID).
-				Err(err).
-				Msg("CollectService: collect cycle failed")
+				Msg("CollectService: skipping chain, gas spike circuit breaker is open")
+			continue
 		}
+
+		// Collection is deferred while the gas price exceeds the configured cap, following the retry schedule
+		if s.gasPriceCap.ChainDeferred(ch.ChainID, time.Now()) {
+			log.Debug().
+				Int("chain_id", ch.ChainID).
+				Msg("CollectService: skipping chain, collection deferred by gas price cap")
+			continue
+		}
+
+		g.Go(func() error {
+			err := s.CollectForChain(ctx, ch.ChainID)
+			switch {
+			case gasguard.IsCapExceeded(err):
+				log.Warn().
+					Int("chain_id", ch.ChainID).
+					Err(err).
+					Time("retry_at", s.gasPriceCap.DeferChain(ch.ChainID, time.Now())).
+					Msg("CollectService: collect cycle deferred, gas price exceeds the cap")
+			case err != nil:
+				log.Error().
+					Int("chain_id", ch.ChainID).
+					Err(err).
+					Msg("CollectService: collect cycle failed")
+			default:
+				s.gasPriceCap.ResumeChain(ch.ChainID)
+			}
+			return nil
+		})
 	}
+
+	_ = g.Wait()
 }
 
 func (s *service) collectWalletNative(ctx context.Context, wallet *models.Wallet, hotWallet *models.Wallet) error {
@@ -224,7 +325,7 @@ func (s *service) collectWalletNative(ctx context.Context, wallet *models.Wallet
 		return errors.Wrap(err, "failed to query wallet balance")
 	}
 
-	if balanceWei.Cmp(minCollectAmountWei) < 0 {
+	if balanceWei.Cmp(s.config.MinNativeCollectAmountWei) < 0 {
 		log.Debug().
 			Str("wallet_id", wallet.ID).
 			Str("address", wallet.Address).
@@ -233,28 +334,13 @@ func (s *service) collectWalletNative(ctx context.Context, wallet *models.Wallet
 		return nil
 	}
 
-	tipCap, err := client.SuggestGasTipCap(ctx)
-	if err != nil {
-		return errors.Wrap(err, "failed to suggest gas tip cap")
-	}
-
-	latestBlock, err := client.GetBlockByNumber(ctx, nil)
+	fees, err := s.suggestGasFees(ctx, client, wallet.ChainID)
 	if err != nil {
-		return errors.Wrap(err, "failed to fetch latest block header")
-	}
-
-	baseFee := latestBlock.BaseFee()
-	if baseFee == nil {
-		baseFee = big.NewInt(0)
+		return errors.Wrap(err, "failed to suggest gas fees")
 	}
 
-	maxFee := new(big.Int).Add(
-		new(big.Int).Mul(baseFee, big.NewInt(defaultEIP1559Multiplier)),
-		tipCap,
-	)
-
 	gasLimit := collectGasLimitNative
-	gasFee := new(big.Int).Mul(maxFee, big.NewInt(int64(gasLimit)))
+	gasFee := fees.Cost(gasLimit)
 
 	if balanceWei.Cmp(gasFee) <= 0 {
 		log.Debug().
@@ -267,7 +353,7 @@ func (s *service) collectWalletNative(ctx context.Context, wallet *models.Wallet
 	}
 
 	transferAmount := new(big.Int).Sub(balanceWei, gasFee)
-	if transferAmount.Cmp(minCollectAmountWei) < 0 || transferAmount.Cmp(minBalanceWithGasBuff) <= 0 {
+	if transferAmount.Cmp(s.config.MinNativeCollectAmountWei) < 0 || transferAmount.Cmp(minBalanceWithGasBuff) <= 0 {
 		log.Debug().
 			Str("wallet_id", wallet.ID).
 			Str("address", wallet.Address).
@@ -276,35 +362,9 @@ func (s *service) collectWalletNative(ctx context.Context, wallet *models.Wallet
 		return nil
 	}
 
-	nonce, err := client.PendingNonceAt(ctx, fromAddr)
-	if err != nil {
-		return errors.Wrap(err, "failed to fetch pending nonce")
-	}
-
-	signReq := &signer.SignEVMRequest{
-		ChainID:              int64(wallet.ChainID),
-		To:                   toAddr.Hex(),
-		Value:                transferAmount.String(),
-		GasLimit:             gasLimit,
-		MaxFeePerGas:         maxFee.String(),
-		MaxPriorityFeePerGas: tipCap.String(),
-		Nonce:                nonce,
-		FromAddress:          fromAddr.Hex(),
-		DerivationPath:       wallet.DerivationPath,
-	}
-
-	signResp, err := s.signerService.SignEVMTransaction(ctx, signReq)
+	txObj, err := s.sendNativeTransfer(ctx, wallet, hotWallet, client, fees, transferAmount)
 	if err != nil {
-		return errors.Wrap(err, "failed to sign collect transaction")
-	}
-
-	txObj := new(types.Transaction)
-	if err := txObj.UnmarshalBinary(signResp.RawTransaction); err != nil {
-		return errors.Wrap(err, "failed to decode signed transaction")
-	}
-
-	if err := client.SendTransaction(ctx, txObj); err != nil {
-		return errors.Wrap(err, "failed to broadcast collect transaction")
+		return errors.Wrap(err, "failed to send collect transaction")
 	}
 
 	receipt, err := s.waitForReceipt(ctx, client, txObj.Hash())
@@ -333,6 +393,31 @@ func (s *service) collectWalletNative(ctx context.Context, wallet *models.Wallet
 	return nil
 }
 
+// sendNativeTransfer sends amount of the native token from the user address to the hot wallet.
+func (s *service) sendNativeTransfer(
+	ctx context.Context,
+	wallet *models.Wallet,
+	hotWallet *models.Wallet,
+	client *scan.RPCClient,
+	fees *scan.GasFees,
+	amount *big.Int,
+) (*types.Transaction, error) {
+	maxFeePerGas, maxPriorityFeePerGas, gasPrice := fees.SignFields()
+	signReq := &signer.SignEVMRequest{
+		ChainID:              int64(wallet.ChainID),
+		To:                   common.HexToAddress(strings.ToLower(hotWallet.Address)).Hex(),
+		Value:                amount.String(),
+		GasLimit:             collectGasLimitNative,
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+		GasPrice:             gasPrice,
+		FromAddress:          common.HexToAddress(strings.ToLower(wallet.Address)).Hex(),
+		DerivationPath:       wallet.DerivationPath,
+	}
+
+	return s.signAndBroadcast(ctx, client, signReq)
+}
+
 func (s *service) collectWalletERC20(
 	ctx context.Context,
 	wallet *models.Wallet,
@@ -344,7 +429,6 @@ func (s *service) collectWalletERC20(
 	}
 
 	fromAddr := common.HexToAddress(strings.ToLower(wallet.Address))
-	toAddr := common.HexToAddress(strings.ToLower(hotWallet.Address))
 
 	client, err := s.scanService.GetClient(ctx, wallet.ChainID)
 	if err != nil {
@@ -356,27 +440,12 @@ func (s *service) collectWalletERC20(
 		return errors.Wrap(err, "failed to fetch native balance for ERC20 collect")
 	}
 
-	tipCap, err := client.SuggestGasTipCap(ctx)
-	if err != nil {
-		return errors.Wrap(err, "failed to suggest gas tip cap for ERC20 collect")
-	}
-
-	latestBlock, err := client.GetBlockByNumber(ctx, nil)
+	fees, err := s.suggestGasFees(ctx, client, wallet.ChainID)
 	if err != nil {
-		return errors.Wrap(err, "failed to fetch latest block header for ERC20 collect")
+		return errors.Wrap(err, "failed to suggest gas fees for ERC20 collect")
 	}
 
-	baseFee := latestBlock.BaseFee()
-	if baseFee == nil {
-		baseFee = big.NewInt(0)
-	}
-
-	maxFee := new(big.Int).Add(
-		new(big.Int).Mul(baseFee, big.NewInt(defaultEIP1559Multiplier)),
-		tipCap,
-	)
-
-	gasFee := new(big.Int).Mul(maxFee, big.NewInt(int64(defaultERC20CollectGasLimit)))
+	gasFee := fees.Cost(defaultERC20CollectGasLimit)
 
 	for _, token := range tokens {
 		if token == nil || token.IsNative || !token.TokenAddress.Valid || token.TokenAddress.String == "" {
@@ -400,79 +469,26 @@ func (s *service) collectWalletERC20(
 			continue
 		}
 
-		minCollectAmount := getTokenMinCollectAmount(token)
+		minCollectAmount := s.getTokenMinCollectAmount(token)
 		if tokenBalance.Cmp(minCollectAmount) < 0 {
 			continue
 		}
 
-		if nativeBalance.Cmp(gasFee) <= 0 {
-			requiredBalance := new(big.Int).Add(gasFee, minBalanceWithGasBuff)
-			updatedBalance, err := s.ensureNativeGas(ctx, wallet, hotWallet, client, nativeBalance, requiredBalance)
-			if err != nil {
-				log.Warn().
-					Err(err).
-					Str("wallet_id", wallet.ID).
-					Str("token_address", tokenAddressStr).
-					Msg("CollectService: failed to top up native balance for ERC20 gas fee")
-				continue
-			}
-			nativeBalance = updatedBalance
+		// Tokens supporting EIP-2612 are collected by the hot wallet with permit + transferFrom,
+		// the user address needs no native gas
+		var txObj *types.Transaction
+		if token.SupportsPermit {
+			txObj, err = s.sendERC20PermitCollect(ctx, wallet, hotWallet, client, fees, tokenAddr, tokenBalance)
+		} else {
+			txObj, err = s.sendERC20Transfer(ctx, wallet, hotWallet, client, fees, tokenAddr, tokenBalance, nativeBalance)
 		}
-
-		data := make([]byte, 0, len(erc20TransferMethodID)+abiPaddedAddressLength*2)
-		data = append(data, erc20TransferMethodID...)
-		data = append(data, common.LeftPadBytes(toAddr.Bytes(), abiPaddedAddressLength)...)
-		data = append(data, common.LeftPadBytes(tokenBalance.Bytes(), abiPaddedAddressLength)...)
-
-		nonce, err := client.PendingNonceAt(ctx, fromAddr)
-		if err != nil {
-			log.Warn().
-				Err(err).
-				Str("wallet_id", wallet.ID).
-				Str("token_address", tokenAddressStr).
-				Msg("CollectService: failed to fetch nonce for ERC20 collect")
-			continue
-		}
-
-		signReq := &signer.SignEVMRequest{
-			ChainID:              int64(wallet.ChainID),
-			To:                   tokenAddr.Hex(),
-			Value:                "0",
-			GasLimit:             defaultERC20CollectGasLimit,
-			MaxFeePerGas:         maxFee.String(),
-			MaxPriorityFeePerGas: tipCap.String(),
-			Nonce:                nonce,
-			Data:                 data,
-			FromAddress:          fromAddr.Hex(),
-			DerivationPath:       wallet.DerivationPath,
-		}
-
-		signResp, err := s.signerService.SignEVMTransaction(ctx, signReq)
 		if err != nil {
 			log.Warn().
 				Err(err).
 				Str("wallet_id", wallet.ID).
 				Str("token_address", tokenAddressStr).
-				Msg("CollectService: failed to sign ERC20 collect transaction")
-			continue
-		}
-
-		txObj := new(types.Transaction)
-		if err := txObj.UnmarshalBinary(signResp.RawTransaction); err != nil {
-			log.Warn().
-				Err(err).
-				Str("wallet_id", wallet.ID).
-				Str("token_address", tokenAddressStr).
-				Msg("CollectService: failed to decode ERC20 collect transaction")
-			continue
-		}
-
-		if err := client.SendTransaction(ctx, txObj); err != nil {
-			log.Warn().
-				Err(err).
-				Str("wallet_id", wallet.ID).
-				Str("token_address", tokenAddressStr).
-				Msg("CollectService: failed to broadcast ERC20 collect transaction")
+				Bool("permit", token.SupportsPermit).
+				Msg("CollectService: failed to send ERC20 collect transaction")
 			continue
 		}
 
@@ -509,7 +525,9 @@ func (s *service) collectWalletERC20(
 			continue
 		}
 
-		nativeBalance.Sub(nativeBalance, gasFee)
+		if !token.SupportsPermit {
+			nativeBalance.Sub(nativeBalance, gasFee)
+		}
 		log.Info().
 			Str("wallet_id", wallet.ID).
 			Str("token_address", tokenAddressStr).
@@ -521,6 +539,111 @@ func (s *service) collectWalletERC20(
 	return nil
 }
 
+// sendERC20Transfer sends transfer of amount from the user address to the hot wallet, topping up native gas
+// of the user address first when nativeBalance does not cover the fee. nativeBalance is updated by the top-up.
+func (s *service) sendERC20Transfer(
+	ctx context.Context,
+	wallet *models.Wallet,
+	hotWallet *models.Wallet,
+	client *scan.RPCClient,
+	fees *scan.GasFees,
+	tokenAddr common.Address,
+	amount *big.Int,
+	nativeBalance *big.Int,
+) (*types.Transaction, error) {
+	gasFee := fees.Cost(defaultERC20CollectGasLimit)
+	if nativeBalance.Cmp(gasFee) <= 0 {
+		requiredBalance := new(big.Int).Add(gasFee, minBalanceWithGasBuff)
+		updatedBalance, err := s.ensureNativeGas(ctx, wallet, hotWallet, client, nativeBalance, requiredBalance)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to top up native balance for ERC20 gas fee")
+		}
+		nativeBalance.Set(updatedBalance)
+	}
+
+	fromAddr := common.HexToAddress(strings.ToLower(wallet.Address))
+	toAddr := common.HexToAddress(strings.ToLower(hotWallet.Address))
+
+	data := make([]byte, 0, len(erc20TransferMethodID)+abiPaddedAddressLength*2)
+	data = append(data, erc20TransferMethodID...)
+	data = append(data, common.LeftPadBytes(toAddr.Bytes(), abiPaddedAddressLength)...)
+	data = append(data, common.LeftPadBytes(amount.Bytes(), abiPaddedAddressLength)...)
+
+	maxFeePerGas, maxPriorityFeePerGas, gasPrice := fees.SignFields()
+	signReq := &signer.SignEVMRequest{
+		ChainID:              int64(wallet.ChainID),
+		To:                   tokenAddr.Hex(),
+		Value:                "0",
+		GasLimit:             defaultERC20CollectGasLimit,
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+		GasPrice:             gasPrice,
+		Data:                 data,
+		FromAddress:          fromAddr.Hex(),
+		DerivationPath:       wallet.DerivationPath,
+	}
+
+	return s.signAndBroadcast(ctx, client, signReq)
+}
+
+// signAndBroadcast simulates the transaction, then fetches the pending nonce of signReq.FromAddress, signs and broadcasts it
+// through the same RPC endpoint, so a failover cannot pair a nonce with a node whose pending pool differs
+// (see scan.StickyClient for the fallback rules).
+func (s *service) signAndBroadcast(ctx context.Context, client *scan.RPCClient, signReq *signer.SignEVMRequest) (*types.Transaction, error) {
+	// Simulate first so a transaction that would revert (e.g. a blacklisted token holder) does not burn gas
+	msg, err := signReq.CallMsg()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build simulation call")
+	}
+	if err := client.SimulateTransaction(ctx, msg); err != nil {
+		return nil, errors.Wrap(err, "transaction simulation failed")
+	}
+
+	sticky, err := client.Sticky(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer sticky.Close()
+
+	nonce, err := sticky.PendingNonceAt(ctx, common.HexToAddress(signReq.FromAddress))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch pending nonce")
+	}
+	signReq.Nonce = nonce
+
+	signResp, err := s.signerService.SignEVMTransaction(ctx, signReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign transaction")
+	}
+
+	txObj := new(types.Transaction)
+	if err := txObj.UnmarshalBinary(signResp.RawTransaction); err != nil {
+		return nil, errors.Wrap(err, "failed to decode signed transaction")
+	}
+
+	if err := sticky.SendTransaction(ctx, txObj); err != nil {
+		return nil, errors.Wrap(err, "failed to broadcast transaction")
+	}
+
+	return txObj, nil
+}
+
+// suggestGasFees fetches the current gas prices of the chain, falling back to legacy gas price
+// transactions on chains without EIP-1559 support or configured as legacy.
+// Returns a *gasguard.CapExceededError when the gas price exceeds the cap of the chain.
+func (s *service) suggestGasFees(ctx context.Context, client *scan.RPCClient, chainID int) (*scan.GasFees, error) {
+	fees, err := client.SuggestGasFees(ctx, s.config.BaseFeeMultiplier, slices.Contains(s.config.LegacyTxChainIDs, chainID))
+	if err != nil {
+		return nil, err //nolint:wrapcheck // errors are wrapped by the callers
+	}
+
+	if err := s.gasPriceCap.Check(ctx, chainID, fees); err != nil {
+		return nil, err //nolint:wrapcheck // errors are wrapped by the callers
+	}
+
+	return fees, nil
+}
+
 func (s *service) ensureNativeGas(
 	ctx context.Context,
 	wallet *models.Wallet,
@@ -533,6 +656,11 @@ func (s *service) ensureNativeGas(
 		return currentBalance, nil
 	}
 
+	// Gas top-ups are paused while the base fee exceeds the configured ceiling
+	if s.gasGuard.IsPaused(wallet.ChainID) {
+		return nil, errors.New("gas top-up paused by gas spike circuit breaker")
+	}
+
 	shortfall := new(big.Int).Sub(requiredBalance, currentBalance)
 	shortfall.Add(shortfall, nativeTopUpBufferWei)
 
@@ -544,62 +672,34 @@ func (s *service) ensureNativeGas(
 		return nil, errors.Wrap(err, "failed to query hot wallet balance for top-up")
 	}
 
-	tipCap, err := client.SuggestGasTipCap(ctx)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to suggest gas tip cap for top-up")
-	}
-
-	latestBlock, err := client.GetBlockByNumber(ctx, nil)
+	fees, err := s.suggestGasFees(ctx, client, wallet.ChainID)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to fetch latest block header for top-up")
+		return nil, errors.Wrap(err, "failed to suggest gas fees for top-up")
 	}
 
-	baseFee := latestBlock.BaseFee()
-	if baseFee == nil {
-		baseFee = big.NewInt(0)
-	}
-
-	maxFee := new(big.Int).Add(
-		new(big.Int).Mul(baseFee, big.NewInt(defaultEIP1559Multiplier)),
-		tipCap,
-	)
-
-	gasFee := new(big.Int).Mul(maxFee, big.NewInt(int64(collectGasLimitNative)))
+	gasFee := fees.Cost(collectGasLimitNative)
 	totalCost := new(big.Int).Add(shortfall, gasFee)
 
 	if hotBalance.Cmp(totalCost) <= 0 {
 		return nil, errors.New("hot wallet does not have enough native balance for top-up")
 	}
 
-	nonce, err := client.PendingNonceAt(ctx, fromAddr)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to fetch hot wallet nonce for top-up")
-	}
-
+	maxFeePerGas, maxPriorityFeePerGas, gasPrice := fees.SignFields()
 	signReq := &signer.SignEVMRequest{
 		ChainID:              int64(wallet.ChainID),
 		To:                   toAddr.Hex(),
 		Value:                shortfall.String(),
 		GasLimit:             collectGasLimitNative,
-		MaxFeePerGas:         maxFee.String(),
-		MaxPriorityFeePerGas: tipCap.String(),
-		Nonce:                nonce,
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+		GasPrice:             gasPrice,
 		FromAddress:          fromAddr.Hex(),
 		DerivationPath:       hotWallet.DerivationPath,
 	}
 
-	signResp, err := s.signerService.SignEVMTransaction(ctx, signReq)
+	txObj, err := s.signAndBroadcast(ctx, client, signReq)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to sign native top-up transaction")
-	}
-
-	txObj := new(types.Transaction)
-	if err := txObj.UnmarshalBinary(signResp.RawTransaction); err != nil {
-		return nil, errors.Wrap(err, "failed to decode native top-up transaction")
-	}
-
-	if err := client.SendTransaction(ctx, txObj); err != nil {
-		return nil, errors.Wrap(err, "failed to broadcast native top-up transaction")
+		return nil, errors.Wrap(err, "failed to send native top-up transaction")
 	}
 
 	receipt, err := s.waitForReceipt(ctx, client, txObj.Hash())
@@ -628,7 +728,7 @@ func (s *service) insertCollectTransaction(
 	amountWei *big.Int,
 	tx *types.Transaction,
 	receipt *types.Receipt,
-	status string,
+	status models.TransactionStatus,
 	tokenAddress null.String,
 ) error {
 	transaction := &models.Transaction{
@@ -645,18 +745,52 @@ func (s *service) insertCollectTransaction(
 		ConfirmationCount: null.IntFrom(0),
 	}
 
-	if err := transaction.Insert(ctx, s.db, boil.Infer()); err != nil {
+	dbTx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = dbTx.Rollback() }()
+
+	if err := transaction.Insert(ctx, dbTx, boil.Infer()); err != nil {
 		return errors.Wrap(err, "failed to insert collect transaction")
 	}
 
+	// 归集不改变用户余额，只记录资金从用户充值地址转入热钱包的托管流转，便于按用户追溯链上资金去向
+	if _, err := dbTx.ExecContext(ctx, `
+		INSERT INTO custody_moves (transaction_id, move_type, user_id, chain_id, from_wallet_id, from_address, to_wallet_id, to_address, token_addr, amount)
+		VALUES ($1, 'collect', $2, $3, $4, $5, $6, $7, $8, $9)
+	`,
+		transaction.ID,
+		fromWallet.UserID,
+		fromWallet.ChainID,
+		fromWallet.ID,
+		transaction.FromAddr,
+		toWallet.ID,
+		transaction.ToAddr,
+		tokenAddress,
+		transaction.Amount,
+	); err != nil {
+		return errors.Wrap(err, "failed to insert collect custody move")
+	}
+
+	if err := dbTx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit collect transaction")
+	}
+
+	asset := walletMetrics.AssetNative
+	if tokenAddress.Valid {
+		asset = walletMetrics.AssetERC20
+	}
+	walletMetrics.CollectTransactions.WithLabelValues(walletMetrics.ChainLabel(fromWallet.ChainID), asset, status.String()).Inc()
+
 	return nil
 }
 
 func (s *service) waitForReceipt(ctx context.Context, client *scan.RPCClient, txHash common.Hash) (*types.Receipt, error) {
-	localCtx, cancel := context.WithTimeout(ctx, receiptWaitTimeout)
+	localCtx, cancel := context.WithTimeout(ctx, s.config.ReceiptTimeout)
 	defer cancel()
 
-	ticker := time.NewTicker(receiptPollInterval)
+	ticker := time.NewTicker(s.config.ReceiptPollInterval)
 	defer ticker.Stop()
 
 	for {
@@ -693,45 +827,17 @@ func (s *service) getActiveTokensForChain(ctx context.Context, chainID int) ([]*
 	return tokens, nil
 }
 
-func getTokenMinCollectAmount(token *models.Token) *big.Int {
+func (s *service) getTokenMinCollectAmount(token *models.Token) *big.Int {
 	if token != nil && token.MinWithdrawAmount.Valid && token.MinWithdrawAmount.String != "" {
-		if amountWei, err := convertAmountToWei(token.MinWithdrawAmount.String, token.Decimals); err == nil && amountWei.Sign() > 0 {
+		if amountWei, err := money.ToSmallestUnit(token.MinWithdrawAmount.String, token.Decimals); err == nil {
 			return amountWei
 		}
 	}
 
-	amountWei, err := convertAmountToWei(defaultMinERC20CollectAmountString, token.Decimals)
+	amountWei, err := money.ToSmallestUnit(s.config.MinERC20CollectAmount, token.Decimals)
 	if err != nil {
 		return big.NewInt(1)
 	}
 
 	return amountWei
 }
-
-func convertAmountToWei(amountStr string, decimals int) (*big.Int, error) {
-	const (
-		defaultDecimalBase  = 10
-		defaultFloatBits    = 256
-		defaultRoundingMode = big.ToNearestEven
-		defaultScaleBase    = 10
-	)
-
-	amountFloat, _, err := big.ParseFloat(amountStr, defaultDecimalBase, defaultFloatBits, defaultRoundingMode)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to parse token amount")
-	}
-
-	if decimals <= 0 {
-		result := new(big.Int)
-		amountFloat.Int(result)
-		return result, nil
-	}
-
-	scale := new(big.Int).Exp(big.NewInt(defaultScaleBase), big.NewInt(int64(decimals)), nil)
-	scaleFloat := new(big.Float).SetInt(scale)
-	amountFloat.Mul(amountFloat, scaleFloat)
-
-	result := new(big.Int)
-	amountFloat.Int(result)
-	return result, nil
-}
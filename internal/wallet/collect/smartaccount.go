@@ -0,0 +1,312 @@
+package collect
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/signer"
+	"github/chapool/go-wallet/internal/wallet/smartaccount"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/aarondl/null/v8"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// Gas limits of collect user operations, the paymaster only pays for the gas actually used
+const (
+	userOpNativeCallGasLimit         uint64 = 60000
+	userOpERC20CallGasLimit          uint64 = 120000
+	userOpVerificationGasLimit       uint64 = 150000
+	userOpDeployVerificationGasLimit uint64 = 500000 // Includes deploying the account through initCode
+	userOpPreVerificationGas         uint64 = 60000
+	handleOpsOverheadGas             uint64 = 60000
+	// The verification gas limit applies to validateUserOp, validatePaymasterUserOp and postOp (EntryPoint v0.6)
+	verificationGasLimitPhases = 3
+)
+
+// isSmartAccount reports whether the wallet address is an ERC-4337 smart account of a derived owner address.
+func isSmartAccount(wallet *models.Wallet) bool {
+	return wallet.SmartAccountOwner.Valid && wallet.SmartAccountFactory.Valid
+}
+
+// collectSmartAccount sweeps the ERC20 and native balances of a smart account wallet above the collect thresholds.
+// Gas is sponsored by the paymaster, so the full balances are collected and the account never needs a gas top-up.
+func (s *service) collectSmartAccount(ctx context.Context, wallet *models.Wallet, hotWallet *models.Wallet, tokens []*models.Token) error {
+	if _, loaded := s.collecting.LoadOrStore(wallet.ID, struct{}{}); loaded {
+		log.Debug().
+			Str("wallet_id", wallet.ID).
+			Msg("CollectService: wallet is already collecting, skipping")
+		return nil
+	}
+	defer s.collecting.Delete(wallet.ID)
+
+	client, err := s.scanService.GetClient(ctx, wallet.ChainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get RPC client")
+	}
+
+	fees, err := s.suggestGasFees(ctx, client, wallet.ChainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to suggest gas fees")
+	}
+
+	account := common.HexToAddress(wallet.Address)
+
+	for _, token := range tokens {
+		if token == nil || token.IsNative || !token.TokenAddress.Valid || token.TokenAddress.String == "" {
+			continue
+		}
+
+		tokenAddr := common.HexToAddress(strings.ToLower(token.TokenAddress.String))
+		tokenBalance, err := client.TokenBalance(ctx, tokenAddr, account)
+		if err != nil {
+			log.Warn().
+				Err(err).
+				Str("wallet_id", wallet.ID).
+				Str("token_address", tokenAddr.Hex()).
+				Msg("CollectService: failed to query smart account ERC20 balance")
+			continue
+		}
+		if tokenBalance.Sign() <= 0 || tokenBalance.Cmp(s.getTokenMinCollectAmount(token)) < 0 {
+			continue
+		}
+
+		if err := s.collectSmartAccountAsset(ctx, wallet, hotWallet, client, fees, &tokenAddr, tokenBalance); err != nil {
+			log.Warn().
+				Err(err).
+				Str("wallet_id", wallet.ID).
+				Str("token_address", tokenAddr.Hex()).
+				Msg("CollectService: failed to collect smart account ERC20 funds")
+		}
+	}
+
+	balance, err := client.BalanceAt(ctx, account)
+	if err != nil {
+		return errors.Wrap(err, "failed to query smart account balance")
+	}
+	if balance.Sign() <= 0 || balance.Cmp(s.config.MinNativeCollectAmountWei) < 0 {
+		return nil
+	}
+
+	return s.collectSmartAccountAsset(ctx, wallet, hotWallet, client, fees, nil, balance)
+}
+
+// collectSmartAccountAsset sends amount of the native token (tokenAddr nil) or an ERC20 token from the smart account
+// to the hot wallet, waits for the receipt and records the collect transaction.
+func (s *service) collectSmartAccountAsset(
+	ctx context.Context,
+	wallet *models.Wallet,
+	hotWallet *models.Wallet,
+	client *scan.RPCClient,
+	fees *scan.GasFees,
+	tokenAddr *common.Address,
+	amount *big.Int,
+) error {
+	txObj, userOpHash, err := s.sendSmartAccountTransfer(ctx, wallet, hotWallet, client, fees, tokenAddr, amount)
+	if err != nil {
+		return err
+	}
+
+	receipt, err := s.waitForReceipt(ctx, client, txObj.Hash())
+	if err != nil {
+		return errors.Wrap(err, "failed while waiting for user operation receipt")
+	}
+
+	status := s.receiptStatus(wallet, receipt, &userOpHash)
+
+	tokenAddress := null.String{}
+	if tokenAddr != nil {
+		tokenAddress = null.StringFrom(strings.ToLower(tokenAddr.Hex()))
+	}
+	if err := s.insertCollectTransaction(ctx, wallet, hotWallet, amount, txObj, receipt, status, tokenAddress); err != nil {
+		return errors.Wrap(err, "failed to insert collect transaction record")
+	}
+
+	log.Info().
+		Str("wallet_id", wallet.ID).
+		Str("user_id", wallet.UserID).
+		Str("token_address", tokenAddress.String).
+		Str("amount", amount.String()).
+		Str("tx_hash", txObj.Hash().Hex()).
+		Str("user_op_hash", userOpHash.Hex()).
+		Str("status", status.String()).
+		Msg("CollectService: collected smart account funds to hot wallet")
+
+	return nil
+}
+
+// sendRequestedSmartAccount sends amount (or the full balance when amount is nil) of the native token (token nil)
+// or an ERC20 token from a smart account wallet. The paymaster pays the gas, so no fee is deducted from the balance.
+func (s *service) sendRequestedSmartAccount(
+	ctx context.Context,
+	wallet *models.Wallet,
+	hotWallet *models.Wallet,
+	client *scan.RPCClient,
+	fees *scan.GasFees,
+	token *models.Token,
+	amount *big.Int,
+) (*types.Transaction, *big.Int, *common.Hash, error) {
+	account := common.HexToAddress(wallet.Address)
+
+	var (
+		tokenAddr *common.Address
+		balance   *big.Int
+		err       error
+	)
+	if token == nil {
+		balance, err = client.BalanceAt(ctx, account)
+	} else {
+		addr := common.HexToAddress(strings.ToLower(token.TokenAddress.String))
+		tokenAddr = &addr
+		balance, err = client.TokenBalance(ctx, addr, account)
+	}
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to query smart account balance")
+	}
+
+	if amount == nil {
+		amount = balance
+	}
+	if amount.Sign() <= 0 || balance.Cmp(amount) < 0 {
+		return nil, nil, nil, errors.Wrapf(walleterrors.ErrInsufficientBalance, "balance %s does not cover amount %s", balance, amount)
+	}
+
+	txObj, userOpHash, err := s.sendSmartAccountTransfer(ctx, wallet, hotWallet, client, fees, tokenAddr, amount)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return txObj, amount, &userOpHash, nil
+}
+
+// sendSmartAccountTransfer signs a user operation transferring amount from the smart account to the hot wallet with the
+// owner key and submits it through handleOps of the EntryPoint from the hot wallet, which is refunded by the paymaster
+// as beneficiary. Accounts without code are deployed by the same user operation.
+// Returns the unconfirmed handleOps transaction and the user operation hash.
+func (s *service) sendSmartAccountTransfer(
+	ctx context.Context,
+	wallet *models.Wallet,
+	hotWallet *models.Wallet,
+	client *scan.RPCClient,
+	fees *scan.GasFees,
+	tokenAddr *common.Address,
+	amount *big.Int,
+) (*types.Transaction, common.Hash, error) {
+	config, ok := s.smartAccounts.Config(wallet.ChainID)
+	if !ok {
+		return nil, common.Hash{}, errors.Errorf("smart accounts are not configured for chain %d", wallet.ChainID)
+	}
+
+	account := common.HexToAddress(wallet.Address)
+	owner := common.HexToAddress(wallet.SmartAccountOwner.String)
+	beneficiary := common.HexToAddress(hotWallet.Address)
+
+	// The account executes the transfer: a plain value transfer or transfer(hotWallet, amount) on the token
+	var (
+		callData     []byte
+		callGasLimit uint64
+		err          error
+	)
+	if tokenAddr == nil {
+		callData, err = smartaccount.ExecuteCallData(beneficiary, amount, nil)
+		callGasLimit = userOpNativeCallGasLimit
+	} else {
+		transfer := make([]byte, 0, len(erc20TransferMethodID)+abiPaddedAddressLength*2)
+		transfer = append(transfer, erc20TransferMethodID...)
+		transfer = append(transfer, common.LeftPadBytes(beneficiary.Bytes(), abiPaddedAddressLength)...)
+		transfer = append(transfer, common.LeftPadBytes(amount.Bytes(), abiPaddedAddressLength)...)
+		callData, err = smartaccount.ExecuteCallData(*tokenAddr, big.NewInt(0), transfer)
+		callGasLimit = userOpERC20CallGasLimit
+	}
+	if err != nil {
+		return nil, common.Hash{}, err
+	}
+
+	// Counterfactual accounts are deployed by the factory of the wallet, which may differ from the configured one
+	code, err := client.CodeAt(ctx, account, nil)
+	if err != nil {
+		return nil, common.Hash{}, errors.Wrap(err, "failed to query smart account code")
+	}
+	var initCode []byte
+	verificationGasLimit := userOpVerificationGasLimit
+	if len(code) == 0 {
+		initCode, err = smartaccount.InitCode(common.HexToAddress(wallet.SmartAccountFactory.String), owner)
+		if err != nil {
+			return nil, common.Hash{}, err
+		}
+		verificationGasLimit = userOpDeployVerificationGasLimit
+	}
+
+	nonce, err := s.smartAccounts.GetNonce(ctx, client, config.EntryPoint, account)
+	if err != nil {
+		return nil, common.Hash{}, err
+	}
+
+	// Legacy chains use the gas price for both fields, the EntryPoint then ignores the base fee
+	maxFeePerGas, maxPriorityFeePerGas := fees.MaxFee, fees.TipCap
+	if fees.Legacy {
+		maxFeePerGas, maxPriorityFeePerGas = fees.GasPrice, fees.GasPrice
+	}
+
+	op := &signer.UserOperation{
+		Sender:               account,
+		Nonce:                nonce,
+		InitCode:             initCode,
+		CallData:             callData,
+		CallGasLimit:         new(big.Int).SetUint64(callGasLimit),
+		VerificationGasLimit: new(big.Int).SetUint64(verificationGasLimit),
+		PreVerificationGas:   new(big.Int).SetUint64(userOpPreVerificationGas),
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+		PaymasterAndData:     config.Paymaster.Bytes(),
+	}
+
+	signResp, err := s.signerService.SignEVMUserOperation(ctx, &signer.SignEVMUserOperationRequest{
+		ChainID:        int64(wallet.ChainID),
+		EntryPoint:     config.EntryPoint.Hex(),
+		Owner:          owner.Hex(),
+		UserOperation:  op,
+		DerivationPath: wallet.DerivationPath,
+	})
+	if err != nil {
+		return nil, common.Hash{}, errors.Wrap(err, "failed to sign user operation")
+	}
+	op.Signature = signResp.Signature
+
+	data, err := smartaccount.HandleOpsCallData([]signer.UserOperation{*op}, beneficiary)
+	if err != nil {
+		return nil, common.Hash{}, err
+	}
+
+	gasLimit := userOpPreVerificationGas + verificationGasLimit*verificationGasLimitPhases + callGasLimit + handleOpsOverheadGas
+	txObj, err := s.signAndBroadcast(ctx, client, hotWalletSignRequest(wallet.ChainID, hotWallet, fees, config.EntryPoint, gasLimit, data))
+	if err != nil {
+		return nil, common.Hash{}, errors.Wrap(err, "failed to send handleOps transaction")
+	}
+
+	return txObj, common.HexToHash(signResp.UserOpHash), nil
+}
+
+// receiptStatus returns the status of a collect transaction. For user operations a successful handleOps transaction
+// is not enough, the account call may still have reverted, so the UserOperationEvent of userOpHash has to report success.
+func (s *service) receiptStatus(wallet *models.Wallet, receipt *types.Receipt, userOpHash *common.Hash) models.TransactionStatus {
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return models.TransactionStatusFailed
+	}
+	if userOpHash == nil {
+		return models.TransactionStatusConfirmed
+	}
+
+	config, ok := s.smartAccounts.Config(wallet.ChainID)
+	if !ok || !smartaccount.UserOperationSucceeded(receipt, config.EntryPoint, *userOpHash) {
+		return models.TransactionStatusFailed
+	}
+
+	return models.TransactionStatusConfirmed
+}
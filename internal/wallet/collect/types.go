@@ -1,11 +1,37 @@
 package collect
 
-import "math/big"
+import (
+	"math/big"
+	"time"
 
-// Request represents a manual collect request.
+	"github/chapool/go-wallet/internal/models"
+)
+
+// Request represents a manual collect request of a single asset of a user wallet.
 type Request struct {
-	WalletID string
-	ChainID  int
-	TokenID  int
-	Amount   *big.Int // Amount in wei
+	WalletID    string
+	TokenID     *int   // Token to collect, nil collects the native token
+	Amount      string // Amount in whole tokens, empty sweeps the full balance
+	HotWalletID string // Destination hot wallet, empty uses the hot wallet selected for the chain
+}
+
+// Result describes the collect transaction sent for a Request.
+type Result struct {
+	WalletID     string
+	HotWalletID  string
+	TokenAddress string   // Empty for the native token
+	Amount       *big.Int // Amount in the smallest unit
+	TxHash       string
+	Status       models.TransactionStatus
+}
+
+// Config holds the tunable collect settings.
+type Config struct {
+	MinNativeCollectAmountWei *big.Int      // Minimum native balance of a user address worth sweeping
+	MinERC20CollectAmount     string        // Default minimum ERC20 amount (in whole tokens) unless configured per token
+	BaseFeeMultiplier         int64         // maxFeePerGas = baseFee * BaseFeeMultiplier + tipCap
+	WorkerConcurrency         int           // Number of chains collected in parallel by the auto collect scheduler
+	LegacyTxChainIDs          []int         // Chains always using legacy (gasPrice) transactions; chains without a base fee are detected automatically
+	ReceiptPollInterval       time.Duration // How often the receipt of a gas top-up or sweep transaction is polled
+	ReceiptTimeout            time.Duration // How long to wait for the receipt of a gas top-up or sweep transaction
 }
@@ -0,0 +1,187 @@
+package deposit
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// DustSummary 链上代币累计的小额充值（低于代币最小充值金额，记录为 dust 不入账）
+type DustSummary struct {
+	ChainID          int
+	TokenID          int
+	TokenSymbol      string
+	MinDepositAmount string // 当前的最小充值金额（人类可读单位）
+	DepositCount     int
+	AddressCount     int    // 收到小额充值的地址数
+	TotalAmount      string // 累计金额（人类可读单位）
+	FirstDepositAt   time.Time
+	LastDepositAt    time.Time
+}
+
+// dustThresholds 链上配置了最小充值金额的代币：代币地址（原生代币为空字符串） -> 最小充值金额（最小单位）
+func dustThresholds(ctx context.Context, db boil.ContextExecutor, chainID int) (map[string]*big.Int, error) {
+	tokens, err := models.Tokens(
+		models.TokenWhere.ChainID.EQ(chainID),
+	).All(ctx, db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query tokens")
+	}
+
+	thresholds := make(map[string]*big.Int)
+	for _, token := range tokens {
+		minAmount, ok := minDepositUnits(token.MinDepositAmount.String, token.Decimals)
+		if !ok {
+			continue
+		}
+
+		tokenAddr := ""
+		if !token.IsNative {
+			if !token.TokenAddress.Valid || token.TokenAddress.String == "" {
+				continue
+			}
+			tokenAddr = token.TokenAddress.String
+		}
+		thresholds[tokenAddr] = minAmount
+	}
+
+	return thresholds, nil
+}
+
+// minDepositUnits 将最小充值金额（人类可读单位）转换为最小单位，向上取整；未配置或为 0 时返回 false
+// 充值金额是整数，amount < ceil(min) 与 amount < min 等价
+func minDepositUnits(amount string, decimals int) (*big.Int, bool) {
+	value, err := money.ParseDecimal(amount)
+	if err != nil || value.Sign() <= 0 {
+		return nil, false
+	}
+
+	return money.CeilSmallestUnit(value, decimals), true
+}
+
+// markDustDeposits 将低于代币最小充值金额、尚未终结的充值标记为 dust
+// dust 交易不再推进确认状态，不生成 credits，也不计入待确认余额
+func (p *transactionStatusProcessor) markDustDeposits(ctx context.Context, tx *sql.Tx, chainID int) (int64, error) {
+	thresholds, err := dustThresholds(ctx, tx, chainID)
+	if err != nil {
+		return 0, err
+	}
+	if len(thresholds) == 0 {
+		return 0, nil
+	}
+
+	tokenAddrs := make([]string, 0, len(thresholds))
+	minAmounts := make([]string, 0, len(thresholds))
+	for tokenAddr, minAmount := range thresholds {
+		tokenAddrs = append(tokenAddrs, tokenAddr)
+		minAmounts = append(minAmounts, minAmount.String())
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE transactions t SET
+			status = $2,
+			updated_at = NOW()
+		FROM unnest($3::text[], $4::numeric[]) AS m(token_addr, min_amount)
+		WHERE t.chain_id = $1
+			AND t.type = $5
+			AND t.status = ANY($6::transaction_status[])
+			AND COALESCE(t.token_addr, '') = m.token_addr
+			AND t.amount::numeric < m.min_amount
+	`, chainID, models.TransactionStatusDust, pq.Array(tokenAddrs), pq.Array(minAmounts), models.TransactionTypeDeposit,
+		pq.Array([]models.TransactionStatus{models.TransactionStatusConfirmed, models.TransactionStatusSafe}))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to mark dust deposits")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get marked dust deposit rows")
+	}
+
+	if rowsAffected > 0 {
+		log.Info().
+			Int("chain_id", chainID).
+			Int64("count", rowsAffected).
+			Msg("Deposits below the minimum deposit amount marked as dust")
+	}
+
+	return rowsAffected, nil
+}
+
+// GetDustReport 按链和代币汇总小额充值，chainID 为空时汇总所有链
+func (s *service) GetDustReport(ctx context.Context, chainID *int) ([]*DustSummary, error) {
+	query := `
+		SELECT
+			tk.chain_id,
+			tk.id,
+			tk.token_symbol,
+			tk.decimals,
+			COALESCE(tk.min_deposit_amount, '0'),
+			COUNT(*),
+			COUNT(DISTINCT t.to_addr),
+			SUM(t.amount::numeric)::text,
+			MIN(t.created_at),
+			MAX(t.created_at)
+		FROM transactions t
+		JOIN tokens tk ON tk.chain_id = t.chain_id
+			AND (
+				(COALESCE(t.token_addr, '') = '' AND tk.is_native)
+				OR t.token_addr = tk.token_address
+			)
+		WHERE t.type = $1
+			AND t.status = $2`
+	args := []any{models.TransactionTypeDeposit, models.TransactionStatusDust}
+	if chainID != nil {
+		query += ` AND t.chain_id = $3`
+		args = append(args, *chainID)
+	}
+	query += `
+		GROUP BY tk.chain_id, tk.id
+		ORDER BY tk.chain_id, tk.id`
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query dust deposits")
+	}
+	defer rows.Close()
+
+	summaries := make([]*DustSummary, 0)
+	for rows.Next() {
+		var (
+			summary     DustSummary
+			decimals    int
+			totalAmount string
+		)
+		if err := rows.Scan(
+			&summary.ChainID,
+			&summary.TokenID,
+			&summary.TokenSymbol,
+			&decimals,
+			&summary.MinDepositAmount,
+			&summary.DepositCount,
+			&summary.AddressCount,
+			&totalAmount,
+			&summary.FirstDepositAt,
+			&summary.LastDepositAt,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan dust deposit summary")
+		}
+		summary.TotalAmount = money.FormatSmallestUnit(totalAmount, decimals)
+		summaries = append(summaries, &summary)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate dust deposit summaries")
+	}
+
+	return summaries, nil
+}
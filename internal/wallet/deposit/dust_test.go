@@ -0,0 +1,39 @@
+package deposit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinDepositUnits(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		decimals int
+		want     string
+		ok       bool
+	}{
+		{"not configured", "", 18, "", false},
+		{"zero", "0", 18, "", false},
+		{"negative", "-1", 6, "", false},
+		{"invalid", "abc", 6, "", false},
+		{"integer", "1", 6, "1000000", true},
+		{"fraction", "0.5", 18, "500000000000000000", true},
+		{"trimmed", " 0.01 ", 8, "1000000", true},
+		{"rounded up", "0.0000015", 6, "2", true},
+		{"zero decimals", "1.5", 0, "2", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			units, ok := minDepositUnits(tt.amount, tt.decimals)
+			assert.Equal(t, tt.ok, ok)
+			if tt.ok {
+				assert.Equal(t, tt.want, units.String())
+			} else {
+				assert.Nil(t, units)
+			}
+		})
+	}
+}
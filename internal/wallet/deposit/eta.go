@@ -0,0 +1,117 @@
+package deposit
+
+import (
+	"context"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultBlockTimeSeconds = 12
+
+	// degradedSlowdownFactor 降级估算时出块/扫描速度按配置出块时间的倍数计算
+	degradedSlowdownFactor = 2
+)
+
+// ChainConditions 链的实时扫描状况，由扫描服务提供
+type ChainConditions struct {
+	LatestBlock int64 // 链上最新区块
+	ScannedTo   int64 // 已扫描到的区块
+	Halted      bool  // 链停摆（最新区块长时间不变）
+	Unavailable bool  // RPC 不可用，LatestBlock 未知
+}
+
+// lagging 扫描落后超过确认区块数、链停摆或状况未知时视为降级
+func (c *ChainConditions) lagging(confirmationBlocks int64) bool {
+	if c == nil || c.Unavailable || c.Halted {
+		return true
+	}
+	return c.LatestBlock-c.ScannedTo > confirmationBlocks
+}
+
+// FinalityETA 充值预计终结时间
+type FinalityETA struct {
+	EstimatedAt     time.Time
+	RemainingBlocks int64
+	// Degraded 链停摆、扫描落后或 RPC 不可用时为 true，此时按已扫描的确认数和放慢的出块速度估算
+	Degraded bool
+}
+
+// finalityParams 估算所需的链配置
+type finalityParams struct {
+	blockTime          time.Duration
+	confirmationBlocks int64
+	finalizedBlocks    int64
+}
+
+// EstimateFinality 估算未终结充值的终结时间
+func (s *service) EstimateFinality(ctx context.Context, transactions []*models.Transaction, conditions map[int]*ChainConditions) (map[string]*FinalityETA, error) {
+	now := time.Now()
+	params := make(map[int]*finalityParams)
+	result := make(map[string]*FinalityETA)
+
+	for _, tx := range transactions {
+		if tx.Status != models.TransactionStatusConfirmed && tx.Status != models.TransactionStatusSafe {
+			continue
+		}
+
+		chainParams, ok := params[tx.ChainID]
+		if !ok {
+			chain, err := s.chainService.GetChain(ctx, tx.ChainID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get chain config for chain_id=%d", tx.ChainID)
+			}
+			chainParams = newFinalityParams(chain)
+			params[tx.ChainID] = chainParams
+		}
+
+		confirmations := int64(0)
+		if tx.ConfirmationCount.Valid {
+			confirmations = int64(tx.ConfirmationCount.Int)
+		}
+
+		result[tx.ID] = estimateFinality(now, tx.BlockNo, confirmations, chainParams, conditions[tx.ChainID])
+	}
+
+	return result, nil
+}
+
+// newFinalityParams 读取链的出块时间和确认/终结区块数，未配置时使用默认值
+func newFinalityParams(chain *models.Chain) *finalityParams {
+	params := &finalityParams{
+		blockTime:          defaultBlockTimeSeconds * time.Second,
+		confirmationBlocks: defaultConfirmationBlocks,
+		finalizedBlocks:    defaultFinalizedBlocks,
+	}
+	if chain.BlockTimeSeconds.Valid && chain.BlockTimeSeconds.Int > 0 {
+		params.blockTime = time.Duration(chain.BlockTimeSeconds.Int) * time.Second
+	}
+	if chain.ConfirmationBlocks.Valid {
+		params.confirmationBlocks = int64(chain.ConfirmationBlocks.Int)
+	}
+	if chain.FinalizedBlocks.Valid {
+		params.finalizedBlocks = int64(chain.FinalizedBlocks.Int)
+	}
+	return params
+}
+
+// estimateFinality 计算单笔充值的终结时间
+// 扫描正常时按链上最新区块计算剩余区块；降级时只能信任已扫描的确认数，并按放慢的速度估算
+func estimateFinality(now time.Time, blockNo int64, confirmations int64, params *finalityParams, conditions *ChainConditions) *FinalityETA {
+	eta := &FinalityETA{Degraded: conditions.lagging(params.confirmationBlocks)}
+
+	blockTime := params.blockTime
+	if eta.Degraded {
+		blockTime *= degradedSlowdownFactor
+	} else if headConfirmations := conditions.LatestBlock - blockNo; headConfirmations > confirmations {
+		confirmations = headConfirmations
+	}
+
+	eta.RemainingBlocks = max(params.finalizedBlocks-confirmations, 0)
+	eta.EstimatedAt = now.Add(time.Duration(eta.RemainingBlocks) * blockTime)
+
+	return eta
+}
@@ -0,0 +1,49 @@
+package deposit
+
+import (
+	"testing"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateFinality(t *testing.T) {
+	now := time.Date(2025, 11, 27, 12, 0, 0, 0, time.UTC)
+	params := newFinalityParams(&models.Chain{
+		BlockTimeSeconds:   null.IntFrom(3),
+		ConfirmationBlocks: null.IntFrom(15),
+		FinalizedBlocks:    null.IntFrom(30),
+	})
+
+	// 扫描正常：按链上最新区块计算剩余区块
+	eta := estimateFinality(now, 1000, 5, params, &ChainConditions{LatestBlock: 1010, ScannedTo: 1008})
+	assert.False(t, eta.Degraded)
+	assert.Equal(t, int64(20), eta.RemainingBlocks)
+	assert.Equal(t, now.Add(60*time.Second), eta.EstimatedAt)
+
+	// 已达到终结区块数，等待下一轮状态更新
+	eta = estimateFinality(now, 1000, 30, params, &ChainConditions{LatestBlock: 1040, ScannedTo: 1040})
+	assert.Equal(t, int64(0), eta.RemainingBlocks)
+	assert.Equal(t, now, eta.EstimatedAt)
+
+	// 扫描落后超过确认区块数：按已扫描确认数和放慢的出块速度估算
+	eta = estimateFinality(now, 1000, 5, params, &ChainConditions{LatestBlock: 1100, ScannedTo: 1005})
+	assert.True(t, eta.Degraded)
+	assert.Equal(t, int64(25), eta.RemainingBlocks)
+	assert.Equal(t, now.Add(150*time.Second), eta.EstimatedAt)
+
+	// 链停摆或状况未知
+	assert.True(t, estimateFinality(now, 1000, 5, params, &ChainConditions{LatestBlock: 1005, ScannedTo: 1005, Halted: true}).Degraded)
+	assert.True(t, estimateFinality(now, 1000, 5, params, &ChainConditions{Unavailable: true}).Degraded)
+	assert.True(t, estimateFinality(now, 1000, 5, params, nil).Degraded)
+}
+
+func TestNewFinalityParamsDefaults(t *testing.T) {
+	params := newFinalityParams(&models.Chain{BlockTimeSeconds: null.IntFrom(0)})
+	assert.Equal(t, defaultBlockTimeSeconds*time.Second, params.blockTime)
+	assert.Equal(t, int64(defaultConfirmationBlocks), params.confirmationBlocks)
+	assert.Equal(t, int64(defaultFinalizedBlocks), params.finalizedBlocks)
+}
@@ -4,38 +4,40 @@ import (
 	"context"
 	"database/sql"
 	"math/big"
-	"time"
 
-	"github.com/aarondl/null/v8"
-	"github.com/aarondl/sqlboiler/v4/boil"
-	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/chain"
 )
 
 const (
 	defaultConfirmationBlocks = 12
 	defaultFinalizedBlocks    = 32
+
+	// finalizedTrackingFactor 交易终结后继续更新确认数的区块窗口（终结区块数的倍数），超出后确认数不再更新
+	finalizedTrackingFactor = 2
 )
 
 // transactionStatusProcessor 交易状态处理器
+// 每个状态层级使用一条基于区块阈值的 UPDATE，只处理状态或确认数会变化的记录；
+// 所有语句都带状态条件，因此不同链的扫描器可以并行执行，同一条链重复执行也是幂等的
 type transactionStatusProcessor struct {
-	db *sql.DB
+	db           *sql.DB
+	chainService chain.Service
 }
 
 // newTransactionStatusProcessor 创建交易状态处理器
-func newTransactionStatusProcessor(db *sql.DB) *transactionStatusProcessor {
-	return &transactionStatusProcessor{db: db}
+func newTransactionStatusProcessor(db *sql.DB, chainService chain.Service) *transactionStatusProcessor {
+	return &transactionStatusProcessor{db: db, chainService: chainService}
 }
 
 // updateTransactionStatus 更新交易状态（根据确认数）
+// 包括所有类型的交易：deposit, withdraw, collect, rebalance
 func (p *transactionStatusProcessor) updateTransactionStatus(ctx context.Context, chainID int, latestBlockNumber *big.Int) error {
-	// 获取链配置
-	chain, err := models.Chains(
-		models.ChainWhere.ChainID.EQ(chainID),
-	).One(ctx, p.db)
-
+	// 获取链配置（包含配置覆盖的确认/终结区块数）
+	chain, err := p.chainService.GetChain(ctx, chainID)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get chain config for chain_id=%d", chainID)
 	}
@@ -51,149 +53,174 @@ func (p *transactionStatusProcessor) updateTransactionStatus(ctx context.Context
 		finalizedBlocks = int64(chain.FinalizedBlocks.Int)
 	}
 
-	// 查询所有待更新的交易（包括所有状态，因为确认数需要持续更新）
-	// 注意：finalized 状态的交易也需要更新确认数，以便追踪
-	// 包括所有类型的交易：deposit, withdraw, collect, rebalance
-	transactions, err := models.Transactions(
-		models.TransactionWhere.ChainID.EQ(chainID),
-		models.TransactionWhere.Status.IN([]string{
-			models.TransactionStatusConfirmed,
-			models.TransactionStatusSafe,
-			models.TransactionStatusFinalized,
-		}),
-		qm.OrderBy("block_no ASC"),
-	).All(ctx, p.db)
+	latestBlock := latestBlockNumber.Int64()
 
+	tx, err := p.db.BeginTx(ctx, nil)
 	if err != nil {
-		return errors.Wrap(err, "failed to query transactions")
+		return errors.Wrap(err, "failed to begin transaction")
 	}
+	defer func() { _ = tx.Rollback() }()
 
-	log.Info().
-		Int("chain_id", chainID).
-		Int64("latest_block", latestBlockNumber.Int64()).
-		Int64("confirmation_blocks", confirmationBlocks).
-		Int64("finalized_blocks", finalizedBlocks).
-		Int("pending_tx_count", len(transactions)).
-		Msg("Updating transaction confirmation status")
+	// 低于最小充值金额的充值先标记为 dust，不再推进状态
+	dustCount, err := p.markDustDeposits(ctx, tx, chainID)
+	if err != nil {
+		return err
+	}
 
-	updatedCount := 0
-	for _, tx := range transactions {
-		// 计算确认数
-		confirmationCount := latestBlockNumber.Int64() - tx.BlockNo
+	// 先处理更高层级，确认数同时跨过两个阈值的交易直接变为 finalized
+	finalizedIDs, err := p.promoteTransactions(ctx, tx, chainID, latestBlock,
+		[]models.TransactionStatus{models.TransactionStatusConfirmed, models.TransactionStatusSafe},
+		models.TransactionStatusFinalized, latestBlock-finalizedBlocks)
+	if err != nil {
+		return err
+	}
 
-		// 获取当前确认数（用于对比）
-		currentConfirmationCount := int64(0)
-		if tx.ConfirmationCount.Valid {
-			currentConfirmationCount = int64(tx.ConfirmationCount.Int)
-		}
+	safeIDs, err := p.promoteTransactions(ctx, tx, chainID, latestBlock,
+		[]models.TransactionStatus{models.TransactionStatusConfirmed},
+		models.TransactionStatusSafe, latestBlock-confirmationBlocks)
+	if err != nil {
+		return err
+	}
 
-		log.Debug().
-			Int("chain_id", chainID).
-			Str("tx_hash", tx.TXHash).
-			Str("tx_id", tx.ID).
-			Str("tx_type", tx.Type).
-			Int64("tx_block_no", tx.BlockNo).
-			Int64("latest_block", latestBlockNumber.Int64()).
-			Int64("current_confirmation_count", currentConfirmationCount).
-			Int64("calculated_confirmation_count", confirmationCount).
-			Str("current_status", tx.Status).
-			Msg("Calculating confirmation count for transaction")
+	// 同步更新 credits 状态
+	if err := p.updateCreditStatus(ctx, tx, finalizedIDs, models.TransactionStatusFinalized); err != nil {
+		return err
+	}
+	if err := p.updateCreditStatus(ctx, tx, safeIDs, models.TransactionStatusSafe); err != nil {
+		return err
+	}
 
-		// 确保确认数不为负数（防止区块重组等情况）
-		if confirmationCount < 0 {
-			log.Warn().
-				Int("chain_id", chainID).
-				Str("tx_hash", tx.TXHash).
-				Int64("block_no", tx.BlockNo).
-				Int64("latest_block", latestBlockNumber.Int64()).
-				Int64("confirmation_count", confirmationCount).
-				Msg("Negative confirmation count detected, skipping status update")
-			continue
-		}
+	countUpdated, err := p.updateConfirmationCounts(ctx, tx, chainID, latestBlock, latestBlock-finalizedBlocks*finalizedTrackingFactor)
+	if err != nil {
+		return err
+	}
 
-		// 更新确认数
-		tx.ConfirmationCount = null.IntFrom(int(confirmationCount))
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction status update")
+	}
 
-		// 根据确认数更新状态
-		var newStatus string
-		switch {
-		case confirmationCount >= finalizedBlocks:
-			newStatus = "finalized"
-		case confirmationCount >= confirmationBlocks:
-			newStatus = "safe"
-		default:
-			newStatus = "confirmed"
-		}
+	log.Debug().
+		Int("chain_id", chainID).
+		Int64("latest_block", latestBlock).
+		Int64("confirmation_blocks", confirmationBlocks).
+		Int64("finalized_blocks", finalizedBlocks).
+		Int64("confirmation_count_updated", countUpdated).
+		Int64("dust_marked", dustCount).
+		Msg("Transaction confirmation counts updated")
 
-		log.Debug().
+	if len(finalizedIDs) > 0 || len(safeIDs) > 0 {
+		log.Info().
 			Int("chain_id", chainID).
-			Str("tx_hash", tx.TXHash).
-			Str("current_status", tx.Status).
-			Str("calculated_status", newStatus).
-			Int64("confirmation_count", confirmationCount).
-			Int64("block_no", tx.BlockNo).
-			Int64("latest_block", latestBlockNumber.Int64()).
-			Int64("finalized_blocks", finalizedBlocks).
-			Msg("Evaluating transaction status update")
+			Int64("latest_block", latestBlock).
+			Int("finalized_count", len(finalizedIDs)).
+			Int("safe_count", len(safeIDs)).
+			Msg("Transaction statuses updated")
+	}
 
-		// 确保 ConfirmationCount 字段被正确设置
-		tx.ConfirmationCount = null.IntFrom(int(confirmationCount))
+	return nil
+}
 
-		// 如果状态发生变化，更新状态和确认数
-		if tx.Status != newStatus {
-			if err := p.updateTransactionStatusAndConfirmation(ctx, tx, newStatus, confirmationCount, chainID, latestBlockNumber.Int64()); err != nil {
-				log.Error().
-					Str("tx_hash", tx.TXHash).
-					Err(err).
-					Msg("Failed to update transaction status and confirmation count")
-				continue
-			}
-			updatedCount++
-			continue
-		}
+// promoteTransactions 将 block_no <= maxBlockNo 且处于 fromStatuses 的交易更新为 newStatus，返回被更新的交易 ID
+func (p *transactionStatusProcessor) promoteTransactions(
+	ctx context.Context,
+	tx *sql.Tx,
+	chainID int,
+	latestBlock int64,
+	fromStatuses []models.TransactionStatus,
+	newStatus models.TransactionStatus,
+	maxBlockNo int64,
+) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, `
+		UPDATE transactions SET
+			status = $1,
+			confirmation_count = $2 - block_no,
+			updated_at = NOW()
+		WHERE chain_id = $3
+			AND status = ANY($4::transaction_status[])
+			AND block_no <= $5
+		RETURNING id
+	`, newStatus, latestBlock, chainID, pq.Array(fromStatuses), maxBlockNo)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update transactions to status %s", newStatus)
+	}
+	defer rows.Close()
 
-		// 状态没有变化，只更新确认数
-		if err := p.updateConfirmationCountOnly(ctx, tx, confirmationCount, chainID, latestBlockNumber.Int64()); err != nil {
-			log.Error().
-				Int("chain_id", chainID).
-				Str("tx_hash", tx.TXHash).
-				Int64("confirmation_count", confirmationCount).
-				Err(err).
-				Msg("Failed to update transaction confirmation count")
-			continue
+	ids := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(err, "failed to scan updated transaction id")
 		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate updated transactions")
 	}
 
-	if updatedCount > 0 {
-		log.Info().
-			Int("chain_id", chainID).
-			Int("updated_count", updatedCount).
-			Msg("Transaction statuses updated")
+	return ids, nil
+}
+
+// updateConfirmationCounts 只更新确认数（状态不变）
+// 跳过区块高于最新区块的交易（区块重组等情况），以及终结已久（block_no <= finalizedTrackingBlockNo）的交易
+func (p *transactionStatusProcessor) updateConfirmationCounts(
+	ctx context.Context,
+	tx *sql.Tx,
+	chainID int,
+	latestBlock int64,
+	finalizedTrackingBlockNo int64,
+) (int64, error) {
+	result, err := tx.ExecContext(ctx, `
+		UPDATE transactions SET
+			confirmation_count = $1 - block_no,
+			updated_at = NOW()
+		WHERE chain_id = $2
+			AND block_no <= $1
+			AND confirmation_count IS DISTINCT FROM $1 - block_no
+			AND (
+				status IN ($3, $4)
+				OR (status = $5 AND block_no > $6)
+			)
+	`, latestBlock, chainID,
+		models.TransactionStatusConfirmed, models.TransactionStatusSafe,
+		models.TransactionStatusFinalized, finalizedTrackingBlockNo)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to update transaction confirmation counts")
 	}
 
-	return nil
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get updated confirmation count rows")
+	}
+
+	return rowsAffected, nil
 }
 
-func (p *transactionStatusProcessor) updateCreditStatus(ctx context.Context, tx *models.Transaction, txStatus string) error {
+// updateCreditStatus 批量同步交易对应 credits 的状态
+func (p *transactionStatusProcessor) updateCreditStatus(ctx context.Context, tx *sql.Tx, transactionIDs []string, txStatus models.TransactionStatus) error {
+	if len(transactionIDs) == 0 {
+		return nil
+	}
+
 	creditStatus, ok := mapTransactionToCreditStatus(txStatus)
 	if !ok {
 		return nil
 	}
 
-	_, err := models.Credits(
-		models.CreditWhere.ReferenceID.EQ(tx.ID),
-		models.CreditWhere.ReferenceType.EQ(models.ReferenceTypeBlockchainTX),
-	).UpdateAll(ctx, p.db, models.M{
-		models.CreditColumns.Status:    creditStatus,
-		models.CreditColumns.UpdatedAt: time.Now(),
-	})
+	_, err := tx.ExecContext(ctx, `
+		UPDATE credits SET
+			status = $1,
+			updated_at = NOW()
+		WHERE reference_type = $2
+			AND reference_id = ANY($3)
+	`, creditStatus, models.ReferenceTypeBlockchainTX, pq.Array(transactionIDs))
 	if err != nil {
 		return errors.Wrap(err, "failed to update credit status")
 	}
+
 	return nil
 }
 
-func mapTransactionToCreditStatus(txStatus string) (string, bool) {
+func mapTransactionToCreditStatus(txStatus models.TransactionStatus) (models.CreditStatus, bool) {
 	switch txStatus {
 	case models.TransactionStatusConfirmed, models.TransactionStatusSafe:
 		return models.CreditStatusConfirmed, true
@@ -205,87 +232,3 @@ func mapTransactionToCreditStatus(txStatus string) (string, bool) {
 		return "", false
 	}
 }
-
-// updateTransactionStatusAndConfirmation 更新交易状态和确认数
-func (p *transactionStatusProcessor) updateTransactionStatusAndConfirmation(
-	ctx context.Context,
-	tx *models.Transaction,
-	newStatus string,
-	confirmationCount int64,
-	chainID int,
-	latestBlock int64,
-) error {
-	oldStatus := tx.Status
-	tx.Status = newStatus
-	tx.ConfirmationCount = null.IntFrom(int(confirmationCount))
-
-	_, err := tx.Update(ctx, p.db, boil.Whitelist(
-		models.TransactionColumns.Status,
-		models.TransactionColumns.ConfirmationCount,
-		models.TransactionColumns.UpdatedAt,
-	))
-	if err != nil {
-		return errors.Wrap(err, "failed to update transaction status and confirmation count")
-	}
-
-	// 同步更新 credits 状态
-	if err := p.updateCreditStatus(ctx, tx, newStatus); err != nil {
-		log.Error().
-			Str("tx_hash", tx.TXHash).
-			Err(err).
-			Msg("Failed to sync credit status with transaction status")
-		// 不返回错误，因为交易状态已经更新成功
-	}
-
-	log.Info().
-		Int("chain_id", chainID).
-		Str("tx_hash", tx.TXHash).
-		Str("old_status", oldStatus).
-		Str("new_status", newStatus).
-		Int64("confirmation_count", confirmationCount).
-		Int64("block_no", tx.BlockNo).
-		Int64("latest_block", latestBlock).
-		Msg("Transaction status updated")
-
-	return nil
-}
-
-// updateConfirmationCountOnly 只更新确认数（状态不变）
-func (p *transactionStatusProcessor) updateConfirmationCountOnly(
-	ctx context.Context,
-	tx *models.Transaction,
-	confirmationCount int64,
-	chainID int,
-	latestBlock int64,
-) error {
-	tx.ConfirmationCount = null.IntFrom(int(confirmationCount))
-
-	rowsAffected, err := tx.Update(ctx, p.db, boil.Whitelist(
-		models.TransactionColumns.ConfirmationCount,
-		models.TransactionColumns.UpdatedAt,
-	))
-	if err != nil {
-		return errors.Wrap(err, "failed to update transaction confirmation count")
-	}
-
-	if rowsAffected == 0 {
-		log.Warn().
-			Int("chain_id", chainID).
-			Str("tx_hash", tx.TXHash).
-			Str("tx_id", tx.ID).
-			Int64("confirmation_count", confirmationCount).
-			Msg("No rows affected when updating confirmation count")
-	}
-
-	log.Info().
-		Int("chain_id", chainID).
-		Str("tx_hash", tx.TXHash).
-		Str("status", tx.Status).
-		Int64("confirmation_count", confirmationCount).
-		Int64("block_no", tx.BlockNo).
-		Int64("latest_block", latestBlock).
-		Int64("rows_affected", rowsAffected).
-		Msg("Transaction confirmation count updated (status unchanged)")
-
-	return nil
-}
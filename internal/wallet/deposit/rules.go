@@ -0,0 +1,361 @@
+package deposit
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// 规则动作
+const (
+	RuleActionTag      = "tag"       // 为充值打标签（写入 credits.metadata）
+	RuleActionRouteFee = "route_fee" // 按比例将充值金额划转到手续费账户
+	RuleActionReject   = "reject"    // 拒绝入账：Credits 记录为 frozen，不计入余额，需人工处理
+)
+
+// maxFeePercent 手续费比例上限（百分比）
+const maxFeePercent = 100
+
+// ErrInvalidRule 规则参数不合法
+var ErrInvalidRule = errors.New("invalid deposit rule")
+
+// depositRuleColumns deposit_rules 查询列，与 scanRule 的顺序一致
+const depositRuleColumns = `id, name, description, priority, is_active, stop_processing,
+	chain_id, token_id, user_id, from_addresses, min_amount, max_amount, sender_is_contract,
+	action, tag, fee_account_user_id, fee_percent, created_by, created_at, updated_at`
+
+// Rule 充值入账规则，所有条件均为可选，同时满足时命中
+type Rule struct {
+	ID             string
+	Name           string
+	Description    *string
+	Priority       int // 数值越小越先评估
+	IsActive       bool
+	StopProcessing bool // 命中后不再评估后续规则
+
+	// 匹配条件
+	ChainID          *int
+	TokenID          *int
+	UserID           *string
+	FromAddresses    []string // 小写地址
+	MinAmount        *string  // 含，人类可读单位
+	MaxAmount        *string  // 含，人类可读单位
+	SenderIsContract *bool
+
+	// 命中后的动作
+	Action           string
+	Tag              *string
+	FeeAccountUserID *string
+	FeePercent       *string
+
+	CreatedBy *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// rowScanner 兼容 *sql.Row 和 *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// ListRules 查询所有充值规则（按评估顺序）
+func (s *service) ListRules(ctx context.Context) ([]*Rule, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+depositRuleColumns+`
+		FROM deposit_rules
+		ORDER BY priority, created_at, id
+	`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query deposit rules")
+	}
+	defer rows.Close()
+
+	rules := make([]*Rule, 0)
+	for rows.Next() {
+		rule, err := scanRule(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan deposit rule")
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate deposit rules")
+	}
+
+	return rules, nil
+}
+
+// GetRule 获取充值规则
+func (s *service) GetRule(ctx context.Context, ruleID string) (*Rule, error) {
+	rule, err := scanRule(s.db.QueryRowContext(ctx, `
+		SELECT `+depositRuleColumns+`
+		FROM deposit_rules
+		WHERE id = $1
+	`, ruleID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("deposit rule not found")
+		}
+		return nil, errors.Wrap(err, "failed to get deposit rule")
+	}
+
+	return rule, nil
+}
+
+// CreateRule 创建充值规则
+func (s *service) CreateRule(ctx context.Context, rule *Rule) (*Rule, error) {
+	if err := normalizeRule(rule); err != nil {
+		return nil, err
+	}
+
+	created, err := scanRule(s.db.QueryRowContext(ctx, `
+		INSERT INTO deposit_rules (
+			name, description, priority, is_active, stop_processing,
+			chain_id, token_id, user_id, from_addresses, min_amount, max_amount, sender_is_contract,
+			action, tag, fee_account_user_id, fee_percent, created_by
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+		RETURNING `+depositRuleColumns,
+		rule.Name, rule.Description, rule.Priority, rule.IsActive, rule.StopProcessing,
+		rule.ChainID, rule.TokenID, rule.UserID, nullableStringArray(rule.FromAddresses), rule.MinAmount, rule.MaxAmount, rule.SenderIsContract,
+		rule.Action, rule.Tag, rule.FeeAccountUserID, rule.FeePercent, rule.CreatedBy,
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create deposit rule")
+	}
+
+	return created, nil
+}
+
+// UpdateRule 更新充值规则（整体替换条件和动作）
+func (s *service) UpdateRule(ctx context.Context, rule *Rule) (*Rule, error) {
+	if err := normalizeRule(rule); err != nil {
+		return nil, err
+	}
+
+	updated, err := scanRule(s.db.QueryRowContext(ctx, `
+		UPDATE deposit_rules
+		SET name = $2, description = $3, priority = $4, is_active = $5, stop_processing = $6,
+			chain_id = $7, token_id = $8, user_id = $9, from_addresses = $10, min_amount = $11, max_amount = $12,
+			sender_is_contract = $13, action = $14, tag = $15, fee_account_user_id = $16, fee_percent = $17,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING `+depositRuleColumns,
+		rule.ID, rule.Name, rule.Description, rule.Priority, rule.IsActive, rule.StopProcessing,
+		rule.ChainID, rule.TokenID, rule.UserID, nullableStringArray(rule.FromAddresses), rule.MinAmount, rule.MaxAmount,
+		rule.SenderIsContract, rule.Action, rule.Tag, rule.FeeAccountUserID, rule.FeePercent,
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("deposit rule not found")
+		}
+		return nil, errors.Wrap(err, "failed to update deposit rule")
+	}
+
+	return updated, nil
+}
+
+// DeleteRule 删除充值规则
+func (s *service) DeleteRule(ctx context.Context, ruleID string) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM deposit_rules WHERE id = $1`, ruleID)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete deposit rule")
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get affected rows")
+	}
+	if affected == 0 {
+		return errors.New("deposit rule not found")
+	}
+
+	return nil
+}
+
+// getActiveRules 查询启用的规则（按评估顺序）
+func (s *service) getActiveRules(ctx context.Context) ([]*Rule, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+depositRuleColumns+`
+		FROM deposit_rules
+		WHERE is_active
+		ORDER BY priority, created_at, id
+	`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query active deposit rules")
+	}
+	defer rows.Close()
+
+	var rules []*Rule
+	for rows.Next() {
+		rule, err := scanRule(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan deposit rule")
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate deposit rules")
+	}
+
+	return rules, nil
+}
+
+// normalizeRule 校验规则并规范化（地址转小写、清除与动作无关的字段）
+func normalizeRule(rule *Rule) error {
+	rule.Name = strings.TrimSpace(rule.Name)
+	if rule.Name == "" {
+		return errors.Wrap(ErrInvalidRule, "name is required")
+	}
+
+	for i, addr := range rule.FromAddresses {
+		if !common.IsHexAddress(addr) {
+			return errors.Wrapf(ErrInvalidRule, "from_addresses[%d] is not a valid address", i)
+		}
+		rule.FromAddresses[i] = strings.ToLower(common.HexToAddress(addr).Hex())
+	}
+
+	minAmount, err := parseOptionalRuleAmount(rule.MinAmount, "min_amount")
+	if err != nil {
+		return err
+	}
+	maxAmount, err := parseOptionalRuleAmount(rule.MaxAmount, "max_amount")
+	if err != nil {
+		return err
+	}
+	if minAmount != nil && maxAmount != nil && minAmount.Cmp(maxAmount) > 0 {
+		return errors.Wrap(ErrInvalidRule, "min_amount must not be greater than max_amount")
+	}
+
+	switch rule.Action {
+	case RuleActionTag:
+		if rule.Tag == nil || strings.TrimSpace(*rule.Tag) == "" {
+			return errors.Wrap(ErrInvalidRule, "tag is required for action tag")
+		}
+		rule.FeeAccountUserID, rule.FeePercent = nil, nil
+	case RuleActionRouteFee:
+		if rule.FeeAccountUserID == nil || *rule.FeeAccountUserID == "" {
+			return errors.Wrap(ErrInvalidRule, "fee_account_user_id is required for action route_fee")
+		}
+		feePercent, err := parseOptionalRuleAmount(rule.FeePercent, "fee_percent")
+		if err != nil {
+			return err
+		}
+		if feePercent == nil || feePercent.Sign() <= 0 || feePercent.Cmp(big.NewRat(maxFeePercent, 1)) > 0 {
+			return errors.Wrap(ErrInvalidRule, "fee_percent must be greater than 0 and at most 100")
+		}
+		rule.Tag = nil
+	case RuleActionReject:
+		rule.Tag, rule.FeeAccountUserID, rule.FeePercent = nil, nil, nil
+	default:
+		return errors.Wrapf(ErrInvalidRule, "unknown action %q", rule.Action)
+	}
+
+	return nil
+}
+
+// parseOptionalRuleAmount 解析规则中的非负十进制金额，为空时返回 nil
+func parseOptionalRuleAmount(value *string, field string) (*big.Rat, error) {
+	if value == nil {
+		return nil, nil //nolint:nilnil // 未设置条件
+	}
+
+	amount, ok := new(big.Rat).SetString(*value)
+	if !ok || amount.Sign() < 0 {
+		return nil, errors.Wrapf(ErrInvalidRule, "%s must be a non-negative number", field)
+	}
+
+	return amount, nil
+}
+
+// nullableStringArray 空数组存储为 NULL（表示不限）
+func nullableStringArray(values []string) any {
+	if len(values) == 0 {
+		return nil
+	}
+
+	return pq.Array(values)
+}
+
+// scanRule 扫描一行充值规则
+func scanRule(row rowScanner) (*Rule, error) {
+	var (
+		rule             Rule
+		description      sql.NullString
+		chainID          sql.NullInt64
+		tokenID          sql.NullInt64
+		userID           sql.NullString
+		fromAddresses    pq.StringArray
+		minAmount        sql.NullString
+		maxAmount        sql.NullString
+		senderIsContract sql.NullBool
+		tag              sql.NullString
+		feeAccountUserID sql.NullString
+		feePercent       sql.NullString
+		createdBy        sql.NullString
+	)
+
+	if err := row.Scan(
+		&rule.ID,
+		&rule.Name,
+		&description,
+		&rule.Priority,
+		&rule.IsActive,
+		&rule.StopProcessing,
+		&chainID,
+		&tokenID,
+		&userID,
+		&fromAddresses,
+		&minAmount,
+		&maxAmount,
+		&senderIsContract,
+		&rule.Action,
+		&tag,
+		&feeAccountUserID,
+		&feePercent,
+		&createdBy,
+		&rule.CreatedAt,
+		&rule.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	rule.Description = nullStringPtr(description)
+	if chainID.Valid {
+		v := int(chainID.Int64)
+		rule.ChainID = &v
+	}
+	if tokenID.Valid {
+		v := int(tokenID.Int64)
+		rule.TokenID = &v
+	}
+	rule.UserID = nullStringPtr(userID)
+	rule.FromAddresses = fromAddresses
+	rule.MinAmount = nullStringPtr(minAmount)
+	rule.MaxAmount = nullStringPtr(maxAmount)
+	if senderIsContract.Valid {
+		rule.SenderIsContract = &senderIsContract.Bool
+	}
+	rule.Tag = nullStringPtr(tag)
+	rule.FeeAccountUserID = nullStringPtr(feeAccountUserID)
+	rule.FeePercent = nullStringPtr(feePercent)
+	rule.CreatedBy = nullStringPtr(createdBy)
+
+	return &rule, nil
+}
+
+func nullStringPtr(v sql.NullString) *string {
+	if !v.Valid {
+		return nil
+	}
+
+	return &v.String
+}
@@ -0,0 +1,314 @@
+package deposit
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/pkg/errors"
+)
+
+// ContractChecker 判断地址是否为合约
+// 由扫描服务实现；扫描服务依赖充值服务，因此在扫描服务创建后通过 SetContractChecker 注入
+type ContractChecker interface {
+	IsContract(ctx context.Context, chainID int, address string) (bool, error)
+}
+
+// RuleInput 规则评估输入
+type RuleInput struct {
+	ChainID          int
+	TokenID          int
+	UserID           string // 充值用户，为空时限定用户的规则不会命中
+	FromAddress      string
+	Amount           string // 人类可读单位（试运行）；CreateCredit 内部直接使用交易的最小单位金额
+	SenderIsContract *bool  // 为空且有规则需要时通过 ContractChecker 查询
+}
+
+// RuleMatch 命中的规则
+type RuleMatch struct {
+	RuleID string `json:"rule_id"`
+	Name   string `json:"name"`
+	Action string `json:"action"`
+}
+
+// FeeRoute 手续费划转
+type FeeRoute struct {
+	RuleID        string
+	AccountUserID string
+	Amount        string // 最小单位，向下取整
+}
+
+// RuleOutcome 规则评估结果
+type RuleOutcome struct {
+	Matches   []RuleMatch
+	Tags      []string
+	FeeRoutes []FeeRoute
+	Rejected  bool
+}
+
+// ruleMetadata 写入 credits.metadata 的规则评估结果
+type ruleMetadata struct {
+	DepositRules struct {
+		Matched []RuleMatch `json:"matched"`
+		Tags    []string    `json:"tags,omitempty"`
+	} `json:"deposit_rules"`
+}
+
+// SetContractChecker 注入合约地址检查器
+func (s *service) SetContractChecker(checker ContractChecker) {
+	s.contractChecker = checker
+}
+
+// EvaluateRules 试运行：按当前启用的规则评估一笔充值，不写入任何数据
+func (s *service) EvaluateRules(ctx context.Context, input *RuleInput) (*RuleOutcome, error) {
+	token, err := models.Tokens(
+		models.TokenWhere.ID.EQ(input.TokenID),
+		models.TokenWhere.ChainID.EQ(input.ChainID),
+	).One(ctx, s.db)
+	if err != nil {
+		return nil, walleterrors.ErrTokenNotFound
+	}
+
+	rawAmount, err := money.ToSmallestUnit(input.Amount, token.Decimals)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.evaluateRules(ctx, input, rawAmount, token.Decimals)
+}
+
+// evaluateRules 按优先级评估启用的规则
+// 金额条件按人类可读单位比较；reject 命中后立即停止；
+// route_fee 的手续费按原始金额计算，累计不超过充值金额
+func (s *service) evaluateRules(ctx context.Context, input *RuleInput, rawAmount *big.Int, decimals int) (*RuleOutcome, error) {
+	rules, err := s.getActiveRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	outcome := &RuleOutcome{}
+	if len(rules) == 0 {
+		return outcome, nil
+	}
+
+	amount := money.FromSmallestUnit(rawAmount, decimals)
+	remaining := new(big.Int).Set(rawAmount)
+	senderIsContract := s.senderIsContractFunc(ctx, input)
+
+	for _, rule := range rules {
+		matched, err := ruleMatches(rule, input, amount, senderIsContract)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to evaluate deposit rule %s", rule.ID)
+		}
+		if !matched {
+			continue
+		}
+
+		outcome.Matches = append(outcome.Matches, RuleMatch{RuleID: rule.ID, Name: rule.Name, Action: rule.Action})
+
+		switch rule.Action {
+		case RuleActionTag:
+			outcome.Tags = append(outcome.Tags, *rule.Tag)
+		case RuleActionRouteFee:
+			// 手续费账户即充值用户时无需划转
+			if *rule.FeeAccountUserID == input.UserID {
+				break
+			}
+			fee := feeAmount(rawAmount, *rule.FeePercent)
+			if fee.Cmp(remaining) > 0 {
+				fee.Set(remaining)
+			}
+			if fee.Sign() <= 0 {
+				break
+			}
+			remaining.Sub(remaining, fee)
+			outcome.FeeRoutes = append(outcome.FeeRoutes, FeeRoute{
+				RuleID:        rule.ID,
+				AccountUserID: *rule.FeeAccountUserID,
+				Amount:        fee.String(),
+			})
+		case RuleActionReject:
+			outcome.Rejected = true
+			outcome.FeeRoutes = nil
+			return outcome, nil
+		}
+
+		if rule.StopProcessing {
+			break
+		}
+	}
+
+	return outcome, nil
+}
+
+// senderIsContractFunc 返回按需查询且只查询一次的发送方合约检查
+func (s *service) senderIsContractFunc(ctx context.Context, input *RuleInput) func() (bool, error) {
+	var (
+		checked bool
+		result  bool
+		err     error
+	)
+
+	return func() (bool, error) {
+		if input.SenderIsContract != nil {
+			return *input.SenderIsContract, nil
+		}
+		if checked {
+			return result, err
+		}
+
+		checked = true
+		if s.contractChecker == nil {
+			err = errors.New("contract checker is not configured")
+			return false, err
+		}
+		result, err = s.contractChecker.IsContract(ctx, input.ChainID, input.FromAddress)
+		return result, err
+	}
+}
+
+// ruleMatches 判断规则条件是否全部满足
+func ruleMatches(rule *Rule, input *RuleInput, amount *big.Rat, senderIsContract func() (bool, error)) (bool, error) {
+	if rule.ChainID != nil && *rule.ChainID != input.ChainID {
+		return false, nil
+	}
+	if rule.TokenID != nil && *rule.TokenID != input.TokenID {
+		return false, nil
+	}
+	if rule.UserID != nil && *rule.UserID != input.UserID {
+		return false, nil
+	}
+
+	if len(rule.FromAddresses) > 0 {
+		from := strings.ToLower(input.FromAddress)
+		found := false
+		for _, addr := range rule.FromAddresses {
+			if addr == from {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+
+	if rule.MinAmount != nil {
+		minAmount, ok := new(big.Rat).SetString(*rule.MinAmount)
+		if !ok {
+			return false, errors.Errorf("invalid min_amount %q", *rule.MinAmount)
+		}
+		if amount.Cmp(minAmount) < 0 {
+			return false, nil
+		}
+	}
+	if rule.MaxAmount != nil {
+		maxAmount, ok := new(big.Rat).SetString(*rule.MaxAmount)
+		if !ok {
+			return false, errors.Errorf("invalid max_amount %q", *rule.MaxAmount)
+		}
+		if amount.Cmp(maxAmount) > 0 {
+			return false, nil
+		}
+	}
+
+	// 最后检查，只有其他条件均满足时才需要查询链上数据
+	if rule.SenderIsContract != nil {
+		isContract, err := senderIsContract()
+		if err != nil {
+			return false, errors.Wrap(err, "failed to check whether sender is a contract")
+		}
+		if isContract != *rule.SenderIsContract {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// applyRuleOutcome 将规则评估结果写入充值 Credits 记录（metadata、拒绝时冻结）
+func applyRuleOutcome(credit *models.Credit, outcome *RuleOutcome) error {
+	if outcome.Rejected {
+		credit.Status = models.CreditStatusFrozen
+	}
+
+	if len(outcome.Matches) == 0 {
+		return nil
+	}
+
+	var metadata ruleMetadata
+	metadata.DepositRules.Matched = outcome.Matches
+	metadata.DepositRules.Tags = outcome.Tags
+
+	b, err := json.Marshal(metadata)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal deposit rule metadata")
+	}
+	credit.Metadata = null.JSONFrom(b)
+
+	return nil
+}
+
+// insertFeeCredits 为每笔手续费划转写入一对 Credits：充值用户扣减、手续费账户入账
+// event_index 使用划转序号，保证同一笔充值的多笔划转满足唯一约束
+func insertFeeCredits(ctx context.Context, exec boil.ContextExecutor, deposit *models.Credit, routes []FeeRoute) error {
+	for i, route := range routes {
+		metadata, err := json.Marshal(map[string]string{"rule_id": route.RuleID})
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal fee credit metadata")
+		}
+
+		entries := []struct {
+			userID string
+			amount string
+		}{
+			{deposit.UserID, "-" + route.Amount},
+			{route.AccountUserID, route.Amount},
+		}
+		for _, entry := range entries {
+			credit := &models.Credit{
+				UserID:        entry.userID,
+				Address:       deposit.Address,
+				TokenID:       deposit.TokenID,
+				TokenSymbol:   deposit.TokenSymbol,
+				Amount:        entry.amount,
+				CreditType:    models.CreditTypeDepositFee,
+				BusinessType:  models.BusinessTypeInternalTransfer,
+				ReferenceID:   deposit.ReferenceID,
+				ReferenceType: models.ReferenceTypeDepositFee,
+				ChainID:       deposit.ChainID,
+				ChainType:     deposit.ChainType,
+				Status:        models.CreditStatusFinalized,
+				BlockNumber:   deposit.BlockNumber,
+				TXHash:        deposit.TXHash,
+				EventIndex:    null.IntFrom(i),
+				Metadata:      null.JSONFrom(metadata),
+			}
+			if err := credit.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert deposit fee credit")
+			}
+		}
+	}
+
+	return nil
+}
+
+// feeAmount 计算手续费 amount * percent / 100（最小单位，向下取整）
+func feeAmount(rawAmount *big.Int, percent string) *big.Int {
+	pct, ok := new(big.Rat).SetString(percent)
+	if !ok {
+		return new(big.Int)
+	}
+
+	fee := new(big.Rat).Mul(new(big.Rat).SetInt(rawAmount), pct)
+	fee.Quo(fee, big.NewRat(maxFeePercent, 1))
+
+	return new(big.Int).Quo(fee.Num(), fee.Denom())
+}
@@ -0,0 +1,99 @@
+package deposit
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleMatches(t *testing.T) {
+	const (
+		user   = "7e2b9f2c-0b5c-4a4e-9f6c-3a1d2e4b5c6d"
+		sender = "0x8589427373d6d84e98730d7795d8f6f8731fda16"
+	)
+
+	input := &RuleInput{ChainID: 97, TokenID: 1, UserID: user, FromAddress: "0x8589427373D6D84E98730D7795D8F6F8731FDA16"}
+	amount := big.NewRat(5, 1)
+
+	tests := []struct {
+		name string
+		rule Rule
+		want bool
+	}{
+		{"NoConditions", Rule{}, true},
+		{"ChainMatches", Rule{ChainID: intPtr(97)}, true},
+		{"OtherChain", Rule{ChainID: intPtr(1)}, false},
+		{"OtherToken", Rule{TokenID: intPtr(2)}, false},
+		{"UserMatches", Rule{UserID: stringPtr(user)}, true},
+		{"OtherUser", Rule{UserID: stringPtr("00000000-0000-0000-0000-000000000000")}, false},
+		{"FromAddressCaseInsensitive", Rule{FromAddresses: []string{"0x0000000000000000000000000000000000000001", sender}}, true},
+		{"OtherFromAddress", Rule{FromAddresses: []string{"0x0000000000000000000000000000000000000001"}}, false},
+		{"MinAmountInclusive", Rule{MinAmount: stringPtr("5")}, true},
+		{"BelowMinAmount", Rule{MinAmount: stringPtr("5.000001")}, false},
+		{"MaxAmountInclusive", Rule{MaxAmount: stringPtr("5")}, true},
+		{"AboveMaxAmount", Rule{MaxAmount: stringPtr("4.99")}, false},
+		{"SenderIsContract", Rule{SenderIsContract: boolPtr(true)}, true},
+		{"SenderIsNotContract", Rule{SenderIsContract: boolPtr(false)}, false},
+		{"AllConditions", Rule{ChainID: intPtr(97), TokenID: intPtr(1), UserID: stringPtr(user), FromAddresses: []string{sender}, MinAmount: stringPtr("1"), MaxAmount: stringPtr("10"), SenderIsContract: boolPtr(true)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ruleMatches(&tt.rule, input, amount, func() (bool, error) { return true, nil })
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRuleMatchesChecksContractLast(t *testing.T) {
+	input := &RuleInput{ChainID: 97, TokenID: 1}
+	checked := 0
+	senderIsContract := func() (bool, error) {
+		checked++
+		return false, errors.New("rpc unavailable")
+	}
+
+	// 其他条件不满足时不查询链上数据
+	got, err := ruleMatches(&Rule{ChainID: intPtr(1), SenderIsContract: boolPtr(true)}, input, big.NewRat(1, 1), senderIsContract)
+	require.NoError(t, err)
+	assert.False(t, got)
+	assert.Equal(t, 0, checked)
+
+	_, err = ruleMatches(&Rule{ChainID: intPtr(97), SenderIsContract: boolPtr(true)}, input, big.NewRat(1, 1), senderIsContract)
+	require.Error(t, err)
+	assert.Equal(t, 1, checked)
+}
+
+func TestFeeAmount(t *testing.T) {
+	tests := []struct {
+		raw     int64
+		percent string
+		want    string
+	}{
+		{1000, "1", "10"},
+		{1000, "0.5", "5"},
+		{999, "1", "9"}, // 向下取整
+		{1000, "100", "1000"},
+		{1000, "invalid", "0"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, feeAmount(big.NewInt(tt.raw), tt.percent).String(), "%d * %s%%", tt.raw, tt.percent)
+	}
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func stringPtr(v string) *string {
+	return &v
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
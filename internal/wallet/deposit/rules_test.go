@@ -0,0 +1,93 @@
+package deposit_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/test"
+	"github/chapool/go-wallet/internal/test/fixtures"
+	"github/chapool/go-wallet/internal/wallet/deposit"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateRules(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		token, err := models.Tokens(qm.OrderBy(models.TokenColumns.ID)).One(ctx, db)
+		require.NoError(t, err)
+
+		const blockedSender = "0x0d1d4e623d10f9fba5db95830f7d3839406c6af2"
+		tag := func(v string) *string { return &v }
+		feePercent := "60"
+		minAmount := "100"
+
+		service := deposit.NewService(db, nil, nil)
+		rules := []*deposit.Rule{
+			{Name: "inactive", Priority: 0, IsActive: false, Action: deposit.RuleActionReject},
+			{Name: "blocked sender", Priority: 0, IsActive: true, FromAddresses: []string{blockedSender}, Action: deposit.RuleActionReject},
+			{Name: "large", Priority: 1, IsActive: true, MinAmount: &minAmount, Action: deposit.RuleActionTag, Tag: tag("large")},
+			{Name: "fee 1", Priority: 2, IsActive: true, TokenID: &token.ID, Action: deposit.RuleActionRouteFee, FeeAccountUserID: &fix.User2.ID, FeePercent: &feePercent},
+			{Name: "fee 2", Priority: 3, IsActive: true, StopProcessing: true, Action: deposit.RuleActionRouteFee, FeeAccountUserID: &fix.User2.ID, FeePercent: &feePercent},
+			{Name: "after stop", Priority: 4, IsActive: true, Action: deposit.RuleActionTag, Tag: tag("unreachable")},
+		}
+		ids := make(map[string]string, len(rules))
+		for _, rule := range rules {
+			created, err := service.CreateRule(ctx, rule)
+			require.NoError(t, err)
+			ids[created.Name] = created.ID
+		}
+
+		input := &deposit.RuleInput{
+			ChainID:     token.ChainID,
+			TokenID:     token.ID,
+			UserID:      fix.User1.ID,
+			FromAddress: "0x8589427373d6d84e98730d7795d8f6f8731fda16",
+			Amount:      "10",
+		}
+
+		// 按优先级命中，stop_processing 后不再评估；手续费累计不超过充值金额（最小单位）
+		outcome, err := service.EvaluateRules(ctx, input)
+		require.NoError(t, err)
+		assert.False(t, outcome.Rejected)
+		assert.Empty(t, outcome.Tags)
+		assert.Equal(t, []deposit.RuleMatch{
+			{RuleID: ids["fee 1"], Name: "fee 1", Action: deposit.RuleActionRouteFee},
+			{RuleID: ids["fee 2"], Name: "fee 2", Action: deposit.RuleActionRouteFee},
+		}, outcome.Matches)
+		assert.Equal(t, []deposit.FeeRoute{
+			{RuleID: ids["fee 1"], AccountUserID: fix.User2.ID, Amount: "6000000000000000000"},
+			{RuleID: ids["fee 2"], AccountUserID: fix.User2.ID, Amount: "4000000000000000000"},
+		}, outcome.FeeRoutes)
+
+		// 金额条件按代币单位比较
+		input.Amount = "100"
+		outcome, err = service.EvaluateRules(ctx, input)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"large"}, outcome.Tags)
+		require.Len(t, outcome.Matches, 3)
+		assert.Equal(t, ids["large"], outcome.Matches[0].RuleID)
+
+		// 手续费账户即充值用户时不划转
+		input.UserID = fix.User2.ID
+		outcome, err = service.EvaluateRules(ctx, input)
+		require.NoError(t, err)
+		assert.Len(t, outcome.Matches, 3)
+		assert.Empty(t, outcome.FeeRoutes)
+
+		// reject 命中后立即停止，不划转手续费
+		input.FromAddress = "0x0D1D4E623D10F9FBA5DB95830F7D3839406C6AF2"
+		outcome, err = service.EvaluateRules(ctx, input)
+		require.NoError(t, err)
+		assert.True(t, outcome.Rejected)
+		assert.Equal(t, []deposit.RuleMatch{
+			{RuleID: ids["blocked sender"], Name: "blocked sender", Action: deposit.RuleActionReject},
+		}, outcome.Matches)
+		assert.Empty(t, outcome.FeeRoutes)
+	})
+}
@@ -0,0 +1,54 @@
+package deposit
+
+import (
+	"context"
+
+	"github/chapool/go-wallet/internal/models"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/pkg/errors"
+)
+
+// ScreeningResult 来源地址和代币合规筛查结果
+type ScreeningResult struct {
+	Flagged bool
+	Source  string // 命中的名单来源
+	Reason  string
+}
+
+// ScreeningHook 充值来源地址和代币合规筛查
+// 由隔离服务实现；隔离服务依赖统计服务，因此在创建后通过 SetScreeningHook 注入
+// 被标记的充值冻结不入账，隔离案件与 Credits 记录在同一事务中写入
+type ScreeningHook interface {
+	// Screen 筛查充值来源地址和代币
+	Screen(ctx context.Context, chainType string, address string, tokenID int) (*ScreeningResult, error)
+
+	// Quarantine 为被冻结的充值创建隔离案件，返回案件 ID
+	Quarantine(ctx context.Context, exec boil.ContextExecutor, credit *models.Credit, transaction *models.Transaction, result *ScreeningResult) (string, error)
+
+	// Quarantined 隔离案件提交后调用（通知合规团队）
+	Quarantined(ctx context.Context, caseID string)
+}
+
+// SetScreeningHook 注入合规筛查，未注入时不筛查
+func (s *service) SetScreeningHook(hook ScreeningHook) {
+	s.screeningHook = hook
+}
+
+// screen 筛查充值来源地址和代币，未注入筛查或未命中时返回 nil
+// 筛查失败（如外部筛查服务不可用）时不入账，由 ProcessFinalizedDeposits 稍后重试
+func (s *service) screen(ctx context.Context, chainType string, fromAddress string, tokenID int) (*ScreeningResult, error) {
+	if s.screeningHook == nil {
+		return nil, nil //nolint:nilnil // 未注入筛查
+	}
+
+	result, err := s.screeningHook.Screen(ctx, chainType, fromAddress, tokenID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to screen deposit")
+	}
+	if result == nil || !result.Flagged {
+		return nil, nil //nolint:nilnil // 未命中
+	}
+
+	return result, nil
+}
@@ -4,9 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"math/big"
-	"strings"
 
 	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/stats"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
@@ -16,29 +17,38 @@ import (
 )
 
 const (
-	nativeTokenDecimals = 18 // 原生代币通常是 18 位小数
+	nativeTokenDecimals   = 18 // 原生代币通常是 18 位小数
+	solanaNativeDecimals  = 9  // SOL 为 9 位小数（lamports）
+	bitcoinNativeDecimals = 8  // BTC 为 8 位小数（聪）
 )
 
 // service 实现 Service 接口
 type service struct {
-	db        *sql.DB
-	processor *transactionStatusProcessor
+	db           *sql.DB
+	chainService chain.Service
+	processor    *transactionStatusProcessor
+	statsService stats.Service
+
+	contractChecker ContractChecker
+	screeningHook   ScreeningHook
 }
 
 // NewService 创建充值服务
 //
 //nolint:ireturn
-func NewService(db *sql.DB) Service {
+func NewService(db *sql.DB, chainService chain.Service, statsService stats.Service) Service {
 	return &service{
-		db:        db,
-		processor: newTransactionStatusProcessor(db),
+		db:           db,
+		chainService: chainService,
+		processor:    newTransactionStatusProcessor(db, chainService),
+		statsService: statsService,
 	}
 }
 
 // ProcessDeposit 处理充值交易（创建 Credits 记录）
 func (s *service) ProcessDeposit(ctx context.Context, transaction *models.Transaction) error {
 	// 只处理已终结的充值交易
-	if transaction.Status != "finalized" {
+	if transaction.Status != models.TransactionStatusFinalized {
 		return nil
 	}
 
@@ -97,7 +107,7 @@ func (s *service) ProcessFinalizedDeposits(ctx context.Context, chainID int) err
 			Str("tx_hash", tx.TXHash).
 			Str("tx_id", tx.ID).
 			Int64("block_no", tx.BlockNo).
-			Str("status", tx.Status).
+			Str("status", tx.Status.String()).
 			Msg("Processing finalized deposit")
 
 		if err := s.ProcessDeposit(ctx, tx); err != nil {
@@ -129,10 +139,15 @@ func (s *service) CreateCredit(ctx context.Context, transaction *models.Transact
 		Int("chain_id", transaction.ChainID).
 		Msg("Creating credit for transaction")
 
+	chainConfig, err := s.chainService.GetChain(ctx, transaction.ChainID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get chain config for chain_id=%d", transaction.ChainID)
+	}
+
 	// 获取钱包信息（通过地址查找）
 	wallet, err := models.Wallets(
 		models.WalletWhere.ChainID.EQ(transaction.ChainID),
-		models.WalletWhere.Address.EQ(strings.ToLower(transaction.ToAddr)),
+		qm.Where(chain.WalletAddressKeySQL+" = ?", chain.NormalizeAddress(chainConfig.ChainType, transaction.ToAddr)),
 	).One(ctx, s.db)
 
 	if err != nil {
@@ -149,7 +164,7 @@ func (s *service) CreateCredit(ctx context.Context, transaction *models.Transact
 		Msg("Found wallet for transaction")
 
 	// 获取代币信息
-	token, err := s.getTokenInfo(ctx, transaction.ChainID, transaction.TokenAddr.String)
+	token, err := s.getTokenInfo(ctx, chainConfig, transaction.TokenAddr.String)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get token info")
 	}
@@ -159,6 +174,13 @@ func (s *service) CreateCredit(ctx context.Context, transaction *models.Transact
 		Str("token_symbol", token.TokenSymbol).
 		Msg("Found token for transaction")
 
+	// 包装原生代币可配置为按原生代币入账（如 WBNB 充值入账为 BNB）
+	receivedToken := token
+	token, err = s.resolveCreditToken(ctx, chainConfig, receivedToken)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve credit token")
+	}
+
 	// 创建 Credits 记录
 	credit := &models.Credit{
 		UserID:        wallet.UserID,
@@ -166,34 +188,113 @@ func (s *service) CreateCredit(ctx context.Context, transaction *models.Transact
 		TokenID:       token.ID,
 		TokenSymbol:   token.TokenSymbol,
 		Amount:        transaction.Amount,
-		CreditType:    "deposit",
-		BusinessType:  "blockchain",
+		CreditType:    models.CreditTypeDeposit,
+		BusinessType:  models.BusinessTypeBlockchain,
 		ReferenceID:   transaction.ID,
-		ReferenceType: "blockchain_tx",
+		ReferenceType: models.ReferenceTypeBlockchainTX,
 		ChainID:       null.IntFrom(transaction.ChainID),
-		ChainType:     null.StringFrom("evm"),
-		Status:        "finalized", // 充值交易已终结，直接标记为 finalized
+		ChainType:     null.StringFrom(chainConfig.ChainType),
+		Status:        models.CreditStatusFinalized, // 充值交易已终结，直接标记为 finalized
 		BlockNumber:   null.Int64From(transaction.BlockNo),
 		TXHash:        null.StringFrom(transaction.TXHash),
-		EventIndex:    null.Int{}, // ETH 转账没有事件索引
+		EventIndex:    transaction.EventIndex, // ERC20 Transfer 事件的 logIndex，ETH 转账没有事件索引
 	}
 
-	// 如果是 ERC20 转账，设置事件索引（从交易中获取，这里暂时设为 0）
-	if transaction.TokenAddr.Valid && transaction.TokenAddr.String != "" {
-		// TODO: 从交易日志中获取事件索引
+	// 记录事件索引之前扫描的 ERC20 交易没有事件索引，沿用 0
+	if transaction.TokenAddr.Valid && transaction.TokenAddr.String != "" && !transaction.EventIndex.Valid {
 		credit.EventIndex = null.IntFrom(0)
 	}
 
-	if err := credit.Insert(ctx, s.db, boil.Infer()); err != nil {
+	// 评估充值规则（标签、手续费划转、拒绝入账）
+	rawAmount, ok := new(big.Int).SetString(transaction.Amount, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid transaction amount %q", transaction.Amount)
+	}
+	outcome, err := s.evaluateRules(ctx, &RuleInput{
+		ChainID:     transaction.ChainID,
+		TokenID:     token.ID,
+		UserID:      wallet.UserID,
+		FromAddress: transaction.FromAddr,
+	}, rawAmount, token.Decimals)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to evaluate deposit rules")
+	}
+	if err := applyRuleOutcome(credit, outcome); err != nil {
+		return nil, err
+	}
+
+	if token.ID != receivedToken.ID {
+		if err := tagWrappedNative(credit, receivedToken); err != nil {
+			return nil, err
+		}
+	}
+
+	// 观察地址（外部地址）的充值标记为 watch-only，资金不在平台控制的地址上
+	if wallet.WalletType == models.WalletTypeWatch {
+		if err := tagWatchOnly(credit); err != nil {
+			return nil, err
+		}
+	}
+
+	// 合规筛查：来源地址或收到的代币被标记的充值冻结并进入隔离，等待合规人员处理
+	screening, err := s.screen(ctx, chainConfig.ChainType, transaction.FromAddr, receivedToken.ID)
+	if err != nil {
+		return nil, err
+	}
+	quarantined := screening != nil
+	if quarantined {
+		credit.Status = models.CreditStatusFrozen
+	}
+
+	// Credits 记录与用户统计在同一事务中写入，保证统计与入账一致
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := credit.Insert(ctx, tx, boil.Infer()); err != nil {
 		return nil, errors.Wrap(err, "failed to insert credit")
 	}
 
+	var caseID string
+	if quarantined {
+		caseID, err = s.screeningHook.Quarantine(ctx, tx, credit, transaction, screening)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to quarantine deposit")
+		}
+	}
+
+	// 被拒绝或隔离的充值冻结待人工处理，不计入统计
+	if !outcome.Rejected && !quarantined {
+		if err := insertFeeCredits(ctx, tx, credit, outcome.FeeRoutes); err != nil {
+			return nil, err
+		}
+
+		if err := s.statsService.RecordDeposit(ctx, tx, wallet.UserID, token.ID, transaction.Amount, token.Decimals, credit.CreatedAt); err != nil {
+			return nil, errors.Wrap(err, "failed to record deposit stats")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "failed to commit transaction")
+	}
+
+	if quarantined {
+		s.screeningHook.Quarantined(ctx, caseID)
+	}
+
 	log.Info().
 		Str("user_id", wallet.UserID).
 		Str("address", transaction.ToAddr).
 		Str("token_symbol", token.TokenSymbol).
 		Str("amount", transaction.Amount).
 		Str("tx_hash", transaction.TXHash).
+		Strs("tags", outcome.Tags).
+		Int("fee_routes", len(outcome.FeeRoutes)).
+		Bool("rejected", outcome.Rejected).
+		Bool("quarantined", quarantined).
+		Bool("watch_only", wallet.WalletType == models.WalletTypeWatch).
 		Msg("Credit created for deposit")
 
 	return credit, nil
@@ -203,8 +304,8 @@ func (s *service) CreateCredit(ctx context.Context, transaction *models.Transact
 func (s *service) GetPendingDeposits(ctx context.Context, chainID int) ([]*models.Transaction, error) {
 	transactions, err := models.Transactions(
 		models.TransactionWhere.ChainID.EQ(chainID),
-		models.TransactionWhere.Type.EQ("deposit"),
-		models.TransactionWhere.Status.IN([]string{"confirmed", "safe"}),
+		models.TransactionWhere.Type.EQ(models.TransactionTypeDeposit),
+		models.TransactionWhere.Status.IN([]models.TransactionStatus{models.TransactionStatusConfirmed, models.TransactionStatusSafe}),
 		qm.OrderBy("block_no ASC"),
 	).All(ctx, s.db)
 
@@ -216,17 +317,11 @@ func (s *service) GetPendingDeposits(ctx context.Context, chainID int) ([]*model
 }
 
 // getTokenInfo 获取代币信息
-func (s *service) getTokenInfo(ctx context.Context, chainID int, tokenAddr string) (*models.Token, error) {
+func (s *service) getTokenInfo(ctx context.Context, chainConfig *models.Chain, tokenAddr string) (*models.Token, error) {
+	chainID := chainConfig.ChainID
+
 	// 如果是原生代币（tokenAddr 为空），查找原生代币
 	if tokenAddr == "" {
-		chain, err := models.Chains(
-			models.ChainWhere.ChainID.EQ(chainID),
-		).One(ctx, s.db)
-
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to get chain config")
-		}
-
 		// 查找原生代币
 		token, err := models.Tokens(
 			models.TokenWhere.ChainID.EQ(chainID),
@@ -235,16 +330,16 @@ func (s *service) getTokenInfo(ctx context.Context, chainID int, tokenAddr strin
 
 		if err != nil {
 			// 如果原生代币不存在，创建一个默认记录
-			return s.createNativeToken(ctx, chainID, chain.NativeTokenSymbol)
+			return s.createNativeToken(ctx, chainConfig)
 		}
 
 		return token, nil
 	}
 
-	// 查找 ERC20 代币
+	// 查找 ERC20 / SPL 代币
 	token, err := models.Tokens(
 		models.TokenWhere.ChainID.EQ(chainID),
-		models.TokenWhere.TokenAddress.EQ(null.StringFrom(strings.ToLower(tokenAddr))),
+		models.TokenWhere.TokenAddress.EQ(null.StringFrom(chain.NormalizeAddress(chainConfig.ChainType, tokenAddr))),
 	).One(ctx, s.db)
 
 	if err != nil {
@@ -255,18 +350,24 @@ func (s *service) getTokenInfo(ctx context.Context, chainID int, tokenAddr strin
 		return nil, errors.Wrap(err, "failed to get token")
 	}
 
+	// 未启用的代币（如自动发现、待管理员审核的代币）暂不入账，启用后再处理
+	if !token.IsActive {
+		return nil, errors.Errorf("token is not active: chain_id=%d, token_addr=%s", chainID, tokenAddr)
+	}
+
 	return token, nil
 }
 
 // createNativeToken 创建原生代币记录（如果不存在）
-func (s *service) createNativeToken(ctx context.Context, chainID int, symbol string) (*models.Token, error) {
+func (s *service) createNativeToken(ctx context.Context, chainConfig *models.Chain) (*models.Token, error) {
+	symbol := chainConfig.NativeTokenSymbol
 	token := &models.Token{
-		ChainID:      chainID,
-		ChainType:    "evm",
+		ChainID:      chainConfig.ChainID,
+		ChainType:    chainConfig.ChainType,
 		TokenAddress: null.String{},
 		TokenSymbol:  symbol,
 		TokenName:    null.StringFrom(symbol),
-		Decimals:     nativeTokenDecimals,
+		Decimals:     chainConfig.NativeTokenDecimals,
 		IsNative:     true,
 		TokenType:    null.StringFrom("native"),
 		IsActive:     true,
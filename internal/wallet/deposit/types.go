@@ -22,4 +22,38 @@ type Service interface {
 
 	// ProcessFinalizedDeposits 处理已终结的充值（确保生成 Credits 记录）
 	ProcessFinalizedDeposits(ctx context.Context, chainID int) error
+
+	// EstimateFinality 根据链出块时间、当前确认数、终结区块数和链实时状况估算未终结充值的终结时间，
+	// 返回以交易 ID 为键的结果，已终结或失败的交易不包含在内
+	EstimateFinality(ctx context.Context, transactions []*models.Transaction, conditions map[int]*ChainConditions) (map[string]*FinalityETA, error)
+
+	// GetDustReport 按链和代币汇总低于最小充值金额、记录为 dust 的充值，chainID 为空时汇总所有链
+	GetDustReport(ctx context.Context, chainID *int) ([]*DustSummary, error)
+
+	// GetDepositURI 生成用户充值地址的 EIP-681 URI（可指定代币和金额）
+	GetDepositURI(ctx context.Context, req *URIRequest) (*URI, error)
+
+	// ListRules 查询所有充值规则（按评估顺序）
+	ListRules(ctx context.Context) ([]*Rule, error)
+
+	// GetRule 获取充值规则
+	GetRule(ctx context.Context, ruleID string) (*Rule, error)
+
+	// CreateRule 创建充值规则
+	CreateRule(ctx context.Context, rule *Rule) (*Rule, error)
+
+	// UpdateRule 更新充值规则
+	UpdateRule(ctx context.Context, rule *Rule) (*Rule, error)
+
+	// DeleteRule 删除充值规则
+	DeleteRule(ctx context.Context, ruleID string) error
+
+	// EvaluateRules 试运行充值规则，不写入任何数据
+	EvaluateRules(ctx context.Context, input *RuleInput) (*RuleOutcome, error)
+
+	// SetContractChecker 注入合约地址检查器（规则条件 sender_is_contract 使用）
+	SetContractChecker(checker ContractChecker)
+
+	// SetScreeningHook 注入来源地址合规筛查（被标记的充值进入隔离）
+	SetScreeningHook(hook ScreeningHook)
 }
@@ -0,0 +1,161 @@
+package deposit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"net/url"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// URIRequest 充值 URI 请求参数
+type URIRequest struct {
+	UserID  string
+	ChainID int
+	TokenID *int   // 为空时表示链原生代币
+	Amount  string // 人类可读金额，可为空
+}
+
+// URI 充值 URI（EVM 链为 EIP-681，Solana 为 Solana Pay，Bitcoin 为 BIP-21）
+type URI struct {
+	URI       string
+	Address   string // 用户充值地址（EVM 为 checksum 格式）
+	ChainID   int
+	TokenID   *int
+	Amount    string
+	AmountWei *big.Int // 最小单位金额，未指定金额时为 nil
+}
+
+// GetDepositURI 生成用户充值地址的 EIP-681 URI
+// 原生代币：ethereum:<address>@<chainId>?value=<wei>
+// ERC20：ethereum:<token>@<chainId>/transfer?address=<address>&uint256=<amount>
+// Solana 链生成 Solana Pay URI：solana:<address>?amount=<amount>&spl-token=<mint>
+// Bitcoin 链生成 BIP-21 URI：bitcoin:<address>?amount=<btc>
+func (s *service) GetDepositURI(ctx context.Context, req *URIRequest) (*URI, error) {
+	wallet, err := models.Wallets(
+		models.WalletWhere.UserID.EQ(req.UserID),
+		models.WalletWhere.ChainID.EQ(req.ChainID),
+		models.WalletWhere.WalletType.NEQ(models.WalletTypeWatch), // 充值地址只使用派生地址
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, walleterrors.ErrWalletNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get wallet")
+	}
+
+	isSolana := wallet.ChainType == chain.TypeSolana
+	isBitcoin := wallet.ChainType == chain.TypeBitcoin
+
+	result := &URI{
+		Address: wallet.Address,
+		ChainID: req.ChainID,
+		TokenID: req.TokenID,
+		Amount:  req.Amount,
+	}
+
+	var (
+		tokenAddress string
+		decimals     = nativeTokenDecimals
+	)
+	switch {
+	case isSolana:
+		decimals = solanaNativeDecimals
+	case isBitcoin:
+		decimals = bitcoinNativeDecimals
+	default:
+		result.Address = common.HexToAddress(wallet.Address).Hex()
+	}
+	if req.TokenID != nil {
+		token, err := models.Tokens(
+			models.TokenWhere.ID.EQ(*req.TokenID),
+			models.TokenWhere.ChainID.EQ(req.ChainID),
+			models.TokenWhere.IsActive.EQ(true),
+		).One(ctx, s.db)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, walleterrors.ErrTokenNotFound
+			}
+			return nil, errors.Wrap(err, "failed to get token")
+		}
+
+		decimals = token.Decimals
+		if !token.IsNative && token.TokenAddress.Valid && token.TokenAddress.String != "" {
+			tokenAddress = token.TokenAddress.String
+			if !isSolana && !isBitcoin {
+				tokenAddress = common.HexToAddress(tokenAddress).Hex()
+			}
+		}
+	}
+
+	if req.Amount != "" {
+		result.AmountWei, err = money.ToSmallestUnit(req.Amount, decimals)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case isSolana:
+		result.URI = buildSolanaPayURI(result.Address, tokenAddress, req.Amount)
+	case isBitcoin:
+		result.URI = buildBIP21URI(result.Address, req.Amount)
+	default:
+		result.URI = buildEIP681URI(result.Address, tokenAddress, req.ChainID, result.AmountWei)
+	}
+
+	return result, nil
+}
+
+// buildEIP681URI 构建 EIP-681 URI，tokenAddress 为空时生成原生代币转账 URI
+func buildEIP681URI(address string, tokenAddress string, chainID int, amountWei *big.Int) string {
+	params := url.Values{}
+
+	if tokenAddress == "" {
+		uri := fmt.Sprintf("ethereum:%s@%d", address, chainID)
+		if amountWei != nil {
+			params.Set("value", amountWei.String())
+			uri += "?" + params.Encode()
+		}
+		return uri
+	}
+
+	params.Set("address", address)
+	if amountWei != nil {
+		params.Set("uint256", amountWei.String())
+	}
+	// url.Values.Encode 按键排序，address 始终在 uint256 之前
+	return fmt.Sprintf("ethereum:%s@%d/transfer?%s", tokenAddress, chainID, params.Encode())
+}
+
+// buildSolanaPayURI 构建 Solana Pay 转账 URI，金额为人类可读单位，mint 为空时为 SOL 转账
+func buildSolanaPayURI(address string, mint string, amount string) string {
+	params := url.Values{}
+	if amount != "" {
+		params.Set("amount", amount)
+	}
+	if mint != "" {
+		params.Set("spl-token", mint)
+	}
+
+	if len(params) == 0 {
+		return "solana:" + address
+	}
+	return "solana:" + address + "?" + params.Encode()
+}
+
+// buildBIP21URI 构建 BIP-21 URI，金额为人类可读单位（BTC）
+func buildBIP21URI(address string, amount string) string {
+	if amount == "" {
+		return "bitcoin:" + address
+	}
+	return "bitcoin:" + address + "?" + url.Values{"amount": {amount}}.Encode()
+}
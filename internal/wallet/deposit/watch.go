@@ -0,0 +1,32 @@
+package deposit
+
+import (
+	"encoding/json"
+
+	"github/chapool/go-wallet/internal/models"
+
+	"github.com/aarondl/null/v8"
+	"github.com/pkg/errors"
+)
+
+// watchOnlyMetadataKey 观察地址充值在 credits.metadata 中的标记
+const watchOnlyMetadataKey = "watch_only"
+
+// tagWatchOnly 在 credits.metadata 中标记观察地址充值，保留已有的规则评估结果
+func tagWatchOnly(credit *models.Credit) error {
+	metadata := make(map[string]any)
+	if credit.Metadata.Valid {
+		if err := json.Unmarshal(credit.Metadata.JSON, &metadata); err != nil {
+			return errors.Wrap(err, "failed to unmarshal credit metadata")
+		}
+	}
+	metadata[watchOnlyMetadataKey] = true
+
+	b, err := json.Marshal(metadata)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal watch-only credit metadata")
+	}
+	credit.Metadata = null.JSONFrom(b)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package deposit
+
+import (
+	"context"
+	"encoding/json"
+
+	"github/chapool/go-wallet/internal/models"
+
+	"github.com/aarondl/null/v8"
+	"github.com/pkg/errors"
+)
+
+// wrappedNativeMetadataKey 包装原生代币按原生代币入账时，在 credits.metadata 中记录实际收到的代币
+const wrappedNativeMetadataKey = "wrapped_native"
+
+// wrappedNativeMetadata 实际收到的包装原生代币
+type wrappedNativeMetadata struct {
+	TokenID      int    `json:"token_id"`
+	TokenSymbol  string `json:"token_symbol"`
+	TokenAddress string `json:"token_address"`
+}
+
+// resolveCreditToken 获取入账使用的代币：包装原生代币（如 WBNB）开启 credit_as_native 时按链的原生代币入账，其他代币按原代币入账
+func (s *service) resolveCreditToken(ctx context.Context, chainConfig *models.Chain, token *models.Token) (*models.Token, error) {
+	if !token.IsWrappedNative || !token.CreditAsNative {
+		return token, nil
+	}
+
+	native, err := s.getTokenInfo(ctx, chainConfig, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get native token")
+	}
+
+	// 金额按原样入账，精度不同时无法按原生代币入账
+	if native.Decimals != token.Decimals {
+		return nil, errors.Errorf("wrapped native token %s has %d decimals, native token %s has %d",
+			token.TokenSymbol, token.Decimals, native.TokenSymbol, native.Decimals)
+	}
+
+	return native, nil
+}
+
+// tagWrappedNative 在 credits.metadata 中记录按原生代币入账的包装代币，保留已有的规则评估结果
+func tagWrappedNative(credit *models.Credit, wrapped *models.Token) error {
+	metadata := make(map[string]any)
+	if credit.Metadata.Valid {
+		if err := json.Unmarshal(credit.Metadata.JSON, &metadata); err != nil {
+			return errors.Wrap(err, "failed to unmarshal credit metadata")
+		}
+	}
+	metadata[wrappedNativeMetadataKey] = wrappedNativeMetadata{
+		TokenID:      wrapped.ID,
+		TokenSymbol:  wrapped.TokenSymbol,
+		TokenAddress: wrapped.TokenAddress.String,
+	}
+
+	b, err := json.Marshal(metadata)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal wrapped native credit metadata")
+	}
+	credit.Metadata = null.JSONFrom(b)
+
+	return nil
+}
@@ -0,0 +1,256 @@
+package diagnostics
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// unfinishedWithdrawStatuses 未完成的提现状态
+var unfinishedWithdrawStatuses = []string{
+	string(models.WithdrawStatusUserWithdrawRequest),
+	string(models.WithdrawStatusSigning),
+	string(models.WithdrawStatusPending),
+	string(models.WithdrawStatusProcessing),
+}
+
+// stuckWithdrawStatuses 已开始处理、应在短时间内变化的提现状态（等待审批或处理窗口的提现不算卡住）
+var stuckWithdrawStatuses = []string{
+	string(models.WithdrawStatusSigning),
+	string(models.WithdrawStatusPending),
+	string(models.WithdrawStatusProcessing),
+}
+
+// Collect 收集诊断包，单项收集失败时记录在 Bundle.CollectionErrors 中，其余项照常返回
+func (s *service) Collect(ctx context.Context) *Bundle {
+	log := util.LogFromContext(ctx)
+
+	now := time.Now()
+	bundle := &Bundle{
+		GeneratedAt:      now,
+		CollectionErrors: make(map[string]string),
+	}
+
+	collect := func(section string, fn func() error) {
+		if err := fn(); err != nil {
+			log.Warn().Err(err).Str("section", section).Msg("Failed to collect diagnostics section")
+			bundle.CollectionErrors[section] = err.Error()
+		}
+	}
+
+	collect(SectionScanners, func() error {
+		scanners, err := s.scanService.GetScanStatus(ctx)
+		bundle.Scanners = scanners
+		return err
+	})
+	bundle.RPCClients = s.scanService.GetRPCClientStates()
+	collect(SectionQueueDepths, func() error {
+		queueDepths, err := s.queueDepths(ctx)
+		bundle.QueueDepths = queueDepths
+		return err
+	})
+	collect(SectionHotWallets, func() error {
+		hotWallets, err := s.hotWalletMonitor.GetHealth(ctx)
+		bundle.HotWallets = hotWallets
+		return err
+	})
+	collect(SectionStuckWithdraws, func() error {
+		stuckWithdraws, err := s.stuckWithdraws(ctx, now.Add(-stuckWithdrawAfter))
+		bundle.StuckWithdraws = stuckWithdraws
+		return err
+	})
+	collect(SectionRecentReorgs, func() error {
+		reorgs, err := s.recentReorgs(ctx, now.Add(-recentWindow))
+		bundle.RecentReorgs = reorgs
+		return err
+	})
+	collect(SectionRecentErrors, func() error {
+		recentErrors, err := s.recentErrors(ctx, now.Add(-recentWindow))
+		bundle.RecentErrors = recentErrors
+		return err
+	})
+
+	return bundle
+}
+
+// queueDepths 统计未完成的提现、补扫任务、状态推送和领域事件的队列深度
+func (s *service) queueDepths(ctx context.Context) ([]*QueueDepth, error) {
+	depths := make([]*QueueDepth, 0)
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT 'withdraws', chain_id, status::text, COUNT(*), MIN(created_at)
+		FROM withdraws
+		WHERE status = ANY($1::withdraw_status[])
+		GROUP BY chain_id, status
+		UNION ALL
+		SELECT 'backfill_jobs', chain_id, status, COUNT(*), MIN(created_at)
+		FROM backfill_jobs
+		WHERE status IN ('pending', 'running')
+		GROUP BY chain_id, status
+		ORDER BY 1, 2, 3
+	`, pq.Array(unfinishedWithdrawStatuses))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query withdraw and backfill queues")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			depth   QueueDepth
+			chainID int
+			status  string
+		)
+		if err := rows.Scan(&depth.Queue, &chainID, &status, &depth.Depth, &depth.OldestAt); err != nil {
+			return nil, errors.Wrap(err, "failed to scan queue depth")
+		}
+		depth.ChainID = &chainID
+		depth.Status = &status
+		depths = append(depths, &depth)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate queue depths")
+	}
+
+	// 状态推送、领域事件和提现处理事件不按链统计，队列为空时也返回深度 0
+	for _, queue := range []struct {
+		name  string
+		query string
+	}{
+		{QueueStatusPush, `SELECT COUNT(*), MIN(created_at) FROM push_notification_queue`},
+		{QueueWalletEvents, `SELECT COUNT(*), MIN(occurred_at) FROM wallet_events WHERE published_at IS NULL`},
+		{QueueWithdrawOutbox, `SELECT COUNT(*), MIN(created_at) FROM withdraw_outbox WHERE dispatched_at IS NULL`},
+	} {
+		depth := &QueueDepth{Queue: queue.name}
+		if err := s.db.QueryRowContext(ctx, queue.query).Scan(&depth.Depth, &depth.OldestAt); err != nil {
+			return nil, errors.Wrapf(err, "failed to query %s queue", queue.name)
+		}
+		depths = append(depths, depth)
+	}
+
+	return depths, nil
+}
+
+// stuckWithdraws 查询 before 之后没有状态变化的签名中、已广播或处理中的提现，最早的在前
+func (s *service) stuckWithdraws(ctx context.Context, before time.Time) ([]*StuckWithdraw, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, chain_id, token_id, status::text, from_address, tx_hash, nonce, created_at, updated_at
+		FROM withdraws
+		WHERE status = ANY($1::withdraw_status[]) AND updated_at < $2
+		ORDER BY updated_at
+		LIMIT $3
+	`, pq.Array(stuckWithdrawStatuses), before, stuckWithdrawLimit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query stuck withdraws")
+	}
+	defer rows.Close()
+
+	withdraws := make([]*StuckWithdraw, 0)
+	for rows.Next() {
+		var (
+			withdraw    StuckWithdraw
+			fromAddress sql.NullString
+			txHash      sql.NullString
+			nonce       sql.NullInt64
+		)
+		if err := rows.Scan(&withdraw.ID, &withdraw.ChainID, &withdraw.TokenID, &withdraw.Status,
+			&fromAddress, &txHash, &nonce, &withdraw.CreatedAt, &withdraw.UpdatedAt); err != nil {
+			return nil, errors.Wrap(err, "failed to scan stuck withdraw")
+		}
+		if fromAddress.Valid {
+			withdraw.FromAddress = &fromAddress.String
+		}
+		if txHash.Valid {
+			withdraw.TxHash = &txHash.String
+		}
+		if nonce.Valid {
+			n := int(nonce.Int64)
+			withdraw.Nonce = &n
+		}
+		withdraws = append(withdraws, &withdraw)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate stuck withdraws")
+	}
+
+	return withdraws, nil
+}
+
+// recentReorgs 按链统计 since 之后被标记为 orphaned 的区块
+func (s *service) recentReorgs(ctx context.Context, since time.Time) ([]*Reorg, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT chain_id, COUNT(*), MIN(number), MAX(number), MAX(updated_at)
+		FROM blocks
+		WHERE status = $1 AND updated_at >= $2
+		GROUP BY chain_id
+		ORDER BY MAX(updated_at) DESC
+	`, string(models.BlockStatusOrphaned), since)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query recent reorgs")
+	}
+	defer rows.Close()
+
+	reorgs := make([]*Reorg, 0)
+	for rows.Next() {
+		var reorg Reorg
+		if err := rows.Scan(&reorg.ChainID, &reorg.OrphanedBlocks, &reorg.FromBlock, &reorg.ToBlock, &reorg.LastOrphanedAt); err != nil {
+			return nil, errors.Wrap(err, "failed to scan reorg")
+		}
+		reorgs = append(reorgs, &reorg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reorgs")
+	}
+
+	return reorgs, nil
+}
+
+// recentErrors 查询 since 之后失败的提现、补扫任务和未发布的领域事件的错误，最新的在前
+// 扫描器的错误（RPC 不可用、链 ID 不一致）记录在 Bundle.Scanners 中
+func (s *service) recentErrors(ctx context.Context, since time.Time) ([]*RecentError, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT $2::text AS source, chain_id, id::text, error_message, updated_at AS occurred_at
+		FROM withdraws
+		WHERE status = 'failed' AND error_message IS NOT NULL AND updated_at >= $1
+		UNION ALL
+		SELECT $3::text, chain_id, id::text, error, updated_at
+		FROM backfill_jobs
+		WHERE status = 'failed' AND error IS NOT NULL AND updated_at >= $1
+		UNION ALL
+		SELECT $4::text, chain_id, id::text, last_error, occurred_at
+		FROM wallet_events
+		WHERE published_at IS NULL AND last_error IS NOT NULL
+		ORDER BY occurred_at DESC
+		LIMIT $5
+	`, since, ErrorSourceWithdraw, ErrorSourceBackfill, ErrorSourceEventPublisher, recentErrorLimit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query recent errors")
+	}
+	defer rows.Close()
+
+	recentErrors := make([]*RecentError, 0)
+	for rows.Next() {
+		var (
+			recentError RecentError
+			chainID     sql.NullInt64
+		)
+		if err := rows.Scan(&recentError.Source, &chainID, &recentError.ReferenceID, &recentError.Message, &recentError.OccurredAt); err != nil {
+			return nil, errors.Wrap(err, "failed to scan recent error")
+		}
+		if chainID.Valid {
+			id := int(chainID.Int64)
+			recentError.ChainID = &id
+		}
+		recentErrors = append(recentErrors, &recentError)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate recent errors")
+	}
+
+	return recentErrors, nil
+}
@@ -0,0 +1,60 @@
+package diagnostics
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github/chapool/go-wallet/internal/wallet/hotwallet"
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeScanService struct {
+	scan.Service
+	statuses []*scan.ScanProgress
+}
+
+func (f *fakeScanService) GetScanStatus(context.Context) ([]*scan.ScanProgress, error) {
+	return f.statuses, nil
+}
+
+func (f *fakeScanService) GetRPCClientStates() []*scan.RPCClientState {
+	return []*scan.RPCClientState{{ChainID: 56, ChainType: "evm", RPCEndpointCount: 2}}
+}
+
+type failingHotWalletMonitor struct {
+	hotwallet.Monitor
+}
+
+func (failingHotWalletMonitor) GetHealth(context.Context) ([]*hotwallet.Health, error) {
+	return nil, errors.New("rpc unavailable")
+}
+
+func TestCollectKeepsSectionsWhenOthersFail(t *testing.T) {
+	t.Parallel()
+
+	// 关闭的数据库连接使所有数据库查询失败
+	db, err := sql.Open("postgres", "")
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	statuses := []*scan.ScanProgress{{ChainID: 56, Status: scan.ScanStatusRPCUnavailable, Error: "connection refused"}}
+	svc := NewService(db, &fakeScanService{statuses: statuses}, failingHotWalletMonitor{})
+
+	bundle := svc.Collect(context.Background())
+
+	assert.Equal(t, statuses, bundle.Scanners)
+	require.Len(t, bundle.RPCClients, 1)
+	assert.Equal(t, 56, bundle.RPCClients[0].ChainID)
+
+	assert.NotContains(t, bundle.CollectionErrors, SectionScanners)
+	assert.Equal(t, "rpc unavailable", bundle.CollectionErrors[SectionHotWallets])
+	for _, section := range []string{SectionQueueDepths, SectionStuckWithdraws, SectionRecentReorgs, SectionRecentErrors} {
+		assert.Contains(t, bundle.CollectionErrors, section)
+	}
+	assert.False(t, bundle.GeneratedAt.IsZero())
+}
@@ -0,0 +1,126 @@
+//nolint:ireturn // 返回接口类型是预期的设计
+package diagnostics
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github/chapool/go-wallet/internal/wallet/hotwallet"
+	"github/chapool/go-wallet/internal/wallet/scan"
+)
+
+const (
+	// stuckWithdrawAfter 签名中、已广播或处理中的提现超过该时间没有状态变化视为卡住
+	stuckWithdrawAfter = 30 * time.Minute
+	// recentWindow 重组和错误的统计窗口
+	recentWindow = 24 * time.Hour
+	// stuckWithdrawLimit 诊断包中卡住提现的最大条数
+	stuckWithdrawLimit = 100
+	// recentErrorLimit 诊断包中最近错误的最大条数
+	recentErrorLimit = 50
+)
+
+// 诊断包的项（与 Bundle.CollectionErrors 的键一致）
+const (
+	SectionScanners       = "scanners"
+	SectionRPCClients     = "rpc_clients"
+	SectionQueueDepths    = "queue_depths"
+	SectionHotWallets     = "hot_wallets"
+	SectionStuckWithdraws = "stuck_withdraws"
+	SectionRecentReorgs   = "recent_reorgs"
+	SectionRecentErrors   = "recent_errors"
+)
+
+// 队列（与 QueueDepth.Queue 一致）
+const (
+	QueueWithdraws      = "withdraws"       // 未完成的提现，按链和状态统计
+	QueueBackfillJobs   = "backfill_jobs"   // 等待或运行中的历史区块补扫任务，按链和状态统计
+	QueueStatusPush     = "status_push"     // 待发送的充值/提现状态推送
+	QueueWalletEvents   = "wallet_events"   // 未发布的领域事件
+	QueueWithdrawOutbox = "withdraw_outbox" // 未完成分发的提现处理事件
+)
+
+// 错误来源（与 RecentError.Source 一致）
+const (
+	ErrorSourceWithdraw       = "withdraw"        // 失败的提现
+	ErrorSourceBackfill       = "backfill"        // 失败的补扫任务
+	ErrorSourceEventPublisher = "event_publisher" // 发布失败的领域事件
+)
+
+// Service 运维诊断服务接口
+// 把扫描状态、RPC 节点健康、队列深度、热钱包余额、卡住的提现、最近的重组和错误汇总为一个诊断包，附加到事故工单
+type Service interface {
+	// Collect 收集诊断包，单项收集失败时记录在 Bundle.CollectionErrors 中，其余项照常返回
+	Collect(ctx context.Context) *Bundle
+}
+
+// Bundle 诊断包
+type Bundle struct {
+	GeneratedAt    time.Time
+	Scanners       []*scan.ScanProgress
+	RPCClients     []*scan.RPCClientState
+	QueueDepths    []*QueueDepth
+	HotWallets     []*hotwallet.Health
+	StuckWithdraws []*StuckWithdraw
+	RecentReorgs   []*Reorg
+	RecentErrors   []*RecentError
+
+	// CollectionErrors 收集失败的项及原因（键为 Section*）
+	CollectionErrors map[string]string
+}
+
+// QueueDepth 队列深度
+type QueueDepth struct {
+	Queue    string
+	ChainID  *int    // 不按链统计的队列为空
+	Status   *string // 不按状态统计的队列为空
+	Depth    int64
+	OldestAt *time.Time // 队列中最早的记录的创建时间
+}
+
+// StuckWithdraw 长时间没有状态变化的提现
+type StuckWithdraw struct {
+	ID          string
+	ChainID     int
+	TokenID     int
+	Status      string
+	FromAddress *string
+	TxHash      *string
+	Nonce       *int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Reorg 链在统计窗口内的区块重组（被标记为 orphaned 的区块）
+type Reorg struct {
+	ChainID        int
+	OrphanedBlocks int64
+	FromBlock      int64
+	ToBlock        int64
+	LastOrphanedAt time.Time
+}
+
+// RecentError 统计窗口内的错误
+type RecentError struct {
+	Source      string
+	ChainID     *int
+	ReferenceID string // 提现 ID、补扫任务 ID 或事件 ID
+	Message     string
+	OccurredAt  time.Time
+}
+
+type service struct {
+	db               *sql.DB
+	scanService      scan.Service
+	hotWalletMonitor hotwallet.Monitor
+}
+
+// NewService 创建运维诊断服务
+func NewService(db *sql.DB, scanService scan.Service, hotWalletMonitor hotwallet.Monitor) Service {
+	return &service{
+		db:               db,
+		scanService:      scanService,
+		hotWalletMonitor: hotWalletMonitor,
+	}
+}
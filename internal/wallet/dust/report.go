@@ -0,0 +1,166 @@
+package dust
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github/chapool/go-wallet/internal/money"
+)
+
+// ReportFilter 归集报告查询条件
+type ReportFilter struct {
+	UserID  *string
+	TokenID *int
+	Limit   int
+	Offset  int
+}
+
+// Consolidation 归集记录
+type Consolidation struct {
+	ID            string
+	UserID        string
+	AccountUserID string
+	TokenID       int
+	TokenSymbol   string
+	ChainID       int
+	Amount        *big.Float
+	Threshold     *big.Float
+	CreatedAt     time.Time
+}
+
+// TokenTotal 按代币汇总（不受分页影响）
+type TokenTotal struct {
+	TokenID     int
+	TokenSymbol string
+	ChainID     int
+	TotalAmount *big.Float
+	Count       int64
+	UserCount   int64
+}
+
+// Report 归集报告
+type Report struct {
+	Items  []*Consolidation
+	Totals []*TokenTotal
+}
+
+// GetReport 获取归集记录及按代币汇总
+func (s *service) GetReport(ctx context.Context, filter *ReportFilter) (*Report, error) {
+	items, err := s.getConsolidations(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, err := s.getTokenTotals(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Report{
+		Items:  items,
+		Totals: totals,
+	}, nil
+}
+
+// getConsolidations 分页查询归集记录（按时间倒序）
+func (s *service) getConsolidations(ctx context.Context, filter *ReportFilter) ([]*Consolidation, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT d.id, d.user_id, d.account_user_id, d.token_id, t.token_symbol, d.chain_id, d.amount, d.threshold, d.created_at
+		FROM dust_consolidations d
+		INNER JOIN tokens t ON t.id = d.token_id
+		WHERE ($1::uuid IS NULL OR d.user_id = $1)
+			AND ($2::integer IS NULL OR d.token_id = $2)
+		ORDER BY d.created_at DESC, d.id DESC
+		LIMIT $3 OFFSET $4
+	`, filter.UserID, filter.TokenID, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query dust consolidations")
+	}
+	defer rows.Close()
+
+	items := make([]*Consolidation, 0)
+	for rows.Next() {
+		var (
+			item         Consolidation
+			amountStr    string
+			thresholdStr string
+		)
+
+		if err := rows.Scan(
+			&item.ID,
+			&item.UserID,
+			&item.AccountUserID,
+			&item.TokenID,
+			&item.TokenSymbol,
+			&item.ChainID,
+			&amountStr,
+			&thresholdStr,
+			&item.CreatedAt,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan dust consolidation")
+		}
+
+		item.Amount, err = money.ParseFloat(amountStr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse amount: %s", amountStr)
+		}
+
+		item.Threshold, err = money.ParseFloat(thresholdStr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse threshold: %s", thresholdStr)
+		}
+
+		items = append(items, &item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate dust consolidations")
+	}
+
+	return items, nil
+}
+
+// getTokenTotals 按代币汇总归集金额
+func (s *service) getTokenTotals(ctx context.Context, filter *ReportFilter) ([]*TokenTotal, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT d.token_id, t.token_symbol, d.chain_id, SUM(d.amount::numeric)::text, COUNT(*), COUNT(DISTINCT d.user_id)
+		FROM dust_consolidations d
+		INNER JOIN tokens t ON t.id = d.token_id
+		WHERE ($1::uuid IS NULL OR d.user_id = $1)
+			AND ($2::integer IS NULL OR d.token_id = $2)
+		GROUP BY d.token_id, t.token_symbol, d.chain_id
+		ORDER BY d.chain_id, d.token_id
+	`, filter.UserID, filter.TokenID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query dust consolidation totals")
+	}
+	defer rows.Close()
+
+	totals := make([]*TokenTotal, 0)
+	for rows.Next() {
+		var (
+			total    TokenTotal
+			totalStr string
+		)
+
+		if err := rows.Scan(&total.TokenID, &total.TokenSymbol, &total.ChainID, &totalStr, &total.Count, &total.UserCount); err != nil {
+			return nil, errors.Wrap(err, "failed to scan dust consolidation total")
+		}
+
+		total.TotalAmount, err = money.ParseFloat(totalStr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse total amount: %s", totalStr)
+		}
+
+		totals = append(totals, &total)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate dust consolidation totals")
+	}
+
+	return totals, nil
+}
@@ -0,0 +1,353 @@
+//nolint:ireturn // 返回接口类型是预期的设计
+package dust
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/balance"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// Service 小额余额（dust）归集服务接口
+// 已授权用户低于代币最小提现金额、且长期无变动的余额，定时归集到归集账户（手续费/公益账户）
+type Service interface {
+	// GetConsent 获取用户的归集授权状态
+	GetConsent(ctx context.Context, userID string) (*Consent, error)
+
+	// SetConsent 授权或撤销授权小额余额归集
+	SetConsent(ctx context.Context, userID string, enabled bool) (*Consent, error)
+
+	// ConsolidateDust 执行一次归集，返回归集的记录数
+	ConsolidateDust(ctx context.Context) (int, error)
+
+	// StartDustConsolidation 启动定时归集
+	StartDustConsolidation(ctx context.Context, interval time.Duration)
+
+	// GetReport 获取归集记录及按代币汇总
+	GetReport(ctx context.Context, filter *ReportFilter) (*Report, error)
+}
+
+// Config 归集服务配置
+type Config struct {
+	// AccountUserID 接收归集余额的账户
+	AccountUserID string
+	// MinIdle 余额至少多长时间无任何 credits 变动才会被归集
+	MinIdle time.Duration
+}
+
+// service 实现 Service 接口
+type service struct {
+	db     *sql.DB
+	config Config
+}
+
+// NewService 创建小额余额归集服务
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(db *sql.DB, config Config) Service {
+	return &service{
+		db:     db,
+		config: config,
+	}
+}
+
+// Consent 用户归集授权状态
+type Consent struct {
+	Enabled     bool
+	ConsentedAt *time.Time // 从未授权时为 nil
+	RevokedAt   *time.Time // 授权有效时为 nil
+}
+
+// candidate 待归集的用户代币余额
+type candidate struct {
+	userID  string
+	tokenID int
+}
+
+// GetConsent 获取用户的归集授权状态
+func (s *service) GetConsent(ctx context.Context, userID string) (*Consent, error) {
+	return s.getConsent(ctx, s.db, userID)
+}
+
+// SetConsent 授权或撤销授权小额余额归集
+func (s *service) SetConsent(ctx context.Context, userID string, enabled bool) (*Consent, error) {
+	var err error
+	if enabled {
+		_, err = s.db.ExecContext(ctx, `
+			INSERT INTO dust_consolidation_consents (user_id, consented_at)
+			VALUES ($1, NOW())
+			ON CONFLICT (user_id) DO UPDATE SET
+				consented_at = NOW(),
+				revoked_at = NULL,
+				updated_at = NOW()
+		`, userID)
+	} else {
+		_, err = s.db.ExecContext(ctx, `
+			UPDATE dust_consolidation_consents
+			SET revoked_at = NOW(), updated_at = NOW()
+			WHERE user_id = $1 AND revoked_at IS NULL
+		`, userID)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update dust consolidation consent")
+	}
+
+	log.Info().
+		Str("user_id", userID).
+		Bool("enabled", enabled).
+		Msg("Dust consolidation consent updated")
+
+	return s.getConsent(ctx, s.db, userID)
+}
+
+// getConsent 查询授权状态
+func (s *service) getConsent(ctx context.Context, exec boil.ContextExecutor, userID string) (*Consent, error) {
+	var consentedAt, revokedAt sql.NullTime
+	err := exec.QueryRowContext(ctx, `
+		SELECT consented_at, revoked_at FROM dust_consolidation_consents WHERE user_id = $1
+	`, userID).Scan(&consentedAt, &revokedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &Consent{}, nil
+		}
+		return nil, errors.Wrap(err, "failed to get dust consolidation consent")
+	}
+
+	consent := &Consent{
+		Enabled: !revokedAt.Valid,
+	}
+	if consentedAt.Valid {
+		consent.ConsentedAt = &consentedAt.Time
+	}
+	if revokedAt.Valid {
+		consent.RevokedAt = &revokedAt.Time
+	}
+
+	return consent, nil
+}
+
+// StartDustConsolidation 启动定时归集
+func (s *service) StartDustConsolidation(ctx context.Context, interval time.Duration) {
+	log.Info().
+		Dur("interval", interval).
+		Str("account_user_id", s.config.AccountUserID).
+		Msg("Starting dust consolidation")
+
+	lifecycle.Go(ctx, "dust consolidation", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Dust consolidation stopped")
+				return
+			case <-ticker.C:
+				count, err := s.ConsolidateDust(ctx)
+				if err != nil {
+					log.Error().Err(err).Msg("Dust consolidation failed")
+					continue
+				}
+				log.Info().Int("count", count).Msg("Dust consolidation finished")
+			}
+		}
+	})
+}
+
+// ConsolidateDust 执行一次归集
+func (s *service) ConsolidateDust(ctx context.Context) (int, error) {
+	candidates, err := s.findCandidates(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, c := range candidates {
+		// 停机时在用户之间停止，单个用户的归集在同一个数据库事务中完成，不会留下中间状态
+		if ctx.Err() != nil {
+			break
+		}
+
+		consolidated, err := s.consolidate(ctx, c)
+		if err != nil {
+			// 单个用户失败不影响其他用户
+			log.Error().
+				Err(err).
+				Str("user_id", c.userID).
+				Int("token_id", c.tokenID).
+				Msg("Failed to consolidate dust balance")
+			continue
+		}
+		if consolidated {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// findCandidates 查找已授权用户中余额大于 0、低于代币最小提现金额且长期无变动的余额
+func (s *service) findCandidates(ctx context.Context) ([]candidate, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT c.user_id, c.token_id
+		FROM credits c
+		INNER JOIN dust_consolidation_consents dc ON dc.user_id = c.user_id AND dc.revoked_at IS NULL
+		INNER JOIN tokens t ON t.id = c.token_id
+		WHERE c.user_id <> $1
+		GROUP BY c.user_id, c.token_id, t.min_withdraw_amount
+		HAVING SUM(CASE WHEN `+balance.EffectiveCreditSQL+` THEN `+balance.TokenAmountSQL+` ELSE 0 END) > 0
+			AND SUM(CASE WHEN `+balance.EffectiveCreditSQL+` THEN `+balance.TokenAmountSQL+` ELSE 0 END)
+				< COALESCE(NULLIF(t.min_withdraw_amount, ''), '0')::numeric
+			AND MAX(c.created_at) < $2
+	`, s.config.AccountUserID, time.Now().Add(-s.config.MinIdle))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query dust candidates")
+	}
+	defer rows.Close()
+
+	var candidates []candidate
+	for rows.Next() {
+		var c candidate
+		if err := rows.Scan(&c.userID, &c.tokenID); err != nil {
+			return nil, errors.Wrap(err, "failed to scan dust candidate")
+		}
+		candidates = append(candidates, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate dust candidates")
+	}
+
+	return candidates, nil
+}
+
+// consolidate 归集单个用户的代币余额
+// 在事务中锁定用户和授权记录并重新计算余额，写入归集记录以及一对 credits
+func (s *service) consolidate(ctx context.Context, c candidate) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// 锁定用户后再读取余额，与提现、内部转账使用同一把锁，防止并发扣减后归集超出余额
+	if _, err := tx.ExecContext(ctx, `SELECT id FROM users WHERE id = $1 FOR UPDATE`, c.userID); err != nil {
+		return false, errors.Wrap(err, "failed to lock user for dust consolidation")
+	}
+
+	// 锁定授权记录，防止归集期间用户撤销授权
+	var revokedAt sql.NullTime
+	if err := tx.QueryRowContext(ctx, `
+		SELECT revoked_at FROM dust_consolidation_consents WHERE user_id = $1 FOR UPDATE
+	`, c.userID).Scan(&revokedAt); err != nil {
+		return false, errors.Wrap(err, "failed to lock dust consolidation consent")
+	}
+	if revokedAt.Valid {
+		return false, nil
+	}
+
+	token, err := models.Tokens(models.TokenWhere.ID.EQ(c.tokenID)).One(ctx, tx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get token")
+	}
+
+	threshold := "0"
+	if token.MinWithdrawAmount.Valid && token.MinWithdrawAmount.String != "" {
+		threshold = token.MinWithdrawAmount.String
+	}
+
+	// 充值 credits 为代币最小单位，按代币精度换算后与其他 credits（代币单位）汇总
+	var balanceStr string
+	if err := tx.QueryRowContext(ctx, `
+		SELECT COALESCE(SUM(`+balance.TokenAmountSQL+`), 0)::text
+		FROM credits
+		JOIN tokens t ON t.id = credits.token_id
+		WHERE credits.user_id = $1 AND credits.token_id = $2 AND `+balance.EffectiveCreditSQL+`
+	`, c.userID, c.tokenID).Scan(&balanceStr); err != nil {
+		return false, errors.Wrap(err, "failed to get balance")
+	}
+
+	available, err := money.ParseDecimal(balanceStr)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to parse balance: %s", balanceStr)
+	}
+	thresholdAmount, err := money.ParseDecimal(threshold)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to parse min withdraw amount: %s", threshold)
+	}
+
+	// 余额已变化（不再是 dust），跳过
+	if available.Sign() <= 0 || available.Cmp(thresholdAmount) >= 0 {
+		return false, nil
+	}
+	amount := money.Format(available, token.Decimals)
+
+	// 链上资金仍在用户充值地址上（等待归集），credits 使用用户地址
+	userWallet, err := models.Wallets(
+		models.WalletWhere.UserID.EQ(c.userID),
+		models.WalletWhere.ChainID.EQ(token.ChainID),
+		models.WalletWhere.WalletType.NEQ(models.WalletTypeWatch),
+	).One(ctx, tx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to find user wallet for credit record")
+	}
+
+	var consolidationID string
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO dust_consolidations (user_id, account_user_id, token_id, chain_id, amount, threshold)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`, c.userID, s.config.AccountUserID, token.ID, token.ChainID, amount, threshold).Scan(&consolidationID); err != nil {
+		return false, errors.Wrap(err, "failed to insert dust consolidation")
+	}
+
+	credits := []*models.Credit{
+		{
+			UserID: c.userID,
+			Amount: "-" + amount, // 用户扣减
+		},
+		{
+			UserID: s.config.AccountUserID,
+			Amount: amount, // 归集账户入账
+		},
+	}
+	for _, credit := range credits {
+		credit.Address = userWallet.Address
+		credit.TokenID = token.ID
+		credit.TokenSymbol = token.TokenSymbol
+		credit.CreditType = models.CreditTypeDustConsolidation
+		credit.BusinessType = models.BusinessTypeInternalTransfer
+		credit.ReferenceID = consolidationID
+		credit.ReferenceType = models.ReferenceTypeDustConsolidation
+		credit.ChainID = null.IntFrom(token.ChainID)
+		credit.ChainType = null.StringFrom(token.ChainType)
+		credit.Status = models.CreditStatusFinalized
+
+		if err := credit.Insert(ctx, tx, boil.Infer()); err != nil {
+			return false, errors.Wrap(err, "failed to insert dust consolidation credit")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, errors.Wrap(err, "failed to commit transaction")
+	}
+
+	log.Info().
+		Str("consolidation_id", consolidationID).
+		Str("user_id", c.userID).
+		Int("token_id", token.ID).
+		Str("amount", amount).
+		Str("threshold", threshold).
+		Msg("Dust balance consolidated")
+
+	return true, nil
+}
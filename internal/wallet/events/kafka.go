@@ -0,0 +1,108 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// kafkaRequestTimeout Kafka REST Proxy 请求超时时间
+const kafkaRequestTimeout = 30 * time.Second
+
+// kafkaContentType Kafka REST Proxy v2 JSON 格式
+const kafkaContentType = "application/vnd.kafka.json.v2+json"
+
+// kafkaPublisher 通过 Kafka REST Proxy（Confluent REST Proxy v2 API）发布事件
+// 以 aggregate_id 作为消息 key，同一聚合的事件进入同一分区并保持顺序
+type kafkaPublisher struct {
+	endpoint string
+	client   *http.Client
+}
+
+type kafkaRecord struct {
+	Key   string `json:"key"`
+	Value *Event `json:"value"`
+}
+
+type kafkaProduceRequest struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaProduceResponse struct {
+	Offsets []struct {
+		Partition *int   `json:"partition"`
+		Offset    *int64 `json:"offset"`
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+func newKafkaPublisher(proxyURL string, topic string) (*kafkaPublisher, error) {
+	endpoint, err := url.JoinPath(proxyURL, "topics", url.PathEscape(topic))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid kafka REST proxy URL")
+	}
+
+	return &kafkaPublisher{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: kafkaRequestTimeout},
+	}, nil
+}
+
+func (p *kafkaPublisher) Publish(ctx context.Context, events []*Event) error {
+	records := make([]kafkaRecord, 0, len(events))
+	for _, event := range events {
+		records = append(records, kafkaRecord{Key: event.AggregateID, Value: event})
+	}
+
+	body, err := json.Marshal(kafkaProduceRequest{Records: records})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal kafka records")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to create kafka produce request")
+	}
+	req.Header.Set("Content-Type", kafkaContentType)
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send kafka produce request")
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read kafka produce response")
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("kafka REST proxy returned status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	// 单条消息写入失败时整批重试，消费方按事件 id 去重
+	var produceResp kafkaProduceResponse
+	if err := json.Unmarshal(respBody, &produceResp); err != nil {
+		return errors.Wrap(err, "failed to decode kafka produce response")
+	}
+	for i, offset := range produceResp.Offsets {
+		if offset.ErrorCode != nil || offset.Error != "" {
+			return errors.Errorf("kafka rejected record %d: %s", i, offset.Error)
+		}
+	}
+
+	return nil
+}
+
+func (p *kafkaPublisher) Close() error {
+	p.client.CloseIdleConnections()
+	return nil
+}
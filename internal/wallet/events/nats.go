@@ -0,0 +1,213 @@
+package events
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// natsTimeout NATS 连接和单批发布的超时时间
+const natsTimeout = 30 * time.Second
+
+// natsPublisher 使用 NATS 核心协议发布事件，主题为 "<前缀>.<事件类型>"
+// 每批消息后发送 PING 并等待 PONG，确认服务端已处理该批消息；连接出错时关闭，下一批重新连接
+type natsPublisher struct {
+	url     *url.URL
+	subject string
+
+	mu         sync.Mutex
+	conn       net.Conn
+	reader     *bufio.Reader
+	maxPayload int
+}
+
+type natsInfo struct {
+	MaxPayload int `json:"max_payload"`
+}
+
+type natsConnect struct {
+	Verbose   bool   `json:"verbose"`
+	Pedantic  bool   `json:"pedantic"`
+	Name      string `json:"name"`
+	Lang      string `json:"lang"`
+	Version   string `json:"version"`
+	User      string `json:"user,omitempty"`
+	Pass      string `json:"pass,omitempty"`
+	AuthToken string `json:"auth_token,omitempty"`
+}
+
+func newNATSPublisher(serverURL string, subject string) (*natsPublisher, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid NATS URL")
+	}
+
+	return &natsPublisher{url: u, subject: subject}, nil
+}
+
+func (p *natsPublisher) Publish(ctx context.Context, events []*Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.publish(ctx, events); err != nil {
+		p.closeConn()
+		return err
+	}
+
+	return nil
+}
+
+func (p *natsPublisher) publish(ctx context.Context, events []*Event) error {
+	if p.conn == nil {
+		if err := p.connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(natsTimeout)
+	}
+	if err := p.conn.SetDeadline(deadline); err != nil {
+		return errors.Wrap(err, "failed to set NATS deadline")
+	}
+
+	w := bufio.NewWriter(p.conn)
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal event")
+		}
+		if p.maxPayload > 0 && len(payload) > p.maxPayload {
+			return errors.Errorf("event %d exceeds NATS max payload (%d > %d bytes)", event.ID, len(payload), p.maxPayload)
+		}
+
+		_, _ = w.WriteString("PUB " + p.subject + "." + event.Type + " " + strconv.Itoa(len(payload)) + "\r\n")
+		_, _ = w.Write(payload)
+		_, _ = w.WriteString("\r\n")
+	}
+	_, _ = w.WriteString("PING\r\n")
+	if err := w.Flush(); err != nil {
+		return errors.Wrap(err, "failed to write NATS messages")
+	}
+
+	return p.waitPong()
+}
+
+// connect 建立连接：读取服务端 INFO，发送 CONNECT（URL 中的用户名密码，或只有用户名时作为 token），并用 PING/PONG 确认认证成功
+func (p *natsPublisher) connect(ctx context.Context) error {
+	dialer := &net.Dialer{Timeout: natsTimeout}
+
+	var conn net.Conn
+	var err error
+	if p.url.Scheme == "tls" {
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: &tls.Config{
+			ServerName: p.url.Hostname(),
+			MinVersion: tls.VersionTLS12,
+		}}
+		conn, err = tlsDialer.DialContext(ctx, "tcp", p.url.Host)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", p.url.Host)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to NATS")
+	}
+
+	p.conn = conn
+	p.reader = bufio.NewReader(conn)
+
+	if err := conn.SetDeadline(time.Now().Add(natsTimeout)); err != nil {
+		return errors.Wrap(err, "failed to set NATS deadline")
+	}
+
+	line, err := p.readLine()
+	if err != nil {
+		return errors.Wrap(err, "failed to read NATS INFO")
+	}
+	infoJSON, found := strings.CutPrefix(line, "INFO ")
+	if !found {
+		return errors.Errorf("unexpected NATS greeting %q", line)
+	}
+	var info natsInfo
+	if err := json.Unmarshal([]byte(infoJSON), &info); err != nil {
+		return errors.Wrap(err, "failed to decode NATS INFO")
+	}
+	p.maxPayload = info.MaxPayload
+
+	connect := natsConnect{Name: "go-wallet-events", Lang: "go", Version: "1.0.0"}
+	if p.url.User != nil {
+		if pass, ok := p.url.User.Password(); ok {
+			connect.User = p.url.User.Username()
+			connect.Pass = pass
+		} else {
+			connect.AuthToken = p.url.User.Username()
+		}
+	}
+	connectJSON, err := json.Marshal(connect)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal NATS CONNECT")
+	}
+
+	if _, err := conn.Write([]byte("CONNECT " + string(connectJSON) + "\r\nPING\r\n")); err != nil {
+		return errors.Wrap(err, "failed to send NATS CONNECT")
+	}
+
+	return p.waitPong()
+}
+
+// waitPong 等待 PONG，期间回复服务端的 PING，服务端返回 -ERR 时报错
+func (p *natsPublisher) waitPong() error {
+	for {
+		line, err := p.readLine()
+		if err != nil {
+			return errors.Wrap(err, "failed to read NATS response")
+		}
+
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := p.conn.Write([]byte("PONG\r\n")); err != nil {
+				return errors.Wrap(err, "failed to send NATS PONG")
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return errors.Errorf("NATS error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		default:
+			// +OK、INFO（集群拓扑变化）等无需处理
+		}
+	}
+}
+
+func (p *natsPublisher) readLine() (string, error) {
+	line, err := p.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func (p *natsPublisher) closeConn() {
+	if p.conn != nil {
+		_ = p.conn.Close()
+		p.conn = nil
+		p.reader = nil
+	}
+}
+
+func (p *natsPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closeConn()
+	return nil
+}
@@ -0,0 +1,27 @@
+package events
+
+import (
+	"github.com/pkg/errors"
+)
+
+// 发布器类型（与配置 WALLET_EVENTS_PUBLISHER 一致）
+const (
+	PublisherKafka = "kafka"
+	PublisherNATS  = "nats"
+)
+
+// NewPublisher 创建事件发布器，kind 为空时返回 nil（不发布，事件仍记录在发件箱中）
+//
+//nolint:ireturn
+func NewPublisher(kind string, url string, topic string) (Publisher, error) {
+	switch kind {
+	case "":
+		return nil, nil //nolint:nilnil // 未配置发布器
+	case PublisherKafka:
+		return newKafkaPublisher(url, topic)
+	case PublisherNATS:
+		return newNATSPublisher(url, topic)
+	default:
+		return nil, errors.Errorf("unknown event publisher %q", kind)
+	}
+}
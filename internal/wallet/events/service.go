@@ -0,0 +1,352 @@
+//nolint:ireturn
+package events
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"slices"
+	"time"
+
+	walletMetrics "github/chapool/go-wallet/internal/metrics/wallet"
+	"github/chapool/go-wallet/internal/util/lifecycle"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// cleanupInterval 发件箱过期事件的清理间隔
+const cleanupInterval = time.Hour
+
+// maxLastErrorLength last_error 最大保存长度
+const maxLastErrorLength = 1000
+
+// Service 钱包领域事件服务：从发件箱（wallet_events）按顺序发布事件，并支持历史事件补发
+type Service interface {
+	// StartPublisher 启动发布 worker，定时发布未发布的事件、更新投递指标并清理过期事件
+	StartPublisher(ctx context.Context, interval time.Duration)
+
+	// PublishPending 发布发件箱中所有未发布的事件，返回发布成功的事件数
+	PublishPending(ctx context.Context) (int, error)
+
+	// Replay 将 [from, to) 内已发布的事件重新标记为未发布，由发布器重新投递，返回事件数
+	Replay(ctx context.Context, from, to time.Time) (int64, error)
+
+	// Snapshot 为 [From, To) 内创建的记录生成 snapshot 事件（用于数据仓库初始化或修复），返回事件数
+	Snapshot(ctx context.Context, req *SnapshotRequest) (int64, error)
+}
+
+// snapshotSources 支持快照补发的实体及其来源表和过滤条件
+var snapshotSources = map[string]struct {
+	table     string
+	condition string
+}{
+	EntityDeposit:  {table: "transactions", condition: "type = 'deposit'"},
+	EntitySweep:    {table: "transactions", condition: "type = 'collect'"},
+	EntityCredit:   {table: "credits", condition: "TRUE"},
+	EntityWithdraw: {table: "withdraws", condition: "TRUE"},
+}
+
+// snapshotEntities 快照补发的默认实体顺序
+var snapshotEntities = []string{EntityDeposit, EntityCredit, EntityWithdraw, EntitySweep}
+
+type service struct {
+	db        *sql.DB
+	config    Config
+	publisher Publisher // 为 nil 时不发布
+}
+
+// NewService 创建事件服务，publisher 为 nil 时只清理过期事件
+func NewService(db *sql.DB, config Config, publisher Publisher) Service {
+	return &service{
+		db:        db,
+		config:    config,
+		publisher: publisher,
+	}
+}
+
+// StartPublisher 启动发布 worker
+func (s *service) StartPublisher(ctx context.Context, interval time.Duration) {
+	lifecycle.Go(ctx, "event publisher", func() {
+		log.Info().
+			Bool("publisher_enabled", s.publisher != nil).
+			Dur("interval", interval).
+			Msg("Starting wallet event publisher")
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		var lastCleanup time.Time
+		for {
+			if s.publisher != nil {
+				if _, err := s.PublishPending(ctx); err != nil {
+					log.Error().Err(err).Msg("Failed to publish wallet events")
+				}
+				s.updateLagMetrics(ctx)
+			}
+
+			if time.Since(lastCleanup) >= cleanupInterval {
+				if err := s.cleanup(ctx); err != nil {
+					log.Error().Err(err).Msg("Failed to clean up wallet events")
+				}
+				lastCleanup = time.Now()
+			}
+
+			select {
+			case <-ctx.Done():
+				if s.publisher != nil {
+					_ = s.publisher.Close()
+				}
+				log.Info().Msg("Wallet event publisher stopped")
+				return
+			case <-ticker.C:
+			}
+		}
+	})
+}
+
+// PublishPending 按 id 顺序分批发布未发布的事件，某批失败时停止，下一轮从该批重试
+func (s *service) PublishPending(ctx context.Context) (int, error) {
+	if s.publisher == nil {
+		return 0, nil
+	}
+
+	total := 0
+	for {
+		n, err := s.publishBatch(ctx)
+		total += n
+		if err != nil {
+			return total, err
+		}
+		if n < s.config.BatchSize {
+			return total, nil
+		}
+	}
+}
+
+// publishBatch 锁定一批未发布的事件（SKIP LOCKED，多实例部署时互不阻塞）并发布，在同一事务中标记发布结果
+// 投递语义为至少一次：发布成功但提交失败时事件会被重新发布，消费方按事件 id 去重
+func (s *service) publishBatch(ctx context.Context) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	events, err := queryEvents(ctx, tx, s.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]int64, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+
+	if publishErr := s.publisher.Publish(ctx, events); publishErr != nil {
+		walletMetrics.EventPublishErrors.Inc()
+
+		lastError := publishErr.Error()
+		if len(lastError) > maxLastErrorLength {
+			lastError = lastError[:maxLastErrorLength]
+		}
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE wallet_events SET publish_attempts = publish_attempts + 1, last_error = $1 WHERE id = ANY($2)
+		`, lastError, pq.Array(ids)); err != nil {
+			return 0, errors.Wrap(err, "failed to record wallet event publish error")
+		}
+		if err := tx.Commit(); err != nil {
+			return 0, errors.Wrap(err, "failed to commit wallet event publish error")
+		}
+
+		return 0, errors.Wrapf(publishErr, "failed to publish wallet events %d-%d", ids[0], ids[len(ids)-1])
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE wallet_events SET published_at = NOW(), publish_attempts = publish_attempts + 1, last_error = NULL WHERE id = ANY($1)
+	`, pq.Array(ids)); err != nil {
+		return 0, errors.Wrap(err, "failed to mark wallet events as published")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, errors.Wrap(err, "failed to commit published wallet events")
+	}
+
+	for _, event := range events {
+		walletMetrics.EventsPublished.WithLabelValues(event.Type).Inc()
+	}
+
+	log.Debug().
+		Int64("from_id", ids[0]).
+		Int64("to_id", ids[len(ids)-1]).
+		Int("count", len(events)).
+		Msg("Wallet events published")
+
+	return len(events), nil
+}
+
+// queryEvents 查询并锁定一批未发布的事件
+func queryEvents(ctx context.Context, tx *sql.Tx, limit int) ([]*Event, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id, event_type, schema_version, aggregate_id, chain_id, payload, occurred_at
+		FROM wallet_events
+		WHERE published_at IS NULL
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query unpublished wallet events")
+	}
+	defer rows.Close()
+
+	events := make([]*Event, 0, limit)
+	for rows.Next() {
+		var event Event
+		var chainID sql.NullInt64
+		var payload []byte
+		if err := rows.Scan(&event.ID, &event.Type, &event.SchemaVersion, &event.AggregateID, &chainID, &payload, &event.OccurredAt); err != nil {
+			return nil, errors.Wrap(err, "failed to scan wallet event")
+		}
+		if chainID.Valid {
+			id := int(chainID.Int64)
+			event.ChainID = &id
+		}
+		event.Data = json.RawMessage(payload)
+		events = append(events, &event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate wallet events")
+	}
+
+	return events, nil
+}
+
+// updateLagMetrics 更新待发布事件数和最早未发布事件的等待时间
+func (s *service) updateLagMetrics(ctx context.Context) {
+	var pending int64
+	var oldest sql.NullTime
+	err := s.db.QueryRowContext(ctx, `
+		SELECT COUNT(*), MIN(occurred_at) FROM wallet_events WHERE published_at IS NULL
+	`).Scan(&pending, &oldest)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to query wallet event lag")
+		return
+	}
+
+	walletMetrics.EventsPending.Set(float64(pending))
+	if oldest.Valid {
+		walletMetrics.EventsLagSeconds.Set(time.Since(oldest.Time).Seconds())
+	} else {
+		walletMetrics.EventsLagSeconds.Set(0)
+	}
+}
+
+// cleanup 删除超过保留时间的事件；配置了发布器时只删除已发布的事件，避免丢失未投递的事件
+func (s *service) cleanup(ctx context.Context) error {
+	result, err := s.db.ExecContext(ctx, `
+		DELETE FROM wallet_events
+		WHERE occurred_at < $1 AND (published_at IS NOT NULL OR $2)
+	`, time.Now().Add(-s.config.Retention), s.publisher == nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete expired wallet events")
+	}
+
+	if deleted, _ := result.RowsAffected(); deleted > 0 {
+		log.Info().Int64("deleted", deleted).Msg("Expired wallet events deleted")
+	}
+
+	return nil
+}
+
+// Replay 将 [from, to) 内已发布的事件重新标记为未发布
+func (s *service) Replay(ctx context.Context, from, to time.Time) (int64, error) {
+	if !from.Before(to) {
+		return 0, errors.New("from must be before to")
+	}
+
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE wallet_events SET published_at = NULL, last_error = NULL
+		WHERE occurred_at >= $1 AND occurred_at < $2 AND published_at IS NOT NULL
+	`, from, to)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to reset published wallet events")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get replayed wallet event count")
+	}
+
+	log.Info().
+		Time("from", from).
+		Time("to", to).
+		Int64("count", count).
+		Msg("Wallet events scheduled for replay")
+
+	return count, nil
+}
+
+// Snapshot 为 [From, To) 内创建的记录生成 snapshot 事件，payload 为记录的当前状态
+func (s *service) Snapshot(ctx context.Context, req *SnapshotRequest) (int64, error) {
+	if !req.From.Before(req.To) {
+		return 0, errors.New("from must be before to")
+	}
+
+	entities := req.Entities
+	if len(entities) == 0 {
+		entities = snapshotEntities
+	}
+	for _, entity := range entities {
+		if _, ok := snapshotSources[entity]; !ok {
+			return 0, errors.Errorf("snapshot is not supported for entity %q, supported: %v", entity, snapshotEntities)
+		}
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var total int64
+	for _, entity := range snapshotEntities {
+		if !slices.Contains(entities, entity) {
+			continue
+		}
+		source := snapshotSources[entity]
+
+		//nolint:gosec // 表名和条件来自固定的 snapshotSources
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO wallet_events (event_type, schema_version, aggregate_id, chain_id, payload)
+			SELECT $1, $2, r.id::text, r.chain_id, to_jsonb(r)
+			FROM `+source.table+` r
+			WHERE `+source.condition+` AND r.created_at >= $3 AND r.created_at < $4
+			ORDER BY r.created_at
+		`, entity+"."+ActionSnapshot, SchemaVersion, req.From, req.To)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to create %s snapshot events", entity)
+		}
+
+		count, err := result.RowsAffected()
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to get %s snapshot event count", entity)
+		}
+		total += count
+
+		log.Info().
+			Str("entity", entity).
+			Int64("count", count).
+			Msg("Wallet snapshot events created")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, errors.Wrap(err, "failed to commit snapshot events")
+	}
+
+	return total, nil
+}
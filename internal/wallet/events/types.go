@@ -0,0 +1,59 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// 事件实体，事件类型为 "<实体>.<动作>"，如 deposit.created、withdraw.status_changed
+const (
+	EntityDeposit  = "deposit"  // 充值交易（transactions.type = 'deposit'）
+	EntityCredit   = "credit"   // 资金流水
+	EntityWithdraw = "withdraw" // 提现
+	EntitySweep    = "sweep"    // 归集交易（transactions.type = 'collect'）
+	EntityReorg    = "reorg"    // 区块重组（区块被标记为 orphaned）
+)
+
+// 事件动作
+const (
+	ActionCreated       = "created"
+	ActionStatusChanged = "status_changed"
+	ActionSnapshot      = "snapshot" // 补发的历史数据快照
+	ActionBlockOrphaned = "block_orphaned"
+)
+
+// SchemaVersion 当前事件结构版本，与迁移中触发器写入的 schema_version 一致
+const SchemaVersion = 1
+
+// Event 钱包领域事件（发布的 JSON 消息体）
+type Event struct {
+	ID            int64           `json:"id"` // 发件箱 ID，全局递增，消费方用于去重
+	Type          string          `json:"type"`
+	SchemaVersion int             `json:"schema_version"`
+	AggregateID   string          `json:"aggregate_id"`
+	ChainID       *int            `json:"chain_id,omitempty"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	Data          json.RawMessage `json:"data"`
+}
+
+// Publisher 事件发布通道
+type Publisher interface {
+	// Publish 发布一批事件，返回 nil 表示所有事件都已被消息系统接收
+	Publish(ctx context.Context, events []*Event) error
+	Close() error
+}
+
+// SnapshotRequest 历史数据快照补发请求，为 [From, To) 内创建的记录生成 snapshot 事件
+type SnapshotRequest struct {
+	Entities []string // 为空时补发所有支持快照的实体（deposit、credit、withdraw、sweep）
+	From     time.Time
+	To       time.Time
+}
+
+// Config 事件服务配置
+type Config struct {
+	BatchSize int
+	// Retention 发件箱保留时间，未配置发布器时未发布的事件也会被清理
+	Retention time.Duration
+}
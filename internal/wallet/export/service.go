@@ -0,0 +1,351 @@
+package export
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+)
+
+// batchSize 每批从数据库读取的记录数，导出时不一次性加载全部记录
+const batchSize = 1000
+
+var (
+	// ErrTokenNotFound 筛选的代币不存在
+	ErrTokenNotFound = walleterrors.ErrTokenNotFound
+)
+
+// 导出文件的表头
+var (
+	//nolint:gochecknoglobals // 固定表头
+	transactionHeader = []string{"id", "chain_id", "tx_hash", "block_no", "from_addr", "to_addr", "token_symbol", "token_addr", "amount", "status", "confirmation_count", "created_at"}
+	//nolint:gochecknoglobals // 固定表头
+	withdrawHeader = []string{"id", "user_id", "chain_id", "token_id", "token_symbol", "to_address", "amount", "fee", "status", "tx_hash", "created_at", "updated_at"}
+)
+
+// Service 充值、提现和归集记录导出服务接口
+// 记录按创建时间倒序分批读取并逐行写出，导出大量记录时不占用大量内存
+type Service interface {
+	// ExportDeposits 导出充值交易，UserID 为空时导出全部用户
+	ExportDeposits(ctx context.Context, filter *Filter, w Writer) error
+
+	// ExportWithdraws 导出提现记录，UserID 为空时导出全部用户
+	ExportWithdraws(ctx context.Context, filter *Filter, w Writer) error
+
+	// ExportCollects 导出归集交易，UserID 为空时导出全部用户
+	ExportCollects(ctx context.Context, filter *Filter, w Writer) error
+}
+
+// Filter 导出条件，字段为空表示不限
+type Filter struct {
+	UserID        *string // 只导出该用户钱包地址相关的记录
+	ChainID       *int
+	TokenID       *int // 原生代币匹配 token_addr 为空的交易
+	Status        *string
+	CreatedAfter  *time.Time // 包含
+	CreatedBefore *time.Time // 不包含
+}
+
+type service struct {
+	db *sql.DB
+}
+
+// NewService 创建导出服务
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(db *sql.DB) Service {
+	return &service{db: db}
+}
+
+// ExportDeposits 导出充值交易（按接收地址匹配用户钱包）
+func (s *service) ExportDeposits(ctx context.Context, filter *Filter, w Writer) error {
+	return s.exportTransactions(ctx, models.TransactionTypeDeposit, models.TransactionColumns.ToAddr, filter, w)
+}
+
+// ExportCollects 导出归集交易（按发送地址匹配用户钱包）
+func (s *service) ExportCollects(ctx context.Context, filter *Filter, w Writer) error {
+	return s.exportTransactions(ctx, models.TransactionTypeCollect, models.TransactionColumns.FromAddr, filter, w)
+}
+
+func (s *service) exportTransactions(ctx context.Context, txType models.TransactionType, userAddrColumn string, filter *Filter, w Writer) error {
+	mods := []qm.QueryMod{
+		models.TransactionWhere.Type.EQ(txType),
+	}
+
+	if filter.UserID != nil {
+		addresses, err := s.userAddresses(ctx, *filter.UserID, filter.ChainID)
+		if err != nil {
+			return err
+		}
+		if len(addresses) == 0 {
+			// 用户没有钱包，只导出表头
+			return writeHeader(w, transactionHeader)
+		}
+		mods = append(mods, qm.WhereIn(userAddrColumn+" IN ?", toInterfaces(addresses)...))
+	}
+	if filter.ChainID != nil {
+		mods = append(mods, models.TransactionWhere.ChainID.EQ(*filter.ChainID))
+	}
+	if filter.TokenID != nil {
+		token, err := s.findToken(ctx, *filter.TokenID)
+		if err != nil {
+			return err
+		}
+		mods = append(mods, models.TransactionWhere.ChainID.EQ(token.ChainID))
+		if token.IsNative {
+			mods = append(mods, models.TransactionWhere.TokenAddr.IsNull())
+		} else {
+			mods = append(mods, models.TransactionWhere.TokenAddr.EQ(token.TokenAddress))
+		}
+	}
+	if filter.Status != nil {
+		mods = append(mods, models.TransactionWhere.Status.EQ(models.TransactionStatus(*filter.Status)))
+	}
+	if filter.CreatedAfter != nil {
+		mods = append(mods, models.TransactionWhere.CreatedAt.GTE(*filter.CreatedAfter))
+	}
+	if filter.CreatedBefore != nil {
+		mods = append(mods, models.TransactionWhere.CreatedAt.LT(*filter.CreatedBefore))
+	}
+
+	symbols, err := s.tokenSymbols(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := writeHeader(w, transactionHeader); err != nil {
+		return err
+	}
+
+	var last *models.Transaction
+	for {
+		batchMods := append([]qm.QueryMod{}, mods...)
+		if last != nil {
+			batchMods = append(batchMods, qm.Where("("+models.TransactionColumns.CreatedAt+", "+models.TransactionColumns.ID+") < (?, ?)", last.CreatedAt, last.ID))
+		}
+		batchMods = append(batchMods,
+			qm.OrderBy(models.TransactionColumns.CreatedAt+" DESC, "+models.TransactionColumns.ID+" DESC"),
+			qm.Limit(batchSize),
+		)
+
+		transactions, err := models.Transactions(batchMods...).All(ctx, s.db)
+		if err != nil {
+			return errors.Wrap(err, "failed to query transactions")
+		}
+
+		for _, tx := range transactions {
+			if err := w.WriteRow(transactionRow(tx, symbols)); err != nil {
+				return errors.Wrap(err, "failed to write row")
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return errors.Wrap(err, "failed to flush rows")
+		}
+
+		if len(transactions) < batchSize {
+			return nil
+		}
+		last = transactions[len(transactions)-1]
+	}
+}
+
+// ExportWithdraws 导出提现记录
+func (s *service) ExportWithdraws(ctx context.Context, filter *Filter, w Writer) error {
+	var mods []qm.QueryMod
+
+	if filter.UserID != nil {
+		mods = append(mods, models.WithdrawWhere.UserID.EQ(*filter.UserID))
+	}
+	if filter.ChainID != nil {
+		mods = append(mods, models.WithdrawWhere.ChainID.EQ(*filter.ChainID))
+	}
+	if filter.TokenID != nil {
+		mods = append(mods, models.WithdrawWhere.TokenID.EQ(*filter.TokenID))
+	}
+	if filter.Status != nil {
+		mods = append(mods, models.WithdrawWhere.Status.EQ(models.WithdrawStatus(*filter.Status)))
+	}
+	if filter.CreatedAfter != nil {
+		mods = append(mods, models.WithdrawWhere.CreatedAt.GTE(*filter.CreatedAfter))
+	}
+	if filter.CreatedBefore != nil {
+		mods = append(mods, models.WithdrawWhere.CreatedAt.LT(*filter.CreatedBefore))
+	}
+
+	tokens, err := models.Tokens().All(ctx, s.db)
+	if err != nil {
+		return errors.Wrap(err, "failed to load tokens")
+	}
+	symbolsByID := make(map[int]string, len(tokens))
+	for _, token := range tokens {
+		symbolsByID[token.ID] = token.TokenSymbol
+	}
+
+	if err := writeHeader(w, withdrawHeader); err != nil {
+		return err
+	}
+
+	var last *models.Withdraw
+	for {
+		batchMods := append([]qm.QueryMod{}, mods...)
+		if last != nil {
+			batchMods = append(batchMods, qm.Where("("+models.WithdrawColumns.CreatedAt+", "+models.WithdrawColumns.ID+") < (?, ?)", last.CreatedAt, last.ID))
+		}
+		batchMods = append(batchMods,
+			qm.OrderBy(models.WithdrawColumns.CreatedAt+" DESC, "+models.WithdrawColumns.ID+" DESC"),
+			qm.Limit(batchSize),
+		)
+
+		withdraws, err := models.Withdraws(batchMods...).All(ctx, s.db)
+		if err != nil {
+			return errors.Wrap(err, "failed to query withdraws")
+		}
+
+		for _, withdraw := range withdraws {
+			if err := w.WriteRow(withdrawRow(withdraw, symbolsByID[withdraw.TokenID])); err != nil {
+				return errors.Wrap(err, "failed to write row")
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return errors.Wrap(err, "failed to flush rows")
+		}
+
+		if len(withdraws) < batchSize {
+			return nil
+		}
+		last = withdraws[len(withdraws)-1]
+	}
+}
+
+// userAddresses 获取用户钱包地址（小写，与 transactions 表中的格式一致）
+func (s *service) userAddresses(ctx context.Context, userID string, chainID *int) ([]string, error) {
+	mods := []qm.QueryMod{
+		models.WalletWhere.UserID.EQ(userID),
+	}
+	if chainID != nil {
+		mods = append(mods, models.WalletWhere.ChainID.EQ(*chainID))
+	}
+
+	wallets, err := models.Wallets(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load user wallets")
+	}
+
+	seen := make(map[string]struct{}, len(wallets))
+	addresses := make([]string, 0, len(wallets))
+	for _, wallet := range wallets {
+		address := strings.ToLower(wallet.Address)
+		if _, ok := seen[address]; ok {
+			continue
+		}
+		seen[address] = struct{}{}
+		addresses = append(addresses, address)
+	}
+
+	return addresses, nil
+}
+
+func (s *service) findToken(ctx context.Context, tokenID int) (*models.Token, error) {
+	token, err := models.FindToken(ctx, s.db, tokenID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTokenNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get token")
+	}
+
+	return token, nil
+}
+
+type tokenKey struct {
+	chainID int
+	address string // 原生代币为空
+}
+
+// tokenSymbols 按链和合约地址索引的代币符号
+func (s *service) tokenSymbols(ctx context.Context) (map[tokenKey]string, error) {
+	tokens, err := models.Tokens().All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load tokens")
+	}
+
+	symbols := make(map[tokenKey]string, len(tokens))
+	for _, token := range tokens {
+		key := tokenKey{chainID: token.ChainID}
+		if !token.IsNative {
+			key.address = strings.ToLower(token.TokenAddress.String)
+		}
+		symbols[key] = token.TokenSymbol
+	}
+
+	return symbols, nil
+}
+
+func writeHeader(w Writer, header []string) error {
+	if err := w.WriteRow(header); err != nil {
+		return errors.Wrap(err, "failed to write header")
+	}
+
+	return nil
+}
+
+//nolint:varnamelen // tx is a common abbreviation for transaction
+func transactionRow(tx *models.Transaction, symbols map[tokenKey]string) []string {
+	key := tokenKey{chainID: tx.ChainID}
+	if tx.TokenAddr.Valid {
+		key.address = strings.ToLower(tx.TokenAddr.String)
+	}
+
+	confirmationCount := ""
+	if tx.ConfirmationCount.Valid {
+		confirmationCount = strconv.Itoa(tx.ConfirmationCount.Int)
+	}
+
+	return []string{
+		tx.ID,
+		strconv.Itoa(tx.ChainID),
+		tx.TXHash,
+		strconv.FormatInt(tx.BlockNo, 10),
+		tx.FromAddr,
+		tx.ToAddr,
+		symbols[key],
+		tx.TokenAddr.String,
+		tx.Amount,
+		tx.Status.String(),
+		confirmationCount,
+		tx.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func withdrawRow(withdraw *models.Withdraw, tokenSymbol string) []string {
+	return []string{
+		withdraw.ID,
+		withdraw.UserID,
+		strconv.Itoa(withdraw.ChainID),
+		strconv.Itoa(withdraw.TokenID),
+		tokenSymbol,
+		withdraw.ToAddress,
+		withdraw.Amount,
+		withdraw.Fee,
+		withdraw.Status.String(),
+		withdraw.TXHash.String,
+		withdraw.CreatedAt.UTC().Format(time.RFC3339),
+		withdraw.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func toInterfaces(values []string) []interface{} {
+	result := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		result = append(result, v)
+	}
+
+	return result
+}
@@ -0,0 +1,68 @@
+package export_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+
+	"github/chapool/go-wallet/internal/wallet/export"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVWriter(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	w, err := export.NewWriter(export.FormatCSV, &buf)
+	require.NoError(t, err)
+
+	require.NoError(t, w.WriteRow([]string{"id", "amount"}))
+	require.NoError(t, w.WriteRow([]string{"a,b", "1.5"}))
+	require.NoError(t, w.Close())
+
+	assert.Equal(t, "id,amount\n\"a,b\",1.5\n", buf.String())
+}
+
+func TestXLSXWriter(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	w, err := export.NewWriter(export.FormatXLSX, &buf)
+	require.NoError(t, err)
+
+	require.NoError(t, w.WriteRow([]string{"id", "memo"}))
+	require.NoError(t, w.WriteRow([]string{"1", "<a & b>"}))
+	require.NoError(t, w.Flush())
+	require.NoError(t, w.Close())
+
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := make(map[string]string, len(reader.File))
+	for _, f := range reader.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		files[f.Name] = string(content)
+	}
+
+	assert.Contains(t, files, "[Content_Types].xml")
+	assert.Contains(t, files, "xl/workbook.xml")
+	sheet := files["xl/worksheets/sheet1.xml"]
+	assert.Contains(t, sheet, `<row r="1">`)
+	assert.Contains(t, sheet, `<row r="2">`)
+	assert.Contains(t, sheet, "&lt;a &amp; b&gt;")
+	assert.Contains(t, sheet, "</sheetData></worksheet>")
+}
+
+func TestUnsupportedFormat(t *testing.T) {
+	t.Parallel()
+
+	_, err := export.NewWriter("pdf", io.Discard)
+	require.ErrorIs(t, err, export.ErrUnsupportedFormat)
+}
@@ -0,0 +1,225 @@
+package gasguard
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/settings"
+
+	"github.com/pkg/errors"
+)
+
+// PriceCap 出账交易 gas 价格上限接口
+// 提现、归集和再平衡交易签名前检查 gas 价格（maxFeePerGas，不支持 EIP-1559 的链为 gasPrice），
+// 超过链的上限时不广播，按重试计划推迟；管理员可临时覆盖上限用于紧急出款
+type PriceCap interface {
+	// Caps 链是否配置了 gas 价格上限
+	Caps(chainID int) bool
+
+	// Check 检查交易 gas 价格是否超过链当前的有效上限（管理员覆盖优先于配置），超过时返回 *CapExceededError
+	Check(ctx context.Context, chainID int, fees *scan.GasFees) error
+
+	// RetryAt 第 attempts 次推迟后的下次尝试时间，超出重试计划时使用最后一个间隔
+	RetryAt(attempts int, now time.Time) time.Time
+
+	// DeferChain 推迟链的自动归集和再平衡，返回下次尝试时间
+	DeferChain(chainID int, now time.Time) time.Time
+
+	// ChainDeferred 链的自动归集和再平衡是否仍在推迟中
+	ChainDeferred(chainID int, now time.Time) bool
+
+	// ResumeChain 清除链的推迟状态，交易发送成功后调用
+	ResumeChain(chainID int)
+
+	// GetCaps 获取所有配置了上限的链的上限和当前生效的管理员覆盖
+	GetCaps(ctx context.Context) ([]*CapStatus, error)
+}
+
+// CapConfig gas 价格上限配置
+type CapConfig struct {
+	Caps        []Cap
+	RetryDelays []time.Duration // 第 n 次推迟后等待 RetryDelays[n-1] 再尝试
+}
+
+// Cap 链的 gas 价格上限
+type Cap struct {
+	ChainID     int
+	MaxGasPrice *big.Int // wei
+}
+
+// CapStatus 链的 gas 价格上限和管理员覆盖
+type CapStatus struct {
+	ChainID     int
+	MaxGasPrice *big.Int                      // 配置的上限（wei）
+	Override    *settings.GasPriceCapOverride // 当前生效的覆盖，没有时为空
+}
+
+// CapExceededError 交易 gas 价格超过链的上限
+type CapExceededError struct {
+	ChainID  int
+	GasPrice *big.Int // wei
+	Cap      *big.Int // wei
+}
+
+func (e *CapExceededError) Error() string {
+	return fmt.Sprintf("gas price %s gwei exceeds the cap of %s gwei on chain %d", FormatGwei(e.GasPrice), FormatGwei(e.Cap), e.ChainID)
+}
+
+// IsCapExceeded 判断错误是否为 gas 价格超过上限
+func IsCapExceeded(err error) bool {
+	var capErr *CapExceededError
+	return errors.As(err, &capErr)
+}
+
+// chainDeferral 链的自动操作推迟状态
+type chainDeferral struct {
+	attempts int
+	until    time.Time
+}
+
+type priceCap struct {
+	config          CapConfig
+	settingsService settings.Service
+	caps            map[int]*big.Int
+
+	mu        sync.Mutex
+	deferrals map[int]*chainDeferral
+}
+
+// NewPriceCap 创建 gas 价格上限检查
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewPriceCap(config CapConfig, settingsService settings.Service) PriceCap {
+	caps := make(map[int]*big.Int, len(config.Caps))
+	for _, c := range config.Caps {
+		caps[c.ChainID] = c.MaxGasPrice
+	}
+
+	return &priceCap{
+		config:          config,
+		settingsService: settingsService,
+		caps:            caps,
+		deferrals:       make(map[int]*chainDeferral),
+	}
+}
+
+// Caps 链是否配置了 gas 价格上限
+func (p *priceCap) Caps(chainID int) bool {
+	_, ok := p.caps[chainID]
+	return ok
+}
+
+// Check 检查交易 gas 价格，未配置上限的链不检查；覆盖未设置上限时覆盖期间不检查
+func (p *priceCap) Check(ctx context.Context, chainID int, fees *scan.GasFees) error {
+	maxGasPrice, ok := p.caps[chainID]
+	if !ok {
+		return nil
+	}
+
+	override, err := p.settingsService.GetGasPriceCapOverride(ctx, chainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get gas price cap override")
+	}
+	if override != nil {
+		if override.MaxGasPrice == nil {
+			return nil
+		}
+		maxGasPrice = override.MaxGasPrice
+	}
+
+	if gasPrice := fees.PerGas(); gasPrice.Cmp(maxGasPrice) > 0 {
+		return &CapExceededError{ChainID: chainID, GasPrice: gasPrice, Cap: maxGasPrice}
+	}
+
+	return nil
+}
+
+// RetryAt 第 attempts 次推迟后的下次尝试时间
+func (p *priceCap) RetryAt(attempts int, now time.Time) time.Time {
+	delays := p.config.RetryDelays
+	if len(delays) == 0 {
+		return now
+	}
+
+	idx := min(max(attempts, 1), len(delays)) - 1
+	return now.Add(delays[idx])
+}
+
+// DeferChain 推迟链的自动归集和再平衡，连续推迟时按重试计划逐步拉长间隔
+func (p *priceCap) DeferChain(chainID int, now time.Time) time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	deferral, ok := p.deferrals[chainID]
+	if !ok {
+		deferral = &chainDeferral{}
+		p.deferrals[chainID] = deferral
+	}
+	deferral.attempts++
+	deferral.until = p.RetryAt(deferral.attempts, now)
+
+	return deferral.until
+}
+
+// ChainDeferred 链的自动归集和再平衡是否仍在推迟中
+func (p *priceCap) ChainDeferred(chainID int, now time.Time) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	deferral, ok := p.deferrals[chainID]
+	return ok && now.Before(deferral.until)
+}
+
+// ResumeChain 清除链的推迟状态
+func (p *priceCap) ResumeChain(chainID int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.deferrals, chainID)
+}
+
+// GetCaps 获取所有配置了上限的链的上限和当前生效的管理员覆盖，按配置顺序返回
+func (p *priceCap) GetCaps(ctx context.Context) ([]*CapStatus, error) {
+	overrides, err := p.settingsService.ListGasPriceCapOverrides(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list gas price cap overrides")
+	}
+
+	now := time.Now()
+	active := make(map[int]*settings.GasPriceCapOverride, len(overrides))
+	for _, override := range overrides {
+		if override.ActiveAt(now) {
+			active[override.ChainID] = override
+		}
+	}
+
+	statuses := make([]*CapStatus, 0, len(p.config.Caps))
+	for _, c := range p.config.Caps {
+		statuses = append(statuses, &CapStatus{
+			ChainID:     c.ChainID,
+			MaxGasPrice: c.MaxGasPrice,
+			Override:    active[c.ChainID],
+		})
+	}
+
+	return statuses, nil
+}
+
+// ParseGwei 解析十进制 gwei 数值并转换为 wei（向下取整），结果必须至少为 1 wei
+func ParseGwei(s string) (*big.Int, bool) {
+	v, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, false
+	}
+
+	wei := new(big.Int).Quo(new(big.Int).Mul(v.Num(), big.NewInt(weiPerGwei)), v.Denom())
+	if wei.Sign() <= 0 {
+		return nil, false
+	}
+
+	return wei, true
+}
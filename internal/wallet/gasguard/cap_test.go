@@ -0,0 +1,73 @@
+package gasguard
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceCapRetryAt(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	p := NewPriceCap(CapConfig{RetryDelays: []time.Duration{time.Minute, 5 * time.Minute, 15 * time.Minute}}, nil)
+
+	assert.Equal(t, now.Add(time.Minute), p.RetryAt(1, now))
+	assert.Equal(t, now.Add(5*time.Minute), p.RetryAt(2, now))
+	assert.Equal(t, now.Add(15*time.Minute), p.RetryAt(3, now))
+	// 超出重试计划时使用最后一个间隔
+	assert.Equal(t, now.Add(15*time.Minute), p.RetryAt(10, now))
+}
+
+func TestPriceCapChainDeferral(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	p := NewPriceCap(CapConfig{RetryDelays: []time.Duration{time.Minute, 5 * time.Minute}}, nil)
+
+	assert.False(t, p.ChainDeferred(1, now))
+
+	assert.Equal(t, now.Add(time.Minute), p.DeferChain(1, now))
+	assert.True(t, p.ChainDeferred(1, now))
+	assert.False(t, p.ChainDeferred(56, now))
+	assert.False(t, p.ChainDeferred(1, now.Add(time.Minute)))
+
+	// 连续推迟时拉长间隔
+	later := now.Add(time.Minute)
+	assert.Equal(t, later.Add(5*time.Minute), p.DeferChain(1, later))
+
+	p.ResumeChain(1)
+	assert.False(t, p.ChainDeferred(1, later))
+	assert.Equal(t, later.Add(time.Minute), p.DeferChain(1, later))
+}
+
+func TestPriceCapCheckUncappedChain(t *testing.T) {
+	t.Parallel()
+
+	p := NewPriceCap(CapConfig{Caps: []Cap{{ChainID: 1, MaxGasPrice: big.NewInt(100)}}}, nil)
+
+	assert.True(t, p.Caps(1))
+	assert.False(t, p.Caps(56))
+	require.NoError(t, p.Check(context.Background(), 56, &scan.GasFees{Legacy: true, GasPrice: big.NewInt(1_000)}))
+}
+
+func TestParseGwei(t *testing.T) {
+	t.Parallel()
+
+	wei, ok := ParseGwei("1.5")
+	require.True(t, ok)
+	assert.Equal(t, "1500000000", wei.String())
+
+	_, ok = ParseGwei("0")
+	assert.False(t, ok)
+	_, ok = ParseGwei("0.0000000001")
+	assert.False(t, ok)
+	_, ok = ParseGwei("abc")
+	assert.False(t, ok)
+}
@@ -0,0 +1,286 @@
+package gasguard
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	walletMetrics "github/chapool/go-wallet/internal/metrics/wallet"
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/alert"
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	weiPerGwei   = 1_000_000_000
+	gweiDecimals = 9
+	percent      = 100
+)
+
+// Guard 链 gas 熔断器接口
+// 定期检查配置了 baseFee 上限的链，baseFee 超过上限时暂停该链的自动归集、自动再平衡和 gas 补充，
+// baseFee 回落到上限的 ResumePercent 以下后自动恢复；暂停和恢复时均告警
+type Guard interface {
+	// StartMonitor 启动定时检查，未配置上限时不启动
+	StartMonitor(ctx context.Context, interval time.Duration)
+
+	// Guards 链是否配置了 baseFee 上限
+	Guards(chainID int) bool
+
+	// IsPaused 链的自动操作是否因 baseFee 过高而暂停
+	IsPaused(chainID int) bool
+
+	// GetStatus 获取链的熔断状态，未配置上限时返回 nil
+	GetStatus(chainID int) *Status
+}
+
+// Config gas 熔断配置
+type Config struct {
+	Ceilings      []Ceiling
+	ResumePercent int // baseFee 回落到上限的该百分比以下时恢复，避免在上限附近反复暂停和恢复
+}
+
+// Ceiling 链的 baseFee 上限（不支持 EIP-1559 的链为 gasPrice 上限）
+type Ceiling struct {
+	ChainID    int
+	MaxBaseFee *big.Int // wei
+}
+
+// Status 链的熔断状态快照
+type Status struct {
+	ChainID     int
+	MaxBaseFee  *big.Int   // wei
+	BaseFee     *big.Int   // 最近一次检查的 baseFee（wei），尚未检查成功时为空
+	Paused      bool       // 自动操作是否暂停
+	PausedSince *time.Time // 暂停开始时间
+	CheckedAt   *time.Time // 最近一次检查成功的时间
+}
+
+// chainState 单条链的熔断状态
+type chainState struct {
+	ceiling     Ceiling
+	baseFee     *big.Int
+	paused      bool
+	pausedSince time.Time
+	checkedAt   time.Time
+}
+
+type guard struct {
+	config      Config
+	scanService scan.Service
+	notifier    alert.Notifier
+
+	mu     sync.RWMutex
+	states map[int]*chainState
+}
+
+// NewGuard 创建 gas 熔断器
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewGuard(config Config, scanService scan.Service, notifier alert.Notifier) Guard {
+	states := make(map[int]*chainState, len(config.Ceilings))
+	for _, ceiling := range config.Ceilings {
+		states[ceiling.ChainID] = &chainState{ceiling: ceiling}
+	}
+
+	return &guard{
+		config:      config,
+		scanService: scanService,
+		notifier:    notifier,
+		states:      states,
+	}
+}
+
+// StartMonitor 启动定时检查
+func (g *guard) StartMonitor(ctx context.Context, interval time.Duration) {
+	if len(g.config.Ceilings) == 0 {
+		log.Info().Msg("No gas ceilings configured, gas spike circuit breaker is disabled")
+		return
+	}
+
+	log.Info().
+		Dur("interval", interval).
+		Int("ceilings", len(g.config.Ceilings)).
+		Int("resume_percent", g.config.ResumePercent).
+		Msg("Starting gas spike circuit breaker")
+
+	lifecycle.Go(ctx, "gas guard monitor", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		g.runCheck(ctx)
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Gas spike circuit breaker stopped")
+				return
+			case <-ticker.C:
+				g.runCheck(ctx)
+			}
+		}
+	})
+}
+
+// Guards 链是否配置了 baseFee 上限
+func (g *guard) Guards(chainID int) bool {
+	_, ok := g.states[chainID]
+	return ok
+}
+
+// IsPaused 链的自动操作是否暂停
+func (g *guard) IsPaused(chainID int) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	state, ok := g.states[chainID]
+	return ok && state.paused
+}
+
+// GetStatus 获取链的熔断状态快照
+func (g *guard) GetStatus(chainID int) *Status {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	state, ok := g.states[chainID]
+	if !ok {
+		return nil
+	}
+
+	status := &Status{
+		ChainID:    chainID,
+		MaxBaseFee: state.ceiling.MaxBaseFee,
+		BaseFee:    state.baseFee,
+		Paused:     state.paused,
+	}
+	if state.paused {
+		pausedSince := state.pausedSince
+		status.PausedSince = &pausedSince
+	}
+	if !state.checkedAt.IsZero() {
+		checkedAt := state.checkedAt
+		status.CheckedAt = &checkedAt
+	}
+
+	return status
+}
+
+// runCheck 检查所有配置了上限的链，查询失败时保持原状态
+func (g *guard) runCheck(ctx context.Context) {
+	for _, ceiling := range g.config.Ceilings {
+		baseFee, err := g.getBaseFee(ctx, ceiling.ChainID)
+		if err != nil {
+			log.Warn().Err(err).Int("chain_id", ceiling.ChainID).Msg("Failed to check base fee of chain, keeping gas spike state")
+			continue
+		}
+
+		g.record(ctx, ceiling, baseFee, time.Now())
+	}
+}
+
+// getBaseFee 获取最新区块的 baseFee，不支持 EIP-1559 的链使用 gasPrice
+func (g *guard) getBaseFee(ctx context.Context, chainID int) (*big.Int, error) {
+	client, err := g.scanService.GetClient(ctx, chainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get RPC client")
+	}
+
+	latestBlock, err := client.GetBlockByNumber(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get latest block")
+	}
+	if baseFee := latestBlock.BaseFee(); baseFee != nil {
+		return baseFee, nil
+	}
+
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get gas price")
+	}
+
+	return gasPrice, nil
+}
+
+// record 记录链的 baseFee：超过上限时暂停，暂停后回落到恢复阈值以下时恢复，状态变化时告警
+func (g *guard) record(ctx context.Context, ceiling Ceiling, baseFee *big.Int, now time.Time) {
+	resumeBelow := new(big.Int).Mul(ceiling.MaxBaseFee, big.NewInt(int64(g.config.ResumePercent)))
+	resumeBelow.Quo(resumeBelow, big.NewInt(percent))
+
+	g.mu.Lock()
+	state := g.states[ceiling.ChainID]
+	state.baseFee = baseFee
+	state.checkedAt = now
+
+	var paused, resumed bool
+	var pausedFor time.Duration
+	switch {
+	case !state.paused && baseFee.Cmp(ceiling.MaxBaseFee) > 0:
+		state.paused = true
+		state.pausedSince = now
+		paused = true
+	case state.paused && baseFee.Cmp(resumeBelow) <= 0:
+		pausedFor = now.Sub(state.pausedSince)
+		state.paused = false
+		state.pausedSince = time.Time{}
+		resumed = true
+	}
+	isPaused := state.paused
+	g.mu.Unlock()
+
+	chainLabel := walletMetrics.ChainLabel(ceiling.ChainID)
+	walletMetrics.ChainBaseFeeGwei.WithLabelValues(chainLabel).Set(toGwei(baseFee))
+	if isPaused {
+		walletMetrics.GasSpikePaused.WithLabelValues(chainLabel).Set(1)
+	} else {
+		walletMetrics.GasSpikePaused.WithLabelValues(chainLabel).Set(0)
+	}
+
+	switch {
+	case paused:
+		g.notify(ctx, &alert.Alert{
+			Type:     alert.TypeGasSpike,
+			Severity: alert.SeverityWarning,
+			ChainID:  ceiling.ChainID,
+			Message:  "Base fee exceeds the configured ceiling, automatic operations are paused",
+			Fields: map[string]any{
+				"base_fee_gwei":     FormatGwei(baseFee),
+				"max_base_fee_gwei": FormatGwei(ceiling.MaxBaseFee),
+			},
+		})
+	case resumed:
+		g.notify(ctx, &alert.Alert{
+			Type:     alert.TypeGasNormalized,
+			Severity: alert.SeverityInfo,
+			ChainID:  ceiling.ChainID,
+			Message:  "Base fee is back to normal, automatic operations are resumed",
+			Fields: map[string]any{
+				"base_fee_gwei":     FormatGwei(baseFee),
+				"max_base_fee_gwei": FormatGwei(ceiling.MaxBaseFee),
+				"resume_below_gwei": FormatGwei(resumeBelow),
+				"paused_for_sec":    int64(pausedFor.Seconds()),
+			},
+		})
+	}
+}
+
+func (g *guard) notify(ctx context.Context, a *alert.Alert) {
+	if err := g.notifier.Notify(ctx, a); err != nil {
+		log.Error().Err(err).Str("alert_type", a.Type).Int("chain_id", a.ChainID).Msg("Failed to send gas spike alert")
+	}
+}
+
+// FormatGwei 将 wei 格式化为 gwei 十进制字符串（最多 9 位小数，去掉末尾的 0）
+func FormatGwei(wei *big.Int) string {
+	s := new(big.Rat).SetFrac(wei, big.NewInt(weiPerGwei)).FloatString(gweiDecimals)
+	return strings.TrimRight(strings.TrimRight(s, "0"), ".")
+}
+
+func toGwei(wei *big.Int) float64 {
+	f, _ := new(big.Rat).SetFrac(wei, big.NewInt(weiPerGwei)).Float64()
+	return f
+}
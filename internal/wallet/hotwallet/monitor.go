@@ -0,0 +1,429 @@
+package hotwallet
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	walletMetrics "github/chapool/go-wallet/internal/metrics/wallet"
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/alert"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+)
+
+// 热钱包余额状态
+const (
+	HealthStatusOK           = "ok"           // 余额充足
+	HealthStatusLowBalance   = "low_balance"  // 余额低于配置的最低余额
+	HealthStatusInsufficient = "insufficient" // 余额不足以支付待发送的提现
+	HealthStatusUnknown      = "unknown"      // 查询余额失败
+)
+
+const nativeDecimals = 18 // 原生币精度（EVM 链）
+
+// Monitor 热钱包余额监控接口
+// 定期检查各链热钱包的原生币和 ERC20 余额，低于最低余额或不足以支付待发送的提现时告警
+type Monitor interface {
+	// StartMonitor 启动定时检查
+	StartMonitor(ctx context.Context, interval time.Duration)
+
+	// GetHealth 获取最近一次检查的结果，尚未检查时立即检查一次
+	GetHealth(ctx context.Context) ([]*Health, error)
+}
+
+// MonitorConfig 热钱包余额监控配置
+type MonitorConfig struct {
+	MinBalances       []MinBalance
+	WorkerConcurrency int
+}
+
+// MinBalance 链上代币的热钱包最低余额（人类可读单位）
+type MinBalance struct {
+	ChainID     int
+	TokenSymbol string
+	MinAmount   *big.Float
+}
+
+// Health 热钱包单个代币的余额状态
+type Health struct {
+	ChainID               int
+	TokenID               int
+	TokenSymbol           string
+	Address               string
+	Balance               *string // 查询失败时为空
+	MinBalance            *string // 未配置时为空
+	PendingWithdrawAmount string  // 待发送提现总额
+	PendingWithdrawCount  int
+	Status                string
+	CheckedAt             time.Time
+}
+
+// pendingWithdraws 待发送的提现（已申请或签名中，尚未广播）
+type pendingWithdraws struct {
+	amount *big.Rat
+	count  int
+}
+
+type monitor struct {
+	db               *sql.DB
+	config           MonitorConfig
+	chainService     chain.Service
+	scanService      scan.Service
+	hotWalletService Service
+	notifier         alert.Notifier
+
+	mu       sync.RWMutex
+	health   []*Health
+	statuses map[string]string // chainID:tokenID -> 上次告警时的状态，仅在状态变化时告警
+}
+
+// NewMonitor 创建热钱包余额监控
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewMonitor(
+	db *sql.DB,
+	config MonitorConfig,
+	chainService chain.Service,
+	scanService scan.Service,
+	hotWalletService Service,
+	notifier alert.Notifier,
+) Monitor {
+	return &monitor{
+		db:               db,
+		config:           config,
+		chainService:     chainService,
+		scanService:      scanService,
+		hotWalletService: hotWalletService,
+		notifier:         notifier,
+		statuses:         make(map[string]string),
+	}
+}
+
+// StartMonitor 启动定时检查
+func (m *monitor) StartMonitor(ctx context.Context, interval time.Duration) {
+	log.Info().
+		Dur("interval", interval).
+		Int("min_balances", len(m.config.MinBalances)).
+		Msg("Starting hot wallet balance monitor")
+
+	lifecycle.Go(ctx, "hot wallet monitor", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		m.runMonitor(ctx)
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Hot wallet balance monitor stopped")
+				return
+			case <-ticker.C:
+				m.runMonitor(ctx)
+			}
+		}
+	})
+}
+
+// GetHealth 获取最近一次检查的结果
+func (m *monitor) GetHealth(ctx context.Context) ([]*Health, error) {
+	m.mu.RLock()
+	health := m.health
+	m.mu.RUnlock()
+
+	if health != nil {
+		return health, nil
+	}
+
+	return m.checkHealth(ctx)
+}
+
+// runMonitor 检查所有热钱包余额，保存结果并对状态变化告警
+func (m *monitor) runMonitor(ctx context.Context) {
+	health, err := m.checkHealth(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check hot wallet balances")
+		return
+	}
+
+	m.mu.Lock()
+	m.health = health
+	m.mu.Unlock()
+
+	for _, h := range health {
+		m.alertOnChange(ctx, h)
+	}
+}
+
+// checkHealth 检查所有启用的 EVM 链上热钱包的余额
+func (m *monitor) checkHealth(ctx context.Context) ([]*Health, error) {
+	chains, err := m.chainService.GetActiveChains(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get active chains")
+	}
+
+	var (
+		g      errgroup.Group
+		mu     sync.Mutex
+		health = make([]*Health, 0)
+	)
+	g.SetLimit(max(m.config.WorkerConcurrency, 1))
+
+	for _, ch := range chains {
+		// 目前只支持 EVM 链
+		if ch.ChainType != chain.TypeEVM {
+			continue
+		}
+
+		g.Go(func() error {
+			chainHealth, err := m.checkChain(ctx, ch.ChainID)
+			if err != nil {
+				log.Error().Err(err).Int("chain_id", ch.ChainID).Msg("Failed to check hot wallet balances of chain")
+				return nil
+			}
+
+			mu.Lock()
+			health = append(health, chainHealth...)
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+
+	sort.Slice(health, func(i, j int) bool {
+		if health[i].ChainID != health[j].ChainID {
+			return health[i].ChainID < health[j].ChainID
+		}
+		return health[i].TokenID < health[j].TokenID
+	})
+
+	return health, nil
+}
+
+// checkChain 检查单条链热钱包各代币的余额
+func (m *monitor) checkChain(ctx context.Context, chainID int) ([]*Health, error) {
+	wallet, err := m.hotWalletService.GetHotWallet(ctx, chainID)
+	if err != nil {
+		// 未创建热钱包的链不检查
+		log.Debug().Err(err).Int("chain_id", chainID).Msg("Skipping hot wallet balance check of chain")
+		return nil, nil
+	}
+
+	tokens, err := models.Tokens(
+		models.TokenWhere.ChainID.EQ(chainID),
+		models.TokenWhere.IsActive.EQ(true),
+		qm.OrderBy(models.TokenColumns.ID),
+	).All(ctx, m.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get active tokens")
+	}
+
+	pending, err := m.getPendingWithdraws(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := m.scanService.GetClient(ctx, chainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get RPC client")
+	}
+
+	address := strings.ToLower(wallet.Address)
+	hotWalletAddr := common.HexToAddress(address)
+	now := time.Now()
+
+	health := make([]*Health, 0, len(tokens))
+	for _, token := range tokens {
+		h := &Health{
+			ChainID:               chainID,
+			TokenID:               token.ID,
+			TokenSymbol:           token.TokenSymbol,
+			Address:               address,
+			PendingWithdrawAmount: "0",
+			Status:                HealthStatusOK,
+			CheckedAt:             now,
+		}
+
+		withdraws, ok := pending[token.ID]
+		if ok {
+			h.PendingWithdrawAmount = money.Format(withdraws.amount, token.Decimals)
+			h.PendingWithdrawCount = withdraws.count
+		}
+
+		minBalance := m.minBalance(chainID, token.TokenSymbol)
+		if minBalance != nil {
+			h.MinBalance = ptr(money.Format(minBalance, token.Decimals))
+		}
+
+		var balanceWei *big.Int
+		if token.IsNative {
+			balanceWei, err = client.BalanceAt(ctx, hotWalletAddr)
+		} else if token.TokenAddress.Valid {
+			balanceWei, err = client.TokenBalance(ctx, common.HexToAddress(token.TokenAddress.String), hotWalletAddr)
+		} else {
+			continue
+		}
+		if err != nil {
+			log.Error().
+				Err(err).
+				Int("chain_id", chainID).
+				Str("token_symbol", token.TokenSymbol).
+				Msg("Failed to get hot wallet balance")
+			h.Status = HealthStatusUnknown
+			health = append(health, h)
+			continue
+		}
+
+		asset := token.TokenSymbol
+		decimals := token.Decimals
+		if token.IsNative {
+			asset = walletMetrics.AssetNative
+			decimals = nativeDecimals
+		}
+		walletMetrics.SetHotWalletBalance(chainID, address, asset, balanceWei, decimals)
+
+		balance := money.FromSmallestUnit(balanceWei, decimals)
+		h.Balance = ptr(money.Format(balance, decimals))
+
+		switch {
+		case ok && balance.Cmp(withdraws.amount) < 0:
+			h.Status = HealthStatusInsufficient
+		case minBalance != nil && balance.Cmp(minBalance) < 0:
+			h.Status = HealthStatusLowBalance
+		}
+
+		health = append(health, h)
+	}
+
+	return health, nil
+}
+
+// getPendingWithdraws 按代币统计链上待发送的提现
+func (m *monitor) getPendingWithdraws(ctx context.Context, chainID int) (map[int]pendingWithdraws, error) {
+	rows, err := m.db.QueryContext(ctx, `
+		SELECT token_id, SUM(amount::numeric)::text, COUNT(*)
+		FROM withdraws
+		WHERE chain_id = $1 AND status IN ($2, $3)
+		GROUP BY token_id
+	`, chainID, models.WithdrawStatusUserWithdrawRequest, models.WithdrawStatusSigning)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query pending withdraws")
+	}
+	defer rows.Close()
+
+	result := make(map[int]pendingWithdraws)
+	for rows.Next() {
+		var (
+			tokenID int
+			amount  string
+			count   int
+		)
+		if err := rows.Scan(&tokenID, &amount, &count); err != nil {
+			return nil, errors.Wrap(err, "failed to scan pending withdraws")
+		}
+
+		total, ok := new(big.Rat).SetString(amount)
+		if !ok {
+			return nil, errors.Errorf("invalid pending withdraw amount %q", amount)
+		}
+		result[tokenID] = pendingWithdraws{amount: total, count: count}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate pending withdraws")
+	}
+
+	return result, nil
+}
+
+// minBalance 获取链上代币配置的最低余额（代币符号不区分大小写），未配置时返回 nil
+func (m *monitor) minBalance(chainID int, tokenSymbol string) *big.Rat {
+	for _, minBalance := range m.config.MinBalances {
+		if minBalance.ChainID == chainID && strings.EqualFold(minBalance.TokenSymbol, tokenSymbol) {
+			v, _ := minBalance.MinAmount.Rat(nil)
+			return v
+		}
+	}
+
+	return nil
+}
+
+// alertOnChange 状态变化时告警，查询失败（unknown）不改变上次的状态
+func (m *monitor) alertOnChange(ctx context.Context, h *Health) {
+	if h.Status == HealthStatusUnknown {
+		return
+	}
+
+	key := fmt.Sprintf("%d:%d", h.ChainID, h.TokenID)
+
+	m.mu.Lock()
+	previous, ok := m.statuses[key]
+	m.statuses[key] = h.Status
+	m.mu.Unlock()
+
+	if !ok {
+		previous = HealthStatusOK
+	}
+	if previous == h.Status {
+		return
+	}
+
+	fields := map[string]any{
+		"address":                 h.Address,
+		"token_symbol":            h.TokenSymbol,
+		"balance":                 deref(h.Balance),
+		"pending_withdraw_amount": h.PendingWithdrawAmount,
+		"pending_withdraw_count":  h.PendingWithdrawCount,
+	}
+	if h.MinBalance != nil {
+		fields["min_balance"] = *h.MinBalance
+	}
+
+	a := &alert.Alert{ChainID: h.ChainID, Fields: fields}
+	switch h.Status {
+	case HealthStatusInsufficient:
+		a.Type = alert.TypeHotWalletInsufficient
+		a.Severity = alert.SeverityCritical
+		a.Message = fmt.Sprintf("Hot wallet %s balance cannot cover pending withdraws", h.TokenSymbol)
+	case HealthStatusLowBalance:
+		a.Type = alert.TypeHotWalletLowBalance
+		a.Severity = alert.SeverityWarning
+		a.Message = fmt.Sprintf("Hot wallet %s balance is below the configured minimum", h.TokenSymbol)
+	default:
+		a.Type = alert.TypeHotWalletRecovered
+		a.Severity = alert.SeverityInfo
+		a.Message = fmt.Sprintf("Hot wallet %s balance recovered", h.TokenSymbol)
+	}
+
+	notifier := m.notifier
+	if notifier == nil {
+		notifier = alert.NewNotifier("", nil, nil)
+	}
+	_ = notifier.Notify(ctx, a)
+}
+
+func ptr(s string) *string {
+	return &s
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
@@ -0,0 +1,206 @@
+package hotwallet
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// 热钱包选择策略
+const (
+	StrategyFirst             = "first"               // 第一个热钱包（默认）
+	StrategyRoundRobin        = "round_robin"         // 轮流使用各热钱包
+	StrategyHighestBalance    = "highest_balance"     // 提现代币余额最高的热钱包
+	StrategyLeastPendingNonce = "least_pending_nonce" // 已广播未上链的交易最少的热钱包，减少 nonce 排队
+)
+
+// SelectHotWallet 按链配置的选择策略选取发送提现的热钱包
+// 只在组织的热钱包中选择（组织没有热钱包时在共用热钱包中选择），只有一个热钱包或未配置策略时返回第一个热钱包
+func (s *service) SelectHotWallet(ctx context.Context, chainID int, orgID *string, client *scan.RPCClient, token *models.Token) (*models.Wallet, error) {
+	strategy := s.strategies[chainID]
+	if strategy == "" || strategy == StrategyFirst {
+		return s.GetOrgHotWallet(ctx, chainID, orgID)
+	}
+
+	wallets, err := s.getHotWallets(ctx, chainID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if len(wallets) == 1 {
+		return wallets[0], nil
+	}
+
+	var wallet *models.Wallet
+	switch strategy {
+	case StrategyRoundRobin:
+		wallet = s.selectRoundRobin(chainID, orgID, wallets)
+	case StrategyHighestBalance:
+		wallet, err = s.selectHighestBalance(ctx, client, token, wallets)
+	case StrategyLeastPendingNonce:
+		wallet, err = s.selectLeastPending(ctx, chainID, wallets)
+	default:
+		return nil, errors.Errorf("unknown hot wallet strategy %q", strategy)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug().
+		Int("chain_id", chainID).
+		Str("strategy", strategy).
+		Str("address", wallet.Address).
+		Int("hot_wallets", len(wallets)).
+		Msg("Selected hot wallet for withdraw")
+
+	return wallet, nil
+}
+
+// getHotWallets 获取组织在链上的所有热钱包（按创建时间），组织没有热钱包或 orgID 为空时返回共用热钱包
+func (s *service) getHotWallets(ctx context.Context, chainID int, orgID *string) ([]*models.Wallet, error) {
+	orgMod := models.WalletWhere.OrgID.IsNull()
+	if orgID != nil {
+		orgMod = qm.Expr(
+			models.WalletWhere.OrgID.EQ(null.StringFrom(*orgID)),
+			qm.Or2(models.WalletWhere.OrgID.IsNull()),
+		)
+	}
+
+	// 组织的热钱包排在共用热钱包之前
+	wallets, err := models.Wallets(
+		models.WalletWhere.ChainID.EQ(chainID),
+		models.WalletWhere.WalletType.EQ(models.WalletTypeHot),
+		orgMod,
+		qm.OrderBy(models.WalletColumns.OrgID+" IS NULL, "+models.WalletColumns.CreatedAt+" ASC, "+models.WalletColumns.ID+" ASC"),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get hot wallets")
+	}
+	if len(wallets) == 0 {
+		return nil, errors.New("no hot wallet found for this chain")
+	}
+
+	return orgHotWallets(wallets), nil
+}
+
+// orgHotWallets 有组织热钱包时只保留组织的热钱包，wallets 中组织的热钱包排在共用热钱包之前
+func orgHotWallets(wallets []*models.Wallet) []*models.Wallet {
+	if !wallets[0].OrgID.Valid {
+		return wallets
+	}
+
+	for i, wallet := range wallets {
+		if !wallet.OrgID.Valid {
+			return wallets[:i]
+		}
+	}
+
+	return wallets
+}
+
+// selectRoundRobin 轮流选择热钱包（按链和组织在进程内计数，重启后从第一个开始）
+func (s *service) selectRoundRobin(chainID int, orgID *string, wallets []*models.Wallet) *models.Wallet {
+	key := strconv.Itoa(chainID)
+	if orgID != nil {
+		key += ":" + *orgID
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	next := s.roundRobin[key] % len(wallets)
+	s.roundRobin[key] = next + 1
+
+	return wallets[next]
+}
+
+// selectHighestBalance 选择提现代币余额最高的热钱包，查询失败的热钱包不参与选择
+func (s *service) selectHighestBalance(ctx context.Context, client *scan.RPCClient, token *models.Token, wallets []*models.Wallet) (*models.Wallet, error) {
+	if !token.IsNative && !token.TokenAddress.Valid {
+		return nil, errors.New("token address is invalid for non-native token")
+	}
+
+	var (
+		selected *models.Wallet
+		highest  *big.Int
+	)
+	for _, wallet := range wallets {
+		addr := common.HexToAddress(strings.ToLower(wallet.Address))
+
+		var (
+			balance *big.Int
+			err     error
+		)
+		if token.IsNative {
+			balance, err = client.BalanceAt(ctx, addr)
+		} else {
+			balance, err = client.TokenBalance(ctx, common.HexToAddress(token.TokenAddress.String), addr)
+		}
+		if err != nil {
+			log.Warn().
+				Err(err).
+				Int("chain_id", wallet.ChainID).
+				Str("address", wallet.Address).
+				Msg("Failed to get hot wallet balance, skipping hot wallet")
+			continue
+		}
+
+		if highest == nil || balance.Cmp(highest) > 0 {
+			selected = wallet
+			highest = balance
+		}
+	}
+
+	if selected == nil {
+		return nil, errors.New("failed to get the balance of any hot wallet")
+	}
+
+	return selected, nil
+}
+
+// selectLeastPending 选择已广播但尚未上链的提现最少的热钱包，数量相同时选择靠前的热钱包
+func (s *service) selectLeastPending(ctx context.Context, chainID int, wallets []*models.Wallet) (*models.Wallet, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT LOWER(from_address), COUNT(*)
+		FROM withdraws
+		WHERE chain_id = $1 AND status = $2 AND from_address IS NOT NULL
+		GROUP BY LOWER(from_address)
+	`, chainID, models.WithdrawStatusPending)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count pending withdraws per hot wallet")
+	}
+	defer rows.Close()
+
+	pending := make(map[string]int)
+	for rows.Next() {
+		var (
+			address string
+			count   int
+		)
+		if err := rows.Scan(&address, &count); err != nil {
+			return nil, errors.Wrap(err, "failed to scan pending withdraw count")
+		}
+		pending[address] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate pending withdraw counts")
+	}
+
+	selected := wallets[0]
+	for _, wallet := range wallets[1:] {
+		if pending[strings.ToLower(wallet.Address)] < pending[strings.ToLower(selected.Address)] {
+			selected = wallet
+		}
+	}
+
+	return selected, nil
+}
@@ -0,0 +1,71 @@
+package hotwallet
+
+import (
+	"testing"
+
+	"github/chapool/go-wallet/internal/models"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrgHotWallets(t *testing.T) {
+	t.Parallel()
+
+	orgWallet := func(id string) *models.Wallet {
+		return &models.Wallet{ID: id, OrgID: null.StringFrom("org-1")}
+	}
+	sharedWallet := func(id string) *models.Wallet {
+		return &models.Wallet{ID: id}
+	}
+	ids := func(wallets []*models.Wallet) []string {
+		result := make([]string, 0, len(wallets))
+		for _, wallet := range wallets {
+			result = append(result, wallet.ID)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name    string
+		wallets []*models.Wallet
+		want    []string
+	}{
+		{
+			name:    "OnlyShared",
+			wallets: []*models.Wallet{sharedWallet("s1"), sharedWallet("s2")},
+			want:    []string{"s1", "s2"},
+		},
+		{
+			name:    "OrgBeforeShared",
+			wallets: []*models.Wallet{orgWallet("o1"), orgWallet("o2"), sharedWallet("s1")},
+			want:    []string{"o1", "o2"},
+		},
+		{
+			name:    "OnlyOrg",
+			wallets: []*models.Wallet{orgWallet("o1")},
+			want:    []string{"o1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, ids(orgHotWallets(tt.wallets)))
+		})
+	}
+}
+
+func TestSelectRoundRobinPerOrg(t *testing.T) {
+	t.Parallel()
+
+	s := &service{roundRobin: make(map[string]int)}
+	wallets := []*models.Wallet{{ID: "w1"}, {ID: "w2"}}
+	orgID := "org-1"
+
+	assert.Equal(t, "w1", s.selectRoundRobin(1, nil, wallets).ID)
+	assert.Equal(t, "w1", s.selectRoundRobin(1, &orgID, wallets).ID)
+	assert.Equal(t, "w2", s.selectRoundRobin(1, nil, wallets).ID)
+	assert.Equal(t, "w2", s.selectRoundRobin(1, &orgID, wallets).ID)
+	assert.Equal(t, "w1", s.selectRoundRobin(1, nil, wallets).ID)
+}
@@ -3,11 +3,13 @@ package hotwallet
 import (
 	"context"
 	"database/sql"
+	"sync"
 	"time"
 
 	"github/chapool/go-wallet/internal/models"
 	"github/chapool/go-wallet/internal/wallet/address"
-	"github/chapool/go-wallet/internal/wallet/seed"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
@@ -18,12 +20,19 @@ import (
 
 // Service 热钱包服务接口
 type Service interface {
-	// CreateHotWallet 创建热钱包
-	CreateHotWallet(ctx context.Context, userID string, chainID int, deviceName string) (*models.Wallet, error)
+	// CreateHotWallet 创建热钱包，orgID 为空时所有组织共用
+	CreateHotWallet(ctx context.Context, userID string, chainID int, deviceName string, orgID *string) (*models.Wallet, error)
 
-	// GetHotWallet 获取指定链的热钱包（目前简单返回第一个）
+	// GetHotWallet 获取指定链的第一个共用热钱包（余额监控等不区分组织的场景）
 	GetHotWallet(ctx context.Context, chainID int) (*models.Wallet, error)
 
+	// GetOrgHotWallet 获取组织在指定链的第一个热钱包（归集目标），组织没有热钱包时使用共用热钱包
+	GetOrgHotWallet(ctx context.Context, chainID int, orgID *string) (*models.Wallet, error)
+
+	// SelectHotWallet 按链配置的选择策略在组织的热钱包中选取发送提现的热钱包（组织没有热钱包时使用共用热钱包），
+	// token 为提现的代币（highest_balance 策略比较该代币余额）
+	SelectHotWallet(ctx context.Context, chainID int, orgID *string, client *scan.RPCClient, token *models.Token) (*models.Wallet, error)
+
 	// GetNextNonce 获取并锁定下一个 Nonce
 	GetNextNonce(ctx context.Context, address string, chainID int) (int, error)
 }
@@ -31,39 +40,48 @@ type Service interface {
 type service struct {
 	db             *sql.DB
 	addressService address.Service
-	seedManager    seed.Manager
+	deriver        address.Deriver
+	strategies     map[int]string // chainID -> 热钱包选择策略，未配置的链使用第一个热钱包
+
+	mu         sync.Mutex
+	roundRobin map[string]int // chainID:orgID -> 下一个轮询位置
 }
 
 // NewService 创建热钱包服务
 //
 //nolint:ireturn // 返回接口类型是预期的设计
-func NewService(db *sql.DB, addressService address.Service, seedManager seed.Manager) Service {
+func NewService(db *sql.DB, addressService address.Service, deriver address.Deriver, strategies map[int]string) Service {
 	return &service{
 		db:             db,
 		addressService: addressService,
-		seedManager:    seedManager,
+		deriver:        deriver,
+		strategies:     strategies,
+		roundRobin:     make(map[string]int),
 	}
 }
 
 // CreateHotWallet 创建热钱包
-func (s *service) CreateHotWallet(ctx context.Context, userID string, chainID int, deviceName string) (*models.Wallet, error) {
-	// 1. 获取种子
-	seed := s.seedManager.GetSeed()
-	if seed == nil {
-		return nil, errors.New("seed not initialized")
+func (s *service) CreateHotWallet(ctx context.Context, userID string, chainID int, deviceName string, orgID *string) (*models.Wallet, error) {
+	// 1. 获取链类型（决定派生方式）
+	chain, err := models.Chains(models.ChainWhere.ChainID.EQ(chainID)).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, walleterrors.ErrChainNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get chain")
 	}
 
 	// 2. 获取下一个地址索引
-	index, err := s.addressService.GetNextAddressIndex(ctx, "evm", deviceName)
+	index, err := s.addressService.GetNextAddressIndex(ctx, chain.ChainType, deviceName)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get next address index")
 	}
 
 	// 3. 计算派生路径
-	derivationPath := s.addressService.GetBIP44Path(index)
+	derivationPath := s.addressService.GetDerivationPath(chain.ChainType, index)
 
-	// 4. 生成地址
-	addr, err := s.addressService.DeriveAddress(ctx, seed, derivationPath, "evm")
+	// 4. 生成地址（远程签名模式下由签名进程派生）
+	addr, err := s.deriver.DeriveAddress(ctx, derivationPath, chain.ChainType)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to derive address for hot wallet")
 	}
@@ -72,12 +90,13 @@ func (s *service) CreateHotWallet(ctx context.Context, userID string, chainID in
 	wallet := &models.Wallet{
 		UserID:         userID,
 		Address:        addr,
-		ChainType:      "evm",
+		ChainType:      chain.ChainType,
 		ChainID:        chainID,
 		DerivationPath: derivationPath,
 		AddressIndex:   index,
-		WalletType:     "hot",
+		WalletType:     models.WalletTypeHot,
 		DeviceName:     null.StringFrom(deviceName),
+		OrgID:          null.StringFromPtr(orgID),
 	}
 
 	// 开启事务
@@ -114,22 +133,19 @@ func (s *service) CreateHotWallet(ctx context.Context, userID string, chainID in
 	return wallet, nil
 }
 
-// GetHotWallet 获取指定链的热钱包
+// GetHotWallet 获取指定链的第一个共用热钱包（按创建时间）
 func (s *service) GetHotWallet(ctx context.Context, chainID int) (*models.Wallet, error) {
-	// 简单策略：获取该链下第一个可用的热钱包
-	wallet, err := models.Wallets(
-		models.WalletWhere.ChainID.EQ(chainID),
-		models.WalletWhere.WalletType.EQ("hot"),
-	).One(ctx, s.db)
+	return s.GetOrgHotWallet(ctx, chainID, nil)
+}
 
+// GetOrgHotWallet 获取组织在指定链的第一个热钱包（按创建时间）
+func (s *service) GetOrgHotWallet(ctx context.Context, chainID int, orgID *string) (*models.Wallet, error) {
+	wallets, err := s.getHotWallets(ctx, chainID, orgID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.New("no hot wallet found for this chain")
-		}
-		return nil, errors.Wrap(err, "failed to get hot wallet")
+		return nil, err
 	}
 
-	return wallet, nil
+	return wallets[0], nil
 }
 
 // GetNextNonce 获取并锁定下一个 Nonce
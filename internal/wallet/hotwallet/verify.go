@@ -0,0 +1,442 @@
+package hotwallet
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/address"
+	"github/chapool/go-wallet/internal/wallet/alert"
+	"github/chapool/go-wallet/internal/wallet/chain"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// 系统钱包类型
+const (
+	SystemWalletHot          = "hot"          // 热钱包（wallets 表）
+	SystemWalletVerification = "verification" // 密码校验地址（keystore.verification_address）
+)
+
+// 派生校验状态，按严重程度从高到低排列
+const (
+	VerifyStatusError         = "error"          // 派生失败（路径格式错误、链类型不支持）
+	VerifyStatusMismatch      = "mismatch"       // 派生路径派生出的地址与存储的地址不一致，签名会失败
+	VerifyStatusIndexMismatch = "index_mismatch" // 地址一致，但 address_index 与派生路径不一致
+	VerifyStatusIndexBehind   = "index_behind"   // address_indexes 的当前索引落后于钱包索引，新建地址会复用该路径
+	VerifyStatusOK            = "ok"             // 派生路径派生出存储的地址
+)
+
+const (
+	// verificationAddressIndex 密码校验地址的地址索引（与 wallet.VerificationAddressIndex 一致）
+	verificationAddressIndex = 0
+	// repairSearchWindow 地址不一致时，在 [0, max(钱包索引, 当前索引) + repairSearchWindow] 范围内查找派生出存储地址的索引
+	repairSearchWindow = 100
+)
+
+// Verifier 系统钱包派生校验接口
+// 启动时和定期用当前种子重新派生所有热钱包和密码校验地址，与存储的地址比较，
+// 避免种子恢复错误或索引表被篡改后，热钱包在提现签名时才失败
+type Verifier interface {
+	// StartVerifier 启动时立即校验一次，之后定时校验
+	StartVerifier(ctx context.Context, interval time.Duration)
+
+	// GetReport 获取最近一次校验的报告，尚未校验时立即校验一次
+	GetReport(ctx context.Context) (*VerificationReport, error)
+
+	// Verify 立即校验并更新报告
+	Verify(ctx context.Context) (*VerificationReport, error)
+}
+
+// VerificationReport 系统钱包派生校验报告
+type VerificationReport struct {
+	Items     []*Verification
+	Healthy   bool // 所有系统钱包的状态都是 ok
+	CheckedAt time.Time
+}
+
+// Verification 单个系统钱包的派生校验结果和修复建议
+type Verification struct {
+	Kind           string
+	WalletID       *string // 密码校验地址为空
+	ChainID        *int    // 密码校验地址为空
+	ChainType      string
+	DeviceName     *string
+	Address        string // 存储的地址
+	DerivationPath string
+	AddressIndex   int
+	DerivedAddress *string // 派生失败时为空
+	// CurrentIndex address_indexes 中该链类型和设备的当前索引，没有记录时为空
+	CurrentIndex *int
+	Status       string
+	Error        *string
+	// SuggestedIndex / SuggestedPath 在搜索范围内派生出存储地址的索引和路径，未找到时为空
+	SuggestedIndex *int
+	SuggestedPath  *string
+	// Repair 修复建议，状态为 ok 时为空
+	Repair *string
+}
+
+// systemWallet 待校验的系统钱包
+type systemWallet struct {
+	kind           string
+	walletID       *string
+	chainID        *int
+	chainType      string
+	deviceName     *string
+	address        string
+	derivationPath string
+	addressIndex   int
+	currentIndex   *int
+}
+
+type verifier struct {
+	db             *sql.DB
+	addressService address.Service
+	deriver        address.Deriver
+	notifier       alert.Notifier
+
+	mu       sync.RWMutex
+	report   *VerificationReport
+	statuses map[string]string // 系统钱包 -> 上次告警时的状态，仅在状态变化时告警
+}
+
+// NewVerifier 创建系统钱包派生校验
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewVerifier(db *sql.DB, addressService address.Service, deriver address.Deriver, notifier alert.Notifier) Verifier {
+	return &verifier{
+		db:             db,
+		addressService: addressService,
+		deriver:        deriver,
+		notifier:       notifier,
+		statuses:       make(map[string]string),
+	}
+}
+
+// StartVerifier 启动时立即校验一次，之后定时校验
+func (v *verifier) StartVerifier(ctx context.Context, interval time.Duration) {
+	log.Info().Dur("interval", interval).Msg("Starting system wallet derivation verifier")
+
+	lifecycle.Go(ctx, "system wallet verifier", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		v.runVerifier(ctx)
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("System wallet derivation verifier stopped")
+				return
+			case <-ticker.C:
+				v.runVerifier(ctx)
+			}
+		}
+	})
+}
+
+// GetReport 获取最近一次校验的报告
+func (v *verifier) GetReport(ctx context.Context) (*VerificationReport, error) {
+	v.mu.RLock()
+	report := v.report
+	v.mu.RUnlock()
+
+	if report != nil {
+		return report, nil
+	}
+
+	return v.Verify(ctx)
+}
+
+// Verify 校验所有系统钱包，保存报告并对状态变化告警
+func (v *verifier) Verify(ctx context.Context) (*VerificationReport, error) {
+	wallets, err := v.loadSystemWallets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 种子锁定时所有派生都会失败，不生成报告
+	if len(wallets) > 0 {
+		if _, err := v.deriver.DeriveAddress(ctx, wallets[0].derivationPath, wallets[0].chainType); errors.Is(err, address.ErrSeedLocked) {
+			return nil, err
+		}
+	}
+
+	report := &VerificationReport{
+		Items:     make([]*Verification, 0, len(wallets)),
+		Healthy:   true,
+		CheckedAt: time.Now(),
+	}
+	for _, w := range wallets {
+		item := verifySystemWallet(ctx, v.addressService, v.deriver, w)
+		if item.Status != VerifyStatusOK {
+			report.Healthy = false
+		}
+		report.Items = append(report.Items, item)
+	}
+
+	v.mu.Lock()
+	v.report = report
+	v.mu.Unlock()
+
+	for _, item := range report.Items {
+		v.alertOnChange(ctx, item)
+	}
+
+	return report, nil
+}
+
+// runVerifier 定时任务执行一次校验
+func (v *verifier) runVerifier(ctx context.Context) {
+	report, err := v.Verify(ctx)
+	// 种子锁定期间无法派生地址，解锁后的下一次定时校验继续
+	if errors.Is(err, address.ErrSeedLocked) {
+		log.Debug().Msg("Seed is locked, skipping system wallet derivation verification")
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to verify system wallet derivation paths")
+		return
+	}
+
+	if !report.Healthy {
+		log.Error().Int("wallets", len(report.Items)).Msg("System wallet derivation verification found mismatches")
+		return
+	}
+
+	log.Debug().Int("wallets", len(report.Items)).Msg("System wallet derivation verification passed")
+}
+
+// loadSystemWallets 查询密码校验地址和所有热钱包，以及对应的 address_indexes 当前索引
+func (v *verifier) loadSystemWallets(ctx context.Context) ([]*systemWallet, error) {
+	wallets := make([]*systemWallet, 0)
+
+	var verificationAddress sql.NullString
+	err := v.db.QueryRowContext(ctx, `SELECT verification_address FROM keystore LIMIT 1`).Scan(&verificationAddress)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to query verification address")
+	}
+	if verificationAddress.Valid && verificationAddress.String != "" {
+		wallets = append(wallets, &systemWallet{
+			kind:           SystemWalletVerification,
+			chainType:      chain.TypeEVM,
+			address:        verificationAddress.String,
+			derivationPath: v.addressService.GetBIP44Path(verificationAddressIndex),
+			addressIndex:   verificationAddressIndex,
+		})
+	}
+
+	hotWallets, err := models.Wallets(
+		models.WalletWhere.WalletType.EQ(models.WalletTypeHot),
+		qm.OrderBy(models.WalletColumns.ChainID+" ASC, "+models.WalletColumns.CreatedAt+" ASC, "+models.WalletColumns.ID+" ASC"),
+	).All(ctx, v.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query hot wallets")
+	}
+
+	indexes, err := models.AddressIndexes().All(ctx, v.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query address indexes")
+	}
+	currentIndexes := make(map[string]int, len(indexes))
+	for _, index := range indexes {
+		currentIndexes[index.ChainType+":"+index.DeviceName.String] = index.CurrentIndex
+	}
+
+	for _, w := range hotWallets {
+		sw := &systemWallet{
+			kind:           SystemWalletHot,
+			walletID:       &w.ID,
+			chainID:        &w.ChainID,
+			chainType:      w.ChainType,
+			deviceName:     w.DeviceName.Ptr(),
+			address:        w.Address,
+			derivationPath: w.DerivationPath,
+			addressIndex:   w.AddressIndex,
+		}
+		if current, ok := currentIndexes[w.ChainType+":"+w.DeviceName.String]; ok {
+			sw.currentIndex = &current
+		}
+		wallets = append(wallets, sw)
+	}
+
+	return wallets, nil
+}
+
+// verifySystemWallet 用种子重新派生系统钱包的地址，与存储的地址和索引比较
+func verifySystemWallet(ctx context.Context, addressService address.Service, deriver address.Deriver, w *systemWallet) *Verification {
+	item := &Verification{
+		Kind:           w.kind,
+		WalletID:       w.walletID,
+		ChainID:        w.chainID,
+		ChainType:      w.chainType,
+		DeviceName:     w.deviceName,
+		Address:        w.address,
+		DerivationPath: w.derivationPath,
+		AddressIndex:   w.addressIndex,
+		CurrentIndex:   w.currentIndex,
+		Status:         VerifyStatusOK,
+	}
+
+	derived, err := deriver.DeriveAddress(ctx, w.derivationPath, w.chainType)
+	if err != nil {
+		item.Status = VerifyStatusError
+		item.Error = ptr(err.Error())
+		item.Repair = ptr(fmt.Sprintf("derivation_path %q cannot be derived for chain type %s, restore it from a backup of the wallets table", w.derivationPath, w.chainType))
+		return item
+	}
+	item.DerivedAddress = &derived
+
+	if !sameAddress(w.chainType, derived, w.address) {
+		item.Status = VerifyStatusMismatch
+		suggestMatchingIndex(ctx, addressService, deriver, w, item)
+		return item
+	}
+
+	if addressService.GetDerivationPath(w.chainType, w.addressIndex) != w.derivationPath {
+		item.Status = VerifyStatusIndexMismatch
+		if index, ok := indexOfPath(addressService, w); ok {
+			item.SuggestedIndex = &index
+			item.SuggestedPath = &w.derivationPath
+			item.Repair = ptr(fmt.Sprintf("Set address_index to %d to match derivation_path %s", index, w.derivationPath))
+		} else {
+			item.Repair = ptr(fmt.Sprintf("derivation_path %s is not a standard path of chain type %s, check how the wallet was created", w.derivationPath, w.chainType))
+		}
+		return item
+	}
+
+	// 热钱包共享 address_indexes 的索引分配，当前索引落后时新建的地址会复用已使用的路径
+	if w.kind == SystemWalletHot && (w.currentIndex == nil || *w.currentIndex < w.addressIndex) {
+		item.Status = VerifyStatusIndexBehind
+		item.Repair = ptr(fmt.Sprintf("Raise address_indexes.current_index of chain type %s and device %q to at least %d",
+			w.chainType, deref(w.deviceName), w.addressIndex))
+	}
+
+	return item
+}
+
+// suggestMatchingIndex 查找派生出存储地址的索引，作为修复建议
+func suggestMatchingIndex(ctx context.Context, addressService address.Service, deriver address.Deriver, w *systemWallet, item *Verification) {
+	limit := w.addressIndex
+	if w.currentIndex != nil {
+		limit = max(limit, *w.currentIndex)
+	}
+	limit += repairSearchWindow
+
+	for index := 0; index <= limit; index++ {
+		path := addressService.GetDerivationPath(w.chainType, index)
+		derived, err := deriver.DeriveAddress(ctx, path, w.chainType)
+		if err != nil {
+			break
+		}
+		if sameAddress(w.chainType, derived, w.address) {
+			item.SuggestedIndex = &index
+			item.SuggestedPath = &path
+			item.Repair = ptr(fmt.Sprintf("Update derivation_path to %s and address_index to %d", path, index))
+			return
+		}
+	}
+
+	if w.kind == SystemWalletVerification {
+		item.Repair = ptr("The loaded seed does not derive the verification address, the mnemonic or passphrase restored for this keystore is wrong")
+		return
+	}
+	item.Repair = ptr(fmt.Sprintf("No address index in 0-%d derives the stored address from the loaded seed, "+
+		"check the restored mnemonic and stop sending withdraws from this wallet", limit))
+}
+
+// indexOfPath 查找标准派生路径等于钱包派生路径的索引
+func indexOfPath(addressService address.Service, w *systemWallet) (int, bool) {
+	limit := w.addressIndex
+	if w.currentIndex != nil {
+		limit = max(limit, *w.currentIndex)
+	}
+	limit += repairSearchWindow
+
+	for index := 0; index <= limit; index++ {
+		if addressService.GetDerivationPath(w.chainType, index) == w.derivationPath {
+			return index, true
+		}
+	}
+
+	return 0, false
+}
+
+// sameAddress 比较地址，EVM 地址不区分大小写（校验和格式），其他链的地址区分大小写
+func sameAddress(chainType string, a string, b string) bool {
+	if chainType == chain.TypeEVM {
+		return strings.EqualFold(a, b)
+	}
+
+	return a == b
+}
+
+// alertOnChange 状态变化时告警：地址不一致和派生失败为严重告警
+func (v *verifier) alertOnChange(ctx context.Context, item *Verification) {
+	key := item.Kind
+	if item.WalletID != nil {
+		key += ":" + *item.WalletID
+	}
+
+	v.mu.Lock()
+	previous, ok := v.statuses[key]
+	v.statuses[key] = item.Status
+	v.mu.Unlock()
+
+	if !ok {
+		previous = VerifyStatusOK
+	}
+	if previous == item.Status {
+		return
+	}
+
+	fields := map[string]any{
+		"kind":            item.Kind,
+		"address":         item.Address,
+		"derivation_path": item.DerivationPath,
+		"address_index":   item.AddressIndex,
+		"status":          item.Status,
+	}
+	if item.WalletID != nil {
+		fields["wallet_id"] = *item.WalletID
+	}
+	if item.DerivedAddress != nil {
+		fields["derived_address"] = *item.DerivedAddress
+	}
+	if item.Repair != nil {
+		fields["repair"] = *item.Repair
+	}
+
+	a := &alert.Alert{Fields: fields}
+	if item.ChainID != nil {
+		a.ChainID = *item.ChainID
+	}
+	switch item.Status {
+	case VerifyStatusMismatch, VerifyStatusError:
+		a.Type = alert.TypeSystemWalletMismatch
+		a.Severity = alert.SeverityCritical
+		a.Message = fmt.Sprintf("System wallet %s derivation path does not derive its stored address", item.Address)
+	case VerifyStatusIndexMismatch, VerifyStatusIndexBehind:
+		a.Type = alert.TypeSystemWalletIndexInconsistent
+		a.Severity = alert.SeverityWarning
+		a.Message = fmt.Sprintf("System wallet %s address index is inconsistent", item.Address)
+	default:
+		a.Type = alert.TypeSystemWalletRecovered
+		a.Severity = alert.SeverityInfo
+		a.Message = fmt.Sprintf("System wallet %s derivation path verified", item.Address)
+	}
+
+	notifier := v.notifier
+	if notifier == nil {
+		notifier = alert.NewNotifier("", nil, nil)
+	}
+	_ = notifier.Notify(ctx, a)
+}
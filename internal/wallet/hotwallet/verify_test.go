@@ -0,0 +1,130 @@
+package hotwallet
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github/chapool/go-wallet/internal/wallet/address"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/seed"
+	"github/chapool/go-wallet/internal/wallet/testvectors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySystemWallet(t *testing.T) {
+	t.Parallel()
+
+	addressService, err := address.NewService(nil, address.Config{})
+	require.NoError(t, err)
+
+	// abandon/0、abandon/1、abandon/2
+	vectors := testvectors.EVMDerivationVectors[:3]
+	deriver := testDeriver(t, addressService, vectors[0].Mnemonic, vectors[0].Passphrase)
+	intPtr := func(i int) *int { return &i }
+
+	tests := []struct {
+		name           string
+		wallet         systemWallet
+		status         string
+		suggestedIndex *int
+	}{
+		{
+			name: "OK",
+			wallet: systemWallet{kind: SystemWalletHot, chainType: chain.TypeEVM, address: strings.ToLower(vectors[1].Address),
+				derivationPath: vectors[1].Path, addressIndex: 1, currentIndex: intPtr(2)},
+			status: VerifyStatusOK,
+		},
+		{
+			name: "PathDerivesOtherAddress",
+			wallet: systemWallet{kind: SystemWalletHot, chainType: chain.TypeEVM, address: vectors[2].Address,
+				derivationPath: vectors[1].Path, addressIndex: 1, currentIndex: intPtr(2)},
+			status:         VerifyStatusMismatch,
+			suggestedIndex: intPtr(2),
+		},
+		{
+			name: "AddressIndexDoesNotMatchPath",
+			wallet: systemWallet{kind: SystemWalletHot, chainType: chain.TypeEVM, address: vectors[1].Address,
+				derivationPath: vectors[1].Path, addressIndex: 2, currentIndex: intPtr(2)},
+			status:         VerifyStatusIndexMismatch,
+			suggestedIndex: intPtr(1),
+		},
+		{
+			name: "AddressIndexTableBehind",
+			wallet: systemWallet{kind: SystemWalletHot, chainType: chain.TypeEVM, address: vectors[2].Address,
+				derivationPath: vectors[2].Path, addressIndex: 2, currentIndex: intPtr(1)},
+			status: VerifyStatusIndexBehind,
+		},
+		{
+			name: "AddressIndexTableMissing",
+			wallet: systemWallet{kind: SystemWalletHot, chainType: chain.TypeEVM, address: vectors[2].Address,
+				derivationPath: vectors[2].Path, addressIndex: 2},
+			status: VerifyStatusIndexBehind,
+		},
+		{
+			name: "VerificationAddressNotInIndexTable",
+			wallet: systemWallet{kind: SystemWalletVerification, chainType: chain.TypeEVM, address: vectors[0].Address,
+				derivationPath: vectors[0].Path, addressIndex: 0},
+			status: VerifyStatusOK,
+		},
+		{
+			name: "InvalidPath",
+			wallet: systemWallet{kind: SystemWalletHot, chainType: chain.TypeEVM, address: vectors[0].Address,
+				derivationPath: "m/44'/60'/x", addressIndex: 0, currentIndex: intPtr(0)},
+			status: VerifyStatusError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			item := verifySystemWallet(context.Background(), addressService, deriver, &tt.wallet)
+			assert.Equal(t, tt.status, item.Status)
+			assert.Equal(t, tt.suggestedIndex, item.SuggestedIndex)
+			if tt.status == VerifyStatusOK {
+				assert.Nil(t, item.Repair)
+			} else {
+				assert.NotNil(t, item.Repair)
+			}
+		})
+	}
+}
+
+func TestVerifySystemWalletForeignSeed(t *testing.T) {
+	t.Parallel()
+
+	addressService, err := address.NewService(nil, address.Config{})
+	require.NoError(t, err)
+
+	// 用其他助记词派生的地址在搜索范围内找不到
+	vector := testvectors.EVMDerivationVectors[0]
+	deriver := testDeriver(t, addressService, vector.Mnemonic, "TREZOR")
+	item := verifySystemWallet(context.Background(), addressService, deriver, &systemWallet{
+		kind:           SystemWalletVerification,
+		chainType:      chain.TypeEVM,
+		address:        vector.Address,
+		derivationPath: vector.Path,
+		addressIndex:   0,
+	})
+
+	assert.Equal(t, VerifyStatusMismatch, item.Status)
+	assert.Nil(t, item.SuggestedIndex)
+	require.NotNil(t, item.Repair)
+	assert.Contains(t, *item.Repair, "mnemonic or passphrase")
+}
+
+// testDeriver 返回用助记词和 BIP39 密码派生地址的 Deriver
+//
+//nolint:ireturn // 测试辅助函数返回接口
+func testDeriver(t *testing.T, addressService address.Service, mnemonic string, passphrase string) address.Deriver {
+	t.Helper()
+
+	seedManager := seed.NewManager()
+	require.NoError(t, seedManager.Initialize(mnemonic, passphrase))
+	t.Cleanup(seedManager.Clear)
+
+	return address.NewSeedDeriver(seedManager, addressService)
+}
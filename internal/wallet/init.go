@@ -91,17 +91,10 @@ func InitializeKeystore(ctx context.Context, db *sql.DB, seedManager seed.Manage
 			return errors.Wrap(err, "failed to read password")
 		}
 
-		// Get keystore
-		//nolint:varnamelen // ks is a common abbreviation for keystore
-		ks, err := keystoreService.GetKeystore(ctx)
-		if err != nil {
-			return errors.Wrap(err, "failed to get keystore")
-		}
-
 		// Decrypt mnemonic
-		mnemonic, err := keystoreService.DecryptMnemonic(ctx, ks, password)
+		mnemonic, err := decryptKeystore(ctx, keystoreService, password)
 		if err != nil {
-			return errors.Wrap(err, "failed to decrypt keystore (invalid password?)")
+			return err
 		}
 
 		// Initialize seed manager
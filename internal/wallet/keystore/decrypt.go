@@ -49,7 +49,7 @@ func (s *service) decryptMnemonic(keystoreJSON *KeystoreJSON, password string) (
 	// Verify MAC
 	mac := calculateMAC(derivedKey[16:32], ciphertext)
 	if !constantTimeCompare(mac, expectedMAC) {
-		return "", fmt.Errorf("invalid password: MAC mismatch")
+		return "", ErrInvalidPassword
 	}
 
 	// Decrypt mnemonic using AES-128-CTR
@@ -0,0 +1,67 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github/chapool/go-wallet/internal/wallet/testvectors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// goldenKeystore is a keystore recorded from this implementation with a fixed salt and IV and
+// low-cost scrypt parameters. The MAC is SHA-256 (not Keccak-256 as in Web3 Secret Storage),
+// so existing keystores only decrypt as long as this vector keeps passing.
+func goldenKeystore() *KeystoreJSON {
+	keystoreJSON := &KeystoreJSON{Version: 3, ID: "5f3b7d2e-8c1a-4e6b-9f0d-2a4c6e8b0d1f"}
+	keystoreJSON.Crypto.Cipher = "aes-128-ctr"
+	keystoreJSON.Crypto.CipherParams.IV = "a0a1a2a3a4a5a6a7a8a9aaabacadaeaf"
+	keystoreJSON.Crypto.Ciphertext = "4d9feb6de36b46a7fda387f96ec82d280b5114e7a3b1242d780d5f4252442ee5b6db715699efdab924adf0231f75df16e2ff15f626c934b1cb64d06371f2014748a6d1bb026fe8cdae94538dc289c606e018ee90e7fe30a77dedfb7738"
+	keystoreJSON.Crypto.KDF = "scrypt"
+	keystoreJSON.Crypto.KDFParams.DKLen = 32
+	keystoreJSON.Crypto.KDFParams.N = 1024
+	keystoreJSON.Crypto.KDFParams.R = 8
+	keystoreJSON.Crypto.KDFParams.P = 1
+	keystoreJSON.Crypto.KDFParams.Salt = "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+	keystoreJSON.Crypto.MAC = "64f80524610db4c04718dafb3dcee01b04a7efeba8b2f49fa248f35d091dd993"
+
+	return keystoreJSON
+}
+
+func TestDecryptGoldenKeystore(t *testing.T) {
+	t.Parallel()
+
+	mnemonic, err := (&service{}).decryptMnemonic(goldenKeystore(), "testpassword")
+	require.NoError(t, err)
+	assert.Equal(t, testvectors.AbandonMnemonic, mnemonic)
+
+	_, err = (&service{}).decryptMnemonic(goldenKeystore(), "testpassword2")
+	require.ErrorIs(t, err, ErrInvalidPassword)
+}
+
+func TestEncryptDecryptRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	s := &service{}
+	keystoreJSON, err := s.encryptMnemonic(testvectors.AbandonMnemonic, "correct horse battery staple")
+	require.NoError(t, err)
+
+	defaults := DefaultScryptParams()
+	assert.Equal(t, 3, keystoreJSON.Version)
+	assert.Equal(t, "aes-128-ctr", keystoreJSON.Crypto.Cipher)
+	assert.Equal(t, "scrypt", keystoreJSON.Crypto.KDF)
+	assert.Equal(t, defaults.N, keystoreJSON.Crypto.KDFParams.N)
+	assert.Equal(t, defaults.R, keystoreJSON.Crypto.KDFParams.R)
+	assert.Equal(t, defaults.P, keystoreJSON.Crypto.KDFParams.P)
+	assert.Equal(t, defaults.DKLen, keystoreJSON.Crypto.KDFParams.DKLen)
+	assert.NotContains(t, keystoreJSON.Crypto.Ciphertext, hex.EncodeToString([]byte("abandon")))
+
+	mnemonic, err := s.decryptMnemonic(keystoreJSON, "correct horse battery staple")
+	require.NoError(t, err)
+	assert.Equal(t, testvectors.AbandonMnemonic, mnemonic)
+
+	_, err = s.decryptMnemonic(keystoreJSON, "wrong password")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "MAC mismatch")
+}
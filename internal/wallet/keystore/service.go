@@ -11,6 +11,9 @@ import (
 	"github/chapool/go-wallet/internal/util"
 )
 
+// ErrInvalidPassword is returned when the keystore MAC does not match the password
+var ErrInvalidPassword = errors.New("invalid password: MAC mismatch")
+
 // Service provides keystore encryption and decryption functionality
 type Service interface {
 	// CreateKeystore creates and encrypts a mnemonic to keystore
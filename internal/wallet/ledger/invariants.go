@@ -0,0 +1,215 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/balance"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// 不变量检查项
+const (
+	CheckNegativeBalance        = "negative_balance"         // 用户代币余额为负
+	CheckDepositCreditMismatch  = "deposit_credit_mismatch"  // 充值 credits 与链上交易记录不一致
+	CheckWithdrawCreditMismatch = "withdraw_credit_mismatch" // 提现 credits 与提现记录不一致
+	CheckStatsMismatch          = "stats_mismatch"           // 用户统计汇总与 credits 不一致
+	CheckCollectCustodyMismatch = "collect_custody_mismatch" // 归集交易与托管流转记录不一致
+)
+
+// InvariantViolation 不变量违规记录
+type InvariantViolation struct {
+	Check       string
+	UserID      string
+	TokenID     int
+	ReferenceID string // 关联的 credit / withdraw / transaction ID，可能为空
+	Expected    string
+	Actual      string
+}
+
+// InvariantReport 不变量检查结果
+type InvariantReport struct {
+	CheckedAt  time.Time
+	Violations []*InvariantViolation
+}
+
+// invariantQuery 单项不变量检查，SQL 需返回 user_id, token_id, reference_id, expected, actual
+type invariantQuery struct {
+	check string
+	query string
+}
+
+var invariantQueries = []invariantQuery{
+	{
+		// 计入余额的 credits 之和（代币单位）不能为负
+		check: CheckNegativeBalance,
+		query: `
+			SELECT credits.user_id::text, credits.token_id, '', '>= 0', SUM(` + balance.TokenAmountSQL + `)::text
+			FROM credits
+			JOIN tokens t ON t.id = credits.token_id
+			WHERE ` + balance.EffectiveCreditSQL + `
+			GROUP BY credits.user_id, credits.token_id
+			HAVING SUM(` + balance.TokenAmountSQL + `) < 0
+		`,
+	},
+	{
+		// 每条充值 credit 必须对应一条金额相同的链上交易
+		check: CheckDepositCreditMismatch,
+		query: `
+			SELECT c.user_id::text, c.token_id, c.id::text, COALESCE(t.amount, ''), c.amount
+			FROM credits c
+			LEFT JOIN transactions t ON t.id::text = c.reference_id
+			WHERE c.credit_type = 'deposit'
+				AND c.reference_type = 'blockchain_tx'
+				AND (t.id IS NULL OR t.amount::numeric <> c.amount::numeric)
+		`,
+	},
+	{
+		// 每条提现必须有一条金额相反的 credit，且仅在提现失败时 credit 被冲正（旧数据为 failed）
+		check: CheckWithdrawCreditMismatch,
+		query: `
+			SELECT w.user_id::text, w.token_id, w.id::text,
+				'-' || w.amount || ' (' || CASE WHEN w.status = 'failed' THEN 'failed' ELSE 'not reversed' END || ')',
+				COALESCE(c.amount || ' (' || c.status || CASE WHEN r.id IS NOT NULL THEN ', reversed' ELSE '' END || ')', '')
+			FROM withdraws w
+			LEFT JOIN credits c ON c.reference_type = 'withdraw' AND c.credit_type = 'withdraw' AND c.reference_id = w.id::text
+			LEFT JOIN credits r ON r.reference_type = 'withdraw' AND r.credit_type = 'withdraw_reversal'
+				AND r.metadata->>'reverses_credit_id' = c.id::text
+			WHERE c.id IS NULL
+				OR c.amount::numeric <> -(w.amount::numeric)
+				OR (w.status <> 'failed' AND (c.status = 'failed' OR r.id IS NOT NULL))
+				OR (r.id IS NOT NULL AND r.amount::numeric <> -(c.amount::numeric))
+		`,
+	},
+	{
+		// 统计汇总表中的累计充值（代币单位）必须与已终结的充值 credits（最小单位）按代币精度换算后一致
+		check: CheckStatsMismatch,
+		query: `
+			SELECT COALESCE(s.user_id, c.user_id)::text, COALESCE(s.token_id, c.token_id), '',
+				COALESCE(c.total, 0)::text || ' (' || COALESCE(c.cnt, 0) || ')',
+				COALESCE(s.total_deposited, 0)::text || ' (' || COALESCE(s.deposit_count, 0) || ')'
+			FROM user_wallet_stats s
+			FULL OUTER JOIN (
+				SELECT cr.user_id, cr.token_id, SUM(cr.amount::numeric) * POWER(10::numeric, -t.decimals) AS total, COUNT(*) AS cnt
+				FROM credits cr
+				JOIN tokens t ON t.id = cr.token_id
+				WHERE cr.credit_type = 'deposit' AND cr.status = 'finalized'
+				GROUP BY cr.user_id, cr.token_id, t.decimals
+			) c ON c.user_id = s.user_id AND c.token_id = s.token_id
+			WHERE COALESCE(c.total, 0) <> COALESCE(s.total_deposited, 0)
+				OR COALESCE(c.cnt, 0) <> COALESCE(s.deposit_count, 0)
+		`,
+	},
+	{
+		// 每条归集交易必须有一条金额相同的托管流转记录（custody_moves）
+		check: CheckCollectCustodyMismatch,
+		query: `
+			SELECT COALESCE(m.user_id::text, ''), COALESCE(tk.id, 0), t.id::text, t.amount, COALESCE(m.amount, '')
+			FROM transactions t
+			LEFT JOIN custody_moves m ON m.transaction_id = t.id
+			LEFT JOIN tokens tk ON tk.chain_id = t.chain_id
+				AND ((t.token_addr IS NULL AND tk.is_native) OR LOWER(tk.token_address) = LOWER(t.token_addr))
+			WHERE t.type = 'collect'
+				AND (m.id IS NULL OR m.amount <> t.amount)
+		`,
+	},
+}
+
+// CheckInvariants 检查账本不变量
+func (s *service) CheckInvariants(ctx context.Context) (*InvariantReport, error) {
+	report := &InvariantReport{
+		CheckedAt:  time.Now(),
+		Violations: make([]*InvariantViolation, 0),
+	}
+
+	for _, iq := range invariantQueries {
+		violations, err := s.runInvariantQuery(ctx, iq)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to check invariant %s", iq.check)
+		}
+
+		report.Violations = append(report.Violations, violations...)
+	}
+
+	return report, nil
+}
+
+// StartInvariantsChecker 启动定时不变量检查
+func (s *service) StartInvariantsChecker(ctx context.Context, interval time.Duration) {
+	log.Info().
+		Dur("interval", interval).
+		Msg("Starting ledger invariants checker")
+
+	lifecycle.Go(ctx, "ledger invariants checker", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Ledger invariants checker stopped")
+				return
+			case <-ticker.C:
+				s.logInvariantViolations(ctx)
+			}
+		}
+	})
+}
+
+// logInvariantViolations 执行一次不变量检查并记录违规
+func (s *service) logInvariantViolations(ctx context.Context) {
+	report, err := s.CheckInvariants(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Ledger invariants check failed")
+		return
+	}
+
+	for _, v := range report.Violations {
+		log.Error().
+			Str("check", v.Check).
+			Str("user_id", v.UserID).
+			Int("token_id", v.TokenID).
+			Str("reference_id", v.ReferenceID).
+			Str("expected", v.Expected).
+			Str("actual", v.Actual).
+			Msg("Ledger invariant violated")
+	}
+
+	if len(report.Violations) == 0 {
+		log.Debug().Msg("Ledger invariants check passed")
+	}
+}
+
+// runInvariantQuery 执行单项不变量检查
+func (s *service) runInvariantQuery(ctx context.Context, iq invariantQuery) ([]*InvariantViolation, error) {
+	rows, err := s.db.QueryContext(ctx, iq.query)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query invariant")
+	}
+	defer rows.Close()
+
+	var violations []*InvariantViolation
+	for rows.Next() {
+		var (
+			v           = &InvariantViolation{Check: iq.check}
+			referenceID sql.NullString
+		)
+
+		if err := rows.Scan(&v.UserID, &v.TokenID, &referenceID, &v.Expected, &v.Actual); err != nil {
+			return nil, errors.Wrap(err, "failed to scan invariant violation")
+		}
+		v.ReferenceID = referenceID.String
+
+		violations = append(violations, v)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate invariant violations")
+	}
+
+	return violations, nil
+}
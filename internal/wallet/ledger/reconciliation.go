@@ -0,0 +1,215 @@
+package ledger
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/wallet/balance"
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+)
+
+// ReconciliationItem 单个代币的对账结果（金额均为人类可读单位）
+type ReconciliationItem struct {
+	ChainID          int
+	TokenID          int
+	TokenSymbol      string
+	LedgerTotal      *big.Float // 账本中所有用户计入余额的 credits 之和
+	OnchainUserTotal *big.Float // 链上所有用户钱包余额之和
+	OnchainHotTotal  *big.Float // 链上所有热钱包余额之和
+	Difference       *big.Float // 链上总额 - 账本总额，负数表示链上资金不足
+	FailedWallets    int        // 查询链上余额失败的钱包数量（失败时对应余额未计入）
+}
+
+// ReconciliationReport 对账报告
+type ReconciliationReport struct {
+	GeneratedAt time.Time
+	Items       []*ReconciliationItem
+}
+
+// GetReconciliationReport 生成账本总额与链上余额的对账报告
+func (s *service) GetReconciliationReport(ctx context.Context, chainID *int) (*ReconciliationReport, error) {
+	chainMods := []qm.QueryMod{
+		models.ChainWhere.IsActive.EQ(true),
+		qm.OrderBy(models.ChainColumns.ChainID + " ASC"),
+	}
+	if chainID != nil {
+		chainMods = append(chainMods, models.ChainWhere.ChainID.EQ(*chainID))
+	}
+
+	chains, err := models.Chains(chainMods...).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query active chains")
+	}
+
+	ledgerTotals, err := s.getLedgerTotals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mu       sync.Mutex
+		itemsMap = make(map[int][]*ReconciliationItem, len(chains))
+		g        errgroup.Group
+	)
+	g.SetLimit(max(s.config.WorkerConcurrency, 1))
+
+	for _, c := range chains {
+		g.Go(func() error {
+			items, err := s.reconcileChain(ctx, c.ChainID, ledgerTotals)
+			if err != nil {
+				return errors.Wrapf(err, "failed to reconcile chain_id=%d", c.ChainID)
+			}
+
+			mu.Lock()
+			itemsMap[c.ChainID] = items
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	report := &ReconciliationReport{
+		GeneratedAt: time.Now(),
+		Items:       make([]*ReconciliationItem, 0),
+	}
+	// 按链顺序输出
+	for _, c := range chains {
+		report.Items = append(report.Items, itemsMap[c.ChainID]...)
+	}
+
+	return report, nil
+}
+
+// reconcileChain 对单条链上的所有活跃代币进行对账
+func (s *service) reconcileChain(ctx context.Context, chainID int, ledgerTotals map[int]*big.Float) ([]*ReconciliationItem, error) {
+	tokens, err := models.Tokens(
+		models.TokenWhere.ChainID.EQ(chainID),
+		models.TokenWhere.IsActive.EQ(true),
+		qm.OrderBy(models.TokenColumns.ID+" ASC"),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query tokens")
+	}
+
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	wallets, err := models.Wallets(
+		models.WalletWhere.ChainID.EQ(chainID),
+		models.WalletWhere.WalletType.IN([]models.WalletType{models.WalletTypeUser, models.WalletTypeHot, models.WalletTypeWatch}),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query wallets")
+	}
+
+	client, err := s.scanService.GetClient(ctx, chainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get RPC client")
+	}
+
+	items := make([]*ReconciliationItem, 0, len(tokens))
+	for _, token := range tokens {
+		item := &ReconciliationItem{
+			ChainID:          chainID,
+			TokenID:          token.ID,
+			TokenSymbol:      token.TokenSymbol,
+			LedgerTotal:      new(big.Float),
+			OnchainUserTotal: new(big.Float),
+			OnchainHotTotal:  new(big.Float),
+		}
+		if total, ok := ledgerTotals[token.ID]; ok {
+			item.LedgerTotal.Set(total)
+		}
+
+		userWei := new(big.Int)
+		hotWei := new(big.Int)
+		for _, w := range wallets {
+			balance, err := getOnchainBalance(ctx, client, token, common.HexToAddress(w.Address))
+			if err != nil {
+				log.Warn().
+					Err(err).
+					Int("chain_id", chainID).
+					Int("token_id", token.ID).
+					Str("address", w.Address).
+					Msg("Failed to get on-chain balance for reconciliation")
+				item.FailedWallets++
+				continue
+			}
+
+			if w.WalletType == models.WalletTypeHot {
+				hotWei.Add(hotWei, balance)
+			} else {
+				userWei.Add(userWei, balance)
+			}
+		}
+
+		item.OnchainUserTotal = money.FloatFromSmallestUnit(userWei, token.Decimals)
+		item.OnchainHotTotal = money.FloatFromSmallestUnit(hotWei, token.Decimals)
+		onchainTotal := new(big.Float).Add(item.OnchainUserTotal, item.OnchainHotTotal)
+		item.Difference = new(big.Float).Sub(onchainTotal, item.LedgerTotal)
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// getLedgerTotals 按代币汇总账本中计入余额的 credits
+func (s *service) getLedgerTotals(ctx context.Context) (map[int]*big.Float, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT token_id, SUM(amount::numeric)::text
+		FROM credits
+		WHERE `+balance.EffectiveCreditSQL+`
+		GROUP BY token_id
+	`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query ledger totals")
+	}
+	defer rows.Close()
+
+	totals := make(map[int]*big.Float)
+	for rows.Next() {
+		var (
+			tokenID  int
+			totalStr string
+		)
+		if err := rows.Scan(&tokenID, &totalStr); err != nil {
+			return nil, errors.Wrap(err, "failed to scan ledger total")
+		}
+
+		total, err := money.ParseFloat(totalStr)
+		if err != nil {
+			return nil, err
+		}
+		totals[tokenID] = total
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate ledger totals")
+	}
+
+	return totals, nil
+}
+
+// getOnchainBalance 查询地址的原生代币或 ERC20 代币余额（最小单位）
+func getOnchainBalance(ctx context.Context, client *scan.RPCClient, token *models.Token, account common.Address) (*big.Int, error) {
+	if token.IsNative || !token.TokenAddress.Valid || token.TokenAddress.String == "" {
+		return client.BalanceAt(ctx, account)
+	}
+
+	return client.TokenBalance(ctx, common.HexToAddress(token.TokenAddress.String), account)
+}
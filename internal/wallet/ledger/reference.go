@@ -0,0 +1,152 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/wallet/balance"
+)
+
+// CreditDetail 按链上引用查询到的入账详情
+type CreditDetail struct {
+	Entry
+	UserID        string
+	Address       string
+	EventIndex    int
+	BlockNumber   *int64
+	UpdatedAt     time.Time
+	StatusHistory []*StatusChange // 按时间正序
+}
+
+// StatusChange credits 状态变更记录
+type StatusChange struct {
+	FromStatus string // 创建时为空
+	ToStatus   string
+	ChangedAt  time.Time
+}
+
+// GetCreditsByReference 按链上引用（chain_id + tx_hash，可选 event_index）查询 credits 及其状态历史
+// tx_hash 不区分大小写；同一事件可能对应多条 credits（如提现金额与手续费）
+func (s *service) GetCreditsByReference(ctx context.Context, chainID int, txHash string, eventIndex *int) ([]*CreditDetail, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT
+			id, user_id, address, token_id, token_symbol, COALESCE(chain_id, 0), amount,
+			credit_type, business_type, reference_id, reference_type, status,
+			COALESCE(tx_hash, ''), COALESCE(event_index, 0), block_number,
+			`+balance.EffectiveCreditSQL+` AS effective, created_at, updated_at
+		FROM credits
+		WHERE chain_id = $1
+			AND lower(tx_hash) = lower($2)
+			AND ($3::integer IS NULL OR event_index = $3)
+		ORDER BY event_index, created_at, id
+	`, chainID, txHash, eventIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query credits by reference")
+	}
+	defer rows.Close()
+
+	credits := make([]*CreditDetail, 0)
+	byID := make(map[string]*CreditDetail)
+	for rows.Next() {
+		var (
+			credit      CreditDetail
+			amountStr   string
+			blockNumber sql.NullInt64
+		)
+
+		if err := rows.Scan(
+			&credit.ID,
+			&credit.UserID,
+			&credit.Address,
+			&credit.TokenID,
+			&credit.TokenSymbol,
+			&credit.ChainID,
+			&amountStr,
+			&credit.CreditType,
+			&credit.BusinessType,
+			&credit.ReferenceID,
+			&credit.ReferenceType,
+			&credit.Status,
+			&credit.TxHash,
+			&credit.EventIndex,
+			&blockNumber,
+			&credit.Effective,
+			&credit.CreatedAt,
+			&credit.UpdatedAt,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan credit")
+		}
+
+		credit.Amount, err = money.ParseFloat(amountStr)
+		if err != nil {
+			return nil, err
+		}
+
+		if blockNumber.Valid {
+			credit.BlockNumber = &blockNumber.Int64
+		}
+		credit.StatusHistory = make([]*StatusChange, 0)
+
+		credits = append(credits, &credit)
+		byID[credit.ID] = &credit
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate credits")
+	}
+
+	if len(credits) == 0 {
+		return credits, nil
+	}
+
+	if err := s.loadStatusHistory(ctx, byID); err != nil {
+		return nil, err
+	}
+
+	return credits, nil
+}
+
+// loadStatusHistory 批量加载 credits 的状态变更历史
+func (s *service) loadStatusHistory(ctx context.Context, byID map[string]*CreditDetail) error {
+	ids := make([]string, 0, len(byID))
+	for id := range byID {
+		ids = append(ids, id)
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT credit_id, COALESCE(from_status::text, ''), to_status, changed_at
+		FROM credit_status_history
+		WHERE credit_id = ANY($1::uuid[])
+		ORDER BY changed_at, id
+	`, pq.Array(ids))
+	if err != nil {
+		return errors.Wrap(err, "failed to query credit status history")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			creditID string
+			change   StatusChange
+		)
+
+		if err := rows.Scan(&creditID, &change.FromStatus, &change.ToStatus, &change.ChangedAt); err != nil {
+			return errors.Wrap(err, "failed to scan credit status history")
+		}
+
+		if credit, ok := byID[creditID]; ok {
+			credit.StatusHistory = append(credit.StatusHistory, &change)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(err, "failed to iterate credit status history")
+	}
+
+	return nil
+}
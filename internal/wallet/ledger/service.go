@@ -0,0 +1,170 @@
+//nolint:ireturn // 返回接口类型是预期的设计
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"time"
+
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/wallet/balance"
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/pkg/errors"
+)
+
+// Service 账本服务接口
+// 将 credits 表作为账本，提供带累计余额的流水、不变量检查以及与链上余额的对账
+type Service interface {
+	// GetLedger 获取用户账本流水（按时间倒序），每条记录附带该代币截至该条记录的累计余额
+	GetLedger(ctx context.Context, userID string, filter *Filter) ([]*Entry, error)
+
+	// CheckInvariants 检查账本不变量（余额非负、credits 与充值/提现记录一致、统计汇总一致）
+	CheckInvariants(ctx context.Context) (*InvariantReport, error)
+
+	// StartInvariantsChecker 启动定时不变量检查，发现问题时记录错误日志
+	StartInvariantsChecker(ctx context.Context, interval time.Duration)
+
+	// GetReconciliationReport 生成账本总额与链上用户钱包/热钱包余额的对账报告
+	GetReconciliationReport(ctx context.Context, chainID *int) (*ReconciliationReport, error)
+
+	// GetCreditsByReference 按链上引用（chain_id + tx_hash，可选 event_index）查询 credits 及其状态历史
+	GetCreditsByReference(ctx context.Context, chainID int, txHash string, eventIndex *int) ([]*CreditDetail, error)
+}
+
+// Config 账本服务配置
+type Config struct {
+	// WorkerConcurrency 对账时并行处理的链数量上限
+	WorkerConcurrency int
+}
+
+// service 实现 Service 接口
+type service struct {
+	db          *sql.DB
+	config      Config
+	scanService scan.Service
+}
+
+// NewService 创建账本服务
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(db *sql.DB, config Config, scanService scan.Service) Service {
+	return &service{
+		db:          db,
+		config:      config,
+		scanService: scanService,
+	}
+}
+
+// Filter 账本查询条件
+type Filter struct {
+	ChainID *int
+	TokenID *int
+	Limit   int
+	Offset  int
+}
+
+// Entry 账本流水记录
+type Entry struct {
+	ID             string
+	TokenID        int
+	TokenSymbol    string
+	ChainID        int
+	Amount         *big.Float // 代币单位，正数入账、负数出账
+	CreditType     string
+	BusinessType   string
+	ReferenceID    string
+	ReferenceType  string
+	Status         string
+	TxHash         string
+	Effective      bool       // 是否计入余额
+	RunningBalance *big.Float // 截至该条记录的累计余额（仅统计计入余额的记录）
+	CreatedAt      time.Time
+}
+
+// GetLedger 获取用户账本流水，金额和累计余额均为代币单位（充值 credits 按代币精度换算）
+func (s *service) GetLedger(ctx context.Context, userID string, filter *Filter) ([]*Entry, error) {
+	// 窗口函数按代币分区计算累计余额，再分页，保证分页不影响累计值
+	rows, err := s.db.QueryContext(ctx, `
+		WITH entries AS (
+			SELECT
+				credits.id,
+				credits.token_id,
+				credits.token_symbol,
+				COALESCE(credits.chain_id, 0) AS chain_id,
+				`+balance.TokenAmountSQL+` AS amount,
+				credit_type,
+				business_type,
+				reference_id,
+				reference_type,
+				status,
+				COALESCE(tx_hash, '') AS tx_hash,
+				`+balance.EffectiveCreditSQL+` AS effective,
+				SUM(CASE WHEN `+balance.EffectiveCreditSQL+` THEN `+balance.TokenAmountSQL+` ELSE 0 END)
+					OVER (PARTITION BY credits.token_id ORDER BY credits.created_at, credits.id) AS running_balance,
+				credits.created_at
+			FROM credits
+			JOIN tokens t ON t.id = credits.token_id
+			WHERE credits.user_id = $1
+				AND ($2::integer IS NULL OR credits.chain_id = $2)
+				AND ($3::integer IS NULL OR credits.token_id = $3)
+		)
+		SELECT
+			id, token_id, token_symbol, chain_id, amount::text, credit_type, business_type,
+			reference_id, reference_type, status, tx_hash, effective, running_balance::text, created_at
+		FROM entries
+		ORDER BY created_at DESC, id DESC
+		LIMIT $4 OFFSET $5
+	`, userID, filter.ChainID, filter.TokenID, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query ledger entries")
+	}
+	defer rows.Close()
+
+	entries := make([]*Entry, 0)
+	for rows.Next() {
+		var (
+			entry             Entry
+			amountStr         string
+			runningBalanceStr string
+		)
+
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.TokenID,
+			&entry.TokenSymbol,
+			&entry.ChainID,
+			&amountStr,
+			&entry.CreditType,
+			&entry.BusinessType,
+			&entry.ReferenceID,
+			&entry.ReferenceType,
+			&entry.Status,
+			&entry.TxHash,
+			&entry.Effective,
+			&runningBalanceStr,
+			&entry.CreatedAt,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan ledger entry")
+		}
+
+		entry.Amount, err = money.ParseFloat(amountStr)
+		if err != nil {
+			return nil, err
+		}
+
+		entry.RunningBalance, err = money.ParseFloat(runningBalanceStr)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate ledger entries")
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,129 @@
+package ledger_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/test"
+	"github/chapool/go-wallet/internal/test/fixtures"
+	"github/chapool/go-wallet/internal/wallet/ledger"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// insertCredits 依次写入用户的 credits，充值金额为代币单位，写入时换算为代币最小单位
+func insertCredits(t *testing.T, ctx context.Context, db *sql.DB, userID string, token *models.Token, credits []*models.Credit) {
+	t.Helper()
+
+	for i, credit := range credits {
+		if credit.CreditType == models.CreditTypeDeposit {
+			amount, err := money.ToSmallestUnit(credit.Amount, token.Decimals)
+			require.NoError(t, err)
+			credit.Amount = amount.String()
+		}
+		credit.UserID = userID
+		credit.Address = "0x8589427373d6d84e98730d7795d8f6f8731fda16"
+		credit.TokenID = token.ID
+		credit.TokenSymbol = token.TokenSymbol
+		credit.ChainID = null.IntFrom(token.ChainID)
+		credit.ChainType = null.StringFrom(token.ChainType)
+		credit.EventIndex = null.IntFrom(i)
+		require.NoError(t, credit.Insert(ctx, db, boil.Infer()))
+	}
+}
+
+func TestGetLedgerRunningBalance(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		token, err := models.Tokens(qm.OrderBy(models.TokenColumns.ID)).One(ctx, db)
+		require.NoError(t, err)
+
+		insertCredits(t, ctx, db, fix.User1.ID, token, []*models.Credit{
+			{Amount: "10", CreditType: models.CreditTypeDeposit, BusinessType: models.BusinessTypeBlockchain, ReferenceID: "0xdeposit_0", ReferenceType: models.ReferenceTypeBlockchainTX, Status: models.CreditStatusFinalized},
+			// 待确认的充值不计入余额
+			{Amount: "5", CreditType: models.CreditTypeDeposit, BusinessType: models.BusinessTypeBlockchain, ReferenceID: "0xdeposit_1", ReferenceType: models.ReferenceTypeBlockchainTX, Status: models.CreditStatusPending},
+			{Amount: "-2.5", CreditType: models.CreditTypeWithdraw, BusinessType: models.BusinessTypeBlockchain, ReferenceID: "withdraw_0", ReferenceType: models.ReferenceTypeWithdraw, Status: models.CreditStatusFrozen},
+			{Amount: "-0.5", CreditType: models.CreditTypeWithdrawFee, BusinessType: models.BusinessTypeBlockchain, ReferenceID: "withdraw_0", ReferenceType: models.ReferenceTypeWithdraw, Status: models.CreditStatusFrozen},
+			// 拒绝提现的冲正抵消冻结的提现金额和手续费
+			{Amount: "2.5", CreditType: models.CreditTypeWithdrawReversal, BusinessType: models.BusinessTypeBlockchain, ReferenceID: "withdraw_0", ReferenceType: models.ReferenceTypeWithdraw, Status: models.CreditStatusFrozen},
+			{Amount: "0.5", CreditType: models.CreditTypeWithdrawReversal, BusinessType: models.BusinessTypeBlockchain, ReferenceID: "withdraw_0", ReferenceType: models.ReferenceTypeWithdraw, Status: models.CreditStatusFrozen},
+			{Amount: "-4", CreditType: models.CreditTypeInternal, BusinessType: models.BusinessTypeInternalTransfer, ReferenceID: "transfer_0", ReferenceType: models.ReferenceTypeInternalTransfer, Status: models.CreditStatusFinalized},
+		})
+
+		entries, err := ledger.NewService(db, ledger.Config{}, nil).GetLedger(ctx, fix.User1.ID, &ledger.Filter{Limit: 100})
+		require.NoError(t, err)
+		require.Len(t, entries, 7)
+
+		// 按时间倒序返回，金额和累计余额均为代币单位
+		expected := []struct {
+			amount         string
+			effective      bool
+			runningBalance string
+		}{
+			{"-4", true, "6"},
+			{"0.5", true, "10"},
+			{"2.5", true, "9.5"},
+			{"-0.5", true, "7"},
+			{"-2.5", true, "7.5"},
+			{"5", false, "10"},
+			{"10", true, "10"},
+		}
+		for i, want := range expected {
+			assert.Equal(t, want.amount, entries[i].Amount.Text('f', -1), "entry %d amount", i)
+			assert.Equal(t, want.effective, entries[i].Effective, "entry %d effective", i)
+			assert.Equal(t, want.runningBalance, entries[i].RunningBalance.Text('f', -1), "entry %d running balance", i)
+		}
+
+		// 分页不影响累计余额
+		entries, err = ledger.NewService(db, ledger.Config{}, nil).GetLedger(ctx, fix.User1.ID, &ledger.Filter{Limit: 1, Offset: 6})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "10", entries[0].RunningBalance.Text('f', -1))
+	})
+}
+
+func TestCheckInvariantsNegativeBalance(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		token, err := models.Tokens(qm.OrderBy(models.TokenColumns.ID)).One(ctx, db)
+		require.NoError(t, err)
+
+		// User1 转出的金额不超过充值金额，User2 转出的金额超过充值金额
+		insertCredits(t, ctx, db, fix.User1.ID, token, []*models.Credit{
+			{Amount: "2", CreditType: models.CreditTypeDeposit, BusinessType: models.BusinessTypeBlockchain, ReferenceID: "0xdeposit_0", ReferenceType: models.ReferenceTypeBlockchainTX, Status: models.CreditStatusFinalized},
+			{Amount: "-2", CreditType: models.CreditTypeInternal, BusinessType: models.BusinessTypeInternalTransfer, ReferenceID: "transfer_0", ReferenceType: models.ReferenceTypeInternalTransfer, Status: models.CreditStatusFinalized},
+		})
+		insertCredits(t, ctx, db, fix.User2.ID, token, []*models.Credit{
+			{Amount: "1", CreditType: models.CreditTypeDeposit, BusinessType: models.BusinessTypeBlockchain, ReferenceID: "0xdeposit_1", ReferenceType: models.ReferenceTypeBlockchainTX, Status: models.CreditStatusFinalized},
+			{Amount: "-1.5", CreditType: models.CreditTypeInternal, BusinessType: models.BusinessTypeInternalTransfer, ReferenceID: "transfer_1", ReferenceType: models.ReferenceTypeInternalTransfer, Status: models.CreditStatusFinalized},
+		})
+
+		report, err := ledger.NewService(db, ledger.Config{}, nil).CheckInvariants(ctx)
+		require.NoError(t, err)
+
+		var negative []*ledger.InvariantViolation
+		for _, v := range report.Violations {
+			if v.Check == ledger.CheckNegativeBalance {
+				negative = append(negative, v)
+			}
+		}
+		require.Len(t, negative, 1)
+		assert.Equal(t, fix.User2.ID, negative[0].UserID)
+		assert.Equal(t, token.ID, negative[0].TokenID)
+
+		actual, err := money.ParseFloat(negative[0].Actual)
+		require.NoError(t, err)
+		assert.Equal(t, "-0.5", actual.Text('f', -1))
+	})
+}
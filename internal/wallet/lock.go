@@ -0,0 +1,112 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/address"
+	"github/chapool/go-wallet/internal/wallet/keystore"
+	"github/chapool/go-wallet/internal/wallet/seed"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidKeystorePassword is returned when unlocking the keystore with a wrong password
+var ErrInvalidKeystorePassword = errors.New("invalid keystore password")
+
+// KeystoreLock locks and unlocks the seed at runtime
+// While the seed is locked signing and address derivation are disabled
+type KeystoreLock interface {
+	// Lock wipes the seed from memory
+	Lock(ctx context.Context)
+
+	// Unlock decrypts the keystore with password and initializes the seed again
+	Unlock(ctx context.Context, password string) error
+
+	// Status returns the lock state of the seed
+	Status() seed.Status
+}
+
+type keystoreLock struct {
+	db              *sql.DB
+	seedManager     seed.Manager
+	keystoreService keystore.Service
+	addressService  address.Service
+}
+
+// NewKeystoreLock creates a new KeystoreLock
+//
+//nolint:ireturn // Returning interface is intentional for dependency injection
+func NewKeystoreLock(db *sql.DB, seedManager seed.Manager, keystoreService keystore.Service, addressService address.Service) KeystoreLock {
+	return &keystoreLock{
+		db:              db,
+		seedManager:     seedManager,
+		keystoreService: keystoreService,
+		addressService:  addressService,
+	}
+}
+
+// Lock wipes the seed from memory
+func (l *keystoreLock) Lock(ctx context.Context) {
+	l.seedManager.Lock()
+
+	util.LogFromContext(ctx).Warn().Msg("Keystore locked, signing is disabled until the keystore is unlocked")
+}
+
+// Unlock decrypts the keystore with password and initializes the seed again
+// The password is verified against the stored verification address before the seed is replaced,
+// so a wrong password never replaces an unlocked seed
+func (l *keystoreLock) Unlock(ctx context.Context, password string) error {
+	mnemonic, err := decryptKeystore(ctx, l.keystoreService, password)
+	if err != nil {
+		return err
+	}
+
+	candidate := seed.NewManager()
+	defer candidate.Clear()
+
+	if err := candidate.Initialize(mnemonic, password); err != nil {
+		return errors.Wrap(err, "failed to initialize seed manager")
+	}
+
+	valid, err := VerifyPasswordByAddress(ctx, candidate, l.addressService, l.db)
+	if err != nil {
+		return errors.Wrap(err, "failed to verify password")
+	}
+	if !valid {
+		return ErrInvalidKeystorePassword
+	}
+
+	if err := l.seedManager.Initialize(mnemonic, password); err != nil {
+		return errors.Wrap(err, "failed to initialize seed manager")
+	}
+
+	util.LogFromContext(ctx).Info().Msg("Keystore unlocked")
+
+	return nil
+}
+
+// Status returns the lock state of the seed
+func (l *keystoreLock) Status() seed.Status {
+	return l.seedManager.Status()
+}
+
+// decryptKeystore decrypts the mnemonic from the stored keystore
+func decryptKeystore(ctx context.Context, keystoreService keystore.Service, password string) (string, error) {
+	//nolint:varnamelen // ks is a common abbreviation for keystore
+	ks, err := keystoreService.GetKeystore(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get keystore")
+	}
+
+	mnemonic, err := keystoreService.DecryptMnemonic(ctx, ks, password)
+	if err != nil {
+		if errors.Is(err, keystore.ErrInvalidPassword) {
+			return "", ErrInvalidKeystorePassword
+		}
+		return "", errors.Wrap(err, "failed to decrypt keystore")
+	}
+
+	return mnemonic, nil
+}
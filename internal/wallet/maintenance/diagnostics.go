@@ -0,0 +1,291 @@
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/util/lifecycle"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// Diagnose 生成数据库诊断报告
+// 慢查询依赖 pg_stat_statements，扩展不可用时报告其余部分并记录原因
+func (s *service) Diagnose(ctx context.Context) (*Report, error) {
+	tables, err := s.queryTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	indexes, err := s.queryIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	indexIssues(indexes)
+
+	report := &Report{
+		GeneratedAt: time.Now(),
+		Tables:      tables,
+		Indexes:     indexes,
+		SlowQueries: []*SlowQuery{},
+		Suggestions: missingIndexSuggestions(tables, indexes),
+	}
+
+	slowQueries, err := s.querySlowQueries(ctx)
+	if err != nil {
+		report.SlowQueriesError = err.Error()
+	} else {
+		report.SlowQueriesAvailable = true
+		report.SlowQueries = slowQueries
+	}
+
+	return report, nil
+}
+
+// StartReporter 启动定时数据库诊断
+func (s *service) StartReporter(ctx context.Context, interval time.Duration) {
+	log.Info().
+		Dur("interval", interval).
+		Msg("Starting database maintenance reporter")
+
+	lifecycle.Go(ctx, "database maintenance reporter", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Database maintenance reporter stopped")
+				return
+			case <-ticker.C:
+				s.logReport(ctx)
+			}
+		}
+	})
+}
+
+// logReport 执行一次诊断并记录发现的问题
+func (s *service) logReport(ctx context.Context) {
+	report, err := s.Diagnose(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Database diagnostics failed")
+		return
+	}
+
+	var problems int
+	for _, table := range report.Tables {
+		if !table.Bloated {
+			continue
+		}
+		problems++
+		event := log.Warn().
+			Str("table", table.Table).
+			Int64("live_rows", table.LiveRows).
+			Int64("dead_rows", table.DeadRows).
+			Float64("dead_row_ratio", table.DeadRowRatio).
+			Int64("total_bytes", table.TotalBytes)
+		if table.LastVacuumAt != nil {
+			event = event.Time("last_vacuum_at", *table.LastVacuumAt)
+		}
+		event.Msg("Table is bloated, check autovacuum settings")
+	}
+
+	for _, idx := range report.Indexes {
+		if len(idx.Issues) == 0 {
+			continue
+		}
+		problems++
+		log.Warn().
+			Str("index", idx.Index).
+			Str("table", idx.Table).
+			Strs("issues", idx.Issues).
+			Int64("size_bytes", idx.SizeBytes).
+			Int64("scans", idx.Scans).
+			Msg("Index needs attention")
+	}
+
+	for _, suggestion := range report.Suggestions {
+		problems++
+		log.Warn().
+			Str("table", suggestion.Table).
+			Strs("columns", suggestion.Columns).
+			Str("reason", suggestion.Reason).
+			Str("statement", suggestion.Statement).
+			Msg("Missing index suggested")
+	}
+
+	event := log.Info().
+		Int("tables", len(report.Tables)).
+		Int("indexes", len(report.Indexes)).
+		Int("problems", problems)
+	if !report.SlowQueriesAvailable {
+		event = event.Str("slow_queries_error", report.SlowQueriesError)
+	} else if len(report.SlowQueries) > 0 {
+		event = event.
+			Str("slowest_query", report.SlowQueries[0].Query).
+			Float64("slowest_query_mean_ms", report.SlowQueries[0].MeanMs)
+	}
+	event.Msg("Database diagnostics completed")
+}
+
+// queryTables 查询当前 schema 下所有表的统计（按总大小倒序）
+func (s *service) queryTables(ctx context.Context) ([]*TableStats, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT
+			relname,
+			n_live_tup,
+			n_dead_tup,
+			pg_total_relation_size(relid),
+			pg_relation_size(relid),
+			pg_indexes_size(relid),
+			COALESCE(seq_scan, 0),
+			COALESCE(idx_scan, 0),
+			GREATEST(last_vacuum, last_autovacuum),
+			GREATEST(last_analyze, last_autoanalyze)
+		FROM pg_stat_user_tables
+		WHERE schemaname = current_schema()
+		ORDER BY pg_total_relation_size(relid) DESC, relname
+	`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query table statistics")
+	}
+	defer rows.Close()
+
+	tables := []*TableStats{}
+	for rows.Next() {
+		var (
+			table       TableStats
+			lastVacuum  sql.NullTime
+			lastAnalyze sql.NullTime
+		)
+		if err := rows.Scan(
+			&table.Table,
+			&table.LiveRows,
+			&table.DeadRows,
+			&table.TotalBytes,
+			&table.TableBytes,
+			&table.IndexBytes,
+			&table.SeqScans,
+			&table.IndexScans,
+			&lastVacuum,
+			&lastAnalyze,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan table statistics")
+		}
+
+		if total := table.LiveRows + table.DeadRows; total > 0 {
+			table.DeadRowRatio = float64(table.DeadRows) / float64(total)
+		}
+		table.Bloated = table.LiveRows+table.DeadRows >= minRowsForReport && table.DeadRowRatio > bloatedDeadRowRatio
+		if lastVacuum.Valid {
+			table.LastVacuumAt = &lastVacuum.Time
+		}
+		if lastAnalyze.Valid {
+			table.LastAnalyzeAt = &lastAnalyze.Time
+		}
+
+		tables = append(tables, &table)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate table statistics")
+	}
+
+	return tables, nil
+}
+
+// queryIndexes 查询当前 schema 下所有索引的统计（按大小倒序），Columns 只包含键列（不含 INCLUDE 列）
+func (s *service) queryIndexes(ctx context.Context) ([]*IndexStats, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT
+			s.indexrelname,
+			s.relname,
+			ARRAY(
+				SELECT COALESCE(a.attname, '')
+				FROM unnest(ix.indkey::int2[]) WITH ORDINALITY AS k (attnum, ord)
+				LEFT JOIN pg_attribute a ON a.attrelid = ix.indrelid AND a.attnum = k.attnum
+				WHERE k.ord <= ix.indnkeyatts
+				ORDER BY k.ord
+			),
+			ix.indpred IS NOT NULL,
+			pg_relation_size(s.indexrelid),
+			COALESCE(s.idx_scan, 0),
+			ix.indisunique OR ix.indisprimary,
+			ix.indisvalid
+		FROM pg_stat_user_indexes s
+		JOIN pg_index ix ON ix.indexrelid = s.indexrelid
+		WHERE s.schemaname = current_schema()
+		ORDER BY pg_relation_size(s.indexrelid) DESC, s.indexrelname
+	`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query index statistics")
+	}
+	defer rows.Close()
+
+	indexes := []*IndexStats{}
+	for rows.Next() {
+		var idx IndexStats
+		if err := rows.Scan(
+			&idx.Index,
+			&idx.Table,
+			pq.Array(&idx.Columns),
+			&idx.Partial,
+			&idx.SizeBytes,
+			&idx.Scans,
+			&idx.Unique,
+			&idx.Valid,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan index statistics")
+		}
+		indexes = append(indexes, &idx)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate index statistics")
+	}
+
+	return indexes, nil
+}
+
+// querySlowQueries 查询钱包表上平均执行时间最长的查询
+// pg_stat_statements 需要安装扩展并在 shared_preload_libraries 中加载
+func (s *service) querySlowQueries(ctx context.Context) ([]*SlowQuery, error) {
+	var installed bool
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'pg_stat_statements')
+	`).Scan(&installed); err != nil {
+		return nil, errors.Wrap(err, "failed to check pg_stat_statements extension")
+	}
+	if !installed {
+		return nil, errors.New("pg_stat_statements extension is not installed")
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT query, calls, total_exec_time, mean_exec_time, rows
+		FROM pg_stat_statements
+		WHERE dbid = (SELECT oid FROM pg_database WHERE datname = current_database())
+			AND query ~* $1
+		ORDER BY mean_exec_time DESC
+		LIMIT $2
+	`, `\m(`+strings.Join(walletTables, "|")+`)\M`, slowQueryLimit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query pg_stat_statements")
+	}
+	defer rows.Close()
+
+	queries := []*SlowQuery{}
+	for rows.Next() {
+		var query SlowQuery
+		if err := rows.Scan(&query.Query, &query.Calls, &query.TotalMs, &query.MeanMs, &query.Rows); err != nil {
+			return nil, errors.Wrap(err, "failed to scan pg_stat_statements")
+		}
+		queries = append(queries, &query)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate pg_stat_statements")
+	}
+
+	return queries, nil
+}
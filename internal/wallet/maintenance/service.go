@@ -0,0 +1,106 @@
+//nolint:ireturn // 返回接口类型是预期的设计
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// 索引问题
+const (
+	IssueInvalid   = "invalid"   // 并发建索引失败留下的无效索引，不参与查询但仍需维护
+	IssueUnused    = "unused"    // 统计重置以来从未被扫描
+	IssueRedundant = "redundant" // 索引列是同表另一个索引的前导列
+)
+
+const (
+	// bloatedDeadRowRatio 死行占比超过该值视为表膨胀
+	bloatedDeadRowRatio = 0.2
+	// minRowsForReport 行数少于该值的表不参与膨胀和顺序扫描判断（小表顺序扫描是正常的）
+	minRowsForReport = 10000
+	// slowQueryLimit 慢查询报告条数
+	slowQueryLimit = 20
+)
+
+// Service 数据库维护服务接口
+// 报告表膨胀、索引健康状况、钱包表上的慢查询（pg_stat_statements）以及按当前查询条件缺失的索引
+type Service interface {
+	// Diagnose 生成数据库诊断报告
+	Diagnose(ctx context.Context) (*Report, error)
+
+	// StartReporter 启动定时诊断，发现问题时记录告警日志
+	StartReporter(ctx context.Context, interval time.Duration)
+}
+
+// Report 数据库诊断报告
+type Report struct {
+	GeneratedAt time.Time
+	Tables      []*TableStats // 按总大小倒序
+	Indexes     []*IndexStats // 按大小倒序
+
+	// SlowQueriesAvailable pg_stat_statements 未安装或未加载时为 false，原因见 SlowQueriesError
+	SlowQueriesAvailable bool
+	SlowQueriesError     string
+	SlowQueries          []*SlowQuery // 按平均执行时间倒序
+
+	Suggestions []*Suggestion
+}
+
+// TableStats 表统计（行数为估算值）
+type TableStats struct {
+	Table         string
+	LiveRows      int64
+	DeadRows      int64
+	DeadRowRatio  float64 // 死行占比，作为表膨胀的估算
+	TotalBytes    int64
+	TableBytes    int64
+	IndexBytes    int64
+	SeqScans      int64
+	IndexScans    int64
+	LastVacuumAt  *time.Time // 手动或自动 VACUUM 的最近时间
+	LastAnalyzeAt *time.Time // 手动或自动 ANALYZE 的最近时间
+	Bloated       bool
+}
+
+// IndexStats 索引统计
+type IndexStats struct {
+	Index     string
+	Table     string
+	Columns   []string // 表达式列为空字符串
+	Partial   bool
+	SizeBytes int64
+	Scans     int64
+	Unique    bool // 唯一索引或主键
+	Valid     bool
+	Issues    []string
+}
+
+// SlowQuery pg_stat_statements 中的慢查询
+type SlowQuery struct {
+	Query   string
+	Calls   int64
+	TotalMs float64
+	MeanMs  float64
+	Rows    int64
+}
+
+// Suggestion 缺失索引建议
+type Suggestion struct {
+	Table     string
+	Columns   []string // 仅根据扫描统计提出的建议为空
+	Reason    string
+	Statement string // 建议的 DDL，Columns 为空时为空
+}
+
+// service 实现 Service 接口
+type service struct {
+	db *sql.DB
+}
+
+// NewService 创建数据库维护服务
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(db *sql.DB) Service {
+	return &service{db: db}
+}
@@ -0,0 +1,125 @@
+package maintenance
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// filterPattern 钱包查询使用的过滤条件（列按建议的索引顺序排列）
+type filterPattern struct {
+	Table   string
+	Columns []string
+	Reason  string
+}
+
+// filterPatterns 当前代码中高频查询的过滤条件，新增查询模式时在这里补充
+var filterPatterns = []filterPattern{
+	{"credits", []string{"reference_id", "reference_type"}, "credits of a deposit or withdraw are looked up by reference (deposit crediting, withdraw reversal)"},
+	{"credits", []string{"user_id", "status"}, "balances are aggregated over the finalized credits of a user"},
+	{"credits", []string{"user_id", "created_at"}, "the ledger lists the credits of a user, newest first"},
+	{"transactions", []string{"chain_id", "status"}, "the scanner and confirmation workers load transactions of a chain by status"},
+	{"transactions", []string{"chain_id", "block_no"}, "reorg handling and finalization load transactions of a chain by block"},
+	{"withdraws", []string{"chain_id", "status"}, "hot wallet monitoring and confirmation polling load withdraws of a chain by status"},
+	{"withdraws", []string{"user_id", "token_id", "created_at"}, "withdraw limits and risk checks sum the recent withdraws of a user and token"},
+	{"deposit_quarantines", []string{"status", "created_at"}, "compliance lists quarantine cases by status, newest first"},
+}
+
+// walletTables 慢查询报告关注的钱包表
+var walletTables = []string{
+	"credits", "transactions", "withdraws", "wallets", "user_wallet_stats", "blocks",
+	"tokens", "wallet_nonces", "withdraw_batches", "deposit_quarantines", "wallet_events", "withdraw_outbox",
+}
+
+// indexIssues 检查索引问题：无效、未使用（唯一索引除外）、冗余（索引列是同表另一个索引的前导列）
+func indexIssues(indexes []*IndexStats) {
+	for _, idx := range indexes {
+		idx.Issues = []string{}
+		if !idx.Valid {
+			idx.Issues = append(idx.Issues, IssueInvalid)
+		}
+		if idx.Scans == 0 && !idx.Unique {
+			idx.Issues = append(idx.Issues, IssueUnused)
+		}
+		if isRedundant(idx, indexes) {
+			idx.Issues = append(idx.Issues, IssueRedundant)
+		}
+	}
+}
+
+// isRedundant 判断索引是否可以由同表另一个索引代替
+// 列完全相同的两个索引只标记名称较大的一个
+func isRedundant(idx *IndexStats, indexes []*IndexStats) bool {
+	if idx.Unique || idx.Partial || slices.Contains(idx.Columns, "") {
+		return false
+	}
+
+	for _, other := range indexes {
+		if other == idx || other.Table != idx.Table || other.Partial || !other.Valid {
+			continue
+		}
+		if len(other.Columns) < len(idx.Columns) || !slices.Equal(other.Columns[:len(idx.Columns)], idx.Columns) {
+			continue
+		}
+		if len(other.Columns) > len(idx.Columns) || other.Unique || other.Index < idx.Index {
+			return true
+		}
+	}
+
+	return false
+}
+
+// missingIndexSuggestions 根据过滤条件和扫描统计给出缺失索引建议
+// 过滤条件没有以其列为前导列（按顺序）的有效、非部分索引时建议创建；
+// 较大的表顺序扫描多于索引扫描时提示检查该表上的查询
+func missingIndexSuggestions(tables []*TableStats, indexes []*IndexStats) []*Suggestion {
+	suggestions := []*Suggestion{}
+
+	known := make(map[string]bool, len(tables))
+	for _, table := range tables {
+		known[table.Table] = true
+	}
+
+	for _, pattern := range filterPatterns {
+		if !known[pattern.Table] || hasLeadingIndex(pattern, indexes) {
+			continue
+		}
+
+		suggestions = append(suggestions, &Suggestion{
+			Table:   pattern.Table,
+			Columns: pattern.Columns,
+			Reason:  pattern.Reason,
+			Statement: fmt.Sprintf("CREATE INDEX CONCURRENTLY idx_%s_%s ON %s (%s)",
+				pattern.Table, strings.Join(pattern.Columns, "_"), pattern.Table, strings.Join(pattern.Columns, ", ")),
+		})
+	}
+
+	for _, table := range tables {
+		if table.LiveRows < minRowsForReport || table.SeqScans <= table.IndexScans {
+			continue
+		}
+
+		suggestions = append(suggestions, &Suggestion{
+			Table:   table.Table,
+			Columns: []string{},
+			Reason: fmt.Sprintf("table has %d rows and is read mostly by sequential scans (%d sequential vs %d index scans), check the filters of the queries on this table",
+				table.LiveRows, table.SeqScans, table.IndexScans),
+		})
+	}
+
+	return suggestions
+}
+
+// hasLeadingIndex 判断是否存在以过滤条件的列为前导列的有效索引
+func hasLeadingIndex(pattern filterPattern, indexes []*IndexStats) bool {
+	for _, idx := range indexes {
+		if idx.Table != pattern.Table || idx.Partial || !idx.Valid || len(idx.Columns) < len(pattern.Columns) {
+			continue
+		}
+		if slices.Equal(idx.Columns[:len(pattern.Columns)], pattern.Columns) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,58 @@
+package maintenance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexIssues(t *testing.T) {
+	indexes := []*IndexStats{
+		{Index: "credits_pkey", Table: "credits", Columns: []string{"id"}, Unique: true, Valid: true, Scans: 0},
+		{Index: "idx_credits_user_id", Table: "credits", Columns: []string{"user_id"}, Valid: true, Scans: 10},
+		{Index: "idx_credits_user_id_created_at", Table: "credits", Columns: []string{"user_id", "created_at"}, Valid: true, Scans: 5},
+		{Index: "idx_credits_status", Table: "credits", Columns: []string{"status"}, Valid: true, Scans: 0},
+		{Index: "idx_credits_status_dup", Table: "credits", Columns: []string{"status"}, Valid: true, Scans: 3},
+		{Index: "idx_credits_tx_hash_lower", Table: "credits", Columns: []string{"chain_id", ""}, Valid: false, Scans: 7},
+		{Index: "idx_withdraws_user_id", Table: "withdraws", Columns: []string{"user_id"}, Valid: true, Scans: 1},
+	}
+
+	indexIssues(indexes)
+
+	assert.Empty(t, indexes[0].Issues)
+	assert.Equal(t, []string{IssueRedundant}, indexes[1].Issues)
+	assert.Empty(t, indexes[2].Issues)
+	assert.Equal(t, []string{IssueUnused}, indexes[3].Issues)
+	assert.Equal(t, []string{IssueRedundant}, indexes[4].Issues)
+	assert.Equal(t, []string{IssueInvalid}, indexes[5].Issues)
+	assert.Empty(t, indexes[6].Issues)
+}
+
+func TestMissingIndexSuggestions(t *testing.T) {
+	tables := []*TableStats{
+		{Table: "credits", LiveRows: 500000, SeqScans: 10, IndexScans: 1000},
+		{Table: "withdraws", LiveRows: 200000, SeqScans: 300, IndexScans: 20},
+		{Table: "chains", LiveRows: 10, SeqScans: 5000, IndexScans: 0},
+	}
+	indexes := []*IndexStats{
+		{Index: "idx_credits_reference", Table: "credits", Columns: []string{"reference_id", "reference_type", "credit_type"}, Valid: true},
+		{Index: "idx_credits_user_status", Table: "credits", Columns: []string{"user_id", "status"}, Valid: false},
+		{Index: "idx_credits_user_created_at", Table: "credits", Columns: []string{"user_id", "created_at"}, Valid: true, Partial: true},
+		{Index: "idx_withdraws_chain_status", Table: "withdraws", Columns: []string{"chain_id", "status"}, Valid: true},
+		{Index: "idx_withdraws_user_token", Table: "withdraws", Columns: []string{"user_id", "token_id", "created_at"}, Valid: true},
+	}
+
+	suggestions := missingIndexSuggestions(tables, indexes)
+
+	require.Len(t, suggestions, 3)
+	assert.Equal(t, "credits", suggestions[0].Table)
+	assert.Equal(t, []string{"user_id", "status"}, suggestions[0].Columns)
+	assert.Equal(t, "CREATE INDEX CONCURRENTLY idx_credits_user_id_status ON credits (user_id, status)", suggestions[0].Statement)
+	assert.Equal(t, []string{"user_id", "created_at"}, suggestions[1].Columns)
+
+	// 顺序扫描为主的大表，小表不提示
+	assert.Equal(t, "withdraws", suggestions[2].Table)
+	assert.Empty(t, suggestions[2].Columns)
+	assert.Empty(t, suggestions[2].Statement)
+}
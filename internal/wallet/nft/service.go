@@ -0,0 +1,276 @@
+//nolint:ireturn // 返回接口类型是预期的设计
+package nft
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/wallet/gasguard"
+	"github/chapool/go-wallet/internal/wallet/hotwallet"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/signer"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// NFT 托管状态
+const (
+	StatusHeld              = "held"               // 托管在用户充值地址上
+	StatusWithdrawRequested = "withdraw_requested" // 管理员已发起提现，等待提现任务发送
+	StatusWithdrawing       = "withdrawing"        // 提现交易已广播，等待上链
+	StatusWithdrawn         = "withdrawn"          // 已转出
+	StatusOrphaned          = "orphaned"           // 所在区块因重组被回滚，不对外展示
+)
+
+// holdingColumns NFT 查询列，与 scanHolding 的顺序一致
+const holdingColumns = `n.id, n.user_id, n.wallet_id, w.address, n.chain_id, n.contract_addr, n.token_id, n.standard, n.amount,
+	n.from_addr, n.tx_hash, n.block_no, n.status, n.withdraw_to_addr, n.withdraw_tx_hash, n.withdraw_error,
+	n.withdraw_requested_at, n.withdrawn_at, n.created_at`
+
+// holdingFrom NFT 查询的 FROM 子句
+const holdingFrom = `FROM nft_holdings n JOIN wallets w ON w.id = n.wallet_id`
+
+// Config NFT 提现配置
+type Config struct {
+	BaseFeeMultiplier   int64         // maxFeePerGas = baseFee * BaseFeeMultiplier + tipCap
+	LegacyTxChainIDs    []int         // 强制使用 legacy gasPrice 交易的链
+	ReceiptPollInterval time.Duration // Gas 充值交易收据轮询间隔
+	ReceiptTimeout      time.Duration // 等待 Gas 充值交易收据的超时
+}
+
+// Holding 托管的 NFT（TransferBatch 中的每个 id 一条）
+type Holding struct {
+	ID                  string
+	UserID              string
+	WalletID            string
+	WalletAddress       string
+	ChainID             int
+	ContractAddress     string
+	TokenID             string
+	Standard            string // scan.NFTStandardERC721 或 scan.NFTStandardERC1155
+	Amount              string
+	FromAddress         string
+	TxHash              string
+	BlockNo             int64
+	Status              string
+	WithdrawToAddress   *string
+	WithdrawTxHash      *string
+	WithdrawError       *string
+	WithdrawRequestedAt *time.Time
+	WithdrawnAt         *time.Time
+	CreatedAt           time.Time
+}
+
+// Filter NFT 查询条件
+type Filter struct {
+	UserID  string
+	ChainID *int
+	Status  *string
+}
+
+// Service NFT 托管服务接口
+// 扫描器把转入用户充值地址的 ERC-721 / ERC-1155 记录到 nft_holdings，NFT 留在充值地址上，由管理员发起提现转出
+type Service interface {
+	// ListHoldings 查询用户的 NFT 充值记录，按创建时间倒序
+	ListHoldings(ctx context.Context, filter *Filter, limit int, offset int) ([]*Holding, error)
+
+	// RequestWithdraw 管理员发起 NFT 提现，只能提现所在区块已终结的托管中 NFT，由提现任务签名发送
+	RequestWithdraw(ctx context.Context, holdingID string, toAddress string, adminUserID string) (*Holding, error)
+
+	// StartWithdrawer 启动 NFT 提现任务：发送已发起的提现并确认已广播的提现交易
+	StartWithdrawer(ctx context.Context, interval time.Duration)
+}
+
+// service 实现 Service 接口
+type service struct {
+	db               *sql.DB
+	config           Config
+	scanService      scan.Service
+	hotWalletService hotwallet.Service
+	signerService    signer.Service
+	gasPriceCap      gasguard.PriceCap
+}
+
+// NewService 创建 NFT 托管服务
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(
+	db *sql.DB,
+	config Config,
+	scanService scan.Service,
+	hotWalletService hotwallet.Service,
+	signerService signer.Service,
+	gasPriceCap gasguard.PriceCap,
+) Service {
+	return &service{
+		db:               db,
+		config:           config,
+		scanService:      scanService,
+		hotWalletService: hotWalletService,
+		signerService:    signerService,
+		gasPriceCap:      gasPriceCap,
+	}
+}
+
+// ListHoldings 查询用户的 NFT 充值记录，按创建时间倒序
+func (s *service) ListHoldings(ctx context.Context, filter *Filter, limit int, offset int) ([]*Holding, error) {
+	where := "WHERE n.user_id = $1 AND n.status <> $2"
+	args := []any{filter.UserID, StatusOrphaned}
+	if filter.ChainID != nil {
+		args = append(args, *filter.ChainID)
+		where += fmt.Sprintf(" AND n.chain_id = $%d", len(args))
+	}
+	if filter.Status != nil {
+		args = append(args, *filter.Status)
+		where += fmt.Sprintf(" AND n.status = $%d", len(args))
+	}
+	args = append(args, limit, offset)
+
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT %s %s %s
+		ORDER BY n.created_at DESC, n.id DESC
+		LIMIT $%d OFFSET $%d
+	`, holdingColumns, holdingFrom, where, len(args)-1, len(args)), args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query nft holdings")
+	}
+	defer rows.Close()
+
+	holdings := make([]*Holding, 0)
+	for rows.Next() {
+		holding, err := scanHolding(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan nft holding")
+		}
+		holdings = append(holdings, holding)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate nft holdings")
+	}
+
+	return holdings, nil
+}
+
+// RequestWithdraw 管理员发起 NFT 提现
+// 提现失败后 NFT 恢复为 held，可以重新发起
+func (s *service) RequestWithdraw(ctx context.Context, holdingID string, toAddress string, adminUserID string) (*Holding, error) {
+	toAddress = strings.ToLower(toAddress)
+
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE nft_holdings n SET
+			status = $2,
+			withdraw_to_addr = $3,
+			withdraw_requested_by = $4,
+			withdraw_requested_at = NOW(),
+			withdraw_tx_hash = NULL,
+			withdraw_error = NULL,
+			updated_at = NOW()
+		WHERE n.id = $1
+			AND n.status = $5
+			AND n.block_no <= (SELECT MAX(b.number) FROM blocks b WHERE b.chain_id = n.chain_id AND b.status = 'finalized')
+	`, holdingID, StatusWithdrawRequested, toAddress, adminUserID, StatusHeld)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request nft withdraw")
+	}
+
+	holding, err := s.getHolding(ctx, holdingID)
+	if err != nil {
+		return nil, err
+	}
+
+	if updated, _ := result.RowsAffected(); updated == 0 {
+		if holding.Status != StatusHeld {
+			return nil, errors.Wrapf(walleterrors.ErrNFTStateConflict, "nft status is %s, expected %s", holding.Status, StatusHeld)
+		}
+		return nil, errors.Wrap(walleterrors.ErrNFTStateConflict, "block of the nft deposit is not finalized yet")
+	}
+
+	log.Info().
+		Str("nft_id", holdingID).
+		Str("admin_user_id", adminUserID).
+		Str("contract_addr", holding.ContractAddress).
+		Str("token_id", holding.TokenID).
+		Str("to_address", toAddress).
+		Msg("NFT withdraw requested by admin")
+
+	return holding, nil
+}
+
+// getHolding 获取 NFT 托管记录，已回滚的记录视为不存在
+func (s *service) getHolding(ctx context.Context, holdingID string) (*Holding, error) {
+	holding, err := scanHolding(s.db.QueryRowContext(ctx, `
+		SELECT `+holdingColumns+` `+holdingFrom+` WHERE n.id = $1 AND n.status <> $2
+	`, holdingID, StatusOrphaned))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, walleterrors.ErrNFTNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get nft holding")
+	}
+
+	return holding, nil
+}
+
+// rowScanner 兼容 *sql.Row 和 *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanHolding 扫描一行 NFT 托管记录
+func scanHolding(row rowScanner) (*Holding, error) {
+	var (
+		holding             Holding
+		withdrawToAddress   sql.NullString
+		withdrawTxHash      sql.NullString
+		withdrawError       sql.NullString
+		withdrawRequestedAt sql.NullTime
+		withdrawnAt         sql.NullTime
+	)
+
+	if err := row.Scan(
+		&holding.ID,
+		&holding.UserID,
+		&holding.WalletID,
+		&holding.WalletAddress,
+		&holding.ChainID,
+		&holding.ContractAddress,
+		&holding.TokenID,
+		&holding.Standard,
+		&holding.Amount,
+		&holding.FromAddress,
+		&holding.TxHash,
+		&holding.BlockNo,
+		&holding.Status,
+		&withdrawToAddress,
+		&withdrawTxHash,
+		&withdrawError,
+		&withdrawRequestedAt,
+		&withdrawnAt,
+		&holding.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if withdrawToAddress.Valid {
+		holding.WithdrawToAddress = &withdrawToAddress.String
+	}
+	if withdrawTxHash.Valid {
+		holding.WithdrawTxHash = &withdrawTxHash.String
+	}
+	if withdrawError.Valid {
+		holding.WithdrawError = &withdrawError.String
+	}
+	if withdrawRequestedAt.Valid {
+		holding.WithdrawRequestedAt = &withdrawRequestedAt.Time
+	}
+	if withdrawnAt.Valid {
+		holding.WithdrawnAt = &withdrawnAt.Time
+	}
+
+	return &holding, nil
+}
@@ -0,0 +1,479 @@
+package nft
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"slices"
+	"time"
+
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/gasguard"
+	"github/chapool/go-wallet/internal/wallet/organization"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/signer"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	nativeTransferGasLimit uint64 = 21000
+	abiWordSize                   = 32
+	// gasLimitMarginPercent 预估 gas 的余量（百分比）
+	gasLimitMarginPercent = 20
+	// maxWithdrawErrorLength withdraw_error 最大保存长度
+	maxWithdrawErrorLength = 1000
+)
+
+var (
+	// safeTransferFrom(address,address,uint256)（ERC-721）
+	erc721SafeTransferFromMethodID = common.FromHex("42842e0e")
+	// safeTransferFrom(address,address,uint256,uint256,bytes)（ERC-1155）
+	erc1155SafeTransferFromMethodID = common.FromHex("f242432a")
+)
+
+// pendingWithdraw 待发送或待确认的 NFT 提现
+type pendingWithdraw struct {
+	Holding
+	derivationPath string
+}
+
+// StartWithdrawer 启动 NFT 提现任务
+func (s *service) StartWithdrawer(ctx context.Context, interval time.Duration) {
+	log.Info().
+		Dur("interval", interval).
+		Msg("Starting NFT withdrawer")
+
+	lifecycle.Go(ctx, "nft withdrawer", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("NFT withdrawer stopped")
+				return
+			case <-ticker.C:
+				s.confirmWithdraws(ctx)
+				s.sendRequestedWithdraws(ctx)
+			}
+		}
+	})
+}
+
+// sendRequestedWithdraws 逐条发送已发起的提现，多实例通过 SKIP LOCKED 避免重复发送
+func (s *service) sendRequestedWithdraws(ctx context.Context) {
+	for ctx.Err() == nil {
+		sent, err := s.sendNextWithdraw(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to send NFT withdraw")
+			return
+		}
+		if !sent {
+			return
+		}
+	}
+}
+
+// sendNextWithdraw 锁定并发送一条已发起的提现，没有待发送的提现时返回 false
+// gas 价格超过上限时保留待发送状态，下次重试；其余失败恢复为 held 并记录原因
+func (s *service) sendNextWithdraw(ctx context.Context) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	withdraw, err := scanPendingWithdraw(tx.QueryRowContext(ctx, `
+		SELECT `+holdingColumns+`, w.derivation_path `+holdingFrom+`
+		WHERE n.status = $1
+		ORDER BY n.withdraw_requested_at
+		LIMIT 1
+		FOR UPDATE OF n SKIP LOCKED
+	`, StatusWithdrawRequested))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "failed to claim nft withdraw")
+	}
+
+	txHash, sendErr := s.sendWithdraw(ctx, withdraw)
+	if sendErr != nil {
+		if gasguard.IsCapExceeded(sendErr) {
+			log.Warn().
+				Err(sendErr).
+				Str("nft_id", withdraw.ID).
+				Msg("NFT withdraw deferred, gas price exceeds the cap")
+			return false, nil
+		}
+
+		message := sendErr.Error()
+		if len(message) > maxWithdrawErrorLength {
+			message = message[:maxWithdrawErrorLength]
+		}
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE nft_holdings SET status = $2, withdraw_error = $3, updated_at = NOW() WHERE id = $1
+		`, withdraw.ID, StatusHeld, message); err != nil {
+			return false, errors.Wrap(err, "failed to record nft withdraw error")
+		}
+		log.Error().
+			Err(sendErr).
+			Str("nft_id", withdraw.ID).
+			Msg("NFT withdraw failed, nft is held again")
+	} else {
+		// 交易已广播，即使请求取消也要记录交易哈希
+		if _, err := tx.ExecContext(context.WithoutCancel(ctx), `
+			UPDATE nft_holdings SET status = $2, withdraw_tx_hash = $3, updated_at = NOW() WHERE id = $1
+		`, withdraw.ID, StatusWithdrawing, txHash); err != nil {
+			return false, errors.Wrap(err, "failed to record nft withdraw transaction")
+		}
+		log.Info().
+			Str("nft_id", withdraw.ID).
+			Str("tx_hash", txHash).
+			Msg("NFT withdraw transaction broadcasted")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return true, nil
+}
+
+// sendWithdraw 从用户充值地址调用 safeTransferFrom 转出 NFT，原生代币不足以支付 gas 时先由热钱包充值
+func (s *service) sendWithdraw(ctx context.Context, withdraw *pendingWithdraw) (string, error) {
+	client, err := s.scanService.GetClient(ctx, withdraw.ChainID)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get RPC client")
+	}
+
+	from := common.HexToAddress(withdraw.WalletAddress)
+	to := common.HexToAddress(*withdraw.WithdrawToAddress)
+	data, err := safeTransferFromData(withdraw, from, to)
+	if err != nil {
+		return "", err
+	}
+
+	contract := common.HexToAddress(withdraw.ContractAddress)
+	// 模拟调用：NFT 已不在充值地址上或接收地址不接受 NFT 时交易会回滚
+	gasLimit, err := client.EstimateGas(ctx, ethereum.CallMsg{From: from, To: &contract, Data: data})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to estimate gas for nft transfer")
+	}
+	gasLimit += gasLimit * gasLimitMarginPercent / 100
+
+	fees, err := s.suggestGasFees(ctx, client, withdraw.ChainID)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to suggest gas fees")
+	}
+
+	if err := s.ensureNativeGas(ctx, client, withdraw, fees.Cost(gasLimit)); err != nil {
+		return "", err
+	}
+
+	maxFeePerGas, maxPriorityFeePerGas, gasPrice := fees.SignFields()
+	txObj, err := s.signAndBroadcast(ctx, client, &signer.SignEVMRequest{
+		ChainID:              int64(withdraw.ChainID),
+		To:                   contract.Hex(),
+		Value:                "0",
+		GasLimit:             gasLimit,
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+		GasPrice:             gasPrice,
+		Data:                 data,
+		FromAddress:          from.Hex(),
+		DerivationPath:       withdraw.derivationPath,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to send nft transfer")
+	}
+
+	return txObj.Hash().Hex(), nil
+}
+
+// safeTransferFromData 编码 safeTransferFrom 调用数据
+func safeTransferFromData(withdraw *pendingWithdraw, from common.Address, to common.Address) ([]byte, error) {
+	tokenID, ok := new(big.Int).SetString(withdraw.TokenID, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid token id %q", withdraw.TokenID)
+	}
+
+	switch withdraw.Standard {
+	case scan.NFTStandardERC721:
+		data := make([]byte, 0, len(erc721SafeTransferFromMethodID)+abiWordSize*3)
+		data = append(data, erc721SafeTransferFromMethodID...)
+		data = append(data, common.LeftPadBytes(from.Bytes(), abiWordSize)...)
+		data = append(data, common.LeftPadBytes(to.Bytes(), abiWordSize)...)
+		data = append(data, common.LeftPadBytes(tokenID.Bytes(), abiWordSize)...)
+		return data, nil
+	case scan.NFTStandardERC1155:
+		amount, ok := new(big.Int).SetString(withdraw.Amount, 10)
+		if !ok {
+			return nil, errors.Errorf("invalid amount %q", withdraw.Amount)
+		}
+		// bytes 参数为空：偏移量指向参数区末尾，长度为 0
+		const bytesOffset = abiWordSize * 5
+		data := make([]byte, 0, len(erc1155SafeTransferFromMethodID)+abiWordSize*6)
+		data = append(data, erc1155SafeTransferFromMethodID...)
+		data = append(data, common.LeftPadBytes(from.Bytes(), abiWordSize)...)
+		data = append(data, common.LeftPadBytes(to.Bytes(), abiWordSize)...)
+		data = append(data, common.LeftPadBytes(tokenID.Bytes(), abiWordSize)...)
+		data = append(data, common.LeftPadBytes(amount.Bytes(), abiWordSize)...)
+		data = append(data, common.LeftPadBytes(big.NewInt(bytesOffset).Bytes(), abiWordSize)...)
+		data = append(data, make([]byte, abiWordSize)...)
+		return data, nil
+	default:
+		return nil, errors.Errorf("unsupported nft standard %q", withdraw.Standard)
+	}
+}
+
+// ensureNativeGas 充值地址的原生代币余额不足以支付 gas 时，由热钱包转入差额并等待上链
+func (s *service) ensureNativeGas(ctx context.Context, client *scan.RPCClient, withdraw *pendingWithdraw, gasCost *big.Int) error {
+	balance, err := client.BalanceAt(ctx, common.HexToAddress(withdraw.WalletAddress))
+	if err != nil {
+		return errors.Wrap(err, "failed to get deposit address balance")
+	}
+	if balance.Cmp(gasCost) >= 0 {
+		return nil
+	}
+	shortfall := new(big.Int).Sub(gasCost, balance)
+
+	orgID, err := organization.UserOrgID(ctx, s.db, withdraw.UserID)
+	if err != nil {
+		return err
+	}
+	hotWallet, err := s.hotWalletService.GetOrgHotWallet(ctx, withdraw.ChainID, orgID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get hot wallet for gas top-up")
+	}
+
+	fees, err := s.suggestGasFees(ctx, client, withdraw.ChainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to suggest gas fees for top-up")
+	}
+
+	maxFeePerGas, maxPriorityFeePerGas, gasPrice := fees.SignFields()
+	txObj, err := s.signAndBroadcast(ctx, client, &signer.SignEVMRequest{
+		ChainID:              int64(withdraw.ChainID),
+		To:                   common.HexToAddress(withdraw.WalletAddress).Hex(),
+		Value:                shortfall.String(),
+		GasLimit:             nativeTransferGasLimit,
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+		GasPrice:             gasPrice,
+		FromAddress:          common.HexToAddress(hotWallet.Address).Hex(),
+		DerivationPath:       hotWallet.DerivationPath,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to send gas top-up")
+	}
+
+	receipt, err := s.waitForReceipt(ctx, client, txObj.Hash())
+	if err != nil {
+		return errors.Wrap(err, "failed while waiting for gas top-up receipt")
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return errors.New("gas top-up transaction failed")
+	}
+
+	log.Info().
+		Str("nft_id", withdraw.ID).
+		Str("hot_wallet_id", hotWallet.ID).
+		Str("tx_hash", txObj.Hash().Hex()).
+		Str("topup_amount", shortfall.String()).
+		Msg("Topped up native gas for NFT withdraw")
+
+	return nil
+}
+
+// confirmWithdraws 检查已广播的提现交易：成功后标记为 withdrawn，回滚的交易恢复为 held
+func (s *service) confirmWithdraws(ctx context.Context) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+holdingColumns+`, w.derivation_path `+holdingFrom+`
+		WHERE n.status = $1
+	`, StatusWithdrawing)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to query broadcast NFT withdraws")
+		return
+	}
+	var withdraws []*pendingWithdraw
+	for rows.Next() {
+		withdraw, err := scanPendingWithdraw(rows)
+		if err != nil {
+			rows.Close()
+			log.Error().Err(err).Msg("Failed to scan broadcast NFT withdraw")
+			return
+		}
+		withdraws = append(withdraws, withdraw)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		log.Error().Err(err).Msg("Failed to iterate broadcast NFT withdraws")
+		return
+	}
+
+	for _, withdraw := range withdraws {
+		if err := s.confirmWithdraw(ctx, withdraw); err != nil {
+			log.Error().Err(err).Str("nft_id", withdraw.ID).Msg("Failed to confirm NFT withdraw")
+		}
+	}
+}
+
+// confirmWithdraw 检查一条已广播的提现交易，尚未上链时保持 withdrawing
+func (s *service) confirmWithdraw(ctx context.Context, withdraw *pendingWithdraw) error {
+	client, err := s.scanService.GetClient(ctx, withdraw.ChainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get RPC client")
+	}
+
+	receipt, err := client.GetTransactionReceipt(ctx, common.HexToHash(*withdraw.WithdrawTxHash))
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to get withdraw receipt")
+	}
+
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		if _, err := s.db.ExecContext(ctx, `
+			UPDATE nft_holdings SET status = $3, withdrawn_at = NOW(), updated_at = NOW() WHERE id = $1 AND status = $2
+		`, withdraw.ID, StatusWithdrawing, StatusWithdrawn); err != nil {
+			return errors.Wrap(err, "failed to mark nft withdrawn")
+		}
+		log.Info().
+			Str("nft_id", withdraw.ID).
+			Str("tx_hash", *withdraw.WithdrawTxHash).
+			Msg("NFT withdrawn")
+		return nil
+	}
+
+	if _, err := s.db.ExecContext(ctx, `
+		UPDATE nft_holdings SET status = $3, withdraw_error = $4, updated_at = NOW() WHERE id = $1 AND status = $2
+	`, withdraw.ID, StatusWithdrawing, StatusHeld, "withdraw transaction reverted"); err != nil {
+		return errors.Wrap(err, "failed to record nft withdraw error")
+	}
+	log.Warn().
+		Str("nft_id", withdraw.ID).
+		Str("tx_hash", *withdraw.WithdrawTxHash).
+		Msg("NFT withdraw transaction reverted, nft is held again")
+
+	return nil
+}
+
+// suggestGasFees 获取链当前的 gas 价格，超过上限时返回 *gasguard.CapExceededError
+func (s *service) suggestGasFees(ctx context.Context, client *scan.RPCClient, chainID int) (*scan.GasFees, error) {
+	fees, err := client.SuggestGasFees(ctx, s.config.BaseFeeMultiplier, slices.Contains(s.config.LegacyTxChainIDs, chainID))
+	if err != nil {
+		return nil, err //nolint:wrapcheck // errors are wrapped by the callers
+	}
+
+	if err := s.gasPriceCap.Check(ctx, chainID, fees); err != nil {
+		return nil, err //nolint:wrapcheck // *gasguard.CapExceededError is checked by the callers
+	}
+
+	return fees, nil
+}
+
+// signAndBroadcast 获取发送地址的 pending nonce，签名并通过同一个 RPC 节点广播交易（见 scan.StickyClient）
+func (s *service) signAndBroadcast(ctx context.Context, client *scan.RPCClient, signReq *signer.SignEVMRequest) (*types.Transaction, error) {
+	sticky, err := client.Sticky(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // errors are wrapped by the callers
+	}
+	defer sticky.Close()
+
+	nonce, err := sticky.PendingNonceAt(ctx, common.HexToAddress(signReq.FromAddress))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch pending nonce")
+	}
+	signReq.Nonce = nonce
+
+	signResp, err := s.signerService.SignEVMTransaction(ctx, signReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign transaction")
+	}
+
+	txObj := new(types.Transaction)
+	if err := txObj.UnmarshalBinary(signResp.RawTransaction); err != nil {
+		return nil, errors.Wrap(err, "failed to decode signed transaction")
+	}
+
+	if err := sticky.SendTransaction(ctx, txObj); err != nil {
+		return nil, errors.Wrap(err, "failed to broadcast transaction")
+	}
+
+	return txObj, nil
+}
+
+// waitForReceipt 轮询交易收据直到上链或超时
+func (s *service) waitForReceipt(ctx context.Context, client *scan.RPCClient, txHash common.Hash) (*types.Receipt, error) {
+	localCtx, cancel := context.WithTimeout(ctx, s.config.ReceiptTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(s.config.ReceiptPollInterval)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := client.GetTransactionReceipt(localCtx, txHash)
+		if err == nil {
+			return receipt, nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return nil, err //nolint:wrapcheck // errors are wrapped by the callers
+		}
+
+		select {
+		case <-localCtx.Done():
+			return nil, errors.Wrap(localCtx.Err(), "context canceled while waiting for receipt")
+		case <-ticker.C:
+		}
+	}
+}
+
+// scanPendingWithdraw 扫描一行 NFT 托管记录和充值地址的派生路径
+func scanPendingWithdraw(row rowScanner) (*pendingWithdraw, error) {
+	var (
+		withdraw            pendingWithdraw
+		withdrawToAddress   sql.NullString
+		withdrawTxHash      sql.NullString
+		withdrawError       sql.NullString
+		withdrawRequestedAt sql.NullTime
+		withdrawnAt         sql.NullTime
+	)
+
+	if err := row.Scan(
+		&withdraw.ID,
+		&withdraw.UserID,
+		&withdraw.WalletID,
+		&withdraw.WalletAddress,
+		&withdraw.ChainID,
+		&withdraw.ContractAddress,
+		&withdraw.TokenID,
+		&withdraw.Standard,
+		&withdraw.Amount,
+		&withdraw.FromAddress,
+		&withdraw.TxHash,
+		&withdraw.BlockNo,
+		&withdraw.Status,
+		&withdrawToAddress,
+		&withdrawTxHash,
+		&withdrawError,
+		&withdrawRequestedAt,
+		&withdrawnAt,
+		&withdraw.CreatedAt,
+		&withdraw.derivationPath,
+	); err != nil {
+		return nil, err
+	}
+
+	// 待发送的提现一定有目标地址，已广播的提现一定有交易哈希
+	withdraw.WithdrawToAddress = &withdrawToAddress.String
+	withdraw.WithdrawTxHash = &withdrawTxHash.String
+
+	return &withdraw, nil
+}
@@ -0,0 +1,13 @@
+package notification
+
+import "context"
+
+// RenderUserMessage 供外部测试渲染发送给用户的通知，返回标题、正文、邮件正文模板和发件人名称
+func RenderUserMessage(ctx context.Context, s Service, event *Event, locale string) (title, body, emailTemplate, senderName string, err error) {
+	msg, err := s.(*service).renderUserMessage(ctx, event, locale)
+	if err != nil {
+		return "", "", "", "", err
+	}
+
+	return msg.Title, msg.Body, msg.EmailTemplate, msg.SenderName, nil
+}
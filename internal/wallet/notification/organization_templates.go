@@ -0,0 +1,496 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	htmltemplate "html/template"
+	"net/url"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"github/chapool/go-wallet/internal/wallet/organization"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"golang.org/x/text/language"
+)
+
+// 模板长度限制
+const (
+	maxTemplateTitleLength = 200
+	maxTemplateBodyLength  = 2000
+	maxEmailTemplateLength = 65536
+	maxSenderNameLength    = 100
+)
+
+// 预览内容的模板来源
+const (
+	TemplateSourceDraft        = "draft"        // 请求中的草稿模板
+	TemplateSourceOrganization = "organization" // 组织已保存的模板
+	TemplateSourceSystem       = "system"       // 系统默认模板
+)
+
+// templateColumns notification_templates 查询列，与 scanTemplate 的顺序一致
+const templateColumns = `id, org_id, event, locale, title, body, email_html, created_by, created_at, updated_at`
+
+var (
+	// ErrInvalidTemplate 通知模板校验失败
+	ErrInvalidTemplate = errors.New("invalid notification template")
+	// ErrTemplateNotFound 通知模板不存在
+	ErrTemplateNotFound = errors.New("notification template not found")
+	// ErrInvalidBranding 通知品牌校验失败
+	ErrInvalidBranding = errors.New("invalid notification branding")
+)
+
+// Branding 组织的通知品牌，模板中通过 senderName、logoUrl 变量引用
+type Branding struct {
+	OrgID      string
+	SenderName *string // 邮件发件人显示名称，为空时使用部署的默认发件人
+	LogoURL    *string
+	UpdatedAt  *time.Time // 未配置时为空
+}
+
+// Template 组织对单个事件和语言的通知模板，Locale 为空表示组织的默认模板
+type Template struct {
+	ID        string
+	OrgID     string
+	Event     string
+	Locale    string
+	Title     string  // text/template
+	Body      string  // text/template
+	EmailHTML *string // html/template，仅安全通知事件
+	CreatedBy *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Preview 用示例数据渲染的通知内容
+type Preview struct {
+	Source    string  // draft、organization 或 system
+	Locale    *string // 组织模板的语言，Source 为 system 时为空
+	Title     string
+	Body      string
+	EmailHTML *string // 仅在使用组织邮件模板时返回
+}
+
+// sampleData 预览和校验模板时使用的示例变量
+func sampleData() map[string]string {
+	return map[string]string{
+		"amount":          "1.5",
+		"tokenSymbol":     "USDT",
+		"toAddress":       "0x742d35cc6634c0532925a3b844bc9e7595f0beb0",
+		"txHash":          "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060",
+		"chainId":         "1",
+		"change":          "added",
+		"referenceId":     "00000000-0000-0000-0000-000000000000",
+		"deviceIp":        "203.0.113.7",
+		"deviceUserAgent": "Mozilla/5.0",
+		"time":            time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC).Format(time.RFC3339),
+	}
+}
+
+// brandingData 将品牌加入模板变量（senderName、logoUrl）
+func brandingData(data map[string]string, branding *Branding) map[string]string {
+	if branding.SenderName != nil {
+		data["senderName"] = *branding.SenderName
+	}
+	if branding.LogoURL != nil {
+		data["logoUrl"] = *branding.LogoURL
+	}
+
+	return data
+}
+
+// localeFallbacks 返回查找组织模板的语言顺序：用户语言 > 基础语言（如 de-AT 回退到 de）> 组织默认模板（空字符串）
+func localeFallbacks(locale string) []string {
+	fallbacks := make([]string, 0, 3)
+	if tag, err := language.Parse(locale); locale != "" && err == nil {
+		fallbacks = append(fallbacks, tag.String())
+		if base, confidence := tag.Base(); confidence != language.No && base.String() != tag.String() {
+			fallbacks = append(fallbacks, base.String())
+		}
+	}
+
+	return append(fallbacks, "")
+}
+
+// canonicalLocale 校验 BCP 47 语言标签并返回规范形式，空字符串表示未设置
+func canonicalLocale(locale string) (string, error) {
+	locale = strings.TrimSpace(locale)
+	if locale == "" {
+		return "", nil
+	}
+
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return "", errors.Errorf("invalid locale %q", locale)
+	}
+
+	return tag.String(), nil
+}
+
+// parseTemplate 解析组织模板的推送标题和正文
+func parseTemplate(tmpl *Template) (messageTemplate, error) {
+	title, err := template.New("title").Parse(tmpl.Title)
+	if err != nil {
+		return messageTemplate{}, errors.Wrapf(ErrInvalidTemplate, "title: %v", err)
+	}
+	body, err := template.New("body").Parse(tmpl.Body)
+	if err != nil {
+		return messageTemplate{}, errors.Wrapf(ErrInvalidTemplate, "body: %v", err)
+	}
+
+	return messageTemplate{title: title, body: body}, nil
+}
+
+// renderEmailTemplate 渲染组织的邮件正文模板，变量会按 HTML 转义
+func renderEmailTemplate(source string, data map[string]string) (string, error) {
+	tmpl, err := htmltemplate.New("email").Parse(source)
+	if err != nil {
+		return "", errors.Wrapf(ErrInvalidTemplate, "email_html: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", errors.Wrapf(ErrInvalidTemplate, "email_html: %v", err)
+	}
+
+	return buf.String(), nil
+}
+
+// renderTemplate 渲染组织模板；邮件正文模板原样返回，由 mailer 在发送时渲染
+func renderTemplate(tmpl *Template, data map[string]string) (*message, error) {
+	parsed, err := parseTemplate(tmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := executeMessage(parsed, data)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidTemplate, err.Error())
+	}
+	if tmpl.EmailHTML != nil {
+		msg.EmailTemplate = *tmpl.EmailHTML
+	}
+
+	return msg, nil
+}
+
+// validateTemplate 校验事件、语言和长度，并用示例数据试渲染，语言转换为规范形式
+func validateTemplate(tmpl *Template) error {
+	if !slices.Contains(AllEvents(), tmpl.Event) && !slices.Contains(AllStatusEvents(), tmpl.Event) {
+		return errors.Wrapf(ErrInvalidTemplate, "unknown event %q", tmpl.Event)
+	}
+
+	locale, err := canonicalLocale(tmpl.Locale)
+	if err != nil {
+		return errors.Wrap(ErrInvalidTemplate, err.Error())
+	}
+	tmpl.Locale = locale
+
+	if strings.TrimSpace(tmpl.Title) == "" || utf8.RuneCountInString(tmpl.Title) > maxTemplateTitleLength {
+		return errors.Wrapf(ErrInvalidTemplate, "title must be 1 to %d characters", maxTemplateTitleLength)
+	}
+	if strings.TrimSpace(tmpl.Body) == "" || utf8.RuneCountInString(tmpl.Body) > maxTemplateBodyLength {
+		return errors.Wrapf(ErrInvalidTemplate, "body must be 1 to %d characters", maxTemplateBodyLength)
+	}
+
+	if tmpl.EmailHTML != nil {
+		if strings.TrimSpace(*tmpl.EmailHTML) == "" {
+			tmpl.EmailHTML = nil
+		} else {
+			// 只有安全通知发送邮件
+			if !slices.Contains(AllEvents(), tmpl.Event) {
+				return errors.Wrapf(ErrInvalidTemplate, "email_html is only supported for security events, not %q", tmpl.Event)
+			}
+			if len(*tmpl.EmailHTML) > maxEmailTemplateLength {
+				return errors.Wrapf(ErrInvalidTemplate, "email_html must be at most %d bytes", maxEmailTemplateLength)
+			}
+		}
+	}
+
+	data := brandingData(sampleData(), &Branding{SenderName: new(string), LogoURL: new(string)})
+	if _, err := renderTemplate(tmpl, data); err != nil {
+		return err
+	}
+	if tmpl.EmailHTML != nil {
+		if _, err := renderEmailTemplate(*tmpl.EmailHTML, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateBranding 校验发件人名称和 Logo 地址，空字符串视为未设置
+func validateBranding(branding *Branding) error {
+	if branding.SenderName != nil {
+		name := strings.TrimSpace(*branding.SenderName)
+		if utf8.RuneCountInString(name) > maxSenderNameLength || strings.ContainsAny(name, "\r\n") {
+			return errors.Wrapf(ErrInvalidBranding, "sender_name must be at most %d characters on a single line", maxSenderNameLength)
+		}
+		branding.SenderName = &name
+		if name == "" {
+			branding.SenderName = nil
+		}
+	}
+
+	if branding.LogoURL != nil {
+		logoURL := strings.TrimSpace(*branding.LogoURL)
+		if logoURL == "" {
+			branding.LogoURL = nil
+		} else {
+			u, err := url.Parse(logoURL)
+			if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+				return errors.Wrap(ErrInvalidBranding, "logo_url must be an absolute http(s) URL")
+			}
+			branding.LogoURL = &logoURL
+		}
+	}
+
+	return nil
+}
+
+// scanTemplate 扫描 templateColumns
+func scanTemplate(row interface{ Scan(dest ...any) error }) (*Template, error) {
+	var tmpl Template
+	if err := row.Scan(
+		&tmpl.ID, &tmpl.OrgID, &tmpl.Event, &tmpl.Locale, &tmpl.Title, &tmpl.Body,
+		&tmpl.EmailHTML, &tmpl.CreatedBy, &tmpl.CreatedAt, &tmpl.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &tmpl, nil
+}
+
+// GetBranding 获取组织的通知品牌，未配置时返回空品牌
+func (s *service) GetBranding(ctx context.Context, orgID string) (*Branding, error) {
+	branding := &Branding{OrgID: orgID}
+
+	err := s.db.QueryRowContext(ctx, `
+		SELECT sender_name, logo_url, updated_at FROM notification_brandings WHERE org_id = $1
+	`, orgID).Scan(&branding.SenderName, &branding.LogoURL, &branding.UpdatedAt)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get notification branding")
+	}
+
+	return branding, nil
+}
+
+// UpdateBranding 覆盖组织的通知品牌
+func (s *service) UpdateBranding(ctx context.Context, branding *Branding) (*Branding, error) {
+	if err := validateBranding(branding); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.db.ExecContext(ctx, `
+		INSERT INTO notification_brandings (org_id, sender_name, logo_url)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (org_id) DO UPDATE SET
+			sender_name = EXCLUDED.sender_name,
+			logo_url = EXCLUDED.logo_url,
+			updated_at = NOW()
+	`, branding.OrgID, branding.SenderName, branding.LogoURL); err != nil {
+		return nil, errors.Wrap(err, "failed to update notification branding")
+	}
+
+	return s.GetBranding(ctx, branding.OrgID)
+}
+
+// ListTemplates 查询组织的通知模板（按事件、语言）
+func (s *service) ListTemplates(ctx context.Context, orgID string) ([]*Template, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+templateColumns+`
+		FROM notification_templates
+		WHERE org_id = $1
+		ORDER BY event, locale
+	`, orgID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query notification templates")
+	}
+	defer rows.Close()
+
+	templates := make([]*Template, 0)
+	for rows.Next() {
+		tmpl, err := scanTemplate(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan notification template")
+		}
+		templates = append(templates, tmpl)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate notification templates")
+	}
+
+	return templates, nil
+}
+
+// SaveTemplate 创建或覆盖组织对事件和语言的通知模板
+func (s *service) SaveTemplate(ctx context.Context, tmpl *Template) (*Template, error) {
+	if err := validateTemplate(tmpl); err != nil {
+		return nil, err
+	}
+
+	saved, err := scanTemplate(s.db.QueryRowContext(ctx, `
+		INSERT INTO notification_templates (org_id, event, locale, title, body, email_html, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (org_id, event, locale) DO UPDATE SET
+			title = EXCLUDED.title,
+			body = EXCLUDED.body,
+			email_html = EXCLUDED.email_html,
+			updated_at = NOW()
+		RETURNING `+templateColumns,
+		tmpl.OrgID, tmpl.Event, tmpl.Locale, tmpl.Title, tmpl.Body, tmpl.EmailHTML, tmpl.CreatedBy))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to save notification template")
+	}
+
+	return saved, nil
+}
+
+// DeleteTemplate 删除组织的通知模板，之后该事件和语言回退到下一级模板
+func (s *service) DeleteTemplate(ctx context.Context, orgID string, templateID string) error {
+	result, err := s.db.ExecContext(ctx, `
+		DELETE FROM notification_templates WHERE id = $1 AND org_id = $2
+	`, templateID, orgID)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete notification template")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get affected rows")
+	}
+	if affected == 0 {
+		return ErrTemplateNotFound
+	}
+
+	return nil
+}
+
+// PreviewTemplate 用示例数据和组织品牌渲染通知
+// 草稿的标题和正文都为空时，预览组织对草稿事件和语言实际生效的模板（按 localeFallbacks 回退，最后使用系统模板）
+func (s *service) PreviewTemplate(ctx context.Context, orgID string, draft *Template) (*Preview, error) {
+	branding, err := s.GetBranding(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	data := brandingData(sampleData(), branding)
+
+	tmpl := draft
+	source := TemplateSourceDraft
+	if draft.Title == "" && draft.Body == "" {
+		if !slices.Contains(AllEvents(), draft.Event) && !slices.Contains(AllStatusEvents(), draft.Event) {
+			return nil, errors.Wrapf(ErrInvalidTemplate, "unknown event %q", draft.Event)
+		}
+		locale, err := canonicalLocale(draft.Locale)
+		if err != nil {
+			return nil, errors.Wrap(ErrInvalidTemplate, err.Error())
+		}
+
+		tmpl, err = s.findTemplate(ctx, orgID, draft.Event, locale)
+		if err != nil {
+			return nil, err
+		}
+		if tmpl == nil {
+			msg, err := renderSystemMessage(draft.Event, data)
+			if err != nil {
+				return nil, err
+			}
+			return &Preview{Source: TemplateSourceSystem, Title: msg.Title, Body: msg.Body}, nil
+		}
+		source = TemplateSourceOrganization
+	} else if err := validateTemplate(tmpl); err != nil {
+		return nil, err
+	}
+
+	msg, err := renderTemplate(tmpl, data)
+	if err != nil {
+		return nil, err
+	}
+
+	locale := tmpl.Locale
+	preview := &Preview{Source: source, Locale: &locale, Title: msg.Title, Body: msg.Body}
+	if msg.EmailTemplate != "" {
+		html, err := renderEmailTemplate(msg.EmailTemplate, data)
+		if err != nil {
+			return nil, err
+		}
+		preview.EmailHTML = &html
+	}
+
+	return preview, nil
+}
+
+// findTemplate 按 localeFallbacks 的顺序查找组织对事件的模板，没有时返回 nil
+func (s *service) findTemplate(ctx context.Context, orgID string, eventType string, locale string) (*Template, error) {
+	tmpl, err := scanTemplate(s.db.QueryRowContext(ctx, `
+		SELECT `+templateColumns+`
+		FROM notification_templates
+		WHERE org_id = $1 AND event = $2 AND locale = ANY($3::text[])
+		ORDER BY array_position($3::text[], locale::text)
+		LIMIT 1
+	`, orgID, eventType, pq.Array(localeFallbacks(locale))))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil // 组织没有该事件的模板，使用系统模板
+		}
+		return nil, errors.Wrap(err, "failed to find notification template")
+	}
+
+	return tmpl, nil
+}
+
+// renderUserMessage 渲染发送给用户的通知：用户所在组织有该事件的模板时使用组织模板和品牌，否则使用系统模板
+func (s *service) renderUserMessage(ctx context.Context, event *Event, locale string) (*message, error) {
+	orgID, err := organization.UserOrgID(ctx, s.db, event.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if orgID == nil {
+		return renderMessage(event)
+	}
+
+	branding, err := s.GetBranding(ctx, *orgID)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := s.findTemplate(ctx, *orgID, event.Type, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	data := brandingData(templateData(event), branding)
+	var msg *message
+	if tmpl != nil {
+		msg, err = renderTemplate(tmpl, data)
+	} else {
+		msg, err = renderSystemMessage(event.Type, data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if branding.SenderName != nil {
+		msg.SenderName = *branding.SenderName
+	}
+
+	return msg, nil
+}
+
+// userLocale 获取用户的通知语言，未设置时返回空字符串
+func (s *service) userLocale(ctx context.Context, userID string) (string, error) {
+	var locale sql.NullString
+	err := s.db.QueryRowContext(ctx, `
+		SELECT locale FROM security_notification_settings WHERE user_id = $1
+	`, userID).Scan(&locale)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", errors.Wrap(err, "failed to get notification locale")
+	}
+
+	return locale.String, nil
+}
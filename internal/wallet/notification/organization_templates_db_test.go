@@ -0,0 +1,173 @@
+package notification_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github/chapool/go-wallet/internal/test"
+	"github/chapool/go-wallet/internal/test/fixtures"
+	"github/chapool/go-wallet/internal/wallet/notification"
+	"github/chapool/go-wallet/internal/wallet/organization"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderUserMessageWithOrganizationTemplates(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		organizations := organization.NewService(db)
+		org, err := organizations.CreateOrganization(ctx, "Acme")
+		require.NoError(t, err)
+		_, err = organizations.AddMember(ctx, org.ID, fix.User1.ID, organization.RoleMember)
+		require.NoError(t, err)
+
+		service := notification.NewService(db, nil, nil)
+
+		senderName := "Acme Pay"
+		_, err = service.UpdateBranding(ctx, &notification.Branding{OrgID: org.ID, SenderName: &senderName})
+		require.NoError(t, err)
+
+		emailHTML := "<p>{{ .amount }} {{ .tokenSymbol }}</p>"
+		for _, tmpl := range []*notification.Template{
+			{Locale: "", Title: "Large withdrawal", Body: "{{ .senderName }}: {{ .amount }} {{ .tokenSymbol }}"},
+			{Locale: "de", Title: "Große Auszahlung", Body: "{{ .senderName }}: {{ .amount }} {{ .tokenSymbol }} ausgezahlt", EmailHTML: &emailHTML},
+		} {
+			tmpl.OrgID = org.ID
+			tmpl.Event = notification.EventLargeWithdraw
+			_, err := service.SaveTemplate(ctx, tmpl)
+			require.NoError(t, err)
+		}
+
+		event := func(userID string) *notification.Event {
+			return &notification.Event{
+				Type:       notification.EventLargeWithdraw,
+				UserID:     userID,
+				Data:       map[string]string{"amount": "1.5", "tokenSymbol": "USDT", "toAddress": "0x742d35cc6634c0532925a3b844bc9e7595f0beb0"},
+				OccurredAt: time.Now(),
+			}
+		}
+
+		// de-AT 回退到 de 模板
+		title, body, emailTemplate, sender, err := notification.RenderUserMessage(ctx, service, event(fix.User1.ID), "de-AT")
+		require.NoError(t, err)
+		assert.Equal(t, "Große Auszahlung", title)
+		assert.Equal(t, "Acme Pay: 1.5 USDT ausgezahlt", body)
+		assert.Equal(t, emailHTML, emailTemplate)
+		assert.Equal(t, "Acme Pay", sender)
+
+		// 没有对应语言时使用组织默认模板
+		title, body, emailTemplate, _, err = notification.RenderUserMessage(ctx, service, event(fix.User1.ID), "fr")
+		require.NoError(t, err)
+		assert.Equal(t, "Large withdrawal", title)
+		assert.Equal(t, "Acme Pay: 1.5 USDT", body)
+		assert.Empty(t, emailTemplate)
+
+		// 组织没有模板的事件使用系统模板，仍然使用组织的发件人名称
+		whitelistEvent := event(fix.User1.ID)
+		whitelistEvent.Type = notification.EventWhitelistChanged
+		title, _, _, sender, err = notification.RenderUserMessage(ctx, service, whitelistEvent, "de")
+		require.NoError(t, err)
+		assert.Equal(t, "Withdrawal whitelist changed", title)
+		assert.Equal(t, "Acme Pay", sender)
+
+		// 不属于组织的用户使用系统模板
+		title, _, _, sender, err = notification.RenderUserMessage(ctx, service, event(fix.User2.ID), "de")
+		require.NoError(t, err)
+		assert.Equal(t, "Large withdrawal requested", title)
+		assert.Empty(t, sender)
+
+		// 保存同一事件和语言的模板覆盖原模板
+		saved, err := service.SaveTemplate(ctx, &notification.Template{
+			OrgID: org.ID, Event: notification.EventLargeWithdraw, Locale: "DE", Title: "Auszahlung", Body: "{{ .amount }}",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "de", saved.Locale)
+		assert.Nil(t, saved.EmailHTML)
+
+		templates, err := service.ListTemplates(ctx, org.ID)
+		require.NoError(t, err)
+		require.Len(t, templates, 2)
+
+		// 删除 de 模板后回退到组织默认模板
+		require.NoError(t, service.DeleteTemplate(ctx, org.ID, saved.ID))
+		title, _, _, _, err = notification.RenderUserMessage(ctx, service, event(fix.User1.ID), "de")
+		require.NoError(t, err)
+		assert.Equal(t, "Large withdrawal", title)
+
+		err = service.DeleteTemplate(ctx, org.ID, saved.ID)
+		assert.True(t, errors.Is(err, notification.ErrTemplateNotFound))
+	})
+}
+
+func TestPreviewTemplate(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+
+		org, err := organization.NewService(db).CreateOrganization(ctx, "Acme")
+		require.NoError(t, err)
+
+		service := notification.NewService(db, nil, nil)
+
+		logoURL := "https://example.com/logo.png"
+		_, err = service.UpdateBranding(ctx, &notification.Branding{OrgID: org.ID, LogoURL: &logoURL})
+		require.NoError(t, err)
+
+		// 草稿：邮件正文中的变量按 HTML 转义
+		emailHTML := `<img src="{{ .logoUrl }}"><p>{{ .amount }} {{ .tokenSymbol }}</p>`
+		preview, err := service.PreviewTemplate(ctx, org.ID, &notification.Template{
+			Event: notification.EventLargeWithdraw, Title: "Draft", Body: "{{ .amount }} {{ .tokenSymbol }}", EmailHTML: &emailHTML,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, notification.TemplateSourceDraft, preview.Source)
+		assert.Equal(t, "1.5 USDT", preview.Body)
+		require.NotNil(t, preview.EmailHTML)
+		assert.Equal(t, `<img src="https://example.com/logo.png"><p>1.5 USDT</p>`, *preview.EmailHTML)
+
+		// 组织没有模板时预览系统模板
+		preview, err = service.PreviewTemplate(ctx, org.ID, &notification.Template{Event: notification.EventDepositFinalized, Locale: "de"})
+		require.NoError(t, err)
+		assert.Equal(t, notification.TemplateSourceSystem, preview.Source)
+		assert.Nil(t, preview.Locale)
+		assert.Equal(t, "Deposit credited", preview.Title)
+
+		_, err = service.SaveTemplate(ctx, &notification.Template{
+			OrgID: org.ID, Event: notification.EventDepositFinalized, Title: "Einzahlung gutgeschrieben", Body: "{{ .amount }} {{ .tokenSymbol }}",
+		})
+		require.NoError(t, err)
+
+		preview, err = service.PreviewTemplate(ctx, org.ID, &notification.Template{Event: notification.EventDepositFinalized, Locale: "de"})
+		require.NoError(t, err)
+		assert.Equal(t, notification.TemplateSourceOrganization, preview.Source)
+		require.NotNil(t, preview.Locale)
+		assert.Empty(t, *preview.Locale)
+		assert.Equal(t, "Einzahlung gutgeschrieben", preview.Title)
+
+		// 不完整的草稿
+		_, err = service.PreviewTemplate(ctx, org.ID, &notification.Template{Event: notification.EventDepositFinalized, Title: "Draft"})
+		assert.True(t, errors.Is(err, notification.ErrInvalidTemplate))
+	})
+}
+
+func TestUpdateSettingsLocale(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		service := notification.NewService(db, nil, nil)
+
+		locale := "de_at"
+		settings, err := service.UpdateSettings(ctx, fix.User1.ID, &notification.Settings{Locale: &locale})
+		require.NoError(t, err)
+		require.NotNil(t, settings.Locale)
+		assert.Equal(t, "de-AT", *settings.Locale)
+
+		invalid := "not a locale"
+		_, err = service.UpdateSettings(ctx, fix.User1.ID, &notification.Settings{Locale: &invalid})
+		assert.True(t, errors.Is(err, notification.ErrInvalidSettings))
+	})
+}
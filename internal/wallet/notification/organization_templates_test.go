@@ -0,0 +1,85 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocaleFallbacks(t *testing.T) {
+	assert.Equal(t, []string{"de-AT", "de", ""}, localeFallbacks("de-AT"))
+	assert.Equal(t, []string{"de-AT", "de", ""}, localeFallbacks("de_at"))
+	assert.Equal(t, []string{"de", ""}, localeFallbacks("de"))
+	assert.Equal(t, []string{""}, localeFallbacks(""))
+	assert.Equal(t, []string{""}, localeFallbacks("not a locale"))
+}
+
+func TestValidateTemplate(t *testing.T) {
+	emailHTML := "<p>{{ .amount }} {{ .tokenSymbol }}</p>"
+
+	tmpl := &Template{
+		Event:     EventLargeWithdraw,
+		Locale:    "de-at",
+		Title:     "Große Auszahlung",
+		Body:      "{{ .senderName }}: {{ .amount }} {{ .tokenSymbol }} an {{ .toAddress }}",
+		EmailHTML: &emailHTML,
+	}
+	require.NoError(t, validateTemplate(tmpl))
+	assert.Equal(t, "de-AT", tmpl.Locale)
+
+	tests := []struct {
+		name string
+		tmpl Template
+	}{
+		{"unknown event", Template{Event: "unknown", Title: "title", Body: "body"}},
+		{"invalid locale", Template{Event: EventLargeWithdraw, Locale: "not a locale", Title: "title", Body: "body"}},
+		{"empty title", Template{Event: EventLargeWithdraw, Title: " ", Body: "body"}},
+		{"unparsable body", Template{Event: EventLargeWithdraw, Title: "title", Body: "{{ .amount "}},
+		{"unknown function", Template{Event: EventLargeWithdraw, Title: "{{ upper .amount }}", Body: "body"}},
+		{"email for status event", Template{Event: EventDepositFinalized, Title: "title", Body: "body", EmailHTML: &emailHTML}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTemplate(&tt.tmpl)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidTemplate), err)
+		})
+	}
+}
+
+func TestRenderTemplateWithBranding(t *testing.T) {
+	senderName := "Acme Pay"
+	data := brandingData(templateData(&Event{
+		Type: EventWithdrawConfirmed,
+		Data: map[string]string{"amount": "1.5", "tokenSymbol": "USDT", "toAddress": "0x742d35cc6634c0532925a3b844bc9e7595f0beb0"},
+	}), &Branding{SenderName: &senderName})
+
+	message, err := renderTemplate(&Template{
+		Event: EventWithdrawConfirmed,
+		Title: "{{ .senderName }}",
+		Body:  "{{ .amount }} {{ .tokenSymbol }} sent{{ if .logoUrl }} with logo{{ end }}",
+	}, data)
+	require.NoError(t, err)
+	assert.Equal(t, "Acme Pay", message.Title)
+	assert.Equal(t, "1.5 USDT sent", message.Body)
+	assert.Empty(t, message.EmailTemplate)
+}
+
+func TestValidateBranding(t *testing.T) {
+	senderName := "  Acme Pay "
+	logoURL := ""
+	branding := &Branding{SenderName: &senderName, LogoURL: &logoURL}
+	require.NoError(t, validateBranding(branding))
+	assert.Equal(t, "Acme Pay", *branding.SenderName)
+	assert.Nil(t, branding.LogoURL)
+
+	for _, logoURL := range []string{"javascript:alert(1)", "/logo.png", "ftp://example.com/logo.png"} {
+		err := validateBranding(&Branding{LogoURL: &logoURL})
+		assert.True(t, errors.Is(err, ErrInvalidBranding), logoURL)
+	}
+
+	senderName = "Acme\r\nBcc: victim@example.com"
+	assert.True(t, errors.Is(validateBranding(&Branding{SenderName: &senderName}), ErrInvalidBranding))
+}
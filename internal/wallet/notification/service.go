@@ -0,0 +1,354 @@
+//nolint:ireturn // 返回接口类型是预期的设计
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"slices"
+	"time"
+
+	"github/chapool/go-wallet/internal/auth"
+	"github/chapool/go-wallet/internal/data/dto"
+	"github/chapool/go-wallet/internal/mailer"
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/push"
+	"github/chapool/go-wallet/internal/util"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// 安全通知事件类型
+const (
+	EventLargeWithdraw      = "large_withdraw"       // 提现金额达到用户设置的个人阈值
+	EventNewWithdrawAddress = "new_withdraw_address" // 首次向新地址提现
+	EventWhitelistChanged   = "whitelist_changed"    // 提现白名单变更
+)
+
+// ErrInvalidSettings 通知设置校验失败
+var ErrInvalidSettings = errors.New("invalid notification settings")
+
+// AllEvents 返回所有安全通知事件类型
+func AllEvents() []string {
+	return []string{
+		EventLargeWithdraw,
+		EventNewWithdrawAddress,
+		EventWhitelistChanged,
+	}
+}
+
+// Event 安全通知事件
+type Event struct {
+	Type       string
+	UserID     string
+	Data       map[string]string // 模板变量，如 amount、tokenSymbol、toAddress
+	Device     *auth.Device      // 触发事件的设备，非 HTTP 请求触发时为空
+	OccurredAt time.Time
+}
+
+// WithdrawThreshold 大额提现通知个人阈值：提现金额 >= MinAmount（人类可读单位）时通知
+type WithdrawThreshold struct {
+	TokenID   int
+	MinAmount string
+}
+
+// Settings 用户安全通知设置
+type Settings struct {
+	DisabledEvents     []string
+	WithdrawThresholds []WithdrawThreshold
+	Locale             *string // 通知语言（BCP 47），用于选择组织模板
+}
+
+// Service 用户安全通知服务接口
+// 风险相关事件（大额提现、向新地址提现、白名单变更）通过推送和邮件通知用户，用户可关闭单个事件的通知
+// 组织可以按事件和语言自定义通知模板和品牌，组织成员收到的通知使用组织模板
+type Service interface {
+	// Notify 异步发送安全通知，用户关闭了该事件时不发送；发送失败只记录日志
+	Notify(ctx context.Context, event *Event)
+
+	// GetSettings 获取用户的通知设置
+	GetSettings(ctx context.Context, userID string) (*Settings, error)
+
+	// UpdateSettings 覆盖用户的通知设置
+	UpdateSettings(ctx context.Context, userID string, settings *Settings) (*Settings, error)
+
+	// GetWithdrawThreshold 获取用户对代币设置的大额提现通知阈值，未设置时返回 nil
+	GetWithdrawThreshold(ctx context.Context, userID string, tokenID int) (*big.Float, error)
+
+	// GetPushPreferences 获取用户的充值/提现状态推送偏好
+	GetPushPreferences(ctx context.Context, userID string) (*PushPreferences, error)
+
+	// UpdatePushPreferences 覆盖用户的充值/提现状态推送偏好
+	UpdatePushPreferences(ctx context.Context, userID string, preferences *PushPreferences) (*PushPreferences, error)
+
+	// StartStatusPushWorker 启动充值/提现状态推送 worker
+	StartStatusPushWorker(ctx context.Context, interval time.Duration)
+
+	// GetBranding 获取组织的通知品牌，未配置时返回空品牌
+	GetBranding(ctx context.Context, orgID string) (*Branding, error)
+
+	// UpdateBranding 覆盖组织的通知品牌
+	UpdateBranding(ctx context.Context, branding *Branding) (*Branding, error)
+
+	// ListTemplates 查询组织的通知模板
+	ListTemplates(ctx context.Context, orgID string) ([]*Template, error)
+
+	// SaveTemplate 创建或覆盖组织对事件和语言的通知模板
+	SaveTemplate(ctx context.Context, template *Template) (*Template, error)
+
+	// DeleteTemplate 删除组织的通知模板
+	DeleteTemplate(ctx context.Context, orgID string, templateID string) error
+
+	// PreviewTemplate 用示例数据渲染草稿模板或组织实际生效的模板
+	PreviewTemplate(ctx context.Context, orgID string, draft *Template) (*Preview, error)
+}
+
+// service 实现 Service 接口
+type service struct {
+	db     *sql.DB
+	mailer *mailer.Mailer
+	pusher *push.Service
+}
+
+// NewService 创建安全通知服务，mailer 或 pusher 为空时跳过对应渠道
+func NewService(db *sql.DB, mailer *mailer.Mailer, pusher *push.Service) Service {
+	return &service{
+		db:     db,
+		mailer: mailer,
+		pusher: pusher,
+	}
+}
+
+// Notify 异步发送安全通知
+func (s *service) Notify(ctx context.Context, event *Event) {
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+	if event.Device == nil {
+		event.Device = auth.DeviceFromContext(ctx)
+	}
+
+	// 通知不能阻塞或影响触发事件的业务请求
+	detachedCtx := util.DetachContext(ctx)
+	go func() {
+		if err := s.send(detachedCtx, event); err != nil {
+			log.Warn().
+				Err(err).
+				Str("event", event.Type).
+				Str("user_id", event.UserID).
+				Msg("Failed to send security notification")
+		}
+	}()
+}
+
+// send 按用户设置发送推送和邮件
+func (s *service) send(ctx context.Context, event *Event) error {
+	settings, err := s.GetSettings(ctx, event.UserID)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(settings.DisabledEvents, event.Type) {
+		log.Debug().
+			Str("event", event.Type).
+			Str("user_id", event.UserID).
+			Msg("Security notification disabled by user, skipping")
+		return nil
+	}
+
+	locale := ""
+	if settings.Locale != nil {
+		locale = *settings.Locale
+	}
+	message, err := s.renderUserMessage(ctx, event, locale)
+	if err != nil {
+		return err
+	}
+
+	if s.pusher != nil && s.pusher.GetProviderCount() > 0 {
+		if err := s.pusher.SendToUser(ctx, &dto.User{ID: event.UserID}, message.Title, message.Body); err != nil {
+			log.Warn().Err(err).Str("event", event.Type).Str("user_id", event.UserID).Msg("Failed to push security notification")
+		}
+	}
+
+	if s.mailer != nil {
+		user, err := models.FindUser(ctx, s.db, event.UserID)
+		if err != nil {
+			return errors.Wrap(err, "failed to get user")
+		}
+		if user.Username.Valid && user.Username.String != "" {
+			if err := s.mailer.SendSecurityNotification(ctx, user.Username.String, dto.SecurityNotificationPayload{
+				Event:      event.Type,
+				Subject:    message.Title,
+				Data:       templateData(event),
+				Template:   message.EmailTemplate,
+				SenderName: message.SenderName,
+			}); err != nil {
+				return errors.Wrap(err, "failed to send security notification email")
+			}
+		}
+	}
+
+	log.Info().
+		Str("event", event.Type).
+		Str("user_id", event.UserID).
+		Msg("Security notification sent")
+
+	return nil
+}
+
+// GetSettings 获取用户的通知设置，未配置时所有事件均开启、没有大额提现阈值
+func (s *service) GetSettings(ctx context.Context, userID string) (*Settings, error) {
+	settings := &Settings{
+		DisabledEvents:     make([]string, 0),
+		WithdrawThresholds: make([]WithdrawThreshold, 0),
+	}
+
+	var disabled pq.StringArray
+	err := s.db.QueryRowContext(ctx, `
+		SELECT disabled_events, locale FROM security_notification_settings WHERE user_id = $1
+	`, userID).Scan(&disabled, &settings.Locale)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get notification settings")
+	}
+	if len(disabled) > 0 {
+		settings.DisabledEvents = disabled
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT token_id, min_amount FROM security_withdraw_thresholds WHERE user_id = $1 ORDER BY token_id
+	`, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get withdraw notification thresholds")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var threshold WithdrawThreshold
+		if err := rows.Scan(&threshold.TokenID, &threshold.MinAmount); err != nil {
+			return nil, errors.Wrap(err, "failed to scan withdraw notification threshold")
+		}
+		settings.WithdrawThresholds = append(settings.WithdrawThresholds, threshold)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate withdraw notification thresholds")
+	}
+
+	return settings, nil
+}
+
+// UpdateSettings 覆盖用户的通知设置（关闭的事件、通知语言与所有大额提现阈值）
+func (s *service) UpdateSettings(ctx context.Context, userID string, settings *Settings) (*Settings, error) {
+	if err := s.validateSettings(ctx, settings); err != nil {
+		return nil, err
+	}
+	if settings.DisabledEvents == nil {
+		settings.DisabledEvents = make([]string, 0)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO security_notification_settings (user_id, disabled_events, locale)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET
+			disabled_events = EXCLUDED.disabled_events,
+			locale = EXCLUDED.locale,
+			updated_at = NOW()
+	`, userID, pq.Array(settings.DisabledEvents), settings.Locale); err != nil {
+		return nil, errors.Wrap(err, "failed to update notification settings")
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM security_withdraw_thresholds WHERE user_id = $1`, userID); err != nil {
+		return nil, errors.Wrap(err, "failed to delete withdraw notification thresholds")
+	}
+	for _, threshold := range settings.WithdrawThresholds {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO security_withdraw_thresholds (user_id, token_id, min_amount) VALUES ($1, $2, $3)
+		`, userID, threshold.TokenID, threshold.MinAmount); err != nil {
+			return nil, errors.Wrap(err, "failed to insert withdraw notification threshold")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "failed to commit transaction")
+	}
+
+	log.Info().
+		Str("user_id", userID).
+		Strs("disabled_events", settings.DisabledEvents).
+		Int("withdraw_thresholds", len(settings.WithdrawThresholds)).
+		Msg("Security notification settings updated")
+
+	return s.GetSettings(ctx, userID)
+}
+
+// validateSettings 校验事件类型、通知语言、阈值金额与代币，通知语言转换为规范形式
+func (s *service) validateSettings(ctx context.Context, settings *Settings) error {
+	for _, event := range settings.DisabledEvents {
+		if !slices.Contains(AllEvents(), event) {
+			return errors.Wrapf(ErrInvalidSettings, "unknown event %q", event)
+		}
+	}
+
+	if settings.Locale != nil {
+		locale, err := canonicalLocale(*settings.Locale)
+		if err != nil {
+			return errors.Wrap(ErrInvalidSettings, err.Error())
+		}
+		settings.Locale = &locale
+		if locale == "" {
+			settings.Locale = nil
+		}
+	}
+
+	tokenIDs := make(map[int]bool, len(settings.WithdrawThresholds))
+	for _, threshold := range settings.WithdrawThresholds {
+		if tokenIDs[threshold.TokenID] {
+			return errors.Wrapf(ErrInvalidSettings, "duplicate withdraw threshold for token_id=%d", threshold.TokenID)
+		}
+		tokenIDs[threshold.TokenID] = true
+
+		amount, err := money.ParseFloat(threshold.MinAmount)
+		if err != nil || amount.Sign() <= 0 {
+			return errors.Wrapf(ErrInvalidSettings, "invalid withdraw threshold min_amount %q", threshold.MinAmount)
+		}
+
+		exists, err := models.TokenExists(ctx, s.db, threshold.TokenID)
+		if err != nil {
+			return errors.Wrap(err, "failed to check token")
+		}
+		if !exists {
+			return errors.Wrapf(ErrInvalidSettings, "token_id=%d not found", threshold.TokenID)
+		}
+	}
+
+	return nil
+}
+
+// GetWithdrawThreshold 获取用户对代币设置的大额提现通知阈值
+func (s *service) GetWithdrawThreshold(ctx context.Context, userID string, tokenID int) (*big.Float, error) {
+	var minAmount string
+	err := s.db.QueryRowContext(ctx, `
+		SELECT min_amount FROM security_withdraw_thresholds WHERE user_id = $1 AND token_id = $2
+	`, userID, tokenID).Scan(&minAmount)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil // 未设置阈值表示不发送大额提现通知
+		}
+		return nil, errors.Wrap(err, "failed to get withdraw notification threshold")
+	}
+
+	amount, err := money.ParseFloat(minAmount)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid withdraw notification threshold %q", minAmount)
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,300 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/data/dto"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/chain"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// 充值/提现状态推送事件类型，由 transactions、withdraws 表上的触发器写入 push_notification_queue
+const (
+	EventDepositConfirmed  = "deposit_confirmed"  // 充值交易已上链确认
+	EventDepositFinalized  = "deposit_finalized"  // 充值交易已终结并入账
+	EventDepositFailed     = "deposit_failed"     // 充值交易因区块重组失效
+	EventWithdrawConfirmed = "withdraw_confirmed" // 提现交易已上链确认
+	EventWithdrawFailed    = "withdraw_failed"    // 提现失败
+)
+
+// statusPushBatchSize 每次领取的待发送推送数量
+const statusPushBatchSize = 100
+
+// AllStatusEvents 返回所有状态推送事件类型
+func AllStatusEvents() []string {
+	return []string{
+		EventDepositConfirmed,
+		EventDepositFinalized,
+		EventDepositFailed,
+		EventWithdrawConfirmed,
+		EventWithdrawFailed,
+	}
+}
+
+// PushPreferences 用户状态推送偏好
+type PushPreferences struct {
+	DisabledEvents []string
+}
+
+// GetPushPreferences 获取用户的状态推送偏好，未配置时所有事件均开启
+func (s *service) GetPushPreferences(ctx context.Context, userID string) (*PushPreferences, error) {
+	preferences := &PushPreferences{DisabledEvents: make([]string, 0)}
+
+	var disabled pq.StringArray
+	err := s.db.QueryRowContext(ctx, `
+		SELECT disabled_events FROM push_notification_preferences WHERE user_id = $1
+	`, userID).Scan(&disabled)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get push notification preferences")
+	}
+	if len(disabled) > 0 {
+		preferences.DisabledEvents = disabled
+	}
+
+	return preferences, nil
+}
+
+// UpdatePushPreferences 覆盖用户的状态推送偏好
+func (s *service) UpdatePushPreferences(ctx context.Context, userID string, preferences *PushPreferences) (*PushPreferences, error) {
+	for _, event := range preferences.DisabledEvents {
+		if !slices.Contains(AllStatusEvents(), event) {
+			return nil, errors.Wrapf(ErrInvalidSettings, "unknown event %q", event)
+		}
+	}
+	if preferences.DisabledEvents == nil {
+		preferences.DisabledEvents = make([]string, 0)
+	}
+
+	if _, err := s.db.ExecContext(ctx, `
+		INSERT INTO push_notification_preferences (user_id, disabled_events)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET
+			disabled_events = EXCLUDED.disabled_events,
+			updated_at = NOW()
+	`, userID, pq.Array(preferences.DisabledEvents)); err != nil {
+		return nil, errors.Wrap(err, "failed to update push notification preferences")
+	}
+
+	log.Info().
+		Str("user_id", userID).
+		Strs("disabled_events", preferences.DisabledEvents).
+		Msg("Push notification preferences updated")
+
+	return s.GetPushPreferences(ctx, userID)
+}
+
+// StartStatusPushWorker 启动状态推送 worker，定期发送触发器写入的充值/提现状态推送
+// 未配置推送渠道时仍然清空队列
+func (s *service) StartStatusPushWorker(ctx context.Context, interval time.Duration) {
+	log.Info().
+		Dur("interval", interval).
+		Msg("Starting status push worker")
+
+	lifecycle.Go(ctx, "status push worker", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Status push worker stopped")
+				return
+			case <-ticker.C:
+				s.processStatusPushes(ctx)
+			}
+		}
+	})
+}
+
+// processStatusPushes 依次领取并发送待发送的推送，直到队列为空
+// 领取即删除（多实例通过 SKIP LOCKED 互不重复），推送失败只记录日志，不重试
+func (s *service) processStatusPushes(ctx context.Context) {
+	for ctx.Err() == nil {
+		events, err := s.claimStatusPushes(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to claim status pushes")
+			return
+		}
+
+		for _, event := range events {
+			if err := s.sendStatusPush(ctx, event); err != nil {
+				log.Warn().
+					Err(err).
+					Str("event", event.Type).
+					Str("reference_id", event.Data["referenceId"]).
+					Msg("Failed to send status push notification")
+			}
+		}
+
+		if len(events) < statusPushBatchSize {
+			return
+		}
+	}
+}
+
+// claimStatusPushes 领取一批待发送的推送
+func (s *service) claimStatusPushes(ctx context.Context) ([]*Event, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		WITH claimed AS (
+			DELETE FROM push_notification_queue
+			WHERE id IN (
+				SELECT id FROM push_notification_queue
+				ORDER BY id
+				LIMIT $1
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, event, reference_id, created_at
+		)
+		SELECT event, reference_id, created_at FROM claimed ORDER BY id
+	`, statusPushBatchSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to claim status pushes")
+	}
+	defer rows.Close()
+
+	events := make([]*Event, 0)
+	for rows.Next() {
+		var (
+			event       Event
+			referenceID string
+		)
+		if err := rows.Scan(&event.Type, &referenceID, &event.OccurredAt); err != nil {
+			return nil, errors.Wrap(err, "failed to scan status push")
+		}
+		event.Data = map[string]string{"referenceId": referenceID}
+		events = append(events, &event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate status pushes")
+	}
+
+	return events, nil
+}
+
+// sendStatusPush 补全推送内容并按用户偏好推送到用户的设备
+func (s *service) sendStatusPush(ctx context.Context, event *Event) error {
+	var err error
+	if strings.HasPrefix(event.Type, "deposit_") {
+		err = s.loadDeposit(ctx, event)
+	} else {
+		err = s.loadWithdraw(ctx, event)
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// 不属于任何用户钱包或代币未登记，不推送
+			return nil
+		}
+		return err
+	}
+
+	preferences, err := s.GetPushPreferences(ctx, event.UserID)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(preferences.DisabledEvents, event.Type) {
+		return nil
+	}
+
+	if s.pusher == nil || s.pusher.GetProviderCount() == 0 {
+		return nil
+	}
+
+	locale, err := s.userLocale(ctx, event.UserID)
+	if err != nil {
+		return err
+	}
+	message, err := s.renderUserMessage(ctx, event, locale)
+	if err != nil {
+		return err
+	}
+
+	if err := s.pusher.SendToUser(ctx, &dto.User{ID: event.UserID}, message.Title, message.Body); err != nil {
+		return errors.Wrap(err, "failed to push status notification")
+	}
+
+	log.Debug().
+		Str("event", event.Type).
+		Str("user_id", event.UserID).
+		Str("reference_id", event.Data["referenceId"]).
+		Msg("Status push notification sent")
+
+	return nil
+}
+
+// loadDeposit 按收款地址查找充值所属用户，金额转换为人类可读单位
+func (s *service) loadDeposit(ctx context.Context, event *Event) error {
+	var (
+		chainID  int
+		amount   string
+		txHash   string
+		symbol   string
+		decimals int
+	)
+	err := s.db.QueryRowContext(ctx, `
+		SELECT w.user_id, t.chain_id, t.amount, t.tx_hash, tk.token_symbol, tk.decimals
+		FROM transactions t
+		JOIN chains c ON c.chain_id = t.chain_id
+		JOIN wallets w ON w.chain_id = t.chain_id
+			AND wallet_address_key(w.chain_type, w.address) = wallet_address_key(c.chain_type, t.to_addr)
+		JOIN LATERAL (
+			SELECT token_symbol, decimals
+			FROM tokens
+			WHERE chain_id = t.chain_id
+				AND CASE
+					WHEN COALESCE(t.token_addr, '') = '' THEN is_native
+					WHEN c.chain_type = $2 THEN token_address = t.token_addr
+					ELSE lower(token_address) = lower(t.token_addr)
+				END
+			LIMIT 1
+		) tk ON TRUE
+		WHERE t.id = $1
+	`, event.Data["referenceId"], chain.TypeSolana).Scan(&event.UserID, &chainID, &amount, &txHash, &symbol, &decimals)
+	if err != nil {
+		return errors.Wrap(err, "failed to get deposit")
+	}
+
+	event.Data["amount"] = money.FormatSmallestUnit(amount, decimals)
+	event.Data["tokenSymbol"] = symbol
+	event.Data["txHash"] = txHash
+	event.Data["chainId"] = strconv.Itoa(chainID)
+
+	return nil
+}
+
+// loadWithdraw 获取提现信息
+func (s *service) loadWithdraw(ctx context.Context, event *Event) error {
+	var (
+		chainID   int
+		amount    string
+		toAddress string
+		txHash    string
+		symbol    string
+	)
+	err := s.db.QueryRowContext(ctx, `
+		SELECT w.user_id, w.chain_id, w.amount, w.to_address, COALESCE(w.tx_hash, ''), tk.token_symbol
+		FROM withdraws w
+		JOIN tokens tk ON tk.id = w.token_id
+		WHERE w.id = $1
+	`, event.Data["referenceId"]).Scan(&event.UserID, &chainID, &amount, &toAddress, &txHash, &symbol)
+	if err != nil {
+		return errors.Wrap(err, "failed to get withdraw")
+	}
+
+	event.Data["amount"] = amount
+	event.Data["tokenSymbol"] = symbol
+	event.Data["toAddress"] = toAddress
+	event.Data["txHash"] = txHash
+	event.Data["chainId"] = strconv.Itoa(chainID)
+
+	return nil
+}
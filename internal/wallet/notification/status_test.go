@@ -0,0 +1,27 @@
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderStatusMessages(t *testing.T) {
+	for _, eventType := range AllStatusEvents() {
+		message, err := renderMessage(&Event{
+			Type: eventType,
+			Data: map[string]string{
+				"amount":      "1.5",
+				"tokenSymbol": "USDT",
+				"toAddress":   "0x742d35cc6634c0532925a3b844bc9e7595f0beb0",
+				"txHash":      "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060",
+			},
+			OccurredAt: time.Now(),
+		})
+		require.NoError(t, err, eventType)
+		assert.NotEmpty(t, message.Title, eventType)
+		assert.Contains(t, message.Body, "1.5 USDT", eventType)
+	}
+}
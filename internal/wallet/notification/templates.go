@@ -0,0 +1,114 @@
+package notification
+
+import (
+	"bytes"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// message 推送通知内容，安全通知的标题同时用作邮件主题（邮件正文使用 web/templates/email/security_<event> 模板）
+// 使用组织模板时，EmailTemplate 为组织的邮件正文模板（html/template，为空时仍使用系统邮件模板），SenderName 为组织的发件人名称
+type message struct {
+	Title         string
+	Body          string
+	EmailTemplate string
+	SenderName    string
+}
+
+// messageTemplate 单个事件的推送模板
+type messageTemplate struct {
+	title *template.Template
+	body  *template.Template
+}
+
+// pushTemplates 每个事件的推送模板，变量见 templateData
+var pushTemplates = map[string]messageTemplate{
+	EventLargeWithdraw: {
+		title: template.Must(template.New("title").Parse("Large withdrawal requested")),
+		body: template.Must(template.New("body").Parse(
+			"A withdrawal of {{ .amount }} {{ .tokenSymbol }} to {{ .toAddress }} was requested" +
+				"{{ if .deviceIp }} from {{ .deviceIp }}{{ end }}. If this was not you, contact support immediately.")),
+	},
+	EventNewWithdrawAddress: {
+		title: template.Must(template.New("title").Parse("Withdrawal to a new address")),
+		body: template.Must(template.New("body").Parse(
+			"A withdrawal of {{ .amount }} {{ .tokenSymbol }} to the new address {{ .toAddress }} was requested" +
+				"{{ if .deviceIp }} from {{ .deviceIp }}{{ end }}. If this was not you, contact support immediately.")),
+	},
+	EventWhitelistChanged: {
+		title: template.Must(template.New("title").Parse("Withdrawal whitelist changed")),
+		body: template.Must(template.New("body").Parse(
+			"Your withdrawal whitelist was changed: {{ .change }} {{ .toAddress }}" +
+				"{{ if .deviceIp }} from {{ .deviceIp }}{{ end }}. If this was not you, contact support immediately.")),
+	},
+	EventDepositConfirmed: {
+		title: template.Must(template.New("title").Parse("Deposit received")),
+		body: template.Must(template.New("body").Parse(
+			"Your deposit of {{ .amount }} {{ .tokenSymbol }} was confirmed on chain and will be credited once finalized.")),
+	},
+	EventDepositFinalized: {
+		title: template.Must(template.New("title").Parse("Deposit credited")),
+		body: template.Must(template.New("body").Parse(
+			"Your deposit of {{ .amount }} {{ .tokenSymbol }} was finalized and credited to your balance.")),
+	},
+	EventDepositFailed: {
+		title: template.Must(template.New("title").Parse("Deposit reverted")),
+		body: template.Must(template.New("body").Parse(
+			"Your deposit of {{ .amount }} {{ .tokenSymbol }} (transaction {{ .txHash }}) was dropped by a chain reorganization and will not be credited.")),
+	},
+	EventWithdrawConfirmed: {
+		title: template.Must(template.New("title").Parse("Withdrawal completed")),
+		body: template.Must(template.New("body").Parse(
+			"Your withdrawal of {{ .amount }} {{ .tokenSymbol }} to {{ .toAddress }} was confirmed on chain.")),
+	},
+	EventWithdrawFailed: {
+		title: template.Must(template.New("title").Parse("Withdrawal failed")),
+		body: template.Must(template.New("body").Parse(
+			"Your withdrawal of {{ .amount }} {{ .tokenSymbol }} to {{ .toAddress }} failed. The amount is returned to your balance.")),
+	},
+}
+
+// templateData 模板变量：事件数据 + 设备信息（deviceIp、deviceUserAgent）+ 发生时间（time，UTC）
+func templateData(event *Event) map[string]string {
+	data := make(map[string]string, len(event.Data))
+	for k, v := range event.Data {
+		data[k] = v
+	}
+	if event.Device != nil {
+		data["deviceIp"] = event.Device.IP
+		data["deviceUserAgent"] = event.Device.UserAgent
+	}
+	data["time"] = event.OccurredAt.UTC().Format(time.RFC3339)
+
+	return data
+}
+
+// renderMessage 渲染事件的系统推送内容
+func renderMessage(event *Event) (*message, error) {
+	return renderSystemMessage(event.Type, templateData(event))
+}
+
+// renderSystemMessage 用系统模板渲染事件的推送内容
+func renderSystemMessage(eventType string, data map[string]string) (*message, error) {
+	tmpl, ok := pushTemplates[eventType]
+	if !ok {
+		return nil, errors.Errorf("no notification template for event %q", eventType)
+	}
+
+	return executeMessage(tmpl, data)
+}
+
+// executeMessage 渲染推送标题和正文
+func executeMessage(tmpl messageTemplate, data map[string]string) (*message, error) {
+	var title, body bytes.Buffer
+	if err := tmpl.title.Execute(&title, data); err != nil {
+		return nil, errors.Wrap(err, "failed to render notification title")
+	}
+	if err := tmpl.body.Execute(&body, data); err != nil {
+		return nil, errors.Wrap(err, "failed to render notification body")
+	}
+
+	return &message{Title: title.String(), Body: body.String()}, nil
+}
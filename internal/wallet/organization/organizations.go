@@ -0,0 +1,283 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"unicode/utf8"
+
+	"github/chapool/go-wallet/internal/models"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// organizationColumns organizations 查询列（含成员数），与 scanOrganization 的顺序一致
+const organizationColumns = `o.id, o.name, (SELECT COUNT(*) FROM organization_members m WHERE m.org_id = o.id), o.created_at, o.updated_at`
+
+// memberColumns organization_members 查询列（关联 users），与 scanMember 的顺序一致
+const memberColumns = `m.org_id, m.user_id, u.username, m.role, m.created_at`
+
+// rowScanner 兼容 *sql.Row 和 *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// CreateOrganization 创建组织
+func (s *service) CreateOrganization(ctx context.Context, name string) (*Organization, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxNameLength {
+		return nil, errors.Wrapf(ErrInvalidOrganization, "name must be 1 to %d characters", maxNameLength)
+	}
+
+	var orgID string
+	err := s.db.QueryRowContext(ctx, `INSERT INTO organizations (name) VALUES ($1) RETURNING id`, name).Scan(&orgID)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == pgUniqueViolation {
+			return nil, ErrOrganizationExists
+		}
+		return nil, errors.Wrap(err, "failed to insert organization")
+	}
+
+	return s.GetOrganization(ctx, orgID)
+}
+
+// ListOrganizations 查询所有组织（按名称）
+func (s *service) ListOrganizations(ctx context.Context) ([]*Organization, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+organizationColumns+`
+		FROM organizations o
+		ORDER BY o.name
+	`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query organizations")
+	}
+	defer rows.Close()
+
+	organizations := make([]*Organization, 0)
+	for rows.Next() {
+		organization, err := scanOrganization(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan organization")
+		}
+		organizations = append(organizations, organization)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate organizations")
+	}
+
+	return organizations, nil
+}
+
+// GetOrganization 获取组织
+func (s *service) GetOrganization(ctx context.Context, orgID string) (*Organization, error) {
+	organization, err := scanOrganization(s.db.QueryRowContext(ctx, `
+		SELECT `+organizationColumns+`
+		FROM organizations o
+		WHERE o.id = $1
+	`, orgID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOrganizationNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get organization")
+	}
+
+	return organization, nil
+}
+
+// GetMembership 获取用户所属组织的成员信息
+func (s *service) GetMembership(ctx context.Context, userID string) (*Member, error) {
+	member, err := scanMember(s.db.QueryRowContext(ctx, `
+		SELECT `+memberColumns+`
+		FROM organization_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.user_id = $1
+	`, userID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil // 用户不属于任何组织
+		}
+		return nil, errors.Wrap(err, "failed to get organization membership")
+	}
+
+	return member, nil
+}
+
+// ListMembers 查询组织成员（管理员在前）
+func (s *service) ListMembers(ctx context.Context, orgID string) ([]*Member, error) {
+	if _, err := s.GetOrganization(ctx, orgID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+memberColumns+`
+		FROM organization_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.org_id = $1
+		ORDER BY m.role = 'admin' DESC, m.created_at, m.user_id
+	`, orgID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query organization members")
+	}
+	defer rows.Close()
+
+	members := make([]*Member, 0)
+	for rows.Next() {
+		member, err := scanMember(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan organization member")
+		}
+		members = append(members, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate organization members")
+	}
+
+	return members, nil
+}
+
+// AddMember 将用户加入组织或修改成员角色
+// 新成员已有的用户钱包和资金流水在同一事务中归属该组织，之后新建的由数据库触发器设置 org_id
+func (s *service) AddMember(ctx context.Context, orgID string, userID string, role string) (*Member, error) {
+	if role != RoleAdmin && role != RoleMember {
+		return nil, errors.Wrapf(ErrInvalidOrganization, "unknown role %q", role)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// 确认组织存在
+	var locked string
+	if err := tx.QueryRowContext(ctx, `SELECT id FROM organizations WHERE id = $1`, orgID).Scan(&locked); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOrganizationNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get organization")
+	}
+
+	// 锁定用户行，使同一用户并发加入不同组织时依次处理
+	if err := tx.QueryRowContext(ctx, `SELECT id FROM users WHERE id = $1 FOR UPDATE`, userID).Scan(&locked); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, errors.Wrap(err, "failed to lock user")
+	}
+
+	currentOrgID, err := UserOrgID(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case currentOrgID == nil:
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO organization_members (user_id, org_id, role) VALUES ($1, $2, $3)
+		`, userID, orgID, role); err != nil {
+			return nil, errors.Wrap(err, "failed to insert organization member")
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE wallets SET org_id = $1, updated_at = NOW()
+			WHERE user_id = $2 AND wallet_type = $3 AND org_id IS NULL
+		`, orgID, userID, models.WalletTypeUser); err != nil {
+			return nil, errors.Wrap(err, "failed to assign wallets to organization")
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE credits SET org_id = $1 WHERE user_id = $2 AND org_id IS NULL
+		`, orgID, userID); err != nil {
+			return nil, errors.Wrap(err, "failed to assign credits to organization")
+		}
+	case *currentOrgID == orgID:
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE organization_members SET role = $1 WHERE user_id = $2
+		`, role, userID); err != nil {
+			return nil, errors.Wrap(err, "failed to update organization member role")
+		}
+	default:
+		return nil, ErrAlreadyMember
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return s.GetMembership(ctx, userID)
+}
+
+// RemoveMember 将用户移出组织
+// 资金流水不能在组织间迁移，只有在组织中没有钱包的成员（如只做管理的成员）可以移除
+func (s *service) RemoveMember(ctx context.Context, orgID string, userID string) error {
+	res, err := s.db.ExecContext(ctx, `
+		DELETE FROM organization_members m
+		WHERE m.org_id = $1 AND m.user_id = $2
+			AND NOT EXISTS (SELECT 1 FROM wallets w WHERE w.user_id = m.user_id AND w.org_id = m.org_id)
+	`, orgID, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete organization member")
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get affected rows")
+	}
+	if affected > 0 {
+		return nil
+	}
+
+	currentOrgID, err := UserOrgID(ctx, s.db, userID)
+	if err != nil {
+		return err
+	}
+	if currentOrgID == nil || *currentOrgID != orgID {
+		return ErrMemberNotFound
+	}
+
+	return ErrMemberHasWallets
+}
+
+// scanOrganization 扫描一行组织
+func scanOrganization(row rowScanner) (*Organization, error) {
+	var organization Organization
+	if err := row.Scan(
+		&organization.ID,
+		&organization.Name,
+		&organization.MemberCount,
+		&organization.CreatedAt,
+		&organization.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &organization, nil
+}
+
+// scanMember 扫描一行组织成员
+func scanMember(row rowScanner) (*Member, error) {
+	var (
+		member   Member
+		username sql.NullString
+	)
+	if err := row.Scan(
+		&member.OrgID,
+		&member.UserID,
+		&username,
+		&member.Role,
+		&member.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if username.Valid {
+		member.Username = &username.String
+	}
+
+	return &member, nil
+}
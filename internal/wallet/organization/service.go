@@ -0,0 +1,110 @@
+// Package organization 提供多租户组织
+// 同一部署共用一个种子托管多个相互独立的租户：用户钱包、资金流水、组织默认提现限额和热钱包按 org_id 隔离，
+// 用户最多属于一个组织，组织管理员（admin）管理本组织成员并查看组织账务
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/pkg/errors"
+)
+
+// 组织成员角色
+const (
+	RoleAdmin  = "admin"  // 管理组织成员，查看组织账务
+	RoleMember = "member" // 普通成员
+)
+
+// maxNameLength 组织名称最大长度
+const maxNameLength = 100
+
+// pgUniqueViolation PostgreSQL 唯一约束冲突错误码
+const pgUniqueViolation = "23505"
+
+var (
+	// ErrInvalidOrganization 组织参数不合法
+	ErrInvalidOrganization = errors.New("invalid organization")
+	// ErrOrganizationNotFound 组织不存在
+	ErrOrganizationNotFound = errors.New("organization not found")
+	// ErrOrganizationExists 组织名称已存在
+	ErrOrganizationExists = errors.New("organization already exists")
+	// ErrUserNotFound 用户不存在
+	ErrUserNotFound = errors.New("user not found")
+	// ErrAlreadyMember 用户已属于其他组织
+	ErrAlreadyMember = errors.New("user already belongs to another organization")
+	// ErrMemberNotFound 用户不是组织成员
+	ErrMemberNotFound = errors.New("organization member not found")
+	// ErrMemberHasWallets 成员在组织中有钱包，移除后账务将无法归属
+	ErrMemberHasWallets = errors.New("organization member has wallets")
+)
+
+// Service 组织服务接口
+type Service interface {
+	// CreateOrganization 创建组织
+	CreateOrganization(ctx context.Context, name string) (*Organization, error)
+
+	// ListOrganizations 查询所有组织
+	ListOrganizations(ctx context.Context) ([]*Organization, error)
+
+	// GetOrganization 获取组织，不存在时返回 ErrOrganizationNotFound
+	GetOrganization(ctx context.Context, orgID string) (*Organization, error)
+
+	// GetMembership 获取用户所属组织的成员信息，不属于任何组织时返回 nil
+	GetMembership(ctx context.Context, userID string) (*Member, error)
+
+	// ListMembers 查询组织成员
+	ListMembers(ctx context.Context, orgID string) ([]*Member, error)
+
+	// AddMember 将用户加入组织或修改成员角色，用户已有的钱包和资金流水随之归属该组织
+	AddMember(ctx context.Context, orgID string, userID string, role string) (*Member, error)
+
+	// RemoveMember 将用户移出组织，成员在组织中有钱包时返回 ErrMemberHasWallets
+	RemoveMember(ctx context.Context, orgID string, userID string) error
+}
+
+// Organization 组织
+type Organization struct {
+	ID          string
+	Name        string
+	MemberCount int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Member 组织成员
+type Member struct {
+	OrgID     string
+	UserID    string
+	Username  *string
+	Role      string
+	CreatedAt time.Time
+}
+
+type service struct {
+	db *sql.DB
+}
+
+// NewService 创建组织服务
+func NewService(db *sql.DB) Service {
+	return &service{db: db}
+}
+
+// UserOrgID 查询用户所属组织，不属于任何组织时返回 nil
+// 供按组织选择热钱包等需要在业务事务中确定组织的场景使用
+func UserOrgID(ctx context.Context, exec boil.ContextExecutor, userID string) (*string, error) {
+	var orgID string
+	err := exec.QueryRowContext(ctx, `SELECT org_id FROM organization_members WHERE user_id = $1`, userID).Scan(&orgID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil // 用户不属于任何组织
+		}
+		return nil, errors.Wrap(err, "failed to get user organization")
+	}
+
+	return &orgID, nil
+}
@@ -0,0 +1,147 @@
+package price
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// Chainlink AggregatorV3Interface 方法的 selector
+var (
+	decimalsMethodID        = common.Hex2Bytes("313ce567") // decimals()
+	latestRoundDataMethodID = common.Hex2Bytes("feaf968c") // latestRoundData()
+)
+
+const (
+	abiWordLength = 32
+	// roundDataLength latestRoundData 返回 (roundId, answer, startedAt, updatedAt, answeredInRound)
+	roundDataLength = 5 * abiWordLength
+	// maxFeedDecimals 喂价 decimals 的合理上限
+	maxFeedDecimals = 36
+	// maxRoundAge 喂价最长的更新间隔（Chainlink USD 喂价的心跳最长为 24 小时），超过时视为失效
+	maxRoundAge = 25 * time.Hour
+)
+
+// chainlinkProvider 读取 Chainlink USD 喂价合约（AggregatorV3Interface）获取价格
+type chainlinkProvider struct {
+	chainID int
+	feeds   map[string]common.Address // 代币符号 -> 喂价合约地址
+	clients clientProvider
+}
+
+func newChainlinkProvider(chainID int, feeds map[string]string, clients clientProvider) (*chainlinkProvider, error) {
+	if clients == nil {
+		return nil, errors.New("chainlink price provider requires RPC clients")
+	}
+
+	res := make(map[string]common.Address, len(feeds))
+	for symbol, feed := range feeds {
+		if !common.IsHexAddress(feed) {
+			return nil, errors.Errorf("invalid chainlink feed address %q for %s", feed, symbol)
+		}
+		res[strings.ToUpper(symbol)] = common.HexToAddress(feed)
+	}
+
+	return &chainlinkProvider{
+		chainID: chainID,
+		feeds:   res,
+		clients: clients,
+	}, nil
+}
+
+func (p *chainlinkProvider) Name() string {
+	return SourceChainlink
+}
+
+// FetchPrices 逐个读取喂价，单个喂价失败或失效时跳过该代币（保留上次的价格）
+func (p *chainlinkProvider) FetchPrices(ctx context.Context, symbols []string) (map[string]*big.Float, error) {
+	prices := make(map[string]*big.Float, len(symbols))
+
+	client, err := p.clients.GetClient(ctx, p.chainID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get RPC client for chain %d", p.chainID)
+	}
+
+	for _, symbol := range symbols {
+		feed, ok := p.feeds[symbol]
+		if !ok {
+			continue
+		}
+
+		price, err := readFeed(ctx, client, feed, time.Now())
+		if err != nil {
+			log.Warn().
+				Err(err).
+				Int("chain_id", p.chainID).
+				Str("symbol", symbol).
+				Str("feed", feed.Hex()).
+				Msg("Failed to read chainlink price feed")
+			continue
+		}
+		prices[symbol] = price
+	}
+
+	return prices, nil
+}
+
+// contractCaller 执行 eth_call（*scan.RPCClient）
+type contractCaller interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// readFeed 读取喂价合约的最新价格
+func readFeed(ctx context.Context, caller contractCaller, feed common.Address, now time.Time) (*big.Float, error) {
+	resp, err := caller.CallContract(ctx, ethereum.CallMsg{To: &feed, Data: decimalsMethodID}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call decimals")
+	}
+	if len(resp) < abiWordLength {
+		return nil, errors.New("decimals returned invalid data")
+	}
+	decimals := new(big.Int).SetBytes(resp[:abiWordLength])
+	if !decimals.IsInt64() || decimals.Int64() > maxFeedDecimals {
+		return nil, errors.Errorf("decimals %s is out of range", decimals.String())
+	}
+
+	resp, err = caller.CallContract(ctx, ethereum.CallMsg{To: &feed, Data: latestRoundDataMethodID}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call latestRoundData")
+	}
+
+	return parseRoundData(resp, int(decimals.Int64()), now)
+}
+
+// parseRoundData 解析 latestRoundData 的返回值，answer 不为正数或超过 maxRoundAge 未更新时返回错误
+func parseRoundData(data []byte, decimals int, now time.Time) (*big.Float, error) {
+	if len(data) < roundDataLength {
+		return nil, errors.New("latestRoundData returned invalid data")
+	}
+
+	answerWord := data[abiWordLength : 2*abiWordLength]
+	// answer 为 int256，最高位为 1 表示负数
+	if answerWord[0]&0x80 != 0 {
+		return nil, errors.New("latestRoundData returned a negative answer")
+	}
+	answer := new(big.Int).SetBytes(answerWord)
+	if answer.Sign() == 0 {
+		return nil, errors.New("latestRoundData returned a zero answer")
+	}
+
+	updatedAt := new(big.Int).SetBytes(data[3*abiWordLength : 4*abiWordLength])
+	if !updatedAt.IsInt64() {
+		return nil, errors.New("latestRoundData returned an invalid updatedAt")
+	}
+	if age := now.Sub(time.Unix(updatedAt.Int64(), 0)); age > maxRoundAge {
+		return nil, errors.Errorf("price feed is stale, last updated %s ago", age.Truncate(time.Second))
+	}
+
+	divisor := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	price := new(big.Float).SetPrec(bigFloatPrecision).SetInt(answer)
+	return price.Quo(price, divisor), nil
+}
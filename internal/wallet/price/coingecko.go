@@ -0,0 +1,125 @@
+package price
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// coinGeckoRequestTimeout CoinGecko 请求超时时间
+	coinGeckoRequestTimeout = 30 * time.Second
+	// coinGeckoProHost CoinGecko Pro API 的域名，使用 x-cg-pro-api-key 认证，其他域名使用 x-cg-demo-api-key
+	coinGeckoProHost = "pro-api.coingecko.com"
+	// maxErrorBodyLength 错误响应最多读取的长度
+	maxErrorBodyLength = 1024
+)
+
+// coinGeckoProvider 通过 CoinGecko simple/price API 获取价格
+type coinGeckoProvider struct {
+	endpoint  string
+	apiKey    string
+	keyHeader string
+	ids       map[string]string // 代币符号 -> coin id
+	client    *http.Client
+}
+
+func newCoinGeckoProvider(baseURL string, apiKey string, ids map[string]string) (*coinGeckoProvider, error) {
+	endpoint, err := url.JoinPath(baseURL, "simple", "price")
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid CoinGecko URL")
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid CoinGecko URL")
+	}
+
+	keyHeader := "x-cg-demo-api-key"
+	if u.Hostname() == coinGeckoProHost {
+		keyHeader = "x-cg-pro-api-key"
+	}
+
+	return &coinGeckoProvider{
+		endpoint:  endpoint,
+		apiKey:    apiKey,
+		keyHeader: keyHeader,
+		ids:       ids,
+		client:    &http.Client{Timeout: coinGeckoRequestTimeout},
+	}, nil
+}
+
+func (p *coinGeckoProvider) Name() string {
+	return SourceCoinGecko
+}
+
+func (p *coinGeckoProvider) FetchPrices(ctx context.Context, symbols []string) (map[string]*big.Float, error) {
+	prices := make(map[string]*big.Float, len(symbols))
+
+	var ids []string
+	for _, symbol := range symbols {
+		if id, ok := p.ids[symbol]; ok {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return prices, nil
+	}
+
+	query := url.Values{
+		"ids":           {strings.Join(ids, ",")},
+		"vs_currencies": {"usd"},
+		"precision":     {"full"},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create CoinGecko request")
+	}
+	req.Header.Set("Accept", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set(p.keyHeader, p.apiKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send CoinGecko request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyLength))
+		return nil, errors.Errorf("CoinGecko responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	// {"ethereum": {"usd": 3120.15}, ...}，价格按原始数字解析以免损失精度
+	var result map[string]map[string]json.Number
+	dec := json.NewDecoder(resp.Body)
+	dec.UseNumber()
+	if err := dec.Decode(&result); err != nil {
+		return nil, errors.Wrap(err, "failed to decode CoinGecko response")
+	}
+
+	for _, symbol := range symbols {
+		id, ok := p.ids[symbol]
+		if !ok {
+			continue
+		}
+		value, ok := result[id]["usd"]
+		if !ok {
+			continue
+		}
+		price, _, err := big.ParseFloat(value.String(), 10, bigFloatPrecision, big.ToNearestEven)
+		if err != nil || price.Sign() < 0 {
+			return nil, errors.Errorf("invalid CoinGecko price %q for %s", value, id)
+		}
+		prices[symbol] = price
+	}
+
+	return prices, nil
+}
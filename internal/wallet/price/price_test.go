@@ -0,0 +1,122 @@
+package price
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceValueUSD(t *testing.T) {
+	t.Parallel()
+
+	price := &Price{PriceUSD: "0.99987"}
+	assert.Equal(t, "1499.81", price.ValueUSD(big.NewFloat(1500)))
+	assert.Equal(t, "0.00", price.ValueUSD(new(big.Float)))
+
+	assert.Empty(t, (&Price{PriceUSD: "n/a"}).ValueUSD(big.NewFloat(1)))
+}
+
+func TestCoinGeckoFetchPrices(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v3/simple/price", r.URL.Path)
+		assert.Equal(t, "ethereum,tether", r.URL.Query().Get("ids"))
+		assert.Equal(t, "usd", r.URL.Query().Get("vs_currencies"))
+		assert.Equal(t, "secret", r.Header.Get("x-cg-demo-api-key"))
+		_, _ = w.Write([]byte(`{"ethereum":{"usd":3120.150000000000091},"tether":{"usd":0.99987}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	provider, err := newCoinGeckoProvider(server.URL+"/api/v3", "secret", map[string]string{
+		"ETH":  "ethereum",
+		"USDT": "tether",
+		"BNB":  "binancecoin",
+	})
+	require.NoError(t, err)
+
+	prices, err := provider.FetchPrices(context.Background(), []string{"ETH", "USDT", "CPOP"})
+	require.NoError(t, err)
+	require.Len(t, prices, 2)
+	assert.Equal(t, "3120.150000000000091", prices["ETH"].Text('f', -1))
+	assert.Equal(t, "0.99987", prices["USDT"].Text('f', -1))
+}
+
+func TestCoinGeckoFetchPricesError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"status":{"error_code":429}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	provider, err := newCoinGeckoProvider(server.URL, "", map[string]string{"ETH": "ethereum"})
+	require.NoError(t, err)
+
+	_, err = provider.FetchPrices(context.Background(), []string{"ETH"})
+	require.ErrorContains(t, err, "status 429")
+
+	// 没有映射的符号不发送请求
+	prices, err := provider.FetchPrices(context.Background(), []string{"CPOP"})
+	require.NoError(t, err)
+	assert.Empty(t, prices)
+}
+
+// testFeed 模拟 Chainlink 喂价合约
+type testFeed struct {
+	decimals  int64
+	answer    *big.Int
+	updatedAt time.Time
+}
+
+func (f *testFeed) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if string(msg.Data) == string(decimalsMethodID) {
+		return common.LeftPadBytes(big.NewInt(f.decimals).Bytes(), abiWordLength), nil
+	}
+
+	data := make([]byte, roundDataLength)
+	copy(data[abiWordLength:2*abiWordLength], common.LeftPadBytes(f.answer.Bytes(), abiWordLength))
+	copy(data[3*abiWordLength:4*abiWordLength], common.LeftPadBytes(big.NewInt(f.updatedAt.Unix()).Bytes(), abiWordLength))
+	return data, nil
+}
+
+func TestReadFeed(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	feed := common.HexToAddress("0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419")
+
+	price, err := readFeed(context.Background(), &testFeed{decimals: 8, answer: big.NewInt(312015000000), updatedAt: now.Add(-time.Hour)}, feed, now)
+	require.NoError(t, err)
+	assert.Equal(t, "3120.15", price.Text('f', -1))
+
+	_, err = readFeed(context.Background(), &testFeed{decimals: 8, answer: big.NewInt(312015000000), updatedAt: now.Add(-48 * time.Hour)}, feed, now)
+	require.ErrorContains(t, err, "stale")
+
+	_, err = readFeed(context.Background(), &testFeed{decimals: 8, answer: big.NewInt(0), updatedAt: now}, feed, now)
+	require.ErrorContains(t, err, "zero answer")
+}
+
+func TestParseRoundDataNegativeAnswer(t *testing.T) {
+	t.Parallel()
+
+	data := make([]byte, roundDataLength)
+	for i := abiWordLength; i < 2*abiWordLength; i++ {
+		data[i] = 0xff // -1
+	}
+
+	_, err := parseRoundData(data, 8, time.Now())
+	require.ErrorContains(t, err, "negative answer")
+
+	_, err = parseRoundData(data[:abiWordLength], 8, time.Now())
+	require.Error(t, err)
+}
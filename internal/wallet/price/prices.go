@@ -0,0 +1,154 @@
+package price
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// priceColumns token_prices（别名 p）和 tokens（别名 t）的查询列，与 scanPrice 的顺序一致
+const priceColumns = `p.token_id, t.token_symbol, t.chain_id, p.price_usd::text, p.source, p.updated_by, p.updated_at`
+
+// rowScanner 兼容 *sql.Row 和 *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// ListPrices 查询所有代币价格，按链和代币排序
+func (s *service) ListPrices(ctx context.Context) ([]*Price, error) {
+	return s.queryPrices(ctx, `
+		SELECT `+priceColumns+`
+		FROM token_prices p
+		JOIN tokens t ON t.id = p.token_id
+		ORDER BY t.chain_id, p.token_id
+	`)
+}
+
+// GetPrices 批量获取代币价格
+func (s *service) GetPrices(ctx context.Context, tokenIDs []int) (map[int]*Price, error) {
+	if len(tokenIDs) == 0 {
+		return map[int]*Price{}, nil
+	}
+
+	ids := make([]int64, 0, len(tokenIDs))
+	for _, tokenID := range tokenIDs {
+		ids = append(ids, int64(tokenID))
+	}
+
+	prices, err := s.queryPrices(ctx, `
+		SELECT `+priceColumns+`
+		FROM token_prices p
+		JOIN tokens t ON t.id = p.token_id
+		WHERE p.token_id = ANY($1)
+	`, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[int]*Price, len(prices))
+	for _, price := range prices {
+		res[price.TokenID] = price
+	}
+
+	return res, nil
+}
+
+// SetManualPrice 创建或覆盖代币的价格为手动价格
+func (s *service) SetManualPrice(ctx context.Context, tokenID int, priceUSD string, adminUserID string) (*Price, error) {
+	value, ok := new(big.Rat).SetString(priceUSD)
+	if !ok || value.Sign() < 0 {
+		return nil, errors.Wrap(ErrInvalidPrice, "price_usd must be a non-negative number")
+	}
+
+	saved, err := scanPrice(s.db.QueryRowContext(ctx, `
+		WITH p AS (
+			INSERT INTO token_prices (token_id, price_usd, source, updated_by)
+			SELECT id, $2, 'manual', $3 FROM tokens WHERE id = $1
+			ON CONFLICT (token_id) DO UPDATE SET price_usd = EXCLUDED.price_usd, source = EXCLUDED.source,
+				updated_by = EXCLUDED.updated_by, updated_at = NOW()
+			RETURNING *
+		)
+		SELECT `+priceColumns+`
+		FROM p
+		JOIN tokens t ON t.id = p.token_id
+	`, tokenID, priceUSD, adminUserID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTokenNotFound
+		}
+		return nil, errors.Wrap(err, "failed to save token price")
+	}
+
+	return saved, nil
+}
+
+// DeleteManualPrice 删除代币的手动价格，价格源更新的价格不能删除
+func (s *service) DeleteManualPrice(ctx context.Context, tokenID int) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM token_prices WHERE token_id = $1 AND source = 'manual'`, tokenID)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete token price")
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get affected rows")
+	}
+	if affected == 0 {
+		return ErrPriceNotFound
+	}
+
+	return nil
+}
+
+// queryPrices 执行价格查询
+func (s *service) queryPrices(ctx context.Context, query string, args ...any) ([]*Price, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query token prices")
+	}
+	defer rows.Close()
+
+	prices := make([]*Price, 0)
+	for rows.Next() {
+		price, err := scanPrice(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan token price")
+		}
+		prices = append(prices, price)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate token prices")
+	}
+
+	return prices, nil
+}
+
+// scanPrice 扫描一行代币价格
+func scanPrice(row rowScanner) (*Price, error) {
+	var (
+		price     Price
+		updatedBy sql.NullString
+	)
+
+	if err := row.Scan(
+		&price.TokenID,
+		&price.TokenSymbol,
+		&price.ChainID,
+		&price.PriceUSD,
+		&price.Source,
+		&updatedBy,
+		&price.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if updatedBy.Valid {
+		price.UpdatedBy = &updatedBy.String
+	}
+
+	return &price, nil
+}
@@ -0,0 +1,55 @@
+//nolint:ireturn
+package price
+
+import (
+	"context"
+	"math/big"
+
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/pkg/errors"
+)
+
+// Provider 价格源
+type Provider interface {
+	// Name 价格来源，写入 token_prices.source
+	Name() string
+
+	// FetchPrices 获取代币符号（大写）的美元价格，价格源不支持的符号不在结果中
+	FetchPrices(ctx context.Context, symbols []string) (map[string]*big.Float, error)
+}
+
+// Config 价格源配置
+type Config struct {
+	// Provider 价格源：coingecko、chainlink 或空（只使用手动价格）
+	Provider string
+
+	CoinGeckoURL    string
+	CoinGeckoAPIKey string
+	// CoinGeckoIDs 代币符号（大写） -> CoinGecko coin id
+	CoinGeckoIDs map[string]string
+
+	// ChainlinkChainID 读取 Chainlink 喂价合约的链
+	ChainlinkChainID int
+	// ChainlinkFeeds 代币符号（大写） -> ChainlinkChainID 上的 USD 喂价合约地址
+	ChainlinkFeeds map[string]string
+}
+
+// clientProvider 获取链的 RPC 客户端（scan.Service）
+type clientProvider interface {
+	GetClient(ctx context.Context, chainID int) (*scan.RPCClient, error)
+}
+
+// NewProvider 创建价格源，config.Provider 为空时返回 nil（只使用手动价格）
+func NewProvider(config Config, clients clientProvider) (Provider, error) {
+	switch config.Provider {
+	case "":
+		return nil, nil //nolint:nilnil // 未配置价格源
+	case SourceCoinGecko:
+		return newCoinGeckoProvider(config.CoinGeckoURL, config.CoinGeckoAPIKey, config.CoinGeckoIDs)
+	case SourceChainlink:
+		return newChainlinkProvider(config.ChainlinkChainID, config.ChainlinkFeeds, clients)
+	default:
+		return nil, errors.Errorf("unknown price provider %q", config.Provider)
+	}
+}
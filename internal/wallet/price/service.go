@@ -0,0 +1,231 @@
+//nolint:ireturn // 返回接口类型是预期的设计
+package price
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// 价格来源（与 token_prices.source 一致）
+const (
+	SourceCoinGecko = "coingecko"
+	SourceChainlink = "chainlink"
+	SourceManual    = "manual" // 管理员手动设置，不会被价格源覆盖
+)
+
+const (
+	// usdValueDecimals 美元价值保留的小数位数
+	usdValueDecimals = 2
+	// bigFloatPrecision 价格计算使用的 big.Float 精度位数
+	bigFloatPrecision = 256
+)
+
+var (
+	// ErrInvalidPrice 价格参数不合法
+	ErrInvalidPrice = errors.New("invalid token price")
+	// ErrTokenNotFound 代币不存在
+	ErrTokenNotFound = walleterrors.ErrTokenNotFound
+	// ErrPriceNotFound 代币没有手动价格
+	ErrPriceNotFound = errors.New("token price not found")
+)
+
+// Service 代币价格服务接口
+// 定时从价格源更新启用代币的美元价格，价格源不支持的代币由管理员手动设置价格
+type Service interface {
+	// StartUpdater 启动价格更新 worker，未配置价格源时不启动
+	StartUpdater(ctx context.Context, interval time.Duration)
+
+	// UpdatePrices 从价格源更新启用代币的价格（跳过手动价格），返回更新的代币数
+	UpdatePrices(ctx context.Context) (int64, error)
+
+	// ListPrices 查询所有代币价格
+	ListPrices(ctx context.Context) ([]*Price, error)
+
+	// GetPrices 批量获取代币价格，没有价格的代币不在结果中
+	GetPrices(ctx context.Context, tokenIDs []int) (map[int]*Price, error)
+
+	// SetManualPrice 设置代币的手动价格（人类可读单位的美元价格）
+	SetManualPrice(ctx context.Context, tokenID int, priceUSD string, adminUserID string) (*Price, error)
+
+	// DeleteManualPrice 删除代币的手动价格，之后由价格源更新
+	DeleteManualPrice(ctx context.Context, tokenID int) error
+}
+
+// Price 代币美元价格
+type Price struct {
+	TokenID     int
+	TokenSymbol string
+	ChainID     int
+	PriceUSD    string // 1 个代币（人类可读单位）的美元价格
+	Source      string
+	UpdatedBy   *string // 设置手动价格的管理员
+	UpdatedAt   time.Time
+}
+
+// Value 计算 amount 个代币的美元价值，价格不合法时返回 nil
+func (p *Price) Value(amount *big.Float) *big.Float {
+	price, ok := new(big.Float).SetPrec(bigFloatPrecision).SetString(p.PriceUSD)
+	if !ok {
+		return nil
+	}
+	return new(big.Float).SetPrec(bigFloatPrecision).Mul(amount, price)
+}
+
+// ValueUSD 计算 amount 个代币的美元价值，保留两位小数
+func (p *Price) ValueUSD(amount *big.Float) string {
+	value := p.Value(amount)
+	if value == nil {
+		return ""
+	}
+	return FormatUSD(value)
+}
+
+// FormatUSD 格式化美元价值，保留两位小数
+func FormatUSD(value *big.Float) string {
+	return value.Text('f', usdValueDecimals)
+}
+
+type service struct {
+	db       *sql.DB
+	provider Provider // 为 nil 时只使用手动价格
+}
+
+// NewService 创建价格服务，provider 为 nil 时不从价格源更新
+func NewService(db *sql.DB, provider Provider) Service {
+	return &service{
+		db:       db,
+		provider: provider,
+	}
+}
+
+// StartUpdater 启动价格更新 worker
+func (s *service) StartUpdater(ctx context.Context, interval time.Duration) {
+	if s.provider == nil {
+		log.Info().Msg("No token price provider configured, only manual token prices are used")
+		return
+	}
+
+	lifecycle.Go(ctx, "token price updater", func() {
+		log.Info().
+			Str("provider", s.provider.Name()).
+			Dur("interval", interval).
+			Msg("Starting token price updater")
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := s.UpdatePrices(ctx); err != nil {
+				log.Error().Err(err).Str("provider", s.provider.Name()).Msg("Failed to update token prices")
+			}
+
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Token price updater stopped")
+				return
+			case <-ticker.C:
+			}
+		}
+	})
+}
+
+// UpdatePrices 按代币符号从价格源获取价格，写入所有同符号的启用代币（如各链上的 USDT）
+func (s *service) UpdatePrices(ctx context.Context) (int64, error) {
+	if s.provider == nil {
+		return 0, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `SELECT id, UPPER(token_symbol) FROM tokens WHERE is_active ORDER BY id`)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to query active tokens")
+	}
+	defer rows.Close()
+
+	tokenSymbols := make(map[int]string)
+	var symbols []string
+	for rows.Next() {
+		var (
+			tokenID int
+			symbol  string
+		)
+		if err := rows.Scan(&tokenID, &symbol); err != nil {
+			return 0, errors.Wrap(err, "failed to scan token")
+		}
+		tokenSymbols[tokenID] = symbol
+		if !slices.Contains(symbols, symbol) {
+			symbols = append(symbols, symbol)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, errors.Wrap(err, "failed to iterate tokens")
+	}
+	if len(symbols) == 0 {
+		return 0, nil
+	}
+
+	prices, err := s.provider.FetchPrices(ctx, symbols)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to fetch prices from %s", s.provider.Name())
+	}
+
+	tokenIDs := make([]int64, 0, len(tokenSymbols))
+	values := make([]string, 0, len(tokenSymbols))
+	for tokenID, symbol := range tokenSymbols {
+		if price, ok := prices[symbol]; ok {
+			tokenIDs = append(tokenIDs, int64(tokenID))
+			values = append(values, price.Text('f', -1))
+		}
+	}
+
+	var unpriced []string
+	for _, symbol := range symbols {
+		if _, ok := prices[symbol]; !ok {
+			unpriced = append(unpriced, symbol)
+		}
+	}
+	if len(unpriced) > 0 {
+		log.Debug().
+			Str("provider", s.provider.Name()).
+			Str("symbols", strings.Join(unpriced, ",")).
+			Msg("No price for tokens from price provider, set a manual price if needed")
+	}
+
+	if len(tokenIDs) == 0 {
+		return 0, nil
+	}
+
+	res, err := s.db.ExecContext(ctx, `
+		INSERT INTO token_prices (token_id, price_usd, source)
+		SELECT u.token_id, u.price_usd, $3
+		FROM unnest($1::int[], $2::numeric[]) AS u(token_id, price_usd)
+		ON CONFLICT (token_id) DO UPDATE SET price_usd = EXCLUDED.price_usd, source = EXCLUDED.source,
+			updated_by = NULL, updated_at = NOW()
+		WHERE token_prices.source <> 'manual'
+	`, pq.Array(tokenIDs), pq.Array(values), s.provider.Name())
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to save token prices")
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get affected rows")
+	}
+
+	log.Debug().
+		Str("provider", s.provider.Name()).
+		Int64("updated", updated).
+		Msg("Token prices updated")
+
+	return updated, nil
+}
@@ -0,0 +1,453 @@
+package quarantine
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/alert"
+	"github/chapool/go-wallet/internal/wallet/export"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// exportBatchSize 导出时每批读取的案件数
+	exportBatchSize = 1000
+	// maxNoteLength 处理备注最大长度
+	maxNoteLength = 1000
+)
+
+// caseColumns 隔离案件查询列，与 scanCase 的顺序一致
+const caseColumns = `q.id, q.transaction_id, q.credit_id, q.user_id, q.chain_id, q.token_id, t.token_symbol,
+	q.from_address, q.to_address, q.amount, q.tx_hash, q.screening_source, q.screening_reason, q.status,
+	q.resolution_note, q.resolved_by, q.return_tx_hash, q.escalated_at, q.resolved_at, q.created_at, q.updated_at`
+
+// caseFrom 隔离案件查询的 FROM 子句
+const caseFrom = `FROM deposit_quarantines q JOIN tokens t ON t.id = q.token_id`
+
+// 导出文件的表头
+//
+//nolint:gochecknoglobals // 固定表头
+var caseHeader = []string{"id", "transaction_id", "user_id", "chain_id", "token_id", "token_symbol", "from_address", "to_address", "amount", "tx_hash", "screening_source", "screening_reason", "status", "resolution_note", "resolved_by", "return_tx_hash", "escalated_at", "resolved_at", "created_at"}
+
+// transitions 隔离案件状态机：当前状态 -> 处理动作 -> 新状态
+// 释放和退回是终态，升级后仍可释放或退回
+//
+//nolint:gochecknoglobals // 固定状态机
+var transitions = map[string]map[string]string{
+	StatusQuarantined: {
+		ActionRelease:  StatusReleased,
+		ActionReturn:   StatusReturned,
+		ActionEscalate: StatusEscalated,
+	},
+	StatusEscalated: {
+		ActionRelease: StatusReleased,
+		ActionReturn:  StatusReturned,
+	},
+}
+
+// rowScanner 兼容 *sql.Row 和 *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// nextStatus 返回处理动作后的案件状态
+func nextStatus(status string, action string) (string, error) {
+	next, ok := transitions[status][action]
+	if !ok {
+		return "", errors.Wrapf(ErrInvalidTransition, "cannot %s a %s case", action, status)
+	}
+
+	return next, nil
+}
+
+// ListCases 查询隔离案件
+func (s *service) ListCases(ctx context.Context, filter *Filter, limit int, offset int) ([]*Case, error) {
+	where, args := filterClause(filter)
+	args = append(args, limit, offset)
+
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT %s %s %s
+		ORDER BY q.created_at DESC, q.id DESC
+		LIMIT $%d OFFSET $%d
+	`, caseColumns, caseFrom, where, len(args)-1, len(args)), args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query quarantine cases")
+	}
+	defer rows.Close()
+
+	return scanCases(rows)
+}
+
+// GetCase 获取隔离案件
+func (s *service) GetCase(ctx context.Context, caseID string) (*Case, error) {
+	quarantineCase, err := scanCase(s.db.QueryRowContext(ctx, `
+		SELECT `+caseColumns+` `+caseFrom+` WHERE q.id = $1
+	`, caseID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCaseNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get quarantine case")
+	}
+
+	return quarantineCase, nil
+}
+
+// Resolve 处理隔离案件
+// 释放：冻结的充值 Credits 改为 finalized 并计入用户统计；
+// 退回：资金由合规人员从平台地址退回来源地址，记录退回交易哈希，Credits 标记为 failed 不再入账；
+// 升级：只改变案件状态，资金保持冻结
+func (s *service) Resolve(ctx context.Context, req *ResolveRequest) (*Case, error) {
+	if req.Note != nil && len(*req.Note) > maxNoteLength {
+		return nil, errors.Wrapf(ErrInvalidRequest, "note must be at most %d characters", maxNoteLength)
+	}
+	returnTxHash := ""
+	if req.ReturnTxHash != nil {
+		returnTxHash = strings.TrimSpace(*req.ReturnTxHash)
+	}
+	if req.Action == ActionReturn && returnTxHash == "" {
+		return nil, errors.Wrap(ErrInvalidRequest, "return_tx_hash is required to return a deposit")
+	}
+	if req.Action != ActionReturn && returnTxHash != "" {
+		return nil, errors.Wrap(ErrInvalidRequest, "return_tx_hash is only allowed when returning a deposit")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// 锁定案件，避免多个管理员同时处理
+	quarantineCase, err := scanCase(tx.QueryRowContext(ctx, `
+		SELECT `+caseColumns+` `+caseFrom+` WHERE q.id = $1 FOR UPDATE OF q
+	`, req.CaseID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCaseNotFound
+		}
+		return nil, errors.Wrap(err, "failed to lock quarantine case")
+	}
+
+	status, err := nextStatus(quarantineCase.Status, req.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	switch req.Action {
+	case ActionRelease:
+		if err := s.updateCredit(ctx, tx, quarantineCase.CreditID, models.CreditStatusFinalized); err != nil {
+			return nil, err
+		}
+		token, err := models.FindToken(ctx, tx, quarantineCase.TokenID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get quarantined token")
+		}
+		if err := s.statsService.RecordDeposit(ctx, tx, quarantineCase.UserID, quarantineCase.TokenID, quarantineCase.Amount, token.Decimals, now); err != nil {
+			return nil, errors.Wrap(err, "failed to record deposit stats")
+		}
+	case ActionReturn:
+		if err := s.updateCredit(ctx, tx, quarantineCase.CreditID, models.CreditStatusFailed); err != nil {
+			return nil, err
+		}
+	}
+
+	var returnTxHashArg *string
+	if returnTxHash != "" {
+		returnTxHashArg = &returnTxHash
+	}
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE deposit_quarantines SET
+			status = $2,
+			resolution_note = COALESCE($3, resolution_note),
+			resolved_by = $4,
+			return_tx_hash = COALESCE($5, return_tx_hash),
+			escalated_at = CASE WHEN $2 = 'escalated' THEN $6 ELSE escalated_at END,
+			resolved_at = CASE WHEN $2 IN ('released', 'returned') THEN $6 ELSE resolved_at END,
+			updated_at = $6
+		WHERE id = $1
+	`, quarantineCase.ID, status, req.Note, req.AdminUserID, returnTxHashArg, now); err != nil {
+		return nil, errors.Wrap(err, "failed to update quarantine case")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "failed to commit transaction")
+	}
+
+	log.Info().
+		Str("case_id", quarantineCase.ID).
+		Str("admin_user_id", req.AdminUserID).
+		Str("action", req.Action).
+		Str("from_status", quarantineCase.Status).
+		Str("to_status", status).
+		Msg("Quarantine case resolved")
+
+	resolved, err := s.GetCase(ctx, quarantineCase.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == StatusEscalated {
+		s.notify(ctx, alert.TypeQuarantineEscalated, alert.SeverityCritical, "Quarantined deposit was escalated", resolved)
+	} else {
+		s.notify(ctx, alert.TypeQuarantineResolved, alert.SeverityInfo, "Quarantined deposit was "+status, resolved)
+	}
+
+	return resolved, nil
+}
+
+// updateCredit 更新隔离充值的 Credits 状态，只允许从冻结状态变更
+func (s *service) updateCredit(ctx context.Context, tx *sql.Tx, creditID string, status models.CreditStatus) error {
+	res, err := tx.ExecContext(ctx, `
+		UPDATE credits SET status = $2, updated_at = NOW() WHERE id = $1 AND status = $3
+	`, creditID, status, models.CreditStatusFrozen)
+	if err != nil {
+		return errors.Wrap(err, "failed to update quarantined credit")
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get affected rows")
+	}
+	if affected == 0 {
+		return errors.Errorf("quarantined credit %s is no longer frozen", creditID)
+	}
+
+	return nil
+}
+
+// ExportCases 按创建时间倒序分批导出隔离案件
+func (s *service) ExportCases(ctx context.Context, filter *Filter, w export.Writer) error {
+	if err := w.WriteRow(caseHeader); err != nil {
+		return errors.Wrap(err, "failed to write header")
+	}
+
+	where, args := filterClause(filter)
+	var last *Case
+	for {
+		batchWhere, batchArgs := where, append([]any{}, args...)
+		if last != nil {
+			batchArgs = append(batchArgs, last.CreatedAt, last.ID)
+			batchWhere = appendCondition(batchWhere, fmt.Sprintf("(q.created_at, q.id) < ($%d, $%d)", len(batchArgs)-1, len(batchArgs)))
+		}
+		batchArgs = append(batchArgs, exportBatchSize)
+
+		rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+			SELECT %s %s %s
+			ORDER BY q.created_at DESC, q.id DESC
+			LIMIT $%d
+		`, caseColumns, caseFrom, batchWhere, len(batchArgs)), batchArgs...)
+		if err != nil {
+			return errors.Wrap(err, "failed to query quarantine cases")
+		}
+		cases, err := scanCases(rows)
+		rows.Close()
+		if err != nil {
+			return err
+		}
+
+		for _, quarantineCase := range cases {
+			if err := w.WriteRow(caseRow(quarantineCase)); err != nil {
+				return errors.Wrap(err, "failed to write row")
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return errors.Wrap(err, "failed to flush rows")
+		}
+
+		if len(cases) < exportBatchSize {
+			return nil
+		}
+		last = cases[len(cases)-1]
+	}
+}
+
+// notify 通知合规团队，通知失败只记录日志
+func (s *service) notify(ctx context.Context, alertType string, severity string, message string, quarantineCase *Case) {
+	fields := map[string]any{
+		"case_id":          quarantineCase.ID,
+		"status":           quarantineCase.Status,
+		"user_id":          quarantineCase.UserID,
+		"token_symbol":     quarantineCase.TokenSymbol,
+		"amount":           quarantineCase.Amount,
+		"from_address":     quarantineCase.FromAddress,
+		"to_address":       quarantineCase.ToAddress,
+		"tx_hash":          quarantineCase.TxHash,
+		"screening_source": quarantineCase.ScreeningSource,
+	}
+	if quarantineCase.ResolvedBy != nil {
+		fields["resolved_by"] = *quarantineCase.ResolvedBy
+	}
+	if quarantineCase.ReturnTxHash != nil {
+		fields["return_tx_hash"] = *quarantineCase.ReturnTxHash
+	}
+
+	//nolint:errcheck // multiNotifier 已记录发送失败
+	_ = s.notifier.Notify(ctx, &alert.Alert{
+		Type:     alertType,
+		Severity: severity,
+		ChainID:  quarantineCase.ChainID,
+		Message:  message,
+		Fields:   fields,
+	})
+}
+
+// filterClause 将查询条件转换为 WHERE 子句和参数
+func filterClause(filter *Filter) (string, []any) {
+	var (
+		where string
+		args  []any
+	)
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		where = appendCondition(where, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.Status != nil {
+		add("q.status = $%d", *filter.Status)
+	}
+	if filter.UserID != nil {
+		add("q.user_id = $%d", *filter.UserID)
+	}
+	if filter.ChainID != nil {
+		add("q.chain_id = $%d", *filter.ChainID)
+	}
+	if filter.CreatedAfter != nil {
+		add("q.created_at >= $%d", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		add("q.created_at < $%d", *filter.CreatedBefore)
+	}
+
+	return where, args
+}
+
+func appendCondition(where string, condition string) string {
+	if where == "" {
+		return "WHERE " + condition
+	}
+
+	return where + " AND " + condition
+}
+
+func scanCases(rows *sql.Rows) ([]*Case, error) {
+	cases := make([]*Case, 0)
+	for rows.Next() {
+		quarantineCase, err := scanCase(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan quarantine case")
+		}
+		cases = append(cases, quarantineCase)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate quarantine cases")
+	}
+
+	return cases, nil
+}
+
+// scanCase 扫描一行隔离案件
+func scanCase(row rowScanner) (*Case, error) {
+	var (
+		quarantineCase  Case
+		screeningReason sql.NullString
+		resolutionNote  sql.NullString
+		resolvedBy      sql.NullString
+		returnTxHash    sql.NullString
+		escalatedAt     sql.NullTime
+		resolvedAt      sql.NullTime
+	)
+
+	if err := row.Scan(
+		&quarantineCase.ID,
+		&quarantineCase.TransactionID,
+		&quarantineCase.CreditID,
+		&quarantineCase.UserID,
+		&quarantineCase.ChainID,
+		&quarantineCase.TokenID,
+		&quarantineCase.TokenSymbol,
+		&quarantineCase.FromAddress,
+		&quarantineCase.ToAddress,
+		&quarantineCase.Amount,
+		&quarantineCase.TxHash,
+		&quarantineCase.ScreeningSource,
+		&screeningReason,
+		&quarantineCase.Status,
+		&resolutionNote,
+		&resolvedBy,
+		&returnTxHash,
+		&escalatedAt,
+		&resolvedAt,
+		&quarantineCase.CreatedAt,
+		&quarantineCase.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if screeningReason.Valid {
+		quarantineCase.ScreeningReason = &screeningReason.String
+	}
+	if resolutionNote.Valid {
+		quarantineCase.ResolutionNote = &resolutionNote.String
+	}
+	if resolvedBy.Valid {
+		quarantineCase.ResolvedBy = &resolvedBy.String
+	}
+	if returnTxHash.Valid {
+		quarantineCase.ReturnTxHash = &returnTxHash.String
+	}
+	if escalatedAt.Valid {
+		quarantineCase.EscalatedAt = &escalatedAt.Time
+	}
+	if resolvedAt.Valid {
+		quarantineCase.ResolvedAt = &resolvedAt.Time
+	}
+
+	return &quarantineCase, nil
+}
+
+func caseRow(quarantineCase *Case) []string {
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+	stringValue := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+
+	return []string{
+		quarantineCase.ID,
+		quarantineCase.TransactionID,
+		quarantineCase.UserID,
+		strconv.Itoa(quarantineCase.ChainID),
+		strconv.Itoa(quarantineCase.TokenID),
+		quarantineCase.TokenSymbol,
+		quarantineCase.FromAddress,
+		quarantineCase.ToAddress,
+		quarantineCase.Amount,
+		quarantineCase.TxHash,
+		quarantineCase.ScreeningSource,
+		stringValue(quarantineCase.ScreeningReason),
+		quarantineCase.Status,
+		stringValue(quarantineCase.ResolutionNote),
+		stringValue(quarantineCase.ResolvedBy),
+		stringValue(quarantineCase.ReturnTxHash),
+		formatTime(quarantineCase.EscalatedAt),
+		formatTime(quarantineCase.ResolvedAt),
+		quarantineCase.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,58 @@
+package quarantine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextStatus(t *testing.T) {
+	tests := []struct {
+		status string
+		action string
+		want   string
+	}{
+		{StatusQuarantined, ActionRelease, StatusReleased},
+		{StatusQuarantined, ActionReturn, StatusReturned},
+		{StatusQuarantined, ActionEscalate, StatusEscalated},
+		{StatusEscalated, ActionRelease, StatusReleased},
+		{StatusEscalated, ActionReturn, StatusReturned},
+	}
+	for _, tt := range tests {
+		got, err := nextStatus(tt.status, tt.action)
+		require.NoError(t, err, "%s -> %s", tt.status, tt.action)
+		assert.Equal(t, tt.want, got)
+	}
+}
+
+func TestNextStatusInvalid(t *testing.T) {
+	tests := []struct {
+		status string
+		action string
+	}{
+		{StatusEscalated, ActionEscalate},
+		{StatusReleased, ActionRelease},
+		{StatusReleased, ActionReturn},
+		{StatusReturned, ActionRelease},
+		{StatusReturned, ActionEscalate},
+		{StatusQuarantined, "approve"},
+	}
+	for _, tt := range tests {
+		_, err := nextStatus(tt.status, tt.action)
+		require.ErrorIs(t, err, ErrInvalidTransition, "%s -> %s", tt.status, tt.action)
+	}
+}
+
+func TestFilterClause(t *testing.T) {
+	status := StatusEscalated
+	chainID := 56
+
+	where, args := filterClause(&Filter{Status: &status, ChainID: &chainID})
+	assert.Equal(t, "WHERE q.status = $1 AND q.chain_id = $2", where)
+	assert.Equal(t, []any{StatusEscalated, 56}, args)
+
+	where, args = filterClause(&Filter{})
+	assert.Empty(t, where)
+	assert.Empty(t, args)
+}
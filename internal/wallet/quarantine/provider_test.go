@@ -0,0 +1,55 @@
+package quarantine
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanctionsAPIScreenAddress(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.Header.Get("X-API-Key"))
+		switch r.URL.Path {
+		case "/api/v1/address/0x8589427373d6d84e98730d7795d8f6f8731fda16":
+			_, _ = w.Write([]byte(`{"identifications":[{"category":"sanctions","name":"SANCTIONS: OFAC SDN Tornado Cash"},{"category":"sanctions"}]}`))
+		case "/api/v1/address/0x0000000000000000000000000000000000000001":
+			_, _ = w.Write([]byte(`{"identifications":[]}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`upstream unavailable`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	provider, err := NewSanctionsAPIProvider(server.URL+"/api/v1/", "secret", time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, SourceSanctionsAPI, provider.Name())
+
+	match, err := provider.ScreenAddress(context.Background(), "evm", "0x8589427373d6d84e98730d7795d8f6f8731fda16")
+	require.NoError(t, err)
+	require.NotNil(t, match)
+	assert.Equal(t, "SANCTIONS: OFAC SDN Tornado Cash; sanctions", match.Reason)
+
+	match, err = provider.ScreenAddress(context.Background(), "evm", "0x0000000000000000000000000000000000000001")
+	require.NoError(t, err)
+	assert.Nil(t, match)
+
+	_, err = provider.ScreenAddress(context.Background(), "evm", "0x0000000000000000000000000000000000000002")
+	require.ErrorContains(t, err, "status 500: upstream unavailable")
+}
+
+func TestNewSanctionsAPIProviderInvalidURL(t *testing.T) {
+	t.Parallel()
+
+	for _, baseURL := range []string{"", "ftp://example.com", "https://"} {
+		_, err := NewSanctionsAPIProvider(baseURL, "", time.Second)
+		assert.Error(t, err, baseURL)
+	}
+}
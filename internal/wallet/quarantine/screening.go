@@ -0,0 +1,355 @@
+package quarantine
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/alert"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/deposit"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+	"github/chapool/go-wallet/internal/wallet/withdraw"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// maxSourceLength 名单来源最大长度
+	maxSourceLength = 50
+	// pgUniqueViolation PostgreSQL 唯一约束冲突错误码
+	pgUniqueViolation = "23505"
+)
+
+// screeningAddressColumns screening_addresses 查询列，与 scanScreeningAddress 的顺序一致
+const screeningAddressColumns = `id, chain_type, address, source, reason, created_by, created_at`
+
+// screeningTokenColumns screening_tokens（st）关联 tokens（t）的查询列，与 scanScreeningToken 的顺序一致
+const screeningTokenColumns = `st.id, st.token_id, t.chain_id, t.token_symbol, st.source, st.reason, st.created_by, st.created_at`
+
+// screeningMatch 筛查命中结果
+type screeningMatch struct {
+	matchType string // MatchTypeAddress 或 MatchTypeToken
+	source    string
+	reason    string
+}
+
+// Screen 查询充值来源地址或代币是否在筛查名单中
+func (s *service) Screen(ctx context.Context, chainType string, address string, tokenID int) (*deposit.ScreeningResult, error) {
+	match, err := s.screen(ctx, chainType, address, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	if match == nil {
+		return &deposit.ScreeningResult{Flagged: false}, nil
+	}
+
+	return &deposit.ScreeningResult{Flagged: true, Source: match.source, Reason: match.reason}, nil
+}
+
+// screen 依次查询代币名单、地址名单和外部筛查服务，未命中时返回 nil
+// 外部筛查服务不可用时返回错误（充值稍后重试，提现请求被拒绝），不放行未经筛查的资金
+func (s *service) screen(ctx context.Context, chainType string, address string, tokenID int) (*screeningMatch, error) {
+	var (
+		source string
+		reason sql.NullString
+	)
+	err := s.db.QueryRowContext(ctx, `
+		SELECT source, reason FROM screening_tokens WHERE token_id = $1
+	`, tokenID).Scan(&source, &reason)
+	if err == nil {
+		return &screeningMatch{matchType: MatchTypeToken, source: source, reason: reason.String}, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to query screening tokens")
+	}
+
+	err = s.db.QueryRowContext(ctx, `
+		SELECT source, reason FROM screening_addresses WHERE chain_type = $1 AND address = $2
+	`, chainType, chain.NormalizeAddress(chainType, address)).Scan(&source, &reason)
+	if err == nil {
+		return &screeningMatch{matchType: MatchTypeAddress, source: source, reason: reason.String}, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to query screening addresses")
+	}
+
+	if s.provider == nil {
+		return nil, nil //nolint:nilnil // 未命中
+	}
+
+	providerMatch, err := s.provider.ScreenAddress(ctx, chainType, address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to screen address with %s", s.provider.Name())
+	}
+	if providerMatch == nil {
+		return nil, nil //nolint:nilnil // 未命中
+	}
+
+	return &screeningMatch{matchType: MatchTypeAddress, source: s.provider.Name(), reason: providerMatch.Reason}, nil
+}
+
+// ScreenWithdraw 筛查提现目标地址和代币，命中时记录拦截审计、通知合规团队并返回 walleterrors.ErrWithdrawBlocked
+// 拦截记录写入失败时同样拒绝提现
+func (s *service) ScreenWithdraw(ctx context.Context, req *withdraw.ScreeningRequest) error {
+	match, err := s.screen(ctx, req.ChainType, req.ToAddress, req.TokenID)
+	if err != nil {
+		return err
+	}
+	if match == nil {
+		return nil
+	}
+
+	var reason *string
+	if match.reason != "" {
+		reason = &match.reason
+	}
+
+	var blockID string
+	if err := s.db.QueryRowContext(ctx, `
+		INSERT INTO withdraw_screening_blocks (user_id, chain_id, token_id, to_address, amount, match_type, screening_source, screening_reason)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`,
+		req.UserID, req.ChainID, req.TokenID, req.ToAddress, req.Amount,
+		match.matchType, match.source, reason,
+	).Scan(&blockID); err != nil {
+		return errors.Wrap(err, "failed to insert withdraw screening block")
+	}
+
+	log.Warn().
+		Str("block_id", blockID).
+		Str("user_id", req.UserID).
+		Int("chain_id", req.ChainID).
+		Int("token_id", req.TokenID).
+		Str("to_address", req.ToAddress).
+		Str("match_type", match.matchType).
+		Str("screening_source", match.source).
+		Msg("Withdraw blocked by compliance screening")
+
+	//nolint:errcheck // multiNotifier 已记录发送失败
+	_ = s.notifier.Notify(ctx, &alert.Alert{
+		Type:     alert.TypeWithdrawBlocked,
+		Severity: alert.SeverityCritical,
+		ChainID:  req.ChainID,
+		Message:  "Withdraw to a flagged address or of a flagged token was blocked",
+		Fields: map[string]any{
+			"block_id":         blockID,
+			"user_id":          req.UserID,
+			"token_id":         req.TokenID,
+			"amount":           req.Amount,
+			"to_address":       req.ToAddress,
+			"match_type":       match.matchType,
+			"screening_source": match.source,
+		},
+	})
+
+	// 不向用户透露命中的名单
+	return walleterrors.ErrWithdrawBlocked
+}
+
+// Quarantine 在充值入账事务中创建隔离案件
+func (s *service) Quarantine(ctx context.Context, exec boil.ContextExecutor, credit *models.Credit, transaction *models.Transaction, result *deposit.ScreeningResult) (string, error) {
+	var reason *string
+	if result.Reason != "" {
+		reason = &result.Reason
+	}
+
+	var caseID string
+	if err := exec.QueryRowContext(ctx, `
+		INSERT INTO deposit_quarantines (transaction_id, credit_id, user_id, chain_id, token_id, from_address, to_address, amount, tx_hash, screening_source, screening_reason)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id
+	`,
+		transaction.ID, credit.ID, credit.UserID, transaction.ChainID, credit.TokenID,
+		transaction.FromAddr, transaction.ToAddr, transaction.Amount, transaction.TXHash,
+		result.Source, reason,
+	).Scan(&caseID); err != nil {
+		return "", errors.Wrap(err, "failed to insert quarantine case")
+	}
+
+	return caseID, nil
+}
+
+// Quarantined 通知合规团队新的隔离案件，通知失败只记录日志
+func (s *service) Quarantined(ctx context.Context, caseID string) {
+	quarantineCase, err := s.GetCase(ctx, caseID)
+	if err != nil {
+		log.Error().Err(err).Str("case_id", caseID).Msg("Failed to load quarantine case for notification")
+		return
+	}
+
+	s.notify(ctx, alert.TypeDepositQuarantined, alert.SeverityCritical,
+		"Deposit from a flagged source address was quarantined and not credited", quarantineCase)
+}
+
+// AddScreeningAddress 将地址加入筛查名单
+func (s *service) AddScreeningAddress(ctx context.Context, req *ScreeningAddress) (*ScreeningAddress, error) {
+	switch req.ChainType {
+	case chain.TypeEVM, chain.TypeSolana:
+	default:
+		return nil, errors.Wrapf(ErrInvalidRequest, "unsupported chain type %q", req.ChainType)
+	}
+
+	address := chain.NormalizeAddress(req.ChainType, strings.TrimSpace(req.Address))
+	if address == "" {
+		return nil, errors.Wrap(ErrInvalidRequest, "address is required")
+	}
+	source := strings.TrimSpace(req.Source)
+	if source == "" || len(source) > maxSourceLength {
+		return nil, errors.Wrapf(ErrInvalidRequest, "source must be between 1 and %d characters", maxSourceLength)
+	}
+
+	created, err := scanScreeningAddress(s.db.QueryRowContext(ctx, `
+		INSERT INTO screening_addresses (chain_type, address, source, reason, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING `+screeningAddressColumns,
+		req.ChainType, address, source, req.Reason, req.CreatedBy,
+	))
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == pgUniqueViolation {
+			return nil, ErrScreeningAddressExists
+		}
+		return nil, errors.Wrap(err, "failed to insert screening address")
+	}
+
+	return created, nil
+}
+
+// AddScreeningToken 将代币加入筛查名单
+func (s *service) AddScreeningToken(ctx context.Context, req *ScreeningToken) (*ScreeningToken, error) {
+	source := strings.TrimSpace(req.Source)
+	if source == "" || len(source) > maxSourceLength {
+		return nil, errors.Wrapf(ErrInvalidRequest, "source must be between 1 and %d characters", maxSourceLength)
+	}
+
+	var exists bool
+	if err := s.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM tokens WHERE id = $1)`, req.TokenID).Scan(&exists); err != nil {
+		return nil, errors.Wrap(err, "failed to query token")
+	}
+	if !exists {
+		return nil, walleterrors.ErrTokenNotFound
+	}
+
+	created, err := scanScreeningToken(s.db.QueryRowContext(ctx, `
+		WITH inserted AS (
+			INSERT INTO screening_tokens (token_id, source, reason, created_by)
+			VALUES ($1, $2, $3, $4)
+			RETURNING *
+		)
+		SELECT `+screeningTokenColumns+`
+		FROM inserted st
+		JOIN tokens t ON t.id = st.token_id
+	`, req.TokenID, source, req.Reason, req.CreatedBy))
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == pgUniqueViolation {
+			return nil, ErrScreeningTokenExists
+		}
+		return nil, errors.Wrap(err, "failed to insert screening token")
+	}
+
+	return created, nil
+}
+
+// DeleteScreeningToken 将代币移出筛查名单
+func (s *service) DeleteScreeningToken(ctx context.Context, id string) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM screening_tokens WHERE id = $1`, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete screening token")
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get affected rows")
+	}
+	if affected == 0 {
+		return ErrScreeningTokenNotFound
+	}
+
+	return nil
+}
+
+// DeleteScreeningAddress 将地址移出筛查名单
+func (s *service) DeleteScreeningAddress(ctx context.Context, id string) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM screening_addresses WHERE id = $1`, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete screening address")
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get affected rows")
+	}
+	if affected == 0 {
+		return ErrScreeningAddressNotFound
+	}
+
+	return nil
+}
+
+// scanScreeningAddress 扫描一行筛查名单地址
+func scanScreeningAddress(row rowScanner) (*ScreeningAddress, error) {
+	var (
+		address   ScreeningAddress
+		reason    sql.NullString
+		createdBy sql.NullString
+	)
+
+	if err := row.Scan(
+		&address.ID,
+		&address.ChainType,
+		&address.Address,
+		&address.Source,
+		&reason,
+		&createdBy,
+		&address.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if reason.Valid {
+		address.Reason = &reason.String
+	}
+	if createdBy.Valid {
+		address.CreatedBy = &createdBy.String
+	}
+
+	return &address, nil
+}
+
+// scanScreeningToken 扫描一行筛查名单代币
+func scanScreeningToken(row rowScanner) (*ScreeningToken, error) {
+	var (
+		token     ScreeningToken
+		reason    sql.NullString
+		createdBy sql.NullString
+	)
+
+	if err := row.Scan(
+		&token.ID,
+		&token.TokenID,
+		&token.ChainID,
+		&token.TokenSymbol,
+		&token.Source,
+		&reason,
+		&createdBy,
+		&token.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if reason.Valid {
+		token.Reason = &reason.String
+	}
+	if createdBy.Valid {
+		token.CreatedBy = &createdBy.String
+	}
+
+	return &token, nil
+}
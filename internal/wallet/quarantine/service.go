@@ -0,0 +1,176 @@
+//nolint:ireturn // 返回接口类型是预期的设计
+package quarantine
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github/chapool/go-wallet/internal/wallet/alert"
+	"github/chapool/go-wallet/internal/wallet/deposit"
+	"github/chapool/go-wallet/internal/wallet/export"
+	"github/chapool/go-wallet/internal/wallet/stats"
+	"github/chapool/go-wallet/internal/wallet/withdraw"
+
+	"github.com/pkg/errors"
+)
+
+// 隔离案件状态
+const (
+	StatusQuarantined = "quarantined" // 资金已冻结，等待合规处理
+	StatusEscalated   = "escalated"   // 已升级处理，仍然冻结
+	StatusReleased    = "released"    // 已释放入账
+	StatusReturned    = "returned"    // 已退回来源地址，不入账
+)
+
+// 筛查命中类型
+const (
+	MatchTypeAddress = "address" // 地址命中筛查名单或外部筛查服务
+	MatchTypeToken   = "token"   // 代币命中筛查名单
+)
+
+// 处理动作
+const (
+	ActionRelease  = "release"
+	ActionReturn   = "return"
+	ActionEscalate = "escalate"
+)
+
+var (
+	// ErrCaseNotFound 隔离案件不存在
+	ErrCaseNotFound = errors.New("quarantine case not found")
+	// ErrInvalidTransition 案件当前状态不允许该处理动作
+	ErrInvalidTransition = errors.New("invalid quarantine case transition")
+	// ErrInvalidRequest 请求参数不合法
+	ErrInvalidRequest = errors.New("invalid quarantine request")
+	// ErrScreeningAddressNotFound 筛查名单地址不存在
+	ErrScreeningAddressNotFound = errors.New("screening address not found")
+	// ErrScreeningAddressExists 筛查名单中已有该地址
+	ErrScreeningAddressExists = errors.New("screening address already exists")
+	// ErrScreeningTokenNotFound 筛查名单代币不存在
+	ErrScreeningTokenNotFound = errors.New("screening token not found")
+	// ErrScreeningTokenExists 筛查名单中已有该代币
+	ErrScreeningTokenExists = errors.New("screening token already exists")
+)
+
+// Service 充值隔离服务接口
+// 来源地址或代币命中筛查名单（或外部筛查服务）的充值不入账，Credits 记录冻结并创建隔离案件；
+// 合规人员可释放入账、退回来源地址或升级处理，每次状态变化都通知合规团队。
+// 目标地址或代币命中的提现请求被拦截并记录拦截审计
+type Service interface {
+	deposit.ScreeningHook
+	withdraw.ScreeningHook
+
+	// AddScreeningAddress 将地址加入筛查名单，之后来自该地址的充值进入隔离
+	AddScreeningAddress(ctx context.Context, req *ScreeningAddress) (*ScreeningAddress, error)
+
+	// DeleteScreeningAddress 将地址移出筛查名单，已隔离的案件不受影响
+	DeleteScreeningAddress(ctx context.Context, id string) error
+
+	// AddScreeningToken 将代币加入筛查名单，之后该代币的充值进入隔离、提现被拦截；代币不存在时返回 walleterrors.ErrTokenNotFound
+	AddScreeningToken(ctx context.Context, req *ScreeningToken) (*ScreeningToken, error)
+
+	// DeleteScreeningToken 将代币移出筛查名单，已隔离的案件不受影响
+	DeleteScreeningToken(ctx context.Context, id string) error
+
+	// ListCases 查询隔离案件（按创建时间倒序）
+	ListCases(ctx context.Context, filter *Filter, limit int, offset int) ([]*Case, error)
+
+	// GetCase 获取隔离案件
+	GetCase(ctx context.Context, caseID string) (*Case, error)
+
+	// Resolve 处理隔离案件：释放入账、退回来源地址或升级处理
+	Resolve(ctx context.Context, req *ResolveRequest) (*Case, error)
+
+	// ExportCases 导出隔离案件
+	ExportCases(ctx context.Context, filter *Filter, w export.Writer) error
+}
+
+// ScreeningAddress 筛查名单地址
+type ScreeningAddress struct {
+	ID        string
+	ChainType string
+	Address   string
+	Source    string
+	Reason    *string
+	CreatedBy *string
+	CreatedAt time.Time
+}
+
+// ScreeningToken 筛查名单代币
+type ScreeningToken struct {
+	ID          string
+	TokenID     int
+	ChainID     int
+	TokenSymbol string
+	Source      string
+	Reason      *string
+	CreatedBy   *string
+	CreatedAt   time.Time
+}
+
+// Case 隔离案件
+type Case struct {
+	ID              string
+	TransactionID   string
+	CreditID        string
+	UserID          string
+	ChainID         int
+	TokenID         int
+	TokenSymbol     string
+	FromAddress     string
+	ToAddress       string
+	Amount          string // 最小单位
+	TxHash          string
+	ScreeningSource string
+	ScreeningReason *string
+	Status          string
+	ResolutionNote  *string
+	ResolvedBy      *string
+	ReturnTxHash    *string
+	EscalatedAt     *time.Time
+	ResolvedAt      *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Filter 隔离案件查询条件，字段为空表示不限
+type Filter struct {
+	Status        *string
+	UserID        *string
+	ChainID       *int
+	CreatedAfter  *time.Time // 包含
+	CreatedBefore *time.Time // 不包含
+}
+
+// ResolveRequest 处理隔离案件请求
+type ResolveRequest struct {
+	CaseID       string
+	AdminUserID  string
+	Action       string
+	Note         *string
+	ReturnTxHash *string // 退回来源地址的交易哈希，Action 为 return 时必填
+}
+
+type service struct {
+	db           *sql.DB
+	statsService stats.Service
+	notifier     alert.Notifier
+	provider     Provider
+}
+
+// NewService 创建充值隔离服务，notifier 接收合规通知，provider 为外部地址筛查服务（为 nil 时只使用本地筛查名单）
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(db *sql.DB, statsService stats.Service, notifier alert.Notifier, provider Provider) Service {
+	if notifier == nil {
+		notifier = alert.NewNotifier("", nil, nil)
+	}
+
+	return &service{
+		db:           db,
+		statsService: statsService,
+		notifier:     notifier,
+		provider:     provider,
+	}
+}
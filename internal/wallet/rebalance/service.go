@@ -5,15 +5,20 @@ import (
 	"context"
 	"database/sql"
 	"math/big"
+	"slices"
 	"sort"
 	"strings"
 	"time"
 
+	walletMetrics "github/chapool/go-wallet/internal/metrics/wallet"
 	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/util/lifecycle"
 	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/gasguard"
 	"github/chapool/go-wallet/internal/wallet/hotwallet"
 	"github/chapool/go-wallet/internal/wallet/scan"
 	"github/chapool/go-wallet/internal/wallet/signer"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
@@ -22,28 +27,19 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
-	rebalanceGasLimitNative        uint64 = 21000
-	rebalanceEIPMultiplier         int64  = 2
-	weiPerEtherValue                      = 1_000_000_000_000_000_000
-	minHotWalletsForRebalance             = 2
-	rebalanceMinBalanceETH                = 3
-	rebalanceMaxBalanceETH                = 8
-	rebalanceGasBufferWeiValue            = 200_000_000_000_000 // 0.0002 ETH
-	rebalanceReceiptTimeoutMinutes        = 2
-	rebalancePollIntervalSeconds          = 3
-	abiPaddedAddressLength                = 32
+	rebalanceGasLimitNative    uint64 = 21000
+	minHotWalletsForRebalance         = 2
+	rebalanceGasBufferWeiValue        = 200_000_000_000_000 // 0.0002 ETH
+	abiPaddedAddressLength            = 32
+	nativeDecimals                    = 18
 )
 
 var (
-	weiPerEther             = big.NewInt(weiPerEtherValue)
-	rebalanceMinBalanceWei  = new(big.Int).Mul(big.NewInt(rebalanceMinBalanceETH), weiPerEther) // 3 ETH
-	rebalanceMaxBalanceWei  = new(big.Int).Mul(big.NewInt(rebalanceMaxBalanceETH), weiPerEther) // 8 ETH
-	rebalanceGasBufferWei   = big.NewInt(rebalanceGasBufferWeiValue)
-	rebalanceReceiptTimeout = rebalanceReceiptTimeoutMinutes * time.Minute
-	rebalancePollInterval   = rebalancePollIntervalSeconds * time.Second
+	rebalanceGasBufferWei = big.NewInt(rebalanceGasBufferWeiValue)
 )
 
 // Service defines the rebalance operations contract.
@@ -55,10 +51,13 @@ type Service interface {
 
 type service struct {
 	db               *sql.DB
+	config           Config
 	chainService     chain.Service
 	scanService      scan.Service
 	hotWalletService hotwallet.Service
 	signerService    signer.Service
+	gasGuard         gasguard.Guard
+	gasPriceCap      gasguard.PriceCap
 }
 
 // NewService creates a new rebalance service.
@@ -66,17 +65,23 @@ type service struct {
 //nolint:ireturn // Returning interface aids DI
 func NewService(
 	db *sql.DB,
+	config Config,
 	chainService chain.Service,
 	scanService scan.Service,
 	hotWalletService hotwallet.Service,
 	signerService signer.Service,
+	gasGuard gasguard.Guard,
+	gasPriceCap gasguard.PriceCap,
 ) Service {
 	return &service{
 		db:               db,
+		config:           config,
 		chainService:     chainService,
 		scanService:      scanService,
 		hotWalletService: hotWalletService,
 		signerService:    signerService,
+		gasGuard:         gasGuard,
+		gasPriceCap:      gasPriceCap,
 	}
 }
 
@@ -86,7 +91,7 @@ func (s *service) StartAutoRebalance(ctx context.Context, interval time.Duration
 		Dur("interval", interval).
 		Msg("Starting auto rebalance scheduler")
 
-	go func() {
+	lifecycle.Go(ctx, "auto rebalance", func() {
 		ticker := time.NewTicker(interval)
 		defer ticker.Stop()
 
@@ -101,14 +106,14 @@ func (s *service) StartAutoRebalance(ctx context.Context, interval time.Duration
 				s.runAutoRebalance(ctx)
 			}
 		}
-	}()
+	})
 }
 
 // RebalanceForChain checks hot wallets on a specific chain.
 func (s *service) RebalanceForChain(ctx context.Context, chainID int) error {
 	wallets, err := models.Wallets(
 		models.WalletWhere.ChainID.EQ(chainID),
-		models.WalletWhere.WalletType.EQ("hot"),
+		models.WalletWhere.WalletType.EQ(models.WalletTypeHot),
 	).All(ctx, s.db)
 	if err != nil {
 		return errors.Wrap(err, "failed to load hot wallets")
@@ -142,12 +147,13 @@ func (s *service) RebalanceForChain(ctx context.Context, chainID int) error {
 				Msg("RebalanceService: failed to fetch hot wallet balance")
 			continue
 		}
+		walletMetrics.SetHotWalletBalance(chainID, strings.ToLower(wallet.Address), walletMetrics.AssetNative, balance, nativeDecimals)
 
 		entry := walletBalance{wallet: wallet, balance: balance}
 		switch {
-		case balance.Cmp(rebalanceMaxBalanceWei) > 0:
+		case balance.Cmp(s.config.MaxBalanceWei) > 0:
 			donors = append(donors, entry)
-		case balance.Cmp(rebalanceMinBalanceWei) < 0:
+		case balance.Cmp(s.config.MinBalanceWei) < 0:
 			receivers = append(receivers, entry)
 		}
 	}
@@ -164,13 +170,13 @@ func (s *service) RebalanceForChain(ctx context.Context, chainID int) error {
 	})
 
 	for i := range receivers {
-		needed := new(big.Int).Sub(rebalanceMinBalanceWei, receivers[i].balance)
+		needed := new(big.Int).Sub(s.config.MinBalanceWei, receivers[i].balance)
 		if needed.Sign() <= 0 {
 			continue
 		}
 
 		for donorIdx := range donors {
-			available := new(big.Int).Sub(donors[donorIdx].balance, rebalanceMaxBalanceWei)
+			available := new(big.Int).Sub(donors[donorIdx].balance, s.config.MaxBalanceWei)
 			available.Sub(available, rebalanceGasBufferWei)
 			if available.Sign() <= 0 {
 				continue
@@ -185,7 +191,19 @@ func (s *service) RebalanceForChain(ctx context.Context, chainID int) error {
 				continue
 			}
 
-			if err := s.transferBetweenHotWallets(ctx, donors[donorIdx].wallet, receivers[i].wallet, transfer); err != nil {
+			// Stop before starting a new transfer on shutdown, a started transfer is not interrupted
+			if ctx.Err() != nil {
+				return errors.Wrap(ctx.Err(), "context canceled during rebalance")
+			}
+
+			transferCtx, cancel := lifecycle.InFlight(ctx)
+			err := s.transferBetweenHotWallets(transferCtx, donors[donorIdx].wallet, receivers[i].wallet, transfer)
+			cancel()
+			// The gas price applies to the whole chain, the remaining transfers are retried on the next attempt
+			if gasguard.IsCapExceeded(err) {
+				return err
+			}
+			if err != nil {
 				log.Error().
 					Err(err).
 					Str("from", donors[donorIdx].wallet.Address).
@@ -199,7 +217,7 @@ func (s *service) RebalanceForChain(ctx context.Context, chainID int) error {
 			receivers[i].balance.Add(receivers[i].balance, transfer)
 			needed.Sub(needed, transfer)
 
-			if donors[donorIdx].balance.Cmp(rebalanceMaxBalanceWei) <= 0 {
+			if donors[donorIdx].balance.Cmp(s.config.MaxBalanceWei) <= 0 {
 				continue
 			}
 
@@ -220,7 +238,7 @@ func (s *service) Rebalance(ctx context.Context, req *Request) error {
 
 	fromWallet, err := models.Wallets(
 		models.WalletWhere.Address.EQ(strings.ToLower(req.FromAddress)),
-		models.WalletWhere.WalletType.EQ("hot"),
+		models.WalletWhere.WalletType.EQ(models.WalletTypeHot),
 		models.WalletWhere.ChainID.EQ(req.ChainID),
 	).One(ctx, s.db)
 	if err != nil {
@@ -229,7 +247,7 @@ func (s *service) Rebalance(ctx context.Context, req *Request) error {
 
 	toWallet, err := models.Wallets(
 		models.WalletWhere.Address.EQ(strings.ToLower(req.ToAddress)),
-		models.WalletWhere.WalletType.EQ("hot"),
+		models.WalletWhere.WalletType.EQ(models.WalletTypeHot),
 		models.WalletWhere.ChainID.EQ(req.ChainID),
 	).One(ctx, s.db)
 	if err != nil {
@@ -246,14 +264,53 @@ func (s *service) runAutoRebalance(ctx context.Context) {
 		return
 	}
 
+	var g errgroup.Group
+	g.SetLimit(max(s.config.WorkerConcurrency, 1))
+
 	for _, ch := range chains {
-		if err := s.RebalanceForChain(ctx, ch.ChainID); err != nil {
-			log.Error().
-				Err(err).
+		// Rebalancing only supports EVM chains
+		if ch.ChainType != chain.TypeEVM {
+			continue
+		}
+
+		// Automatic operations are paused while the base fee exceeds the configured ceiling
+		if s.gasGuard.IsPaused(ch.ChainID) {
+			log.Info().
 				Int("chain_id", ch.ChainID).
-				Msg("RebalanceService: chain rebalance failed")
+				Msg("RebalanceService: skipping chain, gas spike circuit breaker is open")
+			continue
+		}
+
+		// Rebalancing is deferred while the gas price exceeds the configured cap, following the retry schedule
+		if s.gasPriceCap.ChainDeferred(ch.ChainID, time.Now()) {
+			log.Debug().
+				Int("chain_id", ch.ChainID).
+				Msg("RebalanceService: skipping chain, rebalance deferred by gas price cap")
+			continue
 		}
+
+		g.Go(func() error {
+			err := s.RebalanceForChain(ctx, ch.ChainID)
+			switch {
+			case gasguard.IsCapExceeded(err):
+				log.Warn().
+					Err(err).
+					Int("chain_id", ch.ChainID).
+					Time("retry_at", s.gasPriceCap.DeferChain(ch.ChainID, time.Now())).
+					Msg("RebalanceService: chain rebalance deferred, gas price exceeds the cap")
+			case err != nil:
+				log.Error().
+					Err(err).
+					Int("chain_id", ch.ChainID).
+					Msg("RebalanceService: chain rebalance failed")
+			default:
+				s.gasPriceCap.ResumeChain(ch.ChainID)
+			}
+			return nil
+		})
 	}
+
+	_ = g.Wait()
 }
 
 func (s *service) transferBetweenHotWallets(
@@ -276,51 +333,52 @@ func (s *service) transferBetweenHotWallets(
 	}
 
 	if new(big.Int).Add(amountWei, rebalanceGasBufferWei).Cmp(balance) > 0 {
-		return errors.New("insufficient balance on source hot wallet")
+		return errors.Wrap(walleterrors.ErrInsufficientBalance, "source hot wallet")
 	}
 
-	tipCap, err := client.SuggestGasTipCap(ctx)
+	fees, err := client.SuggestGasFees(ctx, s.config.BaseFeeMultiplier, slices.Contains(s.config.LegacyTxChainIDs, fromWallet.ChainID))
 	if err != nil {
-		return errors.Wrap(err, "failed to suggest gas tip cap")
+		return errors.Wrap(err, "failed to suggest gas fees")
 	}
 
-	latestBlock, err := client.GetBlockByNumber(ctx, nil)
-	if err != nil {
-		return errors.Wrap(err, "failed to fetch latest block")
+	// Transfers are not sent while the gas price exceeds the cap of the chain
+	if err := s.gasPriceCap.Check(ctx, fromWallet.ChainID, fees); err != nil {
+		return errors.Wrap(err, "gas price cap")
 	}
 
-	baseFee := latestBlock.BaseFee()
-	if baseFee == nil {
-		baseFee = big.NewInt(0)
-	}
-
-	maxFee := new(big.Int).Add(
-		new(big.Int).Mul(baseFee, big.NewInt(rebalanceEIPMultiplier)),
-		tipCap,
-	)
-
-	gasFee := new(big.Int).Mul(maxFee, big.NewInt(int64(rebalanceGasLimitNative)))
+	gasFee := fees.Cost(rebalanceGasLimitNative)
 	if new(big.Int).Add(amountWei, gasFee).Cmp(balance) > 0 {
 		return errors.New("insufficient funds after gas estimation")
 	}
 
-	nonce, err := s.hotWalletService.GetNextNonce(ctx, strings.ToLower(fromWallet.Address), fromWallet.ChainID)
-	if err != nil {
-		return errors.Wrap(err, "failed to reserve nonce")
-	}
-
+	maxFeePerGas, maxPriorityFeePerGas, gasPrice := fees.SignFields()
 	signReq := &signer.SignEVMRequest{
 		ChainID:              int64(fromWallet.ChainID),
 		To:                   toAddr.Hex(),
 		Value:                amountWei.String(),
 		GasLimit:             rebalanceGasLimitNative,
-		MaxFeePerGas:         maxFee.String(),
-		MaxPriorityFeePerGas: tipCap.String(),
-		//nolint:gosec // Nonce is guaranteed to be positive and fit in uint64
-		Nonce:          uint64(nonce),
-		FromAddress:    fromAddr.Hex(),
-		DerivationPath: fromWallet.DerivationPath,
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+		GasPrice:             gasPrice,
+		FromAddress:          fromAddr.Hex(),
+		DerivationPath:       fromWallet.DerivationPath,
+	}
+
+	// Simulate before reserving a nonce so a transaction that would revert does not leave a nonce gap
+	msg, err := signReq.CallMsg()
+	if err != nil {
+		return errors.Wrap(err, "failed to build rebalance simulation call")
+	}
+	if err := client.SimulateTransaction(ctx, msg); err != nil {
+		return errors.Wrap(err, "rebalance transaction simulation failed")
+	}
+
+	nonce, err := s.hotWalletService.GetNextNonce(ctx, strings.ToLower(fromWallet.Address), fromWallet.ChainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to reserve nonce")
 	}
+	//nolint:gosec // Nonce is guaranteed to be positive and fit in uint64
+	signReq.Nonce = uint64(nonce)
 
 	signResp, err := s.signerService.SignEVMTransaction(ctx, signReq)
 	if err != nil {
@@ -332,7 +390,7 @@ func (s *service) transferBetweenHotWallets(
 		return errors.Wrap(err, "failed to decode signed rebalance transaction")
 	}
 
-	if err := client.SendTransaction(ctx, txObj); err != nil {
+	if err := client.BroadcastTransaction(ctx, txObj); err != nil {
 		return errors.Wrap(err, "failed to broadcast rebalance transaction")
 	}
 
@@ -376,10 +434,10 @@ func (s *service) transferBetweenHotWallets(
 }
 
 func (s *service) waitForReceipt(ctx context.Context, client *scan.RPCClient, txHash common.Hash) (*types.Receipt, error) {
-	localCtx, cancel := context.WithTimeout(ctx, rebalanceReceiptTimeout)
+	localCtx, cancel := context.WithTimeout(ctx, s.config.ReceiptTimeout)
 	defer cancel()
 
-	ticker := time.NewTicker(rebalancePollInterval)
+	ticker := time.NewTicker(s.config.ReceiptPollInterval)
 	defer ticker.Stop()
 
 	for {
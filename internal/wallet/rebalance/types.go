@@ -1,6 +1,9 @@
 package rebalance
 
-import "math/big"
+import (
+	"math/big"
+	"time"
+)
 
 // Request represents a manual rebalance operation between hot wallets.
 type Request struct {
@@ -9,3 +12,14 @@ type Request struct {
 	ToAddress   string
 	Amount      *big.Int // Amount in wei
 }
+
+// Config holds the tunable rebalance settings.
+type Config struct {
+	MinBalanceWei       *big.Int      // Hot wallets below this native balance receive funds
+	MaxBalanceWei       *big.Int      // Hot wallets above this native balance donate funds
+	BaseFeeMultiplier   int64         // maxFeePerGas = baseFee * BaseFeeMultiplier + tipCap
+	WorkerConcurrency   int           // Number of chains rebalanced in parallel by the auto rebalance scheduler
+	LegacyTxChainIDs    []int         // Chains always using legacy (gasPrice) transactions; chains without a base fee are detected automatically
+	ReceiptPollInterval time.Duration // How often the receipt of a rebalance transaction is polled
+	ReceiptTimeout      time.Duration // How long to wait for the receipt of a rebalance transaction
+}
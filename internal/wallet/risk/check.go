@@ -0,0 +1,191 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github/chapool/go-wallet/internal/models"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// usage 用户在限额周期内已发起的提现（不含失败的提现）
+type usage struct {
+	amount *big.Rat
+	count  int
+}
+
+// CheckWithdraw 检查用户本次提现是否超出限额
+// 先锁定用户行，使同一用户的并发提现依次统计，避免同时通过限额检查
+func (s *service) CheckWithdraw(ctx context.Context, exec boil.ContextExecutor, userID string, token *models.Token, amount string) ([]*Violation, error) {
+	limits, err := s.effectiveLimits(ctx, exec, userID, token.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(limits) == 0 {
+		return nil, nil
+	}
+
+	requested, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return nil, errors.Errorf("invalid withdraw amount %q", amount)
+	}
+
+	if _, err := exec.ExecContext(ctx, `SELECT id FROM users WHERE id = $1 FOR UPDATE`, userID); err != nil {
+		return nil, errors.Wrap(err, "failed to lock user for withdraw limit check")
+	}
+
+	usages, err := s.getUsage(ctx, exec, userID, token.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []*Violation
+	for _, limit := range limits {
+		used := usages[limit.Period]
+		if limit.MaxCount != nil && used.count+1 > *limit.MaxCount {
+			violations = append(violations, &Violation{
+				Limit:  limit,
+				Reason: fmt.Sprintf("%s withdraw count limit of %d exceeded", limit.Period, *limit.MaxCount),
+			})
+			continue
+		}
+
+		if limit.MaxAmount != nil {
+			maxAmount, ok := new(big.Rat).SetString(*limit.MaxAmount)
+			if !ok {
+				return nil, errors.Errorf("invalid max_amount %q for withdraw limit %s", *limit.MaxAmount, limit.ID)
+			}
+			total := new(big.Rat).Add(used.amount, requested)
+			if total.Cmp(maxAmount) > 0 {
+				violations = append(violations, &Violation{
+					Limit:  limit,
+					Reason: fmt.Sprintf("%s withdraw amount limit of %s %s exceeded", limit.Period, *limit.MaxAmount, token.TokenSymbol),
+				})
+			}
+		}
+	}
+
+	return violations, nil
+}
+
+// effectiveLimits 获取对用户生效的限额，同一周期和处理方式下用户限额优先于用户所属组织的默认限额，组织默认限额优先于全局默认限额
+func (s *service) effectiveLimits(ctx context.Context, exec boil.ContextExecutor, userID string, tokenID int) ([]*Limit, error) {
+	rows, err := exec.QueryContext(ctx, `
+		SELECT DISTINCT ON (period, action) `+withdrawLimitColumns+`
+		FROM withdraw_limits
+		WHERE token_id = $2
+			AND (user_id = $1
+				OR (user_id IS NULL AND org_id IS NULL)
+				OR (user_id IS NULL AND org_id = (SELECT org_id FROM organization_members WHERE user_id = $1)))
+		ORDER BY period, action, user_id NULLS LAST, org_id NULLS LAST
+	`, userID, tokenID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query effective withdraw limits")
+	}
+	defer rows.Close()
+
+	var limits []*Limit
+	for rows.Next() {
+		limit, err := scanLimit(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan withdraw limit")
+		}
+		limits = append(limits, limit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate withdraw limits")
+	}
+
+	return limits, nil
+}
+
+// getUsage 统计用户在每日、每周窗口内该代币的提现金额和笔数
+func (s *service) getUsage(ctx context.Context, exec boil.ContextExecutor, userID string, tokenID int) (map[string]usage, error) {
+	var (
+		dailyAmount, weeklyAmount string
+		dailyCount, weeklyCount   int
+	)
+
+	err := exec.QueryRowContext(ctx, `
+		SELECT
+			COALESCE(SUM(amount::numeric) FILTER (WHERE created_at > NOW() - INTERVAL '24 hours'), 0)::text,
+			COUNT(*) FILTER (WHERE created_at > NOW() - INTERVAL '24 hours'),
+			COALESCE(SUM(amount::numeric), 0)::text,
+			COUNT(*)
+		FROM withdraws
+		WHERE user_id = $1 AND token_id = $2 AND status <> $3
+			AND created_at > NOW() - INTERVAL '7 days'
+	`, userID, tokenID, models.WithdrawStatusFailed).Scan(&dailyAmount, &dailyCount, &weeklyAmount, &weeklyCount)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get withdraw usage")
+	}
+
+	daily, ok := new(big.Rat).SetString(dailyAmount)
+	if !ok {
+		return nil, errors.Errorf("invalid daily withdraw usage %q", dailyAmount)
+	}
+	weekly, ok := new(big.Rat).SetString(weeklyAmount)
+	if !ok {
+		return nil, errors.Errorf("invalid weekly withdraw usage %q", weeklyAmount)
+	}
+
+	return map[string]usage{
+		PeriodDaily:  {amount: daily, count: dailyCount},
+		PeriodWeekly: {amount: weekly, count: weeklyCount},
+	}, nil
+}
+
+// RecordFlags 记录提现的复核标记
+func (s *service) RecordFlags(ctx context.Context, exec boil.ContextExecutor, withdrawID string, violations []*Violation) error {
+	for _, violation := range violations {
+		if _, err := exec.ExecContext(ctx, `
+			INSERT INTO withdraw_risk_flags (withdraw_id, limit_id, reason) VALUES ($1, $2, $3)
+		`, withdrawID, violation.Limit.ID, violation.Reason); err != nil {
+			return errors.Wrap(err, "failed to insert withdraw risk flag")
+		}
+	}
+
+	return nil
+}
+
+// GetFlags 批量获取提现的复核标记（按标记时间正序）
+func (s *service) GetFlags(ctx context.Context, withdrawIDs []string) (map[string][]*Flag, error) {
+	result := make(map[string][]*Flag, len(withdrawIDs))
+	for _, withdrawID := range withdrawIDs {
+		result[withdrawID] = make([]*Flag, 0)
+	}
+
+	if len(withdrawIDs) == 0 {
+		return result, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT withdraw_id, limit_id, reason, created_at
+		FROM withdraw_risk_flags
+		WHERE withdraw_id = ANY($1::uuid[])
+		ORDER BY created_at ASC, id ASC
+	`, pq.Array(withdrawIDs))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query withdraw risk flags")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var flag Flag
+		if err := rows.Scan(&flag.WithdrawID, &flag.LimitID, &flag.Reason, &flag.CreatedAt); err != nil {
+			return nil, errors.Wrap(err, "failed to scan withdraw risk flag")
+		}
+		result[flag.WithdrawID] = append(result[flag.WithdrawID], &flag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate withdraw risk flags")
+	}
+
+	return result, nil
+}
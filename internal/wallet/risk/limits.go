@@ -0,0 +1,179 @@
+package risk
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// withdrawLimitColumns withdraw_limits 查询列，与 scanLimit 的顺序一致
+const withdrawLimitColumns = `id, user_id, org_id, token_id, period, max_amount, max_count, action, created_by, created_at, updated_at`
+
+// rowScanner 兼容 *sql.Row 和 *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// ListLimits 查询提现限额
+func (s *service) ListLimits(ctx context.Context, filter *LimitFilter) ([]*Limit, error) {
+	if filter == nil {
+		filter = &LimitFilter{}
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+withdrawLimitColumns+`
+		FROM withdraw_limits
+		WHERE ($1::uuid IS NULL OR user_id = $1)
+			AND ($2::int IS NULL OR token_id = $2)
+			AND ($3::uuid IS NULL OR org_id = $3)
+		ORDER BY token_id, user_id NULLS FIRST, org_id NULLS FIRST, period, action
+	`, filter.UserID, filter.TokenID, filter.OrgID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query withdraw limits")
+	}
+	defer rows.Close()
+
+	limits := make([]*Limit, 0)
+	for rows.Next() {
+		limit, err := scanLimit(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan withdraw limit")
+		}
+		limits = append(limits, limit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate withdraw limits")
+	}
+
+	return limits, nil
+}
+
+// SetLimit 创建或更新提现限额
+func (s *service) SetLimit(ctx context.Context, limit *Limit) (*Limit, error) {
+	if err := validateLimit(limit); err != nil {
+		return nil, err
+	}
+
+	saved, err := scanLimit(s.db.QueryRowContext(ctx, `
+		INSERT INTO withdraw_limits (user_id, org_id, token_id, period, max_amount, max_count, action, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT ((COALESCE(user_id, '00000000-0000-0000-0000-000000000000'::uuid)), (COALESCE(org_id, '00000000-0000-0000-0000-000000000000'::uuid)), token_id, period, action)
+		DO UPDATE SET max_amount = EXCLUDED.max_amount, max_count = EXCLUDED.max_count,
+			created_by = EXCLUDED.created_by, updated_at = NOW()
+		RETURNING `+withdrawLimitColumns,
+		limit.UserID, limit.OrgID, limit.TokenID, limit.Period, limit.MaxAmount, limit.MaxCount, limit.Action, limit.CreatedBy,
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to save withdraw limit")
+	}
+
+	return saved, nil
+}
+
+// DeleteLimit 删除提现限额
+func (s *service) DeleteLimit(ctx context.Context, limitID string) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM withdraw_limits WHERE id = $1`, limitID)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete withdraw limit")
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get affected rows")
+	}
+	if affected == 0 {
+		return ErrLimitNotFound
+	}
+
+	return nil
+}
+
+// validateLimit 校验限额参数
+func validateLimit(limit *Limit) error {
+	if limit.TokenID <= 0 {
+		return errors.Wrap(ErrInvalidLimit, "token_id is required")
+	}
+
+	if limit.UserID != nil && limit.OrgID != nil {
+		return errors.Wrap(ErrInvalidLimit, "user_id and org_id cannot both be set")
+	}
+
+	switch limit.Period {
+	case PeriodDaily, PeriodWeekly:
+	default:
+		return errors.Wrapf(ErrInvalidLimit, "unknown period %q", limit.Period)
+	}
+
+	switch limit.Action {
+	case ActionReject, ActionReview:
+	default:
+		return errors.Wrapf(ErrInvalidLimit, "unknown action %q", limit.Action)
+	}
+
+	if limit.MaxAmount == nil && limit.MaxCount == nil {
+		return errors.Wrap(ErrInvalidLimit, "max_amount or max_count is required")
+	}
+
+	if limit.MaxAmount != nil {
+		amount, ok := new(big.Rat).SetString(*limit.MaxAmount)
+		if !ok || amount.Sign() < 0 {
+			return errors.Wrap(ErrInvalidLimit, "max_amount must be a non-negative number")
+		}
+	}
+
+	if limit.MaxCount != nil && *limit.MaxCount < 0 {
+		return errors.Wrap(ErrInvalidLimit, "max_count must not be negative")
+	}
+
+	return nil
+}
+
+// scanLimit 扫描一行提现限额
+func scanLimit(row rowScanner) (*Limit, error) {
+	var (
+		limit     Limit
+		userID    sql.NullString
+		orgID     sql.NullString
+		maxAmount sql.NullString
+		maxCount  sql.NullInt64
+		createdBy sql.NullString
+	)
+
+	if err := row.Scan(
+		&limit.ID,
+		&userID,
+		&orgID,
+		&limit.TokenID,
+		&limit.Period,
+		&maxAmount,
+		&maxCount,
+		&limit.Action,
+		&createdBy,
+		&limit.CreatedAt,
+		&limit.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	limit.UserID = nullStringPtr(userID)
+	limit.OrgID = nullStringPtr(orgID)
+	limit.MaxAmount = nullStringPtr(maxAmount)
+	if maxCount.Valid {
+		v := int(maxCount.Int64)
+		limit.MaxCount = &v
+	}
+	limit.CreatedBy = nullStringPtr(createdBy)
+
+	return &limit, nil
+}
+
+func nullStringPtr(v sql.NullString) *string {
+	if !v.Valid {
+		return nil
+	}
+
+	return &v.String
+}
@@ -0,0 +1,118 @@
+//nolint:ireturn // 返回接口类型是预期的设计
+package risk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/pkg/errors"
+)
+
+// 限额周期（滚动窗口）
+const (
+	PeriodDaily  = "daily"  // 最近 24 小时
+	PeriodWeekly = "weekly" // 最近 7 天
+)
+
+// 超限处理方式
+const (
+	ActionReject = "reject" // 拒绝提现
+	ActionReview = "review" // 创建提现并标记人工复核
+)
+
+var (
+	// ErrInvalidLimit 限额参数不合法
+	ErrInvalidLimit = errors.New("invalid withdraw limit")
+	// ErrLimitNotFound 限额不存在
+	ErrLimitNotFound = errors.New("withdraw limit not found")
+)
+
+// Service 提现风控服务接口
+// 按用户、代币维护每日/每周提现金额和笔数限额，user_id 为空、org_id 不为空的限额作为组织成员的默认值，
+// 两者都为空的限额作为所有用户的默认值
+type Service interface {
+	// ListLimits 查询提现限额
+	ListLimits(ctx context.Context, filter *LimitFilter) ([]*Limit, error)
+
+	// SetLimit 创建或更新提现限额（同一用户/组织、代币、周期、处理方式只有一条）
+	SetLimit(ctx context.Context, limit *Limit) (*Limit, error)
+
+	// DeleteLimit 删除提现限额
+	DeleteLimit(ctx context.Context, limitID string) error
+
+	// CheckWithdraw 检查用户本次提现是否超出限额，返回命中的限额（未超限时为空）
+	// 在创建提现的事务中调用，exec 为该事务
+	CheckWithdraw(ctx context.Context, exec boil.ContextExecutor, userID string, token *models.Token, amount string) ([]*Violation, error)
+
+	// RecordFlags 记录提现的复核标记
+	RecordFlags(ctx context.Context, exec boil.ContextExecutor, withdrawID string, violations []*Violation) error
+
+	// GetFlags 批量获取提现的复核标记
+	GetFlags(ctx context.Context, withdrawIDs []string) (map[string][]*Flag, error)
+}
+
+// Limit 提现限额，MaxAmount 和 MaxCount 至少设置一项
+type Limit struct {
+	ID        string
+	UserID    *string // 为空表示默认限额
+	OrgID     *string // 组织默认限额，不能与 UserID 同时设置
+	TokenID   int
+	Period    string
+	MaxAmount *string // 周期内提现总额上限（人类可读单位，含本次提现）
+	MaxCount  *int    // 周期内提现笔数上限（含本次提现）
+	Action    string
+	CreatedBy *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// LimitFilter 限额查询条件
+type LimitFilter struct {
+	UserID  *string
+	OrgID   *string
+	TokenID *int
+}
+
+// Violation 提现命中的限额
+type Violation struct {
+	Limit  *Limit
+	Reason string
+}
+
+// Flag 提现的复核标记
+type Flag struct {
+	WithdrawID string
+	LimitID    *string
+	Reason     string
+	CreatedAt  time.Time
+}
+
+// LimitExceededError 提现超出拒绝限额
+type LimitExceededError struct {
+	Violation *Violation
+}
+
+func (e *LimitExceededError) Error() string {
+	return e.Violation.Reason
+}
+
+// Unwrap 使 errors.Is(err, walleterrors.ErrWithdrawLimitExceeded) 成立
+func (e *LimitExceededError) Unwrap() error {
+	return walleterrors.ErrWithdrawLimitExceeded
+}
+
+type service struct {
+	db *sql.DB
+}
+
+// NewService 创建提现风控服务
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(db *sql.DB) Service {
+	return &service{db: db}
+}
@@ -6,7 +6,9 @@ import (
 	"math/big"
 	"strings"
 
+	walletMetrics "github/chapool/go-wallet/internal/metrics/wallet"
 	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/chain"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
@@ -17,10 +19,6 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-const (
-	minTransferEventTopics = 3 // ERC20 Transfer 事件至少需要 3 个 topics
-)
-
 // ERC20 Transfer 事件签名
 // Transfer(address indexed from, address indexed to, uint256 value)
 var transferEventSignature = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
@@ -28,15 +26,57 @@ var transferEventSignature = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378da
 // analyzer 交易分析器
 type analyzer struct {
 	db *sql.DB
+	// transferEvents 非标准代币的自定义转账事件布局（按代币合约地址），未配置的代币按标准 Transfer 事件解析
+	transferEvents map[common.Address][]*transferEventLayout
+	// tokenDiscovery 未知代币自动发现，未启用时为 nil
+	tokenDiscovery *tokenDiscovery
+	// feeOnTransferTokens 转账扣费代币，充值按实际到账金额入账（见 verifyReceivedAmounts）
+	feeOnTransferTokens map[common.Address]struct{}
+	// balanceReader 查询转账扣费代币在区块前后的余额
+	balanceReader tokenBalanceReader
+	// receivedTotals 当前区块内转账扣费代币的实际到账金额
+	receivedTotals map[receivedKey]*receivedTotals
+	// hotWallets 链上的热钱包地址（地址 -> 钱包 ID），记录热钱包发出的授权
+	hotWallets map[common.Address]string
 }
 
-// newAnalyzer 创建交易分析器
-func newAnalyzer(db *sql.DB) *analyzer {
-	return &analyzer{db: db}
+// newAnalyzer 创建交易分析器，并加载链上代币的自定义转账事件配置、转账扣费代币和热钱包地址
+func newAnalyzer(ctx context.Context, db *sql.DB, chainID int) (*analyzer, error) {
+	transferEvents, err := loadTransferEventLayouts(ctx, db, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	feeOnTransferTokens, err := loadFeeOnTransferTokens(ctx, db, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	hotWallets, err := loadHotWalletAddresses(ctx, db, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &analyzer{
+		db:                  db,
+		transferEvents:      transferEvents,
+		feeOnTransferTokens: feeOnTransferTokens,
+		hotWallets:          hotWallets,
+	}, nil
 }
 
 // analyzeTransaction 分析交易
 func (a *analyzer) analyzeTransaction(ctx context.Context, chainID int, tx *types.Transaction, receipt *types.Receipt, blockNumber *big.Int, blockHash common.Hash) error {
+	// 分析 NFT 转账：NFT 不写入 transactions，按事件去重，已记录的交易同样需要分析
+	if err := a.analyzeNFTTransfers(ctx, chainID, tx, receipt, blockNumber, blockHash); err != nil {
+		return errors.Wrap(err, "failed to analyze NFT transfers")
+	}
+
+	// 分析热钱包授权：授权同样按事件去重，已记录的交易（如热钱包发出的交易）同样需要分析
+	if err := a.analyzeApprovals(ctx, chainID, tx, receipt, blockNumber, blockHash); err != nil {
+		return errors.Wrap(err, "failed to analyze token approvals")
+	}
+
 	// 检查交易是否已存在
 	exists, err := a.transactionExists(ctx, tx.Hash().Hex(), chainID)
 	if err != nil {
@@ -44,7 +84,12 @@ func (a *analyzer) analyzeTransaction(ctx context.Context, chainID int, tx *type
 	}
 
 	if exists {
-		// 交易已存在，跳过
+		// 区块重组中被回滚的交易重新打包进规范链时恢复原记录，其余已存在的交易跳过
+		if receipt.Status == types.ReceiptStatusSuccessful {
+			if _, err := reviveReorgedTransaction(ctx, a.db, chainID, tx.Hash().Hex(), blockNumber.Int64(), blockHash.Hex()); err != nil {
+				return errors.Wrap(err, "failed to revive reorged transaction")
+			}
+		}
 		return nil
 	}
 
@@ -107,7 +152,7 @@ func (a *analyzer) analyzeETHTransfer(ctx context.Context, chainID int, tx *type
 
 	txHash := strings.ToLower(tx.Hash().Hex())
 
-	exists, err := a.transactionExists(ctx, txHash, chainID)
+	exists, err := a.transferExists(ctx, chainID, txHash, null.Int{})
 	if err != nil {
 		return errors.Wrap(err, "failed to check transaction existence")
 	}
@@ -129,15 +174,17 @@ func (a *analyzer) analyzeETHTransfer(ctx context.Context, chainID int, tx *type
 		FromAddr:          fromAddr,
 		ToAddr:            toAddr,
 		TokenAddr:         null.String{}, // ETH 转账，token_addr 为空
+		EventIndex:        null.Int{},    // ETH 转账没有事件索引
 		Amount:            tx.Value().String(),
-		Type:              "deposit",
-		Status:            "confirmed",
+		Type:              models.TransactionTypeDeposit,
+		Status:            models.TransactionStatusConfirmed,
 		ConfirmationCount: null.IntFrom(0), // 初始确认数为 0（交易刚被确认）
 	}
 
 	if err := transaction.Insert(ctx, a.db, boil.Infer()); err != nil {
 		return errors.Wrap(err, "failed to insert ETH transfer transaction")
 	}
+	walletMetrics.DepositsDetected.WithLabelValues(walletMetrics.ChainLabel(chainID), walletMetrics.AssetNative).Inc()
 
 	return nil
 }
@@ -151,28 +198,16 @@ func (a *analyzer) analyzeERC20Transfers(ctx context.Context, chainID int, tx *t
 
 	// 解析 Transfer 事件
 	for _, logEntry := range receipt.Logs {
-		if len(logEntry.Topics) < minTransferEventTopics {
+		event, ok := a.decodeTransferEvent(logEntry)
+		if !ok {
 			continue
 		}
 
-		// 检查是否是 Transfer 事件
-		if logEntry.Topics[0] != transferEventSignature {
-			continue
-		}
-
-		// 解析 Transfer 事件参数
-		// Transfer(address indexed from, address indexed to, uint256 value)
-		// Topics[0] = event signature
-		// Topics[1] = from address (indexed)
-		// Topics[2] = to address (indexed)
-		// Data = value (uint256)
-
-		from := common.BytesToAddress(logEntry.Topics[1].Bytes())
-		to := common.BytesToAddress(logEntry.Topics[2].Bytes())
+		from := event.from
+		to := event.to
 		tokenAddr := logEntry.Address.Hex()
-
-		// 解析 amount
-		amount := new(big.Int).SetBytes(logEntry.Data)
+		// 转账扣费代币按实际到账金额入账
+		amount := a.receivedAmount(logEntry.Address, to, event.amount)
 
 		// 检查是否是充值交易（to 地址是用户钱包地址）
 		toAddr := strings.ToLower(to.Hex())
@@ -191,6 +226,10 @@ func (a *analyzer) analyzeERC20Transfers(ctx context.Context, chainID int, tx *t
 			continue
 		}
 
+		if a.tokenDiscovery != nil {
+			a.tokenDiscovery.discover(ctx, logEntry.Address)
+		}
+
 		log.Info().
 			Int("chain_id", chainID).
 			Str("tx_hash", tx.Hash().Hex()).
@@ -200,8 +239,10 @@ func (a *analyzer) analyzeERC20Transfers(ctx context.Context, chainID int, tx *t
 			Msg("ERC20 deposit detected")
 
 		txHash := strings.ToLower(tx.Hash().Hex())
+		// 同一笔交易中的多笔转账按事件的 logIndex 区分
+		eventIndex := null.IntFrom(int(logEntry.Index))
 
-		exists, err := a.transactionExists(ctx, txHash, chainID)
+		exists, err := a.transferExists(ctx, chainID, txHash, eventIndex)
 		if err != nil {
 			return errors.Wrap(err, "failed to check transaction existence")
 		}
@@ -210,6 +251,7 @@ func (a *analyzer) analyzeERC20Transfers(ctx context.Context, chainID int, tx *t
 			log.Debug().
 				Int("chain_id", chainID).
 				Str("tx_hash", txHash).
+				Int("event_index", eventIndex.Int).
 				Msg("ERC20 deposit transaction already recorded")
 			continue
 		}
@@ -223,15 +265,17 @@ func (a *analyzer) analyzeERC20Transfers(ctx context.Context, chainID int, tx *t
 			FromAddr:          fromAddr,
 			ToAddr:            toAddr,
 			TokenAddr:         null.StringFrom(strings.ToLower(tokenAddr)),
+			EventIndex:        eventIndex,
 			Amount:            amount.String(),
-			Type:              "deposit",
-			Status:            "confirmed",
+			Type:              models.TransactionTypeDeposit,
+			Status:            models.TransactionStatusConfirmed,
 			ConfirmationCount: null.IntFrom(0), // 初始确认数为 0（交易刚被确认）
 		}
 
 		if err := transaction.Insert(ctx, a.db, boil.Infer()); err != nil {
 			return errors.Wrap(err, "failed to insert ERC20 transfer transaction")
 		}
+		walletMetrics.DepositsDetected.WithLabelValues(walletMetrics.ChainLabel(chainID), walletMetrics.AssetERC20).Inc()
 
 		log.Debug().
 			Int("chain_id", chainID).
@@ -239,22 +283,39 @@ func (a *analyzer) analyzeERC20Transfers(ctx context.Context, chainID int, tx *t
 			Str("token_addr", tokenAddr).
 			Str("to_addr", to.Hex()).
 			Str("amount", amount.String()).
+			Int("event_index", eventIndex.Int).
 			Msg("ERC20 deposit transaction recorded")
 	}
 
 	return nil
 }
 
-// isUserAddress 检查地址是否是用户钱包地址
-// 使用 LOWER() 函数确保不区分大小写比较（兼容旧数据）
+// decodeTransferEvent 解析转账事件
+// 配置了自定义事件的代币只按自定义布局解析，其余代币按标准 Transfer 事件解析
+func (a *analyzer) decodeTransferEvent(logEntry *types.Log) (*transferEvent, bool) {
+	layouts, ok := a.transferEvents[logEntry.Address]
+	if !ok {
+		return standardTransferLayout.decode(logEntry)
+	}
+
+	for _, layout := range layouts {
+		if event, ok := layout.decode(logEntry); ok {
+			return event, true
+		}
+	}
+
+	return nil, false
+}
+
+// isUserAddress 检查地址是否是用户钱包地址（包括只监控充值的观察地址）
+// 使用规范化地址表达式比较，不区分大小写（兼容旧数据）且命中索引
 func (a *analyzer) isUserAddress(ctx context.Context, chainID int, address string) (bool, error) {
 	var count int64
-	addressLower := strings.ToLower(address)
 	err := a.db.QueryRowContext(ctx, `
 		SELECT COUNT(*) 
 		FROM wallets 
-		WHERE chain_id = $1 AND LOWER(address) = $2
-	`, chainID, addressLower).Scan(&count)
+		WHERE chain_id = $1 AND `+chain.WalletAddressKeySQL+` = $2
+	`, chainID, chain.NormalizeAddress(chain.TypeEVM, address)).Scan(&count)
 
 	if err != nil {
 		return false, errors.Wrap(err, "failed to check user address")
@@ -280,5 +341,21 @@ func (a *analyzer) transactionExists(ctx context.Context, txHash string, chainID
 	return count > 0, nil
 }
 
+// transferExists 检查交易中的某笔转账是否已记录（ERC20 按事件索引区分，原生代币转账的事件索引为空）
+func (a *analyzer) transferExists(ctx context.Context, chainID int, txHash string, eventIndex null.Int) (bool, error) {
+	var count int64
+	err := a.db.QueryRowContext(ctx, `
+		SELECT COUNT(*)
+		FROM transactions
+		WHERE chain_id = $1 AND LOWER(tx_hash) = $2 AND event_index IS NOT DISTINCT FROM $3
+	`, chainID, strings.ToLower(txHash), eventIndex).Scan(&count)
+
+	if err != nil {
+		return false, errors.Wrap(err, "failed to check transfer existence")
+	}
+
+	return count > 0, nil
+}
+
 // 移除未使用的导入
 var _ = abi.ABI{}
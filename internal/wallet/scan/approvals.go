@@ -0,0 +1,130 @@
+package scan
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"strings"
+
+	"github/chapool/go-wallet/internal/models"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// ERC20 Approval 事件签名
+// Approval(address indexed owner, address indexed spender, uint256 value)
+var approvalEventSignature = common.HexToHash("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+
+// erc20ApprovalTopicCount ERC20 Approval 事件的 topic 数（签名、owner、spender），ERC-721 Approval 的 tokenId 为第 4 个 topic
+const erc20ApprovalTopicCount = 3
+
+// approvalEvent 解析后的 ERC20 授权事件
+type approvalEvent struct {
+	owner   common.Address
+	spender common.Address
+	amount  *big.Int
+}
+
+// decodeApproval 解析 ERC20 Approval 事件，其他事件（包括 ERC-721 Approval）返回 false
+func decodeApproval(logEntry *types.Log) (*approvalEvent, bool) {
+	if len(logEntry.Topics) != erc20ApprovalTopicCount || logEntry.Topics[0] != approvalEventSignature {
+		return nil, false
+	}
+	if len(logEntry.Data) != eventDataWordSize {
+		return nil, false
+	}
+
+	return &approvalEvent{
+		owner:   common.BytesToAddress(logEntry.Topics[1].Bytes()),
+		spender: common.BytesToAddress(logEntry.Topics[2].Bytes()),
+		amount:  new(big.Int).SetBytes(logEntry.Data),
+	}, true
+}
+
+// loadHotWalletAddresses 加载链上的热钱包地址（地址 -> 钱包 ID），用于识别热钱包发出的授权
+func loadHotWalletAddresses(ctx context.Context, db *sql.DB, chainID int) (map[common.Address]string, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, address
+		FROM wallets
+		WHERE chain_id = $1 AND wallet_type = $2
+	`, chainID, models.WalletTypeHot)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query hot wallets")
+	}
+	defer rows.Close()
+
+	wallets := make(map[common.Address]string)
+	for rows.Next() {
+		var walletID, address string
+		if err := rows.Scan(&walletID, &address); err != nil {
+			return nil, errors.Wrap(err, "failed to scan hot wallet")
+		}
+		if !common.IsHexAddress(address) {
+			continue
+		}
+		wallets[common.HexToAddress(address)] = walletID
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate hot wallets")
+	}
+
+	return wallets, nil
+}
+
+// analyzeApprovals 记录 owner 为热钱包的 ERC20 Approval 事件，写入 token_approvals
+// 按 (chain_id, tx_hash, event_index) 去重，重新扫描时跳过；因重组回滚的记录重新打包进规范链区块时恢复为 active
+func (a *analyzer) analyzeApprovals(ctx context.Context, chainID int, tx *types.Transaction, receipt *types.Receipt, blockNumber *big.Int, blockHash common.Hash) error {
+	if receipt.Status != types.ReceiptStatusSuccessful || len(a.hotWallets) == 0 {
+		return nil
+	}
+
+	for _, logEntry := range receipt.Logs {
+		approval, ok := decodeApproval(logEntry)
+		if !ok {
+			continue
+		}
+
+		walletID, ok := a.hotWallets[approval.owner]
+		if !ok {
+			continue
+		}
+
+		txHash := strings.ToLower(tx.Hash().Hex())
+		tokenAddr := strings.ToLower(logEntry.Address.Hex())
+		spenderAddr := strings.ToLower(approval.spender.Hex())
+		result, err := a.db.ExecContext(ctx, `
+			INSERT INTO token_approvals (
+				wallet_id, chain_id, owner_addr, token_addr, spender_addr, amount, tx_hash, block_hash, block_no, event_index
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			ON CONFLICT (chain_id, tx_hash, event_index) DO UPDATE SET
+				block_hash = EXCLUDED.block_hash,
+				block_no = EXCLUDED.block_no,
+				status = 'active',
+				updated_at = NOW()
+			WHERE token_approvals.status = 'orphaned'
+		`, walletID, chainID, strings.ToLower(approval.owner.Hex()), tokenAddr, spenderAddr, approval.amount.String(),
+			txHash, blockHash.Hex(), blockNumber.Int64(), int(logEntry.Index))
+		if err != nil {
+			return errors.Wrap(err, "failed to insert token approval")
+		}
+		if inserted, _ := result.RowsAffected(); inserted == 0 {
+			continue
+		}
+
+		log.Info().
+			Int("chain_id", chainID).
+			Str("tx_hash", txHash).
+			Str("owner_addr", approval.owner.Hex()).
+			Str("token_addr", tokenAddr).
+			Str("spender_addr", spenderAddr).
+			Str("amount", approval.amount.String()).
+			Msg("Hot wallet token approval detected")
+	}
+
+	return nil
+}
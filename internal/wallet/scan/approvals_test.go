@@ -0,0 +1,59 @@
+package scan
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApprovalEventSignature(t *testing.T) {
+	assert.Equal(t, crypto.Keccak256Hash([]byte("Approval(address,address,uint256)")), approvalEventSignature)
+}
+
+func TestDecodeApproval(t *testing.T) {
+	owner := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	spender := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	addressTopic := func(address common.Address) common.Hash {
+		return common.BytesToHash(address.Bytes())
+	}
+
+	t.Run("erc20 approval", func(t *testing.T) {
+		approval, ok := decodeApproval(&types.Log{
+			Topics: []common.Hash{approvalEventSignature, addressTopic(owner), addressTopic(spender)},
+			Data:   common.BigToHash(big.NewInt(1000)).Bytes(),
+		})
+		require.True(t, ok)
+		assert.Equal(t, owner, approval.owner)
+		assert.Equal(t, spender, approval.spender)
+		assert.Equal(t, "1000", approval.amount.String())
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		approval, ok := decodeApproval(&types.Log{
+			Topics: []common.Hash{approvalEventSignature, addressTopic(owner), addressTopic(spender)},
+			Data:   common.BigToHash(big.NewInt(0)).Bytes(),
+		})
+		require.True(t, ok)
+		assert.Equal(t, "0", approval.amount.String())
+	})
+
+	t.Run("erc721 approval is ignored", func(t *testing.T) {
+		_, ok := decodeApproval(&types.Log{
+			Topics: []common.Hash{approvalEventSignature, addressTopic(owner), addressTopic(spender), common.BigToHash(big.NewInt(42))},
+		})
+		assert.False(t, ok)
+	})
+
+	t.Run("transfer is ignored", func(t *testing.T) {
+		_, ok := decodeApproval(&types.Log{
+			Topics: []common.Hash{transferEventSignature, addressTopic(owner), addressTopic(spender)},
+			Data:   common.BigToHash(big.NewInt(1000)).Bytes(),
+		})
+		assert.False(t, ok)
+	})
+}
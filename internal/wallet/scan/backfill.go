@@ -0,0 +1,507 @@
+package scan
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"time"
+
+	"github/chapool/go-wallet/internal/util/lifecycle"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// 补扫任务状态
+const (
+	BackfillStatusPending   = "pending"   // 等待执行（包括被中断、可从检查点继续的任务）
+	BackfillStatusRunning   = "running"   // 执行中
+	BackfillStatusCompleted = "completed" // 已完成
+	BackfillStatusFailed    = "failed"    // 执行失败，重新提交相同区间后从检查点继续
+	BackfillStatusCancelled = "cancelled" // 已取消
+)
+
+const (
+	// backfillLeaseTimeout 运行中的任务超过该时间未刷新检查点，视为执行进程已崩溃，可由其他进程接管
+	backfillLeaseTimeout = 5 * time.Minute
+	// backfillCheckpointInterval 保存检查点的最长间隔（同时每扫描 blockBatchSize 个区块保存一次）
+	backfillCheckpointInterval = 30 * time.Second
+
+	backfillJobColumns = `id, chain_id, from_block, to_block, next_block, status, error, created_by, created_at, updated_at, completed_at`
+
+	// claimableBackfillSQL 可被领取执行的任务：等待中，或运行中但租约已过期
+	claimableBackfillSQL = `(status = 'pending' OR (status = 'running' AND updated_at < NOW() - $1::integer * INTERVAL '1 second'))`
+)
+
+// BackfillJob 历史区块补扫任务
+type BackfillJob struct {
+	ID          string
+	ChainID     int
+	FromBlock   int64
+	ToBlock     int64
+	NextBlock   int64 // 检查点：下一个待扫描的区块号
+	Status      string
+	Error       *string
+	CreatedBy   *string // 创建任务的管理员，命令行创建时为空
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CompletedAt *time.Time
+}
+
+// ScannedBlocks 已扫描的区块数量
+func (j *BackfillJob) ScannedBlocks() int64 {
+	return j.NextBlock - j.FromBlock
+}
+
+// TotalBlocks 区间内的区块总数
+func (j *BackfillJob) TotalBlocks() int64 {
+	return j.ToBlock - j.FromBlock + 1
+}
+
+// BackfillFilter 补扫任务查询条件
+type BackfillFilter struct {
+	ChainID *int
+	Status  *string
+	Limit   int
+	Offset  int
+}
+
+// rowScanner 兼容 *sql.Row 和 *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// CreateBackfillJob 创建补扫任务，相同区间未完成的任务会被复用（失败的任务重置为等待状态，从检查点继续）
+func (s *service) CreateBackfillJob(ctx context.Context, chainID int, fromBlock, toBlock int64, createdBy *string) (*BackfillJob, error) {
+	if fromBlock < 0 || fromBlock > toBlock {
+		return nil, errors.New("invalid block range")
+	}
+
+	chainConfig, err := s.chainService.GetChain(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if !chainConfig.IsActive {
+		return nil, errors.New("chain is not active")
+	}
+
+	client, err := s.getOrCreateClient(ctx, chainID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get RPC client for chain_id=%d", chainID)
+	}
+
+	latestBlock, err := client.GetLatestBlockNumber(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get latest block number")
+	}
+	if big.NewInt(toBlock).Cmp(latestBlock) > 0 {
+		return nil, errors.New("invalid block range")
+	}
+
+	// 复用相同区间未完成的任务
+	job, err := scanBackfillJob(s.db.QueryRowContext(ctx, `
+		UPDATE backfill_jobs
+		SET status = CASE WHEN status = 'failed' THEN 'pending' ELSE status END,
+			error = CASE WHEN status = 'failed' THEN NULL ELSE error END,
+			updated_at = CASE WHEN status = 'failed' THEN NOW() ELSE updated_at END
+		WHERE id = (
+			SELECT id FROM backfill_jobs
+			WHERE chain_id = $1 AND from_block = $2 AND to_block = $3
+				AND status IN ('pending', 'running', 'failed')
+			ORDER BY created_at DESC
+			LIMIT 1
+		)
+		RETURNING `+backfillJobColumns,
+		chainID, fromBlock, toBlock))
+	if err == nil {
+		log.Info().
+			Str("job_id", job.ID).
+			Int("chain_id", chainID).
+			Int64("next_block", job.NextBlock).
+			Msg("Reusing existing backfill job")
+		return job, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to query existing backfill job")
+	}
+
+	job, err = scanBackfillJob(s.db.QueryRowContext(ctx, `
+		INSERT INTO backfill_jobs (chain_id, from_block, to_block, next_block, created_by)
+		VALUES ($1, $2, $3, $2, $4)
+		RETURNING `+backfillJobColumns,
+		chainID, fromBlock, toBlock, createdBy))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create backfill job")
+	}
+
+	log.Info().
+		Str("job_id", job.ID).
+		Int("chain_id", chainID).
+		Int64("from_block", fromBlock).
+		Int64("to_block", toBlock).
+		Msg("Backfill job created")
+
+	return job, nil
+}
+
+// BackfillRange 同步补扫指定区块区间，中断或失败后再次执行相同区间会从检查点继续
+func (s *service) BackfillRange(ctx context.Context, chainID int, fromBlock, toBlock int64) (*BackfillJob, error) {
+	job, err := s.CreateBackfillJob(ctx, chainID, fromBlock, toBlock, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err = scanBackfillJob(s.db.QueryRowContext(ctx, `
+		UPDATE backfill_jobs
+		SET status = 'running', updated_at = NOW()
+		WHERE id = $2 AND `+claimableBackfillSQL+`
+		RETURNING `+backfillJobColumns,
+		int64(backfillLeaseTimeout.Seconds()), job.ID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("backfill job is already running")
+		}
+		return nil, errors.Wrap(err, "failed to claim backfill job")
+	}
+
+	runErr := s.runBackfillJob(ctx, job)
+
+	// 即使 ctx 已取消也返回任务的最新状态
+	job, err = s.GetBackfillJob(context.WithoutCancel(ctx), job.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return job, runErr
+}
+
+// GetBackfillJob 获取补扫任务
+func (s *service) GetBackfillJob(ctx context.Context, jobID string) (*BackfillJob, error) {
+	job, err := scanBackfillJob(s.db.QueryRowContext(ctx, `
+		SELECT `+backfillJobColumns+`
+		FROM backfill_jobs
+		WHERE id = $1
+	`, jobID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("backfill job not found")
+		}
+		return nil, errors.Wrap(err, "failed to get backfill job")
+	}
+
+	return job, nil
+}
+
+// ListBackfillJobs 查询补扫任务（按创建时间倒序）
+func (s *service) ListBackfillJobs(ctx context.Context, filter *BackfillFilter) ([]*BackfillJob, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+backfillJobColumns+`
+		FROM backfill_jobs
+		WHERE ($1::integer IS NULL OR chain_id = $1)
+			AND ($2::text IS NULL OR status = $2)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3 OFFSET $4
+	`, filter.ChainID, filter.Status, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query backfill jobs")
+	}
+	defer rows.Close()
+
+	jobs := make([]*BackfillJob, 0)
+	for rows.Next() {
+		job, err := scanBackfillJob(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan backfill job")
+		}
+		jobs = append(jobs, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate backfill jobs")
+	}
+
+	return jobs, nil
+}
+
+// CancelBackfillJob 取消未完成的补扫任务，运行中的任务在下一个检查点停止
+func (s *service) CancelBackfillJob(ctx context.Context, jobID string) (*BackfillJob, error) {
+	job, err := scanBackfillJob(s.db.QueryRowContext(ctx, `
+		UPDATE backfill_jobs
+		SET status = 'cancelled', updated_at = NOW()
+		WHERE id = $1 AND status IN ('pending', 'running', 'failed')
+		RETURNING `+backfillJobColumns,
+		jobID))
+	if err == nil {
+		log.Info().Str("job_id", jobID).Msg("Backfill job cancelled")
+		return job, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to cancel backfill job")
+	}
+
+	// 区分任务不存在和任务已结束
+	if _, err := s.GetBackfillJob(ctx, jobID); err != nil {
+		return nil, err
+	}
+
+	return nil, errors.New("backfill job is already finished")
+}
+
+// StartBackfillWorker 启动补扫 worker，按创建顺序逐个执行等待中的任务，并接管租约过期（进程崩溃）的任务
+func (s *service) StartBackfillWorker(ctx context.Context, interval time.Duration) {
+	log.Info().
+		Dur("interval", interval).
+		Int("blocks_per_second", s.backfillBlocksPerSecond).
+		Msg("Starting backfill worker")
+
+	lifecycle.Go(ctx, "scan backfill worker", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Backfill worker stopped")
+				return
+			case <-ticker.C:
+				s.processBackfillJobs(ctx)
+			}
+		}
+	})
+}
+
+// processBackfillJobs 依次领取并执行可执行的任务，直到没有可执行的任务
+func (s *service) processBackfillJobs(ctx context.Context) {
+	for ctx.Err() == nil {
+		job, err := scanBackfillJob(s.db.QueryRowContext(ctx, `
+			UPDATE backfill_jobs
+			SET status = 'running', updated_at = NOW()
+			WHERE id = (
+				SELECT id FROM backfill_jobs
+				WHERE `+claimableBackfillSQL+`
+				ORDER BY created_at
+				LIMIT 1
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING `+backfillJobColumns,
+			int64(backfillLeaseTimeout.Seconds())))
+		if err != nil {
+			if !errors.Is(err, sql.ErrNoRows) {
+				log.Error().Err(err).Msg("Failed to claim backfill job")
+			}
+			return
+		}
+
+		if err := s.runBackfillJob(ctx, job); err != nil {
+			log.Error().
+				Str("job_id", job.ID).
+				Int("chain_id", job.ChainID).
+				Err(err).
+				Msg("Backfill job failed")
+		}
+	}
+}
+
+// runBackfillJob 从检查点开始扫描任务区间，定期保存检查点
+// ctx 取消时任务重置为等待状态，扫描失败时任务标记为失败，两种情况都保留检查点
+func (s *service) runBackfillJob(ctx context.Context, job *BackfillJob) error {
+	log.Info().
+		Str("job_id", job.ID).
+		Int("chain_id", job.ChainID).
+		Int64("from_block", job.FromBlock).
+		Int64("to_block", job.ToBlock).
+		Int64("next_block", job.NextBlock).
+		Msg("Running backfill job")
+
+	client, err := s.getOrCreateClient(ctx, job.ChainID)
+	if err != nil {
+		s.finishBackfillJob(ctx, job.ID, job.NextBlock, err)
+		return errors.Wrapf(err, "failed to get RPC client for chain_id=%d", job.ChainID)
+	}
+
+	chainConfig, err := s.chainService.GetChain(ctx, job.ChainID)
+	if err != nil {
+		s.finishBackfillJob(ctx, job.ID, job.NextBlock, err)
+		return errors.Wrapf(err, "failed to get chain config for chain_id=%d", job.ChainID)
+	}
+	settings := s.resolveScanSettings(chainConfig)
+
+	scanner := newChainScanner(s.db, client, s.depositService, s.withdrawStatusUpdater, job.ChainID, settings)
+
+	// 限速：每个区块等待一个 tick
+	var rateLimit <-chan time.Time
+	if s.backfillBlocksPerSecond > 0 {
+		ticker := time.NewTicker(time.Second / time.Duration(s.backfillBlocksPerSecond))
+		defer ticker.Stop()
+		rateLimit = ticker.C
+	}
+
+	var (
+		next             = job.NextBlock
+		lastCheckpointAt = time.Now()
+		sinceCheckpoint  = 0
+	)
+	for next <= job.ToBlock {
+		if rateLimit != nil {
+			select {
+			case <-ctx.Done():
+			case <-rateLimit:
+			}
+		}
+
+		if ctx.Err() != nil {
+			s.finishBackfillJob(ctx, job.ID, next, nil)
+			return ctx.Err()
+		}
+
+		if err := scanner.backfillBlock(ctx, big.NewInt(next)); err != nil {
+			if ctx.Err() != nil {
+				s.finishBackfillJob(ctx, job.ID, next, nil)
+				return ctx.Err()
+			}
+			s.finishBackfillJob(ctx, job.ID, next, err)
+			return errors.Wrapf(err, "failed to backfill block %d", next)
+		}
+
+		next++
+		sinceCheckpoint++
+		if next <= job.ToBlock && sinceCheckpoint < settings.BlockBatchSize && time.Since(lastCheckpointAt) < backfillCheckpointInterval {
+			continue
+		}
+
+		active, err := s.saveBackfillCheckpoint(ctx, job.ID, next)
+		if err != nil {
+			return err
+		}
+		if !active {
+			log.Info().
+				Str("job_id", job.ID).
+				Int64("next_block", next).
+				Msg("Backfill job is no longer running (cancelled), stopping")
+			return nil
+		}
+
+		lastCheckpointAt = time.Now()
+		sinceCheckpoint = 0
+
+		log.Debug().
+			Str("job_id", job.ID).
+			Int("chain_id", job.ChainID).
+			Int64("next_block", next).
+			Int64("to_block", job.ToBlock).
+			Msg("Backfill checkpoint saved")
+	}
+
+	if _, err := s.db.ExecContext(ctx, `
+		UPDATE backfill_jobs
+		SET status = 'completed', updated_at = NOW(), completed_at = NOW()
+		WHERE id = $1 AND status = 'running'
+	`, job.ID); err != nil {
+		return errors.Wrap(err, "failed to complete backfill job")
+	}
+
+	log.Info().
+		Str("job_id", job.ID).
+		Int("chain_id", job.ChainID).
+		Int64("from_block", job.FromBlock).
+		Int64("to_block", job.ToBlock).
+		Msg("Backfill job completed")
+
+	// 推进补扫到的交易的确认状态并生成 credits（实时扫描未运行时，例如命令行补扫）
+	latestBlock, err := client.GetLatestBlockNumber(ctx)
+	if err != nil {
+		log.Warn().Int("chain_id", job.ChainID).Err(err).Msg("Failed to get latest block number after backfill")
+		return nil
+	}
+	scanner.runPostScanHooks(ctx, latestBlock)
+
+	return nil
+}
+
+// saveBackfillCheckpoint 保存检查点并刷新租约，任务已不在运行状态（被取消）时返回 false
+func (s *service) saveBackfillCheckpoint(ctx context.Context, jobID string, nextBlock int64) (bool, error) {
+	res, err := s.db.ExecContext(ctx, `
+		UPDATE backfill_jobs
+		SET next_block = $2, updated_at = NOW()
+		WHERE id = $1 AND status = 'running'
+	`, jobID, nextBlock)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to save backfill checkpoint")
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get affected rows")
+	}
+
+	return affected > 0, nil
+}
+
+// finishBackfillJob 保存检查点并结束本次执行：scanErr 为空时重置为等待状态（被中断），否则标记为失败
+func (s *service) finishBackfillJob(ctx context.Context, jobID string, nextBlock int64, scanErr error) {
+	status := BackfillStatusPending
+	var errMsg *string
+	if scanErr != nil {
+		status = BackfillStatusFailed
+		msg := scanErr.Error()
+		errMsg = &msg
+	}
+
+	// ctx 可能已被取消，检查点仍需写入
+	if _, err := s.db.ExecContext(context.WithoutCancel(ctx), `
+		UPDATE backfill_jobs
+		SET status = $3, error = $4, next_block = $2, updated_at = NOW()
+		WHERE id = $1 AND status = 'running'
+	`, jobID, nextBlock, status, errMsg); err != nil {
+		log.Error().
+			Str("job_id", jobID).
+			Int64("next_block", nextBlock).
+			Err(err).
+			Msg("Failed to save backfill checkpoint")
+		return
+	}
+
+	log.Info().
+		Str("job_id", jobID).
+		Str("status", status).
+		Int64("next_block", nextBlock).
+		Msg("Backfill job stopped")
+}
+
+// scanBackfillJob 扫描一行补扫任务
+func scanBackfillJob(row rowScanner) (*BackfillJob, error) {
+	var (
+		job         BackfillJob
+		errMsg      sql.NullString
+		createdBy   sql.NullString
+		completedAt sql.NullTime
+	)
+
+	if err := row.Scan(
+		&job.ID,
+		&job.ChainID,
+		&job.FromBlock,
+		&job.ToBlock,
+		&job.NextBlock,
+		&job.Status,
+		&errMsg,
+		&createdBy,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+		&completedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if errMsg.Valid {
+		job.Error = &errMsg.String
+	}
+	if createdBy.Valid {
+		job.CreatedBy = &createdBy.String
+	}
+	if completedAt.Valid {
+		job.CompletedAt = &completedAt.Time
+	}
+
+	return &job, nil
+}
@@ -0,0 +1,186 @@
+package scan
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// defaultRPCBatchSize 未配置时每个 JSON-RPC 批量请求的最大请求数
+const defaultRPCBatchSize = 100
+
+// TokenBalanceQuery 批量查询 ERC20 余额的一项
+type TokenBalanceQuery struct {
+	Token   common.Address
+	Account common.Address
+}
+
+// BatchSize 每个 JSON-RPC 批量请求的最大请求数，0 表示逐个发送
+func (c *RPCClient) BatchSize() int {
+	return int(c.batchSize.Load())
+}
+
+// SetBatchSize 设置每个 JSON-RPC 批量请求的最大请求数，0 表示逐个发送（用于不支持批量请求的节点）
+func (c *RPCClient) SetBatchSize(size int) {
+	c.batchSize.Store(int64(max(size, 0)))
+}
+
+// BatchCall 按 BatchSize 分批发送请求，每项的结果和错误写入对应的 BatchElem
+// 分批依次发送，每批发送前检查 ctx：超过截止时间后不再发送，未发送的项以 ctx 的错误返回，
+// 已完成的项保留结果，调用方可只重试失败的项。只有获取不到可用节点时返回错误
+func (c *RPCClient) BatchCall(ctx context.Context, elems []rpc.BatchElem) error {
+	if len(elems) == 0 {
+		return nil
+	}
+
+	client, call, err := c.getClient(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get RPC client")
+	}
+	// 批量请求的耗时取决于请求数，不计入节点延迟
+	defer call.untimed().release()
+
+	rpcClient := client.Client()
+	batchSize := c.BatchSize()
+	for start := 0; start < len(elems); {
+		if err := ctx.Err(); err != nil {
+			for i := start; i < len(elems); i++ {
+				elems[i].Error = err
+			}
+			return nil
+		}
+
+		// 批量大小为 0 时逐个发送
+		if batchSize <= 0 {
+			elem := &elems[start]
+			elem.Error = rpcClient.CallContext(ctx, elem.Result, elem.Method, elem.Args...)
+			if elem.Error != nil {
+				call.recordError(elem.Method, elem.Error)
+			}
+			start++
+			continue
+		}
+
+		end := min(start+batchSize, len(elems))
+		batch := elems[start:end]
+		if err := rpcClient.BatchCallContext(ctx, batch); err != nil {
+			// 整批失败（连接错误、超时或节点不支持批量请求）
+			call.recordError("BatchCall", err)
+			for i := range batch {
+				batch[i].Error = err
+			}
+		} else {
+			for i := range batch {
+				if batch[i].Error != nil {
+					call.recordError(batch[i].Method, batch[i].Error)
+				}
+			}
+		}
+		start = end
+	}
+
+	return nil
+}
+
+// BatchTransactionReceipts 批量获取交易收据，receipts 和 errs 与 txHashes 一一对应
+// 收据不存在的交易返回 ethereum.NotFound
+func (c *RPCClient) BatchTransactionReceipts(ctx context.Context, txHashes []common.Hash) ([]*types.Receipt, []error, error) {
+	receipts := make([]*types.Receipt, len(txHashes))
+	elems := make([]rpc.BatchElem, len(txHashes))
+	for i, txHash := range txHashes {
+		elems[i] = rpc.BatchElem{
+			Method: "eth_getTransactionReceipt",
+			Args:   []interface{}{txHash},
+			Result: &receipts[i],
+		}
+	}
+
+	if err := c.BatchCall(ctx, elems); err != nil {
+		return nil, nil, err
+	}
+
+	errs := make([]error, len(txHashes))
+	for i := range elems {
+		switch {
+		case elems[i].Error != nil:
+			errs[i] = errors.Wrap(elems[i].Error, "failed to get transaction receipt")
+		case receipts[i] == nil:
+			errs[i] = errors.Wrap(ethereum.NotFound, "failed to get transaction receipt")
+		}
+	}
+
+	return receipts, errs, nil
+}
+
+// BatchBalances 批量获取地址在最新区块的原生代币余额，balances 和 errs 与 addresses 一一对应
+func (c *RPCClient) BatchBalances(ctx context.Context, addresses []common.Address) ([]*big.Int, []error, error) {
+	results := make([]hexutil.Big, len(addresses))
+	elems := make([]rpc.BatchElem, len(addresses))
+	for i, address := range addresses {
+		elems[i] = rpc.BatchElem{
+			Method: "eth_getBalance",
+			Args:   []interface{}{address, "latest"},
+			Result: &results[i],
+		}
+	}
+
+	if err := c.BatchCall(ctx, elems); err != nil {
+		return nil, nil, err
+	}
+
+	balances := make([]*big.Int, len(addresses))
+	errs := make([]error, len(addresses))
+	for i := range elems {
+		if elems[i].Error != nil {
+			errs[i] = errors.Wrap(elems[i].Error, "failed to get balance")
+			continue
+		}
+		balances[i] = results[i].ToInt()
+	}
+
+	return balances, errs, nil
+}
+
+// BatchTokenBalances 以批量 eth_call 获取 ERC20 余额，balances 和 errs 与 queries 一一对应
+func (c *RPCClient) BatchTokenBalances(ctx context.Context, queries []TokenBalanceQuery) ([]*big.Int, []error, error) {
+	const abiPaddedAddressLength = 32
+
+	results := make([]hexutil.Bytes, len(queries))
+	elems := make([]rpc.BatchElem, len(queries))
+	for i, query := range queries {
+		data := make([]byte, 0, len(balanceOfMethodID)+abiPaddedAddressLength)
+		data = append(data, balanceOfMethodID...)
+		data = append(data, common.LeftPadBytes(query.Account.Bytes(), abiPaddedAddressLength)...)
+
+		elems[i] = rpc.BatchElem{
+			Method: "eth_call",
+			Args: []interface{}{
+				map[string]interface{}{"to": query.Token, "data": hexutil.Bytes(data)},
+				"latest",
+			},
+			Result: &results[i],
+		}
+	}
+
+	if err := c.BatchCall(ctx, elems); err != nil {
+		return nil, nil, err
+	}
+
+	balances := make([]*big.Int, len(queries))
+	errs := make([]error, len(queries))
+	for i := range elems {
+		if elems[i].Error != nil {
+			errs[i] = errors.Wrap(elems[i].Error, "failed to call balanceOf")
+			continue
+		}
+		balances[i] = new(big.Int).SetBytes(results[i])
+	}
+
+	return balances, errs, nil
+}
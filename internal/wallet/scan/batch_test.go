@@ -0,0 +1,187 @@
+package scan
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testEthAPI 进程内 JSON-RPC 节点，只实现批量请求用到的方法
+type testEthAPI struct{}
+
+func (testEthAPI) ChainId() *hexutil.Big { //nolint:revive,stylecheck // JSON-RPC 方法名 eth_chainId
+	return (*hexutil.Big)(big.NewInt(1))
+}
+
+func (testEthAPI) GetTransactionReceipt(txHash common.Hash) *types.Receipt {
+	if txHash.Big().Int64()%2 == 0 {
+		return nil
+	}
+	return &types.Receipt{TxHash: txHash, Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{}}
+}
+
+func (testEthAPI) GetBalance(address common.Address, _ string) *hexutil.Big {
+	return (*hexutil.Big)(new(big.Int).SetBytes(address.Bytes()))
+}
+
+func (testEthAPI) Call(args map[string]interface{}, _ string) (hexutil.Bytes, error) {
+	if args["to"] == (common.Address{}).Hex() {
+		return nil, errors.New("execution reverted")
+	}
+	data, err := hexutil.Decode(args["data"].(string))
+	if err != nil {
+		return nil, err
+	}
+	// 返回 balanceOf 参数中的账户地址作为余额
+	return data[len(data)-common.AddressLength:], nil
+}
+
+func newTestBatchClient(t *testing.T, batchSize int) *RPCClient {
+	t.Helper()
+
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", testEthAPI{}))
+	t.Cleanup(server.Stop)
+
+	client := &RPCClient{
+		chainID:   1,
+		urls:      []string{"inproc"},
+		clients:   []*ethclient.Client{ethclient.NewClient(rpc.DialInProc(server))},
+		endpoints: newEndpointStatsList(1),
+		calls:     &sync.WaitGroup{},
+	}
+	client.SetBatchSize(batchSize)
+	t.Cleanup(client.Close)
+
+	return client
+}
+
+func TestBatchTransactionReceipts(t *testing.T) {
+	for _, batchSize := range []int{0, 2, 100} {
+		client := newTestBatchClient(t, batchSize)
+
+		txHashes := []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03")}
+		receipts, errs, err := client.BatchTransactionReceipts(context.Background(), txHashes)
+		require.NoError(t, err)
+
+		require.NoError(t, errs[0])
+		assert.Equal(t, txHashes[0], receipts[0].TxHash)
+		require.ErrorIs(t, errs[1], ethereum.NotFound, "batch size %d", batchSize)
+		assert.Nil(t, receipts[1])
+		require.NoError(t, errs[2])
+		assert.Equal(t, txHashes[2], receipts[2].TxHash)
+	}
+}
+
+func TestBatchBalances(t *testing.T) {
+	client := newTestBatchClient(t, 2)
+
+	addresses := []common.Address{common.HexToAddress("0x0a"), common.HexToAddress("0x0b"), common.HexToAddress("0x0c")}
+	balances, errs, err := client.BatchBalances(context.Background(), addresses)
+	require.NoError(t, err)
+	for i, address := range addresses {
+		require.NoError(t, errs[i])
+		assert.Equal(t, new(big.Int).SetBytes(address.Bytes()), balances[i])
+	}
+}
+
+func TestBatchTokenBalances(t *testing.T) {
+	client := newTestBatchClient(t, 100)
+
+	queries := []TokenBalanceQuery{
+		{Token: common.HexToAddress("0x01"), Account: common.HexToAddress("0x0a")},
+		{Token: common.Address{}, Account: common.HexToAddress("0x0b")},
+	}
+	balances, errs, err := client.BatchTokenBalances(context.Background(), queries)
+	require.NoError(t, err)
+	require.NoError(t, errs[0])
+	assert.Equal(t, big.NewInt(0x0a), balances[0])
+	require.Error(t, errs[1])
+	assert.Nil(t, balances[1])
+}
+
+func TestBatchCallStopsAtDeadline(t *testing.T) {
+	client := newTestBatchClient(t, 1)
+
+	// 第一批完成时取消 ctx，后续批次不再发送
+	ctx, cancel := context.WithCancel(context.Background())
+	elems := []rpc.BatchElem{
+		{Method: "eth_getBalance", Args: []interface{}{common.HexToAddress("0x0a"), "latest"}, Result: &cancelOnDecode{cancel: cancel}},
+		{Method: "eth_getBalance", Args: []interface{}{common.HexToAddress("0x0b"), "latest"}, Result: new(hexutil.Big)},
+	}
+
+	require.NoError(t, client.BatchCall(ctx, elems))
+	require.NoError(t, elems[0].Error)
+	require.ErrorIs(t, elems[1].Error, context.Canceled)
+}
+
+// cancelOnDecode 解码结果时取消 ctx，模拟第一批完成后到达截止时间
+type cancelOnDecode struct {
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnDecode) UnmarshalJSON([]byte) error {
+	c.cancel()
+	return nil
+}
+
+func TestFetchReceiptsInBatches(t *testing.T) {
+	txHashes := make([]common.Hash, 7)
+	for i := range txHashes {
+		txHashes[i] = common.BigToHash(big.NewInt(int64(i + 1)))
+	}
+
+	var mu sync.Mutex
+	var batchSizes []int
+	fetch := func(_ context.Context, hashes []common.Hash) ([]*types.Receipt, []error, error) {
+		mu.Lock()
+		batchSizes = append(batchSizes, len(hashes))
+		mu.Unlock()
+
+		receipts := make([]*types.Receipt, len(hashes))
+		for i, txHash := range hashes {
+			receipts[i] = &types.Receipt{TxHash: txHash}
+		}
+		return receipts, make([]error, len(hashes)), nil
+	}
+
+	receipts, err := fetchReceiptsInBatches(context.Background(), big.NewInt(100), txHashes, 3, 2, fetch)
+	require.NoError(t, err)
+	for i, receipt := range receipts {
+		assert.Equal(t, txHashes[i], receipt.TxHash)
+	}
+	assert.ElementsMatch(t, []int{3, 3, 1}, batchSizes)
+}
+
+func TestFetchReceiptsInBatchesFailedBatch(t *testing.T) {
+	txHashes := []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03")}
+	fetch := func(_ context.Context, hashes []common.Hash) ([]*types.Receipt, []error, error) {
+		if hashes[0] == txHashes[2] {
+			return nil, nil, errors.New("batch too large")
+		}
+		receipts := make([]*types.Receipt, len(hashes))
+		errs := make([]error, len(hashes))
+		for i, txHash := range hashes {
+			receipts[i] = &types.Receipt{TxHash: txHash}
+		}
+		return receipts, errs, nil
+	}
+
+	receipts, err := fetchReceiptsInBatches(context.Background(), big.NewInt(100), txHashes, 2, 1, fetch)
+	assert.Nil(t, receipts)
+
+	var blockErr *blockReceiptError
+	require.ErrorAs(t, err, &blockErr)
+	assert.Equal(t, []common.Hash{txHashes[2]}, blockErr.Failed)
+}
@@ -0,0 +1,574 @@
+package scan
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	walletMetrics "github/chapool/go-wallet/internal/metrics/wallet"
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/bitcoin"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/deposit"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// bitcoinScanner 比特币链的扫描器，按区块高度扫描 BTC 充值并维护派生地址的 UTXO
+// 转入用户地址的输出记录为充值交易（与其他链一致，每笔交易只记录第一个用户地址的充值，金额为该地址的输出之和）；
+// 转入用户地址和热钱包的输出记录到 utxos 表供提现使用，花费这些输出的交易（本系统的提现）不产生充值记录。
+// 父区块哈希不一致时回溯到共同祖先，回滚之后的区块和 UTXO 并从共同祖先的下一个区块重新扫描
+type bitcoinScanner struct {
+	db                    *sql.DB
+	client                *bitcoin.Client
+	depositService        deposit.Service
+	withdrawStatusUpdater WithdrawStatusUpdater
+	chainID               int
+	settings              atomic.Pointer[ScanSettings] // 运行中可由管理员调整
+	stopCh                chan struct{}
+}
+
+// bitcoinOutput 区块中转入派生地址的输出
+type bitcoinOutput struct {
+	txID    string
+	vout    uint32
+	address string
+	amount  int64
+	script  string
+}
+
+// newBitcoinScanner 创建比特币链扫描器
+func newBitcoinScanner(db *sql.DB, client *bitcoin.Client, depositService deposit.Service, withdrawStatusUpdater WithdrawStatusUpdater, chainID int, settings ScanSettings) *bitcoinScanner {
+	scanner := &bitcoinScanner{
+		db:                    db,
+		client:                client,
+		depositService:        depositService,
+		withdrawStatusUpdater: withdrawStatusUpdater,
+		chainID:               chainID,
+		stopCh:                make(chan struct{}),
+	}
+	scanner.settings.Store(&settings)
+	return scanner
+}
+
+// currentSettings 当前生效的扫描参数
+func (s *bitcoinScanner) currentSettings() ScanSettings {
+	return *s.settings.Load()
+}
+
+// setSettings 更新扫描参数，下一轮扫描生效，返回参数是否变化
+func (s *bitcoinScanner) setSettings(settings ScanSettings) bool {
+	return *s.settings.Swap(&settings) != settings
+}
+
+// stop 停止扫描循环，只能调用一次（调用方从扫描器列表中移除后调用）
+func (s *bitcoinScanner) stop() {
+	close(s.stopCh)
+}
+
+// start 启动扫描循环
+func (s *bitcoinScanner) start(ctx context.Context) error {
+	log.Info().Int("chain_id", s.chainID).Msg("Starting bitcoin block scanner")
+
+	startHeight, err := s.getStartHeight(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get start height")
+	}
+
+	lifecycle.Go(ctx, fmt.Sprintf("bitcoin block scanner %d", s.chainID), func() {
+		s.scanLoop(ctx, startHeight)
+	})
+
+	return nil
+}
+
+// getStartHeight 获取起始扫描高度：已扫描的最大高度的下一个，没有记录时从最新区块开始
+func (s *bitcoinScanner) getStartHeight(ctx context.Context) (int64, error) {
+	var maxHeight sql.NullInt64
+	err := s.db.QueryRowContext(ctx, `
+		SELECT MAX(number)
+		FROM blocks
+		WHERE chain_id = $1 AND status != 'orphaned'
+	`, s.chainID).Scan(&maxHeight)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, errors.Wrap(err, "failed to query max height")
+	}
+
+	if maxHeight.Valid {
+		return maxHeight.Int64 + 1, nil
+	}
+
+	latestHeight, err := s.client.GetBlockCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	log.Info().
+		Int("chain_id", s.chainID).
+		Int64("start_height", latestHeight).
+		Msg("No previous blocks found, starting from latest block")
+
+	return latestHeight, nil
+}
+
+// scanLoop 扫描循环，每轮最多扫描 blockBatchSize 个区块，落后时立即继续
+// 扫描参数在每轮开始时读取，调整扫描间隔后重置 ticker
+func (s *bitcoinScanner) scanLoop(ctx context.Context, startHeight int64) {
+	currentHeight := startHeight
+	scanInterval := s.currentSettings().ScanInterval
+	ticker := time.NewTicker(scanInterval)
+	defer ticker.Stop()
+
+	scanOnce := func() bool {
+		latestHeight, err := s.client.GetBlockCount(ctx)
+		if err != nil {
+			log.Error().
+				Int("chain_id", s.chainID).
+				Err(err).
+				Msg("Failed to get latest block height")
+			return false
+		}
+
+		blockBatchSize := int64(max(s.currentSettings().BlockBatchSize, 1))
+		endHeight := min(latestHeight, currentHeight+blockBatchSize-1)
+		for currentHeight <= endHeight {
+			next, err := s.scanBlock(ctx, currentHeight)
+			if err != nil {
+				log.Error().
+					Int("chain_id", s.chainID).
+					Int64("height", currentHeight).
+					Err(err).
+					Msg("Failed to scan block")
+				break
+			}
+			currentHeight = next
+		}
+
+		recordScanProgress(s.chainID, latestHeight, currentHeight)
+		runPostScanHooks(ctx, s.chainID, s.depositService, s.withdrawStatusUpdater, big.NewInt(latestHeight))
+
+		return currentHeight <= latestHeight
+	}
+
+	scanOnce()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Int("chain_id", s.chainID).Msg("Bitcoin block scanner stopped by context")
+			return
+		case <-s.stopCh:
+			log.Info().Int("chain_id", s.chainID).Msg("Bitcoin block scanner stopped")
+			return
+		case <-ticker.C:
+			if interval := s.currentSettings().ScanInterval; interval != scanInterval {
+				scanInterval = interval
+				ticker.Reset(scanInterval)
+			}
+
+			// 落后于最新区块时连续扫描，直到追上
+			for scanOnce() {
+				select {
+				case <-ctx.Done():
+					return
+				case <-s.stopCh:
+					return
+				case <-time.After(rapidRescanDelay):
+				}
+			}
+		}
+	}
+}
+
+// scanBlock 扫描单个区块，返回下一个待扫描的高度；发生重组时返回共同祖先的下一个高度
+func (s *bitcoinScanner) scanBlock(ctx context.Context, height int64) (int64, error) {
+	block, err := s.client.GetBlockByHeight(ctx, height)
+	if err != nil {
+		return height, err
+	}
+
+	stored, err := models.Blocks(
+		models.BlockWhere.ChainID.EQ(s.chainID),
+		models.BlockWhere.Number.EQ(height),
+		models.BlockWhere.Status.NEQ(models.BlockStatusOrphaned),
+	).One(ctx, s.db)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return height, errors.Wrap(err, "failed to check block existence")
+	}
+	if stored != nil && stored.Hash == block.Hash {
+		return height + 1, nil
+	}
+
+	// 已扫描的同高度区块或父区块不在规范链上时回滚
+	parent, err := models.Blocks(
+		models.BlockWhere.ChainID.EQ(s.chainID),
+		models.BlockWhere.Number.EQ(height-1),
+		models.BlockWhere.Status.NEQ(models.BlockStatusOrphaned),
+	).One(ctx, s.db)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return height, errors.Wrap(err, "failed to get parent block")
+	}
+	if stored != nil || (parent != nil && parent.Hash != block.PreviousBlockHash) {
+		log.Warn().
+			Int("chain_id", s.chainID).
+			Int64("height", height).
+			Str("previous_block_hash", block.PreviousBlockHash).
+			Msg("Bitcoin block reorg detected")
+
+		ancestor, err := s.findCommonAncestor(ctx, height)
+		if err != nil {
+			return height, errors.Wrap(err, "failed to find common ancestor")
+		}
+		if err := s.rollback(ctx, ancestor); err != nil {
+			return height, errors.Wrap(err, "failed to handle reorg")
+		}
+		return ancestor + 1, nil
+	}
+
+	if err := s.indexBlock(ctx, block); err != nil {
+		return height, err
+	}
+
+	return height + 1, nil
+}
+
+// findCommonAncestor 从 fromHeight 开始逐块向前比较本地区块与规范链区块的哈希，返回共同祖先的高度
+func (s *bitcoinScanner) findCommonAncestor(ctx context.Context, fromHeight int64) (int64, error) {
+	height := fromHeight
+	for ; height >= 0 && fromHeight-height < maxReorgDepth; height-- {
+		stored, err := models.Blocks(
+			models.BlockWhere.ChainID.EQ(s.chainID),
+			models.BlockWhere.Number.EQ(height),
+			models.BlockWhere.Status.NEQ(models.BlockStatusOrphaned),
+		).One(ctx, s.db)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				// 更早的区块未扫描过或已回滚，无需再比较
+				if height < fromHeight {
+					break
+				}
+				continue
+			}
+			return 0, errors.Wrapf(err, "failed to get block %d", height)
+		}
+
+		canonicalHash, err := s.client.GetBlockHash(ctx, height)
+		if err != nil {
+			return 0, err
+		}
+		if stored.Hash == canonicalHash {
+			break
+		}
+	}
+
+	if fromHeight-height >= maxReorgDepth {
+		log.Error().
+			Int("chain_id", s.chainID).
+			Int64("from_height", fromHeight).
+			Int("max_reorg_depth", maxReorgDepth).
+			Msg("No common ancestor found within max reorg depth, rolling back to max depth")
+	}
+
+	return height, nil
+}
+
+// rollback 回滚 ancestor 之后的区块、交易和 credits，撤销这些区块中产生和花费的 UTXO
+// 被花费的 UTXO 恢复为花费前的状态（提现交易回到内存池，仍保持锁定），区块中产生的未花费输出删除，重新扫描时再次记录
+func (s *bitcoinScanner) rollback(ctx context.Context, ancestor int64) error {
+	if err := newReorgDetector(s.db, nil, s.chainID).handleReorg(ctx, ancestor); err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE utxos SET
+			status = CASE WHEN withdraw_id IS NULL THEN 'unspent' ELSE 'locked' END,
+			spent_tx_hash = CASE WHEN withdraw_id IS NULL THEN NULL ELSE spent_tx_hash END,
+			spent_block_no = NULL,
+			spent_block_hash = NULL,
+			updated_at = NOW()
+		WHERE chain_id = $1 AND spent_block_no > $2
+	`, s.chainID, ancestor); err != nil {
+		return errors.Wrap(err, "failed to restore spent utxos")
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM utxos
+		WHERE chain_id = $1 AND block_no > $2 AND status = 'unspent'
+	`, s.chainID, ancestor); err != nil {
+		return errors.Wrap(err, "failed to delete orphaned utxos")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+// indexBlock 在一个事务中保存区块、标记被花费的 UTXO、记录新的 UTXO 和充值交易
+func (s *bitcoinScanner) indexBlock(ctx context.Context, block *bitcoin.Block) error {
+	outputs, walletTypes, err := s.findOutputs(ctx, block)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	blockModel := &models.Block{
+		Hash:       block.Hash,
+		ChainID:    s.chainID,
+		ParentHash: block.PreviousBlockHash,
+		Number:     block.Height,
+		Timestamp:  block.Time,
+		Status:     models.BlockStatusConfirmed,
+	}
+	if err := blockModel.Insert(ctx, tx, boil.Infer()); err != nil {
+		return errors.Wrap(err, "failed to save block")
+	}
+
+	spendingTxs, err := s.markSpent(ctx, tx, block)
+	if err != nil {
+		return err
+	}
+
+	utxoCount := 0
+	for _, output := range outputs {
+		// 观察地址和冷钱包没有可用的派生私钥，不记录 UTXO
+		if walletType := walletTypes[output.address]; walletType != models.WalletTypeUser && walletType != models.WalletTypeHot {
+			continue
+		}
+		utxoCount++
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO utxos (chain_id, tx_hash, vout, address, amount, script_pub_key, block_no, block_hash)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT (chain_id, tx_hash, vout) DO UPDATE SET
+				block_no = EXCLUDED.block_no,
+				block_hash = EXCLUDED.block_hash,
+				updated_at = NOW()
+		`, s.chainID, output.txID, output.vout, output.address, output.amount, output.script, block.Height, block.Hash); err != nil {
+			return errors.Wrapf(err, "failed to save utxo %s:%d", output.txID, output.vout)
+		}
+	}
+
+	deposits := s.buildDeposits(outputs, walletTypes, spendingTxs, block)
+	inserted := make([]*models.Transaction, 0, len(deposits))
+	for _, transaction := range deposits {
+		exists, err := models.Transactions(
+			models.TransactionWhere.ChainID.EQ(s.chainID),
+			models.TransactionWhere.TXHash.EQ(transaction.TXHash),
+		).Exists(ctx, tx)
+		if err != nil {
+			return errors.Wrap(err, "failed to check transaction existence")
+		}
+		if exists {
+			continue
+		}
+		if err := transaction.Insert(ctx, tx, boil.Infer()); err != nil {
+			return errors.Wrapf(err, "failed to insert deposit transaction %s", transaction.TXHash)
+		}
+		inserted = append(inserted, transaction)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit block")
+	}
+
+	// 区块重组中被回滚的充值重新打包进规范链时恢复原记录
+	for _, transaction := range deposits {
+		if _, err := reviveReorgedTransaction(ctx, s.db, s.chainID, transaction.TXHash, block.Height, block.Hash); err != nil {
+			return errors.Wrap(err, "failed to revive reorged transaction")
+		}
+	}
+
+	walletMetrics.BlocksScanned.WithLabelValues(walletMetrics.ChainLabel(s.chainID)).Inc()
+	for _, transaction := range inserted {
+		walletMetrics.DepositsDetected.WithLabelValues(walletMetrics.ChainLabel(s.chainID), walletMetrics.AssetNative).Inc()
+
+		log.Info().
+			Int("chain_id", s.chainID).
+			Str("tx_hash", transaction.TXHash).
+			Str("to_addr", transaction.ToAddr).
+			Str("amount", transaction.Amount).
+			Msg("Bitcoin deposit detected")
+	}
+
+	log.Debug().
+		Int("chain_id", s.chainID).
+		Int64("height", block.Height).
+		Int("tx_count", len(block.Transactions)).
+		Int("utxo_count", utxoCount).
+		Int("deposit_count", len(inserted)).
+		Msg("Bitcoin block scanned successfully")
+
+	return nil
+}
+
+// findOutputs 查找区块中转入钱包地址的输出，返回输出及其地址的钱包类型
+func (s *bitcoinScanner) findOutputs(ctx context.Context, block *bitcoin.Block) ([]*bitcoinOutput, map[string]models.WalletType, error) {
+	addresses := make([]string, 0)
+	for _, transaction := range block.Transactions {
+		for _, output := range transaction.Outputs {
+			if output.ScriptPubKey.Address != "" {
+				addresses = append(addresses, chain.NormalizeAddress(chain.TypeBitcoin, output.ScriptPubKey.Address))
+			}
+		}
+	}
+	if len(addresses) == 0 {
+		return nil, nil, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+chain.WalletAddressKeySQL+`, wallet_type FROM wallets WHERE chain_id = $1 AND `+chain.WalletAddressKeySQL+` = ANY($2)
+	`, s.chainID, pq.Array(addresses))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to check wallet addresses")
+	}
+	defer rows.Close()
+
+	walletTypes := make(map[string]models.WalletType)
+	for rows.Next() {
+		var (
+			address    string
+			walletType models.WalletType
+		)
+		if err := rows.Scan(&address, &walletType); err != nil {
+			return nil, nil, errors.Wrap(err, "failed to scan wallet address")
+		}
+		walletTypes[address] = walletType
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to check wallet addresses")
+	}
+
+	outputs := make([]*bitcoinOutput, 0)
+	for _, transaction := range block.Transactions {
+		for _, output := range transaction.Outputs {
+			address := chain.NormalizeAddress(chain.TypeBitcoin, output.ScriptPubKey.Address)
+			if _, ok := walletTypes[address]; !ok || output.Value <= 0 {
+				continue
+			}
+			outputs = append(outputs, &bitcoinOutput{
+				txID:    transaction.TxID,
+				vout:    output.N,
+				address: address,
+				amount:  int64(output.Value),
+				script:  output.ScriptPubKey.Hex,
+			})
+		}
+	}
+
+	return outputs, walletTypes, nil
+}
+
+// markSpent 将区块中交易花费的 UTXO 标记为 spent，返回花费了派生地址 UTXO 的交易
+func (s *bitcoinScanner) markSpent(ctx context.Context, tx *sql.Tx, block *bitcoin.Block) (map[string]bool, error) {
+	var (
+		prevTxIDs    []string
+		prevVouts    []int64
+		spendingTxs  []string
+		spendingByID = make(map[string]bool)
+	)
+	for _, transaction := range block.Transactions {
+		for _, input := range transaction.Inputs {
+			if input.Coinbase != "" || input.TxID == "" {
+				continue
+			}
+			prevTxIDs = append(prevTxIDs, input.TxID)
+			prevVouts = append(prevVouts, int64(input.Vout))
+			spendingTxs = append(spendingTxs, transaction.TxID)
+		}
+	}
+	if len(prevTxIDs) == 0 {
+		return spendingByID, nil
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		UPDATE utxos u SET
+			status = 'spent',
+			spent_tx_hash = i.spent_tx_hash,
+			spent_block_no = $5,
+			spent_block_hash = $6,
+			updated_at = NOW()
+		FROM unnest($2::text[], $3::integer[], $4::text[]) AS i (tx_hash, vout, spent_tx_hash)
+		WHERE u.chain_id = $1 AND u.tx_hash = i.tx_hash AND u.vout = i.vout
+		RETURNING u.spent_tx_hash
+	`, s.chainID, pq.Array(prevTxIDs), pq.Array(prevVouts), pq.Array(spendingTxs), block.Height, block.Hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to mark spent utxos")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var txID string
+		if err := rows.Scan(&txID); err != nil {
+			return nil, errors.Wrap(err, "failed to scan spent utxo")
+		}
+		spendingByID[txID] = true
+	}
+
+	return spendingByID, rows.Err()
+}
+
+// buildDeposits 构建充值交易记录：每笔交易取第一个用户地址（或观察地址），金额为该地址在交易中的输出之和
+// 花费了派生地址 UTXO 的交易是本系统发出的提现，其找零和转入其他用户地址的输出不作为充值
+func (s *bitcoinScanner) buildDeposits(outputs []*bitcoinOutput, walletTypes map[string]models.WalletType, spendingTxs map[string]bool, block *bitcoin.Block) []*models.Transaction {
+	fromAddresses := make(map[string]string, len(block.Transactions))
+	for _, transaction := range block.Transactions {
+		fromAddresses[transaction.TxID] = transaction.FromAddress()
+	}
+
+	deposits := make([]*models.Transaction, 0)
+	byTxID := make(map[string]*models.Transaction)
+	amounts := make(map[string]int64)
+	for _, output := range outputs {
+		if spendingTxs[output.txID] {
+			continue
+		}
+		walletType := walletTypes[output.address]
+		if walletType != models.WalletTypeUser && walletType != models.WalletTypeWatch {
+			continue
+		}
+
+		transaction, ok := byTxID[output.txID]
+		if !ok {
+			transaction = &models.Transaction{
+				ChainID:           s.chainID,
+				BlockHash:         block.Hash,
+				BlockNo:           block.Height,
+				TXHash:            output.txID,
+				FromAddr:          fromAddresses[output.txID],
+				ToAddr:            output.address,
+				Type:              models.TransactionTypeDeposit,
+				Status:            models.TransactionStatusConfirmed,
+				ConfirmationCount: null.IntFrom(0),
+			}
+			byTxID[output.txID] = transaction
+			deposits = append(deposits, transaction)
+		}
+		if transaction.ToAddr == output.address {
+			amounts[output.txID] += output.amount
+			transaction.Amount = strconv.FormatInt(amounts[output.txID], 10)
+		}
+	}
+
+	return deposits
+}
@@ -0,0 +1,103 @@
+package scan
+
+import (
+	"context"
+
+	walletMetrics "github/chapool/go-wallet/internal/metrics/wallet"
+	"github/chapool/go-wallet/internal/wallet/alert"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// verifyChainID 校验新建 RPC 客户端的链 ID
+// 不一致时记录到本进程（不再重试）和 chain_id_mismatches 表并告警，一致时清除之前的记录
+func (s *service) verifyChainID(ctx context.Context, client *RPCClient) error {
+	chainLabel := walletMetrics.ChainLabel(client.chainID)
+
+	err := client.VerifyChainID(ctx)
+	if err == nil {
+		walletMetrics.ChainIDMismatch.WithLabelValues(chainLabel).Set(0)
+		s.clearChainIDMismatch(ctx, client.chainID)
+		return nil
+	}
+
+	var mismatch *ChainIDMismatchError
+	if !errors.As(err, &mismatch) {
+		return err
+	}
+
+	s.clientsMu.Lock()
+	s.chainIDMismatches[client.chainID] = mismatch
+	s.clientsMu.Unlock()
+
+	walletMetrics.ChainIDMismatch.WithLabelValues(chainLabel).Set(1)
+
+	log.Error().
+		Int("chain_id", mismatch.ChainID).
+		Int("rpc_endpoint", mismatch.Endpoint).
+		Str("reported_chain_id", mismatch.ReportedChainID.String()).
+		Msg("RPC endpoint reports a different chain ID, chain will not be served")
+
+	inserted, recordErr := s.recordChainIDMismatch(ctx, mismatch)
+	if recordErr != nil {
+		log.Error().Err(recordErr).Int("chain_id", mismatch.ChainID).Msg("Failed to record chain ID mismatch")
+	}
+
+	// 同一错误配置只在首次发现时告警（多个服务实例或重启时不重复告警）
+	if inserted || recordErr != nil {
+		s.notify(ctx, &alert.Alert{
+			Type:     alert.TypeChainIDMismatch,
+			Severity: alert.SeverityCritical,
+			ChainID:  mismatch.ChainID,
+			Message:  "RPC endpoint reports a different chain ID, chain is not served until the RPC configuration is fixed",
+			Fields: map[string]any{
+				"rpc_endpoint":       mismatch.Endpoint,
+				"rpc_endpoint_count": client.EndpointCount(),
+				"reported_chain_id":  mismatch.ReportedChainID.String(),
+			},
+		})
+	}
+
+	return mismatch
+}
+
+// recordChainIDMismatch 记录链 ID 校验失败，返回是否为新发现的错误配置
+func (s *service) recordChainIDMismatch(ctx context.Context, mismatch *ChainIDMismatchError) (bool, error) {
+	var inserted bool
+	err := s.db.QueryRowContext(ctx, `
+		INSERT INTO chain_id_mismatches (chain_id, rpc_endpoint, reported_chain_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (chain_id) DO UPDATE
+		SET rpc_endpoint = EXCLUDED.rpc_endpoint, reported_chain_id = EXCLUDED.reported_chain_id
+		RETURNING (xmax = 0)
+	`, mismatch.ChainID, mismatch.Endpoint, mismatch.ReportedChainID.String()).Scan(&inserted)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to insert chain ID mismatch")
+	}
+
+	return inserted, nil
+}
+
+// clearChainIDMismatch 链 ID 校验通过后删除之前的失败记录
+func (s *service) clearChainIDMismatch(ctx context.Context, chainID int) {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM chain_id_mismatches WHERE chain_id = $1`, chainID)
+	if err != nil {
+		log.Error().Err(err).Int("chain_id", chainID).Msg("Failed to clear chain ID mismatch")
+		return
+	}
+
+	if affected, err := res.RowsAffected(); err == nil && affected > 0 {
+		log.Info().Int("chain_id", chainID).Msg("RPC chain ID verified, chain ID mismatch cleared")
+	}
+}
+
+// notify 发送告警，未配置通知时只写日志
+func (s *service) notify(ctx context.Context, a *alert.Alert) {
+	notifier := s.notifier
+	if notifier == nil {
+		notifier = alert.NewNotifier("", nil, nil)
+	}
+
+	_ = notifier.Notify(ctx, a)
+}
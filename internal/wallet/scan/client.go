@@ -2,10 +2,16 @@ package scan
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"slices"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	walletMetrics "github/chapool/go-wallet/internal/metrics/wallet"
+
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -16,16 +22,96 @@ import (
 
 var balanceOfMethodID = common.Hex2Bytes("70a08231")
 
-// RPCClient 封装以太坊 RPC 客户端，支持多个 URL 和故障转移
+// allowanceMethodID is the selector of ERC20 allowance(address,address)
+var allowanceMethodID = common.Hex2Bytes("dd62ed3e")
+
+// RPCClient 封装以太坊 RPC 客户端，支持多个 URL、负载均衡和熔断
+// 每个节点记录调用延迟和错误率，连续失败的节点熔断一段时间后再探测（见 endpointStats）；
+// 扫描、交易查询和广播使用主节点，主节点熔断时故障转移到下一个可用节点，
+// 余额、合约调用和 gas 估算等只读调用按延迟和错误率加权分摊到熔断器关闭的节点
+// 链配置变化时 RPC 节点在运行时替换，持有 RPCClient 的扫描器和业务服务无需重新创建
 type RPCClient struct {
-	urls    []string
-	clients []*ethclient.Client
-	mu      sync.RWMutex
-	current int // 当前使用的客户端索引
+	chainID   int
+	urls      []string
+	clients   []*ethclient.Client
+	endpoints []*endpointStats
+	mu        sync.RWMutex
+	current   int // 主节点索引
+	// calls 使用当前这组连接的进行中调用，替换节点后等待旧连接上的调用完成再关闭旧连接
+	calls *sync.WaitGroup
+	// 最近一次使用、最近一次获得可用节点的时间和所有节点开始不可用的时间（UnixNano，可用时为 0），
+	// 用于回收长期未使用或持续不可用的客户端（见 collectChainClients）
+	lastUsedAt    atomic.Int64
+	lastSuccessAt atomic.Int64
+	failingSince  atomic.Int64
+	// batchSize 每个 JSON-RPC 批量请求的最大请求数（见 BatchCall）
+	batchSize atomic.Int64
 }
 
 // NewRPCClient 创建新的 RPC 客户端
-func NewRPCClient(urls []string) (*RPCClient, error) {
+func NewRPCClient(chainID int, urls []string) (*RPCClient, error) {
+	clients, err := dialClients(urls)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &RPCClient{
+		chainID:   chainID,
+		urls:      urls,
+		clients:   clients,
+		endpoints: newEndpointStatsList(len(urls)),
+		current:   0,
+		calls:     &sync.WaitGroup{},
+	}
+	now := time.Now().UnixNano()
+	client.lastUsedAt.Store(now)
+	client.lastSuccessAt.Store(now)
+	client.batchSize.Store(defaultRPCBatchSize)
+
+	return client, nil
+}
+
+// replaceEndpoints 用 next 的 RPC 节点替换当前节点，next 之后不再单独使用
+// 替换后新调用立即使用新节点，旧连接在进行中的调用完成后关闭（WebSocket 订阅随旧连接关闭，订阅方会重新订阅）
+func (c *RPCClient) replaceEndpoints(next *RPCClient) {
+	c.mu.Lock()
+	oldClients, oldCalls := c.clients, c.calls
+	c.urls, c.clients, c.endpoints, c.current, c.calls = next.urls, next.clients, next.endpoints, 0, next.calls
+	c.mu.Unlock()
+	// 新节点已通过链 ID 校验
+	c.failingSince.Store(0)
+
+	go func() {
+		oldCalls.Wait()
+		for _, client := range oldClients {
+			if client != nil {
+				client.Close()
+			}
+		}
+		log.Debug().Int("chain_id", c.chainID).Msg("Closed RPC connections replaced by reload")
+	}()
+}
+
+// URLs 返回当前的 RPC URL 列表
+func (c *RPCClient) URLs() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return slices.Clone(c.urls)
+}
+
+// newEndpointStatsList 为每个节点创建熔断器关闭的统计
+func newEndpointStatsList(n int) []*endpointStats {
+	endpoints := make([]*endpointStats, n)
+	for i := range endpoints {
+		endpoints[i] = newEndpointStats()
+	}
+
+	return endpoints
+}
+
+// dialClients 连接所有 RPC 节点，连接失败的节点为 nil，使用时再重试
+func dialClients(urls []string) ([]*ethclient.Client, error) {
 	if len(urls) == 0 {
 		return nil, errors.New("at least one RPC URL is required")
 	}
@@ -49,11 +135,59 @@ func NewRPCClient(urls []string) (*RPCClient, error) {
 		return nil, errors.New("failed to connect to any RPC node")
 	}
 
-	return &RPCClient{
-		urls:    urls,
-		clients: clients,
-		current: 0,
-	}, nil
+	return clients, nil
+}
+
+// ChainIDMismatchError RPC 节点返回的链 ID（eth_chainId）与配置的链 ID 不一致
+type ChainIDMismatchError struct {
+	ChainID         int      // 配置的链 ID
+	ReportedChainID *big.Int // RPC 节点返回的链 ID
+	Endpoint        int      // RPC 节点索引（不暴露可能包含 API Key 的 URL）
+}
+
+func (e *ChainIDMismatchError) Error() string {
+	return fmt.Sprintf("RPC endpoint %d of chain %d reports chain ID %s", e.Endpoint, e.ChainID, e.ReportedChainID)
+}
+
+// VerifyChainID 检查所有已连接的 RPC 节点返回的链 ID 与配置一致
+// 暂时无法访问的节点跳过，重新连接后在 getClient 中检查
+func (c *RPCClient) VerifyChainID(ctx context.Context) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for idx, client := range c.clients {
+		if client == nil {
+			continue
+		}
+
+		if err := c.checkChainID(ctx, idx, client); err != nil {
+			var mismatch *ChainIDMismatchError
+			if errors.As(err, &mismatch) {
+				return mismatch
+			}
+			log.Warn().
+				Int("chain_id", c.chainID).
+				Int("rpc_endpoint", idx).
+				Err(err).
+				Msg("Failed to verify chain ID of RPC endpoint, will verify on use")
+		}
+	}
+
+	return nil
+}
+
+// checkChainID 获取节点的链 ID 并与配置比较，链 ID 不一致时返回 *ChainIDMismatchError
+func (c *RPCClient) checkChainID(ctx context.Context, idx int, client *ethclient.Client) error {
+	reported, err := client.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if reported.Cmp(big.NewInt(int64(c.chainID))) != 0 {
+		return &ChainIDMismatchError{ChainID: c.chainID, ReportedChainID: reported, Endpoint: idx}
+	}
+
+	return nil
 }
 
 // allClientsNil 检查所有客户端是否都是 nil
@@ -66,6 +200,18 @@ func allClientsNil(clients []*ethclient.Client) bool {
 	return true
 }
 
+// closeWhenIdle 在进行中的调用完成后关闭所有客户端连接
+func (c *RPCClient) closeWhenIdle() {
+	c.mu.RLock()
+	calls := c.calls
+	c.mu.RUnlock()
+
+	go func() {
+		calls.Wait()
+		c.Close()
+	}()
+}
+
 // Close 关闭所有客户端连接
 func (c *RPCClient) Close() {
 	c.mu.Lock()
@@ -80,13 +226,15 @@ func (c *RPCClient) Close() {
 
 // GetLatestBlockNumber 获取最新区块号
 func (c *RPCClient) GetLatestBlockNumber(ctx context.Context) (*big.Int, error) {
-	client, err := c.getClient(ctx)
+	client, call, err := c.getClient(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get RPC client")
 	}
+	defer call.release()
 
 	blockNumber, err := client.BlockNumber(ctx)
 	if err != nil {
+		call.recordError("BlockNumber", err)
 		return nil, errors.Wrap(err, "failed to get latest block number")
 	}
 
@@ -101,13 +249,15 @@ func (c *RPCClient) GetLatestBlockNumber(ctx context.Context) (*big.Int, error)
 
 // GetBlockByNumber 根据区块号获取区块
 func (c *RPCClient) GetBlockByNumber(ctx context.Context, blockNumber *big.Int) (*types.Block, error) {
-	client, err := c.getClient(ctx)
+	client, call, err := c.getClient(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get RPC client")
 	}
+	defer call.release()
 
 	block, err := client.BlockByNumber(ctx, blockNumber)
 	if err != nil {
+		call.recordError("BlockByNumber", err)
 		return nil, errors.Wrap(err, "failed to get block by number")
 	}
 
@@ -116,28 +266,49 @@ func (c *RPCClient) GetBlockByNumber(ctx context.Context, blockNumber *big.Int)
 
 // GetTransactionReceipt 获取交易回执
 func (c *RPCClient) GetTransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
-	client, err := c.getClient(ctx)
+	client, call, err := c.getClient(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get RPC client")
 	}
+	defer call.release()
 
 	receipt, err := client.TransactionReceipt(ctx, txHash)
 	if err != nil {
+		call.recordError("TransactionReceipt", err)
 		return nil, errors.Wrap(err, "failed to get transaction receipt")
 	}
 
 	return receipt, nil
 }
 
+// GetTransactionByHash 获取交易，isPending 表示交易仍在交易池中未打包
+func (c *RPCClient) GetTransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+	client, call, err := c.getClient(ctx)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to get RPC client")
+	}
+	defer call.release()
+
+	tx, isPending, err := client.TransactionByHash(ctx, txHash)
+	if err != nil {
+		call.recordError("TransactionByHash", err)
+		return nil, false, errors.Wrap(err, "failed to get transaction")
+	}
+
+	return tx, isPending, nil
+}
+
 // GetChainID 获取链 ID
 func (c *RPCClient) GetChainID(ctx context.Context) (*big.Int, error) {
-	client, err := c.getClient(ctx)
+	client, call, err := c.getReadClient(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get RPC client")
 	}
+	defer call.release()
 
 	chainID, err := client.ChainID(ctx)
 	if err != nil {
+		call.recordError("ChainID", err)
 		return nil, errors.Wrap(err, "failed to get chain ID")
 	}
 
@@ -146,13 +317,15 @@ func (c *RPCClient) GetChainID(ctx context.Context) (*big.Int, error) {
 
 // FilterLogs 过滤日志（用于 ERC20 转账事件）
 func (c *RPCClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
-	client, err := c.getClient(ctx)
+	client, call, err := c.getClient(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get RPC client")
 	}
+	defer call.release()
 
 	logs, err := client.FilterLogs(ctx, query)
 	if err != nil {
+		call.recordError("FilterLogs", err)
 		return nil, errors.Wrap(err, "failed to filter logs")
 	}
 
@@ -161,12 +334,14 @@ func (c *RPCClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery)
 
 // SendTransaction 发送已签名的交易
 func (c *RPCClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
-	client, err := c.getClient(ctx)
+	client, call, err := c.getClient(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get RPC client")
 	}
+	defer call.release()
 
 	if err := client.SendTransaction(ctx, tx); err != nil {
+		call.recordError("SendTransaction", err)
 		return errors.Wrap(err, "failed to send transaction")
 	}
 
@@ -175,28 +350,49 @@ func (c *RPCClient) SendTransaction(ctx context.Context, tx *types.Transaction)
 
 // SuggestGasTipCap 建议 Gas 小费上限 (EIP-1559)
 func (c *RPCClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
-	client, err := c.getClient(ctx)
+	client, call, err := c.getReadClient(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get RPC client")
 	}
+	defer call.release()
 
 	tipCap, err := client.SuggestGasTipCap(ctx)
 	if err != nil {
+		call.recordError("SuggestGasTipCap", err)
 		return nil, errors.Wrap(err, "failed to suggest gas tip cap")
 	}
 
 	return tipCap, nil
 }
 
+// SuggestGasPrice 建议 Gas 价格 (legacy 交易)
+func (c *RPCClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	client, call, err := c.getReadClient(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get RPC client")
+	}
+	defer call.release()
+
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		call.recordError("SuggestGasPrice", err)
+		return nil, errors.Wrap(err, "failed to suggest gas price")
+	}
+
+	return gasPrice, nil
+}
+
 // EstimateGas 估算 Gas 用量
 func (c *RPCClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
-	client, err := c.getClient(ctx)
+	client, call, err := c.getReadClient(ctx)
 	if err != nil {
 		return 0, errors.Wrap(err, "failed to get RPC client")
 	}
+	defer call.release()
 
 	gas, err := client.EstimateGas(ctx, msg)
 	if err != nil {
+		call.recordError("EstimateGas", err)
 		return 0, errors.Wrap(err, "failed to estimate gas")
 	}
 
@@ -205,13 +401,15 @@ func (c *RPCClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint
 
 // BalanceAt returns the balance of an address at the latest known block.
 func (c *RPCClient) BalanceAt(ctx context.Context, address common.Address) (*big.Int, error) {
-	client, err := c.getClient(ctx)
+	client, call, err := c.getReadClient(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get RPC client")
 	}
+	defer call.release()
 
 	balance, err := client.BalanceAt(ctx, address, nil)
 	if err != nil {
+		call.recordError("BalanceAt", err)
 		return nil, errors.Wrap(err, "failed to get balance")
 	}
 
@@ -220,25 +418,56 @@ func (c *RPCClient) BalanceAt(ctx context.Context, address common.Address) (*big
 
 // PendingNonceAt returns the pending nonce for the given address.
 func (c *RPCClient) PendingNonceAt(ctx context.Context, address common.Address) (uint64, error) {
-	client, err := c.getClient(ctx)
+	client, call, err := c.getClient(ctx)
 	if err != nil {
 		return 0, errors.Wrap(err, "failed to get RPC client")
 	}
+	defer call.release()
 
 	nonce, err := client.PendingNonceAt(ctx, address)
 	if err != nil {
+		call.recordError("PendingNonceAt", err)
 		return 0, errors.Wrap(err, "failed to get pending nonce")
 	}
 
 	return nonce, nil
 }
 
+// NonceAt returns the nonce of the given address at the latest block, i.e. the number of mined transactions.
+func (c *RPCClient) NonceAt(ctx context.Context, address common.Address) (uint64, error) {
+	client, call, err := c.getClient(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get RPC client")
+	}
+	defer call.release()
+
+	nonce, err := client.NonceAt(ctx, address, nil)
+	if err != nil {
+		call.recordError("NonceAt", err)
+		return 0, errors.Wrap(err, "failed to get nonce")
+	}
+
+	return nonce, nil
+}
+
 // TokenBalance returns the ERC20 token balance for the given account.
 func (c *RPCClient) TokenBalance(ctx context.Context, tokenAddress, account common.Address) (*big.Int, error) {
-	client, err := c.getClient(ctx)
+	return c.TokenBalanceAt(ctx, tokenAddress, account, nil)
+}
+
+// TokenBalanceAt returns the ERC20 token balance for the given account at the given block (nil = latest).
+// Balances of older blocks require a node that keeps historical state.
+func (c *RPCClient) TokenBalanceAt(ctx context.Context, tokenAddress, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	// 历史区块的余额只查询主节点（需要保留历史状态的节点），最新余额分摊到所有可用节点
+	getClient := c.getClient
+	if blockNumber == nil {
+		getClient = c.getReadClient
+	}
+	client, call, err := getClient(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get RPC client")
 	}
+	defer call.release()
 
 	if len(balanceOfMethodID) == 0 {
 		return nil, errors.New("balanceOf method ID is not configured")
@@ -254,8 +483,9 @@ func (c *RPCClient) TokenBalance(ctx context.Context, tokenAddress, account comm
 		Data: data,
 	}
 
-	resp, err := client.CallContract(ctx, callMsg, nil)
+	resp, err := client.CallContract(ctx, callMsg, blockNumber)
 	if err != nil {
+		call.recordError("CallContract", err)
 		return nil, errors.Wrap(err, "failed to call balanceOf")
 	}
 
@@ -263,56 +493,380 @@ func (c *RPCClient) TokenBalance(ctx context.Context, tokenAddress, account comm
 	return balance, nil
 }
 
-// getClient 获取当前可用的客户端，如果失败则尝试下一个
-func (c *RPCClient) getClient(ctx context.Context) (*ethclient.Client, error) {
+// TokenAllowance returns the ERC20 amount spender is allowed to transfer from owner.
+func (c *RPCClient) TokenAllowance(ctx context.Context, tokenAddress, owner, spender common.Address) (*big.Int, error) {
+	client, call, err := c.getReadClient(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get RPC client")
+	}
+	defer call.release()
+
+	const abiPaddedAddressLength = 32
+	data := make([]byte, 0, len(allowanceMethodID)+2*abiPaddedAddressLength)
+	data = append(data, allowanceMethodID...)
+	data = append(data, common.LeftPadBytes(owner.Bytes(), abiPaddedAddressLength)...)
+	data = append(data, common.LeftPadBytes(spender.Bytes(), abiPaddedAddressLength)...)
+
+	callMsg := ethereum.CallMsg{
+		To:   &tokenAddress,
+		Data: data,
+	}
+
+	resp, err := client.CallContract(ctx, callMsg, nil)
+	if err != nil {
+		call.recordError("CallContract", err)
+		return nil, errors.Wrap(err, "failed to call allowance")
+	}
+
+	return new(big.Int).SetBytes(resp), nil
+}
+
+// CodeAt returns the contract code of the given account at the latest known block.
+func (c *RPCClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	client, call, err := c.getReadClient(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get RPC client")
+	}
+	defer call.release()
+
+	code, err := client.CodeAt(ctx, account, blockNumber)
+	if err != nil {
+		call.recordError("CodeAt", err)
+		return nil, errors.Wrap(err, "failed to get contract code")
+	}
+
+	return code, nil
+}
+
+// CallContract executes a read-only message call against the latest known block.
+func (c *RPCClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	// 指定区块的调用只查询主节点，最新区块的调用分摊到所有可用节点
+	getClient := c.getClient
+	if blockNumber == nil {
+		getClient = c.getReadClient
+	}
+	client, call, err := getClient(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get RPC client")
+	}
+	defer call.release()
+
+	resp, err := client.CallContract(ctx, msg, blockNumber)
+	if err != nil {
+		call.recordError("CallContract", err)
+		return nil, errors.Wrap(err, "failed to call contract")
+	}
+
+	return resp, nil
+}
+
+// EndpointCount 返回配置的 RPC 节点数量
+func (c *RPCClient) EndpointCount() int {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	// 尝试从当前索引开始
-	for i := 0; i < len(c.clients); i++ {
-		idx := (c.current + i) % len(c.clients)
-		client := c.clients[idx]
+	return len(c.urls)
+}
 
-		if client != nil {
-			// 简单健康检查：尝试获取链 ID
-			_, err := client.ChainID(ctx)
-			if err == nil {
-				// 更新当前索引
-				if idx != c.current {
-					c.mu.RUnlock()
-					c.mu.Lock()
-					c.current = idx
-					c.mu.Unlock()
-					c.mu.RLock()
-				}
-				return client, nil
-			}
+// CurrentEndpoint 返回当前使用的 RPC 节点索引
+func (c *RPCClient) CurrentEndpoint() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.current
+}
+
+// RotateEndpoint 切换到下一个 RPC 节点，返回新的节点索引
+// 用于排除健康检查正常但数据不再更新的节点（如同步卡住）
+func (c *RPCClient) RotateEndpoint() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.current = (c.current + 1) % len(c.urls)
+	c.recordFailover()
+	return c.current
+}
+
+// HasWebSocketEndpoint 判断 RPC URL 列表中是否包含 WebSocket 地址
+func (c *RPCClient) HasWebSocketEndpoint() bool {
+	for _, url := range c.URLs() {
+		if isWebSocketURL(url) {
+			return true
+		}
+	}
+	return false
+}
 
-			// 连接失败，尝试重新连接
+// SubscribeNewHeads 通过 WebSocket 订阅新区块头（eth_subscribe newHeads）
+// 依次尝试所有 ws:// / wss:// 地址，全部失败时返回错误，调用方应回退到轮询
+func (c *RPCClient) SubscribeNewHeads(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	for idx, url := range c.URLs() {
+		if !isWebSocketURL(url) {
+			continue
+		}
+
+		client, err := c.getOrDialClient(idx, url)
+		if err != nil {
 			log.Warn().
-				Str("url", c.urls[idx]).
+				Str("url", url).
 				Err(err).
-				Msg("RPC client health check failed, will try to reconnect")
+				Msg("Failed to connect to WebSocket RPC node")
+			continue
 		}
 
-		// 尝试重新连接
-		c.mu.RUnlock()
-		c.mu.Lock()
-		if c.clients[idx] == nil {
-			client, err := ethclient.Dial(c.urls[idx])
-			if err == nil {
-				c.clients[idx] = client
+		sub, err := client.SubscribeNewHead(ctx, ch)
+		if err != nil {
+			log.Warn().
+				Str("url", url).
+				Err(err).
+				Msg("Failed to subscribe to new heads")
+			continue
+		}
+
+		return sub, nil
+	}
+
+	return nil, errors.New("no WebSocket RPC endpoint available for new heads subscription")
+}
+
+// getOrDialClient 获取指定索引的客户端，未连接时尝试重新连接
+// url 为调用方读取的节点地址，期间 URL 列表被替换时返回错误
+func (c *RPCClient) getOrDialClient(idx int, url string) (*ethclient.Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if idx >= len(c.urls) || c.urls[idx] != url {
+		return nil, errEndpointsReloaded
+	}
+	if c.clients[idx] != nil {
+		return c.clients[idx], nil
+	}
+
+	client, err := ethclient.Dial(c.urls[idx])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial RPC node")
+	}
+	c.clients[idx] = client
+
+	return client, nil
+}
+
+// isWebSocketURL 判断 URL 是否为 WebSocket 地址
+func isWebSocketURL(url string) bool {
+	lower := strings.ToLower(url)
+	return strings.HasPrefix(lower, "ws://") || strings.HasPrefix(lower, "wss://")
+}
+
+// getClient 获取主节点的客户端，主节点熔断或无法连接时按顺序故障转移到下一个可用节点并设为主节点
+// 用于需要同一节点视图的调用（扫描区块、交易查询、模拟和广播）
+// 调用方使用完客户端后必须调用 call.release，替换节点后等待所有 release 才关闭旧连接
+func (c *RPCClient) getClient(ctx context.Context) (*ethclient.Client, *endpointCall, error) {
+	c.lastUsedAt.Store(time.Now().UnixNano())
+
+	c.mu.RLock()
+	primary, count, calls := c.current, len(c.clients), c.calls
+	c.mu.RUnlock()
+
+	for i := 0; i < count; i++ {
+		idx := (primary + i) % count
+		client, call, err := c.useEndpoint(ctx, idx)
+		if err != nil {
+			continue
+		}
+
+		if idx != primary {
+			c.recordFailover()
+			c.mu.Lock()
+			// 期间节点列表被替换时不更新
+			if c.calls == calls {
 				c.current = idx
-				c.mu.Unlock()
-				c.mu.RLock()
-				return client, nil
+			}
+			c.mu.Unlock()
+			log.Warn().
+				Int("chain_id", c.chainID).
+				Int("from_rpc_endpoint", primary).
+				Int("rpc_endpoint", idx).
+				Msg("Primary RPC endpoint unavailable, switched to another endpoint")
+		}
+		c.recordAvailable()
+		return client, call, nil
+	}
+
+	c.failingSince.CompareAndSwap(0, time.Now().UnixNano())
+
+	return nil, nil, errors.New("all RPC clients are unavailable")
+}
+
+// getReadClient 按权重在熔断器关闭且已连接的节点间分摊只读调用，延迟越低、错误率越低的节点分到的调用越多
+// 只有一个可用节点或选中的节点不可用时使用 getClient
+func (c *RPCClient) getReadClient(ctx context.Context) (*ethclient.Client, *endpointCall, error) {
+	c.mu.RLock()
+	candidates := make([]int, 0, len(c.clients))
+	weights := make([]float64, 0, len(c.clients))
+	for idx, client := range c.clients {
+		if client != nil && c.endpoints[idx].closed() {
+			candidates = append(candidates, idx)
+			weights = append(weights, c.endpoints[idx].weight())
+		}
+	}
+	c.mu.RUnlock()
+
+	if len(candidates) > 1 {
+		if idx := pickWeighted(candidates, weights); idx >= 0 {
+			if client, call, err := c.useEndpoint(ctx, idx); err == nil {
+				c.lastUsedAt.Store(time.Now().UnixNano())
+				c.recordAvailable()
+				return client, call, nil
 			}
 		}
-		c.mu.Unlock()
-		c.mu.RLock()
 	}
 
-	return nil, errors.New("all RPC clients are unavailable")
+	return c.getClient(ctx)
+}
+
+// useEndpoint 获取指定节点的客户端：熔断中的节点返回 errCircuitOpen；
+// 未连接的节点和熔断冷却结束的节点先（重新）连接并检查链 ID，结果计入熔断统计，链 ID 与配置不一致的节点不使用
+func (c *RPCClient) useEndpoint(ctx context.Context, idx int) (*ethclient.Client, *endpointCall, error) {
+	c.mu.RLock()
+	if idx >= len(c.clients) {
+		c.mu.RUnlock()
+		return nil, nil, errEndpointsReloaded
+	}
+	client, url, stats, calls := c.clients[idx], c.urls[idx], c.endpoints[idx], c.calls
+	c.mu.RUnlock()
+
+	allowed, probe := stats.allow(time.Now())
+	if !allowed {
+		return nil, nil, errCircuitOpen
+	}
+
+	if client == nil || probe {
+		connected, err := c.connectEndpoint(ctx, idx, url, client, calls)
+		c.recordEndpointResult(idx, stats, 0, err)
+		if err != nil {
+			c.recordError("HealthCheck", err)
+			log.Warn().
+				Int("chain_id", c.chainID).
+				Int("rpc_endpoint", idx).
+				Err(err).
+				Msg("RPC endpoint health check failed")
+			return nil, nil, err
+		}
+		client = connected
+	}
+
+	return c.acquireCall(idx, client, stats, calls)
+}
+
+// connectEndpoint 检查节点的链 ID，节点未连接时先连接，新连接在节点列表未被替换时保存
+func (c *RPCClient) connectEndpoint(ctx context.Context, idx int, url string, client *ethclient.Client, calls *sync.WaitGroup) (*ethclient.Client, error) {
+	if client != nil {
+		if err := c.checkChainID(ctx, idx, client); err != nil {
+			return nil, err
+		}
+		return client, nil
+	}
+
+	dialed, err := ethclient.DialContext(ctx, url)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial RPC node")
+	}
+	// 新连接的节点同样需要返回配置的链 ID，失败时保持未连接，下次再试
+	if err := c.checkChainID(ctx, idx, dialed); err != nil {
+		dialed.Close()
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.calls != calls {
+		dialed.Close()
+		return nil, errEndpointsReloaded
+	}
+	if existing := c.clients[idx]; existing != nil {
+		// 其他调用已重新连接
+		dialed.Close()
+		return existing, nil
+	}
+	c.clients[idx] = dialed
+
+	return dialed, nil
+}
+
+// acquireCall 登记一个使用节点连接的调用，期间节点列表被替换时返回错误
+func (c *RPCClient) acquireCall(idx int, client *ethclient.Client, stats *endpointStats, calls *sync.WaitGroup) (*ethclient.Client, *endpointCall, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.calls != calls {
+		return nil, nil, errEndpointsReloaded
+	}
+	calls.Add(1)
+
+	return client, &endpointCall{
+		parent:   c,
+		endpoint: idx,
+		stats:    stats,
+		calls:    calls,
+		start:    time.Now(),
+	}, nil
+}
+
+// recordAvailable 记录获得可用节点
+func (c *RPCClient) recordAvailable() {
+	c.lastSuccessAt.Store(time.Now().UnixNano())
+	c.failingSince.Store(0)
+}
+
+// RPCClientHealth RPC 客户端的使用和可用状态
+type RPCClientHealth struct {
+	LastUsedAt    time.Time
+	LastSuccessAt time.Time
+	FailingSince  *time.Time           // 所有节点均不可用的开始时间，可用时为 nil
+	Endpoints     []*RPCEndpointHealth // 每个节点的负载均衡和熔断状态，按节点索引排序
+}
+
+// Health 返回客户端的使用和可用状态
+func (c *RPCClient) Health() RPCClientHealth {
+	health := RPCClientHealth{
+		LastUsedAt:    time.Unix(0, c.lastUsedAt.Load()),
+		LastSuccessAt: time.Unix(0, c.lastSuccessAt.Load()),
+		Endpoints:     c.EndpointHealth(),
+	}
+	if failingSince := c.failingSince.Load(); failingSince != 0 {
+		t := time.Unix(0, failingSince)
+		health.FailingSince = &t
+	}
+
+	return health
+}
+
+// EndpointHealth 返回每个节点的负载均衡和熔断状态，按节点索引排序
+func (c *RPCClient) EndpointHealth() []*RPCEndpointHealth {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	endpoints := make([]*RPCEndpointHealth, 0, len(c.endpoints))
+	for idx, stats := range c.endpoints {
+		endpoints = append(endpoints, stats.snapshot(idx, c.clients[idx] != nil, idx == c.current))
+	}
+
+	return endpoints
+}
+
+// recordError 记录 RPC 调用失败（交易/收据不存在不计入）
+func (c *RPCClient) recordError(method string, err error) {
+	if errors.Is(err, ethereum.NotFound) {
+		return
+	}
+
+	walletMetrics.RPCErrors.WithLabelValues(walletMetrics.ChainLabel(c.chainID), method).Inc()
+}
+
+// recordFailover 记录切换 RPC 节点
+func (c *RPCClient) recordFailover() {
+	walletMetrics.RPCFailovers.WithLabelValues(walletMetrics.ChainLabel(c.chainID)).Inc()
 }
 
 // WithTimeout 为操作添加超时控制
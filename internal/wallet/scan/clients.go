@@ -0,0 +1,258 @@
+package scan
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/chain"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// clientIdleTTL 未被扫描器持有的 RPC 客户端超过该时间未使用时关闭并移除，下次使用时重新创建
+	clientIdleTTL = time.Hour
+	// deadClientEvictAfter 未被扫描器持有的 RPC 客户端所有节点持续不可用超过该时间时关闭并移除，
+	// 下次使用时重新连接并校验链 ID
+	deadClientEvictAfter = 10 * time.Minute
+)
+
+// collectChainClients 按最新的链配置回收 RPC 客户端，使客户端缓存只包含启用中的链：
+// 链被删除、停用或链类型变化时停止该链的扫描器并关闭其所有客户端；
+// 未被扫描器持有的 EVM 客户端长期未使用或持续不可用时关闭并移除；
+// 多链扫描已启动时，为没有运行扫描器的启用链（例如重新启用的链）启动扫描
+func (s *service) collectChainClients(ctx context.Context) {
+	chains, err := s.chainService.ListChains(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list chains for RPC client collection")
+		return
+	}
+
+	configs := make(map[int]*models.Chain, len(chains))
+	for _, chainConfig := range chains {
+		configs[chainConfig.ChainID] = chainConfig
+	}
+
+	s.clientsMu.RLock()
+	chainIDs := make([]int, 0, len(s.clients)+len(s.solanaClients)+len(s.bitcoinClients))
+	for chainID := range s.clients {
+		chainIDs = append(chainIDs, chainID)
+	}
+	for chainID := range s.solanaClients {
+		chainIDs = append(chainIDs, chainID)
+	}
+	for chainID := range s.bitcoinClients {
+		chainIDs = append(chainIDs, chainID)
+	}
+	s.clientsMu.RUnlock()
+
+	slices.Sort(chainIDs)
+	now := time.Now()
+	for _, chainID := range slices.Compact(chainIDs) {
+		chainConfig, exists := configs[chainID]
+		switch {
+		case !exists:
+			s.releaseChain(chainID, "chain deleted")
+		case !chainConfig.IsActive:
+			s.releaseChain(chainID, "chain deactivated")
+		case s.chainTypeOfClients(chainID) != chainConfig.ChainType:
+			s.releaseChain(chainID, "chain type changed")
+		default:
+			s.evictStaleClient(chainID, now)
+		}
+	}
+
+	if !s.scanStarted.Load() {
+		return
+	}
+	for _, chainConfig := range chains {
+		if chainConfig.IsActive && !s.hasScanner(chainConfig.ChainID) && !s.hasChainIDMismatch(chainConfig.ChainID) {
+			if err := s.StartChainScan(ctx, chainConfig.ChainID); err != nil {
+				log.Error().Err(err).Int("chain_id", chainConfig.ChainID).Msg("Failed to resume chain scan")
+			}
+		}
+	}
+}
+
+// chainTypeOfClients 返回缓存中该链客户端的链类型，没有客户端时为空
+func (s *service) chainTypeOfClients(chainID int) string {
+	s.clientsMu.RLock()
+	defer s.clientsMu.RUnlock()
+
+	switch {
+	case s.clients[chainID] != nil:
+		return chain.TypeEVM
+	case s.solanaClients[chainID] != nil:
+		return chain.TypeSolana
+	case s.bitcoinClients[chainID] != nil:
+		return chain.TypeBitcoin
+	default:
+		return ""
+	}
+}
+
+// hasChainIDMismatch 该链的 RPC 节点链 ID 是否与配置不一致（配置变更前不再创建客户端）
+func (s *service) hasChainIDMismatch(chainID int) bool {
+	s.clientsMu.RLock()
+	defer s.clientsMu.RUnlock()
+
+	_, mismatch := s.chainIDMismatches[chainID]
+	return mismatch
+}
+
+// hasScanner 本进程是否运行了该链的扫描器
+func (s *service) hasScanner(chainID int) bool {
+	s.scannersMu.RLock()
+	defer s.scannersMu.RUnlock()
+
+	_, exists := s.scanners[chainID]
+	_, solExists := s.solanaScanners[chainID]
+	_, btcExists := s.bitcoinScanners[chainID]
+
+	return exists || solExists || btcExists
+}
+
+// releaseChain 停止该链的扫描器，关闭并移除该链的所有 RPC 客户端（EVM 客户端在进行中的调用完成后关闭）
+func (s *service) releaseChain(chainID int, reason string) {
+	s.scannersMu.Lock()
+	scanner := s.scanners[chainID]
+	solScanner := s.solanaScanners[chainID]
+	btcScanner := s.bitcoinScanners[chainID]
+	delete(s.scanners, chainID)
+	delete(s.solanaScanners, chainID)
+	delete(s.bitcoinScanners, chainID)
+	s.scannersMu.Unlock()
+
+	if scanner != nil {
+		scanner.stop()
+	}
+	if solScanner != nil {
+		solScanner.stop()
+	}
+	if btcScanner != nil {
+		btcScanner.stop()
+	}
+
+	s.clientsMu.Lock()
+	client := s.clients[chainID]
+	solanaClient := s.solanaClients[chainID]
+	bitcoinClient := s.bitcoinClients[chainID]
+	delete(s.clients, chainID)
+	delete(s.solanaClients, chainID)
+	delete(s.bitcoinClients, chainID)
+	s.clientsMu.Unlock()
+
+	if client != nil {
+		client.closeWhenIdle()
+	}
+	if solanaClient != nil {
+		solanaClient.Close()
+	}
+	if bitcoinClient != nil {
+		bitcoinClient.Close()
+	}
+
+	log.Info().
+		Int("chain_id", chainID).
+		Str("reason", reason).
+		Bool("scanner_stopped", scanner != nil || solScanner != nil || btcScanner != nil).
+		Msg("Released chain scanner and RPC clients")
+}
+
+// evictStaleClient 关闭并移除未被扫描器持有、长期未使用或持续不可用的 EVM 客户端
+// 扫描器持有的客户端不回收，扫描器会持续重连其节点
+func (s *service) evictStaleClient(chainID int, now time.Time) {
+	s.scannersMu.RLock()
+	_, scanned := s.scanners[chainID]
+	s.scannersMu.RUnlock()
+	if scanned {
+		return
+	}
+
+	s.clientsMu.Lock()
+	client := s.clients[chainID]
+	if client == nil {
+		s.clientsMu.Unlock()
+		return
+	}
+
+	health := client.Health()
+	var reason string
+	switch {
+	case health.FailingSince != nil && now.Sub(*health.FailingSince) > deadClientEvictAfter:
+		reason = "all RPC endpoints unavailable"
+	case now.Sub(health.LastUsedAt) > clientIdleTTL:
+		reason = "idle"
+	default:
+		s.clientsMu.Unlock()
+		return
+	}
+	delete(s.clients, chainID)
+	s.clientsMu.Unlock()
+
+	client.closeWhenIdle()
+
+	log.Info().
+		Int("chain_id", chainID).
+		Str("reason", reason).
+		Time("last_used_at", health.LastUsedAt).
+		Time("last_success_at", health.LastSuccessAt).
+		Msg("Evicted RPC client")
+}
+
+// GetRPCClientStates 返回本进程缓存的 RPC 客户端，按链 ID 排序
+func (s *service) GetRPCClientStates() []*RPCClientState {
+	s.scannersMu.RLock()
+	scanned := make(map[int]bool, len(s.scanners)+len(s.solanaScanners)+len(s.bitcoinScanners))
+	for chainID := range s.scanners {
+		scanned[chainID] = true
+	}
+	for chainID := range s.solanaScanners {
+		scanned[chainID] = true
+	}
+	for chainID := range s.bitcoinScanners {
+		scanned[chainID] = true
+	}
+	s.scannersMu.RUnlock()
+
+	s.clientsMu.RLock()
+	states := make([]*RPCClientState, 0, len(s.clients)+len(s.solanaClients)+len(s.bitcoinClients))
+	for chainID, client := range s.clients {
+		health := client.Health()
+		states = append(states, &RPCClientState{
+			ChainID:          chainID,
+			ChainType:        chain.TypeEVM,
+			RPCEndpoint:      client.CurrentEndpoint(),
+			RPCEndpointCount: client.EndpointCount(),
+			ScannerRunning:   scanned[chainID],
+			Health:           &health,
+		})
+	}
+	for chainID, client := range s.solanaClients {
+		states = append(states, &RPCClientState{
+			ChainID:          chainID,
+			ChainType:        chain.TypeSolana,
+			RPCEndpoint:      client.CurrentEndpoint(),
+			RPCEndpointCount: client.EndpointCount(),
+			ScannerRunning:   scanned[chainID],
+		})
+	}
+	for chainID, client := range s.bitcoinClients {
+		states = append(states, &RPCClientState{
+			ChainID:          chainID,
+			ChainType:        chain.TypeBitcoin,
+			RPCEndpoint:      client.CurrentEndpoint(),
+			RPCEndpointCount: client.EndpointCount(),
+			ScannerRunning:   scanned[chainID],
+		})
+	}
+	s.clientsMu.RUnlock()
+
+	slices.SortFunc(states, func(a, b *RPCClientState) int {
+		return a.ChainID - b.ChainID
+	})
+
+	return states
+}
@@ -0,0 +1,168 @@
+package scan
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// tokenBalanceReader 查询指定区块的 ERC20 余额
+type tokenBalanceReader interface {
+	TokenBalanceAt(ctx context.Context, tokenAddress, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// receivedKey 区块内某个代币转入某个地址
+type receivedKey struct {
+	token   common.Address
+	account common.Address
+}
+
+// receivedTotals 区块内转入地址的事件金额合计与实际到账金额
+type receivedTotals struct {
+	inbound  *big.Int // Transfer 事件记录的转入金额合计
+	received *big.Int // 实际到账金额：区块前后余额变化加上同一区块内的转出金额
+}
+
+// loadFeeOnTransferTokens 加载链上标记为转账扣费（fee_on_transfer）的启用代币
+func loadFeeOnTransferTokens(ctx context.Context, db *sql.DB, chainID int) (map[common.Address]struct{}, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT token_address
+		FROM tokens
+		WHERE chain_id = $1
+			AND is_active = TRUE
+			AND fee_on_transfer = TRUE
+			AND token_address IS NOT NULL
+	`, chainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query fee-on-transfer tokens")
+	}
+	defer rows.Close()
+
+	tokens := make(map[common.Address]struct{})
+	for rows.Next() {
+		var tokenAddress string
+		if err := rows.Scan(&tokenAddress); err != nil {
+			return nil, errors.Wrap(err, "failed to scan fee-on-transfer token")
+		}
+		tokens[common.HexToAddress(tokenAddress)] = struct{}{}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate fee-on-transfer tokens")
+	}
+
+	return tokens, nil
+}
+
+// verifyReceivedAmounts 查询区块内收到转账扣费代币的用户地址在区块前后的余额，计算实际到账金额
+// 需要在保存区块前调用，查询失败时区块不保存，下一轮整体重试（节点需要保留历史状态）
+func (a *analyzer) verifyReceivedAmounts(ctx context.Context, chainID int, blockNumber *big.Int, receipts []*types.Receipt) error {
+	if len(a.feeOnTransferTokens) == 0 {
+		return nil
+	}
+	if a.balanceReader == nil {
+		return errors.New("no balance reader configured for fee-on-transfer tokens")
+	}
+
+	totals := make(map[receivedKey]*receivedTotals)
+	outbound := make(map[receivedKey]*big.Int)
+	for _, receipt := range receipts {
+		if receipt == nil || receipt.Status != types.ReceiptStatusSuccessful {
+			continue
+		}
+		for _, logEntry := range receipt.Logs {
+			if _, ok := a.feeOnTransferTokens[logEntry.Address]; !ok {
+				continue
+			}
+			event, ok := a.decodeTransferEvent(logEntry)
+			if !ok {
+				continue
+			}
+
+			fromKey := receivedKey{token: logEntry.Address, account: event.from}
+			if outbound[fromKey] == nil {
+				outbound[fromKey] = new(big.Int)
+			}
+			outbound[fromKey].Add(outbound[fromKey], event.amount)
+
+			toKey := receivedKey{token: logEntry.Address, account: event.to}
+			if totals[toKey] == nil {
+				isUser, err := a.isUserAddress(ctx, chainID, strings.ToLower(event.to.Hex()))
+				if err != nil {
+					return errors.Wrap(err, "failed to check if address is user address")
+				}
+				if !isUser {
+					continue
+				}
+				totals[toKey] = &receivedTotals{inbound: new(big.Int)}
+			}
+			totals[toKey].inbound.Add(totals[toKey].inbound, event.amount)
+		}
+	}
+
+	previousBlock := new(big.Int).Sub(blockNumber, big.NewInt(1))
+	for key, total := range totals {
+		after, err := a.balanceReader.TokenBalanceAt(ctx, key.token, key.account, blockNumber)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get balance of %s at block %s", key.account.Hex(), blockNumber)
+		}
+		before, err := a.balanceReader.TokenBalanceAt(ctx, key.token, key.account, previousBlock)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get balance of %s at block %s", key.account.Hex(), previousBlock)
+		}
+
+		total.received = new(big.Int).Sub(after, before)
+		if out := outbound[key]; out != nil {
+			total.received.Add(total.received, out)
+		}
+
+		if total.received.Cmp(total.inbound) < 0 {
+			log.Info().
+				Int("chain_id", chainID).
+				Int64("block_number", blockNumber.Int64()).
+				Str("token_addr", strings.ToLower(key.token.Hex())).
+				Str("to_addr", strings.ToLower(key.account.Hex())).
+				Str("event_amount", total.inbound.String()).
+				Str("received_amount", total.received.String()).
+				Msg("Fee-on-transfer token received less than the transfer event amount")
+		}
+	}
+
+	a.receivedTotals = totals
+
+	return nil
+}
+
+// receivedAmount 返回转账扣费代币单笔转账的实际到账金额，其他代币直接返回事件金额
+func (a *analyzer) receivedAmount(token common.Address, to common.Address, amount *big.Int) *big.Int {
+	if _, ok := a.feeOnTransferTokens[token]; !ok {
+		return amount
+	}
+
+	total, ok := a.receivedTotals[receivedKey{token: token, account: to}]
+	if !ok || total.received == nil {
+		return amount
+	}
+
+	return receivedShare(amount, total.inbound, total.received)
+}
+
+// receivedShare 按区块内实际到账合计折算单笔转账金额（向下取整）
+// 实际到账不少于事件金额合计时（如 rebasing 代币在区块内增发）按事件金额入账，不会多入账
+func receivedShare(amount, inbound, received *big.Int) *big.Int {
+	if received.Sign() <= 0 {
+		return new(big.Int)
+	}
+	if inbound.Sign() <= 0 || received.Cmp(inbound) >= 0 {
+		return amount
+	}
+
+	share := new(big.Int).Mul(amount, received)
+	return share.Quo(share, inbound)
+}
@@ -0,0 +1,49 @@
+package scan
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReceivedShare(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		inbound  int64
+		received int64
+		want     int64
+	}{
+		{name: "no fee", amount: 100, inbound: 100, received: 100, want: 100},
+		{name: "single transfer with 2% fee", amount: 100, inbound: 100, received: 98, want: 98},
+		{name: "two transfers share the fee", amount: 300, inbound: 400, received: 392, want: 294},
+		{name: "rounded down", amount: 1, inbound: 3, received: 2, want: 0},
+		{name: "rebase increase is not credited", amount: 100, inbound: 100, received: 105, want: 100},
+		{name: "nothing received", amount: 100, inbound: 100, received: -5, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := receivedShare(big.NewInt(tt.amount), big.NewInt(tt.inbound), big.NewInt(tt.received))
+			assert.Equal(t, big.NewInt(tt.want).String(), got.String())
+		})
+	}
+}
+
+func TestReceivedAmount(t *testing.T) {
+	feeToken := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	otherToken := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	user := common.HexToAddress("0x3333333333333333333333333333333333333333")
+
+	a := &analyzer{
+		feeOnTransferTokens: map[common.Address]struct{}{feeToken: {}},
+		receivedTotals: map[receivedKey]*receivedTotals{
+			{token: feeToken, account: user}: {inbound: big.NewInt(1000), received: big.NewInt(950)},
+		},
+	}
+
+	assert.Equal(t, big.NewInt(950), a.receivedAmount(feeToken, user, big.NewInt(1000)))
+	assert.Equal(t, big.NewInt(1000), a.receivedAmount(otherToken, user, big.NewInt(1000)))
+}
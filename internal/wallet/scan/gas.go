@@ -0,0 +1,72 @@
+package scan
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// GasFees 交易 gas 价格
+// 支持 EIP-1559 的链使用 MaxFee / TipCap；不支持的链（最新区块没有 baseFee）或强制配置的链使用 GasPrice（legacy 交易）
+type GasFees struct {
+	Legacy   bool
+	GasPrice *big.Int // legacy 交易的 gasPrice
+	MaxFee   *big.Int // EIP-1559 maxFeePerGas = baseFee * baseFeeMultiplier + tipCap
+	TipCap   *big.Int // EIP-1559 maxPriorityFeePerGas
+}
+
+// PerGas 返回每单位 gas 的最高价格
+func (f *GasFees) PerGas() *big.Int {
+	if f.Legacy {
+		return f.GasPrice
+	}
+
+	return f.MaxFee
+}
+
+// Cost 返回 gasLimit 下的最高链上手续费（wei）
+func (f *GasFees) Cost(gasLimit uint64) *big.Int {
+	return new(big.Int).Mul(f.PerGas(), new(big.Int).SetUint64(gasLimit))
+}
+
+// SignFields 返回签名请求的 gas 字段（十进制字符串），legacy 交易只设置 gasPrice
+func (f *GasFees) SignFields() (maxFeePerGas string, maxPriorityFeePerGas string, gasPrice string) {
+	if f.Legacy {
+		return "", "", f.GasPrice.String()
+	}
+
+	return f.MaxFee.String(), f.TipCap.String(), ""
+}
+
+// SuggestGasFees 获取当前 gas 价格；forceLegacy 为 true 或最新区块没有 baseFee 时使用 legacy gasPrice
+func (c *RPCClient) SuggestGasFees(ctx context.Context, baseFeeMultiplier int64, forceLegacy bool) (*GasFees, error) {
+	if !forceLegacy {
+		latestBlock, err := c.GetBlockByNumber(ctx, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get latest block")
+		}
+
+		if baseFee := latestBlock.BaseFee(); baseFee != nil {
+			tipCap, err := c.SuggestGasTipCap(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			return &GasFees{
+				MaxFee: new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(baseFeeMultiplier)), tipCap),
+				TipCap: tipCap,
+			}, nil
+		}
+	}
+
+	gasPrice, err := c.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GasFees{
+		Legacy:   true,
+		GasPrice: gasPrice,
+	}, nil
+}
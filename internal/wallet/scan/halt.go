@@ -0,0 +1,195 @@
+package scan
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	walletMetrics "github/chapool/go-wallet/internal/metrics/wallet"
+	"github/chapool/go-wallet/internal/wallet/alert"
+
+	"github.com/rs/zerolog/log"
+)
+
+// headMonitor 跟踪链的最新区块高度，检测出块停滞（链停摆或 RPC 节点卡住）
+type headMonitor struct {
+	threshold time.Duration // 最新区块超过该时间不变视为停滞，0 表示不检测
+
+	mu           sync.Mutex
+	lastHead     int64
+	lastChangeAt time.Time
+	halted       bool
+	lastProbeAt  time.Time // 停滞期间最近一次轮换节点检查的时间
+}
+
+// HeadStatus 出块状态快照
+type HeadStatus struct {
+	LastHead         int64
+	LastHeadChangeAt time.Time
+	Halted           bool
+}
+
+func newHeadMonitor(threshold time.Duration) *headMonitor {
+	return &headMonitor{threshold: threshold}
+}
+
+// record 记录观察到的最新区块，返回停滞时长；区块前进时返回是否从停滞中恢复及停滞了多久
+// 切换到落后的节点导致区块变小时不视为前进
+func (m *headMonitor) record(head int64, now time.Time) (stalledFor time.Duration, recovered bool, haltedFor time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.lastChangeAt.IsZero() || head > m.lastHead {
+		recovered = m.halted
+		if recovered {
+			haltedFor = now.Sub(m.lastChangeAt)
+		}
+		m.lastHead = head
+		m.lastChangeAt = now
+		m.halted = false
+		m.lastProbeAt = time.Time{}
+		return 0, recovered, haltedFor
+	}
+
+	return now.Sub(m.lastChangeAt), false, 0
+}
+
+// shouldProbe 停滞期间每个阈值周期最多轮换检查一次节点
+func (m *headMonitor) shouldProbe(now time.Time) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.lastProbeAt.IsZero() && now.Sub(m.lastProbeAt) < m.threshold {
+		return false
+	}
+	m.lastProbeAt = now
+
+	return true
+}
+
+// markHalted 标记为停滞，首次标记时返回 true（只告警一次）
+func (m *headMonitor) markHalted() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.halted {
+		return false
+	}
+	m.halted = true
+
+	return true
+}
+
+// status 返回出块状态快照
+func (m *headMonitor) status() HeadStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return HeadStatus{
+		LastHead:         m.lastHead,
+		LastHeadChangeAt: m.lastChangeAt,
+		Halted:           m.halted,
+	}
+}
+
+// checkHeadProgress 检查最新区块是否停滞
+// 停滞超过阈值时依次切换到其他 RPC 节点：有节点返回更高的区块说明原节点卡住，切换后继续扫描；
+// 所有节点返回的区块都不变时判定链停摆并告警。返回用于本轮扫描的最新区块号
+func (s *chainScanner) checkHeadProgress(ctx context.Context, latestBlock *big.Int) *big.Int {
+	m := s.headMonitor
+	if m == nil || m.threshold <= 0 {
+		return latestBlock
+	}
+
+	now := time.Now()
+	head := latestBlock.Int64()
+
+	stalledFor, recovered, haltedFor := m.record(head, now)
+	if recovered {
+		walletMetrics.ChainHalted.WithLabelValues(walletMetrics.ChainLabel(s.chainID)).Set(0)
+		s.notify(ctx, &alert.Alert{
+			Type:     alert.TypeChainRecovered,
+			Severity: alert.SeverityInfo,
+			ChainID:  s.chainID,
+			Message:  "Chain head is advancing again",
+			Fields: map[string]any{
+				"head":               head,
+				"halted_for_sec":     int64(haltedFor.Seconds()),
+				"rpc_endpoint":       s.client.CurrentEndpoint(),
+				"rpc_endpoint_count": s.client.EndpointCount(),
+			},
+		})
+	}
+
+	if stalledFor < m.threshold || !m.shouldProbe(now) {
+		return latestBlock
+	}
+
+	log.Warn().
+		Int("chain_id", s.chainID).
+		Int64("head", head).
+		Dur("stalled_for", stalledFor).
+		Msg("Chain head has not advanced, checking other RPC endpoints")
+
+	// 轮换节点，排除单个节点卡住
+	from := s.client.CurrentEndpoint()
+	for i := 1; i < s.client.EndpointCount(); i++ {
+		to := s.client.RotateEndpoint()
+		candidate, err := s.client.GetLatestBlockNumber(ctx)
+		if err != nil {
+			log.Warn().
+				Int("chain_id", s.chainID).
+				Int("rpc_endpoint", to).
+				Err(err).
+				Msg("Failed to get latest block number from RPC endpoint")
+			continue
+		}
+
+		if candidate.Int64() > head {
+			s.notify(ctx, &alert.Alert{
+				Type:     alert.TypeRPCNodeStuck,
+				Severity: alert.SeverityWarning,
+				ChainID:  s.chainID,
+				Message:  "RPC endpoint stopped advancing, switched to another endpoint",
+				Fields: map[string]any{
+					"stuck_rpc_endpoint": from,
+					"rpc_endpoint":       s.client.CurrentEndpoint(),
+					"stuck_head":         head,
+					"head":               candidate.Int64(),
+					"stalled_for_sec":    int64(stalledFor.Seconds()),
+				},
+			})
+			// 记录新节点的区块（之前已判定停滞时会发出恢复通知）
+			s.checkHeadProgress(ctx, candidate)
+			return candidate
+		}
+	}
+
+	if m.markHalted() {
+		walletMetrics.ChainHalted.WithLabelValues(walletMetrics.ChainLabel(s.chainID)).Set(1)
+		s.notify(ctx, &alert.Alert{
+			Type:     alert.TypeChainHalted,
+			Severity: alert.SeverityCritical,
+			ChainID:  s.chainID,
+			Message:  "Chain head has not advanced on any RPC endpoint, chain may be halted",
+			Fields: map[string]any{
+				"head":               head,
+				"stalled_for_sec":    int64(stalledFor.Seconds()),
+				"rpc_endpoint_count": s.client.EndpointCount(),
+			},
+		})
+	}
+
+	return latestBlock
+}
+
+// notify 发送告警，未配置通知时只写日志
+func (s *chainScanner) notify(ctx context.Context, a *alert.Alert) {
+	notifier := s.notifier
+	if notifier == nil {
+		notifier = alert.NewNotifier("", nil, nil)
+	}
+
+	_ = notifier.Notify(ctx, a)
+}
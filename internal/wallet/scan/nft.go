@@ -0,0 +1,197 @@
+package scan
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"strings"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/chain"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// NFT 标准
+const (
+	NFTStandardERC721  = "erc721"
+	NFTStandardERC1155 = "erc1155"
+)
+
+var (
+	// ERC-1155 TransferSingle 事件签名
+	// TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+	transferSingleEventSignature = common.HexToHash("0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62")
+	// ERC-1155 TransferBatch 事件签名
+	// TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+	transferBatchEventSignature = common.HexToHash("0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb")
+)
+
+// ERC-721 Transfer 事件与 ERC20 Transfer 签名相同，tokenId 为第 4 个 topic 且 data 为空
+const erc721TransferTopicCount = 4
+
+// erc1155TransferTopicCount ERC-1155 转账事件的 topic 数（签名、operator、from、to）
+const erc1155TransferTopicCount = 4
+
+// transferBatchArguments TransferBatch 事件 data 的 ABI 布局
+var transferBatchArguments = func() abi.Arguments {
+	uint256Array, err := abi.NewType("uint256[]", "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return abi.Arguments{{Type: uint256Array}, {Type: uint256Array}}
+}()
+
+// nftTransfer 解析后的 NFT 转账（TransferBatch 中的每个 id 一条）
+type nftTransfer struct {
+	standard   string
+	from       common.Address
+	to         common.Address
+	tokenID    *big.Int
+	amount     *big.Int
+	batchIndex int
+}
+
+// decodeNFTTransfers 解析 ERC-721 Transfer、ERC-1155 TransferSingle 和 TransferBatch 事件，其他事件返回 nil
+func decodeNFTTransfers(logEntry *types.Log) []*nftTransfer {
+	if len(logEntry.Topics) == 0 {
+		return nil
+	}
+
+	switch logEntry.Topics[0] {
+	case transferEventSignature:
+		if len(logEntry.Topics) != erc721TransferTopicCount || len(logEntry.Data) != 0 {
+			return nil
+		}
+		return []*nftTransfer{{
+			standard: NFTStandardERC721,
+			from:     common.BytesToAddress(logEntry.Topics[1].Bytes()),
+			to:       common.BytesToAddress(logEntry.Topics[2].Bytes()),
+			tokenID:  logEntry.Topics[3].Big(),
+			amount:   big.NewInt(1),
+		}}
+	case transferSingleEventSignature:
+		if len(logEntry.Topics) != erc1155TransferTopicCount || len(logEntry.Data) != 2*eventDataWordSize {
+			return nil
+		}
+		return []*nftTransfer{{
+			standard: NFTStandardERC1155,
+			from:     common.BytesToAddress(logEntry.Topics[2].Bytes()),
+			to:       common.BytesToAddress(logEntry.Topics[3].Bytes()),
+			tokenID:  new(big.Int).SetBytes(logEntry.Data[:eventDataWordSize]),
+			amount:   new(big.Int).SetBytes(logEntry.Data[eventDataWordSize:]),
+		}}
+	case transferBatchEventSignature:
+		if len(logEntry.Topics) != erc1155TransferTopicCount {
+			return nil
+		}
+		values, err := transferBatchArguments.Unpack(logEntry.Data)
+		if err != nil || len(values) != 2 {
+			return nil
+		}
+		ids, idsOK := values[0].([]*big.Int)
+		amounts, amountsOK := values[1].([]*big.Int)
+		if !idsOK || !amountsOK || len(ids) != len(amounts) {
+			return nil
+		}
+		from := common.BytesToAddress(logEntry.Topics[2].Bytes())
+		to := common.BytesToAddress(logEntry.Topics[3].Bytes())
+		transfers := make([]*nftTransfer, 0, len(ids))
+		for i := range ids {
+			transfers = append(transfers, &nftTransfer{
+				standard:   NFTStandardERC1155,
+				from:       from,
+				to:         to,
+				tokenID:    ids[i],
+				amount:     amounts[i],
+				batchIndex: i,
+			})
+		}
+		return transfers
+	default:
+		return nil
+	}
+}
+
+// analyzeNFTTransfers 分析转入用户充值地址的 NFT，写入 nft_holdings
+// 按 (chain_id, tx_hash, event_index, batch_index) 去重，重新扫描时跳过；因重组回滚的记录重新打包进规范链区块时恢复为 held
+func (a *analyzer) analyzeNFTTransfers(ctx context.Context, chainID int, tx *types.Transaction, receipt *types.Receipt, blockNumber *big.Int, blockHash common.Hash) error {
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil
+	}
+
+	for _, logEntry := range receipt.Logs {
+		for _, transfer := range decodeNFTTransfers(logEntry) {
+			if transfer.amount.Sign() <= 0 {
+				continue
+			}
+
+			toAddr := strings.ToLower(transfer.to.Hex())
+			walletID, userID, err := a.userWallet(ctx, chainID, toAddr)
+			if err != nil {
+				return errors.Wrap(err, "failed to look up user wallet")
+			}
+			if walletID == "" {
+				continue
+			}
+
+			txHash := strings.ToLower(tx.Hash().Hex())
+			contractAddr := strings.ToLower(logEntry.Address.Hex())
+			result, err := a.db.ExecContext(ctx, `
+				INSERT INTO nft_holdings (
+					user_id, wallet_id, chain_id, contract_addr, token_id, standard, amount, from_addr,
+					tx_hash, block_hash, block_no, event_index, batch_index
+				)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+				ON CONFLICT (chain_id, tx_hash, event_index, batch_index) DO UPDATE SET
+					block_hash = EXCLUDED.block_hash,
+					block_no = EXCLUDED.block_no,
+					status = 'held',
+					updated_at = NOW()
+				WHERE nft_holdings.status = 'orphaned'
+			`, userID, walletID, chainID, contractAddr, transfer.tokenID.String(), transfer.standard, transfer.amount.String(),
+				strings.ToLower(transfer.from.Hex()), txHash, blockHash.Hex(), blockNumber.Int64(), int(logEntry.Index), transfer.batchIndex)
+			if err != nil {
+				return errors.Wrap(err, "failed to insert nft holding")
+			}
+			if inserted, _ := result.RowsAffected(); inserted == 0 {
+				continue
+			}
+
+			log.Info().
+				Int("chain_id", chainID).
+				Str("tx_hash", txHash).
+				Str("to_addr", toAddr).
+				Str("contract_addr", contractAddr).
+				Str("standard", transfer.standard).
+				Str("token_id", transfer.tokenID.String()).
+				Str("amount", transfer.amount.String()).
+				Msg("NFT deposit detected")
+		}
+	}
+
+	return nil
+}
+
+// userWallet 获取地址对应的用户充值钱包，观察地址没有私钥无法托管，不记录 NFT；不是用户地址时返回空字符串
+func (a *analyzer) userWallet(ctx context.Context, chainID int, address string) (string, string, error) {
+	var walletID, userID string
+	err := a.db.QueryRowContext(ctx, `
+		SELECT id, user_id
+		FROM wallets
+		WHERE chain_id = $1 AND wallet_type = $2 AND `+chain.WalletAddressKeySQL+` = $3
+		LIMIT 1
+	`, chainID, models.WalletTypeUser, chain.NormalizeAddress(chain.TypeEVM, address)).Scan(&walletID, &userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", "", nil
+		}
+		return "", "", errors.Wrap(err, "failed to get user wallet")
+	}
+
+	return walletID, userID, nil
+}
@@ -0,0 +1,83 @@
+package scan
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeNFTTransfers(t *testing.T) {
+	operator := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	from := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	to := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	addressTopic := func(address common.Address) common.Hash {
+		return common.BytesToHash(address.Bytes())
+	}
+
+	t.Run("erc721 transfer", func(t *testing.T) {
+		transfers := decodeNFTTransfers(&types.Log{
+			Topics: []common.Hash{transferEventSignature, addressTopic(from), addressTopic(to), common.BigToHash(big.NewInt(42))},
+		})
+		require.Len(t, transfers, 1)
+		assert.Equal(t, NFTStandardERC721, transfers[0].standard)
+		assert.Equal(t, from, transfers[0].from)
+		assert.Equal(t, to, transfers[0].to)
+		assert.Equal(t, "42", transfers[0].tokenID.String())
+		assert.Equal(t, "1", transfers[0].amount.String())
+	})
+
+	t.Run("erc20 transfer is ignored", func(t *testing.T) {
+		transfers := decodeNFTTransfers(&types.Log{
+			Topics: []common.Hash{transferEventSignature, addressTopic(from), addressTopic(to)},
+			Data:   common.BigToHash(big.NewInt(1000)).Bytes(),
+		})
+		assert.Empty(t, transfers)
+	})
+
+	t.Run("erc1155 transfer single", func(t *testing.T) {
+		data := append(common.BigToHash(big.NewInt(7)).Bytes(), common.BigToHash(big.NewInt(5)).Bytes()...)
+		transfers := decodeNFTTransfers(&types.Log{
+			Topics: []common.Hash{transferSingleEventSignature, addressTopic(operator), addressTopic(from), addressTopic(to)},
+			Data:   data,
+		})
+		require.Len(t, transfers, 1)
+		assert.Equal(t, NFTStandardERC1155, transfers[0].standard)
+		assert.Equal(t, from, transfers[0].from)
+		assert.Equal(t, to, transfers[0].to)
+		assert.Equal(t, "7", transfers[0].tokenID.String())
+		assert.Equal(t, "5", transfers[0].amount.String())
+	})
+
+	t.Run("erc1155 transfer batch", func(t *testing.T) {
+		data, err := transferBatchArguments.Pack(
+			[]*big.Int{big.NewInt(1), big.NewInt(2)},
+			[]*big.Int{big.NewInt(10), big.NewInt(20)},
+		)
+		require.NoError(t, err)
+
+		transfers := decodeNFTTransfers(&types.Log{
+			Topics: []common.Hash{transferBatchEventSignature, addressTopic(operator), addressTopic(from), addressTopic(to)},
+			Data:   data,
+		})
+		require.Len(t, transfers, 2)
+		for i, transfer := range transfers {
+			assert.Equal(t, NFTStandardERC1155, transfer.standard)
+			assert.Equal(t, to, transfer.to)
+			assert.Equal(t, i, transfer.batchIndex)
+		}
+		assert.Equal(t, "2", transfers[1].tokenID.String())
+		assert.Equal(t, "20", transfers[1].amount.String())
+	})
+
+	t.Run("malformed transfer batch is ignored", func(t *testing.T) {
+		transfers := decodeNFTTransfers(&types.Log{
+			Topics: []common.Hash{transferBatchEventSignature, addressTopic(operator), addressTopic(from), addressTopic(to)},
+			Data:   []byte{0x01},
+		})
+		assert.Empty(t, transfers)
+	})
+}
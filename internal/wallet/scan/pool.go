@@ -0,0 +1,308 @@
+package scan
+
+import (
+	"context"
+	"math/rand/v2"
+	"strconv"
+	"sync"
+	"time"
+
+	walletMetrics "github/chapool/go-wallet/internal/metrics/wallet"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// RPC 节点熔断器状态
+const (
+	CircuitClosed   = "closed"    // 正常使用
+	CircuitOpen     = "open"      // 连续失败后暂停使用，冷却结束后探测
+	CircuitHalfOpen = "half_open" // 冷却结束，正在用链 ID 检查探测节点
+)
+
+const (
+	// circuitFailureThreshold 连续失败多少次后熔断节点
+	circuitFailureThreshold = 3
+	// circuitBaseCooldown 首次熔断的冷却时间，探测失败后加倍，最长 circuitMaxCooldown
+	circuitBaseCooldown = 15 * time.Second
+	circuitMaxCooldown  = 5 * time.Minute
+	// endpointEWMAAlpha 延迟和错误率指数移动平均中新样本的权重
+	endpointEWMAAlpha = 0.2
+	// defaultEndpointLatency 还没有延迟样本的节点按该延迟计算权重
+	defaultEndpointLatency = 100 * time.Millisecond
+	// minEndpointLatency 计算权重时的最小延迟，避免极小延迟的节点独占负载
+	minEndpointLatency = time.Millisecond
+	// minEndpointSuccessRate 计算权重时的最小成功率，错误率高的节点仍分到少量负载以便恢复
+	minEndpointSuccessRate = 0.05
+)
+
+var (
+	errCircuitOpen       = errors.New("RPC endpoint circuit is open")
+	errEndpointsReloaded = errors.New("RPC endpoints were reloaded")
+)
+
+// RPCEndpointHealth RPC 节点的负载均衡和熔断状态
+type RPCEndpointHealth struct {
+	Endpoint            int            // 节点索引（不暴露可能包含 API Key 的 URL）
+	Connected           bool           // 是否已建立连接
+	Primary             bool           // 是否为扫描和广播使用的主节点
+	CircuitState        string         // CircuitClosed、CircuitOpen 或 CircuitHalfOpen
+	CircuitOpenUntil    *time.Time     // 熔断冷却结束时间，熔断器关闭时为 nil
+	Latency             *time.Duration // 调用延迟的指数移动平均，还没有样本时为 nil
+	ErrorRate           float64        // 调用失败率的指数移动平均（0-1）
+	ConsecutiveFailures int
+	Requests            uint64
+	Failures            uint64
+	LastError           string
+	LastErrorAt         *time.Time
+}
+
+// endpointStats 单个 RPC 节点的延迟、错误率和熔断器状态
+type endpointStats struct {
+	mu                  sync.Mutex
+	latency             float64 // 纳秒，0 表示还没有样本
+	errorRate           float64
+	consecutiveFailures int
+	requests            uint64
+	failures            uint64
+	state               string
+	openedAt            time.Time
+	cooldown            time.Duration
+	lastError           string
+	lastErrorAt         time.Time
+}
+
+func newEndpointStats() *endpointStats {
+	return &endpointStats{state: CircuitClosed}
+}
+
+// allow 判断节点是否可以使用；熔断冷却结束时转为半开并返回 probe = true，由调用方探测节点，
+// 探测期间其他调用不使用该节点
+func (e *endpointStats) allow(now time.Time) (allowed bool, probe bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	switch e.state {
+	case CircuitClosed:
+		return true, false
+	case CircuitOpen:
+		if now.Before(e.openedAt.Add(e.cooldown)) {
+			return false, false
+		}
+		e.state = CircuitHalfOpen
+		return true, true
+	default:
+		return false, false
+	}
+}
+
+// closed 节点熔断器是否关闭
+func (e *endpointStats) closed() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.state == CircuitClosed
+}
+
+// weight 节点的负载均衡权重：延迟越低、错误率越低权重越高
+func (e *endpointStats) weight() float64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	latency := e.latency
+	if latency == 0 {
+		latency = float64(defaultEndpointLatency)
+	}
+	latency = max(latency, float64(minEndpointLatency))
+
+	return max(1-e.errorRate, minEndpointSuccessRate) / latency
+}
+
+// record 记录一次调用结果，latency <= 0 时不计入延迟（如失败的调用和固定节点的调用），
+// 返回熔断器状态和状态是否变化
+func (e *endpointStats) record(latency time.Duration, err error, now time.Time) (string, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.requests++
+	if latency > 0 {
+		if e.latency == 0 {
+			e.latency = float64(latency)
+		} else {
+			e.latency = e.latency*(1-endpointEWMAAlpha) + float64(latency)*endpointEWMAAlpha
+		}
+	}
+
+	previous := e.state
+	if err == nil {
+		e.errorRate *= 1 - endpointEWMAAlpha
+		e.consecutiveFailures = 0
+		e.state = CircuitClosed
+		e.cooldown = 0
+		return e.state, previous != e.state
+	}
+
+	e.failures++
+	e.errorRate = e.errorRate*(1-endpointEWMAAlpha) + endpointEWMAAlpha
+	e.consecutiveFailures++
+	e.lastError = err.Error()
+	e.lastErrorAt = now
+
+	switch {
+	case e.state == CircuitHalfOpen:
+		// 探测失败，冷却时间加倍
+		e.state = CircuitOpen
+		e.openedAt = now
+		e.cooldown = min(max(e.cooldown*2, circuitBaseCooldown), circuitMaxCooldown)
+	case e.state == CircuitClosed && e.consecutiveFailures >= circuitFailureThreshold:
+		e.state = CircuitOpen
+		e.openedAt = now
+		e.cooldown = circuitBaseCooldown
+	}
+
+	return e.state, previous != e.state
+}
+
+// snapshot 返回节点状态
+func (e *endpointStats) snapshot(endpoint int, connected bool, primary bool) *RPCEndpointHealth {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	health := &RPCEndpointHealth{
+		Endpoint:            endpoint,
+		Connected:           connected,
+		Primary:             primary,
+		CircuitState:        e.state,
+		ErrorRate:           e.errorRate,
+		ConsecutiveFailures: e.consecutiveFailures,
+		Requests:            e.requests,
+		Failures:            e.failures,
+		LastError:           e.lastError,
+	}
+	if e.state != CircuitClosed {
+		until := e.openedAt.Add(e.cooldown)
+		health.CircuitOpenUntil = &until
+	}
+	if e.latency > 0 {
+		latency := time.Duration(e.latency)
+		health.Latency = &latency
+	}
+	if !e.lastErrorAt.IsZero() {
+		lastErrorAt := e.lastErrorAt
+		health.LastErrorAt = &lastErrorAt
+	}
+
+	return health
+}
+
+// isEndpointFailure 判断调用错误是否说明节点不可用：节点返回的 JSON-RPC 错误（如 execution reverted、nonce too low）、
+// 交易/收据不存在和调用方取消不计入，连接失败、超时和 HTTP 错误（如 429、5xx）计入
+func isEndpointFailure(err error) bool {
+	if err == nil || errors.Is(err, ethereum.NotFound) || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var rpcErr rpc.Error
+	return !errors.As(err, &rpcErr)
+}
+
+// endpointCall 一次使用选中节点的调用，释放时记录节点的延迟和调用结果
+type endpointCall struct {
+	parent   *RPCClient
+	endpoint int
+	stats    *endpointStats
+	calls    *sync.WaitGroup
+	start    time.Time
+	err      error
+	once     sync.Once
+}
+
+// untimed 调用不计入节点延迟（调用持有节点的时间不代表节点响应时间）
+func (call *endpointCall) untimed() *endpointCall {
+	call.start = time.Time{}
+	return call
+}
+
+// recordError 记录调用失败：更新 RPC 错误指标，节点不可用的错误在释放时计入节点的熔断统计
+func (call *endpointCall) recordError(method string, err error) {
+	call.parent.recordError(method, err)
+	if isEndpointFailure(err) {
+		call.err = err
+	}
+}
+
+// release 释放节点连接并记录调用结果，可重复调用
+func (call *endpointCall) release() {
+	call.once.Do(func() {
+		var latency time.Duration
+		if !call.start.IsZero() && call.err == nil {
+			latency = time.Since(call.start)
+		}
+		call.parent.recordEndpointResult(call.endpoint, call.stats, latency, call.err)
+		call.calls.Done()
+	})
+}
+
+// recordEndpointResult 记录节点调用结果，更新节点指标，熔断器状态变化时写日志
+func (c *RPCClient) recordEndpointResult(endpoint int, stats *endpointStats, latency time.Duration, err error) {
+	state, changed := stats.record(latency, err, time.Now())
+
+	chainLabel := walletMetrics.ChainLabel(c.chainID)
+	endpointLabel := strconv.Itoa(endpoint)
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	walletMetrics.RPCEndpointRequests.WithLabelValues(chainLabel, endpointLabel, result).Inc()
+	if latency > 0 {
+		walletMetrics.RPCEndpointLatency.WithLabelValues(chainLabel, endpointLabel).Observe(latency.Seconds())
+	}
+
+	if !changed {
+		return
+	}
+
+	open := 0.0
+	if state != CircuitClosed {
+		open = 1
+	}
+	walletMetrics.RPCEndpointCircuitOpen.WithLabelValues(chainLabel, endpointLabel).Set(open)
+
+	switch state {
+	case CircuitOpen:
+		log.Warn().
+			Int("chain_id", c.chainID).
+			Int("rpc_endpoint", endpoint).
+			Err(err).
+			Msg("RPC endpoint circuit opened, endpoint is skipped until the cooldown ends")
+	case CircuitClosed:
+		log.Info().
+			Int("chain_id", c.chainID).
+			Int("rpc_endpoint", endpoint).
+			Msg("RPC endpoint circuit closed")
+	}
+}
+
+// pickWeighted 按权重随机选择一个节点索引，weights 为空时返回 -1
+func pickWeighted(endpoints []int, weights []float64) int {
+	var total float64
+	for _, w := range weights {
+		total += w
+	}
+	if len(endpoints) == 0 || total <= 0 {
+		return -1
+	}
+
+	//nolint:gosec // 负载均衡不需要密码学安全的随机数
+	r := rand.Float64() * total
+	for i, w := range weights {
+		if r < w {
+			return endpoints[i]
+		}
+		r -= w
+	}
+
+	return endpoints[len(endpoints)-1]
+}
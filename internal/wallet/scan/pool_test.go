@@ -0,0 +1,154 @@
+package scan
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	stats := newEndpointStats()
+	now := time.Now()
+	failure := errors.New("connection refused")
+
+	for i := 1; i < circuitFailureThreshold; i++ {
+		state, changed := stats.record(0, failure, now)
+		assert.Equal(t, CircuitClosed, state)
+		assert.False(t, changed)
+	}
+	state, changed := stats.record(0, failure, now)
+	assert.Equal(t, CircuitOpen, state)
+	assert.True(t, changed)
+
+	allowed, _ := stats.allow(now.Add(circuitBaseCooldown - time.Second))
+	assert.False(t, allowed)
+
+	// 冷却结束后只允许一个探测
+	allowed, probe := stats.allow(now.Add(circuitBaseCooldown))
+	assert.True(t, allowed)
+	assert.True(t, probe)
+	allowed, _ = stats.allow(now.Add(circuitBaseCooldown))
+	assert.False(t, allowed)
+
+	// 探测失败后冷却时间加倍
+	probeFailedAt := now.Add(circuitBaseCooldown)
+	state, _ = stats.record(0, failure, probeFailedAt)
+	assert.Equal(t, CircuitOpen, state)
+	health := stats.snapshot(0, true, false)
+	require.NotNil(t, health.CircuitOpenUntil)
+	assert.Equal(t, probeFailedAt.Add(2*circuitBaseCooldown), *health.CircuitOpenUntil)
+
+	allowed, probe = stats.allow(probeFailedAt.Add(2 * circuitBaseCooldown))
+	assert.True(t, allowed)
+	assert.True(t, probe)
+	state, changed = stats.record(0, nil, probeFailedAt.Add(2*circuitBaseCooldown))
+	assert.Equal(t, CircuitClosed, state)
+	assert.True(t, changed)
+	assert.Nil(t, stats.snapshot(0, true, false).CircuitOpenUntil)
+}
+
+func TestEndpointWeight(t *testing.T) {
+	t.Parallel()
+
+	fast := newEndpointStats()
+	slow := newEndpointStats()
+	failing := newEndpointStats()
+	now := time.Now()
+	for range 5 {
+		fast.record(10*time.Millisecond, nil, now)
+		slow.record(200*time.Millisecond, nil, now)
+		failing.record(10*time.Millisecond, nil, now)
+		failing.record(0, errors.New("timeout"), now)
+	}
+
+	assert.Greater(t, fast.weight(), slow.weight())
+	assert.Greater(t, fast.weight(), failing.weight())
+	assert.Positive(t, failing.weight())
+	assert.Equal(t, -1, pickWeighted(nil, nil))
+	assert.Equal(t, 2, pickWeighted([]int{1, 2}, []float64{0, 1}))
+}
+
+func TestIsEndpointFailure(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, isEndpointFailure(nil))
+	assert.False(t, isEndpointFailure(ethereum.NotFound))
+	assert.False(t, isEndpointFailure(errors.Wrap(context.Canceled, "call")))
+	assert.False(t, isEndpointFailure(&testRPCError{}))
+	assert.True(t, isEndpointFailure(context.DeadlineExceeded))
+	assert.True(t, isEndpointFailure(rpc.HTTPError{StatusCode: 429, Status: "429 Too Many Requests"}))
+}
+
+type testRPCError struct{}
+
+func (*testRPCError) Error() string  { return "execution reverted" }
+func (*testRPCError) ErrorCode() int { return 3 }
+
+// testFailingEthAPI 进程内 JSON-RPC 节点，链 ID 正常，余额查询失败
+type testFailingEthAPI struct{}
+
+func (testFailingEthAPI) ChainId() *hexutil.Big { //nolint:revive,stylecheck // JSON-RPC 方法名 eth_chainId
+	return (*hexutil.Big)(big.NewInt(1))
+}
+
+func TestReadClientSkipsOpenCircuit(t *testing.T) {
+	t.Parallel()
+
+	healthy := rpc.NewServer()
+	require.NoError(t, healthy.RegisterName("eth", testEthAPI{}))
+	t.Cleanup(healthy.Stop)
+	broken := rpc.NewServer()
+	require.NoError(t, broken.RegisterName("eth", testFailingEthAPI{}))
+	t.Cleanup(broken.Stop)
+
+	client := &RPCClient{
+		chainID: 1,
+		urls:    []string{"inproc-broken", "inproc-healthy"},
+		clients: []*ethclient.Client{
+			ethclient.NewClient(rpc.DialInProc(broken)),
+			ethclient.NewClient(rpc.DialInProc(healthy)),
+		},
+		endpoints: newEndpointStatsList(2),
+		calls:     &sync.WaitGroup{},
+	}
+	t.Cleanup(client.Close)
+
+	// 熔断主节点，只读调用和主节点调用都使用另一个节点
+	for range circuitFailureThreshold {
+		client.recordEndpointResult(0, client.endpoints[0], 0, errors.New("connection reset"))
+	}
+
+	for range 5 {
+		balance, err := client.BalanceAt(context.Background(), common.HexToAddress("0x05"))
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), balance.Int64())
+	}
+
+	_, call, err := client.getClient(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, call.endpoint)
+	call.release()
+	assert.Equal(t, 1, client.CurrentEndpoint())
+
+	health := client.EndpointHealth()
+	require.Len(t, health, 2)
+	assert.Equal(t, CircuitOpen, health[0].CircuitState)
+	assert.False(t, health[0].Primary)
+	assert.Equal(t, CircuitClosed, health[1].CircuitState)
+	assert.True(t, health[1].Primary)
+	assert.Equal(t, uint64(6), health[1].Requests)
+	require.NotNil(t, health[1].Latency)
+}
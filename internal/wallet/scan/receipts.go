@@ -0,0 +1,143 @@
+package scan
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// receiptFetcher 获取单笔交易的收据
+type receiptFetcher func(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+
+// receiptBatchFetcher 以一个 JSON-RPC 批量请求获取多笔交易的收据，receipts 和 errs 与 txHashes 一一对应
+type receiptBatchFetcher func(ctx context.Context, txHashes []common.Hash) ([]*types.Receipt, []error, error)
+
+// fetchReceipts 以最多 concurrency 个 worker 并发获取交易收据，返回的收据与 txHashes 顺序一致
+// 获取失败的交易汇总为一个 *blockReceiptError，调用方应整体重试该区块，避免漏掉其中的充值
+func fetchReceipts(ctx context.Context, blockNumber *big.Int, txHashes []common.Hash, concurrency int, fetch receiptFetcher) ([]*types.Receipt, error) {
+	receipts := make([]*types.Receipt, len(txHashes))
+	errs := make([]error, len(txHashes))
+
+	runWorkers(ctx, len(txHashes), concurrency, func(idx int) {
+		receipts[idx], errs[idx] = fetch(ctx, txHashes[idx])
+	}, func(idx int, err error) {
+		errs[idx] = err
+	})
+
+	if err := newBlockReceiptError(blockNumber, txHashes, errs); err != nil {
+		return nil, err
+	}
+
+	return receipts, nil
+}
+
+// fetchReceiptsInBatches 将交易按 batchSize 分为多个批量请求，以最多 concurrency 个请求并发获取收据
+// 返回值与 fetchReceipts 相同；整个批量请求失败时该批所有交易记为失败
+func fetchReceiptsInBatches(ctx context.Context, blockNumber *big.Int, txHashes []common.Hash, batchSize, concurrency int, fetch receiptBatchFetcher) ([]*types.Receipt, error) {
+	receipts := make([]*types.Receipt, len(txHashes))
+	errs := make([]error, len(txHashes))
+
+	batchSize = max(batchSize, 1)
+	batches := (len(txHashes) + batchSize - 1) / batchSize
+	batchRange := func(idx int) (int, int) {
+		start := idx * batchSize
+		return start, min(start+batchSize, len(txHashes))
+	}
+
+	runWorkers(ctx, batches, concurrency, func(idx int) {
+		start, end := batchRange(idx)
+		batchReceipts, batchErrs, err := fetch(ctx, txHashes[start:end])
+		if err != nil {
+			for i := start; i < end; i++ {
+				errs[i] = err
+			}
+			return
+		}
+		copy(receipts[start:end], batchReceipts)
+		copy(errs[start:end], batchErrs)
+	}, func(idx int, err error) {
+		start, end := batchRange(idx)
+		for i := start; i < end; i++ {
+			errs[i] = err
+		}
+	})
+
+	if err := newBlockReceiptError(blockNumber, txHashes, errs); err != nil {
+		return nil, err
+	}
+
+	return receipts, nil
+}
+
+// runWorkers 以最多 concurrency 个 worker 执行 n 个任务，ctx 结束后剩余任务交给 canceled
+func runWorkers(ctx context.Context, n, concurrency int, run func(idx int), canceled func(idx int, err error)) {
+	if n == 0 {
+		return
+	}
+
+	workers := min(max(concurrency, 1), n)
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				if err := ctx.Err(); err != nil {
+					canceled(idx, err)
+					continue
+				}
+				run(idx)
+			}
+		}()
+	}
+
+	for idx := range n {
+		jobs <- idx
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// newBlockReceiptError 汇总获取失败的交易，全部成功时返回 nil
+func newBlockReceiptError(blockNumber *big.Int, txHashes []common.Hash, errs []error) error {
+	var blockErr *blockReceiptError
+	for idx, err := range errs {
+		if err == nil {
+			continue
+		}
+		if blockErr == nil {
+			blockErr = &blockReceiptError{BlockNumber: blockNumber, Total: len(txHashes)}
+		}
+		blockErr.Failed = append(blockErr.Failed, txHashes[idx])
+		blockErr.Errs = append(blockErr.Errs, err)
+	}
+	if blockErr != nil {
+		return blockErr
+	}
+
+	return nil
+}
+
+// blockReceiptError 区块中获取收据失败的交易汇总
+type blockReceiptError struct {
+	BlockNumber *big.Int
+	Total       int
+	Failed      []common.Hash
+	Errs        []error // 与 Failed 一一对应
+}
+
+func (e *blockReceiptError) Error() string {
+	return fmt.Sprintf("failed to fetch %d of %d receipts in block %s, first failure %s: %v",
+		len(e.Failed), e.Total, e.BlockNumber, e.Failed[0].Hex(), e.Errs[0])
+}
+
+// Unwrap 支持 errors.Is / errors.As 匹配其中任一交易的错误
+func (e *blockReceiptError) Unwrap() []error {
+	return e.Errs
+}
@@ -0,0 +1,76 @@
+package scan
+
+import (
+	"context"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchReceiptsKeepsOrderAndBoundsConcurrency(t *testing.T) {
+	txHashes := make([]common.Hash, 20)
+	for i := range txHashes {
+		txHashes[i] = common.BigToHash(big.NewInt(int64(i + 1)))
+	}
+
+	var inFlight, maxInFlight atomic.Int32
+	fetch := func(_ context.Context, txHash common.Hash) (*types.Receipt, error) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		// 后面的交易先返回，验证结果仍按区块内顺序排列
+		time.Sleep(time.Duration(len(txHashes)-int(txHash.Big().Int64())) * time.Millisecond)
+		return &types.Receipt{TxHash: txHash}, nil
+	}
+
+	receipts, err := fetchReceipts(context.Background(), big.NewInt(100), txHashes, 4, fetch)
+	require.NoError(t, err)
+	require.Len(t, receipts, len(txHashes))
+	for i, receipt := range receipts {
+		assert.Equal(t, txHashes[i], receipt.TxHash)
+	}
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(4))
+}
+
+func TestFetchReceiptsAggregatesBlockErrors(t *testing.T) {
+	txHashes := []common.Hash{
+		common.HexToHash("0x01"),
+		common.HexToHash("0x02"),
+		common.HexToHash("0x03"),
+	}
+	fetch := func(_ context.Context, txHash common.Hash) (*types.Receipt, error) {
+		if txHash == txHashes[0] {
+			return &types.Receipt{TxHash: txHash}, nil
+		}
+		return nil, errors.Wrap(ethereum.NotFound, "failed to get transaction receipt")
+	}
+
+	receipts, err := fetchReceipts(context.Background(), big.NewInt(100), txHashes, 2, fetch)
+	require.Error(t, err)
+	assert.Nil(t, receipts)
+
+	var blockErr *blockReceiptError
+	require.ErrorAs(t, err, &blockErr)
+	assert.Equal(t, 3, blockErr.Total)
+	assert.Equal(t, txHashes[1:], blockErr.Failed)
+	assert.ErrorIs(t, err, ethereum.NotFound)
+}
+
+func TestFetchReceiptsEmptyBlock(t *testing.T) {
+	receipts, err := fetchReceipts(context.Background(), big.NewInt(100), nil, 8, nil)
+	require.NoError(t, err)
+	assert.Empty(t, receipts)
+}
@@ -0,0 +1,181 @@
+package scan
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/chain"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// ChainConfigChannel 链配置变更通知的 LISTEN 通道，payload 为 chain_id（由 chains 表触发器发送）
+	ChainConfigChannel = "chain_config_changed"
+
+	listenerMinReconnectInterval = 10 * time.Second
+	listenerMaxReconnectInterval = time.Minute
+)
+
+// StartChainConfigWatcher 监听链配置变更，RPC URL 变化时重建该链的 RPC 客户端，扫描参数变化时更新运行中的扫描器，
+// 链停用时停止其扫描器并关闭客户端（见 collectChainClients）
+// 监听连接断开期间可能漏收通知，因此重连后以及每隔 interval 检查所有已创建的客户端和扫描器
+func (s *service) StartChainConfigWatcher(ctx context.Context, connString string, interval time.Duration) {
+	listener := pq.NewListener(connString, listenerMinReconnectInterval, listenerMaxReconnectInterval,
+		func(event pq.ListenerEventType, err error) {
+			if err != nil {
+				log.Warn().Err(err).Int("event", int(event)).Msg("Chain config listener connection event")
+			}
+		})
+	if err := listener.Listen(ChainConfigChannel); err != nil {
+		// 首次连接失败时 pq 仍会在后台重连，定期检查保证配置最终生效
+		log.Error().Err(err).Msg("Failed to listen for chain config changes")
+	}
+
+	log.Info().Dur("interval", interval).Msg("Starting chain config watcher")
+
+	lifecycle.Go(ctx, "scan chain config watcher", func() {
+		defer listener.Close()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Chain config watcher stopped")
+				return
+			case notification := <-listener.Notify:
+				// 重连后收到 nil，期间的通知可能已丢失
+				if notification == nil {
+					s.reloadAllChainClients(ctx)
+					s.reloadAllScanSettings(ctx)
+					s.collectChainClients(ctx)
+					continue
+				}
+
+				chainID, err := strconv.Atoi(notification.Extra)
+				if err != nil {
+					log.Warn().Str("payload", notification.Extra).Msg("Invalid chain config change notification")
+					continue
+				}
+				if err := s.ReloadChainClients(ctx, chainID); err != nil {
+					log.Error().Err(err).Int("chain_id", chainID).Msg("Failed to reload RPC clients after chain config change")
+				}
+				if err := s.ReloadScanSettings(ctx, chainID); err != nil {
+					log.Error().Err(err).Int("chain_id", chainID).Msg("Failed to reload scan settings after chain config change")
+				}
+				s.collectChainClients(ctx)
+			case <-ticker.C:
+				s.reloadAllChainClients(ctx)
+				s.reloadAllScanSettings(ctx)
+				s.collectChainClients(ctx)
+			}
+		}
+	})
+}
+
+// ReloadChainClients 按最新的链配置重建该链的 RPC 客户端，URL 列表未变化时不做任何操作
+// 已创建的客户端原地替换节点（持有客户端的扫描器和业务服务无需重新获取），旧连接在进行中的调用完成后关闭；
+// 新节点不可用或链 ID 不一致时保留原有节点
+func (s *service) ReloadChainClients(ctx context.Context, chainID int) error {
+	s.clientsMu.Lock()
+	client := s.clients[chainID]
+	solanaClient := s.solanaClients[chainID]
+	bitcoinClient := s.bitcoinClients[chainID]
+	// 之前因链 ID 不一致不再创建客户端的链，配置变更后重新校验
+	delete(s.chainIDMismatches, chainID)
+	s.clientsMu.Unlock()
+
+	if client == nil && solanaClient == nil && bitcoinClient == nil {
+		return nil
+	}
+
+	chainConfig, err := s.chainService.GetChain(ctx, chainID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get chain config for chain_id=%d", chainID)
+	}
+	urls := s.chainService.ParseRPCURLs(chainConfig.RPCURL)
+	if len(urls) == 0 {
+		return errors.Errorf("no valid RPC URLs for chain_id=%d", chainID)
+	}
+
+	if client != nil && chainConfig.ChainType == chain.TypeEVM {
+		if err := s.reloadClient(ctx, client, urls); err != nil {
+			return err
+		}
+	}
+
+	if solanaClient != nil && chainConfig.ChainType == chain.TypeSolana {
+		reloaded, err := solanaClient.Reload(urls)
+		if err != nil {
+			return errors.Wrapf(err, "failed to reload solana RPC client for chain_id=%d", chainID)
+		}
+		if reloaded {
+			log.Info().Int("chain_id", chainID).Int("rpc_endpoint_count", len(urls)).Msg("Solana RPC client reloaded")
+		}
+	}
+
+	if bitcoinClient != nil && chainConfig.ChainType == chain.TypeBitcoin {
+		reloaded, err := bitcoinClient.Reload(urls)
+		if err != nil {
+			return errors.Wrapf(err, "failed to reload bitcoin RPC client for chain_id=%d", chainID)
+		}
+		if reloaded {
+			log.Info().Int("chain_id", chainID).Int("rpc_endpoint_count", len(urls)).Msg("Bitcoin RPC client reloaded")
+		}
+	}
+
+	return nil
+}
+
+// reloadClient 连接新的 RPC 节点并校验链 ID，通过后替换 client 的节点
+func (s *service) reloadClient(ctx context.Context, client *RPCClient, urls []string) error {
+	if slices.Equal(client.URLs(), urls) {
+		return nil
+	}
+
+	next, err := NewRPCClient(client.chainID, urls)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create RPC client for chain_id=%d", client.chainID)
+	}
+
+	if err := s.verifyChainID(ctx, next); err != nil {
+		// 链 ID 不一致时已告警，原有节点继续服务
+		next.Close()
+		return err
+	}
+
+	client.replaceEndpoints(next)
+
+	log.Info().Int("chain_id", client.chainID).Int("rpc_endpoint_count", len(urls)).Msg("RPC client reloaded")
+
+	return nil
+}
+
+// reloadAllChainClients 检查所有已创建的 RPC 客户端
+func (s *service) reloadAllChainClients(ctx context.Context) {
+	s.clientsMu.RLock()
+	chainIDs := make([]int, 0, len(s.clients)+len(s.solanaClients)+len(s.bitcoinClients))
+	for chainID := range s.clients {
+		chainIDs = append(chainIDs, chainID)
+	}
+	for chainID := range s.solanaClients {
+		chainIDs = append(chainIDs, chainID)
+	}
+	for chainID := range s.bitcoinClients {
+		chainIDs = append(chainIDs, chainID)
+	}
+	s.clientsMu.RUnlock()
+
+	for _, chainID := range chainIDs {
+		if err := s.ReloadChainClients(ctx, chainID); err != nil {
+			log.Error().Err(err).Int("chain_id", chainID).Msg("Failed to reload RPC clients")
+		}
+	}
+}
@@ -3,39 +3,54 @@ package scan
 import (
 	"context"
 	"database/sql"
+	"math/big"
+	"slices"
+	"strings"
 
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 	"github/chapool/go-wallet/internal/models"
 )
 
+// maxReorgDepth 查找共同祖先时最多回溯的区块数，超过时按此深度处理并记录错误日志
+const maxReorgDepth = 128
+
+// blockFetcher 获取规范链上的区块
+type blockFetcher interface {
+	GetBlockByNumber(ctx context.Context, blockNumber *big.Int) (*types.Block, error)
+}
+
 // reorgDetector 区块重组检测器
 type reorgDetector struct {
 	db      *sql.DB
+	client  blockFetcher
 	chainID int
 }
 
 // newReorgDetector 创建重组检测器
-func newReorgDetector(db *sql.DB, chainID int) *reorgDetector {
+func newReorgDetector(db *sql.DB, client blockFetcher, chainID int) *reorgDetector {
 	return &reorgDetector{
 		db:      db,
+		client:  client,
 		chainID: chainID,
 	}
 }
 
 // detectAndHandleReorg 检测并处理区块重组
-func (r *reorgDetector) detectAndHandleReorg(ctx context.Context, block *types.Block) error {
+// 发生重组时返回共同祖先之后、block 之前的规范链区块（按区块号升序），调用方需在 block 之前重新扫描这些区块
+func (r *reorgDetector) detectAndHandleReorg(ctx context.Context, block *types.Block) ([]*types.Block, error) {
 	// 检查父区块是否存在且匹配
 	parentBlock, err := r.getBlockByNumber(ctx, block.Number().Int64()-1)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return errors.Wrap(err, "failed to get parent block")
+		return nil, errors.Wrap(err, "failed to get parent block")
 	}
 
 	// 如果是第一个区块，不需要检查
 	if errors.Is(err, sql.ErrNoRows) {
-		return nil
+		return nil, nil
 	}
 
 	// 检查父区块哈希是否匹配
@@ -47,27 +62,75 @@ func (r *reorgDetector) detectAndHandleReorg(ctx context.Context, block *types.B
 			Str("actual_parent", block.ParentHash().Hex()).
 			Msg("Block reorg detected")
 
-		// 处理重组：回滚到父区块之前
-		if err := r.handleReorg(ctx, block.Number().Int64()-1); err != nil {
-			return errors.Wrap(err, "failed to handle reorg")
+		// 父区块已被替换，从父区块开始回溯到与规范链一致的共同祖先
+		ancestor, canonicalBlocks, err := r.findCommonAncestor(ctx, block.Number().Int64()-1)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find common ancestor")
 		}
+
+		// 处理重组：回滚共同祖先之后的所有区块
+		if err := r.handleReorg(ctx, ancestor); err != nil {
+			return nil, errors.Wrap(err, "failed to handle reorg")
+		}
+
+		return canonicalBlocks, nil
 	}
 
-	return nil
+	return nil, nil
 }
 
-// handleReorg 处理区块重组
-func (r *reorgDetector) handleReorg(ctx context.Context, reorgBlockNumber int64) error {
+// findCommonAncestor 从 fromNumber 开始逐块向前比较本地区块与规范链区块的哈希，返回共同祖先的区块号
+// 以及共同祖先之后、fromNumber 及之前的规范链区块（按区块号升序）
+func (r *reorgDetector) findCommonAncestor(ctx context.Context, fromNumber int64) (int64, []*types.Block, error) {
+	var canonicalBlocks []*types.Block
+
+	number := fromNumber
+	for ; number >= 0 && fromNumber-number < maxReorgDepth; number-- {
+		stored, err := r.getBlockByNumber(ctx, number)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				// 更早的区块未扫描过，无需再比较
+				break
+			}
+			return 0, nil, errors.Wrapf(err, "failed to get block %d", number)
+		}
+
+		canonical, err := r.client.GetBlockByNumber(ctx, big.NewInt(number))
+		if err != nil {
+			return 0, nil, errors.Wrapf(err, "failed to get canonical block %d", number)
+		}
+		if strings.EqualFold(stored.Hash, canonical.Hash().Hex()) {
+			break
+		}
+
+		canonicalBlocks = append(canonicalBlocks, canonical)
+	}
+
+	if fromNumber-number >= maxReorgDepth {
+		log.Error().
+			Int("chain_id", r.chainID).
+			Int64("from_block_number", fromNumber).
+			Int("max_reorg_depth", maxReorgDepth).
+			Msg("No common ancestor found within max reorg depth, rolling back to max depth")
+	}
+
+	slices.Reverse(canonicalBlocks)
+
+	return number, canonicalBlocks, nil
+}
+
+// handleReorg 处理区块重组，回滚 ancestorNumber 之后的所有区块
+func (r *reorgDetector) handleReorg(ctx context.Context, ancestorNumber int64) error {
 	log.Info().
 		Int("chain_id", r.chainID).
-		Int64("reorg_block_number", reorgBlockNumber).
+		Int64("ancestor_block_number", ancestorNumber).
 		Msg("Handling block reorg")
 
-	// 查找需要回滚的区块（从 reorgBlockNumber + 1 开始的所有后续区块）
+	// 查找需要回滚的区块（从 ancestorNumber + 1 开始的所有后续区块）
 	orphanedBlocks, err := models.Blocks(
 		models.BlockWhere.ChainID.EQ(r.chainID),
-		models.BlockWhere.Number.GT(reorgBlockNumber),
-		models.BlockWhere.Status.NEQ("orphaned"),
+		models.BlockWhere.Number.GT(ancestorNumber),
+		models.BlockWhere.Status.NEQ(models.BlockStatusOrphaned),
 		qm.OrderBy("number DESC"),
 	).All(ctx, r.db)
 
@@ -133,6 +196,26 @@ func (r *reorgDetector) rollbackBlock(ctx context.Context, block *models.Block)
 		return errors.Wrap(err, "failed to update transaction status")
 	}
 
+	// 回滚托管中的 NFT，重新打包进规范链区块时恢复
+	_, err = tx.ExecContext(ctx, `
+		UPDATE nft_holdings
+		SET status = 'orphaned', updated_at = NOW()
+		WHERE chain_id = $1 AND block_hash = $2 AND status = 'held'
+	`, r.chainID, block.Hash)
+	if err != nil {
+		return errors.Wrap(err, "failed to update nft holding status")
+	}
+
+	// 回滚热钱包授权记录，重新打包进规范链区块时恢复
+	_, err = tx.ExecContext(ctx, `
+		UPDATE token_approvals
+		SET status = 'orphaned', updated_at = NOW()
+		WHERE chain_id = $1 AND block_hash = $2 AND status = 'active'
+	`, r.chainID, block.Hash)
+	if err != nil {
+		return errors.Wrap(err, "failed to update token approval status")
+	}
+
 	// 回滚相关 Credits 记录（如果有）
 	_, err = tx.ExecContext(ctx, `
 		UPDATE credits 
@@ -154,7 +237,7 @@ func (r *reorgDetector) getBlockByNumber(ctx context.Context, blockNumber int64)
 	block, err := models.Blocks(
 		models.BlockWhere.ChainID.EQ(r.chainID),
 		models.BlockWhere.Number.EQ(blockNumber),
-		models.BlockWhere.Status.NEQ("orphaned"),
+		models.BlockWhere.Status.NEQ(models.BlockStatusOrphaned),
 	).One(ctx, r.db)
 
 	if err != nil {
@@ -163,3 +246,72 @@ func (r *reorgDetector) getBlockByNumber(ctx context.Context, blockNumber int64)
 
 	return block, nil
 }
+
+// reviveReorgedTransaction 恢复因区块重组被标记为 failed、又被打包进规范链区块的交易
+// 交易哈希（同一笔交易中的多笔转账按事件索引区分）唯一，重新扫描时无法再插入，改为更新原记录的区块并重置状态为 confirmed，
+// 对应的 credits 同步恢复为 confirmed，之后随确认数正常推进到 finalized
+func reviveReorgedTransaction(ctx context.Context, db *sql.DB, chainID int, txHash string, blockNumber int64, blockHash string) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rows, err := tx.QueryContext(ctx, `
+		UPDATE transactions SET
+			block_hash = $3,
+			block_no = $4,
+			status = 'confirmed',
+			confirmation_count = 0,
+			updated_at = NOW()
+		WHERE chain_id = $1
+			AND LOWER(tx_hash) = $2
+			AND status = 'failed'
+			AND block_hash IN (SELECT hash FROM blocks WHERE chain_id = $1 AND status = 'orphaned')
+		RETURNING id
+	`, chainID, strings.ToLower(txHash), blockHash, blockNumber)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to revive reorged transaction")
+	}
+	var transactionIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return false, errors.Wrap(err, "failed to scan revived transaction")
+		}
+		transactionIDs = append(transactionIDs, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return false, errors.Wrap(err, "failed to revive reorged transaction")
+	}
+	if len(transactionIDs) == 0 {
+		return false, nil
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE credits SET
+			status = 'confirmed',
+			block_number = $3,
+			updated_at = NOW()
+		WHERE reference_type = $1
+			AND reference_id = ANY($2)
+			AND status = 'failed'
+	`, models.ReferenceTypeBlockchainTX, pq.Array(transactionIDs), blockNumber); err != nil {
+		return false, errors.Wrap(err, "failed to revive reorged credits")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, errors.Wrap(err, "failed to commit transaction")
+	}
+
+	log.Info().
+		Int("chain_id", chainID).
+		Str("tx_hash", txHash).
+		Strs("transaction_ids", transactionIDs).
+		Int64("block_number", blockNumber).
+		Msg("Reorged transaction included in canonical block, revived")
+
+	return true, nil
+}
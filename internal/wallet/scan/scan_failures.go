@@ -0,0 +1,403 @@
+package scan
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/alert"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// 交易分析失败记录状态
+const (
+	ScanFailureStatusPending  = "pending"  // 等待重试
+	ScanFailureStatusResolved = "resolved" // 重新分析成功
+	ScanFailureStatusDead     = "dead"     // 超过最大重试次数，等待管理员排查后重新入队
+	ScanFailureStatusOrphaned = "orphaned" // 区块已被重组回滚，交易在新的规范链区块中重新扫描
+)
+
+const (
+	// scanFailureRetryBatchSize 每轮领取的失败记录数
+	scanFailureRetryBatchSize = 50
+	// scanFailureLeaseTimeout 领取的记录在该时间内不会被其他进程重复领取
+	scanFailureLeaseTimeout = 5 * time.Minute
+	// scanFailureMaxRetryDelay 退避延迟的上限
+	scanFailureMaxRetryDelay = 6 * time.Hour
+
+	scanFailureColumns = `id, chain_id, block_number, block_hash, tx_hash, error, attempts, status, next_retry_at, resolved_at, created_at, updated_at`
+)
+
+// errScanFailureOrphaned 失败交易所在区块已不在规范链上
+var errScanFailureOrphaned = errors.New("block of the failed transaction is no longer canonical")
+
+// ScanFailure 交易分析失败记录（死信队列）
+type ScanFailure struct {
+	ID          string
+	ChainID     int
+	BlockNumber int64
+	BlockHash   string
+	TxHash      string
+	Error       string // 最近一次分析失败原因
+	Attempts    int    // 重试次数
+	Status      string
+	NextRetryAt time.Time
+	ResolvedAt  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ScanFailureFilter 交易分析失败记录查询条件
+type ScanFailureFilter struct {
+	ChainID *int
+	Status  *string
+	Limit   int
+	Offset  int
+}
+
+// recordScanFailure 记录区块内分析失败的交易，同一区块的交易再次失败时只更新失败原因
+func recordScanFailure(ctx context.Context, db *sql.DB, chainID int, block *types.Block, txHash common.Hash, analyzeErr error) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO scan_failures (chain_id, block_number, block_hash, tx_hash, error)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (chain_id, tx_hash, block_hash) DO UPDATE
+		SET error = EXCLUDED.error, updated_at = NOW()
+		WHERE scan_failures.status = 'pending'
+	`, chainID, block.Number().Int64(), block.Hash().Hex(), txHash.Hex(), analyzeErr.Error())
+	if err != nil {
+		return errors.Wrap(err, "failed to record scan failure")
+	}
+
+	return nil
+}
+
+// ListScanFailures 查询交易分析失败记录（按创建时间倒序）
+func (s *service) ListScanFailures(ctx context.Context, filter *ScanFailureFilter) ([]*ScanFailure, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+scanFailureColumns+`
+		FROM scan_failures
+		WHERE ($1::integer IS NULL OR chain_id = $1)
+			AND ($2::text IS NULL OR status = $2)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3 OFFSET $4
+	`, filter.ChainID, filter.Status, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query scan failures")
+	}
+	defer rows.Close()
+
+	failures := make([]*ScanFailure, 0)
+	for rows.Next() {
+		failure, err := scanScanFailure(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan scan failure")
+		}
+		failures = append(failures, failure)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate scan failures")
+	}
+
+	return failures, nil
+}
+
+// RequeueScanFailure 重新入队等待中或已放弃（dead）的失败记录：重置重试次数，由重试 worker 立即重新分析
+func (s *service) RequeueScanFailure(ctx context.Context, failureID string) (*ScanFailure, error) {
+	failure, err := scanScanFailure(s.db.QueryRowContext(ctx, `
+		UPDATE scan_failures
+		SET status = 'pending', attempts = 0, next_retry_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND status IN ('pending', 'dead')
+		RETURNING `+scanFailureColumns,
+		failureID))
+	if err == nil {
+		log.Info().
+			Str("failure_id", failureID).
+			Int("chain_id", failure.ChainID).
+			Str("tx_hash", failure.TxHash).
+			Msg("Scan failure requeued")
+		return failure, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to requeue scan failure")
+	}
+
+	// 区分记录不存在和记录已结束
+	var exists bool
+	if err := s.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM scan_failures WHERE id = $1)`, failureID).Scan(&exists); err != nil {
+		return nil, errors.Wrap(err, "failed to get scan failure")
+	}
+	if !exists {
+		return nil, errors.New("scan failure not found")
+	}
+
+	return nil, errors.New("scan failure is already resolved")
+}
+
+// StartScanFailureWorker 启动交易分析失败重试 worker，按退避计划重新分析失败的交易，
+// 重试 maxAttempts 次仍失败的记录标记为 dead 并告警
+func (s *service) StartScanFailureWorker(ctx context.Context, interval time.Duration, maxAttempts int) {
+	log.Info().
+		Dur("interval", interval).
+		Int("max_attempts", maxAttempts).
+		Msg("Starting scan failure retry worker")
+
+	lifecycle.Go(ctx, "scan failure retry worker", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Scan failure retry worker stopped")
+				return
+			case <-ticker.C:
+				s.retryScanFailures(ctx, interval, maxAttempts)
+			}
+		}
+	})
+}
+
+// retryScanFailures 领取到期的失败记录并逐个重新分析
+func (s *service) retryScanFailures(ctx context.Context, interval time.Duration, maxAttempts int) {
+	rows, err := s.db.QueryContext(ctx, `
+		UPDATE scan_failures
+		SET next_retry_at = NOW() + $1::integer * INTERVAL '1 second', updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM scan_failures
+			WHERE status = 'pending' AND next_retry_at <= NOW()
+			ORDER BY next_retry_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+scanFailureColumns,
+		int64(scanFailureLeaseTimeout.Seconds()), scanFailureRetryBatchSize)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to claim scan failures")
+		return
+	}
+
+	failures := make([]*ScanFailure, 0)
+	for rows.Next() {
+		failure, err := scanScanFailure(rows)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to scan scan failure")
+			continue
+		}
+		failures = append(failures, failure)
+	}
+	if err := rows.Err(); err != nil {
+		log.Error().Err(err).Msg("Failed to iterate scan failures")
+	}
+	rows.Close()
+
+	for _, failure := range failures {
+		if ctx.Err() != nil {
+			return
+		}
+		s.retryScanFailure(ctx, failure, interval, maxAttempts)
+	}
+}
+
+// retryScanFailure 重新分析一条失败记录并更新其状态
+func (s *service) retryScanFailure(ctx context.Context, failure *ScanFailure, interval time.Duration, maxAttempts int) {
+	logger := log.With().
+		Str("failure_id", failure.ID).
+		Int("chain_id", failure.ChainID).
+		Int64("block_number", failure.BlockNumber).
+		Str("tx_hash", failure.TxHash).
+		Logger()
+
+	analyzeErr := s.reanalyzeTransaction(ctx, failure)
+	if analyzeErr != nil && ctx.Err() != nil {
+		// 进程退出，租约到期后重新领取
+		return
+	}
+
+	switch {
+	case analyzeErr == nil:
+		if _, err := s.db.ExecContext(ctx, `
+			UPDATE scan_failures
+			SET status = 'resolved', resolved_at = NOW(), updated_at = NOW()
+			WHERE id = $1 AND status = 'pending'
+		`, failure.ID); err != nil {
+			logger.Error().Err(err).Msg("Failed to mark scan failure as resolved")
+			return
+		}
+		logger.Info().Int("attempts", failure.Attempts+1).Msg("Failed transaction re-analyzed successfully")
+
+	case errors.Is(analyzeErr, errScanFailureOrphaned):
+		if _, err := s.db.ExecContext(ctx, `
+			UPDATE scan_failures
+			SET status = 'orphaned', updated_at = NOW()
+			WHERE id = $1 AND status = 'pending'
+		`, failure.ID); err != nil {
+			logger.Error().Err(err).Msg("Failed to mark scan failure as orphaned")
+			return
+		}
+		logger.Info().Msg("Block of failed transaction was reorganized, transaction is rescanned in the canonical block")
+
+	default:
+		attempts := failure.Attempts + 1
+		status := ScanFailureStatusPending
+		if attempts >= maxAttempts {
+			status = ScanFailureStatusDead
+		}
+
+		if _, err := s.db.ExecContext(ctx, `
+			UPDATE scan_failures
+			SET status = $2, attempts = $3, error = $4,
+				next_retry_at = NOW() + $5::bigint * INTERVAL '1 millisecond', updated_at = NOW()
+			WHERE id = $1 AND status = 'pending'
+		`, failure.ID, status, attempts, analyzeErr.Error(), scanFailureRetryDelay(interval, attempts).Milliseconds()); err != nil {
+			logger.Error().Err(err).Msg("Failed to update scan failure")
+			return
+		}
+
+		if status != ScanFailureStatusDead {
+			logger.Warn().Err(analyzeErr).Int("attempts", attempts).Msg("Failed to re-analyze transaction, retrying later")
+			return
+		}
+
+		logger.Error().Err(analyzeErr).Int("attempts", attempts).Msg("Failed to re-analyze transaction, giving up")
+		s.notify(ctx, &alert.Alert{
+			Type:     alert.TypeScanFailureDead,
+			Severity: alert.SeverityCritical,
+			ChainID:  failure.ChainID,
+			Message:  "Transaction analysis keeps failing, deposits in the transaction may be missing until an admin requeues it",
+			Fields: map[string]any{
+				"failure_id":   failure.ID,
+				"block_number": failure.BlockNumber,
+				"tx_hash":      failure.TxHash,
+				"attempts":     attempts,
+				"error":        analyzeErr.Error(),
+			},
+		})
+	}
+}
+
+// reanalyzeTransaction 重新获取失败交易所在区块并分析该交易，区块已不在规范链上时返回 errScanFailureOrphaned
+func (s *service) reanalyzeTransaction(ctx context.Context, failure *ScanFailure) error {
+	// 区块被重组回滚后，新的规范链区块由扫描器重新扫描
+	var blockStatus string
+	err := s.db.QueryRowContext(ctx, `
+		SELECT status FROM blocks WHERE chain_id = $1 AND hash = $2
+	`, failure.ChainID, failure.BlockHash).Scan(&blockStatus)
+	if errors.Is(err, sql.ErrNoRows) || blockStatus == string(models.BlockStatusOrphaned) {
+		return errScanFailureOrphaned
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to query block status")
+	}
+
+	client, err := s.getOrCreateClient(ctx, failure.ChainID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get RPC client for chain_id=%d", failure.ChainID)
+	}
+
+	block, err := client.GetBlockByNumber(ctx, big.NewInt(failure.BlockNumber))
+	if err != nil {
+		return errors.Wrap(err, "failed to get block")
+	}
+	if block.Hash().Hex() != failure.BlockHash {
+		return errScanFailureOrphaned
+	}
+
+	txIndex := -1
+	for idx, tx := range block.Transactions() {
+		if tx.Hash().Hex() == failure.TxHash {
+			txIndex = idx
+			break
+		}
+	}
+	if txIndex < 0 {
+		return errors.New("transaction not found in block")
+	}
+	tx := block.Transactions()[txIndex]
+
+	analyzer, err := newAnalyzer(ctx, s.db, failure.ChainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to create transaction analyzer")
+	}
+	analyzer.balanceReader = client
+	if s.tokenDiscovery {
+		analyzer.tokenDiscovery = newTokenDiscovery(s.db, client, failure.ChainID)
+	}
+
+	// 转账扣费代币的实际到账金额按整个区块计算，需要区块内所有交易的收据
+	var receipt *types.Receipt
+	if len(analyzer.feeOnTransferTokens) > 0 {
+		chainConfig, err := s.chainService.GetChain(ctx, failure.ChainID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get chain config for chain_id=%d", failure.ChainID)
+		}
+
+		scanner := newChainScanner(s.db, client, s.depositService, s.withdrawStatusUpdater, failure.ChainID, s.resolveScanSettings(chainConfig))
+		receipts, err := scanner.fetchBlockReceipts(ctx, block)
+		if err != nil {
+			return err
+		}
+		if err := analyzer.verifyReceivedAmounts(ctx, failure.ChainID, block.Number(), receipts); err != nil {
+			return errors.Wrap(err, "failed to verify fee-on-transfer received amounts")
+		}
+		receipt = receipts[txIndex]
+	} else {
+		receipt, err = client.GetTransactionReceipt(ctx, tx.Hash())
+		if err != nil {
+			return errors.Wrap(err, "failed to get transaction receipt")
+		}
+		if receipt.BlockHash != block.Hash() {
+			return errScanFailureOrphaned
+		}
+	}
+
+	return analyzer.analyzeTransaction(ctx, failure.ChainID, tx, receipt, block.Number(), block.Hash())
+}
+
+// scanFailureRetryDelay 第 attempts 次重试失败后的等待时间：从重试间隔开始每次翻倍，不超过 scanFailureMaxRetryDelay
+func scanFailureRetryDelay(interval time.Duration, attempts int) time.Duration {
+	delay := interval
+	for i := 1; i < attempts && delay < scanFailureMaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, scanFailureMaxRetryDelay)
+}
+
+// scanScanFailure 扫描一行交易分析失败记录
+func scanScanFailure(row rowScanner) (*ScanFailure, error) {
+	var (
+		failure    ScanFailure
+		resolvedAt sql.NullTime
+	)
+
+	if err := row.Scan(
+		&failure.ID,
+		&failure.ChainID,
+		&failure.BlockNumber,
+		&failure.BlockHash,
+		&failure.TxHash,
+		&failure.Error,
+		&failure.Attempts,
+		&failure.Status,
+		&failure.NextRetryAt,
+		&resolvedAt,
+		&failure.CreatedAt,
+		&failure.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if resolvedAt.Valid {
+		failure.ResolvedAt = &resolvedAt.Time
+	}
+
+	return &failure, nil
+}
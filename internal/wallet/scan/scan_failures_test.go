@@ -0,0 +1,28 @@
+package scan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanFailureRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{4, 8 * time.Minute},
+		{9, 256 * time.Minute},
+		{10, scanFailureMaxRetryDelay},
+		{1000, scanFailureMaxRetryDelay},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, scanFailureRetryDelay(time.Minute, tt.attempts), "attempts %d", tt.attempts)
+	}
+}
@@ -3,13 +3,20 @@ package scan
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"math/big"
+	"sync/atomic"
 	"time"
 
+	walletMetrics "github/chapool/go-wallet/internal/metrics/wallet"
 	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/alert"
 	"github/chapool/go-wallet/internal/wallet/deposit"
 
 	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
@@ -18,6 +25,12 @@ import (
 const (
 	// rapidRescanDelay 快速链重新扫描延迟（避免过于频繁的RPC调用）
 	rapidRescanDelay = 100 * time.Millisecond
+	// newHeadsResubscribeDelay WebSocket 订阅断开后的重试间隔
+	newHeadsResubscribeDelay = 5 * time.Second
+	// newHeadsStaleTimeout 超过该时间未收到新区块通知时，回退到定时轮询
+	newHeadsStaleTimeout = 30 * time.Second
+	// newHeadsBufferSize 新区块头通道缓冲大小
+	newHeadsBufferSize = 16
 )
 
 // chainScanner 单个链的扫描器
@@ -27,23 +40,41 @@ type chainScanner struct {
 	depositService        deposit.Service
 	withdrawStatusUpdater WithdrawStatusUpdater
 	chainID               int
-	scanInterval          time.Duration
-	blockBatchSize        int
+	settings              atomic.Pointer[ScanSettings] // 运行中可由管理员调整
 	stopCh                chan struct{}
+	lastHeadAt            atomic.Int64 // 最近一次收到 WebSocket 新区块通知的时间（UnixNano）
+	headMonitor           *headMonitor // 出块停滞检测，临时扫描器（单区块扫描、补扫）为 nil
+	notifier              alert.Notifier
+	tokenDiscovery        *tokenDiscovery // 未启用代币自动发现时为 nil
 }
 
 // newChainScanner 创建新的链扫描器
-func newChainScanner(db *sql.DB, client *RPCClient, depositService deposit.Service, withdrawStatusUpdater WithdrawStatusUpdater, chainID int, scanInterval time.Duration, blockBatchSize int) *chainScanner {
-	return &chainScanner{
+func newChainScanner(db *sql.DB, client *RPCClient, depositService deposit.Service, withdrawStatusUpdater WithdrawStatusUpdater, chainID int, settings ScanSettings) *chainScanner {
+	scanner := &chainScanner{
 		db:                    db,
 		client:                client,
 		depositService:        depositService,
 		withdrawStatusUpdater: withdrawStatusUpdater,
 		chainID:               chainID,
-		scanInterval:          scanInterval,
-		blockBatchSize:        blockBatchSize,
 		stopCh:                make(chan struct{}),
 	}
+	scanner.settings.Store(&settings)
+	return scanner
+}
+
+// currentSettings 当前生效的扫描参数
+func (s *chainScanner) currentSettings() ScanSettings {
+	return *s.settings.Load()
+}
+
+// setSettings 更新扫描参数，下一轮扫描生效，返回参数是否变化
+func (s *chainScanner) setSettings(settings ScanSettings) bool {
+	return *s.settings.Swap(&settings) != settings
+}
+
+// stop 停止扫描循环，只能调用一次（调用方从扫描器列表中移除后调用）
+func (s *chainScanner) stop() {
+	close(s.stopCh)
 }
 
 // start 启动扫描循环
@@ -57,7 +88,9 @@ func (s *chainScanner) start(ctx context.Context) error {
 	}
 
 	// 启动扫描循环
-	go s.scanLoop(ctx, startBlock)
+	lifecycle.Go(ctx, fmt.Sprintf("chain scanner %d", s.chainID), func() {
+		s.scanLoop(ctx, startBlock)
+	})
 
 	return nil
 }
@@ -97,9 +130,11 @@ func (s *chainScanner) getStartBlock(ctx context.Context) (*big.Int, error) {
 }
 
 // scanLoop 扫描循环
+// 扫描参数在每轮开始时读取，调整扫描间隔后重置 ticker
 func (s *chainScanner) scanLoop(ctx context.Context, startBlock *big.Int) {
 	currentBlock := new(big.Int).Set(startBlock)
-	ticker := time.NewTicker(s.scanInterval)
+	scanInterval := s.currentSettings().ScanInterval
+	ticker := time.NewTicker(scanInterval)
 	defer ticker.Stop()
 
 	// 立即执行一次扫描，不等待第一个ticker
@@ -114,12 +149,16 @@ func (s *chainScanner) scanLoop(ctx context.Context, startBlock *big.Int) {
 			return false
 		}
 
+		// 检测出块停滞，节点卡住时切换节点后使用新节点的区块号
+		latestBlock = s.checkHeadProgress(ctx, latestBlock)
+
+		blockBatchSize := s.currentSettings().BlockBatchSize
 		hasNewBlocks := false
 		// 批量扫描区块
 		for currentBlock.Cmp(latestBlock) <= 0 {
 			hasNewBlocks = true
 			// 计算批次结束区块号
-			endBlock := new(big.Int).Add(currentBlock, big.NewInt(int64(s.blockBatchSize-1)))
+			endBlock := new(big.Int).Add(currentBlock, big.NewInt(int64(blockBatchSize-1)))
 			if endBlock.Cmp(latestBlock) > 0 {
 				endBlock = new(big.Int).Set(latestBlock)
 			}
@@ -139,12 +178,22 @@ func (s *chainScanner) scanLoop(ctx context.Context, startBlock *big.Int) {
 			currentBlock = new(big.Int).Add(endBlock, big.NewInt(1))
 		}
 
+		s.recordScanProgress(latestBlock, currentBlock)
+
 		// 无论是否有新区块，都需要更新交易状态和处理已终结的充值
 		// 这对于快速链特别重要，因为交易状态需要及时更新
 		s.runPostScanHooks(ctx, latestBlock)
 		return hasNewBlocks
 	}
 
+	// 链配置中包含 ws:// 地址时，通过 newHeads 订阅驱动扫描，轮询作为兜底
+	newHeadCh := make(chan struct{}, 1)
+	if s.client.HasWebSocketEndpoint() {
+		lifecycle.Go(ctx, fmt.Sprintf("new heads watcher %d", s.chainID), func() {
+			s.watchNewHeads(ctx, newHeadCh)
+		})
+	}
+
 	// 立即执行一次
 	scanOnce()
 
@@ -156,7 +205,19 @@ func (s *chainScanner) scanLoop(ctx context.Context, startBlock *big.Int) {
 		case <-s.stopCh:
 			log.Info().Int("chain_id", s.chainID).Msg("Chain scanner stopped")
 			return
+		case <-newHeadCh:
+			scanOnce()
 		case <-ticker.C:
+			if interval := s.currentSettings().ScanInterval; interval != scanInterval {
+				scanInterval = interval
+				ticker.Reset(scanInterval)
+			}
+
+			// 订阅正常工作时由新区块通知驱动扫描，跳过轮询以节省 RPC 配额
+			if s.newHeadsSubscriptionHealthy() {
+				continue
+			}
+
 			// 执行扫描
 			hasNewBlocks := scanOnce()
 			// 如果有新区块，立即再检查一次（不等待ticker）
@@ -170,6 +231,86 @@ func (s *chainScanner) scanLoop(ctx context.Context, startBlock *big.Int) {
 	}
 }
 
+// recordScanProgress 更新扫描进度指标，nextBlock 为下一个待扫描的区块
+func (s *chainScanner) recordScanProgress(latestBlock, nextBlock *big.Int) {
+	recordScanProgress(s.chainID, latestBlock.Int64(), nextBlock.Int64())
+}
+
+// recordScanProgress 更新扫描进度指标（区块号或 slot），next 为下一个待扫描的区块
+func recordScanProgress(chainID int, latest, next int64) {
+	chain := walletMetrics.ChainLabel(chainID)
+	scanned := next - 1
+
+	walletMetrics.ScanLatestBlock.WithLabelValues(chain).Set(float64(latest))
+	walletMetrics.ScanScannedBlock.WithLabelValues(chain).Set(float64(scanned))
+	walletMetrics.ScanLagBlocks.WithLabelValues(chain).Set(float64(max(latest-scanned, 0)))
+}
+
+// watchNewHeads 通过 WebSocket 订阅新区块头，收到新区块时通知扫描循环
+// 订阅失败或断开后会定期重试，期间扫描循环回退到定时轮询
+func (s *chainScanner) watchNewHeads(ctx context.Context, notify chan<- struct{}) {
+	for {
+		headers := make(chan *types.Header, newHeadsBufferSize)
+		sub, err := s.client.SubscribeNewHeads(ctx, headers)
+		if err != nil {
+			log.Warn().
+				Int("chain_id", s.chainID).
+				Err(err).
+				Msg("Failed to subscribe to new heads, falling back to polling")
+		} else {
+			log.Info().Int("chain_id", s.chainID).Msg("Subscribed to new heads via WebSocket")
+			s.consumeNewHeads(ctx, sub, headers, notify)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case <-time.After(newHeadsResubscribeDelay):
+		}
+	}
+}
+
+// consumeNewHeads 消费新区块头通知，直到订阅出错或扫描器停止
+func (s *chainScanner) consumeNewHeads(ctx context.Context, sub ethereum.Subscription, headers <-chan *types.Header, notify chan<- struct{}) {
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case err := <-sub.Err():
+			s.lastHeadAt.Store(0)
+			log.Warn().
+				Int("chain_id", s.chainID).
+				Err(err).
+				Msg("New heads subscription dropped, falling back to polling")
+			return
+		case header := <-headers:
+			s.lastHeadAt.Store(time.Now().UnixNano())
+			log.Debug().
+				Int("chain_id", s.chainID).
+				Str("block_number", header.Number.String()).
+				Msg("Received new head")
+
+			// 非阻塞通知，扫描进行中时合并多个通知
+			select {
+			case notify <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// newHeadsSubscriptionHealthy 判断 WebSocket 订阅是否在最近一段时间内正常推送新区块
+func (s *chainScanner) newHeadsSubscriptionHealthy() bool {
+	lastHeadAt := s.lastHeadAt.Load()
+	return lastHeadAt > 0 && time.Since(time.Unix(0, lastHeadAt)) < newHeadsStaleTimeout
+}
+
 // scanBlockRange 扫描区块范围
 func (s *chainScanner) scanBlockRange(ctx context.Context, startBlock, endBlock *big.Int) error {
 	current := new(big.Int).Set(startBlock)
@@ -194,11 +335,44 @@ func (s *chainScanner) scanBlock(ctx context.Context, blockNumber *big.Int) erro
 	}
 
 	// 检测区块重组
-	reorgDetector := newReorgDetector(s.db, s.chainID)
-	if err := reorgDetector.detectAndHandleReorg(ctx, block); err != nil {
+	reorgDetector := newReorgDetector(s.db, s.client, s.chainID)
+	canonicalBlocks, err := reorgDetector.detectAndHandleReorg(ctx, block)
+	if err != nil {
 		return errors.Wrap(err, "failed to detect and handle reorg")
 	}
 
+	// 重新扫描替换孤块的规范链区块，否则新分支上的充值会被遗漏
+	for _, canonicalBlock := range canonicalBlocks {
+		if err := s.indexBlock(ctx, canonicalBlock); err != nil {
+			return errors.Wrapf(err, "failed to rescan canonical block %s", canonicalBlock.Number().String())
+		}
+	}
+	if len(canonicalBlocks) > 0 {
+		log.Info().
+			Int("chain_id", s.chainID).
+			Int64("from_block_number", canonicalBlocks[0].Number().Int64()).
+			Int64("to_block_number", canonicalBlocks[len(canonicalBlocks)-1].Number().Int64()).
+			Msg("Rescanned canonical blocks after reorg")
+	}
+
+	return s.indexBlock(ctx, block)
+}
+
+// backfillBlock 补扫单个历史区块
+// 历史区块早已终结，不做重组检测：父区块缺失或与已扫描区块不连续时，重组处理会误将后续实时扫描的区块标记为孤块
+func (s *chainScanner) backfillBlock(ctx context.Context, blockNumber *big.Int) error {
+	block, err := s.client.GetBlockByNumber(ctx, blockNumber)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get block %s", blockNumber.String())
+	}
+
+	return s.indexBlock(ctx, block)
+}
+
+// indexBlock 保存区块并分析其中的交易，已扫描过的区块直接跳过
+func (s *chainScanner) indexBlock(ctx context.Context, block *types.Block) error {
+	blockNumber := block.Number()
+
 	// 检查区块是否已存在
 	exists, err := s.blockExists(ctx, block.Hash().Hex(), blockNumber.Int64())
 	if err != nil {
@@ -210,15 +384,34 @@ func (s *chainScanner) scanBlock(ctx context.Context, blockNumber *big.Int) erro
 		return nil
 	}
 
+	// 创建交易分析器（在保存区块前加载自定义转账事件配置，加载失败时整个区块稍后重试）
+	analyzer, err := newAnalyzer(ctx, s.db, s.chainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to create transaction analyzer")
+	}
+	analyzer.tokenDiscovery = s.tokenDiscovery
+	analyzer.balanceReader = s.client
+
+	// 在保存区块前获取所有收据，部分收据获取失败时区块不保存，下一轮整体重试
+	receipts, err := s.fetchBlockReceipts(ctx, block)
+	if err != nil {
+		return err
+	}
+
+	// 转账扣费代币的实际到账金额同样在保存区块前查询，失败时整个区块稍后重试
+	if err := analyzer.verifyReceivedAmounts(ctx, s.chainID, blockNumber, receipts); err != nil {
+		return errors.Wrap(err, "failed to verify fee-on-transfer received amounts")
+	}
+
 	// 保存区块信息
 	if err := s.saveBlock(ctx, block); err != nil {
 		return errors.Wrap(err, "failed to save block")
 	}
 
-	// 处理区块中的交易
-	if err := s.processBlockTransactions(ctx, block); err != nil {
-		return errors.Wrap(err, "failed to process block transactions")
-	}
+	// 按区块内顺序处理交易
+	s.processBlockTransactions(ctx, analyzer, block, receipts)
+
+	walletMetrics.BlocksScanned.WithLabelValues(walletMetrics.ChainLabel(s.chainID)).Inc()
 
 	log.Debug().
 		Int("chain_id", s.chainID).
@@ -230,13 +423,13 @@ func (s *chainScanner) scanBlock(ctx context.Context, blockNumber *big.Int) erro
 	return nil
 }
 
-// blockExists 检查区块是否已存在
+// blockExists 检查区块是否已存在，重组中被标记为 orphaned 的区块不计入，同高度的规范链区块需要重新扫描
 func (s *chainScanner) blockExists(ctx context.Context, blockHash string, blockNumber int64) (bool, error) {
 	var count int64
 	err := s.db.QueryRowContext(ctx, `
 		SELECT COUNT(*) 
 		FROM blocks 
-		WHERE chain_id = $1 AND (hash = $2 OR number = $3)
+		WHERE chain_id = $1 AND (hash = $2 OR number = $3) AND status <> 'orphaned'
 	`, s.chainID, blockHash, blockNumber).Scan(&count)
 
 	if err != nil {
@@ -247,100 +440,133 @@ func (s *chainScanner) blockExists(ctx context.Context, blockHash string, blockN
 }
 
 // saveBlock 保存区块信息
+// 重组后又回到原分支时区块哈希已存在（orphaned），恢复为 confirmed
 func (s *chainScanner) saveBlock(ctx context.Context, block *types.Block) error {
 	blockModel := &models.Block{
 		Hash:       block.Hash().Hex(),
 		ChainID:    s.chainID,
 		ParentHash: block.ParentHash().Hex(),
 		Number:     block.Number().Int64(),
-		Timestamp:  int64(block.Time()), //nolint:gosec // Block timestamp is safe to convert
-		Status:     "confirmed",         // 初始状态为 confirmed
+		Timestamp:  int64(block.Time()),         //nolint:gosec // Block timestamp is safe to convert
+		Status:     models.BlockStatusConfirmed, // 初始状态为 confirmed
 	}
 
-	return blockModel.Insert(ctx, s.db, boil.Infer())
+	return blockModel.Upsert(ctx, s.db, true,
+		[]string{models.BlockColumns.Hash, models.BlockColumns.ChainID},
+		boil.Whitelist(models.BlockColumns.Status, models.BlockColumns.UpdatedAt),
+		boil.Infer(),
+	)
 }
 
-// processBlockTransactions 处理区块中的交易
-//
-//nolint:unparam // Error return is required for future error handling
-func (s *chainScanner) processBlockTransactions(ctx context.Context, block *types.Block) error {
-	analyzer := newAnalyzer(s.db)
+// fetchBlockReceipts 按链配置的收据并发数获取区块中所有交易的收据，顺序与区块内交易顺序一致
+// 配置了 JSON-RPC 批量大小时以批量请求获取，并发数为同时进行的批量请求数
+func (s *chainScanner) fetchBlockReceipts(ctx context.Context, block *types.Block) ([]*types.Receipt, error) {
+	transactions := block.Transactions()
+	txHashes := make([]common.Hash, 0, len(transactions))
+	for _, tx := range transactions {
+		txHashes = append(txHashes, tx.Hash())
+	}
+
+	concurrency := s.currentSettings().MaxReceiptConcurrency
+	if batchSize := s.client.BatchSize(); batchSize > 0 {
+		return fetchReceiptsInBatches(ctx, block.Number(), txHashes, batchSize, concurrency, s.client.BatchTransactionReceipts)
+	}
 
-	// 获取所有交易的收据
-	for _, tx := range block.Transactions() {
-		receipt, err := s.client.GetTransactionReceipt(ctx, tx.Hash())
-		if err != nil {
-			log.Warn().
-				Str("tx_hash", tx.Hash().Hex()).
-				Err(err).
-				Msg("Failed to get transaction receipt, skipping")
-			continue
-		}
+	return fetchReceipts(ctx, block.Number(), txHashes, concurrency, s.client.GetTransactionReceipt)
+}
 
-		// 分析交易
-		if err := analyzer.analyzeTransaction(ctx, s.chainID, tx, receipt, block.Number(), block.Hash()); err != nil {
-			log.Warn().
-				Str("tx_hash", tx.Hash().Hex()).
-				Err(err).
-				Msg("Failed to analyze transaction, skipping")
-			// 继续处理其他交易
-			continue
+// processBlockTransactions 按区块内顺序分析交易，receipts 与区块交易一一对应
+// 单笔交易分析失败时跳过并记录到 scan_failures，由重试 worker 重新分析，区块内的失败汇总记录一条日志
+func (s *chainScanner) processBlockTransactions(ctx context.Context, analyzer *analyzer, block *types.Block, receipts []*types.Receipt) {
+	var (
+		failed   int
+		firstErr error
+		firstTx  common.Hash
+	)
+	for idx, tx := range block.Transactions() {
+		if err := analyzer.analyzeTransaction(ctx, s.chainID, tx, receipts[idx], block.Number(), block.Hash()); err != nil {
+			if failed == 0 {
+				firstErr, firstTx = err, tx.Hash()
+			}
+			failed++
+
+			if recordErr := recordScanFailure(ctx, s.db, s.chainID, block, tx.Hash(), err); recordErr != nil {
+				log.Error().
+					Int("chain_id", s.chainID).
+					Str("tx_hash", tx.Hash().Hex()).
+					Err(recordErr).
+					Msg("Failed to record transaction analysis failure, transaction will not be retried")
+			}
 		}
 	}
 
-	return nil
+	if failed > 0 {
+		log.Warn().
+			Int("chain_id", s.chainID).
+			Str("block_number", block.Number().String()).
+			Int("failed_count", failed).
+			Int("tx_count", len(block.Transactions())).
+			Str("first_failed_tx_hash", firstTx.Hex()).
+			Err(firstErr).
+			Msg("Failed to analyze transactions, queued for retry")
+	}
 }
 
 func (s *chainScanner) runPostScanHooks(ctx context.Context, latestBlock *big.Int) {
-	if s.depositService == nil || latestBlock == nil {
+	runPostScanHooks(ctx, s.chainID, s.depositService, s.withdrawStatusUpdater, latestBlock)
+}
+
+// runPostScanHooks 扫描后更新交易确认状态、处理已终结的充值并更新提现状态
+func runPostScanHooks(ctx context.Context, chainID int, depositService deposit.Service, withdrawStatusUpdater WithdrawStatusUpdater, latestBlock *big.Int) {
+	if depositService == nil || latestBlock == nil {
 		log.Warn().
-			Int("chain_id", s.chainID).
+			Int("chain_id", chainID).
 			Msg("Skipping post-scan hooks: depositService is nil or latestBlock is nil")
 		return
 	}
 
 	log.Debug().
-		Int("chain_id", s.chainID).
+		Int("chain_id", chainID).
 		Int64("latest_block", latestBlock.Int64()).
 		Msg("Running post-scan hooks: updating transaction status and processing finalized deposits")
 
 	// 更新交易确认状态（confirmed -> safe -> finalized）
-	if err := s.depositService.UpdateConfirmationStatus(ctx, s.chainID, latestBlock.Int64()); err != nil {
+	if err := depositService.UpdateConfirmationStatus(ctx, chainID, latestBlock.Int64()); err != nil {
 		log.Error().
-			Int("chain_id", s.chainID).
+			Int("chain_id", chainID).
 			Int64("latest_block", latestBlock.Int64()).
 			Err(err).
 			Msg("Failed to update deposit confirmations")
 	} else {
 		log.Debug().
-			Int("chain_id", s.chainID).
+			Int("chain_id", chainID).
 			Int64("latest_block", latestBlock.Int64()).
 			Msg("Successfully updated transaction confirmation status")
 	}
 
 	// 处理已终结的充值（创建 credits 记录）
-	if err := s.depositService.ProcessFinalizedDeposits(ctx, s.chainID); err != nil {
+	if err := depositService.ProcessFinalizedDeposits(ctx, chainID); err != nil {
 		log.Error().
-			Int("chain_id", s.chainID).
+			Int("chain_id", chainID).
 			Err(err).
 			Msg("Failed to process finalized deposits")
 	} else {
 		log.Debug().
-			Int("chain_id", s.chainID).
+			Int("chain_id", chainID).
 			Msg("Successfully processed finalized deposits")
 	}
 
 	// 更新提现状态（pending -> processing -> confirmed）
-	if s.withdrawStatusUpdater != nil {
-		if err := s.withdrawStatusUpdater.UpdateWithdrawStatus(ctx, s.chainID, latestBlock.Int64()); err != nil {
+	if withdrawStatusUpdater != nil {
+		if err := withdrawStatusUpdater.UpdateWithdrawStatus(ctx, chainID, latestBlock.Int64()); err != nil {
 			log.Error().
-				Int("chain_id", s.chainID).
+				Int("chain_id", chainID).
 				Int64("latest_block", latestBlock.Int64()).
 				Err(err).
 				Msg("Failed to update withdraw status")
 		} else {
 			log.Debug().
-				Int("chain_id", s.chainID).
+				Int("chain_id", chainID).
 				Int64("latest_block", latestBlock.Int64()).
 				Msg("Successfully updated withdraw status")
 		}
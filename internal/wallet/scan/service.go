@@ -3,14 +3,22 @@ package scan
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/alert"
+	"github/chapool/go-wallet/internal/wallet/bitcoin"
 	"github/chapool/go-wallet/internal/wallet/chain"
 	"github/chapool/go-wallet/internal/wallet/deposit"
+	"github/chapool/go-wallet/internal/wallet/solana"
 )
 
 // service 实现 Service 接口
@@ -23,37 +31,60 @@ type service struct {
 	clientsMu             sync.RWMutex
 	scanners              map[int]*chainScanner // chainID -> scanner
 	scannersMu            sync.RWMutex
-	scanInterval          time.Duration
-	blockBatchSize        int
+	solanaClients         map[int]*solana.Client  // chainID -> Solana RPC 客户端
+	solanaScanners        map[int]*solanaScanner  // chainID -> Solana 扫描器
+	bitcoinClients        map[int]*bitcoin.Client // chainID -> Bitcoin RPC 客户端
+	bitcoinScanners       map[int]*bitcoinScanner // chainID -> Bitcoin 扫描器
+	// scanInterval、blockBatchSize 全局扫描参数，链未配置时使用（见 resolveScanSettings）
+	scanInterval   time.Duration
+	blockBatchSize int
+	// rpcBatchSize 每个 JSON-RPC 批量请求的最大请求数，0 表示逐个发送
+	rpcBatchSize int
+	// backfillBlocksPerSecond 补扫限速（每秒区块数），0 表示不限速
+	backfillBlocksPerSecond int
+	// haltThreshold 最新区块超过该时间不变时检查其他节点并告警，0 表示不检测
+	haltThreshold time.Duration
+	notifier      alert.Notifier
+	// tokenDiscovery 是否自动登记用户地址收到的未知代币（登记为未启用，等待管理员审核）
+	tokenDiscovery bool
+	// chainIDMismatches RPC 节点链 ID 与配置不一致的链，本进程内不再为其创建客户端
+	chainIDMismatches map[int]*ChainIDMismatchError
+	// scanStarted 本进程已启动多链扫描，链重新启用后自动恢复扫描
+	scanStarted atomic.Bool
 }
 
 // NewService 创建扫描服务
 //
 //nolint:ireturn
-func NewService(db *sql.DB, chainService chain.Service, depositService deposit.Service, withdrawStatusUpdater WithdrawStatusUpdater, scanInterval time.Duration, blockBatchSize int) Service {
+func NewService(db *sql.DB, chainService chain.Service, depositService deposit.Service, withdrawStatusUpdater WithdrawStatusUpdater, scanInterval time.Duration, blockBatchSize int, rpcBatchSize int, backfillBlocksPerSecond int, haltThreshold time.Duration, notifier alert.Notifier, tokenDiscovery bool) Service {
 	return &service{
-		db:                    db,
-		chainService:          chainService,
-		depositService:        depositService,
-		withdrawStatusUpdater: withdrawStatusUpdater,
-		clients:               make(map[int]*RPCClient),
-		scanners:              make(map[int]*chainScanner),
-		scanInterval:          scanInterval,
-		blockBatchSize:        blockBatchSize,
+		db:                      db,
+		chainService:            chainService,
+		depositService:          depositService,
+		withdrawStatusUpdater:   withdrawStatusUpdater,
+		clients:                 make(map[int]*RPCClient),
+		scanners:                make(map[int]*chainScanner),
+		solanaClients:           make(map[int]*solana.Client),
+		solanaScanners:          make(map[int]*solanaScanner),
+		bitcoinClients:          make(map[int]*bitcoin.Client),
+		bitcoinScanners:         make(map[int]*bitcoinScanner),
+		chainIDMismatches:       make(map[int]*ChainIDMismatchError),
+		scanInterval:            scanInterval,
+		blockBatchSize:          blockBatchSize,
+		rpcBatchSize:            rpcBatchSize,
+		backfillBlocksPerSecond: backfillBlocksPerSecond,
+		haltThreshold:           haltThreshold,
+		notifier:                notifier,
+		tokenDiscovery:          tokenDiscovery,
 	}
 }
 
 // GetScanProgress 获取扫描进度
 func (s *service) GetScanProgress(ctx context.Context, chainID int) (*ScanProgress, error) {
 	// 获取最新区块号
-	client, err := s.getOrCreateClient(ctx, chainID)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get RPC client for chain_id=%d", chainID)
-	}
-
-	latestBlock, err := client.GetLatestBlockNumber(ctx)
+	latestBlock, err := s.getLatestBlockNumber(ctx, chainID)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get latest block number")
+		return nil, err
 	}
 
 	// 查询已扫描的最大区块号
@@ -71,7 +102,7 @@ func (s *service) GetScanProgress(ctx context.Context, chainID int) (*ScanProgre
 	progress := &ScanProgress{
 		ChainID:     chainID,
 		LatestBlock: latestBlock,
-		Status:      "scanning",
+		Status:      ScanStatusStopped,
 	}
 
 	if scannedTo.Valid {
@@ -80,12 +111,144 @@ func (s *service) GetScanProgress(ctx context.Context, chainID int) (*ScanProgre
 		progress.ScannedTo = big.NewInt(0)
 	}
 
+	s.applyScannerStatus(progress)
+
 	return progress, nil
 }
 
+// GetScanStatus 获取所有启用链的扫描状态，单条链 RPC 不可用时记录在该链的状态中
+func (s *service) GetScanStatus(ctx context.Context) ([]*ScanProgress, error) {
+	chains, err := s.chainService.GetActiveChains(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get active chains")
+	}
+
+	statuses := make([]*ScanProgress, 0, len(chains))
+	for _, chainConfig := range chains {
+		progress, err := s.GetScanProgress(ctx, chainConfig.ChainID)
+		if err != nil {
+			log.Warn().Err(err).Int("chain_id", chainConfig.ChainID).Msg("Failed to get scan progress")
+			progress = &ScanProgress{
+				ChainID: chainConfig.ChainID,
+				Status:  ScanStatusRPCUnavailable,
+				Error:   err.Error(),
+			}
+			var mismatch *ChainIDMismatchError
+			if errors.As(err, &mismatch) {
+				progress.Status = ScanStatusChainIDMismatch
+				progress.Error = mismatch.Error()
+			}
+			s.applyScannerStatus(progress)
+		}
+		statuses = append(statuses, progress)
+	}
+
+	return statuses, nil
+}
+
+// getLatestBlockNumber 获取指定链的最新区块号，Solana 链为 confirmed 级别的最新 slot，Bitcoin 链为最新区块高度
+func (s *service) getLatestBlockNumber(ctx context.Context, chainID int) (*big.Int, error) {
+	chainConfig, err := s.chainService.GetChain(ctx, chainID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get chain config for chain_id=%d", chainID)
+	}
+
+	if chainConfig.ChainType == chain.TypeSolana {
+		client, err := s.GetSolanaClient(ctx, chainID)
+		if err != nil {
+			return nil, err
+		}
+
+		latestSlot, err := client.GetSlot(ctx, solana.CommitmentConfirmed)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get latest slot")
+		}
+
+		return new(big.Int).SetUint64(latestSlot), nil
+	}
+
+	if chainConfig.ChainType == chain.TypeBitcoin {
+		client, err := s.GetBitcoinClient(ctx, chainID)
+		if err != nil {
+			return nil, err
+		}
+
+		latestHeight, err := client.GetBlockCount(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return big.NewInt(latestHeight), nil
+	}
+
+	client, err := s.getOrCreateClient(ctx, chainID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get RPC client for chain_id=%d", chainID)
+	}
+
+	latestBlock, err := client.GetLatestBlockNumber(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get latest block number")
+	}
+
+	return latestBlock, nil
+}
+
+// applyScannerStatus 填充扫描器运行状态和出块停滞检测结果
+func (s *service) applyScannerStatus(progress *ScanProgress) {
+	s.scannersMu.RLock()
+	scanner, exists := s.scanners[progress.ChainID]
+	solScanner, solExists := s.solanaScanners[progress.ChainID]
+	btcScanner, btcExists := s.bitcoinScanners[progress.ChainID]
+	s.scannersMu.RUnlock()
+
+	if btcExists {
+		if progress.Status == ScanStatusStopped {
+			progress.Status = ScanStatusScanning
+		}
+		progress.RPCEndpoint = btcScanner.client.CurrentEndpoint()
+		progress.RPCEndpointCount = btcScanner.client.EndpointCount()
+		return
+	}
+
+	if solExists {
+		if progress.Status == ScanStatusStopped {
+			progress.Status = ScanStatusScanning
+		}
+		progress.RPCEndpoint = solScanner.client.CurrentEndpoint()
+		progress.RPCEndpointCount = solScanner.client.EndpointCount()
+		return
+	}
+
+	if !exists {
+		return
+	}
+
+	if progress.Status == ScanStatusStopped {
+		progress.Status = ScanStatusScanning
+	}
+	progress.RPCEndpoint = scanner.client.CurrentEndpoint()
+	progress.RPCEndpointCount = scanner.client.EndpointCount()
+
+	if scanner.headMonitor == nil {
+		return
+	}
+
+	head := scanner.headMonitor.status()
+	if !head.LastHeadChangeAt.IsZero() {
+		lastHeadChangeAt := head.LastHeadChangeAt
+		progress.LastHeadChangeAt = &lastHeadChangeAt
+	}
+	if head.Halted {
+		progress.Halted = true
+		progress.Status = ScanStatusHalted
+	}
+}
+
 // StartMultiChainScan 启动多链并发扫描
 func (s *service) StartMultiChainScan(ctx context.Context) error {
 	log.Info().Msg("Starting multi-chain scan")
+	s.scanStarted.Store(true)
 
 	// 获取所有启用的链
 	chains, err := s.chainService.GetActiveChains(ctx)
@@ -103,14 +266,14 @@ func (s *service) StartMultiChainScan(ctx context.Context) error {
 	// 为每个链启动独立的扫描 goroutine
 	for _, chainConfig := range chains {
 		chainID := chainConfig.ChainID
-		go func(cid int) {
-			if err := s.StartChainScan(ctx, cid); err != nil {
+		lifecycle.Go(ctx, fmt.Sprintf("chain scan start %d", chainID), func() {
+			if err := s.StartChainScan(ctx, chainID); err != nil {
 				log.Error().
-					Int("chain_id", cid).
+					Int("chain_id", chainID).
 					Err(err).
 					Msg("Failed to start chain scan")
 			}
-		}(chainID)
+		})
 	}
 
 	log.Info().Msg("Multi-chain scan started, all scanners running in background")
@@ -131,6 +294,14 @@ func (s *service) StartChainScan(ctx context.Context, chainID int) error {
 		return errors.Errorf("chain %d is not active, cannot start scan", chainID)
 	}
 
+	if chainConfig.ChainType == chain.TypeSolana {
+		return s.startSolanaScan(ctx, chainConfig)
+	}
+
+	if chainConfig.ChainType == chain.TypeBitcoin {
+		return s.startBitcoinScan(ctx, chainConfig)
+	}
+
 	// 获取或创建 RPC 客户端
 	client, err := s.getOrCreateClient(ctx, chainID)
 	if err != nil {
@@ -138,20 +309,127 @@ func (s *service) StartChainScan(ctx context.Context, chainID int) error {
 	}
 
 	// 创建或获取扫描器
+	// 创建扫描器，已在运行时不重复启动
 	s.scannersMu.Lock()
-	scanner, exists := s.scanners[chainID]
-	if !exists {
-		scanner = newChainScanner(s.db, client, s.depositService, s.withdrawStatusUpdater, chainID, s.scanInterval, s.blockBatchSize)
-		s.scanners[chainID] = scanner
+	if _, exists := s.scanners[chainID]; exists {
+		s.scannersMu.Unlock()
+		return nil
+	}
+	scanner := newChainScanner(s.db, client, s.depositService, s.withdrawStatusUpdater, chainID, s.resolveScanSettings(chainConfig))
+	scanner.headMonitor = newHeadMonitor(s.haltThreshold)
+	scanner.notifier = s.notifier
+	if s.tokenDiscovery {
+		scanner.tokenDiscovery = newTokenDiscovery(s.db, client, chainID)
+	}
+	s.scanners[chainID] = scanner
+	s.scannersMu.Unlock()
+
+	// 启动扫描，失败时移除扫描器，由链客户端回收时重试（见 collectChainClients）
+	if err := scanner.start(ctx); err != nil {
+		s.scannersMu.Lock()
+		if s.scanners[chainID] == scanner {
+			delete(s.scanners, chainID)
+		}
+		s.scannersMu.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// startSolanaScan 启动 Solana 链的 slot 扫描
+func (s *service) startSolanaScan(ctx context.Context, chainConfig *models.Chain) error {
+	chainID := chainConfig.ChainID
+	client, err := s.GetSolanaClient(ctx, chainID)
+	if err != nil {
+		return err
+	}
+
+	s.scannersMu.Lock()
+	if _, exists := s.solanaScanners[chainID]; exists {
+		s.scannersMu.Unlock()
+		return nil
 	}
+	scanner := newSolanaScanner(s.db, client, s.depositService, s.withdrawStatusUpdater, chainID, s.resolveScanSettings(chainConfig))
+	s.solanaScanners[chainID] = scanner
 	s.scannersMu.Unlock()
 
-	// 启动扫描
-	return scanner.start(ctx)
+	if err := scanner.start(ctx); err != nil {
+		s.scannersMu.Lock()
+		if s.solanaScanners[chainID] == scanner {
+			delete(s.solanaScanners, chainID)
+		}
+		s.scannersMu.Unlock()
+		return err
+	}
+
+	return nil
 }
 
-// ScanChainBlock 扫描指定链的单个区块
+// startBitcoinScan 启动 Bitcoin 链的区块扫描
+func (s *service) startBitcoinScan(ctx context.Context, chainConfig *models.Chain) error {
+	chainID := chainConfig.ChainID
+	client, err := s.GetBitcoinClient(ctx, chainID)
+	if err != nil {
+		return err
+	}
+
+	s.scannersMu.Lock()
+	if _, exists := s.bitcoinScanners[chainID]; exists {
+		s.scannersMu.Unlock()
+		return nil
+	}
+	scanner := newBitcoinScanner(s.db, client, s.depositService, s.withdrawStatusUpdater, chainID, s.resolveScanSettings(chainConfig))
+	s.bitcoinScanners[chainID] = scanner
+	s.scannersMu.Unlock()
+
+	if err := scanner.start(ctx); err != nil {
+		s.scannersMu.Lock()
+		if s.bitcoinScanners[chainID] == scanner {
+			delete(s.bitcoinScanners, chainID)
+		}
+		s.scannersMu.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// ScanChainBlock 扫描指定链的单个区块（Solana 链为 slot）
 func (s *service) ScanChainBlock(ctx context.Context, chainID int, blockNumber *big.Int) error {
+	chainConfig, err := s.chainService.GetChain(ctx, chainID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get chain config for chain_id=%d", chainID)
+	}
+
+	if chainConfig.ChainType == chain.TypeSolana {
+		client, err := s.GetSolanaClient(ctx, chainID)
+		if err != nil {
+			return err
+		}
+
+		scanner := newSolanaScanner(s.db, client, s.depositService, s.withdrawStatusUpdater, chainID, s.resolveScanSettings(chainConfig))
+		if err := scanner.scanSlot(ctx, blockNumber.Uint64()); err != nil {
+			return err
+		}
+		runPostScanHooks(ctx, chainID, s.depositService, s.withdrawStatusUpdater, blockNumber)
+		return nil
+	}
+
+	if chainConfig.ChainType == chain.TypeBitcoin {
+		client, err := s.GetBitcoinClient(ctx, chainID)
+		if err != nil {
+			return err
+		}
+
+		scanner := newBitcoinScanner(s.db, client, s.depositService, s.withdrawStatusUpdater, chainID, s.resolveScanSettings(chainConfig))
+		if _, err := scanner.scanBlock(ctx, blockNumber.Int64()); err != nil {
+			return err
+		}
+		runPostScanHooks(ctx, chainID, s.depositService, s.withdrawStatusUpdater, blockNumber)
+		return nil
+	}
+
 	// 获取或创建 RPC 客户端
 	client, err := s.getOrCreateClient(ctx, chainID)
 	if err != nil {
@@ -159,7 +437,7 @@ func (s *service) ScanChainBlock(ctx context.Context, chainID int, blockNumber *
 	}
 
 	// 创建临时扫描器
-	scanner := newChainScanner(s.db, client, s.depositService, s.withdrawStatusUpdater, chainID, s.scanInterval, s.blockBatchSize)
+	scanner := newChainScanner(s.db, client, s.depositService, s.withdrawStatusUpdater, chainID, s.resolveScanSettings(chainConfig))
 	if err := scanner.scanBlock(ctx, blockNumber); err != nil {
 		return err
 	}
@@ -172,15 +450,38 @@ func (s *service) GetClient(ctx context.Context, chainID int) (*RPCClient, error
 	return s.getOrCreateClient(ctx, chainID)
 }
 
+// IsContract 判断地址在最新区块是否部署了合约代码
+func (s *service) IsContract(ctx context.Context, chainID int, address string) (bool, error) {
+	if !common.IsHexAddress(address) {
+		return false, errors.Errorf("invalid address %s", address)
+	}
+
+	client, err := s.getOrCreateClient(ctx, chainID)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get RPC client for chain_id=%d", chainID)
+	}
+
+	code, err := client.CodeAt(ctx, common.HexToAddress(address), nil)
+	if err != nil {
+		return false, err
+	}
+
+	return len(code) > 0, nil
+}
+
 // getOrCreateClient 获取或创建 RPC 客户端
 func (s *service) getOrCreateClient(ctx context.Context, chainID int) (*RPCClient, error) {
 	s.clientsMu.RLock()
 	client, exists := s.clients[chainID]
+	mismatch := s.chainIDMismatches[chainID]
 	s.clientsMu.RUnlock()
 
 	if exists && client != nil {
 		return client, nil
 	}
+	if mismatch != nil {
+		return nil, mismatch
+	}
 
 	// 获取链配置
 	chainConfig, err := s.chainService.GetChain(ctx, chainID)
@@ -188,6 +489,10 @@ func (s *service) getOrCreateClient(ctx context.Context, chainID int) (*RPCClien
 		return nil, errors.Wrapf(err, "failed to get chain config for chain_id=%d", chainID)
 	}
 
+	if chainConfig.ChainType != chain.TypeEVM {
+		return nil, errors.Errorf("chain %d is not an EVM chain (chain_type=%s)", chainID, chainConfig.ChainType)
+	}
+
 	// 解析 RPC URLs
 	urls := s.chainService.ParseRPCURLs(chainConfig.RPCURL)
 	if len(urls) == 0 {
@@ -195,10 +500,17 @@ func (s *service) getOrCreateClient(ctx context.Context, chainID int) (*RPCClien
 	}
 
 	// 创建 RPC 客户端
-	client, err = NewRPCClient(urls)
+	client, err = NewRPCClient(chainID, urls)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create RPC client for chain_id=%d", chainID)
 	}
+	client.SetBatchSize(s.rpcBatchSize)
+
+	// RPC 节点必须返回配置的链 ID，避免错误配置的节点导致向错误的链发送交易
+	if err := s.verifyChainID(ctx, client); err != nil {
+		client.Close()
+		return nil, err
+	}
 
 	s.clientsMu.Lock()
 	s.clients[chainID] = client
@@ -206,3 +518,75 @@ func (s *service) getOrCreateClient(ctx context.Context, chainID int) (*RPCClien
 
 	return client, nil
 }
+
+// GetSolanaClient 获取或创建 Solana 链的 RPC 客户端
+func (s *service) GetSolanaClient(ctx context.Context, chainID int) (*solana.Client, error) {
+	s.clientsMu.RLock()
+	client, exists := s.solanaClients[chainID]
+	s.clientsMu.RUnlock()
+
+	if exists && client != nil {
+		return client, nil
+	}
+
+	chainConfig, err := s.chainService.GetChain(ctx, chainID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get chain config for chain_id=%d", chainID)
+	}
+
+	if chainConfig.ChainType != chain.TypeSolana {
+		return nil, errors.Errorf("chain %d is not a solana chain (chain_type=%s)", chainID, chainConfig.ChainType)
+	}
+
+	urls := s.chainService.ParseRPCURLs(chainConfig.RPCURL)
+	if len(urls) == 0 {
+		return nil, errors.Errorf("no valid RPC URLs for chain_id=%d", chainID)
+	}
+
+	client, err = solana.NewClient(chainID, urls)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create solana RPC client for chain_id=%d", chainID)
+	}
+
+	s.clientsMu.Lock()
+	s.solanaClients[chainID] = client
+	s.clientsMu.Unlock()
+
+	return client, nil
+}
+
+// GetBitcoinClient 获取或创建 Bitcoin 链的 RPC 客户端
+func (s *service) GetBitcoinClient(ctx context.Context, chainID int) (*bitcoin.Client, error) {
+	s.clientsMu.RLock()
+	client, exists := s.bitcoinClients[chainID]
+	s.clientsMu.RUnlock()
+
+	if exists && client != nil {
+		return client, nil
+	}
+
+	chainConfig, err := s.chainService.GetChain(ctx, chainID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get chain config for chain_id=%d", chainID)
+	}
+
+	if chainConfig.ChainType != chain.TypeBitcoin {
+		return nil, errors.Errorf("chain %d is not a bitcoin chain (chain_type=%s)", chainID, chainConfig.ChainType)
+	}
+
+	urls := s.chainService.ParseRPCURLs(chainConfig.RPCURL)
+	if len(urls) == 0 {
+		return nil, errors.Errorf("no valid RPC URLs for chain_id=%d", chainID)
+	}
+
+	client, err = bitcoin.NewClient(chainID, urls)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create bitcoin RPC client for chain_id=%d", chainID)
+	}
+
+	s.clientsMu.Lock()
+	s.bitcoinClients[chainID] = client
+	s.clientsMu.Unlock()
+
+	return client, nil
+}
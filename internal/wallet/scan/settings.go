@@ -0,0 +1,172 @@
+package scan
+
+import (
+	"context"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/chain"
+
+	"github.com/aarondl/null/v8"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// defaultMaxReceiptConcurrency 链未配置收据并发数时串行获取交易收据
+const defaultMaxReceiptConcurrency = 1
+
+// ScanSettings 单条链实际生效的扫描参数
+//
+//nolint:revive // 与 ScanProgress 保持一致的命名
+type ScanSettings struct {
+	ScanInterval          time.Duration
+	BlockBatchSize        int
+	MaxReceiptConcurrency int
+}
+
+// ChainScanSettings 链的扫描参数：Configured 为 chains 表中的配置（Null 表示使用全局配置），Effective 为实际生效值
+type ChainScanSettings struct {
+	ChainID    int
+	Configured chain.ScanSettings
+	Effective  ScanSettings
+}
+
+// resolveScanSettings 合并链配置与全局配置得到实际生效的扫描参数
+func (s *service) resolveScanSettings(chainConfig *models.Chain) ScanSettings {
+	settings := ScanSettings{
+		ScanInterval:          s.scanInterval,
+		BlockBatchSize:        s.blockBatchSize,
+		MaxReceiptConcurrency: defaultMaxReceiptConcurrency,
+	}
+
+	if chainConfig.ScanIntervalMs.Valid && chainConfig.ScanIntervalMs.Int > 0 {
+		settings.ScanInterval = time.Duration(chainConfig.ScanIntervalMs.Int) * time.Millisecond
+	}
+	if chainConfig.BlockBatchSize.Valid && chainConfig.BlockBatchSize.Int > 0 {
+		settings.BlockBatchSize = chainConfig.BlockBatchSize.Int
+	}
+	if chainConfig.MaxReceiptConcurrency.Valid && chainConfig.MaxReceiptConcurrency.Int > 0 {
+		settings.MaxReceiptConcurrency = chainConfig.MaxReceiptConcurrency.Int
+	}
+
+	return settings
+}
+
+// GetChainScanSettings 获取链配置的和实际生效的扫描参数
+func (s *service) GetChainScanSettings(ctx context.Context, chainID int) (*ChainScanSettings, error) {
+	chainConfig, err := s.chainService.GetChain(ctx, chainID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get chain config for chain_id=%d", chainID)
+	}
+
+	return s.chainScanSettings(chainConfig), nil
+}
+
+// UpdateChainScanSettings 更新链的扫描参数并立即应用到本进程运行中的扫描器，
+// 其他服务实例通过 chain_config_changed 通知重新加载
+func (s *service) UpdateChainScanSettings(ctx context.Context, chainID int, settings chain.ScanSettings) (*ChainScanSettings, error) {
+	chainConfig, err := s.chainService.UpdateScanSettings(ctx, chainID, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s.applyScanSettings(chainConfig)
+
+	return s.chainScanSettings(chainConfig), nil
+}
+
+// ReloadScanSettings 按最新的链配置更新该链运行中扫描器的扫描参数
+func (s *service) ReloadScanSettings(ctx context.Context, chainID int) error {
+	s.scannersMu.RLock()
+	_, exists := s.scanners[chainID]
+	_, solExists := s.solanaScanners[chainID]
+	_, btcExists := s.bitcoinScanners[chainID]
+	s.scannersMu.RUnlock()
+
+	if !exists && !solExists && !btcExists {
+		return nil
+	}
+
+	chainConfig, err := s.chainService.GetChain(ctx, chainID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get chain config for chain_id=%d", chainID)
+	}
+
+	s.applyScanSettings(chainConfig)
+
+	return nil
+}
+
+// reloadAllScanSettings 检查所有运行中扫描器的扫描参数
+func (s *service) reloadAllScanSettings(ctx context.Context) {
+	s.scannersMu.RLock()
+	chainIDs := make([]int, 0, len(s.scanners)+len(s.solanaScanners)+len(s.bitcoinScanners))
+	for chainID := range s.scanners {
+		chainIDs = append(chainIDs, chainID)
+	}
+	for chainID := range s.solanaScanners {
+		chainIDs = append(chainIDs, chainID)
+	}
+	for chainID := range s.bitcoinScanners {
+		chainIDs = append(chainIDs, chainID)
+	}
+	s.scannersMu.RUnlock()
+
+	for _, chainID := range chainIDs {
+		if err := s.ReloadScanSettings(ctx, chainID); err != nil {
+			log.Error().Err(err).Int("chain_id", chainID).Msg("Failed to reload scan settings")
+		}
+	}
+}
+
+// applyScanSettings 将链配置的扫描参数应用到运行中的扫描器，参数未变化时不做任何操作
+func (s *service) applyScanSettings(chainConfig *models.Chain) {
+	settings := s.resolveScanSettings(chainConfig)
+
+	s.scannersMu.RLock()
+	scanner := s.scanners[chainConfig.ChainID]
+	solScanner := s.solanaScanners[chainConfig.ChainID]
+	btcScanner := s.bitcoinScanners[chainConfig.ChainID]
+	s.scannersMu.RUnlock()
+
+	changed := false
+	if scanner != nil {
+		changed = scanner.setSettings(settings) || changed
+	}
+	if solScanner != nil {
+		changed = solScanner.setSettings(settings) || changed
+	}
+	if btcScanner != nil {
+		changed = btcScanner.setSettings(settings) || changed
+	}
+
+	if changed {
+		log.Info().
+			Int("chain_id", chainConfig.ChainID).
+			Dur("scan_interval", settings.ScanInterval).
+			Int("block_batch_size", settings.BlockBatchSize).
+			Int("max_receipt_concurrency", settings.MaxReceiptConcurrency).
+			Msg("Scan settings reloaded")
+	}
+}
+
+// chainScanSettings 构造链的扫描参数视图
+func (s *service) chainScanSettings(chainConfig *models.Chain) *ChainScanSettings {
+	return &ChainScanSettings{
+		ChainID: chainConfig.ChainID,
+		Configured: chain.ScanSettings{
+			ScanIntervalMs:        nullPositiveInt(chainConfig.ScanIntervalMs),
+			BlockBatchSize:        nullPositiveInt(chainConfig.BlockBatchSize),
+			MaxReceiptConcurrency: nullPositiveInt(chainConfig.MaxReceiptConcurrency),
+		},
+		Effective: s.resolveScanSettings(chainConfig),
+	}
+}
+
+// nullPositiveInt 非正数视为未配置（数据库约束保证为正数，这里与 resolveScanSettings 保持一致）
+func nullPositiveInt(value null.Int) null.Int {
+	if !value.Valid || value.Int <= 0 {
+		return null.Int{}
+	}
+	return value
+}
@@ -0,0 +1,84 @@
+package scan
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+const (
+	revertMessage      = "execution reverted"
+	revertSelectorSize = 4
+)
+
+// SimulationError 交易模拟执行（eth_call）回滚，广播后必然失败
+type SimulationError struct {
+	Reason string // 解码后的回滚原因，节点未返回时为空
+	Data   []byte // 原始回滚数据
+}
+
+func (e *SimulationError) Error() string {
+	if e.Reason == "" {
+		return "transaction simulation reverted"
+	}
+	return "transaction simulation reverted: " + e.Reason
+}
+
+// SimulateTransaction 在最新区块上以 eth_call 模拟执行交易，交易会回滚时返回 *SimulationError
+// 用于广播前拦截必然失败的交易，避免占用 nonce 并消耗 gas
+func (c *RPCClient) SimulateTransaction(ctx context.Context, msg ethereum.CallMsg) error {
+	client, call, err := c.getClient(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get RPC client")
+	}
+	defer call.release()
+
+	if _, err := client.CallContract(ctx, msg, nil); err != nil {
+		// 回滚是交易本身的问题，不计入节点错误
+		if simErr := parseRevert(err); simErr != nil {
+			return simErr
+		}
+		call.recordError("CallContract", err)
+		return errors.Wrap(err, "failed to simulate transaction")
+	}
+
+	return nil
+}
+
+// parseRevert 从 eth_call 错误中解析回滚信息，非回滚错误返回 nil
+// 支持 Error(string)、Panic(uint256)，自定义错误以选择器表示
+func parseRevert(err error) *SimulationError {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if hexData, ok := dataErr.ErrorData().(string); ok {
+			if data, decodeErr := hexutil.Decode(hexData); decodeErr == nil && len(data) > 0 {
+				return &SimulationError{Reason: decodeRevertReason(data), Data: data}
+			}
+		}
+	}
+
+	// 部分节点不返回回滚数据，只在错误信息中携带原因
+	message := err.Error()
+	idx := strings.Index(message, revertMessage)
+	if idx < 0 {
+		return nil
+	}
+	reason := strings.TrimPrefix(message[idx+len(revertMessage):], ":")
+	return &SimulationError{Reason: strings.TrimSpace(reason)}
+}
+
+// decodeRevertReason 解码回滚数据
+func decodeRevertReason(data []byte) string {
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	if len(data) < revertSelectorSize {
+		return ""
+	}
+	return "custom error " + hexutil.Encode(data[:revertSelectorSize])
+}
@@ -0,0 +1,75 @@
+package scan
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type revertDataError struct {
+	message string
+	data    string
+}
+
+func (e *revertDataError) Error() string          { return e.message }
+func (e *revertDataError) ErrorData() interface{} { return e.data }
+
+func TestParseRevert(t *testing.T) {
+	// Error(string) with "blacklisted"
+	errorData := "0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"000000000000000000000000000000000000000000000000000000000000000b" +
+		"626c61636b6c6973746564000000000000000000000000000000000000000000"
+	// Panic(uint256) with code 0x11 (arithmetic overflow)
+	panicData := "0x4e487b71" +
+		"0000000000000000000000000000000000000000000000000000000000000011"
+
+	tests := []struct {
+		name   string
+		err    error
+		reason string
+	}{
+		{
+			name:   "error string",
+			err:    errors.Wrap(&revertDataError{message: "execution reverted: blacklisted", data: errorData}, "call failed"),
+			reason: "blacklisted",
+		},
+		{
+			name:   "panic code",
+			err:    &revertDataError{message: "execution reverted", data: panicData},
+			reason: "arithmetic underflow or overflow",
+		},
+		{
+			name:   "custom error",
+			err:    &revertDataError{message: "execution reverted", data: "0xdeadbeef"},
+			reason: "custom error 0xdeadbeef",
+		},
+		{
+			name:   "message only",
+			err:    errors.New("execution reverted: ERC20: transfer amount exceeds balance"),
+			reason: "ERC20: transfer amount exceeds balance",
+		},
+		{
+			name:   "no reason",
+			err:    errors.New("execution reverted"),
+			reason: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			simErr := parseRevert(tt.err)
+			require.NotNil(t, simErr)
+			assert.Equal(t, tt.reason, simErr.Reason)
+		})
+	}
+
+	assert.Nil(t, parseRevert(errors.New("connection refused")))
+
+	data, err := hexutil.Decode(errorData)
+	require.NoError(t, err)
+	assert.Equal(t, "transaction simulation reverted: blacklisted", (&SimulationError{Reason: decodeRevertReason(data)}).Error())
+}
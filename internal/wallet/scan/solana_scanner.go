@@ -0,0 +1,381 @@
+package scan
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	walletMetrics "github/chapool/go-wallet/internal/metrics/wallet"
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/deposit"
+	"github/chapool/go-wallet/internal/wallet/solana"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// solanaScanner Solana 链的扫描器，按 slot 扫描 SOL 和 SPL 代币充值
+// slot 号记录在 blocks.number 和 transactions.block_no 中，确认数按 slot 计算（链配置的确认/终结区块数即 slot 数）；
+// 被跳过的 slot 没有区块记录。只扫描 confirmed 级别的 slot，不做重组检测
+type solanaScanner struct {
+	db                    *sql.DB
+	client                *solana.Client
+	depositService        deposit.Service
+	withdrawStatusUpdater WithdrawStatusUpdater
+	chainID               int
+	settings              atomic.Pointer[ScanSettings] // 运行中可由管理员调整
+	stopCh                chan struct{}
+}
+
+// newSolanaScanner 创建 Solana 链扫描器
+func newSolanaScanner(db *sql.DB, client *solana.Client, depositService deposit.Service, withdrawStatusUpdater WithdrawStatusUpdater, chainID int, settings ScanSettings) *solanaScanner {
+	scanner := &solanaScanner{
+		db:                    db,
+		client:                client,
+		depositService:        depositService,
+		withdrawStatusUpdater: withdrawStatusUpdater,
+		chainID:               chainID,
+		stopCh:                make(chan struct{}),
+	}
+	scanner.settings.Store(&settings)
+	return scanner
+}
+
+// currentSettings 当前生效的扫描参数
+func (s *solanaScanner) currentSettings() ScanSettings {
+	return *s.settings.Load()
+}
+
+// setSettings 更新扫描参数，下一轮扫描生效，返回参数是否变化
+func (s *solanaScanner) setSettings(settings ScanSettings) bool {
+	return *s.settings.Swap(&settings) != settings
+}
+
+// stop 停止扫描循环，只能调用一次（调用方从扫描器列表中移除后调用）
+func (s *solanaScanner) stop() {
+	close(s.stopCh)
+}
+
+// start 启动扫描循环
+func (s *solanaScanner) start(ctx context.Context) error {
+	log.Info().Int("chain_id", s.chainID).Msg("Starting solana slot scanner")
+
+	startSlot, err := s.getStartSlot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get start slot")
+	}
+
+	lifecycle.Go(ctx, fmt.Sprintf("solana slot scanner %d", s.chainID), func() {
+		s.scanLoop(ctx, startSlot)
+	})
+
+	return nil
+}
+
+// getStartSlot 获取起始扫描 slot：已扫描的最大 slot 的下一个，没有记录时从最新 slot 开始
+func (s *solanaScanner) getStartSlot(ctx context.Context) (uint64, error) {
+	var maxSlot sql.NullInt64
+	err := s.db.QueryRowContext(ctx, `
+		SELECT MAX(number)
+		FROM blocks
+		WHERE chain_id = $1 AND status != 'orphaned'
+	`, s.chainID).Scan(&maxSlot)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, errors.Wrap(err, "failed to query max slot")
+	}
+
+	if maxSlot.Valid {
+		return uint64(maxSlot.Int64) + 1, nil //nolint:gosec // slot 为非负数
+	}
+
+	latestSlot, err := s.client.GetSlot(ctx, solana.CommitmentConfirmed)
+	if err != nil {
+		return 0, err
+	}
+
+	log.Info().
+		Int("chain_id", s.chainID).
+		Uint64("start_slot", latestSlot).
+		Msg("No previous slots found, starting from latest slot")
+
+	return latestSlot, nil
+}
+
+// scanLoop 扫描循环，每轮最多扫描 blockBatchSize 个 slot，落后时立即继续
+// 扫描参数在每轮开始时读取，调整扫描间隔后重置 ticker
+func (s *solanaScanner) scanLoop(ctx context.Context, startSlot uint64) {
+	currentSlot := startSlot
+	scanInterval := s.currentSettings().ScanInterval
+	ticker := time.NewTicker(scanInterval)
+	defer ticker.Stop()
+
+	scanOnce := func() bool {
+		latestSlot, err := s.client.GetSlot(ctx, solana.CommitmentConfirmed)
+		if err != nil {
+			log.Error().
+				Int("chain_id", s.chainID).
+				Err(err).
+				Msg("Failed to get latest slot")
+			return false
+		}
+
+		blockBatchSize := s.currentSettings().BlockBatchSize
+		endSlot := min(latestSlot, currentSlot+uint64(max(blockBatchSize, 1))-1) //nolint:gosec // 批次大小为正数
+		for currentSlot <= endSlot {
+			if err := s.scanSlot(ctx, currentSlot); err != nil {
+				log.Error().
+					Int("chain_id", s.chainID).
+					Uint64("slot", currentSlot).
+					Err(err).
+					Msg("Failed to scan slot")
+				break
+			}
+			currentSlot++
+		}
+
+		//nolint:gosec // slot 远小于 int64 上限
+		recordScanProgress(s.chainID, int64(latestSlot), int64(currentSlot))
+
+		//nolint:gosec // slot 远小于 int64 上限
+		runPostScanHooks(ctx, s.chainID, s.depositService, s.withdrawStatusUpdater, big.NewInt(int64(latestSlot)))
+
+		return currentSlot <= latestSlot
+	}
+
+	scanOnce()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Int("chain_id", s.chainID).Msg("Solana slot scanner stopped by context")
+			return
+		case <-s.stopCh:
+			log.Info().Int("chain_id", s.chainID).Msg("Solana slot scanner stopped")
+			return
+		case <-ticker.C:
+			if interval := s.currentSettings().ScanInterval; interval != scanInterval {
+				scanInterval = interval
+				ticker.Reset(scanInterval)
+			}
+
+			// 落后于最新 slot 时连续扫描，直到追上
+			for scanOnce() {
+				select {
+				case <-ctx.Done():
+					return
+				case <-s.stopCh:
+					return
+				case <-time.After(rapidRescanDelay):
+				}
+			}
+		}
+	}
+}
+
+// scanSlot 扫描单个 slot，保存区块并记录转入用户地址的 SOL 和已配置 SPL 代币的充值
+func (s *solanaScanner) scanSlot(ctx context.Context, slot uint64) error {
+	block, err := s.client.GetBlock(ctx, slot)
+	if err != nil {
+		if errors.Is(err, solana.ErrSlotSkipped) {
+			return nil
+		}
+		return err
+	}
+
+	//nolint:gosec // slot 远小于 int64 上限
+	slotNumber := int64(slot)
+
+	exists, err := models.Blocks(
+		models.BlockWhere.ChainID.EQ(s.chainID),
+		models.BlockWhere.Number.EQ(slotNumber),
+	).Exists(ctx, s.db)
+	if err != nil {
+		return errors.Wrap(err, "failed to check block existence")
+	}
+	if exists {
+		return nil
+	}
+
+	deposits, err := s.findDeposits(ctx, block)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	blockModel := &models.Block{
+		Hash:       block.Blockhash,
+		ChainID:    s.chainID,
+		ParentHash: block.PreviousBlockhash,
+		Number:     slotNumber,
+		Status:     models.BlockStatusConfirmed,
+	}
+	if block.BlockTime != nil {
+		blockModel.Timestamp = *block.BlockTime
+	}
+	if err := blockModel.Insert(ctx, tx, boil.Infer()); err != nil {
+		return errors.Wrap(err, "failed to save block")
+	}
+
+	for _, transaction := range deposits {
+		transaction.BlockHash = block.Blockhash
+		transaction.BlockNo = slotNumber
+		if err := transaction.Insert(ctx, tx, boil.Infer()); err != nil {
+			return errors.Wrapf(err, "failed to insert deposit transaction %s", transaction.TXHash)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit slot")
+	}
+
+	walletMetrics.BlocksScanned.WithLabelValues(walletMetrics.ChainLabel(s.chainID)).Inc()
+	for _, transaction := range deposits {
+		asset := walletMetrics.AssetNative
+		if transaction.TokenAddr.Valid {
+			asset = walletMetrics.AssetSPL
+		}
+		walletMetrics.DepositsDetected.WithLabelValues(walletMetrics.ChainLabel(s.chainID), asset).Inc()
+
+		log.Info().
+			Int("chain_id", s.chainID).
+			Str("tx_hash", transaction.TXHash).
+			Str("to_addr", transaction.ToAddr).
+			Str("token_addr", transaction.TokenAddr.String).
+			Str("amount", transaction.Amount).
+			Msg("Solana deposit detected")
+	}
+
+	log.Debug().
+		Int("chain_id", s.chainID).
+		Uint64("slot", slot).
+		Int("tx_count", len(block.Transactions)).
+		Int("deposit_count", len(deposits)).
+		Msg("Slot scanned successfully")
+
+	return nil
+}
+
+// findDeposits 查找区块中转入用户地址的转账
+// 与 EVM 扫描一致，每笔交易只记录第一笔充值；SPL 代币只记录已配置的 mint，避免垃圾代币空投产生无法入账的记录
+func (s *solanaScanner) findDeposits(ctx context.Context, block *solana.Block) ([]*models.Transaction, error) {
+	type candidate struct {
+		signature string
+		transfer  *solana.Transfer
+	}
+
+	candidates := make([]candidate, 0)
+	addresses := make([]string, 0)
+	mints := make([]string, 0)
+	for _, blockTransaction := range block.Transactions {
+		signature := blockTransaction.Signature()
+		if signature == "" {
+			continue
+		}
+		for _, transfer := range blockTransaction.Transfers() {
+			candidates = append(candidates, candidate{signature: signature, transfer: transfer})
+			addresses = append(addresses, transfer.To)
+			if transfer.Mint != "" {
+				mints = append(mints, transfer.Mint)
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	userAddresses, err := s.queryStringSet(ctx, `
+		SELECT `+chain.WalletAddressKeySQL+` FROM wallets WHERE chain_id = $1 AND `+chain.WalletAddressKeySQL+` = ANY($2)
+	`, addresses)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check user addresses")
+	}
+	if len(userAddresses) == 0 {
+		return nil, nil
+	}
+
+	knownMints, err := s.queryStringSet(ctx, `
+		SELECT token_address FROM tokens WHERE chain_id = $1 AND token_address = ANY($2)
+	`, mints)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check token mints")
+	}
+
+	deposits := make([]*models.Transaction, 0)
+	recorded := make(map[string]bool)
+	for _, c := range candidates {
+		if recorded[c.signature] || !userAddresses[c.transfer.To] {
+			continue
+		}
+		if c.transfer.Mint != "" && !knownMints[c.transfer.Mint] {
+			continue
+		}
+
+		exists, err := models.Transactions(
+			models.TransactionWhere.ChainID.EQ(s.chainID),
+			models.TransactionWhere.TXHash.EQ(c.signature),
+		).Exists(ctx, s.db)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to check transaction existence")
+		}
+		if exists {
+			continue
+		}
+
+		transaction := &models.Transaction{
+			ChainID:           s.chainID,
+			TXHash:            c.signature,
+			FromAddr:          c.transfer.From,
+			ToAddr:            c.transfer.To,
+			Amount:            c.transfer.Amount,
+			Type:              models.TransactionTypeDeposit,
+			Status:            models.TransactionStatusConfirmed,
+			ConfirmationCount: null.IntFrom(0),
+		}
+		if c.transfer.Mint != "" {
+			transaction.TokenAddr = null.StringFrom(c.transfer.Mint)
+		}
+
+		deposits = append(deposits, transaction)
+		recorded[c.signature] = true
+	}
+
+	return deposits, nil
+}
+
+// queryStringSet 查询链上与 values 匹配的字符串集合（Solana 地址区分大小写，精确匹配）
+func (s *solanaScanner) queryStringSet(ctx context.Context, query string, values []string) (map[string]bool, error) {
+	result := make(map[string]bool)
+	if len(values) == 0 {
+		return result, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, s.chainID, pq.Array(values))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		result[value] = true
+	}
+
+	return result, rows.Err()
+}
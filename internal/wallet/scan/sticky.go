@@ -0,0 +1,188 @@
+package scan
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// StickyClient 将 nonce 获取和交易广播固定在同一个 RPC 节点上
+// 普通调用在节点间故障转移，nonce 可能从一个节点获取、交易却通过 pending 交易池不同的另一个节点广播，
+// 导致 nonce too low / replacement transaction underpriced 等问题。回退规则：
+//   - 选定节点时与普通调用一样跳过不可用的节点
+//   - 选定节点后获取 nonce 失败直接返回错误，不切换节点
+//   - 广播时节点返回 JSON-RPC 错误（节点已收到并拒绝交易）直接返回错误，不换节点重发
+//   - 广播时连接失败（节点未收到交易）才将同一笔已签名交易依次发送到其他节点，
+//     其他节点返回 already known 视为广播成功
+//
+// 使用完必须调用 Close，释放前替换节点不会关闭选定节点的连接
+type StickyClient struct {
+	parent   *RPCClient
+	client   *ethclient.Client
+	endpoint int
+	call     *endpointCall
+}
+
+// Sticky 选定当前可用的 RPC 节点，返回固定使用该节点的客户端
+func (c *RPCClient) Sticky(ctx context.Context) (*StickyClient, error) {
+	client, call, err := c.getClient(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get RPC client")
+	}
+
+	return &StickyClient{
+		parent:   c,
+		client:   client,
+		endpoint: call.endpoint,
+		// 固定节点期间还包含签名等操作，不计入节点延迟
+		call: call.untimed(),
+	}, nil
+}
+
+// BroadcastTransaction 按 StickyClient 的回退规则广播已签名交易并记录广播节点，用于 nonce 由数据库分配的流程
+func (c *RPCClient) BroadcastTransaction(ctx context.Context, tx *types.Transaction) error {
+	sticky, err := c.Sticky(ctx)
+	if err != nil {
+		return err
+	}
+	defer sticky.Close()
+
+	return sticky.SendTransaction(ctx, tx)
+}
+
+// Endpoint 返回选定的 RPC 节点索引，回退广播成功后为实际广播交易的节点
+func (s *StickyClient) Endpoint() int {
+	return s.endpoint
+}
+
+// Close 释放选定的节点连接，可重复调用
+func (s *StickyClient) Close() {
+	s.call.release()
+}
+
+// PendingNonceAt 从选定的节点获取 pending nonce
+func (s *StickyClient) PendingNonceAt(ctx context.Context, address common.Address) (uint64, error) {
+	nonce, err := s.client.PendingNonceAt(ctx, address)
+	if err != nil {
+		s.call.recordError("PendingNonceAt", err)
+		return 0, errors.Wrapf(err, "failed to get pending nonce from RPC endpoint %d", s.endpoint)
+	}
+
+	return nonce, nil
+}
+
+// SendTransaction 通过选定的节点广播交易，节点连接失败时按回退规则发送到其他节点
+func (s *StickyClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	err := s.client.SendTransaction(ctx, tx)
+	if err == nil {
+		s.logBroadcast(tx, false)
+		return nil
+	}
+	s.call.recordError("SendTransaction", err)
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) || ctx.Err() != nil {
+		return errors.Wrapf(err, "failed to send transaction via RPC endpoint %d", s.endpoint)
+	}
+
+	log.Warn().
+		Int("chain_id", s.parent.chainID).
+		Int("rpc_endpoint", s.endpoint).
+		Str("tx_hash", tx.Hash().Hex()).
+		Uint64("nonce", tx.Nonce()).
+		Err(err).
+		Msg("RPC endpoint unreachable while broadcasting, rebroadcasting signed transaction via other endpoints")
+
+	for _, endpoint := range s.parent.fallbackEndpoints(s.endpoint) {
+		sent, fallbackErr := s.parent.sendVia(ctx, endpoint, tx)
+		if !sent {
+			log.Warn().
+				Int("chain_id", s.parent.chainID).
+				Int("rpc_endpoint", endpoint).
+				Str("tx_hash", tx.Hash().Hex()).
+				Err(fallbackErr).
+				Msg("Fallback broadcast failed")
+			continue
+		}
+
+		s.endpoint = endpoint
+		s.parent.recordFailover()
+		s.logBroadcast(tx, true)
+		return nil
+	}
+
+	return errors.Wrapf(err, "failed to send transaction via RPC endpoint %d and all fallback endpoints", s.endpoint)
+}
+
+// logBroadcast 记录广播交易的节点
+func (s *StickyClient) logBroadcast(tx *types.Transaction, fallback bool) {
+	log.Info().
+		Int("chain_id", s.parent.chainID).
+		Int("rpc_endpoint", s.endpoint).
+		Str("tx_hash", tx.Hash().Hex()).
+		Uint64("nonce", tx.Nonce()).
+		Bool("fallback", fallback).
+		Msg("Transaction broadcast")
+}
+
+// fallbackEndpoints 返回除 exclude 外的节点索引，从 exclude 的下一个开始
+func (c *RPCClient) fallbackEndpoints(exclude int) []int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	endpoints := make([]int, 0, len(c.clients))
+	for i := 1; i < len(c.clients); i++ {
+		endpoints = append(endpoints, (exclude+i)%len(c.clients))
+	}
+
+	return endpoints
+}
+
+// sendVia 通过指定节点广播交易，节点未连接时重新连接并校验链 ID
+// 节点返回 already known 说明交易已在其交易池中，视为发送成功
+func (c *RPCClient) sendVia(ctx context.Context, endpoint int, tx *types.Transaction) (bool, error) {
+	client, call, err := c.endpointClient(ctx, endpoint)
+	if err != nil {
+		return false, err
+	}
+	defer call.release()
+
+	if err := client.SendTransaction(ctx, tx); err != nil {
+		if strings.Contains(err.Error(), "already known") {
+			return true, nil
+		}
+		call.recordError("SendTransaction", err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// endpointClient 获取指定索引的节点连接，未连接时重新连接；不检查熔断器，广播回退时尝试所有节点
+// 调用方使用完后必须调用 call.release
+func (c *RPCClient) endpointClient(ctx context.Context, endpoint int) (*ethclient.Client, *endpointCall, error) {
+	c.mu.RLock()
+	if endpoint >= len(c.clients) {
+		c.mu.RUnlock()
+		return nil, nil, errEndpointsReloaded
+	}
+	client, url, stats, calls := c.clients[endpoint], c.urls[endpoint], c.endpoints[endpoint], c.calls
+	c.mu.RUnlock()
+
+	if client == nil {
+		// 与 getClient 一样，链 ID 不一致的节点不使用
+		dialed, err := c.connectEndpoint(ctx, endpoint, url, nil, calls)
+		if err != nil {
+			return nil, nil, err
+		}
+		client = dialed
+	}
+
+	return c.acquireCall(endpoint, client, stats, calls)
+}
@@ -0,0 +1,98 @@
+package scan
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github/chapool/go-wallet/internal/wallet/chain"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+// tokenDiscovery 代币自动发现
+// 用户地址收到未登记代币的 Transfer 事件时，读取合约元数据并登记为未启用代币，等待管理员审核启用
+type tokenDiscovery struct {
+	db      *sql.DB
+	client  *RPCClient
+	chainID int
+
+	mu sync.Mutex
+	// checked 本进程内已检查过的代币合约，避免对同一合约重复查询数据库和链上元数据
+	checked map[common.Address]struct{}
+}
+
+func newTokenDiscovery(db *sql.DB, client *RPCClient, chainID int) *tokenDiscovery {
+	return &tokenDiscovery{
+		db:      db,
+		client:  client,
+		chainID: chainID,
+		checked: make(map[common.Address]struct{}),
+	}
+}
+
+// discover 登记未知代币，失败只记录日志，不影响区块扫描
+func (d *tokenDiscovery) discover(ctx context.Context, tokenAddress common.Address) {
+	d.mu.Lock()
+	if _, ok := d.checked[tokenAddress]; ok {
+		d.mu.Unlock()
+		return
+	}
+	d.checked[tokenAddress] = struct{}{}
+	d.mu.Unlock()
+
+	address := chain.NormalizeAddress(chain.TypeEVM, tokenAddress.Hex())
+
+	var exists bool
+	if err := d.db.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM tokens WHERE chain_id = $1 AND token_address = $2)
+	`, d.chainID, address).Scan(&exists); err != nil {
+		d.forget(tokenAddress)
+		log.Warn().Err(err).Int("chain_id", d.chainID).Str("token_addr", address).Msg("Failed to check whether token is registered")
+		return
+	}
+	if exists {
+		return
+	}
+
+	metadata, err := d.client.TokenMetadata(ctx, tokenAddress)
+	if err != nil {
+		// 非标准合约不登记，本进程内不再重试
+		log.Warn().Err(err).Int("chain_id", d.chainID).Str("token_addr", address).Msg("Unknown token is not a valid ERC20 contract, skipping discovery")
+		return
+	}
+
+	var tokenName sql.NullString
+	if metadata.Name != "" {
+		tokenName = sql.NullString{String: metadata.Name, Valid: true}
+	}
+
+	result, err := d.db.ExecContext(ctx, `
+		INSERT INTO tokens (chain_type, chain_id, token_address, token_symbol, token_name, decimals, is_native, token_type, withdraw_fee, min_withdraw_amount, is_active)
+		VALUES ($1, $2, $3, $4, $5, $6, FALSE, 'erc20', '0', '0', FALSE)
+		ON CONFLICT (chain_id, token_address) DO NOTHING
+	`, chain.TypeEVM, d.chainID, address, metadata.Symbol, tokenName, metadata.Decimals)
+	if err != nil {
+		d.forget(tokenAddress)
+		log.Warn().Err(err).Int("chain_id", d.chainID).Str("token_addr", address).Msg("Failed to register discovered token")
+		return
+	}
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return
+	}
+
+	log.Info().
+		Int("chain_id", d.chainID).
+		Str("token_addr", address).
+		Str("token_symbol", metadata.Symbol).
+		Int("decimals", metadata.Decimals).
+		Msg("Discovered unknown token, registered as inactive pending admin approval")
+}
+
+// forget 移除检查记录，临时错误后下次收到转账时重试
+func (d *tokenDiscovery) forget(tokenAddress common.Address) {
+	d.mu.Lock()
+	delete(d.checked, tokenAddress)
+	d.mu.Unlock()
+}
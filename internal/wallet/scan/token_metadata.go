@@ -0,0 +1,120 @@
+package scan
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// ERC20 元数据方法的 selector
+var (
+	nameMethodID     = common.Hex2Bytes("06fdde03") // name()
+	symbolMethodID   = common.Hex2Bytes("95d89b41") // symbol()
+	decimalsMethodID = common.Hex2Bytes("313ce567") // decimals()
+)
+
+const (
+	abiWordLength = 32
+	// maxTokenDecimals ERC20 decimals 的合理上限，超过时视为非标准合约
+	maxTokenDecimals = 36
+	// maxTokenStringLength symbol/name 的最大长度，超过时视为非标准合约
+	maxTokenStringLength = 128
+)
+
+// TokenMetadata ERC20 代币的链上元数据
+type TokenMetadata struct {
+	Symbol   string
+	Name     string // 合约未实现 name() 时为空
+	Decimals int
+}
+
+// TokenMetadata 通过 symbol()、decimals()、name() 调用读取 ERC20 合约的元数据
+// 地址没有合约代码、symbol() 或 decimals() 调用失败或返回值不合法时返回错误；name() 是可选方法，失败时忽略
+func (c *RPCClient) TokenMetadata(ctx context.Context, tokenAddress common.Address) (*TokenMetadata, error) {
+	code, err := c.CodeAt(ctx, tokenAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, errors.Errorf("no contract code at %s", tokenAddress.Hex())
+	}
+
+	resp, err := c.CallContract(ctx, ethereum.CallMsg{To: &tokenAddress, Data: decimalsMethodID}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call decimals")
+	}
+	if len(resp) < abiWordLength {
+		return nil, errors.New("decimals returned invalid data")
+	}
+	decimals := new(big.Int).SetBytes(resp[:abiWordLength])
+	if !decimals.IsInt64() || decimals.Int64() > maxTokenDecimals {
+		return nil, errors.Errorf("decimals %s is out of range", decimals.String())
+	}
+
+	resp, err = c.CallContract(ctx, ethereum.CallMsg{To: &tokenAddress, Data: symbolMethodID}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call symbol")
+	}
+	symbol, err := decodeABIString(resp)
+	if err != nil {
+		return nil, errors.Wrap(err, "symbol returned invalid data")
+	}
+	if symbol == "" {
+		return nil, errors.New("symbol is empty")
+	}
+
+	metadata := &TokenMetadata{
+		Symbol:   symbol,
+		Decimals: int(decimals.Int64()),
+	}
+
+	resp, err = c.CallContract(ctx, ethereum.CallMsg{To: &tokenAddress, Data: nameMethodID}, nil)
+	if err == nil {
+		if name, err := decodeABIString(resp); err == nil {
+			metadata.Name = name
+		}
+	}
+
+	return metadata, nil
+}
+
+// decodeABIString 解码 ABI 编码的 string 返回值，兼容返回 bytes32 的早期合约（如 MKR）
+func decodeABIString(data []byte) (string, error) {
+	if len(data) == abiWordLength {
+		return cleanTokenString(string(bytes.TrimRight(data, "\x00")))
+	}
+	if len(data) < 2*abiWordLength {
+		return "", errors.New("data too short")
+	}
+
+	offset := new(big.Int).SetBytes(data[:abiWordLength])
+	if !offset.IsInt64() || offset.Int64()+abiWordLength > int64(len(data)) {
+		return "", errors.New("invalid string offset")
+	}
+	start := int(offset.Int64())
+
+	length := new(big.Int).SetBytes(data[start : start+abiWordLength])
+	if !length.IsInt64() || length.Int64() > maxTokenStringLength || int64(start+abiWordLength)+length.Int64() > int64(len(data)) {
+		return "", errors.New("invalid string length")
+	}
+	start += abiWordLength
+
+	return cleanTokenString(string(data[start : start+int(length.Int64())]))
+}
+
+func cleanTokenString(s string) (string, error) {
+	if !utf8.ValidString(s) {
+		return "", errors.New("string is not valid UTF-8")
+	}
+	if len(s) > maxTokenStringLength {
+		return "", errors.New("string too long")
+	}
+
+	return strings.TrimSpace(s), nil
+}
@@ -0,0 +1,149 @@
+package scan
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// 事件字段所在位置
+const (
+	eventFieldSourceTopic = "topic"
+	eventFieldSourceData  = "data"
+
+	eventDataWordSize = 32 // ABI 编码中每个字的字节数
+)
+
+// eventField 事件字段位置：topics[index] 或 data 中第 index 个 32 字节字
+type eventField struct {
+	source string
+	index  int
+}
+
+// transferEventLayout 转账事件的签名与数据布局
+type transferEventLayout struct {
+	topic  common.Hash
+	from   eventField
+	to     eventField
+	amount eventField
+}
+
+// standardTransferLayout 标准 ERC20 Transfer 事件布局
+// Transfer(address indexed from, address indexed to, uint256 value)
+// Topics[0] = event signature
+// Topics[1] = from address (indexed)
+// Topics[2] = to address (indexed)
+// Data = value (uint256)
+var standardTransferLayout = &transferEventLayout{
+	topic:  transferEventSignature,
+	from:   eventField{source: eventFieldSourceTopic, index: 1},
+	to:     eventField{source: eventFieldSourceTopic, index: 2},
+	amount: eventField{source: eventFieldSourceData, index: 0},
+}
+
+// transferEvent 解析后的转账事件
+type transferEvent struct {
+	from   common.Address
+	to     common.Address
+	amount *big.Int
+}
+
+// word 读取字段对应的 32 字节字，位置越界时返回 false
+func (f eventField) word(logEntry *types.Log) ([]byte, bool) {
+	switch f.source {
+	case eventFieldSourceTopic:
+		if f.index < 1 || f.index >= len(logEntry.Topics) {
+			return nil, false
+		}
+		return logEntry.Topics[f.index].Bytes(), true
+	case eventFieldSourceData:
+		start := f.index * eventDataWordSize
+		if f.index < 0 || start+eventDataWordSize > len(logEntry.Data) {
+			return nil, false
+		}
+		return logEntry.Data[start : start+eventDataWordSize], true
+	default:
+		return nil, false
+	}
+}
+
+// decode 按布局解析事件，事件签名不匹配或数据不完整时返回 false
+func (l *transferEventLayout) decode(logEntry *types.Log) (*transferEvent, bool) {
+	if len(logEntry.Topics) == 0 || logEntry.Topics[0] != l.topic {
+		return nil, false
+	}
+
+	fromWord, ok := l.from.word(logEntry)
+	if !ok {
+		return nil, false
+	}
+	toWord, ok := l.to.word(logEntry)
+	if !ok {
+		return nil, false
+	}
+	amountWord, ok := l.amount.word(logEntry)
+	if !ok {
+		return nil, false
+	}
+
+	return &transferEvent{
+		from:   common.BytesToAddress(fromWord),
+		to:     common.BytesToAddress(toWord),
+		amount: new(big.Int).SetBytes(amountWord),
+	}, true
+}
+
+// loadTransferEventLayouts 加载链上启用代币的自定义转账事件配置（按代币合约地址分组）
+func loadTransferEventLayouts(ctx context.Context, db *sql.DB, chainID int) (map[common.Address][]*transferEventLayout, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT t.token_address, e.event_topic, e.from_source, e.from_index, e.to_source, e.to_index, e.amount_source, e.amount_index
+		FROM token_transfer_events e
+		INNER JOIN tokens t ON t.id = e.token_id
+		WHERE t.chain_id = $1
+			AND t.is_active = TRUE
+			AND t.token_address IS NOT NULL
+			AND e.is_active = TRUE
+		ORDER BY e.id
+	`, chainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query token transfer events")
+	}
+	defer rows.Close()
+
+	layouts := make(map[common.Address][]*transferEventLayout)
+	for rows.Next() {
+		var (
+			tokenAddress string
+			topic        string
+			layout       transferEventLayout
+		)
+
+		if err := rows.Scan(
+			&tokenAddress,
+			&topic,
+			&layout.from.source,
+			&layout.from.index,
+			&layout.to.source,
+			&layout.to.index,
+			&layout.amount.source,
+			&layout.amount.index,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan token transfer event")
+		}
+
+		layout.topic = common.HexToHash(strings.TrimSpace(topic))
+		address := common.HexToAddress(tokenAddress)
+		layouts[address] = append(layouts[address], &layout)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate token transfer events")
+	}
+
+	return layouts, nil
+}
@@ -3,6 +3,11 @@ package scan
 import (
 	"context"
 	"math/big"
+	"time"
+
+	"github/chapool/go-wallet/internal/wallet/bitcoin"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/solana"
 )
 
 // WithdrawStatusUpdater 提现状态更新器接口（避免循环依赖）
@@ -25,8 +30,65 @@ type Service interface {
 	// GetScanProgress 获取扫描进度
 	GetScanProgress(ctx context.Context, chainID int) (*ScanProgress, error)
 
+	// GetScanStatus 获取所有启用链的扫描状态（含出块停滞检测结果）
+	GetScanStatus(ctx context.Context) ([]*ScanProgress, error)
+
+	// GetRPCClientStates 获取本进程缓存的 RPC 客户端及其健康状态（诊断用）
+	GetRPCClientStates() []*RPCClientState
+
 	// GetClient 获取指定链的 RPC 客户端
 	GetClient(ctx context.Context, chainID int) (*RPCClient, error)
+
+	// GetSolanaClient 获取 Solana 链的 RPC 客户端
+	GetSolanaClient(ctx context.Context, chainID int) (*solana.Client, error)
+
+	// GetBitcoinClient 获取 Bitcoin 链的 RPC 客户端
+	GetBitcoinClient(ctx context.Context, chainID int) (*bitcoin.Client, error)
+
+	// IsContract 判断地址在最新区块是否部署了合约代码
+	IsContract(ctx context.Context, chainID int, address string) (bool, error)
+
+	// CreateBackfillJob 创建历史区块补扫任务，由补扫 worker 异步执行
+	CreateBackfillJob(ctx context.Context, chainID int, fromBlock, toBlock int64, createdBy *string) (*BackfillJob, error)
+
+	// BackfillRange 同步补扫指定区块区间，带检查点和限速，中断后再次执行相同区间会从检查点继续
+	BackfillRange(ctx context.Context, chainID int, fromBlock, toBlock int64) (*BackfillJob, error)
+
+	// GetBackfillJob 获取补扫任务
+	GetBackfillJob(ctx context.Context, jobID string) (*BackfillJob, error)
+
+	// ListBackfillJobs 查询补扫任务
+	ListBackfillJobs(ctx context.Context, filter *BackfillFilter) ([]*BackfillJob, error)
+
+	// CancelBackfillJob 取消补扫任务
+	CancelBackfillJob(ctx context.Context, jobID string) (*BackfillJob, error)
+
+	// StartBackfillWorker 启动补扫 worker，执行等待中的任务并接管崩溃进程遗留的任务
+	StartBackfillWorker(ctx context.Context, interval time.Duration)
+
+	// ListScanFailures 查询交易分析失败记录（死信队列）
+	ListScanFailures(ctx context.Context, filter *ScanFailureFilter) ([]*ScanFailure, error)
+
+	// RequeueScanFailure 重新入队失败记录，由重试 worker 立即重新分析
+	RequeueScanFailure(ctx context.Context, failureID string) (*ScanFailure, error)
+
+	// StartScanFailureWorker 启动交易分析失败重试 worker，按退避计划重新分析失败的交易
+	StartScanFailureWorker(ctx context.Context, interval time.Duration, maxAttempts int)
+
+	// ReloadChainClients 按最新的链配置重建指定链的 RPC 客户端，旧连接在进行中的调用完成后关闭
+	ReloadChainClients(ctx context.Context, chainID int) error
+
+	// StartChainConfigWatcher 监听链配置变更（LISTEN chain_config_changed），RPC URL 变化时重建 RPC 客户端，扫描参数变化时更新扫描器
+	StartChainConfigWatcher(ctx context.Context, connString string, interval time.Duration)
+
+	// GetChainScanSettings 获取链配置的和实际生效的扫描参数（扫描间隔、批次大小、收据并发数）
+	GetChainScanSettings(ctx context.Context, chainID int) (*ChainScanSettings, error)
+
+	// UpdateChainScanSettings 更新链的扫描参数，运行中的扫描器下一轮扫描生效，无需重启
+	UpdateChainScanSettings(ctx context.Context, chainID int, settings chain.ScanSettings) (*ChainScanSettings, error)
+
+	// ReloadScanSettings 按最新的链配置更新该链运行中扫描器的扫描参数
+	ReloadScanSettings(ctx context.Context, chainID int) error
 }
 
 // Progress 扫描进度
@@ -37,8 +99,35 @@ type ScanProgress struct {
 	LatestBlock *big.Int
 	ScannedTo   *big.Int
 	Status      string
+	Error       string // Status 为 rpc_unavailable 或 chain_id_mismatch 时的错误信息
+
+	// Halted 最新区块长时间不变且所有 RPC 节点均如此（链停摆）
+	Halted           bool
+	LastHeadChangeAt *time.Time // 最近一次观察到最新区块前进的时间
+	RPCEndpoint      int        // 当前使用的 RPC 节点索引
+	RPCEndpointCount int
+}
+
+// RPCClientState 缓存中的 RPC 客户端
+type RPCClientState struct {
+	ChainID          int
+	ChainType        string
+	RPCEndpoint      int // 当前使用的 RPC 节点索引
+	RPCEndpointCount int
+	ScannerRunning   bool             // 本进程是否运行了该链的扫描器（持有该客户端，不会被回收）
+	Health           *RPCClientHealth // 仅 EVM 客户端记录
 }
 
+// 扫描状态
+const (
+	ScanStatusScanning       = "scanning"
+	ScanStatusHalted         = "halted"
+	ScanStatusStopped        = "stopped" // 本进程未运行该链的扫描器
+	ScanStatusRPCUnavailable = "rpc_unavailable"
+	// ScanStatusChainIDMismatch RPC 节点返回的链 ID 与配置不一致，该链不再提供服务
+	ScanStatusChainIDMismatch = "chain_id_mismatch"
+)
+
 // BlockInfo 区块信息
 type BlockInfo struct {
 	Hash       string
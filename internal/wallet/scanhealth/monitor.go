@@ -0,0 +1,162 @@
+package scanhealth
+
+import (
+	"context"
+	"time"
+
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/alert"
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// StartMonitor 启动定时采样、告警和过期采样清理
+func (s *service) StartMonitor(ctx context.Context, interval time.Duration) {
+	log.Info().
+		Dur("interval", interval).
+		Dur("window", s.config.Window).
+		Int("warning_blocks", s.config.WarningBlocks).
+		Int("critical_blocks", s.config.CriticalBlocks).
+		Msg("Starting scan lag monitor")
+
+	lifecycle.Go(ctx, "scan lag monitor", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		s.runMonitor(ctx)
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Scan lag monitor stopped")
+				return
+			case <-ticker.C:
+				s.runMonitor(ctx)
+			}
+		}
+	})
+}
+
+// runMonitor 采样所有启用的链，对延迟级别变化告警并清理过期采样
+func (s *service) runMonitor(ctx context.Context) {
+	statuses, err := s.scanService.GetScanStatus(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get scan status for scan lag monitor")
+		return
+	}
+
+	now := time.Now()
+	for _, progress := range statuses {
+		// RPC 不可用时无法得知最新区块，不记录采样（链停摆由扫描器单独告警）
+		if progress.LatestBlock == nil || progress.ScannedTo == nil {
+			continue
+		}
+
+		if err := s.recordSample(ctx, progress, now); err != nil {
+			log.Error().Err(err).Int("chain_id", progress.ChainID).Msg("Failed to record scan lag sample")
+			continue
+		}
+
+		if err := s.checkChain(ctx, progress.ChainID, now); err != nil {
+			log.Error().Err(err).Int("chain_id", progress.ChainID).Msg("Failed to check scan lag")
+		}
+	}
+
+	if err := s.purgeSamples(ctx, now.Add(-s.config.Retention)); err != nil {
+		log.Error().Err(err).Msg("Failed to purge scan lag samples")
+	}
+}
+
+// recordSample 写入一次采样
+func (s *service) recordSample(ctx context.Context, progress *scan.ScanProgress, now time.Time) error {
+	latest := progress.LatestBlock.Int64()
+	scanned := progress.ScannedTo.Int64()
+
+	if _, err := s.db.ExecContext(ctx, `
+		INSERT INTO scan_lag_samples (chain_id, latest_block, scanned_block, lag, sampled_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`, progress.ChainID, latest, scanned, max(latest-scanned, 0), now); err != nil {
+		return errors.Wrap(err, "failed to insert scan lag sample")
+	}
+
+	return nil
+}
+
+// checkChain 计算统计窗口内的时间加权平均延迟，级别变化时告警
+// 采样历史不足一个窗口时（如刚启动）保持上次的级别
+func (s *service) checkChain(ctx context.Context, chainID int, now time.Time) error {
+	samples, err := s.windowSamples(ctx, chainID, now)
+	if err != nil {
+		return err
+	}
+
+	average, ok := timeWeightedAverage(samples, now.Add(-s.config.Window), now)
+	if !ok {
+		return nil
+	}
+
+	thresholds := s.config.thresholds(chainID)
+	level := lagLevel(average, thresholds)
+
+	s.mu.Lock()
+	previous, known := s.levels[chainID]
+	s.levels[chainID] = level
+	s.mu.Unlock()
+
+	// 首次检查时只在延迟过高时告警
+	if level == previous || (!known && level == LevelOK) {
+		return nil
+	}
+
+	a := &alert.Alert{
+		ChainID: chainID,
+		Fields: map[string]any{
+			"average_lag":     int64(average),
+			"current_lag":     samples[len(samples)-1].Lag,
+			"window_sec":      int64(s.config.Window.Seconds()),
+			"warning_blocks":  thresholds.WarningBlocks,
+			"critical_blocks": thresholds.CriticalBlocks,
+		},
+	}
+	switch level {
+	case LevelCritical:
+		a.Type = alert.TypeScanLagCritical
+		a.Severity = alert.SeverityCritical
+		a.Message = "Scanner has been lagging far behind the chain head"
+	case LevelWarning:
+		a.Type = alert.TypeScanLagWarning
+		a.Severity = alert.SeverityWarning
+		a.Message = "Scanner has been lagging behind the chain head"
+	default:
+		a.Type = alert.TypeScanLagRecovered
+		a.Severity = alert.SeverityInfo
+		a.Message = "Scanner caught up with the chain head"
+	}
+	if known {
+		a.Fields["previous_level"] = previous
+	}
+
+	notifier := s.notifier
+	if notifier == nil {
+		notifier = alert.NewNotifier("", nil, nil)
+	}
+	_ = notifier.Notify(ctx, a)
+
+	return nil
+}
+
+// purgeSamples 删除 before 之前的采样
+func (s *service) purgeSamples(ctx context.Context, before time.Time) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM scan_lag_samples WHERE sampled_at < $1`, before)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete scan lag samples")
+	}
+
+	if deleted, _ := result.RowsAffected(); deleted > 0 {
+		log.Debug().Int64("deleted", deleted).Msg("Purged expired scan lag samples")
+	}
+
+	return nil
+}
@@ -0,0 +1,248 @@
+// Package scanhealth 跟踪各链扫描器落后最新区块的程度（扫描延迟）
+// 定期采样每条链的扫描延迟写入 scan_lag_samples，按时间加权平均延迟持续超过阈值时告警，并提供延迟历史用于绘图
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+package scanhealth
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github/chapool/go-wallet/internal/wallet/alert"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/pkg/errors"
+)
+
+// 扫描延迟级别
+const (
+	LevelOK       = "ok"       // 平均延迟低于告警阈值
+	LevelWarning  = "warning"  // 平均延迟超过告警阈值
+	LevelCritical = "critical" // 平均延迟超过严重阈值
+	LevelUnknown  = "unknown"  // 采样历史不足一个统计窗口，无法判断
+)
+
+// Config 扫描延迟监控配置
+type Config struct {
+	// Window 时间加权平均延迟的统计窗口，延迟需要持续约这么久才会告警
+	Window time.Duration
+	// WarningBlocks / CriticalBlocks 平均延迟（区块数）超过该值时告警，0 表示不告警
+	WarningBlocks  int
+	CriticalBlocks int
+	// WarningBlocksOverrides / CriticalBlocksOverrides 按链覆盖阈值（chain_id -> 区块数）
+	WarningBlocksOverrides  map[int]int
+	CriticalBlocksOverrides map[int]int
+	// Retention 采样保留时长
+	Retention time.Duration
+}
+
+// Thresholds 链的扫描延迟阈值（区块数），0 表示不告警
+type Thresholds struct {
+	WarningBlocks  int
+	CriticalBlocks int
+}
+
+// thresholds 返回链的扫描延迟阈值
+func (c Config) thresholds(chainID int) Thresholds {
+	t := Thresholds{WarningBlocks: c.WarningBlocks, CriticalBlocks: c.CriticalBlocks}
+	if blocks, ok := c.WarningBlocksOverrides[chainID]; ok {
+		t.WarningBlocks = blocks
+	}
+	if blocks, ok := c.CriticalBlocksOverrides[chainID]; ok {
+		t.CriticalBlocks = blocks
+	}
+	return t
+}
+
+// Sample 一次扫描延迟采样
+type Sample struct {
+	LatestBlock  int64
+	ScannedBlock int64
+	Lag          int64 // 落后最新区块的区块数
+	SampledAt    time.Time
+}
+
+// Health 链的扫描延迟状态和历史
+type Health struct {
+	ChainID    int
+	Level      string
+	AverageLag *float64 // 最近一个统计窗口的时间加权平均延迟，采样历史不足时为空
+	CurrentLag *int64   // 最近一次采样的延迟，没有采样时为空
+	Thresholds Thresholds
+	Window     time.Duration
+	Samples    []*Sample // 查询时间范围内的采样，按时间升序
+}
+
+// Service 扫描延迟监控服务接口
+type Service interface {
+	// StartMonitor 启动定时采样、告警和过期采样清理
+	StartMonitor(ctx context.Context, interval time.Duration)
+
+	// GetHealth 获取链当前的扫描延迟状态和 [from, to) 范围内的采样历史，链不存在时返回 walleterrors.ErrChainNotFound
+	GetHealth(ctx context.Context, chainID int, from, to time.Time) (*Health, error)
+}
+
+// service 实现 Service 接口
+type service struct {
+	db           *sql.DB
+	config       Config
+	chainService chain.Service
+	scanService  scan.Service
+	notifier     alert.Notifier
+
+	mu     sync.Mutex
+	levels map[int]string // chain_id -> 上次告警时的级别，仅在级别变化时告警
+}
+
+// NewService 创建扫描延迟监控服务
+func NewService(db *sql.DB, config Config, chainService chain.Service, scanService scan.Service, notifier alert.Notifier) Service {
+	return &service{
+		db:           db,
+		config:       config,
+		chainService: chainService,
+		scanService:  scanService,
+		notifier:     notifier,
+		levels:       make(map[int]string),
+	}
+}
+
+// GetHealth 获取链当前的扫描延迟状态和采样历史
+func (s *service) GetHealth(ctx context.Context, chainID int, from, to time.Time) (*Health, error) {
+	if _, err := s.chainService.GetChain(ctx, chainID); err != nil {
+		return nil, err
+	}
+
+	samples, err := s.listSamples(ctx, chainID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	windowSamples, err := s.windowSamples(ctx, chainID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	health := &Health{
+		ChainID:    chainID,
+		Level:      LevelUnknown,
+		Thresholds: s.config.thresholds(chainID),
+		Window:     s.config.Window,
+		Samples:    samples,
+	}
+	if len(windowSamples) > 0 {
+		current := windowSamples[len(windowSamples)-1].Lag
+		health.CurrentLag = &current
+	}
+	if average, ok := timeWeightedAverage(windowSamples, now.Add(-s.config.Window), now); ok {
+		health.AverageLag = &average
+		health.Level = lagLevel(average, health.Thresholds)
+	}
+
+	return health, nil
+}
+
+// listSamples 查询 [from, to) 范围内的采样，按时间升序
+func (s *service) listSamples(ctx context.Context, chainID int, from, to time.Time) ([]*Sample, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT latest_block, scanned_block, lag, sampled_at
+		FROM scan_lag_samples
+		WHERE chain_id = $1 AND sampled_at >= $2 AND sampled_at < $3
+		ORDER BY sampled_at
+	`, chainID, from, to)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query scan lag samples")
+	}
+	defer rows.Close()
+
+	return scanSamples(rows)
+}
+
+// windowSamples 查询统计窗口内的采样，附带窗口开始前的最后一次采样（其延迟一直持续到窗口内的第一次采样）
+func (s *service) windowSamples(ctx context.Context, chainID int, now time.Time) ([]*Sample, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT latest_block, scanned_block, lag, sampled_at
+		FROM (
+			(
+				SELECT latest_block, scanned_block, lag, sampled_at
+				FROM scan_lag_samples
+				WHERE chain_id = $1 AND sampled_at < $2
+				ORDER BY sampled_at DESC
+				LIMIT 1
+			)
+			UNION ALL
+			SELECT latest_block, scanned_block, lag, sampled_at
+			FROM scan_lag_samples
+			WHERE chain_id = $1 AND sampled_at >= $2
+		) samples
+		ORDER BY sampled_at
+	`, chainID, now.Add(-s.config.Window))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query scan lag window samples")
+	}
+	defer rows.Close()
+
+	return scanSamples(rows)
+}
+
+// scanSamples 扫描采样结果
+func scanSamples(rows *sql.Rows) ([]*Sample, error) {
+	samples := make([]*Sample, 0)
+	for rows.Next() {
+		var sample Sample
+		if err := rows.Scan(&sample.LatestBlock, &sample.ScannedBlock, &sample.Lag, &sample.SampledAt); err != nil {
+			return nil, errors.Wrap(err, "failed to scan scan lag sample")
+		}
+		samples = append(samples, &sample)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate scan lag samples")
+	}
+
+	return samples, nil
+}
+
+// timeWeightedAverage 计算 [windowStart, now] 内的时间加权平均延迟
+// 每次采样的延迟持续到下一次采样（最后一次持续到 now），采样历史没有覆盖整个窗口时返回 false
+func timeWeightedAverage(samples []*Sample, windowStart, now time.Time) (float64, bool) {
+	if len(samples) == 0 || samples[0].SampledAt.After(windowStart) || !now.After(windowStart) {
+		return 0, false
+	}
+
+	var weighted float64
+	for i, sample := range samples {
+		start := sample.SampledAt
+		if start.Before(windowStart) {
+			start = windowStart
+		}
+		end := now
+		if i+1 < len(samples) {
+			end = samples[i+1].SampledAt
+		}
+		if end.After(now) {
+			end = now
+		}
+		if !end.After(start) {
+			continue
+		}
+		weighted += float64(sample.Lag) * end.Sub(start).Seconds()
+	}
+
+	return weighted / now.Sub(windowStart).Seconds(), true
+}
+
+// lagLevel 按阈值判断平均延迟的级别
+func lagLevel(average float64, t Thresholds) string {
+	switch {
+	case t.CriticalBlocks > 0 && average > float64(t.CriticalBlocks):
+		return LevelCritical
+	case t.WarningBlocks > 0 && average > float64(t.WarningBlocks):
+		return LevelWarning
+	default:
+		return LevelOK
+	}
+}
@@ -0,0 +1,78 @@
+package scanhealth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeWeightedAverage(t *testing.T) {
+	now := time.Date(2025, 12, 4, 12, 0, 0, 0, time.UTC)
+	windowStart := now.Add(-10 * time.Minute)
+	sample := func(lag int64, before time.Duration) *Sample {
+		return &Sample{Lag: lag, SampledAt: now.Add(-before)}
+	}
+
+	t.Run("weights lag by duration", func(t *testing.T) {
+		// 0 持续 8 分钟（窗口开始前的采样只计窗口内部分），100 持续 2 分钟
+		average, ok := timeWeightedAverage([]*Sample{
+			sample(0, 15*time.Minute),
+			sample(100, 2*time.Minute),
+		}, windowStart, now)
+		require.True(t, ok)
+		assert.InDelta(t, 20, average, 0.001)
+	})
+
+	t.Run("short spike stays below sustained lag", func(t *testing.T) {
+		spike, ok := timeWeightedAverage([]*Sample{
+			sample(10, 10*time.Minute),
+			sample(1000, 5*time.Minute),
+			sample(10, 4*time.Minute),
+		}, windowStart, now)
+		require.True(t, ok)
+
+		sustained, ok := timeWeightedAverage([]*Sample{
+			sample(300, 10*time.Minute),
+			sample(300, 5*time.Minute),
+		}, windowStart, now)
+		require.True(t, ok)
+
+		assert.InDelta(t, 109, spike, 0.001)
+		assert.InDelta(t, 300, sustained, 0.001)
+	})
+
+	t.Run("history shorter than window", func(t *testing.T) {
+		_, ok := timeWeightedAverage([]*Sample{sample(500, 5*time.Minute)}, windowStart, now)
+		assert.False(t, ok)
+	})
+
+	t.Run("no samples", func(t *testing.T) {
+		_, ok := timeWeightedAverage(nil, windowStart, now)
+		assert.False(t, ok)
+	})
+}
+
+func TestLagLevel(t *testing.T) {
+	thresholds := Thresholds{WarningBlocks: 50, CriticalBlocks: 200}
+
+	assert.Equal(t, LevelOK, lagLevel(50, thresholds))
+	assert.Equal(t, LevelWarning, lagLevel(50.5, thresholds))
+	assert.Equal(t, LevelCritical, lagLevel(201, thresholds))
+	assert.Equal(t, LevelOK, lagLevel(10000, Thresholds{}))
+	assert.Equal(t, LevelCritical, lagLevel(201, Thresholds{CriticalBlocks: 200}))
+}
+
+func TestConfigThresholds(t *testing.T) {
+	config := Config{
+		WarningBlocks:           100,
+		CriticalBlocks:          500,
+		WarningBlocksOverrides:  map[int]int{101: 1500},
+		CriticalBlocksOverrides: map[int]int{101: 7500, 1: 0},
+	}
+
+	assert.Equal(t, Thresholds{WarningBlocks: 100, CriticalBlocks: 500}, config.thresholds(56))
+	assert.Equal(t, Thresholds{WarningBlocks: 1500, CriticalBlocks: 7500}, config.thresholds(101))
+	assert.Equal(t, Thresholds{WarningBlocks: 100, CriticalBlocks: 0}, config.thresholds(1))
+}
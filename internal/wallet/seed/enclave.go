@@ -0,0 +1,93 @@
+package seed
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+
+	"github.com/pkg/errors"
+)
+
+const enclaveKeyLength = 32 // AES-256
+
+// enclave keeps a secret encrypted in memory with a random key generated per enclave (memguard-style),
+// so the plaintext only exists in memory while it is being used and core dumps or memory scraping
+// outside of that window only reveal the ciphertext. It is not safe for concurrent use.
+type enclave struct {
+	key        []byte
+	nonce      []byte
+	ciphertext []byte
+}
+
+// newEnclave seals plaintext into a new enclave, plaintext is wiped afterwards
+func newEnclave(plaintext []byte) (*enclave, error) {
+	defer wipe(plaintext)
+
+	key := make([]byte, enclaveKeyLength)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.Wrap(err, "failed to generate enclave key")
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		wipe(key)
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		wipe(key)
+		return nil, errors.Wrap(err, "failed to generate enclave nonce")
+	}
+
+	return &enclave{
+		key:        key,
+		nonce:      nonce,
+		ciphertext: aead.Seal(nil, nonce, plaintext, nil),
+	}, nil
+}
+
+// open decrypts the secret, the caller must wipe the returned plaintext once it is no longer needed
+func (e *enclave) open() ([]byte, error) {
+	aead, err := newAEAD(e.key)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := aead.Open(nil, e.nonce, e.ciphertext, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open enclave")
+	}
+
+	return plaintext, nil
+}
+
+// destroy wipes the key and ciphertext, the enclave can not be opened afterwards
+func (e *enclave) destroy() {
+	wipe(e.key)
+	wipe(e.ciphertext)
+	e.key = nil
+	e.nonce = nil
+	e.ciphertext = nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create enclave cipher")
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create enclave AEAD")
+	}
+
+	return aead, nil
+}
+
+// wipe overwrites b with zeros
+func wipe(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
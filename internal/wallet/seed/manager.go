@@ -3,15 +3,27 @@ package seed
 import (
 	"crypto/sha512"
 	"sync"
+	"time"
 
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
 	"golang.org/x/crypto/pbkdf2"
 )
 
 // manager implements seed management with thread-safe access
+// The seed is kept encrypted in an enclave and only decrypted for the duration of GetSeed
 type manager struct {
-	seed        []byte
+	enclave     *enclave
 	mu          sync.RWMutex
 	initialized bool
+	locked      bool
+	unlockedAt  time.Time
+	lockedAt    time.Time
+
+	autoLockAfter time.Duration
+	autoLockTimer *time.Timer
+	// generation is incremented on every unlock and lock so that a stale auto-lock timer does not lock a newer unlock
+	generation uint64
 }
 
 // NewManager creates a new SeedManager
@@ -19,7 +31,7 @@ type manager struct {
 //nolint:ireturn // Returning interface is intentional for dependency injection
 func NewManager() Manager {
 	return &manager{
-		seed:        nil,
+		enclave:     nil,
 		initialized: false,
 	}
 }
@@ -27,9 +39,6 @@ func NewManager() Manager {
 // Initialize initializes the seed manager with mnemonic and password
 // This converts mnemonic to seed using PBKDF2 (BIP39 standard)
 func (m *manager) Initialize(mnemonic string, password string) error {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-
 	// Convert mnemonic to seed using PBKDF2
 	// BIP39: seed = PBKDF2(mnemonic, "mnemonic" + password, 2048, 64, SHA512)
 	const (
@@ -45,8 +54,23 @@ func (m *manager) Initialize(mnemonic string, password string) error {
 		sha512.New,
 	)
 
-	m.seed = seed
+	// newEnclave wipes the plaintext seed
+	sealed, err := newEnclave(seed)
+	if err != nil {
+		return errors.Wrap(err, "failed to seal seed")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.destroyEnclave()
+	m.enclave = sealed
 	m.initialized = true
+	m.locked = false
+	m.unlockedAt = time.Now()
+	m.lockedAt = time.Time{}
+	m.generation++
+	m.resetAutoLockTimer()
 
 	return nil
 }
@@ -56,14 +80,17 @@ func (m *manager) GetSeed() []byte {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	if !m.initialized || m.seed == nil {
+	if !m.initialized || m.enclave == nil {
 		return nil
 	}
 
-	// Return a copy to prevent external modification
-	seedCopy := make([]byte, len(m.seed))
-	copy(seedCopy, m.seed)
-	return seedCopy
+	// The opened plaintext is a fresh copy owned by the caller
+	seed, err := m.enclave.open()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to open seed enclave")
+		return nil
+	}
+	return seed
 }
 
 // IsInitialized checks if seed is initialized
@@ -74,17 +101,114 @@ func (m *manager) IsInitialized() bool {
 	return m.initialized
 }
 
+// Lock wipes the seed from memory until the seed manager is initialized again
+func (m *manager) Lock() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.lock()
+}
+
+// IsLocked checks if the seed has been locked
+func (m *manager) IsLocked() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.locked
+}
+
+// SetAutoLock sets the auto-lock duration, the timer restarts if the seed is unlocked
+func (m *manager) SetAutoLock(after time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.autoLockAfter = after
+	m.resetAutoLockTimer()
+}
+
+// Status returns the lock state of the seed
+func (m *manager) Status() Status {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	status := Status{
+		Initialized: m.initialized,
+		Locked:      m.locked,
+	}
+	if !m.unlockedAt.IsZero() {
+		unlockedAt := m.unlockedAt
+		status.UnlockedAt = &unlockedAt
+	}
+	if m.locked {
+		lockedAt := m.lockedAt
+		status.LockedAt = &lockedAt
+	}
+	if m.enclave != nil && m.autoLockAfter > 0 {
+		autoLockAt := m.unlockedAt.Add(m.autoLockAfter)
+		status.AutoLockAt = &autoLockAt
+	}
+
+	return status
+}
+
 // Clear clears the seed from memory
 func (m *manager) Clear() {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if m.seed != nil {
-		// Clear seed from memory
-		for i := range m.seed {
-			m.seed[i] = 0
+	m.destroyEnclave()
+	m.stopAutoLockTimer()
+	m.initialized = false
+	m.locked = false
+	m.generation++
+}
+
+// lock wipes the seed, must be called with mu held
+func (m *manager) lock() {
+	if m.locked || m.enclave == nil {
+		return
+	}
+
+	m.destroyEnclave()
+	m.stopAutoLockTimer()
+	m.locked = true
+	m.lockedAt = time.Now()
+	m.generation++
+}
+
+// destroyEnclave wipes the enclave holding the seed, must be called with mu held
+func (m *manager) destroyEnclave() {
+	if m.enclave != nil {
+		m.enclave.destroy()
+		m.enclave = nil
+	}
+}
+
+// resetAutoLockTimer restarts the auto-lock timer relative to the last unlock, must be called with mu held
+func (m *manager) resetAutoLockTimer() {
+	m.stopAutoLockTimer()
+	if m.autoLockAfter <= 0 || m.enclave == nil {
+		return
+	}
+
+	generation := m.generation
+	remaining := time.Until(m.unlockedAt.Add(m.autoLockAfter))
+	m.autoLockTimer = time.AfterFunc(remaining, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if m.generation != generation {
+			return
 		}
-		m.seed = nil
+		m.lock()
+		log.Warn().Dur("auto_lock_after", m.autoLockAfter).Msg("Seed auto-locked, signing is disabled until the keystore is unlocked")
+	})
+}
+
+// stopAutoLockTimer stops the pending auto-lock timer, must be called with mu held
+func (m *manager) stopAutoLockTimer() {
+	if m.autoLockTimer != nil {
+		m.autoLockTimer.Stop()
+		m.autoLockTimer = nil
 	}
-	m.initialized = false
 }
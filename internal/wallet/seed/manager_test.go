@@ -0,0 +1,88 @@
+package seed
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:dupword // BIP39 test mnemonic with repeated words
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestEnclaveRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("correct horse battery staple")
+	plaintext := bytes.Clone(secret)
+
+	sealed, err := newEnclave(plaintext)
+	require.NoError(t, err)
+	assert.Equal(t, make([]byte, len(secret)), plaintext, "plaintext must be wiped after sealing")
+	assert.NotContains(t, string(sealed.ciphertext), string(secret))
+
+	opened, err := sealed.open()
+	require.NoError(t, err)
+	assert.Equal(t, secret, opened)
+
+	sealed.destroy()
+	_, err = sealed.open()
+	require.Error(t, err)
+}
+
+func TestManagerLock(t *testing.T) {
+	t.Parallel()
+
+	m := NewManager()
+	assert.Nil(t, m.GetSeed())
+	assert.False(t, m.Status().Initialized)
+
+	require.NoError(t, m.Initialize(testMnemonic, "testpassword"))
+	seed := m.GetSeed()
+	require.Len(t, seed, 64)
+
+	// GetSeed returns a copy, callers can not modify the stored seed
+	seed[0] ^= 0xff
+	assert.NotEqual(t, seed, m.GetSeed())
+
+	m.Lock()
+	assert.True(t, m.IsLocked())
+	assert.True(t, m.IsInitialized())
+	assert.Nil(t, m.GetSeed())
+
+	status := m.Status()
+	assert.True(t, status.Locked)
+	assert.NotNil(t, status.LockedAt)
+	assert.Nil(t, status.AutoLockAt)
+
+	// Unlocking derives the same seed again
+	require.NoError(t, m.Initialize(testMnemonic, "testpassword"))
+	assert.False(t, m.IsLocked())
+	seed[0] ^= 0xff
+	assert.Equal(t, seed, m.GetSeed())
+	assert.Nil(t, m.Status().LockedAt)
+}
+
+func TestManagerAutoLock(t *testing.T) {
+	t.Parallel()
+
+	m := NewManager()
+	m.SetAutoLock(50 * time.Millisecond)
+	require.NoError(t, m.Initialize(testMnemonic, "testpassword"))
+
+	status := m.Status()
+	require.NotNil(t, status.AutoLockAt)
+	assert.Equal(t, status.UnlockedAt.Add(50*time.Millisecond), *status.AutoLockAt)
+
+	assert.Eventually(t, m.IsLocked, time.Second, 10*time.Millisecond)
+	assert.Nil(t, m.GetSeed())
+
+	// Disabling auto-lock keeps the seed unlocked
+	m.SetAutoLock(0)
+	require.NoError(t, m.Initialize(testMnemonic, "testpassword"))
+	time.Sleep(100 * time.Millisecond)
+	assert.False(t, m.IsLocked())
+	assert.Nil(t, m.Status().AutoLockAt)
+}
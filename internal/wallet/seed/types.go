@@ -1,16 +1,39 @@
 package seed
 
+import "time"
+
 // Manager provides seed management functionality
 type Manager interface {
-	// Initialize initializes the seed manager (called at startup)
+	// Initialize initializes the seed manager (called at startup and when unlocking)
 	Initialize(mnemonic string, password string) error
 
-	// GetSeed gets the seed (from memory)
+	// GetSeed gets the seed (from memory), returns nil while the seed is locked or not initialized
 	GetSeed() []byte
 
 	// IsInitialized checks if seed is initialized
 	IsInitialized() bool
 
+	// Lock wipes the seed from memory, signing and address derivation are disabled until the seed is initialized again
+	Lock()
+
+	// IsLocked checks if the seed has been locked
+	IsLocked() bool
+
+	// SetAutoLock locks the seed automatically after it has been unlocked for the given duration (0 disables auto-lock)
+	SetAutoLock(after time.Duration)
+
+	// Status returns the lock state of the seed
+	Status() Status
+
 	// Clear clears the seed from memory
 	Clear()
 }
+
+// Status describes the lock state of the seed
+type Status struct {
+	Initialized bool
+	Locked      bool
+	UnlockedAt  *time.Time // last time the seed was initialized
+	LockedAt    *time.Time // set while the seed is locked
+	AutoLockAt  *time.Time // set while the seed is unlocked and auto-lock is enabled
+}
@@ -3,15 +3,17 @@ package wallet
 import (
 	"context"
 	"database/sql"
-	"strings"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/pkg/errors"
 	"github/chapool/go-wallet/internal/models"
 	"github/chapool/go-wallet/internal/util"
 	"github/chapool/go-wallet/internal/util/db"
 	"github/chapool/go-wallet/internal/wallet/address"
-	"github/chapool/go-wallet/internal/wallet/seed"
+	walletChain "github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/smartaccount"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
 )
 
 // Service provides wallet management functionality
@@ -27,25 +29,44 @@ type Service interface {
 
 	// GetWalletByAddress gets wallet by address and chain ID
 	GetWalletByAddress(ctx context.Context, address string, chainID int) (*Wallet, error)
+
+	// RegisterWatchAddress registers an externally controlled address of a user to be monitored for deposits
+	RegisterWatchAddress(ctx context.Context, userID string, chainID int, address string) (*Wallet, error)
+
+	// ListWatchAddresses lists registered watch addresses, optionally filtered by user and chain
+	ListWatchAddresses(ctx context.Context, userID *string, chainID *int) ([]*Wallet, error)
+
+	// RemoveWatchAddress stops monitoring a watch address
+	RemoveWatchAddress(ctx context.Context, walletID string) error
+
+	// SetSmartAccounts injects the ERC-4337 smart account address provider.
+	// The provider queries factory contracts through the scan service, which is created after the wallet service.
+	SetSmartAccounts(smartAccounts smartaccount.Service)
 }
 
 type service struct {
 	db             *sql.DB
-	seedManager    seed.Manager
+	deriver        address.Deriver
 	addressService address.Service
+	smartAccounts  smartaccount.Service
 }
 
 // NewService creates a new WalletService
 //
 //nolint:ireturn // Returning interface is intentional for dependency injection
-func NewService(db *sql.DB, seedManager seed.Manager, addressService address.Service) (Service, error) {
+func NewService(db *sql.DB, deriver address.Deriver, addressService address.Service) (Service, error) {
 	return &service{
 		db:             db,
-		seedManager:    seedManager,
+		deriver:        deriver,
 		addressService: addressService,
 	}, nil
 }
 
+// SetSmartAccounts injects the smart account address provider, without it all wallets are derived addresses
+func (s *service) SetSmartAccounts(smartAccounts smartaccount.Service) {
+	s.smartAccounts = smartAccounts
+}
+
 // CreateWallet creates a wallet for user on specified chain
 func (s *service) CreateWallet(ctx context.Context, userID string, chainID int) (*Wallet, error) {
 	log := util.LogFromContext(ctx).With().
@@ -61,7 +82,7 @@ func (s *service) CreateWallet(ctx context.Context, userID string, chainID int)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.New("chain not found or inactive")
+			return nil, walleterrors.ErrChainNotFound
 		}
 		return nil, errors.Wrap(err, "failed to get chain")
 	}
@@ -70,6 +91,7 @@ func (s *service) CreateWallet(ctx context.Context, userID string, chainID int)
 	existingWallet, err := models.Wallets(
 		models.WalletWhere.UserID.EQ(userID),
 		models.WalletWhere.ChainID.EQ(chainID),
+		models.WalletWhere.WalletType.NEQ(models.WalletTypeWatch),
 	).One(ctx, s.db)
 
 	if err == nil {
@@ -82,40 +104,51 @@ func (s *service) CreateWallet(ctx context.Context, userID string, chainID int)
 		return nil, errors.Wrap(err, "failed to check existing wallet")
 	}
 
-	// Get seed from memory
-	seed := s.seedManager.GetSeed()
-	if seed == nil {
-		return nil, errors.New("seed not initialized")
-	}
-
-	// Get next address index (shared across all EVM chains)
-	addressIndex, err := s.addressService.GetNextAddressIndex(ctx, "evm", "")
+	// Get next address index (shared across all chains of the same chain type)
+	addressIndex, err := s.addressService.GetNextAddressIndex(ctx, chain.ChainType, "")
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get next address index")
 	}
 
-	// Get BIP44 path
-	path := s.addressService.GetBIP44Path(addressIndex)
+	// Get derivation path
+	path := s.addressService.GetDerivationPath(chain.ChainType, addressIndex)
 
-	// Derive address from seed
-	derivedAddress, err := s.addressService.DeriveAddress(ctx, seed, path, "evm")
+	// Derive address from seed (in remote signer mode by the signer process)
+	derivedAddress, err := s.deriver.DeriveAddress(ctx, path, chain.ChainType)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to derive address")
 	}
 
+	// EVM addresses are stored lowercase for consistent storage and querying, Solana addresses are case-sensitive
+	normalizedAddress := walletChain.NormalizeAddress(chain.ChainType, derivedAddress)
+
+	// On chains with smart accounts the deposit address is the counterfactual account of the derived owner address
+	var smartAccount *smartaccount.Account
+	if s.smartAccounts != nil && chain.ChainType == walletChain.TypeEVM {
+		if _, ok := s.smartAccounts.Config(chainID); ok {
+			smartAccount, err = s.smartAccounts.AccountAddress(ctx, chainID, normalizedAddress)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to compute smart account address")
+			}
+			normalizedAddress = smartAccount.Address
+		}
+	}
+
 	// Create wallet record in database
-	// Convert address to lowercase for consistent storage and querying
-	addressLower := strings.ToLower(derivedAddress)
 	var walletModel *models.Wallet
 	err = db.WithTransaction(ctx, s.db, func(tx boil.ContextExecutor) error {
 		walletModel = &models.Wallet{
 			UserID:         userID,
-			Address:        addressLower,
-			ChainType:      "evm",
+			Address:        normalizedAddress,
+			ChainType:      chain.ChainType,
 			ChainID:        chainID,
 			DerivationPath: path,
 			AddressIndex:   addressIndex,
-			WalletType:     "user",
+			WalletType:     models.WalletTypeUser,
+		}
+		if smartAccount != nil {
+			walletModel.SmartAccountOwner = null.StringFrom(smartAccount.Owner)
+			walletModel.SmartAccountFactory = null.StringFrom(smartAccount.Factory)
 		}
 
 		if err := walletModel.Insert(ctx, tx, boil.Infer()); err != nil {
@@ -131,7 +164,8 @@ func (s *service) CreateWallet(ctx context.Context, userID string, chainID int)
 	}
 
 	log.Info().
-		Str("address", derivedAddress).
+		Str("address", walletModel.Address).
+		Str("smart_account_owner", walletModel.SmartAccountOwner.String).
 		Int("address_index", addressIndex).
 		Msg("Wallet created successfully")
 
@@ -143,11 +177,12 @@ func (s *service) GetWallet(ctx context.Context, userID string, chainID int) (*W
 	walletModel, err := models.Wallets(
 		models.WalletWhere.UserID.EQ(userID),
 		models.WalletWhere.ChainID.EQ(chainID),
+		models.WalletWhere.WalletType.NEQ(models.WalletTypeWatch),
 	).One(ctx, s.db)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.New("wallet not found")
+			return nil, walleterrors.ErrWalletNotFound
 		}
 		return nil, errors.Wrap(err, "failed to get wallet")
 	}
@@ -214,7 +249,7 @@ func (s *service) GetWalletByAddress(ctx context.Context, address string, chainI
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.New("wallet not found")
+			return nil, walleterrors.ErrWalletNotFound
 		}
 		return nil, errors.Wrap(err, "failed to get wallet by address")
 	}
@@ -0,0 +1,223 @@
+package settings
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// maxGasPriceCapOverrideReasonLength 覆盖原因的最大长度
+const maxGasPriceCapOverrideReasonLength = 500
+
+// GasPriceCapOverride 链的 gas 价格上限临时覆盖：生效期间出账交易按覆盖的上限检查，用于 gas 价格高企时的紧急出款
+type GasPriceCapOverride struct {
+	ChainID     int
+	MaxGasPrice *big.Int // wei，为空表示生效期间不限制 gas 价格
+	Reason      string
+	EndsAt      time.Time
+	UpdatedBy   *string
+	UpdatedAt   time.Time
+}
+
+// ActiveAt 覆盖在 t 时是否生效
+func (o *GasPriceCapOverride) ActiveAt(t time.Time) bool {
+	return t.Before(o.EndsAt)
+}
+
+// GasPriceCapOverrideRequest 覆盖 gas 价格上限请求
+type GasPriceCapOverrideRequest struct {
+	ChainID     int
+	MaxGasPrice *big.Int // wei，为空表示生效期间不限制 gas 价格
+	Reason      string
+	EndsAt      time.Time // 覆盖必须设置结束时间，避免紧急出款后忘记恢复
+}
+
+// gasPriceCapValue system_settings.value 中的 gas 价格上限覆盖
+type gasPriceCapValue struct {
+	MaxGasPrice *string   `json:"max_gas_price,omitempty"` // wei 十进制字符串
+	Reason      string    `json:"reason"`
+	EndsAt      time.Time `json:"ends_at"`
+}
+
+// validateGasPriceCapOverride 校验覆盖请求，返回要保存的设置
+func validateGasPriceCapOverride(req *GasPriceCapOverrideRequest, now time.Time) (*gasPriceCapValue, error) {
+	value := &gasPriceCapValue{
+		Reason: strings.TrimSpace(req.Reason),
+		EndsAt: req.EndsAt,
+	}
+	if len(value.Reason) > maxGasPriceCapOverrideReasonLength {
+		return nil, errors.Wrapf(ErrInvalidGasPriceCapOverride, "reason must not exceed %d characters", maxGasPriceCapOverrideReasonLength)
+	}
+	if req.MaxGasPrice != nil {
+		if req.MaxGasPrice.Sign() <= 0 {
+			return nil, errors.Wrap(ErrInvalidGasPriceCapOverride, "max gas price must be at least 1 wei")
+		}
+		maxGasPrice := req.MaxGasPrice.String()
+		value.MaxGasPrice = &maxGasPrice
+	}
+	if !value.EndsAt.After(now) {
+		return nil, errors.Wrap(ErrInvalidGasPriceCapOverride, "ends_at must be in the future")
+	}
+
+	return value, nil
+}
+
+// ListGasPriceCapOverrides 查询所有链的 gas 价格上限覆盖，按链排序
+func (s *service) ListGasPriceCapOverrides(ctx context.Context) ([]*GasPriceCapOverride, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT chain_id, value, updated_by, updated_at
+		FROM system_settings
+		WHERE key = $1
+		ORDER BY chain_id
+	`, KeyGasPriceCap)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query gas price cap overrides")
+	}
+	defer rows.Close()
+
+	overrides := make([]*GasPriceCapOverride, 0)
+	for rows.Next() {
+		override, err := scanGasPriceCapOverride(rows)
+		if err != nil {
+			return nil, err
+		}
+		overrides = append(overrides, override)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate gas price cap overrides")
+	}
+
+	return overrides, nil
+}
+
+// GetGasPriceCapOverride 获取链当前生效的 gas 价格上限覆盖，已过期的覆盖视为不存在
+func (s *service) GetGasPriceCapOverride(ctx context.Context, chainID int) (*GasPriceCapOverride, error) {
+	override, err := scanGasPriceCapOverride(s.db.QueryRowContext(ctx, `
+		SELECT chain_id, value, updated_by, updated_at
+		FROM system_settings
+		WHERE key = $1 AND chain_id = $2
+	`, KeyGasPriceCap, chainID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if !override.ActiveAt(time.Now()) {
+		return nil, nil
+	}
+
+	return override, nil
+}
+
+// SetGasPriceCapOverride 创建或替换链的 gas 价格上限覆盖
+func (s *service) SetGasPriceCapOverride(ctx context.Context, req *GasPriceCapOverrideRequest, adminUserID string) (*GasPriceCapOverride, error) {
+	value, err := validateGasPriceCapOverride(req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := models.Chains(models.ChainWhere.ChainID.EQ(req.ChainID)).Exists(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check chain")
+	}
+	if !exists {
+		return nil, ErrChainNotFound
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal gas price cap override")
+	}
+
+	saved, err := scanGasPriceCapOverride(s.db.QueryRowContext(ctx, `
+		INSERT INTO system_settings (key, chain_id, value, updated_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (key, COALESCE(chain_id, 0)) DO UPDATE SET value = EXCLUDED.value,
+			updated_by = EXCLUDED.updated_by, updated_at = NOW()
+		RETURNING chain_id, value, updated_by, updated_at
+	`, KeyGasPriceCap, req.ChainID, data, adminUserID))
+	if err != nil {
+		return nil, err
+	}
+
+	event := log.Warn().
+		Str("admin_user_id", adminUserID).
+		Int("chain_id", saved.ChainID).
+		Str("reason", saved.Reason).
+		Time("ends_at", saved.EndsAt)
+	if saved.MaxGasPrice != nil {
+		event = event.Str("max_gas_price_wei", saved.MaxGasPrice.String())
+	}
+	event.Msg("Gas price cap overridden, outgoing transactions are sent above the configured cap until the override ends")
+
+	return saved, nil
+}
+
+// ClearGasPriceCapOverride 删除链的 gas 价格上限覆盖
+func (s *service) ClearGasPriceCapOverride(ctx context.Context, chainID int) error {
+	res, err := s.db.ExecContext(ctx, `
+		DELETE FROM system_settings WHERE key = $1 AND chain_id = $2
+	`, KeyGasPriceCap, chainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete gas price cap override")
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get affected rows")
+	}
+	if affected == 0 {
+		return ErrGasPriceCapOverrideNotFound
+	}
+
+	log.Info().Int("chain_id", chainID).Msg("Gas price cap override cleared")
+
+	return nil
+}
+
+// scanGasPriceCapOverride 扫描 chain_id, value, updated_by, updated_at
+func scanGasPriceCapOverride(row rowScanner) (*GasPriceCapOverride, error) {
+	var (
+		chainID   int
+		data      []byte
+		updatedBy sql.NullString
+		updatedAt time.Time
+	)
+	if err := row.Scan(&chainID, &data, &updatedBy, &updatedAt); err != nil {
+		return nil, errors.Wrap(err, "failed to scan gas price cap override")
+	}
+
+	var value gasPriceCapValue
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal gas price cap override")
+	}
+
+	override := &GasPriceCapOverride{
+		ChainID:   chainID,
+		Reason:    value.Reason,
+		EndsAt:    value.EndsAt,
+		UpdatedAt: updatedAt,
+	}
+	if value.MaxGasPrice != nil {
+		maxGasPrice, ok := new(big.Int).SetString(*value.MaxGasPrice, 10)
+		if !ok {
+			return nil, errors.Errorf("invalid max gas price %q in gas price cap override", *value.MaxGasPrice)
+		}
+		override.MaxGasPrice = maxGasPrice
+	}
+	if updatedBy.Valid {
+		override.UpdatedBy = &updatedBy.String
+	}
+
+	return override, nil
+}
@@ -0,0 +1,51 @@
+package settings
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGasPriceCapOverride(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	value, err := validateGasPriceCapOverride(&GasPriceCapOverrideRequest{
+		MaxGasPrice: big.NewInt(50_000_000_000),
+		Reason:      "  urgent payout  ",
+		EndsAt:      now.Add(time.Hour),
+	}, now)
+	require.NoError(t, err)
+	require.NotNil(t, value.MaxGasPrice)
+	assert.Equal(t, "50000000000", *value.MaxGasPrice)
+	assert.Equal(t, "urgent payout", value.Reason)
+
+	// 未设置上限表示覆盖期间不限制 gas 价格
+	value, err = validateGasPriceCapOverride(&GasPriceCapOverrideRequest{EndsAt: now.Add(time.Hour)}, now)
+	require.NoError(t, err)
+	assert.Nil(t, value.MaxGasPrice)
+
+	tests := []struct {
+		name string
+		req  *GasPriceCapOverrideRequest
+	}{
+		{name: "ZeroMaxGasPrice", req: &GasPriceCapOverrideRequest{MaxGasPrice: big.NewInt(0), EndsAt: now.Add(time.Hour)}},
+		{name: "EndsInPast", req: &GasPriceCapOverrideRequest{EndsAt: now.Add(-time.Hour)}},
+		{name: "NoEndsAt", req: &GasPriceCapOverrideRequest{}},
+		{name: "ReasonTooLong", req: &GasPriceCapOverrideRequest{Reason: strings.Repeat("a", maxGasPriceCapOverrideReasonLength+1), EndsAt: now.Add(time.Hour)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := validateGasPriceCapOverride(tt.req, now)
+			assert.True(t, errors.Is(err, ErrInvalidGasPriceCapOverride))
+		})
+	}
+}
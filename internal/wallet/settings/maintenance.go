@@ -0,0 +1,266 @@
+package settings
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// maxMaintenanceMessageLength 维护通知的最大长度
+const maxMaintenanceMessageLength = 500
+
+// defaultMaintenanceMessage 未填写维护通知时返回给用户的通知
+const defaultMaintenanceMessage = "Withdraws are paused for scheduled maintenance, approved withdraws are processed after the maintenance"
+
+// Maintenance 维护模式：维护期间照常受理提现请求，获得批准后排队，不签名广播，维护结束后由提现调度器处理
+type Maintenance struct {
+	ChainID   *int // 为空表示全局维护
+	Message   string
+	StartsAt  time.Time
+	EndsAt    *time.Time // 为空表示直到管理员关闭
+	UpdatedBy *string
+	UpdatedAt time.Time
+}
+
+// ActiveAt 维护模式在 t 时是否生效
+func (m *Maintenance) ActiveAt(t time.Time) bool {
+	return !t.Before(m.StartsAt) && (m.EndsAt == nil || t.Before(*m.EndsAt))
+}
+
+// MaintenanceRequest 开启维护模式请求
+type MaintenanceRequest struct {
+	ChainID  *int       // 为空表示全局维护
+	Message  string     // 返回给用户的维护通知，为空时使用默认通知
+	StartsAt *time.Time // 为空表示立即开始
+	EndsAt   *time.Time // 为空表示直到管理员关闭
+}
+
+// ActiveMaintenances 当前生效的维护模式快照
+type ActiveMaintenances struct {
+	Global *Maintenance
+	Chains map[int]*Maintenance
+}
+
+// For 获取链生效的维护模式，链级设置优先于全局设置，不在维护时返回 nil
+func (a *ActiveMaintenances) For(chainID int) *Maintenance {
+	if a == nil {
+		return nil
+	}
+	if maintenance, ok := a.Chains[chainID]; ok {
+		return maintenance
+	}
+	return a.Global
+}
+
+// maintenanceValue system_settings.value 中的维护模式设置
+type maintenanceValue struct {
+	Message  string     `json:"message"`
+	StartsAt time.Time  `json:"starts_at"`
+	EndsAt   *time.Time `json:"ends_at,omitempty"`
+}
+
+// validateMaintenance 校验维护模式请求，返回要保存的设置
+func validateMaintenance(req *MaintenanceRequest, now time.Time) (*maintenanceValue, error) {
+	value := &maintenanceValue{
+		Message:  strings.TrimSpace(req.Message),
+		StartsAt: now,
+		EndsAt:   req.EndsAt,
+	}
+	if value.Message == "" {
+		value.Message = defaultMaintenanceMessage
+	}
+	if len(value.Message) > maxMaintenanceMessageLength {
+		return nil, errors.Wrapf(ErrInvalidMaintenance, "message must not exceed %d characters", maxMaintenanceMessageLength)
+	}
+	if req.StartsAt != nil {
+		value.StartsAt = *req.StartsAt
+	}
+	if value.EndsAt != nil {
+		if !value.EndsAt.After(value.StartsAt) {
+			return nil, errors.Wrap(ErrInvalidMaintenance, "ends_at must be after starts_at")
+		}
+		if !value.EndsAt.After(now) {
+			return nil, errors.Wrap(ErrInvalidMaintenance, "ends_at must be in the future")
+		}
+	}
+
+	return value, nil
+}
+
+// ListMaintenances 查询所有维护模式设置，全局设置在前，之后按链排序
+func (s *service) ListMaintenances(ctx context.Context) ([]*Maintenance, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT chain_id, value, updated_by, updated_at
+		FROM system_settings
+		WHERE key = $1
+		ORDER BY chain_id NULLS FIRST
+	`, KeyMaintenance)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query maintenance settings")
+	}
+	defer rows.Close()
+
+	maintenances := make([]*Maintenance, 0)
+	for rows.Next() {
+		maintenance, err := scanMaintenance(rows)
+		if err != nil {
+			return nil, err
+		}
+		maintenances = append(maintenances, maintenance)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate maintenance settings")
+	}
+
+	return maintenances, nil
+}
+
+// GetActiveMaintenances 获取当前生效的维护模式
+func (s *service) GetActiveMaintenances(ctx context.Context) (*ActiveMaintenances, error) {
+	maintenances, err := s.ListMaintenances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	active := &ActiveMaintenances{Chains: make(map[int]*Maintenance)}
+	for _, maintenance := range maintenances {
+		if !maintenance.ActiveAt(now) {
+			continue
+		}
+		if maintenance.ChainID == nil {
+			active.Global = maintenance
+			continue
+		}
+		active.Chains[*maintenance.ChainID] = maintenance
+	}
+
+	return active, nil
+}
+
+// GetMaintenance 获取链当前生效的维护模式
+func (s *service) GetMaintenance(ctx context.Context, chainID int) (*Maintenance, error) {
+	active, err := s.GetActiveMaintenances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return active.For(chainID), nil
+}
+
+// SetMaintenance 创建或覆盖全局或链的维护模式设置
+func (s *service) SetMaintenance(ctx context.Context, req *MaintenanceRequest, adminUserID string) (*Maintenance, error) {
+	value, err := validateMaintenance(req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ChainID != nil {
+		exists, err := models.Chains(models.ChainWhere.ChainID.EQ(*req.ChainID)).Exists(ctx, s.db)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to check chain")
+		}
+		if !exists {
+			return nil, ErrChainNotFound
+		}
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal maintenance settings")
+	}
+
+	saved, err := scanMaintenance(s.db.QueryRowContext(ctx, `
+		INSERT INTO system_settings (key, chain_id, value, updated_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (key, COALESCE(chain_id, 0)) DO UPDATE SET value = EXCLUDED.value,
+			updated_by = EXCLUDED.updated_by, updated_at = NOW()
+		RETURNING chain_id, value, updated_by, updated_at
+	`, KeyMaintenance, req.ChainID, data, adminUserID))
+	if err != nil {
+		return nil, err
+	}
+
+	event := log.Info().Str("admin_user_id", adminUserID).Time("starts_at", saved.StartsAt)
+	if saved.ChainID != nil {
+		event = event.Int("chain_id", *saved.ChainID)
+	}
+	if saved.EndsAt != nil {
+		event = event.Time("ends_at", *saved.EndsAt)
+	}
+	event.Msg("Maintenance mode scheduled, approved withdraws are queued during the maintenance")
+
+	return saved, nil
+}
+
+// ClearMaintenance 删除全局或链的维护模式设置，维护期间排队的提现由提现调度器处理
+func (s *service) ClearMaintenance(ctx context.Context, chainID *int) error {
+	res, err := s.db.ExecContext(ctx, `
+		DELETE FROM system_settings WHERE key = $1 AND COALESCE(chain_id, 0) = COALESCE($2, 0)
+	`, KeyMaintenance, chainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete maintenance settings")
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get affected rows")
+	}
+	if affected == 0 {
+		return ErrMaintenanceNotFound
+	}
+
+	event := log.Info()
+	if chainID != nil {
+		event = event.Int("chain_id", *chainID)
+	}
+	event.Msg("Maintenance mode cleared")
+
+	return nil
+}
+
+// rowScanner 兼容 *sql.Row 和 *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanMaintenance 扫描 chain_id, value, updated_by, updated_at
+func scanMaintenance(row rowScanner) (*Maintenance, error) {
+	var (
+		chainID   sql.NullInt64
+		data      []byte
+		updatedBy sql.NullString
+		updatedAt time.Time
+	)
+	if err := row.Scan(&chainID, &data, &updatedBy, &updatedAt); err != nil {
+		return nil, errors.Wrap(err, "failed to scan maintenance settings")
+	}
+
+	var value maintenanceValue
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal maintenance settings")
+	}
+
+	maintenance := &Maintenance{
+		Message:   value.Message,
+		StartsAt:  value.StartsAt,
+		EndsAt:    value.EndsAt,
+		UpdatedAt: updatedAt,
+	}
+	if chainID.Valid {
+		id := int(chainID.Int64)
+		maintenance.ChainID = &id
+	}
+	if updatedBy.Valid {
+		maintenance.UpdatedBy = &updatedBy.String
+	}
+
+	return maintenance, nil
+}
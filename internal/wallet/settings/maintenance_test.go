@@ -0,0 +1,83 @@
+package settings
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceActiveAt(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Date(2025, 12, 1, 2, 0, 0, 0, time.UTC)
+	endsAt := startsAt.Add(2 * time.Hour)
+
+	scheduled := &Maintenance{StartsAt: startsAt, EndsAt: &endsAt}
+	assert.False(t, scheduled.ActiveAt(startsAt.Add(-time.Second)))
+	assert.True(t, scheduled.ActiveAt(startsAt))
+	assert.True(t, scheduled.ActiveAt(endsAt.Add(-time.Second)))
+	assert.False(t, scheduled.ActiveAt(endsAt))
+
+	// 未设置结束时间时直到管理员关闭
+	open := &Maintenance{StartsAt: startsAt}
+	assert.True(t, open.ActiveAt(startsAt.Add(24*time.Hour)))
+}
+
+func TestActiveMaintenancesFor(t *testing.T) {
+	t.Parallel()
+
+	global := &Maintenance{Message: "global"}
+	chain := &Maintenance{Message: "chain 56"}
+
+	active := &ActiveMaintenances{Global: global, Chains: map[int]*Maintenance{56: chain}}
+	assert.Same(t, chain, active.For(56))
+	assert.Same(t, global, active.For(1))
+
+	active = &ActiveMaintenances{Chains: map[int]*Maintenance{56: chain}}
+	assert.Nil(t, active.For(1))
+
+	var none *ActiveMaintenances
+	assert.Nil(t, none.For(56))
+}
+
+func TestValidateMaintenance(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	timePtr := func(t time.Time) *time.Time { return &t }
+
+	value, err := validateMaintenance(&MaintenanceRequest{Message: "  Upgrading nodes  "}, now)
+	require.NoError(t, err)
+	assert.Equal(t, "Upgrading nodes", value.Message)
+	assert.Equal(t, now, value.StartsAt)
+	assert.Nil(t, value.EndsAt)
+
+	value, err = validateMaintenance(&MaintenanceRequest{
+		StartsAt: timePtr(now.Add(time.Hour)),
+		EndsAt:   timePtr(now.Add(3 * time.Hour)),
+	}, now)
+	require.NoError(t, err)
+	assert.Equal(t, defaultMaintenanceMessage, value.Message)
+	assert.Equal(t, now.Add(time.Hour), value.StartsAt)
+
+	tests := []struct {
+		name string
+		req  *MaintenanceRequest
+	}{
+		{name: "EndsBeforeStart", req: &MaintenanceRequest{StartsAt: timePtr(now.Add(2 * time.Hour)), EndsAt: timePtr(now.Add(time.Hour))}},
+		{name: "EndsInPast", req: &MaintenanceRequest{StartsAt: timePtr(now.Add(-2 * time.Hour)), EndsAt: timePtr(now.Add(-time.Hour))}},
+		{name: "MessageTooLong", req: &MaintenanceRequest{Message: strings.Repeat("a", maxMaintenanceMessageLength+1)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := validateMaintenance(tt.req, now)
+			assert.True(t, errors.Is(err, ErrInvalidMaintenance))
+		})
+	}
+}
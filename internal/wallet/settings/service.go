@@ -0,0 +1,70 @@
+//nolint:ireturn // 返回接口类型是预期的设计
+package settings
+
+import (
+	"context"
+	"database/sql"
+
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/pkg/errors"
+)
+
+// 系统设置（与 system_settings.key 一致）
+const (
+	KeyMaintenance = "maintenance"
+	KeyGasPriceCap = "gas_price_cap"
+)
+
+var (
+	// ErrInvalidMaintenance 维护模式参数不合法
+	ErrInvalidMaintenance = errors.New("invalid maintenance settings")
+	// ErrChainNotFound 链不存在
+	ErrChainNotFound = walleterrors.ErrChainNotFound
+	// ErrMaintenanceNotFound 全局或链没有维护模式设置
+	ErrMaintenanceNotFound = errors.New("maintenance not found")
+	// ErrInvalidGasPriceCapOverride gas 价格上限覆盖参数不合法
+	ErrInvalidGasPriceCapOverride = errors.New("invalid gas price cap override")
+	// ErrGasPriceCapOverrideNotFound 链没有 gas 价格上限覆盖
+	ErrGasPriceCapOverrideNotFound = errors.New("gas price cap override not found")
+)
+
+// Service 系统设置服务接口
+// 系统设置持久化在 system_settings 表，管理员在运行时修改，所有服务实例读取同一份设置
+type Service interface {
+	// ListMaintenances 查询所有维护模式设置，包括尚未开始和已结束的
+	ListMaintenances(ctx context.Context) ([]*Maintenance, error)
+
+	// GetActiveMaintenances 获取当前生效的维护模式
+	GetActiveMaintenances(ctx context.Context) (*ActiveMaintenances, error)
+
+	// GetMaintenance 获取链当前生效的维护模式，链级设置优先于全局设置，不在维护时返回 nil
+	GetMaintenance(ctx context.Context, chainID int) (*Maintenance, error)
+
+	// SetMaintenance 开启全局或链的维护模式，已有设置时覆盖
+	SetMaintenance(ctx context.Context, req *MaintenanceRequest, adminUserID string) (*Maintenance, error)
+
+	// ClearMaintenance 关闭全局（chainID 为空）或链的维护模式
+	ClearMaintenance(ctx context.Context, chainID *int) error
+
+	// ListGasPriceCapOverrides 查询所有链的 gas 价格上限覆盖，包括已过期的
+	ListGasPriceCapOverrides(ctx context.Context) ([]*GasPriceCapOverride, error)
+
+	// GetGasPriceCapOverride 获取链当前生效的 gas 价格上限覆盖，没有生效的覆盖时返回 nil
+	GetGasPriceCapOverride(ctx context.Context, chainID int) (*GasPriceCapOverride, error)
+
+	// SetGasPriceCapOverride 临时覆盖链的 gas 价格上限（紧急出款），已有覆盖时替换
+	SetGasPriceCapOverride(ctx context.Context, req *GasPriceCapOverrideRequest, adminUserID string) (*GasPriceCapOverride, error)
+
+	// ClearGasPriceCapOverride 删除链的 gas 价格上限覆盖，恢复使用配置的上限
+	ClearGasPriceCapOverride(ctx context.Context, chainID int) error
+}
+
+type service struct {
+	db *sql.DB
+}
+
+// NewService 创建系统设置服务
+func NewService(db *sql.DB) Service {
+	return &service{db: db}
+}
@@ -0,0 +1,53 @@
+package signer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github/chapool/go-wallet/internal/wallet/chain"
+)
+
+// SignBitcoinTransaction signs all inputs of a Bitcoin transaction
+func (s *service) SignBitcoinTransaction(ctx context.Context, req *SignBitcoinRequest) (*SignBitcoinResponse, error) {
+	// Check if signing is enabled
+	if !s.enableSigning {
+		return nil, errors.New("signing is disabled by configuration")
+	}
+
+	if req.Transaction == nil {
+		return nil, errors.New("transaction is required")
+	}
+
+	if len(req.DerivationPaths) != len(req.Transaction.Inputs) {
+		return nil, errors.Errorf("expected %d derivation paths, got %d", len(req.Transaction.Inputs), len(req.DerivationPaths))
+	}
+
+	// Get seed from memory
+	seed := s.seedManager.GetSeed()
+	if seed == nil {
+		return nil, errors.New("seed not initialized")
+	}
+
+	for i, derivationPath := range req.DerivationPaths {
+		privateKey, err := s.addressService.DerivePrivateKey(ctx, seed, derivationPath, chain.TypeBitcoin)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to derive private key for input %d", i)
+		}
+
+		err = req.Transaction.SignInput(i, privateKey)
+
+		// Clear private key after use
+		for j := range privateKey {
+			privateKey[j] = 0
+		}
+
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to sign input %d", i)
+		}
+	}
+
+	return &SignBitcoinResponse{
+		RawTransaction: req.Transaction.Serialize(),
+		TxID:           req.Transaction.TxID(),
+	}, nil
+}
@@ -11,8 +11,8 @@ import (
 	"github.com/pkg/errors"
 )
 
-// signEIP1559Transaction signs an EIP-1559 transaction
-func (s *service) signEIP1559Transaction(_ context.Context, req *SignEVMRequest, privateKey []byte) (*SignEVMResponse, error) {
+// signEVMTransaction signs an EIP-1559 transaction, or a legacy (EIP-155) transaction when GasPrice is set
+func (s *service) signEVMTransaction(_ context.Context, req *SignEVMRequest, privateKey []byte) (*SignEVMResponse, error) {
 	// Convert private key to ECDSA
 	ecdsaPrivateKey, err := crypto.ToECDSA(privateKey)
 	if err != nil {
@@ -42,32 +42,13 @@ func (s *service) signEIP1559Transaction(_ context.Context, req *SignEVMRequest,
 		return nil, errors.New("invalid value format")
 	}
 
-	// Parse max fee per gas
-	maxFeePerGas, ok := new(big.Int).SetString(req.MaxFeePerGas, base10)
-	if !ok {
-		return nil, errors.New("invalid maxFeePerGas format")
-	}
-
-	// Parse max priority fee per gas
-	maxPriorityFeePerGas, ok := new(big.Int).SetString(req.MaxPriorityFeePerGas, base10)
-	if !ok {
-		return nil, errors.New("invalid maxPriorityFeePerGas format")
-	}
-
-	// Create EIP-1559 transaction
 	//nolint:varnamelen // tx is a common abbreviation for transaction
-	tx := types.NewTx(&types.DynamicFeeTx{
-		ChainID:   big.NewInt(req.ChainID),
-		Nonce:     req.Nonce,
-		GasTipCap: maxPriorityFeePerGas,
-		GasFeeCap: maxFeePerGas,
-		Gas:       req.GasLimit,
-		To:        &toAddress,
-		Value:     value,
-		Data:      req.Data,
-	})
+	tx, err := buildEVMTransaction(req, &toAddress, value)
+	if err != nil {
+		return nil, err
+	}
 
-	// Sign transaction
+	// Sign transaction (the London signer handles both legacy and dynamic fee transactions)
 	signer := types.NewLondonSigner(big.NewInt(req.ChainID))
 	signedTx, err := types.SignTx(tx, signer, ecdsaPrivateKey)
 	if err != nil {
@@ -88,3 +69,49 @@ func (s *service) signEIP1559Transaction(_ context.Context, req *SignEVMRequest,
 		TxHash:         txHash.Hex(),
 	}, nil
 }
+
+// buildEVMTransaction creates the unsigned transaction for the request
+func buildEVMTransaction(req *SignEVMRequest, to *common.Address, value *big.Int) (*types.Transaction, error) {
+	const base10 = 10
+
+	// Legacy transaction for chains without EIP-1559 support
+	if req.GasPrice != "" {
+		gasPrice, ok := new(big.Int).SetString(req.GasPrice, base10)
+		if !ok {
+			return nil, errors.New("invalid gasPrice format")
+		}
+
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    req.Nonce,
+			GasPrice: gasPrice,
+			Gas:      req.GasLimit,
+			To:       to,
+			Value:    value,
+			Data:     req.Data,
+		}), nil
+	}
+
+	// Parse max fee per gas
+	maxFeePerGas, ok := new(big.Int).SetString(req.MaxFeePerGas, base10)
+	if !ok {
+		return nil, errors.New("invalid maxFeePerGas format")
+	}
+
+	// Parse max priority fee per gas
+	maxPriorityFeePerGas, ok := new(big.Int).SetString(req.MaxPriorityFeePerGas, base10)
+	if !ok {
+		return nil, errors.New("invalid maxPriorityFeePerGas format")
+	}
+
+	// Create EIP-1559 transaction
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(req.ChainID),
+		Nonce:     req.Nonce,
+		GasTipCap: maxPriorityFeePerGas,
+		GasFeeCap: maxFeePerGas,
+		Gas:       req.GasLimit,
+		To:        to,
+		Value:     value,
+		Data:      req.Data,
+	}), nil
+}
@@ -0,0 +1,61 @@
+package signer
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github/chapool/go-wallet/internal/wallet/testvectors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignEVMTransactionGolden(t *testing.T) {
+	t.Parallel()
+
+	for _, vector := range testvectors.EVMSigningVectors {
+		t.Run(vector.Name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := (&service{}).signEVMTransaction(context.Background(), signingRequest(vector), common.FromHex(vector.PrivateKey))
+			require.NoError(t, err)
+			assert.Equal(t, vector.RawTransaction, hex.EncodeToString(resp.RawTransaction))
+			assert.Equal(t, vector.TxHash, resp.TxHash)
+
+			var tx types.Transaction
+			require.NoError(t, tx.UnmarshalBinary(resp.RawTransaction))
+			sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), &tx)
+			require.NoError(t, err)
+			assert.Equal(t, vector.From, sender.Hex())
+		})
+	}
+}
+
+func TestSignEVMTransactionRejectsMismatchedFromAddress(t *testing.T) {
+	t.Parallel()
+
+	vector := testvectors.EVMSigningVectors[0]
+	req := signingRequest(vector)
+	req.FromAddress = testvectors.EVMDerivationVectors[0].Address
+
+	_, err := (&service{}).signEVMTransaction(context.Background(), req, common.FromHex(vector.PrivateKey))
+	require.Error(t, err)
+}
+
+func signingRequest(vector testvectors.EVMSigningVector) *SignEVMRequest {
+	return &SignEVMRequest{
+		ChainID:              vector.ChainID,
+		To:                   vector.To,
+		Value:                vector.Value,
+		GasLimit:             vector.GasLimit,
+		MaxFeePerGas:         vector.MaxFeePerGas,
+		MaxPriorityFeePerGas: vector.MaxPriorityFeePerGas,
+		GasPrice:             vector.GasPrice,
+		Nonce:                vector.Nonce,
+		Data:                 common.FromHex(vector.Data),
+		FromAddress:          vector.From,
+	}
+}
@@ -0,0 +1,113 @@
+package signer
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// permitTypeHash is keccak256("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)")
+//
+//nolint:gochecknoglobals // Constant hash computed once
+var permitTypeHash = crypto.Keccak256([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+
+// SignEVMPermit signs an EIP-2612 permit with the key of the owner address
+func (s *service) SignEVMPermit(ctx context.Context, req *SignEVMPermitRequest) (*SignEVMPermitResponse, error) {
+	// Check if signing is enabled
+	if !s.enableSigning {
+		return nil, errors.New("signing is disabled by configuration")
+	}
+
+	// Get seed from memory
+	seed := s.seedManager.GetSeed()
+	if seed == nil {
+		return nil, errors.New("seed not initialized")
+	}
+
+	// Derive private key from seed and derivation path
+	privateKey, err := s.addressService.DerivePrivateKey(ctx, seed, req.DerivationPath, "evm")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive private key")
+	}
+
+	// Clear private key after use
+	defer func() {
+		for i := range privateKey {
+			privateKey[i] = 0
+		}
+	}()
+
+	return signEVMPermit(req, privateKey)
+}
+
+// signEVMPermit signs the EIP-712 digest of the permit
+func signEVMPermit(req *SignEVMPermitRequest, privateKey []byte) (*SignEVMPermitResponse, error) {
+	ecdsaPrivateKey, err := crypto.ToECDSA(privateKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert private key to ECDSA")
+	}
+
+	// Verify owner address matches private key
+	if crypto.PubkeyToAddress(ecdsaPrivateKey.PublicKey) != common.HexToAddress(req.Owner) {
+		return nil, errors.New("owner address does not match private key")
+	}
+
+	digest, err := PermitDigest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := crypto.Sign(digest, ecdsaPrivateKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign permit")
+	}
+
+	resp := &SignEVMPermitResponse{
+		V: signature[ecdsaRecoveryIDPos] + legacyRecoveryIDMin,
+	}
+	copy(resp.R[:], signature[:abiWordLength])
+	copy(resp.S[:], signature[abiWordLength:ecdsaRecoveryIDPos])
+
+	return resp, nil
+}
+
+// PermitDigest returns the EIP-712 digest of the permit:
+// keccak256(0x1901 || DOMAIN_SEPARATOR || keccak256(abi.encode(PERMIT_TYPEHASH, owner, spender, value, nonce, deadline)))
+func PermitDigest(req *SignEVMPermitRequest) ([]byte, error) {
+	if len(req.DomainSeparator) != abiWordLength {
+		return nil, errors.New("domain separator must be 32 bytes")
+	}
+	if !common.IsHexAddress(req.Owner) || !common.IsHexAddress(req.Spender) {
+		return nil, errors.New("invalid owner or spender address")
+	}
+
+	const (
+		base10      = 10
+		uint256Bits = 256
+	)
+	value, ok := new(big.Int).SetString(req.Value, base10)
+	if !ok || value.Sign() < 0 || value.BitLen() > uint256Bits {
+		return nil, errors.New("invalid value format")
+	}
+	nonce, ok := new(big.Int).SetString(req.Nonce, base10)
+	if !ok || nonce.Sign() < 0 || nonce.BitLen() > uint256Bits {
+		return nil, errors.New("invalid nonce format")
+	}
+	if req.Deadline <= 0 {
+		return nil, errors.New("deadline is required")
+	}
+
+	structHash := crypto.Keccak256(
+		permitTypeHash,
+		common.LeftPadBytes(common.HexToAddress(req.Owner).Bytes(), abiWordLength),
+		common.LeftPadBytes(common.HexToAddress(req.Spender).Bytes(), abiWordLength),
+		common.LeftPadBytes(value.Bytes(), abiWordLength),
+		common.LeftPadBytes(nonce.Bytes(), abiWordLength),
+		common.LeftPadBytes(big.NewInt(req.Deadline).Bytes(), abiWordLength),
+	)
+
+	return crypto.Keccak256([]byte{0x19, 0x01}, req.DomainSeparator, structHash), nil
+}
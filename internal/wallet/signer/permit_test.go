@@ -0,0 +1,69 @@
+package signer
+
+import (
+	"testing"
+
+	"github/chapool/go-wallet/internal/wallet/testvectors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermitTypeHash(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "0x6e71edae12b1b97f4d1f60370fef10105fa2faae0126114a169c64845d6126c9", common.BytesToHash(permitTypeHash).Hex())
+}
+
+func TestSignEVMPermitRecoversOwner(t *testing.T) {
+	t.Parallel()
+
+	vector := testvectors.EVMSigningVectors[0]
+	req := permitRequest(vector.From)
+
+	resp, err := signEVMPermit(req, common.FromHex(vector.PrivateKey))
+	require.NoError(t, err)
+	assert.Contains(t, []uint8{27, 28}, resp.V)
+
+	digest, err := PermitDigest(req)
+	require.NoError(t, err)
+
+	signature := append(append(resp.R[:], resp.S[:]...), resp.V-27)
+	pub, err := crypto.SigToPub(digest, signature)
+	require.NoError(t, err)
+	assert.Equal(t, vector.From, crypto.PubkeyToAddress(*pub).Hex())
+}
+
+func TestSignEVMPermitRejectsInvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	vector := testvectors.EVMSigningVectors[0]
+
+	_, err := signEVMPermit(permitRequest(testvectors.EVMDerivationVectors[0].Address), common.FromHex(vector.PrivateKey))
+	require.Error(t, err)
+
+	req := permitRequest(vector.From)
+	req.DomainSeparator = req.DomainSeparator[:31]
+	_, err = signEVMPermit(req, common.FromHex(vector.PrivateKey))
+	require.Error(t, err)
+
+	req = permitRequest(vector.From)
+	req.Deadline = 0
+	_, err = signEVMPermit(req, common.FromHex(vector.PrivateKey))
+	require.Error(t, err)
+}
+
+func permitRequest(owner string) *SignEVMPermitRequest {
+	return &SignEVMPermitRequest{
+		ChainID:         56,
+		Token:           "0x55d398326f99059fF775485246999027B3197955",
+		DomainSeparator: crypto.Keccak256([]byte("domain")),
+		Owner:           owner,
+		Spender:         "0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+		Value:           "1000000000000000000",
+		Nonce:           "3",
+		Deadline:        1767225600,
+	}
+}
@@ -0,0 +1,149 @@
+package remote
+
+import (
+	"context"
+	"crypto/tls"
+	"time"
+
+	"github/chapool/go-wallet/internal/wallet/address"
+	"github/chapool/go-wallet/internal/wallet/signer"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// ErrUnsupported is returned for transactions the remote signer does not sign (Solana and Bitcoin)
+var ErrUnsupported = errors.New("transaction type is not supported in remote signer mode")
+
+// Client signs EVM transactions and permits and derives addresses through the signer process
+type Client struct {
+	conn    *grpc.ClientConn
+	timeout time.Duration
+}
+
+// NewClient creates a signer.Service and address.Deriver using the signer process at addr.
+// Solana and Bitcoin transactions are not signed in remote mode, the API server never loads the seed.
+// The connection is established lazily on the first request.
+func NewClient(addr string, tlsConfig *tls.Config, timeout time.Duration) (*Client, error) {
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(jsonCodec{})),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create remote signer client for %s", addr)
+	}
+
+	return &Client{
+		conn:    conn,
+		timeout: timeout,
+	}, nil
+}
+
+// Close closes the connection to the signer process
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// SignEVMTransaction signs an EVM transaction (EIP-1559) in the signer process
+func (c *Client) SignEVMTransaction(ctx context.Context, req *signer.SignEVMRequest) (*signer.SignEVMResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp := new(signEVMResponse)
+	if err := c.conn.Invoke(ctx, methodSignEVMTransaction, toSignEVMRequest(req), resp); err != nil {
+		if s, ok := status.FromError(err); ok {
+			return nil, errors.Errorf("remote signer: %s", s.Message())
+		}
+		return nil, errors.Wrap(err, "remote signer")
+	}
+
+	return &signer.SignEVMResponse{
+		RawTransaction: resp.RawTransaction,
+		TxHash:         resp.TxHash,
+	}, nil
+}
+
+// SignEVMPermit signs an EIP-2612 permit in the signer process
+func (c *Client) SignEVMPermit(ctx context.Context, req *signer.SignEVMPermitRequest) (*signer.SignEVMPermitResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp := new(signEVMPermitResponse)
+	if err := c.conn.Invoke(ctx, methodSignEVMPermit, toSignEVMPermitRequest(req), resp); err != nil {
+		if s, ok := status.FromError(err); ok {
+			return nil, errors.Errorf("remote signer: %s", s.Message())
+		}
+		return nil, errors.Wrap(err, "remote signer")
+	}
+
+	const signatureWordLength = 32
+	if len(resp.R) != signatureWordLength || len(resp.S) != signatureWordLength {
+		return nil, errors.New("remote signer: invalid permit signature")
+	}
+
+	permit := &signer.SignEVMPermitResponse{V: resp.V}
+	copy(permit.R[:], resp.R)
+	copy(permit.S[:], resp.S)
+
+	return permit, nil
+}
+
+// SignEVMUserOperation signs an ERC-4337 user operation in the signer process
+func (c *Client) SignEVMUserOperation(ctx context.Context, req *signer.SignEVMUserOperationRequest) (*signer.SignEVMUserOperationResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp := new(signEVMUserOperationResponse)
+	if err := c.conn.Invoke(ctx, methodSignEVMUserOp, toSignEVMUserOperationRequest(req), resp); err != nil {
+		if s, ok := status.FromError(err); ok {
+			return nil, errors.Errorf("remote signer: %s", s.Message())
+		}
+		return nil, errors.Wrap(err, "remote signer")
+	}
+
+	const signatureLength = 65
+	if len(resp.Signature) != signatureLength {
+		return nil, errors.New("remote signer: invalid user operation signature")
+	}
+
+	return &signer.SignEVMUserOperationResponse{
+		Signature:  resp.Signature,
+		UserOpHash: resp.UserOpHash,
+	}, nil
+}
+
+// DeriveAddress derives the address at path in the signer process
+func (c *Client) DeriveAddress(ctx context.Context, path string, chainType string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp := new(deriveAddressResponse)
+	req := &deriveAddressRequest{
+		ChainType:      chainType,
+		DerivationPath: path,
+	}
+	if err := c.conn.Invoke(ctx, methodDeriveAddress, req, resp); err != nil {
+		if s, ok := status.FromError(err); ok {
+			if s.Code() == codes.FailedPrecondition {
+				return "", errors.Wrap(address.ErrSeedLocked, "remote signer")
+			}
+			return "", errors.Errorf("remote signer: %s", s.Message())
+		}
+		return "", errors.Wrap(err, "remote signer")
+	}
+
+	return resp.Address, nil
+}
+
+// SignSolanaTransaction is not supported in remote signer mode
+func (c *Client) SignSolanaTransaction(_ context.Context, _ *signer.SignSolanaRequest) (*signer.SignSolanaResponse, error) {
+	return nil, ErrUnsupported
+}
+
+// SignBitcoinTransaction is not supported in remote signer mode
+func (c *Client) SignBitcoinTransaction(_ context.Context, _ *signer.SignBitcoinRequest) (*signer.SignBitcoinResponse, error) {
+	return nil, ErrUnsupported
+}
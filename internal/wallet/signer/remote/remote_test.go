@@ -0,0 +1,267 @@
+package remote_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github/chapool/go-wallet/internal/wallet/address"
+	"github/chapool/go-wallet/internal/wallet/signer"
+	"github/chapool/go-wallet/internal/wallet/signer/remote"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSigner struct {
+	signer.Service
+	req       *signer.SignEVMRequest
+	permitReq *signer.SignEVMPermitRequest
+	path      string
+	err       error
+}
+
+func (f *fakeSigner) SignEVMTransaction(_ context.Context, req *signer.SignEVMRequest) (*signer.SignEVMResponse, error) {
+	f.req = req
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return &signer.SignEVMResponse{RawTransaction: []byte{0x02, 0xf8}, TxHash: "0xabc"}, nil
+}
+
+func (f *fakeSigner) SignEVMPermit(_ context.Context, req *signer.SignEVMPermitRequest) (*signer.SignEVMPermitResponse, error) {
+	f.permitReq = req
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return &signer.SignEVMPermitResponse{V: 28, R: [32]byte{1}, S: [32]byte{2}}, nil
+}
+
+func (f *fakeSigner) DeriveAddress(_ context.Context, path string, _ string) (string, error) {
+	f.path = path
+	if f.err != nil {
+		return "", f.err
+	}
+
+	return "0x9858effd232b4033e47d90003d41ec34ecaeda94", nil
+}
+
+// testPKI writes a CA and certificates for the signer and the API server issued by it
+type testPKI struct {
+	dir    string
+	caCert *x509.Certificate
+	caKey  *ecdsa.PrivateKey
+	caFile string
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	pki := &testPKI{dir: t.TempDir(), caCert: cert, caKey: key}
+	pki.caFile = pki.writePEM(t, "ca.pem", "CERTIFICATE", der)
+
+	return pki
+}
+
+func (p *testPKI) writePEM(t *testing.T, name string, blockType string, der []byte) string {
+	t.Helper()
+
+	path := filepath.Join(p.dir, name)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+
+	return path
+}
+
+// issue returns the certificate and key file of a certificate valid for 127.0.0.1
+func (p *testPKI) issue(t *testing.T, name string, serial int64) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, p.caCert, &key.PublicKey, p.caKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return p.writePEM(t, name+".pem", "CERTIFICATE", der), p.writePEM(t, name+"-key.pem", "EC PRIVATE KEY", keyDER)
+}
+
+func startServer(t *testing.T, pki *testPKI, fake *fakeSigner) string {
+	t.Helper()
+
+	certFile, keyFile := pki.issue(t, "signer", 2)
+	tlsConfig, err := remote.ServerTLSConfig(certFile, keyFile, pki.caFile)
+	require.NoError(t, err)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := remote.NewServer(fake, fake, tlsConfig)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String()
+}
+
+func TestSignEVMTransaction(t *testing.T) {
+	pki := newTestPKI(t)
+	fake := &fakeSigner{}
+	addr := startServer(t, pki, fake)
+
+	certFile, keyFile := pki.issue(t, "api-server", 3)
+	tlsConfig, err := remote.ClientTLSConfig(certFile, keyFile, pki.caFile, "")
+	require.NoError(t, err)
+	client, err := remote.NewClient(addr, tlsConfig, 5*time.Second)
+	require.NoError(t, err)
+	defer client.Close()
+
+	req := &signer.SignEVMRequest{
+		ChainID:        56,
+		To:             "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb0",
+		Value:          "1000",
+		GasLimit:       21000,
+		GasPrice:       "3000000000",
+		Nonce:          7,
+		Data:           []byte{0xa9, 0x05},
+		FromAddress:    "0x9858EfFD232B4033E47d90003D41EC34EcaEda94",
+		DerivationPath: "m/44'/60'/0'/0/0",
+	}
+	resp, err := client.SignEVMTransaction(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, []byte{0x02, 0xf8}, resp.RawTransaction)
+	assert.Equal(t, "0xabc", resp.TxHash)
+	assert.Equal(t, req, fake.req)
+
+	fake.err = errors.New("seed is locked")
+	_, err = client.SignEVMTransaction(context.Background(), req)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "seed is locked")
+
+	_, err = client.SignSolanaTransaction(context.Background(), &signer.SignSolanaRequest{})
+	require.ErrorIs(t, err, remote.ErrUnsupported)
+	_, err = client.SignBitcoinTransaction(context.Background(), &signer.SignBitcoinRequest{})
+	require.ErrorIs(t, err, remote.ErrUnsupported)
+}
+
+func TestSignEVMPermit(t *testing.T) {
+	pki := newTestPKI(t)
+	fake := &fakeSigner{}
+	addr := startServer(t, pki, fake)
+
+	certFile, keyFile := pki.issue(t, "api-server", 3)
+	tlsConfig, err := remote.ClientTLSConfig(certFile, keyFile, pki.caFile, "")
+	require.NoError(t, err)
+	client, err := remote.NewClient(addr, tlsConfig, 5*time.Second)
+	require.NoError(t, err)
+	defer client.Close()
+
+	req := &signer.SignEVMPermitRequest{
+		ChainID:         56,
+		Token:           "0x55d398326f99059fF775485246999027B3197955",
+		DomainSeparator: []byte{0xde, 0xad},
+		Owner:           "0x9858EfFD232B4033E47d90003D41EC34EcaEda94",
+		Spender:         "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb0",
+		Value:           "1000",
+		Nonce:           "0",
+		Deadline:        1767225600,
+		DerivationPath:  "m/44'/60'/0'/0/0",
+	}
+	resp, err := client.SignEVMPermit(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, &signer.SignEVMPermitResponse{V: 28, R: [32]byte{1}, S: [32]byte{2}}, resp)
+	assert.Equal(t, req, fake.permitReq)
+
+	fake.err = errors.New("owner address does not match private key")
+	_, err = client.SignEVMPermit(context.Background(), req)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "owner address does not match private key")
+}
+
+func TestDeriveAddress(t *testing.T) {
+	pki := newTestPKI(t)
+	fake := &fakeSigner{}
+	addr := startServer(t, pki, fake)
+
+	certFile, keyFile := pki.issue(t, "api-server", 3)
+	tlsConfig, err := remote.ClientTLSConfig(certFile, keyFile, pki.caFile, "")
+	require.NoError(t, err)
+	client, err := remote.NewClient(addr, tlsConfig, 5*time.Second)
+	require.NoError(t, err)
+	defer client.Close()
+
+	derived, err := client.DeriveAddress(context.Background(), "m/44'/60'/0'/0/0", "evm")
+	require.NoError(t, err)
+	assert.Equal(t, "0x9858effd232b4033e47d90003d41ec34ecaeda94", derived)
+	assert.Equal(t, "m/44'/60'/0'/0/0", fake.path)
+
+	fake.err = errors.New("invalid derivation path")
+	_, err = client.DeriveAddress(context.Background(), "m/44'/60'/x", "evm")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid derivation path")
+	assert.NotErrorIs(t, err, address.ErrSeedLocked)
+
+	// A locked seed in the signer process is reported with the same error as a locked local seed
+	fake.err = address.ErrSeedLocked
+	_, err = client.DeriveAddress(context.Background(), "m/44'/60'/0'/0/1", "evm")
+	require.ErrorIs(t, err, address.ErrSeedLocked)
+}
+
+func TestRejectsClientWithoutCertificate(t *testing.T) {
+	pki := newTestPKI(t)
+	fake := &fakeSigner{}
+	addr := startServer(t, pki, fake)
+
+	caPEM, err := os.ReadFile(pki.caFile)
+	require.NoError(t, err)
+	pool := x509.NewCertPool()
+	require.True(t, pool.AppendCertsFromPEM(caPEM))
+
+	client, err := remote.NewClient(addr, &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS13}, 5*time.Second)
+	require.NoError(t, err)
+	defer client.Close()
+
+	_, err = client.SignEVMTransaction(context.Background(), &signer.SignEVMRequest{ChainID: 56})
+	require.Error(t, err)
+	assert.Nil(t, fake.req)
+}
@@ -0,0 +1,195 @@
+package remote
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"time"
+
+	"github/chapool/go-wallet/internal/wallet/address"
+	"github/chapool/go-wallet/internal/wallet/signer"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Server serves signing and address derivation requests of the API server over gRPC with mutual TLS.
+// Every request is written to the audit log with the client certificate subject, the transaction and the result.
+type Server struct {
+	signer     signer.Service
+	deriver    address.Deriver
+	grpcServer *grpc.Server
+}
+
+// NewServer creates the signer gRPC server, tlsConfig must require client certificates (see ServerTLSConfig)
+func NewServer(signerService signer.Service, deriver address.Deriver, tlsConfig *tls.Config) *Server {
+	s := &Server{
+		signer:  signerService,
+		deriver: deriver,
+	}
+	s.grpcServer = grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.ForceServerCodec(jsonCodec{}),
+	)
+	s.grpcServer.RegisterService(&serviceDesc, s)
+
+	return s
+}
+
+// Serve accepts connections on lis until Stop is called
+func (s *Server) Serve(lis net.Listener) error {
+	return s.grpcServer.Serve(lis)
+}
+
+// Stop stops accepting connections and waits for running requests to finish
+func (s *Server) Stop() {
+	s.grpcServer.GracefulStop()
+}
+
+func (s *Server) signEVMTransaction(ctx context.Context, req *signEVMRequest) (*signEVMResponse, error) {
+	start := time.Now()
+
+	resp, err := s.signer.SignEVMTransaction(ctx, req.toSigner())
+
+	event := log.Info()
+	if err != nil {
+		event = log.Warn().Err(err)
+	}
+	event = event.
+		Str("component", "signer_audit").
+		Str("client", clientSubject(ctx)).
+		Int64("chain_id", req.ChainID).
+		Str("from_address", req.FromAddress).
+		Str("to_address", req.To).
+		Str("value", req.Value).
+		Uint64("nonce", req.Nonce).
+		Str("derivation_path", req.DerivationPath).
+		Dur("duration", time.Since(start))
+	if err != nil {
+		event.Msg("Rejected EVM transaction signing request")
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	event.Str("tx_hash", resp.TxHash).Msg("Signed EVM transaction")
+
+	return &signEVMResponse{
+		RawTransaction: resp.RawTransaction,
+		TxHash:         resp.TxHash,
+	}, nil
+}
+
+func (s *Server) signEVMPermit(ctx context.Context, req *signEVMPermitRequest) (*signEVMPermitResponse, error) {
+	start := time.Now()
+
+	resp, err := s.signer.SignEVMPermit(ctx, req.toSigner())
+
+	event := log.Info()
+	if err != nil {
+		event = log.Warn().Err(err)
+	}
+	event = event.
+		Str("component", "signer_audit").
+		Str("client", clientSubject(ctx)).
+		Int64("chain_id", req.ChainID).
+		Str("token_address", req.Token).
+		Str("owner", req.Owner).
+		Str("spender", req.Spender).
+		Str("value", req.Value).
+		Str("nonce", req.Nonce).
+		Int64("deadline", req.Deadline).
+		Str("derivation_path", req.DerivationPath).
+		Dur("duration", time.Since(start))
+	if err != nil {
+		event.Msg("Rejected EVM permit signing request")
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	event.Msg("Signed EVM permit")
+
+	return &signEVMPermitResponse{
+		V: resp.V,
+		R: resp.R[:],
+		S: resp.S[:],
+	}, nil
+}
+
+func (s *Server) signEVMUserOperation(ctx context.Context, req *signEVMUserOperationRequest) (*signEVMUserOperationResponse, error) {
+	start := time.Now()
+
+	resp, err := s.signer.SignEVMUserOperation(ctx, req.toSigner())
+
+	event := log.Info()
+	if err != nil {
+		event = log.Warn().Err(err)
+	}
+	event = event.
+		Str("component", "signer_audit").
+		Str("client", clientSubject(ctx)).
+		Int64("chain_id", req.ChainID).
+		Str("entry_point", req.EntryPoint).
+		Str("owner", req.Owner).
+		Str("derivation_path", req.DerivationPath).
+		Dur("duration", time.Since(start))
+	if req.UserOperation != nil {
+		event = event.
+			Str("sender", req.UserOperation.Sender).
+			Str("nonce", req.UserOperation.Nonce).
+			Bool("deploys_account", len(req.UserOperation.InitCode) > 0)
+	}
+	if err != nil {
+		event.Msg("Rejected EVM user operation signing request")
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	event.Str("user_op_hash", resp.UserOpHash).Msg("Signed EVM user operation")
+
+	return &signEVMUserOperationResponse{
+		Signature:  resp.Signature,
+		UserOpHash: resp.UserOpHash,
+	}, nil
+}
+
+func (s *Server) deriveAddress(ctx context.Context, req *deriveAddressRequest) (*deriveAddressResponse, error) {
+	start := time.Now()
+
+	addr, err := s.deriver.DeriveAddress(ctx, req.DerivationPath, req.ChainType)
+
+	event := log.Info()
+	if err != nil {
+		event = log.Warn().Err(err)
+	}
+	event = event.
+		Str("component", "signer_audit").
+		Str("client", clientSubject(ctx)).
+		Str("chain_type", req.ChainType).
+		Str("derivation_path", req.DerivationPath).
+		Dur("duration", time.Since(start))
+	if err != nil {
+		event.Msg("Rejected address derivation request")
+		// The client reports a locked seed as address.ErrSeedLocked
+		if errors.Is(err, address.ErrSeedLocked) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	event.Str("address", addr).Msg("Derived address")
+
+	return &deriveAddressResponse{Address: addr}, nil
+}
+
+// clientSubject returns the subject of the verified client certificate
+func clientSubject(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return p.Addr.String()
+	}
+
+	return tlsInfo.State.VerifiedChains[0][0].Subject.String()
+}
@@ -0,0 +1,371 @@
+// Package remote runs the signer as a standalone process and signs EVM transactions, permits and ERC-4337 user operations
+// and derives wallet addresses through it over gRPC,
+// so the seed does not have to be loaded by the API server.
+//
+// The service is described by hand instead of generated from a .proto file, messages are encoded as JSON.
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github/chapool/go-wallet/internal/wallet/signer"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc"
+)
+
+const (
+	serviceName = "wallet.signer.v1.Signer"
+
+	methodSignEVMTransaction = "/" + serviceName + "/SignEVMTransaction"
+	methodSignEVMPermit      = "/" + serviceName + "/SignEVMPermit"
+	methodSignEVMUserOp      = "/" + serviceName + "/SignEVMUserOperation"
+	methodDeriveAddress      = "/" + serviceName + "/DeriveAddress"
+)
+
+// jsonCodec encodes gRPC messages as JSON
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return "json"
+}
+
+// signEVMRequest is the wire format of signer.SignEVMRequest
+type signEVMRequest struct {
+	ChainID              int64  `json:"chain_id"`
+	To                   string `json:"to"`
+	Value                string `json:"value"`
+	GasLimit             uint64 `json:"gas_limit"`
+	MaxFeePerGas         string `json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas string `json:"max_priority_fee_per_gas,omitempty"`
+	GasPrice             string `json:"gas_price,omitempty"`
+	Nonce                uint64 `json:"nonce"`
+	Data                 []byte `json:"data,omitempty"`
+	FromAddress          string `json:"from_address"`
+	DerivationPath       string `json:"derivation_path"`
+}
+
+// signEVMResponse is the wire format of signer.SignEVMResponse
+type signEVMResponse struct {
+	RawTransaction []byte `json:"raw_transaction"`
+	TxHash         string `json:"tx_hash"`
+}
+
+func toSignEVMRequest(req *signer.SignEVMRequest) *signEVMRequest {
+	return &signEVMRequest{
+		ChainID:              req.ChainID,
+		To:                   req.To,
+		Value:                req.Value,
+		GasLimit:             req.GasLimit,
+		MaxFeePerGas:         req.MaxFeePerGas,
+		MaxPriorityFeePerGas: req.MaxPriorityFeePerGas,
+		GasPrice:             req.GasPrice,
+		Nonce:                req.Nonce,
+		Data:                 req.Data,
+		FromAddress:          req.FromAddress,
+		DerivationPath:       req.DerivationPath,
+	}
+}
+
+func (r *signEVMRequest) toSigner() *signer.SignEVMRequest {
+	return &signer.SignEVMRequest{
+		ChainID:              r.ChainID,
+		To:                   r.To,
+		Value:                r.Value,
+		GasLimit:             r.GasLimit,
+		MaxFeePerGas:         r.MaxFeePerGas,
+		MaxPriorityFeePerGas: r.MaxPriorityFeePerGas,
+		GasPrice:             r.GasPrice,
+		Nonce:                r.Nonce,
+		Data:                 r.Data,
+		FromAddress:          r.FromAddress,
+		DerivationPath:       r.DerivationPath,
+	}
+}
+
+// signEVMPermitRequest is the wire format of signer.SignEVMPermitRequest
+type signEVMPermitRequest struct {
+	ChainID         int64  `json:"chain_id"`
+	Token           string `json:"token"`
+	DomainSeparator []byte `json:"domain_separator"`
+	Owner           string `json:"owner"`
+	Spender         string `json:"spender"`
+	Value           string `json:"value"`
+	Nonce           string `json:"nonce"`
+	Deadline        int64  `json:"deadline"`
+	DerivationPath  string `json:"derivation_path"`
+}
+
+// signEVMPermitResponse is the wire format of signer.SignEVMPermitResponse
+type signEVMPermitResponse struct {
+	V uint8  `json:"v"`
+	R []byte `json:"r"`
+	S []byte `json:"s"`
+}
+
+func toSignEVMPermitRequest(req *signer.SignEVMPermitRequest) *signEVMPermitRequest {
+	return &signEVMPermitRequest{
+		ChainID:         req.ChainID,
+		Token:           req.Token,
+		DomainSeparator: req.DomainSeparator,
+		Owner:           req.Owner,
+		Spender:         req.Spender,
+		Value:           req.Value,
+		Nonce:           req.Nonce,
+		Deadline:        req.Deadline,
+		DerivationPath:  req.DerivationPath,
+	}
+}
+
+func (r *signEVMPermitRequest) toSigner() *signer.SignEVMPermitRequest {
+	return &signer.SignEVMPermitRequest{
+		ChainID:         r.ChainID,
+		Token:           r.Token,
+		DomainSeparator: r.DomainSeparator,
+		Owner:           r.Owner,
+		Spender:         r.Spender,
+		Value:           r.Value,
+		Nonce:           r.Nonce,
+		Deadline:        r.Deadline,
+		DerivationPath:  r.DerivationPath,
+	}
+}
+
+// userOperation is the wire format of signer.UserOperation, numbers are decimal strings
+type userOperation struct {
+	Sender               string `json:"sender"`
+	Nonce                string `json:"nonce"`
+	InitCode             []byte `json:"init_code,omitempty"`
+	CallData             []byte `json:"call_data,omitempty"`
+	CallGasLimit         string `json:"call_gas_limit"`
+	VerificationGasLimit string `json:"verification_gas_limit"`
+	PreVerificationGas   string `json:"pre_verification_gas"`
+	MaxFeePerGas         string `json:"max_fee_per_gas"`
+	MaxPriorityFeePerGas string `json:"max_priority_fee_per_gas"`
+	PaymasterAndData     []byte `json:"paymaster_and_data,omitempty"`
+}
+
+// signEVMUserOperationRequest is the wire format of signer.SignEVMUserOperationRequest
+type signEVMUserOperationRequest struct {
+	ChainID        int64          `json:"chain_id"`
+	EntryPoint     string         `json:"entry_point"`
+	Owner          string         `json:"owner"`
+	UserOperation  *userOperation `json:"user_operation"`
+	DerivationPath string         `json:"derivation_path"`
+}
+
+// signEVMUserOperationResponse is the wire format of signer.SignEVMUserOperationResponse
+type signEVMUserOperationResponse struct {
+	Signature  []byte `json:"signature"`
+	UserOpHash string `json:"user_op_hash"`
+}
+
+func toSignEVMUserOperationRequest(req *signer.SignEVMUserOperationRequest) *signEVMUserOperationRequest {
+	wire := &signEVMUserOperationRequest{
+		ChainID:        req.ChainID,
+		EntryPoint:     req.EntryPoint,
+		Owner:          req.Owner,
+		DerivationPath: req.DerivationPath,
+	}
+	if op := req.UserOperation; op != nil {
+		wire.UserOperation = &userOperation{
+			Sender:               op.Sender.Hex(),
+			Nonce:                op.Nonce.String(),
+			InitCode:             op.InitCode,
+			CallData:             op.CallData,
+			CallGasLimit:         op.CallGasLimit.String(),
+			VerificationGasLimit: op.VerificationGasLimit.String(),
+			PreVerificationGas:   op.PreVerificationGas.String(),
+			MaxFeePerGas:         op.MaxFeePerGas.String(),
+			MaxPriorityFeePerGas: op.MaxPriorityFeePerGas.String(),
+			PaymasterAndData:     op.PaymasterAndData,
+		}
+	}
+
+	return wire
+}
+
+// toSigner converts the wire format, malformed numbers are left nil and rejected by the signer
+func (r *signEVMUserOperationRequest) toSigner() *signer.SignEVMUserOperationRequest {
+	req := &signer.SignEVMUserOperationRequest{
+		ChainID:        r.ChainID,
+		EntryPoint:     r.EntryPoint,
+		Owner:          r.Owner,
+		DerivationPath: r.DerivationPath,
+	}
+	if op := r.UserOperation; op != nil {
+		req.UserOperation = &signer.UserOperation{
+			Sender:               common.HexToAddress(op.Sender),
+			Nonce:                parseUint(op.Nonce),
+			InitCode:             op.InitCode,
+			CallData:             op.CallData,
+			CallGasLimit:         parseUint(op.CallGasLimit),
+			VerificationGasLimit: parseUint(op.VerificationGasLimit),
+			PreVerificationGas:   parseUint(op.PreVerificationGas),
+			MaxFeePerGas:         parseUint(op.MaxFeePerGas),
+			MaxPriorityFeePerGas: parseUint(op.MaxPriorityFeePerGas),
+			PaymasterAndData:     op.PaymasterAndData,
+		}
+	}
+
+	return req
+}
+
+// deriveAddressRequest is the wire format of an address derivation request
+type deriveAddressRequest struct {
+	ChainType      string `json:"chain_type"`
+	DerivationPath string `json:"derivation_path"`
+}
+
+// deriveAddressResponse is the wire format of a derived address
+type deriveAddressResponse struct {
+	Address string `json:"address"`
+}
+
+// parseUint parses a decimal string, nil if it is not a valid number
+func parseUint(s string) *big.Int {
+	const base10 = 10
+	v, ok := new(big.Int).SetString(s, base10)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+// signerServer is implemented by Server, used as HandlerType of the service description
+type signerServer interface {
+	signEVMTransaction(ctx context.Context, req *signEVMRequest) (*signEVMResponse, error)
+	signEVMPermit(ctx context.Context, req *signEVMPermitRequest) (*signEVMPermitResponse, error)
+	signEVMUserOperation(ctx context.Context, req *signEVMUserOperationRequest) (*signEVMUserOperationResponse, error)
+	deriveAddress(ctx context.Context, req *deriveAddressRequest) (*deriveAddressResponse, error)
+}
+
+// serviceDesc describes the signer gRPC service
+//
+//nolint:gochecknoglobals // Service descriptions are registered once, same as generated gRPC code
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: serviceName,
+	HandlerType: (*signerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignEVMTransaction",
+			Handler:    signEVMTransactionHandler,
+		},
+		{
+			MethodName: "SignEVMPermit",
+			Handler:    signEVMPermitHandler,
+		},
+		{
+			MethodName: "SignEVMUserOperation",
+			Handler:    signEVMUserOperationHandler,
+		},
+		{
+			MethodName: "DeriveAddress",
+			Handler:    deriveAddressHandler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+func signEVMTransactionHandler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(signEVMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	server, _ := srv.(signerServer)
+	if interceptor == nil {
+		return server.signEVMTransaction(ctx, in)
+	}
+
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: methodSignEVMTransaction,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		r, _ := req.(*signEVMRequest)
+		return server.signEVMTransaction(ctx, r)
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
+
+func signEVMPermitHandler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(signEVMPermitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	server, _ := srv.(signerServer)
+	if interceptor == nil {
+		return server.signEVMPermit(ctx, in)
+	}
+
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: methodSignEVMPermit,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		r, _ := req.(*signEVMPermitRequest)
+		return server.signEVMPermit(ctx, r)
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
+
+func signEVMUserOperationHandler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(signEVMUserOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	server, _ := srv.(signerServer)
+	if interceptor == nil {
+		return server.signEVMUserOperation(ctx, in)
+	}
+
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: methodSignEVMUserOp,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		r, _ := req.(*signEVMUserOperationRequest)
+		return server.signEVMUserOperation(ctx, r)
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
+
+func deriveAddressHandler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(deriveAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	server, _ := srv.(signerServer)
+	if interceptor == nil {
+		return server.deriveAddress(ctx, in)
+	}
+
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: methodDeriveAddress,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		r, _ := req.(*deriveAddressRequest)
+		return server.deriveAddress(ctx, r)
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
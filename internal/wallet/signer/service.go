@@ -52,5 +52,5 @@ func (s *service) SignEVMTransaction(ctx context.Context, req *SignEVMRequest) (
 	}()
 
 	// Sign transaction
-	return s.signEIP1559Transaction(ctx, req, privateKey)
+	return s.signEVMTransaction(ctx, req, privateKey)
 }
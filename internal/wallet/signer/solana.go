@@ -0,0 +1,55 @@
+package signer
+
+import (
+	"context"
+	"crypto/ed25519"
+
+	"github.com/pkg/errors"
+	"github/chapool/go-wallet/internal/wallet/chain"
+)
+
+// SignSolanaTransaction signs a Solana transaction
+func (s *service) SignSolanaTransaction(ctx context.Context, req *SignSolanaRequest) (*SignSolanaResponse, error) {
+	// Check if signing is enabled
+	if !s.enableSigning {
+		return nil, errors.New("signing is disabled by configuration")
+	}
+
+	if req.Transaction == nil {
+		return nil, errors.New("transaction is required")
+	}
+
+	// Get seed from memory
+	seed := s.seedManager.GetSeed()
+	if seed == nil {
+		return nil, errors.New("seed not initialized")
+	}
+
+	// Derive ed25519 key from seed and derivation path
+	privateKey, err := s.addressService.DerivePrivateKey(ctx, seed, req.DerivationPath, chain.TypeSolana)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive private key")
+	}
+
+	keyPair := ed25519.NewKeyFromSeed(privateKey)
+
+	// Clear private keys after use
+	defer func() {
+		for i := range privateKey {
+			privateKey[i] = 0
+		}
+		for i := range keyPair {
+			keyPair[i] = 0
+		}
+	}()
+
+	rawTransaction, signature, err := req.Transaction.Sign(keyPair)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign transaction")
+	}
+
+	return &SignSolanaResponse{
+		RawTransaction: rawTransaction,
+		Signature:      signature,
+	}, nil
+}
@@ -1,11 +1,33 @@
 package signer
 
-import "context"
+import (
+	"context"
+	"math/big"
+
+	"github/chapool/go-wallet/internal/wallet/bitcoin"
+	"github/chapool/go-wallet/internal/wallet/solana"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
 
 // Service provides transaction signing functionality
 type Service interface {
 	// SignEVMTransaction signs an EVM transaction (EIP-1559)
 	SignEVMTransaction(ctx context.Context, req *SignEVMRequest) (*SignEVMResponse, error)
+
+	// SignSolanaTransaction signs a Solana transaction
+	SignSolanaTransaction(ctx context.Context, req *SignSolanaRequest) (*SignSolanaResponse, error)
+
+	// SignBitcoinTransaction signs all inputs of a Bitcoin transaction
+	SignBitcoinTransaction(ctx context.Context, req *SignBitcoinRequest) (*SignBitcoinResponse, error)
+
+	// SignEVMPermit signs an EIP-2612 permit allowing spender to transfer ERC20 tokens of the owner
+	SignEVMPermit(ctx context.Context, req *SignEVMPermitRequest) (*SignEVMPermitResponse, error)
+
+	// SignEVMUserOperation signs an ERC-4337 user operation of a smart account with the key of its owner
+	SignEVMUserOperation(ctx context.Context, req *SignEVMUserOperationRequest) (*SignEVMUserOperationResponse, error)
 }
 
 // SignEVMRequest represents a request to sign an EVM transaction
@@ -16,6 +38,7 @@ type SignEVMRequest struct {
 	GasLimit             uint64 // Gas limit
 	MaxFeePerGas         string // Max fee per gas (EIP-1559, in wei, as string)
 	MaxPriorityFeePerGas string // Max priority fee per gas (EIP-1559, in wei, as string)
+	GasPrice             string // Gas price (legacy transaction, in wei, as string); when set, MaxFeePerGas and MaxPriorityFeePerGas are ignored
 	Nonce                uint64 // Transaction nonce
 	Data                 []byte // Transaction data (for contract calls)
 	FromAddress          string // Address to sign from (hex string with 0x prefix)
@@ -27,3 +50,97 @@ type SignEVMResponse struct {
 	RawTransaction []byte // RLP-encoded signed transaction
 	TxHash         string // Transaction hash (hex string with 0x prefix)
 }
+
+// SignEVMPermitRequest represents a request to sign an EIP-2612 permit
+type SignEVMPermitRequest struct {
+	ChainID         int64  // Chain ID of the token, only used for auditing (the domain separator binds the chain)
+	Token           string // Token contract address (hex string with 0x prefix)
+	DomainSeparator []byte // DOMAIN_SEPARATOR() of the token contract (32 bytes)
+	Owner           string // Token owner and signing address (hex string with 0x prefix)
+	Spender         string // Address allowed to transfer the tokens (hex string with 0x prefix)
+	Value           string // Allowance in the token's smallest unit (as string to avoid precision loss)
+	Nonce           string // nonces(owner) of the token contract (as string)
+	Deadline        int64  // Unix timestamp after which the permit is rejected by the token contract
+	DerivationPath  string // BIP44 derivation path of the owner (e.g., "m/44'/60'/0'/0/0")
+}
+
+// SignEVMPermitResponse represents a signed EIP-2612 permit, V, R and S are the arguments of permit()
+type SignEVMPermitResponse struct {
+	V uint8    // Recovery ID (27 or 28)
+	R [32]byte // Signature R
+	S [32]byte // Signature S
+}
+
+// UserOperation represents an ERC-4337 user operation (EntryPoint v0.6),
+// field names match the tuple components of handleOps so it can be ABI encoded directly
+type UserOperation struct {
+	Sender               common.Address // Smart account
+	Nonce                *big.Int       // EntryPoint getNonce(sender, key)
+	InitCode             []byte         // Factory address + createAccount call, empty once the account is deployed
+	CallData             []byte         // Call executed by the account
+	CallGasLimit         *big.Int
+	VerificationGasLimit *big.Int
+	PreVerificationGas   *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	PaymasterAndData     []byte // Paymaster address + paymaster data, empty if the account pays its own gas
+	Signature            []byte // Set from SignEVMUserOperationResponse, not part of the hash
+}
+
+// SignEVMUserOperationRequest represents a request to sign an ERC-4337 user operation
+type SignEVMUserOperationRequest struct {
+	ChainID        int64          // Chain ID, part of the user operation hash
+	EntryPoint     string         // EntryPoint contract address (hex string with 0x prefix)
+	Owner          string         // Smart account owner and signing address (hex string with 0x prefix)
+	UserOperation  *UserOperation // User operation to sign, its Signature is ignored
+	DerivationPath string         // BIP44 derivation path of the owner (e.g., "m/44'/60'/0'/0/0")
+}
+
+// SignEVMUserOperationResponse represents a signed ERC-4337 user operation
+type SignEVMUserOperationResponse struct {
+	Signature  []byte // 65 byte r || s || v (27 or 28) over the EIP-191 message of the user operation hash
+	UserOpHash string // User operation hash (hex string with 0x prefix), identifies the UserOperationEvent
+}
+
+// SignSolanaRequest represents a request to sign a Solana transaction
+type SignSolanaRequest struct {
+	Transaction    *solana.Transaction // Compiled transaction, the derived key must be its only required signer (fee payer)
+	DerivationPath string              // SLIP-0010 derivation path (e.g., "m/44'/501'/0'/0'")
+}
+
+// SignSolanaResponse represents a signed Solana transaction
+type SignSolanaResponse struct {
+	RawTransaction []byte // Serialized signed transaction
+	Signature      string // Transaction signature (base58), used as the transaction hash
+}
+
+// SignBitcoinRequest represents a request to sign a Bitcoin transaction
+type SignBitcoinRequest struct {
+	Transaction     *bitcoin.Transaction // Unsigned transaction, all inputs must be P2WPKH outputs of derived addresses
+	DerivationPaths []string             // BIP84 derivation path of each input (e.g., "m/84'/0'/0'/0/0"), in input order
+}
+
+// SignBitcoinResponse represents a signed Bitcoin transaction
+type SignBitcoinResponse struct {
+	RawTransaction []byte // Serialized signed transaction (with witness)
+	TxID           string // Transaction ID, used as the transaction hash
+}
+
+// CallMsg returns the eth_call message equivalent to the transaction, used to simulate it before signing.
+// Gas prices are omitted so the simulation does not depend on the current base fee.
+func (r *SignEVMRequest) CallMsg() (ethereum.CallMsg, error) {
+	const base10 = 10
+	value, ok := new(big.Int).SetString(r.Value, base10)
+	if !ok {
+		return ethereum.CallMsg{}, errors.New("invalid value format")
+	}
+
+	to := common.HexToAddress(r.To)
+	return ethereum.CallMsg{
+		From:  common.HexToAddress(r.FromAddress),
+		To:    &to,
+		Gas:   r.GasLimit,
+		Value: value,
+		Data:  r.Data,
+	}, nil
+}
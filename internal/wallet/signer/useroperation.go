@@ -0,0 +1,137 @@
+package signer
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// userOperationPackArgs is abi.encode of the user operation fields hashed by EntryPoint v0.6 getUserOpHash,
+// the dynamic fields (initCode, callData, paymasterAndData) are replaced by their keccak256 hashes.
+//
+//nolint:gochecknoglobals // ABI types are constant and created once
+var userOperationPackArgs = mustArguments(
+	"address", "uint256", "bytes32", "bytes32", "uint256", "uint256", "uint256", "uint256", "uint256", "bytes32",
+)
+
+// userOperationHashArgs is abi.encode(keccak256(pack(userOp)), entryPoint, chainId)
+//
+//nolint:gochecknoglobals // ABI types are constant and created once
+var userOperationHashArgs = mustArguments("bytes32", "address", "uint256")
+
+// SignEVMUserOperation signs an ERC-4337 user operation with the key of the smart account owner
+func (s *service) SignEVMUserOperation(ctx context.Context, req *SignEVMUserOperationRequest) (*SignEVMUserOperationResponse, error) {
+	// Check if signing is enabled
+	if !s.enableSigning {
+		return nil, errors.New("signing is disabled by configuration")
+	}
+
+	// Get seed from memory
+	seed := s.seedManager.GetSeed()
+	if seed == nil {
+		return nil, errors.New("seed not initialized")
+	}
+
+	// Derive private key from seed and derivation path
+	privateKey, err := s.addressService.DerivePrivateKey(ctx, seed, req.DerivationPath, "evm")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive private key")
+	}
+
+	// Clear private key after use
+	defer func() {
+		for i := range privateKey {
+			privateKey[i] = 0
+		}
+	}()
+
+	return signEVMUserOperation(req, privateKey)
+}
+
+// signEVMUserOperation signs the EIP-191 message of the user operation hash, as verified by SimpleAccount compatible accounts
+func signEVMUserOperation(req *SignEVMUserOperationRequest, privateKey []byte) (*SignEVMUserOperationResponse, error) {
+	ecdsaPrivateKey, err := crypto.ToECDSA(privateKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert private key to ECDSA")
+	}
+
+	// Verify owner address matches private key
+	if crypto.PubkeyToAddress(ecdsaPrivateKey.PublicKey) != common.HexToAddress(req.Owner) {
+		return nil, errors.New("owner address does not match private key")
+	}
+
+	hash, err := UserOperationHash(req.UserOperation, req.EntryPoint, req.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := crypto.Sign(HashPersonalMessage(hash.Bytes()), ecdsaPrivateKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign user operation")
+	}
+	signature[ecdsaRecoveryIDPos] += legacyRecoveryIDMin
+
+	return &SignEVMUserOperationResponse{
+		Signature:  signature,
+		UserOpHash: hash.Hex(),
+	}, nil
+}
+
+// UserOperationHash returns the hash of the user operation as computed by EntryPoint v0.6 getUserOpHash:
+// keccak256(abi.encode(keccak256(pack(userOp)), entryPoint, chainId)), the signature is not part of the hash.
+func UserOperationHash(op *UserOperation, entryPoint string, chainID int64) (common.Hash, error) {
+	if op == nil {
+		return common.Hash{}, errors.New("user operation is required")
+	}
+	if !common.IsHexAddress(entryPoint) {
+		return common.Hash{}, errors.New("invalid entry point address")
+	}
+	for _, v := range []*big.Int{
+		op.Nonce, op.CallGasLimit, op.VerificationGasLimit, op.PreVerificationGas, op.MaxFeePerGas, op.MaxPriorityFeePerGas,
+	} {
+		if v == nil || v.Sign() < 0 {
+			return common.Hash{}, errors.New("user operation numbers must be set and not negative")
+		}
+	}
+
+	packed, err := userOperationPackArgs.Pack(
+		op.Sender,
+		op.Nonce,
+		crypto.Keccak256Hash(op.InitCode),
+		crypto.Keccak256Hash(op.CallData),
+		op.CallGasLimit,
+		op.VerificationGasLimit,
+		op.PreVerificationGas,
+		op.MaxFeePerGas,
+		op.MaxPriorityFeePerGas,
+		crypto.Keccak256Hash(op.PaymasterAndData),
+	)
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "failed to encode user operation")
+	}
+
+	encoded, err := userOperationHashArgs.Pack(crypto.Keccak256Hash(packed), common.HexToAddress(entryPoint), big.NewInt(chainID))
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "failed to encode user operation hash")
+	}
+
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// mustArguments builds ABI arguments of the given types, panics on unknown types
+func mustArguments(typeNames ...string) abi.Arguments {
+	args := make(abi.Arguments, 0, len(typeNames))
+	for _, name := range typeNames {
+		typ, err := abi.NewType(name, "", nil)
+		if err != nil {
+			panic(err)
+		}
+		args = append(args, abi.Argument{Type: typ})
+	}
+
+	return args
+}
@@ -0,0 +1,93 @@
+package signer
+
+import (
+	"math/big"
+	"testing"
+
+	"github/chapool/go-wallet/internal/wallet/testvectors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testEntryPoint = "0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789"
+
+func TestSignEVMUserOperationRecoversOwner(t *testing.T) {
+	t.Parallel()
+
+	vector := testvectors.EVMSigningVectors[0]
+	req := userOperationRequest(vector.From)
+
+	resp, err := signEVMUserOperation(req, common.FromHex(vector.PrivateKey))
+	require.NoError(t, err)
+	require.Len(t, resp.Signature, 65)
+	assert.Contains(t, []byte{27, 28}, resp.Signature[64])
+
+	hash, err := UserOperationHash(req.UserOperation, req.EntryPoint, req.ChainID)
+	require.NoError(t, err)
+	assert.Equal(t, hash.Hex(), resp.UserOpHash)
+
+	signature := append([]byte{}, resp.Signature...)
+	signature[64] -= 27
+	pub, err := crypto.SigToPub(HashPersonalMessage(hash.Bytes()), signature)
+	require.NoError(t, err)
+	assert.Equal(t, vector.From, crypto.PubkeyToAddress(*pub).Hex())
+}
+
+func TestUserOperationHash(t *testing.T) {
+	t.Parallel()
+
+	op := userOperationRequest(testvectors.EVMSigningVectors[0].From).UserOperation
+
+	hash, err := UserOperationHash(op, testEntryPoint, 56)
+	require.NoError(t, err)
+
+	otherChain, err := UserOperationHash(op, testEntryPoint, 97)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, otherChain)
+
+	signed := *op
+	signed.Signature = []byte{1, 2, 3}
+	withSignature, err := UserOperationHash(&signed, testEntryPoint, 56)
+	require.NoError(t, err)
+	assert.Equal(t, hash, withSignature)
+
+	_, err = UserOperationHash(op, "entry-point", 56)
+	require.Error(t, err)
+
+	missing := *op
+	missing.Nonce = nil
+	_, err = UserOperationHash(&missing, testEntryPoint, 56)
+	require.Error(t, err)
+}
+
+func TestSignEVMUserOperationRejectsOtherOwner(t *testing.T) {
+	t.Parallel()
+
+	vector := testvectors.EVMSigningVectors[0]
+
+	_, err := signEVMUserOperation(userOperationRequest(testvectors.EVMDerivationVectors[0].Address), common.FromHex(vector.PrivateKey))
+	require.Error(t, err)
+}
+
+func userOperationRequest(owner string) *SignEVMUserOperationRequest {
+	return &SignEVMUserOperationRequest{
+		ChainID:    56,
+		EntryPoint: testEntryPoint,
+		Owner:      owner,
+		UserOperation: &UserOperation{
+			Sender:               common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8"),
+			Nonce:                big.NewInt(0),
+			InitCode:             common.FromHex("0x9406cc6185a346906296840746125a0e44976454"),
+			CallData:             common.FromHex("0xb61d27f6"),
+			CallGasLimit:         big.NewInt(60000),
+			VerificationGasLimit: big.NewInt(500000),
+			PreVerificationGas:   big.NewInt(60000),
+			MaxFeePerGas:         big.NewInt(3000000000),
+			MaxPriorityFeePerGas: big.NewInt(3000000000),
+			PaymasterAndData:     common.FromHex("0x3c44cdddb6a900fa2b585dd299e03d12fa4293bc"),
+		},
+	}
+}
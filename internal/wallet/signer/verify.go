@@ -0,0 +1,130 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// eip1271MagicValue is returned by isValidSignature(bytes32,bytes) for a valid signature,
+// it equals bytes4(keccak256("isValidSignature(bytes32,bytes)")).
+var eip1271MagicValue = common.Hex2Bytes("1626ba7e")
+
+const (
+	abiWordLength       = 32
+	ecdsaSignatureLen   = 65
+	ecdsaRecoveryIDPos  = 64
+	legacyRecoveryIDMin = 27
+)
+
+// ContractCaller is the subset of RPC functionality needed to verify signatures of contract accounts.
+// Both *ethclient.Client and *scan.RPCClient satisfy it.
+type ContractCaller interface {
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// HashPersonalMessage returns the EIP-191 (personal_sign) hash of the given message.
+func HashPersonalMessage(message []byte) []byte {
+	return accounts.TextHash(message)
+}
+
+// VerifyMessageSignature verifies a personal_sign signature for the given address.
+// Externally owned accounts are checked via ECDSA recovery, while accounts with contract code
+// (smart-contract wallets) are checked by calling their EIP-1271 isValidSignature method.
+func VerifyMessageSignature(ctx context.Context, caller ContractCaller, signerAddress string, message []byte, signature []byte) (bool, error) {
+	return VerifyHashSignature(ctx, caller, signerAddress, HashPersonalMessage(message), signature)
+}
+
+// VerifyHashSignature verifies a signature over an already computed 32 byte hash for the given address.
+// See VerifyMessageSignature for the EOA / contract account distinction.
+func VerifyHashSignature(ctx context.Context, caller ContractCaller, signerAddress string, hash []byte, signature []byte) (bool, error) {
+	if !common.IsHexAddress(signerAddress) {
+		return false, errors.Errorf("invalid signer address: %s", signerAddress)
+	}
+
+	if len(hash) != abiWordLength {
+		return false, errors.Errorf("invalid hash length: %d", len(hash))
+	}
+
+	account := common.HexToAddress(signerAddress)
+
+	if caller != nil {
+		code, err := caller.CodeAt(ctx, account, nil)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to get account code")
+		}
+
+		if len(code) > 0 {
+			return verifyEIP1271Signature(ctx, caller, account, hash, signature)
+		}
+	}
+
+	return verifyECDSASignature(account, hash, signature)
+}
+
+// verifyECDSASignature recovers the signer of hash and compares it to the expected account.
+func verifyECDSASignature(account common.Address, hash []byte, signature []byte) (bool, error) {
+	if len(signature) != ecdsaSignatureLen {
+		return false, errors.Errorf("invalid signature length: %d", len(signature))
+	}
+
+	// Copy so the caller's signature is left untouched when normalizing V
+	sig := make([]byte, ecdsaSignatureLen)
+	copy(sig, signature)
+
+	// Wallets commonly return V as 27/28, go-ethereum expects 0/1
+	if sig[ecdsaRecoveryIDPos] >= legacyRecoveryIDMin {
+		sig[ecdsaRecoveryIDPos] -= legacyRecoveryIDMin
+	}
+
+	publicKey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		// Malformed signatures are treated as invalid rather than as an error
+		return false, nil //nolint:nilerr
+	}
+
+	return crypto.PubkeyToAddress(*publicKey) == account, nil
+}
+
+// verifyEIP1271Signature calls isValidSignature(bytes32,bytes) on the contract account and
+// checks that the returned value equals the EIP-1271 magic value.
+func verifyEIP1271Signature(ctx context.Context, caller ContractCaller, account common.Address, hash []byte, signature []byte) (bool, error) {
+	callMsg := ethereum.CallMsg{
+		To:   &account,
+		Data: encodeIsValidSignatureCall(hash, signature),
+	}
+
+	resp, err := caller.CallContract(ctx, callMsg, nil)
+	if err != nil {
+		// A revert means the contract rejected the signature (or does not implement EIP-1271)
+		return false, nil //nolint:nilerr
+	}
+
+	if len(resp) < len(eip1271MagicValue) {
+		return false, nil
+	}
+
+	return bytes.Equal(resp[:len(eip1271MagicValue)], eip1271MagicValue), nil
+}
+
+// encodeIsValidSignatureCall ABI-encodes a call to isValidSignature(bytes32 hash, bytes signature).
+func encodeIsValidSignatureCall(hash []byte, signature []byte) []byte {
+	paddedSignatureLen := (len(signature) + abiWordLength - 1) / abiWordLength * abiWordLength
+
+	data := make([]byte, 0, len(eip1271MagicValue)+3*abiWordLength+paddedSignatureLen)
+	data = append(data, eip1271MagicValue...)
+	data = append(data, common.LeftPadBytes(hash, abiWordLength)...)
+	// Offset of the dynamic bytes argument: two head words (hash + offset)
+	data = append(data, common.LeftPadBytes(big.NewInt(2*abiWordLength).Bytes(), abiWordLength)...)
+	data = append(data, common.LeftPadBytes(big.NewInt(int64(len(signature))).Bytes(), abiWordLength)...)
+	data = append(data, common.RightPadBytes(signature, paddedSignatureLen)...)
+
+	return data
+}
@@ -0,0 +1,131 @@
+package signer
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeContractCaller returns fixed contract code and isValidSignature results
+type fakeContractCaller struct {
+	code     []byte
+	codeErr  error
+	response []byte
+	callErr  error
+	calls    []ethereum.CallMsg
+}
+
+func (f *fakeContractCaller) CodeAt(_ context.Context, _ common.Address, _ *big.Int) ([]byte, error) {
+	return f.code, f.codeErr
+}
+
+func (f *fakeContractCaller) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	f.calls = append(f.calls, msg)
+	return f.response, f.callErr
+}
+
+func TestVerifyMessageSignatureEOA(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	account := crypto.PubkeyToAddress(key.PublicKey)
+	message := []byte("I own this address")
+
+	signature, err := crypto.Sign(HashPersonalMessage(message), key)
+	require.NoError(t, err)
+
+	// Wallets commonly return V as 27/28
+	legacy := append([]byte{}, signature...)
+	legacy[ecdsaRecoveryIDPos] += legacyRecoveryIDMin
+
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	for _, caller := range []ContractCaller{nil, &fakeContractCaller{}} {
+		valid, err := VerifyMessageSignature(context.Background(), caller, account.Hex(), message, signature)
+		require.NoError(t, err)
+		assert.True(t, valid)
+
+		valid, err = VerifyMessageSignature(context.Background(), caller, account.Hex(), message, legacy)
+		require.NoError(t, err)
+		assert.True(t, valid)
+
+		valid, err = VerifyMessageSignature(context.Background(), caller, account.Hex(), []byte("another message"), signature)
+		require.NoError(t, err)
+		assert.False(t, valid)
+
+		valid, err = VerifyMessageSignature(context.Background(), caller, crypto.PubkeyToAddress(other.PublicKey).Hex(), message, signature)
+		require.NoError(t, err)
+		assert.False(t, valid)
+	}
+
+	_, err = VerifyMessageSignature(context.Background(), nil, account.Hex(), message, signature[:64])
+	require.Error(t, err)
+
+	_, err = VerifyMessageSignature(context.Background(), nil, "not-an-address", message, signature)
+	require.Error(t, err)
+}
+
+func TestVerifyMessageSignatureContractWallet(t *testing.T) {
+	t.Parallel()
+
+	account := common.HexToAddress("0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb0")
+	message := []byte("I own this address")
+	signature := []byte{0x01, 0x02, 0x03}
+
+	tests := []struct {
+		name     string
+		response []byte
+		callErr  error
+		valid    bool
+	}{
+		{name: "MagicValue", response: common.RightPadBytes(eip1271MagicValue, abiWordLength), valid: true},
+		{name: "OtherValue", response: common.RightPadBytes(common.Hex2Bytes("ffffffff"), abiWordLength), valid: false},
+		{name: "EmptyResponse", response: nil, valid: false},
+		{name: "Revert", callErr: errors.New("execution reverted"), valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			caller := &fakeContractCaller{code: []byte{0x60, 0x80}, response: tt.response, callErr: tt.callErr}
+
+			valid, err := VerifyMessageSignature(context.Background(), caller, account.Hex(), message, signature)
+			require.NoError(t, err)
+			assert.Equal(t, tt.valid, valid)
+
+			require.Len(t, caller.calls, 1)
+			assert.Equal(t, account, *caller.calls[0].To)
+			assert.Equal(t, encodeIsValidSignatureCall(HashPersonalMessage(message), signature), caller.calls[0].Data)
+		})
+	}
+
+	_, err := VerifyMessageSignature(context.Background(), &fakeContractCaller{codeErr: errors.New("rpc unavailable")}, account.Hex(), message, signature)
+	require.Error(t, err)
+}
+
+func TestEncodeIsValidSignatureCall(t *testing.T) {
+	t.Parallel()
+
+	hash := common.HexToHash("0x1111111111111111111111111111111111111111111111111111111111111111").Bytes()
+	signature := common.RightPadBytes([]byte{0xaa}, ecdsaSignatureLen)
+
+	data := encodeIsValidSignatureCall(hash, signature)
+
+	// selector + hash + offset + length + signature padded to 3 words
+	require.Len(t, data, 4+3*abiWordLength+3*abiWordLength)
+	assert.Equal(t, eip1271MagicValue, data[:4])
+	assert.Equal(t, hash, data[4:36])
+	assert.Equal(t, int64(2*abiWordLength), new(big.Int).SetBytes(data[36:68]).Int64())
+	assert.Equal(t, int64(ecdsaSignatureLen), new(big.Int).SetBytes(data[68:100]).Int64())
+	assert.Equal(t, signature, data[100:100+ecdsaSignatureLen])
+}
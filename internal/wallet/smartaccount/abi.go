@@ -0,0 +1,124 @@
+package smartaccount
+
+import (
+	"math/big"
+	"strings"
+
+	"github/chapool/go-wallet/internal/wallet/signer"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// accountSalt 创建账户的 CREATE2 salt，每个 owner 只创建一个账户
+//
+//nolint:gochecknoglobals // 常量 salt
+var accountSalt = big.NewInt(0)
+
+// factoryABI SimpleAccountFactory 兼容的工厂合约接口
+var factoryABI = mustParseABI(`[
+	{"type":"function","name":"createAccount","stateMutability":"nonpayable","inputs":[{"name":"owner","type":"address"},{"name":"salt","type":"uint256"}],"outputs":[{"name":"ret","type":"address"}]},
+	{"type":"function","name":"getAddress","stateMutability":"view","inputs":[{"name":"owner","type":"address"},{"name":"salt","type":"uint256"}],"outputs":[{"name":"","type":"address"}]}
+]`)
+
+// accountABI SimpleAccount 兼容的账户合约接口
+var accountABI = mustParseABI(`[
+	{"type":"function","name":"execute","stateMutability":"nonpayable","inputs":[{"name":"dest","type":"address"},{"name":"value","type":"uint256"},{"name":"func","type":"bytes"}],"outputs":[]}
+]`)
+
+// entryPointABI ERC-4337 EntryPoint v0.6 接口
+var entryPointABI = mustParseABI(`[
+	{"type":"function","name":"handleOps","stateMutability":"nonpayable","inputs":[{"name":"ops","type":"tuple[]","components":[
+		{"name":"sender","type":"address"},
+		{"name":"nonce","type":"uint256"},
+		{"name":"initCode","type":"bytes"},
+		{"name":"callData","type":"bytes"},
+		{"name":"callGasLimit","type":"uint256"},
+		{"name":"verificationGasLimit","type":"uint256"},
+		{"name":"preVerificationGas","type":"uint256"},
+		{"name":"maxFeePerGas","type":"uint256"},
+		{"name":"maxPriorityFeePerGas","type":"uint256"},
+		{"name":"paymasterAndData","type":"bytes"},
+		{"name":"signature","type":"bytes"}
+	]},{"name":"beneficiary","type":"address"}],"outputs":[]},
+	{"type":"function","name":"getNonce","stateMutability":"view","inputs":[{"name":"sender","type":"address"},{"name":"key","type":"uint192"}],"outputs":[{"name":"nonce","type":"uint256"}]},
+	{"type":"event","name":"UserOperationEvent","anonymous":false,"inputs":[
+		{"name":"userOpHash","type":"bytes32","indexed":true},
+		{"name":"sender","type":"address","indexed":true},
+		{"name":"paymaster","type":"address","indexed":true},
+		{"name":"nonce","type":"uint256","indexed":false},
+		{"name":"success","type":"bool","indexed":false},
+		{"name":"actualGasCost","type":"uint256","indexed":false},
+		{"name":"actualGasUsed","type":"uint256","indexed":false}
+	]}
+]`)
+
+func mustParseABI(definition string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic(err)
+	}
+
+	return parsed
+}
+
+// InitCode 部署账户的 initCode：工厂合约地址 + createAccount(owner, 0) 调用数据
+func InitCode(factory common.Address, owner common.Address) ([]byte, error) {
+	data, err := factoryABI.Pack("createAccount", owner, accountSalt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode createAccount")
+	}
+
+	return append(factory.Bytes(), data...), nil
+}
+
+// ExecuteCallData 账户执行调用的 callData：execute(dest, value, func)
+func ExecuteCallData(dest common.Address, value *big.Int, data []byte) ([]byte, error) {
+	if data == nil {
+		data = []byte{}
+	}
+
+	callData, err := accountABI.Pack("execute", dest, value, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode execute")
+	}
+
+	return callData, nil
+}
+
+// HandleOpsCallData 提交 UserOperation 的 EntryPoint 调用数据：handleOps(ops, beneficiary)，beneficiary 收取 gas 补偿
+func HandleOpsCallData(ops []signer.UserOperation, beneficiary common.Address) ([]byte, error) {
+	data, err := entryPointABI.Pack("handleOps", ops, beneficiary)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode handleOps")
+	}
+
+	return data, nil
+}
+
+// UserOperationSucceeded 从 handleOps 交易收据中查找 UserOperationEvent，判断 UserOperation 的调用是否成功
+// handleOps 交易成功但账户调用回滚时事件的 success 为 false，收据中没有该事件时返回 false
+func UserOperationSucceeded(receipt *types.Receipt, entryPoint common.Address, userOpHash common.Hash) bool {
+	if receipt == nil || receipt.Status != types.ReceiptStatusSuccessful {
+		return false
+	}
+
+	event := entryPointABI.Events["UserOperationEvent"]
+	for _, l := range receipt.Logs {
+		if l.Address != entryPoint || len(l.Topics) < 2 || l.Topics[0] != event.ID || l.Topics[1] != userOpHash {
+			continue
+		}
+
+		values, err := event.Inputs.NonIndexed().Unpack(l.Data)
+		if err != nil || len(values) < 2 {
+			return false
+		}
+		success, ok := values[1].(bool)
+
+		return ok && success
+	}
+
+	return false
+}
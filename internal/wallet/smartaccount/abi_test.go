@@ -0,0 +1,92 @@
+package smartaccount
+
+import (
+	"math/big"
+	"testing"
+
+	"github/chapool/go-wallet/internal/wallet/signer"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testFactory    = common.HexToAddress("0x9406Cc6185a346906296840746125a0E44976454")
+	testEntryPoint = common.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+	testOwner      = common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+)
+
+func TestInitCode(t *testing.T) {
+	t.Parallel()
+
+	initCode, err := InitCode(testFactory, testOwner)
+	require.NoError(t, err)
+
+	// 工厂地址 + createAccount(address,uint256) 选择器 + 两个参数
+	require.Len(t, initCode, 20+4+64)
+	assert.Equal(t, testFactory.Bytes(), initCode[:20])
+	assert.Equal(t, "5fbfb9cf", common.Bytes2Hex(initCode[20:24]))
+	assert.Equal(t, common.LeftPadBytes(testOwner.Bytes(), 32), initCode[24:56])
+}
+
+func TestExecuteCallData(t *testing.T) {
+	t.Parallel()
+
+	callData, err := ExecuteCallData(testOwner, big.NewInt(1), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "b61d27f6", common.Bytes2Hex(callData[:4]))
+}
+
+func TestHandleOpsCallData(t *testing.T) {
+	t.Parallel()
+
+	op := signer.UserOperation{
+		Sender:               testOwner,
+		Nonce:                big.NewInt(0),
+		CallGasLimit:         big.NewInt(60000),
+		VerificationGasLimit: big.NewInt(150000),
+		PreVerificationGas:   big.NewInt(60000),
+		MaxFeePerGas:         big.NewInt(1),
+		MaxPriorityFeePerGas: big.NewInt(1),
+		InitCode:             []byte{},
+		CallData:             []byte{0xb6},
+		PaymasterAndData:     testFactory.Bytes(),
+		Signature:            make([]byte, 65),
+	}
+
+	data, err := HandleOpsCallData([]signer.UserOperation{op}, testOwner)
+	require.NoError(t, err)
+	assert.Equal(t, "1fad948c", common.Bytes2Hex(data[:4]))
+}
+
+func TestUserOperationSucceeded(t *testing.T) {
+	t.Parallel()
+
+	userOpHash := common.HexToHash("0x01")
+	event := entryPointABI.Events["UserOperationEvent"]
+
+	receipt := func(success bool) *types.Receipt {
+		data, err := event.Inputs.NonIndexed().Pack(big.NewInt(0), success, big.NewInt(1), big.NewInt(1))
+		require.NoError(t, err)
+
+		return &types.Receipt{
+			Status: types.ReceiptStatusSuccessful,
+			Logs: []*types.Log{{
+				Address: testEntryPoint,
+				Topics:  []common.Hash{event.ID, userOpHash, common.BytesToHash(testOwner.Bytes()), {}},
+				Data:    data,
+			}},
+		}
+	}
+
+	assert.True(t, UserOperationSucceeded(receipt(true), testEntryPoint, userOpHash))
+	assert.False(t, UserOperationSucceeded(receipt(false), testEntryPoint, userOpHash))
+	assert.False(t, UserOperationSucceeded(receipt(true), testEntryPoint, common.HexToHash("0x02")))
+	assert.False(t, UserOperationSucceeded(receipt(true), testFactory, userOpHash))
+
+	failed := receipt(true)
+	failed.Status = types.ReceiptStatusFailed
+	assert.False(t, UserOperationSucceeded(failed, testEntryPoint, userOpHash))
+}
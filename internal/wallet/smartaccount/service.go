@@ -0,0 +1,140 @@
+// Package smartaccount 提供 ERC-4337 智能账户充值地址
+// 配置了智能账户的链上，用户钱包的地址为工厂合约通过 CREATE2 计算的反事实（counterfactual）账户地址，
+// 账户在首次归集时由 UserOperation 的 initCode 部署；归集由热钱包通过 EntryPoint handleOps 提交，
+// gas 由 paymaster 赞助，用户地址无需充值原生代币
+package smartaccount
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github/chapool/go-wallet/internal/wallet/scan"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// Config 链的智能账户配置
+type Config struct {
+	ChainID    int
+	Factory    common.Address // SimpleAccountFactory 兼容的工厂合约（createAccount / getAddress）
+	EntryPoint common.Address // 账户信任的 EntryPoint v0.6
+	Paymaster  common.Address // 赞助归集 gas 的 paymaster，需接受不带 paymaster 数据的 UserOperation 并在 EntryPoint 存入押金
+}
+
+// Account 反事实智能账户
+type Account struct {
+	Address string // 智能账户地址（小写）
+	Owner   string // 签名 UserOperation 的 owner 地址（小写）
+	Factory string // 创建账户的工厂合约地址（小写）
+}
+
+// ClientProvider 提供链的 RPC 客户端，由扫描服务实现
+type ClientProvider interface {
+	GetClient(ctx context.Context, chainID int) (*scan.RPCClient, error)
+}
+
+// Service 智能账户地址服务
+type Service interface {
+	// Config 返回链的智能账户配置，未配置智能账户的链返回 false
+	Config(chainID int) (Config, bool)
+
+	// AccountAddress 通过工厂合约 getAddress(owner, 0) 计算 owner 在链上的反事实智能账户地址
+	AccountAddress(ctx context.Context, chainID int, owner string) (*Account, error)
+
+	// GetNonce 查询账户在 EntryPoint 的 UserOperation nonce（key 0）
+	GetNonce(ctx context.Context, client *scan.RPCClient, entryPoint common.Address, sender common.Address) (*big.Int, error)
+}
+
+type service struct {
+	configs map[int]Config
+	clients ClientProvider
+}
+
+// NewService 创建智能账户地址服务
+//
+//nolint:ireturn // Returning interface is intentional for dependency injection
+func NewService(configs []Config, clients ClientProvider) Service {
+	byChain := make(map[int]Config, len(configs))
+	for _, config := range configs {
+		byChain[config.ChainID] = config
+	}
+
+	return &service{
+		configs: byChain,
+		clients: clients,
+	}
+}
+
+// Config 返回链的智能账户配置
+func (s *service) Config(chainID int) (Config, bool) {
+	config, ok := s.configs[chainID]
+	return config, ok
+}
+
+// AccountAddress 计算 owner 的反事实智能账户地址，账户部署前即可接收充值
+func (s *service) AccountAddress(ctx context.Context, chainID int, owner string) (*Account, error) {
+	config, ok := s.configs[chainID]
+	if !ok {
+		return nil, errors.Errorf("smart accounts are not configured for chain %d", chainID)
+	}
+	if !common.IsHexAddress(owner) {
+		return nil, errors.Errorf("invalid owner address %q", owner)
+	}
+
+	client, err := s.clients.GetClient(ctx, chainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get RPC client")
+	}
+
+	data, err := factoryABI.Pack("getAddress", common.HexToAddress(owner), accountSalt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode getAddress")
+	}
+
+	resp, err := client.CallContract(ctx, ethereum.CallMsg{To: &config.Factory, Data: data}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call factory getAddress")
+	}
+
+	values, err := factoryABI.Unpack("getAddress", resp)
+	if err != nil || len(values) != 1 {
+		return nil, errors.New("factory does not implement getAddress(address,uint256)")
+	}
+	address, ok := values[0].(common.Address)
+	if !ok || address == (common.Address{}) {
+		return nil, errors.New("factory returned an invalid account address")
+	}
+
+	return &Account{
+		Address: strings.ToLower(address.Hex()),
+		Owner:   strings.ToLower(owner),
+		Factory: strings.ToLower(config.Factory.Hex()),
+	}, nil
+}
+
+// GetNonce 查询账户在 EntryPoint 的 nonce，未部署的账户为 0
+func (s *service) GetNonce(ctx context.Context, client *scan.RPCClient, entryPoint common.Address, sender common.Address) (*big.Int, error) {
+	data, err := entryPointABI.Pack("getNonce", sender, big.NewInt(0))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode getNonce")
+	}
+
+	resp, err := client.CallContract(ctx, ethereum.CallMsg{To: &entryPoint, Data: data}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call EntryPoint getNonce")
+	}
+
+	values, err := entryPointABI.Unpack("getNonce", resp)
+	if err != nil || len(values) != 1 {
+		return nil, errors.New("entry point does not implement getNonce(address,uint192)")
+	}
+	nonce, ok := values[0].(*big.Int)
+	if !ok {
+		return nil, errors.New("entry point returned an invalid nonce")
+	}
+
+	return nonce, nil
+}
@@ -0,0 +1,119 @@
+package snapshot
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/address"
+
+	"github.com/aarondl/null/v8"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// scrubbedAmount 不保留金额时写入的金额
+const scrubbedAmount = "0"
+
+// anonymizer 脱敏一次导出中的记录
+// 钱包地址用一次性随机种子按原派生路径重新派生，格式与真实地址一致但与生产密钥无关；
+// 交易哈希等标识用一次性随机盐做哈希，同一次导出内保持一致以保留记录间的关联
+type anonymizer struct {
+	addressService  address.Service
+	seed            []byte
+	salt            []byte
+	preserveAmounts bool
+	addresses       map[string]string // 原地址 -> 替换地址
+}
+
+func newAnonymizer(addressService address.Service, seed, salt []byte, preserveAmounts bool) *anonymizer {
+	return &anonymizer{
+		addressService:  addressService,
+		seed:            seed,
+		salt:            salt,
+		preserveAmounts: preserveAmounts,
+		addresses:       make(map[string]string),
+	}
+}
+
+// chain 节点地址可能带 API key，不导出；导入方使用自己的节点配置
+func (a *anonymizer) chain(chain *models.Chain) {
+	chain.RPCURL = ""
+}
+
+// user 去掉用户名和密码，导入后的用户无法登录
+func (a *anonymizer) user(user *models.User) {
+	user.Username = null.StringFrom("snapshot-" + user.ID)
+	user.Password = null.String{}
+}
+
+// wallet 用一次性种子按原派生路径重新派生地址
+func (a *anonymizer) wallet(ctx context.Context, wallet *models.Wallet) error {
+	fake, err := a.addressService.DeriveAddress(ctx, a.seed, wallet.DerivationPath, wallet.ChainType)
+	if err != nil {
+		return errors.Wrapf(err, "failed to derive address for wallet %s", wallet.ID)
+	}
+
+	a.addresses[addressKey(wallet.Address)] = fake
+	wallet.Address = fake
+	wallet.DeviceName = null.String{}
+	return nil
+}
+
+// credit 替换地址和交易哈希，去掉扩展信息，按需清除金额
+// 需在该用户的钱包之后处理，以使用相同的替换地址
+func (a *anonymizer) credit(credit *models.Credit) {
+	credit.Address = a.address(credit.Address)
+
+	if credit.TXHash.Valid {
+		fake := a.hash(credit.TXHash.String)
+		credit.ReferenceID = replaceFold(credit.ReferenceID, credit.TXHash.String, fake)
+		credit.TXHash = null.StringFrom(fake)
+	}
+	credit.Metadata = null.JSON{}
+
+	if !a.preserveAmounts {
+		credit.Amount = scrubbedAmount
+	}
+}
+
+// address 获取替换地址，不属于任何钱包的地址按哈希生成
+func (a *anonymizer) address(addr string) string {
+	if fake, ok := a.addresses[addressKey(addr)]; ok {
+		return fake
+	}
+
+	digest := crypto.Keccak256(a.salt, []byte(addressKey(addr)))
+	var fake string
+	if strings.HasPrefix(addr, "0x") {
+		fake = common.BytesToAddress(digest).Hex()
+	} else {
+		fake = hex.EncodeToString(digest[:common.AddressLength])
+	}
+	a.addresses[addressKey(addr)] = fake
+	return fake
+}
+
+// hash 生成替换的交易哈希
+func (a *anonymizer) hash(txHash string) string {
+	return common.BytesToHash(crypto.Keccak256(a.salt, []byte(strings.ToLower(txHash)))).Hex()
+}
+
+// addressKey EVM 地址不区分大小写，其他链的地址原样比较
+func addressKey(addr string) string {
+	if strings.HasPrefix(addr, "0x") {
+		return strings.ToLower(addr)
+	}
+	return addr
+}
+
+// replaceFold 不区分大小写地替换子串
+func replaceFold(s, old, replacement string) string {
+	idx := strings.Index(strings.ToLower(s), strings.ToLower(old))
+	if old == "" || idx < 0 {
+		return s
+	}
+	return s[:idx] + replacement + replaceFold(s[idx+len(old):], old, replacement)
+}
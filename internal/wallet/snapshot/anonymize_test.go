@@ -0,0 +1,142 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/address"
+	"github/chapool/go-wallet/internal/wallet/chain"
+
+	"github.com/aarondl/null/v8"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTxHash = "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"
+
+func newTestAnonymizer(t *testing.T, preserveAmounts bool) *anonymizer {
+	t.Helper()
+
+	addressService, err := address.NewService(nil, address.Config{})
+	require.NoError(t, err)
+
+	seed := bytes.Repeat([]byte{0x01}, seedSize)
+	salt := bytes.Repeat([]byte{0x02}, saltSize)
+	return newAnonymizer(addressService, seed, salt, preserveAmounts)
+}
+
+func TestAnonymizeWalletAndCredit(t *testing.T) {
+	t.Parallel()
+
+	anon := newTestAnonymizer(t, false)
+	original := "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
+
+	wallet := &models.Wallet{
+		ID:             "wallet-1",
+		Address:        original,
+		ChainType:      chain.TypeEVM,
+		ChainID:        1,
+		DerivationPath: "m/44'/60'/0'/0/3",
+		DeviceName:     null.StringFrom("alice-phone"),
+	}
+	require.NoError(t, anon.wallet(context.Background(), wallet))
+	assert.NotEqual(t, original, wallet.Address)
+	assert.True(t, common.IsHexAddress(wallet.Address))
+	assert.False(t, wallet.DeviceName.Valid)
+
+	credit := &models.Credit{
+		Address:     strings.ToLower(original),
+		Amount:      "1500000",
+		ReferenceID: testTxHash + "_2",
+		TXHash:      null.StringFrom(testTxHash),
+		Metadata:    null.JSONFrom([]byte(`{"from":"0x0"}`)),
+	}
+	anon.credit(credit)
+	assert.Equal(t, wallet.Address, credit.Address)
+	assert.Equal(t, scrubbedAmount, credit.Amount)
+	assert.NotEqual(t, testTxHash, credit.TXHash.String)
+	assert.Equal(t, credit.TXHash.String+"_2", credit.ReferenceID)
+	assert.False(t, credit.Metadata.Valid)
+}
+
+func TestAnonymizeCreditPreserveAmounts(t *testing.T) {
+	t.Parallel()
+
+	anon := newTestAnonymizer(t, true)
+	credit := &models.Credit{
+		Address: "0x0000000000000000000000000000000000000001",
+		Amount:  "-42",
+	}
+	anon.credit(credit)
+	assert.Equal(t, "-42", credit.Amount)
+	assert.True(t, common.IsHexAddress(credit.Address))
+	assert.NotEqual(t, "0x0000000000000000000000000000000000000001", credit.Address)
+}
+
+func TestAnonymizeIsDeterministicPerExport(t *testing.T) {
+	t.Parallel()
+
+	anon := newTestAnonymizer(t, false)
+	assert.Equal(t, anon.hash(testTxHash), anon.hash("0x"+strings.ToUpper(testTxHash[2:])))
+	assert.Equal(t, anon.address("0xAB5801A7D398351B8BE11C439E05C5B3259AEC9B"), anon.address("0xab5801a7d398351b8be11c439e05c5b3259aec9b"))
+
+	other := newAnonymizer(anon.addressService, anon.seed, bytes.Repeat([]byte{0x03}, saltSize), false)
+	assert.NotEqual(t, anon.hash(testTxHash), other.hash(testTxHash))
+}
+
+func TestAnonymizeUserAndChain(t *testing.T) {
+	t.Parallel()
+
+	anon := newTestAnonymizer(t, false)
+
+	user := &models.User{ID: "user-1", Username: null.StringFrom("alice@example.com"), Password: null.StringFrom("hash")}
+	anon.user(user)
+	assert.Equal(t, "snapshot-user-1", user.Username.String)
+	assert.False(t, user.Password.Valid)
+
+	chainModel := &models.Chain{RPCURL: "https://mainnet.example.com/v3/secret"}
+	anon.chain(chainModel)
+	assert.Empty(t, chainModel.RPCURL)
+}
+
+func TestReplaceFold(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "x_1", replaceFold("0xABC_1", "0xabc", "x"))
+	assert.Equal(t, "x_x", replaceFold("0xabc_0xABC", "0xabc", "x"))
+	assert.Equal(t, "withdraw-1", replaceFold("withdraw-1", "0xabc", "x"))
+	assert.Equal(t, "abc", replaceFold("abc", "", "x"))
+}
+
+func TestSnapshotFormat(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	enc := newEncoder(&buf)
+	require.NoError(t, enc.writeHeader(&header{Version: formatVersion, PreserveAmounts: true}))
+	require.NoError(t, enc.writeRow(TableTokens, &models.Token{ID: 7, TokenSymbol: "USDC"}))
+	require.NoError(t, enc.flush())
+
+	dec := newDecoder(&buf)
+	h, err := dec.readHeader()
+	require.NoError(t, err)
+	assert.True(t, h.PreserveAmounts)
+
+	rec, err := dec.next()
+	require.NoError(t, err)
+	assert.Equal(t, TableTokens, rec.Table)
+	assert.Contains(t, string(rec.Row), `"token_symbol":"USDC"`)
+
+	_, err = dec.next()
+	assert.ErrorIs(t, err, io.EOF)
+
+	_, err = newDecoder(strings.NewReader(`{"version":99}`)).readHeader()
+	require.ErrorIs(t, err, ErrInvalidSnapshot)
+	_, err = newDecoder(strings.NewReader("")).readHeader()
+	require.ErrorIs(t, err, ErrInvalidSnapshot)
+}
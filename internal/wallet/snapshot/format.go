@@ -0,0 +1,122 @@
+package snapshot
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// formatVersion 快照文件格式版本，格式不兼容时递增
+const formatVersion = 1
+
+// maxLineSize 快照文件单行最大长度
+const maxLineSize = 4 * 1024 * 1024
+
+// 快照中的表，按外键依赖顺序写出和导入
+const (
+	TableChains  = "chains"
+	TableTokens  = "tokens"
+	TableUsers   = "users"
+	TableWallets = "wallets"
+	TableCredits = "credits"
+)
+
+var (
+	// ErrInvalidSnapshot 快照文件格式不正确或版本不支持
+	ErrInvalidSnapshot = errors.New("invalid snapshot")
+)
+
+// header 快照文件第一行
+type header struct {
+	Version         int       `json:"version"`
+	CreatedAt       time.Time `json:"created_at"`
+	PreserveAmounts bool      `json:"preserve_amounts"`
+}
+
+// record 快照文件中的一行记录
+type record struct {
+	Table string          `json:"table"`
+	Row   json.RawMessage `json:"row"`
+}
+
+// encoder 以 JSON Lines 格式写出快照
+type encoder struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func newEncoder(w io.Writer) *encoder {
+	bw := bufio.NewWriter(w)
+	return &encoder{w: bw, enc: json.NewEncoder(bw)}
+}
+
+func (e *encoder) writeHeader(h *header) error {
+	return errors.Wrap(e.enc.Encode(h), "failed to write snapshot header")
+}
+
+func (e *encoder) writeRow(table string, row interface{}) error {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal %s row", table)
+	}
+
+	return errors.Wrapf(e.enc.Encode(&record{Table: table, Row: data}), "failed to write %s row", table)
+}
+
+func (e *encoder) flush() error {
+	return errors.Wrap(e.w.Flush(), "failed to flush snapshot")
+}
+
+// decoder 逐行读取快照，不一次性加载整个文件
+type decoder struct {
+	scanner *bufio.Scanner
+}
+
+func newDecoder(r io.Reader) *decoder {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
+	return &decoder{scanner: scanner}
+}
+
+func (d *decoder) readHeader() (*header, error) {
+	if !d.scanner.Scan() {
+		if err := d.scanner.Err(); err != nil {
+			return nil, errors.Wrap(err, "failed to read snapshot header")
+		}
+		return nil, errors.Wrap(ErrInvalidSnapshot, "empty snapshot")
+	}
+
+	var h header
+	if err := json.Unmarshal(d.scanner.Bytes(), &h); err != nil {
+		return nil, errors.Wrap(ErrInvalidSnapshot, "malformed snapshot header")
+	}
+	if h.Version != formatVersion {
+		return nil, errors.Wrapf(ErrInvalidSnapshot, "unsupported snapshot version %d", h.Version)
+	}
+
+	return &h, nil
+}
+
+// next 读取下一条记录，读完时返回 io.EOF
+func (d *decoder) next() (*record, error) {
+	for d.scanner.Scan() {
+		line := d.scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var rec record
+		if err := json.Unmarshal(line, &rec); err != nil {
+			return nil, errors.Wrap(ErrInvalidSnapshot, "malformed snapshot record")
+		}
+		return &rec, nil
+	}
+	if err := d.scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read snapshot")
+	}
+
+	return nil, io.EOF
+}
@@ -0,0 +1,386 @@
+package snapshot
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/util"
+	dbutil "github/chapool/go-wallet/internal/util/db"
+	"github/chapool/go-wallet/internal/wallet/address"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+)
+
+// batchSize 每批从数据库读取的记录数，导出时不一次性加载全部记录
+const batchSize = 1000
+
+const (
+	seedSize = 64 // 一次性派生种子长度（BIP32 允许的最大长度）
+	saltSize = 32
+)
+
+// Service 钱包数据快照服务接口
+// 导出脱敏后的链、代币、用户、钱包和入账记录，导入到预发环境，
+// 用于在接近生产规模和分布的数据上验证迁移和性能，而不暴露用户信息和真实密钥
+type Service interface {
+	// Export 导出脱敏快照，每次导出使用新的随机种子和盐，不同快照之间的替换值不可关联
+	Export(ctx context.Context, w io.Writer, opts *ExportOptions) (*Result, error)
+
+	// Import 在一个数据库事务中导入快照，已存在的记录（任一唯一约束冲突）保持不变
+	Import(ctx context.Context, r io.Reader) (*Result, error)
+}
+
+// ExportOptions 导出选项
+type ExportOptions struct {
+	PreserveAmounts bool // 保留入账金额，默认金额替换为 0
+}
+
+// Result 每张表导出或导入的记录数，导入时用户、钱包和入账记录包含已存在而跳过的记录
+type Result struct {
+	Rows map[string]int64
+}
+
+type service struct {
+	db             *sql.DB
+	addressService address.Service
+}
+
+// NewService 创建快照服务
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(db *sql.DB, addressService address.Service) Service {
+	return &service{
+		db:             db,
+		addressService: addressService,
+	}
+}
+
+// Export 导出脱敏快照
+func (s *service) Export(ctx context.Context, w io.Writer, opts *ExportOptions) (*Result, error) {
+	seed := make([]byte, seedSize)
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, errors.Wrap(err, "failed to generate seed")
+	}
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.Wrap(err, "failed to generate salt")
+	}
+	// 种子和盐只存在于本次导出，导出后清除
+	defer func() {
+		for i := range seed {
+			seed[i] = 0
+		}
+		for i := range salt {
+			salt[i] = 0
+		}
+	}()
+
+	anon := newAnonymizer(s.addressService, seed, salt, opts.PreserveAmounts)
+	enc := newEncoder(w)
+	result := &Result{Rows: make(map[string]int64)}
+
+	if err := enc.writeHeader(&header{
+		Version:         formatVersion,
+		CreatedAt:       time.Now().UTC(),
+		PreserveAmounts: opts.PreserveAmounts,
+	}); err != nil {
+		return nil, err
+	}
+
+	chains, err := models.Chains(qm.OrderBy(models.ChainColumns.ID)).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load chains")
+	}
+	for _, chain := range chains {
+		anon.chain(chain)
+		if err := enc.writeRow(TableChains, chain); err != nil {
+			return nil, err
+		}
+	}
+	result.Rows[TableChains] = int64(len(chains))
+
+	tokens, err := models.Tokens(qm.OrderBy(models.TokenColumns.ID)).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load tokens")
+	}
+	for _, token := range tokens {
+		if err := enc.writeRow(TableTokens, token); err != nil {
+			return nil, err
+		}
+	}
+	result.Rows[TableTokens] = int64(len(tokens))
+
+	if result.Rows[TableUsers], err = s.exportUsers(ctx, enc, anon); err != nil {
+		return nil, err
+	}
+	if result.Rows[TableWallets], err = s.exportWallets(ctx, enc, anon); err != nil {
+		return nil, err
+	}
+	if result.Rows[TableCredits], err = s.exportCredits(ctx, enc, anon); err != nil {
+		return nil, err
+	}
+
+	if err := enc.flush(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *service) exportUsers(ctx context.Context, enc *encoder, anon *anonymizer) (int64, error) {
+	var count int64
+	lastID := ""
+	for {
+		mods := []qm.QueryMod{
+			qm.OrderBy(models.UserColumns.ID),
+			qm.Limit(batchSize),
+		}
+		if lastID != "" {
+			mods = append(mods, models.UserWhere.ID.GT(lastID))
+		}
+
+		users, err := models.Users(mods...).All(ctx, s.db)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to query users")
+		}
+
+		for _, user := range users {
+			anon.user(user)
+			if err := enc.writeRow(TableUsers, user); err != nil {
+				return 0, err
+			}
+		}
+		count += int64(len(users))
+
+		if len(users) < batchSize {
+			return count, nil
+		}
+		lastID = users[len(users)-1].ID
+	}
+}
+
+func (s *service) exportWallets(ctx context.Context, enc *encoder, anon *anonymizer) (int64, error) {
+	var count int64
+	lastID := ""
+	for {
+		mods := []qm.QueryMod{
+			qm.OrderBy(models.WalletColumns.ID),
+			qm.Limit(batchSize),
+		}
+		if lastID != "" {
+			mods = append(mods, models.WalletWhere.ID.GT(lastID))
+		}
+
+		wallets, err := models.Wallets(mods...).All(ctx, s.db)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to query wallets")
+		}
+
+		for _, wallet := range wallets {
+			if err := anon.wallet(ctx, wallet); err != nil {
+				return 0, err
+			}
+			if err := enc.writeRow(TableWallets, wallet); err != nil {
+				return 0, err
+			}
+		}
+		count += int64(len(wallets))
+
+		if len(wallets) < batchSize {
+			return count, nil
+		}
+		lastID = wallets[len(wallets)-1].ID
+	}
+}
+
+func (s *service) exportCredits(ctx context.Context, enc *encoder, anon *anonymizer) (int64, error) {
+	var count int64
+	lastID := ""
+	for {
+		mods := []qm.QueryMod{
+			qm.OrderBy(models.CreditColumns.ID),
+			qm.Limit(batchSize),
+		}
+		if lastID != "" {
+			mods = append(mods, models.CreditWhere.ID.GT(lastID))
+		}
+
+		credits, err := models.Credits(mods...).All(ctx, s.db)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to query credits")
+		}
+
+		for _, credit := range credits {
+			anon.credit(credit)
+			if err := enc.writeRow(TableCredits, credit); err != nil {
+				return 0, err
+			}
+		}
+		count += int64(len(credits))
+
+		if len(credits) < batchSize {
+			return count, nil
+		}
+		lastID = credits[len(credits)-1].ID
+	}
+}
+
+// Import 导入快照
+// 链按 chain_id、代币按链和合约地址匹配已有记录，代币 ID 按匹配结果重新映射；
+// 新增的链没有节点地址，以停用状态导入
+func (s *service) Import(ctx context.Context, r io.Reader) (*Result, error) {
+	log := util.LogFromContext(ctx)
+
+	dec := newDecoder(r)
+	h, err := dec.readHeader()
+	if err != nil {
+		return nil, err
+	}
+	log.Info().Time("created_at", h.CreatedAt).Bool("preserve_amounts", h.PreserveAmounts).Msg("Importing wallet snapshot")
+
+	// 保留快照中的创建和更新时间
+	ctx = boil.SkipTimestamps(ctx)
+
+	result := &Result{Rows: make(map[string]int64)}
+	err = dbutil.WithTransaction(ctx, s.db, func(tx boil.ContextExecutor) error {
+		imp := &importer{
+			tx:       tx,
+			tokenIDs: make(map[int]int),
+			result:   result,
+		}
+		for {
+			rec, err := dec.next()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := imp.importRecord(ctx, rec); err != nil {
+				return err
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// importer 一次导入的状态
+type importer struct {
+	tx       boil.ContextExecutor
+	tokenIDs map[int]int // 快照中的代币 ID -> 目标库中的代币 ID
+	result   *Result
+}
+
+func (i *importer) importRecord(ctx context.Context, rec *record) error {
+	switch rec.Table {
+	case TableChains:
+		var chain models.Chain
+		if err := json.Unmarshal(rec.Row, &chain); err != nil {
+			return errors.Wrap(ErrInvalidSnapshot, "malformed chain row")
+		}
+		return i.importChain(ctx, &chain)
+	case TableTokens:
+		var token models.Token
+		if err := json.Unmarshal(rec.Row, &token); err != nil {
+			return errors.Wrap(ErrInvalidSnapshot, "malformed token row")
+		}
+		return i.importToken(ctx, &token)
+	case TableUsers:
+		var user models.User
+		if err := json.Unmarshal(rec.Row, &user); err != nil {
+			return errors.Wrap(ErrInvalidSnapshot, "malformed user row")
+		}
+		if err := user.Upsert(ctx, i.tx, false, nil, boil.None(), boil.Infer()); err != nil {
+			return errors.Wrapf(err, "failed to insert user %s", user.ID)
+		}
+		i.result.Rows[TableUsers]++
+		return nil
+	case TableWallets:
+		var wallet models.Wallet
+		if err := json.Unmarshal(rec.Row, &wallet); err != nil {
+			return errors.Wrap(ErrInvalidSnapshot, "malformed wallet row")
+		}
+		if err := wallet.Upsert(ctx, i.tx, false, nil, boil.None(), boil.Infer()); err != nil {
+			return errors.Wrapf(err, "failed to insert wallet %s", wallet.ID)
+		}
+		i.result.Rows[TableWallets]++
+		return nil
+	case TableCredits:
+		var credit models.Credit
+		if err := json.Unmarshal(rec.Row, &credit); err != nil {
+			return errors.Wrap(ErrInvalidSnapshot, "malformed credit row")
+		}
+		tokenID, ok := i.tokenIDs[credit.TokenID]
+		if !ok {
+			return errors.Wrapf(ErrInvalidSnapshot, "credit %s references unknown token %d", credit.ID, credit.TokenID)
+		}
+		credit.TokenID = tokenID
+		if err := credit.Upsert(ctx, i.tx, false, nil, boil.None(), boil.Infer()); err != nil {
+			return errors.Wrapf(err, "failed to insert credit %s", credit.ID)
+		}
+		i.result.Rows[TableCredits]++
+		return nil
+	default:
+		return errors.Wrapf(ErrInvalidSnapshot, "unknown table %q", rec.Table)
+	}
+}
+
+func (i *importer) importChain(ctx context.Context, chain *models.Chain) error {
+	exists, err := models.Chains(models.ChainWhere.ChainID.EQ(chain.ChainID)).Exists(ctx, i.tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to check chain")
+	}
+	if exists {
+		return nil
+	}
+
+	chain.ID = 0
+	chain.IsActive = false
+	if err := chain.Insert(ctx, i.tx, boil.Infer()); err != nil {
+		return errors.Wrapf(err, "failed to insert chain %d", chain.ChainID)
+	}
+	i.result.Rows[TableChains]++
+	return nil
+}
+
+func (i *importer) importToken(ctx context.Context, token *models.Token) error {
+	snapshotID := token.ID
+
+	mods := []qm.QueryMod{
+		models.TokenWhere.ChainID.EQ(token.ChainID),
+	}
+	if token.IsNative {
+		mods = append(mods, models.TokenWhere.IsNative.EQ(true))
+	} else {
+		mods = append(mods, qm.Where("LOWER("+models.TokenColumns.TokenAddress+") = ?", strings.ToLower(token.TokenAddress.String)))
+	}
+
+	existing, err := models.Tokens(mods...).One(ctx, i.tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return errors.Wrap(err, "failed to find token")
+	}
+	if existing != nil {
+		i.tokenIDs[snapshotID] = existing.ID
+		return nil
+	}
+
+	token.ID = 0
+	if err := token.Insert(ctx, i.tx, boil.Infer()); err != nil {
+		return errors.Wrapf(err, "failed to insert token %s on chain %d", token.TokenSymbol, token.ChainID)
+	}
+	i.tokenIDs[snapshotID] = token.ID
+	i.result.Rows[TableTokens]++
+	return nil
+}
@@ -0,0 +1,78 @@
+package solana
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// base58Alphabet Bitcoin/Solana 使用的 base58 字母表
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var (
+	base58Radix   = big.NewInt(58)
+	base58Indexes = func() [256]int {
+		var indexes [256]int
+		for i := range indexes {
+			indexes[i] = -1
+		}
+		for i := 0; i < len(base58Alphabet); i++ {
+			indexes[base58Alphabet[i]] = i
+		}
+		return indexes
+	}()
+)
+
+// EncodeBase58 base58 编码（前导零字节编码为 '1'）
+func EncodeBase58(data []byte) string {
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+
+	n := new(big.Int).SetBytes(data)
+	mod := new(big.Int)
+	encoded := make([]byte, 0, len(data)*138/100+1)
+	for n.Sign() > 0 {
+		n.DivMod(n, base58Radix, mod)
+		encoded = append(encoded, base58Alphabet[mod.Int64()])
+	}
+	for i := 0; i < zeros; i++ {
+		encoded = append(encoded, base58Alphabet[0])
+	}
+
+	// 反转为大端序
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+
+	return string(encoded)
+}
+
+// DecodeBase58 base58 解码
+func DecodeBase58(s string) ([]byte, error) {
+	if s == "" {
+		return nil, errors.New("empty base58 string")
+	}
+
+	n := new(big.Int)
+	for i := 0; i < len(s); i++ {
+		index := base58Indexes[s[i]]
+		if index < 0 {
+			return nil, errors.Errorf("invalid base58 character %q", s[i])
+		}
+		n.Mul(n, base58Radix)
+		n.Add(n, big.NewInt(int64(index)))
+	}
+
+	zeros := 0
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+
+	decoded := n.Bytes()
+	result := make([]byte, zeros+len(decoded))
+	copy(result[zeros:], decoded)
+
+	return result, nil
+}
@@ -0,0 +1,322 @@
+package solana
+
+import (
+	"context"
+	"encoding/base64"
+	"slices"
+	"strconv"
+	"sync"
+
+	walletMetrics "github/chapool/go-wallet/internal/metrics/wallet"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// Commitment 查询使用的确认级别
+const (
+	CommitmentConfirmed = "confirmed"
+	CommitmentFinalized = "finalized"
+)
+
+// 节点返回的区块不可用错误码
+const (
+	errCodeBlockNotAvailable          = -32004
+	errCodeSlotSkipped                = -32007
+	errCodeLongTermStorageSlotSkipped = -32009
+)
+
+// ErrSlotSkipped slot 没有产出区块
+var ErrSlotSkipped = errors.New("slot was skipped")
+
+// Client Solana JSON-RPC 客户端，支持多个 URL 和故障转移
+// RPC URL 列表可以通过 Reload 在运行时替换
+type Client struct {
+	chainID int
+	urls    []string
+	clients []*rpc.Client
+	mu      sync.RWMutex
+	current int
+	// calls 使用当前这组连接的进行中调用，Reload 后等待其完成再关闭旧连接
+	calls *sync.WaitGroup
+}
+
+// NewClient 创建 Solana RPC 客户端
+func NewClient(chainID int, urls []string) (*Client, error) {
+	clients, err := dialClients(urls)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		chainID: chainID,
+		urls:    urls,
+		clients: clients,
+		calls:   &sync.WaitGroup{},
+	}, nil
+}
+
+// Reload 替换 RPC URL 列表，URL 列表未变化时不做任何操作，返回是否已替换
+// 新的节点全部连接失败时保留原有节点；旧连接在进行中的调用完成后关闭
+func (c *Client) Reload(urls []string) (bool, error) {
+	c.mu.RLock()
+	unchanged := slices.Equal(c.urls, urls)
+	c.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	clients, err := dialClients(urls)
+	if err != nil {
+		return false, err
+	}
+
+	c.mu.Lock()
+	oldClients, oldCalls := c.clients, c.calls
+	c.urls, c.clients, c.current, c.calls = urls, clients, 0, &sync.WaitGroup{}
+	c.mu.Unlock()
+
+	go func() {
+		oldCalls.Wait()
+		for _, client := range oldClients {
+			client.Close()
+		}
+		log.Debug().Int("chain_id", c.chainID).Msg("Closed Solana RPC connections replaced by reload")
+	}()
+
+	return true, nil
+}
+
+// dialClients 连接所有 RPC 节点，跳过连接失败的节点
+func dialClients(urls []string) ([]*rpc.Client, error) {
+	if len(urls) == 0 {
+		return nil, errors.New("at least one RPC URL is required")
+	}
+
+	clients := make([]*rpc.Client, 0, len(urls))
+	for _, url := range urls {
+		client, err := rpc.Dial(url)
+		if err != nil {
+			log.Warn().
+				Str("url", url).
+				Err(err).
+				Msg("Failed to connect to Solana RPC node, skipping")
+			continue
+		}
+		clients = append(clients, client)
+	}
+
+	if len(clients) == 0 {
+		return nil, errors.New("failed to connect to any Solana RPC node")
+	}
+
+	return clients, nil
+}
+
+// Close 关闭所有客户端连接
+func (c *Client) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, client := range c.clients {
+		client.Close()
+	}
+}
+
+// CurrentEndpoint 当前使用的 RPC 节点索引
+func (c *Client) CurrentEndpoint() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.current
+}
+
+// EndpointCount RPC 节点数量
+func (c *Client) EndpointCount() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.clients)
+}
+
+// call 调用 RPC 方法，节点不可用时依次尝试其他节点；节点返回的业务错误不触发切换
+func (c *Client) call(ctx context.Context, result any, method string, args ...any) error {
+	c.mu.RLock()
+	current, clients, calls := c.current, c.clients, c.calls
+	calls.Add(1)
+	c.mu.RUnlock()
+	defer calls.Done()
+
+	var lastErr error
+	for i := 0; i < len(clients); i++ {
+		idx := (current + i) % len(clients)
+
+		err := clients[idx].CallContext(ctx, result, method, args...)
+		if err == nil {
+			if idx != current {
+				c.mu.Lock()
+				// 期间 URL 列表被替换时不更新
+				if c.calls == calls {
+					c.current = idx
+				}
+				c.mu.Unlock()
+				walletMetrics.RPCFailovers.WithLabelValues(walletMetrics.ChainLabel(c.chainID)).Inc()
+			}
+			return nil
+		}
+
+		var rpcErr rpc.Error
+		if errors.As(err, &rpcErr) {
+			return err
+		}
+
+		walletMetrics.RPCErrors.WithLabelValues(walletMetrics.ChainLabel(c.chainID), method).Inc()
+		lastErr = err
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return errors.Wrapf(lastErr, "failed to call %s", method)
+}
+
+// GetSlot 获取指定确认级别下的最新 slot
+func (c *Client) GetSlot(ctx context.Context, commitment string) (uint64, error) {
+	var slot uint64
+	if err := c.call(ctx, &slot, "getSlot", map[string]any{"commitment": commitment}); err != nil {
+		return 0, errors.Wrap(err, "failed to get slot")
+	}
+	return slot, nil
+}
+
+// GetBlock 获取 slot 对应的区块（jsonParsed 编码），slot 被跳过时返回 ErrSlotSkipped
+func (c *Client) GetBlock(ctx context.Context, slot uint64) (*Block, error) {
+	var block *Block
+	err := c.call(ctx, &block, "getBlock", slot, map[string]any{
+		"commitment":                     CommitmentConfirmed,
+		"encoding":                       "jsonParsed",
+		"transactionDetails":             "full",
+		"rewards":                        false,
+		"maxSupportedTransactionVersion": 0,
+	})
+	if err != nil {
+		var rpcErr rpc.Error
+		if errors.As(err, &rpcErr) {
+			switch rpcErr.ErrorCode() {
+			case errCodeSlotSkipped, errCodeLongTermStorageSlotSkipped:
+				return nil, ErrSlotSkipped
+			case errCodeBlockNotAvailable:
+				return nil, errors.Wrapf(err, "block at slot %d is not available yet", slot)
+			}
+		}
+		return nil, errors.Wrapf(err, "failed to get block at slot %d", slot)
+	}
+	if block == nil {
+		return nil, ErrSlotSkipped
+	}
+
+	return block, nil
+}
+
+// GetLatestBlockhash 获取最新的 blockhash（用于构建交易）
+func (c *Client) GetLatestBlockhash(ctx context.Context) (string, error) {
+	var result struct {
+		Value struct {
+			Blockhash string `json:"blockhash"`
+		} `json:"value"`
+	}
+	if err := c.call(ctx, &result, "getLatestBlockhash", map[string]any{"commitment": CommitmentFinalized}); err != nil {
+		return "", errors.Wrap(err, "failed to get latest blockhash")
+	}
+	return result.Value.Blockhash, nil
+}
+
+// SendTransaction 广播已签名的交易，返回交易签名
+func (c *Client) SendTransaction(ctx context.Context, rawTransaction []byte) (string, error) {
+	var signature string
+	err := c.call(ctx, &signature, "sendTransaction", base64.StdEncoding.EncodeToString(rawTransaction), map[string]any{
+		"encoding":            "base64",
+		"preflightCommitment": CommitmentConfirmed,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to send transaction")
+	}
+	return signature, nil
+}
+
+// GetSignatureStatus 查询交易状态，交易尚未上链时返回 nil
+func (c *Client) GetSignatureStatus(ctx context.Context, signature string) (*SignatureStatus, error) {
+	var result struct {
+		Value []*SignatureStatus `json:"value"`
+	}
+	err := c.call(ctx, &result, "getSignatureStatuses", []string{signature}, map[string]any{
+		"searchTransactionHistory": true,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get signature status")
+	}
+	if len(result.Value) == 0 {
+		return nil, nil //nolint:nilnil // 交易尚未上链
+	}
+	return result.Value[0], nil
+}
+
+// GetBalance 查询账户 SOL 余额（lamports）
+func (c *Client) GetBalance(ctx context.Context, address PublicKey) (uint64, error) {
+	var result struct {
+		Value uint64 `json:"value"`
+	}
+	if err := c.call(ctx, &result, "getBalance", address.String(), map[string]any{"commitment": CommitmentConfirmed}); err != nil {
+		return 0, errors.Wrap(err, "failed to get balance")
+	}
+	return result.Value, nil
+}
+
+// HasTransactions 查询地址是否有过交易（getSignaturesForAddress，只取最近一笔）
+func (c *Client) HasTransactions(ctx context.Context, address PublicKey) (bool, error) {
+	var result []struct {
+		Signature string `json:"signature"`
+	}
+	err := c.call(ctx, &result, "getSignaturesForAddress", address.String(), map[string]any{
+		"limit":      1,
+		"commitment": CommitmentConfirmed,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get signatures for address")
+	}
+	return len(result) > 0, nil
+}
+
+// GetTokenBalance 查询钱包在指定 mint 下关联代币账户（提现的转出账户）的余额（原始单位），账户不存在时返回 0
+func (c *Client) GetTokenBalance(ctx context.Context, owner, mint PublicKey) (uint64, error) {
+	ata, err := FindAssociatedTokenAddress(owner, mint)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.getTokenAccountBalance(ctx, ata)
+}
+
+// getTokenAccountBalance 查询代币账户余额，账户不存在时返回 0
+func (c *Client) getTokenAccountBalance(ctx context.Context, account PublicKey) (uint64, error) {
+	var result struct {
+		Value *TokenAmount `json:"value"`
+	}
+	err := c.call(ctx, &result, "getTokenAccountBalance", account.String(), map[string]any{"commitment": CommitmentConfirmed})
+	if err != nil {
+		var rpcErr rpc.Error
+		if errors.As(err, &rpcErr) {
+			// 账户不存在（未创建关联代币账户）
+			return 0, nil
+		}
+		return 0, errors.Wrap(err, "failed to get token account balance")
+	}
+	if result.Value == nil {
+		return 0, nil
+	}
+
+	amount, err := strconv.ParseUint(result.Value.Amount, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid token amount %s", result.Value.Amount)
+	}
+	return amount, nil
+}
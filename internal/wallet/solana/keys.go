@@ -0,0 +1,185 @@
+package solana
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// PublicKeyLength 公钥（地址）字节长度
+const PublicKeyLength = 32
+
+// hardenedOffset SLIP-0010 ed25519 只支持硬化派生
+const hardenedOffset = 0x80000000
+
+// maxSeedLength PDA 单个 seed 的最大长度
+const maxSeedLength = 32
+
+// PublicKey Solana 公钥（账户地址）
+type PublicKey [PublicKeyLength]byte
+
+// 程序地址
+var (
+	SystemProgramID                 = MustParsePublicKey("11111111111111111111111111111111")
+	TokenProgramID                  = MustParsePublicKey("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+	AssociatedTokenAccountProgramID = MustParsePublicKey("ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL")
+)
+
+// ParsePublicKey 解析 base58 编码的地址
+func ParsePublicKey(address string) (PublicKey, error) {
+	var key PublicKey
+
+	decoded, err := DecodeBase58(address)
+	if err != nil {
+		return key, errors.Wrapf(err, "invalid solana address %s", address)
+	}
+	if len(decoded) != PublicKeyLength {
+		return key, errors.Errorf("invalid solana address %s: expected %d bytes, got %d", address, PublicKeyLength, len(decoded))
+	}
+
+	copy(key[:], decoded)
+	return key, nil
+}
+
+// MustParsePublicKey 解析地址，失败时 panic（仅用于常量）
+func MustParsePublicKey(address string) PublicKey {
+	key, err := ParsePublicKey(address)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// IsValidAddress 判断是否为合法的 Solana 地址
+func IsValidAddress(address string) bool {
+	_, err := ParsePublicKey(address)
+	return err == nil
+}
+
+// String base58 编码的地址
+func (k PublicKey) String() string {
+	return EncodeBase58(k[:])
+}
+
+// PublicKeyFromPrivateKey 获取私钥对应的公钥
+func PublicKeyFromPrivateKey(privateKey ed25519.PrivateKey) PublicKey {
+	var key PublicKey
+	copy(key[:], privateKey.Public().(ed25519.PublicKey))
+	return key
+}
+
+// DeriveKey 按 SLIP-0010 从种子派生 ed25519 私钥（32 字节种子形式）
+// indices 必须全部为硬化索引，例如 m/44'/501'/0'/0'
+// WARNING: 调用方需要在使用后清除私钥
+func DeriveKey(seed []byte, indices []uint32) ([]byte, error) {
+	mac := hmac.New(sha512.New, []byte("ed25519 seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	key, chainCode := sum[:32], sum[32:]
+
+	for _, index := range indices {
+		if index < hardenedOffset {
+			return nil, errors.Errorf("ed25519 only supports hardened derivation, got index %d", index)
+		}
+
+		data := make([]byte, 0, 1+32+4)
+		data = append(data, 0)
+		data = append(data, key...)
+		data = binary.BigEndian.AppendUint32(data, index)
+
+		mac = hmac.New(sha512.New, chainCode)
+		mac.Write(data)
+		sum = mac.Sum(nil)
+
+		for i := range data {
+			data[i] = 0
+		}
+		key, chainCode = sum[:32], sum[32:]
+	}
+
+	return key, nil
+}
+
+// FindProgramAddress 计算程序派生地址（PDA），返回地址和 bump seed
+func FindProgramAddress(seeds [][]byte, programID PublicKey) (PublicKey, uint8, error) {
+	for _, seed := range seeds {
+		if len(seed) > maxSeedLength {
+			return PublicKey{}, 0, errors.New("seed exceeds maximum length")
+		}
+	}
+
+	for bump := 255; bump >= 0; bump-- {
+		hash := sha256.New()
+		for _, seed := range seeds {
+			hash.Write(seed)
+		}
+		hash.Write([]byte{byte(bump)})
+		hash.Write(programID[:])
+		hash.Write([]byte("ProgramDerivedAddress"))
+
+		var address PublicKey
+		copy(address[:], hash.Sum(nil))
+		if !isOnCurve(address[:]) {
+			return address, uint8(bump), nil
+		}
+	}
+
+	return PublicKey{}, 0, errors.New("unable to find a viable program address")
+}
+
+// FindAssociatedTokenAddress 计算钱包在指定 mint 下的关联代币账户（ATA）地址
+func FindAssociatedTokenAddress(owner, mint PublicKey) (PublicKey, error) {
+	address, _, err := FindProgramAddress([][]byte{owner[:], TokenProgramID[:], mint[:]}, AssociatedTokenAccountProgramID)
+	return address, err
+}
+
+var (
+	// curveP ed25519 基域素数 2^255 - 19
+	curveP = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(19))
+	// curveD 曲线参数 d = -121665/121666 mod p
+	curveD = func() *big.Int {
+		d := new(big.Int).ModInverse(big.NewInt(121666), curveP)
+		d.Mul(d, big.NewInt(-121665))
+		return d.Mod(d, curveP)
+	}()
+	// legendreExp (p-1)/2，用于欧拉判别法
+	legendreExp = new(big.Int).Rsh(new(big.Int).Sub(curveP, big.NewInt(1)), 1)
+)
+
+// isOnCurve 判断 32 字节是否为合法的 ed25519 压缩点（与 curve25519-dalek 的 decompress 行为一致）
+// x^2 = (y^2 - 1) / (d*y^2 + 1) 有解即在曲线上
+func isOnCurve(point []byte) bool {
+	// 小端序，最高位为 x 的符号位
+	reversed := make([]byte, len(point))
+	for i := range point {
+		reversed[len(point)-1-i] = point[i]
+	}
+	reversed[0] &= 0x7f
+
+	y := new(big.Int).SetBytes(reversed)
+	y.Mod(y, curveP)
+
+	y2 := new(big.Int).Mul(y, y)
+	y2.Mod(y2, curveP)
+
+	u := new(big.Int).Sub(y2, big.NewInt(1))
+	u.Mod(u, curveP)
+
+	v := new(big.Int).Mul(curveD, y2)
+	v.Add(v, big.NewInt(1))
+	v.Mod(v, curveP)
+
+	// u/v 与 u*v 的二次剩余性相同
+	uv := new(big.Int).Mul(u, v)
+	uv.Mod(uv, curveP)
+	if uv.Sign() == 0 {
+		return true
+	}
+
+	return new(big.Int).Exp(uv, legendreExp, curveP).Cmp(big.NewInt(1)) == 0
+}
@@ -0,0 +1,232 @@
+package solana
+
+import (
+	"crypto/ed25519"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// SignatureLength 交易签名字节长度
+	SignatureLength = 64
+
+	// LamportsPerSignature 每个签名的基础手续费（lamports）
+	LamportsPerSignature = 5000
+	// TokenAccountRentExemptLamports 创建代币账户（165 字节）所需的免租金押金（lamports）
+	TokenAccountRentExemptLamports = 2039280
+
+	// systemInstructionTransfer System Program Transfer 指令编号
+	systemInstructionTransfer = 2
+	// tokenInstructionTransferChecked Token Program TransferChecked 指令编号
+	tokenInstructionTransferChecked = 12
+	// ataInstructionCreateIdempotent Associated Token Account Program CreateIdempotent 指令编号
+	ataInstructionCreateIdempotent = 1
+)
+
+// AccountMeta 指令引用的账户
+type AccountMeta struct {
+	PublicKey  PublicKey
+	IsSigner   bool
+	IsWritable bool
+}
+
+// Instruction 交易指令
+type Instruction struct {
+	ProgramID PublicKey
+	Accounts  []AccountMeta
+	Data      []byte
+}
+
+// Transaction 未签名的 legacy 交易
+type Transaction struct {
+	// Message 待签名的序列化消息
+	Message []byte
+	// Signers 需要签名的账户（按消息中的顺序）
+	Signers []PublicKey
+}
+
+// SystemTransfer 构建 SOL 转账指令（lamports）
+func SystemTransfer(from, to PublicKey, lamports uint64) Instruction {
+	data := binary.LittleEndian.AppendUint32(nil, systemInstructionTransfer)
+	data = binary.LittleEndian.AppendUint64(data, lamports)
+
+	return Instruction{
+		ProgramID: SystemProgramID,
+		Accounts: []AccountMeta{
+			{PublicKey: from, IsSigner: true, IsWritable: true},
+			{PublicKey: to, IsWritable: true},
+		},
+		Data: data,
+	}
+}
+
+// CreateAssociatedTokenAccountIdempotent 构建创建关联代币账户指令，账户已存在时不报错
+func CreateAssociatedTokenAccountIdempotent(payer, owner, mint PublicKey) (Instruction, error) {
+	ata, err := FindAssociatedTokenAddress(owner, mint)
+	if err != nil {
+		return Instruction{}, err
+	}
+
+	return Instruction{
+		ProgramID: AssociatedTokenAccountProgramID,
+		Accounts: []AccountMeta{
+			{PublicKey: payer, IsSigner: true, IsWritable: true},
+			{PublicKey: ata, IsWritable: true},
+			{PublicKey: owner},
+			{PublicKey: mint},
+			{PublicKey: SystemProgramID},
+			{PublicKey: TokenProgramID},
+		},
+		Data: []byte{ataInstructionCreateIdempotent},
+	}, nil
+}
+
+// TokenTransferChecked 构建 SPL 代币转账指令（原始单位），source/destination 为代币账户
+func TokenTransferChecked(source, mint, destination, owner PublicKey, amount uint64, decimals uint8) Instruction {
+	data := []byte{tokenInstructionTransferChecked}
+	data = binary.LittleEndian.AppendUint64(data, amount)
+	data = append(data, decimals)
+
+	return Instruction{
+		ProgramID: TokenProgramID,
+		Accounts: []AccountMeta{
+			{PublicKey: source, IsWritable: true},
+			{PublicKey: mint},
+			{PublicKey: destination, IsWritable: true},
+			{PublicKey: owner, IsSigner: true},
+		},
+		Data: data,
+	}
+}
+
+// NewTransaction 编译 legacy 交易消息，payer 为手续费支付账户（第一个签名者）
+func NewTransaction(instructions []Instruction, recentBlockhash string, payer PublicKey) (*Transaction, error) {
+	if len(instructions) == 0 {
+		return nil, errors.New("transaction requires at least one instruction")
+	}
+
+	blockhash, err := DecodeBase58(recentBlockhash)
+	if err != nil || len(blockhash) != PublicKeyLength {
+		return nil, errors.Errorf("invalid recent blockhash %s", recentBlockhash)
+	}
+
+	accounts := compileAccounts(instructions, payer)
+
+	indexes := make(map[PublicKey]int, len(accounts))
+	var numSigners, numReadonlySigned, numReadonlyUnsigned int
+	for i, account := range accounts {
+		indexes[account.PublicKey] = i
+		switch {
+		case account.IsSigner && !account.IsWritable:
+			numSigners++
+			numReadonlySigned++
+		case account.IsSigner:
+			numSigners++
+		case !account.IsWritable:
+			numReadonlyUnsigned++
+		}
+	}
+
+	message := []byte{byte(numSigners), byte(numReadonlySigned), byte(numReadonlyUnsigned)}
+	message = appendCompactU16(message, len(accounts))
+	for _, account := range accounts {
+		message = append(message, account.PublicKey[:]...)
+	}
+	message = append(message, blockhash...)
+
+	message = appendCompactU16(message, len(instructions))
+	for _, instruction := range instructions {
+		message = append(message, byte(indexes[instruction.ProgramID]))
+		message = appendCompactU16(message, len(instruction.Accounts))
+		for _, account := range instruction.Accounts {
+			message = append(message, byte(indexes[account.PublicKey]))
+		}
+		message = appendCompactU16(message, len(instruction.Data))
+		message = append(message, instruction.Data...)
+	}
+
+	signers := make([]PublicKey, 0, numSigners)
+	for _, account := range accounts[:numSigners] {
+		signers = append(signers, account.PublicKey)
+	}
+
+	return &Transaction{Message: message, Signers: signers}, nil
+}
+
+// Sign 使用签名者私钥签名，返回序列化的交易和交易签名（base58，即交易哈希）
+// privateKeys 按 Signers 的顺序提供
+func (t *Transaction) Sign(privateKeys ...ed25519.PrivateKey) ([]byte, string, error) {
+	if len(privateKeys) != len(t.Signers) {
+		return nil, "", errors.Errorf("transaction requires %d signatures, got %d keys", len(t.Signers), len(privateKeys))
+	}
+
+	raw := appendCompactU16(nil, len(t.Signers))
+	var firstSignature []byte
+	for i, privateKey := range privateKeys {
+		if PublicKeyFromPrivateKey(privateKey) != t.Signers[i] {
+			return nil, "", errors.Errorf("private key does not match signer %s", t.Signers[i])
+		}
+
+		signature := ed25519.Sign(privateKey, t.Message)
+		if i == 0 {
+			firstSignature = signature
+		}
+		raw = append(raw, signature...)
+	}
+	raw = append(raw, t.Message...)
+
+	return raw, EncodeBase58(firstSignature), nil
+}
+
+// compileAccounts 合并指令账户并排序：payer、可写签名者、只读签名者、可写非签名者、只读非签名者
+func compileAccounts(instructions []Instruction, payer PublicKey) []AccountMeta {
+	order := []PublicKey{payer}
+	merged := map[PublicKey]*AccountMeta{
+		payer: {PublicKey: payer, IsSigner: true, IsWritable: true},
+	}
+
+	add := func(meta AccountMeta) {
+		existing, ok := merged[meta.PublicKey]
+		if !ok {
+			order = append(order, meta.PublicKey)
+			merged[meta.PublicKey] = &meta
+			return
+		}
+		existing.IsSigner = existing.IsSigner || meta.IsSigner
+		existing.IsWritable = existing.IsWritable || meta.IsWritable
+	}
+
+	for _, instruction := range instructions {
+		for _, account := range instruction.Accounts {
+			add(account)
+		}
+		add(AccountMeta{PublicKey: instruction.ProgramID})
+	}
+
+	accounts := make([]AccountMeta, 0, len(order))
+	for _, group := range []struct{ signer, writable bool }{
+		{true, true}, {true, false}, {false, true}, {false, false},
+	} {
+		for _, key := range order {
+			account := merged[key]
+			if account.IsSigner == group.signer && account.IsWritable == group.writable {
+				accounts = append(accounts, *account)
+			}
+		}
+	}
+
+	return accounts
+}
+
+// appendCompactU16 追加 compact-u16 编码的长度
+func appendCompactU16(buf []byte, n int) []byte {
+	for {
+		b := byte(n & 0x7f)
+		n >>= 7
+		if n == 0 {
+			return append(buf, b)
+		}
+		buf = append(buf, b|0x80)
+	}
+}
@@ -0,0 +1,133 @@
+package solana
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// 节点解析指令时使用的程序名
+const (
+	parsedProgramSystem = "system"
+	parsedProgramToken  = "spl-token"
+)
+
+// Transfer 交易中的一笔 SOL 或 SPL 代币转账
+type Transfer struct {
+	From   string // 转出钱包地址（SPL 转账为转出代币账户的所有者）
+	To     string // 转入钱包地址（SPL 转账为转入代币账户的所有者）
+	Mint   string // SPL 代币 mint 地址，SOL 转账为空
+	Amount string // 原始单位（lamports 或代币最小单位）
+}
+
+// parsedInstruction 节点解析后的指令内容
+type parsedInstruction struct {
+	Type string `json:"type"`
+	Info struct {
+		Source      string       `json:"source"`
+		Destination string       `json:"destination"`
+		Lamports    uint64       `json:"lamports"`
+		Amount      string       `json:"amount"`
+		Mint        string       `json:"mint"`
+		Authority   string       `json:"authority"`
+		TokenAmount *TokenAmount `json:"tokenAmount"`
+	} `json:"info"`
+}
+
+// tokenAccount 代币账户的所有者和 mint
+type tokenAccount struct {
+	owner string
+	mint  string
+}
+
+// Signature 交易签名（交易哈希）
+func (t *BlockTransaction) Signature() string {
+	if len(t.Transaction.Signatures) == 0 {
+		return ""
+	}
+	return t.Transaction.Signatures[0]
+}
+
+// Transfers 解析成功交易中的 SOL 转账（System Program transfer）和 SPL 代币转账（transfer/transferChecked），包括跨程序调用产生的内部指令
+// 失败的交易返回空
+func (t *BlockTransaction) Transfers() []*Transfer {
+	if t.Meta == nil || !t.Meta.Succeeded() {
+		return nil
+	}
+
+	accounts := t.tokenAccounts()
+
+	instructions := append([]ParsedInstruction{}, t.Transaction.Message.Instructions...)
+	for _, inner := range t.Meta.InnerInstructions {
+		instructions = append(instructions, inner.Instructions...)
+	}
+
+	transfers := make([]*Transfer, 0)
+	for _, instruction := range instructions {
+		if len(instruction.Parsed) == 0 {
+			continue
+		}
+
+		var parsed parsedInstruction
+		if err := json.Unmarshal(instruction.Parsed, &parsed); err != nil {
+			// 部分指令的 parsed 字段为字符串（如 memo），跳过
+			continue
+		}
+
+		switch {
+		case instruction.Program == parsedProgramSystem && parsed.Type == "transfer":
+			if parsed.Info.Lamports == 0 {
+				continue
+			}
+			transfers = append(transfers, &Transfer{
+				From:   parsed.Info.Source,
+				To:     parsed.Info.Destination,
+				Amount: strconv.FormatUint(parsed.Info.Lamports, 10),
+			})
+
+		case instruction.Program == parsedProgramToken && (parsed.Type == "transfer" || parsed.Type == "transferChecked"):
+			destination, ok := accounts[parsed.Info.Destination]
+			if !ok {
+				continue
+			}
+
+			amount := parsed.Info.Amount
+			if parsed.Info.TokenAmount != nil {
+				amount = parsed.Info.TokenAmount.Amount
+			}
+			if amount == "" || amount == "0" {
+				continue
+			}
+
+			from := parsed.Info.Authority
+			if source, ok := accounts[parsed.Info.Source]; ok {
+				from = source.owner
+			}
+
+			transfers = append(transfers, &Transfer{
+				From:   from,
+				To:     destination.owner,
+				Mint:   destination.mint,
+				Amount: amount,
+			})
+		}
+	}
+
+	return transfers
+}
+
+// tokenAccounts 根据交易前后的代币余额获取涉及的代币账户的所有者和 mint
+func (t *BlockTransaction) tokenAccounts() map[string]tokenAccount {
+	accounts := make(map[string]tokenAccount)
+	keys := t.Transaction.Message.AccountKeys
+
+	for _, balances := range [][]TokenBalance{t.Meta.PreTokenBalances, t.Meta.PostTokenBalances} {
+		for _, balance := range balances {
+			if balance.AccountIndex < 0 || balance.AccountIndex >= len(keys) || balance.Owner == "" {
+				continue
+			}
+			accounts[keys[balance.AccountIndex].Pubkey] = tokenAccount{owner: balance.Owner, mint: balance.Mint}
+		}
+	}
+
+	return accounts
+}
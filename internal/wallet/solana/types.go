@@ -0,0 +1,93 @@
+package solana
+
+import (
+	"encoding/json"
+)
+
+// Block getBlock（jsonParsed 编码）返回的区块
+type Block struct {
+	Blockhash         string              `json:"blockhash"`
+	PreviousBlockhash string              `json:"previousBlockhash"`
+	ParentSlot        uint64              `json:"parentSlot"`
+	BlockTime         *int64              `json:"blockTime"`
+	Transactions      []*BlockTransaction `json:"transactions"`
+}
+
+// BlockTransaction 区块中的交易
+type BlockTransaction struct {
+	Meta        *TransactionMeta  `json:"meta"`
+	Transaction ParsedTransaction `json:"transaction"`
+}
+
+// TransactionMeta 交易执行结果
+type TransactionMeta struct {
+	Err               json.RawMessage     `json:"err"`
+	PreTokenBalances  []TokenBalance      `json:"preTokenBalances"`
+	PostTokenBalances []TokenBalance      `json:"postTokenBalances"`
+	InnerInstructions []InnerInstructions `json:"innerInstructions"`
+}
+
+// Succeeded 交易是否执行成功
+func (m *TransactionMeta) Succeeded() bool {
+	return len(m.Err) == 0 || string(m.Err) == "null"
+}
+
+// ParsedTransaction jsonParsed 编码的交易
+type ParsedTransaction struct {
+	Signatures []string      `json:"signatures"`
+	Message    ParsedMessage `json:"message"`
+}
+
+// ParsedMessage jsonParsed 编码的交易消息
+type ParsedMessage struct {
+	AccountKeys  []ParsedAccountKey  `json:"accountKeys"`
+	Instructions []ParsedInstruction `json:"instructions"`
+}
+
+// ParsedAccountKey 交易引用的账户
+type ParsedAccountKey struct {
+	Pubkey   string `json:"pubkey"`
+	Signer   bool   `json:"signer"`
+	Writable bool   `json:"writable"`
+}
+
+// InnerInstructions 跨程序调用产生的内部指令
+type InnerInstructions struct {
+	Index        int                 `json:"index"`
+	Instructions []ParsedInstruction `json:"instructions"`
+}
+
+// ParsedInstruction 节点解析后的指令，无法解析的指令 Parsed 为空
+type ParsedInstruction struct {
+	Program   string          `json:"program"`
+	ProgramID string          `json:"programId"`
+	Parsed    json.RawMessage `json:"parsed"`
+}
+
+// TokenBalance 交易前后代币账户余额
+type TokenBalance struct {
+	AccountIndex  int         `json:"accountIndex"`
+	Mint          string      `json:"mint"`
+	Owner         string      `json:"owner"`
+	ProgramID     string      `json:"programId"`
+	UITokenAmount TokenAmount `json:"uiTokenAmount"`
+}
+
+// TokenAmount 代币数量（原始单位字符串）
+type TokenAmount struct {
+	Amount   string `json:"amount"`
+	Decimals int    `json:"decimals"`
+}
+
+// SignatureStatus getSignatureStatuses 返回的交易状态
+type SignatureStatus struct {
+	Slot               uint64          `json:"slot"`
+	Confirmations      *uint64         `json:"confirmations"`
+	Err                json.RawMessage `json:"err"`
+	ConfirmationStatus string          `json:"confirmationStatus"`
+}
+
+// Succeeded 交易是否执行成功
+func (s *SignatureStatus) Succeeded() bool {
+	return len(s.Err) == 0 || string(s.Err) == "null"
+}
@@ -0,0 +1,316 @@
+//nolint:ireturn // 返回接口类型是预期的设计
+package statement
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/balance"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// dateLayout 快照日期格式（UTC）
+	dateLayout = "2006-01-02"
+)
+
+// Service 余额快照和对账单服务接口
+// 每天结束后（UTC）为每个用户每个代币写入当天结束时的余额快照，对账单和历史余额直接读取快照，无需汇总全部 credits
+type Service interface {
+	// GenerateSnapshots 为截至昨天（UTC）尚未生成快照的日期写入快照，返回本次生成的天数
+	GenerateSnapshots(ctx context.Context) (int, error)
+
+	// StartSnapshotGenerator 启动定时生成快照
+	StartSnapshotGenerator(ctx context.Context, interval time.Duration)
+
+	// GetStatement 获取用户某月（UTC）的对账单
+	GetStatement(ctx context.Context, userID string, month time.Time, filter *Filter) (*Statement, error)
+}
+
+// service 实现 Service 接口
+type service struct {
+	db *sql.DB
+}
+
+// NewService 创建余额快照和对账单服务
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(db *sql.DB) Service {
+	return &service{
+		db: db,
+	}
+}
+
+// StartSnapshotGenerator 启动定时生成快照，启动时先补齐缺少的日期
+func (s *service) StartSnapshotGenerator(ctx context.Context, interval time.Duration) {
+	log.Info().
+		Dur("interval", interval).
+		Msg("Starting balance snapshot generator")
+
+	lifecycle.Go(ctx, "balance snapshot generator", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			s.generateSnapshots(ctx)
+
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Balance snapshot generator stopped")
+				return
+			case <-ticker.C:
+			}
+		}
+	})
+}
+
+// generateSnapshots 执行一次生成并记录结果
+func (s *service) generateSnapshots(ctx context.Context) {
+	days, err := s.GenerateSnapshots(ctx)
+	if err != nil {
+		log.Error().Err(err).Int("days", days).Msg("Balance snapshot generation failed")
+		return
+	}
+	if days > 0 {
+		log.Info().Int("days", days).Msg("Balance snapshots generated")
+	}
+}
+
+// GenerateSnapshots 为截至昨天（UTC）尚未生成快照的日期写入快照
+// 从最后一个已生成日期的下一天开始，还没有快照时从第一条 credits 的日期开始
+func (s *service) GenerateSnapshots(ctx context.Context) (int, error) {
+	next, ok, err := s.nextSnapshotDate(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, nil
+	}
+
+	yesterday := utcDate(time.Now()).AddDate(0, 0, -1)
+	days := 0
+	for day := next; !day.After(yesterday); day = day.AddDate(0, 0, 1) {
+		// 停机时在日期之间停止，每天的快照在同一个数据库事务中写入
+		if ctx.Err() != nil {
+			break
+		}
+
+		generated, err := s.generateDay(ctx, day)
+		if err != nil {
+			return days, errors.Wrapf(err, "failed to generate balance snapshots for %s", day.Format(dateLayout))
+		}
+		if generated {
+			days++
+		}
+	}
+
+	return days, nil
+}
+
+// nextSnapshotDate 下一个需要生成快照的日期，没有 credits 时返回 ok = false
+func (s *service) nextSnapshotDate(ctx context.Context) (time.Time, bool, error) {
+	var last sql.NullString
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT MAX(snapshot_date)::text FROM balance_snapshot_days
+	`).Scan(&last); err != nil {
+		return time.Time{}, false, errors.Wrap(err, "failed to get last balance snapshot date")
+	}
+	if last.Valid {
+		day, err := time.Parse(dateLayout, last.String)
+		if err != nil {
+			return time.Time{}, false, errors.Wrapf(err, "failed to parse balance snapshot date: %s", last.String)
+		}
+		return day.AddDate(0, 0, 1), true, nil
+	}
+
+	var first sql.NullTime
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT MIN(created_at) FROM credits
+	`).Scan(&first); err != nil {
+		return time.Time{}, false, errors.Wrap(err, "failed to get first credit time")
+	}
+	if !first.Valid {
+		return time.Time{}, false, nil
+	}
+
+	return utcDate(first.Time), true, nil
+}
+
+// generateDay 写入某天的快照，该日期已由其他实例生成时返回 false
+// 余额为该日结束前创建的 credits 按当前状态汇总；余额为 0 且当天没有 credits 的代币不写快照
+func (s *service) generateDay(ctx context.Context, day time.Time) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	date := day.Format(dateLayout)
+
+	// 其他实例正在生成同一天时，插入会等待其事务结束后跳过
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO balance_snapshot_days (snapshot_date, snapshot_count)
+		VALUES ($1::date, 0)
+		ON CONFLICT (snapshot_date) DO NOTHING
+	`, date)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to insert balance snapshot day")
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return false, errors.Wrap(err, "failed to get affected rows")
+	} else if affected == 0 {
+		return false, nil
+	}
+
+	result, err = tx.ExecContext(ctx, `
+		INSERT INTO balance_snapshots (user_id, token_id, chain_id, snapshot_date, balance)
+		SELECT c.user_id, c.token_id, t.chain_id, $1::date,
+			COALESCE(SUM(CASE WHEN `+balance.EffectiveCreditSQL+` THEN c.amount::numeric ELSE 0 END), 0)::text
+		FROM credits c
+		INNER JOIN tokens t ON t.id = c.token_id
+		WHERE c.created_at < $3
+		GROUP BY c.user_id, c.token_id, t.chain_id
+		HAVING SUM(CASE WHEN `+balance.EffectiveCreditSQL+` THEN c.amount::numeric ELSE 0 END) <> 0
+			OR MAX(c.created_at) >= $2
+	`, date, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to insert balance snapshots")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get affected rows")
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE balance_snapshot_days SET snapshot_count = $2 WHERE snapshot_date = $1::date
+	`, date, count); err != nil {
+		return false, errors.Wrap(err, "failed to update balance snapshot day")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, errors.Wrap(err, "failed to commit transaction")
+	}
+
+	log.Debug().
+		Str("snapshot_date", date).
+		Int64("snapshot_count", count).
+		Msg("Balance snapshots generated for day")
+
+	return true, nil
+}
+
+// GetStatement 获取用户某月的对账单
+// 期初余额为上月最后一天的快照，每天的余额按已生成的快照日期列出，该月还没有快照的日期不列出
+func (s *service) GetStatement(ctx context.Context, userID string, month time.Time, filter *Filter) (*Statement, error) {
+	monthStart := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	monthEnd := monthStart.AddDate(0, 1, 0)
+	openingDate := monthStart.AddDate(0, 0, -1)
+
+	days, err := s.getSnapshotDays(ctx, monthStart, monthEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT s.token_id, t.token_symbol, s.chain_id, s.snapshot_date::text, s.balance
+		FROM balance_snapshots s
+		INNER JOIN tokens t ON t.id = s.token_id
+		WHERE s.user_id = $1
+			AND s.snapshot_date >= $2::date
+			AND s.snapshot_date < $3::date
+	`
+	args := []interface{}{userID, openingDate.Format(dateLayout), monthEnd.Format(dateLayout)}
+
+	var conditions []string
+	if filter != nil {
+		if filter.ChainID != nil {
+			args = append(args, *filter.ChainID)
+			conditions = append(conditions, fmt.Sprintf("s.chain_id = $%d", len(args)))
+		}
+		if filter.TokenID != nil {
+			args = append(args, *filter.TokenID)
+			conditions = append(conditions, fmt.Sprintf("s.token_id = $%d", len(args)))
+		}
+	}
+	if len(conditions) > 0 {
+		query += " AND " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY s.chain_id, s.token_id, s.snapshot_date"
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query balance snapshots")
+	}
+	defer rows.Close()
+
+	var snapshots []*snapshot
+	for rows.Next() {
+		var (
+			snap    = &snapshot{}
+			date    string
+			balance string
+		)
+		if err := rows.Scan(&snap.tokenID, &snap.tokenSymbol, &snap.chainID, &date, &balance); err != nil {
+			return nil, errors.Wrap(err, "failed to scan balance snapshot")
+		}
+		if snap.date, err = time.Parse(dateLayout, date); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse balance snapshot date: %s", date)
+		}
+		if snap.balance, err = money.ParseFloat(balance); err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snap)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate balance snapshots")
+	}
+
+	return buildStatement(monthStart, days, snapshots), nil
+}
+
+// getSnapshotDays 查询 [from, to) 内已生成快照的日期
+func (s *service) getSnapshotDays(ctx context.Context, from, to time.Time) ([]time.Time, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT snapshot_date::text
+		FROM balance_snapshot_days
+		WHERE snapshot_date >= $1::date AND snapshot_date < $2::date
+		ORDER BY snapshot_date
+	`, from.Format(dateLayout), to.Format(dateLayout))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query balance snapshot days")
+	}
+	defer rows.Close()
+
+	var days []time.Time
+	for rows.Next() {
+		var date string
+		if err := rows.Scan(&date); err != nil {
+			return nil, errors.Wrap(err, "failed to scan balance snapshot day")
+		}
+		day, err := time.Parse(dateLayout, date)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse balance snapshot date: %s", date)
+		}
+		days = append(days, day)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate balance snapshot days")
+	}
+
+	return days, nil
+}
+
+// utcDate 返回 t 所在的 UTC 日期（零点）
+func utcDate(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
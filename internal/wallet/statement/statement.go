@@ -0,0 +1,110 @@
+package statement
+
+import (
+	"math/big"
+	"time"
+)
+
+// Filter 对账单过滤条件
+type Filter struct {
+	ChainID *int
+	TokenID *int
+}
+
+// Statement 用户某月的对账单
+type Statement struct {
+	Month            time.Time  // 月份第一天（UTC）
+	GeneratedThrough *time.Time // 该月最后一个已生成快照的日期，该月还没有快照时为 nil
+	Tokens           []*TokenStatement
+}
+
+// TokenStatement 单个代币的月度对账单
+type TokenStatement struct {
+	TokenID        int
+	TokenSymbol    string
+	ChainID        int
+	OpeningBalance *big.Float // 上月最后一天结束时的余额
+	ClosingBalance *big.Float // GeneratedThrough 当天结束时的余额，该月还没有快照时等于期初余额
+	NetChange      *big.Float // ClosingBalance - OpeningBalance
+	Days           []*DailyBalance
+}
+
+// DailyBalance 某天结束时的余额及相对前一天的变化
+type DailyBalance struct {
+	Date    time.Time
+	Balance *big.Float
+	Change  *big.Float
+}
+
+// snapshot balance_snapshots 中的一条快照
+type snapshot struct {
+	tokenID     int
+	tokenSymbol string
+	chainID     int
+	date        time.Time
+	balance     *big.Float
+}
+
+// buildStatement 按已生成的日期组装对账单
+// snapshots 包含上月最后一天和该月的快照，按链、代币、日期排序；已生成日期没有快照的代币当天余额为 0
+func buildStatement(month time.Time, days []time.Time, snapshots []*snapshot) *Statement {
+	statement := &Statement{
+		Month:  month,
+		Tokens: make([]*TokenStatement, 0),
+	}
+	if len(days) > 0 {
+		last := days[len(days)-1]
+		statement.GeneratedThrough = &last
+	}
+
+	for start := 0; start < len(snapshots); {
+		end := start
+		for end < len(snapshots) && snapshots[end].tokenID == snapshots[start].tokenID {
+			end++
+		}
+		statement.Tokens = append(statement.Tokens, buildTokenStatement(month, days, snapshots[start:end]))
+		start = end
+	}
+
+	return statement
+}
+
+// buildTokenStatement 组装单个代币的对账单，snapshots 为该代币按日期排序的快照
+func buildTokenStatement(month time.Time, days []time.Time, snapshots []*snapshot) *TokenStatement {
+	first := snapshots[0]
+	token := &TokenStatement{
+		TokenID:        first.tokenID,
+		TokenSymbol:    first.tokenSymbol,
+		ChainID:        first.chainID,
+		OpeningBalance: new(big.Float),
+		Days:           make([]*DailyBalance, 0, len(days)),
+	}
+
+	balances := make(map[time.Time]*big.Float, len(snapshots))
+	for _, snap := range snapshots {
+		if snap.date.Before(month) {
+			token.OpeningBalance = snap.balance
+			continue
+		}
+		balances[snap.date] = snap.balance
+	}
+
+	previous := token.OpeningBalance
+	for _, day := range days {
+		balance, ok := balances[day]
+		if !ok {
+			balance = new(big.Float)
+		}
+		token.Days = append(token.Days, &DailyBalance{
+			Date:    day,
+			Balance: balance,
+			Change:  new(big.Float).Sub(balance, previous),
+		})
+		previous = balance
+	}
+
+	token.ClosingBalance = previous
+	token.NetChange = new(big.Float).Sub(token.ClosingBalance, token.OpeningBalance)
+
+	return token
+}
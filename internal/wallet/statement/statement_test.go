@@ -0,0 +1,80 @@
+package statement
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github/chapool/go-wallet/internal/money"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(t *testing.T, value string) time.Time {
+	t.Helper()
+
+	day, err := time.Parse(dateLayout, value)
+	require.NoError(t, err)
+	return day
+}
+
+func amount(value string) *big.Float {
+	f, _ := money.ParseFloat(value)
+	return f
+}
+
+func TestBuildStatement(t *testing.T) {
+	t.Parallel()
+
+	month := date(t, "2025-11-01")
+	days := []time.Time{date(t, "2025-11-01"), date(t, "2025-11-02"), date(t, "2025-11-03")}
+	snapshots := []*snapshot{
+		// USDT：有期初余额，11 月 2 日余额清零（当天有提现，写入了 0）后 11 月 3 日没有快照
+		{tokenID: 2, tokenSymbol: "USDT", chainID: 56, date: date(t, "2025-10-31"), balance: amount("100")},
+		{tokenID: 2, tokenSymbol: "USDT", chainID: 56, date: date(t, "2025-11-01"), balance: amount("150")},
+		{tokenID: 2, tokenSymbol: "USDT", chainID: 56, date: date(t, "2025-11-02"), balance: amount("0")},
+		// BNB：11 月 2 日首次充值
+		{tokenID: 3, tokenSymbol: "BNB", chainID: 56, date: date(t, "2025-11-02"), balance: amount("5")},
+		{tokenID: 3, tokenSymbol: "BNB", chainID: 56, date: date(t, "2025-11-03"), balance: amount("7.5")},
+	}
+
+	statement := buildStatement(month, days, snapshots)
+	require.NotNil(t, statement.GeneratedThrough)
+	assert.Equal(t, date(t, "2025-11-03"), *statement.GeneratedThrough)
+	require.Len(t, statement.Tokens, 2)
+
+	usdt := statement.Tokens[0]
+	assert.Equal(t, "USDT", usdt.TokenSymbol)
+	assert.Equal(t, "100", usdt.OpeningBalance.Text('f', -1))
+	assert.Equal(t, "0", usdt.ClosingBalance.Text('f', -1))
+	assert.Equal(t, "-100", usdt.NetChange.Text('f', -1))
+	require.Len(t, usdt.Days, 3)
+	assert.Equal(t, "50", usdt.Days[0].Change.Text('f', -1))
+	assert.Equal(t, "-150", usdt.Days[1].Change.Text('f', -1))
+	assert.Equal(t, "0", usdt.Days[2].Balance.Text('f', -1))
+
+	bnb := statement.Tokens[1]
+	assert.Equal(t, "0", bnb.OpeningBalance.Text('f', -1))
+	assert.Equal(t, "7.5", bnb.ClosingBalance.Text('f', -1))
+	assert.Equal(t, "0", bnb.Days[0].Balance.Text('f', -1))
+	assert.Equal(t, "5", bnb.Days[1].Change.Text('f', -1))
+	assert.Equal(t, "2.5", bnb.Days[2].Change.Text('f', -1))
+}
+
+func TestBuildStatementWithoutSnapshotsInMonth(t *testing.T) {
+	t.Parallel()
+
+	month := date(t, "2025-12-01")
+	snapshots := []*snapshot{
+		{tokenID: 2, tokenSymbol: "USDT", chainID: 56, date: date(t, "2025-11-30"), balance: amount("42")},
+	}
+
+	statement := buildStatement(month, nil, snapshots)
+	assert.Nil(t, statement.GeneratedThrough)
+	require.Len(t, statement.Tokens, 1)
+	assert.Equal(t, "42", statement.Tokens[0].OpeningBalance.Text('f', -1))
+	assert.Equal(t, "42", statement.Tokens[0].ClosingBalance.Text('f', -1))
+	assert.Equal(t, "0", statement.Tokens[0].NetChange.Text('f', -1))
+	assert.Empty(t, statement.Tokens[0].Days)
+}
@@ -0,0 +1,195 @@
+//nolint:ireturn // 返回接口类型是预期的设计
+package stats
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/pkg/errors"
+
+	"github/chapool/go-wallet/internal/money"
+)
+
+// Service 用户钱包统计服务接口
+// 统计数据保存在 user_wallet_stats 汇总表中，由充值入账和提现确认时增量更新
+type Service interface {
+	// RecordDeposit 累加一笔充值统计，exec 应与创建 Credits 记录使用同一个事务
+	// units 为最小单位金额（与充值 Credits 一致），按 decimals 换算为代币单位后累加
+	RecordDeposit(ctx context.Context, exec boil.ContextExecutor, userID string, tokenID int, units string, decimals int, at time.Time) error
+
+	// RecordWithdraw 累加一笔已确认提现统计，exec 应与更新提现状态使用同一个事务
+	// amount 为代币单位金额（与提现记录一致）
+	RecordWithdraw(ctx context.Context, exec boil.ContextExecutor, userID string, tokenID int, amount string, at time.Time) error
+
+	// GetUserStats 获取用户的累计充值/提现统计
+	GetUserStats(ctx context.Context, userID string) (*UserStats, error)
+}
+
+// service 实现 Service 接口
+type service struct {
+	db *sql.DB
+}
+
+// NewService 创建统计服务
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(db *sql.DB) Service {
+	return &service{
+		db: db,
+	}
+}
+
+// UserStats 用户统计汇总
+type UserStats struct {
+	DepositCount    int64
+	WithdrawCount   int64
+	FirstActivityAt *time.Time // 无任何记录时为 nil
+	LastActivityAt  *time.Time // 无任何记录时为 nil
+	Tokens          []*TokenStats
+}
+
+// TokenStats 按代币统计
+type TokenStats struct {
+	TokenID         int
+	TokenSymbol     string
+	ChainID         int
+	TotalDeposited  *big.Float
+	DepositCount    int64
+	TotalWithdrawn  *big.Float
+	WithdrawCount   int64
+	FirstActivityAt *time.Time
+	LastActivityAt  *time.Time
+}
+
+// RecordDeposit 累加一笔充值统计，统计表中的金额统一为代币单位
+func (s *service) RecordDeposit(ctx context.Context, exec boil.ContextExecutor, userID string, tokenID int, units string, decimals int, at time.Time) error {
+	value, ok := new(big.Int).SetString(units, 10)
+	if !ok {
+		return errors.Errorf("invalid deposit amount %q", units)
+	}
+	amount := money.Format(money.FromSmallestUnit(value, decimals), decimals)
+
+	_, err := exec.ExecContext(ctx, `
+		INSERT INTO user_wallet_stats (user_id, token_id, total_deposited, deposit_count, first_activity_at, last_activity_at)
+		VALUES ($1, $2, $3::numeric, 1, $4, $4)
+		ON CONFLICT (user_id, token_id) DO UPDATE SET
+			total_deposited = user_wallet_stats.total_deposited + EXCLUDED.total_deposited,
+			deposit_count = user_wallet_stats.deposit_count + 1,
+			first_activity_at = LEAST(user_wallet_stats.first_activity_at, EXCLUDED.first_activity_at),
+			last_activity_at = GREATEST(user_wallet_stats.last_activity_at, EXCLUDED.last_activity_at),
+			updated_at = NOW()
+	`, userID, tokenID, amount, at)
+	if err != nil {
+		return errors.Wrap(err, "failed to record deposit stats")
+	}
+
+	return nil
+}
+
+// RecordWithdraw 累加一笔已确认提现统计
+func (s *service) RecordWithdraw(ctx context.Context, exec boil.ContextExecutor, userID string, tokenID int, amount string, at time.Time) error {
+	_, err := exec.ExecContext(ctx, `
+		INSERT INTO user_wallet_stats (user_id, token_id, total_withdrawn, withdraw_count, first_activity_at, last_activity_at)
+		VALUES ($1, $2, $3::numeric, 1, $4, $4)
+		ON CONFLICT (user_id, token_id) DO UPDATE SET
+			total_withdrawn = user_wallet_stats.total_withdrawn + EXCLUDED.total_withdrawn,
+			withdraw_count = user_wallet_stats.withdraw_count + 1,
+			first_activity_at = LEAST(user_wallet_stats.first_activity_at, EXCLUDED.first_activity_at),
+			last_activity_at = GREATEST(user_wallet_stats.last_activity_at, EXCLUDED.last_activity_at),
+			updated_at = NOW()
+	`, userID, tokenID, amount, at)
+	if err != nil {
+		return errors.Wrap(err, "failed to record withdraw stats")
+	}
+
+	return nil
+}
+
+// GetUserStats 获取用户的累计充值/提现统计
+func (s *service) GetUserStats(ctx context.Context, userID string) (*UserStats, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT
+			s.token_id,
+			t.token_symbol,
+			t.chain_id,
+			s.total_deposited::text,
+			s.deposit_count,
+			s.total_withdrawn::text,
+			s.withdraw_count,
+			s.first_activity_at,
+			s.last_activity_at
+		FROM user_wallet_stats s
+		INNER JOIN tokens t ON t.id = s.token_id
+		WHERE s.user_id = $1
+		ORDER BY t.chain_id, s.token_id
+	`, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query user wallet stats")
+	}
+	defer rows.Close()
+
+	result := &UserStats{
+		Tokens: make([]*TokenStats, 0),
+	}
+
+	for rows.Next() {
+		var (
+			item              TokenStats
+			totalDepositedStr string
+			totalWithdrawnStr string
+			firstActivityAt   sql.NullTime
+			lastActivityAt    sql.NullTime
+		)
+
+		if err := rows.Scan(
+			&item.TokenID,
+			&item.TokenSymbol,
+			&item.ChainID,
+			&totalDepositedStr,
+			&item.DepositCount,
+			&totalWithdrawnStr,
+			&item.WithdrawCount,
+			&firstActivityAt,
+			&lastActivityAt,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan user wallet stats")
+		}
+
+		item.TotalDeposited, err = money.ParseFloat(totalDepositedStr)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse total deposited")
+		}
+
+		item.TotalWithdrawn, err = money.ParseFloat(totalWithdrawnStr)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse total withdrawn")
+		}
+
+		if firstActivityAt.Valid {
+			item.FirstActivityAt = &firstActivityAt.Time
+			if result.FirstActivityAt == nil || firstActivityAt.Time.Before(*result.FirstActivityAt) {
+				result.FirstActivityAt = item.FirstActivityAt
+			}
+		}
+
+		if lastActivityAt.Valid {
+			item.LastActivityAt = &lastActivityAt.Time
+			if result.LastActivityAt == nil || lastActivityAt.Time.After(*result.LastActivityAt) {
+				result.LastActivityAt = item.LastActivityAt
+			}
+		}
+
+		result.DepositCount += item.DepositCount
+		result.WithdrawCount += item.WithdrawCount
+		result.Tokens = append(result.Tokens, &item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate user wallet stats")
+	}
+
+	return result, nil
+}
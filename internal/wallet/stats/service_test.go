@@ -0,0 +1,44 @@
+package stats_test
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/test"
+	"github/chapool/go-wallet/internal/test/fixtures"
+	"github/chapool/go-wallet/internal/wallet/stats"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordDepositAndWithdrawUseTokenUnits(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		token, err := models.Tokens(qm.OrderBy(models.TokenColumns.ID)).One(ctx, db)
+		require.NoError(t, err)
+
+		// 充值 1.5 个代币（最小单位），提现 0.25 个代币（代币单位）
+		depositUnits := "15" + strings.Repeat("0", token.Decimals-1)
+		service := stats.NewService(db)
+		now := time.Now()
+		require.NoError(t, service.RecordDeposit(ctx, db, fix.User1.ID, token.ID, depositUnits, token.Decimals, now))
+		require.NoError(t, service.RecordWithdraw(ctx, db, fix.User1.ID, token.ID, "0.25", now))
+
+		result, err := service.GetUserStats(ctx, fix.User1.ID)
+		require.NoError(t, err)
+		require.Len(t, result.Tokens, 1)
+		assert.Equal(t, "1.5", result.Tokens[0].TotalDeposited.Text('f', -1))
+		assert.Equal(t, "0.25", result.Tokens[0].TotalWithdrawn.Text('f', -1))
+		assert.Equal(t, int64(1), result.DepositCount)
+		assert.Equal(t, int64(1), result.WithdrawCount)
+
+		require.Error(t, service.RecordDeposit(ctx, db, fix.User1.ID, token.ID, "1.5", token.Decimals, now))
+	})
+}
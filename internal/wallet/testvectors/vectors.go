@@ -0,0 +1,265 @@
+// Package testvectors contains known-answer vectors for the wallet crypto path
+// (mnemonic -> seed -> derivation path -> key -> address -> signed transaction).
+//
+// The vectors are shared by the golden tests of the address, signer and keystore
+// packages so that a refactor of the derivation or signing code cannot silently
+// change derived addresses or signed transaction bytes. Vectors marked as
+// cross-checked match the values produced by ethers.js / geth (EVM), the
+// Solana CLI / Phantom (Solana) and the BIP specifications (Bitcoin); the
+// remaining ones are goldens recorded from this implementation. Never change an
+// expected value to make a test pass.
+package testvectors
+
+import (
+	"github/chapool/go-wallet/internal/wallet/seed"
+)
+
+// Well-known BIP39 test mnemonics
+const (
+	// AbandonMnemonic is the BIP39 all-zero entropy test mnemonic
+	AbandonMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	// HardhatMnemonic is the default mnemonic of the Hardhat / Anvil development networks
+	HardhatMnemonic = "test test test test test test test test test test test junk"
+)
+
+// DerivationVector is a known mnemonic -> path -> key -> address vector
+type DerivationVector struct {
+	Name       string
+	Mnemonic   string
+	Passphrase string // BIP39 passphrase (the keystore password is not used as passphrase by default)
+	ChainType  string
+	Index      int
+	Path       string
+	Address    string // checksummed for EVM, base58 for Solana, bech32 for Bitcoin
+	PrivateKey string // hex without 0x prefix; empty when only the address is pinned
+	// CrossChecked reports whether the vector was verified against an independent implementation
+	CrossChecked bool
+}
+
+// EVMDerivationVectors are BIP44 vectors on m/44'/60'/0'/0/{index}
+//
+//nolint:gochecknoglobals // immutable test vectors
+var EVMDerivationVectors = []DerivationVector{
+	{
+		Name:         "abandon/0",
+		Mnemonic:     AbandonMnemonic,
+		ChainType:    "evm",
+		Index:        0,
+		Path:         "m/44'/60'/0'/0/0",
+		Address:      "0x9858EfFD232B4033E47d90003D41EC34EcaEda94",
+		PrivateKey:   "1ab42cc412b618bdea3a599e3c9bae199ebf030895b039e9db1e30dafb12b727",
+		CrossChecked: true,
+	},
+	{
+		Name:         "abandon/1",
+		Mnemonic:     AbandonMnemonic,
+		ChainType:    "evm",
+		Index:        1,
+		Path:         "m/44'/60'/0'/0/1",
+		Address:      "0x6Fac4D18c912343BF86fa7049364Dd4E424Ab9C0",
+		PrivateKey:   "9a983cb3d832fbde5ab49d692b7a8bf5b5d232479c99333d0fc8e1d21f1b55b6",
+		CrossChecked: true,
+	},
+	{
+		Name:       "abandon/2",
+		Mnemonic:   AbandonMnemonic,
+		ChainType:  "evm",
+		Index:      2,
+		Path:       "m/44'/60'/0'/0/2",
+		Address:    "0xb6716976A3ebe8D39aCEB04372f22Ff8e6802D7A",
+		PrivateKey: "5b824bd1104617939cd07c117ddc4301eb5beeca0904f964158963d69ab9d831",
+	},
+	{
+		Name:         "hardhat/0",
+		Mnemonic:     HardhatMnemonic,
+		ChainType:    "evm",
+		Index:        0,
+		Path:         "m/44'/60'/0'/0/0",
+		Address:      "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		PrivateKey:   "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
+		CrossChecked: true,
+	},
+	{
+		Name:         "hardhat/1",
+		Mnemonic:     HardhatMnemonic,
+		ChainType:    "evm",
+		Index:        1,
+		Path:         "m/44'/60'/0'/0/1",
+		Address:      "0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+		PrivateKey:   "59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d",
+		CrossChecked: true,
+	},
+	{
+		Name:         "hardhat/2",
+		Mnemonic:     HardhatMnemonic,
+		ChainType:    "evm",
+		Index:        2,
+		Path:         "m/44'/60'/0'/0/2",
+		Address:      "0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC",
+		PrivateKey:   "5de4111afa1a4b94908f83103eb1f1706367c2e68ca870fc3fb9a804cdab365a",
+		CrossChecked: true,
+	},
+}
+
+// SolanaDerivationVectors are SLIP-0010 ed25519 vectors on m/44'/501'/{index}'/0'
+//
+//nolint:gochecknoglobals // immutable test vectors
+var SolanaDerivationVectors = []DerivationVector{
+	{
+		Name:         "abandon/0",
+		Mnemonic:     AbandonMnemonic,
+		ChainType:    "solana",
+		Index:        0,
+		Path:         "m/44'/501'/0'/0'",
+		Address:      "HAgk14JpMQLgt6rVgv7cBQFJWFto5Dqxi472uT3DKpqk",
+		CrossChecked: true,
+	},
+	{
+		Name:      "abandon/1",
+		Mnemonic:  AbandonMnemonic,
+		ChainType: "solana",
+		Index:     1,
+		Path:      "m/44'/501'/1'/0'",
+		Address:   "Hh8QwFUA6MtVu1qAoq12ucvFHNwCcVTV7hpWjeY1Hztb",
+	},
+	{
+		Name:      "abandon/2",
+		Mnemonic:  AbandonMnemonic,
+		ChainType: "solana",
+		Index:     2,
+		Path:      "m/44'/501'/2'/0'",
+		Address:   "7WktogJEd2wQ9eH2oWusmcoFTgeYi6rS632UviTBJ2jm",
+	},
+	{
+		Name:      "hardhat/0",
+		Mnemonic:  HardhatMnemonic,
+		ChainType: "solana",
+		Index:     0,
+		Path:      "m/44'/501'/0'/0'",
+		Address:   "oeYf6KAJkLYhBuR8CiGc6L4D4Xtfepr85fuDgA9kq96",
+	},
+	{
+		Name:      "hardhat/1",
+		Mnemonic:  HardhatMnemonic,
+		ChainType: "solana",
+		Index:     1,
+		Path:      "m/44'/501'/1'/0'",
+		Address:   "AqynRZwvVqUPRwRJXvm6odUb3t93fDjnWe3p6BeuUFxD",
+	},
+	{
+		Name:      "hardhat/2",
+		Mnemonic:  HardhatMnemonic,
+		ChainType: "solana",
+		Index:     2,
+		Path:      "m/44'/501'/2'/0'",
+		Address:   "CqMbRgMuEhQi9BUS8xP44Wk5nENm48FqJnfjEi4eNb1k",
+	},
+}
+
+// BitcoinDerivationVectors are BIP84 (native segwit) vectors on m/84'/0'/0'/0/{index}
+//
+//nolint:gochecknoglobals // immutable test vectors
+var BitcoinDerivationVectors = []DerivationVector{
+	{
+		// Test vector from the BIP84 specification
+		Name:         "abandon/0",
+		Mnemonic:     AbandonMnemonic,
+		ChainType:    "bitcoin",
+		Index:        0,
+		Path:         "m/84'/0'/0'/0/0",
+		Address:      "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu",
+		PrivateKey:   "4604b4b710fe91f584fff084e1a9159fe4f8408fff380596a604948474ce4fa3",
+		CrossChecked: true,
+	},
+	{
+		// Test vector from the BIP84 specification
+		Name:         "abandon/1",
+		Mnemonic:     AbandonMnemonic,
+		ChainType:    "bitcoin",
+		Index:        1,
+		Path:         "m/84'/0'/0'/0/1",
+		Address:      "bc1qnjg0jd8228aq7egyzacy8cys3knf9xvrerkf9g",
+		PrivateKey:   "2fd0affa51529f940a358ec0c50de81267d0bf5158ca61887347676946362c5b",
+		CrossChecked: true,
+	},
+}
+
+// EVMSigningVector is a known unsigned transaction -> signed raw transaction vector.
+// ECDSA signatures are deterministic (RFC 6979), so the raw bytes are stable.
+type EVMSigningVector struct {
+	Name                 string
+	PrivateKey           string // hex without 0x prefix
+	From                 string // address of PrivateKey
+	ChainID              int64
+	Nonce                uint64
+	To                   string
+	Value                string
+	GasLimit             uint64
+	GasPrice             string // legacy transaction when set
+	MaxFeePerGas         string
+	MaxPriorityFeePerGas string
+	Data                 string // hex without 0x prefix
+	RawTransaction       string // hex without 0x prefix
+	TxHash               string
+	CrossChecked         bool
+}
+
+// EVMSigningVectors covers legacy (EIP-155) and dynamic fee (EIP-1559) transactions
+//
+//nolint:gochecknoglobals // immutable test vectors
+var EVMSigningVectors = []EVMSigningVector{
+	{
+		// Example transaction from the EIP-155 specification
+		Name:           "eip155-spec-example",
+		PrivateKey:     "4646464646464646464646464646464646464646464646464646464646464646",
+		From:           "0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F",
+		ChainID:        1,
+		Nonce:          9,
+		To:             "0x3535353535353535353535353535353535353535",
+		Value:          "1000000000000000000",
+		GasLimit:       21000,
+		GasPrice:       "20000000000",
+		RawTransaction: "f86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83",
+		TxHash:         "0x33469b22e9f636356c4160a87eb19df52b7412e8eac32a4a55ffe88ea8350788",
+		CrossChecked:   true,
+	},
+	{
+		Name:                 "eip1559-native-transfer",
+		PrivateKey:           "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
+		From:                 "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		ChainID:              56,
+		Nonce:                7,
+		To:                   "0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+		Value:                "1500000000000000000",
+		GasLimit:             21000,
+		MaxFeePerGas:         "3000000000",
+		MaxPriorityFeePerGas: "1000000000",
+		RawTransaction:       "02f8723807843b9aca0084b2d05e008252089470997970c51812dc3a010c7d01b50e0d17dc79c88814d1120d7b16000080c080a002b17320b6ae710a6240e8a9650d804480b7ce6a08fa165da401f124ce9e9b2aa012f0e01de8328679fb8d37f58ac7dd4bcd63be5ac70e8c3d3e6ad592442e05e6",
+		TxHash:               "0xeb46a297a9c09b7fec314eb8d53073698d048779e64c515ec4ebd5c5903f2989",
+	},
+	{
+		// ERC20 transfer(0x7099...79C8, 1000000) on the USDT contract
+		Name:                 "eip1559-erc20-transfer",
+		PrivateKey:           "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
+		From:                 "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		ChainID:              1,
+		Nonce:                0,
+		To:                   "0xdAC17F958D2ee523a2206206994597C13D831ec7",
+		Value:                "0",
+		GasLimit:             65000,
+		MaxFeePerGas:         "30000000000",
+		MaxPriorityFeePerGas: "1500000000",
+		Data:                 "a9059cbb00000000000000000000000070997970c51812dc3a010c7d01b50e0d17dc79c800000000000000000000000000000000000000000000000000000000000f4240",
+		RawTransaction:       "02f8b001808459682f008506fc23ac0082fde894dac17f958d2ee523a2206206994597c13d831ec780b844a9059cbb00000000000000000000000070997970c51812dc3a010c7d01b50e0d17dc79c800000000000000000000000000000000000000000000000000000000000f4240c080a0c1925e9812025405dcd5d4bdbcb0e913506eee5f82e9c83fb29304bc11651c2ca04ab9ebcb66c8ad8938c2b07b9b6fee68ea97936d55b271463a150b2674994e7f",
+		TxHash:               "0x6b77996cfd344ece417ca0ba94a1cb1b623ada7b5db5ed8f0348207ec1e864a5",
+	},
+}
+
+// Seed converts a mnemonic and BIP39 passphrase to a seed the same way the running wallet does
+func Seed(mnemonic string, passphrase string) []byte {
+	manager := seed.NewManager()
+	//nolint:errcheck // Initialize never fails for a non-empty mnemonic
+	_ = manager.Initialize(mnemonic, passphrase)
+
+	return manager.GetSeed()
+}
@@ -0,0 +1,383 @@
+package token
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"strings"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// PostgreSQL 约束冲突错误码
+const (
+	pgForeignKeyViolation = "23503"
+	pgUniqueViolation     = "23505"
+
+	// wrappedNativeIndex 每条链最多一个包装原生代币的唯一索引
+	wrappedNativeIndex = "idx_tokens_wrapped_native"
+)
+
+var (
+	// ErrInvalidToken 代币参数不合法或合约不是有效的 ERC20 合约
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrTokenNotFound 代币不存在
+	ErrTokenNotFound = walleterrors.ErrTokenNotFound
+	// ErrTokenExists 代币已登记
+	ErrTokenExists = errors.New("token already registered")
+	// ErrTokenInUse 代币已有余额、提现等记录，只能停用不能删除
+	ErrTokenInUse = errors.New("token is in use")
+)
+
+// Service 代币管理服务接口
+// 管理员登记代币时在链上校验合约并读取 symbol、name、decimals，扫描器自动发现的代币需要管理员启用后才入账
+type Service interface {
+	// ListTokens 查询代币（包括未启用的代币）
+	ListTokens(ctx context.Context, filter *ListFilter) ([]*models.Token, error)
+
+	// CreateToken 登记 EVM 链的 ERC20 代币
+	CreateToken(ctx context.Context, req *CreateRequest) (*models.Token, error)
+
+	// UpdateToken 更新代币的启用状态、提现手续费、最小提现金额和包装原生代币配置
+	UpdateToken(ctx context.Context, req *UpdateRequest) (*models.Token, error)
+
+	// DeleteToken 删除未被使用的代币
+	DeleteToken(ctx context.Context, tokenID int) error
+}
+
+// ListFilter 代币查询条件，字段为空表示不限
+type ListFilter struct {
+	ChainID  *int
+	IsActive *bool
+}
+
+// CreateRequest 登记代币请求
+type CreateRequest struct {
+	ChainID           int
+	TokenAddress      string
+	IsActive          bool
+	WithdrawFee       *string // 人类可读单位，为空时为 0
+	MinWithdrawAmount *string // 人类可读单位，为空时为 0
+	MinTransferAmount *string // 最小内部转账金额，人类可读单位，为空时为 0
+	MinDepositAmount  *string // 最小充值金额，人类可读单位，为空时为 0；低于该金额的充值记录为 dust，不入账
+	IsWrappedNative   bool    // 链的包装原生代币（如 WBNB、WETH），每条链最多一个
+	CreditAsNative    bool    // 包装原生代币的充值按原生代币入账
+	FeeOnTransfer     bool    // 转账扣费代币，充值按收款地址余额变化的实际到账金额入账
+	SupportsPermit    bool    // 支持 EIP-2612 permit，归集时用 permit + transferFrom，用户地址无需原生代币 gas
+}
+
+// UpdateRequest 更新代币请求，字段为空表示不修改
+type UpdateRequest struct {
+	TokenID           int
+	IsActive          *bool
+	WithdrawFee       *string
+	MinWithdrawAmount *string
+	MinTransferAmount *string
+	MinDepositAmount  *string
+	IsWrappedNative   *bool
+	CreditAsNative    *bool
+	FeeOnTransfer     *bool
+	SupportsPermit    *bool
+}
+
+type service struct {
+	db           *sql.DB
+	chainService chain.Service
+	scanService  scan.Service
+}
+
+// NewService 创建代币管理服务
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(db *sql.DB, chainService chain.Service, scanService scan.Service) Service {
+	return &service{
+		db:           db,
+		chainService: chainService,
+		scanService:  scanService,
+	}
+}
+
+// ListTokens 查询代币，按链和 ID 排序
+func (s *service) ListTokens(ctx context.Context, filter *ListFilter) ([]*models.Token, error) {
+	mods := []qm.QueryMod{
+		qm.OrderBy(models.TokenColumns.ChainID + " ASC, " + models.TokenColumns.ID + " ASC"),
+	}
+	if filter != nil && filter.ChainID != nil {
+		mods = append(mods, models.TokenWhere.ChainID.EQ(*filter.ChainID))
+	}
+	if filter != nil && filter.IsActive != nil {
+		mods = append(mods, models.TokenWhere.IsActive.EQ(*filter.IsActive))
+	}
+
+	tokens, err := models.Tokens(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list tokens")
+	}
+
+	return tokens, nil
+}
+
+// CreateToken 登记代币：校验合约代码并读取链上元数据
+func (s *service) CreateToken(ctx context.Context, req *CreateRequest) (*models.Token, error) {
+	if !common.IsHexAddress(req.TokenAddress) {
+		return nil, errors.Wrap(ErrInvalidToken, "token_address is not a valid address")
+	}
+	withdrawFee, err := parseOptionalAmount(req.WithdrawFee, "withdraw_fee")
+	if err != nil {
+		return nil, err
+	}
+	minWithdrawAmount, err := parseOptionalAmount(req.MinWithdrawAmount, "min_withdraw_amount")
+	if err != nil {
+		return nil, err
+	}
+	minTransferAmount, err := parseOptionalAmount(req.MinTransferAmount, "min_transfer_amount")
+	if err != nil {
+		return nil, err
+	}
+	minDepositAmount, err := parseOptionalAmount(req.MinDepositAmount, "min_deposit_amount")
+	if err != nil {
+		return nil, err
+	}
+
+	chainConfig, err := s.chainService.GetChain(ctx, req.ChainID)
+	if err != nil {
+		if errors.Is(err, walleterrors.ErrChainNotFound) {
+			return nil, errors.Wrapf(ErrInvalidToken, "chain %d is not configured", req.ChainID)
+		}
+		return nil, err
+	}
+	if chainConfig.ChainType != chain.TypeEVM {
+		return nil, errors.Wrapf(ErrInvalidToken, "only tokens of EVM chains can be registered, chain %d is %s", req.ChainID, chainConfig.ChainType)
+	}
+
+	address := common.HexToAddress(req.TokenAddress)
+	normalized := chain.NormalizeAddress(chainConfig.ChainType, address.Hex())
+
+	exists, err := models.Tokens(
+		models.TokenWhere.ChainID.EQ(req.ChainID),
+		models.TokenWhere.TokenAddress.EQ(null.StringFrom(normalized)),
+	).Exists(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check token existence")
+	}
+	if exists {
+		return nil, ErrTokenExists
+	}
+
+	client, err := s.scanService.GetClient(ctx, req.ChainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get RPC client")
+	}
+
+	metadata, err := client.TokenMetadata(ctx, address)
+	if err != nil {
+		log.Warn().Err(err).Int("chain_id", req.ChainID).Str("token_addr", normalized).Msg("Token contract validation failed")
+		return nil, errors.Wrapf(ErrInvalidToken, "token_address is not a valid ERC20 contract: %s", err.Error())
+	}
+
+	token := &models.Token{
+		ChainType:         chainConfig.ChainType,
+		ChainID:           req.ChainID,
+		TokenAddress:      null.StringFrom(normalized),
+		TokenSymbol:       metadata.Symbol,
+		TokenName:         null.NewString(metadata.Name, metadata.Name != ""),
+		Decimals:          metadata.Decimals,
+		IsNative:          false,
+		TokenType:         null.StringFrom("erc20"),
+		WithdrawFee:       null.StringFrom(withdrawFee),
+		MinWithdrawAmount: null.StringFrom(minWithdrawAmount),
+		MinTransferAmount: null.StringFrom(minTransferAmount),
+		MinDepositAmount:  null.StringFrom(minDepositAmount),
+		IsActive:          req.IsActive,
+		IsWrappedNative:   req.IsWrappedNative,
+		CreditAsNative:    req.CreditAsNative,
+		FeeOnTransfer:     req.FeeOnTransfer,
+		SupportsPermit:    req.SupportsPermit,
+	}
+	if err := validateWrappedNative(token, chainConfig); err != nil {
+		return nil, err
+	}
+	if err := token.Insert(ctx, s.db, boil.Infer()); err != nil {
+		if isWrappedNativeConflict(err) {
+			return nil, errors.Wrapf(ErrInvalidToken, "chain %d already has a wrapped native token", req.ChainID)
+		}
+		if isPQError(err, pgUniqueViolation) {
+			return nil, ErrTokenExists
+		}
+		return nil, errors.Wrap(err, "failed to insert token")
+	}
+
+	return token, nil
+}
+
+// UpdateToken 更新代币
+func (s *service) UpdateToken(ctx context.Context, req *UpdateRequest) (*models.Token, error) {
+	token, err := models.FindToken(ctx, s.db, req.TokenID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTokenNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get token")
+	}
+
+	if req.WithdrawFee != nil {
+		withdrawFee, err := parseOptionalAmount(req.WithdrawFee, "withdraw_fee")
+		if err != nil {
+			return nil, err
+		}
+		token.WithdrawFee = null.StringFrom(withdrawFee)
+	}
+	if req.MinWithdrawAmount != nil {
+		minWithdrawAmount, err := parseOptionalAmount(req.MinWithdrawAmount, "min_withdraw_amount")
+		if err != nil {
+			return nil, err
+		}
+		token.MinWithdrawAmount = null.StringFrom(minWithdrawAmount)
+	}
+	if req.MinTransferAmount != nil {
+		minTransferAmount, err := parseOptionalAmount(req.MinTransferAmount, "min_transfer_amount")
+		if err != nil {
+			return nil, err
+		}
+		token.MinTransferAmount = null.StringFrom(minTransferAmount)
+	}
+	if req.MinDepositAmount != nil {
+		minDepositAmount, err := parseOptionalAmount(req.MinDepositAmount, "min_deposit_amount")
+		if err != nil {
+			return nil, err
+		}
+		token.MinDepositAmount = null.StringFrom(minDepositAmount)
+	}
+	if req.IsActive != nil {
+		token.IsActive = *req.IsActive
+	}
+	if req.IsWrappedNative != nil {
+		token.IsWrappedNative = *req.IsWrappedNative
+		// 取消包装原生代币标记时同时关闭按原生代币入账
+		if !token.IsWrappedNative && req.CreditAsNative == nil {
+			token.CreditAsNative = false
+		}
+	}
+	if req.CreditAsNative != nil {
+		token.CreditAsNative = *req.CreditAsNative
+	}
+	if req.FeeOnTransfer != nil {
+		token.FeeOnTransfer = *req.FeeOnTransfer
+	}
+	if req.SupportsPermit != nil {
+		token.SupportsPermit = *req.SupportsPermit
+	}
+
+	// 只有包装原生代币需要链配置校验精度
+	var chainConfig *models.Chain
+	if token.IsWrappedNative {
+		chainConfig, err = s.chainService.GetChain(ctx, token.ChainID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get chain config for chain_id=%d", token.ChainID)
+		}
+	}
+	if err := validateWrappedNative(token, chainConfig); err != nil {
+		return nil, err
+	}
+
+	if _, err := token.Update(ctx, s.db, boil.Whitelist(
+		models.TokenColumns.WithdrawFee,
+		models.TokenColumns.MinWithdrawAmount,
+		models.TokenColumns.MinTransferAmount,
+		models.TokenColumns.MinDepositAmount,
+		models.TokenColumns.IsActive,
+		models.TokenColumns.IsWrappedNative,
+		models.TokenColumns.CreditAsNative,
+		models.TokenColumns.FeeOnTransfer,
+		models.TokenColumns.SupportsPermit,
+		models.TokenColumns.UpdatedAt,
+	)); err != nil {
+		if isWrappedNativeConflict(err) {
+			return nil, errors.Wrapf(ErrInvalidToken, "chain %d already has a wrapped native token", token.ChainID)
+		}
+		return nil, errors.Wrap(err, "failed to update token")
+	}
+
+	return token, nil
+}
+
+// DeleteToken 删除代币，代币已被余额、提现等记录引用时返回 ErrTokenInUse
+func (s *service) DeleteToken(ctx context.Context, tokenID int) error {
+	token, err := models.FindToken(ctx, s.db, tokenID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTokenNotFound
+		}
+		return errors.Wrap(err, "failed to get token")
+	}
+	if token.IsNative {
+		return errors.Wrap(ErrInvalidToken, "native tokens cannot be deleted")
+	}
+
+	if _, err := token.Delete(ctx, s.db); err != nil {
+		if isPQError(err, pgForeignKeyViolation) {
+			return ErrTokenInUse
+		}
+		return errors.Wrap(err, "failed to delete token")
+	}
+
+	return nil
+}
+
+// parseOptionalAmount 校验人类可读金额，为空时返回 "0"
+func parseOptionalAmount(value *string, field string) (string, error) {
+	if value == nil {
+		return "0", nil
+	}
+
+	trimmed := strings.TrimSpace(*value)
+	amount, ok := new(big.Rat).SetString(trimmed)
+	if !ok || amount.Sign() < 0 {
+		return "", errors.Wrapf(ErrInvalidToken, "%s must be a non-negative number", field)
+	}
+
+	return trimmed, nil
+}
+
+// validateWrappedNative 校验包装原生代币配置：按原生代币入账需要标记为包装原生代币，
+// 包装原生代币不能是原生代币本身，且精度与链的原生代币一致（充值金额按原样入账）
+func validateWrappedNative(token *models.Token, chainConfig *models.Chain) error {
+	if token.CreditAsNative && !token.IsWrappedNative {
+		return errors.Wrap(ErrInvalidToken, "credit_as_native requires is_wrapped_native")
+	}
+	if !token.IsWrappedNative {
+		return nil
+	}
+	if token.IsNative {
+		return errors.Wrap(ErrInvalidToken, "native tokens cannot be marked as wrapped native")
+	}
+	if token.Decimals != chainConfig.NativeTokenDecimals {
+		return errors.Wrapf(ErrInvalidToken, "wrapped native token must have %d decimals like the native token of chain %d, got %d",
+			chainConfig.NativeTokenDecimals, chainConfig.ChainID, token.Decimals)
+	}
+
+	return nil
+}
+
+// isWrappedNativeConflict 判断是否违反每条链最多一个包装原生代币的唯一索引
+func isWrappedNativeConflict(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == pgUniqueViolation && pqErr.Constraint == wrappedNativeIndex
+}
+
+// isPQError 判断是否为指定错误码的 PostgreSQL 错误
+func isPQError(err error, code pq.ErrorCode) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == code
+}
@@ -0,0 +1,53 @@
+package trace
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimiter 按用户的固定窗口计数限流，只在本进程内生效（多实例部署时总频率为实例数倍）
+type rateLimiter struct {
+	limit   RateLimit
+	mu      sync.Mutex
+	windows map[string]*rateWindow
+}
+
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+func newRateLimiter(limit RateLimit) *rateLimiter {
+	return &rateLimiter{
+		limit:   limit,
+		windows: make(map[string]*rateWindow),
+	}
+}
+
+// allow 记录一次请求，超过窗口内的次数限制时返回 false
+func (l *rateLimiter) allow(userID string, now time.Time) bool {
+	if l.limit.MaxRequests <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// 清理已过期的窗口，避免 map 随用户数无限增长
+	for key, window := range l.windows {
+		if now.Sub(window.start) >= l.limit.Window {
+			delete(l.windows, key)
+		}
+	}
+
+	window, ok := l.windows[userID]
+	if !ok {
+		l.windows[userID] = &rateWindow{start: now, count: 1}
+		return true
+	}
+	if window.count >= l.limit.MaxRequests {
+		return false
+	}
+	window.count++
+	return true
+}
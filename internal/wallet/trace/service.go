@@ -0,0 +1,417 @@
+package trace
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// ERC20 Transfer(address,address,uint256) 事件签名
+var transferEventSignature = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+// transferEventTopics Transfer 事件的 topic 数量（签名、from、to）
+const transferEventTopics = 3
+
+var (
+	// ErrInvalidTxHash 交易哈希格式不正确
+	ErrInvalidTxHash = errors.New("invalid tx hash")
+	// ErrChainNotFound 链不存在或未启用
+	ErrChainNotFound = walleterrors.ErrChainNotFound
+	// ErrUnsupportedChain 链类型不支持充值排查（目前只支持 EVM 链）
+	ErrUnsupportedChain = errors.New("deposit trace is not supported on this chain")
+	// ErrRateLimited 用户排查请求过于频繁
+	ErrRateLimited = errors.New("too many deposit trace requests")
+)
+
+// 排查结论，按检查顺序给出第一个未通过的环节
+const (
+	DiagnosisTxNotFound       = "tx_not_found"      // 链上查不到该交易（哈希错误或交易已被丢弃）
+	DiagnosisTxPending        = "tx_pending"        // 交易仍在交易池中未打包
+	DiagnosisTxFailed         = "tx_failed"         // 交易执行失败
+	DiagnosisNotUserAddress   = "not_user_address"  // 交易没有转入用户在该链的地址
+	DiagnosisUnsupportedToken = "unsupported_token" // 转入的代币未登记或未启用
+	DiagnosisBlockNotScanned  = "block_not_scanned" // 交易所在区块尚未扫描
+	DiagnosisNotRecorded      = "not_recorded"      // 区块已扫描但未记录该充值（如被充值规则拒绝）
+	DiagnosisBelowMinimum     = "below_minimum"     // 金额低于代币最小充值金额，记录为 dust 不入账
+	DiagnosisQuarantined      = "quarantined"       // 来源地址命中筛查名单，充值已隔离
+	DiagnosisConfirming       = "confirming"        // 已记录，等待达到终结区块数后入账
+	DiagnosisCredited         = "credited"          // 已入账
+)
+
+// Service 充值排查服务接口
+// 用户提交交易哈希，只读地检查链上交易和扫描记录，给出充值未到账的原因
+type Service interface {
+	// TraceDeposit 排查用户的一笔充值交易，每个用户在 Window 内最多排查 MaxRequests 次
+	TraceDeposit(ctx context.Context, req *Request) (*Result, error)
+}
+
+// Config 充值排查配置
+type Config struct {
+	RateLimit RateLimit
+}
+
+// RateLimit 排查频率限制：每个用户在 Window 内最多排查 MaxRequests 次（MaxRequests 为 0 表示不限制）
+type RateLimit struct {
+	MaxRequests int
+	Window      time.Duration
+}
+
+// Request 充值排查请求
+type Request struct {
+	UserID  string
+	ChainID int
+	TxHash  string
+}
+
+// Transfer 交易中转入用户地址的一笔转账
+type Transfer struct {
+	ToAddress    string
+	TokenAddress string // 原生代币为空
+	TokenID      *int   // 代币未登记或未启用时为空
+	TokenSymbol  string
+	Amount       string // 最小单位金额
+	Supported    bool   // 代币已登记且启用
+}
+
+// Result 充值排查结果
+type Result struct {
+	ChainID   int
+	TxHash    string
+	Diagnosis string
+
+	Found     bool
+	Pending   bool
+	Succeeded bool
+
+	BlockNumber           *int64
+	BlockHash             string
+	Confirmations         int64
+	RequiredConfirmations int64 // 入账需要的终结区块数
+
+	FromAddress string
+	ToAddress   string
+	Transfers   []*Transfer
+
+	BlockScanned bool
+	ScannedTo    *int64 // 已扫描的最新区块，未检查扫描记录时为空
+
+	Recorded          bool   // 扫描器已记录该充值交易
+	TransactionStatus string // 已记录时的交易状态
+	CreditStatus      string // 已生成入账记录时的状态
+}
+
+type service struct {
+	db           *sql.DB
+	chainService chain.Service
+	scanService  scan.Service
+	limiter      *rateLimiter
+}
+
+// NewService 创建充值排查服务
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(db *sql.DB, config Config, chainService chain.Service, scanService scan.Service) Service {
+	return &service{
+		db:           db,
+		chainService: chainService,
+		scanService:  scanService,
+		limiter:      newRateLimiter(config.RateLimit),
+	}
+}
+
+// TraceDeposit 依次检查：交易是否上链、是否转入用户地址、代币是否支持、区块是否已扫描、是否已记录和入账
+func (s *service) TraceDeposit(ctx context.Context, req *Request) (*Result, error) {
+	txHashBytes, err := hexToHash(req.TxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	chainConfig, err := s.chainService.GetChain(ctx, req.ChainID)
+	if err != nil {
+		if errors.Is(err, walleterrors.ErrChainNotFound) {
+			return nil, ErrChainNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get chain")
+	}
+	if !chainConfig.IsActive {
+		return nil, ErrChainNotFound
+	}
+	if chainConfig.ChainType != chain.TypeEVM {
+		return nil, ErrUnsupportedChain
+	}
+
+	if !s.limiter.allow(req.UserID, time.Now()) {
+		return nil, ErrRateLimited
+	}
+
+	result := &Result{
+		ChainID:               req.ChainID,
+		TxHash:                strings.ToLower(txHashBytes.Hex()),
+		RequiredConfirmations: int64(chainConfig.FinalizedBlocks.Int),
+	}
+
+	client, err := s.scanService.GetClient(ctx, req.ChainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get RPC client")
+	}
+
+	if err := s.traceOnChain(ctx, client, txHashBytes, req, result); err != nil {
+		return nil, err
+	}
+	if result.Found && !result.Pending && result.Succeeded && len(result.Transfers) > 0 {
+		if err := s.traceScan(ctx, req, result); err != nil {
+			return nil, err
+		}
+	}
+
+	result.Diagnosis = diagnose(result)
+
+	return result, nil
+}
+
+// traceOnChain 查询链上交易和收据，解析转入用户地址的原生代币和 ERC20 转账
+func (s *service) traceOnChain(ctx context.Context, client *scan.RPCClient, txHash common.Hash, req *Request, result *Result) error {
+	tx, isPending, err := client.GetTransactionByHash(ctx, txHash)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to get transaction")
+	}
+	result.Found = true
+	result.Pending = isPending
+	if tx.To() != nil {
+		result.ToAddress = strings.ToLower(tx.To().Hex())
+	}
+	if from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx); err == nil {
+		result.FromAddress = strings.ToLower(from.Hex())
+	}
+	if isPending {
+		return nil
+	}
+
+	receipt, err := client.GetTransactionReceipt(ctx, txHash)
+	if err != nil {
+		return errors.Wrap(err, "failed to get transaction receipt")
+	}
+	blockNumber := receipt.BlockNumber.Int64()
+	result.BlockNumber = &blockNumber
+	result.BlockHash = strings.ToLower(receipt.BlockHash.Hex())
+	result.Succeeded = receipt.Status == types.ReceiptStatusSuccessful
+
+	latestBlock, err := client.GetLatestBlockNumber(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get latest block number")
+	}
+	result.Confirmations = max(latestBlock.Int64()-blockNumber+1, 0)
+
+	if !result.Succeeded {
+		return nil
+	}
+
+	addresses, err := s.userAddresses(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if result.ToAddress != "" && addresses[result.ToAddress] && tx.Value().Sign() > 0 {
+		result.Transfers = append(result.Transfers, &Transfer{
+			ToAddress: result.ToAddress,
+			Amount:    tx.Value().String(),
+		})
+	}
+	for _, logEntry := range receipt.Logs {
+		if len(logEntry.Topics) != transferEventTopics || logEntry.Topics[0] != transferEventSignature {
+			continue
+		}
+		toAddress := strings.ToLower(common.BytesToAddress(logEntry.Topics[2].Bytes()).Hex())
+		if !addresses[toAddress] {
+			continue
+		}
+		result.Transfers = append(result.Transfers, &Transfer{
+			ToAddress:    toAddress,
+			TokenAddress: strings.ToLower(logEntry.Address.Hex()),
+			Amount:       new(big.Int).SetBytes(logEntry.Data).String(),
+		})
+	}
+
+	return s.resolveTokens(ctx, req.ChainID, result.Transfers)
+}
+
+// userAddresses 用户在该链的所有地址（派生地址和观察地址）
+func (s *service) userAddresses(ctx context.Context, req *Request) (map[string]bool, error) {
+	wallets, err := models.Wallets(
+		models.WalletWhere.UserID.EQ(req.UserID),
+		models.WalletWhere.ChainID.EQ(req.ChainID),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user wallets")
+	}
+
+	addresses := make(map[string]bool, len(wallets))
+	for _, wallet := range wallets {
+		addresses[strings.ToLower(wallet.Address)] = true
+	}
+	return addresses, nil
+}
+
+// resolveTokens 按合约地址匹配已启用的代币，原生代币匹配链的原生代币记录
+func (s *service) resolveTokens(ctx context.Context, chainID int, transfers []*Transfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	tokens, err := models.Tokens(
+		models.TokenWhere.ChainID.EQ(chainID),
+		models.TokenWhere.IsActive.EQ(true),
+	).All(ctx, s.db)
+	if err != nil {
+		return errors.Wrap(err, "failed to get tokens")
+	}
+
+	for _, transfer := range transfers {
+		for _, token := range tokens {
+			matched := token.IsNative && transfer.TokenAddress == ""
+			if !token.IsNative && token.TokenAddress.Valid {
+				matched = strings.EqualFold(token.TokenAddress.String, transfer.TokenAddress)
+			}
+			if matched {
+				tokenID := token.ID
+				transfer.TokenID = &tokenID
+				transfer.TokenSymbol = token.TokenSymbol
+				transfer.Supported = true
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// traceScan 检查交易所在区块是否已扫描，以及扫描器记录的充值交易和入账记录
+func (s *service) traceScan(ctx context.Context, req *Request, result *Result) error {
+	var scannedTo int64
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT COALESCE(MAX(number), 0)
+		FROM blocks
+		WHERE chain_id = $1 AND status != 'orphaned'
+	`, req.ChainID).Scan(&scannedTo); err != nil {
+		return errors.Wrap(err, "failed to query scanned block")
+	}
+	result.ScannedTo = &scannedTo
+
+	blockScanned, err := models.Blocks(
+		models.BlockWhere.ChainID.EQ(req.ChainID),
+		models.BlockWhere.Hash.EQ(result.BlockHash),
+		qm.Where("status != 'orphaned'"),
+	).Exists(ctx, s.db)
+	if err != nil {
+		return errors.Wrap(err, "failed to check scanned block")
+	}
+	// 超过保留时间的区块可能已移到归档表，归档的区块同样已扫描
+	if !blockScanned {
+		if err := s.db.QueryRowContext(ctx, `
+			SELECT EXISTS (SELECT 1 FROM blocks_archive WHERE chain_id = $1 AND hash = $2 AND status <> 'orphaned')
+		`, req.ChainID, result.BlockHash).Scan(&blockScanned); err != nil {
+			return errors.Wrap(err, "failed to check archived block")
+		}
+	}
+	result.BlockScanned = blockScanned
+	if !blockScanned {
+		return nil
+	}
+
+	transaction, err := models.Transactions(
+		models.TransactionWhere.ChainID.EQ(req.ChainID),
+		models.TransactionWhere.TXHash.EQ(result.TxHash),
+		models.TransactionWhere.Type.EQ(models.TransactionTypeDeposit),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to get deposit transaction")
+	}
+	result.Recorded = true
+	result.TransactionStatus = transaction.Status.String()
+
+	credit, err := models.Credits(
+		models.CreditWhere.UserID.EQ(req.UserID),
+		models.CreditWhere.ReferenceID.EQ(transaction.ID),
+		models.CreditWhere.ReferenceType.EQ(models.ReferenceTypeBlockchainTX),
+		qm.OrderBy(models.CreditColumns.CreatedAt+" DESC"),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to get deposit credit")
+	}
+	result.CreditStatus = credit.Status.String()
+
+	return nil
+}
+
+// diagnose 按检查顺序返回第一个未通过环节的结论
+func diagnose(result *Result) string {
+	switch {
+	case !result.Found:
+		return DiagnosisTxNotFound
+	case result.Pending:
+		return DiagnosisTxPending
+	case !result.Succeeded:
+		return DiagnosisTxFailed
+	case len(result.Transfers) == 0:
+		return DiagnosisNotUserAddress
+	case !hasSupportedTransfer(result.Transfers):
+		return DiagnosisUnsupportedToken
+	case !result.BlockScanned:
+		return DiagnosisBlockNotScanned
+	case !result.Recorded:
+		return DiagnosisNotRecorded
+	case result.TransactionStatus == models.TransactionStatusDust.String():
+		return DiagnosisBelowMinimum
+	case result.CreditStatus == models.CreditStatusFrozen.String():
+		return DiagnosisQuarantined
+	case result.CreditStatus == "":
+		return DiagnosisConfirming
+	default:
+		return DiagnosisCredited
+	}
+}
+
+func hasSupportedTransfer(transfers []*Transfer) bool {
+	for _, transfer := range transfers {
+		if transfer.Supported {
+			return true
+		}
+	}
+	return false
+}
+
+// hexToHash 校验并解析 32 字节的十六进制交易哈希
+func hexToHash(txHash string) (common.Hash, error) {
+	const hashHexLength = 2 + common.HashLength*2
+	if len(txHash) != hashHexLength || !strings.HasPrefix(txHash, "0x") {
+		return common.Hash{}, ErrInvalidTxHash
+	}
+	for _, c := range txHash[2:] {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return common.Hash{}, ErrInvalidTxHash
+		}
+	}
+	return common.HexToHash(txHash), nil
+}
@@ -0,0 +1,77 @@
+package trace
+
+import (
+	"testing"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiagnose(t *testing.T) {
+	supported := []*Transfer{{ToAddress: "0xabc", Amount: "1", Supported: true}}
+	unsupported := []*Transfer{{ToAddress: "0xabc", TokenAddress: "0xdef", Amount: "1"}}
+
+	tests := []struct {
+		name   string
+		result *Result
+		want   string
+	}{
+		{"not found", &Result{}, DiagnosisTxNotFound},
+		{"pending", &Result{Found: true, Pending: true}, DiagnosisTxPending},
+		{"failed", &Result{Found: true}, DiagnosisTxFailed},
+		{"not user address", &Result{Found: true, Succeeded: true}, DiagnosisNotUserAddress},
+		{"unsupported token", &Result{Found: true, Succeeded: true, Transfers: unsupported}, DiagnosisUnsupportedToken},
+		{"block not scanned", &Result{Found: true, Succeeded: true, Transfers: supported}, DiagnosisBlockNotScanned},
+		{"not recorded", &Result{Found: true, Succeeded: true, Transfers: supported, BlockScanned: true}, DiagnosisNotRecorded},
+		{"confirming", &Result{Found: true, Succeeded: true, Transfers: supported, BlockScanned: true, Recorded: true}, DiagnosisConfirming},
+		{
+			"below minimum",
+			&Result{Found: true, Succeeded: true, Transfers: supported, BlockScanned: true, Recorded: true, TransactionStatus: models.TransactionStatusDust.String()},
+			DiagnosisBelowMinimum,
+		},
+		{
+			"quarantined",
+			&Result{Found: true, Succeeded: true, Transfers: supported, BlockScanned: true, Recorded: true, CreditStatus: models.CreditStatusFrozen.String()},
+			DiagnosisQuarantined,
+		},
+		{
+			"credited",
+			&Result{Found: true, Succeeded: true, Transfers: supported, BlockScanned: true, Recorded: true, CreditStatus: models.CreditStatusFinalized.String()},
+			DiagnosisCredited,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, diagnose(tt.result))
+		})
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := newRateLimiter(RateLimit{MaxRequests: 2, Window: time.Minute})
+	now := time.Now()
+
+	assert.True(t, limiter.allow("user-1", now))
+	assert.True(t, limiter.allow("user-1", now.Add(time.Second)))
+	assert.False(t, limiter.allow("user-1", now.Add(2*time.Second)))
+	assert.True(t, limiter.allow("user-2", now.Add(2*time.Second)), "limits are per user")
+	assert.True(t, limiter.allow("user-1", now.Add(time.Minute)), "a new window starts after the window expired")
+
+	unlimited := newRateLimiter(RateLimit{})
+	for range 10 {
+		assert.True(t, unlimited.allow("user-1", now))
+	}
+}
+
+func TestHexToHash(t *testing.T) {
+	_, err := hexToHash("0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060")
+	assert.NoError(t, err)
+
+	for _, txHash := range []string{"", "0x1234", "5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b2206000", "0xzc504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"} {
+		_, err := hexToHash(txHash)
+		assert.ErrorIs(t, err, ErrInvalidTxHash, txHash)
+	}
+}
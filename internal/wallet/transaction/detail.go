@@ -0,0 +1,321 @@
+package transaction
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// ERC20 Transfer(address,address,uint256) 事件签名
+var transferEventSignature = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+// ERC20 Transfer 事件的 topic 数量（签名、from、to）和 data 长度（value）
+const (
+	transferEventTopics   = 3
+	transferEventDataSize = 32
+)
+
+// 链上交易状态
+const (
+	OnChainStatusNotFound = "not_found" // 链上查不到该交易（哈希错误、交易被丢弃或不在该链）
+	OnChainStatusPending  = "pending"   // 交易仍在交易池中未打包
+	OnChainStatusSuccess  = "success"   // 交易已打包且执行成功
+	OnChainStatusFailed   = "failed"    // 交易已打包但执行失败
+)
+
+var (
+	// ErrInvalidTxHash 交易哈希格式不正确
+	ErrInvalidTxHash = errors.New("invalid tx hash")
+	// ErrTransactionNotFound 数据库中没有该交易，且未指定要核对的链
+	ErrTransactionNotFound = errors.New("transaction not found")
+)
+
+// Detail 交易详情：数据库中的记录和每条链的链上核对结果
+type Detail struct {
+	TxHash       string
+	Transactions []*models.Transaction // 同一交易可能有多条记录（如一笔交易中的多笔转账）
+	Checks       []*ChainCheck
+}
+
+// ChainCheck 交易在一条链上的核对结果
+type ChainCheck struct {
+	ChainID   int
+	ChainType string
+	Supported bool   // 目前只支持 EVM 链核对，其他链只返回数据库记录
+	Error     string // RPC 查询失败的原因，不影响数据库记录的返回
+
+	Status        string // OnChainStatus*，未核对时为空
+	BlockNumber   *int64
+	BlockHash     string
+	LatestBlock   *int64
+	Confirmations int64
+	// BlockScanned 交易所在区块已被扫描（包括已归档的区块）且不是孤块
+	BlockScanned bool
+	// RecordsMatch 数据库记录的区块哈希与链上一致，不一致说明记录来自被重组回滚的区块；该链没有记录时为空
+	RecordsMatch *bool
+
+	FromAddress    string
+	ToAddress      string
+	Value          string // 原生代币金额（最小单位）
+	TokenTransfers []*TokenTransfer
+}
+
+// TokenTransfer 交易收据中解析出的 ERC20 转账
+type TokenTransfer struct {
+	TokenAddress string
+	TokenID      *int   // 代币未登记时为空
+	TokenSymbol  string // 代币未登记时为空
+	FromAddress  string
+	ToAddress    string
+	Amount       string // 最小单位金额
+}
+
+// GetTransactionDetail 查询交易的数据库记录，并在记录所在的链（或指定的链）上核对收据状态、区块、确认数和代币转账
+// chainID 不为空时只查询该链，数据库中没有记录时仍会核对链上交易
+func (s *service) GetTransactionDetail(ctx context.Context, txHash string, chainID *int) (*Detail, error) {
+	txHash, err := normalizeTxHash(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	mods := []qm.QueryMod{
+		models.TransactionWhere.TXHash.EQ(txHash),
+		qm.OrderBy(models.TransactionColumns.ChainID + " ASC, " + models.TransactionColumns.CreatedAt + " ASC"),
+	}
+	if chainID != nil {
+		mods = append(mods, models.TransactionWhere.ChainID.EQ(*chainID))
+	}
+	transactions, err := models.Transactions(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query transactions")
+	}
+
+	chainIDs := make([]int, 0)
+	if chainID != nil {
+		chainIDs = append(chainIDs, *chainID)
+	} else {
+		for _, tx := range transactions {
+			if len(chainIDs) == 0 || chainIDs[len(chainIDs)-1] != tx.ChainID {
+				chainIDs = append(chainIDs, tx.ChainID)
+			}
+		}
+	}
+	if len(chainIDs) == 0 {
+		return nil, ErrTransactionNotFound
+	}
+
+	detail := &Detail{
+		TxHash:       txHash,
+		Transactions: transactions,
+		Checks:       make([]*ChainCheck, 0, len(chainIDs)),
+	}
+	for _, id := range chainIDs {
+		check, err := s.checkOnChain(ctx, id, txHash, transactions, chainID != nil)
+		if err != nil {
+			return nil, err
+		}
+		detail.Checks = append(detail.Checks, check)
+	}
+
+	return detail, nil
+}
+
+// checkOnChain 在一条链上核对交易，RPC 失败记录在结果中而不是返回错误，避免节点故障时查不到数据库记录
+// explicit 表示链由调用方指定，此时链不存在返回错误；记录所在的链已被删除或停用时只返回不支持核对
+func (s *service) checkOnChain(ctx context.Context, chainID int, txHash string, transactions []*models.Transaction, explicit bool) (*ChainCheck, error) {
+	check := &ChainCheck{ChainID: chainID}
+
+	chainConfig, err := s.chainService.GetChain(ctx, chainID)
+	if err != nil {
+		if errors.Is(err, walleterrors.ErrChainNotFound) && !explicit {
+			return check, nil
+		}
+		return nil, errors.Wrap(err, "failed to get chain")
+	}
+	check.ChainType = chainConfig.ChainType
+	if !chainConfig.IsActive {
+		if explicit {
+			return nil, walleterrors.ErrChainNotFound
+		}
+		return check, nil
+	}
+	if chainConfig.ChainType != chain.TypeEVM {
+		return check, nil
+	}
+	check.Supported = true
+
+	// 非 0x 开头的哈希（如 Solana 签名）不可能是 EVM 交易
+	if !strings.HasPrefix(txHash, "0x") {
+		check.Status = OnChainStatusNotFound
+		return check, nil
+	}
+
+	if err := s.fetchOnChain(ctx, chainID, common.HexToHash(txHash), check); err != nil {
+		log.Warn().Err(err).Int("chain_id", chainID).Str("tx_hash", txHash).Msg("Failed to cross-check transaction on chain")
+		check.Error = err.Error()
+		return check, nil
+	}
+	if check.BlockNumber == nil {
+		return check, nil
+	}
+
+	if err := s.checkRecords(ctx, chainID, transactions, check); err != nil {
+		return nil, err
+	}
+
+	return check, nil
+}
+
+// fetchOnChain 通过 RPC 查询交易、收据和最新区块，解析 ERC20 转账
+func (s *service) fetchOnChain(ctx context.Context, chainID int, txHash common.Hash, check *ChainCheck) error {
+	client, err := s.scanService.GetClient(ctx, chainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get RPC client")
+	}
+
+	tx, isPending, err := client.GetTransactionByHash(ctx, txHash)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			check.Status = OnChainStatusNotFound
+			return nil
+		}
+		return errors.Wrap(err, "failed to get transaction")
+	}
+	if tx.To() != nil {
+		check.ToAddress = strings.ToLower(tx.To().Hex())
+	}
+	if from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx); err == nil {
+		check.FromAddress = strings.ToLower(from.Hex())
+	}
+	check.Value = tx.Value().String()
+	if isPending {
+		check.Status = OnChainStatusPending
+		return nil
+	}
+
+	receipt, err := client.GetTransactionReceipt(ctx, txHash)
+	if err != nil {
+		return errors.Wrap(err, "failed to get transaction receipt")
+	}
+	check.Status = OnChainStatusFailed
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		check.Status = OnChainStatusSuccess
+	}
+	blockNumber := receipt.BlockNumber.Int64()
+	check.BlockNumber = &blockNumber
+	check.BlockHash = strings.ToLower(receipt.BlockHash.Hex())
+
+	latestBlock, err := client.GetLatestBlockNumber(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get latest block number")
+	}
+	latest := latestBlock.Int64()
+	check.LatestBlock = &latest
+	check.Confirmations = confirmations(latest, blockNumber)
+
+	check.TokenTransfers = decodeTokenTransfers(receipt.Logs)
+
+	return nil
+}
+
+// checkRecords 核对扫描记录：所在区块是否已扫描、数据库记录的区块哈希是否与链上一致，并补充代币信息
+func (s *service) checkRecords(ctx context.Context, chainID int, transactions []*models.Transaction, check *ChainCheck) error {
+	// 超过保留时间的区块可能已移到归档表，归档的区块同样已扫描
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM blocks WHERE chain_id = $1 AND hash = $2 AND status <> 'orphaned')
+			OR EXISTS (SELECT 1 FROM blocks_archive WHERE chain_id = $1 AND hash = $2 AND status <> 'orphaned')
+	`, chainID, check.BlockHash).Scan(&check.BlockScanned); err != nil {
+		return errors.Wrap(err, "failed to check scanned block")
+	}
+
+	for _, tx := range transactions {
+		if tx.ChainID != chainID {
+			continue
+		}
+		match := strings.EqualFold(tx.BlockHash, check.BlockHash)
+		if check.RecordsMatch == nil || !match {
+			check.RecordsMatch = &match
+		}
+	}
+
+	if len(check.TokenTransfers) == 0 {
+		return nil
+	}
+
+	tokens, err := models.Tokens(
+		models.TokenWhere.ChainID.EQ(chainID),
+		models.TokenWhere.IsNative.EQ(false),
+	).All(ctx, s.db)
+	if err != nil {
+		return errors.Wrap(err, "failed to get tokens")
+	}
+	for _, transfer := range check.TokenTransfers {
+		for _, token := range tokens {
+			if token.TokenAddress.Valid && strings.EqualFold(token.TokenAddress.String, transfer.TokenAddress) {
+				tokenID := token.ID
+				transfer.TokenID = &tokenID
+				transfer.TokenSymbol = token.TokenSymbol
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// decodeTokenTransfers 解析收据中的 ERC20 Transfer 事件，忽略 topic 数或 data 长度不符的事件（如 ERC-721 Transfer）
+func decodeTokenTransfers(logs []*types.Log) []*TokenTransfer {
+	transfers := make([]*TokenTransfer, 0)
+	for _, logEntry := range logs {
+		if len(logEntry.Topics) != transferEventTopics || logEntry.Topics[0] != transferEventSignature || len(logEntry.Data) != transferEventDataSize {
+			continue
+		}
+		transfers = append(transfers, &TokenTransfer{
+			TokenAddress: strings.ToLower(logEntry.Address.Hex()),
+			FromAddress:  strings.ToLower(common.BytesToAddress(logEntry.Topics[1].Bytes()).Hex()),
+			ToAddress:    strings.ToLower(common.BytesToAddress(logEntry.Topics[2].Bytes()).Hex()),
+			Amount:       new(big.Int).SetBytes(logEntry.Data).String(),
+		})
+	}
+
+	return transfers
+}
+
+// confirmations 交易所在区块的确认数（所在区块计为 1），节点落后于交易所在区块时为 0
+func confirmations(latestBlock int64, blockNumber int64) int64 {
+	return max(latestBlock-blockNumber+1, 0)
+}
+
+// normalizeTxHash EVM 交易哈希校验为 32 字节十六进制并转为小写，其他链（如 Solana 签名）保持不变
+func normalizeTxHash(txHash string) (string, error) {
+	const hashHexLength = 2 + common.HashLength*2
+
+	txHash = strings.TrimSpace(txHash)
+	if txHash == "" {
+		return "", ErrInvalidTxHash
+	}
+	if !strings.HasPrefix(txHash, "0x") {
+		return txHash, nil
+	}
+	if len(txHash) != hashHexLength {
+		return "", ErrInvalidTxHash
+	}
+	for _, c := range txHash[2:] {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return "", ErrInvalidTxHash
+		}
+	}
+
+	return strings.ToLower(txHash), nil
+}
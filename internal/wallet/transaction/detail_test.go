@@ -0,0 +1,68 @@
+package transaction
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeTokenTransfers(t *testing.T) {
+	token := common.HexToAddress("0x55d398326f99059fF775485246999027B3197955")
+	from := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	to := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	addressTopic := func(address common.Address) common.Hash {
+		return common.BytesToHash(address.Bytes())
+	}
+
+	transfers := decodeTokenTransfers([]*types.Log{
+		{
+			Address: token,
+			Topics:  []common.Hash{transferEventSignature, addressTopic(from), addressTopic(to)},
+			Data:    common.BigToHash(big.NewInt(1000)).Bytes(),
+		},
+		// ERC-721 Transfer：tokenId 在第 4 个 topic 中，不是 ERC20 转账
+		{
+			Address: token,
+			Topics:  []common.Hash{transferEventSignature, addressTopic(from), addressTopic(to), common.BigToHash(big.NewInt(1))},
+		},
+		// 其他事件
+		{
+			Address: token,
+			Topics:  []common.Hash{common.HexToHash("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"), addressTopic(from), addressTopic(to)},
+			Data:    common.BigToHash(big.NewInt(5)).Bytes(),
+		},
+	})
+
+	require.Len(t, transfers, 1)
+	assert.Equal(t, "0x55d398326f99059ff775485246999027b3197955", transfers[0].TokenAddress)
+	assert.Equal(t, "0x2222222222222222222222222222222222222222", transfers[0].FromAddress)
+	assert.Equal(t, "0x3333333333333333333333333333333333333333", transfers[0].ToAddress)
+	assert.Equal(t, "1000", transfers[0].Amount)
+	assert.Nil(t, transfers[0].TokenID)
+}
+
+func TestConfirmations(t *testing.T) {
+	assert.Equal(t, int64(1), confirmations(100, 100))
+	assert.Equal(t, int64(11), confirmations(110, 100))
+	assert.Equal(t, int64(0), confirmations(99, 100))
+}
+
+func TestNormalizeTxHash(t *testing.T) {
+	hash, err := normalizeTxHash(" 0x5C504ED432CB51138BCF09AA5E8A410DD4A1E204EF84BFED1BE16DFBA1B22060 ")
+	require.NoError(t, err)
+	assert.Equal(t, "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060", hash)
+
+	signature := "5VERv8NMvzbJMEkV8xnrLkEaWRtSz9CosKDYjCJjBRnbJLgp8uirBgmQpjKhoR4tjF3ZpRzrFmBV6UjKdiSZkQUW"
+	hash, err = normalizeTxHash(signature)
+	require.NoError(t, err)
+	assert.Equal(t, signature, hash)
+
+	for _, invalid := range []string{"", "0x1234", "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b2206z"} {
+		_, err := normalizeTxHash(invalid)
+		assert.ErrorIs(t, err, ErrInvalidTxHash, invalid)
+	}
+}
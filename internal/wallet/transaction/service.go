@@ -0,0 +1,254 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// cursorSeparator 游标中 created_at 和 id 的分隔符
+const cursorSeparator = "|"
+
+var (
+	// ErrInvalidCursor 分页游标无法解析
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrTokenNotFound 筛选的代币不存在
+	ErrTokenNotFound = walleterrors.ErrTokenNotFound
+)
+
+// Service 链上交易查询服务接口
+// 按条件查询 transactions 表（管理员查询全部交易，用户只能查询自己钱包地址的交易），
+// 按创建时间倒序游标分页，新写入的交易不会导致翻页时重复或遗漏
+type Service interface {
+	// ListTransactions 查询交易
+	ListTransactions(ctx context.Context, filter *Filter) (*Page, error)
+
+	// GetTransactionDetail 按交易哈希查询数据库记录，并通过 RPC 核对链上状态，供客服排查交易问题
+	GetTransactionDetail(ctx context.Context, txHash string, chainID *int) (*Detail, error)
+}
+
+// Filter 交易查询条件，字段为空表示不限
+type Filter struct {
+	UserID        *string // 只查询发送方或接收方为该用户钱包地址的交易
+	Type          *string
+	Status        *string
+	ChainID       *int
+	TokenID       *int    // 原生代币匹配 token_addr 为空的交易
+	Address       *string // 匹配发送方或接收方
+	FromBlock     *int64
+	ToBlock       *int64
+	CreatedAfter  *time.Time // 包含
+	CreatedBefore *time.Time // 不包含
+	Cursor        *string    // 上一页返回的 NextCursor
+	Limit         int
+}
+
+// Page 一页交易
+type Page struct {
+	Transactions []*models.Transaction
+	NextCursor   *string // 没有下一页时为空
+	// TotalEstimate 符合条件的交易总数估算（来自查询计划，不随翻页变化，数据量大时避免 COUNT(*) 全表扫描）
+	TotalEstimate int64
+}
+
+type service struct {
+	db           *sql.DB
+	chainService chain.Service
+	scanService  scan.Service
+}
+
+// NewService 创建交易查询服务
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(db *sql.DB, chainService chain.Service, scanService scan.Service) Service {
+	return &service{
+		db:           db,
+		chainService: chainService,
+		scanService:  scanService,
+	}
+}
+
+// ListTransactions 查询交易，按 (created_at, id) 倒序
+func (s *service) ListTransactions(ctx context.Context, filter *Filter) (*Page, error) {
+	mods, err := s.filterMods(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	totalEstimate, err := s.estimateCount(ctx, mods)
+	if err != nil {
+		return nil, err
+	}
+
+	pageMods := append([]qm.QueryMod{}, mods...)
+	if filter.Cursor != nil && *filter.Cursor != "" {
+		createdAt, id, err := decodeCursor(*filter.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		pageMods = append(pageMods, qm.Where("("+models.TransactionColumns.CreatedAt+", "+models.TransactionColumns.ID+") < (?, ?)", createdAt, id))
+	}
+	// 多查一条判断是否还有下一页
+	pageMods = append(pageMods,
+		qm.OrderBy(models.TransactionColumns.CreatedAt+" DESC, "+models.TransactionColumns.ID+" DESC"),
+		qm.Limit(filter.Limit+1),
+	)
+
+	transactions, err := models.Transactions(pageMods...).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query transactions")
+	}
+
+	page := &Page{
+		Transactions:  transactions,
+		TotalEstimate: totalEstimate,
+	}
+	if len(transactions) > filter.Limit {
+		page.Transactions = transactions[:filter.Limit]
+		last := page.Transactions[len(page.Transactions)-1]
+		cursor := encodeCursor(last.CreatedAt, last.ID)
+		page.NextCursor = &cursor
+	}
+
+	// 第一页已包含全部结果时总数是准确的
+	if (filter.Cursor == nil || *filter.Cursor == "") && page.NextCursor == nil {
+		page.TotalEstimate = int64(len(page.Transactions))
+	}
+
+	return page, nil
+}
+
+// filterMods 将查询条件转换为查询语句
+func (s *service) filterMods(ctx context.Context, filter *Filter) ([]qm.QueryMod, error) {
+	var mods []qm.QueryMod
+
+	if filter.UserID != nil {
+		mods = append(mods, qm.Where(`EXISTS (
+			SELECT 1 FROM wallets w
+			WHERE w.user_id = ? AND w.chain_id = transactions.chain_id
+				AND wallet_address_key(w.chain_type, w.address) IN (
+					wallet_address_key(w.chain_type, transactions.from_addr),
+					wallet_address_key(w.chain_type, transactions.to_addr)
+				)
+		)`, *filter.UserID))
+	}
+	if filter.Type != nil {
+		mods = append(mods, models.TransactionWhere.Type.EQ(models.TransactionType(*filter.Type)))
+	}
+	if filter.Status != nil {
+		mods = append(mods, models.TransactionWhere.Status.EQ(models.TransactionStatus(*filter.Status)))
+	}
+	if filter.ChainID != nil {
+		mods = append(mods, models.TransactionWhere.ChainID.EQ(*filter.ChainID))
+	}
+	if filter.TokenID != nil {
+		token, err := models.FindToken(ctx, s.db, *filter.TokenID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, ErrTokenNotFound
+			}
+			return nil, errors.Wrap(err, "failed to get token")
+		}
+
+		mods = append(mods, models.TransactionWhere.ChainID.EQ(token.ChainID))
+		if token.IsNative {
+			mods = append(mods, models.TransactionWhere.TokenAddr.IsNull())
+		} else {
+			mods = append(mods, models.TransactionWhere.TokenAddr.EQ(token.TokenAddress))
+		}
+	}
+	if filter.Address != nil {
+		address := normalizeAddress(*filter.Address)
+		mods = append(mods, qm.Expr(
+			models.TransactionWhere.FromAddr.EQ(address),
+			qm.Or2(models.TransactionWhere.ToAddr.EQ(address)),
+		))
+	}
+	if filter.FromBlock != nil {
+		mods = append(mods, models.TransactionWhere.BlockNo.GTE(*filter.FromBlock))
+	}
+	if filter.ToBlock != nil {
+		mods = append(mods, models.TransactionWhere.BlockNo.LTE(*filter.ToBlock))
+	}
+	if filter.CreatedAfter != nil {
+		mods = append(mods, models.TransactionWhere.CreatedAt.GTE(*filter.CreatedAfter))
+	}
+	if filter.CreatedBefore != nil {
+		mods = append(mods, models.TransactionWhere.CreatedAt.LT(*filter.CreatedBefore))
+	}
+
+	return mods, nil
+}
+
+// estimateCount 通过 EXPLAIN 读取查询计划估算的行数
+func (s *service) estimateCount(ctx context.Context, mods []qm.QueryMod) (int64, error) {
+	query, args := queries.BuildQuery(models.Transactions(mods...).Query)
+
+	var plan string
+	if err := s.db.QueryRowContext(ctx, "EXPLAIN (FORMAT JSON) "+query, args...).Scan(&plan); err != nil {
+		return 0, errors.Wrap(err, "failed to explain transactions query")
+	}
+
+	var explained []struct {
+		Plan struct {
+			PlanRows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(plan), &explained); err != nil {
+		return 0, errors.Wrap(err, "failed to parse transactions query plan")
+	}
+	if len(explained) == 0 {
+		return 0, errors.New("transactions query plan is empty")
+	}
+
+	return int64(explained[0].Plan.PlanRows), nil
+}
+
+// normalizeAddress EVM 地址统一为小写，其他链（如 Solana）地址区分大小写保持不变
+func normalizeAddress(address string) string {
+	address = strings.TrimSpace(address)
+	if common.IsHexAddress(address) {
+		return chain.NormalizeAddress(chain.TypeEVM, address)
+	}
+
+	return address
+}
+
+func encodeCursor(createdAt time.Time, id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(createdAt.UTC().Format(time.RFC3339Nano) + cursorSeparator + id))
+}
+
+func decodeCursor(cursor string) (time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", ErrInvalidCursor
+	}
+
+	createdAtStr, id, ok := strings.Cut(string(raw), cursorSeparator)
+	if !ok {
+		return time.Time{}, "", ErrInvalidCursor
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return time.Time{}, "", ErrInvalidCursor
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, createdAtStr)
+	if err != nil {
+		return time.Time{}, "", ErrInvalidCursor
+	}
+
+	return createdAt, id, nil
+}
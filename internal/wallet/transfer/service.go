@@ -0,0 +1,348 @@
+//nolint:ireturn // 返回接口类型是预期的设计
+package transfer
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/wallet/balance"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// pgUniqueViolation PostgreSQL 唯一约束冲突错误码
+	pgUniqueViolation = "23505"
+)
+
+var (
+	// ErrInvalidAmount 转账金额不是正数
+	ErrInvalidAmount = walleterrors.ErrInvalidAmount
+	// ErrIdempotencyKeyRequired 未提供幂等键
+	ErrIdempotencyKeyRequired = errors.New("idempotency key is required")
+	// ErrIdempotencyKeyConflict 幂等键已被同一用户用于参数不同的转账
+	ErrIdempotencyKeyConflict = walleterrors.ErrIdempotencyKeyConflict
+	// ErrSameUser 不能转账给自己
+	ErrSameUser = errors.New("cannot transfer to yourself")
+	// ErrRecipientNotFound 接收用户不存在、已停用或在代币所在链上没有钱包
+	ErrRecipientNotFound = errors.New("recipient not found")
+	// ErrTokenNotFound 代币不存在或未启用
+	ErrTokenNotFound = walleterrors.ErrTokenNotFound
+	// ErrBelowMinimum 转账金额低于代币的最小内部转账金额
+	ErrBelowMinimum = walleterrors.ErrBelowMinimum
+	// ErrInsufficientBalance 可用余额不足
+	ErrInsufficientBalance = walleterrors.ErrInsufficientBalance
+)
+
+// Service 内部转账服务接口
+// 平台内用户之间的转账只在一个事务中写入一对 credits（转出扣减、接收入账），不产生链上交易
+type Service interface {
+	// Transfer 从 fromUserID 转账给 req.ToUserID，相同幂等键的重复请求返回原转账
+	Transfer(ctx context.Context, fromUserID string, req *Request) (*Transfer, error)
+}
+
+// Request 内部转账请求
+type Request struct {
+	ToUserID       string
+	TokenID        int
+	Amount         *big.Float
+	Memo           *string
+	IdempotencyKey string // 必填，相同用户使用相同幂等键的重复请求返回原转账
+}
+
+// Transfer 内部转账记录
+type Transfer struct {
+	ID          string
+	FromUserID  string
+	ToUserID    string
+	TokenID     int
+	TokenSymbol string
+	ChainID     int
+	Amount      string // 人类可读单位
+	Memo        *string
+	CreatedAt   time.Time
+}
+
+// service 实现 Service 接口
+type service struct {
+	db *sql.DB
+}
+
+// NewService 创建内部转账服务
+//
+//nolint:ireturn // 返回接口类型是预期的设计
+func NewService(db *sql.DB) Service {
+	return &service{
+		db: db,
+	}
+}
+
+// Transfer 从 fromUserID 转账给 req.ToUserID，相同幂等键的重复请求返回原转账
+func (s *service) Transfer(ctx context.Context, fromUserID string, req *Request) (*Transfer, error) {
+	if req.Amount == nil || req.Amount.Sign() <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	if req.IdempotencyKey == "" {
+		return nil, ErrIdempotencyKeyRequired
+	}
+	fromUserID = strings.ToLower(fromUserID)
+	req.ToUserID = strings.ToLower(req.ToUserID)
+	if fromUserID == req.ToUserID {
+		return nil, ErrSameUser
+	}
+
+	existing, err := s.findIdempotentTransfer(ctx, s.db, fromUserID, req)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		log.Info().
+			Str("transfer_id", existing.ID).
+			Str("from_user_id", fromUserID).
+			Msg("Internal transfer replayed with idempotency key")
+		return existing, nil
+	}
+
+	token, err := models.FindToken(ctx, s.db, req.TokenID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTokenNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get token")
+	}
+	if !token.IsActive {
+		return nil, ErrTokenNotFound
+	}
+
+	amount := req.Amount.Text('f', -1)
+	if err := checkMinimum(token, req.Amount); err != nil {
+		return nil, err
+	}
+
+	recipient, err := models.FindUser(ctx, s.db, req.ToUserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRecipientNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get recipient")
+	}
+	if !recipient.IsActive {
+		return nil, ErrRecipientNotFound
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// 锁定转出用户后再读取余额，与提现的余额检查使用同一把锁，防止并发转账/提现超额扣减
+	if _, err := tx.ExecContext(ctx, `SELECT id FROM users WHERE id = $1 FOR UPDATE`, fromUserID); err != nil {
+		return nil, errors.Wrap(err, "failed to lock user for internal transfer")
+	}
+
+	// 转账金额为代币单位，充值 credits（代币最小单位）按代币精度换算后汇总
+	var balanceStr string
+	if err := tx.QueryRowContext(ctx, `
+		SELECT COALESCE(SUM(`+balance.TokenAmountSQL+`), 0)::text
+		FROM credits
+		JOIN tokens t ON t.id = credits.token_id
+		WHERE credits.user_id = $1 AND credits.token_id = $2 AND `+balance.EffectiveCreditSQL+`
+	`, fromUserID, token.ID).Scan(&balanceStr); err != nil {
+		return nil, errors.Wrap(err, "failed to get balance")
+	}
+	available, err := money.ParseFloat(balanceStr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse balance: %s", balanceStr)
+	}
+	if available.Cmp(req.Amount) < 0 {
+		return nil, ErrInsufficientBalance
+	}
+
+	fromWallet, err := findUserWallet(ctx, tx, fromUserID, token.ChainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find user wallet for credit record")
+	}
+	toWallet, err := findUserWallet(ctx, tx, req.ToUserID, token.ChainID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRecipientNotFound
+		}
+		return nil, errors.Wrap(err, "failed to find recipient wallet for credit record")
+	}
+
+	transfer := &Transfer{
+		FromUserID:  fromUserID,
+		ToUserID:    req.ToUserID,
+		TokenID:     token.ID,
+		TokenSymbol: token.TokenSymbol,
+		ChainID:     token.ChainID,
+		Amount:      amount,
+		Memo:        req.Memo,
+	}
+
+	// 先记录转账再写入 credits，并发的重复提交会在幂等键唯一约束上等待先到的事务提交
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO internal_transfers (from_user_id, to_user_id, token_id, chain_id, amount, idempotency_key, request_hash, memo)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at
+	`, fromUserID, req.ToUserID, token.ID, token.ChainID, amount, req.IdempotencyKey, requestHash(req), req.Memo).Scan(&transfer.ID, &transfer.CreatedAt); err != nil {
+		if !isUniqueViolation(err) {
+			return nil, errors.Wrap(err, "failed to insert internal transfer")
+		}
+		_ = tx.Rollback()
+
+		existing, err := s.findIdempotentTransfer(ctx, s.db, fromUserID, req)
+		if err != nil {
+			return nil, err
+		}
+		if existing == nil {
+			return nil, errors.New("idempotent internal transfer not found after unique violation")
+		}
+		return existing, nil
+	}
+
+	credits := []*models.Credit{
+		{
+			UserID:  fromUserID,
+			Address: fromWallet.Address,
+			Amount:  "-" + amount, // 转出用户扣减
+		},
+		{
+			UserID:  req.ToUserID,
+			Address: toWallet.Address,
+			Amount:  amount, // 接收用户入账
+		},
+	}
+	for _, credit := range credits {
+		credit.TokenID = token.ID
+		credit.TokenSymbol = token.TokenSymbol
+		credit.CreditType = models.CreditTypeInternal
+		credit.BusinessType = models.BusinessTypeInternalTransfer
+		credit.ReferenceID = transfer.ID
+		credit.ReferenceType = models.ReferenceTypeInternalTransfer
+		credit.ChainID = null.IntFrom(token.ChainID)
+		credit.ChainType = null.StringFrom(token.ChainType)
+		credit.Status = models.CreditStatusFinalized
+
+		if err := credit.Insert(ctx, tx, boil.Infer()); err != nil {
+			return nil, errors.Wrap(err, "failed to insert internal transfer credit")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "failed to commit transaction")
+	}
+
+	log.Info().
+		Str("transfer_id", transfer.ID).
+		Str("from_user_id", fromUserID).
+		Str("to_user_id", req.ToUserID).
+		Int("token_id", token.ID).
+		Str("amount", amount).
+		Msg("Internal transfer completed")
+
+	return transfer, nil
+}
+
+// checkMinimum 检查转账金额不低于代币的最小内部转账金额，未设置时不限制
+func checkMinimum(token *models.Token, amount *big.Float) error {
+	if !token.MinTransferAmount.Valid || token.MinTransferAmount.String == "" {
+		return nil
+	}
+
+	minAmount, err := money.ParseFloat(token.MinTransferAmount.String)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse min transfer amount: %s", token.MinTransferAmount.String)
+	}
+	if amount.Cmp(minAmount) < 0 {
+		return errors.Wrapf(ErrBelowMinimum, "minimum is %s %s", token.MinTransferAmount.String, token.TokenSymbol)
+	}
+
+	return nil
+}
+
+// findUserWallet 查询用户在链上的钱包，用于填充 credits.address
+func findUserWallet(ctx context.Context, exec boil.ContextExecutor, userID string, chainID int) (*models.Wallet, error) {
+	return models.Wallets(
+		models.WalletWhere.UserID.EQ(userID),
+		models.WalletWhere.ChainID.EQ(chainID),
+		models.WalletWhere.WalletType.NEQ(models.WalletTypeWatch),
+	).One(ctx, exec)
+}
+
+// requestHash 转账请求参数摘要，用于识别使用相同幂等键但参数不同的请求
+func requestHash(req *Request) string {
+	memo := ""
+	if req.Memo != nil {
+		memo = *req.Memo
+	}
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		req.ToUserID,
+		strconv.Itoa(req.TokenID),
+		req.Amount.Text('f', -1),
+		memo,
+	}, "|")))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// findIdempotentTransfer 查找用户使用相同幂等键发起的转账，未使用过时返回 nil
+func (s *service) findIdempotentTransfer(ctx context.Context, exec boil.ContextExecutor, fromUserID string, req *Request) (*Transfer, error) {
+	var (
+		transfer Transfer
+		hash     string
+		memo     sql.NullString
+	)
+	err := exec.QueryRowContext(ctx, `
+		SELECT t.id, t.from_user_id, t.to_user_id, t.token_id, tk.token_symbol, t.chain_id, t.amount, t.memo, t.created_at, t.request_hash
+		FROM internal_transfers t
+		JOIN tokens tk ON tk.id = t.token_id
+		WHERE t.from_user_id = $1 AND t.idempotency_key = $2
+	`, fromUserID, req.IdempotencyKey).Scan(
+		&transfer.ID,
+		&transfer.FromUserID,
+		&transfer.ToUserID,
+		&transfer.TokenID,
+		&transfer.TokenSymbol,
+		&transfer.ChainID,
+		&transfer.Amount,
+		&memo,
+		&transfer.CreatedAt,
+		&hash,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil // 幂等键未使用过
+		}
+		return nil, errors.Wrap(err, "failed to get idempotent internal transfer")
+	}
+
+	if hash != requestHash(req) {
+		return nil, ErrIdempotencyKeyConflict
+	}
+	if memo.Valid {
+		transfer.Memo = &memo.String
+	}
+
+	return &transfer, nil
+}
+
+// isUniqueViolation 判断是否为唯一约束冲突
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == pgUniqueViolation
+}
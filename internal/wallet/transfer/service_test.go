@@ -0,0 +1,42 @@
+package transfer
+
+import (
+	"math/big"
+	"testing"
+
+	"github/chapool/go-wallet/internal/models"
+
+	"github.com/aarondl/null/v8"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckMinimum(t *testing.T) {
+	token := &models.Token{TokenSymbol: "USDT", MinTransferAmount: null.StringFrom("1.5")}
+
+	require.NoError(t, checkMinimum(token, big.NewFloat(1.5)))
+	require.NoError(t, checkMinimum(token, big.NewFloat(2)))
+
+	err := checkMinimum(token, big.NewFloat(1.49))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrBelowMinimum))
+	assert.Contains(t, err.Error(), "1.5 USDT")
+
+	// 未设置最小金额时不限制
+	require.NoError(t, checkMinimum(&models.Token{}, big.NewFloat(0.000001)))
+	require.NoError(t, checkMinimum(&models.Token{MinTransferAmount: null.StringFrom("")}, big.NewFloat(0.000001)))
+}
+
+func TestRequestHash(t *testing.T) {
+	memo := "rent"
+	req := &Request{ToUserID: "f6ede5d8-e22a-4ca5-aa12-67821865a3e5", TokenID: 1, Amount: big.NewFloat(10), Memo: &memo}
+	same := &Request{ToUserID: "f6ede5d8-e22a-4ca5-aa12-67821865a3e5", TokenID: 1, Amount: big.NewFloat(10), Memo: &memo}
+	otherAmount := &Request{ToUserID: "f6ede5d8-e22a-4ca5-aa12-67821865a3e5", TokenID: 1, Amount: big.NewFloat(11), Memo: &memo}
+	noMemo := &Request{ToUserID: "f6ede5d8-e22a-4ca5-aa12-67821865a3e5", TokenID: 1, Amount: big.NewFloat(10)}
+
+	assert.Equal(t, requestHash(req), requestHash(same))
+	assert.NotEqual(t, requestHash(req), requestHash(otherAmount))
+	assert.NotEqual(t, requestHash(req), requestHash(noMemo))
+	assert.Len(t, requestHash(req), 64)
+}
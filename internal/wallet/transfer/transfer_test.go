@@ -0,0 +1,92 @@
+package transfer_test
+
+import (
+	"database/sql"
+	"math/big"
+	"testing"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/test"
+	"github/chapool/go-wallet/internal/test/fixtures"
+	"github/chapool/go-wallet/internal/wallet/transfer"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferChecksDepositBalanceInTokenUnits(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		token, err := models.Tokens(qm.OrderBy(models.TokenColumns.ID)).One(ctx, db)
+		require.NoError(t, err)
+
+		wallets := []*models.Wallet{
+			{UserID: fix.User1.ID, Address: "0x8589427373d6d84e98730d7795d8f6f8731fda16", DerivationPath: "m/44'/60'/0'/0/1", AddressIndex: 1},
+			{UserID: fix.User2.ID, Address: "0x0d1d4e623d10f9fba5db95830f7d3839406c6af2", DerivationPath: "m/44'/60'/0'/0/2", AddressIndex: 2},
+		}
+		for _, wallet := range wallets {
+			wallet.ChainType = token.ChainType
+			wallet.ChainID = token.ChainID
+			wallet.WalletType = models.WalletTypeUser
+			require.NoError(t, wallet.Insert(ctx, db, boil.Infer()))
+		}
+
+		// 充值 credits 为代币最小单位：10 个代币
+		depositAmount, err := money.ToSmallestUnit("10", token.Decimals)
+		require.NoError(t, err)
+		deposit := &models.Credit{
+			UserID:        fix.User1.ID,
+			Address:       "0x8589427373d6d84e98730d7795d8f6f8731fda16",
+			TokenID:       token.ID,
+			TokenSymbol:   token.TokenSymbol,
+			Amount:        depositAmount.String(),
+			CreditType:    models.CreditTypeDeposit,
+			BusinessType:  models.BusinessTypeBlockchain,
+			ReferenceID:   "0xdeposit_0",
+			ReferenceType: models.ReferenceTypeBlockchainTX,
+			ChainID:       null.IntFrom(token.ChainID),
+			ChainType:     null.StringFrom(token.ChainType),
+			Status:        models.CreditStatusFinalized,
+			EventIndex:    null.IntFrom(0),
+		}
+		require.NoError(t, deposit.Insert(ctx, db, boil.Infer()))
+
+		service := transfer.NewService(db)
+
+		// 超过充值金额（代币单位）的转账被拒绝，不能与最小单位的汇总比较
+		_, err = service.Transfer(ctx, fix.User1.ID, &transfer.Request{
+			ToUserID:       fix.User2.ID,
+			TokenID:        token.ID,
+			Amount:         big.NewFloat(11),
+			IdempotencyKey: "transfer-1",
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, transfer.ErrInsufficientBalance))
+
+		result, err := service.Transfer(ctx, fix.User1.ID, &transfer.Request{
+			ToUserID:       fix.User2.ID,
+			TokenID:        token.ID,
+			Amount:         big.NewFloat(6),
+			IdempotencyKey: "transfer-2",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "6", result.Amount)
+
+		// 剩余 4 个代币
+		_, err = service.Transfer(ctx, fix.User1.ID, &transfer.Request{
+			ToUserID:       fix.User2.ID,
+			TokenID:        token.ID,
+			Amount:         big.NewFloat(5),
+			IdempotencyKey: "transfer-3",
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, transfer.ErrInsufficientBalance))
+	})
+}
@@ -3,6 +3,7 @@ package wallet
 import (
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github/chapool/go-wallet/internal/models"
 )
 
@@ -16,24 +17,30 @@ type Wallet struct {
 	ChainName      string
 	DerivationPath string
 	AddressIndex   int
-	WalletType     string
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	WalletType     models.WalletType
+	// SmartAccountOwner and SmartAccountFactory are set for ERC-4337 smart account wallets, the address is the
+	// counterfactual account of the derived owner address. Empty for derived addresses.
+	SmartAccountOwner   string
+	SmartAccountFactory string
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
 }
 
 // ToModel converts Wallet to models.Wallet
 func (w *Wallet) ToModel() *models.Wallet {
 	return &models.Wallet{
-		ID:             w.ID,
-		UserID:         w.UserID,
-		Address:        w.Address,
-		ChainType:      w.ChainType,
-		ChainID:        w.ChainID,
-		DerivationPath: w.DerivationPath,
-		AddressIndex:   w.AddressIndex,
-		WalletType:     w.WalletType,
-		CreatedAt:      w.CreatedAt,
-		UpdatedAt:      w.UpdatedAt,
+		ID:                  w.ID,
+		UserID:              w.UserID,
+		Address:             w.Address,
+		ChainType:           w.ChainType,
+		ChainID:             w.ChainID,
+		DerivationPath:      w.DerivationPath,
+		AddressIndex:        w.AddressIndex,
+		WalletType:          w.WalletType,
+		SmartAccountOwner:   null.NewString(w.SmartAccountOwner, w.SmartAccountOwner != ""),
+		SmartAccountFactory: null.NewString(w.SmartAccountFactory, w.SmartAccountFactory != ""),
+		CreatedAt:           w.CreatedAt,
+		UpdatedAt:           w.UpdatedAt,
 	}
 }
 
@@ -42,16 +49,18 @@ func (w *Wallet) ToModel() *models.Wallet {
 //nolint:varnamelen // m is a common abbreviation for model
 func FromModel(m *models.Wallet, chainName string) *Wallet {
 	return &Wallet{
-		ID:             m.ID,
-		UserID:         m.UserID,
-		Address:        m.Address,
-		ChainType:      m.ChainType,
-		ChainID:        m.ChainID,
-		ChainName:      chainName,
-		DerivationPath: m.DerivationPath,
-		AddressIndex:   m.AddressIndex,
-		WalletType:     m.WalletType,
-		CreatedAt:      m.CreatedAt,
-		UpdatedAt:      m.UpdatedAt,
+		ID:                  m.ID,
+		UserID:              m.UserID,
+		Address:             m.Address,
+		ChainType:           m.ChainType,
+		ChainID:             m.ChainID,
+		ChainName:           chainName,
+		DerivationPath:      m.DerivationPath,
+		AddressIndex:        m.AddressIndex,
+		WalletType:          m.WalletType,
+		SmartAccountOwner:   m.SmartAccountOwner.String,
+		SmartAccountFactory: m.SmartAccountFactory.String,
+		CreatedAt:           m.CreatedAt,
+		UpdatedAt:           m.UpdatedAt,
 	}
 }
@@ -56,15 +56,32 @@ func (w *Wallet) ToWalletItem() *types.WalletItem {
 	}
 }
 
+// ToWatchAddressItem converts a watch Wallet to WatchAddressItem
+func (w *Wallet) ToWatchAddressItem() *types.WatchAddressItem {
+	id := strfmt.UUID(w.ID)
+	userID := strfmt.UUID(w.UserID)
+	createdAt := strfmt.DateTime(w.CreatedAt)
+
+	return &types.WatchAddressItem{
+		ID:        &id,
+		UserID:    &userID,
+		Address:   swag.String(w.Address),
+		ChainID:   swag.Int64(int64(w.ChainID)),
+		ChainName: swag.String(w.ChainName),
+		CreatedAt: &createdAt,
+	}
+}
+
 // ChainToChainItem converts models.Chain to types.ChainItem
 func ChainToChainItem(chain *models.Chain) *types.ChainItem {
 	item := &types.ChainItem{
-		ID:                swag.Int64(int64(chain.ID)),
-		ChainID:           swag.Int64(int64(chain.ChainID)),
-		ChainName:         swag.String(chain.ChainName),
-		ChainType:         swag.String(chain.ChainType),
-		NativeTokenSymbol: swag.String(chain.NativeTokenSymbol),
-		IsActive:          swag.Bool(chain.IsActive),
+		ID:                  swag.Int64(int64(chain.ID)),
+		ChainID:             swag.Int64(int64(chain.ChainID)),
+		ChainName:           swag.String(chain.ChainName),
+		ChainType:           swag.String(chain.ChainType),
+		NativeTokenSymbol:   swag.String(chain.NativeTokenSymbol),
+		NativeTokenDecimals: swag.Int64(int64(chain.NativeTokenDecimals)),
+		IsActive:            swag.Bool(chain.IsActive),
 	}
 
 	// Set optional fields if they are valid
@@ -77,6 +94,20 @@ func ChainToChainItem(chain *models.Chain) *types.ChainItem {
 	if chain.FinalizedBlocks.Valid {
 		item.FinalizedBlocks = int64(chain.FinalizedBlocks.Int)
 	}
+	if chain.ExplorerURLTemplate.Valid {
+		item.ExplorerURLTemplate = chain.ExplorerURLTemplate.String
+	}
 
 	return item
 }
+
+// TokenToWrappedNativeToken converts a wrapped native models.Token to types.WrappedNativeToken
+func TokenToWrappedNativeToken(token *models.Token) *types.WrappedNativeToken {
+	return &types.WrappedNativeToken{
+		TokenID:        swag.Int64(int64(token.ID)),
+		TokenSymbol:    swag.String(token.TokenSymbol),
+		TokenAddress:   swag.String(token.TokenAddress.String),
+		Decimals:       swag.Int64(int64(token.Decimals)),
+		CreditAsNative: swag.Bool(token.CreditAsNative),
+	}
+}
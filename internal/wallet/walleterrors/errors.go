@@ -0,0 +1,39 @@
+// Package walleterrors 定义钱包服务共用的错误分类
+// 服务返回（或包装）这些错误，API 层据此映射到具体的 HTTP 状态码和公开错误类型，
+// 客户端可以区分余额不足、代币不存在等可处理的原因，而不是统一的 500 错误
+package walleterrors
+
+import "github.com/pkg/errors"
+
+var (
+	// ErrInvalidAmount 金额无效（无法解析、非正数或精度超出代币小数位）
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrBelowMinimum 金额低于代币的最小金额
+	ErrBelowMinimum = errors.New("amount is below the minimum amount of the token")
+	// ErrInsufficientBalance 用户可用余额不足
+	ErrInsufficientBalance = errors.New("insufficient balance")
+	// ErrChainNotFound 链不存在或未启用
+	ErrChainNotFound = errors.New("chain not found")
+	// ErrTokenNotFound 代币不存在或未启用
+	ErrTokenNotFound = errors.New("token not found")
+	// ErrWalletNotFound 钱包不存在
+	ErrWalletNotFound = errors.New("wallet not found")
+	// ErrWithdrawNotFound 提现不存在
+	ErrWithdrawNotFound = errors.New("withdraw not found")
+	// ErrWithdrawStateConflict 提现当前状态不允许该操作（如批准已处理的提现、重复审核）
+	ErrWithdrawStateConflict = errors.New("withdraw state conflict")
+	// ErrSelfApproval 管理员不能审核自己发起的提现
+	ErrSelfApproval = errors.New("admin cannot approve own withdraw")
+	// ErrIdempotencyKeyConflict 幂等键已用于不同的请求
+	ErrIdempotencyKeyConflict = errors.New("idempotency key was already used with a different request")
+	// ErrRateLimited 请求过于频繁
+	ErrRateLimited = errors.New("too many requests")
+	// ErrWithdrawLimitExceeded 超过提现限额
+	ErrWithdrawLimitExceeded = errors.New("withdraw limit exceeded")
+	// ErrNFTNotFound NFT 充值记录不存在
+	ErrNFTNotFound = errors.New("nft not found")
+	// ErrNFTStateConflict NFT 当前状态不允许该操作（如提现已提出或未终结的 NFT）
+	ErrNFTStateConflict = errors.New("nft state conflict")
+	// ErrWithdrawBlocked 提现目标地址或代币命中合规筛查，提现被拦截
+	ErrWithdrawBlocked = errors.New("withdraw is not allowed to this address or of this token")
+)
@@ -0,0 +1,153 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/util"
+	"github/chapool/go-wallet/internal/wallet/bitcoin"
+	walletChain "github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/solana"
+)
+
+// watchAddressIndex marks watch wallets as not derived from the seed
+const watchAddressIndex = -1
+
+var (
+	ErrInvalidWatchAddress      = errors.New("invalid watch address")
+	ErrWatchAddressExists       = errors.New("address is already registered on this chain")
+	ErrWatchAddressUserNotFound = errors.New("user not found")
+	ErrWatchAddressChainInvalid = errors.New("chain not found or inactive")
+)
+
+// RegisterWatchAddress registers an externally controlled address of a user to be monitored for deposits.
+// Watch wallets have no derivation path, thus they are never collected from or signed with.
+func (s *service) RegisterWatchAddress(ctx context.Context, userID string, chainID int, address string) (*Wallet, error) {
+	log := util.LogFromContext(ctx).With().
+		Str("user_id", userID).
+		Int("chain_id", chainID).
+		Str("address", address).
+		Logger()
+
+	chain, err := models.Chains(
+		models.ChainWhere.ChainID.EQ(chainID),
+		models.ChainWhere.IsActive.EQ(true),
+	).One(ctx, s.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrWatchAddressChainInvalid
+		}
+		return nil, errors.Wrap(err, "failed to get chain")
+	}
+
+	if !isValidAddress(chain.ChainType, address) {
+		return nil, ErrInvalidWatchAddress
+	}
+	normalizedAddress := walletChain.NormalizeAddress(chain.ChainType, address)
+
+	userExists, err := models.UserExists(ctx, s.db, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check user")
+	}
+	if !userExists {
+		return nil, ErrWatchAddressUserNotFound
+	}
+
+	// Addresses are unique per chain, including derived user and hot wallets
+	exists, err := models.Wallets(
+		qm.Where(walletChain.WalletAddressKeySQL+" = ?", normalizedAddress),
+		models.WalletWhere.ChainID.EQ(chainID),
+	).Exists(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check existing wallet")
+	}
+	if exists {
+		return nil, ErrWatchAddressExists
+	}
+
+	walletModel := &models.Wallet{
+		UserID:         userID,
+		Address:        normalizedAddress,
+		ChainType:      chain.ChainType,
+		ChainID:        chainID,
+		DerivationPath: "",
+		AddressIndex:   watchAddressIndex,
+		WalletType:     models.WalletTypeWatch,
+	}
+	if err := walletModel.Insert(ctx, s.db, boil.Infer()); err != nil {
+		return nil, errors.Wrap(err, "failed to insert watch wallet")
+	}
+
+	log.Info().Str("wallet_id", walletModel.ID).Msg("Watch address registered")
+
+	return FromModel(walletModel, chain.ChainName), nil
+}
+
+// ListWatchAddresses lists registered watch addresses, optionally filtered by user and chain
+func (s *service) ListWatchAddresses(ctx context.Context, userID *string, chainID *int) ([]*Wallet, error) {
+	mods := []qm.QueryMod{
+		models.WalletWhere.WalletType.EQ(models.WalletTypeWatch),
+		qm.OrderBy(models.WalletColumns.CreatedAt + " DESC"),
+	}
+	if userID != nil {
+		mods = append(mods, models.WalletWhere.UserID.EQ(*userID))
+	}
+	if chainID != nil {
+		mods = append(mods, models.WalletWhere.ChainID.EQ(*chainID))
+	}
+
+	walletModels, err := models.Wallets(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list watch wallets")
+	}
+
+	chainMap := make(map[int]string)
+	chains, err := models.Chains().All(ctx, s.db)
+	if err == nil {
+		for _, chain := range chains {
+			chainMap[chain.ChainID] = chain.ChainName
+		}
+	}
+
+	wallets := make([]*Wallet, 0, len(walletModels))
+	for _, w := range walletModels {
+		wallets = append(wallets, FromModel(w, chainMap[w.ChainID]))
+	}
+
+	return wallets, nil
+}
+
+// RemoveWatchAddress stops monitoring a watch address. Credits already created for it are kept.
+func (s *service) RemoveWatchAddress(ctx context.Context, walletID string) error {
+	rowsAff, err := models.Wallets(
+		models.WalletWhere.ID.EQ(walletID),
+		models.WalletWhere.WalletType.EQ(models.WalletTypeWatch),
+	).DeleteAll(ctx, s.db)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete watch wallet")
+	}
+	if rowsAff == 0 {
+		return errors.New("watch address not found")
+	}
+
+	util.LogFromContext(ctx).Info().Str("wallet_id", walletID).Msg("Watch address removed")
+
+	return nil
+}
+
+// isValidAddress checks the address format of the chain type
+func isValidAddress(chainType string, address string) bool {
+	switch chainType {
+	case walletChain.TypeSolana:
+		return solana.IsValidAddress(address)
+	case walletChain.TypeBitcoin:
+		return bitcoin.IsValidAddress(address)
+	default:
+		return common.IsHexAddress(address)
+	}
+}
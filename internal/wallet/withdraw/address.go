@@ -0,0 +1,199 @@
+package withdraw
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/addressbook"
+	"github/chapool/go-wallet/internal/wallet/bitcoin"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/solana"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// 提现地址校验失败原因
+const (
+	AddressReasonInvalid         = "invalid_address"  // 地址格式错误
+	AddressReasonInvalidChecksum = "invalid_checksum" // EVM 大小写混合地址的 EIP-55 校验和错误
+	AddressReasonContract        = "contract_address" // EVM 合约地址且未加入白名单
+	AddressReasonOwnAddress      = "own_address"      // 用户自己的充值地址
+	AddressReasonSystemAddress   = "system_address"   // 平台热钱包或冷钱包地址
+	AddressReasonInternalAddress = "internal_address" // 平台其他用户的充值地址
+	AddressReasonNotWhitelisted  = "not_whitelisted"  // 用户开启了仅限白名单提现，地址不在地址簿中或仍在冷静期
+)
+
+// 提现到平台其他用户地址的处理方式
+const (
+	InternalAddressModeReject   = "reject"   // 拒绝，提示使用内部转账
+	InternalAddressModeTransfer = "transfer" // 转为内部转账，不产生链上交易
+)
+
+// eip7702DelegationPrefix EIP-7702 委托的外部账户代码前缀（0xef0100 + 20 字节委托地址），不是合约账户
+var eip7702DelegationPrefix = []byte{0xef, 0x01, 0x00}
+
+const eip7702DelegationLength = 23
+
+// AddressError 提现地址校验失败
+type AddressError struct {
+	Reason  string
+	Message string
+	// RecipientUserID 地址所属的平台用户，仅 internal_address 时设置
+	RecipientUserID string
+	// ConvertToTransfer 配置为转为内部转账时设置，调用方应改为发起内部转账
+	ConvertToTransfer bool
+}
+
+func (e *AddressError) Error() string {
+	return e.Message
+}
+
+// ContractAllowlist 允许提现的合约地址：链 ID -> 小写地址集合
+type ContractAllowlist map[int]map[string]bool
+
+// Allowed 判断合约地址是否在链的白名单中
+func (l ContractAllowlist) Allowed(chainID int, address string) bool {
+	return l[chainID][strings.ToLower(address)]
+}
+
+// validateAddressFormat 校验提现目标地址格式：EVM 地址为 20 字节十六进制地址，大小写混合时必须符合 EIP-55 校验和；
+// Solana 地址为 base58 编码的 32 字节公钥，Bitcoin 地址为主网 bech32 或 base58check 地址
+func validateAddressFormat(chainType string, address string) *AddressError {
+	switch chainType {
+	case chain.TypeEVM:
+		if !common.IsHexAddress(address) || !strings.HasPrefix(address, "0x") {
+			return &AddressError{Reason: AddressReasonInvalid, Message: fmt.Sprintf("invalid EVM address %s", address)}
+		}
+		// 全小写或全大写的地址不包含校验和
+		hex := address[2:]
+		if hex != strings.ToLower(hex) && hex != strings.ToUpper(hex) {
+			if checksummed := common.HexToAddress(address).Hex(); checksummed != address {
+				return &AddressError{
+					Reason:  AddressReasonInvalidChecksum,
+					Message: fmt.Sprintf("invalid EIP-55 checksum for address %s", address),
+				}
+			}
+		}
+	case chain.TypeSolana:
+		if !solana.IsValidAddress(address) {
+			return &AddressError{Reason: AddressReasonInvalid, Message: fmt.Sprintf("invalid solana address %s", address)}
+		}
+	case chain.TypeBitcoin:
+		if !bitcoin.IsValidAddress(address) {
+			return &AddressError{Reason: AddressReasonInvalid, Message: fmt.Sprintf("invalid bitcoin address %s", address)}
+		}
+	}
+
+	return nil
+}
+
+// isContractCode 判断账户代码是否为合约（EIP-7702 委托的外部账户不算合约）
+func isContractCode(code []byte) bool {
+	if len(code) == 0 {
+		return false
+	}
+	if len(code) == eip7702DelegationLength && bytes.HasPrefix(code, eip7702DelegationPrefix) {
+		return false
+	}
+
+	return true
+}
+
+// validateToAddress 校验提现目标地址：格式、提现地址簿白名单、平台内部地址和 EVM 合约地址
+func (s *service) validateToAddress(ctx context.Context, userID string, token *models.Token, address string) error {
+	if addrErr := validateAddressFormat(token.ChainType, address); addrErr != nil {
+		return addrErr
+	}
+
+	if s.addressBookService != nil {
+		if err := s.addressBookService.CheckWithdrawAddress(ctx, userID, token.ChainID, token.ChainType, address); err != nil {
+			if errors.Is(err, addressbook.ErrNotWhitelisted) {
+				return &AddressError{
+					Reason:  AddressReasonNotWhitelisted,
+					Message: fmt.Sprintf("address %s is not an active entry of your withdraw address book", address),
+				}
+			}
+			return errors.Wrap(err, "failed to check withdraw address book")
+		}
+	}
+
+	if err := s.checkInternalAddress(ctx, userID, token.ChainID, token.ChainType, address); err != nil {
+		return err
+	}
+
+	if token.ChainType != chain.TypeEVM || s.config.ContractAllowlist.Allowed(token.ChainID, address) {
+		return nil
+	}
+
+	client, err := s.scanService.GetClient(ctx, token.ChainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get RPC client")
+	}
+	code, err := client.CodeAt(ctx, common.HexToAddress(address), nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to get code of withdraw address")
+	}
+	if isContractCode(code) {
+		return &AddressError{
+			Reason:  AddressReasonContract,
+			Message: fmt.Sprintf("address %s is a contract, withdraws to contracts are not allowed", address),
+		}
+	}
+
+	return nil
+}
+
+// checkInternalAddress 检查地址是否为平台管理的钱包地址（观察地址除外）
+func (s *service) checkInternalAddress(ctx context.Context, userID string, chainID int, chainType string, address string) error {
+	var (
+		walletUserID string
+		walletType   models.WalletType
+	)
+	err := s.db.QueryRowContext(ctx, `
+		SELECT user_id, wallet_type
+		FROM wallets
+		WHERE chain_id = $1 AND `+chain.WalletAddressKeySQL+` = $2 AND wallet_type <> $3
+		ORDER BY (user_id = $4) DESC
+		LIMIT 1
+	`, chainID, chain.NormalizeAddress(chainType, address), models.WalletTypeWatch, userID).Scan(&walletUserID, &walletType)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to check internal address")
+	}
+
+	switch {
+	case walletType != models.WalletTypeUser:
+		return &AddressError{
+			Reason:  AddressReasonSystemAddress,
+			Message: fmt.Sprintf("address %s is a system wallet of this platform", address),
+		}
+	case walletUserID == userID:
+		return &AddressError{
+			Reason:  AddressReasonOwnAddress,
+			Message: fmt.Sprintf("address %s is your own deposit address", address),
+		}
+	}
+
+	log.Warn().
+		Str("user_id", userID).
+		Str("recipient_user_id", walletUserID).
+		Int("chain_id", chainID).
+		Str("to_address", address).
+		Str("mode", s.config.InternalAddressMode).
+		Msg("Withdraw requested to an internal address")
+
+	return &AddressError{
+		Reason:            AddressReasonInternalAddress,
+		Message:           fmt.Sprintf("address %s belongs to a user of this platform, use an internal transfer instead", address),
+		RecipientUserID:   walletUserID,
+		ConvertToTransfer: s.config.InternalAddressMode == InternalAddressModeTransfer,
+	}
+}
@@ -0,0 +1,63 @@
+package withdraw
+
+import (
+	"testing"
+
+	"github/chapool/go-wallet/internal/wallet/chain"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAddressFormat(t *testing.T) {
+	tests := []struct {
+		name      string
+		chainType string
+		address   string
+		reason    string
+	}{
+		{"EVMChecksummed", chain.TypeEVM, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", ""},
+		{"EVMLowercase", chain.TypeEVM, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", ""},
+		{"EVMUppercase", chain.TypeEVM, "0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", ""},
+		{"EVMWrongChecksum", chain.TypeEVM, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", AddressReasonInvalidChecksum},
+		{"EVMTooShort", chain.TypeEVM, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1bea", AddressReasonInvalid},
+		{"EVMWithoutPrefix", chain.TypeEVM, "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", AddressReasonInvalid},
+		{"EVMNotHex", chain.TypeEVM, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaeg", AddressReasonInvalid},
+		{"Solana", chain.TypeSolana, "11111111111111111111111111111111", ""},
+		{"SolanaInvalid", chain.TypeSolana, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", AddressReasonInvalid},
+		{"Bitcoin", chain.TypeBitcoin, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", ""},
+		{"BitcoinInvalid", chain.TypeBitcoin, "bc1qinvalid", AddressReasonInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addrErr := validateAddressFormat(tt.chainType, tt.address)
+			if tt.reason == "" {
+				assert.Nil(t, addrErr)
+				return
+			}
+			require.NotNil(t, addrErr)
+			assert.Equal(t, tt.reason, addrErr.Reason)
+		})
+	}
+}
+
+func TestIsContractCode(t *testing.T) {
+	assert.False(t, isContractCode(nil))
+	assert.False(t, isContractCode([]byte{}))
+	assert.True(t, isContractCode(common.FromHex("0x6080604052348015600f57600080fd5b50")))
+
+	// EIP-7702 委托的外部账户
+	delegation := append([]byte{0xef, 0x01, 0x00}, common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed").Bytes()...)
+	assert.False(t, isContractCode(delegation))
+	assert.True(t, isContractCode(append(delegation, 0x00)))
+}
+
+func TestContractAllowlist(t *testing.T) {
+	allowlist := ContractAllowlist{56: {"0xd152f549545093347a162dce210e7293f1452150": true}}
+
+	assert.True(t, allowlist.Allowed(56, "0xD152f549545093347A162Dce210e7293f1452150"))
+	assert.False(t, allowlist.Allowed(1, "0xD152f549545093347A162Dce210e7293f1452150"))
+	assert.False(t, allowlist.Allowed(56, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"))
+}
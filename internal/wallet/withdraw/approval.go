@@ -0,0 +1,230 @@
+package withdraw
+
+import (
+	"context"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/wallet/risk"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// 审批结果
+const (
+	ApprovalDecisionApproved = "approved"
+	ApprovalDecisionRejected = "rejected"
+)
+
+// defaultRequiredApprovals 未命中任何审批阈值时需要的管理员批准数
+const defaultRequiredApprovals = 1
+
+// Approval 管理员审批记录（审计记录）
+type Approval struct {
+	ID          string
+	WithdrawID  string
+	AdminUserID string
+	Decision    string // approved 或 rejected
+	Reason      string
+	CreatedAt   time.Time
+}
+
+// PendingApproval 等待管理员审批的提现
+type PendingApproval struct {
+	Withdraw          *models.Withdraw
+	RequiredApprovals int
+	Approvals         []*Approval
+	RiskFlags         []*risk.Flag // 超出复核限额的标记，需要审核人员重点关注
+}
+
+// requiredApprovals 根据审批策略计算提现需要的管理员批准数
+// 命中同一代币的多个阈值时，取最小金额最高的那一档
+func (s *service) requiredApprovals(tokenID int, amount string) (int, error) {
+	amountFloat, err := money.ParseFloat(amount)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to parse amount")
+	}
+
+	required := defaultRequiredApprovals
+	var matched *ApprovalThreshold
+	for i := range s.config.ApprovalThresholds {
+		threshold := &s.config.ApprovalThresholds[i]
+		if threshold.TokenID != tokenID || amountFloat.Cmp(threshold.MinAmount) < 0 {
+			continue
+		}
+		if matched == nil || threshold.MinAmount.Cmp(matched.MinAmount) > 0 {
+			matched = threshold
+		}
+	}
+	if matched != nil {
+		required = matched.RequiredApprovals
+	}
+
+	return required, nil
+}
+
+// recordApproval 写入一条审批记录，同一管理员对同一笔提现只能审批一次
+func (s *service) recordApproval(ctx context.Context, exec boil.ContextExecutor, withdrawID string, adminUserID string, decision string, reason string) error {
+	res, err := exec.ExecContext(ctx, `
+		INSERT INTO withdraw_approvals (withdraw_id, admin_user_id, decision, reason)
+		VALUES ($1, $2, $3, NULLIF($4, ''))
+		ON CONFLICT (withdraw_id, admin_user_id) DO NOTHING
+	`, withdrawID, adminUserID, decision, reason)
+	if err != nil {
+		return errors.Wrap(err, "failed to insert withdraw approval")
+	}
+
+	rowsAff, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get affected rows")
+	}
+	if rowsAff == 0 {
+		return errors.Wrap(walleterrors.ErrWithdrawStateConflict, "admin has already reviewed this withdraw")
+	}
+
+	return nil
+}
+
+// countApprovals 统计提现已获得的批准数
+func (s *service) countApprovals(ctx context.Context, exec boil.ContextExecutor, withdrawID string) (int, error) {
+	var count int
+	if err := exec.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM withdraw_approvals WHERE withdraw_id = $1 AND decision = $2
+	`, withdrawID, ApprovalDecisionApproved).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, "failed to count withdraw approvals")
+	}
+
+	return count, nil
+}
+
+// checkApprovals 检查提现是否已获得足够的管理员批准
+func (s *service) checkApprovals(ctx context.Context, exec boil.ContextExecutor, withdraw *models.Withdraw) error {
+	required, err := s.requiredApprovals(withdraw.TokenID, withdraw.Amount)
+	if err != nil {
+		return err
+	}
+
+	approvals, err := s.countApprovals(ctx, exec, withdraw.ID)
+	if err != nil {
+		return err
+	}
+
+	if approvals < required {
+		return errors.Errorf("withdraw has %d of %d required approvals", approvals, required)
+	}
+
+	return nil
+}
+
+// GetWithdrawApprovals 获取提现的审批记录
+func (s *service) GetWithdrawApprovals(ctx context.Context, withdrawID string) ([]*Approval, error) {
+	exists, err := models.Withdraws(models.WithdrawWhere.ID.EQ(withdrawID)).Exists(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get withdraw record")
+	}
+	if !exists {
+		return nil, walleterrors.ErrWithdrawNotFound
+	}
+
+	approvals, err := s.getApprovals(ctx, []string{withdrawID})
+	if err != nil {
+		return nil, err
+	}
+
+	return approvals[withdrawID], nil
+}
+
+// ListPendingApprovals 获取等待管理员审批的提现（按创建时间正序）
+func (s *service) ListPendingApprovals(ctx context.Context, limit int, offset int) ([]*PendingApproval, error) {
+	withdraws, err := models.Withdraws(
+		models.WithdrawWhere.Status.EQ(models.WithdrawStatusUserWithdrawRequest),
+		qm.OrderBy(models.WithdrawColumns.CreatedAt+" ASC"),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get pending withdraws")
+	}
+
+	withdrawIDs := make([]string, 0, len(withdraws))
+	for _, withdraw := range withdraws {
+		withdrawIDs = append(withdrawIDs, withdraw.ID)
+	}
+
+	approvals, err := s.getApprovals(ctx, withdrawIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	riskFlags, err := s.riskService.GetFlags(ctx, withdrawIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*PendingApproval, 0, len(withdraws))
+	for _, withdraw := range withdraws {
+		required, err := s.requiredApprovals(withdraw.TokenID, withdraw.Amount)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get required approvals for withdraw_id=%s", withdraw.ID)
+		}
+
+		result = append(result, &PendingApproval{
+			Withdraw:          withdraw,
+			RequiredApprovals: required,
+			Approvals:         approvals[withdraw.ID],
+			RiskFlags:         riskFlags[withdraw.ID],
+		})
+	}
+
+	return result, nil
+}
+
+// getApprovals 批量获取提现的审批记录（按审批时间正序）
+func (s *service) getApprovals(ctx context.Context, withdrawIDs []string) (map[string][]*Approval, error) {
+	result := make(map[string][]*Approval, len(withdrawIDs))
+	for _, withdrawID := range withdrawIDs {
+		result[withdrawID] = make([]*Approval, 0)
+	}
+
+	if len(withdrawIDs) == 0 {
+		return result, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, withdraw_id, admin_user_id, decision, COALESCE(reason, ''), created_at
+		FROM withdraw_approvals
+		WHERE withdraw_id = ANY($1::uuid[])
+		ORDER BY created_at ASC, id ASC
+	`, pq.Array(withdrawIDs))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query withdraw approvals")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var approval Approval
+		if err := rows.Scan(
+			&approval.ID,
+			&approval.WithdrawID,
+			&approval.AdminUserID,
+			&approval.Decision,
+			&approval.Reason,
+			&approval.CreatedAt,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan withdraw approval")
+		}
+
+		result[approval.WithdrawID] = append(result[approval.WithdrawID], &approval)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate withdraw approvals")
+	}
+
+	return result, nil
+}
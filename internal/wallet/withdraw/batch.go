@@ -0,0 +1,514 @@
+package withdraw
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	walletMetrics "github/chapool/go-wallet/internal/metrics/wallet"
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/wallet/organization"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/signer"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// 批量提现 gas 估算：基础 gas + 每笔提现的 gas
+const (
+	batchNativeBaseGas    = 35000
+	batchNativeGasPerItem = 10000
+	batchERC20BaseGas     = 50000
+	batchERC20GasPerItem  = 40000
+	erc20ApproveGasLimit  = 60000
+	uint256Bits           = 256
+)
+
+// disperseABI Disperse 合约接口（https://disperse.app）
+var disperseABI = mustParseABI(`[
+	{"type":"function","name":"disperseEther","stateMutability":"payable","inputs":[{"name":"recipients","type":"address[]"},{"name":"values","type":"uint256[]"}],"outputs":[]},
+	{"type":"function","name":"disperseToken","stateMutability":"nonpayable","inputs":[{"name":"token","type":"address"},{"name":"recipients","type":"address[]"},{"name":"values","type":"uint256[]"}],"outputs":[]}
+]`)
+
+// erc20ApproveABI ERC20 approve 接口，Disperse 合约通过 transferFrom 转出热钱包的代币
+var erc20ApproveABI = mustParseABI(`[
+	{"type":"function","name":"approve","stateMutability":"nonpayable","inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}
+]`)
+
+func mustParseABI(definition string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic(err)
+	}
+
+	return parsed
+}
+
+// withdrawGroup 一起发送的提现：batch 为空时单独处理
+type withdrawGroup struct {
+	batch     *BatchConfig
+	withdraws []*models.Withdraw
+}
+
+// batchConfig 获取链的批量提现配置，未配置时返回 nil
+func (s *service) batchConfig(chainID int) *BatchConfig {
+	for i := range s.config.Batches {
+		if s.config.Batches[i].ChainID == chainID {
+			return &s.config.Batches[i]
+		}
+	}
+
+	return nil
+}
+
+// groupWithdraws 将配置了批量提现的链上同一组织、同一代币的提现按 MaxSize 分组，其余提现单独处理，保持原有顺序
+// orgs 为提现用户所属的组织（user_id -> org_id），不同组织的提现从各自的热钱包发送，不能合并
+func (s *service) groupWithdraws(withdraws []*models.Withdraw, orgs map[string]string) []*withdrawGroup {
+	groups := make([]*withdrawGroup, 0, len(withdraws))
+	open := make(map[string]*withdrawGroup) // token_id:org_id -> 未满的分组
+
+	for _, withdraw := range withdraws {
+		batch := s.batchConfig(withdraw.ChainID)
+		if batch == nil {
+			groups = append(groups, &withdrawGroup{withdraws: []*models.Withdraw{withdraw}})
+			continue
+		}
+
+		key := strconv.Itoa(withdraw.TokenID) + ":" + orgs[withdraw.UserID]
+		group, ok := open[key]
+		if !ok || len(group.withdraws) >= batch.MaxSize {
+			group = &withdrawGroup{batch: batch}
+			open[key] = group
+			groups = append(groups, group)
+		}
+		group.withdraws = append(group.withdraws, withdraw)
+	}
+
+	return groups
+}
+
+// userOrgs 查询提现用户所属的组织（user_id -> org_id），不属于任何组织的用户不在结果中
+func (s *service) userOrgs(ctx context.Context, withdraws []*models.Withdraw) (map[string]string, error) {
+	userIDs := make([]string, 0, len(withdraws))
+	for _, withdraw := range withdraws {
+		userIDs = append(userIDs, withdraw.UserID)
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT user_id, org_id FROM organization_members WHERE user_id = ANY($1::uuid[])
+	`, pq.Array(userIDs))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query withdraw user organizations")
+	}
+	defer rows.Close()
+
+	orgs := make(map[string]string)
+	for rows.Next() {
+		var userID, orgID string
+		if err := rows.Scan(&userID, &orgID); err != nil {
+			return nil, errors.Wrap(err, "failed to scan withdraw user organization")
+		}
+		orgs[userID] = orgID
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate withdraw user organizations")
+	}
+
+	return orgs, nil
+}
+
+// sameOrg 判断两个提现用户是否属于同一组织（都不属于任何组织也视为相同）
+func sameOrg(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return *a == *b
+}
+
+// processBatch 将同一代币的多笔已批准提现合并为一笔 Disperse 合约交易（签名并广播），所有提现记录相同的 tx_hash
+func (s *service) processBatch(ctx context.Context, batch *BatchConfig, withdrawIDs []string) (string, error) {
+	// 1. 获取并锁定提现记录
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	withdraws, err := models.Withdraws(
+		models.WithdrawWhere.ID.IN(withdrawIDs),
+		qm.OrderBy(models.WithdrawColumns.CreatedAt+" ASC"),
+		qm.For("UPDATE"),
+	).All(ctx, tx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get withdraw records")
+	}
+	if len(withdraws) != len(withdrawIDs) {
+		return "", errors.Errorf("expected %d withdraws in batch, found %d", len(withdrawIDs), len(withdraws))
+	}
+
+	first := withdraws[0]
+	orgID, err := organization.UserOrgID(ctx, tx, first.UserID)
+	if err != nil {
+		return "", err
+	}
+	for _, withdraw := range withdraws {
+		if withdraw.Status != models.WithdrawStatusUserWithdrawRequest {
+			return "", errors.Wrapf(walleterrors.ErrWithdrawStateConflict, "withdraw %s status is %s, expected %s", withdraw.ID, withdraw.Status, models.WithdrawStatusUserWithdrawRequest)
+		}
+		if withdraw.ChainID != first.ChainID || withdraw.TokenID != first.TokenID {
+			return "", errors.New("withdraws in a batch must have the same chain and token")
+		}
+		withdrawOrgID, err := organization.UserOrgID(ctx, tx, withdraw.UserID)
+		if err != nil {
+			return "", err
+		}
+		if !sameOrg(withdrawOrgID, orgID) {
+			return "", errors.New("withdraws in a batch must belong to the same organization")
+		}
+		if err := s.checkApprovals(ctx, tx, withdraw); err != nil {
+			return "", err
+		}
+	}
+
+	// 2. 获取热钱包、RPC 客户端和代币信息
+	client, err := s.scanService.GetClient(ctx, first.ChainID)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get RPC client")
+	}
+
+	token, err := models.Tokens(models.TokenWhere.ID.EQ(first.TokenID)).One(ctx, tx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get token info")
+	}
+	if !token.IsNative && !token.TokenAddress.Valid {
+		return "", errors.New("token address is invalid for non-native token")
+	}
+
+	hotWallet, err := s.hotWalletService.SelectHotWallet(ctx, first.ChainID, orgID, client, token)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get hot wallet")
+	}
+
+	// 3. 计算每笔提现的金额（最小单位）
+	recipients := make([]common.Address, 0, len(withdraws))
+	values := make([]*big.Int, 0, len(withdraws))
+	total := new(big.Int)
+	for _, withdraw := range withdraws {
+		amountWei, err := money.ToSmallestUnit(withdraw.Amount, token.Decimals)
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid amount of withdraw %s", withdraw.ID)
+		}
+		recipients = append(recipients, common.HexToAddress(withdraw.ToAddress))
+		values = append(values, amountWei)
+		total.Add(total, amountWei)
+	}
+
+	// 4. 获取 gas 价格，ERC20 首次使用合约时需要先 approve
+	fees, err := s.suggestGasFees(ctx, client, first.ChainID)
+	if err != nil {
+		return "", err
+	}
+	if err := s.checkGasPriceCap(ctx, first.ChainID, fees); err != nil {
+		return "", err
+	}
+
+	contract := common.HexToAddress(batch.ContractAddress)
+	hotWalletAddr := common.HexToAddress(hotWallet.Address)
+
+	needApprove := false
+	if !token.IsNative {
+		allowance, err := client.TokenAllowance(ctx, common.HexToAddress(token.TokenAddress.String), hotWalletAddr, contract)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to get disperse contract allowance")
+		}
+		needApprove = allowance.Cmp(total) < 0
+	}
+
+	gasLimit := batchGasLimit(token.IsNative, len(withdraws))
+	gasCost := fees.Cost(gasLimit)
+	if needApprove {
+		gasCost.Add(gasCost, fees.Cost(erc20ApproveGasLimit))
+	}
+
+	// 5. 检查热钱包余额
+	if err := s.checkBatchHotWalletBalance(ctx, client, token, hotWalletAddr, total, gasCost); err != nil {
+		return "", err
+	}
+
+	if needApprove {
+		if err := s.approveDisperseContract(ctx, client, hotWallet, token, contract, fees); err != nil {
+			return "", err
+		}
+	}
+
+	// 6. 构建 Disperse 合约调用
+	var data []byte
+	value := big.NewInt(0)
+	if token.IsNative {
+		data, err = disperseABI.Pack("disperseEther", recipients, values)
+		value = total
+	} else {
+		data, err = disperseABI.Pack("disperseToken", common.HexToAddress(token.TokenAddress.String), recipients, values)
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode disperse call")
+	}
+
+	maxFeePerGas, maxPriorityFeePerGas, gasPrice := fees.SignFields()
+	signReq := &signer.SignEVMRequest{
+		ChainID:              int64(first.ChainID),
+		To:                   contract.Hex(),
+		Value:                value.String(),
+		Data:                 data,
+		GasLimit:             gasLimit,
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+		GasPrice:             gasPrice,
+		FromAddress:          hotWallet.Address,
+		DerivationPath:       hotWallet.DerivationPath,
+	}
+
+	// 刚发送的 approve 尚未上链时模拟必然回滚，此时跳过模拟
+	if !needApprove {
+		if err := simulateTransaction(ctx, client, signReq); err != nil {
+			return "", err
+		}
+	}
+
+	nonce, err := s.hotWalletService.GetNextNonce(ctx, hotWallet.Address, first.ChainID)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get nonce")
+	}
+	//nolint:gosec // Nonce is guaranteed to be positive and fit in uint64
+	signReq.Nonce = uint64(nonce)
+
+	txHash, err := s.signAndSend(ctx, client, signReq, withdrawIDsOf(withdraws)...)
+	if err != nil {
+		return "", err
+	}
+
+	// 交易已广播，之后的失败同样返回交易信息，不能按未广播处理
+	signedErr := func(err error) error {
+		return &signedTxError{txHash: txHash, fromAddress: hotWallet.Address, nonce: null.IntFrom(nonce), err: err}
+	}
+
+	// 7. 记录批次，所有成员提现状态更新为 pending（后续按 tx_hash 各自更新确认状态）
+	var batchID string
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO withdraw_batches (chain_id, token_id, contract_address, tx_hash, withdraw_count, total_amount)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`, first.ChainID, first.TokenID, strings.ToLower(batch.ContractAddress), txHash, len(withdraws), total.String()).Scan(&batchID); err != nil {
+		return "", signedErr(errors.Wrap(err, "failed to insert withdraw batch"))
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO withdraw_batch_items (batch_id, withdraw_id, position)
+		SELECT $1, withdraw_id, position - 1
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS t(withdraw_id, position)
+	`, batchID, pq.Array(withdrawIDsOf(withdraws))); err != nil {
+		return "", signedErr(errors.Wrap(err, "failed to insert withdraw batch items"))
+	}
+
+	if _, err := models.Withdraws(
+		models.WithdrawWhere.ID.IN(withdrawIDsOf(withdraws)),
+	).UpdateAll(ctx, tx, models.M{
+		models.WithdrawColumns.Status:      models.WithdrawStatusPending,
+		models.WithdrawColumns.TXHash:      null.StringFrom(txHash),
+		models.WithdrawColumns.FromAddress: null.StringFrom(hotWallet.Address),
+		models.WithdrawColumns.Nonce:       null.IntFrom(nonce),
+		models.WithdrawColumns.UpdatedAt:   time.Now(),
+	}); err != nil {
+		return "", signedErr(errors.Wrap(err, "failed to update withdraw status"))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", signedErr(errors.Wrap(err, "failed to commit transaction"))
+	}
+
+	log.Info().
+		Str("batch_id", batchID).
+		Int("chain_id", first.ChainID).
+		Int("token_id", first.TokenID).
+		Int("withdraw_count", len(withdraws)).
+		Str("total_amount_wei", total.String()).
+		Str("tx_hash", txHash).
+		Msg("Withdraw batch processed and broadcasted")
+
+	return txHash, nil
+}
+
+// approveDisperseContract 允许 Disperse 合约转出热钱包的代币（无限额度），交易与后续批量交易使用连续的 nonce
+func (s *service) approveDisperseContract(
+	ctx context.Context,
+	client *scan.RPCClient,
+	hotWallet *models.Wallet,
+	token *models.Token,
+	contract common.Address,
+	fees *scan.GasFees,
+) error {
+	maxAmount := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint256Bits), big.NewInt(1))
+	data, err := erc20ApproveABI.Pack("approve", contract, maxAmount)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode approve call")
+	}
+
+	maxFeePerGas, maxPriorityFeePerGas, gasPrice := fees.SignFields()
+	signReq := &signer.SignEVMRequest{
+		ChainID:              int64(token.ChainID),
+		To:                   token.TokenAddress.String,
+		Value:                "0",
+		Data:                 data,
+		GasLimit:             erc20ApproveGasLimit,
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+		GasPrice:             gasPrice,
+		FromAddress:          hotWallet.Address,
+		DerivationPath:       hotWallet.DerivationPath,
+	}
+	if err := simulateTransaction(ctx, client, signReq); err != nil {
+		return err
+	}
+
+	nonce, err := s.hotWalletService.GetNextNonce(ctx, hotWallet.Address, token.ChainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get nonce")
+	}
+	//nolint:gosec // Nonce is guaranteed to be positive and fit in uint64
+	signReq.Nonce = uint64(nonce)
+
+	txHash, err := s.signAndSend(ctx, client, signReq)
+	if err != nil {
+		// approve 交易不是提现交易，重试提现时不需要确认其是否上链
+		var signedErr *signedTxError
+		if errors.As(err, &signedErr) {
+			err = signedErr.err
+		}
+		return errors.Wrap(err, "failed to approve disperse contract")
+	}
+
+	log.Info().
+		Int("chain_id", token.ChainID).
+		Str("token_symbol", token.TokenSymbol).
+		Str("contract", contract.Hex()).
+		Str("tx_hash", txHash).
+		Msg("Approved disperse contract for hot wallet tokens")
+
+	return nil
+}
+
+// simulateTransaction 广播前以 eth_call 模拟执行交易，回滚时返回 *scan.SimulationError
+// 必须在分配 nonce 之前调用，必然失败的交易不占用 nonce
+func simulateTransaction(ctx context.Context, client *scan.RPCClient, req *signer.SignEVMRequest) error {
+	msg, err := req.CallMsg()
+	if err != nil {
+		return errors.Wrap(err, "failed to build simulation call")
+	}
+	return client.SimulateTransaction(ctx, msg) //nolint:wrapcheck // SimulationError is inspected by updateWithdrawStatusOnError
+}
+
+// signAndSend 签名并广播交易，返回交易哈希；广播前在 withdrawIDs 的分发事件上记录已签名的交易，广播失败时返回 *signedTxError
+func (s *service) signAndSend(ctx context.Context, client *scan.RPCClient, req *signer.SignEVMRequest, withdrawIDs ...string) (string, error) {
+	signResp, err := s.signerService.SignEVMTransaction(ctx, req)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign transaction")
+	}
+
+	txObj := new(types.Transaction)
+	if err := txObj.UnmarshalBinary(signResp.RawTransaction); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal signed transaction")
+	}
+
+	//nolint:gosec // Nonce is allocated from wallet_nonces and fits in int
+	nonce := null.IntFrom(int(req.Nonce))
+	for _, withdrawID := range withdrawIDs {
+		if err := s.recordSignedTransaction(ctx, withdrawID, signResp.TxHash, req.FromAddress, nonce); err != nil {
+			return "", err
+		}
+	}
+
+	if err := client.BroadcastTransaction(ctx, txObj); err != nil {
+		return "", &signedTxError{
+			txHash:      signResp.TxHash,
+			fromAddress: req.FromAddress,
+			nonce:       nonce,
+			err:         errors.Wrap(err, "failed to broadcast transaction"),
+		}
+	}
+
+	return signResp.TxHash, nil
+}
+
+// checkBatchHotWalletBalance 检查热钱包余额是否足够支付批量提现总额和 gas
+func (s *service) checkBatchHotWalletBalance(
+	ctx context.Context,
+	client *scan.RPCClient,
+	token *models.Token,
+	hotWalletAddr common.Address,
+	total *big.Int,
+	gasCost *big.Int,
+) error {
+	balance, err := client.BalanceAt(ctx, hotWalletAddr)
+	if err != nil {
+		return errors.Wrap(err, "failed to get hot wallet native token balance")
+	}
+	walletMetrics.SetHotWalletBalance(token.ChainID, strings.ToLower(hotWalletAddr.Hex()), walletMetrics.AssetNative, balance, nativeTokenDecimals)
+
+	requiredNative := new(big.Int).Set(gasCost)
+	if token.IsNative {
+		requiredNative.Add(requiredNative, total)
+	}
+	if balance.Cmp(requiredNative) < 0 {
+		return errors.Errorf("insufficient native token balance in hot wallet for batch: have %s, need %s",
+			balance.String(), requiredNative.String())
+	}
+
+	if token.IsNative {
+		return nil
+	}
+
+	tokenBalance, err := client.TokenBalance(ctx, common.HexToAddress(token.TokenAddress.String), hotWalletAddr)
+	if err != nil {
+		return errors.Wrap(err, "failed to get hot wallet ERC20 token balance")
+	}
+	walletMetrics.SetHotWalletBalance(token.ChainID, strings.ToLower(hotWalletAddr.Hex()), token.TokenSymbol, tokenBalance, token.Decimals)
+
+	if tokenBalance.Cmp(total) < 0 {
+		return errors.Errorf("insufficient ERC20 token balance in hot wallet for batch: have %s, need %s",
+			tokenBalance.String(), total.String())
+	}
+
+	return nil
+}
+
+// batchGasLimit 批量交易的 gas 上限
+func batchGasLimit(isNative bool, count int) uint64 {
+	//nolint:gosec // count is bounded by the configured batch size
+	n := uint64(count)
+	if isNative {
+		return batchNativeBaseGas + batchNativeGasPerItem*n
+	}
+
+	return batchERC20BaseGas + batchERC20GasPerItem*n
+}
+
+func withdrawIDsOf(withdraws []*models.Withdraw) []string {
+	ids := make([]string, 0, len(withdraws))
+	for _, withdraw := range withdraws {
+		ids = append(ids, withdraw.ID)
+	}
+
+	return ids
+}
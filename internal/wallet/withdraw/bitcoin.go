@@ -0,0 +1,267 @@
+package withdraw
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"math/big"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/wallet/bitcoin"
+	"github/chapool/go-wallet/internal/wallet/organization"
+	"github/chapool/go-wallet/internal/wallet/signer"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// bitcoinFeeConfTarget 估算手续费率的目标确认区块数
+	bitcoinFeeConfTarget = 6
+	// defaultBitcoinFeeRate 节点无法估算手续费率时使用的默认值（聪/vbyte）
+	defaultBitcoinFeeRate = 10
+)
+
+// processBitcoinWithdraw 处理 Bitcoin 链提现：从已确认的用户地址和热钱包 UTXO 中按金额从大到小选择输入，
+// 找零转入热钱包；选中的 UTXO 在同一事务中锁定（FOR UPDATE SKIP LOCKED，并发提现不会选中同一输出），广播后更新为 pending
+func (s *service) processBitcoinWithdraw(ctx context.Context, tx *sql.Tx, withdraw *models.Withdraw) error {
+	orgID, err := organization.UserOrgID(ctx, tx, withdraw.UserID)
+	if err != nil {
+		return err
+	}
+	hotWallet, err := s.hotWalletService.GetOrgHotWallet(ctx, withdraw.ChainID, orgID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get hot wallet")
+	}
+
+	client, err := s.scanService.GetBitcoinClient(ctx, withdraw.ChainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get bitcoin RPC client")
+	}
+
+	token, err := models.Tokens(models.TokenWhere.ID.EQ(withdraw.TokenID)).One(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get token info")
+	}
+	if !token.IsNative {
+		return errors.Errorf("bitcoin chain only supports native token withdraws (token_id=%d)", token.ID)
+	}
+
+	amount, err := money.ToSmallestUnit(withdraw.Amount, token.Decimals)
+	if err != nil {
+		return err
+	}
+	if !amount.IsInt64() || amount.Sign() <= 0 {
+		return errors.Errorf("withdraw amount %s out of range", withdraw.Amount)
+	}
+
+	destinationScript, err := bitcoin.AddressScript(withdraw.ToAddress)
+	if err != nil {
+		return errors.Wrap(err, "invalid withdraw address")
+	}
+	changeScript, err := bitcoin.AddressScript(hotWallet.Address)
+	if err != nil {
+		return errors.Wrap(err, "invalid hot wallet address")
+	}
+
+	feeRate := estimateBitcoinFeeRate(ctx, client, withdraw.ChainID)
+
+	utxos, err := s.getSpendableUTXOs(ctx, tx, client, withdraw.ChainID)
+	if err != nil {
+		return err
+	}
+
+	selection, err := bitcoin.SelectUTXOs(utxos, amount.Int64(), feeRate, destinationScript, changeScript)
+	if err != nil {
+		return errors.Wrapf(err, "failed to select utxos for %d satoshis", amount.Int64())
+	}
+
+	transaction := bitcoin.NewTransaction()
+	derivationPaths := make([]string, 0, len(selection.Inputs))
+	for _, utxo := range selection.Inputs {
+		if err := transaction.AddInput(utxo.TxID, utxo.Vout, utxo.Amount, utxo.PkScript); err != nil {
+			return errors.Wrapf(err, "failed to add input %s:%d", utxo.TxID, utxo.Vout)
+		}
+		derivationPaths = append(derivationPaths, utxo.DerivationPath)
+	}
+	transaction.AddOutput(amount.Int64(), destinationScript)
+	if selection.Change > 0 {
+		transaction.AddOutput(selection.Change, changeScript)
+	}
+
+	signResp, err := s.signerService.SignBitcoinTransaction(ctx, &signer.SignBitcoinRequest{
+		Transaction:     transaction,
+		DerivationPaths: derivationPaths,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to sign transaction")
+	}
+
+	if err := s.recordSignedTransaction(ctx, withdraw.ID, signResp.TxID, hotWallet.Address, null.Int{}); err != nil {
+		return err
+	}
+
+	if _, err := client.SendRawTransaction(ctx, signResp.RawTransaction); err != nil {
+		return &signedTxError{txHash: signResp.TxID, fromAddress: hotWallet.Address, err: errors.Wrap(err, "failed to broadcast transaction")}
+	}
+
+	for _, utxo := range selection.Inputs {
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE utxos SET status = 'locked', withdraw_id = $1, spent_tx_hash = $2, updated_at = NOW()
+			WHERE chain_id = $3 AND tx_hash = $4 AND vout = $5 AND status = 'unspent'
+		`, withdraw.ID, signResp.TxID, withdraw.ChainID, utxo.TxID, utxo.Vout); err != nil {
+			return &signedTxError{txHash: signResp.TxID, fromAddress: hotWallet.Address, err: errors.Wrapf(err, "failed to lock utxo %s:%d", utxo.TxID, utxo.Vout)}
+		}
+	}
+
+	// txid 即交易哈希；Bitcoin 没有 nonce，nonce 留空
+	withdraw.Status = models.WithdrawStatusPending
+	withdraw.TXHash = null.StringFrom(signResp.TxID)
+	withdraw.FromAddress = null.StringFrom(hotWallet.Address)
+
+	if _, err := withdraw.Update(ctx, tx, boil.Infer()); err != nil {
+		return &signedTxError{txHash: signResp.TxID, fromAddress: hotWallet.Address, err: errors.Wrap(err, "failed to update withdraw status")}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &signedTxError{txHash: signResp.TxID, fromAddress: hotWallet.Address, err: errors.Wrap(err, "failed to commit transaction")}
+	}
+
+	log.Info().
+		Str("withdraw_id", withdraw.ID).
+		Str("tx_hash", signResp.TxID).
+		Int("input_count", len(selection.Inputs)).
+		Int64("fee", selection.Fee).
+		Int64("fee_rate", feeRate).
+		Msg("Bitcoin withdraw processed and broadcasted")
+
+	return nil
+}
+
+// estimateBitcoinFeeRate 估算手续费率（聪/vbyte），节点无法估算时使用默认值
+func estimateBitcoinFeeRate(ctx context.Context, client *bitcoin.Client, chainID int) int64 {
+	feeRate, err := client.EstimateFeeRate(ctx, bitcoinFeeConfTarget)
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Int("chain_id", chainID).
+			Int64("fee_rate", defaultBitcoinFeeRate).
+			Msg("Failed to estimate bitcoin fee rate, using default")
+		return defaultBitcoinFeeRate
+	}
+
+	return feeRate
+}
+
+// estimateBitcoinFee 按当前手续费率估算一个输入、收款和找零两个 P2WPKH 输出的交易手续费（聪）
+// 实际手续费随选中的输入数量增加，gas 手续费策略按最常见的单输入交易收取
+func (s *service) estimateBitcoinFee(ctx context.Context, chainID int) (*big.Int, error) {
+	client, err := s.scanService.GetBitcoinClient(ctx, chainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get bitcoin RPC client")
+	}
+
+	script := bitcoin.P2WPKHScript(make([]byte, bitcoin.PubKeyHashSize))
+	vsize := bitcoin.EstimateVirtualSize(1, [][]byte{script, script})
+
+	return big.NewInt(vsize * estimateBitcoinFeeRate(ctx, client, chainID)), nil
+}
+
+// getSpendableUTXOs 查询并锁定已达到确认区块数的未花费 UTXO
+func (s *service) getSpendableUTXOs(ctx context.Context, tx *sql.Tx, client *bitcoin.Client, chainID int) ([]*bitcoin.UTXO, error) {
+	chainConfig, err := s.chainService.GetChain(ctx, chainID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get chain config for chain_id=%d", chainID)
+	}
+	confirmationBlocks := int64(defaultConfirmationBlocks)
+	if chainConfig.ConfirmationBlocks.Valid {
+		confirmationBlocks = int64(chainConfig.ConfirmationBlocks.Int)
+	}
+
+	latestHeight, err := client.GetBlockCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT u.tx_hash, u.vout, u.amount, u.script_pub_key, w.derivation_path
+		FROM utxos u
+		JOIN wallets w ON w.chain_id = u.chain_id AND wallet_address_key(w.chain_type, w.address) = u.address
+		WHERE u.chain_id = $1 AND u.status = 'unspent' AND u.block_no <= $2
+		ORDER BY u.amount DESC
+		FOR UPDATE OF u SKIP LOCKED
+	`, chainID, latestHeight-confirmationBlocks+1)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query utxos")
+	}
+	defer rows.Close()
+
+	utxos := make([]*bitcoin.UTXO, 0)
+	for rows.Next() {
+		var (
+			utxo   bitcoin.UTXO
+			script string
+		)
+		if err := rows.Scan(&utxo.TxID, &utxo.Vout, &utxo.Amount, &script, &utxo.DerivationPath); err != nil {
+			return nil, errors.Wrap(err, "failed to scan utxo")
+		}
+		utxo.PkScript, err = hex.DecodeString(script)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid script of utxo %s:%d", utxo.TxID, utxo.Vout)
+		}
+		utxos = append(utxos, &utxo)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to query utxos")
+	}
+
+	return utxos, nil
+}
+
+// createBitcoinTransactionRecordIfConfirmed 提现交易被扫描器标记为花费 UTXO（已打包进区块）时创建 transactions 记录
+func (s *service) createBitcoinTransactionRecordIfConfirmed(ctx context.Context, chainID int, txID string, withdraw *models.Withdraw, latestBlockNumber int64) error {
+	var (
+		blockNo   int64
+		blockHash string
+	)
+	err := s.db.QueryRowContext(ctx, `
+		SELECT spent_block_no, spent_block_hash
+		FROM utxos
+		WHERE chain_id = $1 AND spent_tx_hash = $2 AND spent_block_no IS NOT NULL
+		LIMIT 1
+	`, chainID, txID).Scan(&blockNo, &blockHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("transaction not confirmed yet (may still be pending)")
+		}
+		return errors.Wrap(err, "failed to query spent utxos")
+	}
+
+	transaction := &models.Transaction{
+		ChainID:           chainID,
+		BlockHash:         blockHash,
+		BlockNo:           blockNo,
+		TXHash:            txID,
+		FromAddr:          withdraw.FromAddress.String,
+		ToAddr:            withdraw.ToAddress,
+		Amount:            withdraw.Amount,
+		Type:              models.TransactionTypeWithdraw,
+		Status:            models.TransactionStatusConfirmed,
+		ConfirmationCount: null.IntFrom(int(max(latestBlockNumber-blockNo, 0))),
+	}
+
+	if err := transaction.Insert(ctx, s.db, boil.Infer()); err != nil {
+		return errors.Wrap(err, "failed to create transaction record")
+	}
+
+	log.Info().
+		Str("withdraw_id", withdraw.ID).
+		Str("tx_hash", txID).
+		Int64("block_no", blockNo).
+		Msg("Created transaction record for bitcoin withdraw")
+
+	return nil
+}
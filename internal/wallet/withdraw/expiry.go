@@ -0,0 +1,235 @@
+package withdraw
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// expiredWithdrawMessage 过期自动拒绝的提现的错误信息
+const expiredWithdrawMessage = "Withdraw request expired before approval"
+
+// GetRequestExpiry 获取等待审核的提现请求的过期时间：管理员设置的过期时间优先，否则为创建时间加配置的默认过期时间
+// 不在等待审核状态或不会过期的提现返回 nil
+func (s *service) GetRequestExpiry(withdraw *models.Withdraw) *time.Time {
+	if withdraw.Status != models.WithdrawStatusUserWithdrawRequest {
+		return nil
+	}
+	if withdraw.ExpiresAt.Valid {
+		return &withdraw.ExpiresAt.Time
+	}
+	if s.config.RequestExpiry <= 0 {
+		return nil
+	}
+
+	expiresAt := withdraw.CreatedAt.Add(s.config.RequestExpiry)
+	return &expiresAt
+}
+
+// SetRequestExpiry 管理员设置等待审核的提现请求的过期时间，expiresAt 为 nil 时恢复为配置的默认过期时间
+func (s *service) SetRequestExpiry(ctx context.Context, withdrawID string, adminUserID string, expiresAt *time.Time) (*models.Withdraw, error) {
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, errors.New("expiry has to be in the future")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	withdraw, err := models.Withdraws(
+		models.WithdrawWhere.ID.EQ(withdrawID),
+		qm.For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, walleterrors.ErrWithdrawNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get withdraw record")
+	}
+
+	// 只有等待审核的提现可以设置过期时间
+	if withdraw.Status != models.WithdrawStatusUserWithdrawRequest {
+		return nil, errors.Wrapf(walleterrors.ErrWithdrawStateConflict, "withdraw status is %s, can only set expiry of %s status", withdraw.Status, models.WithdrawStatusUserWithdrawRequest)
+	}
+
+	withdraw.ExpiresAt = null.TimeFromPtr(expiresAt)
+	if _, err := withdraw.Update(ctx, tx, boil.Whitelist(models.WithdrawColumns.ExpiresAt, models.WithdrawColumns.UpdatedAt)); err != nil {
+		return nil, errors.Wrap(err, "failed to update withdraw expiry")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "failed to commit transaction")
+	}
+
+	log.Info().
+		Str("withdraw_id", withdrawID).
+		Str("admin_user_id", adminUserID).
+		Interface("expires_at", expiresAt).
+		Msg("Withdraw request expiry updated by admin")
+
+	return withdraw, nil
+}
+
+// ExpireWithdrawRequests 自动拒绝超过过期时间仍未获得足够批准的提现请求，写入冲正 credits 释放冻结资金，返回拒绝的提现数量
+// 已获得足够批准、等待处理窗口或排队发送的提现不会过期
+func (s *service) ExpireWithdrawRequests(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	// 管理员设置的过期时间优先，未设置时使用配置的默认过期时间
+	expired := qm.Expr(
+		models.WithdrawWhere.ExpiresAt.LTE(null.TimeFrom(now)),
+	)
+	if s.config.RequestExpiry > 0 {
+		expired = qm.Expr(
+			models.WithdrawWhere.ExpiresAt.LTE(null.TimeFrom(now)),
+			qm.Or2(qm.Expr(
+				models.WithdrawWhere.ExpiresAt.IsNull(),
+				models.WithdrawWhere.CreatedAt.LTE(now.Add(-s.config.RequestExpiry)),
+			)),
+		)
+	}
+
+	withdraws, err := models.Withdraws(
+		models.WithdrawWhere.Status.EQ(models.WithdrawStatusUserWithdrawRequest),
+		expired,
+		qm.OrderBy(models.WithdrawColumns.CreatedAt+" ASC"),
+	).All(ctx, s.db)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get expired withdraw requests")
+	}
+
+	count := 0
+	for _, withdraw := range withdraws {
+		expired, err := s.expireWithdraw(ctx, withdraw.ID, now)
+		if err != nil {
+			log.Error().Err(err).Str("withdraw_id", withdraw.ID).Msg("Failed to expire withdraw request")
+			continue
+		}
+		if expired {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// expireWithdraw 拒绝过期的提现请求，锁定提现后重新检查状态、过期时间和批准数，避免与管理员的审核或修改过期时间并发
+// 提现已不再过期或已获得足够批准时返回 false
+func (s *service) expireWithdraw(ctx context.Context, withdrawID string, now time.Time) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	withdraw, err := models.Withdraws(
+		models.WithdrawWhere.ID.EQ(withdrawID),
+		qm.For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get withdraw record")
+	}
+	expiresAt := s.GetRequestExpiry(withdraw)
+	if expiresAt == nil || expiresAt.After(now) {
+		return false, nil
+	}
+
+	// 已获得足够批准的提现在等待处理，不再等待审核
+	required, err := s.requiredApprovals(withdraw.TokenID, withdraw.Amount)
+	if err != nil {
+		return false, err
+	}
+	approvals, err := s.countApprovals(ctx, tx, withdrawID)
+	if err != nil {
+		return false, err
+	}
+	if approvals >= required {
+		return false, nil
+	}
+
+	reversed, err := isWithdrawReversed(ctx, tx, withdrawID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to check withdraw reversal")
+	}
+
+	credits, err := findReversibleCredits(ctx, tx, withdrawID)
+	if err != nil {
+		return false, err
+	}
+
+	withdraw.Status = models.WithdrawStatusFailed
+	withdraw.ErrorMessage = null.StringFrom(expiredWithdrawMessage)
+	if _, err := withdraw.Update(ctx, tx, boil.Infer()); err != nil {
+		return false, errors.Wrap(err, "failed to update withdraw status")
+	}
+
+	// 已冲正的提现只更新状态，不重复退款
+	if !reversed {
+		if err := insertReversalCredits(ctx, tx, credits); err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, errors.Wrap(err, "failed to commit transaction")
+	}
+
+	log.Info().
+		Str("withdraw_id", withdrawID).
+		Str("user_id", withdraw.UserID).
+		Str("amount", withdraw.Amount).
+		Time("expires_at", *expiresAt).
+		Int("approvals", approvals).
+		Msg("Withdraw request expired and rejected")
+
+	return true, nil
+}
+
+// StartRequestExpiryWorker 启动时立即检查一次，之后定时自动拒绝过期的提现请求
+func (s *service) StartRequestExpiryWorker(ctx context.Context, interval time.Duration) {
+	log.Info().
+		Dur("interval", interval).
+		Dur("request_expiry", s.config.RequestExpiry).
+		Msg("Starting withdraw request expiry worker")
+
+	lifecycle.Go(ctx, "withdraw request expiry worker", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		s.runRequestExpiry(ctx)
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Withdraw request expiry worker stopped")
+				return
+			case <-ticker.C:
+				s.runRequestExpiry(ctx)
+			}
+		}
+	})
+}
+
+// runRequestExpiry 定时任务执行一次过期检查
+func (s *service) runRequestExpiry(ctx context.Context) {
+	count, err := s.ExpireWithdrawRequests(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to expire withdraw requests")
+		return
+	}
+	if count > 0 {
+		log.Info().Int("count", count).Msg("Expired withdraw requests awaiting approval")
+	}
+}
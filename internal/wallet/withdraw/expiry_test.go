@@ -0,0 +1,48 @@
+package withdraw
+
+import (
+	"testing"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+
+	"github.com/aarondl/null/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRequestExpiry(t *testing.T) {
+	createdAt := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	override := createdAt.Add(24 * time.Hour)
+
+	tests := []struct {
+		name          string
+		status        models.WithdrawStatus
+		expiresAt     null.Time
+		requestExpiry time.Duration
+		want          *time.Time
+	}{
+		{"DefaultExpiry", models.WithdrawStatusUserWithdrawRequest, null.Time{}, 72 * time.Hour, timePtr(createdAt.Add(72 * time.Hour))},
+		{"AdminOverride", models.WithdrawStatusUserWithdrawRequest, null.TimeFrom(override), 72 * time.Hour, &override},
+		{"AdminOverrideWithoutDefault", models.WithdrawStatusUserWithdrawRequest, null.TimeFrom(override), 0, &override},
+		{"ExpiryDisabled", models.WithdrawStatusUserWithdrawRequest, null.Time{}, 0, nil},
+		{"NotAwaitingApproval", models.WithdrawStatusPending, null.TimeFrom(override), 72 * time.Hour, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &service{config: Config{RequestExpiry: tt.requestExpiry}}
+			got := s.GetRequestExpiry(&models.Withdraw{Status: tt.status, ExpiresAt: tt.expiresAt, CreatedAt: createdAt})
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.True(t, tt.want.Equal(*got))
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,4 @@
+package withdraw
+
+// InsertFeeCredits 供外部测试写入提现手续费 credits
+var InsertFeeCredits = insertFeeCredits
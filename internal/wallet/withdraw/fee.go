@@ -0,0 +1,236 @@
+package withdraw
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"slices"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/solana"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/pkg/errors"
+)
+
+// 手续费类型
+const (
+	FeeTypeFlat       = "flat"       // 固定金额
+	FeeTypePercentage = "percentage" // 按提现金额的百分比
+	FeeTypeGas        = "gas"        // 按当前 gas 价格估算的链上手续费（仅原生代币）
+)
+
+const percentBase = 100
+
+// 同一笔提现的 credits 按 event_index 区分：提现金额为 0（默认值）
+const (
+	feeCreditEventIndex         = 1 // 用户手续费扣减
+	platformFeeCreditEventIndex = 2 // 平台手续费账户入账
+)
+
+// FeePolicy 代币提现手续费策略（withdraw_fees 表），金额均为人类可读单位
+type FeePolicy struct {
+	ID         string
+	TokenID    int
+	FeeType    string
+	FlatAmount *string
+	FeePercent *string
+	MinFee     *string
+	MaxFee     *string
+}
+
+// getFeePolicy 获取代币启用的手续费策略，未配置时返回 nil
+func (s *service) getFeePolicy(ctx context.Context, tokenID int) (*FeePolicy, error) {
+	var (
+		policy     FeePolicy
+		flatAmount sql.NullString
+		feePercent sql.NullString
+		minFee     sql.NullString
+		maxFee     sql.NullString
+	)
+
+	err := s.db.QueryRowContext(ctx, `
+		SELECT id::text, token_id, fee_type, flat_amount, fee_percent, min_fee, max_fee
+		FROM withdraw_fees
+		WHERE token_id = $1 AND is_active
+	`, tokenID).Scan(&policy.ID, &policy.TokenID, &policy.FeeType, &flatAmount, &feePercent, &minFee, &maxFee)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil // 未配置策略表示不收取手续费
+		}
+		return nil, errors.Wrap(err, "failed to get withdraw fee policy")
+	}
+
+	policy.FlatAmount = nullStringPtr(flatAmount)
+	policy.FeePercent = nullStringPtr(feePercent)
+	policy.MinFee = nullStringPtr(minFee)
+	policy.MaxFee = nullStringPtr(maxFee)
+
+	return &policy, nil
+}
+
+// calculateFee 按代币的手续费策略计算提现手续费（人类可读单位）
+// 结果向上取整到代币精度，并受 min_fee / max_fee 限制；未配置策略时为 0
+func (s *service) calculateFee(ctx context.Context, token *models.Token, amount *big.Float) (string, error) {
+	policy, err := s.getFeePolicy(ctx, token.ID)
+	if err != nil {
+		return "", err
+	}
+	if policy == nil {
+		return "0", nil
+	}
+
+	var fee *big.Rat
+	switch policy.FeeType {
+	case FeeTypeFlat:
+		fee, err = parsePolicyAmount("flat_amount", policy.FlatAmount)
+		if err != nil {
+			return "", err
+		}
+	case FeeTypePercentage:
+		pct, err := parsePolicyAmount("fee_percent", policy.FeePercent)
+		if err != nil {
+			return "", err
+		}
+		amountRat, _ := amount.Rat(nil)
+		fee = new(big.Rat).Mul(amountRat, pct)
+		fee.Quo(fee, big.NewRat(percentBase, 1))
+	case FeeTypeGas:
+		if !token.IsNative {
+			return "", errors.Errorf("gas fee policy is only supported for native tokens (token_id=%d)", token.ID)
+		}
+		var gasCost *big.Int
+		switch token.ChainType {
+		case chain.TypeSolana:
+			// Solana 手续费按签名数固定收取
+			gasCost = big.NewInt(solana.LamportsPerSignature)
+		case chain.TypeBitcoin:
+			gasCost, err = s.estimateBitcoinFee(ctx, token.ChainID)
+			if err != nil {
+				return "", err
+			}
+		default:
+			gasCost, err = s.estimateGasCost(ctx, token.ChainID, defaultETHGasLimit)
+			if err != nil {
+				return "", err
+			}
+		}
+		fee = money.FromSmallestUnit(gasCost, token.Decimals)
+	default:
+		return "", errors.Errorf("unknown withdraw fee type %q", policy.FeeType)
+	}
+
+	if policy.MinFee != nil {
+		minFee, err := parsePolicyAmount("min_fee", policy.MinFee)
+		if err != nil {
+			return "", err
+		}
+		if fee.Cmp(minFee) < 0 {
+			fee = minFee
+		}
+	}
+	if policy.MaxFee != nil {
+		maxFee, err := parsePolicyAmount("max_fee", policy.MaxFee)
+		if err != nil {
+			return "", err
+		}
+		if fee.Cmp(maxFee) > 0 {
+			fee = maxFee
+		}
+	}
+
+	return formatFee(fee, token.Decimals), nil
+}
+
+// estimateGasCost 按当前 gas 价格估算交易的最高链上手续费（最小单位）
+func (s *service) estimateGasCost(ctx context.Context, chainID int, gasLimit uint64) (*big.Int, error) {
+	client, err := s.scanService.GetClient(ctx, chainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get RPC client")
+	}
+
+	fees, err := s.suggestGasFees(ctx, client, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return fees.Cost(gasLimit), nil
+}
+
+// suggestGasFees 获取链的 gas 价格：支持 EIP-1559 的链 maxFeePerGas = baseFee * BaseFeeMultiplier + tipCap，
+// 不支持的链或配置为 legacy 的链使用 gasPrice
+func (s *service) suggestGasFees(ctx context.Context, client *scan.RPCClient, chainID int) (*scan.GasFees, error) {
+	fees, err := client.SuggestGasFees(ctx, s.config.BaseFeeMultiplier, slices.Contains(s.config.LegacyTxChainIDs, chainID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to suggest gas fees")
+	}
+
+	return fees, nil
+}
+
+// insertFeeCredits 为提现手续费写入一对 Credits：用户扣减（与提现金额一起冻结）、平台手续费账户入账
+// 平台入账为 internal_transfer 业务类型的已终结记录，提现退款时与用户扣减一起冲正
+func insertFeeCredits(ctx context.Context, exec boil.ContextExecutor, withdrawCredit *models.Credit, fee string, accountUserID string) error {
+	entries := []struct {
+		userID       string
+		amount       string
+		businessType models.BusinessType
+		status       models.CreditStatus
+		eventIndex   int
+	}{
+		{withdrawCredit.UserID, "-" + fee, models.BusinessTypeBlockchain, models.CreditStatusFrozen, feeCreditEventIndex},
+		{accountUserID, fee, models.BusinessTypeInternalTransfer, models.CreditStatusFinalized, platformFeeCreditEventIndex},
+	}
+	for _, entry := range entries {
+		credit := &models.Credit{
+			UserID:        entry.userID,
+			Address:       withdrawCredit.Address,
+			TokenID:       withdrawCredit.TokenID,
+			TokenSymbol:   withdrawCredit.TokenSymbol,
+			Amount:        entry.amount,
+			CreditType:    models.CreditTypeWithdrawFee,
+			BusinessType:  entry.businessType,
+			ReferenceID:   withdrawCredit.ReferenceID,
+			ReferenceType: withdrawCredit.ReferenceType,
+			ChainID:       withdrawCredit.ChainID,
+			ChainType:     withdrawCredit.ChainType,
+			Status:        entry.status,
+			EventIndex:    null.IntFrom(entry.eventIndex),
+		}
+		if err := credit.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert withdraw fee credit record")
+		}
+	}
+
+	return nil
+}
+
+// parsePolicyAmount 解析策略中的非负十进制数
+func parsePolicyAmount(field string, value *string) (*big.Rat, error) {
+	if value == nil {
+		return nil, errors.Errorf("withdraw fee policy %s is not set", field)
+	}
+	r, ok := new(big.Rat).SetString(*value)
+	if !ok || r.Sign() < 0 {
+		return nil, errors.Errorf("invalid withdraw fee policy %s %q", field, *value)
+	}
+
+	return r, nil
+}
+
+// formatFee 将手续费向上取整到代币精度并格式化为十进制字符串（去掉末尾的 0）
+func formatFee(fee *big.Rat, decimals int) string {
+	return money.Format(money.FromSmallestUnit(money.CeilSmallestUnit(fee, decimals), decimals), decimals)
+}
+
+func nullStringPtr(v sql.NullString) *string {
+	if !v.Valid {
+		return nil
+	}
+
+	return &v.String
+}
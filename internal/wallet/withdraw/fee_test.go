@@ -0,0 +1,176 @@
+package withdraw_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/test"
+	"github/chapool/go-wallet/internal/test/fixtures"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+	"github/chapool/go-wallet/internal/wallet/withdraw"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	feeTestAmount = "1"
+	feeTestFee    = "0.01"
+)
+
+// seedWithdrawWithFee 写入等待审核的提现、冻结的提现金额和手续费 credits，平台手续费由 accountUserID 入账
+func seedWithdrawWithFee(t *testing.T, ctx context.Context, db *sql.DB, userID string, accountUserID string, expiresAt null.Time) *models.Withdraw {
+	t.Helper()
+
+	token, err := models.Tokens(qm.OrderBy(models.TokenColumns.ID)).One(ctx, db)
+	require.NoError(t, err)
+
+	record := &models.Withdraw{
+		UserID:    userID,
+		ToAddress: "0x0000000000000000000000000000000000000001",
+		TokenID:   token.ID,
+		Amount:    feeTestAmount,
+		Fee:       feeTestFee,
+		ChainID:   token.ChainID,
+		ChainType: token.ChainType,
+		Status:    models.WithdrawStatusUserWithdrawRequest,
+		ExpiresAt: expiresAt,
+	}
+	require.NoError(t, record.Insert(ctx, db, boil.Infer()))
+
+	withdrawCredit := &models.Credit{
+		UserID:        userID,
+		Address:       "0x8589427373d6d84e98730d7795d8f6f8731fda16",
+		TokenID:       token.ID,
+		TokenSymbol:   token.TokenSymbol,
+		Amount:        "-" + feeTestAmount,
+		CreditType:    models.CreditTypeWithdraw,
+		BusinessType:  models.BusinessTypeBlockchain,
+		ReferenceID:   record.ID,
+		ReferenceType: models.ReferenceTypeWithdraw,
+		ChainID:       null.IntFrom(token.ChainID),
+		ChainType:     null.StringFrom(token.ChainType),
+		Status:        models.CreditStatusFrozen,
+		EventIndex:    null.IntFrom(0),
+	}
+	require.NoError(t, withdrawCredit.Insert(ctx, db, boil.Infer()))
+	require.NoError(t, withdraw.InsertFeeCredits(ctx, db, withdrawCredit, feeTestFee, accountUserID))
+
+	return record
+}
+
+// withdrawCredits 按 event_index 返回提现的某类 credits
+func withdrawCredits(t *testing.T, ctx context.Context, db *sql.DB, withdrawID string, creditType models.CreditType) models.CreditSlice {
+	t.Helper()
+
+	credits, err := models.Credits(
+		models.CreditWhere.ReferenceID.EQ(withdrawID),
+		models.CreditWhere.ReferenceType.EQ(models.ReferenceTypeWithdraw),
+		models.CreditWhere.CreditType.EQ(creditType),
+		qm.OrderBy(models.CreditColumns.EventIndex),
+	).All(ctx, db)
+	require.NoError(t, err)
+
+	return credits
+}
+
+// assertFeeReversed 检查提现金额、用户手续费和平台手续费入账各冲正一次，且冲正记录与原记录的状态和 event_index 相同
+func assertFeeReversed(t *testing.T, ctx context.Context, db *sql.DB, withdrawID string, userID string, accountUserID string) {
+	t.Helper()
+
+	reversals := withdrawCredits(t, ctx, db, withdrawID, models.CreditTypeWithdrawReversal)
+	require.Len(t, reversals, 3)
+
+	expected := []struct {
+		userID string
+		amount string
+		status models.CreditStatus
+	}{
+		{userID, feeTestAmount, models.CreditStatusFrozen},
+		{userID, feeTestFee, models.CreditStatusFrozen},
+		{accountUserID, "-" + feeTestFee, models.CreditStatusFinalized},
+	}
+	for i, want := range expected {
+		assert.Equal(t, want.userID, reversals[i].UserID)
+		assert.Equal(t, want.amount, reversals[i].Amount)
+		assert.Equal(t, want.status, reversals[i].Status)
+		assert.Equal(t, null.IntFrom(i), reversals[i].EventIndex)
+	}
+}
+
+func TestInsertFeeCredits(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		record := seedWithdrawWithFee(t, ctx, db, fix.User1.ID, fix.User2.ID, null.Time{})
+
+		// 用户手续费与提现金额一起冻结，平台手续费账户直接入账
+		fees := withdrawCredits(t, ctx, db, record.ID, models.CreditTypeWithdrawFee)
+		require.Len(t, fees, 2)
+
+		assert.Equal(t, fix.User1.ID, fees[0].UserID)
+		assert.Equal(t, "-"+feeTestFee, fees[0].Amount)
+		assert.Equal(t, models.BusinessTypeBlockchain, fees[0].BusinessType)
+		assert.Equal(t, models.CreditStatusFrozen, fees[0].Status)
+		assert.Equal(t, null.IntFrom(1), fees[0].EventIndex)
+
+		assert.Equal(t, fix.User2.ID, fees[1].UserID)
+		assert.Equal(t, feeTestFee, fees[1].Amount)
+		assert.Equal(t, models.BusinessTypeInternalTransfer, fees[1].BusinessType)
+		assert.Equal(t, models.CreditStatusFinalized, fees[1].Status)
+		assert.Equal(t, null.IntFrom(2), fees[1].EventIndex)
+	})
+}
+
+func TestRejectWithdrawReversesFeeCredits(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		record := seedWithdrawWithFee(t, ctx, db, fix.User1.ID, fix.User2.ID, null.Time{})
+		service := withdraw.NewService(db, withdraw.Config{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		rejected, err := service.RejectWithdraw(ctx, record.ID, fix.User2.ID, "suspicious")
+		require.NoError(t, err)
+		assert.Equal(t, models.WithdrawStatusFailed, rejected.Status)
+		assertFeeReversed(t, ctx, db, record.ID, fix.User1.ID, fix.User2.ID)
+
+		// 另一位管理员再次拒绝时不能重复退款
+		_, err = service.RejectWithdraw(ctx, record.ID, fix.User1.ID, "suspicious")
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, walleterrors.ErrWithdrawStateConflict))
+		assertFeeReversed(t, ctx, db, record.ID, fix.User1.ID, fix.User2.ID)
+	})
+}
+
+func TestExpireWithdrawReversesFeeCredits(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		record := seedWithdrawWithFee(t, ctx, db, fix.User1.ID, fix.User2.ID, null.TimeFrom(time.Now().Add(-time.Minute)))
+		service := withdraw.NewService(db, withdraw.Config{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		count, err := service.ExpireWithdrawRequests(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		assertFeeReversed(t, ctx, db, record.ID, fix.User1.ID, fix.User2.ID)
+
+		require.NoError(t, record.Reload(ctx, db))
+		assert.Equal(t, models.WithdrawStatusFailed, record.Status)
+
+		// 已过期的提现不再等待审核，管理员拒绝也不会重复退款
+		_, err = service.RejectWithdraw(ctx, record.ID, fix.User2.ID, "")
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, walleterrors.ErrWithdrawStateConflict))
+		assertFeeReversed(t, ctx, db, record.ID, fix.User1.ID, fix.User2.ID)
+	})
+}
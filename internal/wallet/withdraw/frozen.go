@@ -0,0 +1,376 @@
+package withdraw
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/alert"
+	"github/chapool/go-wallet/internal/wallet/chain"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// 冻结资金异常原因
+const (
+	FrozenReasonWithdrawMissing      = "withdraw_missing"       // credits 引用的提现记录不存在
+	FrozenReasonFailedNotBroadcast   = "failed_not_broadcast"   // 提现在签名前失败（EVM 链最近一次分发没有签名交易），长时间未被拒绝或重试
+	FrozenReasonFailedBroadcast      = "failed_broadcast"       // 提现失败但交易可能已广播（有交易哈希或非 EVM 链），无法确认资金是否已转出
+	FrozenReasonFailedSigningUnknown = "failed_signing_unknown" // 提现失败且没有签名记录（没有发件箱事件），无法确认交易是否已签名广播
+	FrozenReasonStaleApproved        = "stale_approved"         // 已获得批准但长时间未发送（处理中崩溃或一直排队）
+	FrozenReasonStaleSigning         = "stale_signing"          // 长时间停留在签名中
+	FrozenReasonStaleUnconfirmed     = "stale_unconfirmed"      // 已广播但长时间未确认（交易可能被丢弃）
+)
+
+// 冻结资金处理方式
+const (
+	FrozenActionRelease  = "release"  // 写入冲正 credits 释放冻结资金
+	FrozenActionEscalate = "escalate" // 无法确定资金是否已转出，告警并等待管理员处理
+)
+
+// FrozenCreditsConfig 冻结资金对账配置
+type FrozenCreditsConfig struct {
+	// ReleaseAfter 签名前失败的 EVM 提现超过该时间未被处理时自动释放冻结资金（0 表示不自动释放，只告警）
+	ReleaseAfter time.Duration
+	// StaleAfter 提现超过该时间停留在失败、已批准未发送、签名中或未确认状态时告警
+	StaleAfter time.Duration
+}
+
+// FrozenCreditReport 冻结资金对账报告
+type FrozenCreditReport struct {
+	Items     []*FrozenWithdraw
+	DryRun    bool
+	CheckedAt time.Time
+}
+
+// FrozenWithdraw 一笔提现未冲正的冻结 credits（提现金额和手续费）及其处理方式
+type FrozenWithdraw struct {
+	WithdrawID     string
+	UserID         string
+	TokenID        int
+	TokenSymbol    string
+	ChainID        *int
+	ChainType      string
+	WithdrawStatus *string // 提现记录不存在时为空
+	TxHash         *string // 提现记录的交易哈希，没有时为最近一次分发签名的交易
+	FrozenAmount   string  // 冻结的 credits 金额之和（负数）
+	CreditIDs      []string
+	Since          time.Time // 提现最后更新时间，提现记录不存在时为 credits 创建时间
+	Reason         string
+	Action         string
+	Released       bool // 已写入冲正 credits
+	Error          *string
+}
+
+// frozenWithdrawRow 冻结资金查询结果
+type frozenWithdrawRow struct {
+	item        *FrozenWithdraw
+	hasApproval bool
+	unsigned    bool // 最近一次分发没有签名交易（见 signingRecord）
+}
+
+// ReconcileFrozenCredits 检查未冲正的冻结提现 credits，找出父提现处于失败或停滞状态的冻结资金：
+// 签名前失败的 EVM 提现超过 ReleaseAfter 后自动释放，其他情况告警；dryRun 时只返回报告
+// 已确认的提现和等待审核的提现的冻结资金是正常状态，不在报告中
+func (s *service) ReconcileFrozenCredits(ctx context.Context, dryRun bool) (*FrozenCreditReport, error) {
+	now := time.Now()
+	rows, err := s.queryFrozenWithdraws(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &FrozenCreditReport{
+		Items:     make([]*FrozenWithdraw, 0),
+		DryRun:    dryRun,
+		CheckedAt: now,
+	}
+	for _, row := range rows {
+		reason, action := classifyFrozenWithdraw(row.item, row.hasApproval, row.unsigned, now, s.config.FrozenCredits)
+		if reason == "" {
+			continue
+		}
+		item := row.item
+		item.Reason = reason
+		item.Action = action
+		report.Items = append(report.Items, item)
+
+		if dryRun {
+			continue
+		}
+
+		if action == FrozenActionRelease {
+			if err := s.releaseFrozenCredits(ctx, item.WithdrawID); err != nil {
+				log.Error().Err(err).Str("withdraw_id", item.WithdrawID).Msg("Failed to release frozen withdraw credits")
+				message := err.Error()
+				item.Error = &message
+				continue
+			}
+			item.Released = true
+			continue
+		}
+
+		s.alertFrozenWithdraw(ctx, item)
+	}
+
+	return report, nil
+}
+
+// StartFrozenCreditReconciler 启动时立即对账一次，之后定时对账
+func (s *service) StartFrozenCreditReconciler(ctx context.Context, interval time.Duration) {
+	log.Info().
+		Dur("interval", interval).
+		Dur("release_after", s.config.FrozenCredits.ReleaseAfter).
+		Dur("stale_after", s.config.FrozenCredits.StaleAfter).
+		Msg("Starting frozen withdraw credit reconciler")
+
+	lifecycle.Go(ctx, "frozen withdraw credit reconciler", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		s.runFrozenCreditReconciler(ctx)
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Frozen withdraw credit reconciler stopped")
+				return
+			case <-ticker.C:
+				s.runFrozenCreditReconciler(ctx)
+			}
+		}
+	})
+}
+
+// runFrozenCreditReconciler 定时任务执行一次对账
+func (s *service) runFrozenCreditReconciler(ctx context.Context) {
+	report, err := s.ReconcileFrozenCredits(ctx, false)
+	if err != nil {
+		log.Error().Err(err).Msg("Frozen withdraw credit reconciliation failed")
+		return
+	}
+
+	released := 0
+	for _, item := range report.Items {
+		if item.Released {
+			released++
+		}
+	}
+	if len(report.Items) > 0 {
+		log.Info().
+			Int("released", released).
+			Int("escalated", len(report.Items)-released).
+			Msg("Frozen withdraw credit reconciliation finished")
+	}
+}
+
+// classifyFrozenWithdraw 根据父提现的状态和停留时间判断冻结资金的异常原因和处理方式，正常状态返回空字符串
+// 只有确认没有签名交易（unsigned）的失败提现可以自动释放，没有签名记录时无法排除交易已广播
+func classifyFrozenWithdraw(item *FrozenWithdraw, hasApproval bool, unsigned bool, now time.Time, config FrozenCreditsConfig) (string, string) {
+	if item.WithdrawStatus == nil {
+		return FrozenReasonWithdrawMissing, FrozenActionEscalate
+	}
+
+	age := now.Sub(item.Since)
+	stale := config.StaleAfter > 0 && age >= config.StaleAfter
+
+	switch models.WithdrawStatus(*item.WithdrawStatus) {
+	case models.WithdrawStatusFailed:
+		// 非 EVM 链广播失败时不记录交易哈希，无法排除交易已上链
+		if item.TxHash != nil || item.ChainType != chain.TypeEVM {
+			if stale {
+				return FrozenReasonFailedBroadcast, FrozenActionEscalate
+			}
+			return "", ""
+		}
+		if !unsigned {
+			if stale {
+				return FrozenReasonFailedSigningUnknown, FrozenActionEscalate
+			}
+			return "", ""
+		}
+		if config.ReleaseAfter > 0 && age >= config.ReleaseAfter {
+			return FrozenReasonFailedNotBroadcast, FrozenActionRelease
+		}
+		if stale {
+			return FrozenReasonFailedNotBroadcast, FrozenActionEscalate
+		}
+	case models.WithdrawStatusUserWithdrawRequest:
+		// 未批准的提现在等待审核，冻结是预期的
+		if hasApproval && stale {
+			return FrozenReasonStaleApproved, FrozenActionEscalate
+		}
+	case models.WithdrawStatusSigning:
+		if stale {
+			return FrozenReasonStaleSigning, FrozenActionEscalate
+		}
+	case models.WithdrawStatusPending, models.WithdrawStatusProcessing:
+		if stale {
+			return FrozenReasonStaleUnconfirmed, FrozenActionEscalate
+		}
+	case models.WithdrawStatusConfirmed:
+	}
+
+	return "", ""
+}
+
+// queryFrozenWithdraws 按提现汇总未冲正的冻结提现 credits（提现金额和手续费）
+func (s *service) queryFrozenWithdraws(ctx context.Context) ([]*frozenWithdrawRow, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT c.reference_id, c.user_id::text, c.token_id, c.token_symbol, c.chain_id, COALESCE(c.chain_type, ''),
+			w.status::text, COALESCE(w.tx_hash, o.tx_hash), SUM(c.amount::numeric)::text, array_agg(c.id::text ORDER BY c.created_at),
+			COALESCE(w.updated_at, MIN(c.created_at)),
+			EXISTS (SELECT 1 FROM withdraw_approvals a WHERE a.withdraw_id = w.id AND a.decision = $1),
+			o.id IS NOT NULL AND o.tx_hash IS NULL
+		FROM credits c
+		LEFT JOIN withdraws w ON w.id::text = c.reference_id
+		LEFT JOIN LATERAL (
+			SELECT id, tx_hash FROM withdraw_outbox WHERE withdraw_id = w.id ORDER BY id DESC LIMIT 1
+		) o ON TRUE
+		WHERE c.reference_type = 'withdraw'
+			AND c.credit_type IN ('withdraw', 'withdraw_fee')
+			AND c.status = 'frozen'
+			AND (w.id IS NULL OR w.status <> 'confirmed')
+			AND NOT EXISTS (
+				SELECT 1 FROM credits r
+				WHERE r.reference_type = 'withdraw' AND r.credit_type = 'withdraw_reversal'
+					AND r.metadata->>'reverses_credit_id' = c.id::text
+			)
+		GROUP BY c.reference_id, c.user_id, c.token_id, c.token_symbol, c.chain_id, c.chain_type, w.id, w.status, w.tx_hash, w.updated_at, o.id, o.tx_hash
+		ORDER BY COALESCE(w.updated_at, MIN(c.created_at)) ASC
+	`, ApprovalDecisionApproved)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query frozen withdraw credits")
+	}
+	defer rows.Close()
+
+	var result []*frozenWithdrawRow
+	for rows.Next() {
+		var (
+			item      = &FrozenWithdraw{}
+			row       = &frozenWithdrawRow{item: item}
+			chainID   sql.NullInt64
+			status    sql.NullString
+			txHash    sql.NullString
+			creditIDs pq.StringArray
+		)
+		if err := rows.Scan(&item.WithdrawID, &item.UserID, &item.TokenID, &item.TokenSymbol, &chainID, &item.ChainType,
+			&status, &txHash, &item.FrozenAmount, &creditIDs, &item.Since, &row.hasApproval, &row.unsigned); err != nil {
+			return nil, errors.Wrap(err, "failed to scan frozen withdraw credits")
+		}
+		if chainID.Valid {
+			id := int(chainID.Int64)
+			item.ChainID = &id
+		}
+		if status.Valid {
+			item.WithdrawStatus = &status.String
+		}
+		if txHash.Valid {
+			item.TxHash = &txHash.String
+		}
+		item.CreditIDs = creditIDs
+		result = append(result, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate frozen withdraw credits")
+	}
+
+	return result, nil
+}
+
+// releaseFrozenCredits 为签名前失败的提现写入冲正 credits，锁定提现后重新检查状态和签名记录，避免与管理员的拒绝或重试并发
+func (s *service) releaseFrozenCredits(ctx context.Context, withdrawID string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	withdraw, err := models.Withdraws(
+		models.WithdrawWhere.ID.EQ(withdrawID),
+		qm.For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get withdraw record")
+	}
+	if withdraw.Status != models.WithdrawStatusFailed || withdraw.TXHash.Valid || withdraw.ChainType != chain.TypeEVM {
+		return errors.Errorf("withdraw is no longer a failed withdraw without transaction (status: %s)", withdraw.Status)
+	}
+
+	record, err := latestSigningRecord(ctx, tx, withdrawID)
+	if err != nil {
+		return err
+	}
+	if !record.unsigned() {
+		return errors.New("withdraw has no record proving its transaction was never signed")
+	}
+
+	reversed, err := isWithdrawReversed(ctx, tx, withdrawID)
+	if err != nil {
+		return errors.Wrap(err, "failed to check withdraw reversal")
+	}
+	if reversed {
+		return nil
+	}
+
+	credits, err := findReversibleCredits(ctx, tx, withdrawID)
+	if err != nil {
+		return err
+	}
+
+	if err := insertReversalCredits(ctx, tx, credits); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	log.Warn().
+		Str("withdraw_id", withdrawID).
+		Str("user_id", withdraw.UserID).
+		Str("amount", withdraw.Amount).
+		Str("fee", withdraw.Fee).
+		Str("error_message", withdraw.ErrorMessage.String).
+		Msg("Released frozen credits of withdraw that failed before signing")
+
+	return nil
+}
+
+// alertFrozenWithdraw 告警需要管理员处理的冻结资金，同一提现同一原因只告警一次
+func (s *service) alertFrozenWithdraw(ctx context.Context, item *FrozenWithdraw) {
+	key := item.WithdrawID + ":" + item.Reason
+	if _, loaded := s.frozenAlerted.LoadOrStore(key, struct{}{}); loaded {
+		return
+	}
+
+	a := &alert.Alert{
+		Type:     alert.TypeFrozenCreditsEscalated,
+		Severity: alert.SeverityWarning,
+		Message:  fmt.Sprintf("Withdraw %s has frozen credits of %s %s that need manual resolution (%s)", item.WithdrawID, item.FrozenAmount, item.TokenSymbol, item.Reason),
+		Fields: map[string]any{
+			"withdraw_id":     item.WithdrawID,
+			"user_id":         item.UserID,
+			"token_id":        item.TokenID,
+			"frozen_amount":   item.FrozenAmount,
+			"reason":          item.Reason,
+			"withdraw_status": item.WithdrawStatus,
+			"since":           item.Since,
+		},
+		Time: time.Now(),
+	}
+	if item.ChainID != nil {
+		a.ChainID = *item.ChainID
+	}
+
+	notifier := s.notifier
+	if notifier == nil {
+		notifier = alert.NewNotifier("", nil, nil)
+	}
+	_ = notifier.Notify(ctx, a)
+}
@@ -0,0 +1,68 @@
+package withdraw
+
+import (
+	"testing"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/chain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyFrozenWithdraw(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	config := FrozenCreditsConfig{ReleaseAfter: 72 * time.Hour, StaleAfter: 24 * time.Hour}
+	txHash := "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"
+
+	tests := []struct {
+		name        string
+		status      *models.WithdrawStatus
+		chainType   string
+		txHash      *string
+		age         time.Duration
+		hasApproval bool
+		unsigned    bool
+		config      FrozenCreditsConfig
+		reason      string
+		action      string
+	}{
+		{"WithdrawMissing", nil, chain.TypeEVM, nil, time.Minute, false, false, config, FrozenReasonWithdrawMissing, FrozenActionEscalate},
+		{"FailedRecently", statusPtr(models.WithdrawStatusFailed), chain.TypeEVM, nil, time.Hour, false, true, config, "", ""},
+		{"FailedStale", statusPtr(models.WithdrawStatusFailed), chain.TypeEVM, nil, 48 * time.Hour, false, true, config, FrozenReasonFailedNotBroadcast, FrozenActionEscalate},
+		{"FailedRelease", statusPtr(models.WithdrawStatusFailed), chain.TypeEVM, nil, 72 * time.Hour, false, true, config, FrozenReasonFailedNotBroadcast, FrozenActionRelease},
+		{"FailedReleaseDisabled", statusPtr(models.WithdrawStatusFailed), chain.TypeEVM, nil, 96 * time.Hour, false, true, FrozenCreditsConfig{StaleAfter: 24 * time.Hour}, FrozenReasonFailedNotBroadcast, FrozenActionEscalate},
+		{"FailedBroadcast", statusPtr(models.WithdrawStatusFailed), chain.TypeEVM, &txHash, 96 * time.Hour, false, false, config, FrozenReasonFailedBroadcast, FrozenActionEscalate},
+		{"FailedNoSigningRecord", statusPtr(models.WithdrawStatusFailed), chain.TypeEVM, nil, 96 * time.Hour, false, false, config, FrozenReasonFailedSigningUnknown, FrozenActionEscalate},
+		{"FailedNoSigningRecordRecently", statusPtr(models.WithdrawStatusFailed), chain.TypeEVM, nil, time.Hour, false, false, config, "", ""},
+		{"FailedNonEVM", statusPtr(models.WithdrawStatusFailed), chain.TypeSolana, nil, 96 * time.Hour, false, false, config, FrozenReasonFailedBroadcast, FrozenActionEscalate},
+		{"AwaitingReview", statusPtr(models.WithdrawStatusUserWithdrawRequest), chain.TypeEVM, nil, 96 * time.Hour, false, false, config, "", ""},
+		{"ApprovedStale", statusPtr(models.WithdrawStatusUserWithdrawRequest), chain.TypeEVM, nil, 48 * time.Hour, true, false, config, FrozenReasonStaleApproved, FrozenActionEscalate},
+		{"SigningStale", statusPtr(models.WithdrawStatusSigning), chain.TypeEVM, nil, 48 * time.Hour, true, false, config, FrozenReasonStaleSigning, FrozenActionEscalate},
+		{"PendingRecently", statusPtr(models.WithdrawStatusPending), chain.TypeEVM, &txHash, time.Hour, true, false, config, "", ""},
+		{"ProcessingStale", statusPtr(models.WithdrawStatusProcessing), chain.TypeEVM, &txHash, 48 * time.Hour, true, false, config, FrozenReasonStaleUnconfirmed, FrozenActionEscalate},
+		{"Confirmed", statusPtr(models.WithdrawStatusConfirmed), chain.TypeEVM, &txHash, 96 * time.Hour, true, false, config, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := &FrozenWithdraw{
+				ChainType: tt.chainType,
+				TxHash:    tt.txHash,
+				Since:     now.Add(-tt.age),
+			}
+			if tt.status != nil {
+				status := tt.status.String()
+				item.WithdrawStatus = &status
+			}
+
+			reason, action := classifyFrozenWithdraw(item, tt.hasApproval, tt.unsigned, now, tt.config)
+			assert.Equal(t, tt.reason, reason)
+			assert.Equal(t, tt.action, action)
+		})
+	}
+}
+
+func statusPtr(status models.WithdrawStatus) *models.WithdrawStatus {
+	return &status
+}
@@ -0,0 +1,102 @@
+package withdraw
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// pgUniqueViolation PostgreSQL 唯一约束冲突错误码
+const pgUniqueViolation = "23505"
+
+var (
+	// ErrIdempotencyKeyConflict 幂等键已被同一用户用于参数不同的提现请求
+	ErrIdempotencyKeyConflict = walleterrors.ErrIdempotencyKeyConflict
+	// ErrRateLimited 用户发起提现过于频繁
+	ErrRateLimited = walleterrors.ErrRateLimited
+)
+
+// requestHash 提现请求参数摘要，用于识别使用相同幂等键但参数不同的请求
+func requestHash(req *Request) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		strings.ToLower(req.ToAddress),
+		strconv.Itoa(req.TokenID),
+		req.Amount.Text('f', -1),
+	}, "|")))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// findIdempotentWithdraw 查找用户使用相同幂等键创建的提现，未使用过时返回 nil
+func (s *service) findIdempotentWithdraw(ctx context.Context, userID string, req *Request) (*models.Withdraw, error) {
+	var withdrawID, hash string
+	err := s.db.QueryRowContext(ctx, `
+		SELECT withdraw_id, request_hash FROM withdraw_idempotency_keys WHERE user_id = $1 AND idempotency_key = $2
+	`, userID, req.IdempotencyKey).Scan(&withdrawID, &hash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil // 幂等键未使用过
+		}
+		return nil, errors.Wrap(err, "failed to get withdraw idempotency key")
+	}
+
+	if hash != requestHash(req) {
+		return nil, ErrIdempotencyKeyConflict
+	}
+
+	withdraw, err := models.FindWithdraw(ctx, s.db, withdrawID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get idempotent withdraw")
+	}
+
+	return withdraw, nil
+}
+
+// insertIdempotencyKey 在创建提现的事务中记录幂等键
+// 并发的重复请求会在唯一约束上等待先到的事务提交，随后返回唯一约束冲突
+func insertIdempotencyKey(ctx context.Context, exec boil.ContextExecutor, userID string, req *Request, withdrawID string) error {
+	_, err := exec.ExecContext(ctx, `
+		INSERT INTO withdraw_idempotency_keys (user_id, idempotency_key, withdraw_id, request_hash) VALUES ($1, $2, $3, $4)
+	`, userID, req.IdempotencyKey, withdrawID, requestHash(req))
+
+	return err
+}
+
+// isUniqueViolation 判断是否为唯一约束冲突
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == pgUniqueViolation
+}
+
+// checkRateLimit 检查用户在限制窗口内发起的提现数量，需在锁定用户（FOR UPDATE）的事务中调用
+func (s *service) checkRateLimit(ctx context.Context, exec boil.ContextExecutor, userID string) error {
+	limit := s.config.RateLimit
+	if limit.MaxRequests <= 0 {
+		return nil
+	}
+
+	count, err := models.Withdraws(
+		models.WithdrawWhere.UserID.EQ(userID),
+		models.WithdrawWhere.CreatedAt.GT(time.Now().Add(-limit.Window)),
+	).Count(ctx, exec)
+	if err != nil {
+		return errors.Wrap(err, "failed to count recent withdraws")
+	}
+
+	if count >= int64(limit.MaxRequests) {
+		return ErrRateLimited
+	}
+
+	return nil
+}
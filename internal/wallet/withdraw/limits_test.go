@@ -0,0 +1,132 @@
+package withdraw_test
+
+import (
+	"database/sql"
+	"math/big"
+	"testing"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/test"
+	"github/chapool/go-wallet/internal/test/fixtures"
+	"github/chapool/go-wallet/internal/wallet/balance"
+	"github/chapool/go-wallet/internal/wallet/risk"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+	"github/chapool/go-wallet/internal/wallet/withdraw"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestWithdrawLimitExceeded(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		token, err := models.Tokens(qm.OrderBy(models.TokenColumns.ID)).One(ctx, db)
+		require.NoError(t, err)
+
+		const (
+			userAddress = "0x8589427373d6d84e98730d7795d8f6f8731fda16"
+			toAddress   = "0x0000000000000000000000000000000000000001"
+		)
+
+		userWallet := &models.Wallet{
+			UserID:         fix.User1.ID,
+			Address:        userAddress,
+			ChainType:      token.ChainType,
+			ChainID:        token.ChainID,
+			DerivationPath: "m/44'/60'/0'/0/1",
+			AddressIndex:   1,
+			WalletType:     models.WalletTypeUser,
+		}
+		require.NoError(t, userWallet.Insert(ctx, db, boil.Infer()))
+
+		depositAmount, err := money.ToSmallestUnit("100", token.Decimals)
+		require.NoError(t, err)
+		deposit := &models.Credit{
+			UserID:        fix.User1.ID,
+			Address:       userAddress,
+			TokenID:       token.ID,
+			TokenSymbol:   token.TokenSymbol,
+			Amount:        depositAmount.String(),
+			CreditType:    models.CreditTypeDeposit,
+			BusinessType:  models.BusinessTypeBlockchain,
+			ReferenceID:   "0xdeposit_0",
+			ReferenceType: models.ReferenceTypeBlockchainTX,
+			ChainID:       null.IntFrom(token.ChainID),
+			ChainType:     null.StringFrom(token.ChainType),
+			Status:        models.CreditStatusFinalized,
+			EventIndex:    null.IntFrom(0),
+		}
+		require.NoError(t, deposit.Insert(ctx, db, boil.Infer()))
+
+		// 所有用户每日最多提现 10 个代币（超出拒绝），User1 每周第二笔起需要人工复核
+		riskService := risk.NewService(db)
+		maxAmount := "10"
+		maxCount := 1
+		dailyLimit, err := riskService.SetLimit(ctx, &risk.Limit{TokenID: token.ID, Period: risk.PeriodDaily, MaxAmount: &maxAmount, Action: risk.ActionReject})
+		require.NoError(t, err)
+		weeklyLimit, err := riskService.SetLimit(ctx, &risk.Limit{UserID: &fix.User1.ID, TokenID: token.ID, Period: risk.PeriodWeekly, MaxCount: &maxCount, Action: risk.ActionReview})
+		require.NoError(t, err)
+
+		config := withdraw.Config{
+			ContractAllowlist: withdraw.ContractAllowlist{token.ChainID: {toAddress: true}},
+		}
+		service := withdraw.NewService(db, config, nil, balance.NewService(db), nil, nil, nil, nil, nil, riskService,
+			nil, nil, nil, nil, nil, nil)
+
+		requestWithdraw := func(amount int64) (*models.Withdraw, error) {
+			return service.RequestWithdraw(ctx, fix.User1.ID, &withdraw.Request{
+				ToAddress: toAddress,
+				TokenID:   token.ID,
+				Amount:    big.NewFloat(float64(amount)),
+			})
+		}
+		countWithdraws := func() int64 {
+			count, err := models.Withdraws(models.WithdrawWhere.UserID.EQ(fix.User1.ID)).Count(ctx, db)
+			require.NoError(t, err)
+			return count
+		}
+
+		first, err := requestWithdraw(6)
+		require.NoError(t, err)
+		flags, err := riskService.GetFlags(ctx, []string{first.ID})
+		require.NoError(t, err)
+		assert.Empty(t, flags[first.ID])
+
+		// 6 + 5 超出每日拒绝限额：不创建提现，也不冻结资金
+		_, err = requestWithdraw(5)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, walleterrors.ErrWithdrawLimitExceeded))
+		var limitErr *risk.LimitExceededError
+		require.True(t, errors.As(err, &limitErr))
+		assert.Equal(t, dailyLimit.ID, limitErr.Violation.Limit.ID)
+		assert.Equal(t, int64(1), countWithdraws())
+
+		available, err := balance.NewService(db).GetAvailableBalance(ctx, db, fix.User1.ID, token.ChainID, token.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "94", available.Text('f', -1))
+
+		// 6 + 4 恰好等于每日限额，但超出每周笔数复核限额：创建提现并标记人工复核
+		second, err := requestWithdraw(4)
+		require.NoError(t, err)
+		assert.Equal(t, models.WithdrawStatusUserWithdrawRequest, second.Status)
+		flags, err = riskService.GetFlags(ctx, []string{second.ID})
+		require.NoError(t, err)
+		require.Len(t, flags[second.ID], 1)
+		require.NotNil(t, flags[second.ID][0].LimitID)
+		assert.Equal(t, weeklyLimit.ID, *flags[second.ID][0].LimitID)
+
+		// 失败的提现不计入限额
+		_, err = service.RejectWithdraw(ctx, first.ID, fix.User2.ID, "")
+		require.NoError(t, err)
+		_, err = requestWithdraw(6)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), countWithdraws())
+	})
+}
@@ -0,0 +1,70 @@
+package withdraw
+
+import (
+	"context"
+	"maps"
+	"math/big"
+	"strconv"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/notification"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// notifyWithdrawRequested 提现请求创建后发送安全通知：金额达到用户设置的个人阈值、首次向该地址提现
+// 通知失败不影响提现请求
+func (s *service) notifyWithdrawRequested(ctx context.Context, withdraw *models.Withdraw, token *models.Token, amount *big.Float) {
+	if s.notificationService == nil {
+		return
+	}
+
+	data := map[string]string{
+		"withdrawId":  withdraw.ID,
+		"amount":      withdraw.Amount,
+		"tokenSymbol": token.TokenSymbol,
+		"toAddress":   withdraw.ToAddress,
+		"chainId":     strconv.Itoa(withdraw.ChainID),
+	}
+
+	threshold, err := s.notificationService.GetWithdrawThreshold(ctx, withdraw.UserID, token.ID)
+	if err != nil {
+		log.Warn().Err(err).Str("withdraw_id", withdraw.ID).Msg("Failed to get withdraw notification threshold")
+	} else if threshold != nil && amount.Cmp(threshold) >= 0 {
+		eventData := maps.Clone(data)
+		eventData["threshold"] = threshold.Text('f', -1)
+		s.notificationService.Notify(ctx, &notification.Event{
+			Type:   notification.EventLargeWithdraw,
+			UserID: withdraw.UserID,
+			Data:   eventData,
+		})
+	}
+
+	usedBefore, err := s.hasWithdrawnToAddress(ctx, withdraw)
+	if err != nil {
+		log.Warn().Err(err).Str("withdraw_id", withdraw.ID).Msg("Failed to check previous withdraws to address")
+	} else if !usedBefore {
+		s.notificationService.Notify(ctx, &notification.Event{
+			Type:   notification.EventNewWithdrawAddress,
+			UserID: withdraw.UserID,
+			Data:   data,
+		})
+	}
+}
+
+// hasWithdrawnToAddress 判断用户之前是否在同一条链上向该地址发起过提现（不区分大小写）
+func (s *service) hasWithdrawnToAddress(ctx context.Context, withdraw *models.Withdraw) (bool, error) {
+	exists, err := models.Withdraws(
+		models.WithdrawWhere.UserID.EQ(withdraw.UserID),
+		models.WithdrawWhere.ChainID.EQ(withdraw.ChainID),
+		models.WithdrawWhere.ID.NEQ(withdraw.ID),
+		qm.Where("LOWER("+models.WithdrawColumns.ToAddress+") = LOWER(?)", withdraw.ToAddress),
+	).Exists(ctx, s.db)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to query previous withdraws")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,326 @@
+package withdraw
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/util/lifecycle"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// outboxLease 分发中的事件的租约，进程在分发过程中崩溃时事件在租约到期后被重新分发
+	outboxLease = 5 * time.Minute
+	// outboxRetryBase / outboxRetryMax 分发失败（如数据库不可用）后的退避重试间隔
+	outboxRetryBase = 10 * time.Second
+	outboxRetryMax  = 10 * time.Minute
+	// outboxDispatchBatch 每轮最多分发的事件数
+	outboxDispatchBatch = 100
+)
+
+// errDispatchInterrupted 上一次分发在签名后没有完成，交易可能已经广播
+var errDispatchInterrupted = errors.New("withdraw dispatch was interrupted after signing, the transaction may have been broadcast")
+
+// outboxEvent 提现处理发件箱事件
+type outboxEvent struct {
+	id          int64
+	withdrawID  string
+	attempts    int
+	txHash      null.String // 之前的分发在广播前记录的已签名交易
+	fromAddress null.String
+	nonce       null.Int
+}
+
+// enqueueDispatch 在批准（或重试）的事务中写入提现处理事件，提现已有未完成的事件时不重复写入
+func enqueueDispatch(ctx context.Context, tx *sql.Tx, withdrawID string) error {
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO withdraw_outbox (withdraw_id) VALUES ($1)
+		ON CONFLICT (withdraw_id) WHERE dispatched_at IS NULL DO NOTHING
+	`, withdrawID); err != nil {
+		return errors.Wrap(err, "failed to enqueue withdraw dispatch")
+	}
+
+	return nil
+}
+
+// StartOutboxDispatcher 启动提现处理发件箱分发，处理批准后没有完成处理（如进程崩溃）的提现
+func (s *service) StartOutboxDispatcher(ctx context.Context, interval time.Duration) {
+	log.Info().Dur("interval", interval).Msg("Starting withdraw outbox dispatcher")
+
+	lifecycle.Go(ctx, "withdraw outbox dispatcher", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		s.dispatchOutbox(ctx)
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Withdraw outbox dispatcher stopped")
+				return
+			case <-ticker.C:
+				s.dispatchOutbox(ctx)
+			}
+		}
+	})
+}
+
+// dispatchOutbox 依次领取并分发到期的事件，停机时剩余事件保留到下次启动后分发
+func (s *service) dispatchOutbox(ctx context.Context) {
+	for range outboxDispatchBatch {
+		if ctx.Err() != nil {
+			return
+		}
+
+		event, err := s.claimOutboxEvent(ctx, null.String{})
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to claim withdraw outbox event")
+			return
+		}
+		if event == nil {
+			return
+		}
+
+		// 已开始的签名、广播和落库不被停机信号打断
+		eventCtx, cancel := lifecycle.InFlight(ctx)
+		if _, err := s.handleOutboxEvent(eventCtx, event); err != nil {
+			log.Warn().Err(err).
+				Str("withdraw_id", event.withdrawID).
+				Int("attempts", event.attempts).
+				Msg("Failed to dispatch withdraw from outbox")
+		}
+		cancel()
+	}
+}
+
+// dispatchNow 批准（或重试）的事务提交后立即分发提现的处理事件，事件已被分发器领取时返回当前的提现记录
+func (s *service) dispatchNow(ctx context.Context, withdrawID string) (*models.Withdraw, error) {
+	event, err := s.claimOutboxEvent(ctx, null.StringFrom(withdrawID))
+	if err != nil {
+		return nil, err
+	}
+
+	if event == nil {
+		withdraw, err := models.Withdraws(models.WithdrawWhere.ID.EQ(withdrawID)).One(ctx, s.db)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get withdraw record")
+		}
+		return withdraw, nil
+	}
+
+	return s.handleOutboxEvent(ctx, event)
+}
+
+// claimOutboxEvent 领取一个到期的未完成事件（指定 withdrawID 时只领取该提现的事件），递增分发次数并设置租约
+// 多个实例通过 SKIP LOCKED 和租约互斥，没有可领取的事件时返回 nil
+func (s *service) claimOutboxEvent(ctx context.Context, withdrawID null.String) (*outboxEvent, error) {
+	var event outboxEvent
+	err := s.db.QueryRowContext(ctx, `
+		UPDATE withdraw_outbox o
+		SET attempts = o.attempts + 1, next_attempt_at = NOW() + $1::integer * INTERVAL '1 second'
+		FROM (
+			SELECT id FROM withdraw_outbox
+			WHERE dispatched_at IS NULL AND next_attempt_at <= NOW()
+				AND ($2::uuid IS NULL OR withdraw_id = $2::uuid)
+			ORDER BY next_attempt_at, id
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		) next
+		WHERE o.id = next.id
+		RETURNING o.id, o.withdraw_id, o.attempts, o.tx_hash, o.from_address, o.nonce
+	`, int(outboxLease.Seconds()), withdrawID).Scan(
+		&event.id, &event.withdrawID, &event.attempts, &event.txHash, &event.fromAddress, &event.nonce,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil // 没有可领取的事件
+		}
+		return nil, errors.Wrap(err, "failed to claim withdraw outbox event")
+	}
+
+	return &event, nil
+}
+
+// handleOutboxEvent 分发提现处理事件（至少一次）：
+//   - 提现已不在待处理状态（已广播、已拒绝等）时直接完成
+//   - 之前的分发已签名交易但没有完成时不再重新签名，将提现标记为失败，管理员确认交易没有上链后重试
+//   - 否则按批准后的流程处理（受处理窗口等限制时排队，由调度器处理）
+//
+// 处理失败且提现已标记为失败时事件完成，其他错误按退避重试
+func (s *service) handleOutboxEvent(ctx context.Context, event *outboxEvent) (*models.Withdraw, error) {
+	withdraw, err := models.Withdraws(models.WithdrawWhere.ID.EQ(event.withdrawID)).One(ctx, s.db)
+	if err != nil {
+		s.rescheduleOutboxEvent(ctx, event, err)
+		return nil, errors.Wrap(err, "failed to get withdraw record")
+	}
+
+	if withdraw.Status != models.WithdrawStatusUserWithdrawRequest {
+		s.completeOutboxEvent(ctx, event, nil)
+		return withdraw, nil
+	}
+
+	if event.txHash.Valid {
+		return s.failInterruptedDispatch(ctx, event)
+	}
+
+	processed, err := s.dispatchWithdraw(ctx, withdraw)
+	if err != nil {
+		current, getErr := models.Withdraws(models.WithdrawWhere.ID.EQ(event.withdrawID)).One(ctx, s.db)
+		if getErr == nil && current.Status != models.WithdrawStatusUserWithdrawRequest {
+			s.completeOutboxEvent(ctx, event, err)
+		} else {
+			s.rescheduleOutboxEvent(ctx, event, err)
+		}
+		return nil, err
+	}
+
+	s.completeOutboxEvent(ctx, event, nil)
+
+	return processed, nil
+}
+
+// failInterruptedDispatch 将分发中断（已签名、未确认是否广播）的提现标记为失败并记录交易信息，
+// 重试时据此确认交易没有上链，避免使用新的 nonce 重复出账
+func (s *service) failInterruptedDispatch(ctx context.Context, event *outboxEvent) (*models.Withdraw, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		s.rescheduleOutboxEvent(ctx, event, err)
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// 锁定提现记录：分发仍在进行（租约到期）时等待其完成
+	withdraw, err := models.Withdraws(
+		models.WithdrawWhere.ID.EQ(event.withdrawID),
+		qm.For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		s.rescheduleOutboxEvent(ctx, event, err)
+		return nil, errors.Wrap(err, "failed to get withdraw record")
+	}
+
+	if withdraw.Status != models.WithdrawStatusUserWithdrawRequest {
+		s.completeOutboxEvent(ctx, event, nil)
+		return withdraw, nil
+	}
+
+	withdraw.Status = models.WithdrawStatusFailed
+	withdraw.ErrorMessage = null.StringFrom(errDispatchInterrupted.Error())
+	withdraw.TXHash = event.txHash
+	withdraw.FromAddress = event.fromAddress
+	withdraw.Nonce = event.nonce
+	if _, err := withdraw.Update(ctx, tx, boil.Whitelist(
+		models.WithdrawColumns.Status,
+		models.WithdrawColumns.ErrorMessage,
+		models.WithdrawColumns.TXHash,
+		models.WithdrawColumns.FromAddress,
+		models.WithdrawColumns.Nonce,
+		models.WithdrawColumns.UpdatedAt,
+	)); err != nil {
+		s.rescheduleOutboxEvent(ctx, event, err)
+		return nil, errors.Wrap(err, "failed to update withdraw status to failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		s.rescheduleOutboxEvent(ctx, event, err)
+		return nil, errors.Wrap(err, "failed to commit transaction")
+	}
+
+	s.completeOutboxEvent(ctx, event, errDispatchInterrupted)
+
+	log.Error().
+		Str("withdraw_id", event.withdrawID).
+		Str("tx_hash", event.txHash.String).
+		Msg("Withdraw dispatch was interrupted after signing, marked as failed for manual verification")
+
+	return withdraw, nil
+}
+
+// recordSignedTransaction 广播前在提现最近一次分发的事件上记录已签名的交易（独立于处理事务提交，事件已因排队完成时同样记录）：
+// 分发在广播后中断时，重复分发据此避免重新签名；提现失败后，重试和冻结资金对账据此确认交易是否可能已广播
+// 提现没有发件箱事件（发件箱之前批准的提现）时不更新任何记录
+func (s *service) recordSignedTransaction(ctx context.Context, withdrawID string, txHash string, fromAddress string, nonce null.Int) error {
+	if _, err := s.db.ExecContext(ctx, `
+		UPDATE withdraw_outbox SET tx_hash = $2, from_address = $3, nonce = $4
+		WHERE id = (SELECT MAX(id) FROM withdraw_outbox WHERE withdraw_id = $1)
+	`, withdrawID, txHash, fromAddress, nonce); err != nil {
+		return errors.Wrap(err, "failed to record signed transaction of withdraw")
+	}
+
+	return nil
+}
+
+// signingRecord 提现最近一次分发记录的签名信息
+type signingRecord struct {
+	dispatched  bool        // 提现有发件箱事件；签名的交易在广播前必然记录到最近的事件上
+	txHash      null.String // 最近一次分发签名的交易，未签名时为空
+	fromAddress null.String
+	nonce       null.Int
+}
+
+// unsigned 最近一次分发没有签名交易，提现的交易没有广播
+func (r *signingRecord) unsigned() bool {
+	return r.dispatched && !r.txHash.Valid
+}
+
+// latestSigningRecord 查询提现最近一次分发的发件箱事件记录的已签名交易
+func latestSigningRecord(ctx context.Context, exec boil.ContextExecutor, withdrawID string) (*signingRecord, error) {
+	record := &signingRecord{}
+	err := exec.QueryRowContext(ctx, `
+		SELECT tx_hash, from_address, nonce FROM withdraw_outbox
+		WHERE withdraw_id = $1
+		ORDER BY id DESC
+		LIMIT 1
+	`, withdrawID).Scan(&record.txHash, &record.fromAddress, &record.nonce)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, nil
+		}
+		return nil, errors.Wrap(err, "failed to get signing record of withdraw")
+	}
+	record.dispatched = true
+
+	return record, nil
+}
+
+// completeOutboxEvent 标记事件分发完成，dispatchErr 为处理失败的原因（提现已标记为失败）
+func (s *service) completeOutboxEvent(ctx context.Context, event *outboxEvent, dispatchErr error) {
+	var lastError null.String
+	if dispatchErr != nil {
+		lastError = null.StringFrom(dispatchErr.Error())
+	}
+
+	if _, err := s.db.ExecContext(ctx, `
+		UPDATE withdraw_outbox SET dispatched_at = NOW(), last_error = $2 WHERE id = $1
+	`, event.id, lastError); err != nil {
+		// 事件会在租约到期后重新分发，提现已不在待处理状态，届时直接完成
+		log.Error().Err(err).Str("withdraw_id", event.withdrawID).Msg("Failed to complete withdraw outbox event")
+	}
+}
+
+// rescheduleOutboxEvent 记录分发失败的原因，按退避间隔重试
+func (s *service) rescheduleOutboxEvent(ctx context.Context, event *outboxEvent, dispatchErr error) {
+	retryAt := time.Now().Add(outboxRetryDelay(event.attempts))
+	if _, err := s.db.ExecContext(ctx, `
+		UPDATE withdraw_outbox SET next_attempt_at = $2, last_error = $3 WHERE id = $1
+	`, event.id, retryAt, dispatchErr.Error()); err != nil {
+		log.Error().Err(err).Str("withdraw_id", event.withdrawID).Msg("Failed to reschedule withdraw outbox event")
+	}
+}
+
+// outboxRetryDelay 第 attempts 次分发失败后的重试间隔：从 outboxRetryBase 开始指数增长，不超过 outboxRetryMax
+func outboxRetryDelay(attempts int) time.Duration {
+	delay := outboxRetryBase
+	for i := 1; i < attempts && delay < outboxRetryMax; i++ {
+		delay *= 2
+	}
+
+	return min(delay, outboxRetryMax)
+}
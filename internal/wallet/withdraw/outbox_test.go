@@ -0,0 +1,17 @@
+package withdraw
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutboxRetryDelay(t *testing.T) {
+	assert.Equal(t, 10*time.Second, outboxRetryDelay(0))
+	assert.Equal(t, 10*time.Second, outboxRetryDelay(1))
+	assert.Equal(t, 20*time.Second, outboxRetryDelay(2))
+	assert.Equal(t, 80*time.Second, outboxRetryDelay(4))
+	assert.Equal(t, 10*time.Minute, outboxRetryDelay(7))
+	assert.Equal(t, 10*time.Minute, outboxRetryDelay(100))
+}
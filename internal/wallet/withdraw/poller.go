@@ -0,0 +1,140 @@
+package withdraw
+
+import (
+	"context"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/solana"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+)
+
+// StartConfirmationPoller 启动独立的提现确认轮询器
+// 轮询器直接通过 RPC 查询最新区块与交易回执，不依赖区块扫描器是否正常运行，
+// 避免某条链扫描中断时提现一直停留在 pending 状态
+func (s *service) StartConfirmationPoller(ctx context.Context, interval time.Duration) {
+	log.Info().
+		Dur("interval", interval).
+		Msg("Starting withdraw confirmation poller")
+
+	lifecycle.Go(ctx, "withdraw confirmation poller", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		s.pollWithdrawConfirmations(ctx) // 启动时立即执行一次
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Withdraw confirmation poller stopped")
+				return
+			case <-ticker.C:
+				s.pollWithdrawConfirmations(ctx)
+			}
+		}
+	})
+}
+
+// pollWithdrawConfirmations 对所有存在待确认提现的链执行一次状态更新
+func (s *service) pollWithdrawConfirmations(ctx context.Context) {
+	chainIDs, err := s.getChainsWithUnconfirmedWithdraws(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Withdraw confirmation poller failed to load chains")
+		return
+	}
+
+	var g errgroup.Group
+	g.SetLimit(max(s.config.WorkerConcurrency, 1))
+
+	for _, chainID := range chainIDs {
+		g.Go(func() error {
+			if err := s.pollChainWithdrawConfirmations(ctx, chainID); err != nil {
+				log.Error().
+					Int("chain_id", chainID).
+					Err(err).
+					Msg("Withdraw confirmation poller failed to update chain")
+			}
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+}
+
+// pollChainWithdrawConfirmations 直接从 RPC 获取最新区块号并更新指定链的提现状态
+func (s *service) pollChainWithdrawConfirmations(ctx context.Context, chainID int) error {
+	chainConfig, err := s.chainService.GetChain(ctx, chainID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get chain config for chain_id=%d", chainID)
+	}
+
+	// Solana 链的区块号为 slot
+	if chainConfig.ChainType == chain.TypeSolana {
+		solanaClient, err := s.scanService.GetSolanaClient(ctx, chainID)
+		if err != nil {
+			return errors.Wrap(err, "failed to get solana RPC client")
+		}
+
+		latestSlot, err := solanaClient.GetSlot(ctx, solana.CommitmentConfirmed)
+		if err != nil {
+			return errors.Wrap(err, "failed to get latest slot")
+		}
+
+		return s.UpdateWithdrawStatus(ctx, chainID, int64(latestSlot)) //nolint:gosec // slot 远小于 int64 上限
+	}
+
+	if chainConfig.ChainType == chain.TypeBitcoin {
+		bitcoinClient, err := s.scanService.GetBitcoinClient(ctx, chainID)
+		if err != nil {
+			return errors.Wrap(err, "failed to get bitcoin RPC client")
+		}
+
+		latestHeight, err := bitcoinClient.GetBlockCount(ctx)
+		if err != nil {
+			return err
+		}
+
+		return s.UpdateWithdrawStatus(ctx, chainID, latestHeight)
+	}
+
+	client, err := s.scanService.GetClient(ctx, chainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get RPC client")
+	}
+
+	latestBlock, err := client.GetLatestBlockNumber(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get latest block number")
+	}
+
+	return s.UpdateWithdrawStatus(ctx, chainID, latestBlock.Int64())
+}
+
+// getChainsWithUnconfirmedWithdraws 查询存在已广播但未确认提现的活跃链
+func (s *service) getChainsWithUnconfirmedWithdraws(ctx context.Context) ([]int, error) {
+	withdraws, err := models.Withdraws(
+		qm.Select("DISTINCT "+models.WithdrawTableColumns.ChainID),
+		qm.InnerJoin("chains c ON c.chain_id = withdraws.chain_id AND c.is_active = true"),
+		models.WithdrawWhere.Status.IN([]models.WithdrawStatus{
+			models.WithdrawStatusPending,
+			models.WithdrawStatusProcessing,
+		}),
+		models.WithdrawWhere.TXHash.IsNotNull(),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query chains with unconfirmed withdraws")
+	}
+
+	chainIDs := make([]int, 0, len(withdraws))
+	for _, w := range withdraws {
+		chainIDs = append(chainIDs, w.ChainID)
+	}
+
+	return chainIDs, nil
+}
@@ -0,0 +1,88 @@
+package withdraw_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/test"
+	"github/chapool/go-wallet/internal/test/fixtures"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/stats"
+	"github/chapool/go-wallet/internal/wallet/withdraw"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateWithdrawStatusConfirmsOnce(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		token, err := models.Tokens(qm.OrderBy(models.TokenColumns.ID)).One(ctx, db)
+		require.NoError(t, err)
+
+		const (
+			txHash  = "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"
+			blockNo = 1000
+		)
+
+		record := &models.Withdraw{
+			UserID:    fix.User1.ID,
+			ToAddress: "0x0000000000000000000000000000000000000001",
+			TokenID:   token.ID,
+			Amount:    "10",
+			Fee:       "0",
+			ChainID:   token.ChainID,
+			ChainType: token.ChainType,
+			Status:    models.WithdrawStatusPending,
+			TXHash:    null.StringFrom(txHash),
+		}
+		require.NoError(t, record.Insert(ctx, db, boil.Infer()))
+
+		tx := &models.Transaction{
+			ChainID:   token.ChainID,
+			BlockHash: "0x9f2c1d0e4b7a8c3f5e6d2a1b0c9f8e7d6c5b4a39281706f5e4d3c2b1a0f9e8d7",
+			BlockNo:   blockNo,
+			TXHash:    txHash,
+			FromAddr:  "0x8894e0a0c962cb723c1976a4421c95949be2d4e3",
+			ToAddr:    record.ToAddress,
+			Amount:    "10000000000000000000",
+			Type:      models.TransactionTypeWithdraw,
+			Status:    models.TransactionStatusConfirmed,
+		}
+		require.NoError(t, tx.Insert(ctx, db, boil.Infer()))
+
+		statsService := stats.NewService(db)
+		service := withdraw.NewService(db, withdraw.Config{}, chain.NewService(db, chain.BlockOverrides{}),
+			nil, nil, nil, nil, statsService, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// 扫描器和确认轮询器（或多个实例）同时确认同一笔提现
+		var wg sync.WaitGroup
+		for range 5 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, service.UpdateWithdrawStatus(ctx, token.ChainID, blockNo+100))
+			}()
+		}
+		wg.Wait()
+
+		// 已确认的提现不再被更新
+		require.NoError(t, service.UpdateWithdrawStatus(ctx, token.ChainID, blockNo+1))
+
+		require.NoError(t, record.Reload(ctx, db))
+		assert.Equal(t, models.WithdrawStatusConfirmed, record.Status)
+
+		result, err := statsService.GetUserStats(ctx, fix.User1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), result.WithdrawCount)
+		require.Len(t, result.Tokens, 1)
+		assert.Equal(t, "10", result.Tokens[0].TotalWithdrawn.Text('f', -1))
+	})
+}
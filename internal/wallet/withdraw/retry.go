@@ -0,0 +1,172 @@
+package withdraw
+
+import (
+	"context"
+	"database/sql"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// signedTxError 交易签名后处理失败（广播失败，或广播后更新提现记录失败），交易仍可能已到达节点并上链
+type signedTxError struct {
+	txHash      string
+	fromAddress string
+	nonce       null.Int // Solana、Bitcoin 交易没有 nonce
+	err         error
+}
+
+func (e *signedTxError) Error() string {
+	return e.err.Error()
+}
+
+func (e *signedTxError) Unwrap() error {
+	return e.err
+}
+
+// RetryWithdraw 重试处理失败的提现（管理员操作）
+// 只能重试未冲正的 EVM 链提现；上一次签名的交易确认没有上链后清除交易信息，按批准后的流程在原热钱包用原 nonce 重新签名
+// 提现没有任何签名记录（发件箱之前分发的提现）时，只有管理员确认没有签名交易（confirmNotSigned）才允许重试
+func (s *service) RetryWithdraw(ctx context.Context, withdrawID string, adminUserID string, confirmNotSigned bool) (*models.Withdraw, error) {
+	// 1. 获取并锁定提现记录
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	withdraw, err := models.Withdraws(
+		models.WithdrawWhere.ID.EQ(withdrawID),
+		qm.For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, walleterrors.ErrWithdrawNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get withdraw record")
+	}
+
+	// 2. 检查状态：只能重试处理失败且未被拒绝（未冲正）的提现
+	if withdraw.Status != models.WithdrawStatusFailed {
+		return nil, errors.Wrapf(walleterrors.ErrWithdrawStateConflict, "withdraw status is %s, expected %s", withdraw.Status, models.WithdrawStatusFailed)
+	}
+
+	reversed, err := isWithdrawReversed(ctx, tx, withdrawID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check withdraw reversal")
+	}
+	if reversed {
+		return nil, errors.Wrap(walleterrors.ErrWithdrawStateConflict, "withdraw was rejected and refunded")
+	}
+
+	// 非 EVM 链无法通过 nonce 确认上一次的交易没有上链
+	if withdraw.ChainType != chain.TypeEVM {
+		return nil, errors.Wrapf(walleterrors.ErrWithdrawStateConflict, "retry is not supported for %s withdraws", withdraw.ChainType)
+	}
+
+	// 3. 上一次签名的交易必须没有上链，否则重试会重复出账
+	// 提现记录没有交易信息（如广播后落库失败）时使用最近一次分发在广播前记录的已签名交易
+	if !withdraw.TXHash.Valid {
+		record, err := latestSigningRecord(ctx, tx, withdrawID)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case record.txHash.Valid:
+			withdraw.TXHash = record.txHash
+			withdraw.FromAddress = record.fromAddress
+			withdraw.Nonce = record.nonce
+		case !record.unsigned() && !confirmNotSigned:
+			return nil, errors.Wrap(walleterrors.ErrWithdrawStateConflict, "withdraw has no signing record, confirm that no transaction was signed to retry")
+		}
+	}
+	if withdraw.TXHash.Valid {
+		client, err := s.scanService.GetClient(ctx, withdraw.ChainID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get RPC client")
+		}
+		if err := verifyTransactionNotMined(ctx, client, withdraw); err != nil {
+			return nil, err
+		}
+	}
+
+	// 4. 重置为待处理状态，已有的批准记录继续有效
+	// 上一次签名交易的热钱包和 nonce 保留，重新处理时用同一 nonce 签名替换旧交易：
+	// 旧交易即使之后被重新广播，同一 nonce 也只有一笔能上链，不会重复出账
+	previousTxHash := withdraw.TXHash.String
+	withdraw.Status = models.WithdrawStatusUserWithdrawRequest
+	withdraw.TXHash = null.String{}
+	withdraw.ErrorMessage = null.String{}
+	if _, err := withdraw.Update(ctx, tx, boil.Whitelist(
+		models.WithdrawColumns.Status,
+		models.WithdrawColumns.TXHash,
+		models.WithdrawColumns.FromAddress,
+		models.WithdrawColumns.Nonce,
+		models.WithdrawColumns.ErrorMessage,
+		models.WithdrawColumns.UpdatedAt,
+	)); err != nil {
+		return nil, errors.Wrap(err, "failed to reset withdraw")
+	}
+
+	// 与重置在同一事务中写入处理事件，由发件箱分发器保证重新处理
+	if err := enqueueDispatch(ctx, tx, withdrawID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "failed to commit transaction")
+	}
+
+	log.Info().
+		Str("withdraw_id", withdrawID).
+		Str("admin_user_id", adminUserID).
+		Str("previous_tx_hash", previousTxHash).
+		Bool("confirm_not_signed", confirmNotSigned).
+		Msg("Failed withdraw reset for retry by admin")
+
+	// 5. 重新处理（上一次签名过交易时使用原 nonce）
+	return s.dispatchNow(ctx, withdrawID)
+}
+
+// verifyTransactionNotMined 确认提现上一次广播的交易没有上链，也不在交易池中等待打包
+// 热钱包已上链的交易数超过该交易的 nonce 时，无法排除交易已上链（节点可能尚未返回回执），同样不允许重试
+func verifyTransactionNotMined(ctx context.Context, client *scan.RPCClient, withdraw *models.Withdraw) error {
+	txHash := common.HexToHash(withdraw.TXHash.String)
+
+	if _, err := client.GetTransactionReceipt(ctx, txHash); err == nil {
+		return errors.Wrapf(walleterrors.ErrWithdrawStateConflict, "transaction %s of withdraw was mined", withdraw.TXHash.String)
+	} else if !errors.Is(err, ethereum.NotFound) {
+		return errors.Wrap(err, "failed to check transaction receipt")
+	}
+
+	if _, _, err := client.GetTransactionByHash(ctx, txHash); err == nil {
+		return errors.Wrapf(walleterrors.ErrWithdrawStateConflict, "transaction %s of withdraw is pending in the mempool", withdraw.TXHash.String)
+	} else if !errors.Is(err, ethereum.NotFound) {
+		return errors.Wrap(err, "failed to check pending transaction")
+	}
+
+	if !withdraw.FromAddress.Valid || !withdraw.Nonce.Valid {
+		return errors.Wrapf(walleterrors.ErrWithdrawStateConflict, "transaction %s of withdraw has no recorded nonce", withdraw.TXHash.String)
+	}
+
+	minedNonce, err := client.NonceAt(ctx, common.HexToAddress(withdraw.FromAddress.String))
+	if err != nil {
+		return errors.Wrap(err, "failed to get hot wallet nonce")
+	}
+	//nolint:gosec // Nonce is allocated from wallet_nonces and is never negative
+	if minedNonce > uint64(withdraw.Nonce.Int) {
+		return errors.Wrapf(walleterrors.ErrWithdrawStateConflict, "nonce %d of hot wallet %s was already used on chain", withdraw.Nonce.Int, withdraw.FromAddress.String)
+	}
+
+	return nil
+}
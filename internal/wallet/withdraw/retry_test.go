@@ -0,0 +1,183 @@
+package withdraw_test
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/test"
+	"github/chapool/go-wallet/internal/test/fixtures"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/hotwallet"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/signer"
+	"github/chapool/go-wallet/internal/wallet/stats"
+	"github/chapool/go-wallet/internal/wallet/withdraw"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testEthAPI 进程内 JSON-RPC 节点，余额充足，交易广播总是成功
+type testEthAPI struct{}
+
+func (testEthAPI) GasPrice() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(1_000_000_000))
+}
+
+func (testEthAPI) GetBalance(_ common.Address, _ string) *hexutil.Big {
+	return (*hexutil.Big)(new(big.Int).Exp(big.NewInt(10), big.NewInt(24), nil))
+}
+
+func (testEthAPI) Call(_ map[string]interface{}, _ string) hexutil.Bytes {
+	return hexutil.Bytes{}
+}
+
+func (testEthAPI) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(data); err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), nil
+}
+
+// testScanService 只提供连接进程内节点的 RPC 客户端
+type testScanService struct {
+	scan.Service
+
+	client *scan.RPCClient
+}
+
+func (s *testScanService) GetClient(_ context.Context, _ int) (*scan.RPCClient, error) {
+	return s.client, nil
+}
+
+// testSigner 用固定私钥签名 legacy 交易，记录签名请求的 nonce
+type testSigner struct {
+	signer.Service
+
+	mu     sync.Mutex
+	nonces []uint64
+}
+
+func (s *testSigner) SignEVMTransaction(_ context.Context, req *signer.SignEVMRequest) (*signer.SignEVMResponse, error) {
+	s.mu.Lock()
+	s.nonces = append(s.nonces, req.Nonce)
+	s.mu.Unlock()
+
+	key, err := crypto.ToECDSA(common.LeftPadBytes([]byte{1}, 32))
+	if err != nil {
+		return nil, err
+	}
+	gasPrice, _ := new(big.Int).SetString(req.GasPrice, 10)
+	value, _ := new(big.Int).SetString(req.Value, 10)
+	to := common.HexToAddress(req.To)
+	tx, err := types.SignTx(types.NewTx(&types.LegacyTx{
+		Nonce:    req.Nonce,
+		GasPrice: gasPrice,
+		Gas:      req.GasLimit,
+		To:       &to,
+		Value:    value,
+		Data:     req.Data,
+	}), types.NewEIP155Signer(big.NewInt(req.ChainID)), key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return &signer.SignEVMResponse{RawTransaction: raw, TxHash: tx.Hash().Hex()}, nil
+}
+
+func TestProcessRetriedWithdrawReusesNonce(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := t.Context()
+		fix := fixtures.Fixtures()
+
+		token, err := models.Tokens(qm.OrderBy(models.TokenColumns.ID)).One(ctx, db)
+		require.NoError(t, err)
+		require.True(t, token.IsNative)
+
+		const (
+			hotWalletAddress = "0x7e5f4552091a69125d5dfcb7b8c2659029395bdf"
+			previousNonce    = 7
+			nextNonce        = 10
+		)
+
+		hotWallet := &models.Wallet{
+			UserID:         fix.User1.ID,
+			Address:        hotWalletAddress,
+			ChainType:      token.ChainType,
+			ChainID:        token.ChainID,
+			DerivationPath: "m/44'/60'/0'/0/9000",
+			AddressIndex:   9000,
+			WalletType:     models.WalletTypeHot,
+		}
+		require.NoError(t, hotWallet.Insert(ctx, db, boil.Infer()))
+		walletNonce := &models.WalletNonce{Address: hotWalletAddress, ChainID: token.ChainID, Nonce: nextNonce}
+		require.NoError(t, walletNonce.Insert(ctx, db, boil.Infer()))
+
+		// 已重试的提现：上一次签名的交易（nonce 7）未上链，热钱包的 nonce 已分配到 10
+		record := &models.Withdraw{
+			UserID:      fix.User1.ID,
+			ToAddress:   "0x0000000000000000000000000000000000000001",
+			TokenID:     token.ID,
+			Amount:      "1",
+			Fee:         "0",
+			ChainID:     token.ChainID,
+			ChainType:   token.ChainType,
+			Status:      models.WithdrawStatusUserWithdrawRequest,
+			FromAddress: null.StringFrom(hotWalletAddress),
+			Nonce:       null.IntFrom(previousNonce),
+		}
+		require.NoError(t, record.Insert(ctx, db, boil.Infer()))
+
+		server := rpc.NewServer()
+		require.NoError(t, server.RegisterName("eth", testEthAPI{}))
+		t.Cleanup(server.Stop)
+		httpServer := httptest.NewServer(server)
+		t.Cleanup(httpServer.Close)
+		client, err := scan.NewRPCClient(token.ChainID, []string{httpServer.URL})
+		require.NoError(t, err)
+		t.Cleanup(client.Close)
+
+		signerService := &testSigner{}
+		config := withdraw.Config{
+			LegacyTxChainIDs: []int{token.ChainID},
+			ApprovalThresholds: []withdraw.ApprovalThreshold{
+				{TokenID: token.ID, MinAmount: big.NewFloat(0), RequiredApprovals: 0},
+			},
+		}
+		service := withdraw.NewService(db, config, chain.NewService(db, chain.BlockOverrides{}),
+			nil, hotwallet.NewService(db, nil, nil, nil), &testScanService{client: client}, signerService,
+			stats.NewService(db), nil, nil, nil, nil, nil, nil, nil, nil)
+
+		require.NoError(t, service.ProcessWithdraw(ctx, record.ID))
+
+		// 重新签名使用原 nonce，旧交易与新交易只能有一笔上链
+		assert.Equal(t, []uint64{previousNonce}, signerService.nonces)
+
+		require.NoError(t, record.Reload(ctx, db))
+		assert.Equal(t, models.WithdrawStatusPending, record.Status)
+		assert.Equal(t, null.IntFrom(previousNonce), record.Nonce)
+		assert.Equal(t, null.StringFrom(hotWalletAddress), record.FromAddress)
+
+		// 热钱包的 nonce 没有再分配
+		require.NoError(t, walletNonce.Reload(ctx, db))
+		assert.Equal(t, nextNonce, walletNonce.Nonce)
+	})
+}
@@ -0,0 +1,98 @@
+package withdraw
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github/chapool/go-wallet/internal/models"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+)
+
+// reversalMetadata 写入冲正 credits.metadata，指向被冲正的原记录
+type reversalMetadata struct {
+	ReversesCreditID string `json:"reverses_credit_id"`
+}
+
+// reversibleCreditTypes 提现退款时需要冲正的 credits 类型
+var reversibleCreditTypes = []models.CreditType{
+	models.CreditTypeWithdraw,
+	models.CreditTypeWithdrawFee,
+}
+
+// isWithdrawReversed 判断提现是否已写入冲正记录
+func isWithdrawReversed(ctx context.Context, exec boil.ContextExecutor, withdrawID string) (bool, error) {
+	return models.Credits(
+		models.CreditWhere.ReferenceID.EQ(withdrawID),
+		models.CreditWhere.ReferenceType.EQ(models.ReferenceTypeWithdraw),
+		models.CreditWhere.CreditType.EQ(models.CreditTypeWithdrawReversal),
+	).Exists(ctx, exec)
+}
+
+// findReversibleCredits 获取提现需要冲正的 credits：用户冻结的提现金额和手续费，以及平台手续费账户的入账记录
+func findReversibleCredits(ctx context.Context, exec boil.ContextExecutor, withdrawID string) ([]*models.Credit, error) {
+	credits, err := models.Credits(
+		models.CreditWhere.ReferenceID.EQ(withdrawID),
+		models.CreditWhere.ReferenceType.EQ(models.ReferenceTypeWithdraw),
+		models.CreditWhere.CreditType.IN(reversibleCreditTypes),
+		qm.Expr(
+			models.CreditWhere.Status.EQ(models.CreditStatusFrozen),
+			qm.Or2(qm.Expr(
+				models.CreditWhere.CreditType.EQ(models.CreditTypeWithdrawFee),
+				models.CreditWhere.BusinessType.EQ(models.BusinessTypeInternalTransfer),
+			)),
+		),
+		qm.OrderBy(models.CreditColumns.EventIndex),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get frozen credits")
+	}
+
+	return credits, nil
+}
+
+// insertReversalCredits 为每条原 credits 写入一条金额相反的冲正记录，原记录保持不变
+// 冲正记录与原记录状态和 event_index 相同，因此在可用余额和总余额中都恰好抵消
+func insertReversalCredits(ctx context.Context, exec boil.ContextExecutor, originals []*models.Credit) error {
+	for _, original := range originals {
+		metadata, err := json.Marshal(reversalMetadata{ReversesCreditID: original.ID})
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal reversal credit metadata")
+		}
+
+		reversal := &models.Credit{
+			UserID:        original.UserID,
+			Address:       original.Address,
+			TokenID:       original.TokenID,
+			TokenSymbol:   original.TokenSymbol,
+			Amount:        negateAmount(original.Amount),
+			CreditType:    models.CreditTypeWithdrawReversal,
+			BusinessType:  original.BusinessType,
+			ReferenceID:   original.ReferenceID,
+			ReferenceType: original.ReferenceType,
+			ChainID:       original.ChainID,
+			ChainType:     original.ChainType,
+			Status:        original.Status,
+			EventIndex:    original.EventIndex,
+			Metadata:      null.JSONFrom(metadata),
+		}
+		if err := reversal.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert reversal credit")
+		}
+	}
+
+	return nil
+}
+
+// negateAmount 对十进制金额字符串取反
+func negateAmount(amount string) string {
+	if strings.HasPrefix(amount, "-") {
+		return strings.TrimPrefix(amount, "-")
+	}
+
+	return "-" + amount
+}
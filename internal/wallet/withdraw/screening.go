@@ -0,0 +1,52 @@
+package withdraw
+
+import (
+	"context"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
+
+	"github.com/pkg/errors"
+)
+
+// ScreeningRequest 提现合规筛查请求
+type ScreeningRequest struct {
+	UserID    string
+	ChainID   int
+	ChainType string
+	TokenID   int
+	ToAddress string
+	Amount    string // 提现金额（代币单位）
+}
+
+// ScreeningHook 提现目标地址和代币合规筛查
+// 由隔离服务实现；命中筛查名单的提现请求被拦截，不创建提现也不冻结资金，拦截记录由实现方写入
+type ScreeningHook interface {
+	// ScreenWithdraw 筛查提现，命中时记录拦截审计并返回 walleterrors.ErrWithdrawBlocked
+	ScreenWithdraw(ctx context.Context, req *ScreeningRequest) error
+}
+
+// screen 筛查提现目标地址和代币，未注入筛查时不筛查
+// 筛查失败（如外部筛查服务不可用）时拒绝提现请求
+func (s *service) screen(ctx context.Context, userID string, token *models.Token, req *Request) error {
+	if s.screeningHook == nil {
+		return nil
+	}
+
+	err := s.screeningHook.ScreenWithdraw(ctx, &ScreeningRequest{
+		UserID:    userID,
+		ChainID:   token.ChainID,
+		ChainType: token.ChainType,
+		TokenID:   token.ID,
+		ToAddress: req.ToAddress,
+		Amount:    req.Amount.Text('f', -1),
+	})
+	if err != nil {
+		if errors.Is(err, walleterrors.ErrWithdrawBlocked) {
+			return err
+		}
+		return errors.Wrap(err, "failed to screen withdraw")
+	}
+
+	return nil
+}
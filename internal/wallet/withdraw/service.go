@@ -7,12 +7,26 @@ import (
 	"encoding/hex"
 	"math/big"
 	"strings"
+	"sync"
+	"time"
 
+	walletMetrics "github/chapool/go-wallet/internal/metrics/wallet"
 	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/wallet/addressbook"
+	"github/chapool/go-wallet/internal/wallet/alert"
 	"github/chapool/go-wallet/internal/wallet/balance"
+	"github/chapool/go-wallet/internal/wallet/chain"
+	"github/chapool/go-wallet/internal/wallet/gasguard"
 	"github/chapool/go-wallet/internal/wallet/hotwallet"
+	"github/chapool/go-wallet/internal/wallet/notification"
+	"github/chapool/go-wallet/internal/wallet/organization"
+	"github/chapool/go-wallet/internal/wallet/risk"
 	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/settings"
 	"github/chapool/go-wallet/internal/wallet/signer"
+	"github/chapool/go-wallet/internal/wallet/stats"
+	"github/chapool/go-wallet/internal/wallet/walleterrors"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
@@ -31,32 +45,87 @@ type Service interface {
 	// ProcessWithdraw 处理提现（签名并广播）
 	ProcessWithdraw(ctx context.Context, withdrawID string) error
 
-	// ApproveWithdraw 管理员批准提现请求
-	ApproveWithdraw(ctx context.Context, withdrawID string) (*models.Withdraw, error)
+	// ApproveWithdraw 管理员批准提现请求，批准数达到审批策略要求后处理提现
+	ApproveWithdraw(ctx context.Context, withdrawID string, adminUserID string) (*models.Withdraw, error)
 
-	// RejectWithdraw 管理员拒绝提现请求
-	RejectWithdraw(ctx context.Context, withdrawID string, reason string) (*models.Withdraw, error)
+	// RejectWithdraw 管理员拒绝提现请求（任一管理员拒绝即生效）
+	RejectWithdraw(ctx context.Context, withdrawID string, adminUserID string, reason string) (*models.Withdraw, error)
+
+	// RetryWithdraw 管理员重试处理失败的提现，确认上一次签名的交易没有上链后使用新的 nonce 重新处理；
+	// 提现没有签名记录时需要管理员确认没有签名交易（confirmNotSigned）
+	RetryWithdraw(ctx context.Context, withdrawID string, adminUserID string, confirmNotSigned bool) (*models.Withdraw, error)
+
+	// ListPendingApprovals 获取等待管理员审批的提现及其审批进度
+	ListPendingApprovals(ctx context.Context, limit int, offset int) ([]*PendingApproval, error)
+
+	// GetWithdrawApprovals 获取提现的审批记录（审计记录）
+	GetWithdrawApprovals(ctx context.Context, withdrawID string) ([]*Approval, error)
 
 	// UpdateWithdrawStatus 根据交易确认数更新提现状态
 	UpdateWithdrawStatus(ctx context.Context, chainID int, latestBlockNumber int64) error
+
+	// StartConfirmationPoller 启动独立于区块扫描的提现确认轮询
+	StartConfirmationPoller(ctx context.Context, interval time.Duration)
+
+	// GetProcessingETA 获取等待处理窗口的提现预计处理时间，不受处理窗口限制时返回 nil
+	GetProcessingETA(withdraw *models.Withdraw) *time.Time
+
+	// StartWindowProcessor 启动处理窗口调度，到达处理窗口时批量处理排队提现，配置了批量提现的链合并为一笔交易
+	StartWindowProcessor(ctx context.Context, interval time.Duration)
+
+	// FlushWithdraws 忽略处理窗口和 gas 熔断，立即处理已获得足够批准的排队提现（管理员操作），包括等待批量发送的提现；
+	// 处于维护模式的链上的提现仍然排队
+	FlushWithdraws(ctx context.Context, filter *FlushFilter) (*FlushResult, error)
+
+	// ReconcileFrozenCredits 检查父提现处于失败或停滞状态的冻结资金，自动释放签名前失败的提现，其他情况告警；dryRun 时只返回报告
+	ReconcileFrozenCredits(ctx context.Context, dryRun bool) (*FrozenCreditReport, error)
+
+	// StartFrozenCreditReconciler 启动定时冻结资金对账
+	StartFrozenCreditReconciler(ctx context.Context, interval time.Duration)
+
+	// GetRequestExpiry 获取等待审核的提现请求的过期时间，不在等待审核状态或不会过期时返回 nil
+	GetRequestExpiry(withdraw *models.Withdraw) *time.Time
+
+	// SetRequestExpiry 管理员设置等待审核的提现请求的过期时间，expiresAt 为 nil 时恢复为配置的默认过期时间
+	SetRequestExpiry(ctx context.Context, withdrawID string, adminUserID string, expiresAt *time.Time) (*models.Withdraw, error)
+
+	// ExpireWithdrawRequests 自动拒绝过期的提现请求并释放冻结资金，返回拒绝的提现数量
+	ExpireWithdrawRequests(ctx context.Context) (int, error)
+
+	// StartRequestExpiryWorker 启动定时提现请求过期检查
+	StartRequestExpiryWorker(ctx context.Context, interval time.Duration)
+
+	// StartOutboxDispatcher 启动提现处理发件箱分发，保证获得足够批准（或管理员重试）后进程崩溃的提现继续处理
+	StartOutboxDispatcher(ctx context.Context, interval time.Duration)
 }
 
 type service struct {
-	db               *sql.DB
-	balanceService   balance.Service
-	hotWalletService hotwallet.Service
-	scanService      scan.Service
-	signerService    signer.Service
+	db                  *sql.DB
+	config              Config
+	chainService        chain.Service
+	balanceService      balance.Service
+	hotWalletService    hotwallet.Service
+	scanService         scan.Service
+	signerService       signer.Service
+	statsService        stats.Service
+	notificationService notification.Service
+	riskService         risk.Service
+	gasGuard            gasguard.Guard
+	settingsService     settings.Service
+	addressBookService  addressbook.Service
+	gasPriceCap         gasguard.PriceCap
+	notifier            alert.Notifier
+	screeningHook       ScreeningHook
+	queueMu             sync.Mutex // 串行处理等待处理窗口的排队提现
+	frozenAlerted       sync.Map   // 已告警的冻结资金（提现 ID + 原因），避免每次对账重复告警
 }
 
 const (
 	defaultERC20GasLimit      = 100000
 	defaultETHGasLimit        = 21000
-	defaultDecimalsBase       = 10
-	defaultFloatPrec          = 256
-	eip1559FeeMultiplier      = 2
 	paddedAddressLength       = 32
 	defaultConfirmationBlocks = 12 // 默认确认区块数
+	nativeTokenDecimals       = 18 // 原生代币通常是 18 位小数
 )
 
 // NewService 创建提现服务
@@ -64,17 +133,39 @@ const (
 //nolint:ireturn // 返回接口类型是预期的设计
 func NewService(
 	db *sql.DB,
+	config Config,
+	chainService chain.Service,
 	balanceService balance.Service,
 	hotWalletService hotwallet.Service,
 	scanService scan.Service,
 	signerService signer.Service,
+	statsService stats.Service,
+	notificationService notification.Service,
+	riskService risk.Service,
+	gasGuard gasguard.Guard,
+	settingsService settings.Service,
+	addressBookService addressbook.Service,
+	gasPriceCap gasguard.PriceCap,
+	notifier alert.Notifier,
+	screeningHook ScreeningHook,
 ) Service {
 	return &service{
-		db:               db,
-		balanceService:   balanceService,
-		hotWalletService: hotWalletService,
-		scanService:      scanService,
-		signerService:    signerService,
+		db:                  db,
+		config:              config,
+		chainService:        chainService,
+		balanceService:      balanceService,
+		hotWalletService:    hotWalletService,
+		scanService:         scanService,
+		signerService:       signerService,
+		statsService:        statsService,
+		notificationService: notificationService,
+		riskService:         riskService,
+		gasGuard:            gasGuard,
+		settingsService:     settingsService,
+		addressBookService:  addressBookService,
+		gasPriceCap:         gasPriceCap,
+		notifier:            notifier,
+		screeningHook:       screeningHook,
 	}
 }
 
@@ -82,26 +173,58 @@ func NewService(
 func (s *service) RequestWithdraw(ctx context.Context, userID string, req *Request) (*models.Withdraw, error) {
 	// 1. 验证参数
 	if req.Amount.Sign() <= 0 {
-		return nil, errors.New("invalid amount")
+		return nil, walleterrors.ErrInvalidAmount
+	}
+
+	// 相同幂等键的重复请求直接返回原提现，不计入频率限制
+	if req.IdempotencyKey != "" {
+		existing, err := s.findIdempotentWithdraw(ctx, userID, req)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			log.Info().
+				Str("withdraw_id", existing.ID).
+				Str("user_id", userID).
+				Msg("Withdraw request replayed with idempotency key")
+			return existing, nil
+		}
 	}
 
 	// 2. 获取代币信息
 	token, err := models.Tokens(models.TokenWhere.ID.EQ(req.TokenID)).One(ctx, s.db)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.New("token not found")
+			return nil, walleterrors.ErrTokenNotFound
 		}
 		return nil, errors.Wrap(err, "failed to get token")
 	}
 
-	// 3. 检查余额
-	availableBalance, err := s.balanceService.GetAvailableBalance(ctx, userID, token.ChainID, token.ID)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to check balance")
+	// 金额的小数位不能超过代币精度，否则链上发送时无法精确换算为最小单位
+	if _, err := money.ToSmallestUnit(req.Amount.Text('f', -1), token.Decimals); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateToAddress(ctx, userID, token, req.ToAddress); err != nil {
+		return nil, err
+	}
+
+	// 合规筛查：目标地址或代币命中筛查名单时拦截
+	if err := s.screen(ctx, userID, token, req); err != nil {
+		return nil, err
 	}
 
-	if availableBalance.Cmp(req.Amount) < 0 {
-		return nil, errors.New("insufficient balance")
+	// 3. 计算手续费（在提现金额之外从用户余额扣除）
+	fee, err := s.calculateFee(ctx, token, req.Amount)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate withdraw fee")
+	}
+	feeFloat, err := money.ParseFloat(fee)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse withdraw fee")
+	}
+	if feeFloat.Sign() > 0 && s.config.FeeAccountUserID == "" {
+		return nil, errors.Errorf("withdraw fee account is not configured, cannot charge withdraw fee for token %d", token.ID)
 	}
 
 	// 4. 开启事务，创建提现记录和扣减余额（冻结）
@@ -111,13 +234,57 @@ func (s *service) RequestWithdraw(ctx context.Context, userID string, req *Reque
 	}
 	defer func() { _ = tx.Rollback() }()
 
+	// 5. 锁定用户后检查余额（提现金额 + 手续费），与内部转账使用同一把锁，防止并发提现/转账超额扣减
+	if _, err := tx.ExecContext(ctx, `SELECT id FROM users WHERE id = $1 FOR UPDATE`, userID); err != nil {
+		return nil, errors.Wrap(err, "failed to lock user for withdraw")
+	}
+
+	// 锁定用户后统计频率限制，同一用户的并发请求依次统计，不会同时通过检查
+	if err := s.checkRateLimit(ctx, tx, userID); err != nil {
+		if !errors.Is(err, ErrRateLimited) || req.IdempotencyKey == "" {
+			return nil, err
+		}
+		// 等待锁期间使用相同幂等键的请求可能已创建提现，重复请求返回原提现，不计入频率限制
+		existing, findErr := s.findIdempotentWithdraw(ctx, userID, req)
+		if findErr != nil {
+			return nil, findErr
+		}
+		if existing == nil {
+			return nil, err
+		}
+		return existing, nil
+	}
+
+	availableBalance, err := s.balanceService.GetAvailableBalance(ctx, tx, userID, token.ChainID, token.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check balance")
+	}
+
+	required := new(big.Float).SetPrec(money.Precision).Add(req.Amount, feeFloat)
+	if availableBalance.Cmp(required) < 0 {
+		return nil, walleterrors.ErrInsufficientBalance
+	}
+
+	// 检查提现限额：超出拒绝限额时拒绝提现，超出复核限额时创建提现并标记人工复核
+	violations, err := s.riskService.CheckWithdraw(ctx, tx, userID, token, req.Amount.Text('f', -1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check withdraw limits")
+	}
+	var reviewViolations []*risk.Violation
+	for _, violation := range violations {
+		if violation.Limit.Action == risk.ActionReject {
+			return nil, &risk.LimitExceededError{Violation: violation}
+		}
+		reviewViolations = append(reviewViolations, violation)
+	}
+
 	// 创建提现记录
 	withdraw := &models.Withdraw{
 		UserID:    userID,
 		ToAddress: req.ToAddress,
 		TokenID:   req.TokenID,
 		Amount:    req.Amount.Text('f', -1), // 保持精度
-		Fee:       "0",                      // 暂时未计算手续费，后续 update
+		Fee:       fee,
 		ChainID:   token.ChainID,
 		ChainType: token.ChainType,
 		Status:    models.WithdrawStatusUserWithdrawRequest, // 初始状态：等待管理员审核
@@ -127,6 +294,25 @@ func (s *service) RequestWithdraw(ctx context.Context, userID string, req *Reque
 		return nil, errors.Wrap(err, "failed to insert withdraw record")
 	}
 
+	// 先记录幂等键再冻结资金，并发的重复提交不会重复冻结
+	if req.IdempotencyKey != "" {
+		if err := insertIdempotencyKey(ctx, tx, userID, req, withdraw.ID); err != nil {
+			if !isUniqueViolation(err) {
+				return nil, errors.Wrap(err, "failed to insert withdraw idempotency key")
+			}
+			_ = tx.Rollback()
+
+			existing, err := s.findIdempotentWithdraw(ctx, userID, req)
+			if err != nil {
+				return nil, err
+			}
+			if existing == nil {
+				return nil, errors.New("idempotent withdraw not found after unique violation")
+			}
+			return existing, nil
+		}
+	}
+
 	// 创建 Credits 记录（冻结资金）
 	// 金额为负数
 	negAmount := new(big.Float).Neg(req.Amount)
@@ -136,13 +322,13 @@ func (s *service) RequestWithdraw(ctx context.Context, userID string, req *Reque
 		TokenID:       token.ID,
 		TokenSymbol:   token.TokenSymbol,
 		Amount:        negAmount.Text('f', -1),
-		CreditType:    "withdraw",
-		BusinessType:  "blockchain",
+		CreditType:    models.CreditTypeWithdraw,
+		BusinessType:  models.BusinessTypeBlockchain,
 		ReferenceID:   withdraw.ID,
-		ReferenceType: "withdraw",
+		ReferenceType: models.ReferenceTypeWithdraw,
 		ChainID:       null.IntFrom(token.ChainID),
 		ChainType:     null.StringFrom(token.ChainType),
-		Status:        "frozen", // 冻结状态，等待处理
+		Status:        models.CreditStatusFrozen, // 冻结状态，等待处理
 	}
 
 	// 获取用户在该链的地址填充 credits.address
@@ -150,6 +336,7 @@ func (s *service) RequestWithdraw(ctx context.Context, userID string, req *Reque
 	userWallet, err := models.Wallets(
 		models.WalletWhere.UserID.EQ(userID),
 		models.WalletWhere.ChainID.EQ(token.ChainID),
+		models.WalletWhere.WalletType.NEQ(models.WalletTypeWatch),
 	).One(ctx, tx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to find user wallet for credit record")
@@ -160,6 +347,17 @@ func (s *service) RequestWithdraw(ctx context.Context, userID string, req *Reque
 		return nil, errors.Wrap(err, "failed to insert credit record")
 	}
 
+	// 手续费单独记一条 Credits（与提现 Credits 一起冻结，拒绝时一起解冻），同时记入平台手续费账户
+	if feeFloat.Sign() > 0 {
+		if err := insertFeeCredits(ctx, tx, credit, fee, s.config.FeeAccountUserID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.riskService.RecordFlags(ctx, tx, withdraw.ID, reviewViolations); err != nil {
+		return nil, err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return nil, errors.Wrap(err, "failed to commit transaction")
 	}
@@ -168,8 +366,20 @@ func (s *service) RequestWithdraw(ctx context.Context, userID string, req *Reque
 		Str("withdraw_id", withdraw.ID).
 		Str("user_id", userID).
 		Str("amount", withdraw.Amount).
+		Str("fee", withdraw.Fee).
 		Msg("Withdraw request created")
 
+	for _, violation := range reviewViolations {
+		log.Warn().
+			Str("withdraw_id", withdraw.ID).
+			Str("user_id", userID).
+			Str("limit_id", violation.Limit.ID).
+			Str("reason", violation.Reason).
+			Msg("Withdraw flagged for manual review")
+	}
+
+	s.notifyWithdrawRequested(ctx, withdraw, token, req.Amount)
+
 	return withdraw, nil
 }
 
@@ -192,81 +402,98 @@ func (s *service) ProcessWithdraw(ctx context.Context, withdrawID string) error
 
 	if withdraw.Status != models.WithdrawStatusUserWithdrawRequest {
 		// 只有 user_withdraw_request 状态的提现可以处理（等待审核的提现）
-		return errors.Errorf("withdraw status is %s, expected %s", withdraw.Status, models.WithdrawStatusUserWithdrawRequest)
+		return errors.Wrapf(walleterrors.ErrWithdrawStateConflict, "withdraw status is %s, expected %s", withdraw.Status, models.WithdrawStatusUserWithdrawRequest)
 	}
 
-	// 2. 获取热钱包
-	hotWallet, err := s.hotWalletService.GetHotWallet(ctx, withdraw.ChainID)
-	if err != nil {
-		return errors.Wrap(err, "failed to get hot wallet")
+	// 必须获得审批策略要求的管理员批准数
+	if err := s.checkApprovals(ctx, tx, withdraw); err != nil {
+		return err
+	}
+
+	if withdraw.ChainType == chain.TypeSolana {
+		return s.processSolanaWithdraw(ctx, tx, withdraw)
+	}
+
+	if withdraw.ChainType == chain.TypeBitcoin {
+		return s.processBitcoinWithdraw(ctx, tx, withdraw)
 	}
 
-	// 3. 获取 RPC 客户端
+	// 2. 获取 RPC 客户端
 	client, err := s.scanService.GetClient(ctx, withdraw.ChainID)
 	if err != nil {
 		return errors.Wrap(err, "failed to get RPC client")
 	}
 
-	// 4. 获取代币信息
+	// 3. 获取代币信息
 	token, err := models.Tokens(models.TokenWhere.ID.EQ(withdraw.TokenID)).One(ctx, tx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get token info")
 	}
 
-	// 5. 转换 Amount 到 Wei (BigInt)
-	amountFloat, _, err := big.ParseFloat(withdraw.Amount, defaultDecimalsBase, defaultFloatPrec, big.ToNearestEven)
+	// 4. 按链配置的策略在用户所属组织的热钱包中选择（多个热钱包分摊提现，避免 nonce 排队）
+	orgID, err := organization.UserOrgID(ctx, tx, withdraw.UserID)
 	if err != nil {
-		return errors.Wrap(err, "failed to parse amount")
+		return err
+	}
+	// 重试的提现（RetryWithdraw）保留了上一次签名交易的热钱包和 nonce，必须在原热钱包用原 nonce 重新签名，
+	// 使用新 nonce 会留下空缺，旧交易之后仍可能上链导致重复出账
+	var hotWallet *models.Wallet
+	if withdraw.FromAddress.Valid && withdraw.Nonce.Valid {
+		hotWallet, err = models.Wallets(
+			models.WalletWhere.Address.EQ(withdraw.FromAddress.String),
+			models.WalletWhere.ChainID.EQ(withdraw.ChainID),
+			models.WalletWhere.WalletType.EQ(models.WalletTypeHot),
+		).One(ctx, tx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get hot wallet %s of previous transaction", withdraw.FromAddress.String)
+		}
+	} else {
+		hotWallet, err = s.hotWalletService.SelectHotWallet(ctx, withdraw.ChainID, orgID, client, token)
+		if err != nil {
+			return errors.Wrap(err, "failed to get hot wallet")
+		}
 	}
-	decimalsFloat := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(defaultDecimalsBase), big.NewInt(int64(token.Decimals)), nil))
-	amountWeiFloat := new(big.Float).Mul(amountFloat, decimalsFloat)
-	amountWei := new(big.Int)
-	amountWeiFloat.Int(amountWei) // 转换为 Int
 
-	// 6. 获取 gas 价格（用于余额检查和交易构建）
-	tipCap, err := client.SuggestGasTipCap(ctx)
+	// 5. 转换 Amount 到 Wei (BigInt)
+	amountWei, err := money.ToSmallestUnit(withdraw.Amount, token.Decimals)
 	if err != nil {
-		return errors.Wrap(err, "failed to suggest gas tip cap")
+		return err
 	}
-	latestBlock, err := client.GetBlockByNumber(ctx, nil)
+
+	// 6. 获取 gas 价格（用于余额检查和交易构建）
+	fees, err := s.suggestGasFees(ctx, client, withdraw.ChainID)
 	if err != nil {
-		return errors.Wrap(err, "failed to get latest block")
+		return err
 	}
-	baseFee := latestBlock.BaseFee()
-	if baseFee == nil {
-		return errors.New("chain does not support EIP-1559 (baseFee is nil)")
+
+	// gas 价格超过链的上限时不广播，由调用方按重试计划推迟
+	if err := s.checkGasPriceCap(ctx, withdraw.ChainID, fees); err != nil {
+		return err
 	}
-	maxFee := new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(eip1559FeeMultiplier)), tipCap)
 
 	// 7. 检查热钱包余额
 	hotWalletAddr := common.HexToAddress(hotWallet.Address)
-	if err := s.checkHotWalletBalance(ctx, client, token, hotWalletAddr, amountWei, maxFee); err != nil {
+	if err := s.checkHotWalletBalance(ctx, client, token, hotWalletAddr, amountWei, fees.PerGas()); err != nil {
 		return err
 	}
 
-	// 8. 获取 Nonce (原子递增)
-	nonce, err := s.hotWalletService.GetNextNonce(ctx, hotWallet.Address, withdraw.ChainID)
-	if err != nil {
-		return errors.Wrap(err, "failed to get nonce")
-	}
-
-	// 9. 构建交易签名请求
-	// tipCap, baseFee, maxFee 已经在余额检查时计算过了，直接使用
+	// 8. 构建交易签名请求
+	// gas 价格已经在余额检查时获取过了，直接使用（不支持 EIP-1559 的链为 legacy 交易）
 
 	// amountWei 已经在余额检查时计算过了，这里直接使用
 
 	// 构建 SignRequest
+	maxFeePerGas, maxPriorityFeePerGas, gasPrice := fees.SignFields()
 	signReq := &signer.SignEVMRequest{
 		ChainID:              int64(withdraw.ChainID),
 		To:                   withdraw.ToAddress,
 		Value:                amountWei.String(),
 		GasLimit:             defaultETHGasLimit, // ETH 转账默认，ERC20 需要 EstimateGas
-		MaxFeePerGas:         maxFee.String(),
-		MaxPriorityFeePerGas: tipCap.String(),
-		//nolint:gosec // Nonce is guaranteed to be positive and fit in uint64
-		Nonce:          uint64(nonce),
-		FromAddress:    hotWallet.Address,
-		DerivationPath: hotWallet.DerivationPath,
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+		GasPrice:             gasPrice,
+		FromAddress:          hotWallet.Address,
+		DerivationPath:       hotWallet.DerivationPath,
 	}
 
 	if !token.IsNative {
@@ -301,6 +528,22 @@ func (s *service) ProcessWithdraw(ctx context.Context, withdrawID string) error
 		signReq.GasLimit = defaultERC20GasLimit // 简单默认值，生产环境必须估算
 	}
 
+	// 9. 模拟执行（eth_call），必然回滚的交易（如 ERC20 向黑名单地址转账）在分配 nonce 前拦截
+	if err := simulateTransaction(ctx, client, signReq); err != nil {
+		return err
+	}
+
+	// 10. 获取 Nonce (原子递增)，重试的提现使用上一次签名交易的 nonce
+	nonce := withdraw.Nonce.Int
+	if !withdraw.Nonce.Valid {
+		nonce, err = s.hotWalletService.GetNextNonce(ctx, hotWallet.Address, withdraw.ChainID)
+		if err != nil {
+			return errors.Wrap(err, "failed to get nonce")
+		}
+	}
+	//nolint:gosec // Nonce is guaranteed to be positive and fit in uint64
+	signReq.Nonce = uint64(nonce)
+
 	// 5. 签名
 	signResp, err := s.signerService.SignEVMTransaction(ctx, signReq)
 	if err != nil {
@@ -314,8 +557,17 @@ func (s *service) ProcessWithdraw(ctx context.Context, withdrawID string) error
 		return errors.Wrap(err, "failed to unmarshal signed transaction")
 	}
 
-	if err := client.SendTransaction(ctx, txObj); err != nil {
-		return errors.Wrap(err, "failed to broadcast transaction")
+	if err := s.recordSignedTransaction(ctx, withdrawID, signResp.TxHash, hotWallet.Address, null.IntFrom(nonce)); err != nil {
+		return err
+	}
+
+	if err := client.BroadcastTransaction(ctx, txObj); err != nil {
+		return &signedTxError{
+			txHash:      signResp.TxHash,
+			fromAddress: hotWallet.Address,
+			nonce:       null.IntFrom(nonce),
+			err:         errors.Wrap(err, "failed to broadcast transaction"),
+		}
 	}
 
 	// 7. 更新状态为 pending（交易已发送，等待确认）
@@ -325,13 +577,24 @@ func (s *service) ProcessWithdraw(ctx context.Context, withdrawID string) error
 	withdraw.FromAddress = null.StringFrom(hotWallet.Address)
 	withdraw.Nonce = null.IntFrom(nonce)
 
+	// 交易已广播，之后的失败同样返回交易信息，不能按未广播处理
 	if _, err := withdraw.Update(ctx, tx, boil.Infer()); err != nil {
-		return errors.Wrap(err, "failed to update withdraw status")
+		return &signedTxError{
+			txHash:      signResp.TxHash,
+			fromAddress: hotWallet.Address,
+			nonce:       null.IntFrom(nonce),
+			err:         errors.Wrap(err, "failed to update withdraw status"),
+		}
 	}
 
 	// 提交事务
 	if err := tx.Commit(); err != nil {
-		return errors.Wrap(err, "failed to commit transaction")
+		return &signedTxError{
+			txHash:      signResp.TxHash,
+			fromAddress: hotWallet.Address,
+			nonce:       null.IntFrom(nonce),
+			err:         errors.Wrap(err, "failed to commit transaction"),
+		}
 	}
 
 	log.Info().
@@ -343,7 +606,7 @@ func (s *service) ProcessWithdraw(ctx context.Context, withdrawID string) error
 }
 
 // ApproveWithdraw 管理员批准提现请求
-func (s *service) ApproveWithdraw(ctx context.Context, withdrawID string) (*models.Withdraw, error) {
+func (s *service) ApproveWithdraw(ctx context.Context, withdrawID string, adminUserID string) (*models.Withdraw, error) {
 	// 1. 获取并锁定提现记录
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -357,29 +620,132 @@ func (s *service) ApproveWithdraw(ctx context.Context, withdrawID string) (*mode
 	).One(ctx, tx)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.New("withdraw not found")
+			return nil, walleterrors.ErrWithdrawNotFound
 		}
 		return nil, errors.Wrap(err, "failed to get withdraw record")
 	}
 
 	// 2. 检查状态
 	if withdraw.Status != models.WithdrawStatusUserWithdrawRequest {
-		return nil, errors.Errorf("withdraw status is %s, expected %s", withdraw.Status, models.WithdrawStatusUserWithdrawRequest)
+		return nil, errors.Wrapf(walleterrors.ErrWithdrawStateConflict, "withdraw status is %s, expected %s", withdraw.Status, models.WithdrawStatusUserWithdrawRequest)
+	}
+
+	// 管理员不能批准自己的提现
+	if withdraw.UserID == adminUserID {
+		return nil, walleterrors.ErrSelfApproval
+	}
+
+	// 3. 记录批准并统计批准数
+	if err := s.recordApproval(ctx, tx, withdrawID, adminUserID, ApprovalDecisionApproved, ""); err != nil {
+		return nil, err
+	}
+
+	approvals, err := s.countApprovals(ctx, tx, withdrawID)
+	if err != nil {
+		return nil, err
+	}
+
+	required, err := s.requiredApprovals(withdraw.TokenID, withdraw.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. 批准数达到要求时在同一事务中写入处理事件，提交后进程崩溃也会由发件箱分发器继续处理
+	if approvals >= required {
+		if err := enqueueDispatch(ctx, tx, withdrawID); err != nil {
+			return nil, err
+		}
 	}
 
-	// 3. 提交事务（状态检查完成）
+	// 5. 提交事务（审批记录写入完成）
 	if err := tx.Commit(); err != nil {
 		return nil, errors.Wrap(err, "failed to commit transaction")
 	}
 
-	// 4. 处理提现（签名并广播）
+	log.Info().
+		Str("withdraw_id", withdrawID).
+		Str("admin_user_id", adminUserID).
+		Int("approvals", approvals).
+		Int("required_approvals", required).
+		Msg("Withdraw approved by admin")
+
+	// 批准数不足，等待其他管理员批准
+	if approvals < required {
+		return withdraw, nil
+	}
+
+	return s.dispatchNow(ctx, withdrawID)
+}
+
+// dispatchWithdraw 处理已获得足够批准的提现：受处理窗口、批量提现、gas 熔断或维护模式限制时排队，否则立即处理
+func (s *service) dispatchWithdraw(ctx context.Context, withdraw *models.Withdraw) (*models.Withdraw, error) {
+	withdrawID := withdraw.ID
+
+	// 配置了处理窗口时排队，由调度器在下一个处理窗口统一处理
+	if window := s.processingWindow(withdraw.ChainID, withdraw.TokenID); window != nil {
+		log.Info().
+			Str("withdraw_id", withdrawID).
+			Time("next_window", window.NextWindow(time.Now())).
+			Msg("Withdraw queued for next processing window")
+		return withdraw, nil
+	}
+
+	// 配置了批量提现的链排队，由调度器与同一代币的其他已批准提现合并为一笔交易
+	if s.batchConfig(withdraw.ChainID) != nil {
+		log.Info().
+			Str("withdraw_id", withdrawID).
+			Msg("Withdraw queued for batch processing")
+		return withdraw, nil
+	}
+
+	// gas 熔断期间排队，由调度器在 baseFee 回落后处理
+	if s.gasSpikeQueued(withdraw.ChainID) {
+		log.Info().
+			Str("withdraw_id", withdrawID).
+			Int("chain_id", withdraw.ChainID).
+			Msg("Withdraw queued until gas fees normalize")
+		return withdraw, nil
+	}
+
+	// 维护期间排队，由调度器在维护结束后处理；无法确认是否处于维护时同样排队
+	maintenances, err := s.activeMaintenances(ctx)
+	if err != nil {
+		log.Error().Err(err).Str("withdraw_id", withdrawID).Msg("Failed to check maintenance mode, withdraw queued")
+		return withdraw, nil
+	}
+	if maintenances.For(withdraw.ChainID) != nil {
+		log.Info().
+			Str("withdraw_id", withdrawID).
+			Int("chain_id", withdraw.ChainID).
+			Msg("Withdraw queued until maintenance ends")
+		return withdraw, nil
+	}
+
+	// 与提现调度器互斥：调度器可能已处理该提现（维护刚结束时），此时不再重复处理
+	s.queueMu.Lock()
+	defer s.queueMu.Unlock()
+
+	current, err := models.Withdraws(models.WithdrawWhere.ID.EQ(withdrawID)).One(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get withdraw record")
+	}
+	if current.Status != models.WithdrawStatusUserWithdrawRequest {
+		return current, nil
+	}
+
+	// 处理提现（签名并广播），gas 价格超过上限时推迟，由调度器按重试计划处理；
+	// 提现已被其他实例处理（状态冲突）时不标记为失败
 	if err := s.ProcessWithdraw(ctx, withdrawID); err != nil {
-		// 如果处理失败，更新状态为 failed 并记录错误信息
-		s.updateWithdrawStatusOnError(ctx, withdrawID, err)
-		return nil, errors.Wrap(err, "failed to process withdraw after approval")
+		if gasguard.IsCapExceeded(err) {
+			s.deferWithdraw(ctx, withdrawID, err)
+		} else if !errors.Is(err, walleterrors.ErrWithdrawStateConflict) {
+			// 如果处理失败，更新状态为 failed 并记录错误信息
+			s.updateWithdrawStatusOnError(ctx, withdrawID, err)
+			return nil, errors.Wrap(err, "failed to process withdraw")
+		}
 	}
 
-	// 5. 重新获取提现记录（获取更新后的状态）
+	// 重新获取提现记录（获取更新后的状态）
 	withdraw, err = models.Withdraws(models.WithdrawWhere.ID.EQ(withdrawID)).One(ctx, s.db)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get updated withdraw record")
@@ -389,7 +755,7 @@ func (s *service) ApproveWithdraw(ctx context.Context, withdrawID string) (*mode
 }
 
 // RejectWithdraw 管理员拒绝提现请求
-func (s *service) RejectWithdraw(ctx context.Context, withdrawID string, reason string) (*models.Withdraw, error) {
+func (s *service) RejectWithdraw(ctx context.Context, withdrawID string, adminUserID string, reason string) (*models.Withdraw, error) {
 	// 1. 获取并锁定提现记录
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -403,28 +769,29 @@ func (s *service) RejectWithdraw(ctx context.Context, withdrawID string, reason
 	).One(ctx, tx)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.New("withdraw not found")
+			return nil, walleterrors.ErrWithdrawNotFound
 		}
 		return nil, errors.Wrap(err, "failed to get withdraw record")
 	}
 
-	// 2. 检查是否有 frozen 的 credits（允许拒绝任何有 frozen credits 的提现，无论状态）
-	credits, err := models.Credits(
-		models.CreditWhere.ReferenceID.EQ(withdrawID),
-		models.CreditWhere.ReferenceType.EQ("withdraw"),
-		models.CreditWhere.Status.EQ("frozen"),
-	).All(ctx, tx)
+	// 2. 检查是否有未冲正的 frozen credits（允许拒绝任何有 frozen credits 的提现，无论状态）
+	reversed, err := isWithdrawReversed(ctx, tx, withdrawID)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get frozen credits")
+		return nil, errors.Wrap(err, "failed to check withdraw reversal")
 	}
 
-	if len(credits) == 0 {
-		// 没有 frozen credits，说明已经处理过了，不允许拒绝
-		return nil, errors.Errorf("withdraw has no frozen credits to reject (status: %s)", withdraw.Status)
+	credits, err := findReversibleCredits(ctx, tx, withdrawID)
+	if err != nil {
+		return nil, err
+	}
+
+	if reversed || len(credits) == 0 {
+		// 已冲正或没有 frozen credits，说明已经处理过了，不允许拒绝
+		return nil, errors.Wrapf(walleterrors.ErrWithdrawStateConflict, "withdraw has no frozen credits to reject (status: %s)", withdraw.Status)
 	}
 
 	// 3. 检查状态（只允许拒绝 user_withdraw_request 或 failed 状态的提现）
-	allowedStatuses := []string{
+	allowedStatuses := []models.WithdrawStatus{
 		models.WithdrawStatusUserWithdrawRequest,
 		models.WithdrawStatusFailed,
 	}
@@ -437,7 +804,7 @@ func (s *service) RejectWithdraw(ctx context.Context, withdrawID string, reason
 	}
 
 	if !statusAllowed {
-		return nil, errors.Errorf("withdraw status is %s, can only reject %s or %s status", withdraw.Status, models.WithdrawStatusUserWithdrawRequest, models.WithdrawStatusFailed)
+		return nil, errors.Wrapf(walleterrors.ErrWithdrawStateConflict, "withdraw status is %s, can only reject %s or %s status", withdraw.Status, models.WithdrawStatusUserWithdrawRequest, models.WithdrawStatusFailed)
 	}
 
 	// 4. 记录之前的状态（用于日志）
@@ -457,12 +824,14 @@ func (s *service) RejectWithdraw(ctx context.Context, withdrawID string, reason
 		return nil, errors.Wrap(err, "failed to update withdraw status")
 	}
 
-	// 6. 解冻 credits（将 frozen 状态的 credits 更新为 failed）
-	for _, credit := range credits {
-		credit.Status = "failed"
-		if _, err := credit.Update(ctx, tx, boil.Infer()); err != nil {
-			return nil, errors.Wrap(err, "failed to update credit status")
-		}
+	// 记录拒绝（审计记录）
+	if err := s.recordApproval(ctx, tx, withdrawID, adminUserID, ApprovalDecisionRejected, reason); err != nil {
+		return nil, err
+	}
+
+	// 6. 退款：写入冲正 credits 抵消冻结的提现金额、手续费和平台手续费入账，原 credits 保持不变
+	if err := insertReversalCredits(ctx, tx, credits); err != nil {
+		return nil, err
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -471,8 +840,9 @@ func (s *service) RejectWithdraw(ctx context.Context, withdrawID string, reason
 
 	log.Info().
 		Str("withdraw_id", withdrawID).
+		Str("admin_user_id", adminUserID).
 		Str("reason", reason).
-		Str("previous_status", previousStatus).
+		Str("previous_status", previousStatus.String()).
 		Msg("Withdraw rejected by admin")
 
 	return withdraw, nil
@@ -481,11 +851,8 @@ func (s *service) RejectWithdraw(ctx context.Context, withdrawID string, reason
 // UpdateWithdrawStatus 根据交易确认数更新提现状态
 // 状态流转：pending → processing → confirmed
 func (s *service) UpdateWithdrawStatus(ctx context.Context, chainID int, latestBlockNumber int64) error {
-	// 获取链配置
-	chain, err := models.Chains(
-		models.ChainWhere.ChainID.EQ(chainID),
-	).One(ctx, s.db)
-
+	// 获取链配置（包含配置覆盖的确认区块数）
+	chain, err := s.chainService.GetChain(ctx, chainID)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get chain config for chain_id=%d", chainID)
 	}
@@ -499,7 +866,7 @@ func (s *service) UpdateWithdrawStatus(ctx context.Context, chainID int, latestB
 	// 查询所有待更新的提现记录（pending 或 processing 状态，且有 tx_hash）
 	withdraws, err := models.Withdraws(
 		models.WithdrawWhere.ChainID.EQ(chainID),
-		models.WithdrawWhere.Status.IN([]string{
+		models.WithdrawWhere.Status.IN([]models.WithdrawStatus{
 			models.WithdrawStatusPending,
 			models.WithdrawStatusProcessing,
 		}),
@@ -556,7 +923,7 @@ func (s *service) UpdateWithdrawStatus(ctx context.Context, chainID int, latestB
 		}
 
 		// 根据确认数确定新状态
-		var newStatus string
+		var newStatus models.WithdrawStatus
 		switch {
 		case confirmationCount >= confirmationBlocks:
 			// 达到确认数，状态为 confirmed
@@ -572,12 +939,9 @@ func (s *service) UpdateWithdrawStatus(ctx context.Context, chainID int, latestB
 		// 如果状态需要更新
 		if withdraw.Status != newStatus {
 			oldStatus := withdraw.Status
-			withdraw.Status = newStatus
 
-			if _, err := withdraw.Update(ctx, s.db, boil.Whitelist(
-				models.WithdrawColumns.Status,
-				models.WithdrawColumns.UpdatedAt,
-			)); err != nil {
+			updated, err := s.saveWithdrawStatus(ctx, withdraw, newStatus)
+			if err != nil {
 				log.Error().
 					Str("withdraw_id", withdraw.ID).
 					Str("tx_hash", txHash).
@@ -585,6 +949,10 @@ func (s *service) UpdateWithdrawStatus(ctx context.Context, chainID int, latestB
 					Msg("Failed to update withdraw status")
 				continue
 			}
+			if !updated {
+				// 扫描器或其他轮询实例已更新该提现
+				continue
+			}
 
 			updatedCount++
 
@@ -592,8 +960,8 @@ func (s *service) UpdateWithdrawStatus(ctx context.Context, chainID int, latestB
 				Int("chain_id", chainID).
 				Str("withdraw_id", withdraw.ID).
 				Str("tx_hash", txHash).
-				Str("old_status", oldStatus).
-				Str("new_status", newStatus).
+				Str("old_status", oldStatus.String()).
+				Str("new_status", newStatus.String()).
 				Int64("confirmation_count", confirmationCount).
 				Int64("block_no", tx.BlockNo).
 				Int64("latest_block", latestBlockNumber).
@@ -611,6 +979,48 @@ func (s *service) UpdateWithdrawStatus(ctx context.Context, chainID int, latestB
 	return nil
 }
 
+// saveWithdrawStatus 将提现从读取时的状态更新为新状态，状态变为 confirmed 时在同一事务中累加用户提现统计
+// 以读取时的状态为条件更新，扫描器与确认轮询器（或多个实例）并发处理同一笔提现时只有一方生效，
+// 不会把已确认的提现改回 processing 再重复确认和统计；提现已被其他处理更新时返回 false
+func (s *service) saveWithdrawStatus(ctx context.Context, withdraw *models.Withdraw, newStatus models.WithdrawStatus) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	now := time.Now()
+	rowsAff, err := models.Withdraws(
+		models.WithdrawWhere.ID.EQ(withdraw.ID),
+		models.WithdrawWhere.Status.EQ(withdraw.Status),
+	).UpdateAll(ctx, tx, models.M{
+		models.WithdrawColumns.Status:    newStatus,
+		models.WithdrawColumns.UpdatedAt: now,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to update withdraw status")
+	}
+
+	if rowsAff == 0 {
+		return false, nil
+	}
+
+	if newStatus == models.WithdrawStatusConfirmed {
+		if err := s.statsService.RecordWithdraw(ctx, tx, withdraw.UserID, withdraw.TokenID, withdraw.Amount, now); err != nil {
+			return false, errors.Wrap(err, "failed to record withdraw stats")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, errors.Wrap(err, "failed to commit transaction")
+	}
+
+	withdraw.Status = newStatus
+	withdraw.UpdatedAt = now
+
+	return true, nil
+}
+
 // getOrCreateTransactionRecord 获取或创建交易记录
 // 如果 transactions 表中没有记录，尝试通过 RPC 查询并创建
 func (s *service) getOrCreateTransactionRecord(ctx context.Context, chainID int, txHash string, withdraw *models.Withdraw, latestBlockNumber int64) (*models.Transaction, error) {
@@ -647,6 +1057,14 @@ func (s *service) getOrCreateTransactionRecord(ctx context.Context, chainID int,
 
 // createTransactionRecordIfConfirmed 如果交易已确认，创建 transactions 记录
 func (s *service) createTransactionRecordIfConfirmed(ctx context.Context, chainID int, txHash string, withdraw *models.Withdraw, latestBlockNumber int64) error {
+	if withdraw.ChainType == chain.TypeSolana {
+		return s.createSolanaTransactionRecordIfConfirmed(ctx, chainID, txHash, withdraw, latestBlockNumber)
+	}
+
+	if withdraw.ChainType == chain.TypeBitcoin {
+		return s.createBitcoinTransactionRecordIfConfirmed(ctx, chainID, txHash, withdraw, latestBlockNumber)
+	}
+
 	// 获取 RPC 客户端
 	client, err := s.scanService.GetClient(ctx, chainID)
 	if err != nil {
@@ -741,7 +1159,7 @@ func (s *service) checkHotWalletBalance(
 	maxFee *big.Int,
 ) error {
 	if token.IsNative {
-		return s.checkNativeTokenBalance(ctx, client, hotWalletAddr, amountWei, maxFee)
+		return s.checkNativeTokenBalance(ctx, client, token, hotWalletAddr, amountWei, maxFee)
 	}
 	return s.checkERC20TokenBalance(ctx, client, token, hotWalletAddr, amountWei, maxFee)
 }
@@ -750,6 +1168,7 @@ func (s *service) checkHotWalletBalance(
 func (s *service) checkNativeTokenBalance(
 	ctx context.Context,
 	client *scan.RPCClient,
+	token *models.Token,
 	hotWalletAddr common.Address,
 	amountWei *big.Int,
 	maxFee *big.Int,
@@ -758,6 +1177,7 @@ func (s *service) checkNativeTokenBalance(
 	if err != nil {
 		return errors.Wrap(err, "failed to get hot wallet native token balance")
 	}
+	walletMetrics.SetHotWalletBalance(token.ChainID, strings.ToLower(hotWalletAddr.Hex()), walletMetrics.AssetNative, balance, nativeTokenDecimals)
 
 	// 估算 gas 费用
 	gasLimit := big.NewInt(defaultETHGasLimit)
@@ -791,6 +1211,7 @@ func (s *service) checkERC20TokenBalance(
 	if err != nil {
 		return errors.Wrap(err, "failed to get hot wallet ERC20 token balance")
 	}
+	walletMetrics.SetHotWalletBalance(token.ChainID, strings.ToLower(hotWalletAddr.Hex()), token.TokenSymbol, tokenBalance, token.Decimals)
 
 	if tokenBalance.Cmp(amountWei) < 0 {
 		return errors.Errorf("insufficient ERC20 token balance in hot wallet: have %s, need %s",
@@ -802,6 +1223,7 @@ func (s *service) checkERC20TokenBalance(
 	if err != nil {
 		return errors.Wrap(err, "failed to get hot wallet native token balance for gas")
 	}
+	walletMetrics.SetHotWalletBalance(token.ChainID, strings.ToLower(hotWalletAddr.Hex()), walletMetrics.AssetNative, balance, nativeTokenDecimals)
 
 	// 估算 gas 费用
 	gasLimit := big.NewInt(defaultERC20GasLimit)
@@ -833,8 +1255,35 @@ func (s *service) updateWithdrawStatusOnError(ctx context.Context, withdrawID st
 		return
 	}
 
+	// 模拟执行回滚时只记录回滚原因，便于按固定格式展示和检索
+	errorMessage := processErr.Error()
+	var simErr *scan.SimulationError
+	if errors.As(processErr, &simErr) {
+		errorMessage = simErr.Error()
+	}
+
 	withdrawRecord.Status = models.WithdrawStatusFailed
-	withdrawRecord.ErrorMessage = null.StringFrom(processErr.Error())
+	withdrawRecord.ErrorMessage = null.StringFrom(errorMessage)
+
+	// 签名后失败的交易仍可能已到达节点，记录交易信息，重试前据此确认交易没有上链；
+	// 错误中没有交易信息时使用分发在广播前记录的已签名交易
+	var signedErr *signedTxError
+	if errors.As(processErr, &signedErr) {
+		withdrawRecord.TXHash = null.StringFrom(signedErr.txHash)
+		withdrawRecord.FromAddress = null.StringFrom(signedErr.fromAddress)
+		withdrawRecord.Nonce = signedErr.nonce
+	} else if !withdrawRecord.TXHash.Valid {
+		record, err := latestSigningRecord(ctx, updateTx, withdrawID)
+		if err != nil {
+			log.Error().Err(err).Str("withdraw_id", withdrawID).Msg("Failed to get signing record for error status update")
+			return
+		}
+		if record.txHash.Valid {
+			withdrawRecord.TXHash = record.txHash
+			withdrawRecord.FromAddress = record.fromAddress
+			withdrawRecord.Nonce = record.nonce
+		}
+	}
 	if _, updateErr := withdrawRecord.Update(ctx, updateTx, boil.Infer()); updateErr != nil {
 		log.Error().Err(updateErr).Str("withdraw_id", withdrawID).Msg("Failed to update withdraw status to failed")
 		return
@@ -0,0 +1,242 @@
+package withdraw
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+
+	walletMetrics "github/chapool/go-wallet/internal/metrics/wallet"
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/money"
+	"github/chapool/go-wallet/internal/wallet/organization"
+	"github/chapool/go-wallet/internal/wallet/signer"
+	"github/chapool/go-wallet/internal/wallet/solana"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// solanaNativeDecimals SOL 精度（lamports）
+const solanaNativeDecimals = 9
+
+// processSolanaWithdraw 处理 Solana 链提现：SOL 使用 System Program 转账，
+// SPL 代币先幂等创建接收方的关联代币账户，再从热钱包的关联代币账户 TransferChecked 转账；
+// 手续费和创建账户的押金由热钱包支付。交易由调用方持有的提现记录锁保护，广播后更新为 pending
+func (s *service) processSolanaWithdraw(ctx context.Context, tx *sql.Tx, withdraw *models.Withdraw) error {
+	orgID, err := organization.UserOrgID(ctx, tx, withdraw.UserID)
+	if err != nil {
+		return err
+	}
+	hotWallet, err := s.hotWalletService.GetOrgHotWallet(ctx, withdraw.ChainID, orgID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get hot wallet")
+	}
+
+	client, err := s.scanService.GetSolanaClient(ctx, withdraw.ChainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get solana RPC client")
+	}
+
+	token, err := models.Tokens(models.TokenWhere.ID.EQ(withdraw.TokenID)).One(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get token info")
+	}
+
+	amount, err := money.ToSmallestUnit(withdraw.Amount, token.Decimals)
+	if err != nil {
+		return err
+	}
+	if !amount.IsUint64() {
+		return errors.Errorf("withdraw amount %s out of range", withdraw.Amount)
+	}
+
+	from, err := solana.ParsePublicKey(hotWallet.Address)
+	if err != nil {
+		return errors.Wrap(err, "invalid hot wallet address")
+	}
+	to, err := solana.ParsePublicKey(withdraw.ToAddress)
+	if err != nil {
+		return errors.Wrap(err, "invalid withdraw address")
+	}
+
+	// 构建转账指令并检查热钱包余额
+	var instructions []solana.Instruction
+	if token.IsNative {
+		if err := s.checkSolanaBalance(ctx, client, token, from, amount.Uint64()+solana.LamportsPerSignature); err != nil {
+			return err
+		}
+		instructions = []solana.Instruction{solana.SystemTransfer(from, to, amount.Uint64())}
+	} else {
+		if !token.TokenAddress.Valid {
+			return errors.New("token address is invalid for non-native token")
+		}
+		instructions, err = s.buildSPLTransfer(ctx, client, token, from, to, amount.Uint64())
+		if err != nil {
+			return err
+		}
+	}
+
+	blockhash, err := client.GetLatestBlockhash(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get latest blockhash")
+	}
+
+	transaction, err := solana.NewTransaction(instructions, blockhash, from)
+	if err != nil {
+		return errors.Wrap(err, "failed to build solana transaction")
+	}
+
+	signResp, err := s.signerService.SignSolanaTransaction(ctx, &signer.SignSolanaRequest{
+		Transaction:    transaction,
+		DerivationPath: hotWallet.DerivationPath,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to sign transaction")
+	}
+
+	if err := s.recordSignedTransaction(ctx, withdraw.ID, signResp.Signature, hotWallet.Address, null.Int{}); err != nil {
+		return err
+	}
+
+	if _, err := client.SendTransaction(ctx, signResp.RawTransaction); err != nil {
+		return &signedTxError{txHash: signResp.Signature, fromAddress: hotWallet.Address, err: errors.Wrap(err, "failed to broadcast transaction")}
+	}
+
+	// 交易签名即交易哈希；Solana 没有 nonce，nonce 留空
+	withdraw.Status = models.WithdrawStatusPending
+	withdraw.TXHash = null.StringFrom(signResp.Signature)
+	withdraw.FromAddress = null.StringFrom(hotWallet.Address)
+
+	if _, err := withdraw.Update(ctx, tx, boil.Infer()); err != nil {
+		return &signedTxError{txHash: signResp.Signature, fromAddress: hotWallet.Address, err: errors.Wrap(err, "failed to update withdraw status")}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &signedTxError{txHash: signResp.Signature, fromAddress: hotWallet.Address, err: errors.Wrap(err, "failed to commit transaction")}
+	}
+
+	log.Info().
+		Str("withdraw_id", withdraw.ID).
+		Str("tx_hash", signResp.Signature).
+		Msg("Solana withdraw processed and broadcasted")
+
+	return nil
+}
+
+// buildSPLTransfer 构建 SPL 代币转账指令，检查热钱包的代币余额和支付手续费、押金所需的 SOL 余额
+func (s *service) buildSPLTransfer(
+	ctx context.Context,
+	client *solana.Client,
+	token *models.Token,
+	from solana.PublicKey,
+	to solana.PublicKey,
+	amount uint64,
+) ([]solana.Instruction, error) {
+	mint, err := solana.ParsePublicKey(token.TokenAddress.String)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid token mint address")
+	}
+
+	tokenBalance, err := client.GetTokenBalance(ctx, from, mint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get hot wallet SPL token balance")
+	}
+	walletMetrics.SetHotWalletBalance(token.ChainID, from.String(), token.TokenSymbol, new(big.Int).SetUint64(tokenBalance), token.Decimals)
+
+	if tokenBalance < amount {
+		return nil, errors.Errorf("insufficient SPL token balance in hot wallet: have %d, need %d", tokenBalance, amount)
+	}
+
+	// 接收方代币账户不存在时需要热钱包支付押金，按最大值检查
+	if err := s.checkSolanaBalance(ctx, client, token, from, solana.LamportsPerSignature+solana.TokenAccountRentExemptLamports); err != nil {
+		return nil, err
+	}
+
+	source, err := solana.FindAssociatedTokenAddress(from, mint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive hot wallet token account")
+	}
+	destination, err := solana.FindAssociatedTokenAddress(to, mint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive recipient token account")
+	}
+
+	createATA, err := solana.CreateAssociatedTokenAccountIdempotent(from, to, mint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build create token account instruction")
+	}
+
+	return []solana.Instruction{
+		createATA,
+		solana.TokenTransferChecked(source, mint, destination, from, amount, uint8(token.Decimals)), //nolint:gosec // 代币精度不超过 255
+	}, nil
+}
+
+// checkSolanaBalance 检查热钱包 SOL 余额是否不低于 required（lamports）
+func (s *service) checkSolanaBalance(ctx context.Context, client *solana.Client, token *models.Token, address solana.PublicKey, required uint64) error {
+	balance, err := client.GetBalance(ctx, address)
+	if err != nil {
+		return errors.Wrap(err, "failed to get hot wallet SOL balance")
+	}
+	walletMetrics.SetHotWalletBalance(token.ChainID, address.String(), walletMetrics.AssetNative, new(big.Int).SetUint64(balance), solanaNativeDecimals)
+
+	if balance < required {
+		return errors.Errorf("insufficient SOL balance in hot wallet: have %d, need %d", balance, required)
+	}
+
+	return nil
+}
+
+// createSolanaTransactionRecordIfConfirmed 查询 Solana 交易签名状态，交易已确认时创建 transactions 记录
+// 确认数按 slot 计算，与扫描器一致
+func (s *service) createSolanaTransactionRecordIfConfirmed(ctx context.Context, chainID int, signature string, withdraw *models.Withdraw, latestSlot int64) error {
+	client, err := s.scanService.GetSolanaClient(ctx, chainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get solana RPC client")
+	}
+
+	status, err := client.GetSignatureStatus(ctx, signature)
+	if err != nil {
+		return errors.Wrap(err, "failed to get signature status")
+	}
+	if status == nil || status.ConfirmationStatus == "processed" {
+		return errors.New("transaction not confirmed yet (may still be pending)")
+	}
+
+	//nolint:gosec // slot 远小于 int64 上限
+	slot := int64(status.Slot)
+	transaction := &models.Transaction{
+		ChainID:           chainID,
+		BlockNo:           slot,
+		TXHash:            signature,
+		FromAddr:          withdraw.FromAddress.String,
+		ToAddr:            withdraw.ToAddress,
+		Amount:            withdraw.Amount,
+		Type:              models.TransactionTypeWithdraw,
+		Status:            models.TransactionStatusConfirmed,
+		ConfirmationCount: null.IntFrom(int(max(latestSlot-slot, 0))),
+	}
+	if !status.Succeeded() {
+		transaction.Status = models.TransactionStatusFailed
+	}
+
+	token, err := models.Tokens(models.TokenWhere.ID.EQ(withdraw.TokenID)).One(ctx, s.db)
+	if err == nil && !token.IsNative && token.TokenAddress.Valid {
+		transaction.TokenAddr = null.StringFrom(token.TokenAddress.String)
+	}
+
+	if err := transaction.Insert(ctx, s.db, boil.Infer()); err != nil {
+		return errors.Wrap(err, "failed to create transaction record")
+	}
+
+	log.Info().
+		Str("withdraw_id", withdraw.ID).
+		Str("tx_hash", signature).
+		Int64("slot", slot).
+		Str("status", transaction.Status.String()).
+		Msg("Created transaction record for solana withdraw")
+
+	return nil
+}
@@ -1,10 +1,51 @@
 package withdraw
 
-import "math/big"
+import (
+	"math/big"
+	"time"
+)
 
 // Request 提现请求参数
 type Request struct {
-	ToAddress string
-	TokenID   int
-	Amount    *big.Float
+	ToAddress      string
+	TokenID        int
+	Amount         *big.Float
+	IdempotencyKey string // 可选，相同用户使用相同幂等键的重复请求返回原提现
+}
+
+// Config 提现服务配置
+type Config struct {
+	BaseFeeMultiplier   int64               // maxFeePerGas = baseFee * BaseFeeMultiplier + tipCap
+	WorkerConcurrency   int                 // 确认轮询时并行处理的链数量
+	ApprovalThresholds  []ApprovalThreshold // 大额提现审批策略，未命中时只需一名管理员批准
+	ProcessingWindows   []ProcessingWindow  // 提现处理窗口，未命中时批准后立即处理
+	LegacyTxChainIDs    []int               // 强制使用 legacy（gasPrice）交易的链，最新区块没有 baseFee 的链自动识别
+	RateLimit           RateLimit           // 每个用户发起提现的频率限制
+	Batches             []BatchConfig       // 批量提现配置，未配置的链每笔提现单独发送交易
+	QueueOnGasSpike     bool                // gas 熔断期间已批准的提现排队，baseFee 回落后处理；否则照常发送
+	ContractAllowlist   ContractAllowlist   // 允许提现的 EVM 合约地址，其他合约地址拒绝提现
+	InternalAddressMode string              // 提现到平台其他用户地址时的处理方式：reject 或 transfer
+	FrozenCredits       FrozenCreditsConfig // 父提现失败或停滞的冻结资金的释放和告警
+	RequestExpiry       time.Duration       // 等待审核的提现请求的默认过期时间，过期后自动拒绝（0 表示不过期，管理员设置的过期时间仍然生效）
+	FeeAccountUserID    string              // 接收提现手续费的平台手续费账户，收取手续费的提现必须配置
+}
+
+// BatchConfig 批量提现配置：同一代币的多笔已批准提现通过 Disperse 合约合并为一笔交易
+type BatchConfig struct {
+	ChainID         int
+	ContractAddress string // Disperse 兼容合约（disperseEther / disperseToken）
+	MaxSize         int    // 每笔交易最多包含的提现数
+}
+
+// RateLimit 提现频率限制：每个用户在 Window 内最多发起 MaxRequests 笔提现（MaxRequests 为 0 表示不限制）
+type RateLimit struct {
+	MaxRequests int
+	Window      time.Duration
+}
+
+// ApprovalThreshold 审批阈值：提现金额 >= MinAmount 时需要 RequiredApprovals 名不同管理员批准
+type ApprovalThreshold struct {
+	TokenID           int
+	MinAmount         *big.Float // 人类可读单位
+	RequiredApprovals int
 }
@@ -0,0 +1,370 @@
+package withdraw
+
+import (
+	"context"
+	"time"
+
+	"github/chapool/go-wallet/internal/models"
+	"github/chapool/go-wallet/internal/util/lifecycle"
+	"github/chapool/go-wallet/internal/wallet/gasguard"
+	"github/chapool/go-wallet/internal/wallet/scan"
+	"github/chapool/go-wallet/internal/wallet/settings"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const hoursPerDay = 24
+
+// ProcessingWindow 提现处理窗口：命中的提现获得足够批准后不立即处理，而是在每天固定时间（UTC）批量处理
+type ProcessingWindow struct {
+	ChainID int             // 按链配置（TokenID 为 0）
+	TokenID int             // 按代币配置，优先于按链配置
+	Times   []time.Duration // 每天的处理时间（距 UTC 零点的偏移）
+}
+
+// NextWindow 返回不早于 t 的最近一个处理时间
+func (w *ProcessingWindow) NextWindow(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	var next time.Time
+	for _, offset := range w.Times {
+		candidate := day.Add(offset)
+		if candidate.Before(t) {
+			candidate = candidate.Add(hoursPerDay * time.Hour)
+		}
+		if next.IsZero() || candidate.Before(next) {
+			next = candidate
+		}
+	}
+
+	return next
+}
+
+// FlushFilter 立即处理排队提现的筛选条件（为空表示不限）
+type FlushFilter struct {
+	ChainID *int
+	TokenID *int
+}
+
+// FlushResult 立即处理排队提现的结果
+type FlushResult struct {
+	Processed []string          // 已签名并广播的提现 ID
+	Failed    map[string]string // 处理失败的提现 ID -> 错误信息（提现已标记为 failed）
+	Deferred  []string          // gas 价格超过链的上限、按重试计划推迟的提现 ID
+}
+
+// queuedWithdraw 已获得足够批准、等待处理窗口或批量发送的提现
+type queuedWithdraw struct {
+	withdraw   *models.Withdraw
+	window     *ProcessingWindow // 只等待批量发送时为空
+	approvedAt time.Time         // 达到所需批准数的时间
+}
+
+// processingWindow 获取提现适用的处理窗口，代币窗口优先于链窗口，未配置时返回 nil
+func (s *service) processingWindow(chainID int, tokenID int) *ProcessingWindow {
+	var chainWindow *ProcessingWindow
+	for i := range s.config.ProcessingWindows {
+		window := &s.config.ProcessingWindows[i]
+		if window.TokenID != 0 && window.TokenID == tokenID {
+			return window
+		}
+		if window.TokenID == 0 && window.ChainID == chainID {
+			chainWindow = window
+		}
+	}
+
+	return chainWindow
+}
+
+// GetProcessingETA 获取等待处理的提现预计处理时间（下一个处理窗口），不受处理窗口限制时返回 nil
+func (s *service) GetProcessingETA(withdraw *models.Withdraw) *time.Time {
+	if withdraw.Status != models.WithdrawStatusUserWithdrawRequest {
+		return nil
+	}
+
+	window := s.processingWindow(withdraw.ChainID, withdraw.TokenID)
+	if window == nil {
+		return nil
+	}
+
+	eta := window.NextWindow(time.Now())
+	return &eta
+}
+
+// StartWindowProcessor 启动处理窗口调度：到达处理窗口时批量处理已获得足够批准的排队提现，
+// 配置了批量提现的链每次调度将同一代币的已批准提现合并为一笔交易，维护期间排队的提现在维护结束后处理
+func (s *service) StartWindowProcessor(ctx context.Context, interval time.Duration) {
+	if len(s.config.ProcessingWindows) == 0 && len(s.config.Batches) == 0 && !s.config.QueueOnGasSpike && s.settingsService == nil &&
+		s.gasPriceCap == nil {
+		log.Info().Msg("No withdraw processing windows or batches configured, withdraws are processed right after approval")
+		return
+	}
+
+	log.Info().
+		Dur("interval", interval).
+		Int("windows", len(s.config.ProcessingWindows)).
+		Int("batches", len(s.config.Batches)).
+		Bool("queue_on_gas_spike", s.config.QueueOnGasSpike).
+		Bool("maintenance", s.settingsService != nil).
+		Bool("gas_price_cap", s.gasPriceCap != nil).
+		Msg("Starting withdraw processing window scheduler")
+
+	lifecycle.Go(ctx, "withdraw window processor", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Withdraw processing window scheduler stopped")
+				return
+			case <-ticker.C:
+				if _, err := s.processQueuedWithdraws(ctx, &FlushFilter{}, false); err != nil {
+					log.Error().Err(err).Msg("Withdraw processing window scheduler failed")
+				}
+			}
+		}
+	})
+}
+
+// FlushWithdraws 管理员操作：忽略处理窗口、gas 熔断和 gas 价格上限的重试计划，立即处理所有已获得足够批准的排队提现（处于维护模式的链除外）
+func (s *service) FlushWithdraws(ctx context.Context, filter *FlushFilter) (*FlushResult, error) {
+	return s.processQueuedWithdraws(ctx, filter, true)
+}
+
+// processQueuedWithdraws 处理排队提现；ignoreWindow 为 false 时只处理批准后已到达处理窗口、所在链未处于 gas 熔断、
+// 且已到达 gas 价格上限重试时间的提现，所在链处于维护模式的提现始终排队
+// 配置了批量提现的链上同一代币的提现合并为一笔交易，批量交易失败时所有成员提现标记为 failed
+// 调度器与管理员立即处理互斥执行，避免同一笔提现被重复处理后误标记为 failed
+func (s *service) processQueuedWithdraws(ctx context.Context, filter *FlushFilter, ignoreWindow bool) (*FlushResult, error) {
+	s.queueMu.Lock()
+	defer s.queueMu.Unlock()
+
+	queued, err := s.getQueuedWithdraws(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	maintenances, err := s.activeMaintenances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FlushResult{
+		Processed: make([]string, 0),
+		Failed:    make(map[string]string),
+		Deferred:  make([]string, 0),
+	}
+
+	now := time.Now()
+	due := make([]*models.Withdraw, 0, len(queued))
+	for _, q := range queued {
+		if !ignoreWindow && q.window != nil && q.window.NextWindow(q.approvedAt).After(now) {
+			continue
+		}
+		if !ignoreWindow && s.gasSpikeQueued(q.withdraw.ChainID) {
+			continue
+		}
+		if !ignoreWindow && q.withdraw.GasDeferredUntil.Valid && q.withdraw.GasDeferredUntil.Time.After(now) {
+			continue
+		}
+		if maintenances.For(q.withdraw.ChainID) != nil {
+			continue
+		}
+		due = append(due, q.withdraw)
+	}
+
+	orgs, err := s.userOrgs(ctx, due)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range s.groupWithdraws(due, orgs) {
+		// 停机时不再处理新的提现组，剩余提现保持排队状态，下次启动后继续处理
+		if ctx.Err() != nil {
+			break
+		}
+
+		// 已开始的签名、广播和落库不被停机信号打断
+		groupCtx, cancel := lifecycle.InFlight(ctx)
+		s.processWithdrawGroup(groupCtx, group, result)
+		cancel()
+	}
+
+	if len(result.Processed) > 0 || len(result.Failed) > 0 || len(result.Deferred) > 0 {
+		log.Info().
+			Bool("flush", ignoreWindow).
+			Int("processed", len(result.Processed)).
+			Int("failed", len(result.Failed)).
+			Int("deferred", len(result.Deferred)).
+			Msg("Processed queued withdraws")
+	}
+
+	return result, nil
+}
+
+// processWithdrawGroup 处理一组提现：单笔提现直接发送，同一批次的多笔提现合并发送；
+// gas 价格超过链的上限时提现保持排队，按重试计划推迟
+func (s *service) processWithdrawGroup(ctx context.Context, group *withdrawGroup, result *FlushResult) {
+	if len(group.withdraws) == 1 {
+		withdrawID := group.withdraws[0].ID
+		if err := s.ProcessWithdraw(ctx, withdrawID); err != nil {
+			if gasguard.IsCapExceeded(err) {
+				s.deferWithdraw(ctx, withdrawID, err)
+				result.Deferred = append(result.Deferred, withdrawID)
+				return
+			}
+			s.updateWithdrawStatusOnError(ctx, withdrawID, err)
+			result.Failed[withdrawID] = err.Error()
+			return
+		}
+		result.Processed = append(result.Processed, withdrawID)
+		return
+	}
+
+	withdrawIDs := withdrawIDsOf(group.withdraws)
+	if _, err := s.processBatch(ctx, group.batch, withdrawIDs); err != nil {
+		for _, withdrawID := range withdrawIDs {
+			if gasguard.IsCapExceeded(err) {
+				s.deferWithdraw(ctx, withdrawID, err)
+				result.Deferred = append(result.Deferred, withdrawID)
+				continue
+			}
+			s.updateWithdrawStatusOnError(ctx, withdrawID, err)
+			result.Failed[withdrawID] = err.Error()
+		}
+		return
+	}
+	result.Processed = append(result.Processed, withdrawIDs...)
+}
+
+// getQueuedWithdraws 获取受处理窗口限制、等待批量发送、gas 熔断或维护期间排队、因 gas 价格超过上限推迟、
+// 已获得足够批准但尚未处理的提现（按创建时间正序）
+// 维护模式可随时对任意链开启，启用了系统设置服务时所有已获得足够批准但尚未处理的提现都是候选
+func (s *service) getQueuedWithdraws(ctx context.Context, filter *FlushFilter) ([]*queuedWithdraw, error) {
+	mods := []qm.QueryMod{
+		models.WithdrawWhere.Status.EQ(models.WithdrawStatusUserWithdrawRequest),
+		qm.OrderBy(models.WithdrawColumns.CreatedAt + " ASC"),
+	}
+	if filter.ChainID != nil {
+		mods = append(mods, models.WithdrawWhere.ChainID.EQ(*filter.ChainID))
+	}
+	if filter.TokenID != nil {
+		mods = append(mods, models.WithdrawWhere.TokenID.EQ(*filter.TokenID))
+	}
+
+	withdraws, err := models.Withdraws(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get queued withdraws")
+	}
+
+	candidates := make([]*models.Withdraw, 0, len(withdraws))
+	withdrawIDs := make([]string, 0, len(withdraws))
+	for _, withdraw := range withdraws {
+		if s.processingWindow(withdraw.ChainID, withdraw.TokenID) == nil && s.batchConfig(withdraw.ChainID) == nil &&
+			!s.gasSpikeGuarded(withdraw.ChainID) && s.settingsService == nil && !withdraw.GasDeferredUntil.Valid {
+			continue
+		}
+		candidates = append(candidates, withdraw)
+		withdrawIDs = append(withdrawIDs, withdraw.ID)
+	}
+
+	approvals, err := s.getApprovals(ctx, withdrawIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	queued := make([]*queuedWithdraw, 0, len(candidates))
+	for _, withdraw := range candidates {
+		required, err := s.requiredApprovals(withdraw.TokenID, withdraw.Amount)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get required approvals for withdraw_id=%s", withdraw.ID)
+		}
+
+		// 审批记录按时间正序，第 required 个批准的时间即提现进入队列的时间
+		approved := 0
+		for _, approval := range approvals[withdraw.ID] {
+			if approval.Decision != ApprovalDecisionApproved {
+				continue
+			}
+			approved++
+			if approved == required {
+				queued = append(queued, &queuedWithdraw{
+					withdraw:   withdraw,
+					window:     s.processingWindow(withdraw.ChainID, withdraw.TokenID),
+					approvedAt: approval.CreatedAt,
+				})
+				break
+			}
+		}
+	}
+
+	return queued, nil
+}
+
+// gasSpikeGuarded 链的已批准提现是否可能因 gas 熔断排队
+func (s *service) gasSpikeGuarded(chainID int) bool {
+	return s.config.QueueOnGasSpike && s.gasGuard != nil && s.gasGuard.Guards(chainID)
+}
+
+// gasSpikeQueued 链当前是否处于 gas 熔断且已批准的提现需要排队
+func (s *service) gasSpikeQueued(chainID int) bool {
+	return s.gasSpikeGuarded(chainID) && s.gasGuard.IsPaused(chainID)
+}
+
+// activeMaintenances 获取当前生效的维护模式，未启用系统设置服务时返回 nil（不处于维护）
+func (s *service) activeMaintenances(ctx context.Context) (*settings.ActiveMaintenances, error) {
+	if s.settingsService == nil {
+		return nil, nil
+	}
+
+	maintenances, err := s.settingsService.GetActiveMaintenances(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get maintenance settings")
+	}
+
+	return maintenances, nil
+}
+
+// checkGasPriceCap 检查交易 gas 价格是否超过链的上限，未启用 gas 价格上限时不检查
+func (s *service) checkGasPriceCap(ctx context.Context, chainID int, fees *scan.GasFees) error {
+	if s.gasPriceCap == nil {
+		return nil
+	}
+
+	return s.gasPriceCap.Check(ctx, chainID, fees) //nolint:wrapcheck // *gasguard.CapExceededError is checked by the callers
+}
+
+// deferWithdraw gas 价格超过链的上限时推迟提现：提现保持排队，按重试计划记录下次尝试时间，连续推迟时逐步拉长间隔
+func (s *service) deferWithdraw(ctx context.Context, withdrawID string, capErr error) {
+	withdrawRecord, err := models.Withdraws(models.WithdrawWhere.ID.EQ(withdrawID)).One(ctx, s.db)
+	if err != nil {
+		log.Error().Err(err).Str("withdraw_id", withdrawID).Msg("Failed to get withdraw record for gas price deferral")
+		return
+	}
+
+	withdrawRecord.GasDeferCount++
+	retryAt := s.gasPriceCap.RetryAt(withdrawRecord.GasDeferCount, time.Now())
+	withdrawRecord.GasDeferredUntil = null.TimeFrom(retryAt)
+	if _, err := withdrawRecord.Update(ctx, s.db, boil.Whitelist(
+		models.WithdrawColumns.GasDeferCount,
+		models.WithdrawColumns.GasDeferredUntil,
+		models.WithdrawColumns.UpdatedAt,
+	)); err != nil {
+		log.Error().Err(err).Str("withdraw_id", withdrawID).Msg("Failed to record gas price deferral of withdraw")
+		return
+	}
+
+	log.Warn().
+		Str("withdraw_id", withdrawID).
+		Int("chain_id", withdrawRecord.ChainID).
+		Int("deferrals", withdrawRecord.GasDeferCount).
+		Time("retry_at", retryAt).
+		Str("reason", capErr.Error()).
+		Msg("Withdraw deferred, gas price exceeds the cap of the chain")
+}
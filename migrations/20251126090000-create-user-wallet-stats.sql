@@ -0,0 +1,65 @@
+-- +migrate Up
+-- Create user_wallet_stats table (用户钱包统计汇总表)
+-- 充值入账、提现确认时增量累加，避免每次查询都对 credits 做 SUM
+CREATE TABLE user_wallet_stats (
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    token_id integer NOT NULL REFERENCES tokens (id) ON DELETE RESTRICT,
+    total_deposited numeric NOT NULL DEFAULT 0, -- 累计充值金额
+    deposit_count bigint NOT NULL DEFAULT 0, -- 累计充值笔数
+    total_withdrawn numeric NOT NULL DEFAULT 0, -- 累计提现金额（已确认）
+    withdraw_count bigint NOT NULL DEFAULT 0, -- 累计提现笔数（已确认）
+    first_activity_at timestamptz, -- 首次充值/提现时间
+    last_activity_at timestamptz, -- 最近一次充值/提现时间
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, token_id)
+);
+
+CREATE INDEX idx_user_wallet_stats_token_id ON user_wallet_stats (token_id);
+
+-- 根据已有数据回填统计，金额统一为代币单位（充值 credits 为最小单位，按代币精度换算）
+INSERT INTO user_wallet_stats (user_id, token_id, total_deposited, deposit_count, total_withdrawn, withdraw_count, first_activity_at, last_activity_at)
+SELECT
+    user_id,
+    token_id,
+    SUM(deposited),
+    SUM(deposit_count),
+    SUM(withdrawn),
+    SUM(withdraw_count),
+    MIN(activity_at),
+    MAX(activity_at)
+FROM (
+    SELECT
+        c.user_id,
+        c.token_id,
+        c.amount::numeric * POWER(10::numeric, -t.decimals) AS deposited,
+        1 AS deposit_count,
+        0 AS withdrawn,
+        0 AS withdraw_count,
+        c.created_at AS activity_at
+    FROM
+        credits c
+        JOIN tokens t ON t.id = c.token_id
+    WHERE
+        c.credit_type = 'deposit'
+        AND c.status = 'finalized'
+    UNION ALL
+    SELECT
+        user_id,
+        token_id,
+        0,
+        0,
+        amount::numeric,
+        1,
+        updated_at
+    FROM
+        withdraws
+    WHERE
+        status = 'confirmed') activity
+GROUP BY
+    user_id,
+    token_id;
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_wallet_stats;
+
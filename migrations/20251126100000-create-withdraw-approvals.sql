@@ -0,0 +1,19 @@
+-- +migrate Up
+-- Create withdraw_approvals table (提现审批记录表)
+-- 大额提现需要多名管理员批准，每名管理员对同一笔提现只能审批一次；同时作为审批审计记录
+CREATE TABLE withdraw_approvals (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    withdraw_id uuid NOT NULL REFERENCES withdraws (id) ON DELETE CASCADE,
+    admin_user_id uuid NOT NULL REFERENCES users (id) ON DELETE RESTRICT, -- 审批的管理员
+    decision varchar(20) NOT NULL, -- 审批结果：approved, rejected
+    reason text, -- 拒绝原因（可选）
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT withdraw_approvals_decision_check CHECK (decision IN ('approved', 'rejected')),
+    CONSTRAINT withdraw_approvals_withdraw_admin_key UNIQUE (withdraw_id, admin_user_id)
+);
+
+CREATE INDEX idx_withdraw_approvals_admin_user_id ON withdraw_approvals (admin_user_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS withdraw_approvals;
+
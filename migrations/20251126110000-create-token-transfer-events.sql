@@ -0,0 +1,27 @@
+-- +migrate Up
+-- Create token_transfer_events table (非标准代币的自定义转账事件配置表)
+-- 部分代币不触发标准 Transfer 事件，而是触发自定义事件；扫描时按此配置解析事件中的 from/to/amount
+-- 配置了自定义事件的代币只按自定义事件解析，不再解析标准 Transfer 事件
+CREATE TABLE token_transfer_events (
+    id serial PRIMARY KEY,
+    token_id integer NOT NULL REFERENCES tokens (id) ON DELETE CASCADE,
+    event_topic varchar(66) NOT NULL, -- 事件签名哈希（topics[0]），0x 开头的小写十六进制
+    event_signature varchar(255), -- 事件签名（仅用于说明），如 Sent(address,address,uint256,bytes)
+    from_source varchar(10) NOT NULL, -- from 地址所在位置：topic, data
+    from_index integer NOT NULL, -- topic 时为 topics 下标，data 时为 data 中 32 字节字的下标
+    to_source varchar(10) NOT NULL, -- to 地址所在位置：topic, data
+    to_index integer NOT NULL,
+    amount_source varchar(10) NOT NULL, -- 金额所在位置：topic, data
+    amount_index integer NOT NULL,
+    is_active boolean NOT NULL DEFAULT TRUE, -- 是否启用
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT token_transfer_events_token_topic_key UNIQUE (token_id, event_topic),
+    CONSTRAINT token_transfer_events_from_check CHECK ((from_source = 'topic' AND from_index >= 1) OR (from_source = 'data' AND from_index >= 0)),
+    CONSTRAINT token_transfer_events_to_check CHECK ((to_source = 'topic' AND to_index >= 1) OR (to_source = 'data' AND to_index >= 0)),
+    CONSTRAINT token_transfer_events_amount_check CHECK ((amount_source = 'topic' AND amount_index >= 1) OR (amount_source = 'data' AND amount_index >= 0))
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS token_transfer_events;
+
@@ -0,0 +1,35 @@
+-- +migrate Up
+-- Create dust_consolidation_consents table (小额余额归集授权表)
+-- 用户主动授权后，低于代币最小提现金额的余额才会被定时归集到归集账户
+CREATE TABLE dust_consolidation_consents (
+    user_id uuid PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    consented_at timestamptz NOT NULL, -- 最近一次授权时间
+    revoked_at timestamptz, -- 撤销授权时间（为空表示授权有效）
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+-- Create dust_consolidations table (小额余额归集记录表)
+-- 每条记录对应一对 credits：用户扣减（负数）与归集账户入账（正数），reference_id 均为本表 id
+CREATE TABLE dust_consolidations (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE, -- 被归集的用户
+    account_user_id uuid NOT NULL REFERENCES users (id) ON DELETE RESTRICT, -- 归集账户
+    token_id integer NOT NULL REFERENCES tokens (id) ON DELETE RESTRICT,
+    chain_id integer NOT NULL,
+    amount text NOT NULL, -- 归集金额（人类可读单位）
+    threshold text NOT NULL, -- 归集时使用的阈值（代币最小提现金额）
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_dust_consolidations_user_id ON dust_consolidations (user_id);
+
+CREATE INDEX idx_dust_consolidations_token_id ON dust_consolidations (token_id);
+
+CREATE INDEX idx_dust_consolidations_created_at ON dust_consolidations (created_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS dust_consolidations;
+
+DROP TABLE IF EXISTS dust_consolidation_consents;
+
@@ -0,0 +1,24 @@
+-- +migrate Up
+-- Create backfill_jobs table (历史区块补扫任务表)
+-- 记录补扫区间和检查点（next_block），进程崩溃或中断后可从检查点继续
+CREATE TABLE backfill_jobs (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    chain_id integer NOT NULL, -- 链ID
+    from_block bigint NOT NULL,
+    to_block bigint NOT NULL,
+    next_block bigint NOT NULL, -- 检查点：下一个待扫描的区块号
+    status varchar(20) NOT NULL DEFAULT 'pending', -- pending, running, completed, failed, cancelled
+    error text, -- 最近一次失败原因
+    created_by uuid REFERENCES users (id) ON DELETE SET NULL, -- 创建任务的管理员（命令行创建时为空）
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(), -- 运行中的任务在每个检查点刷新，用于判断任务是否仍在执行
+    completed_at timestamptz,
+    CONSTRAINT backfill_jobs_status_check CHECK (status IN ('pending', 'running', 'completed', 'failed', 'cancelled')),
+    CONSTRAINT backfill_jobs_range_check CHECK (from_block >= 0 AND from_block <= to_block),
+    CONSTRAINT backfill_jobs_next_block_check CHECK (next_block >= from_block AND next_block <= to_block + 1)
+);
+
+CREATE INDEX idx_backfill_jobs_chain_id_status ON backfill_jobs (chain_id, status);
+
+-- +migrate Down
+DROP TABLE IF EXISTS backfill_jobs;
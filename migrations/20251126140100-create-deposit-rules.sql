@@ -0,0 +1,37 @@
+-- +migrate Up
+-- Create deposit_rules table (充值入账规则表)
+-- 生成充值 Credits 时按 priority 升序评估所有启用的规则，条件均为可选且同时满足时命中
+CREATE TABLE deposit_rules (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    name varchar(100) NOT NULL,
+    description text,
+    priority integer NOT NULL DEFAULT 100, -- 数值越小越先评估
+    is_active boolean NOT NULL DEFAULT TRUE,
+    stop_processing boolean NOT NULL DEFAULT FALSE, -- 命中后不再评估后续规则
+    -- 匹配条件（为空表示不限）
+    chain_id integer, -- 链ID
+    token_id integer REFERENCES tokens (id) ON DELETE CASCADE,
+    user_id uuid REFERENCES users (id) ON DELETE CASCADE, -- 充值用户
+    from_addresses text[], -- 发送方地址（小写）
+    min_amount varchar(78), -- 最小金额（含，人类可读单位）
+    max_amount varchar(78), -- 最大金额（含，人类可读单位）
+    sender_is_contract boolean, -- 发送方是否为合约
+    -- 命中后的动作
+    action varchar(20) NOT NULL, -- tag, route_fee, reject
+    tag varchar(100), -- action = tag：写入 credits.metadata 的标签
+    fee_account_user_id uuid REFERENCES users (id) ON DELETE RESTRICT, -- action = route_fee：接收手续费的账户
+    fee_percent varchar(20), -- action = route_fee：手续费比例（百分比）
+    created_by uuid REFERENCES users (id) ON DELETE SET NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT deposit_rules_action_check CHECK (action IN ('tag', 'route_fee', 'reject')),
+    CONSTRAINT deposit_rules_tag_check CHECK (action <> 'tag' OR tag IS NOT NULL),
+    CONSTRAINT deposit_rules_route_fee_check CHECK (action <> 'route_fee' OR (fee_account_user_id IS NOT NULL AND fee_percent IS NOT NULL))
+);
+
+CREATE INDEX idx_deposit_rules_priority ON deposit_rules (priority, created_at)
+WHERE
+    is_active;
+
+-- +migrate Down
+DROP TABLE IF EXISTS deposit_rules;
@@ -0,0 +1,7 @@
+-- +migrate Up notransaction
+-- Add withdraw fee to credit_type enum
+-- ALTER TYPE ... ADD VALUE 不能与使用新值的语句在同一事务中，因此单独一个迁移且不使用事务
+ALTER TYPE credit_type ADD VALUE IF NOT EXISTS 'withdraw_fee';
+
+-- +migrate Down
+-- PostgreSQL 不支持删除枚举值，回滚时保留
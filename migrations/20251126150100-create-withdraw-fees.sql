@@ -0,0 +1,22 @@
+-- +migrate Up
+-- Create withdraw_fees table (提现手续费策略表)
+-- 每个代币一条策略，未配置或未启用时不收取手续费
+CREATE TABLE withdraw_fees (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    token_id integer NOT NULL REFERENCES tokens (id) ON DELETE CASCADE,
+    fee_type varchar(20) NOT NULL, -- flat, percentage, gas
+    flat_amount varchar(78), -- fee_type = flat：固定手续费（人类可读单位）
+    fee_percent varchar(20), -- fee_type = percentage：手续费比例（百分比）
+    min_fee varchar(78), -- 手续费下限（人类可读单位，可选）
+    max_fee varchar(78), -- 手续费上限（人类可读单位，可选）
+    is_active boolean NOT NULL DEFAULT TRUE,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT withdraw_fees_token_id_key UNIQUE (token_id),
+    CONSTRAINT withdraw_fees_fee_type_check CHECK (fee_type IN ('flat', 'percentage', 'gas')),
+    CONSTRAINT withdraw_fees_flat_check CHECK (fee_type <> 'flat' OR flat_amount IS NOT NULL),
+    CONSTRAINT withdraw_fees_percentage_check CHECK (fee_type <> 'percentage' OR fee_percent IS NOT NULL)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS withdraw_fees;
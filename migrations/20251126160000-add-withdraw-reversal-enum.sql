@@ -0,0 +1,8 @@
+-- +migrate Up notransaction
+-- Add withdraw reversal to credit_type enum
+-- 拒绝提现时写入与原 credits 金额相反的冲正记录，原记录保持不变
+-- ALTER TYPE ... ADD VALUE 不能与使用新值的语句在同一事务中，因此单独一个迁移且不使用事务
+ALTER TYPE credit_type ADD VALUE IF NOT EXISTS 'withdraw_reversal';
+
+-- +migrate Down
+-- PostgreSQL 不支持删除枚举值，回滚时保留
@@ -0,0 +1,24 @@
+-- +migrate Up
+-- Create security_notification_settings table (用户安全通知设置表)
+-- 未配置的用户接收所有安全通知（大额提现通知需要设置个人阈值）
+CREATE TABLE security_notification_settings (
+    user_id uuid PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    disabled_events text[] NOT NULL DEFAULT '{}', -- 用户关闭的通知事件：large_withdraw, new_withdraw_address, whitelist_changed
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+-- Create security_withdraw_thresholds table (大额提现通知个人阈值表)
+-- 提现金额 >= min_amount 时通知用户
+CREATE TABLE security_withdraw_thresholds (
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    token_id integer NOT NULL REFERENCES tokens (id) ON DELETE CASCADE,
+    min_amount varchar(78) NOT NULL, -- 人类可读单位
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, token_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS security_withdraw_thresholds;
+
+DROP TABLE IF EXISTS security_notification_settings;
@@ -0,0 +1,7 @@
+-- +migrate Up
+-- 确认状态处理器每轮只更新尚未终结的交易，部分索引避免随已终结交易数量增长而扫描全部记录
+CREATE INDEX idx_transactions_unfinalized ON transactions (chain_id, block_no)
+WHERE status IN ('confirmed', 'safe');
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_transactions_unfinalized;
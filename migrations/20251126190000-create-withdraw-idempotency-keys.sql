@@ -0,0 +1,19 @@
+-- +migrate Up
+-- Create withdraw_idempotency_keys table (提现幂等键表)
+-- 同一用户使用相同 Idempotency-Key 的重复提交返回原提现，唯一约束保证并发重复提交只冻结一次资金
+CREATE TABLE withdraw_idempotency_keys (
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    idempotency_key varchar(255) NOT NULL,
+    withdraw_id uuid NOT NULL REFERENCES withdraws (id) ON DELETE CASCADE,
+    request_hash varchar(64) NOT NULL, -- 提现参数摘要（to_address、token_id、amount），相同幂等键参数不同时拒绝
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, idempotency_key)
+);
+
+-- 频率限制按用户统计最近创建的提现
+CREATE INDEX idx_withdraws_user_created_at ON withdraws (user_id, created_at);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_withdraws_user_created_at;
+
+DROP TABLE IF EXISTS withdraw_idempotency_keys;
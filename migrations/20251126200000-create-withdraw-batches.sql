@@ -0,0 +1,30 @@
+-- +migrate Up
+-- Create withdraw_batches table (批量提现表)
+-- 同一代币的多笔已批准提现通过 Disperse 合约合并为一笔交易，成员提现记录相同的 tx_hash
+CREATE TABLE withdraw_batches (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    chain_id integer NOT NULL,
+    token_id integer NOT NULL REFERENCES tokens (id) ON DELETE RESTRICT,
+    contract_address varchar(255) NOT NULL, -- Disperse 合约地址
+    tx_hash varchar(255) NOT NULL,
+    withdraw_count integer NOT NULL,
+    total_amount text NOT NULL, -- 总金额（最小单位）
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_withdraw_batches_tx_hash ON withdraw_batches (chain_id, tx_hash);
+
+-- Create withdraw_batch_items table (批量提现成员表)
+CREATE TABLE withdraw_batch_items (
+    batch_id uuid NOT NULL REFERENCES withdraw_batches (id) ON DELETE CASCADE,
+    withdraw_id uuid NOT NULL REFERENCES withdraws (id) ON DELETE CASCADE,
+    position integer NOT NULL, -- 在合约调用参数中的位置
+    PRIMARY KEY (batch_id, withdraw_id)
+);
+
+CREATE INDEX idx_withdraw_batch_items_withdraw_id ON withdraw_batch_items (withdraw_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS withdraw_batch_items;
+
+DROP TABLE IF EXISTS withdraw_batches;
@@ -0,0 +1,44 @@
+-- +migrate Up
+-- credits 状态变更历史，供内部接口按链上引用（tx_hash + event_index）查询入账详情
+CREATE TABLE credit_status_history (
+    id bigserial PRIMARY KEY,
+    credit_id uuid NOT NULL REFERENCES credits (id) ON DELETE CASCADE,
+    from_status credit_status, -- 变更前状态，创建时为空
+    to_status credit_status NOT NULL, -- 变更后状态
+    changed_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_credit_status_history_credit_id ON credit_status_history (credit_id, changed_at);
+
+-- 状态更新分散在充值、提现、归集等多个模块，使用触发器统一记录
+-- +migrate StatementBegin
+CREATE FUNCTION record_credit_status_change () RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO credit_status_history (credit_id, from_status, to_status)
+        VALUES (NEW.id, NULL, NEW.status);
+    ELSIF NEW.status IS DISTINCT FROM OLD.status THEN
+        INSERT INTO credit_status_history (credit_id, from_status, to_status)
+        VALUES (NEW.id, OLD.status, NEW.status);
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+CREATE TRIGGER credits_status_history
+AFTER INSERT OR UPDATE OF status ON credits
+FOR EACH ROW EXECUTE FUNCTION record_credit_status_change ();
+
+-- 已有记录无法还原历史，以当前状态作为初始记录
+INSERT INTO credit_status_history (credit_id, from_status, to_status, changed_at)
+SELECT id, NULL, status, updated_at FROM credits;
+
+-- 按链上引用查询（tx_hash 不区分大小写）
+CREATE INDEX idx_credits_chain_tx_hash_lower ON credits (chain_id, lower(tx_hash), event_index);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_credits_chain_tx_hash_lower;
+DROP TRIGGER IF EXISTS credits_status_history ON credits;
+DROP FUNCTION IF EXISTS record_credit_status_change ();
+DROP TABLE IF EXISTS credit_status_history;
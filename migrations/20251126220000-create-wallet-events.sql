@@ -0,0 +1,86 @@
+-- +migrate Up
+-- 钱包领域事件发件箱（transactional outbox），由发布器推送到 Kafka/NATS 供数据仓库使用
+-- 事件与业务数据在同一事务中写入，发布器按 id 顺序投递（至少一次，消费方按 id 去重）
+CREATE TABLE wallet_events (
+    id bigserial PRIMARY KEY,
+    event_type varchar(100) NOT NULL, -- 事件类型，如 'deposit.created', 'withdraw.status_changed', 'reorg.block_orphaned'
+    schema_version integer NOT NULL, -- 事件结构版本，payload 字段不兼容变更时递增
+    aggregate_id text NOT NULL, -- 聚合 ID（交易/流水/提现 ID，区块为 hash），作为 Kafka 消息 key 保证同一聚合有序
+    chain_id integer,
+    payload jsonb NOT NULL, -- 事件数据（记录当前行，状态变更时附带 previous_status）
+    occurred_at timestamptz NOT NULL DEFAULT NOW(),
+    published_at timestamptz, -- 发布时间，未发布为空
+    publish_attempts integer NOT NULL DEFAULT 0,
+    last_error text
+);
+
+CREATE INDEX idx_wallet_events_unpublished ON wallet_events (id) WHERE published_at IS NULL;
+
+CREATE INDEX idx_wallet_events_occurred_at ON wallet_events (occurred_at);
+
+-- 事件来源分散在扫描、充值、提现、归集等多个模块，使用触发器统一记录
+-- transactions 只记录充值（deposit）和归集（sweep），提现以 withdraws 表为准
+-- +migrate StatementBegin
+CREATE FUNCTION record_wallet_event () RETURNS TRIGGER AS $$
+DECLARE
+    entity text;
+    payload jsonb;
+BEGIN
+    IF TG_TABLE_NAME = 'blocks' THEN
+        IF NEW.status = 'orphaned' AND OLD.status IS DISTINCT FROM 'orphaned' THEN
+            INSERT INTO wallet_events (event_type, schema_version, aggregate_id, chain_id, payload)
+            VALUES ('reorg.block_orphaned', 1, NEW.hash, NEW.chain_id,
+                to_jsonb(NEW) || jsonb_build_object('previous_status', OLD.status));
+        END IF;
+        RETURN NEW;
+    END IF;
+
+    IF TG_TABLE_NAME = 'transactions' THEN
+        CASE NEW.type
+            WHEN 'deposit' THEN entity := 'deposit';
+            WHEN 'collect' THEN entity := 'sweep';
+            ELSE RETURN NEW;
+        END CASE;
+    ELSIF TG_TABLE_NAME = 'credits' THEN
+        entity := 'credit';
+    ELSE
+        entity := 'withdraw';
+    END IF;
+
+    payload := to_jsonb(NEW);
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO wallet_events (event_type, schema_version, aggregate_id, chain_id, payload)
+        VALUES (entity || '.created', 1, NEW.id::text, NEW.chain_id, payload);
+    ELSIF NEW.status IS DISTINCT FROM OLD.status THEN
+        INSERT INTO wallet_events (event_type, schema_version, aggregate_id, chain_id, payload)
+        VALUES (entity || '.status_changed', 1, NEW.id::text, NEW.chain_id,
+            payload || jsonb_build_object('previous_status', OLD.status));
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+CREATE TRIGGER transactions_wallet_events
+AFTER INSERT OR UPDATE OF status ON transactions
+FOR EACH ROW EXECUTE FUNCTION record_wallet_event ();
+
+CREATE TRIGGER credits_wallet_events
+AFTER INSERT OR UPDATE OF status ON credits
+FOR EACH ROW EXECUTE FUNCTION record_wallet_event ();
+
+CREATE TRIGGER withdraws_wallet_events
+AFTER INSERT OR UPDATE OF status ON withdraws
+FOR EACH ROW EXECUTE FUNCTION record_wallet_event ();
+
+CREATE TRIGGER blocks_wallet_events
+AFTER UPDATE OF status ON blocks
+FOR EACH ROW EXECUTE FUNCTION record_wallet_event ();
+
+-- +migrate Down
+DROP TRIGGER IF EXISTS blocks_wallet_events ON blocks;
+DROP TRIGGER IF EXISTS withdraws_wallet_events ON withdraws;
+DROP TRIGGER IF EXISTS credits_wallet_events ON credits;
+DROP TRIGGER IF EXISTS transactions_wallet_events ON transactions;
+DROP FUNCTION IF EXISTS record_wallet_event ();
+DROP TABLE IF EXISTS wallet_events;
@@ -0,0 +1,41 @@
+-- +migrate Up
+-- 提现限额（按用户、代币、周期限制提现金额和笔数），user_id 为空表示所有用户的默认限额，用户限额优先
+CREATE TABLE withdraw_limits (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    user_id uuid REFERENCES users (id) ON DELETE CASCADE, -- 为空表示默认限额
+    token_id integer NOT NULL REFERENCES tokens (id) ON DELETE CASCADE,
+    period varchar(20) NOT NULL, -- 'daily'（滚动 24 小时）、'weekly'（滚动 7 天）
+    max_amount text, -- 周期内提现总额上限（人类可读单位，含本次提现），为空不限制
+    max_count integer, -- 周期内提现笔数上限（含本次提现），为空不限制
+    action varchar(20) NOT NULL, -- 超限时的处理：'reject' 拒绝提现，'review' 创建提现并标记人工复核
+    created_by uuid REFERENCES users (id) ON DELETE SET NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT withdraw_limits_period_check CHECK (period IN ('daily', 'weekly')),
+    CONSTRAINT withdraw_limits_action_check CHECK (action IN ('reject', 'review')),
+    CONSTRAINT withdraw_limits_bound_check CHECK (max_amount IS NOT NULL OR max_count IS NOT NULL)
+);
+
+-- 同一范围（用户/默认、代币、周期）每种处理方式一条，可以同时配置较低的复核限额和较高的拒绝限额
+CREATE UNIQUE INDEX withdraw_limits_scope_unique ON withdraw_limits (COALESCE(user_id, '00000000-0000-0000-0000-000000000000'::uuid), token_id, period, action);
+
+CREATE INDEX idx_withdraw_limits_token_id ON withdraw_limits (token_id);
+
+-- 超出复核限额的提现标记（审核人员在待审批列表中查看）
+CREATE TABLE withdraw_risk_flags (
+    id bigserial PRIMARY KEY,
+    withdraw_id uuid NOT NULL REFERENCES withdraws (id) ON DELETE CASCADE,
+    limit_id uuid REFERENCES withdraw_limits (id) ON DELETE SET NULL, -- 限额删除后保留标记
+    reason text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_withdraw_risk_flags_withdraw_id ON withdraw_risk_flags (withdraw_id);
+
+-- 统计用户在周期内的提现
+CREATE INDEX idx_withdraws_user_token_created_at ON withdraws (user_id, token_id, created_at);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_withdraws_user_token_created_at;
+DROP TABLE IF EXISTS withdraw_risk_flags;
+DROP TABLE IF EXISTS withdraw_limits;
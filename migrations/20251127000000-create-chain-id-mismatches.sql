@@ -0,0 +1,12 @@
+-- +migrate Up
+-- RPC 节点链 ID 校验失败记录：创建 RPC 客户端时 eth_chainId 与 chains.chain_id 不一致，该链停止服务
+-- 校验通过后删除，记录存在期间表示该链的 RPC 配置有误
+CREATE TABLE chain_id_mismatches (
+    chain_id integer PRIMARY KEY REFERENCES chains (chain_id) ON DELETE CASCADE,
+    rpc_endpoint integer NOT NULL, -- RPC 节点索引（chains.rpc_url 中的位置，不记录可能包含 API Key 的 URL）
+    reported_chain_id text NOT NULL, -- RPC 节点返回的链 ID
+    detected_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS chain_id_mismatches;
@@ -0,0 +1,24 @@
+-- +migrate Up
+-- 用户 API Token（程序化访问钱包接口），按 scope 限制可访问的接口，只保存 Token 的 SHA-256 哈希
+CREATE TABLE api_tokens (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name varchar(100) NOT NULL,
+    token_hash varchar(64) NOT NULL UNIQUE, -- Token 的 SHA-256（十六进制）
+    token_prefix varchar(20) NOT NULL, -- Token 前几位，用于展示和识别
+    scopes text[] NOT NULL, -- 'wallet:read' 查询余额/充值/提现，'wallet:withdraw' 发起提现
+    withdraw_token_id integer REFERENCES tokens (id) ON DELETE CASCADE, -- 只允许提现该代币，为空不限制
+    max_withdraw_amount text, -- 单笔提现金额上限（人类可读单位，需要指定 withdraw_token_id），为空不限制
+    expires_at timestamptz, -- 为空表示不过期
+    last_used_at timestamptz,
+    last_used_ip varchar(45),
+    revoked_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT api_tokens_scopes_check CHECK (cardinality(scopes) > 0 AND scopes <@ ARRAY['wallet:read', 'wallet:withdraw']::text[]),
+    CONSTRAINT api_tokens_max_withdraw_amount_check CHECK (max_withdraw_amount IS NULL OR withdraw_token_id IS NOT NULL)
+);
+
+CREATE INDEX idx_api_tokens_user_id ON api_tokens (user_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS api_tokens;
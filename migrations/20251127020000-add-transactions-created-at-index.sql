@@ -0,0 +1,11 @@
+-- +migrate Up
+-- 管理员交易列表按 (created_at, id) 倒序游标分页，每页只需从游标位置扫描索引
+CREATE INDEX idx_transactions_created_at_id ON transactions (created_at DESC, id DESC);
+
+-- 按地址筛选时同时匹配发送方和接收方（已有 (chain_id, to_addr) 索引）
+CREATE INDEX idx_transactions_from_addr ON transactions (chain_id, from_addr);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_transactions_from_addr;
+
+DROP INDEX IF EXISTS idx_transactions_created_at_id;
@@ -0,0 +1,47 @@
+-- +migrate Up
+-- 合规筛查名单：充值来源地址命中名单时资金进入隔离，不入账
+CREATE TABLE screening_addresses (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    chain_type varchar(50) NOT NULL,
+    address varchar(255) NOT NULL, -- EVM 地址小写存储，Solana 地址原样存储
+    source varchar(50) NOT NULL, -- 名单来源，如 'ofac'、'internal'
+    reason text,
+    created_by uuid REFERENCES users (id) ON DELETE SET NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT screening_addresses_chain_type_address_unique UNIQUE (chain_type, address)
+);
+
+-- 充值隔离案件：被标记的充值冻结在 credits 中，由合规人员释放入账、退回来源地址或升级处理
+CREATE TABLE deposit_quarantines (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    transaction_id uuid NOT NULL UNIQUE REFERENCES transactions (id) ON DELETE RESTRICT,
+    credit_id uuid NOT NULL UNIQUE REFERENCES credits (id) ON DELETE RESTRICT,
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    chain_id integer NOT NULL,
+    token_id integer NOT NULL REFERENCES tokens (id) ON DELETE RESTRICT,
+    from_address varchar(255) NOT NULL, -- 被标记的来源地址
+    to_address varchar(255) NOT NULL, -- 用户充值地址
+    amount text NOT NULL, -- 最小单位金额
+    tx_hash varchar(255) NOT NULL,
+    screening_source varchar(50) NOT NULL, -- 命中的名单来源
+    screening_reason text,
+    status varchar(20) NOT NULL DEFAULT 'quarantined', -- 'quarantined'、'escalated'、'released'、'returned'
+    resolution_note text,
+    resolved_by uuid REFERENCES users (id) ON DELETE SET NULL, -- 最近一次处理的管理员
+    return_tx_hash varchar(255), -- 退回来源地址的交易哈希（status = 'returned'）
+    escalated_at timestamptz,
+    resolved_at timestamptz, -- 释放或退回的时间
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT deposit_quarantines_status_check CHECK (status IN ('quarantined', 'escalated', 'released', 'returned')),
+    CONSTRAINT deposit_quarantines_return_tx_hash_check CHECK (status <> 'returned' OR return_tx_hash IS NOT NULL)
+);
+
+CREATE INDEX idx_deposit_quarantines_status_created_at ON deposit_quarantines (status, created_at DESC);
+
+CREATE INDEX idx_deposit_quarantines_user_id ON deposit_quarantines (user_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS deposit_quarantines;
+
+DROP TABLE IF EXISTS screening_addresses;
@@ -0,0 +1,24 @@
+-- +migrate Up
+-- 链配置变更时通过 NOTIFY 通知各服务实例重建 RPC 客户端（payload 为 chain_id）
+-- +migrate StatementBegin
+CREATE FUNCTION notify_chain_config_changed () RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM pg_notify('chain_config_changed', OLD.chain_id::text);
+        RETURN OLD;
+    END IF;
+    IF TG_OP = 'INSERT' OR NEW.rpc_url IS DISTINCT FROM OLD.rpc_url OR NEW.chain_type IS DISTINCT FROM OLD.chain_type THEN
+        PERFORM pg_notify('chain_config_changed', NEW.chain_id::text);
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+CREATE TRIGGER chains_config_changed
+AFTER INSERT OR UPDATE OR DELETE ON chains
+FOR EACH ROW EXECUTE FUNCTION notify_chain_config_changed ();
+
+-- +migrate Down
+DROP TRIGGER IF EXISTS chains_config_changed ON chains;
+DROP FUNCTION IF EXISTS notify_chain_config_changed ();
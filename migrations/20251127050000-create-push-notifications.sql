@@ -0,0 +1,65 @@
+-- +migrate Up
+-- 用户状态推送通知偏好（充值/提现状态变化），未配置的用户接收所有状态推送
+CREATE TABLE push_notification_preferences (
+    user_id uuid PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    disabled_events text[] NOT NULL DEFAULT '{}', -- 用户关闭的推送事件：deposit_confirmed, deposit_finalized, deposit_failed, withdraw_confirmed, withdraw_failed
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+-- 待发送的状态推送，由触发器写入，推送 worker 领取后删除
+CREATE TABLE push_notification_queue (
+    id bigserial PRIMARY KEY,
+    event varchar(50) NOT NULL,
+    reference_id uuid NOT NULL, -- 充值为 transactions.id，提现为 withdraws.id
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+-- 状态更新分散在扫描、充值、提现等多个模块，使用触发器统一入队
+-- +migrate StatementBegin
+CREATE FUNCTION enqueue_status_push () RETURNS TRIGGER AS $$
+DECLARE
+    event text;
+BEGIN
+    IF TG_OP = 'UPDATE' AND NEW.status IS NOT DISTINCT FROM OLD.status THEN
+        RETURN NEW;
+    END IF;
+
+    IF TG_TABLE_NAME = 'transactions' THEN
+        IF NEW.type <> 'deposit' THEN
+            RETURN NEW;
+        END IF;
+        CASE NEW.status
+            WHEN 'confirmed' THEN event := 'deposit_confirmed';
+            WHEN 'finalized' THEN event := 'deposit_finalized';
+            WHEN 'failed' THEN event := 'deposit_failed';
+            ELSE RETURN NEW;
+        END CASE;
+    ELSE
+        CASE NEW.status
+            WHEN 'confirmed' THEN event := 'withdraw_confirmed';
+            WHEN 'failed' THEN event := 'withdraw_failed';
+            ELSE RETURN NEW;
+        END CASE;
+    END IF;
+
+    INSERT INTO push_notification_queue (event, reference_id) VALUES (event, NEW.id);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+CREATE TRIGGER transactions_status_push
+AFTER INSERT OR UPDATE OF status ON transactions
+FOR EACH ROW EXECUTE FUNCTION enqueue_status_push ();
+
+CREATE TRIGGER withdraws_status_push
+AFTER UPDATE OF status ON withdraws
+FOR EACH ROW EXECUTE FUNCTION enqueue_status_push ();
+
+-- +migrate Down
+DROP TRIGGER IF EXISTS withdraws_status_push ON withdraws;
+DROP TRIGGER IF EXISTS transactions_status_push ON transactions;
+DROP FUNCTION IF EXISTS enqueue_status_push ();
+DROP TABLE IF EXISTS push_notification_queue;
+DROP TABLE IF EXISTS push_notification_preferences;
@@ -0,0 +1,19 @@
+-- +migrate Up
+-- Wallet type enum，与其他状态列一样由数据库约束取值并生成 models.WalletType
+CREATE TYPE wallet_type AS ENUM (
+    'user',
+    'hot',
+    'cold',
+    'watch'
+);
+
+ALTER TABLE wallets
+    ALTER COLUMN wallet_type TYPE wallet_type
+    USING wallet_type::wallet_type;
+
+-- +migrate Down
+ALTER TABLE wallets
+    ALTER COLUMN wallet_type TYPE varchar(50)
+    USING wallet_type::text;
+
+DROP TYPE IF EXISTS wallet_type;
@@ -0,0 +1,48 @@
+-- +migrate Up
+-- 每条链的扫描参数，NULL 表示使用全局配置（WALLET_SCAN_INTERVAL_MS / WALLET_BLOCK_BATCH_SIZE，收据并发默认 1 即串行获取）
+ALTER TABLE chains
+    ADD COLUMN scan_interval_ms integer CHECK (scan_interval_ms > 0),
+    ADD COLUMN block_batch_size integer CHECK (block_batch_size > 0),
+    ADD COLUMN max_receipt_concurrency integer CHECK (max_receipt_concurrency > 0);
+
+-- 扫描参数变更同样通知各服务实例，运行中的扫描器无需重启即可生效
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION notify_chain_config_changed () RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM pg_notify('chain_config_changed', OLD.chain_id::text);
+        RETURN OLD;
+    END IF;
+    IF TG_OP = 'INSERT'
+        OR NEW.rpc_url IS DISTINCT FROM OLD.rpc_url
+        OR NEW.chain_type IS DISTINCT FROM OLD.chain_type
+        OR NEW.scan_interval_ms IS DISTINCT FROM OLD.scan_interval_ms
+        OR NEW.block_batch_size IS DISTINCT FROM OLD.block_batch_size
+        OR NEW.max_receipt_concurrency IS DISTINCT FROM OLD.max_receipt_concurrency THEN
+        PERFORM pg_notify('chain_config_changed', NEW.chain_id::text);
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+-- +migrate Down
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION notify_chain_config_changed () RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM pg_notify('chain_config_changed', OLD.chain_id::text);
+        RETURN OLD;
+    END IF;
+    IF TG_OP = 'INSERT' OR NEW.rpc_url IS DISTINCT FROM OLD.rpc_url OR NEW.chain_type IS DISTINCT FROM OLD.chain_type THEN
+        PERFORM pg_notify('chain_config_changed', NEW.chain_id::text);
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+ALTER TABLE chains
+    DROP COLUMN IF EXISTS max_receipt_concurrency,
+    DROP COLUMN IF EXISTS block_batch_size,
+    DROP COLUMN IF EXISTS scan_interval_ms;
@@ -0,0 +1,28 @@
+-- +migrate Up
+-- 管理员操作审计：钱包管理接口的每次变更请求（审批、拒绝、归集、链和代币配置等）记录一行，只追加不修改
+CREATE TABLE admin_audit (
+    id bigserial PRIMARY KEY,
+    actor_user_id uuid, -- 不设外键，用户删除后审计记录保持不变
+    actor_role varchar(50),
+    method varchar(10) NOT NULL,
+    route text NOT NULL, -- 路由模板，如 /api/v1/wallet/withdraw/:withdrawId/approve
+    path text NOT NULL, -- 实际请求路径
+    request_id varchar(255),
+    payload_hash char(64), -- 请求体 SHA-256（十六进制），无请求体时为空
+    result varchar(20) NOT NULL, -- 'success'、'failure'
+    status_code integer NOT NULL,
+    error text,
+    latency_ms bigint NOT NULL,
+    client_ip varchar(255),
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT admin_audit_result_check CHECK (result IN ('success', 'failure'))
+);
+
+CREATE INDEX idx_admin_audit_created_at ON admin_audit (created_at DESC);
+
+CREATE INDEX idx_admin_audit_actor_user_id_created_at ON admin_audit (actor_user_id, created_at DESC);
+
+CREATE INDEX idx_admin_audit_route_created_at ON admin_audit (route, created_at DESC);
+
+-- +migrate Down
+DROP TABLE IF EXISTS admin_audit;
@@ -0,0 +1,10 @@
+-- +migrate Up notransaction
+-- Add internal transfer to credit_type / reference_type enums
+-- 平台内用户之间的转账只记录一对 credits，不产生链上交易
+-- ALTER TYPE ... ADD VALUE 不能与使用新值的语句在同一事务中，因此单独一个迁移且不使用事务
+ALTER TYPE credit_type ADD VALUE IF NOT EXISTS 'internal';
+
+ALTER TYPE reference_type ADD VALUE IF NOT EXISTS 'internal_transfer';
+
+-- +migrate Down
+-- PostgreSQL 不支持删除枚举值，回滚时保留
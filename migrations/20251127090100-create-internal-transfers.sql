@@ -0,0 +1,31 @@
+-- +migrate Up
+-- 代币的最小内部转账金额（人类可读单位），为空或 0 表示不限制
+ALTER TABLE tokens
+    ADD COLUMN min_transfer_amount text DEFAULT '0';
+
+-- Create internal_transfers table (内部转账记录表)
+-- 每条记录对应一对 credits：转出用户扣减（负数）与接收用户入账（正数），reference_id 均为本表 id
+CREATE TABLE internal_transfers (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    from_user_id uuid NOT NULL REFERENCES users (id) ON DELETE RESTRICT,
+    to_user_id uuid NOT NULL REFERENCES users (id) ON DELETE RESTRICT,
+    token_id integer NOT NULL REFERENCES tokens (id) ON DELETE RESTRICT,
+    chain_id integer NOT NULL,
+    amount text NOT NULL, -- 转账金额（人类可读单位）
+    idempotency_key text NOT NULL, -- 转出用户提供的幂等键，重复请求返回同一笔转账
+    request_hash char(64) NOT NULL, -- 请求参数摘要，识别使用相同幂等键但参数不同的请求
+    memo text,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT internal_transfers_different_users CHECK (from_user_id <> to_user_id),
+    CONSTRAINT internal_transfers_idempotency_key_unique UNIQUE (from_user_id, idempotency_key)
+);
+
+CREATE INDEX idx_internal_transfers_to_user_id ON internal_transfers (to_user_id);
+
+CREATE INDEX idx_internal_transfers_created_at ON internal_transfers (created_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS internal_transfers;
+
+ALTER TABLE tokens
+    DROP COLUMN IF EXISTS min_transfer_amount;
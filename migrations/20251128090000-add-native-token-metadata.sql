@@ -0,0 +1,26 @@
+-- +migrate Up
+-- 链原生代币精度，与原生代币符号一起在链 API 中返回
+ALTER TABLE chains
+    ADD COLUMN native_token_decimals integer NOT NULL DEFAULT 18;
+
+UPDATE chains SET native_token_decimals = 9 WHERE chain_type = 'solana';
+
+-- 包装原生代币（如 BSC 的 WBNB、以太坊的 WETH），每条链最多一个
+-- credit_as_native 开启后该代币的充值按原生代币入账
+ALTER TABLE tokens
+    ADD COLUMN is_wrapped_native boolean NOT NULL DEFAULT FALSE,
+    ADD COLUMN credit_as_native boolean NOT NULL DEFAULT FALSE,
+    ADD CONSTRAINT tokens_credit_as_native_wrapped CHECK (NOT credit_as_native OR is_wrapped_native);
+
+CREATE UNIQUE INDEX idx_tokens_wrapped_native ON tokens (chain_id) WHERE is_wrapped_native;
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_tokens_wrapped_native;
+
+ALTER TABLE tokens
+    DROP CONSTRAINT IF EXISTS tokens_credit_as_native_wrapped,
+    DROP COLUMN IF EXISTS credit_as_native,
+    DROP COLUMN IF EXISTS is_wrapped_native;
+
+ALTER TABLE chains
+    DROP COLUMN IF EXISTS native_token_decimals;
@@ -0,0 +1,39 @@
+-- +migrate Up
+-- 比特币原生代币为 8 位小数（聪）
+UPDATE chains SET native_token_decimals = 8 WHERE chain_type = 'bitcoin';
+
+-- Create utxos table (UTXO 链的未花费输出)
+-- 扫描器记录转入派生地址（用户地址和热钱包）的输出；提现选择 UTXO 时锁定（locked），
+-- 花费交易上链后标记为 spent。区块重组时回滚对应区块产生和花费的记录
+CREATE TABLE utxos (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    chain_id integer NOT NULL,
+    tx_hash varchar(66) NOT NULL, -- 产生该输出的交易 txid
+    vout integer NOT NULL, -- 输出序号
+    address varchar(255) NOT NULL, -- 输出地址（wallets.address）
+    amount bigint NOT NULL, -- 金额（聪）
+    script_pub_key text NOT NULL, -- 锁定脚本（十六进制）
+    block_no bigint NOT NULL,
+    block_hash varchar(66) NOT NULL,
+    status varchar(20) NOT NULL DEFAULT 'unspent',
+    withdraw_id uuid REFERENCES withdraws (id) ON DELETE SET NULL, -- 花费该输出的提现
+    spent_tx_hash varchar(66), -- 花费该输出的交易 txid
+    spent_block_no bigint, -- 花费交易所在区块，上链前为空
+    spent_block_hash varchar(66),
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT utxos_status_check CHECK (status IN ('unspent', 'locked', 'spent')),
+    CONSTRAINT utxos_amount_check CHECK (amount > 0),
+    CONSTRAINT utxos_outpoint_unique UNIQUE (chain_id, tx_hash, vout)
+);
+
+CREATE INDEX idx_utxos_unspent ON utxos (chain_id, amount DESC) WHERE status = 'unspent';
+
+CREATE INDEX idx_utxos_withdraw_id ON utxos (withdraw_id) WHERE withdraw_id IS NOT NULL;
+
+CREATE INDEX idx_utxos_spent_tx_hash ON utxos (chain_id, spent_tx_hash) WHERE spent_tx_hash IS NOT NULL;
+
+CREATE INDEX idx_utxos_block_hash ON utxos (chain_id, block_hash);
+
+-- +migrate Down
+DROP TABLE IF EXISTS utxos;
@@ -0,0 +1,17 @@
+-- +migrate Up
+-- Create token_prices table (代币美元价格)
+-- 价格服务定时从价格源（CoinGecko 或 Chainlink）更新；价格源不支持的代币由管理员手动设置（source = 'manual'），
+-- 手动价格不会被价格源覆盖，删除后恢复由价格源更新
+CREATE TABLE token_prices (
+    token_id integer PRIMARY KEY REFERENCES tokens (id) ON DELETE CASCADE,
+    price_usd numeric NOT NULL, -- 1 个代币（人类可读单位）的美元价格
+    source varchar(20) NOT NULL, -- 价格来源：coingecko, chainlink, manual
+    updated_by uuid REFERENCES users (id) ON DELETE SET NULL, -- 设置手动价格的管理员
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT token_prices_price_usd_check CHECK (price_usd >= 0),
+    CONSTRAINT token_prices_source_check CHECK (source IN ('coingecko', 'chainlink', 'manual'))
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS token_prices;
@@ -0,0 +1,7 @@
+-- +migrate Up notransaction
+-- Add dust to transaction_status enum (低于代币最小充值金额的充值)
+-- ALTER TYPE ... ADD VALUE 不能与使用新值的语句在同一事务中，因此单独一个迁移且不使用事务
+ALTER TYPE transaction_status ADD VALUE IF NOT EXISTS 'dust';
+
+-- +migrate Down
+-- PostgreSQL 不支持删除枚举值，回滚时保留
@@ -0,0 +1,16 @@
+-- +migrate Up
+-- 代币的最小充值金额（人类可读单位），为空或 0 表示不限制
+-- 低于该金额的充值记录为 status = 'dust' 的交易，不生成 credits、不计入余额
+ALTER TABLE tokens
+    ADD COLUMN min_deposit_amount text DEFAULT '0';
+
+-- 小额充值报告按链和代币汇总 dust 交易
+CREATE INDEX idx_transactions_dust ON transactions (chain_id, token_addr)
+WHERE
+    status = 'dust';
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_transactions_dust;
+
+ALTER TABLE tokens
+    DROP COLUMN IF EXISTS min_deposit_amount;
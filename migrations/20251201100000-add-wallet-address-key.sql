@@ -0,0 +1,33 @@
+-- +migrate Up
+-- 钱包地址的规范化形式，与 chain.NormalizeAddress 保持一致：EVM 地址小写，Solana（base58）地址区分大小写保持原样，
+-- 比特币 bech32 地址小写、base58 地址保持原样。按地址查找钱包统一使用 wallet_address_key(chain_type, address)，
+-- 旧数据中大小写混合的 EVM 地址同样可以命中索引
+-- +migrate StatementBegin
+CREATE FUNCTION wallet_address_key (chain_type text, address text) RETURNS text AS $$
+    SELECT CASE
+        WHEN chain_type = 'solana' THEN address
+        WHEN chain_type = 'bitcoin' AND lower(address) NOT LIKE 'bc1%' THEN address
+        ELSE lower(address)
+    END
+$$ LANGUAGE sql IMMUTABLE STRICT PARALLEL SAFE;
+-- +migrate StatementEnd
+
+-- 覆盖索引：归属查找只需要 user_id 和 wallet_type，不回表
+CREATE INDEX idx_wallets_chain_address_key ON wallets (chain_id, wallet_address_key(chain_type, address)) INCLUDE (user_id, wallet_type);
+
+-- 供临时 SQL 查询使用：按链和任意大小写的地址查找钱包归属（包括观察地址）
+-- +migrate StatementBegin
+CREATE FUNCTION wallet_owner (p_chain_id integer, p_address text)
+    RETURNS TABLE (wallet_id uuid, user_id uuid, wallet_type wallet_type) AS $$
+    SELECT w.id, w.user_id, w.wallet_type
+    FROM chains c
+    JOIN wallets w ON w.chain_id = c.chain_id
+        AND wallet_address_key(w.chain_type, w.address) = wallet_address_key(c.chain_type, p_address)
+    WHERE c.chain_id = p_chain_id
+$$ LANGUAGE sql STABLE PARALLEL SAFE;
+-- +migrate StatementEnd
+
+-- +migrate Down
+DROP FUNCTION IF EXISTS wallet_owner (integer, text);
+DROP INDEX IF EXISTS idx_wallets_chain_address_key;
+DROP FUNCTION IF EXISTS wallet_address_key (text, text);
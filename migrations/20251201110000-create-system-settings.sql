@@ -0,0 +1,20 @@
+-- +migrate Up
+-- Create system_settings table (系统设置)
+-- 管理员在运行时修改的系统设置，所有服务实例读取同一份设置；chain_id 为空表示全局设置，链级设置优先于全局设置
+-- maintenance：维护模式，value 为 {"message": "...", "starts_at": "...", "ends_at": "..."}（ends_at 为空表示直到管理员关闭），
+-- 维护期间照常受理新的提现请求，获得批准后排队，维护结束后由提现调度器处理
+CREATE TABLE system_settings (
+    id serial PRIMARY KEY,
+    key varchar(50) NOT NULL,
+    chain_id integer REFERENCES chains (chain_id) ON DELETE CASCADE,
+    value jsonb NOT NULL DEFAULT '{}',
+    updated_by uuid REFERENCES users (id) ON DELETE SET NULL, -- 最近修改设置的管理员
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+-- 每个设置最多一条全局设置和每条链一条设置
+CREATE UNIQUE INDEX idx_system_settings_key_chain ON system_settings (key, COALESCE(chain_id, 0));
+
+-- +migrate Down
+DROP TABLE IF EXISTS system_settings;
@@ -0,0 +1,19 @@
+-- +migrate Up
+-- 充值交易对应的 ERC20 Transfer 事件的 logIndex，原生代币转账为空
+-- 同一笔链上交易中的多笔转账（如批量转账合约转给同一地址多次）各自记录为一条交易记录并分别入账
+ALTER TABLE transactions
+    ADD COLUMN event_index integer;
+
+ALTER TABLE transactions
+    DROP CONSTRAINT transactions_tx_hash_chain_unique;
+
+CREATE UNIQUE INDEX transactions_tx_hash_chain_event_unique ON transactions (tx_hash, chain_id, COALESCE(event_index, -1));
+
+-- +migrate Down
+DROP INDEX IF EXISTS transactions_tx_hash_chain_event_unique;
+
+ALTER TABLE transactions
+    ADD CONSTRAINT transactions_tx_hash_chain_unique UNIQUE (tx_hash, chain_id);
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS event_index;
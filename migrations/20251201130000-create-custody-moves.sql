@@ -0,0 +1,38 @@
+-- +migrate Up
+-- Create custody_moves table (资金托管流转记录表)
+-- 归集把用户充值地址上的资金转入热钱包，用户余额（credits）不变，只是资金的托管位置变化
+-- 每条归集交易对应一条记录，与 transactions 记录在同一个数据库事务中写入，状态以关联交易为准
+CREATE TABLE custody_moves (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    transaction_id uuid NOT NULL REFERENCES transactions (id) ON DELETE CASCADE, -- 关联的链上交易
+    move_type varchar(50) NOT NULL, -- 流转类型：'collect'
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE RESTRICT, -- 资金所属用户
+    chain_id integer NOT NULL,
+    from_wallet_id uuid NOT NULL REFERENCES wallets (id) ON DELETE RESTRICT, -- 转出钱包（用户充值地址）
+    from_address varchar(255) NOT NULL,
+    to_wallet_id uuid NOT NULL REFERENCES wallets (id) ON DELETE RESTRICT, -- 转入钱包（热钱包）
+    to_address varchar(255) NOT NULL,
+    token_addr varchar(255), -- 代币合约地址，原生代币为空
+    amount text NOT NULL, -- 金额（最小单位，与 transactions.amount 一致）
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT custody_moves_transaction_unique UNIQUE (transaction_id)
+);
+
+CREATE INDEX idx_custody_moves_user_id ON custody_moves (user_id);
+
+CREATE INDEX idx_custody_moves_to_wallet_id ON custody_moves (to_wallet_id);
+
+CREATE INDEX idx_custody_moves_created_at ON custody_moves (created_at);
+
+-- 为已有的归集交易补写记录（按地址匹配转出、转入钱包）
+INSERT INTO custody_moves (transaction_id, move_type, user_id, chain_id, from_wallet_id, from_address, to_wallet_id, to_address, token_addr, amount, created_at)
+SELECT DISTINCT ON (t.id)
+    t.id, 'collect', fw.user_id, t.chain_id, fw.id, t.from_addr, tw.id, t.to_addr, t.token_addr, t.amount, t.created_at
+FROM transactions t
+JOIN wallets fw ON fw.chain_id = t.chain_id AND LOWER(fw.address) = LOWER(t.from_addr)
+JOIN wallets tw ON tw.chain_id = t.chain_id AND LOWER(tw.address) = LOWER(t.to_addr)
+WHERE t.type = 'collect'
+ORDER BY t.id, fw.created_at, tw.created_at;
+
+-- +migrate Down
+DROP TABLE IF EXISTS custody_moves;
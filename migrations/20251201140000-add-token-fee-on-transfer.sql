@@ -0,0 +1,9 @@
+-- +migrate Up
+-- 转账扣费代币（fee-on-transfer）：Transfer 事件金额可能大于实际到账金额
+-- 扫描时查询收款地址在区块前后的 balanceOf，按实际到账金额记录充值
+ALTER TABLE tokens
+    ADD COLUMN fee_on_transfer boolean NOT NULL DEFAULT FALSE;
+
+-- +migrate Down
+ALTER TABLE tokens
+    DROP COLUMN IF EXISTS fee_on_transfer;
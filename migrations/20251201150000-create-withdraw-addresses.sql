@@ -0,0 +1,34 @@
+-- +migrate Up
+-- 用户提现地址簿：新地址需通过邮件链接确认，确认后经过冷静期（available_at）才能用于提现
+CREATE TABLE withdraw_addresses (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    chain_id integer NOT NULL,
+    address varchar(128) NOT NULL, -- 用户提交的地址
+    address_key varchar(128) NOT NULL, -- 规范化地址（EVM 小写，Solana/比特币 base58 保持原样，bech32 小写），用于去重和提现校验
+    label varchar(100),
+    confirmation_token_hash varchar(64) UNIQUE, -- 确认 Token 的 SHA-256（十六进制），确认后清空
+    confirmation_expires_at timestamptz,
+    confirmed_at timestamptz,
+    available_at timestamptz, -- 确认时间 + 冷静期，之后才能用于提现
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT withdraw_addresses_user_chain_address_key UNIQUE (user_id, chain_id, address_key)
+);
+
+CREATE INDEX idx_withdraw_addresses_user_id ON withdraw_addresses (user_id);
+
+-- 用户提现地址簿设置：开启后只能提现到地址簿中已生效的地址
+-- 关闭需经过冷静期，enforced_until 之前仍按开启处理
+CREATE TABLE withdraw_address_settings (
+    user_id uuid PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    whitelist_only boolean NOT NULL DEFAULT FALSE,
+    enforced_until timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS withdraw_address_settings;
+
+DROP TABLE IF EXISTS withdraw_addresses;
@@ -0,0 +1,11 @@
+-- +migrate Up
+-- gas 价格上限：已批准的提现在交易 gas 价格超过链的上限时不广播，按重试计划推迟处理
+-- gas_deferred_until 为下次尝试时间，gas_defer_count 为已推迟次数（决定下一次推迟的间隔）
+ALTER TABLE withdraws
+    ADD COLUMN gas_deferred_until timestamptz,
+    ADD COLUMN gas_defer_count integer NOT NULL DEFAULT 0;
+
+-- +migrate Down
+ALTER TABLE withdraws
+    DROP COLUMN IF EXISTS gas_defer_count,
+    DROP COLUMN IF EXISTS gas_deferred_until;
@@ -0,0 +1,9 @@
+-- +migrate Up
+-- 支持 EIP-2612 permit 的代币：归集时用户地址离线签名 permit，热钱包提交 permit 和 transferFrom
+-- 用户地址无需充值原生代币作为 gas
+ALTER TABLE tokens
+    ADD COLUMN supports_permit boolean NOT NULL DEFAULT FALSE;
+
+-- +migrate Down
+ALTER TABLE tokens
+    DROP COLUMN IF EXISTS supports_permit;
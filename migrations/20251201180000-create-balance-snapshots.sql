@@ -0,0 +1,27 @@
+-- +migrate Up
+-- 每日余额快照：每个用户每个代币在当天结束时（UTC）的余额，用于月度对账单和历史余额查询，无需汇总全部 credits
+-- 余额口径与可用余额一致（finalized 的 credits 加上 pending/frozen 的提现扣款），按生成快照时的 credits 状态计算
+CREATE TABLE balance_snapshots (
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    token_id integer NOT NULL REFERENCES tokens (id) ON DELETE CASCADE,
+    chain_id integer NOT NULL,
+    snapshot_date date NOT NULL, -- 快照日期（UTC），余额为该日结束时的余额
+    balance text NOT NULL, -- 余额（最小单位，字符串存储）
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, token_id, snapshot_date)
+);
+
+CREATE INDEX idx_balance_snapshots_snapshot_date ON balance_snapshots (snapshot_date);
+
+-- 已生成快照的日期：与当天的快照在同一个事务中写入，多个实例同时生成时只有一个实例写入
+-- 余额为 0 且当天没有 credits 的代币不写快照，按该日期记录判断当天是否已生成
+CREATE TABLE balance_snapshot_days (
+    snapshot_date date PRIMARY KEY,
+    snapshot_count integer NOT NULL, -- 当天写入的快照数
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS balance_snapshot_days;
+
+DROP TABLE IF EXISTS balance_snapshots;
@@ -0,0 +1,65 @@
+-- +migrate Up
+-- 归档表：超过保留时间的已终结区块和已结算交易从 blocks/transactions 移到归档表（或按配置直接删除），
+-- 避免扫描器查询随历史数据增长而变慢；列与原表一致，archived_at 记录归档时间
+CREATE TABLE blocks_archive (
+    hash varchar(255) NOT NULL,
+    chain_id integer NOT NULL,
+    parent_hash varchar(255) NOT NULL,
+    number bigint NOT NULL,
+    timestamp bigint NOT NULL,
+    status block_status NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    archived_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (hash, chain_id)
+);
+
+CREATE INDEX idx_blocks_archive_number ON blocks_archive (chain_id, number);
+
+CREATE TABLE transactions_archive (
+    id uuid PRIMARY KEY,
+    chain_id integer NOT NULL,
+    block_hash varchar(255) NOT NULL,
+    block_no bigint NOT NULL,
+    tx_hash varchar(255) NOT NULL,
+    from_addr varchar(255) NOT NULL,
+    to_addr varchar(255) NOT NULL,
+    token_addr varchar(255),
+    amount text NOT NULL,
+    type transaction_type NOT NULL,
+    status transaction_status NOT NULL,
+    confirmation_count integer,
+    event_index integer,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    archived_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_transactions_archive_tx_hash ON transactions_archive (chain_id, tx_hash);
+
+-- 归档按 created_at 选择超过保留时间的区块
+CREATE INDEX idx_blocks_created_at ON blocks (created_at);
+
+-- 归档执行记录（定时任务和管理员手动执行），mode 为 archive（移到归档表）或 delete（直接删除）
+CREATE TABLE archive_runs (
+    id serial PRIMARY KEY,
+    mode varchar(20) NOT NULL,
+    cutoff timestamptz NOT NULL, -- 归档 created_at 早于该时间的记录
+    triggered_by uuid REFERENCES users (id) ON DELETE SET NULL, -- 手动执行的管理员，定时任务为空
+    blocks_count bigint NOT NULL DEFAULT 0,
+    transactions_count bigint NOT NULL DEFAULT 0,
+    error text,
+    started_at timestamptz NOT NULL DEFAULT NOW(),
+    finished_at timestamptz
+);
+
+CREATE INDEX idx_archive_runs_started_at ON archive_runs (started_at DESC);
+
+-- +migrate Down
+DROP TABLE IF EXISTS archive_runs;
+
+DROP INDEX IF EXISTS idx_blocks_created_at;
+
+DROP TABLE IF EXISTS transactions_archive;
+
+DROP TABLE IF EXISTS blocks_archive;
@@ -0,0 +1,43 @@
+-- +migrate Up
+-- NFT 托管记录：扫描器识别转入用户充值地址的 ERC-721 Transfer 和 ERC-1155 TransferSingle/TransferBatch 事件，
+-- 每个转入的 NFT（TransferBatch 中的每个 id）一条记录；NFT 留在用户充值地址上，由管理员发起提现转出
+CREATE TABLE nft_holdings (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE RESTRICT,
+    wallet_id uuid NOT NULL REFERENCES wallets (id) ON DELETE RESTRICT, -- 收到 NFT 的用户充值地址
+    chain_id integer NOT NULL,
+    contract_addr varchar(255) NOT NULL, -- NFT 合约地址（小写）
+    token_id text NOT NULL, -- NFT 的 tokenId（uint256，十进制字符串）
+    standard varchar(20) NOT NULL, -- 'erc721' 或 'erc1155'
+    amount text NOT NULL, -- 数量，ERC-721 为 1
+    from_addr varchar(255) NOT NULL,
+    tx_hash varchar(255) NOT NULL,
+    block_hash varchar(255) NOT NULL,
+    block_no bigint NOT NULL,
+    event_index integer NOT NULL, -- 事件的 logIndex
+    batch_index integer NOT NULL DEFAULT 0, -- TransferBatch 中的位置，其余事件为 0
+    -- held: 托管中，withdraw_requested: 已发起提现待发送，withdrawing: 提现交易已广播，
+    -- withdrawn: 已转出，orphaned: 所在区块因重组被回滚
+    status varchar(20) NOT NULL DEFAULT 'held',
+    withdraw_to_addr varchar(255),
+    withdraw_tx_hash varchar(255),
+    withdraw_requested_by uuid REFERENCES users (id) ON DELETE SET NULL, -- 发起提现的管理员
+    withdraw_requested_at timestamptz,
+    withdraw_error text, -- 最近一次提现失败的原因，失败后恢复为 held，可重新发起
+    withdrawn_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT nft_holdings_transfer_unique UNIQUE (chain_id, tx_hash, event_index, batch_index)
+);
+
+CREATE INDEX idx_nft_holdings_user_id ON nft_holdings (user_id, created_at DESC);
+
+CREATE INDEX idx_nft_holdings_block_hash ON nft_holdings (chain_id, block_hash);
+
+-- 提现任务只处理进行中的提现
+CREATE INDEX idx_nft_holdings_withdraw_pending ON nft_holdings (status)
+WHERE
+    status IN ('withdraw_requested', 'withdrawing');
+
+-- +migrate Down
+DROP TABLE IF EXISTS nft_holdings;
@@ -0,0 +1,19 @@
+-- +migrate Up
+-- 管理员操作审计记录操作对象和请求参数：操作对象取自路由的路径参数（如 :withdrawId），
+-- 请求参数包含路径参数、查询参数和 JSON 请求体，密码等敏感字段已脱敏
+ALTER TABLE admin_audit
+    ADD COLUMN target_type varchar(50),
+    ADD COLUMN target_id varchar(255),
+    ADD COLUMN params jsonb;
+
+CREATE INDEX idx_admin_audit_target_created_at ON admin_audit (target_type, target_id, created_at DESC)
+WHERE
+    target_type IS NOT NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_admin_audit_target_created_at;
+
+ALTER TABLE admin_audit
+    DROP COLUMN IF EXISTS params,
+    DROP COLUMN IF EXISTS target_id,
+    DROP COLUMN IF EXISTS target_type;
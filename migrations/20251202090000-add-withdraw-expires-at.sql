@@ -0,0 +1,9 @@
+-- +migrate Up
+-- 提现请求过期：等待管理员审核的提现超过过期时间后自动拒绝并释放冻结资金
+-- expires_at 为管理员为单笔提现设置的过期时间，为空时使用配置的默认过期时间（created_at + WALLET_WITHDRAW_EXPIRY_HOURS）
+ALTER TABLE withdraws
+    ADD COLUMN expires_at timestamptz;
+
+-- +migrate Down
+ALTER TABLE withdraws
+    DROP COLUMN IF EXISTS expires_at;
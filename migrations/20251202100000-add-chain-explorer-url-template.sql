@@ -0,0 +1,14 @@
+-- +migrate Up
+-- 区块浏览器链接模板：{type} 替换为 tx 或 address，{value} 替换为交易哈希或地址，
+-- 充值、提现、归集和交易记录的响应中返回生成的浏览器链接，前端无需按链硬编码浏览器地址
+ALTER TABLE chains
+    ADD COLUMN explorer_url_template text;
+
+-- Etherscan 系列浏览器的交易和地址路径为 /tx/<hash> 和 /address/<address>
+UPDATE chains
+SET explorer_url_template = rtrim(explorer_url, '/') || '/{type}/{value}'
+WHERE explorer_url IS NOT NULL AND explorer_url <> '';
+
+-- +migrate Down
+ALTER TABLE chains
+    DROP COLUMN IF EXISTS explorer_url_template;
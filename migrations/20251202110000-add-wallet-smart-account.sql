@@ -0,0 +1,14 @@
+-- +migrate Up
+-- ERC-4337 智能账户充值地址：配置了智能账户的链上，用户钱包地址为工厂合约通过 CREATE2 计算的反事实智能账户地址
+-- smart_account_owner 为派生路径派生的 owner 地址（签名 UserOperation），smart_account_factory 为创建账户的工厂合约，首次归集时部署
+-- 普通 EOA 钱包两列均为空
+ALTER TABLE wallets
+    ADD COLUMN smart_account_owner text,
+    ADD COLUMN smart_account_factory text,
+    ADD CONSTRAINT wallets_smart_account_check CHECK ((smart_account_owner IS NULL) = (smart_account_factory IS NULL));
+
+-- +migrate Down
+ALTER TABLE wallets
+    DROP CONSTRAINT IF EXISTS wallets_smart_account_check,
+    DROP COLUMN IF EXISTS smart_account_factory,
+    DROP COLUMN IF EXISTS smart_account_owner;
@@ -0,0 +1,30 @@
+-- +migrate Up
+-- Create scan_failures table (交易分析失败死信队列)
+-- 扫描时单笔交易分析失败不影响区块保存，失败的交易记录在此表，由重试 worker 按退避计划重新分析
+-- 超过最大重试次数后标记为 dead，管理员排查后可重新入队
+CREATE TABLE scan_failures (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    chain_id integer NOT NULL, -- 链ID
+    block_number bigint NOT NULL,
+    block_hash text NOT NULL,
+    tx_hash text NOT NULL,
+    error text NOT NULL, -- 最近一次分析失败原因
+    attempts integer NOT NULL DEFAULT 0, -- 重试次数（不含扫描时的首次分析）
+    status varchar(20) NOT NULL DEFAULT 'pending', -- pending, resolved, dead, orphaned
+    next_retry_at timestamptz NOT NULL DEFAULT NOW(), -- 下次重试时间，重试执行中时为租约到期时间
+    resolved_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT scan_failures_status_check CHECK (status IN ('pending', 'resolved', 'dead', 'orphaned')),
+    CONSTRAINT scan_failures_attempts_check CHECK (attempts >= 0),
+    CONSTRAINT scan_failures_tx_unique UNIQUE (chain_id, tx_hash, block_hash)
+);
+
+CREATE INDEX idx_scan_failures_retry ON scan_failures (next_retry_at)
+WHERE
+    status = 'pending';
+
+CREATE INDEX idx_scan_failures_chain_id_status ON scan_failures (chain_id, status);
+
+-- +migrate Down
+DROP TABLE IF EXISTS scan_failures;
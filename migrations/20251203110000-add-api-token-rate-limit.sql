@@ -0,0 +1,9 @@
+-- +migrate Up
+-- API Token 请求频率限制（每分钟最多请求数），为空时使用全局默认值 WALLET_API_TOKEN_RATE_LIMIT_PER_MINUTE
+ALTER TABLE api_tokens
+    ADD COLUMN rate_limit_per_minute integer,
+    ADD CONSTRAINT api_tokens_rate_limit_per_minute_check CHECK (rate_limit_per_minute IS NULL OR rate_limit_per_minute > 0);
+
+-- +migrate Down
+ALTER TABLE api_tokens
+    DROP COLUMN IF EXISTS rate_limit_per_minute;
@@ -0,0 +1,99 @@
+-- +migrate Up
+-- 多租户组织：同一部署（共用一个种子）托管多个相互独立的租户，钱包、资金流水、提现限额和热钱包按 org_id 隔离
+-- org_id 为空的记录属于部署本身（未加入组织的用户、共用热钱包、全局默认限额）
+CREATE TABLE organizations (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    name varchar(100) NOT NULL UNIQUE,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+-- 组织成员，每个用户最多属于一个组织；'admin' 管理组织成员并查看组织账务，'member' 为普通成员
+CREATE TABLE organization_members (
+    user_id uuid PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    org_id uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    role varchar(20) NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT organization_members_role_check CHECK (role IN ('admin', 'member'))
+);
+
+CREATE INDEX idx_organization_members_org_id ON organization_members (org_id);
+
+-- 用户钱包和资金流水归属用户所在组织；热钱包由管理员创建时指定组织，为空表示所有组织共用
+ALTER TABLE wallets
+    ADD COLUMN org_id uuid REFERENCES organizations (id) ON DELETE RESTRICT;
+
+CREATE INDEX idx_wallets_org_chain ON wallets (org_id, chain_id);
+
+ALTER TABLE credits
+    ADD COLUMN org_id uuid REFERENCES organizations (id) ON DELETE RESTRICT;
+
+CREATE INDEX idx_credits_org_token ON credits (org_id, token_id);
+
+-- 组织默认提现限额：user_id 为空、org_id 不为空，优先级为用户限额 > 组织默认限额 > 全局默认限额
+ALTER TABLE withdraw_limits
+    ADD COLUMN org_id uuid REFERENCES organizations (id) ON DELETE CASCADE,
+    ADD CONSTRAINT withdraw_limits_scope_check CHECK (user_id IS NULL OR org_id IS NULL);
+
+DROP INDEX IF EXISTS withdraw_limits_scope_unique;
+
+CREATE UNIQUE INDEX withdraw_limits_scope_unique ON withdraw_limits (COALESCE(user_id, '00000000-0000-0000-0000-000000000000'::uuid), COALESCE(org_id, '00000000-0000-0000-0000-000000000000'::uuid), token_id, period, action);
+
+-- 新建的用户钱包和资金流水未指定 org_id 时使用用户所在组织，各写入路径无需关心组织
+-- +migrate StatementBegin
+CREATE FUNCTION set_wallet_org_id () RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.org_id IS NULL AND NEW.wallet_type = 'user' THEN
+        SELECT org_id INTO NEW.org_id FROM organization_members WHERE user_id = NEW.user_id;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+CREATE FUNCTION set_credit_org_id () RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.org_id IS NULL THEN
+        SELECT org_id INTO NEW.org_id FROM organization_members WHERE user_id = NEW.user_id;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+CREATE TRIGGER wallets_set_org_id
+BEFORE INSERT ON wallets
+FOR EACH ROW EXECUTE FUNCTION set_wallet_org_id ();
+
+CREATE TRIGGER credits_set_org_id
+BEFORE INSERT ON credits
+FOR EACH ROW EXECUTE FUNCTION set_credit_org_id ();
+
+-- +migrate Down
+DROP TRIGGER IF EXISTS credits_set_org_id ON credits;
+DROP TRIGGER IF EXISTS wallets_set_org_id ON wallets;
+DROP FUNCTION IF EXISTS set_credit_org_id ();
+DROP FUNCTION IF EXISTS set_wallet_org_id ();
+
+DROP INDEX IF EXISTS withdraw_limits_scope_unique;
+
+DELETE FROM withdraw_limits
+WHERE org_id IS NOT NULL;
+
+ALTER TABLE withdraw_limits
+    DROP CONSTRAINT IF EXISTS withdraw_limits_scope_check,
+    DROP COLUMN IF EXISTS org_id;
+
+CREATE UNIQUE INDEX withdraw_limits_scope_unique ON withdraw_limits (COALESCE(user_id, '00000000-0000-0000-0000-000000000000'::uuid), token_id, period, action);
+
+DROP INDEX IF EXISTS idx_credits_org_token;
+ALTER TABLE credits
+    DROP COLUMN IF EXISTS org_id;
+
+DROP INDEX IF EXISTS idx_wallets_org_chain;
+ALTER TABLE wallets
+    DROP COLUMN IF EXISTS org_id;
+
+DROP TABLE IF EXISTS organization_members;
+DROP TABLE IF EXISTS organizations;
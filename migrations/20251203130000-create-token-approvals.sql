@@ -0,0 +1,42 @@
+-- +migrate Up
+-- 热钱包授权记录：扫描器识别 owner 为热钱包的 ERC20 Approval 事件，每个事件一条记录；
+-- 同一 (chain_id, owner_addr, token_addr, spender_addr) 最新的有效事件即当前授权额度，额度不为 0 的为未撤销的授权
+CREATE TABLE token_approvals (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    wallet_id uuid NOT NULL REFERENCES wallets (id) ON DELETE RESTRICT, -- 授权的热钱包
+    chain_id integer NOT NULL,
+    owner_addr varchar(255) NOT NULL, -- 热钱包地址（小写）
+    token_addr varchar(255) NOT NULL, -- ERC20 合约地址（小写）
+    spender_addr varchar(255) NOT NULL, -- 被授权地址（小写）
+    amount text NOT NULL, -- 授权额度（uint256，十进制字符串），撤销授权为 0
+    tx_hash varchar(255) NOT NULL,
+    block_hash varchar(255) NOT NULL,
+    block_no bigint NOT NULL,
+    event_index integer NOT NULL, -- 事件的 logIndex
+    -- active: 有效，orphaned: 所在区块因重组被回滚
+    status varchar(20) NOT NULL DEFAULT 'active',
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT token_approvals_event_unique UNIQUE (chain_id, tx_hash, event_index)
+);
+
+CREATE INDEX idx_token_approvals_allowance ON token_approvals (chain_id, owner_addr, token_addr, spender_addr, block_no DESC, event_index DESC)
+WHERE
+    status = 'active';
+
+CREATE INDEX idx_token_approvals_block_hash ON token_approvals (chain_id, block_hash);
+
+-- 管理员发起的撤销授权交易（approve(spender, 0)），上链后由扫描器记录额度为 0 的 Approval 事件
+CREATE TABLE token_approval_revokes (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    approval_id uuid NOT NULL REFERENCES token_approvals (id) ON DELETE CASCADE, -- 发起撤销时的最新授权事件
+    tx_hash varchar(255) NOT NULL,
+    requested_by uuid REFERENCES users (id) ON DELETE SET NULL, -- 发起撤销的管理员
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_token_approval_revokes_approval_id ON token_approval_revokes (approval_id, created_at DESC);
+
+-- +migrate Down
+DROP TABLE IF EXISTS token_approval_revokes;
+DROP TABLE IF EXISTS token_approvals;
@@ -0,0 +1,19 @@
+-- +migrate Up
+-- Create scan_lag_samples table (扫描延迟采样)
+-- 扫描延迟监控定期记录每条链的最新区块和已扫描区块，用于计算时间加权平均延迟和绘制延迟历史，超过保留时长的采样定期删除
+CREATE TABLE scan_lag_samples (
+    id bigserial PRIMARY KEY,
+    chain_id integer NOT NULL, -- 链ID
+    latest_block bigint NOT NULL, -- 采样时链的最新区块
+    scanned_block bigint NOT NULL, -- 采样时已扫描到的区块
+    lag bigint NOT NULL, -- 落后最新区块的区块数
+    sampled_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT scan_lag_samples_lag_check CHECK (lag >= 0)
+);
+
+CREATE INDEX idx_scan_lag_samples_chain_id_sampled_at ON scan_lag_samples (chain_id, sampled_at);
+
+CREATE INDEX idx_scan_lag_samples_sampled_at ON scan_lag_samples (sampled_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS scan_lag_samples;
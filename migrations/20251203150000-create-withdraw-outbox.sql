@@ -0,0 +1,23 @@
+-- +migrate Up
+-- 提现处理发件箱（transactional outbox）：提现获得足够批准（或管理员重试）时与审批记录在同一事务中写入，
+-- 由分发器签名并广播（至少一次），批准后进程崩溃的提现在重启后继续处理
+CREATE TABLE withdraw_outbox (
+    id bigserial PRIMARY KEY,
+    withdraw_id uuid NOT NULL REFERENCES withdraws (id) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    attempts integer NOT NULL DEFAULT 0, -- 分发次数
+    next_attempt_at timestamptz NOT NULL DEFAULT NOW(), -- 下次可分发时间（分发中的租约到期时间或失败后的重试时间）
+    tx_hash varchar(255), -- 广播前记录的已签名交易，重复分发时据此避免使用新的 nonce 重复出账
+    from_address varchar(255),
+    nonce integer,
+    dispatched_at timestamptz, -- 分发完成时间（已广播、已排队等待调度器或已标记失败），未完成为空
+    last_error text
+);
+
+-- 每笔提现同一时间只有一个未完成的分发事件，重试后可再次写入
+CREATE UNIQUE INDEX idx_withdraw_outbox_pending_withdraw ON withdraw_outbox (withdraw_id) WHERE dispatched_at IS NULL;
+
+CREATE INDEX idx_withdraw_outbox_pending ON withdraw_outbox (next_attempt_at) WHERE dispatched_at IS NULL;
+
+-- +migrate Down
+DROP TABLE IF EXISTS withdraw_outbox;
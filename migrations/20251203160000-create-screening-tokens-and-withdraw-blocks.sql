@@ -0,0 +1,34 @@
+-- +migrate Up
+-- 合规筛查代币名单：该代币的充值进入隔离不入账，提现被拦截
+CREATE TABLE screening_tokens (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    token_id integer NOT NULL UNIQUE REFERENCES tokens (id) ON DELETE CASCADE,
+    source varchar(50) NOT NULL, -- 名单来源，如 'ofac'、'internal'
+    reason text,
+    created_by uuid REFERENCES users (id) ON DELETE SET NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+-- 提现拦截审计记录：目标地址或代币命中筛查名单（或外部筛查服务）的提现请求不创建提现、不冻结资金，只追加不修改
+CREATE TABLE withdraw_screening_blocks (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    chain_id integer NOT NULL,
+    token_id integer NOT NULL REFERENCES tokens (id) ON DELETE RESTRICT,
+    to_address varchar(255) NOT NULL,
+    amount text NOT NULL, -- 提现金额（代币单位）
+    match_type varchar(20) NOT NULL, -- 命中类型：'address'、'token'
+    screening_source varchar(50) NOT NULL, -- 命中的名单来源
+    screening_reason text,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT withdraw_screening_blocks_match_type_check CHECK (match_type IN ('address', 'token'))
+);
+
+CREATE INDEX idx_withdraw_screening_blocks_created_at ON withdraw_screening_blocks (created_at DESC);
+
+CREATE INDEX idx_withdraw_screening_blocks_user_id ON withdraw_screening_blocks (user_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS withdraw_screening_blocks;
+
+DROP TABLE IF EXISTS screening_tokens;
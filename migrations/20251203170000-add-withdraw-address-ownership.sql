@@ -0,0 +1,8 @@
+-- +migrate Up
+-- 提现地址的持有证明：用户用该地址对持有证明消息签名（EOA 为 ECDSA，合约钱包为 EIP-1271），校验通过后记录时间
+ALTER TABLE withdraw_addresses
+    ADD COLUMN ownership_verified_at timestamptz;
+
+-- +migrate Down
+ALTER TABLE withdraw_addresses
+    DROP COLUMN IF EXISTS ownership_verified_at;
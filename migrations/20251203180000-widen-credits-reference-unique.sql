@@ -0,0 +1,15 @@
+-- +migrate Up
+-- 同一引用下的不同 credits 类型（提现、提现手续费、冲正）不再共用唯一约束，
+-- 提现手续费的平台入账记录与用户扣减记录按 event_index 区分
+ALTER TABLE credits
+    DROP CONSTRAINT IF EXISTS credits_user_reference_unique;
+
+ALTER TABLE credits
+    ADD CONSTRAINT credits_user_reference_unique UNIQUE (user_id, reference_id, reference_type, credit_type, event_index);
+
+-- +migrate Down
+ALTER TABLE credits
+    DROP CONSTRAINT IF EXISTS credits_user_reference_unique;
+
+ALTER TABLE credits
+    ADD CONSTRAINT credits_user_reference_unique UNIQUE (user_id, reference_id, reference_type, event_index);
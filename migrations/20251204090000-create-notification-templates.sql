@@ -0,0 +1,36 @@
+-- +migrate Up
+-- 组织通知品牌：推送和邮件中使用的发件人名称和 Logo，模板中通过 senderName、logoUrl 变量引用
+CREATE TABLE notification_brandings (
+    org_id uuid PRIMARY KEY REFERENCES organizations (id) ON DELETE CASCADE,
+    sender_name varchar(100), -- 邮件发件人显示名称，为空时使用部署的默认发件人
+    logo_url text,
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+-- 组织通知模板：按事件和语言覆盖系统默认的推送标题、正文和邮件正文
+-- locale 为空字符串表示组织的默认模板；查找顺序为用户语言 > 用户语言的基础语言（如 de-AT 回退到 de）> 组织默认模板 > 系统模板
+CREATE TABLE notification_templates (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4 (),
+    org_id uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    event varchar(50) NOT NULL, -- 安全通知或状态推送事件：large_withdraw, deposit_finalized, ...
+    locale varchar(35) NOT NULL DEFAULT '', -- BCP 47 语言标签
+    title text NOT NULL, -- text/template，安全通知的标题同时用作邮件主题
+    body text NOT NULL, -- text/template
+    email_html text, -- html/template，仅安全通知发送邮件；为空时使用系统邮件模板
+    created_by uuid REFERENCES users (id) ON DELETE SET NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT notification_templates_event_locale_unique UNIQUE (org_id, event, locale)
+);
+
+-- 用户的通知语言（BCP 47），用于选择组织模板，为空时使用组织默认模板
+ALTER TABLE security_notification_settings
+    ADD COLUMN locale varchar(35);
+
+-- +migrate Down
+ALTER TABLE security_notification_settings
+    DROP COLUMN IF EXISTS locale;
+
+DROP TABLE IF EXISTS notification_templates;
+
+DROP TABLE IF EXISTS notification_brandings;